@@ -63,6 +63,12 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 	UplinkStorage: applicationserver.UplinkStorageConfig{
 		Limit: 16,
 	},
+	DeferredDownlinks: applicationserver.DeferredDownlinksConfig{
+		Interval: 5 * time.Second,
+	},
+	DownlinkStatus: applicationserver.DownlinkStatusConfig{
+		TTL: 24 * time.Hour,
+	},
 	Distribution: applicationserver.DistributionConfig{
 		Timeout: time.Minute,
 		Local: applicationserver.LocalDistributorConfig{
@@ -97,4 +103,5 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 	Formatters: applicationserver.FormattersConfig{
 		MaxParameterLength: 40960,
 	},
+	CleanupReconcileInterval: 24 * time.Hour,
 }
@@ -20,6 +20,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/config/tlsconfig"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/packetbroker"
 	"go.thethings.network/lorawan-stack/v3/pkg/redis"
@@ -129,6 +130,17 @@ var DefaultRightsConfig = config.Rights{
 // DefaultKeyVaultConfig is the default config for key vaults.
 var DefaultKeyVaultConfig = config.KeyVault{
 	Provider: "static",
+	Vault: cryptoutil.VaultConfig{
+		AppRole: cryptoutil.VaultAppRoleConfig{
+			Mount: "approle",
+		},
+		RequestTimeout: 10 * time.Second,
+		TransitMount:   "transit",
+		PKIMount:       "pki",
+	},
+	PKCS11: cryptoutil.PKCS11Config{
+		Sessions: 4,
+	},
 }
 
 // DefaultServiceBase is the default base config for a service.
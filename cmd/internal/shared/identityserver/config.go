@@ -74,5 +74,13 @@ func init() {
 	DefaultIdentityServerConfig.UserRights.CreateGateways = true
 	DefaultIdentityServerConfig.UserRights.CreateOrganizations = true
 	DefaultIdentityServerConfig.LoginTokens.TokenTTL = time.Hour
+	DefaultIdentityServerConfig.MFA.RecoveryCodes = 10
 	DefaultIdentityServerConfig.Delete.Restore = 24 * time.Hour
+	DefaultIdentityServerConfig.Audit.Enabled = true
+	DefaultIdentityServerConfig.Audit.Retention = 365 * 24 * time.Hour
+	DefaultIdentityServerConfig.SCIM.MemberRights = []string{
+		"RIGHT_ORGANIZATION_INFO",
+		"RIGHT_APPLICATION_INFO",
+		"RIGHT_GATEWAY_INFO",
+	}
 }
@@ -15,6 +15,8 @@
 package joinserver
 
 import (
+	"time"
+
 	"go.thethings.network/lorawan-stack/v3/pkg/joinserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 )
@@ -24,4 +26,6 @@ var DefaultJoinServerConfig = joinserver.Config{
 	JoinEUIPrefixes: []types.EUI64Prefix{
 		{},
 	},
+	JoinEUIRangesCacheTTL:    time.Minute,
+	CleanupReconcileInterval: 24 * time.Hour,
 }
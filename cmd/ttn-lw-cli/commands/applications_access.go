@@ -255,6 +255,7 @@ var (
 			if err != nil {
 				return err
 			}
+			restrictions, _ := getAPIKeyRestrictions(cmd.Flags())
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
@@ -265,6 +266,7 @@ var (
 				Name:           name,
 				Rights:         rights,
 				ExpiresAt:      expiryDate,
+				Restrictions:   restrictions,
 			})
 			if err != nil {
 				return err
@@ -296,6 +298,8 @@ var (
 			if err != nil {
 				return err
 			}
+			restrictions, restrictionsPaths := getAPIKeyRestrictions(cmd.Flags())
+			paths = append(paths, restrictionsPaths...)
 			if len(paths) == 0 {
 				logger.Warn("No fields selected, won't update anything")
 				return nil
@@ -308,10 +312,11 @@ var (
 			_, err = ttnpb.NewApplicationAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateApplicationAPIKeyRequest{
 				ApplicationIds: appID,
 				ApiKey: &ttnpb.APIKey{
-					Id:        id,
-					Name:      name,
-					Rights:    rights,
-					ExpiresAt: expiryDate,
+					Id:           id,
+					Name:         name,
+					Rights:       rights,
+					ExpiresAt:    expiryDate,
+					Restrictions: restrictions,
 				},
 				FieldMask: &pbtypes.FieldMask{Paths: paths},
 			})
@@ -384,11 +389,14 @@ func init() {
 	applicationAPIKeysCreate.Flags().String("name", "", "")
 	applicationAPIKeysCreate.Flags().AddFlagSet(applicationRightsFlags)
 	applicationAPIKeysCreate.Flags().AddFlagSet(apiKeyExpiryFlag)
+	applicationAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionsFlags)
 	applicationAPIKeys.AddCommand(applicationAPIKeysCreate)
 	applicationAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	applicationAPIKeysUpdate.Flags().String("name", "", "")
 	applicationAPIKeysUpdate.Flags().AddFlagSet(applicationRightsFlags)
 	applicationAPIKeysUpdate.Flags().AddFlagSet(apiKeyExpiryFlag)
+	applicationAPIKeysUpdate.Flags().AddFlagSet(apiKeyRestrictionsFlags)
+	applicationAPIKeysUpdate.Flags().Bool("clear-restrictions", false, "remove the restrictions of the API key")
 	applicationAPIKeys.AddCommand(applicationAPIKeysUpdate)
 	applicationAPIKeysDelete.Flags().String("api-key-id", "", "")
 	applicationAPIKeys.AddCommand(applicationAPIKeysDelete)
@@ -16,11 +16,14 @@ package commands
 
 import (
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
 	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
@@ -28,6 +31,24 @@ var (
 	setApplicationDownlinkFlags = util.FieldFlags(&ttnpb.ApplicationDownlink{})
 )
 
+var (
+	errNoCorrelationID             = errors.DefineInvalidArgument("no_correlation_id", "no correlation ID set")
+	errNoDeferredDownlinkID        = errors.DefineInvalidArgument("no_deferred_downlink_id", "no deferred downlink ID set")
+	errInvalidDeferredDownlinkTime = errors.DefineInvalidArgument("invalid_deferred_downlink_time", "invalid time `{value}`, expected RFC 3339")
+)
+
+func getDeferredDownlinkTime(flagSet *pflag.FlagSet, name string) (*time.Time, error) {
+	value, _ := flagSet.GetString(name)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errInvalidDeferredDownlinkTime.WithCause(err).WithAttributes("value", value)
+	}
+	return &t, nil
+}
+
 var (
 	applicationsDownlinkCommand = &cobra.Command{
 		Use:   "downlink",
@@ -135,6 +156,172 @@ var (
 			return io.Write(os.Stdout, config.OutputFormat, res.Downlinks)
 		},
 	}
+	applicationsDownlinkStatusCommand = &cobra.Command{
+		Use:   "status",
+		Short: "Application downlink delivery status commands",
+	}
+	applicationsDownlinkStatusGetCommand = &cobra.Command{
+		Use:   "get [application-id] [device-id]",
+		Short: "Get the delivery status of an application downlink",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			correlationID, _ := cmd.Flags().GetString("correlation-id")
+			if correlationID == "" {
+				return errNoCorrelationID.New()
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewAsDownlinkStatusRegistryClient(as).GetDownlinkStatus(ctx, &ttnpb.GetApplicationDownlinkStatusRequest{
+				EndDeviceIdentifiers: *devID,
+				CorrelationId:        correlationID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	applicationsDownlinkStatusListCommand = &cobra.Command{
+		Use:   "list [application-id] [device-id]",
+		Short: "List the delivery statuses of application downlinks",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			limit, page, opt, getTotal := withPagination(cmd.Flags())
+			res, err := ttnpb.NewAsDownlinkStatusRegistryClient(as).ListDownlinkStatuses(ctx, &ttnpb.ListApplicationDownlinkStatusesRequest{
+				EndDeviceIdentifiers: *devID,
+				Limit:                limit,
+				Page:                 page,
+			}, opt)
+			if err != nil {
+				return err
+			}
+			getTotal()
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Statuses)
+		},
+	}
+	applicationsDownlinkDeferredCommand = &cobra.Command{
+		Use:   "deferred",
+		Short: "Application deferred downlink commands",
+	}
+	applicationsDownlinkDeferredAddCommand = &cobra.Command{
+		Use:   "add [application-id] [device-id]",
+		Short: "Add a deferred application downlink",
+		Long: `Add a deferred application downlink
+
+The downlink is pushed to the downlink queue of the end device once the
+not-before time has passed. If the expires-at time passes before the
+downlink could be pushed, the downlink is dropped.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			var downlink ttnpb.ApplicationDownlink
+			if err = util.SetFields(&downlink, setApplicationDownlinkFlags); err != nil {
+				return err
+			}
+			notBefore, err := getDeferredDownlinkTime(cmd.Flags(), "not-before")
+			if err != nil {
+				return err
+			}
+			if notBefore == nil {
+				now := time.Now()
+				notBefore = &now
+			}
+			expiresAt, err := getDeferredDownlinkTime(cmd.Flags(), "expires-at")
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewAsDeferredDownlinkRegistryClient(as).AddDeferredDownlink(ctx, &ttnpb.AddApplicationDeferredDownlinkRequest{
+				EndDeviceIdentifiers: *devID,
+				Downlinks:            []*ttnpb.ApplicationDownlink{&downlink},
+				NotBefore:            *notBefore,
+				ExpiresAt:            expiresAt,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	applicationsDownlinkDeferredListCommand = &cobra.Command{
+		Use:   "list [application-id] [device-id]",
+		Short: "List the deferred application downlinks",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			limit, page, opt, getTotal := withPagination(cmd.Flags())
+			res, err := ttnpb.NewAsDeferredDownlinkRegistryClient(as).ListDeferredDownlinks(ctx, &ttnpb.ListApplicationDeferredDownlinksRequest{
+				EndDeviceIdentifiers: *devID,
+				Limit:                limit,
+				Page:                 page,
+			}, opt)
+			if err != nil {
+				return err
+			}
+			getTotal()
+
+			return io.Write(os.Stdout, config.OutputFormat, res.DeferredDownlinks)
+		},
+	}
+	applicationsDownlinkDeferredDeleteCommand = &cobra.Command{
+		Use:   "delete [application-id] [device-id]",
+		Short: "Delete a deferred application downlink",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			id, _ := cmd.Flags().GetString("id")
+			if id == "" {
+				return errNoDeferredDownlinkID.New()
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewAsDeferredDownlinkRegistryClient(as).DeleteDeferredDownlink(ctx, &ttnpb.DeleteApplicationDeferredDownlinkRequest{
+				EndDeviceIdentifiers: *devID,
+				Id:                   id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
 )
 
 func init() {
@@ -148,6 +335,25 @@ func init() {
 	applicationsDownlinkCommand.AddCommand(applicationsDownlinkClearCommand)
 	applicationsDownlinkListCommand.Flags().AddFlagSet(endDeviceIDFlags())
 	applicationsDownlinkCommand.AddCommand(applicationsDownlinkListCommand)
+	applicationsDownlinkStatusGetCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	applicationsDownlinkStatusGetCommand.Flags().String("correlation-id", "", "correlation ID assigned to the downlink by the Application Server")
+	applicationsDownlinkStatusCommand.AddCommand(applicationsDownlinkStatusGetCommand)
+	applicationsDownlinkStatusListCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	applicationsDownlinkStatusListCommand.Flags().AddFlagSet(paginationFlags())
+	applicationsDownlinkStatusCommand.AddCommand(applicationsDownlinkStatusListCommand)
+	applicationsDownlinkCommand.AddCommand(applicationsDownlinkStatusCommand)
+	applicationsDownlinkDeferredAddCommand.Flags().AddFlagSet(setApplicationDownlinkFlags)
+	applicationsDownlinkDeferredAddCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	applicationsDownlinkDeferredAddCommand.Flags().String("not-before", "", "time from which the downlink is pushed (RFC 3339, default now)")
+	applicationsDownlinkDeferredAddCommand.Flags().String("expires-at", "", "time after which the downlink is dropped (RFC 3339)")
+	applicationsDownlinkDeferredCommand.AddCommand(applicationsDownlinkDeferredAddCommand)
+	applicationsDownlinkDeferredListCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	applicationsDownlinkDeferredListCommand.Flags().AddFlagSet(paginationFlags())
+	applicationsDownlinkDeferredCommand.AddCommand(applicationsDownlinkDeferredListCommand)
+	applicationsDownlinkDeferredDeleteCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	applicationsDownlinkDeferredDeleteCommand.Flags().String("id", "", "ID of the deferred downlink")
+	applicationsDownlinkDeferredCommand.AddCommand(applicationsDownlinkDeferredDeleteCommand)
+	applicationsDownlinkCommand.AddCommand(applicationsDownlinkDeferredCommand)
 
 	// The applicationsDownlinkCommand is placed under the end device command
 	// It's aliased here, but hidden from the documentation.
@@ -206,6 +206,9 @@ var (
 				return err
 			}
 			paths := util.UpdateFieldMask(cmd.Flags(), setApplicationPubSubFlags)
+			if routingRulesChanged(cmd.Flags()) {
+				paths = append(paths, "routing_rules")
+			}
 
 			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
 			if err != nil {
@@ -225,6 +228,11 @@ var (
 			if err = util.SetFields(pubsub, setApplicationPubSubFlags); err != nil {
 				return err
 			}
+			if routingRulesChanged(cmd.Flags()) {
+				if pubsub.RoutingRules, err = updateRoutingRules(cmd.Flags(), pubsub.RoutingRules); err != nil {
+					return err
+				}
+			}
 
 			if nats, _ := cmd.Flags().GetBool("nats"); nats {
 				if pubsub.GetNats() == nil {
@@ -350,6 +358,7 @@ func init() {
 	applicationsPubSubsSetCommand.Flags().AddFlagSet(applicationPubSubIDFlags())
 	applicationsPubSubsSetCommand.Flags().AddFlagSet(setApplicationPubSubFlags)
 	applicationsPubSubsSetCommand.Flags().AddFlagSet(applicationPubSubProviderFlags())
+	applicationsPubSubsSetCommand.Flags().AddFlagSet(applicationRoutingRuleFlags())
 	applicationsPubSubsCommand.AddCommand(applicationsPubSubsSetCommand)
 	applicationsPubSubsDeleteCommand.Flags().AddFlagSet(applicationPubSubIDFlags())
 	applicationsPubSubsCommand.AddCommand(applicationsPubSubsDeleteCommand)
@@ -0,0 +1,87 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"strings"
+
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	setApplicationRoutingRuleFlags = util.FieldFlags(&ttnpb.ApplicationRoutingRule{}, "routing-rule")
+
+	errRoutingRuleIndex = errors.DefineInvalidArgument("routing_rule_index", "index of routing rule to update out of bounds")
+)
+
+func applicationRoutingRuleFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.AddFlagSet(setApplicationRoutingRuleFlags)
+	flagSet.StringSlice("routing-rule.device-attributes", nil, "key=value (an empty value matches any value of the key)")
+	flagSet.Int("routing-rule.index", 0, "index of the routing rule to update or remove")
+	flagSet.Bool("routing-rule.add", false, "add an extra routing rule")
+	flagSet.Bool("routing-rule.remove", false, "remove a routing rule")
+	return flagSet
+}
+
+// routingRulesChanged returns true if any of the routing rule flags are set.
+func routingRulesChanged(flagSet *pflag.FlagSet) bool {
+	changed := false
+	applicationRoutingRuleFlags().VisitAll(func(flag *pflag.Flag) {
+		if flagSet.Changed(flag.Name) {
+			changed = true
+		}
+	})
+	return changed
+}
+
+// updateRoutingRules adds, updates or removes a routing rule from the rules using the routing rule flags.
+func updateRoutingRules(flagSet *pflag.FlagSet, rules []*ttnpb.ApplicationRoutingRule) ([]*ttnpb.ApplicationRoutingRule, error) {
+	ruleIndex, _ := flagSet.GetInt("routing-rule.index")
+	ruleAdd, _ := flagSet.GetBool("routing-rule.add")
+	ruleRemove, _ := flagSet.GetBool("routing-rule.remove")
+	if ruleRemove {
+		if ruleIndex < 0 || ruleIndex >= len(rules) {
+			return nil, errRoutingRuleIndex.New()
+		}
+		return append(rules[:ruleIndex], rules[ruleIndex+1:]...), nil
+	}
+	if ruleAdd || len(rules) == 0 {
+		rules = append(rules, &ttnpb.ApplicationRoutingRule{})
+		ruleIndex = len(rules) - 1
+	} else if ruleIndex < 0 || ruleIndex >= len(rules) {
+		return nil, errRoutingRuleIndex.New()
+	}
+	rule := rules[ruleIndex]
+	if err := util.SetFields(rule, setApplicationRoutingRuleFlags, "routing-rule"); err != nil {
+		return nil, err
+	}
+	if flagSet.Changed("routing-rule.device-attributes") {
+		kv, _ := flagSet.GetStringSlice("routing-rule.device-attributes")
+		rule.DeviceAttributes = make(map[string]string, len(kv))
+		for _, kv := range kv {
+			kv := strings.SplitN(kv, "=", 2)
+			if len(kv) == 2 {
+				rule.DeviceAttributes[kv[0]] = kv[1]
+			} else {
+				rule.DeviceAttributes[kv[0]] = ""
+			}
+		}
+	}
+	return rules, nil
+}
@@ -189,6 +189,20 @@ var (
 			if err != nil {
 				return err
 			}
+
+			if routingRulesChanged(cmd.Flags()) {
+				res, err := ttnpb.NewApplicationWebhookRegistryClient(as).Get(ctx, &ttnpb.GetApplicationWebhookRequest{
+					Ids:       webhookID,
+					FieldMask: &pbtypes.FieldMask{Paths: []string{"routing_rules"}},
+				})
+				if err != nil && !errors.IsNotFound(err) {
+					return err
+				}
+				if webhook.RoutingRules, err = updateRoutingRules(cmd.Flags(), res.GetRoutingRules()); err != nil {
+					return err
+				}
+				paths = append(paths, "routing_rules")
+			}
 			res, err := ttnpb.NewApplicationWebhookRegistryClient(as).Set(ctx, &ttnpb.SetApplicationWebhookRequest{
 				Webhook:   webhook,
 				FieldMask: &pbtypes.FieldMask{Paths: paths},
@@ -237,6 +251,7 @@ func init() {
 	applicationsWebhooksSetCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
 	applicationsWebhooksSetCommand.Flags().AddFlagSet(setApplicationWebhookFlags)
 	applicationsWebhooksSetCommand.Flags().AddFlagSet(headersFlags())
+	applicationsWebhooksSetCommand.Flags().AddFlagSet(applicationRoutingRuleFlags())
 	applicationsWebhooksCommand.AddCommand(applicationsWebhooksSetCommand)
 	applicationsWebhooksDeleteCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
 	applicationsWebhooksCommand.AddCommand(applicationsWebhooksDeleteCommand)
@@ -0,0 +1,145 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errInvalidAuditTime = errors.DefineInvalidArgument("invalid_audit_time", "invalid time `{value}`, expected RFC 3339")
+
+func auditFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("after", "", "only list entries created at or after this time (RFC 3339)")
+	flagSet.String("before", "", "only list entries created before this time (RFC 3339)")
+	flagSet.AddFlagSet(paginationFlags())
+	return flagSet
+}
+
+func getAuditTime(flagSet *pflag.FlagSet, name string) (*time.Time, error) {
+	value, _ := flagSet.GetString(name)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errInvalidAuditTime.WithCause(err).WithAttributes("value", value)
+	}
+	return &t, nil
+}
+
+func listAuditEntries(cmd *cobra.Command, ids *ttnpb.EntityIdentifiers) error {
+	after, err := getAuditTime(cmd.Flags(), "after")
+	if err != nil {
+		return err
+	}
+	before, err := getAuditTime(cmd.Flags(), "before")
+	if err != nil {
+		return err
+	}
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	limit, page, opt, getTotal := withPagination(cmd.Flags())
+	res, err := ttnpb.NewAuditLogClient(is).ListAuditEntries(ctx, &ttnpb.ListAuditEntriesRequest{
+		EntityIds: ids,
+		After:     after,
+		Before:    before,
+		Limit:     limit,
+		Page:      page,
+	}, opt)
+	if err != nil {
+		return err
+	}
+	getTotal()
+	return io.Write(os.Stdout, config.OutputFormat, res.Entries)
+}
+
+func auditCommand(use, entity string, getIDs func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error), idFlags *pflag.FlagSet) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: fmt.Sprintf("List the audit log of %s", entity),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids, err := getIDs(cmd, args)
+			if err != nil {
+				return err
+			}
+			return listAuditEntries(cmd, ids)
+		},
+	}
+	if idFlags != nil {
+		cmd.Flags().AddFlagSet(idFlags)
+	}
+	cmd.Flags().AddFlagSet(auditFlags())
+	return cmd
+}
+
+func init() {
+	Root.AddCommand(auditCommand("audit", "all entities (admin only)", func(*cobra.Command, []string) (*ttnpb.EntityIdentifiers, error) {
+		return nil, nil
+	}, nil))
+	applicationsCommand.AddCommand(auditCommand("audit [application-id]", "an application", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
+		appID := getApplicationID(cmd.Flags(), args)
+		if appID == nil {
+			return nil, errNoApplicationID.New()
+		}
+		return appID.GetEntityIdentifiers(), nil
+	}, applicationIDFlags()))
+	clientsCommand.AddCommand(auditCommand("audit [client-id]", "an OAuth client", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
+		cliID := getClientID(cmd.Flags(), args)
+		if cliID == nil {
+			return nil, errNoClientID.New()
+		}
+		return cliID.GetEntityIdentifiers(), nil
+	}, clientIDFlags()))
+	endDevicesCommand.AddCommand(auditCommand("audit [application-id] [device-id]", "an end device", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
+		devID, err := getEndDeviceID(cmd.Flags(), args, true)
+		if err != nil {
+			return nil, err
+		}
+		return devID.GetEntityIdentifiers(), nil
+	}, endDeviceIDFlags()))
+	gatewaysCommand.AddCommand(auditCommand("audit [gateway-id]", "a gateway", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
+		gtwID, err := getGatewayID(cmd.Flags(), args, true)
+		if err != nil {
+			return nil, err
+		}
+		return gtwID.GetEntityIdentifiers(), nil
+	}, gatewayIDFlags()))
+	organizationsCommand.AddCommand(auditCommand("audit [organization-id]", "an organization", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
+		orgID := getOrganizationID(cmd.Flags(), args)
+		if orgID == nil {
+			return nil, errNoOrganizationID.New()
+		}
+		return orgID.GetEntityIdentifiers(), nil
+	}, organizationIDFlags()))
+	usersCommand.AddCommand(auditCommand("audit [user-id]", "a user", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
+		usrID := getUserID(cmd.Flags(), args)
+		if usrID == nil {
+			return nil, errNoUserID.New()
+		}
+		return usrID.GetEntityIdentifiers(), nil
+	}, userIDFlags()))
+}
@@ -0,0 +1,429 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicemigration"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errNoEndDevicesToExport   = errors.DefineInvalidArgument("no_end_devices_to_export", "no end devices to export")
+	errExportedSessionChanged = errors.DefineAborted(
+		"exported_session_changed",
+		"session of end device `{device_id}` changed after export, discard the migration bundle and export again",
+	)
+	errNoRecipientKey = errors.DefineInvalidArgument("no_recipient_key", "no recipient key file")
+	errNoSenderKey    = errors.DefineInvalidArgument("no_sender_key", "no sender key file")
+)
+
+// listEndDeviceIDs returns the identifiers of all end devices of the application.
+func listEndDeviceIDs(appID *ttnpb.ApplicationIdentifiers) ([]ttnpb.EndDeviceIdentifiers, error) {
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return nil, err
+	}
+	const limit = 100
+	var ids []ttnpb.EndDeviceIdentifiers
+	for page := uint32(1); ; page++ {
+		res, err := ttnpb.NewEndDeviceRegistryClient(is).List(ctx, &ttnpb.ListEndDevicesRequest{
+			ApplicationIds: appID,
+			FieldMask:      &pbtypes.FieldMask{Paths: []string{"ids"}},
+			Limit:          limit,
+			Page:           page,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, dev := range res.EndDevices {
+			ids = append(ids, dev.EndDeviceIdentifiers)
+		}
+		if len(res.EndDevices) < limit {
+			return ids, nil
+		}
+	}
+}
+
+// exportEndDevice returns the end device with the fields of a migration bundle.
+// The end device is not deleted from the Network Server; see deleteExportedEndDevice.
+func exportEndDevice(ids ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDevice, error) {
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return nil, err
+	}
+	device, err := ttnpb.NewEndDeviceRegistryClient(is).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: ids,
+		FieldMask: &pbtypes.FieldMask{Paths: []string{
+			"application_server_address",
+			"join_server_address",
+			"network_server_address",
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	nsMismatch, asMismatch, _ := compareServerAddressesEndDevice(device, config)
+	if nsMismatch || asMismatch {
+		return nil, errAddressMismatchEndDevice.New()
+	}
+	if !config.NetworkServerEnabled {
+		return nil, errNetworkServerDisabled.New()
+	}
+	ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+	if err != nil {
+		return nil, err
+	}
+	res, err := ttnpb.NewNsEndDeviceMigrationClient(ns).ExportSession(ctx, &device.EndDeviceIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	res.SetFields(device, "ids.application_ids", "ids.dev_eui", "ids.device_id", "ids.join_eui")
+	return res, nil
+}
+
+// exportedSessionChanged returns whether the session of the exported end device changed on the Network Server or the
+// Application Server since the export, i.e. whether the frame counters in the migration bundle are stale.
+func exportedSessionChanged(exported *ttnpb.EndDevice) (bool, error) {
+	ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+	if err != nil {
+		return false, err
+	}
+	nsDevice, err := ttnpb.NewNsEndDeviceRegistryClient(ns).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: exported.EndDeviceIdentifiers,
+		FieldMask: &pbtypes.FieldMask{Paths: []string{
+			"session.keys.session_key_id",
+			"session.last_conf_f_cnt_down",
+			"session.last_f_cnt_up",
+			"session.last_n_f_cnt_down",
+		}},
+	})
+	if err != nil {
+		return false, err
+	}
+	if exported.Session == nil || nsDevice.Session == nil {
+		return exported.Session != nsDevice.Session, nil
+	}
+	if !bytes.Equal(nsDevice.Session.SessionKeyId, exported.Session.SessionKeyId) ||
+		nsDevice.Session.LastFCntUp != exported.Session.LastFCntUp ||
+		nsDevice.Session.LastNFCntDown != exported.Session.LastNFCntDown ||
+		nsDevice.Session.LastConfFCntDown != exported.Session.LastConfFCntDown {
+		return true, nil
+	}
+	// The Application Server session is only in the bundle if it is the current session of the Network Server.
+	if exported.Session.AppSKey == nil || !config.ApplicationServerEnabled {
+		return false, nil
+	}
+	as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+	if err != nil {
+		return false, err
+	}
+	asDevice, err := ttnpb.NewAsEndDeviceRegistryClient(as).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: exported.EndDeviceIdentifiers,
+		FieldMask: &pbtypes.FieldMask{Paths: []string{
+			"session.keys.session_key_id",
+			"session.last_a_f_cnt_down",
+		}},
+	})
+	if err != nil {
+		return false, err
+	}
+	return asDevice.Session == nil ||
+		!bytes.Equal(asDevice.Session.SessionKeyId, exported.Session.SessionKeyId) ||
+		asDevice.Session.LastAFCntDown != exported.Session.LastAFCntDown, nil
+}
+
+// deleteExportedEndDevice deletes the exported end device from the Network Server, so that the session is no longer
+// used in this deployment. This is only called after the migration bundle is written and the sessions of all exported
+// end devices are verified to be unchanged, see exportedSessionChanged.
+func deleteExportedEndDevice(ids *ttnpb.EndDeviceIdentifiers) error {
+	ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	_, err = ttnpb.NewNsEndDeviceRegistryClient(ns).Delete(ctx, ids)
+	return err
+}
+
+// importEndDevicePaths returns the paths to set of an end device from a migration bundle.
+// Keys and sessions that are not in the bundle are not set.
+func importEndDevicePaths(device *ttnpb.EndDevice, paths []string) []string {
+	res := make([]string, 0, len(paths))
+	for _, p := range paths {
+		switch {
+		case strings.HasSuffix(p, ".key") && device.FieldIsZero(p):
+		case strings.HasPrefix(p, "session.") && device.Session == nil:
+		case strings.HasPrefix(p, "root_keys.") && device.RootKeys == nil:
+		default:
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// importEndDevice registers the end device from a migration bundle.
+func importEndDevice(device *ttnpb.EndDevice) (*ttnpb.EndDevice, error) {
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return nil, err
+	}
+	_, err = ttnpb.NewEndDeviceRegistryClient(is).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: device.EndDeviceIdentifiers,
+		FieldMask:            &pbtypes.FieldMask{Paths: []string{"ids"}},
+	})
+	if errors.IsNotFound(err) {
+		isDevice := ttnpb.EndDevice{
+			EndDeviceIdentifiers: device.EndDeviceIdentifiers,
+		}
+		if config.NetworkServerEnabled {
+			isDevice.NetworkServerAddress = getHost(config.NetworkServerGRPCAddress)
+		}
+		if config.ApplicationServerEnabled {
+			isDevice.ApplicationServerAddress = getHost(config.ApplicationServerGRPCAddress)
+		}
+		if device.SupportsJoin && config.JoinServerEnabled {
+			isDevice.JoinServerAddress = getHost(config.JoinServerGRPCAddress)
+		}
+		logger.WithField("device_id", device.DeviceId).Debug("Create end device on Identity Server")
+		if _, err := ttnpb.NewEndDeviceRegistryClient(is).Create(ctx, &ttnpb.CreateEndDeviceRequest{
+			EndDevice: isDevice,
+		}); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+	var jsPaths []string
+	if device.SupportsJoin {
+		jsPaths = importEndDevicePaths(device, devicemigration.JoinServerPaths)
+	}
+	nsPaths := importEndDevicePaths(device, devicemigration.NetworkServerPaths)
+	asPaths := importEndDevicePaths(device, devicemigration.ApplicationServerPaths)
+	return setEndDevice(device, nil, nsPaths, asPaths, jsPaths, nil, false, false)
+}
+
+var (
+	endDevicesExportSessionsCommand = &cobra.Command{
+		Use:   "export-sessions [application-id] [device-id]...",
+		Short: "Export end devices with their sessions to a migration bundle",
+		Long: `Export end devices with their sessions to a migration bundle
+
+The migration bundle contains the session keys, frame counters, MAC state and
+DevAddr of the end devices from the Network Server and Application Server, and
+the root keys and nonces from the Join Server. The keys in the bundle are
+encrypted with the public key of the recipient, which is an ECDSA or RSA key.
+The bundle is signed with the private key of the sender, which is an ECDSA, RSA
+or Ed25519 key.
+
+The end devices are deleted from the Network Server after the bundle is
+written. If the export of an end device fails, no bundle is written and no end
+devices are deleted. Before deleting, the sessions are read again; if the frame
+counters of any end device changed since the export, no end devices are deleted
+and the command fails. In that case, discard the bundle and export again. The
+end devices keep their sessions after importing the bundle in the other
+deployment.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var appArgs, devIDs []string
+			if len(args) > 0 {
+				appArgs, devIDs = args[:1], args[1:]
+			}
+			appID := getApplicationID(cmd.Flags(), appArgs)
+			if appID == nil {
+				return errNoApplicationID.New()
+			}
+			if len(devIDs) == 0 {
+				devIDs, _ = cmd.Flags().GetStringSlice("device-ids")
+			}
+
+			keyFile, _ := cmd.Flags().GetString("recipient-public-key-file")
+			if keyFile == "" {
+				return errNoRecipientKey.New()
+			}
+			keyData, err := os.ReadFile(keyFile)
+			if err != nil {
+				return err
+			}
+			recipient, err := devicemigration.ParsePublicKey(keyData)
+			if err != nil {
+				return err
+			}
+			keyFile, _ = cmd.Flags().GetString("sender-private-key-file")
+			if keyFile == "" {
+				return errNoSenderKey.New()
+			}
+			keyData, err = os.ReadFile(keyFile)
+			if err != nil {
+				return err
+			}
+			sender, err := devicemigration.ParsePrivateKey(keyData)
+			if err != nil {
+				return err
+			}
+
+			var ids []ttnpb.EndDeviceIdentifiers
+			if all, _ := cmd.Flags().GetBool("all"); all {
+				if ids, err = listEndDeviceIDs(appID); err != nil {
+					return err
+				}
+			} else {
+				for _, devID := range devIDs {
+					ids = append(ids, ttnpb.EndDeviceIdentifiers{
+						ApplicationIdentifiers: *appID,
+						DeviceId:               devID,
+					})
+				}
+			}
+			if len(ids) == 0 {
+				return errNoEndDevicesToExport.New()
+			}
+
+			devices := make([]*ttnpb.EndDevice, 0, len(ids))
+			for _, id := range ids {
+				logger.WithField("device_id", id.DeviceId).Info("Export end device")
+				device, err := exportEndDevice(id)
+				if err != nil {
+					return err
+				}
+				devices = append(devices, device)
+			}
+			bundle, err := devicemigration.Seal(ctx, devices, recipient)
+			if err != nil {
+				return err
+			}
+			signed, err := bundle.Sign(sender)
+			if err != nil {
+				return err
+			}
+			if err := io.Write(os.Stdout, config.OutputFormat, signed); err != nil {
+				return err
+			}
+
+			// The end devices stay active until they are deleted, so the frame counters may have changed since the export.
+			// The end devices are only deleted if none of the sessions changed, so that a stale bundle is never the only
+			// copy of a session.
+			for _, device := range devices {
+				changed, err := exportedSessionChanged(device)
+				if err != nil {
+					return err
+				}
+				if changed {
+					return errExportedSessionChanged.WithAttributes("device_id", device.DeviceId)
+				}
+			}
+			// If an end device cannot be deleted, the other end devices are still deleted, and the end device needs to
+			// be deleted before importing the bundle.
+			var deleteErr error
+			for _, device := range devices {
+				logger.WithField("device_id", device.DeviceId).Info("Delete exported end device from Network Server")
+				if err := deleteExportedEndDevice(&device.EndDeviceIdentifiers); err != nil {
+					logger.WithField("device_id", device.DeviceId).WithError(err).Error("Could not delete exported end device from Network Server")
+					deleteErr = err
+				}
+			}
+			return deleteErr
+		},
+	}
+	endDevicesImportSessionsCommand = &cobra.Command{
+		Use:   "import-sessions [application-id]",
+		Short: "Import end devices with their sessions from a migration bundle",
+		Long: `Import end devices with their sessions from a migration bundle
+
+The signature of the bundle is verified with the public key of the sender, and
+the keys in the bundle are decrypted with the private key of the recipient.
+End devices that are not registered in the Identity Server are created. If the
+application ID is set, the end devices are imported in that application.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keyFile, _ := cmd.Flags().GetString("recipient-private-key-file")
+			if keyFile == "" {
+				return errNoRecipientKey.New()
+			}
+			keyData, err := os.ReadFile(keyFile)
+			if err != nil {
+				return err
+			}
+			recipient, err := devicemigration.ParsePrivateKey(keyData)
+			if err != nil {
+				return err
+			}
+			keyFile, _ = cmd.Flags().GetString("sender-public-key-file")
+			if keyFile == "" {
+				return errNoSenderKey.New()
+			}
+			keyData, err = os.ReadFile(keyFile)
+			if err != nil {
+				return err
+			}
+			sender, err := devicemigration.ParsePublicKey(keyData)
+			if err != nil {
+				return err
+			}
+
+			reader, err := getDataReader("", cmd.Flags())
+			if err != nil {
+				return err
+			}
+			var signed devicemigration.SignedBundle
+			if err := json.NewDecoder(reader).Decode(&signed); err != nil {
+				return err
+			}
+			bundle, err := signed.Verify(sender)
+			if err != nil {
+				return err
+			}
+			devices, err := bundle.Open(ctx, recipient)
+			if err != nil {
+				return err
+			}
+
+			appID := getApplicationID(cmd.Flags(), args)
+			res := make([]*ttnpb.EndDeviceIdentifiers, 0, len(devices))
+			for _, device := range devices {
+				if appID != nil {
+					device.ApplicationIdentifiers = *appID
+				}
+				logger.WithField("device_id", device.DeviceId).Info("Import end device")
+				imported, err := importEndDevice(device)
+				if err != nil {
+					return err
+				}
+				res = append(res, &imported.EndDeviceIdentifiers)
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+)
+
+func init() {
+	endDevicesExportSessionsCommand.Flags().AddFlagSet(applicationIDFlags())
+	endDevicesExportSessionsCommand.Flags().StringSlice("device-ids", nil, "")
+	endDevicesExportSessionsCommand.Flags().Bool("all", false, "export all end devices of the application")
+	endDevicesExportSessionsCommand.Flags().String("recipient-public-key-file", "", "PEM encoded public key or certificate of the recipient")
+	endDevicesExportSessionsCommand.Flags().String("sender-private-key-file", "", "PEM encoded private key of the sender")
+	endDevicesCommand.AddCommand(endDevicesExportSessionsCommand)
+	endDevicesImportSessionsCommand.Flags().AddFlagSet(applicationIDFlags())
+	endDevicesImportSessionsCommand.Flags().AddFlagSet(dataFlags("", "migration bundle"))
+	endDevicesImportSessionsCommand.Flags().String("recipient-private-key-file", "", "PEM encoded private key of the recipient")
+	endDevicesImportSessionsCommand.Flags().String("sender-public-key-file", "", "PEM encoded public key or certificate of the sender")
+	endDevicesCommand.AddCommand(endDevicesImportSessionsCommand)
+}
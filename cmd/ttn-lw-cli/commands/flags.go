@@ -180,6 +180,39 @@ func getAPIKeyFields(flagSet *pflag.FlagSet) ([]ttnpb.Right, *time.Time, []strin
 	return rights, expiryDate, paths, nil
 }
 
+var apiKeyRestrictionsFlags = func() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringSlice("restrictions.end-device-ids", nil, "IDs of the end devices that the API key can access (application API keys only)")
+	flagSet.StringSlice("restrictions.end-device-attributes", nil, "key=value attributes of the end devices that the API key can access (application API keys only)")
+	flagSet.StringSlice("restrictions.source-cidrs", nil, "address ranges that the API key can be used from")
+	flagSet.StringSlice("restrictions.methods", nil, "full names of the gRPC methods that the API key can call (/Service/* for all methods of a service)")
+	return flagSet
+}()
+
+// getAPIKeyRestrictions returns the API key restrictions that are set in the flags,
+// and the field mask paths to update them. The restrictions replace the existing
+// restrictions of the API key, and the clear-restrictions flag removes them.
+func getAPIKeyRestrictions(flagSet *pflag.FlagSet) (*ttnpb.APIKeyRestrictions, []string) {
+	if clear, _ := flagSet.GetBool("clear-restrictions"); clear {
+		return nil, []string{"restrictions"}
+	}
+	changed := false
+	apiKeyRestrictionsFlags.VisitAll(func(flag *pflag.Flag) {
+		changed = changed || flagSet.Changed(flag.Name)
+	})
+	if !changed {
+		return nil, nil
+	}
+	restrictions := &ttnpb.APIKeyRestrictions{}
+	restrictions.EndDeviceIds, _ = flagSet.GetStringSlice("restrictions.end-device-ids")
+	if attributes, _ := flagSet.GetStringSlice("restrictions.end-device-attributes"); len(attributes) > 0 {
+		restrictions.EndDeviceAttributes = mergeKV(nil, attributes)
+	}
+	restrictions.SourceCidrs, _ = flagSet.GetStringSlice("restrictions.source-cidrs")
+	restrictions.Methods, _ = flagSet.GetStringSlice("restrictions.methods")
+	return restrictions, []string{"restrictions"}
+}
+
 var searchFlags = func() *pflag.FlagSet {
 	flagSet := &pflag.FlagSet{}
 	// NOTE: These flags need to be named with underscores, not dashes!
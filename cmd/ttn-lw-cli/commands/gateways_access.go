@@ -255,16 +255,18 @@ var (
 			if err != nil {
 				return err
 			}
+			restrictions, _ := getAPIKeyRestrictions(cmd.Flags())
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
 				return err
 			}
 			res, err := ttnpb.NewGatewayAccessClient(is).CreateAPIKey(ctx, &ttnpb.CreateGatewayAPIKeyRequest{
-				GatewayIds: gtwID,
-				Name:       name,
-				Rights:     rights,
-				ExpiresAt:  expiryDate,
+				GatewayIds:   gtwID,
+				Name:         name,
+				Rights:       rights,
+				ExpiresAt:    expiryDate,
+				Restrictions: restrictions,
 			})
 			if err != nil {
 				return err
@@ -297,6 +299,8 @@ var (
 			if err != nil {
 				return err
 			}
+			restrictions, restrictionsPaths := getAPIKeyRestrictions(cmd.Flags())
+			paths = append(paths, restrictionsPaths...)
 			if len(paths) == 0 {
 				logger.Warn("No fields selected, won't update anything")
 				return nil
@@ -308,10 +312,11 @@ var (
 			_, err = ttnpb.NewGatewayAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateGatewayAPIKeyRequest{
 				GatewayIds: gtwID,
 				ApiKey: &ttnpb.APIKey{
-					Id:        id,
-					Name:      name,
-					Rights:    rights,
-					ExpiresAt: expiryDate,
+					Id:           id,
+					Name:         name,
+					Rights:       rights,
+					ExpiresAt:    expiryDate,
+					Restrictions: restrictions,
 				},
 				FieldMask: &pbtypes.FieldMask{Paths: paths},
 			})
@@ -384,11 +389,14 @@ func init() {
 	gatewayAPIKeysCreate.Flags().String("name", "", "")
 	gatewayAPIKeysCreate.Flags().AddFlagSet(gatewayRightsFlags)
 	gatewayAPIKeysCreate.Flags().AddFlagSet(apiKeyExpiryFlag)
+	gatewayAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionsFlags)
 	gatewayAPIKeys.AddCommand(gatewayAPIKeysCreate)
 	gatewayAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	gatewayAPIKeysUpdate.Flags().String("name", "", "")
 	gatewayAPIKeysUpdate.Flags().AddFlagSet(gatewayRightsFlags)
 	gatewayAPIKeysUpdate.Flags().AddFlagSet(apiKeyExpiryFlag)
+	gatewayAPIKeysUpdate.Flags().AddFlagSet(apiKeyRestrictionsFlags)
+	gatewayAPIKeysUpdate.Flags().Bool("clear-restrictions", false, "remove the restrictions of the API key")
 	gatewayAPIKeys.AddCommand(gatewayAPIKeysUpdate)
 	gatewayAPIKeysDelete.Flags().String("api-key-id", "", "")
 	gatewayAPIKeys.AddCommand(gatewayAPIKeysDelete)
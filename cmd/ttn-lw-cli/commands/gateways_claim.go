@@ -119,6 +119,7 @@ A PEM encoded CUPS trust may be included in the claim request.
 The given API key must have the right to
 - read gateway information
 - read secrets
+- edit basic gateway settings
 - delete the gateway.
 If no API key is provided, a new one will be created.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -136,6 +137,7 @@ If no API key is provided, a new one will be created.`,
 				ttnpb.RIGHT_GATEWAY_READ_SECRETS,
 				ttnpb.RIGHT_GATEWAY_DELETE,
 				ttnpb.RIGHT_GATEWAY_INFO,
+				ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC,
 			}
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
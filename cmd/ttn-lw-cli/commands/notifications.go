@@ -0,0 +1,223 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errNoNotificationIDs         = errors.DefineInvalidArgument("no_notification_ids", "no notification IDs set")
+	errInvalidNotificationStatus = errors.DefineInvalidArgument("invalid_notification_status", "invalid notification status `{status}`")
+)
+
+func parseNotificationStatus(s string) (ttnpb.NotificationStatus, error) {
+	s = strings.ToUpper(s)
+	if v, ok := ttnpb.NotificationStatus_value[s]; ok {
+		return ttnpb.NotificationStatus(v), nil
+	}
+	if v, ok := ttnpb.NotificationStatus_customvalue[s]; ok {
+		return ttnpb.NotificationStatus(v), nil
+	}
+	return 0, errInvalidNotificationStatus.WithAttributes("status", s)
+}
+
+var (
+	userNotificationsCommand = &cobra.Command{
+		Use:     "notifications",
+		Aliases: []string{"notification", "inbox"},
+		Short:   "Manage the notifications of a user",
+	}
+	userNotificationsList = &cobra.Command{
+		Use:     "list [user-id]",
+		Aliases: []string{"ls"},
+		Short:   "List the notifications of a user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID.New()
+			}
+			statusFlags, _ := cmd.Flags().GetStringSlice("status")
+			statuses := make([]ttnpb.NotificationStatus, len(statusFlags))
+			for i, statusFlag := range statusFlags {
+				status, err := parseNotificationStatus(statusFlag)
+				if err != nil {
+					return err
+				}
+				statuses[i] = status
+			}
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			limit, page, opt, getTotal := withPagination(cmd.Flags())
+			res, err := ttnpb.NewNotificationServiceClient(is).List(ctx, &ttnpb.ListNotificationsRequest{
+				ReceiverIds: usrID,
+				Status:      statuses,
+				Limit:       limit,
+				Page:        page,
+			}, opt)
+			if err != nil {
+				return err
+			}
+			getTotal()
+			return io.Write(os.Stdout, config.OutputFormat, res.Notifications)
+		},
+	}
+	userNotificationsUpdateStatus = &cobra.Command{
+		Use:   "update-status [user-id]",
+		Short: "Update the status of notifications of a user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID.New()
+			}
+			ids, _ := cmd.Flags().GetStringSlice("notification-ids")
+			if len(ids) == 0 {
+				return errNoNotificationIDs.New()
+			}
+			statusFlag, _ := cmd.Flags().GetString("status")
+			status, err := parseNotificationStatus(statusFlag)
+			if err != nil {
+				return err
+			}
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewNotificationServiceClient(is).UpdateStatus(ctx, &ttnpb.UpdateNotificationStatusRequest{
+				ReceiverIds: usrID,
+				Ids:         ids,
+				Status:      status,
+			})
+			return err
+		},
+	}
+	userNotificationsStream = &cobra.Command{
+		Use:   "stream [user-id]",
+		Short: "Stream the new notifications of a user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID.New()
+			}
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			stream, err := ttnpb.NewNotificationServiceClient(is).Stream(ctx, &ttnpb.StreamNotificationsRequest{
+				ReceiverIds: usrID,
+			})
+			if err != nil {
+				return err
+			}
+			for {
+				notification, err := stream.Recv()
+				if err != nil {
+					if errors.IsCanceled(err) {
+						return nil
+					}
+					return err
+				}
+				if err := io.Write(os.Stdout, config.OutputFormat, notification); err != nil {
+					return err
+				}
+			}
+		},
+	}
+	userNotificationPreferencesCommand = &cobra.Command{
+		Use:   "preferences",
+		Short: "Manage the email preferences for notifications of a user",
+	}
+	userNotificationPreferencesGet = &cobra.Command{
+		Use:   "get [user-id]",
+		Short: "Get the email preferences for notifications of a user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID.New()
+			}
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNotificationServiceClient(is).GetEmailPreferences(ctx, usrID)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	userNotificationPreferencesSet = &cobra.Command{
+		Use:   "set [user-id]",
+		Short: "Set the email preferences for notifications of a user",
+		Long: `Set the email preferences for notifications of a user
+
+Notifications of the disabled types are only delivered to the inbox of the
+user. Notifications of all other types are also sent by email.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID.New()
+			}
+			disabled, _ := cmd.Flags().GetStringSlice("disable-email")
+			preferences := &ttnpb.NotificationEmailPreferences{
+				Email: make(map[string]bool, len(disabled)),
+			}
+			for _, notificationType := range disabled {
+				preferences.Email[notificationType] = false
+			}
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNotificationServiceClient(is).SetEmailPreferences(ctx, &ttnpb.SetNotificationEmailPreferencesRequest{
+				UserIds:     usrID,
+				Preferences: preferences,
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+)
+
+func init() {
+	userNotificationsList.Flags().AddFlagSet(userIDFlags())
+	userNotificationsList.Flags().StringSlice("status", nil, "only list notifications with these statuses (unread, read, archived)")
+	userNotificationsList.Flags().AddFlagSet(paginationFlags())
+	userNotificationsCommand.AddCommand(userNotificationsList)
+	userNotificationsUpdateStatus.Flags().AddFlagSet(userIDFlags())
+	userNotificationsUpdateStatus.Flags().StringSlice("notification-ids", nil, "")
+	userNotificationsUpdateStatus.Flags().String("status", "read", "new status of the notifications (unread, read, archived)")
+	userNotificationsCommand.AddCommand(userNotificationsUpdateStatus)
+	userNotificationsStream.Flags().AddFlagSet(userIDFlags())
+	userNotificationsCommand.AddCommand(userNotificationsStream)
+	userNotificationPreferencesGet.Flags().AddFlagSet(userIDFlags())
+	userNotificationPreferencesCommand.AddCommand(userNotificationPreferencesGet)
+	userNotificationPreferencesSet.Flags().AddFlagSet(userIDFlags())
+	userNotificationPreferencesSet.Flags().StringSlice("disable-email", nil, "types of notifications that are not sent by email")
+	userNotificationPreferencesCommand.AddCommand(userNotificationPreferencesSet)
+	userNotificationsCommand.AddCommand(userNotificationPreferencesCommand)
+	usersCommand.AddCommand(userNotificationsCommand)
+}
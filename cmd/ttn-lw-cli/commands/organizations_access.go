@@ -255,6 +255,7 @@ var (
 			if err != nil {
 				return err
 			}
+			restrictions, _ := getAPIKeyRestrictions(cmd.Flags())
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
@@ -265,6 +266,7 @@ var (
 				Name:            name,
 				Rights:          rights,
 				ExpiresAt:       expiryDate,
+				Restrictions:    restrictions,
 			})
 			if err != nil {
 				return err
@@ -297,6 +299,8 @@ var (
 			if err != nil {
 				return err
 			}
+			restrictions, restrictionsPaths := getAPIKeyRestrictions(cmd.Flags())
+			paths = append(paths, restrictionsPaths...)
 			if len(paths) == 0 {
 				logger.Warn("No fields selected, won't update anything")
 				return nil
@@ -309,10 +313,11 @@ var (
 			_, err = ttnpb.NewOrganizationAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateOrganizationAPIKeyRequest{
 				OrganizationIds: orgID,
 				ApiKey: &ttnpb.APIKey{
-					Id:        id,
-					Name:      name,
-					Rights:    rights,
-					ExpiresAt: expiryDate,
+					Id:           id,
+					Name:         name,
+					Rights:       rights,
+					ExpiresAt:    expiryDate,
+					Restrictions: restrictions,
 				},
 				FieldMask: &pbtypes.FieldMask{Paths: paths},
 			})
@@ -390,11 +395,14 @@ func init() {
 	organizationAPIKeysCreate.Flags().String("name", "", "")
 	organizationAPIKeysCreate.Flags().AddFlagSet(organizationRightsFlags)
 	organizationAPIKeysCreate.Flags().AddFlagSet(apiKeyExpiryFlag)
+	organizationAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionsFlags)
 	organizationAPIKeys.AddCommand(organizationAPIKeysCreate)
 	organizationAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	organizationAPIKeysUpdate.Flags().String("name", "", "")
 	organizationAPIKeysUpdate.Flags().AddFlagSet(organizationRightsFlags)
 	organizationAPIKeysUpdate.Flags().AddFlagSet(apiKeyExpiryFlag)
+	organizationAPIKeysUpdate.Flags().AddFlagSet(apiKeyRestrictionsFlags)
+	organizationAPIKeysUpdate.Flags().Bool("clear-restrictions", false, "remove the restrictions of the API key")
 	organizationAPIKeys.AddCommand(organizationAPIKeysUpdate)
 	organizationAPIKeysDelete.Flags().String("api-key-id", "", "")
 	organizationAPIKeys.AddCommand(organizationAPIKeysDelete)
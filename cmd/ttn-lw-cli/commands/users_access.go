@@ -127,16 +127,18 @@ var (
 			if err != nil {
 				return err
 			}
+			restrictions, _ := getAPIKeyRestrictions(cmd.Flags())
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
 				return err
 			}
 			res, err := ttnpb.NewUserAccessClient(is).CreateAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
-				UserIds:   usrID,
-				Name:      name,
-				Rights:    rights,
-				ExpiresAt: expiryDate,
+				UserIds:      usrID,
+				Name:         name,
+				Rights:       rights,
+				ExpiresAt:    expiryDate,
+				Restrictions: restrictions,
 			})
 			if err != nil {
 				return err
@@ -169,6 +171,8 @@ var (
 			if err != nil {
 				return err
 			}
+			restrictions, restrictionsPaths := getAPIKeyRestrictions(cmd.Flags())
+			paths = append(paths, restrictionsPaths...)
 			if len(paths) == 0 {
 				logger.Warn("No fields selected, won't update anything")
 				return nil
@@ -181,10 +185,11 @@ var (
 			_, err = ttnpb.NewUserAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateUserAPIKeyRequest{
 				UserIds: usrID,
 				ApiKey: &ttnpb.APIKey{
-					Id:        id,
-					Name:      name,
-					Rights:    rights,
-					ExpiresAt: expiryDate,
+					Id:           id,
+					Name:         name,
+					Rights:       rights,
+					ExpiresAt:    expiryDate,
+					Restrictions: restrictions,
 				},
 				FieldMask: &pbtypes.FieldMask{Paths: paths},
 			})
@@ -272,11 +277,14 @@ func init() {
 	userAPIKeysCreate.Flags().String("name", "", "")
 	userAPIKeysCreate.Flags().AddFlagSet(userRightsFlags)
 	userAPIKeysCreate.Flags().AddFlagSet(apiKeyExpiryFlag)
+	userAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionsFlags)
 	userAPIKeys.AddCommand(userAPIKeysCreate)
 	userAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	userAPIKeysUpdate.Flags().String("name", "", "")
 	userAPIKeysUpdate.Flags().AddFlagSet(userRightsFlags)
 	userAPIKeysUpdate.Flags().AddFlagSet(apiKeyExpiryFlag)
+	userAPIKeysUpdate.Flags().AddFlagSet(apiKeyRestrictionsFlags)
+	userAPIKeysUpdate.Flags().Bool("clear-restrictions", false, "remove the restrictions of the API key")
 	userAPIKeys.AddCommand(userAPIKeysUpdate)
 	userAPIKeysDelete.Flags().String("api-key-id", "", "")
 	userAPIKeys.AddCommand(userAPIKeysDelete)
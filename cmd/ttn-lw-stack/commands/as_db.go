@@ -227,6 +227,39 @@ var (
 			return nil
 		},
 	}
+	asDBRewrapCommand = &cobra.Command{
+		Use:   "rewrap",
+		Short: "Re-wrap Application Server keys at rest with another KEK",
+		Long: `Re-wrap Application Server keys at rest with another KEK.
+The application session keys of devices that are wrapped with the KEK to rotate are re-wrapped
+with the configured device KEK label.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if config.Redis.IsZero() {
+				panic("Only Redis is supported by this command")
+			}
+			kekRewrap, err := getKEKRewrap(cmd, config.AS.DeviceKEKLabel)
+			if err != nil {
+				return err
+			}
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return err
+			}
+			logger.Info("Initializing device registry")
+			rewrapper, err := NewASRegistryRewrapper(ctx, &config.Redis)
+			if err != nil {
+				return err
+			}
+			rewrapper.KEKRewrap = kekRewrap
+			rewrapper.DryRun = dryRun
+			logger.Info("Re-wrapping keys in device registry")
+			stats, err := rewrapper.RewrapData(ctx)
+			if err != nil {
+				return err
+			}
+			return logRewrapStats("Application Server device registry", kekRewrap, stats, dryRun)
+		},
+	}
 )
 
 func init() {
@@ -235,4 +268,6 @@ func init() {
 	asDBCleanupCommand.Flags().Bool("dry-run", false, "Dry run")
 	asDBCleanupCommand.Flags().Duration("pagination-delay", 100, "Delay between batch requests")
 	asDBCommand.AddCommand(asDBCleanupCommand)
+	asDBRewrapCommand.Flags().AddFlagSet(rewrapFlags())
+	asDBCommand.AddCommand(asDBRewrapCommand)
 }
@@ -91,6 +91,20 @@ func NewASDeviceRegistryCleaner(ctx context.Context, config *redis.Config) (*as.
 	return cleaner, nil
 }
 
+// NewASRegistryRewrapper returns a new instance of Application Server RegistryRewrapper.
+func NewASRegistryRewrapper(ctx context.Context, config *redis.Config) (*as.RegistryRewrapper, error) {
+	deviceRegistry := &asredis.DeviceRegistry{
+		Redis:   redis.New(config.WithNamespace("as", "devices")),
+		LockTTL: defaultLockTTL,
+	}
+	if err := deviceRegistry.Init(ctx); err != nil {
+		return nil, shared.ErrInitializeApplicationServer.WithCause(err)
+	}
+	return &as.RegistryRewrapper{
+		DevRegistry: deviceRegistry,
+	}, nil
+}
+
 // NewWebhookCleaner returns a new instance of webhook RegistryCleaner with a local set
 // of applications.
 func NewWebhookCleaner(ctx context.Context, config *redis.Config) (*web.RegistryCleaner, error) {
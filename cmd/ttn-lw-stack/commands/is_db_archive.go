@@ -0,0 +1,144 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bufio"
+	"os"
+	"sort"
+
+	"github.com/jinzhu/gorm"
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+)
+
+func logArchiveCounts(verb string, counts store.ArchiveCounts) {
+	types := make([]string, 0, len(counts))
+	for typ := range counts {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	for _, typ := range types {
+		logger.WithField("type", typ).Infof("%s %d records", verb, counts[typ])
+	}
+}
+
+var (
+	isDBExportCommand = &cobra.Command{
+		Use:   "export",
+		Short: "Export the Identity Server database to an archive",
+		Long: `Export the Identity Server database to an archive.
+
+The archive contains users, organizations, applications, gateways, OAuth
+clients, end devices, memberships, API keys and contact info, as well as the
+multi-factor authentication, federated identities and notification email
+preferences of users. Passwords,
+API keys, client secrets and recovery codes are exported hashed. Encrypted
+TOTP secrets are exported encrypted, so the import requires the same keys.
+Deleted entities are not exported. The archive can be imported with the
+import command into a database of any supported dialect.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output, _ := cmd.Flags().GetString("output")
+
+			logger.Info("Connecting to Identity Server database...")
+			db, err := store.Open(ctx, config.IS.DatabaseURI)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			w := os.Stdout
+			if output != "" {
+				f, err := os.OpenFile(output, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+			bw := bufio.NewWriter(w)
+
+			logger.Info("Exporting database...")
+			var counts store.ArchiveCounts
+			err = store.Transact(ctx, db, func(db *gorm.DB) (err error) {
+				counts, err = store.Export(ctx, db, bw)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			if err := bw.Flush(); err != nil {
+				return err
+			}
+			if w != os.Stdout {
+				if err := w.Sync(); err != nil {
+					return err
+				}
+			}
+			logArchiveCounts("Exported", counts)
+			logger.Info("Successfully exported")
+			return nil
+		},
+	}
+	isDBImportCommand = &cobra.Command{
+		Use:   "import",
+		Short: "Import an archive into the Identity Server database",
+		Long: `Import an archive into the Identity Server database.
+
+The database must be initialized and migrated. The import is performed in
+a single transaction, and fails without changes if any of the entities in
+the archive already exist.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			input, _ := cmd.Flags().GetString("input")
+
+			logger.Info("Connecting to Identity Server database...")
+			db, err := store.Open(ctx, config.IS.DatabaseURI)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			r := os.Stdin
+			if input != "" {
+				f, err := os.Open(input)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				r = f
+			}
+
+			logger.Info("Importing archive...")
+			var counts store.ArchiveCounts
+			err = store.Transact(ctx, db, func(db *gorm.DB) (err error) {
+				counts, err = store.Import(ctx, db, bufio.NewReader(r))
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			logArchiveCounts("Imported", counts)
+			logger.Info("Successfully imported")
+			return nil
+		},
+	}
+)
+
+func init() {
+	isDBExportCommand.Flags().StringP("output", "o", "", "Archive file to write (default stdout)")
+	isDBCommand.AddCommand(isDBExportCommand)
+	isDBImportCommand.Flags().StringP("input", "i", "", "Archive file to read (default stdin)")
+	isDBCommand.AddCommand(isDBImportCommand)
+}
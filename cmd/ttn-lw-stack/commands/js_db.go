@@ -56,6 +56,36 @@ func NewJSRegistryCleaner(ctx context.Context, config *redis.Config) (*js.Regist
 	return cleaner, nil
 }
 
+// NewJSRegistryRewrapper returns a new instance of Join Server RegistryRewrapper.
+func NewJSRegistryRewrapper(ctx context.Context, config *redis.Config) (*js.RegistryRewrapper, error) {
+	deviceRegistry := &jsredis.DeviceRegistry{
+		Redis:   redis.New(config.WithNamespace("js", "devices")),
+		LockTTL: defaultLockTTL,
+	}
+	if err := deviceRegistry.Init(ctx); err != nil {
+		return nil, shared.ErrInitializeJoinServer.WithCause(err)
+	}
+	keyRegistry := &jsredis.KeyRegistry{
+		Redis:   redis.New(config.WithNamespace("js", "keys")),
+		LockTTL: defaultLockTTL,
+	}
+	if err := keyRegistry.Init(ctx); err != nil {
+		return nil, shared.ErrInitializeJoinServer.WithCause(err)
+	}
+	applicationActivationSettingRegistry := &jsredis.ApplicationActivationSettingRegistry{
+		Redis:   redis.New(config.WithNamespace("js", "application-activation-settings")),
+		LockTTL: defaultLockTTL,
+	}
+	if err := applicationActivationSettingRegistry.Init(ctx); err != nil {
+		return nil, shared.ErrInitializeJoinServer.WithCause(err)
+	}
+	return &js.RegistryRewrapper{
+		DevRegistry:   deviceRegistry,
+		KeyRegistry:   keyRegistry,
+		AppAsRegistry: applicationActivationSettingRegistry,
+	}, nil
+}
+
 var (
 	jsDBCommand = &cobra.Command{
 		Use:   "js-db",
@@ -128,6 +158,39 @@ var (
 			return nil
 		},
 	}
+	jsDBRewrapCommand = &cobra.Command{
+		Use:   "rewrap",
+		Short: "Re-wrap Join Server keys at rest with another KEK",
+		Long: `Re-wrap Join Server keys at rest with another KEK.
+The root keys of devices and the KEKs of application activation settings that are
+wrapped with the KEK to rotate are re-wrapped with the configured device KEK label.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if config.Redis.IsZero() {
+				panic("Only Redis is supported by this command")
+			}
+			kekRewrap, err := getKEKRewrap(cmd, config.JS.DeviceKEKLabel)
+			if err != nil {
+				return err
+			}
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return err
+			}
+			logger.Info("Initializing device and application activation settings registries")
+			rewrapper, err := NewJSRegistryRewrapper(ctx, &config.Redis)
+			if err != nil {
+				return err
+			}
+			rewrapper.KEKRewrap = kekRewrap
+			rewrapper.DryRun = dryRun
+			logger.Info("Re-wrapping keys in Join Server registries")
+			stats, err := rewrapper.RewrapData(ctx)
+			if err != nil {
+				return err
+			}
+			return logRewrapStats("Join Server registries", kekRewrap, stats, dryRun)
+		},
+	}
 )
 
 func init() {
@@ -135,4 +198,6 @@ func init() {
 	jsDBCleanupCommand.Flags().Bool("dry-run", false, "Dry run")
 	jsDBCleanupCommand.Flags().Duration("pagination-delay", 100, "Delay between batch requests")
 	jsDBCommand.AddCommand(jsDBCleanupCommand)
+	jsDBRewrapCommand.Flags().AddFlagSet(rewrapFlags())
+	jsDBCommand.AddCommand(jsDBRewrapCommand)
 }
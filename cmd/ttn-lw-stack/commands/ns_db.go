@@ -519,6 +519,39 @@ var (
 			return nil
 		},
 	}
+	nsDBRewrapCommand = &cobra.Command{
+		Use:   "rewrap",
+		Short: "Re-wrap Network Server keys at rest with another KEK",
+		Long: `Re-wrap Network Server keys at rest with another KEK.
+The network session keys of devices that are wrapped with the KEK to rotate are re-wrapped
+with the configured device KEK label.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if config.Redis.IsZero() {
+				panic("Only Redis is supported by this command")
+			}
+			kekRewrap, err := getKEKRewrap(cmd, config.NS.DeviceKEKLabel)
+			if err != nil {
+				return err
+			}
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return err
+			}
+			logger.Info("Initializing device registry")
+			rewrapper, err := NewNSRegistryRewrapper(ctx, &config.Redis)
+			if err != nil {
+				return err
+			}
+			rewrapper.KEKRewrap = kekRewrap
+			rewrapper.DryRun = dryRun
+			logger.Info("Re-wrapping keys in device registry")
+			stats, err := rewrapper.RewrapData(ctx)
+			if err != nil {
+				return err
+			}
+			return logRewrapStats("Network Server device registry", kekRewrap, stats, dryRun)
+		},
+	}
 )
 
 func init() {
@@ -528,5 +561,7 @@ func init() {
 	nsDBCleanupCommand.Flags().Bool("dry-run", false, "Dry run")
 	nsDBCleanupCommand.Flags().Duration("pagination-delay", 100, "Delay between batch requests")
 	nsDBCommand.AddCommand(nsDBCleanupCommand)
+	nsDBRewrapCommand.Flags().AddFlagSet(rewrapFlags())
+	nsDBCommand.AddCommand(nsDBRewrapCommand)
 	nsDBMigrateCommand.Flags().Bool("force", false, "Force perform database migrations")
 }
@@ -42,3 +42,17 @@ func NewNSDeviceRegistryCleaner(ctx context.Context, config *redis.Config) (*ns.
 	}
 	return cleaner, nil
 }
+
+// NewNSRegistryRewrapper returns a new instance of Network Server RegistryRewrapper.
+func NewNSRegistryRewrapper(ctx context.Context, config *redis.Config) (*ns.RegistryRewrapper, error) {
+	deviceRegistry := &nsredis.DeviceRegistry{
+		Redis:   redis.New(config.WithNamespace("ns", "devices")),
+		LockTTL: defaultLockTTL,
+	}
+	if err := deviceRegistry.Init(ctx); err != nil {
+		return nil, shared.ErrInitializeNetworkServer.WithCause(err)
+	}
+	return &ns.RegistryRewrapper{
+		DevRegistry: deviceRegistry,
+	}, nil
+}
@@ -23,13 +23,17 @@ import (
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
+	asdeferredredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/deferred/redis"
 	asdistribredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution/redis"
+	asdownlinkstatusredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/downlinkstatus/redis"
 	asioapredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/redis"
 	asiopsredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/redis"
 	asiowebredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/redis"
 	asredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/console"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver"
+	dcsredis "go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicetemplateconverter"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -75,7 +79,7 @@ func NewApplicationServerDeviceRegistryRedis(conf Config) *redis.Client {
 var errUnknownComponent = errors.DefineInvalidArgument("unknown_component", "unknown component `{component}`")
 
 var startCommand = &cobra.Command{
-	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|pba|all]... [flags]",
+	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|pba|dcs|all]... [flags]",
 	Short: "Start The Things Stack",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var start struct {
@@ -90,6 +94,7 @@ var startCommand = &cobra.Command{
 			QRCodeGenerator            bool
 			PacketBrokerAgent          bool
 			DeviceRepository           bool
+			DeviceClaimingServer       bool
 		}
 		startDefault := len(args) == 0
 		for _, arg := range args {
@@ -124,6 +129,8 @@ var startCommand = &cobra.Command{
 				start.PacketBrokerAgent = true
 			case "dr":
 				start.DeviceRepository = true
+			case "dcs":
+				start.DeviceClaimingServer = true
 			case "all":
 				start.IdentityServer = true
 				start.GatewayServer = true
@@ -136,6 +143,7 @@ var startCommand = &cobra.Command{
 				start.QRCodeGenerator = true
 				start.PacketBrokerAgent = true
 				start.DeviceRepository = true
+				start.DeviceClaimingServer = true
 			default:
 				return errUnknownComponent.WithAttributes("component", arg)
 			}
@@ -153,6 +161,7 @@ var startCommand = &cobra.Command{
 			start.QRCodeGenerator = true
 			start.PacketBrokerAgent = true
 			start.DeviceRepository = true
+			start.DeviceClaimingServer = true
 		}
 
 		logger.Info("Setting up core component")
@@ -315,6 +324,13 @@ var startCommand = &cobra.Command{
 				Redis: redis.New(config.Redis.WithNamespace("as", "applicationups")),
 				Limit: config.AS.UplinkStorage.Limit,
 			}
+			config.AS.DeferredDownlinks.Registry = &asdeferredredis.DeferredDownlinkRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("as", "deferreddownlinks")),
+			}
+			config.AS.DownlinkStatus.Registry = &asdownlinkstatusredis.DownlinkStatusRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("as", "downlinkstatus")),
+				TTL:   config.AS.DownlinkStatus.TTL,
+			}
 			config.AS.Distribution.Global.PubSub = &asdistribredis.PubSub{
 				Redis: redis.New(config.Cache.Redis.WithNamespace("as", "traffic")),
 			}
@@ -382,6 +398,9 @@ var startCommand = &cobra.Command{
 				return shared.ErrInitializeJoinServer.WithCause(err)
 			}
 			config.JS.ApplicationActivationSettings = applicationActivationSettingRegistry
+			config.JS.JoinEUIRanges = &jsredis.JoinEUIRangeRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("js", "join-eui-ranges")),
+			}
 			js, err := joinserver.New(c, &config.JS)
 			if err != nil {
 				return shared.ErrInitializeJoinServer.WithCause(err)
@@ -457,6 +476,21 @@ var startCommand = &cobra.Command{
 			_ = dr
 		}
 
+		if start.DeviceClaimingServer {
+			logger.Info("Setting up Device Claiming Server")
+			config.DCS.AuthorizedApplications = &dcsredis.AuthorizedApplicationRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("dcs", "applications")),
+			}
+			config.DCS.AuthorizedGateways = &dcsredis.AuthorizedGatewayRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("dcs", "gateways")),
+			}
+			dcs, err := deviceclaimingserver.New(c, &config.DCS)
+			if err != nil {
+				return shared.ErrInitializeDeviceClaimingServer.WithCause(err)
+			}
+			_ = dcs
+		}
+
 		if rootRedirect != nil {
 			c.RegisterWeb(rootRedirect)
 		}
@@ -21,8 +21,12 @@ import (
 	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
@@ -147,3 +151,73 @@ func checkLatestSchemaVersion(cl *ttnredis.Client, latestVersion int) (bool, err
 	logger.WithField("version", schemaVersion).Info("Existing database schema version")
 	return int(schemaVersion) < latestVersion, nil
 }
+
+// rewrapFlags returns the flags of the commands that re-wrap keys at rest.
+func rewrapFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("from-kek-label", "", "Label of KEK to rotate (empty for keys stored in the clear)")
+	flagSet.String("to-kek-label", "", "Label of KEK to re-wrap keys with (default is the configured device KEK label)")
+	flagSet.Bool("dry-run", false, "Dry run")
+	return flagSet
+}
+
+// getKEKRewrap returns the KEK rewrap of the command flags. Keys are re-wrapped with the given device KEK label,
+// unless another KEK label is specified.
+func getKEKRewrap(cmd *cobra.Command, deviceKEKLabel string) (cryptoutil.KEKRewrap, error) {
+	if !cmd.Flags().Changed("from-kek-label") {
+		return cryptoutil.KEKRewrap{}, errMissingFlag.WithAttributes("flag", "from-kek-label")
+	}
+	from, _ := cmd.Flags().GetString("from-kek-label")
+	to := deviceKEKLabel
+	if cmd.Flags().Changed("to-kek-label") {
+		to, _ = cmd.Flags().GetString("to-kek-label")
+	}
+	keyVault, err := config.KeyVault.KeyVault()
+	if err != nil {
+		return cryptoutil.KEKRewrap{}, err
+	}
+	rw := cryptoutil.KEKRewrap{
+		KeyVault: keyVault,
+		From:     from,
+		To:       to,
+	}
+	if err := rw.Validate(); err != nil {
+		return cryptoutil.KEKRewrap{}, err
+	}
+	return rw, nil
+}
+
+var errRewrapIncomplete = errors.DefineAborted(
+	"rewrap_incomplete",
+	"{remaining} keys not re-wrapped and {failed} entities failed, KEK `{kek_label}` is still referenced",
+)
+
+// logRewrapStats logs the result of re-wrapping the keys of the given registries.
+// It returns an error if keys wrapped with the old KEK remain after a non-dry run.
+func logRewrapStats(registries string, rw cryptoutil.KEKRewrap, stats cryptoutil.RewrapStats, dryRun bool) error {
+	logger := logger.WithFields(log.Fields(
+		"from_kek_label", rw.From,
+		"to_kek_label", rw.To,
+		"entities", stats.Entities,
+		"keys", stats.Keys,
+	))
+	if dryRun {
+		logger.Info("Found keys wrapped with KEK")
+		logger.Warn("Dry run finished. No keys re-wrapped.")
+		return nil
+	}
+	logger = logger.WithFields(log.Fields(
+		"rewrapped", stats.Rewrapped,
+		"failed", stats.Failed,
+	))
+	if stats.Remaining() > 0 || stats.Failed > 0 {
+		logger.Warnf("Not all keys re-wrapped, KEK is still referenced by the %s", registries)
+		return errRewrapIncomplete.WithAttributes(
+			"remaining", stats.Remaining(),
+			"failed", stats.Failed,
+			"kek_label", rw.From,
+		)
+	}
+	logger.Infof("Re-wrapped keys, KEK is no longer referenced by the %s", registries)
+	return nil
+}
@@ -0,0 +1,419 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	echo "github.com/labstack/echo/v4"
+	"go.thethings.network/lorawan-stack/v3/pkg/account/oidc"
+	account_store "go.thethings.network/lorawan-stack/v3/pkg/account/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/oauth"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/web/cookie"
+)
+
+const (
+	federatedLoginCookieName = "_federated_login"
+	federatedLoginTTL        = 10 * time.Minute
+
+	maxUserIDLength = 36
+)
+
+var (
+	errProviderNotFound        = errors.DefineNotFound("provider_not_found", "provider `{provider_id}` not found")
+	errInvalidProviderConfig   = errors.DefineInvalidArgument("invalid_provider_config", "invalid configuration of provider `{provider_id}`")
+	errDuplicateProvider       = errors.DefineAlreadyExists("duplicate_provider", "duplicate provider `{provider_id}`")
+	errFederatedLoginExpired   = errors.DefineUnauthenticated("federated_login_expired", "federated login expired")
+	errFederatedLoginState     = errors.DefinePermissionDenied("federated_login_state", "federated login state mismatch")
+	errFederatedLoginDenied    = errors.DefinePermissionDenied("federated_login_denied", "federated login denied by provider: `{error}`")
+	errFederatedUserNotFound   = errors.DefineNotFound("federated_user_not_found", "no user found for the identity at provider `{provider_id}`")
+	errFederatedMissingEmail   = errors.DefineInvalidArgument("federated_missing_email", "missing email address in identity of provider `{provider_id}`")
+	errFederatedInvalidUserID  = errors.DefineInvalidArgument("federated_invalid_user_id", "no valid user ID in identity of provider `{provider_id}`")
+	errFederatedEmailNotLinked = errors.DefinePermissionDenied("federated_email_not_linked", "email address of the identity is not validated for the existing user")
+)
+
+type federatedOrganization struct {
+	group  string
+	ids    *ttnpb.OrganizationIdentifiers
+	rights *ttnpb.Rights
+}
+
+type federatedProvider struct {
+	config        oauth.ProviderConfig
+	claims        oauth.ProviderClaimsConfig
+	organizations []federatedOrganization
+	oidc          *oidc.Provider
+}
+
+// newFederatedProviders validates the configuration of the upstream OpenID Connect providers and returns them by ID.
+func newFederatedProviders(config oauth.Config, client *http.Client) (map[string]*federatedProvider, error) {
+	providers := make(map[string]*federatedProvider, len(config.Providers))
+	for _, providerConfig := range config.Providers {
+		if _, ok := providers[providerConfig.ID]; ok {
+			return nil, errDuplicateProvider.WithAttributes("provider_id", providerConfig.ID)
+		}
+		if err := (&ttnpb.UserIdentifiers{UserId: providerConfig.ID}).ValidateFields("user_id"); err != nil {
+			return nil, errInvalidProviderConfig.WithCause(err).WithAttributes("provider_id", providerConfig.ID)
+		}
+		if providerConfig.Issuer == "" || providerConfig.ClientID == "" {
+			return nil, errInvalidProviderConfig.WithAttributes("provider_id", providerConfig.ID)
+		}
+		p := &federatedProvider{
+			config: providerConfig,
+			claims: providerConfig.Claims.WithDefaults(),
+			oidc: oidc.NewProvider(oidc.Config{
+				Issuer:       providerConfig.Issuer,
+				ClientID:     providerConfig.ClientID,
+				ClientSecret: providerConfig.ClientSecret,
+				Scopes:       providerConfig.Scopes,
+				RedirectURL: fmt.Sprintf("%s/api/auth/providers/%s/callback",
+					strings.TrimSuffix(config.UI.CanonicalURL, "/"), providerConfig.ID,
+				),
+			}, client),
+		}
+		for _, orgConfig := range providerConfig.Organizations {
+			org := federatedOrganization{
+				group:  orgConfig.Group,
+				ids:    &ttnpb.OrganizationIdentifiers{OrganizationId: orgConfig.OrganizationID},
+				rights: ttnpb.RightsFrom(ttnpb.RIGHT_ORGANIZATION_INFO),
+			}
+			if err := org.ids.ValidateFields("organization_id"); err != nil {
+				return nil, errInvalidProviderConfig.WithCause(err).WithAttributes("provider_id", providerConfig.ID)
+			}
+			if len(orgConfig.Rights) > 0 {
+				org.rights = &ttnpb.Rights{}
+				for _, name := range orgConfig.Rights {
+					var right ttnpb.Right
+					if err := right.UnmarshalText([]byte(name)); err != nil {
+						return nil, errInvalidProviderConfig.WithCause(err).WithAttributes("provider_id", providerConfig.ID)
+					}
+					org.rights.Rights = append(org.rights.Rights, right)
+				}
+			}
+			p.organizations = append(p.organizations, org)
+		}
+		providers[providerConfig.ID] = p
+	}
+	return providers, nil
+}
+
+type federatedLoginCookieShape struct {
+	ProviderID string    `json:"provider_id"`
+	State      string    `json:"state"`
+	Nonce      string    `json:"nonce"`
+	Next       string    `json:"next"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+func (s *server) federatedLoginCookie() *cookie.Cookie {
+	return &cookie.Cookie{
+		Name:     federatedLoginCookieName,
+		Path:     "/",
+		MaxAge:   federatedLoginTTL,
+		HTTPOnly: true,
+	}
+}
+
+func (s *server) getFederatedProvider(c echo.Context) (*federatedProvider, error) {
+	id := c.Param("provider_id")
+	p, ok := s.providers[id]
+	if !ok {
+		return nil, errProviderNotFound.WithAttributes("provider_id", id)
+	}
+	return p, nil
+}
+
+// Providers lists the upstream OpenID Connect providers that users can log in with.
+func (s *server) Providers(c echo.Context) error {
+	type provider struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	res := struct {
+		Providers []provider `json:"providers"`
+	}{
+		Providers: make([]provider, 0, len(s.config.Providers)),
+	}
+	for _, p := range s.config.Providers {
+		res.Providers = append(res.Providers, provider{ID: p.ID, Name: p.Name})
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
+// FederatedLogin redirects the user to the upstream OpenID Connect provider.
+func (s *server) FederatedLogin(c echo.Context) error {
+	ctx := c.Request().Context()
+	p, err := s.getFederatedProvider(c)
+	if err != nil {
+		return err
+	}
+	state, err := auth.GenerateKey(ctx)
+	if err != nil {
+		return err
+	}
+	nonce, err := auth.GenerateKey(ctx)
+	if err != nil {
+		return err
+	}
+	authURL, err := p.oidc.AuthCodeURL(ctx, state, nonce)
+	if err != nil {
+		return err
+	}
+	if err := s.federatedLoginCookie().Set(c.Response(), c.Request(), federatedLoginCookieShape{
+		ProviderID: p.config.ID,
+		State:      state,
+		Nonce:      nonce,
+		Next:       c.QueryParam(nextKey),
+		ExpiresAt:  time.Now().Add(federatedLoginTTL),
+	}); err != nil {
+		return err
+	}
+	return c.Redirect(http.StatusFound, authURL)
+}
+
+// FederatedCallback handles the redirect from the upstream OpenID Connect provider. It logs in the user that is linked
+// to the identity at the provider, linking or creating the user if allowed by the provider configuration.
+func (s *server) FederatedCallback(c echo.Context) error {
+	ctx := c.Request().Context()
+	p, err := s.getFederatedProvider(c)
+	if err != nil {
+		return err
+	}
+	var value federatedLoginCookieShape
+	ok, err := s.federatedLoginCookie().Get(c.Response(), c.Request(), &value)
+	if err != nil {
+		return err
+	}
+	s.federatedLoginCookie().Remove(c.Response(), c.Request())
+	if !ok || time.Now().After(value.ExpiresAt) {
+		return errFederatedLoginExpired.New()
+	}
+	if value.ProviderID != p.config.ID || value.State == "" || c.QueryParam("state") != value.State {
+		return errFederatedLoginState.New()
+	}
+	if errCode := c.QueryParam("error"); errCode != "" {
+		return errFederatedLoginDenied.WithAttributes("error", errCode)
+	}
+	claims, err := p.oidc.Exchange(ctx, c.QueryParam("code"), value.Nonce)
+	if err != nil {
+		return err
+	}
+
+	var userIDs *ttnpb.UserIdentifiers
+	err = s.store.Transact(ctx, func(ctx context.Context, st account_store.Interface) (err error) {
+		userIDs, err = s.getOrCreateFederatedUser(ctx, st, p, claims)
+		if err != nil {
+			return err
+		}
+		return syncFederatedMemberships(ctx, st, p, claims, userIDs)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.startLogin(c, userIDs); err != nil {
+		var mfa string
+		switch {
+		case errors.Resemble(err, errMFARequired):
+			mfa = "true"
+		case errors.Resemble(err, errMFAEnrolmentRequired):
+			mfa = "enrol"
+		default:
+			return err
+		}
+		query := url.Values{"mfa": []string{mfa}}
+		if value.Next != "" {
+			query.Set(nextKey, value.Next)
+		}
+		return c.Redirect(http.StatusFound, fmt.Sprintf("%s/login?%s", strings.TrimSuffix(s.config.Mount, "/"), query.Encode()))
+	}
+	return c.Redirect(http.StatusFound, s.nextURL(value.Next))
+}
+
+// nextURL returns the path and query of the next URL if it is below the mount path, so that users can not be
+// redirected to other hosts or to other applications on this host. Paths starting with `//` or `/\` are rejected,
+// since browsers treat them as references to other hosts.
+func (s *server) nextURL(next string) string {
+	if next == "" {
+		return s.config.Mount
+	}
+	u, err := url.Parse(next)
+	if err != nil || u.Scheme != "" || u.Host != "" || u.User != nil || u.Opaque != "" || u.Path == "" {
+		return s.config.Mount
+	}
+	if strings.HasPrefix(next, "//") || strings.ContainsRune(u.Path, '\\') {
+		return s.config.Mount
+	}
+	mount := strings.TrimSuffix(s.config.Mount, "/")
+	if p := path.Clean(u.Path); p != mount && !strings.HasPrefix(p, mount+"/") {
+		return s.config.Mount
+	}
+	return u.RequestURI()
+}
+
+func (s *server) getOrCreateFederatedUser(ctx context.Context, st account_store.Interface, p *federatedProvider, claims oidc.Claims) (*ttnpb.UserIdentifiers, error) {
+	logger := log.FromContext(ctx).WithField("provider_id", p.config.ID)
+	userIDs, err := st.GetFederatedUser(ctx, p.config.ID, claims.Subject())
+	if err == nil {
+		return userIDs, nil
+	}
+	if !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	email := claims.String(p.claims.Email)
+	emailVerified := email != "" && claims.Bool(p.claims.EmailVerified)
+
+	if p.config.LinkVerifiedEmail && emailVerified {
+		usr, err := st.GetUserByPrimaryEmailAddress(ctx, email, &types.FieldMask{Paths: []string{
+			"primary_email_address_validated_at",
+		}})
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		if usr != nil {
+			if usr.PrimaryEmailAddressValidatedAt == nil {
+				return nil, errFederatedEmailNotLinked.New()
+			}
+			if err := st.CreateFederatedIdentity(ctx, usr.GetIds(), p.config.ID, claims.Subject()); err != nil {
+				return nil, err
+			}
+			logger.WithField("user_uid", unique.ID(ctx, usr.GetIds())).Info("Linked user to federated identity")
+			events.Publish(evtFederationLinkUser.NewWithIdentifiersAndData(ctx, usr.GetIds(), p.config.ID))
+			return usr.GetIds(), nil
+		}
+	}
+
+	if !p.config.AllowRegistration {
+		return nil, errFederatedUserNotFound.WithAttributes("provider_id", p.config.ID)
+	}
+	if email == "" {
+		return nil, errFederatedMissingEmail.WithAttributes("provider_id", p.config.ID)
+	}
+	userIDs = &ttnpb.UserIdentifiers{UserId: federatedUserID(claims.String(p.claims.UserID))}
+	if err := userIDs.ValidateFields("user_id"); err != nil {
+		return nil, errFederatedInvalidUserID.WithCause(err).WithAttributes("provider_id", p.config.ID)
+	}
+	password, err := auth.GenerateKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := auth.Hash(ctx, password)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	usr := &ttnpb.User{
+		Ids:                 userIDs,
+		Name:                claims.String(p.claims.Name),
+		PrimaryEmailAddress: email,
+		Password:            hashedPassword,
+		PasswordUpdatedAt:   &now,
+		State:               ttnpb.STATE_APPROVED,
+		StateDescription:    fmt.Sprintf("created through federated login with %s", p.config.ID),
+	}
+	if s.configFromContext(ctx).AdminApprovalRequired {
+		usr.State = ttnpb.STATE_REQUESTED
+		usr.StateDescription = fmt.Sprintf("created through federated login with %s, admin approval required", p.config.ID)
+	}
+	if emailVerified {
+		usr.PrimaryEmailAddressValidatedAt = &now
+	}
+	if _, err := st.CreateUser(ctx, usr); err != nil {
+		return nil, err
+	}
+	if err := st.CreateFederatedIdentity(ctx, userIDs, p.config.ID, claims.Subject()); err != nil {
+		return nil, err
+	}
+	logger.WithField("user_uid", unique.ID(ctx, userIDs)).Info("Created user for federated identity")
+	events.Publish(evtFederationCreateUser.NewWithIdentifiersAndData(ctx, userIDs, p.config.ID))
+	return userIDs, nil
+}
+
+var (
+	invalidUserIDChars = regexp.MustCompile("[^a-z0-9-]+")
+	repeatedDashes     = regexp.MustCompile("-{2,}")
+)
+
+// federatedUserID derives a user ID from the user ID claim of the provider.
+func federatedUserID(claim string) string {
+	if i := strings.Index(claim, "@"); i > 0 {
+		claim = claim[:i]
+	}
+	id := invalidUserIDChars.ReplaceAllString(strings.ToLower(claim), "-")
+	id = repeatedDashes.ReplaceAllString(id, "-")
+	if len(id) > maxUserIDLength {
+		id = id[:maxUserIDLength]
+	}
+	return strings.Trim(id, "-")
+}
+
+// syncFederatedMemberships synchronizes the memberships of the organizations that are configured for the groups of the
+// user at the provider. The configured rights are granted if the user is in the group, and revoked if the user is not
+// in the group (anymore). Rights that are granted otherwise are kept, and the membership is removed if no rights remain.
+func syncFederatedMemberships(ctx context.Context, st account_store.Interface, p *federatedProvider, claims oidc.Claims, userIDs *ttnpb.UserIdentifiers) error {
+	if len(p.organizations) == 0 {
+		return nil
+	}
+	groups := make(map[string]bool)
+	for _, group := range claims.Strings(p.claims.Groups) {
+		groups[group] = true
+	}
+	memberIDs := userIDs.GetOrganizationOrUserIdentifiers()
+	for _, org := range p.organizations {
+		entityIDs := org.ids.GetEntityIdentifiers()
+		rights, err := st.GetMember(ctx, memberIDs, entityIDs)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		var newRights *ttnpb.Rights
+		if groups[org.group] {
+			newRights = rights.Union(org.rights)
+			if len(newRights.Sub(rights).GetRights()) == 0 {
+				continue
+			}
+		} else {
+			if len(rights.GetRights()) == 0 {
+				continue
+			}
+			newRights = rights.Sub(org.rights)
+			if len(rights.Sub(newRights).GetRights()) == 0 {
+				continue
+			}
+			log.FromContext(ctx).WithFields(log.Fields(
+				"provider_id", p.config.ID,
+				"organization_uid", unique.ID(ctx, org.ids),
+			)).Info("Revoke federated organization membership")
+		}
+		if err := st.SetMember(ctx, memberIDs, entityIDs, newRights); err != nil {
+			return err
+		}
+	}
+	return nil
+}
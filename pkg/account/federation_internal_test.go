@@ -0,0 +1,54 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/oauth"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestNextURL(t *testing.T) {
+	s := &server{
+		config: oauth.Config{
+			Mount: "/oauth",
+		},
+	}
+	for _, tc := range []struct {
+		Next     string
+		Expected string
+	}{
+		{Next: "", Expected: "/oauth"},
+		{Next: "/oauth", Expected: "/oauth"},
+		{Next: "/oauth/", Expected: "/oauth/"},
+		{Next: "/oauth/authorize?client_id=console&response_type=code", Expected: "/oauth/authorize?client_id=console&response_type=code"},
+		{Next: "https://example.com/oauth/authorize", Expected: "/oauth"},
+		{Next: "//example.com/oauth/authorize", Expected: "/oauth"},
+		{Next: "/\\example.com/oauth/authorize", Expected: "/oauth"},
+		{Next: "/oauth/\\example.com", Expected: "/oauth"},
+		{Next: "/oauthx", Expected: "/oauth"},
+		{Next: "/console", Expected: "/oauth"},
+		{Next: "/oauth/../console", Expected: "/oauth"},
+		{Next: "?foo=bar", Expected: "/oauth"},
+		{Next: "javascript:alert(1)", Expected: "/oauth"},
+	} {
+		t.Run(tc.Next, func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(s.nextURL(tc.Next), should.Equal, tc.Expected)
+		})
+	}
+}
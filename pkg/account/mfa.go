@@ -0,0 +1,630 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	echo "github.com/labstack/echo/v4"
+	account_store "go.thethings.network/lorawan-stack/v3/pkg/account/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/qrcodegenerator"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/web/cookie"
+)
+
+// MFAChange is a change of the multi-factor authentication of a user.
+type MFAChange string
+
+const (
+	// MFAEnabled indicates that the user enabled multi-factor authentication.
+	MFAEnabled MFAChange = "enabled"
+	// MFADisabled indicates that the user disabled multi-factor authentication.
+	MFADisabled MFAChange = "disabled"
+	// MFARecoveryCodesRegenerated indicates that the user regenerated the recovery codes.
+	MFARecoveryCodesRegenerated MFAChange = "recovery_codes_regenerated"
+	// MFARecoveryCodeUsed indicates that the user logged in using a recovery code.
+	MFARecoveryCodeUsed MFAChange = "recovery_code_used"
+)
+
+// MFANotifier notifies the user about a change of their multi-factor authentication.
+type MFANotifier func(ctx context.Context, userIDs *ttnpb.UserIdentifiers, change MFAChange) error
+
+const (
+	mfaLoginCookieName = "_mfa_login"
+	mfaLoginTTL        = 5 * time.Minute
+
+	defaultRecoveryCodes = 10
+	recoveryCodeSize     = 10
+	qrCodeSize           = 256
+)
+
+var (
+	errMissingMFACode         = errors.DefineInvalidArgument("missing_mfa_code", "missing one-time password or recovery code")
+	errInvalidMFACode         = errors.DefineInvalidArgument("invalid_mfa_code", "invalid one-time password or recovery code")
+	errMFALoginExpired        = errors.DefineUnauthenticated("mfa_login_expired", "multi-factor login expired")
+	errMFARequired            = errors.DefineUnauthenticated("mfa_required", "multi-factor authentication required")
+	errMFAEnrolmentRequired   = errors.DefineUnauthenticated("mfa_enrolment_required", "multi-factor authentication enrolment required")
+	errMFAAlreadyEnabled      = errors.DefineAlreadyExists("mfa_already_enabled", "multi-factor authentication already enabled")
+	errMFANotEnabled          = errors.DefineFailedPrecondition("mfa_not_enabled", "multi-factor authentication not enabled")
+	errMFAEnrolmentNotStarted = errors.DefineFailedPrecondition("mfa_enrolment_not_started", "multi-factor authentication enrolment not started")
+	errMFARequiredByPolicy    = errors.DefineFailedPrecondition("mfa_required_by_policy", "multi-factor authentication is required by policy")
+	errIncorrectPassword      = errors.DefineInvalidArgument("incorrect_password", "incorrect password")
+	errTOTPSecret             = errors.DefineCorruption("totp_secret", "invalid TOTP secret")
+)
+
+type mfaLoginCookieShape struct {
+	UserID    string    `json:"user_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// Enrol indicates that the user must enrol in multi-factor authentication before the login completes.
+	Enrol bool `json:"enrol,omitempty"`
+}
+
+func (s *server) mfaLoginCookie() *cookie.Cookie {
+	return &cookie.Cookie{
+		Name:     mfaLoginCookieName,
+		Path:     "/",
+		MaxAge:   mfaLoginTTL,
+		HTTPOnly: true,
+	}
+}
+
+// getUserMFA returns the multi-factor authentication of the user, or nil if the user never enrolled.
+func getUserMFA(ctx context.Context, st store.UserMFAStore, userIDs *ttnpb.UserIdentifiers) (*store.UserMFA, error) {
+	mfa, err := st.GetUserMFA(ctx, userIDs)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return mfa, nil
+}
+
+// mfaRequired returns whether the policy requires the user to enable multi-factor authentication.
+func (s *server) mfaRequired(ctx context.Context, userIDs *ttnpb.UserIdentifiers) (bool, error) {
+	policy := s.configFromContext(ctx).MFA
+	if !policy.Required && !policy.RequiredForAdmins {
+		return false, nil
+	}
+	user, err := s.store.GetUser(ctx, userIDs, &types.FieldMask{Paths: []string{"admin"}})
+	if err != nil {
+		return false, err
+	}
+	return policy.RequiredFor(user), nil
+}
+
+// startLogin starts the login of the authenticated user. If the user enabled multi-factor authentication, or the
+// policy requires the user to enable it, the user session is only created after the second login step, and
+// startLogin returns errMFARequired or errMFAEnrolmentRequired.
+func (s *server) startLogin(c echo.Context, userIDs *ttnpb.UserIdentifiers) error {
+	ctx := c.Request().Context()
+	mfa, err := getUserMFA(ctx, s.store, userIDs)
+	if err != nil {
+		return err
+	}
+	var pending error
+	if mfa.Enabled() {
+		pending = errMFARequired.New()
+	} else {
+		required, err := s.mfaRequired(ctx, userIDs)
+		if err != nil {
+			return err
+		}
+		if required {
+			pending = errMFAEnrolmentRequired.New()
+		}
+	}
+	if pending == nil {
+		return s.CreateUserSession(c, userIDs)
+	}
+	if err := s.mfaLoginCookie().Set(c.Response(), c.Request(), mfaLoginCookieShape{
+		UserID:    userIDs.GetUserId(),
+		ExpiresAt: time.Now().Add(mfaLoginTTL),
+		Enrol:     !mfa.Enabled(),
+	}); err != nil {
+		return err
+	}
+	return pending
+}
+
+// completeLogin completes the login of the authenticated user. If the second login step is pending, completeLogin
+// returns errMFARequired or errMFAEnrolmentRequired, so that a successful login keeps returning no content.
+func (s *server) completeLogin(c echo.Context, userIDs *ttnpb.UserIdentifiers) error {
+	if err := s.startLogin(c, userIDs); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// pendingLogin returns the pending multi-factor login of the user.
+func (s *server) pendingLogin(c echo.Context) (*mfaLoginCookieShape, error) {
+	var loginCookie mfaLoginCookieShape
+	ok, err := s.mfaLoginCookie().Get(c.Response(), c.Request(), &loginCookie)
+	if err != nil {
+		return nil, err
+	}
+	if !ok || time.Now().After(loginCookie.ExpiresAt) {
+		s.mfaLoginCookie().Remove(c.Response(), c.Request())
+		return nil, errMFALoginExpired.New()
+	}
+	return &loginCookie, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(strings.ToLower(code))
+}
+
+// generateRecoveryCodes generates new recovery codes and returns them along with their hashes.
+func (s *server) generateRecoveryCodes(ctx context.Context) (codes, hashes []string, err error) {
+	n := s.configFromContext(ctx).MFA.RecoveryCodes
+	if n <= 0 {
+		n = defaultRecoveryCodes
+	}
+	enc := base32.StdEncoding.WithPadding(base32.NoPadding)
+	codes, hashes = make([]string, n), make([]string, n)
+	for i := range codes {
+		var b [recoveryCodeSize]byte
+		if _, err := rand.Read(b[:]); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(enc.EncodeToString(b[:]))[:recoveryCodeSize]
+		codes[i] = code[:recoveryCodeSize/2] + "-" + code[recoveryCodeSize/2:]
+		if hashes[i], err = auth.Hash(ctx, code); err != nil {
+			return nil, nil, err
+		}
+	}
+	return codes, hashes, nil
+}
+
+// encryptTOTPSecret encrypts the secret of the time-based one-time passwords if an encryption key is configured.
+func (s *server) encryptTOTPSecret(ctx context.Context, mfa *store.UserMFA, secret string) error {
+	keyID := s.configFromContext(ctx).MFA.EncryptionKeyID
+	if keyID == "" {
+		log.FromContext(ctx).Warn("No encryption key defined, store TOTP secret in plaintext")
+		mfa.TOTPSecret, mfa.TOTPSecretKeyID = secret, ""
+		return nil
+	}
+	value, err := s.keyVault.Encrypt(ctx, []byte(secret), keyID)
+	if err != nil {
+		return err
+	}
+	mfa.TOTPSecret, mfa.TOTPSecretKeyID = base64.StdEncoding.EncodeToString(value), keyID
+	return nil
+}
+
+// decryptTOTPSecret returns the plaintext secret of the time-based one-time passwords.
+func (s *server) decryptTOTPSecret(ctx context.Context, mfa *store.UserMFA) (string, error) {
+	if mfa.TOTPSecretKeyID == "" {
+		return mfa.TOTPSecret, nil
+	}
+	value, err := base64.StdEncoding.DecodeString(mfa.TOTPSecret)
+	if err != nil {
+		return "", errTOTPSecret.WithCause(err)
+	}
+	secret, err := s.keyVault.Decrypt(ctx, value, mfa.TOTPSecretKeyID)
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
+
+// verifyTOTP verifies the one-time password and prevents its reuse.
+func (s *server) verifyTOTP(ctx context.Context, mfa *store.UserMFA, code string) error {
+	secret, err := s.decryptTOTPSecret(ctx, mfa)
+	if err != nil {
+		return err
+	}
+	step, ok, err := totp.Validate(secret, code, time.Now())
+	if err != nil {
+		return err
+	}
+	if !ok || step <= mfa.TOTPLastStep {
+		return errInvalidMFACode.New()
+	}
+	mfa.TOTPLastStep = step
+	return nil
+}
+
+// verifyMFA verifies the one-time password or recovery code. A used recovery code is removed.
+func (s *server) verifyMFA(ctx context.Context, mfa *store.UserMFA, code, recoveryCode string) (usedRecoveryCode bool, err error) {
+	if !mfa.Enabled() {
+		return false, errMFANotEnabled.New()
+	}
+	if code != "" {
+		return false, s.verifyTOTP(ctx, mfa, code)
+	}
+	recoveryCode = normalizeRecoveryCode(recoveryCode)
+	for i, hashed := range mfa.RecoveryCodes {
+		if ok, err := auth.Validate(hashed, recoveryCode); err == nil && ok {
+			mfa.RecoveryCodes = append(mfa.RecoveryCodes[:i:i], mfa.RecoveryCodes[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, errInvalidMFACode.New()
+}
+
+// verifyPassword re-authenticates the current user with their password before sensitive changes to their
+// multi-factor authentication.
+func verifyPassword(user *ttnpb.User, password string) error {
+	if strings.TrimSpace(password) == "" {
+		return errMissingPassword.New()
+	}
+	if ok, err := auth.Validate(user.GetPassword(), password); err != nil || !ok {
+		return errIncorrectPassword.New()
+	}
+	return nil
+}
+
+func (s *server) notifyMFAChange(ctx context.Context, userIDs *ttnpb.UserIdentifiers, change MFAChange) {
+	if s.mfaNotifier == nil {
+		return
+	}
+	if err := s.mfaNotifier(ctx, userIDs, change); err != nil {
+		log.FromContext(ctx).WithError(err).Error("Could not send multi-factor authentication change notification")
+	}
+}
+
+type mfaCodeRequest struct {
+	Code         string `json:"code" form:"code"`
+	RecoveryCode string `json:"recovery_code" form:"recovery_code"`
+	Password     string `json:"password" form:"password"`
+}
+
+// ValidateContext validates the one-time password or recovery code request.
+func (req *mfaCodeRequest) ValidateContext(ctx context.Context) error {
+	if strings.TrimSpace(req.Code) == "" && strings.TrimSpace(req.RecoveryCode) == "" {
+		return errMissingMFACode.New()
+	}
+	return nil
+}
+
+func (s *server) bindMFACodeRequest(c echo.Context) (*mfaCodeRequest, error) {
+	req := new(mfaCodeRequest)
+	if err := c.Bind(req); err != nil {
+		return nil, err
+	}
+	if err := req.ValidateContext(c.Request().Context()); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// verifyUserMFA verifies the one-time password or recovery code of the user, and stores the updated
+// multi-factor authentication. The attempts are rate limited per user.
+func (s *server) verifyUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers, req *mfaCodeRequest) error {
+	if err := ratelimit.Require(s.c.RateLimiter(), ratelimit.UserMFAResource(userIDs)); err != nil {
+		return err
+	}
+	var usedRecoveryCode bool
+	err := s.store.Transact(ctx, func(ctx context.Context, st account_store.Interface) error {
+		mfa, err := getUserMFA(ctx, st, userIDs)
+		if err != nil {
+			return err
+		}
+		if usedRecoveryCode, err = s.verifyMFA(ctx, mfa, req.Code, req.RecoveryCode); err != nil {
+			return err
+		}
+		_, err = st.SetUserMFA(ctx, userIDs, mfa)
+		return err
+	})
+	if err != nil {
+		if errors.Resemble(err, errInvalidMFACode) {
+			events.Publish(evtMFALoginFailed.NewWithIdentifiersAndData(ctx, userIDs, nil))
+		}
+		return err
+	}
+	if usedRecoveryCode {
+		s.notifyMFAChange(ctx, userIDs, MFARecoveryCodeUsed)
+	}
+	return nil
+}
+
+// MFALogin completes the login of a user with multi-factor authentication using a one-time password or recovery
+// code.
+func (s *server) MFALogin(c echo.Context) error {
+	ctx := c.Request().Context()
+	req, err := s.bindMFACodeRequest(c)
+	if err != nil {
+		return err
+	}
+	loginCookie, err := s.pendingLogin(c)
+	if err != nil {
+		return err
+	}
+	if loginCookie.Enrol {
+		return errMFAEnrolmentRequired.New()
+	}
+	userIDs := &ttnpb.UserIdentifiers{UserId: loginCookie.UserID}
+	if err := s.verifyUserMFA(ctx, userIDs, req); err != nil {
+		return err
+	}
+	s.mfaLoginCookie().Remove(c.Response(), c.Request())
+	if err := s.CreateUserSession(c, userIDs); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// MFAStatus returns the multi-factor authentication status of the current user.
+func (s *server) MFAStatus(c echo.Context) error {
+	ctx := c.Request().Context()
+	user, err := s.session.GetUser(c)
+	if err != nil {
+		return err
+	}
+	mfa, err := getUserMFA(ctx, s.store, user.GetIds())
+	if err != nil {
+		return err
+	}
+	res := struct {
+		Enabled                bool       `json:"enabled"`
+		EnabledAt              *time.Time `json:"enabled_at,omitempty"`
+		RecoveryCodesRemaining int        `json:"recovery_codes_remaining"`
+		Required               bool       `json:"required"`
+	}{
+		Enabled:  mfa.Enabled(),
+		Required: s.configFromContext(ctx).MFA.RequiredFor(user),
+	}
+	if mfa.Enabled() {
+		res.EnabledAt = mfa.EnabledAt
+		res.RecoveryCodesRemaining = len(mfa.RecoveryCodes)
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
+type passwordRequest struct {
+	Password string `json:"password" form:"password"`
+}
+
+// startTOTPEnrolment generates a new secret for the time-based one-time passwords of the user. The enrolment must be
+// confirmed with a one-time password before multi-factor authentication is enabled.
+func (s *server) startTOTPEnrolment(c echo.Context, userIDs *ttnpb.UserIdentifiers) error {
+	ctx := c.Request().Context()
+	mfa, err := getUserMFA(ctx, s.store, userIDs)
+	if err != nil {
+		return err
+	}
+	if mfa.Enabled() {
+		return errMFAAlreadyEnabled.New()
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return err
+	}
+	mfa = &store.UserMFA{}
+	if err := s.encryptTOTPSecret(ctx, mfa, secret); err != nil {
+		return err
+	}
+	if _, err := s.store.SetUserMFA(ctx, userIDs, mfa); err != nil {
+		return err
+	}
+	config := s.configFromContext(ctx)
+	issuer := config.MFA.Issuer
+	if issuer == "" {
+		issuer = config.UI.SiteName
+	}
+	uri := totp.URI(issuer, userIDs.GetUserId(), secret)
+	qrCode, err := qrcodegenerator.RenderPNG(uri, qrCodeSize)
+	if err != nil {
+		return err
+	}
+	type image struct {
+		MimeType string `json:"mime_type"`
+		Data     []byte `json:"data"`
+	}
+	return c.JSON(http.StatusOK, struct {
+		Secret string `json:"secret"`
+		URI    string `json:"uri"`
+		QRCode image  `json:"qr_code"`
+	}{
+		Secret: secret,
+		URI:    uri,
+		QRCode: image{MimeType: "image/png", Data: qrCode},
+	})
+}
+
+// StartTOTPEnrolment starts the enrolment of the current user after verifying their password.
+func (s *server) StartTOTPEnrolment(c echo.Context) error {
+	req := new(passwordRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	user, err := s.session.GetUser(c)
+	if err != nil {
+		return err
+	}
+	if err := verifyPassword(user, req.Password); err != nil {
+		return err
+	}
+	return s.startTOTPEnrolment(c, user.GetIds())
+}
+
+// StartLoginTOTPEnrolment starts the enrolment of the user of a pending login that the policy requires to enable
+// multi-factor authentication. The user already authenticated in the first login step.
+func (s *server) StartLoginTOTPEnrolment(c echo.Context) error {
+	loginCookie, err := s.pendingLogin(c)
+	if err != nil {
+		return err
+	}
+	if !loginCookie.Enrol {
+		return errMFAAlreadyEnabled.New()
+	}
+	return s.startTOTPEnrolment(c, &ttnpb.UserIdentifiers{UserId: loginCookie.UserID})
+}
+
+type recoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// confirmTOTPEnrolment confirms the enrolment of the user with a one-time password, enables multi-factor
+// authentication and returns the recovery codes.
+func (s *server) confirmTOTPEnrolment(ctx context.Context, userIDs *ttnpb.UserIdentifiers, req *mfaCodeRequest) ([]string, error) {
+	codes, hashes, err := s.generateRecoveryCodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = s.store.Transact(ctx, func(ctx context.Context, st account_store.Interface) error {
+		mfa, err := getUserMFA(ctx, st, userIDs)
+		if err != nil {
+			return err
+		}
+		switch {
+		case mfa == nil:
+			return errMFAEnrolmentNotStarted.New()
+		case mfa.Enabled():
+			return errMFAAlreadyEnabled.New()
+		}
+		if err := s.verifyTOTP(ctx, mfa, req.Code); err != nil {
+			return err
+		}
+		now := time.Now()
+		mfa.RecoveryCodes, mfa.EnabledAt = hashes, &now
+		_, err = st.SetUserMFA(ctx, userIDs, mfa)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtMFAEnable.NewWithIdentifiersAndData(ctx, userIDs, nil))
+	s.notifyMFAChange(ctx, userIDs, MFAEnabled)
+	return codes, nil
+}
+
+// ConfirmTOTPEnrolment confirms the enrolment of the current user.
+func (s *server) ConfirmTOTPEnrolment(c echo.Context) error {
+	ctx := c.Request().Context()
+	req, err := s.bindMFACodeRequest(c)
+	if err != nil {
+		return err
+	}
+	session, err := s.session.Get(c)
+	if err != nil {
+		return err
+	}
+	codes, err := s.confirmTOTPEnrolment(ctx, session.GetUserIds(), req)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, recoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// ConfirmLoginTOTPEnrolment confirms the enrolment of the user of a pending login and completes the login.
+func (s *server) ConfirmLoginTOTPEnrolment(c echo.Context) error {
+	ctx := c.Request().Context()
+	req, err := s.bindMFACodeRequest(c)
+	if err != nil {
+		return err
+	}
+	loginCookie, err := s.pendingLogin(c)
+	if err != nil {
+		return err
+	}
+	if !loginCookie.Enrol {
+		return errMFAAlreadyEnabled.New()
+	}
+	userIDs := &ttnpb.UserIdentifiers{UserId: loginCookie.UserID}
+	codes, err := s.confirmTOTPEnrolment(ctx, userIDs, req)
+	if err != nil {
+		return err
+	}
+	s.mfaLoginCookie().Remove(c.Response(), c.Request())
+	if err := s.CreateUserSession(c, userIDs); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, recoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the current user after verifying a one-time password.
+func (s *server) RegenerateRecoveryCodes(c echo.Context) error {
+	ctx := c.Request().Context()
+	req, err := s.bindMFACodeRequest(c)
+	if err != nil {
+		return err
+	}
+	session, err := s.session.Get(c)
+	if err != nil {
+		return err
+	}
+	userIDs := session.GetUserIds()
+	if err := s.verifyUserMFA(ctx, userIDs, req); err != nil {
+		return err
+	}
+	codes, hashes, err := s.generateRecoveryCodes(ctx)
+	if err != nil {
+		return err
+	}
+	err = s.store.Transact(ctx, func(ctx context.Context, st account_store.Interface) error {
+		mfa, err := getUserMFA(ctx, st, userIDs)
+		if err != nil {
+			return err
+		}
+		if !mfa.Enabled() {
+			return errMFANotEnabled.New()
+		}
+		mfa.RecoveryCodes = hashes
+		_, err = st.SetUserMFA(ctx, userIDs, mfa)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	events.Publish(evtMFARecoveryCodesRegenerate.NewWithIdentifiersAndData(ctx, userIDs, nil))
+	s.notifyMFAChange(ctx, userIDs, MFARecoveryCodesRegenerated)
+	return c.JSON(http.StatusOK, recoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// DisableMFA disables multi-factor authentication of the current user after verifying their password and a one-time
+// password or recovery code. Users that are required to use multi-factor authentication by policy can not disable it.
+func (s *server) DisableMFA(c echo.Context) error {
+	ctx := c.Request().Context()
+	req, err := s.bindMFACodeRequest(c)
+	if err != nil {
+		return err
+	}
+	user, err := s.session.GetUser(c)
+	if err != nil {
+		return err
+	}
+	if s.configFromContext(ctx).MFA.RequiredFor(user) {
+		return errMFARequiredByPolicy.New()
+	}
+	if err := verifyPassword(user, req.Password); err != nil {
+		return err
+	}
+	userIDs := user.GetIds()
+	if err := s.verifyUserMFA(ctx, userIDs, req); err != nil {
+		return err
+	}
+	if err := s.store.DeleteUserMFA(ctx, userIDs); err != nil {
+		return err
+	}
+	events.Publish(evtMFADisable.NewWithIdentifiersAndData(ctx, userIDs, nil))
+	s.notifyMFAChange(ctx, userIDs, MFADisabled)
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,57 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	evtMFAEnable = events.Define(
+		"account.mfa.enable", "enable multi-factor authentication",
+		events.WithVisibility(ttnpb.RIGHT_USER_ALL),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtMFADisable = events.Define(
+		"account.mfa.disable", "disable multi-factor authentication",
+		events.WithVisibility(ttnpb.RIGHT_USER_ALL),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtMFARecoveryCodesRegenerate = events.Define(
+		"account.mfa.recovery_codes.regenerate", "regenerate multi-factor authentication recovery codes",
+		events.WithVisibility(ttnpb.RIGHT_USER_ALL),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtFederationCreateUser = events.Define(
+		"account.federation.create_user", "create user through federated login",
+		events.WithVisibility(ttnpb.RIGHT_USER_ALL),
+		events.WithClientInfoFromContext(),
+	)
+	evtFederationLinkUser = events.Define(
+		"account.federation.link_user", "link user to federated identity",
+		events.WithVisibility(ttnpb.RIGHT_USER_ALL),
+		events.WithClientInfoFromContext(),
+	)
+	evtMFALoginFailed = events.Define(
+		"account.mfa.login_failed", "multi-factor login failure",
+		events.WithVisibility(ttnpb.RIGHT_USER_ALL),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+)
@@ -0,0 +1,291 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package oidc implements an OpenID Connect relying party for federated login through upstream providers.
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"golang.org/x/oauth2"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+
+	// keysRefreshInterval is the minimum interval between fetching the keys of the provider for unknown key IDs.
+	keysRefreshInterval = time.Minute
+	// leeway is the allowed clock skew when validating the ID token.
+	leeway = time.Minute
+)
+
+var supportedAlgorithms = map[string]bool{
+	string(jose.RS256): true,
+	string(jose.RS384): true,
+	string(jose.RS512): true,
+	string(jose.PS256): true,
+	string(jose.PS384): true,
+	string(jose.PS512): true,
+	string(jose.ES256): true,
+	string(jose.ES384): true,
+	string(jose.ES512): true,
+}
+
+var (
+	errDiscovery          = errors.DefineUnavailable("discovery", "discover OpenID Connect provider `{issuer}`")
+	errIssuerMismatch     = errors.DefineFailedPrecondition("issuer_mismatch", "issuer `{issuer}` of provider does not match `{expected}`")
+	errFetchKeys          = errors.DefineUnavailable("fetch_keys", "fetch keys of OpenID Connect provider `{issuer}`")
+	errExchange           = errors.DefinePermissionDenied("exchange", "exchange authorization code")
+	errNoIDToken          = errors.DefinePermissionDenied("no_id_token", "no ID token in token response")
+	errInvalidIDToken     = errors.DefinePermissionDenied("invalid_id_token", "invalid ID token")
+	errUnsupportedAlg     = errors.DefinePermissionDenied("unsupported_algorithm", "unsupported ID token signing algorithm `{algorithm}`")
+	errKeyNotFound        = errors.DefinePermissionDenied("key_not_found", "key `{key_id}` not found")
+	errNonceMismatch      = errors.DefinePermissionDenied("nonce_mismatch", "ID token nonce mismatch")
+	errMissingSubject     = errors.DefinePermissionDenied("missing_subject", "missing subject in ID token")
+	errUnexpectedResponse = errors.Define("unexpected_response", "unexpected response status `{status}`")
+)
+
+// Config is the configuration of the relying party at an OpenID Connect provider.
+type Config struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// Scopes are the scopes to request in addition to openid, profile and email.
+	Scopes      []string
+	RedirectURL string
+}
+
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider is an upstream OpenID Connect provider.
+// The provider metadata is discovered when the provider is first used.
+type Provider struct {
+	config Config
+	client *http.Client
+
+	mu            sync.Mutex
+	metadata      *metadata
+	keys          *jose.JSONWebKeySet
+	keysFetchedAt time.Time
+}
+
+// NewProvider returns a new Provider that uses the given HTTP client to communicate with the provider.
+func NewProvider(config Config, client *http.Client) *Provider {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Provider{
+		config: config,
+		client: client,
+	}
+}
+
+func (p *Provider) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return errUnexpectedResponse.WithAttributes("status", res.Status)
+	}
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+func (p *Provider) discover(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+	md := &metadata{}
+	if err := p.getJSON(ctx, strings.TrimSuffix(p.config.Issuer, "/")+discoveryPath, md); err != nil {
+		return nil, errDiscovery.WithCause(err).WithAttributes("issuer", p.config.Issuer)
+	}
+	if strings.TrimSuffix(md.Issuer, "/") != strings.TrimSuffix(p.config.Issuer, "/") {
+		return nil, errIssuerMismatch.WithAttributes("issuer", md.Issuer, "expected", p.config.Issuer)
+	}
+	p.metadata = md
+	return md, nil
+}
+
+func (p *Provider) key(ctx context.Context, md *metadata, keyID string) (*jose.JSONWebKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.keys != nil {
+		if keys := p.keys.Key(keyID); len(keys) > 0 {
+			return &keys[0], nil
+		}
+		if time.Since(p.keysFetchedAt) < keysRefreshInterval {
+			return nil, errKeyNotFound.WithAttributes("key_id", keyID)
+		}
+	}
+	keys := &jose.JSONWebKeySet{}
+	if err := p.getJSON(ctx, md.JWKSURI, keys); err != nil {
+		return nil, errFetchKeys.WithCause(err).WithAttributes("issuer", p.config.Issuer)
+	}
+	p.keys, p.keysFetchedAt = keys, time.Now()
+	if keys := p.keys.Key(keyID); len(keys) > 0 {
+		return &keys[0], nil
+	}
+	return nil, errKeyNotFound.WithAttributes("key_id", keyID)
+}
+
+func (p *Provider) oauth2Config(md *metadata) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  md.AuthorizationEndpoint,
+			TokenURL: md.TokenEndpoint,
+		},
+		RedirectURL: p.config.RedirectURL,
+		Scopes:      append([]string{"openid", "profile", "email"}, p.config.Scopes...),
+	}
+}
+
+// AuthCodeURL returns the URL of the authorization endpoint of the provider to which the user is redirected.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce string) (string, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	return p.oauth2Config(md).AuthCodeURL(state, oauth2.SetAuthURLParam("nonce", nonce)), nil
+}
+
+// Exchange exchanges the authorization code for tokens and returns the claims of the verified ID token.
+func (p *Provider) Exchange(ctx context.Context, code, nonce string) (Claims, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := p.oauth2Config(md).Exchange(context.WithValue(ctx, oauth2.HTTPClient, p.client), code)
+	if err != nil {
+		return nil, errExchange.WithCause(err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, errNoIDToken.New()
+	}
+	return p.Verify(ctx, rawIDToken, nonce)
+}
+
+// Verify verifies the signature, issuer, audience, expiry and nonce of the ID token and returns its claims.
+func (p *Provider) Verify(ctx context.Context, rawIDToken, nonce string) (Claims, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := jwt.ParseSigned(rawIDToken)
+	if err != nil {
+		return nil, errInvalidIDToken.WithCause(err)
+	}
+	if len(token.Headers) != 1 {
+		return nil, errInvalidIDToken.New()
+	}
+	header := token.Headers[0]
+	if !supportedAlgorithms[header.Algorithm] {
+		return nil, errUnsupportedAlg.WithAttributes("algorithm", header.Algorithm)
+	}
+	key, err := p.key(ctx, md, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		std    jwt.Claims
+		claims Claims
+	)
+	if err := token.Claims(key, &std, &claims); err != nil {
+		return nil, errInvalidIDToken.WithCause(err)
+	}
+	if std.Expiry == nil {
+		return nil, errInvalidIDToken.New()
+	}
+	if err := std.ValidateWithLeeway(jwt.Expected{
+		Issuer:   md.Issuer,
+		Audience: jwt.Audience{p.config.ClientID},
+		Time:     time.Now(),
+	}, leeway); err != nil {
+		return nil, errInvalidIDToken.WithCause(err)
+	}
+	if claims.String("nonce") != nonce {
+		return nil, errNonceMismatch.New()
+	}
+	if std.Subject == "" {
+		return nil, errMissingSubject.New()
+	}
+	return claims, nil
+}
+
+// Claims are the claims of an ID token.
+type Claims map[string]interface{}
+
+// Subject returns the subject of the ID token, which identifies the user at the provider.
+func (c Claims) Subject() string {
+	return c.String("sub")
+}
+
+// String returns the string value of the claim.
+func (c Claims) String(name string) string {
+	s, _ := c[name].(string)
+	return s
+}
+
+// Bool returns the boolean value of the claim. String values are accepted for providers that encode booleans as
+// strings.
+func (c Claims) Bool(name string) bool {
+	switch v := c[name].(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	default:
+		return false
+	}
+}
+
+// Strings returns the string values of the claim. A single string value is returned as a slice with one element.
+func (c Claims) Strings(name string) []string {
+	switch v := c[name].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		res := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				res = append(res, s)
+			}
+		}
+		return res
+	default:
+		return nil
+	}
+}
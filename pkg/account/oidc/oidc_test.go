@@ -0,0 +1,228 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/account/oidc"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const (
+	clientID     = "test-client"
+	clientSecret = "test-secret"
+	authCode     = "test-code"
+)
+
+type provider struct {
+	*httptest.Server
+	key   *rsa.PrivateKey
+	token func() string
+}
+
+func (p *provider) sign(t *testing.T, claims map[string]interface{}) string {
+	signer, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.RS256,
+		Key:       jose.JSONWebKey{Key: p.key, KeyID: "test-key"},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func newProvider(t *testing.T) *provider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &provider{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.URL,
+			"authorization_endpoint": p.URL + "/authorize",
+			"token_endpoint":         p.URL + "/token",
+			"jwks_uri":               p.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+			Key:       &key.PublicKey,
+			KeyID:     "test-key",
+			Algorithm: string(jose.RS256),
+			Use:       "sig",
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		id, secret, _ := r.BasicAuth()
+		if id != clientID || secret != clientSecret || r.FormValue("code") != authCode {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "test-access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     p.token(),
+		})
+	})
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+	return p
+}
+
+func TestProvider(t *testing.T) {
+	a := assertions.New(t)
+	ctx := context.Background()
+	idp := newProvider(t)
+
+	p := oidc.NewProvider(oidc.Config{
+		Issuer:       idp.URL,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scopes:       []string{"groups"},
+		RedirectURL:  "https://example.com/callback",
+	}, idp.Client())
+
+	authURL, err := p.AuthCodeURL(ctx, "test-state", "test-nonce")
+	if a.So(err, should.BeNil) {
+		u, err := url.Parse(authURL)
+		a.So(err, should.BeNil)
+		a.So(u.Path, should.Equal, "/authorize")
+		a.So(u.Query().Get("client_id"), should.Equal, clientID)
+		a.So(u.Query().Get("state"), should.Equal, "test-state")
+		a.So(u.Query().Get("nonce"), should.Equal, "test-nonce")
+		a.So(u.Query().Get("scope"), should.Equal, "openid profile email groups")
+		a.So(u.Query().Get("redirect_uri"), should.Equal, "https://example.com/callback")
+	}
+
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":            idp.URL,
+			"sub":            "test-subject",
+			"aud":            clientID,
+			"exp":            time.Now().Add(time.Hour).Unix(),
+			"iat":            time.Now().Unix(),
+			"nonce":          "test-nonce",
+			"email":          "user@example.com",
+			"email_verified": true,
+			"groups":         []string{"admins", "users"},
+		}
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		a := assertions.New(t)
+		idp.token = func() string { return idp.sign(t, validClaims()) }
+		claims, err := p.Exchange(ctx, authCode, "test-nonce")
+		if a.So(err, should.BeNil) {
+			a.So(claims.Subject(), should.Equal, "test-subject")
+			a.So(claims.String("email"), should.Equal, "user@example.com")
+			a.So(claims.Bool("email_verified"), should.BeTrue)
+			a.So(claims.Strings("groups"), should.Resemble, []string{"admins", "users"})
+		}
+	})
+
+	t.Run("InvalidCode", func(t *testing.T) {
+		a := assertions.New(t)
+		idp.token = func() string { return idp.sign(t, validClaims()) }
+		_, err := p.Exchange(ctx, "other-code", "test-nonce")
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+
+	for _, tc := range []struct {
+		Name   string
+		Modify func(map[string]interface{})
+	}{
+		{
+			Name:   "Expired",
+			Modify: func(c map[string]interface{}) { c["exp"] = time.Now().Add(-time.Hour).Unix() },
+		},
+		{
+			Name:   "OtherAudience",
+			Modify: func(c map[string]interface{}) { c["aud"] = "other-client" },
+		},
+		{
+			Name:   "OtherIssuer",
+			Modify: func(c map[string]interface{}) { c["iss"] = "https://other.example.com" },
+		},
+		{
+			Name:   "OtherNonce",
+			Modify: func(c map[string]interface{}) { c["nonce"] = "other-nonce" },
+		},
+		{
+			Name:   "NoSubject",
+			Modify: func(c map[string]interface{}) { delete(c, "sub") },
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			idp.token = func() string {
+				claims := validClaims()
+				tc.Modify(claims)
+				return idp.sign(t, claims)
+			}
+			_, err := p.Exchange(ctx, authCode, "test-nonce")
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		})
+	}
+
+	t.Run("OtherKey", func(t *testing.T) {
+		a := assertions.New(t)
+		other := &provider{}
+		var err error
+		other.key, err = rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = p.Verify(ctx, other.sign(t, validClaims()), "test-nonce")
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+}
+
+func TestClaims(t *testing.T) {
+	a := assertions.New(t)
+	claims := oidc.Claims{
+		"sub":            "test-subject",
+		"email_verified": "true",
+		"group":          "admins",
+		"number":         42.0,
+	}
+	a.So(claims.Subject(), should.Equal, "test-subject")
+	a.So(claims.Bool("email_verified"), should.BeTrue)
+	a.So(claims.Bool("missing"), should.BeFalse)
+	a.So(claims.Strings("group"), should.Resemble, []string{"admins"})
+	a.So(claims.Strings("number"), should.BeEmpty)
+	a.So(claims.String("number"), should.BeEmpty)
+}
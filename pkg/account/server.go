@@ -16,11 +16,13 @@ package account
 
 import (
 	"context"
+	"net/http"
 
 	echo "github.com/labstack/echo/v4"
 	sess "go.thethings.network/lorawan-stack/v3/pkg/account/session"
 	account_store "go.thethings.network/lorawan-stack/v3/pkg/account/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	web_errors "go.thethings.network/lorawan-stack/v3/pkg/errors/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/oauth"
@@ -43,6 +45,7 @@ type Server interface {
 type Component interface {
 	Context() context.Context
 	RateLimiter() ratelimit.Interface
+	HTTPClient(context.Context) (*http.Client, error)
 }
 
 type server struct {
@@ -51,22 +54,50 @@ type server struct {
 	store       account_store.Interface
 	session     sess.Session
 	generateCSP func(config *oauth.Config, nonce string) string
+	mfaNotifier MFANotifier
+	providers   map[string]*federatedProvider
+	keyVault    crypto.KeyVault
+}
+
+// Option configures the account app server.
+type Option func(*server)
+
+// WithMFANotifier returns an Option that sets the function that notifies users about changes of their multi-factor
+// authentication.
+func WithMFANotifier(notifier MFANotifier) Option {
+	return func(s *server) {
+		s.mfaNotifier = notifier
+	}
 }
 
 // NewServer returns a new account app on top of the given store.
-func NewServer(c *component.Component, store account_store.Interface, config oauth.Config, cspFunc func(config *oauth.Config, nonce string) string) (Server, error) {
+func NewServer(c *component.Component, store account_store.Interface, config oauth.Config, cspFunc func(config *oauth.Config, nonce string) string, opts ...Option) (Server, error) {
 	s := &server{
 		c:           c,
 		config:      config,
 		store:       store,
 		session:     sess.Session{Store: store},
 		generateCSP: cspFunc,
+		keyVault:    c.KeyVault,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
 
 	if s.config.Mount == "" {
 		s.config.Mount = s.config.UI.MountPath()
 	}
 
+	if len(s.config.Providers) > 0 {
+		client, err := c.HTTPClient(c.Context())
+		if err != nil {
+			return nil, err
+		}
+		if s.providers, err = newFederatedProviders(s.config, client); err != nil {
+			return nil, err
+		}
+	}
+
 	return s, nil
 }
 
@@ -124,8 +155,19 @@ func (s *server) RegisterRoutes(server *web.Server) {
 	api := root.Group("/api")
 	api.POST("/auth/login", s.Login)
 	api.POST("/auth/token-login", s.TokenLogin)
+	api.POST("/auth/mfa", s.MFALogin)
+	api.POST("/auth/mfa/totp", s.StartLoginTOTPEnrolment)
+	api.POST("/auth/mfa/totp/confirm", s.ConfirmLoginTOTPEnrolment)
+	api.GET("/auth/providers", s.Providers)
+	api.GET("/auth/providers/:provider_id/login", s.FederatedLogin)
+	api.GET("/auth/providers/:provider_id/callback", s.FederatedCallback)
 	api.POST("/auth/logout", s.Logout, s.requireLogin)
 	api.GET("/me", s.CurrentUser, s.requireLogin)
+	api.GET("/me/mfa", s.MFAStatus, s.requireLogin)
+	api.POST("/me/mfa/totp", s.StartTOTPEnrolment, s.requireLogin)
+	api.POST("/me/mfa/totp/confirm", s.ConfirmTOTPEnrolment, s.requireLogin)
+	api.POST("/me/mfa/recovery-codes", s.RegenerateRecoveryCodes, s.requireLogin)
+	api.POST("/me/mfa/disable", s.DisableMFA, s.requireLogin)
 
 	page := root.Group("")
 	page.GET("/login", webui.Template.Handler, s.redirectToNext)
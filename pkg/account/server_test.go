@@ -16,6 +16,7 @@ package account_test
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -31,10 +32,12 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/account"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/pbkdf2"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/totp"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/oauth"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
@@ -53,6 +56,12 @@ type tokenFormData struct {
 	Token    string `json:"token"`
 }
 
+type mfaFormData struct {
+	Code         string `json:"code,omitempty"`
+	RecoveryCode string `json:"recovery_code,omitempty"`
+	Password     string `json:"password,omitempty"`
+}
+
 type authorizeFormData struct {
 	encoding  string
 	Authorize bool `json:"authorize"`
@@ -68,8 +77,32 @@ var (
 	mockUser = &ttnpb.User{
 		Ids: &ttnpb.UserIdentifiers{UserId: "user"},
 	}
+	mockAdminUser = &ttnpb.User{
+		Ids:   &ttnpb.UserIdentifiers{UserId: "admin"},
+		Admin: true,
+	}
+	mockMFASecret       = "JBSWY3DPEHPK3PXP"
+	mockRecoveryCodeKey string
 )
 
+func newMockMFA(enabled bool) *store.UserMFA {
+	mfa := &store.UserMFA{
+		TOTPSecret: mockMFASecret,
+	}
+	if enabled {
+		mfa.RecoveryCodes = []string{mockRecoveryCodeKey}
+		mfa.EnabledAt = &now
+	}
+	return mfa
+}
+
+func newMockEncryptedMFA(secret []byte, keyID string) *store.UserMFA {
+	return &store.UserMFA{
+		TOTPSecret:      base64.StdEncoding.EncodeToString(secret),
+		TOTPSecretKeyID: keyID,
+	}
+}
+
 func init() {
 	ctx := test.Context()
 
@@ -82,6 +115,12 @@ func init() {
 		panic(err)
 	}
 	mockUser.Password = password
+	mockAdminUser.Password = password
+
+	mockRecoveryCodeKey, err = auth.Hash(ctx, "abcdefghij")
+	if err != nil {
+		panic(err)
+	}
 }
 
 func TestAuthentication(t *testing.T) {
@@ -98,6 +137,21 @@ func TestAuthentication(t *testing.T) {
 					BlockKey: []byte("12345678123456781234567812345678"),
 				},
 			},
+			RateLimiting: config.RateLimiting{
+				Profiles: []config.RateLimitingProfile{
+					{
+						Name:         "mfa",
+						MaxPerMin:    3,
+						Associations: []string{"account:mfa"},
+					},
+				},
+			},
+			KeyVault: config.KeyVault{
+				Provider: "static",
+				Static: map[string][]byte{
+					"mfa": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F},
+				},
+			},
 		},
 	})
 	s, err := account.NewServer(c, store, oauth.Config{
@@ -110,6 +164,18 @@ func TestAuthentication(t *testing.T) {
 				CanonicalURL: "https://example.com/oauth",
 			},
 		},
+		Providers: []oauth.ProviderConfig{
+			{
+				ID:       "example-idp",
+				Name:     "Example IdP",
+				Issuer:   "https://idp.example.com",
+				ClientID: "example-client",
+			},
+		},
+		MFA: oauth.MFAConfig{
+			EncryptionKeyID:   "mfa",
+			RequiredForAdmins: true,
+		},
 	}, identityserver.GenerateCSPString)
 	if err != nil {
 		panic(err)
@@ -117,6 +183,15 @@ func TestAuthentication(t *testing.T) {
 	c.RegisterWeb(s)
 	componenttest.StartComponent(t, c)
 
+	mfaCode, err := totp.Code(mockMFASecret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	encryptedMFASecret, err := c.KeyVault.Encrypt(test.Context(), []byte(mockMFASecret), "mfa")
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	var csrfToken string
 	var r *http.Request
 
@@ -158,6 +233,25 @@ func TestAuthentication(t *testing.T) {
 			ExpectedCode: http.StatusOK,
 			ExpectedBody: "The Things Network Account",
 		},
+		{
+			Name:         "list federated login providers",
+			Method:       "GET",
+			Path:         "/oauth/api/auth/providers",
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `{"providers":[{"id":"example-idp","name":"Example IdP"}]}`,
+		},
+		{
+			Name:         "federated login with unknown provider",
+			Method:       "GET",
+			Path:         "/oauth/api/auth/providers/unknown/login",
+			ExpectedCode: http.StatusNotFound,
+		},
+		{
+			Name:         "federated callback without login",
+			Method:       "GET",
+			Path:         "/oauth/api/auth/providers/example-idp/callback?code=foo&state=bar",
+			ExpectedCode: http.StatusUnauthorized,
+		},
 		{
 			Name:         "GET me without auth",
 			Method:       "GET",
@@ -315,6 +409,287 @@ func TestAuthentication(t *testing.T) {
 				a.So(s.req.session.GetUserIds(), should.Resemble, mockUser.GetIds())
 			},
 		},
+		{
+			Name: "login with MFA",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockUser
+				s.res.userMFA = newMockMFA(true)
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginFormData{"json", "user", "pass"},
+			ExpectedCode: http.StatusUnauthorized,
+			ExpectedBody: `"name":"mfa_required"`,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "GetUserMFA")
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name: "MFA login invalid code",
+			StoreSetup: func(s *mockStore) {
+				s.res.userMFA = newMockMFA(true)
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/mfa",
+			Body:         mfaFormData{Code: "abcdef"},
+			ExpectedCode: http.StatusBadRequest,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "SetUserMFA")
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name: "MFA login",
+			StoreSetup: func(s *mockStore) {
+				s.res.userMFA = newMockMFA(true)
+				s.res.session = mockSession
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/mfa",
+			Body:         mfaFormData{Code: mfaCode},
+			ExpectedCode: http.StatusNoContent,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "CreateSession")
+				a.So(s.req.session.GetUserIds(), should.Resemble, mockUser.GetIds())
+				if a.So(s.req.userMFA, should.NotBeNil) {
+					a.So(s.req.userMFA.TOTPLastStep, should.BeGreaterThan, 0)
+				}
+			},
+		},
+		{
+			Name:         "MFA login without pending login",
+			Method:       "POST",
+			Path:         "/oauth/api/auth/mfa",
+			Body:         mfaFormData{Code: mfaCode},
+			ExpectedCode: http.StatusUnauthorized,
+		},
+		{
+			Name: "login with MFA required by policy",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockAdminUser
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginFormData{"json", "admin", "pass"},
+			ExpectedCode: http.StatusUnauthorized,
+			ExpectedBody: `"name":"mfa_enrolment_required"`,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name:         "MFA login with pending enrolment",
+			Method:       "POST",
+			Path:         "/oauth/api/auth/mfa",
+			Body:         mfaFormData{Code: mfaCode},
+			ExpectedCode: http.StatusUnauthorized,
+			ExpectedBody: `"name":"mfa_enrolment_required"`,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name:         "start TOTP enrolment of pending login",
+			Method:       "POST",
+			Path:         "/oauth/api/auth/mfa/totp",
+			Body:         mfaFormData{},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `"uri":"otpauth://totp/The%20Things%20Network:admin?`,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "CreateSession")
+				a.So(s.req.userIDs.GetUserId(), should.Equal, "admin")
+				if a.So(s.req.userMFA, should.NotBeNil) {
+					a.So(s.req.userMFA.Enabled(), should.BeFalse)
+				}
+			},
+		},
+		{
+			Name: "confirm TOTP enrolment of pending login",
+			StoreSetup: func(s *mockStore) {
+				s.res.userMFA = newMockMFA(false)
+				s.res.session = mockSession
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/mfa/totp/confirm",
+			Body:         mfaFormData{Code: mfaCode},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `"recovery_codes":[`,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				if a.So(s.req.userMFA, should.NotBeNil) {
+					a.So(s.req.userMFA.Enabled(), should.BeTrue)
+				}
+				a.So(s.calls, should.Contain, "CreateSession")
+				a.So(s.req.session.GetUserIds().GetUserId(), should.Equal, "admin")
+			},
+		},
+		{
+			Name:         "confirm TOTP enrolment of completed login",
+			Method:       "POST",
+			Path:         "/oauth/api/auth/mfa/totp/confirm",
+			Body:         mfaFormData{Code: mfaCode},
+			ExpectedCode: http.StatusUnauthorized,
+		},
+		{
+			Name: "start TOTP enrolment without password",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/me/mfa/totp",
+			Body:         mfaFormData{},
+			ExpectedCode: http.StatusBadRequest,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "SetUserMFA")
+			},
+		},
+		{
+			Name: "start TOTP enrolment with incorrect password",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/me/mfa/totp",
+			Body:         mfaFormData{Password: "wrong"},
+			ExpectedCode: http.StatusBadRequest,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "SetUserMFA")
+			},
+		},
+		{
+			Name: "start TOTP enrolment",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/me/mfa/totp",
+			Body:         mfaFormData{Password: "pass"},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `"uri":"otpauth://totp/The%20Things%20Network:user?`,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				if a.So(s.req.userMFA, should.NotBeNil) {
+					a.So(s.req.userMFA.TOTPSecret, should.NotBeEmpty)
+					a.So(s.req.userMFA.TOTPSecretKeyID, should.Equal, "mfa")
+					a.So(s.req.userMFA.Enabled(), should.BeFalse)
+				}
+			},
+		},
+		{
+			Name: "confirm TOTP enrolment with encrypted secret",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+				s.res.userMFA = newMockEncryptedMFA(encryptedMFASecret, "mfa")
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/me/mfa/totp/confirm",
+			Body:         mfaFormData{Code: mfaCode},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `"recovery_codes":[`,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				if a.So(s.req.userMFA, should.NotBeNil) {
+					a.So(s.req.userMFA.Enabled(), should.BeTrue)
+				}
+			},
+		},
+		{
+			Name: "confirm TOTP enrolment",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+				s.res.userMFA = newMockMFA(false)
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/me/mfa/totp/confirm",
+			Body:         mfaFormData{Code: mfaCode},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `"recovery_codes":[`,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				if a.So(s.req.userMFA, should.NotBeNil) {
+					a.So(s.req.userMFA.Enabled(), should.BeTrue)
+					a.So(s.req.userMFA.RecoveryCodes, should.HaveLength, 10)
+				}
+			},
+		},
+		{
+			Name: "GET MFA status",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+				s.res.userMFA = newMockMFA(true)
+			},
+			Method:       "GET",
+			Path:         "/oauth/api/me/mfa",
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `"recovery_codes_remaining":1`,
+		},
+		{
+			Name: "disable MFA with incorrect password",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+				s.res.userMFA = newMockMFA(true)
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/me/mfa/disable",
+			Body:         mfaFormData{RecoveryCode: "ABCDE-FGHIJ", Password: "wrong"},
+			ExpectedCode: http.StatusBadRequest,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "DeleteUserMFA")
+			},
+		},
+		{
+			Name: "disable MFA with recovery code",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+				s.res.userMFA = newMockMFA(true)
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/me/mfa/disable",
+			Body:         mfaFormData{RecoveryCode: "ABCDE-FGHIJ", Password: "pass"},
+			ExpectedCode: http.StatusNoContent,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				if a.So(s.req.userMFA, should.NotBeNil) {
+					a.So(s.req.userMFA.RecoveryCodes, should.BeEmpty)
+				}
+				a.So(s.calls, should.Contain, "DeleteUserMFA")
+			},
+		},
+		{
+			Name: "disable MFA rate limited",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+				s.res.userMFA = newMockMFA(true)
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/me/mfa/disable",
+			Body:         mfaFormData{RecoveryCode: "ABCDE-FGHIJ", Password: "pass"},
+			ExpectedCode: http.StatusTooManyRequests,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "GetUserMFA")
+				a.So(s.calls, should.NotContain, "DeleteUserMFA")
+			},
+		},
 	} {
 		name := tt.Name
 		if name == "" {
@@ -352,6 +727,10 @@ func TestAuthentication(t *testing.T) {
 					}.Encode()))
 					contentType = "application/x-www-form-urlencoded"
 				}
+			case mfaFormData:
+				json, _ := json.Marshal(b)
+				body = bytes.NewBuffer(json)
+				contentType = "application/json"
 			case tokenFormData:
 				if b.encoding == "json" {
 					json, _ := json.Marshal(b)
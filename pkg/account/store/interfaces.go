@@ -26,6 +26,11 @@ type Interface interface {
 	store.UserStore
 	store.LoginTokenStore
 	store.UserSessionStore
+	// UserMFAStore is needed for multi-factor authentication.
+	store.UserMFAStore
+	// FederatedIdentityStore and MembershipStore are needed for federated login.
+	store.FederatedIdentityStore
+	store.MembershipStore
 
 	// WithSoftDeleted returns a context that tells the store to include (only) deleted entities.
 	WithSoftDeleted(context.Context, bool) context.Context
@@ -34,11 +34,13 @@ type mockStoreContents struct {
 		sessionID string
 		userIDs   *ttnpb.UserIdentifiers
 		token     string
+		userMFA   *store.UserMFA
 	}
 	res struct {
 		session    *ttnpb.UserSession
 		user       *ttnpb.User
 		loginToken *ttnpb.LoginToken
+		userMFA    *store.UserMFA
 	}
 	err struct {
 		getUser       error
@@ -46,6 +48,7 @@ type mockStoreContents struct {
 		getSession    error
 		deleteSession error
 		loginToken    error
+		getUserMFA    error
 	}
 }
 
@@ -53,6 +56,9 @@ type mockStore struct {
 	store.UserStore
 	store.LoginTokenStore
 	store.UserSessionStore
+	store.UserMFAStore
+	store.FederatedIdentityStore
+	store.MembershipStore
 
 	mockStoreContents
 }
@@ -103,3 +109,21 @@ func (s *mockStore) WithSoftDeleted(ctx context.Context, b bool) context.Context
 func (s *mockStore) Transact(ctx context.Context, f func(context.Context, account_store.Interface) error) error {
 	return f(ctx, s)
 }
+
+func (s *mockStore) GetUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers) (*store.UserMFA, error) {
+	s.req.ctx, s.req.userIDs = ctx, userIDs
+	s.calls = append(s.calls, "GetUserMFA")
+	return s.res.userMFA, s.err.getUserMFA
+}
+
+func (s *mockStore) SetUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers, mfa *store.UserMFA) (*store.UserMFA, error) {
+	s.req.ctx, s.req.userIDs, s.req.userMFA = ctx, userIDs, mfa
+	s.calls = append(s.calls, "SetUserMFA")
+	return mfa, nil
+}
+
+func (s *mockStore) DeleteUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error {
+	s.req.ctx, s.req.userIDs = ctx, userIDs
+	s.calls = append(s.calls, "DeleteUserMFA")
+	return nil
+}
@@ -94,10 +94,7 @@ func (s *server) Login(c echo.Context) error {
 	if err := s.session.DoLogin(ctx, req.UserID, req.Password); err != nil {
 		return err
 	}
-	if err := s.CreateUserSession(c, &ttnpb.UserIdentifiers{UserId: req.UserID}); err != nil {
-		return err
-	}
-	return c.NoContent(http.StatusNoContent)
+	return s.completeLogin(c, &ttnpb.UserIdentifiers{UserId: req.UserID})
 }
 
 type tokenLoginRequest struct {
@@ -127,10 +124,7 @@ func (s *server) TokenLogin(c echo.Context) error {
 	if err != nil {
 		return err
 	}
-	if err := s.CreateUserSession(c, loginToken.GetUserIds()); err != nil {
-		return err
-	}
-	return c.NoContent(http.StatusNoContent)
+	return s.completeLogin(c, loginToken.GetUserIds())
 }
 
 func (s *server) CreateUserSession(c echo.Context, userIDs *ttnpb.UserIdentifiers) error {
@@ -25,7 +25,9 @@ import (
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/deferred"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/downlinkstatus"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	iogrpc "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/grpc"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt"
@@ -33,7 +35,9 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
 	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/mqtt" // The MQTT integration provider
 	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/nats" // The NATS integration provider
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/routing"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/cleanup"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
@@ -47,6 +51,8 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/devicerepository"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/javascript"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/hooks"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
+	js "go.thethings.network/lorawan-stack/v3/pkg/scripting/javascript"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
@@ -62,17 +68,20 @@ type ApplicationServer struct {
 
 	config *Config
 
-	linkRegistry     LinkRegistry
-	deviceRegistry   DeviceRegistry
-	appUpsRegistry   ApplicationUplinkRegistry
-	formatters       messageprocessors.MapPayloadProcessor
-	webhooks         web.Webhooks
-	webhookTemplates web.TemplateStore
-	pubsub           *pubsub.PubSub
-	appPackages      packages.Server
+	linkRegistry      LinkRegistry
+	deviceRegistry    DeviceRegistry
+	appUpsRegistry    ApplicationUplinkRegistry
+	deferredDownlinks deferred.Registry
+	downlinkStatus    *downlinkstatus.Tracker
+	formatters        messageprocessors.MapPayloadProcessor
+	webhooks          web.Webhooks
+	webhookTemplates  web.TemplateStore
+	pubsub            *pubsub.PubSub
+	appPackages       packages.Server
 
 	clusterDistributor distribution.Distributor
 	localDistributor   distribution.Distributor
+	router             *routing.Router
 
 	grpc struct {
 		asDevices asEndDeviceRegistryServer
@@ -115,10 +124,13 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 			interopConf.HTTPClient = httpClient
 		}
 
-		interopCl, err = interop.NewClient(ctx, interopConf)
+		cl, err := interop.NewClient(ctx, interopConf)
 		if err != nil {
 			return nil, err
 		}
+		// The interop server delivers answers from servers that answer asynchronously.
+		c.RegisterInterop(cl)
+		interopCl = cl
 	}
 
 	as = &ApplicationServer{
@@ -139,13 +151,6 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 			conf.Distribution.Global.PubSub,
 			conf.Distribution.Global.Individual.SubscriptionOptions(),
 		),
-		localDistributor: distribution.NewLocalDistributor(
-			ctx,
-			c,
-			conf.Distribution.Timeout,
-			conf.Distribution.Local.Broadcast.SubscriptionOptions(),
-			conf.Distribution.Local.Individual.SubscriptionOptions(),
-		),
 		interopClient:    interopCl,
 		interopID:        conf.Interop.ID,
 		endDeviceFetcher: conf.EndDeviceFetcher.Fetcher,
@@ -156,6 +161,19 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		as.endDeviceFetcher = &NoopEndDeviceFetcher{}
 	}
 
+	as.router = routing.NewRouter(
+		NewCachedEndDeviceFetcher(as.endDeviceFetcher, newRoutingAttributesCache()),
+		js.New(scripting.DefaultOptions),
+	)
+	as.localDistributor = distribution.NewLocalDistributor(
+		ctx,
+		c,
+		conf.Distribution.Timeout,
+		conf.Distribution.Local.Broadcast.SubscriptionOptions(),
+		conf.Distribution.Local.Individual.SubscriptionOptions(),
+		as.router,
+	)
+
 	as.grpc.asDevices = asEndDeviceRegistryServer{
 		AS:       as,
 		kekLabel: conf.DeviceKEKLabel,
@@ -226,6 +244,22 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		}
 	}
 
+	if conf.DownlinkStatus.Registry != nil {
+		as.downlinkStatus = downlinkstatus.NewTracker(conf.DownlinkStatus.Registry)
+	}
+
+	if conf.DeferredDownlinks.Registry != nil {
+		as.deferredDownlinks = conf.DeferredDownlinks.Registry
+		scheduler := deferred.NewScheduler(as.deferredDownlinks, as, as.downlinkStatus, conf.DeferredDownlinks.Interval)
+		as.RegisterTask(&component.TaskConfig{
+			Context: as.Context(),
+			ID:      "push_deferred_downlinks",
+			Func:    scheduler.Run,
+			Restart: component.TaskRestartOnFailure,
+			Backoff: component.DefaultTaskBackoffConfig,
+		})
+	}
+
 	if webhooks, err := conf.Webhooks.NewWebhooks(ctx, as); err != nil {
 		return nil, err
 	} else if webhooks != nil {
@@ -250,6 +284,52 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		c.RegisterGRPC(as.appPackages)
 	}
 
+	if conf.CleanupPurgedApplications {
+		var (
+			cleaners    []cleanup.ApplicationDataCleaner
+			reconcilers []cleanup.Reconciler
+		)
+		// The purge and reconcile tasks use separate cleaners, as the cleaners hold the local sets.
+		if conf.UplinkStorage.Registry != nil {
+			cleaners = append(cleaners, &RegistryCleaner{
+				DevRegistry:    conf.Devices,
+				AppUpsRegistry: conf.UplinkStorage.Registry,
+			})
+			reconcilers = append(reconcilers, cleanup.DeviceSetReconciler(&RegistryCleaner{
+				DevRegistry:    conf.Devices,
+				AppUpsRegistry: conf.UplinkStorage.Registry,
+			}))
+		}
+		if conf.Webhooks.Registry != nil {
+			cleaners = append(cleaners, &web.RegistryCleaner{WebRegistry: conf.Webhooks.Registry})
+			reconcilers = append(reconcilers, cleanup.ApplicationSetReconciler(&web.RegistryCleaner{WebRegistry: conf.Webhooks.Registry}))
+		}
+		if conf.PubSub.Registry != nil {
+			cleaners = append(cleaners, &pubsub.RegistryCleaner{PubSubRegistry: conf.PubSub.Registry})
+			reconcilers = append(reconcilers, cleanup.ApplicationSetReconciler(&pubsub.RegistryCleaner{PubSubRegistry: conf.PubSub.Registry}))
+		}
+		if conf.Packages.Registry != nil {
+			cleaners = append(cleaners, &packages.RegistryCleaner{ApplicationPackagesRegistry: conf.Packages.Registry})
+			reconcilers = append(reconcilers, cleanup.DeviceAndApplicationSetReconciler(&packages.RegistryCleaner{ApplicationPackagesRegistry: conf.Packages.Registry}))
+		}
+		as.RegisterTask(&component.TaskConfig{
+			Context: as.Context(),
+			ID:      "cleanup_purged_applications",
+			Func:    cleanup.NewPurgedApplicationsTask(cleaners...),
+			Restart: component.TaskRestartOnFailure,
+			Backoff: component.DefaultTaskBackoffConfig,
+		})
+		if conf.CleanupReconcileInterval > 0 {
+			as.RegisterTask(&component.TaskConfig{
+				Context: as.Context(),
+				ID:      "cleanup_reconcile",
+				Func:    cleanup.NewReconcileTask(conf.CleanupReconcileInterval, cleanup.ClusterIdentityServerSets(as), reconcilers...),
+				Restart: component.TaskRestartOnFailure,
+				Backoff: component.DefaultTaskBackoffConfig,
+			})
+		}
+	}
+
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsAs", cluster.HookName, c.ClusterAuthUnaryHook())
 
 	c.RegisterGRPC(as)
@@ -268,6 +348,12 @@ func (as *ApplicationServer) RegisterServices(s *grpc.Server) {
 	if as.pubsub != nil {
 		ttnpb.RegisterApplicationPubSubRegistryServer(s, as.pubsub)
 	}
+	if as.downlinkStatus != nil {
+		ttnpb.RegisterAsDownlinkStatusRegistryServer(s, downlinkstatus.NewRegistryRPC(as.downlinkStatus.Registry()))
+	}
+	if as.deferredDownlinks != nil {
+		ttnpb.RegisterAsDeferredDownlinkRegistryServer(s, deferred.NewRegistryRPC(as.deferredDownlinks))
+	}
 }
 
 // RegisterHandlers registers gRPC handlers.
@@ -281,6 +367,12 @@ func (as *ApplicationServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.Cl
 	if as.pubsub != nil {
 		ttnpb.RegisterApplicationPubSubRegistryHandler(as.Context(), s, conn)
 	}
+	if as.downlinkStatus != nil {
+		ttnpb.RegisterAsDownlinkStatusRegistryHandler(as.Context(), s, conn)
+	}
+	if as.deferredDownlinks != nil {
+		ttnpb.RegisterAsDeferredDownlinkRegistryHandler(as.Context(), s, conn)
+	}
 }
 
 // Roles returns the roles that the Application Server fulfills.
@@ -291,16 +383,16 @@ func (as *ApplicationServer) Roles() []ttnpb.ClusterRole {
 // Subscribe subscribes an application or integration by its identifiers to the Application Server, and returns a
 // Subscription for traffic and control. If the cluster parameter is true, the subscription receives all of the
 // traffic of the application. Otherwise, only traffic that was processed locally is sent.
-func (as *ApplicationServer) Subscribe(ctx context.Context, protocol string, ids *ttnpb.ApplicationIdentifiers, cluster bool) (*io.Subscription, error) {
+func (as *ApplicationServer) Subscribe(ctx context.Context, protocol string, ids *ttnpb.ApplicationIdentifiers, cluster bool, opts ...io.SubscriptionOption) (*io.Subscription, error) {
 	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("as:conn:%s", events.NewCorrelationID()))
 	if ids != nil {
 		uid := unique.ID(ctx, ids)
 		ctx = log.NewContextWithField(ctx, "application_uid", uid)
 	}
 	if cluster {
-		return as.clusterDistributor.Subscribe(ctx, protocol, ids)
+		return as.clusterDistributor.Subscribe(ctx, protocol, ids, opts...)
 	}
-	return as.localDistributor.Subscribe(ctx, protocol, ids)
+	return as.localDistributor.Subscribe(ctx, protocol, ids, opts...)
 }
 
 // Publish processes the given upstream message and then publishes it to the application frontends.
@@ -551,6 +643,9 @@ func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids ttnpb.EndD
 	}
 	for _, item := range items {
 		item.CorrelationIds = append(item.CorrelationIds, events.CorrelationIDsFromContext(ctx)...)
+		if _, ok := downlinkstatus.CorrelationID(item); !ok {
+			item.CorrelationIds = append(item.CorrelationIds, downlinkstatus.NewCorrelationID())
+		}
 	}
 	registerReceiveDownlinks(ctx, ids, items)
 	_, err = as.deviceRegistry.Set(ctx, ids,
@@ -577,12 +672,20 @@ func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids ttnpb.EndD
 	)
 	if err != nil {
 		as.registerDropDownlinks(ctx, ids, items, err)
+		as.trackDownlinks(ctx, ids, items, downlinkstatus.StateFailed, err)
 		return err
 	}
 	as.registerForwardDownlinks(ctx, ids, items, peer.Name())
+	as.trackDownlinks(ctx, ids, items, downlinkstatus.StateQueued, nil)
 	return nil
 }
 
+func (as *ApplicationServer) trackDownlinks(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink, state downlinkstatus.State, cause error) {
+	if err := as.downlinkStatus.Track(ctx, ids, items, state, cause); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to track downlink status")
+	}
+}
+
 // DownlinkQueuePush pushes the given downlink messages to the end device's application downlink queue.
 // This operation changes FRMPayload in the given items.
 func (as *ApplicationServer) DownlinkQueuePush(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
@@ -699,6 +802,9 @@ func (as *ApplicationServer) handleUp(ctx context.Context, up *ttnpb.Application
 	if up.Simulated {
 		return true, as.handleSimulatedUp(ctx, up, link)
 	}
+	if err := as.downlinkStatus.TrackUp(ctx, up); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to track downlink status")
+	}
 	switch p := up.Up.(type) {
 	case *ttnpb.ApplicationUp_JoinAccept:
 		return true, as.handleJoinAccept(ctx, up.EndDeviceIdentifiers, p.JoinAccept, link)
@@ -79,3 +79,11 @@ func (cleaner *RegistryCleaner) CleanData(ctx context.Context, isSet map[string]
 	}
 	return nil
 }
+
+// DeleteApplicationData deletes registry device data of all devices of the applications in the application list.
+func (cleaner *RegistryCleaner) DeleteApplicationData(ctx context.Context, applicationList []string) error {
+	if err := cleaner.RangeToLocalSet(ctx); err != nil {
+		return err
+	}
+	return cleaner.DeleteDeviceData(ctx, cleanup.ComputeDevicesOfApplications(ctx, cleaner.LocalSet, applicationList))
+}
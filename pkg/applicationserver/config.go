@@ -19,7 +19,9 @@ import (
 	"time"
 
 	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/deferred"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/downlinkstatus"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
 	loraclouddevicemanagementv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/loradms/v1"
@@ -72,19 +74,23 @@ type FormattersConfig struct {
 
 // Config represents the ApplicationServer configuration.
 type Config struct {
-	LinkMode         string                    `name:"link-mode" description:"Deprecated - mode to link applications to their Network Server (all, explicit)"`
-	Devices          DeviceRegistry            `name:"-"`
-	Links            LinkRegistry              `name:"-"`
-	UplinkStorage    UplinkStorageConfig       `name:"uplink-storage" description:"Application uplinks storage configuration"`
-	Formatters       FormattersConfig          `name:"formatters" description:"Payload formatters configuration"`
-	Distribution     DistributionConfig        `name:"distribution" description:"Distribution configuration"`
-	EndDeviceFetcher EndDeviceFetcherConfig    `name:"fetcher" description:"End Device fetcher configuration"`
-	MQTT             config.MQTT               `name:"mqtt" description:"MQTT configuration"`
-	Webhooks         WebhooksConfig            `name:"webhooks" description:"Webhooks configuration"`
-	PubSub           PubSubConfig              `name:"pubsub" description:"Pub/sub messaging configuration"`
-	Packages         ApplicationPackagesConfig `name:"packages" description:"Application packages configuration"`
-	Interop          InteropConfig             `name:"interop" description:"Interop client configuration"`
-	DeviceKEKLabel   string                    `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
+	LinkMode                  string                    `name:"link-mode" description:"Deprecated - mode to link applications to their Network Server (all, explicit)"`
+	Devices                   DeviceRegistry            `name:"-"`
+	Links                     LinkRegistry              `name:"-"`
+	UplinkStorage             UplinkStorageConfig       `name:"uplink-storage" description:"Application uplinks storage configuration"`
+	Formatters                FormattersConfig          `name:"formatters" description:"Payload formatters configuration"`
+	Distribution              DistributionConfig        `name:"distribution" description:"Distribution configuration"`
+	DeferredDownlinks         DeferredDownlinksConfig   `name:"deferred-downlinks" description:"Deferred downlink queue configuration"`
+	DownlinkStatus            DownlinkStatusConfig      `name:"downlink-status" description:"Downlink delivery status tracking configuration"`
+	EndDeviceFetcher          EndDeviceFetcherConfig    `name:"fetcher" description:"End Device fetcher configuration"`
+	MQTT                      config.MQTT               `name:"mqtt" description:"MQTT configuration"`
+	Webhooks                  WebhooksConfig            `name:"webhooks" description:"Webhooks configuration"`
+	PubSub                    PubSubConfig              `name:"pubsub" description:"Pub/sub messaging configuration"`
+	Packages                  ApplicationPackagesConfig `name:"packages" description:"Application packages configuration"`
+	Interop                   InteropConfig             `name:"interop" description:"Interop client configuration"`
+	DeviceKEKLabel            string                    `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
+	CleanupPurgedApplications bool                      `name:"cleanup-purged-applications" description:"Delete the devices, integrations and application packages of applications that are purged from the Identity Server"`
+	CleanupReconcileInterval  time.Duration             `name:"cleanup-reconcile-interval" description:"Interval at which the data of applications and devices that no longer exist in the Identity Server is deleted, if cleanup of purged applications is enabled (0 is disabled)"`
 }
 
 func (c Config) toProto() *ttnpb.AsConfiguration {
@@ -104,6 +110,18 @@ type UplinkStorageConfig struct {
 	Limit    int64                     `name:"limit" description:"Number of application uplinks to be stored"`
 }
 
+// DeferredDownlinksConfig defines the configuration of the deferred downlink queue.
+type DeferredDownlinksConfig struct {
+	Registry deferred.Registry `name:"-"`
+	Interval time.Duration     `name:"interval" description:"Interval at which due deferred downlinks are pushed to the Network Server"`
+}
+
+// DownlinkStatusConfig defines the configuration of the downlink delivery status tracking.
+type DownlinkStatusConfig struct {
+	Registry downlinkstatus.Registry `name:"-"`
+	TTL      time.Duration           `name:"ttl" description:"Duration for which the delivery status of downlink messages is retained"`
+}
+
 // WebhooksConfig defines the configuration of the webhooks integration.
 type WebhooksConfig struct {
 	Registry  web.WebhookRegistry `name:"-"`
@@ -0,0 +1,65 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deferred implements a queue of application downlink messages that are pushed to the downlink queue of
+// the end device in the Network Server once they are due.
+package deferred
+
+import (
+	"crypto/rand"
+	"time"
+
+	ulid "github.com/oklog/ulid/v2"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errNoNotBefore = errors.DefineInvalidArgument("no_not_before", "no `not_before` time")
+	errExpiresAt   = errors.DefineInvalidArgument("expires_at", "`expires_at` must be after `not_before`")
+	errNoPayload   = errors.DefineInvalidArgument("no_payload", "no `frm_payload` or `decoded_payload` in downlink `{index}`")
+)
+
+// NewID returns a new unique identifier for a deferred downlink.
+func NewID(now time.Time) (string, error) {
+	id, err := ulid.New(ulid.Timestamp(now), rand.Reader)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+// Validate returns an error if the deferred downlink is invalid.
+func Validate(down *ttnpb.ApplicationDeferredDownlink) error {
+	if err := down.ValidateFields(); err != nil {
+		return err
+	}
+	for i, item := range down.Downlinks {
+		if len(item.FrmPayload) == 0 && item.DecodedPayload == nil {
+			return errNoPayload.WithAttributes("index", i)
+		}
+	}
+	if down.NotBefore.IsZero() {
+		return errNoNotBefore.New()
+	}
+	if down.ExpiresAt != nil && !down.ExpiresAt.After(down.NotBefore) {
+		return errExpiresAt.New()
+	}
+	return nil
+}
+
+// Expired returns true if the deferred downlink is expired at the given time.
+func Expired(down *ttnpb.ApplicationDeferredDownlink, now time.Time) bool {
+	return down.ExpiresAt != nil && now.After(*down.ExpiresAt)
+}
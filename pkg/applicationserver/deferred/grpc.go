@@ -0,0 +1,114 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deferred
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/downlinkstatus"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type registryRPC struct {
+	registry Registry
+}
+
+// NewRegistryRPC returns a new deferred downlink registry gRPC service.
+func NewRegistryRPC(registry Registry) ttnpb.AsDeferredDownlinkRegistryServer {
+	return &registryRPC{
+		registry: registry,
+	}
+}
+
+// AddDeferredDownlink implements ttnpb.AsDeferredDownlinkRegistryServer.
+func (r *registryRPC) AddDeferredDownlink(ctx context.Context, req *ttnpb.AddApplicationDeferredDownlinkRequest) (*ttnpb.ApplicationDeferredDownlink, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	id, err := NewID(now)
+	if err != nil {
+		return nil, err
+	}
+	// The correlation IDs are assigned when the deferred downlink is added, so that the delivery status of the
+	// downlink messages can also be tracked when the deferred downlink expires before it is pushed.
+	for _, item := range req.Downlinks {
+		if _, ok := downlinkstatus.CorrelationID(item); !ok {
+			item.CorrelationIds = append(item.CorrelationIds, downlinkstatus.NewCorrelationID())
+		}
+	}
+	down := &ttnpb.ApplicationDeferredDownlink{
+		Id:           id,
+		EndDeviceIds: req.EndDeviceIdentifiers,
+		Downlinks:    req.Downlinks,
+		NotBefore:    req.NotBefore,
+		ExpiresAt:    req.ExpiresAt,
+		CreatedAt:    now.UTC(),
+	}
+	if err := Validate(down); err != nil {
+		return nil, err
+	}
+	if err := r.registry.Add(ctx, down); err != nil {
+		return nil, err
+	}
+	events.Publish(evtSchedule.NewWithIdentifiersAndData(ctx, &req.EndDeviceIdentifiers, &ttnpb.ApplicationDownlinks{
+		Downlinks: down.Downlinks,
+	}))
+	return down, nil
+}
+
+// ListDeferredDownlinks implements ttnpb.AsDeferredDownlinkRegistryServer.
+func (r *registryRPC) ListDeferredDownlinks(ctx context.Context, req *ttnpb.ListApplicationDeferredDownlinksRequest) (res *ttnpb.ApplicationDeferredDownlinks, err error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	var total int64
+	ctx = r.registry.WithPagination(ctx, req.Limit, req.Page, &total)
+	defer func() {
+		if err == nil {
+			setTotalHeader(ctx, total)
+		}
+	}()
+	downs, err := r.registry.List(ctx, req.EndDeviceIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ApplicationDeferredDownlinks{
+		DeferredDownlinks: downs,
+	}, nil
+}
+
+// DeleteDeferredDownlink implements ttnpb.AsDeferredDownlinkRegistryServer.
+func (r *registryRPC) DeleteDeferredDownlink(ctx context.Context, req *ttnpb.DeleteApplicationDeferredDownlinkRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+		return nil, err
+	}
+	if err := r.registry.Delete(ctx, req.EndDeviceIdentifiers, req.Id); err != nil {
+		return nil, err
+	}
+	events.Publish(evtDelete.NewWithIdentifiersAndData(ctx, &req.EndDeviceIdentifiers, nil))
+	return ttnpb.Empty, nil
+}
+
+func setTotalHeader(ctx context.Context, total int64) {
+	grpc.SetHeader(ctx, metadata.Pairs("x-total-count", strconv.FormatInt(total, 10)))
+}
@@ -0,0 +1,267 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the deferred downlink registry using Redis.
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/deferred"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errNotFound           = errors.DefineNotFound("deferred_downlink_not_found", "deferred downlink `{id}` not found")
+)
+
+// DeferredDownlinkRegistry is a Redis deferred downlink registry.
+//
+// The deferred downlinks are stored as protos. The keys of the deferred downlinks are indexed per end device,
+// and in a global sorted set of which the score is the time at which the deferred downlink is due.
+type DeferredDownlinkRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *DeferredDownlinkRegistry) dueKey() string {
+	return r.Redis.Key("due")
+}
+
+func (r *DeferredDownlinkRegistry) deviceKey(devUID string) string {
+	return r.Redis.Key("uid", devUID)
+}
+
+func (r *DeferredDownlinkRegistry) downlinkKey(devUID, id string) string {
+	return r.Redis.Key("uid", devUID, id)
+}
+
+func score(t time.Time) float64 {
+	return float64(t.UnixNano() / int64(time.Millisecond))
+}
+
+// Add implements deferred.Registry.
+func (r *DeferredDownlinkRegistry) Add(ctx context.Context, down *ttnpb.ApplicationDeferredDownlink) error {
+	if err := down.EndDeviceIds.ValidateContext(ctx); err != nil {
+		return errInvalidIdentifiers.WithCause(err)
+	}
+	if err := deferred.Validate(down); err != nil {
+		return err
+	}
+	s, err := ttnredis.MarshalProto(down)
+	if err != nil {
+		return err
+	}
+	devUID := unique.ID(ctx, down.EndDeviceIds)
+	k := r.downlinkKey(devUID, down.Id)
+	due := score(down.NotBefore)
+	_, err = r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.Set(ctx, k, s, 0)
+		p.ZAdd(ctx, r.deviceKey(devUID), &redis.Z{Score: due, Member: down.Id})
+		p.ZAdd(ctx, r.dueKey(), &redis.Z{Score: due, Member: k})
+		return nil
+	})
+	return ttnredis.ConvertError(err)
+}
+
+// List implements deferred.Registry.
+func (r *DeferredDownlinkRegistry) List(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDeferredDownlink, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, errInvalidIdentifiers.WithCause(err)
+	}
+	devUID := unique.ID(ctx, ids)
+	devKey := r.deviceKey(devUID)
+	start, stop := int64(0), int64(-1)
+	if limit, offset := ttnredis.PaginationLimitAndOffsetFromContext(ctx); limit != 0 {
+		total, err := r.Redis.ZCard(ctx, devKey).Result()
+		if err != nil {
+			return nil, ttnredis.ConvertError(err)
+		}
+		ttnredis.SetPaginationTotal(ctx, total)
+		start, stop = offset, offset+limit-1
+	}
+	downIDs, err := r.Redis.ZRange(ctx, devKey, start, stop).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	if len(downIDs) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(downIDs))
+	for _, id := range downIDs {
+		keys = append(keys, r.downlinkKey(devUID, id))
+	}
+	vals, err := r.Redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	res := make([]*ttnpb.ApplicationDeferredDownlink, 0, len(vals))
+	for _, val := range vals {
+		s, ok := val.(string)
+		if !ok {
+			continue
+		}
+		down := &ttnpb.ApplicationDeferredDownlink{}
+		if err := ttnredis.UnmarshalProto(s, down); err != nil {
+			return nil, err
+		}
+		res = append(res, down)
+	}
+	return res, nil
+}
+
+// Delete implements deferred.Registry.
+func (r *DeferredDownlinkRegistry) Delete(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, id string) error {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return errInvalidIdentifiers.WithCause(err)
+	}
+	devUID := unique.ID(ctx, ids)
+	k := r.downlinkKey(devUID, id)
+	var del *redis.IntCmd
+	_, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.ZRem(ctx, r.deviceKey(devUID), id)
+		p.ZRem(ctx, r.dueKey(), k)
+		del = p.Del(ctx, k)
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	if del.Val() == 0 {
+		return errNotFound.WithAttributes("id", id)
+	}
+	return nil
+}
+
+// Clear implements deferred.Registry.
+func (r *DeferredDownlinkRegistry) Clear(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) error {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return errInvalidIdentifiers.WithCause(err)
+	}
+	devUID := unique.ID(ctx, ids)
+	devKey := r.deviceKey(devUID)
+	downIDs, err := r.Redis.ZRange(ctx, devKey, 0, -1).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	_, err = r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		for _, id := range downIDs {
+			k := r.downlinkKey(devUID, id)
+			p.ZRem(ctx, r.dueKey(), k)
+			p.Del(ctx, k)
+		}
+		p.Del(ctx, devKey)
+		return nil
+	})
+	return ttnredis.ConvertError(err)
+}
+
+// Claim implements deferred.Registry.
+// Claim is safe for concurrent use by multiple Application Server instances; a deferred downlink is only claimed
+// by one instance until the claim expires.
+func (r *DeferredDownlinkRegistry) Claim(ctx context.Context, now, until time.Time) (*ttnpb.ApplicationDeferredDownlink, error) {
+	for {
+		var down *ttnpb.ApplicationDeferredDownlink
+		retry := false
+		err := r.Redis.Watch(ctx, func(tx *redis.Tx) error {
+			keys, err := tx.ZRangeByScore(ctx, r.dueKey(), &redis.ZRangeBy{
+				Min:   "-inf",
+				Max:   strconv.FormatFloat(score(now), 'f', -1, 64),
+				Count: 1,
+			}).Result()
+			if err != nil {
+				return err
+			}
+			if len(keys) == 0 {
+				return nil
+			}
+			k := keys[0]
+			val, err := tx.Get(ctx, k).Result()
+			if err != nil && err != redis.Nil {
+				return err
+			}
+			if err == redis.Nil {
+				// The deferred downlink has been removed, but the due index has not.
+				retry = true
+				_, err := tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+					p.ZRem(ctx, r.dueKey(), k)
+					return nil
+				})
+				return err
+			}
+			down = &ttnpb.ApplicationDeferredDownlink{}
+			if err := ttnredis.UnmarshalProto(val, down); err != nil {
+				return err
+			}
+			_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+				p.ZAdd(ctx, r.dueKey(), &redis.Z{Score: score(until), Member: k})
+				return nil
+			})
+			return err
+		}, r.dueKey())
+		if err == redis.TxFailedErr {
+			// Another instance claimed or modified a deferred downlink concurrently.
+			continue
+		}
+		if err != nil {
+			return nil, ttnredis.ConvertError(err)
+		}
+		if retry {
+			continue
+		}
+		return down, nil
+	}
+}
+
+// Reschedule implements deferred.Registry.
+// The deferred downlink is reordered in the index of the end device and in the due index in the same transaction.
+func (r *DeferredDownlinkRegistry) Reschedule(ctx context.Context, down *ttnpb.ApplicationDeferredDownlink, at time.Time) error {
+	if err := down.EndDeviceIds.ValidateContext(ctx); err != nil {
+		return errInvalidIdentifiers.WithCause(err)
+	}
+	s, err := ttnredis.MarshalProto(down)
+	if err != nil {
+		return err
+	}
+	devUID := unique.ID(ctx, down.EndDeviceIds)
+	k := r.downlinkKey(devUID, down.Id)
+	err = r.Redis.Watch(ctx, func(tx *redis.Tx) error {
+		n, err := tx.Exists(ctx, k).Result()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return errNotFound.WithAttributes("id", down.Id)
+		}
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			p.Set(ctx, k, s, 0)
+			p.ZAdd(ctx, r.deviceKey(devUID), &redis.Z{Score: score(at), Member: down.Id})
+			p.ZAdd(ctx, r.dueKey(), &redis.Z{Score: score(at), Member: k})
+			return nil
+		})
+		return err
+	}, k)
+	return ttnredis.ConvertError(err)
+}
+
+// WithPagination implements deferred.Registry.
+func (r *DeferredDownlinkRegistry) WithPagination(ctx context.Context, limit, page uint32, total *int64) context.Context {
+	return ttnredis.NewContextWithPagination(ctx, int64(limit), int64(page), total)
+}
@@ -0,0 +1,131 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/deferred/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// assertDeferredDownlinks asserts that the actual deferred downlinks equal the expected deferred downlinks, in order.
+// The deferred downlinks are compared one by one, since the stored protos are unmarshaled.
+func assertDeferredDownlinks(a *assertions.Assertion, actual []*ttnpb.ApplicationDeferredDownlink, expected ...*ttnpb.ApplicationDeferredDownlink) {
+	if !a.So(actual, should.HaveLength, len(expected)) {
+		return
+	}
+	for i, down := range actual {
+		a.So(down, should.Resemble, expected[i])
+	}
+}
+
+func TestDeferredDownlinkRegistry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.ContextWithTB(test.Context(), t)
+
+	cl, flush := test.NewRedis(ctx, "deferred_redis_test")
+	defer flush()
+	defer cl.Close()
+	registry := &DeferredDownlinkRegistry{Redis: cl}
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationId: "foo-app",
+		},
+		DeviceId: "foo-device",
+	}
+	now := time.Unix(1600000000, 0).UTC()
+	newDownlink := func(id string, notBefore time.Time) *ttnpb.ApplicationDeferredDownlink {
+		return &ttnpb.ApplicationDeferredDownlink{
+			Id:           id,
+			EndDeviceIds: ids,
+			Downlinks:    []*ttnpb.ApplicationDownlink{{FPort: 1, FrmPayload: []byte{0x01}}},
+			NotBefore:    notBefore,
+			CreatedAt:    now,
+		}
+	}
+
+	first, second, third := newDownlink("first", now.Add(time.Minute)), newDownlink("second", now.Add(2*time.Minute)), newDownlink("third", now.Add(3*time.Minute))
+	for _, down := range []*ttnpb.ApplicationDeferredDownlink{second, first, third} {
+		if !a.So(registry.Add(ctx, down), should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	downs, err := registry.List(ctx, ids)
+	a.So(err, should.BeNil)
+	assertDeferredDownlinks(a, downs, first, second, third)
+
+	var total int64
+	downs, err = registry.List(registry.WithPagination(ctx, 2, 2, &total), ids)
+	a.So(err, should.BeNil)
+	assertDeferredDownlinks(a, downs, third)
+	a.So(total, should.Equal, 3)
+
+	a.So(registry.Delete(ctx, ids, "second"), should.BeNil)
+	err = registry.Delete(ctx, ids, "second")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	down, err := registry.Claim(ctx, now, now.Add(time.Minute))
+	a.So(err, should.BeNil)
+	a.So(down, should.BeNil)
+
+	down, err = registry.Claim(ctx, now.Add(5*time.Minute), now.Add(10*time.Minute))
+	a.So(err, should.BeNil)
+	a.So(down, should.Resemble, first)
+
+	// The claimed deferred downlink is postponed, but remains in the registry.
+	down, err = registry.Claim(ctx, now.Add(5*time.Minute), now.Add(10*time.Minute))
+	a.So(err, should.BeNil)
+	a.So(down, should.Resemble, third)
+	down, err = registry.Claim(ctx, now.Add(5*time.Minute), now.Add(10*time.Minute))
+	a.So(err, should.BeNil)
+	a.So(down, should.BeNil)
+
+	downs, err = registry.List(ctx, ids)
+	a.So(err, should.BeNil)
+	assertDeferredDownlinks(a, downs, first, third)
+
+	first.Attempts = 1
+	a.So(registry.Reschedule(ctx, first, now.Add(20*time.Minute)), should.BeNil)
+	// The rescheduled deferred downlink is reordered in the deferred downlinks of the end device.
+	downs, err = registry.List(ctx, ids)
+	a.So(err, should.BeNil)
+	assertDeferredDownlinks(a, downs, third, first)
+	down, err = registry.Claim(ctx, now.Add(15*time.Minute), now.Add(30*time.Minute))
+	a.So(err, should.BeNil)
+	a.So(down, should.Resemble, third)
+	down, err = registry.Claim(ctx, now.Add(20*time.Minute), now.Add(30*time.Minute))
+	a.So(err, should.BeNil)
+	a.So(down, should.Resemble, first)
+
+	a.So(registry.Delete(ctx, ids, "first"), should.BeNil)
+	err = registry.Reschedule(ctx, first, now.Add(40*time.Minute))
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	a.So(registry.Clear(ctx, ids), should.BeNil)
+	down, err = registry.Claim(ctx, now.Add(time.Hour), now.Add(2*time.Hour))
+	a.So(err, should.BeNil)
+	a.So(down, should.BeNil)
+	downs, err = registry.List(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(downs, should.BeEmpty)
+}
@@ -0,0 +1,43 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deferred
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Registry is a store for deferred downlinks.
+type Registry interface {
+	// Add adds the deferred downlink to the queue of the end device.
+	Add(ctx context.Context, down *ttnpb.ApplicationDeferredDownlink) error
+	// List returns the deferred downlinks of the end device, ordered by the time they are due.
+	List(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDeferredDownlink, error)
+	// Delete removes the deferred downlink from the queue of the end device.
+	Delete(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, id string) error
+	// Clear removes all deferred downlinks of the end device.
+	Clear(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) error
+	// Claim returns the deferred downlink that has been due the longest at the given time, and postpones it until
+	// the given time, such that it is not claimed again until then. The deferred downlink remains in the registry
+	// until it is deleted. If no deferred downlink is due, nil is returned.
+	Claim(ctx context.Context, now, until time.Time) (*ttnpb.ApplicationDeferredDownlink, error)
+	// Reschedule stores the deferred downlink and makes it due at the given time.
+	// If the deferred downlink does not exist anymore, a NotFound error is returned.
+	Reschedule(ctx context.Context, down *ttnpb.ApplicationDeferredDownlink, at time.Time) error
+	// WithPagination adds the pagination information to the context.
+	WithPagination(ctx context.Context, limit, page uint32, total *int64) context.Context
+}
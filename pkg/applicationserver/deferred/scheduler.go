@@ -0,0 +1,213 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deferred
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/downlinkstatus"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	evtSchedule = events.Define(
+		"as.down.deferred.schedule", "schedule deferred downlink",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.ApplicationDownlinks{}),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtDelete = events.Define(
+		"as.down.deferred.delete", "delete deferred downlink",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtPush = events.Define(
+		"as.down.deferred.push", "push deferred downlink",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.ApplicationDownlinks{}),
+	)
+	evtPushFail = events.Define(
+		"as.down.deferred.push.fail", "push deferred downlink failure",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithErrorDataType(),
+	)
+	evtDrop = events.Define(
+		"as.down.deferred.drop", "drop deferred downlink",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithErrorDataType(),
+	)
+	evtExpire = events.Define(
+		"as.down.deferred.expire", "expire deferred downlink",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.ApplicationDownlinks{}),
+	)
+)
+
+// Pusher pushes downlink messages to the downlink queue of end devices.
+type Pusher interface {
+	DownlinkQueuePush(context.Context, ttnpb.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink) error
+}
+
+// Scheduler pushes the deferred downlinks to the downlink queue of the end devices once they are due.
+type Scheduler struct {
+	registry Registry
+	pusher   Pusher
+	tracker  *downlinkstatus.Tracker
+	interval time.Duration
+}
+
+// DefaultInterval is the default interval at which the Scheduler checks for due deferred downlinks.
+const DefaultInterval = 5 * time.Second
+
+// NewScheduler returns a new Scheduler which checks for due deferred downlinks at the given interval.
+// The tracker records the downlink messages of deferred downlinks that expire, and may be nil.
+// If the interval is not positive, DefaultInterval is used.
+func NewScheduler(registry Registry, pusher Pusher, tracker *downlinkstatus.Tracker, interval time.Duration) *Scheduler {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Scheduler{
+		registry: registry,
+		pusher:   pusher,
+		tracker:  tracker,
+		interval: interval,
+	}
+}
+
+// Run processes the due deferred downlinks until the context is done.
+func (s *Scheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.ProcessDue(ctx, time.Now()); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// ClaimTimeout is the time after which a deferred downlink that is being pushed is claimed again, if the
+// Application Server that claimed it did not push or reschedule it by then.
+const ClaimTimeout = time.Minute
+
+// MaxRetryBackoff is the maximum time between attempts to push a deferred downlink.
+const MaxRetryBackoff = time.Hour
+
+// ProcessDue pushes all deferred downlinks that are due at the given time.
+// Deferred downlinks that are expired at the given time are dropped.
+// Deferred downlinks that fail to be pushed are retried with exponential backoff until they expire, unless the
+// error is permanent, in which case they are dropped.
+func (s *Scheduler) ProcessDue(ctx context.Context, now time.Time) error {
+	for {
+		down, err := s.registry.Claim(ctx, now, now.Add(ClaimTimeout))
+		if err != nil {
+			return err
+		}
+		if down == nil {
+			return nil
+		}
+		if err := s.process(ctx, now, down); err != nil {
+			return err
+		}
+	}
+}
+
+// retryBackoff returns the time to wait before the given attempt to push a deferred downlink.
+func (s *Scheduler) retryBackoff(attempts uint32) time.Duration {
+	backoff := s.interval
+	for i := uint32(1); i < attempts && backoff < MaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > MaxRetryBackoff {
+		backoff = MaxRetryBackoff
+	}
+	return backoff
+}
+
+// isPermanentError returns whether the push error cannot be resolved by retrying, i.e. because the end device
+// is deleted or the downlink is invalid.
+func isPermanentError(err error) bool {
+	return errors.IsNotFound(err) || errors.IsInvalidArgument(err)
+}
+
+func (s *Scheduler) delete(ctx context.Context, down *ttnpb.ApplicationDeferredDownlink) error {
+	if err := s.registry.Delete(ctx, down.EndDeviceIds, down.Id); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// expire drops the expired deferred downlink and records its downlink messages as expired.
+func (s *Scheduler) expire(ctx context.Context, down *ttnpb.ApplicationDeferredDownlink) error {
+	logger := log.FromContext(ctx)
+	logger.Debug("Deferred downlink expired")
+	events.Publish(evtExpire.NewWithIdentifiersAndData(ctx, &down.EndDeviceIds, &ttnpb.ApplicationDownlinks{
+		Downlinks: down.Downlinks,
+	}))
+	if err := s.tracker.Track(ctx, down.EndDeviceIds, down.Downlinks, downlinkstatus.StateExpired, nil); err != nil {
+		logger.WithError(err).Warn("Failed to track downlink status")
+	}
+	return s.delete(ctx, down)
+}
+
+func (s *Scheduler) process(ctx context.Context, now time.Time, down *ttnpb.ApplicationDeferredDownlink) error {
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"application_id", down.EndDeviceIds.ApplicationId,
+		"device_id", down.EndDeviceIds.DeviceId,
+		"deferred_downlink_id", down.Id,
+	))
+	logger := log.FromContext(ctx)
+	if Expired(down, now) {
+		return s.expire(ctx, down)
+	}
+	if err := s.pusher.DownlinkQueuePush(ctx, down.EndDeviceIds, down.Downlinks); err != nil {
+		logger.WithError(err).Warn("Failed to push deferred downlink")
+		events.Publish(evtPushFail.NewWithIdentifiersAndData(ctx, &down.EndDeviceIds, err))
+		if isPermanentError(err) {
+			logger.Debug("Drop deferred downlink")
+			events.Publish(evtDrop.NewWithIdentifiersAndData(ctx, &down.EndDeviceIds, err))
+			return s.delete(ctx, down)
+		}
+		down.Attempts++
+		at := now.Add(s.retryBackoff(down.Attempts))
+		if down.ExpiresAt != nil && at.After(*down.ExpiresAt) {
+			// Make a last attempt when the deferred downlink expires.
+			at = *down.ExpiresAt
+			if !at.After(now) {
+				return s.expire(ctx, down)
+			}
+		}
+		logger.WithField("retry_at", at).Debug("Reschedule deferred downlink")
+		if err := s.registry.Reschedule(ctx, down, at); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+	logger.Debug("Pushed deferred downlink")
+	events.Publish(evtPush.NewWithIdentifiersAndData(ctx, &down.EndDeviceIds, &ttnpb.ApplicationDownlinks{
+		Downlinks: down.Downlinks,
+	}))
+	return s.delete(ctx, down)
+}
@@ -0,0 +1,456 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deferred_test
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/deferred"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/downlinkstatus"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var (
+	errNotFound        = errors.DefineNotFound("not_found", "not found")
+	errUnavailable     = errors.DefineUnavailable("unavailable", "unavailable")
+	errInvalidArgument = errors.DefineInvalidArgument("invalid_argument", "invalid argument")
+)
+
+type memEntry struct {
+	down *ttnpb.ApplicationDeferredDownlink
+	due  time.Time
+}
+
+type memRegistry struct {
+	mu      sync.Mutex
+	entries []*memEntry
+}
+
+func (r *memRegistry) sort() {
+	sort.SliceStable(r.entries, func(i, j int) bool { return r.entries[i].due.Before(r.entries[j].due) })
+}
+
+func (r *memRegistry) Add(_ context.Context, down *ttnpb.ApplicationDeferredDownlink) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, &memEntry{down: down, due: down.NotBefore})
+	r.sort()
+	return nil
+}
+
+func (r *memRegistry) List(_ context.Context, ids ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDeferredDownlink, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var res []*ttnpb.ApplicationDeferredDownlink
+	for _, e := range r.entries {
+		if e.down.EndDeviceIds.DeviceId == ids.DeviceId {
+			res = append(res, e.down)
+		}
+	}
+	return res, nil
+}
+
+func (r *memRegistry) Delete(_ context.Context, _ ttnpb.EndDeviceIdentifiers, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, e := range r.entries {
+		if e.down.Id == id {
+			r.entries = append(r.entries[:i], r.entries[i+1:]...)
+			return nil
+		}
+	}
+	return errNotFound.New()
+}
+
+func (r *memRegistry) Clear(_ context.Context, _ ttnpb.EndDeviceIdentifiers) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = nil
+	return nil
+}
+
+func (r *memRegistry) Claim(_ context.Context, now, until time.Time) (*ttnpb.ApplicationDeferredDownlink, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.entries) == 0 || r.entries[0].due.After(now) {
+		return nil, nil
+	}
+	e := r.entries[0]
+	e.due = until
+	r.sort()
+	return e.down, nil
+}
+
+func (r *memRegistry) Reschedule(_ context.Context, down *ttnpb.ApplicationDeferredDownlink, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, e := range r.entries {
+		if e.down.Id == down.Id {
+			e.down, e.due = down, at
+			r.sort()
+			return nil
+		}
+	}
+	return errNotFound.New()
+}
+
+func (r *memRegistry) WithPagination(ctx context.Context, _, _ uint32, _ *int64) context.Context {
+	return ctx
+}
+
+type memStatusRegistry struct {
+	mu       sync.Mutex
+	statuses map[string]*ttnpb.ApplicationDownlinkStatus
+}
+
+func (r *memStatusRegistry) Get(_ context.Context, _ ttnpb.EndDeviceIdentifiers, cid string) (*ttnpb.ApplicationDownlinkStatus, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.statuses[cid], nil
+}
+
+func (r *memStatusRegistry) List(context.Context, ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDownlinkStatus, error) {
+	panic("not implemented")
+}
+
+func (r *memStatusRegistry) Update(_ context.Context, _ ttnpb.EndDeviceIdentifiers, cid string, f func(*ttnpb.ApplicationDownlinkStatus) *ttnpb.ApplicationDownlinkStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.statuses == nil {
+		r.statuses = make(map[string]*ttnpb.ApplicationDownlinkStatus)
+	}
+	r.statuses[cid] = f(r.statuses[cid])
+	return nil
+}
+
+func (r *memStatusRegistry) WithPagination(ctx context.Context, _, _ uint32, _ *int64) context.Context {
+	return ctx
+}
+
+type pushFunc func(context.Context, ttnpb.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink) error
+
+func (f pushFunc) DownlinkQueuePush(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
+	return f(ctx, ids, items)
+}
+
+func TestScheduler(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationId: "foo-app",
+		},
+		DeviceId: "foo-device",
+	}
+	now := time.Unix(1600000000, 0).UTC()
+	expiresAt := now.Add(time.Minute)
+
+	registry := &memRegistry{}
+	for _, down := range []*ttnpb.ApplicationDeferredDownlink{
+		{
+			Id:           "due",
+			EndDeviceIds: ids,
+			Downlinks:    []*ttnpb.ApplicationDownlink{{FPort: 1, FrmPayload: []byte{0x01}}},
+			NotBefore:    now.Add(-time.Minute),
+		},
+		{
+			Id:           "expired",
+			EndDeviceIds: ids,
+			Downlinks:    []*ttnpb.ApplicationDownlink{{FPort: 2, FrmPayload: []byte{0x02}}},
+			NotBefore:    now.Add(-2 * time.Minute),
+			ExpiresAt:    &[]time.Time{now.Add(-time.Second)}[0],
+		},
+		{
+			Id:           "later",
+			EndDeviceIds: ids,
+			Downlinks:    []*ttnpb.ApplicationDownlink{{FPort: 3, FrmPayload: []byte{0x03}}},
+			NotBefore:    now.Add(time.Hour),
+			ExpiresAt:    &expiresAt,
+		},
+	} {
+		if !a.So(registry.Add(ctx, down), should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	var pushed []uint32
+	scheduler := deferred.NewScheduler(registry, pushFunc(func(_ context.Context, pushIDs ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
+		a.So(pushIDs, should.Resemble, ids)
+		for _, item := range items {
+			pushed = append(pushed, item.FPort)
+		}
+		return nil
+	}), nil, time.Second)
+
+	a.So(scheduler.ProcessDue(ctx, now), should.BeNil)
+	a.So(pushed, should.Resemble, []uint32{1})
+
+	remaining, err := registry.List(ctx, ids)
+	a.So(err, should.BeNil)
+	if a.So(remaining, should.HaveLength, 1) {
+		a.So(remaining[0].Id, should.Equal, "later")
+	}
+
+	// The last deferred downlink is expired by the time it is due.
+	a.So(scheduler.ProcessDue(ctx, now.Add(2*time.Hour)), should.BeNil)
+	a.So(pushed, should.Resemble, []uint32{1})
+	remaining, err = registry.List(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(remaining, should.BeEmpty)
+}
+
+func TestSchedulerRetry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationId: "foo-app",
+		},
+		DeviceId: "foo-device",
+	}
+	now := time.Unix(1600000000, 0).UTC()
+	expiresAt := now.Add(time.Minute)
+
+	registry := &memRegistry{}
+	if !a.So(registry.Add(ctx, &ttnpb.ApplicationDeferredDownlink{
+		Id:           "retry",
+		EndDeviceIds: ids,
+		Downlinks:    []*ttnpb.ApplicationDownlink{{FPort: 1, FrmPayload: []byte{0x01}}},
+		NotBefore:    now,
+		ExpiresAt:    &expiresAt,
+	}), should.BeNil) {
+		t.FailNow()
+	}
+
+	var (
+		attempts int
+		fail     = true
+	)
+	scheduler := deferred.NewScheduler(registry, pushFunc(func(context.Context, ttnpb.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink) error {
+		attempts++
+		if fail {
+			return errUnavailable.New()
+		}
+		return nil
+	}), nil, 10*time.Second)
+
+	// The failed deferred downlink is kept and retried with backoff.
+	a.So(scheduler.ProcessDue(ctx, now), should.BeNil)
+	a.So(attempts, should.Equal, 1)
+	remaining, err := registry.List(ctx, ids)
+	a.So(err, should.BeNil)
+	if a.So(remaining, should.HaveLength, 1) {
+		a.So(remaining[0].Attempts, should.Equal, uint32(1))
+	}
+	a.So(scheduler.ProcessDue(ctx, now.Add(5*time.Second)), should.BeNil)
+	a.So(attempts, should.Equal, 1)
+	a.So(scheduler.ProcessDue(ctx, now.Add(10*time.Second)), should.BeNil)
+	a.So(attempts, should.Equal, 2)
+	a.So(scheduler.ProcessDue(ctx, now.Add(20*time.Second)), should.BeNil)
+	a.So(attempts, should.Equal, 2)
+
+	// The successful deferred downlink is removed.
+	fail = false
+	a.So(scheduler.ProcessDue(ctx, now.Add(30*time.Second)), should.BeNil)
+	a.So(attempts, should.Equal, 3)
+	remaining, err = registry.List(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(remaining, should.BeEmpty)
+}
+
+func TestSchedulerPermanentError(t *testing.T) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationId: "foo-app",
+		},
+		DeviceId: "foo-device",
+	}
+	now := time.Unix(1600000000, 0).UTC()
+
+	for _, tc := range []struct {
+		Name string
+		Err  error
+	}{
+		{
+			Name: "NotFound",
+			Err:  errNotFound.New(),
+		},
+		{
+			Name: "InvalidArgument",
+			Err:  errInvalidArgument.New(),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := test.Context()
+
+			registry := &memRegistry{}
+			// The deferred downlink does not expire, so it would be retried forever.
+			if !a.So(registry.Add(ctx, &ttnpb.ApplicationDeferredDownlink{
+				Id:           "permanent",
+				EndDeviceIds: ids,
+				Downlinks:    []*ttnpb.ApplicationDownlink{{FPort: 1, FrmPayload: []byte{0x01}}},
+				NotBefore:    now,
+			}), should.BeNil) {
+				t.FailNow()
+			}
+
+			var attempts int
+			scheduler := deferred.NewScheduler(registry, pushFunc(func(context.Context, ttnpb.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink) error {
+				attempts++
+				return tc.Err
+			}), nil, 10*time.Second)
+
+			a.So(scheduler.ProcessDue(ctx, now), should.BeNil)
+			a.So(attempts, should.Equal, 1)
+			remaining, err := registry.List(ctx, ids)
+			a.So(err, should.BeNil)
+			a.So(remaining, should.BeEmpty)
+		})
+	}
+}
+
+func TestSchedulerTracksExpired(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationId: "foo-app",
+		},
+		DeviceId: "foo-device",
+	}
+	now := time.Unix(1600000000, 0).UTC()
+	expiresAt := now.Add(15 * time.Second)
+	expiredCID := downlinkstatus.CorrelationIDPrefix + "01F8MECHZX3TBDSZ7XRADM79XV"
+	retryCID := downlinkstatus.CorrelationIDPrefix + "01F8MECHZX3TBDSZ7XRADM79XW"
+
+	registry := &memRegistry{}
+	for _, down := range []*ttnpb.ApplicationDeferredDownlink{
+		{
+			Id:           "expired",
+			EndDeviceIds: ids,
+			Downlinks: []*ttnpb.ApplicationDownlink{
+				{FPort: 1, FrmPayload: []byte{0x01}, CorrelationIds: []string{expiredCID}},
+			},
+			NotBefore: now.Add(-time.Minute),
+			ExpiresAt: &[]time.Time{now.Add(-time.Second)}[0],
+		},
+		{
+			Id:           "retry",
+			EndDeviceIds: ids,
+			Downlinks: []*ttnpb.ApplicationDownlink{
+				{FPort: 2, FrmPayload: []byte{0x02}, CorrelationIds: []string{retryCID}},
+			},
+			NotBefore: now,
+			ExpiresAt: &expiresAt,
+		},
+	} {
+		if !a.So(registry.Add(ctx, down), should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	statuses := &memStatusRegistry{}
+	var attempts int
+	scheduler := deferred.NewScheduler(registry, pushFunc(func(context.Context, ttnpb.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink) error {
+		attempts++
+		return errUnavailable.New()
+	}), downlinkstatus.NewTracker(statuses), 10*time.Second)
+
+	// The deferred downlink that is expired when it is due is not pushed.
+	a.So(scheduler.ProcessDue(ctx, now), should.BeNil)
+	a.So(attempts, should.Equal, 1)
+	status, err := statuses.Get(ctx, ids, expiredCID)
+	if a.So(err, should.BeNil) && a.So(status, should.NotBeNil) {
+		a.So(status.State, should.Equal, downlinkstatus.StateExpired)
+	}
+	status, err = statuses.Get(ctx, ids, retryCID)
+	a.So(err, should.BeNil)
+	a.So(status, should.BeNil)
+
+	// The deferred downlink that fails to be pushed is retried once more when it expires.
+	a.So(scheduler.ProcessDue(ctx, now.Add(10*time.Second)), should.BeNil)
+	a.So(attempts, should.Equal, 2)
+	a.So(scheduler.ProcessDue(ctx, expiresAt), should.BeNil)
+	a.So(attempts, should.Equal, 3)
+	status, err = statuses.Get(ctx, ids, retryCID)
+	if a.So(err, should.BeNil) && a.So(status, should.NotBeNil) {
+		a.So(status.State, should.Equal, downlinkstatus.StateExpired)
+		a.So(status.FPort, should.Equal, uint32(2))
+	}
+	remaining, err := registry.List(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(remaining, should.BeEmpty)
+}
+
+func TestValidate(t *testing.T) {
+	a := assertions.New(t)
+
+	now := time.Unix(1600000000, 0).UTC()
+	expiresAt := now.Add(time.Hour)
+	down := &ttnpb.ApplicationDeferredDownlink{
+		Id: "01F8MECHZX3TBDSZ7XRADM79XV",
+		EndDeviceIds: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationId: "foo-app",
+			},
+			DeviceId: "foo-device",
+		},
+		Downlinks: []*ttnpb.ApplicationDownlink{
+			{
+				FPort:      42,
+				FrmPayload: []byte{0x01, 0x02},
+				Priority:   ttnpb.TxSchedulePriority_HIGH,
+				Confirmed:  true,
+			},
+		},
+		NotBefore: now,
+		ExpiresAt: &expiresAt,
+		CreatedAt: now.Add(-time.Hour),
+	}
+	a.So(deferred.Validate(down), should.BeNil)
+
+	invalid := *down
+	invalid.ExpiresAt = &invalid.CreatedAt
+	a.So(deferred.Validate(&invalid), should.NotBeNil)
+	invalid = *down
+	invalid.NotBefore = time.Time{}
+	invalid.ExpiresAt = nil
+	a.So(deferred.Validate(&invalid), should.NotBeNil)
+	invalid = *down
+	invalid.Downlinks = nil
+	a.So(deferred.Validate(&invalid), should.NotBeNil)
+	invalid = *down
+	invalid.Downlinks = []*ttnpb.ApplicationDownlink{{FPort: 0, FrmPayload: []byte{0x01}}}
+	a.So(deferred.Validate(&invalid), should.NotBeNil)
+	invalid.Downlinks = []*ttnpb.ApplicationDownlink{{FPort: 224, FrmPayload: []byte{0x01}}}
+	a.So(deferred.Validate(&invalid), should.NotBeNil)
+	invalid.Downlinks = []*ttnpb.ApplicationDownlink{{FPort: 1}}
+	a.So(deferred.Validate(&invalid), should.NotBeNil)
+
+	a.So(deferred.Expired(down, now.Add(time.Minute)), should.BeFalse)
+	a.So(deferred.Expired(down, now.Add(2*time.Hour)), should.BeTrue)
+}
@@ -34,7 +34,7 @@ type Distributor interface {
 	// Publish publishes traffic to the subscribers.
 	Publish(context.Context, *ttnpb.ApplicationUp) error
 	// Subscribe to the traffic of a specific application.
-	Subscribe(context.Context, string, *ttnpb.ApplicationIdentifiers) (*io.Subscription, error)
+	Subscribe(context.Context, string, *ttnpb.ApplicationIdentifiers, ...io.SubscriptionOption) (*io.Subscription, error)
 }
 
 // RequestDecoupler decouples the security information found in a context
@@ -42,3 +42,9 @@ type Distributor interface {
 type RequestDecoupler interface {
 	FromRequestContext(ctx context.Context) context.Context
 }
+
+// Router routes upstream traffic to the integrations of the applications.
+type Router interface {
+	// Route evaluates the routes of the upstream message, and returns the context in which the message is distributed.
+	Route(context.Context, *ttnpb.ApplicationUp) (context.Context, error)
+}
@@ -20,26 +20,39 @@ import (
 
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
 // NewLocalDistributor creates a Distributor that routes the traffic locally.
 // The underlying subscription sets can timeout if there are no active subscribers.
 // A timeout of 0 means the underlying subscriptions never timeout.
-func NewLocalDistributor(ctx context.Context, rd RequestDecoupler, timeout time.Duration, broadcastOpts []io.SubscriptionOption, mapOpts []io.SubscriptionOption) Distributor {
+// If the router is not nil, the routes of the traffic are evaluated once before the traffic is published.
+func NewLocalDistributor(ctx context.Context, rd RequestDecoupler, timeout time.Duration, broadcastOpts []io.SubscriptionOption, mapOpts []io.SubscriptionOption, router Router) Distributor {
 	return &localDistributor{
 		broadcast:     newSubscriptionSet(ctx, rd, 0, broadcastOpts...),
 		subscriptions: newSubscriptionMap(ctx, rd, timeout, noSetup, mapOpts...),
+		router:        router,
 	}
 }
 
 type localDistributor struct {
 	broadcast     *subscriptionSet
 	subscriptions *subscriptionMap
+	router        Router
 }
 
 // Publish publishes traffic to the underlying subscriptions.
 func (d *localDistributor) Publish(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	if d.router != nil {
+		routeCtx, err := d.router.Route(ctx, up)
+		if err != nil {
+			// Integrations with routing rules do not receive traffic that is not routed.
+			log.FromContext(ctx).WithError(err).Warn("Failed to route upstream message")
+		} else {
+			ctx = routeCtx
+		}
+	}
 	if err := d.broadcast.Publish(ctx, up); err != nil {
 		return err
 	}
@@ -54,13 +67,13 @@ func (d *localDistributor) Publish(ctx context.Context, up *ttnpb.ApplicationUp)
 
 // Subscribe creates a subscription in the associated subscription set. If the identifiers are nil,
 // the subscription receives all of the traffic sent to the Distributor.
-func (d *localDistributor) Subscribe(ctx context.Context, protocol string, ids *ttnpb.ApplicationIdentifiers) (*io.Subscription, error) {
+func (d *localDistributor) Subscribe(ctx context.Context, protocol string, ids *ttnpb.ApplicationIdentifiers, opts ...io.SubscriptionOption) (*io.Subscription, error) {
 	if ids == nil {
-		return d.broadcast.Subscribe(ctx, protocol, ids)
+		return d.broadcast.Subscribe(ctx, protocol, ids, opts...)
 	}
 	s, err := d.subscriptions.LoadOrCreate(ctx, *ids)
 	if err != nil {
 		return nil, err
 	}
-	return s.Subscribe(ctx, protocol, ids)
+	return s.Subscribe(ctx, protocol, ids, opts...)
 }
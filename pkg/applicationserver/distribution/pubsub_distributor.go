@@ -47,7 +47,7 @@ func (d *pubSubDistributor) Publish(ctx context.Context, up *ttnpb.ApplicationUp
 var errMissingIdentifiers = errors.DefineFailedPrecondition("missing_identifiers", "subscriptions without identifiers are not supported")
 
 // Subscribe creates a subscription in the associated subscription set.
-func (d *pubSubDistributor) Subscribe(ctx context.Context, protocol string, ids *ttnpb.ApplicationIdentifiers) (*io.Subscription, error) {
+func (d *pubSubDistributor) Subscribe(ctx context.Context, protocol string, ids *ttnpb.ApplicationIdentifiers, opts ...io.SubscriptionOption) (*io.Subscription, error) {
 	if ids == nil {
 		return nil, errMissingIdentifiers.New()
 	}
@@ -55,7 +55,7 @@ func (d *pubSubDistributor) Subscribe(ctx context.Context, protocol string, ids
 	if err != nil {
 		return nil, err
 	}
-	return s.Subscribe(ctx, protocol, ids)
+	return s.Subscribe(ctx, protocol, ids, opts...)
 }
 
 func subscribeSetToPubSub(pubsub PubSub) func(*subscriptionSet, ttnpb.ApplicationIdentifiers) error {
@@ -73,8 +73,9 @@ func (s *subscriptionSet) Cancel(err error) {
 }
 
 // Subscribe creates a subscription for the provided application with the given protocol.
-func (s *subscriptionSet) Subscribe(ctx context.Context, protocol string, ids *ttnpb.ApplicationIdentifiers) (*io.Subscription, error) {
-	sub := io.NewSubscription(ctx, protocol, ids, s.subOpts...)
+// The options are applied after the options of the set.
+func (s *subscriptionSet) Subscribe(ctx context.Context, protocol string, ids *ttnpb.ApplicationIdentifiers, opts ...io.SubscriptionOption) (*io.Subscription, error) {
+	sub := io.NewSubscription(ctx, protocol, ids, append(append(make([]io.SubscriptionOption, 0, len(s.subOpts)+len(opts)), s.subOpts...), opts...)...)
 	select {
 	case <-ctx.Done():
 		return nil, ctx.Err()
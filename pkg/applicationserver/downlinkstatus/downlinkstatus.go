@@ -0,0 +1,102 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package downlinkstatus tracks the delivery state of application downlink messages by correlation ID.
+package downlinkstatus
+
+import (
+	"context"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// CorrelationIDPrefix is the prefix of the correlation ID that the Application Server assigns to each downlink message
+// that is pushed to or replaced in the downlink queue.
+const CorrelationIDPrefix = "as:downlink:item:"
+
+// NewCorrelationID returns a new correlation ID for a downlink message.
+func NewCorrelationID() string {
+	return CorrelationIDPrefix + events.NewCorrelationID()
+}
+
+// State is the delivery state of a downlink message.
+type State = ttnpb.ApplicationDownlinkStatus_State
+
+const (
+	// StateQueued indicates that the downlink message is in the downlink queue of the Network Server.
+	StateQueued = ttnpb.ApplicationDownlinkStatus_QUEUED
+	// StateSent indicates that the downlink message has been sent to the end device.
+	StateSent = ttnpb.ApplicationDownlinkStatus_SENT
+	// StateAcked indicates that the end device acknowledged the confirmed downlink message.
+	StateAcked = ttnpb.ApplicationDownlinkStatus_ACKED
+	// StateNacked indicates that the end device did not acknowledge the confirmed downlink message.
+	// The Application Server pushes nacked downlink messages to the downlink queue again.
+	StateNacked = ttnpb.ApplicationDownlinkStatus_NACKED
+	// StateFailed indicates that the downlink message could not be queued or sent.
+	StateFailed = ttnpb.ApplicationDownlinkStatus_FAILED
+	// StateExpired indicates that the absolute time of the downlink message or the deferred downlink passed before it
+	// could be sent.
+	StateExpired = ttnpb.ApplicationDownlinkStatus_EXPIRED
+)
+
+// transitions are the states to which a downlink message can transition from each state.
+// Acked, failed and expired downlink messages are final.
+var transitions = map[State][]State{
+	StateQueued:  {StateSent, StateAcked, StateNacked, StateFailed, StateExpired},
+	StateSent:    {StateAcked, StateNacked, StateFailed, StateExpired},
+	StateNacked:  {StateQueued, StateSent, StateAcked, StateFailed, StateExpired},
+	StateAcked:   nil,
+	StateFailed:  nil,
+	StateExpired: nil,
+}
+
+// CanTransition returns true if a downlink message with the delivery status can transition to the given state.
+// A downlink message without delivery status can transition to any state. Transitions to earlier states are rejected,
+// as the Application Server and the Network Server report the states concurrently.
+func CanTransition(status *ttnpb.ApplicationDownlinkStatus, to State) bool {
+	if status == nil || len(status.History) == 0 {
+		return true
+	}
+	for _, allowed := range transitions[status.State] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Registry is a store for downlink delivery statuses.
+type Registry interface {
+	// Get returns the delivery status of the downlink message of the end device by its correlation ID.
+	Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, correlationID string) (*ttnpb.ApplicationDownlinkStatus, error)
+	// List returns the delivery statuses of the downlink messages of the end device, most recent first.
+	List(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDownlinkStatus, error)
+	// Update updates the delivery status of the downlink message of the end device by its correlation ID.
+	// The status passed to f is nil if there is no delivery status yet.
+	Update(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, correlationID string, f func(*ttnpb.ApplicationDownlinkStatus) *ttnpb.ApplicationDownlinkStatus) error
+	// WithPagination adds the pagination information to the context.
+	WithPagination(ctx context.Context, limit, page uint32, total *int64) context.Context
+}
+
+// CorrelationID returns the correlation ID that the Application Server assigned to the downlink message.
+func CorrelationID(down *ttnpb.ApplicationDownlink) (string, bool) {
+	for _, cid := range down.GetCorrelationIds() {
+		if strings.HasPrefix(cid, CorrelationIDPrefix) {
+			return cid, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,79 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package downlinkstatus
+
+import (
+	"context"
+	"strconv"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var errNotFound = errors.DefineNotFound("downlink_status_not_found", "status of downlink `{correlation_id}` not found")
+
+type registryRPC struct {
+	registry Registry
+}
+
+// NewRegistryRPC returns a new delivery status registry gRPC service.
+func NewRegistryRPC(registry Registry) ttnpb.AsDownlinkStatusRegistryServer {
+	return &registryRPC{
+		registry: registry,
+	}
+}
+
+// GetDownlinkStatus implements ttnpb.AsDownlinkStatusRegistryServer.
+func (r *registryRPC) GetDownlinkStatus(ctx context.Context, req *ttnpb.GetApplicationDownlinkStatusRequest) (*ttnpb.ApplicationDownlinkStatus, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	status, err := r.registry.Get(ctx, req.EndDeviceIdentifiers, req.CorrelationId)
+	if err != nil {
+		return nil, err
+	}
+	if status == nil {
+		return nil, errNotFound.WithAttributes("correlation_id", req.CorrelationId)
+	}
+	return status, nil
+}
+
+// ListDownlinkStatuses implements ttnpb.AsDownlinkStatusRegistryServer.
+func (r *registryRPC) ListDownlinkStatuses(ctx context.Context, req *ttnpb.ListApplicationDownlinkStatusesRequest) (res *ttnpb.ApplicationDownlinkStatuses, err error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	var total int64
+	ctx = r.registry.WithPagination(ctx, req.Limit, req.Page, &total)
+	defer func() {
+		if err == nil {
+			setTotalHeader(ctx, total)
+		}
+	}()
+	statuses, err := r.registry.List(ctx, req.EndDeviceIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ApplicationDownlinkStatuses{
+		Statuses: statuses,
+	}, nil
+}
+
+func setTotalHeader(ctx context.Context, total int64) {
+	grpc.SetHeader(ctx, metadata.Pairs("x-total-count", strconv.FormatInt(total, 10)))
+}
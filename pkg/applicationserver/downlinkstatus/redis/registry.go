@@ -0,0 +1,176 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the downlink delivery status registry using Redis.
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+
+// DefaultTTL is the default duration for which the delivery statuses are retained.
+const DefaultTTL = 24 * time.Hour
+
+// DownlinkStatusRegistry is a Redis downlink delivery status registry.
+//
+// The delivery statuses are stored as protos which expire after TTL. The correlation IDs are indexed per end
+// device in a sorted set of which the score is the creation time of the delivery status.
+type DownlinkStatusRegistry struct {
+	Redis *ttnredis.Client
+	TTL   time.Duration
+}
+
+func (r *DownlinkStatusRegistry) ttl() time.Duration {
+	if r.TTL <= 0 {
+		return DefaultTTL
+	}
+	return r.TTL
+}
+
+func (r *DownlinkStatusRegistry) deviceKey(devUID string) string {
+	return r.Redis.Key("uid", devUID)
+}
+
+func (r *DownlinkStatusRegistry) statusKey(devUID, correlationID string) string {
+	return r.Redis.Key("uid", devUID, correlationID)
+}
+
+func score(t time.Time) float64 {
+	return float64(t.UnixNano() / int64(time.Millisecond))
+}
+
+func unmarshalStatus(s string) (*ttnpb.ApplicationDownlinkStatus, error) {
+	status := &ttnpb.ApplicationDownlinkStatus{}
+	if err := ttnredis.UnmarshalProto(s, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// Get implements downlinkstatus.Registry.
+func (r *DownlinkStatusRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, correlationID string) (*ttnpb.ApplicationDownlinkStatus, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, errInvalidIdentifiers.WithCause(err)
+	}
+	s, err := r.Redis.Get(ctx, r.statusKey(unique.ID(ctx, ids), correlationID)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, ttnredis.ConvertError(err)
+	}
+	return unmarshalStatus(s)
+}
+
+// List implements downlinkstatus.Registry.
+func (r *DownlinkStatusRegistry) List(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDownlinkStatus, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, errInvalidIdentifiers.WithCause(err)
+	}
+	devUID := unique.ID(ctx, ids)
+	devKey := r.deviceKey(devUID)
+	start, stop := int64(0), int64(-1)
+	if limit, offset := ttnredis.PaginationLimitAndOffsetFromContext(ctx); limit != 0 {
+		total, err := r.Redis.ZCard(ctx, devKey).Result()
+		if err != nil {
+			return nil, ttnredis.ConvertError(err)
+		}
+		ttnredis.SetPaginationTotal(ctx, total)
+		start, stop = offset, offset+limit-1
+	}
+	cids, err := r.Redis.ZRevRange(ctx, devKey, start, stop).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	if len(cids) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(cids))
+	for _, cid := range cids {
+		keys = append(keys, r.statusKey(devUID, cid))
+	}
+	vals, err := r.Redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	res := make([]*ttnpb.ApplicationDownlinkStatus, 0, len(vals))
+	for _, val := range vals {
+		s, ok := val.(string)
+		if !ok {
+			// The delivery status expired.
+			continue
+		}
+		status, err := unmarshalStatus(s)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, status)
+	}
+	return res, nil
+}
+
+// Update implements downlinkstatus.Registry.
+func (r *DownlinkStatusRegistry) Update(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, correlationID string, f func(*ttnpb.ApplicationDownlinkStatus) *ttnpb.ApplicationDownlinkStatus) error {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return errInvalidIdentifiers.WithCause(err)
+	}
+	devUID := unique.ID(ctx, ids)
+	devKey, k := r.deviceKey(devUID), r.statusKey(devUID, correlationID)
+	ttl := r.ttl()
+	err := r.Redis.Watch(ctx, func(tx *redis.Tx) error {
+		var status *ttnpb.ApplicationDownlinkStatus
+		s, err := tx.Get(ctx, k).Result()
+		switch {
+		case err == redis.Nil:
+		case err != nil:
+			return err
+		default:
+			if status, err = unmarshalStatus(s); err != nil {
+				return err
+			}
+		}
+		status = f(status)
+		if status == nil {
+			return nil
+		}
+		if s, err = ttnredis.MarshalProto(status); err != nil {
+			return err
+		}
+		expired := strconv.FormatFloat(score(time.Now().Add(-ttl)), 'f', -1, 64)
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			p.Set(ctx, k, s, ttl)
+			p.ZAdd(ctx, devKey, &redis.Z{Score: score(status.CreatedAt), Member: correlationID})
+			p.ZRemRangeByScore(ctx, devKey, "-inf", "("+expired)
+			p.PExpire(ctx, devKey, ttl)
+			return nil
+		})
+		return err
+	}, k)
+	return ttnredis.ConvertError(err)
+}
+
+// WithPagination implements downlinkstatus.Registry.
+func (r *DownlinkStatusRegistry) WithPagination(ctx context.Context, limit, page uint32, total *int64) context.Context {
+	return ttnredis.NewContextWithPagination(ctx, int64(limit), int64(page), total)
+}
@@ -0,0 +1,106 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/downlinkstatus/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// assertStatuses asserts that the actual delivery statuses equal the expected delivery statuses, in order.
+// The delivery statuses are compared one by one, since the stored protos are unmarshaled.
+func assertStatuses(a *assertions.Assertion, actual []*ttnpb.ApplicationDownlinkStatus, expected ...*ttnpb.ApplicationDownlinkStatus) {
+	if !a.So(actual, should.HaveLength, len(expected)) {
+		return
+	}
+	for i, status := range actual {
+		a.So(status, should.Resemble, expected[i])
+	}
+}
+
+func TestDownlinkStatusRegistry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.ContextWithTB(test.Context(), t)
+
+	cl, flush := test.NewRedis(ctx, "downlinkstatus_redis_test")
+	defer flush()
+	defer cl.Close()
+	registry := &DownlinkStatusRegistry{Redis: cl}
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationId: "foo-app",
+		},
+		DeviceId: "foo-device",
+	}
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	newStatus := func(cid string, createdAt time.Time) *ttnpb.ApplicationDownlinkStatus {
+		return &ttnpb.ApplicationDownlinkStatus{
+			CorrelationId: cid,
+			EndDeviceIds:  ids,
+			FPort:         1,
+			State:         ttnpb.ApplicationDownlinkStatus_QUEUED,
+			History: []*ttnpb.ApplicationDownlinkStatus_Transition{
+				{State: ttnpb.ApplicationDownlinkStatus_QUEUED, Time: createdAt},
+			},
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		}
+	}
+
+	status, err := registry.Get(ctx, ids, "first")
+	a.So(err, should.BeNil)
+	a.So(status, should.BeNil)
+
+	first, second, third := newStatus("first", now.Add(-3*time.Minute)), newStatus("second", now.Add(-2*time.Minute)), newStatus("third", now.Add(-time.Minute))
+	for _, status := range []*ttnpb.ApplicationDownlinkStatus{second, first, third} {
+		status := status
+		if !a.So(registry.Update(ctx, ids, status.CorrelationId, func(stored *ttnpb.ApplicationDownlinkStatus) *ttnpb.ApplicationDownlinkStatus {
+			a.So(stored, should.BeNil)
+			return status
+		}), should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	status, err = registry.Get(ctx, ids, "first")
+	a.So(err, should.BeNil)
+	a.So(status, should.Resemble, first)
+
+	statuses, err := registry.List(ctx, ids)
+	a.So(err, should.BeNil)
+	assertStatuses(a, statuses, third, second, first)
+
+	var total int64
+	statuses, err = registry.List(registry.WithPagination(ctx, 2, 2, &total), ids)
+	a.So(err, should.BeNil)
+	assertStatuses(a, statuses, first)
+	a.So(total, should.Equal, 3)
+
+	a.So(registry.Update(ctx, ids, "first", func(stored *ttnpb.ApplicationDownlinkStatus) *ttnpb.ApplicationDownlinkStatus {
+		a.So(stored, should.Resemble, first)
+		stored.State = ttnpb.ApplicationDownlinkStatus_SENT
+		return stored
+	}), should.BeNil)
+	status, err = registry.Get(ctx, ids, "first")
+	a.So(err, should.BeNil)
+	a.So(status.State, should.Equal, ttnpb.ApplicationDownlinkStatus_SENT)
+}
@@ -0,0 +1,129 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package downlinkstatus
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Tracker records the delivery state transitions of downlink messages.
+type Tracker struct {
+	registry Registry
+}
+
+// NewTracker returns a new Tracker which records the delivery state transitions in the given registry.
+func NewTracker(registry Registry) *Tracker {
+	return &Tracker{
+		registry: registry,
+	}
+}
+
+// Registry returns the delivery status registry.
+func (t *Tracker) Registry() Registry { return t.registry }
+
+// Track records the delivery state transition of the given downlink messages.
+// Downlink messages without a correlation ID assigned by the Application Server are ignored.
+// A nil Tracker does not record anything.
+func (t *Tracker) Track(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink, state State, cause error) error {
+	if t == nil {
+		return nil
+	}
+	now := time.Now().UTC()
+	var errMessage string
+	if cause != nil {
+		errMessage = cause.Error()
+	}
+	for _, item := range items {
+		cid, ok := CorrelationID(item)
+		if !ok {
+			continue
+		}
+		item := item
+		if err := t.registry.Update(ctx, ids, cid, func(status *ttnpb.ApplicationDownlinkStatus) *ttnpb.ApplicationDownlinkStatus {
+			canTransition := CanTransition(status, state)
+			if status == nil {
+				status = &ttnpb.ApplicationDownlinkStatus{
+					CorrelationId: cid,
+					EndDeviceIds:  ids,
+					CreatedAt:     now,
+				}
+			}
+			status.FPort = item.FPort
+			status.Confirmed = item.Confirmed
+			if item.FCnt != 0 {
+				status.FCnt = item.FCnt
+			}
+			if !canTransition {
+				// Both the Application Server and the Network Server report queued downlink messages, and the
+				// Network Server may report the downlink message as sent before the Application Server reports it as
+				// queued.
+				return status
+			}
+			status.State = state
+			status.Error = errMessage
+			status.UpdatedAt = now
+			status.History = append(status.History, &ttnpb.ApplicationDownlinkStatus_Transition{
+				State: state,
+				Time:  now,
+				Error: errMessage,
+			})
+			return status
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expiredDownlink is the namespace and name of the error that the Network Server reports when the absolute time of
+// a downlink message passed before it could be sent.
+var expiredDownlink = [2]string{"pkg/networkserver", "downlink_expired"}
+
+// TrackUp records the delivery state transition reported by the Network Server in the upstream message.
+// Upstream messages which do not report a downlink state are ignored.
+func (t *Tracker) TrackUp(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	if t == nil {
+		return nil
+	}
+	var (
+		items []*ttnpb.ApplicationDownlink
+		state State
+		cause error
+	)
+	switch p := up.Up.(type) {
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		items, state = []*ttnpb.ApplicationDownlink{p.DownlinkQueued}, StateQueued
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		items, state = []*ttnpb.ApplicationDownlink{p.DownlinkSent}, StateSent
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		items, state = []*ttnpb.ApplicationDownlink{p.DownlinkAck}, StateAcked
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		items, state = []*ttnpb.ApplicationDownlink{p.DownlinkNack}, StateNacked
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		items, state = []*ttnpb.ApplicationDownlink{&p.DownlinkFailed.ApplicationDownlink}, StateFailed
+		if details := p.DownlinkFailed.Error; details.Name != "" {
+			if details.Namespace == expiredDownlink[0] && details.Name == expiredDownlink[1] {
+				state = StateExpired
+			}
+			cause = ttnpb.ErrorDetailsFromProto(&details)
+		}
+	default:
+		return nil
+	}
+	return t.Track(ctx, up.EndDeviceIdentifiers, items, state, cause)
+}
@@ -0,0 +1,201 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package downlinkstatus_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/downlinkstatus"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type memRegistry struct {
+	mu       sync.Mutex
+	statuses map[string]*ttnpb.ApplicationDownlinkStatus
+}
+
+func (r *memRegistry) Get(_ context.Context, _ ttnpb.EndDeviceIdentifiers, cid string) (*ttnpb.ApplicationDownlinkStatus, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.statuses[cid], nil
+}
+
+func (r *memRegistry) List(context.Context, ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDownlinkStatus, error) {
+	panic("not implemented")
+}
+
+func (r *memRegistry) WithPagination(ctx context.Context, _, _ uint32, _ *int64) context.Context {
+	return ctx
+}
+
+func (r *memRegistry) Update(_ context.Context, _ ttnpb.EndDeviceIdentifiers, cid string, f func(*ttnpb.ApplicationDownlinkStatus) *ttnpb.ApplicationDownlinkStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.statuses == nil {
+		r.statuses = make(map[string]*ttnpb.ApplicationDownlinkStatus)
+	}
+	r.statuses[cid] = f(r.statuses[cid])
+	return nil
+}
+
+var errExpiredDownlink = errors.Define("downlink_expired", "queued downlink is expired")
+
+func TestTracker(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationId: "foo-app",
+		},
+		DeviceId: "foo-device",
+	}
+	const cid = downlinkstatus.CorrelationIDPrefix + "01F8MECHZX3TBDSZ7XRADM79XV"
+	newDownlink := func(fCnt uint32) *ttnpb.ApplicationDownlink {
+		return &ttnpb.ApplicationDownlink{
+			FPort:          42,
+			FCnt:           fCnt,
+			Confirmed:      true,
+			CorrelationIds: []string{"rpc:/ttn.lorawan.v3.AppAs/DownlinkQueuePush:foo", cid},
+		}
+	}
+
+	registry := &memRegistry{}
+	tracker := downlinkstatus.NewTracker(registry)
+
+	a.So(tracker.Track(ctx, ids, []*ttnpb.ApplicationDownlink{newDownlink(0)}, downlinkstatus.StateQueued, nil), should.BeNil)
+	status, _ := registry.Get(ctx, ids, cid)
+	if !a.So(status, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(status.State, should.Equal, downlinkstatus.StateQueued)
+	a.So(status.EndDeviceIds, should.Resemble, ids)
+	a.So(status.FPort, should.Equal, 42)
+
+	for _, tc := range []struct {
+		Up    *ttnpb.ApplicationUp
+		State downlinkstatus.State
+	}{
+		{
+			Up: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ids,
+				Up:                   &ttnpb.ApplicationUp_DownlinkSent{DownlinkSent: newDownlink(3)},
+			},
+			State: downlinkstatus.StateSent,
+		},
+		{
+			Up: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ids,
+				Up:                   &ttnpb.ApplicationUp_DownlinkNack{DownlinkNack: newDownlink(3)},
+			},
+			State: downlinkstatus.StateNacked,
+		},
+		{
+			Up: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ids,
+				Up:                   &ttnpb.ApplicationUp_DownlinkAck{DownlinkAck: newDownlink(3)},
+			},
+			State: downlinkstatus.StateAcked,
+		},
+	} {
+		a.So(tracker.TrackUp(ctx, tc.Up), should.BeNil)
+		status, _ = registry.Get(ctx, ids, cid)
+		a.So(status.State, should.Equal, tc.State)
+	}
+	a.So(status.FCnt, should.Equal, 3)
+	a.So(status.History, should.HaveLength, 4)
+	a.So(status.Error, should.BeEmpty)
+
+	// Transitions to earlier states are rejected.
+	a.So(tracker.Track(ctx, ids, []*ttnpb.ApplicationDownlink{newDownlink(3)}, downlinkstatus.StateQueued, nil), should.BeNil)
+	status, _ = registry.Get(ctx, ids, cid)
+	a.So(status.State, should.Equal, downlinkstatus.StateAcked)
+	a.So(status.History, should.HaveLength, 4)
+
+	// Downlink messages that are not assigned a correlation ID by the Application Server are not tracked.
+	a.So(tracker.TrackUp(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ids,
+		Up: &ttnpb.ApplicationUp_DownlinkSent{
+			DownlinkSent: &ttnpb.ApplicationDownlink{FPort: 1},
+		},
+	}), should.BeNil)
+	a.So(registry.statuses, should.HaveLength, 1)
+}
+
+func TestCanTransition(t *testing.T) {
+	a := assertions.New(t)
+	withState := func(state downlinkstatus.State) *ttnpb.ApplicationDownlinkStatus {
+		return &ttnpb.ApplicationDownlinkStatus{
+			State: state,
+			History: []*ttnpb.ApplicationDownlinkStatus_Transition{
+				{State: state},
+			},
+		}
+	}
+	a.So(downlinkstatus.CanTransition(withState(downlinkstatus.StateQueued), downlinkstatus.StateSent), should.BeTrue)
+	a.So(downlinkstatus.CanTransition(withState(downlinkstatus.StateSent), downlinkstatus.StateQueued), should.BeFalse)
+	a.So(downlinkstatus.CanTransition(withState(downlinkstatus.StateSent), downlinkstatus.StateSent), should.BeFalse)
+	a.So(downlinkstatus.CanTransition(withState(downlinkstatus.StateNacked), downlinkstatus.StateQueued), should.BeTrue)
+	a.So(downlinkstatus.CanTransition(withState(downlinkstatus.StateAcked), downlinkstatus.StateNacked), should.BeFalse)
+	a.So(downlinkstatus.CanTransition(withState(downlinkstatus.StateFailed), downlinkstatus.StateSent), should.BeFalse)
+	a.So(downlinkstatus.CanTransition(nil, downlinkstatus.StateSent), should.BeTrue)
+	a.So(downlinkstatus.CanTransition(nil, downlinkstatus.StateQueued), should.BeTrue)
+}
+
+func TestTrackerExpired(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationId: "foo-app",
+		},
+		DeviceId: "foo-device",
+	}
+	const cid = downlinkstatus.CorrelationIDPrefix + "01F8MECHZX3TBDSZ7XRADM79XV"
+
+	registry := &memRegistry{}
+	tracker := downlinkstatus.NewTracker(registry)
+
+	details := ttnpb.ErrorDetailsToProto(errExpiredDownlink.New())
+	details.Namespace = "pkg/networkserver"
+	a.So(tracker.TrackUp(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ids,
+		Up: &ttnpb.ApplicationUp_DownlinkFailed{
+			DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
+				ApplicationDownlink: ttnpb.ApplicationDownlink{
+					FPort:          1,
+					CorrelationIds: []string{cid},
+				},
+				Error: *details,
+			},
+		},
+	}), should.BeNil)
+	status, _ := registry.Get(ctx, ids, cid)
+	if !a.So(status, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(status.State, should.Equal, downlinkstatus.StateExpired)
+	a.So(status.Error, should.NotBeEmpty)
+
+	var nilTracker *downlinkstatus.Tracker
+	a.So(nilTracker.Track(ctx, ids, []*ttnpb.ApplicationDownlink{{CorrelationIds: []string{cid}}}, downlinkstatus.StateQueued, nil), should.BeNil)
+}
@@ -291,5 +291,10 @@ func (r asEndDeviceRegistryServer) Delete(ctx context.Context, ids *ttnpb.EndDev
 	if err := r.AS.appUpsRegistry.Clear(ctx, *ids); err != nil {
 		return nil, err
 	}
+	if r.AS.deferredDownlinks != nil {
+		if err := r.AS.deferredDownlinks.Clear(ctx, *ids); err != nil {
+			return nil, err
+		}
+	}
 	return ttnpb.Empty, nil
 }
@@ -0,0 +1,98 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters
+
+import (
+	"bytes"
+	stdcsv "encoding/csv"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+type csv struct {
+	json
+}
+
+// FromUp formats uplink messages as a CSV row, without header. The columns are, in order:
+// received_at, application_id, device_id, dev_eui, f_port, f_cnt, frm_payload, gateway_id, rssi, snr and
+// decoded_payload. The gateway_id, rssi and snr columns refer to the gateway with the best SNR.
+// The decoded_payload column contains the decoded payload in JSON.
+// Other upstream messages are formatted as JSON.
+func (c csv) FromUp(msg *ttnpb.ApplicationUp) ([]byte, error) {
+	up := msg.GetUplinkMessage()
+	if up == nil {
+		return c.json.FromUp(msg)
+	}
+	var decoded []byte
+	if up.DecodedPayload != nil {
+		var err error
+		if decoded, err = jsonpb.TTN().Marshal(up.DecodedPayload); err != nil {
+			return nil, errDecodedPayload.WithCause(err)
+		}
+	}
+
+	receivedAt := up.ReceivedAt
+	if msg.ReceivedAt != nil {
+		receivedAt = *msg.ReceivedAt
+	}
+	var devEUI string
+	if msg.DevEui != nil {
+		devEUI = msg.DevEui.String()
+	}
+	var gatewayID, rssi, snr string
+	var best *ttnpb.RxMetadata
+	for _, md := range up.RxMetadata {
+		if best == nil || md.Snr > best.Snr {
+			best = md
+		}
+	}
+	if best != nil {
+		gatewayID = best.GatewayIds.GetGatewayId()
+		rssi = strconv.FormatFloat(float64(best.Rssi), 'f', -1, 32)
+		snr = strconv.FormatFloat(float64(best.Snr), 'f', -1, 32)
+	}
+
+	var buf bytes.Buffer
+	w := stdcsv.NewWriter(&buf)
+	if err := w.Write([]string{
+		receivedAt.UTC().Format(time.RFC3339Nano),
+		msg.ApplicationId,
+		msg.DeviceId,
+		devEUI,
+		strconv.FormatUint(uint64(up.FPort), 10),
+		strconv.FormatUint(uint64(up.FCnt), 10),
+		hex.EncodeToString(up.FrmPayload),
+		gatewayID,
+		rssi,
+		snr,
+		string(decoded),
+	}); err != nil {
+		return nil, err
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// CSV is a formatter that formats uplink messages as rows of comma-separated values.
+// The columns are fixed, so that the rows of all messages can be appended to a single file or table.
+// Other upstream messages are formatted as JSON and downlink messages are expected to be in the JSON format.
+var CSV Formatter = &csv{}
@@ -0,0 +1,84 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/formatters"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestCSVUpstream(t *testing.T) {
+	a := assertions.New(t)
+	formatter := formatters.CSV
+
+	buf, err := formatter.FromUp(textFormatterUplink)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(string(buf), should.Equal, `2020-09-13T12:26:40Z,foo-app,foo device,4242424242424242,42,24,010203,gtw-2,-100,7.25,"{""gps"":{""fix"":true},""status"":""ok \""good\"""",""temperature"":21.5}"
+`)
+
+	// The columns are fixed, so that the rows can be appended.
+	buf, err = formatter.FromUp(&ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationId: "foo-app",
+			},
+			DeviceId: "bar-device",
+		},
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort:      1,
+				FCnt:       2,
+				ReceivedAt: time.Unix(1600000000, 0).UTC(),
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(string(buf), should.Equal, `2020-09-13T12:26:40Z,foo-app,bar-device,,1,2,,,,,
+`)
+
+	buf, err = formatter.FromUp(&ttnpb.ApplicationUp{
+		Up: &ttnpb.ApplicationUp_DownlinkQueued{
+			DownlinkQueued: &ttnpb.ApplicationDownlink{
+				FPort: 42,
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(string(buf), should.Equal, `{"end_device_ids":{"application_ids":{}},"downlink_queued":{"f_port":42}}`)
+}
+
+func TestCSVDownstream(t *testing.T) {
+	a := assertions.New(t)
+	formatter := formatters.CSV
+
+	res, err := formatter.ToDownlinks([]byte(`{"downlinks":[{"f_port":42,"frm_payload":"AQID"}]}`))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(res.Downlinks, should.HaveLength, 1)
+	a.So(res.Downlinks[0].FPort, should.Equal, 42)
+	a.So(res.Downlinks[0].FrmPayload, should.Resemble, []byte{0x1, 0x2, 0x3})
+}
@@ -0,0 +1,185 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	influxDBUplinkMeasurement     = "uplink_message"
+	influxDBRxMetadataMeasurement = "rx_metadata"
+)
+
+var (
+	influxDBKeyReplacer    = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+	influxDBStringReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+)
+
+type influxDBLine struct {
+	measurement string
+	tags        [][2]string
+	fields      map[string]string
+	timestamp   time.Time
+}
+
+func (l influxDBLine) appendTo(buf *bytes.Buffer) {
+	buf.WriteString(influxDBKeyReplacer.Replace(l.measurement))
+	for _, tag := range l.tags {
+		if tag[1] == "" {
+			continue
+		}
+		buf.WriteByte(',')
+		buf.WriteString(influxDBKeyReplacer.Replace(tag[0]))
+		buf.WriteByte('=')
+		buf.WriteString(influxDBKeyReplacer.Replace(tag[1]))
+	}
+	keys := make([]string, 0, len(l.fields))
+	for key := range l.fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for i, key := range keys {
+		if i == 0 {
+			buf.WriteByte(' ')
+		} else {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(influxDBKeyReplacer.Replace(key))
+		buf.WriteByte('=')
+		buf.WriteString(l.fields[key])
+	}
+	if !l.timestamp.IsZero() {
+		buf.WriteByte(' ')
+		buf.WriteString(strconv.FormatInt(l.timestamp.UnixNano(), 10))
+	}
+	buf.WriteByte('\n')
+}
+
+func influxDBFieldValue(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return `"` + influxDBStringReplacer.Replace(v) + `"`, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), true
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10) + "i", true
+	case int64:
+		return strconv.FormatInt(v, 10) + "i", true
+	default:
+		return "", false
+	}
+}
+
+type influxDB struct {
+	json
+}
+
+// FromUp formats uplink messages in the InfluxDB line protocol.
+// The decoded payload fields, the frame port and the frame counter are written to the uplink_message measurement.
+// Decoded payload fields that have the name of a built-in field are prefixed with decoded_payload_.
+// The RSSI, channel RSSI and SNR of each gateway that received the uplink are written to the rx_metadata measurement.
+// The application, device and gateway identifiers are written as tags.
+// Other upstream messages are formatted as JSON.
+func (f influxDB) FromUp(msg *ttnpb.ApplicationUp) ([]byte, error) {
+	up := msg.GetUplinkMessage()
+	if up == nil {
+		return f.json.FromUp(msg)
+	}
+	decoded, err := flattenDecodedPayload(up.DecodedPayload)
+	if err != nil {
+		return nil, err
+	}
+	var devEUI string
+	if msg.DevEui != nil {
+		devEUI = msg.DevEui.String()
+	}
+	timestamp := up.ReceivedAt
+	if msg.ReceivedAt != nil {
+		timestamp = *msg.ReceivedAt
+	}
+	deviceTags := [][2]string{
+		{"application_id", msg.ApplicationId},
+		{"device_id", msg.DeviceId},
+		{"dev_eui", devEUI},
+	}
+
+	var buf bytes.Buffer
+	uplink := influxDBLine{
+		measurement: influxDBUplinkMeasurement,
+		tags:        deviceTags,
+		fields: map[string]string{
+			"f_port": strconv.FormatUint(uint64(up.FPort), 10) + "i",
+			"f_cnt":  strconv.FormatUint(uint64(up.FCnt), 10) + "i",
+		},
+		timestamp: timestamp,
+	}
+	for key, val := range decoded {
+		s, ok := influxDBFieldValue(val)
+		if !ok {
+			continue
+		}
+		if _, ok := uplink.fields[key]; ok {
+			key = "decoded_payload_" + key
+		}
+		uplink.fields[key] = s
+	}
+	uplink.appendTo(&buf)
+
+	for _, md := range up.RxMetadata {
+		var gatewayID, gatewayEUI string
+		if md.GatewayIds != nil {
+			gatewayID = md.GatewayIds.GatewayId
+			if md.GatewayIds.Eui != nil {
+				gatewayEUI = md.GatewayIds.Eui.String()
+			}
+		}
+		rssi, _ := influxDBFieldValue(md.Rssi)
+		snr, _ := influxDBFieldValue(md.Snr)
+		line := influxDBLine{
+			measurement: influxDBRxMetadataMeasurement,
+			tags: append(deviceTags[:len(deviceTags):len(deviceTags)],
+				[2]string{"gateway_id", gatewayID},
+				[2]string{"gateway_eui", gatewayEUI},
+			),
+			fields: map[string]string{
+				"rssi": rssi,
+				"snr":  snr,
+			},
+			timestamp: timestamp,
+		}
+		// The channel RSSI is only reported by some gateways.
+		if md.ChannelRssi != 0 {
+			line.fields["channel_rssi"], _ = influxDBFieldValue(md.ChannelRssi)
+		}
+		line.appendTo(&buf)
+	}
+	return buf.Bytes(), nil
+}
+
+// InfluxDB is a formatter that formats uplink messages in the InfluxDB line protocol, so that they can be written
+// to InfluxDB directly. Other upstream messages are formatted as JSON and downlink messages are expected to be in the
+// JSON format.
+var InfluxDB Formatter = &influxDB{}
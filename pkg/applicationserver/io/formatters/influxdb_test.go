@@ -0,0 +1,166 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/formatters"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var textFormatterUplink = &ttnpb.ApplicationUp{
+	EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationId: "foo-app",
+		},
+		DeviceId: "foo device",
+		DevEui:   &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+	},
+	Up: &ttnpb.ApplicationUp_UplinkMessage{
+		UplinkMessage: &ttnpb.ApplicationUplink{
+			FPort:      42,
+			FCnt:       24,
+			FrmPayload: []byte{0x1, 0x2, 0x3},
+			DecodedPayload: &pbtypes.Struct{
+				Fields: map[string]*pbtypes.Value{
+					"temperature": {
+						Kind: &pbtypes.Value_NumberValue{
+							NumberValue: 21.5,
+						},
+					},
+					"status": {
+						Kind: &pbtypes.Value_StringValue{
+							StringValue: `ok "good"`,
+						},
+					},
+					"gps": {
+						Kind: &pbtypes.Value_StructValue{
+							StructValue: &pbtypes.Struct{
+								Fields: map[string]*pbtypes.Value{
+									"fix": {
+										Kind: &pbtypes.Value_BoolValue{
+											BoolValue: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIds: &ttnpb.GatewayIdentifiers{
+						GatewayId: "gtw-1",
+					},
+					Rssi: -42,
+					Snr:  5.5,
+				},
+				{
+					GatewayIds: &ttnpb.GatewayIdentifiers{
+						GatewayId: "gtw-2",
+						Eui:       &types.EUI64{0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11},
+					},
+					Rssi:        -100,
+					ChannelRssi: -101,
+					Snr:         7.25,
+				},
+			},
+			ReceivedAt: time.Unix(1600000000, 0).UTC(),
+		},
+	},
+}
+
+func TestInfluxDBUpstream(t *testing.T) {
+	formatter := formatters.InfluxDB
+
+	for i, tc := range []struct {
+		Message *ttnpb.ApplicationUp
+		Result  string
+		Error   bool
+	}{
+		{
+			Message: textFormatterUplink,
+			Result: `uplink_message,application_id=foo-app,device_id=foo\ device,dev_eui=4242424242424242 f_cnt=24i,f_port=42i,gps_fix=true,status="ok \"good\"",temperature=21.5 1600000000000000000
+rx_metadata,application_id=foo-app,device_id=foo\ device,dev_eui=4242424242424242,gateway_id=gtw-1 rssi=-42,snr=5.5 1600000000000000000
+rx_metadata,application_id=foo-app,device_id=foo\ device,dev_eui=4242424242424242,gateway_id=gtw-2,gateway_eui=1111111111111111 channel_rssi=-101,rssi=-100,snr=7.25 1600000000000000000
+`,
+		},
+		{
+			Message: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+						ApplicationId: "foo-app",
+					},
+					DeviceId: "foo-device",
+				},
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{
+						FPort: 42,
+						FCnt:  24,
+						DecodedPayload: &pbtypes.Struct{
+							Fields: map[string]*pbtypes.Value{
+								"f_port": {
+									Kind: &pbtypes.Value_NumberValue{
+										NumberValue: 1,
+									},
+								},
+							},
+						},
+						ReceivedAt: time.Unix(1600000000, 0).UTC(),
+					},
+				},
+			},
+			Result: `uplink_message,application_id=foo-app,device_id=foo-device decoded_payload_f_port=1,f_cnt=24i,f_port=42i 1600000000000000000
+`,
+		},
+		{
+			Message: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+						ApplicationId: "foo-app",
+					},
+					DeviceId: "foo-device",
+				},
+				Up: &ttnpb.ApplicationUp_DownlinkQueueInvalidated{
+					DownlinkQueueInvalidated: &ttnpb.ApplicationInvalidatedDownlinks{
+						LastFCntDown: 42,
+					},
+				},
+			},
+			Result: `{"end_device_ids":{"device_id":"foo-device","application_ids":{"application_id":"foo-app"}},"downlink_queue_invalidated":{"last_f_cnt_down":42}}`,
+		},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			a := assertions.New(t)
+			buf, err := formatter.FromUp(tc.Message)
+			if tc.Error {
+				a.So(err, should.NotBeNil)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(string(buf), should.Equal, tc.Result)
+		})
+	}
+}
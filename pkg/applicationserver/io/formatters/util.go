@@ -0,0 +1,57 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters
+
+import (
+	"fmt"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gogoproto"
+)
+
+var (
+	errDecodedPayload = errors.DefineInvalidArgument("decoded_payload", "invalid decoded payload")
+)
+
+// flattenDecodedPayload returns the decoded payload as a flat map.
+// Nested objects and arrays are flattened by joining the keys and indices with an underscore.
+func flattenDecodedPayload(s *pbtypes.Struct) (map[string]interface{}, error) {
+	m, err := gogoproto.Map(s)
+	if err != nil {
+		return nil, errDecodedPayload.WithCause(err)
+	}
+	res := make(map[string]interface{}, len(m))
+	var flatten func(prefix string, v interface{})
+	flatten = func(prefix string, v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for key, val := range v {
+				flatten(prefix+"_"+key, val)
+			}
+		case []interface{}:
+			for i, val := range v {
+				flatten(fmt.Sprintf("%s_%d", prefix, i), val)
+			}
+		case nil:
+		default:
+			res[prefix] = v
+		}
+	}
+	for key, val := range m {
+		flatten(key, val)
+	}
+	return res, nil
+}
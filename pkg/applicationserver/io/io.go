@@ -40,7 +40,8 @@ type PubSub interface {
 	// Subscribe subscribes an application or integration by its identifiers to the Application Server, and returns a
 	// Subscription for traffic and control. If the cluster parameter is true, the subscription receives all of the
 	// traffic of the application. Otherwise, only traffic that was processed locally is sent.
-	Subscribe(ctx context.Context, protocol string, ids *ttnpb.ApplicationIdentifiers, cluster bool) (*Subscription, error)
+	// The options are applied to the created Subscription.
+	Subscribe(ctx context.Context, protocol string, ids *ttnpb.ApplicationIdentifiers, cluster bool, opts ...SubscriptionOption) (*Subscription, error)
 }
 
 // DownlinkQueueOperator represents the Application Server downlink queue operations to application frontends.
@@ -108,6 +109,7 @@ type Subscription struct {
 
 	upCh    chan *ContextualApplicationUp
 	publish func(context.Context, context.Context, chan<- *ContextualApplicationUp, *ContextualApplicationUp) error
+	filter  func(context.Context, *ttnpb.ApplicationUp) bool
 }
 
 // SubscriptionOption is an option for a Subscription.
@@ -139,6 +141,14 @@ func WithBufferSize(bufferSize int) SubscriptionOption {
 	})
 }
 
+// WithFilter controls which upstream messages are published to the subscription. Messages for which the filter
+// returns false are dropped before they are enqueued.
+func WithFilter(filter func(context.Context, *ttnpb.ApplicationUp) bool) SubscriptionOption {
+	return subscriptionOptionFunc(func(s *Subscription) {
+		s.filter = filter
+	})
+}
+
 // NewSubscription instantiates a new application or integration subscription.
 func NewSubscription(ctx context.Context, protocol string, ids *ttnpb.ApplicationIdentifiers, opts ...SubscriptionOption) *Subscription {
 	ctx, cancelCtx := errorcontext.New(ctx)
@@ -172,6 +182,9 @@ func (s *Subscription) ApplicationIDs() *ttnpb.ApplicationIdentifiers { return s
 
 // Publish publishes an upstream message.
 func (s *Subscription) Publish(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	if s.filter != nil && !s.filter(ctx, up) {
+		return nil
+	}
 	ctxUp := &ContextualApplicationUp{
 		Context:       ctx,
 		ApplicationUp: up,
@@ -79,14 +79,14 @@ func (s *server) Publish(ctx context.Context, up *ttnpb.ApplicationUp) error {
 }
 
 // Subscribe implements io.Server.
-func (s *server) Subscribe(ctx context.Context, protocol string, ids *ttnpb.ApplicationIdentifiers, global bool) (*io.Subscription, error) {
+func (s *server) Subscribe(ctx context.Context, protocol string, ids *ttnpb.ApplicationIdentifiers, global bool, opts ...io.SubscriptionOption) (*io.Subscription, error) {
 	s.subscriptionsMu.RLock()
 	err := s.subscribeError
 	s.subscriptionsMu.RUnlock()
 	if err != nil {
 		return nil, err
 	}
-	sub := io.NewSubscription(ctx, protocol, ids)
+	sub := io.NewSubscription(ctx, protocol, ids, opts...)
 	s.subscriptionsMu.Lock()
 	if ids != nil {
 		s.appSubs[unique.ID(ctx, ids)] = append(s.appSubs[unique.ID(ctx, ids)], sub)
@@ -24,3 +24,10 @@ type Format interface {
 	topics.Layout
 	formatters.Formatter
 }
+
+// topicFormats are the formats that clients can use in addition to the format of the frontend.
+// The topics of these formats are prefixed with the format name, so that the format is selected per topic.
+var topicFormats = []Format{
+	InfluxDB,
+	CSV,
+}
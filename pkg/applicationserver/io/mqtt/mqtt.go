@@ -20,6 +20,7 @@ import (
 	"fmt"
 	stdio "io"
 	"net"
+	"sync"
 
 	"github.com/TheThingsIndustries/mystique/pkg/auth"
 	mqttlog "github.com/TheThingsIndustries/mystique/pkg/log"
@@ -28,6 +29,7 @@ import (
 	"github.com/TheThingsIndustries/mystique/pkg/session"
 	"github.com/TheThingsIndustries/mystique/pkg/topic"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt/topics"
 	ttsauth "go.thethings.network/lorawan-stack/v3/pkg/auth"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/errorcontext"
@@ -38,6 +40,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 const qosUpstream byte = 0
@@ -50,6 +53,8 @@ type srv struct {
 }
 
 // Serve serves the MQTT frontend.
+// Clients use the given format by default, and can select other formats per topic by prefixing the topic with the
+// name of the format.
 func Serve(ctx context.Context, server io.Server, listener net.Listener, format Format, protocol string) error {
 	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/mqtt")
 	ctx = mqttlog.NewContext(ctx, mqtt.Logger(log.FromContext(ctx)))
@@ -73,6 +78,8 @@ func (s *srv) accept() error {
 
 		remoteAddr := mqttConn.RemoteAddr().String()
 		ctx := log.NewContextWithFields(s.ctx, log.Fields("remote_addr", remoteAddr))
+		// The remote address is checked against the source address restrictions of API keys.
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: mqttConn.RemoteAddr()})
 
 		resource := ratelimit.ApplicationAcceptMQTTConnectionResource(remoteAddr)
 		if err := ratelimit.Require(s.server.RateLimiter(), resource); err != nil {
@@ -99,7 +106,11 @@ func (s *srv) accept() error {
 }
 
 type connection struct {
-	format  Format
+	format Format
+
+	topicFormatsMu sync.RWMutex
+	topicFormats   map[Format]struct{}
+
 	server  io.Server
 	mqtt    mqttnet.Conn
 	session session.Session
@@ -132,6 +143,10 @@ func (c *connection) setup(ctx context.Context) error {
 				cancel(err)
 				return
 			}
+			// The session resolves unsubscribed topics through Subscribe, so the topic formats are pruned afterwards.
+			if _, ok := pkt.(*packet.UnsubackPacket); ok {
+				c.removeTopicFormats(unique.ID(ctx, c.io.ApplicationIDs()))
+			}
 			if pkt != nil {
 				logger.Debugf("Schedule %s packet", packet.Name[pkt.PacketType()])
 				select {
@@ -151,44 +166,24 @@ func (c *connection) setup(ctx context.Context) error {
 				return
 			case up := <-c.io.Up():
 				logger := logger.WithField("device_uid", unique.ID(up.Context, up.EndDeviceIdentifiers))
-				var topicParts []string
-				switch up.Up.(type) {
-				case *ttnpb.ApplicationUp_UplinkMessage:
-					topicParts = c.format.UplinkTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceId)
-				case *ttnpb.ApplicationUp_JoinAccept:
-					topicParts = c.format.JoinAcceptTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceId)
-				case *ttnpb.ApplicationUp_DownlinkAck:
-					topicParts = c.format.DownlinkAckTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceId)
-				case *ttnpb.ApplicationUp_DownlinkNack:
-					topicParts = c.format.DownlinkNackTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceId)
-				case *ttnpb.ApplicationUp_DownlinkSent:
-					topicParts = c.format.DownlinkSentTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceId)
-				case *ttnpb.ApplicationUp_DownlinkFailed:
-					topicParts = c.format.DownlinkFailedTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceId)
-				case *ttnpb.ApplicationUp_DownlinkQueued:
-					topicParts = c.format.DownlinkQueuedTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceId)
-				case *ttnpb.ApplicationUp_DownlinkQueueInvalidated:
-					topicParts = c.format.DownlinkQueueInvalidatedTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceId)
-				case *ttnpb.ApplicationUp_LocationSolved:
-					topicParts = c.format.LocationSolvedTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceId)
-				case *ttnpb.ApplicationUp_ServiceData:
-					topicParts = c.format.ServiceDataTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceId)
+				for _, format := range c.upstreamFormats() {
+					topicParts := upstreamTopic(format, unique.ID(up.Context, c.io.ApplicationIDs()), up.ApplicationUp)
+					if topicParts == nil {
+						continue
+					}
+					buf, err := format.FromUp(up.ApplicationUp)
+					if err != nil {
+						logger.WithError(err).Warn("Failed to marshal upstream message")
+						continue
+					}
+					logger.Debug("Publish upstream message")
+					c.session.Publish(&packet.PublishPacket{
+						TopicName:  topic.Join(topicParts),
+						TopicParts: topicParts,
+						QoS:        qosUpstream,
+						Message:    buf,
+					})
 				}
-				if topicParts == nil {
-					continue
-				}
-				buf, err := c.format.FromUp(up.ApplicationUp)
-				if err != nil {
-					logger.WithError(err).Warn("Failed to marshal upstream message")
-					continue
-				}
-				logger.Debug("Publish upstream message")
-				c.session.Publish(&packet.PublishPacket{
-					TopicName:  topic.Join(topicParts),
-					TopicParts: topicParts,
-					QoS:        qosUpstream,
-					Message:    buf,
-				})
 			}
 		}
 	}()
@@ -230,6 +225,66 @@ func (c *connection) setup(ctx context.Context) error {
 	return nil
 }
 
+func upstreamTopic(layout topics.Layout, applicationUID string, up *ttnpb.ApplicationUp) []string {
+	switch up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		return layout.UplinkTopic(applicationUID, up.DeviceId)
+	case *ttnpb.ApplicationUp_JoinAccept:
+		return layout.JoinAcceptTopic(applicationUID, up.DeviceId)
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		return layout.DownlinkAckTopic(applicationUID, up.DeviceId)
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		return layout.DownlinkNackTopic(applicationUID, up.DeviceId)
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		return layout.DownlinkSentTopic(applicationUID, up.DeviceId)
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		return layout.DownlinkFailedTopic(applicationUID, up.DeviceId)
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		return layout.DownlinkQueuedTopic(applicationUID, up.DeviceId)
+	case *ttnpb.ApplicationUp_DownlinkQueueInvalidated:
+		return layout.DownlinkQueueInvalidatedTopic(applicationUID, up.DeviceId)
+	case *ttnpb.ApplicationUp_LocationSolved:
+		return layout.LocationSolvedTopic(applicationUID, up.DeviceId)
+	case *ttnpb.ApplicationUp_ServiceData:
+		return layout.ServiceDataTopic(applicationUID, up.DeviceId)
+	default:
+		return nil
+	}
+}
+
+// upstreamFormats returns the format of the frontend and the topic formats to which the client subscribed.
+func (c *connection) upstreamFormats() []Format {
+	c.topicFormatsMu.RLock()
+	defer c.topicFormatsMu.RUnlock()
+	res := make([]Format, 0, 1+len(c.topicFormats))
+	res = append(res, c.format)
+	for _, format := range topicFormats {
+		if _, ok := c.topicFormats[format]; ok {
+			res = append(res, format)
+		}
+	}
+	return res
+}
+
+// removeTopicFormats removes the topic formats to which the client is no longer subscribed.
+func (c *connection) removeTopicFormats(applicationUID string) {
+	subscriptions := c.session.Subscriptions()
+	c.topicFormatsMu.Lock()
+	defer c.topicFormatsMu.Unlock()
+	for format := range c.topicFormats {
+		subscribed := false
+		for subscription := range subscriptions {
+			if _, ok := format.AcceptedTopic(applicationUID, topic.Split(subscription)); ok {
+				subscribed = true
+				break
+			}
+		}
+		if !subscribed {
+			delete(c.topicFormats, format)
+		}
+	}
+}
+
 type topicAccess struct {
 	appUID string
 	reads  [][]string
@@ -289,18 +344,20 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (_ context.Co
 		appUID: uid,
 	}
 	if err := rights.RequireApplication(ctx, ids, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err == nil {
-		access.reads = append(access.reads,
-			c.format.UplinkTopic(uid, topic.PartWildcard),
-			c.format.JoinAcceptTopic(uid, topic.PartWildcard),
-			c.format.DownlinkAckTopic(uid, topic.PartWildcard),
-			c.format.DownlinkNackTopic(uid, topic.PartWildcard),
-			c.format.DownlinkSentTopic(uid, topic.PartWildcard),
-			c.format.DownlinkFailedTopic(uid, topic.PartWildcard),
-			c.format.DownlinkQueuedTopic(uid, topic.PartWildcard),
-			c.format.DownlinkQueueInvalidatedTopic(uid, topic.PartWildcard),
-			c.format.LocationSolvedTopic(uid, topic.PartWildcard),
-			c.format.ServiceDataTopic(uid, topic.PartWildcard),
-		)
+		for _, format := range append([]Format{c.format}, topicFormats...) {
+			access.reads = append(access.reads,
+				format.UplinkTopic(uid, topic.PartWildcard),
+				format.JoinAcceptTopic(uid, topic.PartWildcard),
+				format.DownlinkAckTopic(uid, topic.PartWildcard),
+				format.DownlinkNackTopic(uid, topic.PartWildcard),
+				format.DownlinkSentTopic(uid, topic.PartWildcard),
+				format.DownlinkFailedTopic(uid, topic.PartWildcard),
+				format.DownlinkQueuedTopic(uid, topic.PartWildcard),
+				format.DownlinkQueueInvalidatedTopic(uid, topic.PartWildcard),
+				format.LocationSolvedTopic(uid, topic.PartWildcard),
+				format.ServiceDataTopic(uid, topic.PartWildcard),
+			)
+		}
 	}
 	if err := rights.RequireApplication(ctx, ids, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err == nil {
 		access.writes = append(access.writes,
@@ -317,6 +374,17 @@ var errNotAuthorized = errors.DefinePermissionDenied("not_authorized", "not auth
 
 func (c *connection) Subscribe(info *auth.Info, requestedTopic string, requestedQoS byte) (acceptedTopic string, acceptedQoS byte, err error) {
 	access := info.Metadata.(topicAccess)
+	for _, format := range topicFormats {
+		if accepted, ok := format.AcceptedTopic(access.appUID, topic.Split(requestedTopic)); ok {
+			c.topicFormatsMu.Lock()
+			if c.topicFormats == nil {
+				c.topicFormats = make(map[Format]struct{})
+			}
+			c.topicFormats[format] = struct{}{}
+			c.topicFormatsMu.Unlock()
+			return topic.Join(accepted), requestedQoS, nil
+		}
+	}
 	accepted, ok := c.format.AcceptedTopic(access.appUID, topic.Split(requestedTopic))
 	if !ok {
 		return "", 0, errNotAuthorized.New()
@@ -285,6 +285,44 @@ func TestTraffic(t *testing.T) {
 		}
 	})
 
+	t.Run("UpstreamTopicFormat", func(t *testing.T) {
+		a := assertions.New(t)
+
+		topicName := fmt.Sprintf("influxdb/v3/%v/devices/%v/up", unique.ID(ctx, registeredDeviceID.ApplicationIdentifiers), registeredDeviceID.DeviceId)
+		payloadCh := make(chan []byte)
+		token := client.Subscribe(topicName, 1, func(_ mqtt.Client, msg mqtt.Message) {
+			payloadCh <- msg.Payload()
+		})
+		if !token.WaitTimeout(timeout) {
+			t.Fatal("Subscribe timeout")
+		}
+		if !a.So(token.Error(), should.BeNil) {
+			t.FailNow()
+		}
+		defer func() {
+			token := client.Unsubscribe(topicName)
+			if !token.WaitTimeout(timeout) {
+				t.Fatal("Unsubscribe timeout")
+			}
+		}()
+
+		err := sub.Publish(ctx, &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: registeredDeviceID,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{FPort: 42, FCnt: 24},
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		select {
+		case payload := <-payloadCh:
+			a.So(string(payload), should.StartWith, "uplink_message,")
+		case <-time.After(timeout):
+			t.Fatal("Receive expected upstream timeout")
+		}
+	})
+
 	t.Run("Downstream", func(t *testing.T) {
 		for _, tc := range []struct {
 			Topic    string
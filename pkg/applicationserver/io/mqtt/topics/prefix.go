@@ -0,0 +1,120 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+type prefixed struct {
+	prefix string
+	layout Layout
+}
+
+// Prefixed returns a Layout that prepends the given prefix to the topics of the given Layout.
+func Prefixed(prefix string, layout Layout) Layout {
+	return &prefixed{
+		prefix: prefix,
+		layout: layout,
+	}
+}
+
+func (p prefixed) wrap(parts []string) []string {
+	if parts == nil {
+		return nil
+	}
+	return append([]string{p.prefix}, parts...)
+}
+
+func (p prefixed) unwrap(parts []string) ([]string, bool) {
+	if len(parts) < 2 || parts[0] != p.prefix {
+		return nil, false
+	}
+	return parts[1:], true
+}
+
+func (p prefixed) AcceptedTopic(applicationUID string, requested []string) ([]string, bool) {
+	parts, ok := p.unwrap(requested)
+	if !ok {
+		return nil, false
+	}
+	accepted, ok := p.layout.AcceptedTopic(applicationUID, parts)
+	if !ok {
+		return nil, false
+	}
+	return p.wrap(accepted), true
+}
+
+func (p prefixed) UplinkTopic(applicationUID, deviceID string) []string {
+	return p.wrap(p.layout.UplinkTopic(applicationUID, deviceID))
+}
+
+func (p prefixed) JoinAcceptTopic(applicationUID, deviceID string) []string {
+	return p.wrap(p.layout.JoinAcceptTopic(applicationUID, deviceID))
+}
+
+func (p prefixed) DownlinkAckTopic(applicationUID, deviceID string) []string {
+	return p.wrap(p.layout.DownlinkAckTopic(applicationUID, deviceID))
+}
+
+func (p prefixed) DownlinkNackTopic(applicationUID, deviceID string) []string {
+	return p.wrap(p.layout.DownlinkNackTopic(applicationUID, deviceID))
+}
+
+func (p prefixed) DownlinkSentTopic(applicationUID, deviceID string) []string {
+	return p.wrap(p.layout.DownlinkSentTopic(applicationUID, deviceID))
+}
+
+func (p prefixed) DownlinkFailedTopic(applicationUID, deviceID string) []string {
+	return p.wrap(p.layout.DownlinkFailedTopic(applicationUID, deviceID))
+}
+
+func (p prefixed) DownlinkQueuedTopic(applicationUID, deviceID string) []string {
+	return p.wrap(p.layout.DownlinkQueuedTopic(applicationUID, deviceID))
+}
+
+func (p prefixed) DownlinkQueueInvalidatedTopic(applicationUID, deviceID string) []string {
+	return p.wrap(p.layout.DownlinkQueueInvalidatedTopic(applicationUID, deviceID))
+}
+
+func (p prefixed) LocationSolvedTopic(applicationUID, deviceID string) []string {
+	return p.wrap(p.layout.LocationSolvedTopic(applicationUID, deviceID))
+}
+
+func (p prefixed) ServiceDataTopic(applicationUID, deviceID string) []string {
+	return p.wrap(p.layout.ServiceDataTopic(applicationUID, deviceID))
+}
+
+func (p prefixed) DownlinkPushTopic(applicationUID, deviceID string) []string {
+	return p.wrap(p.layout.DownlinkPushTopic(applicationUID, deviceID))
+}
+
+func (p prefixed) IsDownlinkPushTopic(parts []string) bool {
+	parts, ok := p.unwrap(parts)
+	return ok && p.layout.IsDownlinkPushTopic(parts)
+}
+
+func (p prefixed) ParseDownlinkPushTopic(parts []string) (deviceID string) {
+	return p.layout.ParseDownlinkPushTopic(parts[1:])
+}
+
+func (p prefixed) DownlinkReplaceTopic(applicationUID, deviceID string) []string {
+	return p.wrap(p.layout.DownlinkReplaceTopic(applicationUID, deviceID))
+}
+
+func (p prefixed) IsDownlinkReplaceTopic(parts []string) bool {
+	parts, ok := p.unwrap(parts)
+	return ok && p.layout.IsDownlinkReplaceTopic(parts)
+}
+
+func (p prefixed) ParseDownlinkReplaceTopic(parts []string) (deviceID string) {
+	return p.layout.ParseDownlinkReplaceTopic(parts[1:])
+}
@@ -0,0 +1,84 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/TheThingsIndustries/mystique/pkg/topic"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt/topics"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestPrefixedAcceptedTopic(t *testing.T) {
+	uid := unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"})
+	layout := topics.Prefixed("csv", topics.Default)
+	for i, tc := range []struct {
+		Requested,
+		Accepted string
+		OK bool
+	}{
+		{
+			Requested: "#",
+		},
+		{
+			Requested: "v3/#",
+		},
+		{
+			Requested: "csv",
+		},
+		{
+			Requested: "csv/#",
+			Accepted:  fmt.Sprintf("csv/v3/%s/#", uid),
+			OK:        true,
+		},
+		{
+			Requested: "csv/v3/+/devices/+/up",
+			Accepted:  fmt.Sprintf("csv/v3/%s/devices/+/up", uid),
+			OK:        true,
+		},
+		{
+			Requested: "csv/v3/other-app/devices/+/up",
+		},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			a := assertions.New(t)
+			actual, ok := layout.AcceptedTopic(uid, topic.Split(tc.Requested))
+			if !a.So(ok, should.Equal, tc.OK) {
+				t.FailNow()
+			}
+			a.So(topic.Join(actual), should.Equal, tc.Accepted)
+		})
+	}
+}
+
+func TestPrefixedTopics(t *testing.T) {
+	a := assertions.New(t)
+	appUID := unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"})
+	layout := topics.Prefixed("csv", topics.Default)
+
+	a.So(topic.Join(layout.UplinkTopic(appUID, "foo-device")), should.Equal, fmt.Sprintf("csv/v3/%s/devices/foo-device/up", appUID))
+
+	push := layout.DownlinkPushTopic(appUID, "foo-device")
+	a.So(layout.IsDownlinkPushTopic(push), should.BeTrue)
+	a.So(layout.ParseDownlinkPushTopic(push), should.Equal, "foo-device")
+	a.So(layout.IsDownlinkPushTopic(topics.Default.DownlinkPushTopic(appUID, "foo-device")), should.BeFalse)
+}
@@ -22,6 +22,7 @@ import (
 
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/routing"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/errorcontext"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -281,7 +282,7 @@ func (ps *PubSub) start(ctx context.Context, pb *ttnpb.ApplicationPubSub) (err e
 			logger.Debug("Shutdown pub/sub connection success")
 		}
 	}()
-	i.sub, err = ps.server.Subscribe(ctx, "pubsub", pb.Ids.ApplicationIds, false)
+	i.sub, err = ps.server.Subscribe(ctx, "pubsub", pb.Ids.ApplicationIds, false, io.WithFilter(routeFilter(pb)))
 	if err != nil {
 		return err
 	}
@@ -326,3 +327,11 @@ func (ps *PubSub) stop(ctx context.Context, ids *ttnpb.ApplicationPubSubIdentifi
 	}
 	return nil
 }
+
+// routeFilter returns a filter that only passes the upstream messages that are routed to the pub/sub.
+func routeFilter(pb *ttnpb.ApplicationPubSub) func(context.Context, *ttnpb.ApplicationUp) bool {
+	integration := routing.Integration{Kind: routing.PubSub, ID: pb.Ids.PubSubId}
+	return func(ctx context.Context, _ *ttnpb.ApplicationUp) bool {
+		return routing.Routed(ctx, integration, pb.RoutingRules)
+	}
+}
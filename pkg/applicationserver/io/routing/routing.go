@@ -0,0 +1,313 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package routing implements routing rules which control the upstream messages
+// that are sent to the webhook and Pub/Sub integrations.
+package routing
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sync"
+
+	"github.com/dop251/goja/ast"
+	"github.com/dop251/goja/parser"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// IntegrationKind is the kind of an integration.
+type IntegrationKind string
+
+const (
+	// Webhook is the kind of the webhook integrations.
+	Webhook IntegrationKind = "webhooks"
+	// PubSub is the kind of the Pub/Sub integrations.
+	PubSub IntegrationKind = "pubsubs"
+)
+
+// Integration identifies an integration of an application.
+type Integration struct {
+	Kind IntegrationKind
+	ID   string
+}
+
+var (
+	errDeviceIDPattern = errors.DefineInvalidArgument("device_id_pattern", "invalid device ID pattern `{pattern}`")
+	errExpression      = errors.DefineInvalidArgument("expression", "invalid expression `{expression}`")
+)
+
+const expressionScript = `
+function main(payload, f_port) {
+	return Boolean(%s
+	);
+}
+`
+
+// compileExpression compiles the expression and returns the script which evaluates it.
+// The expression must consist of a single JavaScript expression, such that it cannot escape the script.
+func compileExpression(expression string) (string, error) {
+	program, err := parser.ParseFile(nil, "", "("+expression+"\n)", 0)
+	if err != nil {
+		return "", errExpression.WithAttributes("expression", expression).WithCause(err)
+	}
+	if len(program.Body) != 1 {
+		return "", errExpression.WithAttributes("expression", expression)
+	}
+	if _, ok := program.Body[0].(*ast.ExpressionStatement); !ok {
+		return "", errExpression.WithAttributes("expression", expression)
+	}
+	script := fmt.Sprintf(expressionScript, expression)
+	if _, err := parser.ParseFile(nil, "", script, 0); err != nil {
+		return "", errExpression.WithAttributes("expression", expression).WithCause(err)
+	}
+	return script, nil
+}
+
+// ValidateRules returns an error if any of the rules is invalid.
+// The expressions of the rules are compiled in order to reject invalid expressions before they are stored.
+func ValidateRules(rules ...*ttnpb.ApplicationRoutingRule) error {
+	for _, r := range rules {
+		if r.DeviceIdPattern != "" {
+			if _, err := path.Match(r.DeviceIdPattern, ""); err != nil {
+				return errDeviceIDPattern.WithAttributes("pattern", r.DeviceIdPattern).WithCause(err)
+			}
+		}
+		if r.Expression != "" {
+			if _, err := compileExpression(r.Expression); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// needsAttributes returns true if any of the rules requires the end device attributes.
+func needsAttributes(rules []*ttnpb.ApplicationRoutingRule) bool {
+	for _, r := range rules {
+		if len(r.DeviceAttributes) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// EndDeviceFetcher fetches end devices.
+type EndDeviceFetcher interface {
+	Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, fieldMaskPaths ...string) (*ttnpb.EndDevice, error)
+}
+
+// Router prepares the upstream messages for the evaluation of the routing rules of the integrations.
+type Router struct {
+	fetcher EndDeviceFetcher
+	engine  scripting.Engine
+}
+
+// NewRouter returns a new Router. The fetcher is used to retrieve the end device attributes and should be cached,
+// and the engine is used to evaluate the expressions.
+func NewRouter(fetcher EndDeviceFetcher, engine scripting.Engine) *Router {
+	return &Router{
+		fetcher: fetcher,
+		engine:  engine,
+	}
+}
+
+type routesKeyType struct{}
+
+var routesKey routesKeyType
+
+// routes holds the routing decisions for an upstream message.
+type routes struct {
+	mu      sync.Mutex
+	matcher *matcher
+	routed  map[Integration]bool
+}
+
+// Route returns the context in which the upstream message is distributed to the integrations.
+// The routing rules are not evaluated by Route, but by Routed with the rules of the integration that is loaded when
+// the message is delivered, so that changes of the rules take effect immediately on all instances.
+func (r *Router) Route(ctx context.Context, up *ttnpb.ApplicationUp) (context.Context, error) {
+	if r == nil {
+		return ctx, nil
+	}
+	return context.WithValue(ctx, routesKey, &routes{
+		matcher: &matcher{
+			up:          up,
+			fetcher:     r.fetcher,
+			engine:      r.engine,
+			expressions: make(map[string]bool),
+		},
+		routed: make(map[Integration]bool),
+	}), nil
+}
+
+// Routed returns true if the upstream message that is distributed in the context is routed to the integration with
+// the given routing rules. If there are no rules, all messages are routed to the integration. Otherwise, the rules are
+// evaluated at most once per integration and message, and the end device attributes are fetched at most once per
+// message. If the message was not routed by a Router or if the rules cannot be evaluated, the message is not routed
+// to the integration.
+func Routed(ctx context.Context, integration Integration, rules []*ttnpb.ApplicationRoutingRule) bool {
+	if len(rules) == 0 {
+		return true
+	}
+	rs, ok := ctx.Value(routesKey).(*routes)
+	if !ok {
+		return false
+	}
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if ok, evaluated := rs.routed[integration]; evaluated {
+		return ok
+	}
+	ok, err := rs.matcher.match(ctx, rules)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).WithFields(log.Fields(
+			"integration_kind", integration.Kind,
+			"integration_id", integration.ID,
+		)).Warn("Failed to evaluate routing rules")
+		ok = false
+	}
+	rs.routed[integration] = ok
+	return ok
+}
+
+func messageFPort(up *ttnpb.ApplicationUp) (uint32, bool) {
+	switch p := up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		return p.UplinkMessage.FPort, true
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		return p.DownlinkAck.FPort, true
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		return p.DownlinkNack.FPort, true
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		return p.DownlinkSent.FPort, true
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		return p.DownlinkQueued.FPort, true
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		return p.DownlinkFailed.FPort, true
+	default:
+		return 0, false
+	}
+}
+
+// matcher matches an upstream message against routing rules.
+// The end device attributes and the results of the expressions are reused for all rules.
+type matcher struct {
+	up      *ttnpb.ApplicationUp
+	fetcher EndDeviceFetcher
+	engine  scripting.Engine
+
+	attributes    map[string]string
+	attributesErr error
+	fetched       bool
+	expressions   map[string]bool
+}
+
+// match returns true if the message matches any of the rules.
+func (m *matcher) match(ctx context.Context, rules []*ttnpb.ApplicationRoutingRule) (bool, error) {
+	if needsAttributes(rules) && !m.fetched {
+		dev, err := m.fetcher.Get(ctx, m.up.EndDeviceIdentifiers, "attributes")
+		if err != nil {
+			m.attributesErr = err
+		} else {
+			m.attributes = dev.Attributes
+		}
+		m.fetched = true
+	}
+	for _, r := range rules {
+		ok, err := m.matchRule(ctx, r)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *matcher) matchRule(ctx context.Context, r *ttnpb.ApplicationRoutingRule) (bool, error) {
+	up := m.up
+	if r.DeviceIdPattern != "" {
+		if ok, err := path.Match(r.DeviceIdPattern, up.DeviceId); err != nil || !ok {
+			return false, err
+		}
+	}
+	if len(r.DeviceAttributes) > 0 && m.attributesErr != nil {
+		return false, m.attributesErr
+	}
+	for key, value := range r.DeviceAttributes {
+		actual, ok := m.attributes[key]
+		if !ok || (value != "" && actual != value) {
+			return false, nil
+		}
+	}
+	if len(r.FPorts) > 0 {
+		fPort, ok := messageFPort(up)
+		if !ok {
+			return false, nil
+		}
+		found := false
+		for _, p := range r.FPorts {
+			if p == fPort {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	if r.Expression != "" {
+		if ok, evaluated := m.expressions[r.Expression]; evaluated {
+			return ok, nil
+		}
+		ok, err := m.evaluate(ctx, r.Expression)
+		if err != nil {
+			return false, err
+		}
+		m.expressions[r.Expression] = ok
+		return ok, nil
+	}
+	return true, nil
+}
+
+func (m *matcher) evaluate(ctx context.Context, expression string) (bool, error) {
+	uplink := m.up.GetUplinkMessage()
+	if uplink == nil {
+		return false, nil
+	}
+	// The rules are validated when they are set, so the expression is known to be a single expression.
+	script := fmt.Sprintf(expressionScript, expression)
+	payload, err := gogoproto.Map(uplink.DecodedPayload)
+	if err != nil {
+		return false, errExpression.WithAttributes("expression", expression).WithCause(err)
+	}
+	if payload == nil {
+		payload = map[string]interface{}{}
+	}
+	valueAs, err := m.engine.Run(ctx, script, "main", payload, uplink.FPort)
+	if err != nil {
+		return false, errExpression.WithAttributes("expression", expression).WithCause(err)
+	}
+	var ok bool
+	if err := valueAs(&ok); err != nil {
+		return false, errExpression.WithAttributes("expression", expression).WithCause(err)
+	}
+	return ok, nil
+}
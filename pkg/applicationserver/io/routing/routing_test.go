@@ -0,0 +1,216 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/routing"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting/javascript"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type mockFetcher struct {
+	attributes map[string]map[string]string
+	gets       int
+}
+
+func (f *mockFetcher) Get(_ context.Context, ids ttnpb.EndDeviceIdentifiers, _ ...string) (*ttnpb.EndDevice, error) {
+	f.gets++
+	return &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ids,
+		Attributes:           f.attributes[ids.DeviceId],
+	}, nil
+}
+
+func uplink(deviceID string, fPort uint32, temperature float64) *ttnpb.ApplicationUp {
+	return &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationId: "foo-app",
+			},
+			DeviceId: deviceID,
+		},
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort: fPort,
+				DecodedPayload: &pbtypes.Struct{
+					Fields: map[string]*pbtypes.Value{
+						"temperature": {
+							Kind: &pbtypes.Value_NumberValue{
+								NumberValue: temperature,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestRouter(t *testing.T) {
+	integration := routing.Integration{Kind: routing.Webhook, ID: "foo"}
+
+	for i, tc := range []struct {
+		Rules   []*ttnpb.ApplicationRoutingRule
+		Message *ttnpb.ApplicationUp
+		Routed  bool
+	}{
+		{
+			Message: uplink("sensor-1", 1, 20),
+			Routed:  true,
+		},
+		{
+			Rules:   []*ttnpb.ApplicationRoutingRule{{DeviceIdPattern: "alarm-*"}},
+			Message: uplink("alarm-1", 1, 20),
+			Routed:  true,
+		},
+		{
+			Rules:   []*ttnpb.ApplicationRoutingRule{{DeviceIdPattern: "alarm-*"}},
+			Message: uplink("sensor-1", 1, 20),
+			Routed:  false,
+		},
+		{
+			Rules:   []*ttnpb.ApplicationRoutingRule{{DeviceAttributes: map[string]string{"site": "roof"}}},
+			Message: uplink("sensor-1", 1, 20),
+			Routed:  true,
+		},
+		{
+			Rules:   []*ttnpb.ApplicationRoutingRule{{DeviceAttributes: map[string]string{"paging": ""}}},
+			Message: uplink("sensor-1", 1, 20),
+			Routed:  false,
+		},
+		{
+			Rules:   []*ttnpb.ApplicationRoutingRule{{FPorts: []uint32{2, 3}}},
+			Message: uplink("sensor-1", 3, 20),
+			Routed:  true,
+		},
+		{
+			Rules:   []*ttnpb.ApplicationRoutingRule{{FPorts: []uint32{2, 3}}},
+			Message: uplink("sensor-1", 1, 20),
+			Routed:  false,
+		},
+		{
+			Rules:   []*ttnpb.ApplicationRoutingRule{{Expression: "payload.temperature > 30 && f_port === 1"}},
+			Message: uplink("sensor-1", 1, 42),
+			Routed:  true,
+		},
+		{
+			Rules:   []*ttnpb.ApplicationRoutingRule{{Expression: "payload.temperature > 30"}},
+			Message: uplink("sensor-1", 1, 20),
+			Routed:  false,
+		},
+		{
+			Rules: []*ttnpb.ApplicationRoutingRule{
+				{DeviceIdPattern: "alarm-*", FPorts: []uint32{2}},
+				{Expression: "payload.temperature > 30"},
+			},
+			Message: uplink("sensor-1", 1, 42),
+			Routed:  true,
+		},
+		{
+			Rules: []*ttnpb.ApplicationRoutingRule{{Expression: "payload.temperature > 30"}},
+			Message: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+						ApplicationId: "foo-app",
+					},
+					DeviceId: "sensor-1",
+				},
+				Up: &ttnpb.ApplicationUp_JoinAccept{
+					JoinAccept: &ttnpb.ApplicationJoinAccept{},
+				},
+			},
+			Routed: false,
+		},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			a := assertions.New(t)
+			fetcher := &mockFetcher{attributes: map[string]map[string]string{
+				"alarm-1":  {"site": "basement", "paging": ""},
+				"sensor-1": {"site": "roof"},
+			}}
+			router := routing.NewRouter(fetcher, javascript.New(scripting.DefaultOptions))
+			ctx, err := router.Route(test.Context(), tc.Message)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(routing.Routed(ctx, integration, tc.Rules), should.Equal, tc.Routed)
+		})
+	}
+}
+
+func TestRouterFetchesAttributesOnce(t *testing.T) {
+	a := assertions.New(t)
+	fetcher := &mockFetcher{attributes: map[string]map[string]string{
+		"sensor-1": {"site": "roof"},
+	}}
+	hook := routing.Integration{Kind: routing.Webhook, ID: "foo"}
+	pubsub := routing.Integration{Kind: routing.PubSub, ID: "bar"}
+	router := routing.NewRouter(fetcher, javascript.New(scripting.DefaultOptions))
+
+	ctx, err := router.Route(test.Context(), uplink("sensor-1", 1, 20))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(routing.Routed(ctx, hook, []*ttnpb.ApplicationRoutingRule{{DeviceAttributes: map[string]string{"site": "roof"}}}), should.BeTrue)
+	a.So(routing.Routed(ctx, pubsub, []*ttnpb.ApplicationRoutingRule{{DeviceAttributes: map[string]string{"site": "basement"}}}), should.BeFalse)
+	a.So(fetcher.gets, should.Equal, 1)
+}
+
+func TestRouterRuleChanges(t *testing.T) {
+	a := assertions.New(t)
+	hook := routing.Integration{Kind: routing.Webhook, ID: "foo"}
+	router := routing.NewRouter(&mockFetcher{}, javascript.New(scripting.DefaultOptions))
+
+	ctx, err := router.Route(test.Context(), uplink("sensor-1", 1, 20))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(routing.Routed(ctx, hook, []*ttnpb.ApplicationRoutingRule{{DeviceIdPattern: "alarm-*"}}), should.BeFalse)
+
+	// The rules of the integration that is loaded for the next message apply immediately.
+	ctx, err = router.Route(test.Context(), uplink("sensor-1", 1, 20))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(routing.Routed(ctx, hook, []*ttnpb.ApplicationRoutingRule{{DeviceIdPattern: "sensor-*"}}), should.BeTrue)
+}
+
+func TestRoutedWithoutRouter(t *testing.T) {
+	a := assertions.New(t)
+	hook := routing.Integration{Kind: routing.Webhook, ID: "foo"}
+	ctx := test.Context()
+	a.So(routing.Routed(ctx, hook, nil), should.BeTrue)
+	a.So(routing.Routed(ctx, hook, []*ttnpb.ApplicationRoutingRule{{DeviceIdPattern: "sensor-*"}}), should.BeFalse)
+}
+
+func TestValidateRules(t *testing.T) {
+	a := assertions.New(t)
+	a.So(routing.ValidateRules(&ttnpb.ApplicationRoutingRule{DeviceIdPattern: "alarm-*"}), should.BeNil)
+	a.So(routing.ValidateRules(&ttnpb.ApplicationRoutingRule{DeviceIdPattern: "alarm-["}), should.NotBeNil)
+	a.So(routing.ValidateRules(&ttnpb.ApplicationRoutingRule{Expression: "payload.temperature > 30 && f_port === 1"}), should.BeNil)
+	a.So(routing.ValidateRules(&ttnpb.ApplicationRoutingRule{Expression: "payload.temperature >"}), should.NotBeNil)
+	a.So(routing.ValidateRules(&ttnpb.ApplicationRoutingRule{Expression: "true); while (true) {}; (true"}), should.NotBeNil)
+	a.So(routing.ValidateRules(&ttnpb.ApplicationRoutingRule{Expression: "true // comment"}), should.BeNil)
+}
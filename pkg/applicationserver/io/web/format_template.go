@@ -0,0 +1,306 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/formatters"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// templateFormat is the name of the format that renders upstream messages using the body template of the webhook.
+const templateFormat = "template"
+
+const defaultTemplateContentType = "text/plain"
+
+func init() {
+	formats[templateFormat] = Format{
+		Formatter:   formatters.JSON,
+		Name:        "Template",
+		ContentType: defaultTemplateContentType,
+	}
+}
+
+var (
+	errNoBodyTemplate   = errors.DefineInvalidArgument("no_body_template", "no body template for format `template`")
+	errTemplateRender   = errors.DefineAborted("template_render", "render template")
+	errTemplateOutput   = errors.DefineAborted("template_output", "invalid template output")
+	errTemplateInput    = errors.DefineInvalidArgument("template_input", "invalid template input")
+	errTemplateLanguage = errors.DefineInvalidArgument("template_language", "invalid template language `{language}`")
+)
+
+const (
+	// maxGoTemplateOutputSize is the maximum size of the body rendered by Go templates.
+	maxGoTemplateOutputSize = 1 << 16
+	// maxGoTemplateRangeDepth is the maximum nesting depth of range actions in Go templates.
+	maxGoTemplateRangeDepth = 2
+	// goTemplateTimeout is the maximum duration of rendering Go templates.
+	goTemplateTimeout = 100 * time.Millisecond
+)
+
+var (
+	errGoTemplateFunction = errors.DefineInvalidArgument("go_template_function", "function `{function}` not allowed in template")
+	errGoTemplateNested   = errors.DefineInvalidArgument("go_template_nested", "nested templates not allowed")
+	errGoTemplateRange    = errors.DefineInvalidArgument("go_template_range", "range actions must range over a field or a variable, nested at most {max} deep")
+	errGoTemplateOutput   = errors.DefineResourceExhausted("go_template_output", "template output exceeds {max} bytes")
+)
+
+// goTemplateFunctions are the functions that Go templates can use. Built-in functions that are not in this list, like
+// `call` and `printf`, are not allowed.
+var goTemplateFunctions = map[string]bool{
+	"and":         true,
+	"contentType": true,
+	"eq":          true,
+	"ge":          true,
+	"gt":          true,
+	"html":        true,
+	"index":       true,
+	"js":          true,
+	"json":        true,
+	"le":          true,
+	"len":         true,
+	"lt":          true,
+	"ne":          true,
+	"not":         true,
+	"or":          true,
+	"print":       true,
+	"slice":       true,
+	"urlquery":    true,
+}
+
+// checkGoTemplateNode checks that the node only uses the allowed functions, does not execute other templates and only
+// ranges over data, so that rendering is bounded by the size of the message.
+func checkGoTemplateNode(node parse.Node, rangeDepth int) error {
+	switch node := node.(type) {
+	case *parse.ListNode:
+		if node == nil {
+			return nil
+		}
+		for _, n := range node.Nodes {
+			if err := checkGoTemplateNode(n, rangeDepth); err != nil {
+				return err
+			}
+		}
+	case *parse.ActionNode:
+		return checkGoTemplateNode(node.Pipe, rangeDepth)
+	case *parse.IfNode:
+		return checkGoTemplateBranch(&node.BranchNode, rangeDepth)
+	case *parse.WithNode:
+		return checkGoTemplateBranch(&node.BranchNode, rangeDepth)
+	case *parse.RangeNode:
+		if rangeDepth >= maxGoTemplateRangeDepth || len(node.Pipe.Cmds) != 1 || len(node.Pipe.Cmds[0].Args) != 1 {
+			return errGoTemplateRange.WithAttributes("max", maxGoTemplateRangeDepth)
+		}
+		switch node.Pipe.Cmds[0].Args[0].(type) {
+		case *parse.FieldNode, *parse.VariableNode, *parse.DotNode, *parse.ChainNode:
+		default:
+			return errGoTemplateRange.WithAttributes("max", maxGoTemplateRangeDepth)
+		}
+		return checkGoTemplateBranch(&node.BranchNode, rangeDepth+1)
+	case *parse.TemplateNode:
+		return errGoTemplateNested.New()
+	case *parse.PipeNode:
+		if node == nil {
+			return nil
+		}
+		for _, cmd := range node.Cmds {
+			if err := checkGoTemplateNode(cmd, rangeDepth); err != nil {
+				return err
+			}
+		}
+	case *parse.CommandNode:
+		for _, arg := range node.Args {
+			if err := checkGoTemplateNode(arg, rangeDepth); err != nil {
+				return err
+			}
+		}
+	case *parse.ChainNode:
+		return checkGoTemplateNode(node.Node, rangeDepth)
+	case *parse.IdentifierNode:
+		if !goTemplateFunctions[node.Ident] {
+			return errGoTemplateFunction.WithAttributes("function", node.Ident)
+		}
+	}
+	return nil
+}
+
+func checkGoTemplateBranch(node *parse.BranchNode, rangeDepth int) error {
+	if err := checkGoTemplateNode(node.Pipe, rangeDepth); err != nil {
+		return err
+	}
+	if err := checkGoTemplateNode(node.List, rangeDepth); err != nil {
+		return err
+	}
+	return checkGoTemplateNode(node.ElseList, rangeDepth)
+}
+
+// goTemplateWriter limits the size and the duration of rendering Go templates.
+type goTemplateWriter struct {
+	ctx context.Context
+	buf bytes.Buffer
+	err error
+}
+
+// Write implements io.Writer.
+func (w *goTemplateWriter) Write(p []byte) (int, error) {
+	if err := w.ctx.Err(); err != nil {
+		w.err = errTemplateRender.WithCause(err)
+		return 0, w.err
+	}
+	if w.buf.Len()+len(p) > maxGoTemplateOutputSize {
+		w.err = errGoTemplateOutput.WithAttributes("max", maxGoTemplateOutputSize)
+		return 0, w.err
+	}
+	return w.buf.Write(p)
+}
+
+// goTemplateData returns the template input with integral numbers as integers, so that they render like in JSON.
+func goTemplateData(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = goTemplateData(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = goTemplateData(e)
+		}
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+			return int64(v)
+		}
+	}
+	return v
+}
+
+// renderGoTemplate renders the body and the content type of the template input using the Go text/template.
+// The template can only use the functions in goTemplateFunctions and can not define or execute other templates.
+func renderGoTemplate(ctx context.Context, text string, input map[string]interface{}) ([]byte, string, error) {
+	var contentType string
+	tmpl, err := template.New("body").Funcs(template.FuncMap{
+		"contentType": func(v string) string {
+			contentType = v
+			return ""
+		},
+		"json": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}).Parse(text)
+	if err != nil {
+		return nil, "", errTemplateRender.WithCause(err)
+	}
+	if len(tmpl.Templates()) > 1 {
+		return nil, "", errGoTemplateNested.New()
+	}
+	if err := checkGoTemplateNode(tmpl.Tree.Root, 0); err != nil {
+		return nil, "", err
+	}
+	ctx, cancel := context.WithTimeout(ctx, goTemplateTimeout)
+	defer cancel()
+	w := &goTemplateWriter{ctx: ctx}
+	if err := tmpl.Execute(w, goTemplateData(input)); err != nil {
+		if w.err != nil {
+			return nil, "", w.err
+		}
+		return nil, "", errTemplateRender.WithCause(err)
+	}
+	return w.buf.Bytes(), contentType, nil
+}
+
+// templateInput returns the representation of the message that is exposed to body templates.
+// The representation matches the JSON format, so that templates can refer to the same field names as
+// the JSON webhooks, i.e. `uplink_message.decoded_payload`.
+func templateInput(msg *ttnpb.ApplicationUp) (map[string]interface{}, error) {
+	buf, err := jsonpb.TTN().Marshal(msg)
+	if err != nil {
+		return nil, errTemplateInput.WithCause(err)
+	}
+	var input map[string]interface{}
+	if err := json.Unmarshal(buf, &input); err != nil {
+		return nil, errTemplateInput.WithCause(err)
+	}
+	return input, nil
+}
+
+// RenderBodyTemplate renders the body and the content type of the given upstream message using the body template.
+// The template language determines how the template is executed:
+//
+//   - GO_TEMPLATE templates are rendered with text/template, see renderGoTemplate.
+//   - JAVASCRIPT templates are executed in the given scripting engine and must define a `formatUp(up)` function which returns either a string, which is used as body,
+//     or an object with the `body` and `contentType` fields.
+func RenderBodyTemplate(ctx context.Context, engine scripting.Engine, tmpl *ttnpb.ApplicationWebhook_BodyTemplate, msg *ttnpb.ApplicationUp) ([]byte, string, error) {
+	if tmpl == nil {
+		return nil, "", errNoBodyTemplate.New()
+	}
+	input, err := templateInput(msg)
+	if err != nil {
+		return nil, "", err
+	}
+	var valueAs func(interface{}) error
+	switch tmpl.Language {
+	case ttnpb.ApplicationWebhook_BodyTemplate_GO_TEMPLATE:
+		body, contentType, err := renderGoTemplate(ctx, tmpl.Template, input)
+		if err != nil {
+			return nil, "", err
+		}
+		if contentType == "" {
+			contentType = defaultTemplateContentType
+		}
+		return body, contentType, nil
+	case ttnpb.ApplicationWebhook_BodyTemplate_JAVASCRIPT:
+		valueAs, err = engine.Run(ctx, tmpl.Template, "formatUp", input)
+	default:
+		return nil, "", errTemplateLanguage.WithAttributes("language", tmpl.Language)
+	}
+	if err != nil {
+		return nil, "", errTemplateRender.WithCause(err)
+	}
+	var output interface{}
+	if err := valueAs(&output); err != nil {
+		return nil, "", errTemplateOutput.WithCause(err)
+	}
+	var body, contentType string
+	switch output := output.(type) {
+	case string:
+		body = output
+	case map[string]interface{}:
+		var ok bool
+		if body, ok = output["body"].(string); !ok {
+			return nil, "", errTemplateOutput.New()
+		}
+		if v, ok := output["contentType"]; ok && v != nil {
+			if contentType, ok = v.(string); !ok {
+				return nil, "", errTemplateOutput.New()
+			}
+		}
+	default:
+		return nil, "", errTemplateOutput.New()
+	}
+	if contentType == "" {
+		contentType = defaultTemplateContentType
+	}
+	return []byte(body), contentType, nil
+}
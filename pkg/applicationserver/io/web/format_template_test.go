@@ -0,0 +1,190 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting/javascript"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRenderBodyTemplate(t *testing.T) {
+	msg := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationId: "foo-app",
+			},
+			DeviceId: "foo-device",
+		},
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort: 42,
+				FCnt:  1000000,
+				DecodedPayload: &pbtypes.Struct{
+					Fields: map[string]*pbtypes.Value{
+						"temperature": {
+							Kind: &pbtypes.Value_NumberValue{
+								NumberValue: 21.5,
+							},
+						},
+						"readings": {
+							Kind: &pbtypes.Value_ListValue{
+								ListValue: &pbtypes.ListValue{
+									Values: []*pbtypes.Value{
+										{Kind: &pbtypes.Value_NumberValue{NumberValue: 1}},
+										{Kind: &pbtypes.Value_NumberValue{NumberValue: 2}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	engine := javascript.New(scripting.DefaultOptions)
+
+	for i, tc := range []struct {
+		Language       ttnpb.ApplicationWebhook_BodyTemplate_Language
+		Template       string
+		Body           string
+		ContentType    string
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Language:    ttnpb.ApplicationWebhook_BodyTemplate_GO_TEMPLATE,
+			Template:    `{{ contentType "text/plain; charset=utf-8" }}temperature,device={{ .end_device_ids.device_id }} value={{ .uplink_message.decoded_payload.temperature }}`,
+			Body:        "temperature,device=foo-device value=21.5",
+			ContentType: "text/plain; charset=utf-8",
+		},
+		{
+			Language:    ttnpb.ApplicationWebhook_BodyTemplate_GO_TEMPLATE,
+			Template:    `{"text":{{ json .uplink_message.decoded_payload.temperature }}}`,
+			Body:        `{"text":21.5}`,
+			ContentType: "text/plain",
+		},
+		{
+			Language: ttnpb.ApplicationWebhook_BodyTemplate_GO_TEMPLATE,
+			Template: `{{/* Missing fields are skipped with if or with. */}}
+{{- with .uplink_message.frm_payload }}payload={{ . }}{{ end -}}
+  port={{ .uplink_message.f_port }} f_cnt={{ .uplink_message.f_cnt }}`,
+			Body:        "port=42 f_cnt=1000000",
+			ContentType: "text/plain",
+		},
+		{
+			Language:    ttnpb.ApplicationWebhook_BodyTemplate_GO_TEMPLATE,
+			Template:    `{{ range $i, $v := .uplink_message.decoded_payload.readings }}{{ if $i }},{{ end }}{{ $v }}{{ end }}{{ if gt .uplink_message.f_port 10 }};high{{ else }};low{{ end }}`,
+			Body:        "1,2;high",
+			ContentType: "text/plain",
+		},
+		{
+			Language:       ttnpb.ApplicationWebhook_BodyTemplate_GO_TEMPLATE,
+			Template:       `{{ printf "%0999999999d" 1 }}`,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Language:       ttnpb.ApplicationWebhook_BodyTemplate_GO_TEMPLATE,
+			Template:       `{{ define "loop" }}{{ template "loop" . }}{{ end }}{{ template "loop" . }}`,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Language:       ttnpb.ApplicationWebhook_BodyTemplate_GO_TEMPLATE,
+			Template:       `{{ range 1000000000 }}{{ end }}`,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Language:       ttnpb.ApplicationWebhook_BodyTemplate_GO_TEMPLATE,
+			Template:       `{{ range .a }}{{ range .b }}{{ range .c }}{{ end }}{{ end }}{{ end }}`,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Language:       ttnpb.ApplicationWebhook_BodyTemplate_GO_TEMPLATE,
+			Template:       strings.Repeat("x", 1<<17),
+			ErrorAssertion: errors.IsResourceExhausted,
+		},
+		{
+			Language:       ttnpb.ApplicationWebhook_BodyTemplate_GO_TEMPLATE,
+			Template:       `{{ .end_device_ids`,
+			ErrorAssertion: func(err error) bool { return err != nil },
+		},
+		{
+			Language: ttnpb.ApplicationWebhook_BodyTemplate_JAVASCRIPT,
+			Template: `function formatUp(up) {
+				return {
+					body: JSON.stringify({ text: up.end_device_ids.device_id + ": " + up.uplink_message.decoded_payload.temperature }),
+					contentType: "application/json",
+				};
+			}`,
+			Body:        `{"text":"foo-device: 21.5"}`,
+			ContentType: "application/json",
+		},
+		{
+			Language: ttnpb.ApplicationWebhook_BodyTemplate_JAVASCRIPT,
+			Template: `function formatUp(up) {
+				return "port " + up.uplink_message.f_port;
+			}`,
+			Body:        "port 42",
+			ContentType: "text/plain",
+		},
+		{
+			Language: ttnpb.ApplicationWebhook_BodyTemplate_JAVASCRIPT,
+			Template: `function formatUp(up) {
+				throw new Error("unsupported");
+			}`,
+			ErrorAssertion: func(err error) bool { return err != nil },
+		},
+		{
+			Language: ttnpb.ApplicationWebhook_BodyTemplate_JAVASCRIPT,
+			Template: `function formatUp(up) {
+				while (true) {}
+			}`,
+			ErrorAssertion: func(err error) bool { return err != nil },
+		},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := test.Context()
+
+			body, contentType, err := web.RenderBodyTemplate(ctx, engine, &ttnpb.ApplicationWebhook_BodyTemplate{
+				Language: tc.Language,
+				Template: tc.Template,
+			}, msg)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(string(body), should.Equal, tc.Body)
+			a.So(contentType, should.Equal, tc.ContentType)
+		})
+	}
+
+	_, _, err := web.RenderBodyTemplate(test.Context(), engine, nil, msg)
+	assertions.New(t).So(err, should.NotBeNil)
+}
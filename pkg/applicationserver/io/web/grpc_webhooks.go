@@ -19,6 +19,7 @@ import (
 	"strconv"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/routing"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
@@ -31,8 +32,9 @@ func setTotalHeader(ctx context.Context, total uint64) {
 
 // appendImplicitWebhookGetPaths appends implicit ttnpb.ApplicationWebhook get paths to paths.
 func appendImplicitWebhookGetPaths(paths ...string) []string {
-	return append(append(make([]string, 0, 2+len(paths)),
+	return append(append(make([]string, 0, 3+len(paths)),
 		"base_url",
+		"body_template",
 		"format",
 	), paths...)
 }
@@ -101,8 +103,25 @@ func (s webhookRegistryRPC) Set(ctx context.Context, req *ttnpb.SetApplicationWe
 	); err != nil {
 		return nil, err
 	}
-	return s.webhooks.Set(ctx, req.Webhook.Ids, appendImplicitWebhookGetPaths(req.FieldMask.GetPaths()...),
+	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "routing_rules") {
+		if err := routing.ValidateRules(req.Webhook.RoutingRules...); err != nil {
+			return nil, err
+		}
+	}
+	webhook, err := s.webhooks.Set(ctx, req.Webhook.Ids, appendImplicitWebhookGetPaths(req.FieldMask.GetPaths()...),
 		func(webhook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			format, bodyTemplate := req.Webhook.Format, req.Webhook.BodyTemplate
+			if webhook != nil {
+				if !ttnpb.HasAnyField(req.FieldMask.GetPaths(), "format") {
+					format = webhook.Format
+				}
+				if !ttnpb.HasAnyField(req.FieldMask.GetPaths(), "body_template") {
+					bodyTemplate = webhook.BodyTemplate
+				}
+			}
+			if format == templateFormat && bodyTemplate == nil {
+				return nil, nil, errNoBodyTemplate.New()
+			}
 			if webhook != nil {
 				return req.Webhook, req.FieldMask.GetPaths(), nil
 			}
@@ -112,6 +131,10 @@ func (s webhookRegistryRPC) Set(ctx context.Context, req *ttnpb.SetApplicationWe
 			), nil
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
+	return webhook, nil
 }
 
 func (s webhookRegistryRPC) Delete(ctx context.Context, req *ttnpb.ApplicationWebhookIdentifiers) (*pbtypes.Empty, error) {
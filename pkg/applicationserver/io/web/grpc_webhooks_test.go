@@ -28,6 +28,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
@@ -78,8 +79,11 @@ func TestWebhookRegistryRPC(t *testing.T) {
 		res, err := client.GetFormats(ctx, ttnpb.Empty, creds)
 		a.So(err, should.BeNil)
 		a.So(res.Formats, should.HaveSameElementsDeep, map[string]string{
+			"csv":      "CSV",
+			"influxdb": "InfluxDB line protocol",
 			"json":     "JSON",
 			"protobuf": "Protocol Buffers",
+			"template": "Template",
 		})
 	}
 
@@ -112,6 +116,43 @@ func TestWebhookRegistryRPC(t *testing.T) {
 		a.So(err, should.BeNil)
 	}
 
+	// Set the template format without a body template; assert failure.
+	{
+		_, err := client.Set(ctx, &ttnpb.SetApplicationWebhookRequest{
+			Webhook: &ttnpb.ApplicationWebhook{
+				Ids: &ttnpb.ApplicationWebhookIdentifiers{
+					ApplicationIds: &registeredApplicationID,
+					WebhookId:      registeredWebhookID,
+				},
+				Format: "template",
+			},
+			FieldMask: &pbtypes.FieldMask{
+				Paths: []string{"format"},
+			},
+		}, creds)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+
+	// Set the template format with a body template.
+	{
+		_, err := client.Set(ctx, &ttnpb.SetApplicationWebhookRequest{
+			Webhook: &ttnpb.ApplicationWebhook{
+				Ids: &ttnpb.ApplicationWebhookIdentifiers{
+					ApplicationIds: &registeredApplicationID,
+					WebhookId:      registeredWebhookID,
+				},
+				Format: "template",
+				BodyTemplate: &ttnpb.ApplicationWebhook_BodyTemplate{
+					Template: "{{ .end_device_ids.device_id }}",
+				},
+			},
+			FieldMask: &pbtypes.FieldMask{
+				Paths: []string{"body_template", "format"},
+			},
+		}, creds)
+		a.So(err, should.BeNil)
+	}
+
 	// List; assert one.
 	{
 		res, err := client.List(ctx, &ttnpb.ListApplicationWebhooksRequest{
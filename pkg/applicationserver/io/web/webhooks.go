@@ -30,9 +30,12 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/jtacoma/uritemplates"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/routing"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/gogoproto"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting/javascript"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	ttnweb "go.thethings.network/lorawan-stack/v3/pkg/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
@@ -146,6 +149,7 @@ type webhooks struct {
 	registry  WebhookRegistry
 	target    Sink
 	downlinks DownlinksConfig
+	engine    scripting.Engine
 }
 
 // NewWebhooks returns a new Webhooks.
@@ -157,6 +161,7 @@ func NewWebhooks(ctx context.Context, server io.Server, registry WebhookRegistry
 		registry:  registry,
 		target:    target,
 		downlinks: downlinks,
+		engine:    javascript.New(scripting.DefaultOptions),
 	}
 	sub, err := server.Subscribe(ctx, "webhooks", nil, false)
 	if err != nil {
@@ -243,6 +248,7 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 			"headers",
 			"join_accept",
 			"location_solved",
+			"routing_rules",
 			"service_data",
 			"uplink_message",
 		},
@@ -254,6 +260,9 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 	wg := sync.WaitGroup{}
 	for i := range hooks {
 		hook := hooks[i]
+		if !routing.Routed(ctx, routing.Integration{Kind: routing.Webhook, ID: hook.Ids.WebhookId}, hook.RoutingRules) {
+			continue
+		}
 		ctx := withWebhookID(ctx, hook.Ids)
 		logger := log.FromContext(ctx).WithField("hook", hook.Ids.WebhookId)
 		wg.Add(1)
@@ -333,7 +342,13 @@ func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hoo
 	if !ok {
 		return nil, errFormatNotFound.WithAttributes("format", hook.Format)
 	}
-	buf, err := format.FromUp(msg)
+	var buf []byte
+	contentType := format.ContentType
+	if hook.Format == templateFormat {
+		buf, contentType, err = RenderBodyTemplate(ctx, w.engine, hook.BodyTemplate, msg)
+	} else {
+		buf, err = format.FromUp(msg)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -352,7 +367,7 @@ func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hoo
 	if domain := w.createDomain(ctx); domain != "" {
 		req.Header.Set(domainHeader, domain)
 	}
-	req.Header.Set("Content-Type", format.ContentType)
+	req.Header.Set("Content-Type", contentType)
 	return req, nil
 }
 
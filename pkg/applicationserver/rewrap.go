@@ -0,0 +1,79 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var rewrapDevicePaths = []string{
+	"ids",
+	"pending_session.keys.app_s_key",
+	"session.keys.app_s_key",
+}
+
+func deviceKeyEnvelopes(dev *ttnpb.EndDevice) map[string]**ttnpb.KeyEnvelope {
+	envelopes := make(map[string]**ttnpb.KeyEnvelope)
+	if dev.Session != nil {
+		envelopes["session.keys.app_s_key"] = &dev.Session.AppSKey
+	}
+	if dev.PendingSession != nil {
+		envelopes["pending_session.keys.app_s_key"] = &dev.PendingSession.AppSKey
+	}
+	return envelopes
+}
+
+// deviceRewrapRegistry is the cryptoutil.RewrapRegistry of the application session keys of devices.
+type deviceRewrapRegistry struct {
+	DeviceRegistry
+}
+
+func (r deviceRewrapRegistry) Range(ctx context.Context, f func(context.Context, ttnpb.IDStringer, map[string]**ttnpb.KeyEnvelope) bool) error {
+	return r.DeviceRegistry.Range(ctx, rewrapDevicePaths, func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, dev *ttnpb.EndDevice) bool {
+		return f(ctx, ids, deviceKeyEnvelopes(dev))
+	})
+}
+
+func (r deviceRewrapRegistry) Update(ctx context.Context, ids ttnpb.IDStringer, f func(context.Context, map[string]**ttnpb.KeyEnvelope) ([]string, error)) error {
+	_, err := r.Set(ctx, ids.(ttnpb.EndDeviceIdentifiers), rewrapDevicePaths, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		if dev == nil {
+			return nil, nil, nil
+		}
+		sets, err := f(ctx, deviceKeyEnvelopes(dev))
+		if err != nil {
+			return nil, nil, err
+		}
+		return dev, sets, nil
+	})
+	return err
+}
+
+// RegistryRewrapper is a service responsible for re-wrapping the application session keys at rest in the
+// device registry with another KEK.
+type RegistryRewrapper struct {
+	DevRegistry DeviceRegistry
+	KEKRewrap   cryptoutil.KEKRewrap
+	// DryRun only counts the keys that are wrapped with the KEK that is rotated.
+	DryRun bool
+}
+
+// RewrapData re-wraps the application session keys of the devices.
+// Devices of which the keys cannot be re-wrapped are logged and counted as failed.
+func (rw *RegistryRewrapper) RewrapData(ctx context.Context) (cryptoutil.RewrapStats, error) {
+	return rw.KEKRewrap.RewrapRegistry(ctx, deviceRewrapRegistry{rw.DevRegistry}, rw.DryRun)
+}
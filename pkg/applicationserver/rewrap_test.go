@@ -0,0 +1,148 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver_test
+
+import (
+	"context"
+	"testing"
+
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRegistryRewrapper(t *testing.T) {
+	a, ctx := test.New(t)
+
+	keyVault := cryptoutil.NewMemKeyVault(map[string][]byte{
+		"old": {0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf},
+		"new": {0xf, 0xe, 0xd, 0xc, 0xb, 0xa, 0x9, 0x8, 0x7, 0x6, 0x5, 0x4, 0x3, 0x2, 0x1, 0x0},
+	})
+	mustWrap := func(key types.AES128Key, kekLabel string) *ttnpb.KeyEnvelope {
+		ke, err := cryptoutil.WrapAES128Key(ctx, key, kekLabel, keyVault)
+		if err != nil {
+			t.Fatalf("Failed to wrap key: %s", err)
+		}
+		return ke
+	}
+	appSKey := types.AES128Key{0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1}
+	newDevice := func(devID string, session, pendingSession *ttnpb.KeyEnvelope) *ttnpb.EndDevice {
+		dev := &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+				DeviceId:               devID,
+			},
+		}
+		if session != nil {
+			dev.Session = &ttnpb.Session{SessionKeys: ttnpb.SessionKeys{AppSKey: session}}
+		}
+		if pendingSession != nil {
+			dev.PendingSession = &ttnpb.Session{SessionKeys: ttnpb.SessionKeys{AppSKey: pendingSession}}
+		}
+		return dev
+	}
+	devs := map[string]*ttnpb.EndDevice{
+		"test-app.dev-1": newDevice("dev-1", mustWrap(appSKey, "old"), mustWrap(appSKey, "old")),
+		"test-app.dev-2": newDevice("dev-2", nil, mustWrap(appSKey, "old")),
+		"test-app.dev-3": newDevice("dev-3", mustWrap(appSKey, "new"), nil),
+		"test-app.dev-4": newDevice("dev-4", &ttnpb.KeyEnvelope{EncryptedKey: []byte{0x1}, KekLabel: "old"}, nil),
+	}
+	registry := MockDeviceRegistry{
+		SetFunc: func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+			uid := unique.ID(ctx, ids)
+			var stored *ttnpb.EndDevice
+			if devs[uid] != nil {
+				var err error
+				stored, err = ttnpb.FilterGetEndDevice(devs[uid], paths...)
+				if err != nil {
+					return nil, err
+				}
+			}
+			pb, sets, err := f(stored)
+			if err != nil || pb == nil {
+				return nil, err
+			}
+			devs[uid], err = ttnpb.ApplyEndDeviceFieldMask(devs[uid], pb, sets...)
+			if err != nil {
+				return nil, err
+			}
+			return pb, nil
+		},
+		RangeFunc: func(ctx context.Context, paths []string, f func(context.Context, ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool) error {
+			for _, dev := range devs {
+				pb, err := ttnpb.FilterGetEndDevice(dev, paths...)
+				if err != nil {
+					return err
+				}
+				if !f(ctx, pb.EndDeviceIdentifiers, pb) {
+					return nil
+				}
+			}
+			return nil
+		},
+	}
+
+	rewrapper := &RegistryRewrapper{
+		DevRegistry: registry,
+		KEKRewrap: cryptoutil.KEKRewrap{
+			KeyVault: keyVault,
+			From:     "old",
+			To:       "new",
+		},
+		DryRun: true,
+	}
+	stats, err := rewrapper.RewrapData(ctx)
+	a.So(err, should.BeNil)
+	a.So(stats, should.Resemble, cryptoutil.RewrapStats{
+		Entities: 3,
+		Keys:     4,
+	})
+	a.So(devs["test-app.dev-1"].Session.AppSKey.KekLabel, should.Equal, "old")
+
+	rewrapper.DryRun = false
+	stats, err = rewrapper.RewrapData(ctx)
+	a.So(err, should.BeNil)
+	a.So(stats, should.Resemble, cryptoutil.RewrapStats{
+		Entities:  3,
+		Keys:      4,
+		Rewrapped: 3,
+		Failed:    1,
+	})
+	for _, ke := range []*ttnpb.KeyEnvelope{
+		devs["test-app.dev-1"].Session.AppSKey,
+		devs["test-app.dev-1"].PendingSession.AppSKey,
+		devs["test-app.dev-2"].PendingSession.AppSKey,
+		devs["test-app.dev-3"].Session.AppSKey,
+	} {
+		a.So(ke.KekLabel, should.Equal, "new")
+		key, err := cryptoutil.UnwrapAES128Key(ctx, ke, keyVault)
+		a.So(err, should.BeNil)
+		a.So(key, should.Resemble, appSKey)
+	}
+	a.So(devs["test-app.dev-2"].Session, should.BeNil)
+	a.So(devs["test-app.dev-4"].Session.AppSKey.KekLabel, should.Equal, "old")
+
+	stats, err = rewrapper.RewrapData(ctx)
+	a.So(err, should.BeNil)
+	a.So(stats, should.Resemble, cryptoutil.RewrapStats{
+		Entities: 1,
+		Keys:     1,
+		Failed:   1,
+	})
+}
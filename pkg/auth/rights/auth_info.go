@@ -38,6 +38,9 @@ func AuthInfo(ctx context.Context) (authInfo *ttnpb.AuthInfoResponse, err error)
 	if !ok {
 		panic(errNoFetcher)
 	}
+	if err := enforceRestrictions(ctx); err != nil {
+		return nil, err
+	}
 	authInfo, err = fetcher.AuthInfo(ctx)
 	if err != nil {
 		if errors.IsPermissionDenied(err) {
@@ -102,6 +102,20 @@ func (c *cachedAuthInfoRes) set(authInfo *ttnpb.AuthInfoResponse, err error) {
 	c.cachedRes.set(authInfo.GetUniversalRights(), err)
 }
 
+type cachedRestrictionsRes struct {
+	*cachedRes
+	restrictions *ttnpb.APIKeyRestrictions
+}
+
+func (c *cachedRestrictionsRes) valid(successTTL, errorTTL time.Duration) bool {
+	return c != nil && c.cachedRes.valid(successTTL, errorTTL)
+}
+
+func (c *cachedRestrictionsRes) set(restrictions *ttnpb.APIKeyRestrictions, err error) {
+	c.restrictions = restrictions
+	c.cachedRes.set(nil, err)
+}
+
 // NewInMemoryCache returns a new in-memory cache on top of the given fetcher.
 // Successful responses are valid for the duration of successTTL, unsuccessful
 // responses are valid for the duration of errorTTL.
@@ -112,6 +126,7 @@ func NewInMemoryCache(fetcher Fetcher, successTTL, errorTTL time.Duration) Fetch
 		errorTTL:           errorTTL,
 		lastCleanup:        now(),
 		authInfo:           make(map[cachedReq]*cachedAuthInfoRes),
+		restrictions:       make(map[cachedReq]*cachedRestrictionsRes),
 		applicationRights:  make(map[cachedReq]*cachedRes),
 		clientRights:       make(map[cachedReq]*cachedRes),
 		gatewayRights:      make(map[cachedReq]*cachedRes),
@@ -127,6 +142,7 @@ type inMemoryCache struct {
 	mu                 sync.Mutex
 	lastCleanup        time.Time
 	authInfo           map[cachedReq]*cachedAuthInfoRes
+	restrictions       map[cachedReq]*cachedRestrictionsRes
 	applicationRights  map[cachedReq]*cachedRes
 	clientRights       map[cachedReq]*cachedRes
 	gatewayRights      map[cachedReq]*cachedRes
@@ -148,6 +164,11 @@ func (f *inMemoryCache) maybeCleanup() {
 			delete(f.authInfo, req)
 		}
 	}
+	for req, res := range f.restrictions {
+		if !res.valid(f.successTTL, f.errorTTL) {
+			delete(f.restrictions, req)
+		}
+	}
 	for req, res := range f.applicationRights {
 		if !res.valid(f.successTTL, f.errorTTL) {
 			delete(f.applicationRights, req)
@@ -196,6 +217,31 @@ func (f *inMemoryCache) AuthInfo(ctx context.Context) (authInfo *ttnpb.AuthInfoR
 	return res.authInfo, res.err
 }
 
+// Restrictions implements RestrictionsFetcher.
+// If the underlying fetcher does not implement RestrictionsFetcher, no restrictions are returned.
+func (f *inMemoryCache) Restrictions(ctx context.Context) (*ttnpb.APIKeyRestrictions, error) {
+	restrictionsFetcher, ok := f.Fetcher.(RestrictionsFetcher)
+	if !ok {
+		return nil, nil
+	}
+	req := newReq(ctx)
+	f.mu.Lock()
+	res := f.restrictions[req]
+	if !res.valid(f.successTTL, f.errorTTL) {
+		res = &cachedRestrictionsRes{
+			cachedRes: newRes(),
+		}
+		f.restrictions[req] = res
+		go res.set(restrictionsFetcher.Restrictions(ctx))
+	}
+	f.maybeCleanup()
+	f.mu.Unlock()
+	if err := res.wait(ctx); err != nil {
+		return nil, err
+	}
+	return res.restrictions, res.err
+}
+
 func (f *inMemoryCache) ApplicationRights(ctx context.Context, appID ttnpb.ApplicationIdentifiers) (rights *ttnpb.Rights, err error) {
 	defer func() { registerRightsRequest(ctx, "application", rights, err) }()
 	req := newEntityReq(ctx, appID.GetEntityIdentifiers())
@@ -149,6 +149,16 @@ func (f accessFetcher) AuthInfo(ctx context.Context) (*ttnpb.AuthInfoResponse, e
 	return authInfo, nil
 }
 
+// Restrictions implements RestrictionsFetcher.
+// The Identity Server returns the restrictions of the API key in the AuthInfo response.
+func (f accessFetcher) Restrictions(ctx context.Context) (*ttnpb.APIKeyRestrictions, error) {
+	authInfo, err := f.AuthInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return authInfo.GetApiKey().GetApiKey().GetRestrictions(), nil
+}
+
 func (f accessFetcher) ApplicationRights(ctx context.Context, appID ttnpb.ApplicationIdentifiers) (*ttnpb.Rights, error) {
 	cc := f.getConn(ctx)
 	if cc == nil {
@@ -0,0 +1,242 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rights
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// unrestrictedMethods are the methods that are used by other components to fetch the rights of the caller,
+// with the credentials of the caller. These methods only return the rights and the restrictions of the API key.
+var unrestrictedMethods = map[string]bool{
+	"/ttn.lorawan.v3.EntityAccess/AuthInfo":         true,
+	"/ttn.lorawan.v3.ApplicationAccess/ListRights":  true,
+	"/ttn.lorawan.v3.ClientAccess/ListRights":       true,
+	"/ttn.lorawan.v3.GatewayAccess/ListRights":      true,
+	"/ttn.lorawan.v3.OrganizationAccess/ListRights": true,
+	"/ttn.lorawan.v3.UserAccess/ListRights":         true,
+}
+
+// credentialMethods are the methods that create or extend credentials. API keys with restrictions can not
+// call these methods, as that would allow them to obtain credentials without restrictions.
+// OAuth clients are credentials too, as the tokens of a client are not restricted.
+// TestCredentialMethods checks that the credential methods of the registered services are in this list.
+var credentialMethods = map[string]bool{
+	"/ttn.lorawan.v3.ApplicationAccess/CreateAPIKey":       true,
+	"/ttn.lorawan.v3.ApplicationAccess/UpdateAPIKey":       true,
+	"/ttn.lorawan.v3.ApplicationAccess/SetCollaborator":    true,
+	"/ttn.lorawan.v3.ClientAccess/SetCollaborator":         true,
+	"/ttn.lorawan.v3.ClientRegistry/Create":                true,
+	"/ttn.lorawan.v3.GatewayAccess/CreateAPIKey":           true,
+	"/ttn.lorawan.v3.GatewayAccess/UpdateAPIKey":           true,
+	"/ttn.lorawan.v3.GatewayAccess/SetCollaborator":        true,
+	"/ttn.lorawan.v3.OrganizationAccess/CreateAPIKey":      true,
+	"/ttn.lorawan.v3.OrganizationAccess/UpdateAPIKey":      true,
+	"/ttn.lorawan.v3.OrganizationAccess/SetCollaborator":   true,
+	"/ttn.lorawan.v3.UserAccess/CreateAPIKey":              true,
+	"/ttn.lorawan.v3.UserAccess/UpdateAPIKey":              true,
+	"/ttn.lorawan.v3.UserAccess/CreateLoginToken":          true,
+	"/ttn.lorawan.v3.UserRegistry/CreateTemporaryPassword": true,
+	"/ttn.lorawan.v3.UserRegistry/UpdatePassword":          true,
+}
+
+type (
+	endDeviceGetter interface {
+		GetEndDevice() ttnpb.EndDevice
+	}
+	endDeviceIDsGetter interface {
+		GetEndDeviceIds() *ttnpb.EndDeviceIdentifiers
+	}
+	endDeviceIdsGetter interface {
+		GetIds() *ttnpb.EndDeviceIdentifiers
+	}
+	entityIdentifiersGetter interface {
+		GetEntityIdentifiers() *ttnpb.EntityIdentifiers
+	}
+)
+
+// endDeviceFromRequest returns the identifiers of the end device that the request is on, if any.
+// The attributes are only returned for requests that create end devices.
+func endDeviceFromRequest(req interface{}) (*ttnpb.EndDeviceIdentifiers, map[string]string) {
+	switch req := req.(type) {
+	case *ttnpb.CreateEndDeviceRequest:
+		return &req.EndDeviceIdentifiers, req.Attributes
+	case endDeviceGetter:
+		dev := req.GetEndDevice()
+		return &dev.EndDeviceIdentifiers, nil
+	case endDeviceIDsGetter:
+		return req.GetEndDeviceIds(), nil
+	case endDeviceIdsGetter:
+		return req.GetIds(), nil
+	case entityIdentifiersGetter:
+		return req.GetEntityIdentifiers().GetDeviceIds(), nil
+	}
+	return nil, nil
+}
+
+// remoteIP returns the address of the caller. The X-Real-IP header is set by rpcmiddleware.ProxyHeaders, and by
+// webmiddleware.ProxyHeaders for HTTP requests, so that callers behind trusted proxies are matched by their own
+// address. Requests without that header fall back to the address of the peer, which is set by the gRPC server and
+// by transports such as MQTT.
+func remoteIP(ctx context.Context) net.IP {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get("x-real-ip"); len(v) > 0 {
+		return net.ParseIP(v[0])
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		return net.ParseIP(host)
+	}
+	return nil
+}
+
+// fetchRestrictions fetches the restrictions of the API key of the request. It returns no restrictions if the
+// request is not authenticated with an API key, or if the rights fetcher does not support restrictions.
+func fetchRestrictions(ctx context.Context) (*ttnpb.APIKeyRestrictions, error) {
+	md := rpcmetadata.FromIncomingContext(ctx)
+	if strings.ToLower(md.AuthType) != "bearer" {
+		return nil, nil
+	}
+	if tokenType, _, _, err := auth.SplitToken(md.AuthValue); err != nil || tokenType != auth.APIKey {
+		return nil, nil
+	}
+	fetcher, ok := fetcherFromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	restrictionsFetcher, ok := fetcher.(RestrictionsFetcher)
+	if !ok {
+		return nil, nil
+	}
+	return restrictionsFetcher.Restrictions(ctx)
+}
+
+// checkMethodAndAddress checks the method and the source address of the request against the restrictions.
+// The method is empty for requests that are not gRPC calls, which are denied if the API key is restricted
+// to methods.
+func checkMethodAndAddress(ctx context.Context, restrictions *ttnpb.APIKeyRestrictions, fullMethod string) error {
+	switch {
+	case fullMethod == "" && len(restrictions.GetMethods()) > 0:
+		return ErrMethodRestricted.WithAttributes("method", "unknown")
+	case fullMethod != "" && (credentialMethods[fullMethod] || !restrictions.AllowsMethod(fullMethod)):
+		return ErrMethodRestricted.WithAttributes("method", fullMethod)
+	}
+	if ip := remoteIP(ctx); !restrictions.AllowsAddress(ip) {
+		address := "unknown"
+		if ip != nil {
+			address = ip.String()
+		}
+		return ErrSourceRestricted.WithAttributes("address", address)
+	}
+	return nil
+}
+
+// checkRestrictions fetches the restrictions of the API key of the request, and checks the method, the source
+// address and the end device of the request. It returns the context with the restrictions, that are checked by
+// RequireApplication. Requests fail if the restrictions can not be fetched.
+func checkRestrictions(ctx context.Context, fullMethod string, req interface{}) (context.Context, error) {
+	if unrestrictedMethods[fullMethod] {
+		return ctx, nil
+	}
+	restrictions, err := fetchRestrictions(ctx)
+	if err != nil {
+		if errors.IsUnauthenticated(err) || errors.IsPermissionDenied(err) {
+			// The credentials are invalid, so the handler returns the error when it requires rights.
+			// The restrictions are not in the context, so fetching rights fetches them again and fails.
+			return ctx, nil
+		}
+		return nil, err
+	}
+	if restrictions.IsZero() {
+		return newContextWithRestrictions(ctx, &requestRestrictions{}), nil
+	}
+	if err := checkMethodAndAddress(ctx, restrictions, fullMethod); err != nil {
+		return nil, err
+	}
+	r := &requestRestrictions{APIKeyRestrictions: restrictions}
+	if ids, attributes := endDeviceFromRequest(req); ids != nil && ids.DeviceId != "" {
+		if !restrictions.AllowsEndDevice(ids.DeviceId, attributes) {
+			return nil, ErrEndDeviceRestricted.WithAttributes("device_id", ids.DeviceId)
+		}
+		r.endDevice = true
+	}
+	return newContextWithRestrictions(ctx, r), nil
+}
+
+// contextRestrictions returns the restrictions of the request. The restrictions of requests that did not go
+// through the gRPC server interceptors, such as MQTT connections and HTTP handlers, are fetched and their
+// method and source address are checked. Requests fail if the restrictions can not be fetched.
+func contextRestrictions(ctx context.Context) (*requestRestrictions, error) {
+	if r, ok := restrictionsFromContext(ctx); ok {
+		return r, nil
+	}
+	fullMethod, _ := grpc.Method(ctx)
+	if unrestrictedMethods[fullMethod] {
+		return nil, nil
+	}
+	restrictions, err := fetchRestrictions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if restrictions.IsZero() {
+		return nil, nil
+	}
+	if err := checkMethodAndAddress(ctx, restrictions, fullMethod); err != nil {
+		return nil, err
+	}
+	return &requestRestrictions{APIKeyRestrictions: restrictions}, nil
+}
+
+// UnaryServerInterceptor returns a gRPC unary server interceptor that enforces the restrictions of API keys.
+// It must be placed after the interceptors that fill the context with the rights fetcher and that set the
+// X-Real-IP header.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := checkRestrictions(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a gRPC stream server interceptor that enforces the restrictions of API keys.
+// As the messages of the stream are not known when the stream is opened, API keys that are restricted to
+// end devices can not be used for streams that require application rights.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := checkRestrictions(stream.Context(), info.FullMethod, nil)
+		if err != nil {
+			return err
+		}
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
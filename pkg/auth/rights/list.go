@@ -42,6 +42,9 @@ func ListApplication(ctx context.Context, id ttnpb.ApplicationIdentifiers) (righ
 	if !ok {
 		panic(errNoFetcher)
 	}
+	if err := enforceRestrictions(ctx); err != nil {
+		return nil, err
+	}
 	rights, err = fetcher.ApplicationRights(ctx, id)
 	if err != nil {
 		if errors.IsPermissionDenied(err) {
@@ -72,6 +75,9 @@ func ListClient(ctx context.Context, id ttnpb.ClientIdentifiers) (rights *ttnpb.
 	if !ok {
 		panic(errNoFetcher)
 	}
+	if err := enforceRestrictions(ctx); err != nil {
+		return nil, err
+	}
 	rights, err = fetcher.ClientRights(ctx, id)
 	if err != nil {
 		if errors.IsPermissionDenied(err) {
@@ -102,6 +108,9 @@ func ListGateway(ctx context.Context, id ttnpb.GatewayIdentifiers) (rights *ttnp
 	if !ok {
 		panic(errNoFetcher)
 	}
+	if err := enforceRestrictions(ctx); err != nil {
+		return nil, err
+	}
 	rights, err = fetcher.GatewayRights(ctx, id)
 	if err != nil {
 		if errors.IsPermissionDenied(err) {
@@ -132,6 +141,9 @@ func ListOrganization(ctx context.Context, id ttnpb.OrganizationIdentifiers) (ri
 	if !ok {
 		panic(errNoFetcher)
 	}
+	if err := enforceRestrictions(ctx); err != nil {
+		return nil, err
+	}
 	rights, err = fetcher.OrganizationRights(ctx, id)
 	if err != nil {
 		if errors.IsPermissionDenied(err) {
@@ -162,6 +174,9 @@ func ListUser(ctx context.Context, id ttnpb.UserIdentifiers) (rights *ttnpb.Righ
 	if !ok {
 		panic(errNoFetcher)
 	}
+	if err := enforceRestrictions(ctx); err != nil {
+		return nil, err
+	}
 	rights, err = fetcher.UserRights(ctx, id)
 	if err != nil {
 		if errors.IsPermissionDenied(err) {
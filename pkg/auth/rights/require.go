@@ -119,7 +119,8 @@ func RequireIsAdmin(ctx context.Context) error {
 }
 
 // RequireApplication checks that context contains the required rights for the
-// given application ID.
+// given application ID. API keys that are restricted to end devices are only
+// allowed for requests on those end devices.
 func RequireApplication(ctx context.Context, id ttnpb.ApplicationIdentifiers, required ...ttnpb.Right) error {
 	uid := unique.ID(ctx, id)
 	rights, err := ListApplication(ctx, id)
@@ -133,7 +134,7 @@ func RequireApplication(ctx context.Context, id ttnpb.ApplicationIdentifiers, re
 	if len(missing) > 0 {
 		return ErrInsufficientApplicationRights.WithAttributes("uid", uid, "missing", rightsNames(missing...))
 	}
-	return nil
+	return requireApplicationRestrictions(ctx, uid)
 }
 
 // RequireClient checks that context contains the required rights for the
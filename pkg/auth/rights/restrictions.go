@@ -0,0 +1,151 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rights
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errInvalidSourceCIDR = errors.DefineInvalidArgument("invalid_source_cidr", "invalid source CIDR `{cidr}`")
+	errInvalidMethod     = errors.DefineInvalidArgument("invalid_method", "invalid gRPC method `{method}`")
+
+	// ErrMethodRestricted is returned when the API key is not allowed to call the gRPC method.
+	ErrMethodRestricted = errors.DefinePermissionDenied(
+		"method_restricted",
+		"API key is not allowed to call `{method}`",
+	)
+	// ErrSourceRestricted is returned when the API key is not allowed to be used from the source address.
+	ErrSourceRestricted = errors.DefinePermissionDenied(
+		"source_restricted",
+		"API key is not allowed to be used from `{address}`",
+	)
+	// ErrEndDeviceRestricted is returned when the API key is not allowed to access the end device.
+	ErrEndDeviceRestricted = errors.DefinePermissionDenied(
+		"end_device_restricted",
+		"API key is not allowed to access end device `{device_id}`",
+	)
+	// ErrApplicationRestricted is returned when an API key that is restricted to end devices is used for a request
+	// on the entire application.
+	ErrApplicationRestricted = errors.DefinePermissionDenied(
+		"application_restricted",
+		"API key is restricted to end devices of application `{uid}`",
+	)
+)
+
+// ValidateRestrictions returns an error if the restrictions are invalid.
+func ValidateRestrictions(r *ttnpb.APIKeyRestrictions) error {
+	if err := r.ValidateFields(); err != nil {
+		return err
+	}
+	for _, cidr := range r.GetSourceCidrs() {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return errInvalidSourceCIDR.WithCause(err).WithAttributes("cidr", cidr)
+		}
+	}
+	for _, method := range r.GetMethods() {
+		if !strings.HasPrefix(method, "/") || strings.Count(method, "/") != 2 || strings.HasSuffix(method, "/") {
+			return errInvalidMethod.WithAttributes("method", method)
+		}
+	}
+	return nil
+}
+
+// RestrictionsFetcher provides an interface for fetching the restrictions of the API key of the request.
+// Fetchers that do not implement this interface do not restrict requests.
+type RestrictionsFetcher interface {
+	// Restrictions returns the restrictions of the API key of the request, or nil if the request
+	// is not authenticated with a restricted API key.
+	Restrictions(context.Context) (*ttnpb.APIKeyRestrictions, error)
+}
+
+// RestrictionsFetcherFunc is a function that implements the RestrictionsFetcher interface.
+type RestrictionsFetcherFunc func(ctx context.Context) (*ttnpb.APIKeyRestrictions, error)
+
+// Restrictions implements the RestrictionsFetcher interface.
+func (f RestrictionsFetcherFunc) Restrictions(ctx context.Context) (*ttnpb.APIKeyRestrictions, error) {
+	return f(ctx)
+}
+
+type requestRestrictions struct {
+	*ttnpb.APIKeyRestrictions
+	// endDevice indicates that the request is on an end device that the API key is allowed to access.
+	endDevice bool
+	// endDeviceFilter indicates that the request filters the end devices that it returns.
+	endDeviceFilter bool
+}
+
+type restrictionsKeyType struct{}
+
+var restrictionsKey restrictionsKeyType
+
+func newContextWithRestrictions(ctx context.Context, r *requestRestrictions) context.Context {
+	return context.WithValue(ctx, restrictionsKey, r)
+}
+
+func restrictionsFromContext(ctx context.Context) (*requestRestrictions, bool) {
+	r, ok := ctx.Value(restrictionsKey).(*requestRestrictions)
+	return r, ok
+}
+
+// WithEndDeviceFilter returns a derived context for requests on an entire application that only return the
+// end devices returned by RestrictedEndDeviceIDs. This allows such requests for API keys that are
+// restricted to end devices.
+func WithEndDeviceFilter(ctx context.Context) context.Context {
+	r, ok := restrictionsFromContext(ctx)
+	if !ok || !r.RestrictsEndDevices() {
+		return ctx
+	}
+	return newContextWithRestrictions(ctx, &requestRestrictions{
+		APIKeyRestrictions: r.APIKeyRestrictions,
+		endDevice:          r.endDevice,
+		endDeviceFilter:    true,
+	})
+}
+
+// RestrictedEndDeviceIDs returns the IDs of the end devices that the API key of the request is restricted to,
+// and whether the API key is restricted to end devices. The Identity Server resolves the end device attributes
+// of the restrictions to the IDs of the end devices that have those attributes.
+func RestrictedEndDeviceIDs(ctx context.Context) ([]string, bool) {
+	r, ok := restrictionsFromContext(ctx)
+	if !ok || !r.RestrictsEndDevices() {
+		return nil, false
+	}
+	return r.GetEndDeviceIds(), true
+}
+
+// requireApplicationRestrictions returns an error if the API key of the request is restricted to end devices,
+// and the request is on the entire application.
+func requireApplicationRestrictions(ctx context.Context, uid string) error {
+	r, err := contextRestrictions(ctx)
+	if err != nil {
+		return err
+	}
+	if r == nil || !r.RestrictsEndDevices() || r.endDevice || r.endDeviceFilter {
+		return nil
+	}
+	return ErrApplicationRestricted.WithAttributes("uid", uid)
+}
+
+// enforceRestrictions checks the restrictions of the API key of the request before rights are fetched.
+func enforceRestrictions(ctx context.Context) error {
+	_, err := contextRestrictions(ctx)
+	return err
+}
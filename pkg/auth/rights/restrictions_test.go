@@ -0,0 +1,220 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rights
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestRestrictions(t *testing.T) {
+	a := assertions.New(t)
+
+	var empty *ttnpb.APIKeyRestrictions
+	a.So(empty.IsZero(), should.BeTrue)
+	a.So(empty.AllowsMethod("/ttn.lorawan.v3.EndDeviceRegistry/Get"), should.BeTrue)
+	a.So(empty.AllowsAddress(nil), should.BeTrue)
+	a.So(empty.AllowsEndDevice("foo", nil), should.BeTrue)
+
+	r := &ttnpb.APIKeyRestrictions{
+		EndDeviceIds:        []string{"foo"},
+		EndDeviceAttributes: map[string]string{"installer": "alice"},
+		SourceCidrs:         []string{"10.0.0.0/8", "2001:db8::/32"},
+		Methods:             []string{"/ttn.lorawan.v3.EndDeviceRegistry/*", "/ttn.lorawan.v3.As/GetLink"},
+	}
+	a.So(ValidateRestrictions(r), should.BeNil)
+	a.So(r.IsZero(), should.BeFalse)
+
+	a.So(r.AllowsMethod("/ttn.lorawan.v3.EndDeviceRegistry/Get"), should.BeTrue)
+	a.So(r.AllowsMethod("/ttn.lorawan.v3.As/GetLink"), should.BeTrue)
+	a.So(r.AllowsMethod("/ttn.lorawan.v3.As/SetLink"), should.BeFalse)
+	a.So(r.AllowsMethod("/ttn.lorawan.v3.EndDeviceRegistrySearch/SearchEndDevices"), should.BeFalse)
+
+	a.So(r.AllowsAddress(net.ParseIP("10.1.2.3")), should.BeTrue)
+	a.So(r.AllowsAddress(net.ParseIP("2001:db8::1")), should.BeTrue)
+	a.So(r.AllowsAddress(net.ParseIP("192.168.1.1")), should.BeFalse)
+	a.So(r.AllowsAddress(nil), should.BeFalse)
+
+	a.So(r.AllowsEndDevice("foo", nil), should.BeTrue)
+	a.So(r.AllowsEndDevice("bar", nil), should.BeFalse)
+	a.So(r.AllowsEndDevice("bar", map[string]string{"installer": "alice", "site": "north"}), should.BeTrue)
+	a.So(r.AllowsEndDevice("bar", map[string]string{"installer": "bob"}), should.BeFalse)
+
+	for _, invalid := range []*ttnpb.APIKeyRestrictions{
+		{SourceCidrs: []string{"10.0.0.1"}},
+		{Methods: []string{"GetLink"}},
+		{Methods: []string{"/ttn.lorawan.v3.As/"}},
+		{EndDeviceIds: []string{"Not A Device"}},
+		{EndDeviceAttributes: map[string]string{"": "alice"}},
+	} {
+		a.So(ValidateRestrictions(invalid), should.NotBeNil)
+	}
+}
+
+func TestRequireApplicationRestrictions(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}
+	r := &ttnpb.APIKeyRestrictions{EndDeviceIds: []string{"foo"}}
+
+	a.So(requireApplicationRestrictions(ctx, "foo-app"), should.BeNil)
+
+	restrictedCtx := newContextWithRestrictions(ctx, &requestRestrictions{APIKeyRestrictions: r})
+	a.So(errors.Resemble(requireApplicationRestrictions(restrictedCtx, appIDs.ApplicationId), ErrApplicationRestricted), should.BeTrue)
+
+	filterCtx := WithEndDeviceFilter(restrictedCtx)
+	a.So(requireApplicationRestrictions(filterCtx, appIDs.ApplicationId), should.BeNil)
+	deviceIDs, ok := RestrictedEndDeviceIDs(filterCtx)
+	a.So(ok, should.BeTrue)
+	a.So(deviceIDs, should.Resemble, []string{"foo"})
+
+	deviceCtx := newContextWithRestrictions(ctx, &requestRestrictions{APIKeyRestrictions: r, endDevice: true})
+	a.So(requireApplicationRestrictions(deviceCtx, appIDs.ApplicationId), should.BeNil)
+
+	_, ok = RestrictedEndDeviceIDs(ctx)
+	a.So(ok, should.BeFalse)
+}
+
+type mockRestrictionsFetcher struct {
+	*mockFetcher
+	restrictions      *ttnpb.APIKeyRestrictions
+	restrictionsError error
+}
+
+func (f *mockRestrictionsFetcher) Restrictions(context.Context) (*ttnpb.APIKeyRestrictions, error) {
+	return f.restrictions, f.restrictionsError
+}
+
+var (
+	errTestUnimplemented    = errors.DefineUnimplemented("test_unimplemented", "unimplemented")
+	errTestPermissionDenied = errors.DefinePermissionDenied("test_permission_denied", "permission denied")
+)
+
+func TestFetchedRestrictions(t *testing.T) {
+	a := assertions.New(t)
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}
+	newContext := func(fetcher Fetcher, address string) context.Context {
+		ctx := metadata.NewIncomingContext(test.Context(), metadata.Pairs(
+			"authorization", "Bearer "+auth.JoinToken(auth.APIKey, "KEYID", "secret"),
+		))
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(address), Port: 1883}})
+		return NewContextWithFetcher(ctx, fetcher)
+	}
+	fetcher := &mockRestrictionsFetcher{
+		mockFetcher: &mockFetcher{
+			applicationRights: ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		},
+	}
+
+	// Requests that do not go through the gRPC interceptors, such as MQTT connections, are restricted too.
+	fetcher.restrictions = &ttnpb.APIKeyRestrictions{EndDeviceIds: []string{"foo"}}
+	err := RequireApplication(newContext(fetcher, "10.1.2.3"), appIDs, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ)
+	a.So(errors.Resemble(err, ErrApplicationRestricted), should.BeTrue)
+
+	fetcher.restrictions = &ttnpb.APIKeyRestrictions{SourceCidrs: []string{"10.0.0.0/8"}}
+	err = RequireApplication(newContext(fetcher, "10.1.2.3"), appIDs, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ)
+	a.So(err, should.BeNil)
+	err = RequireApplication(newContext(fetcher, "192.168.1.1"), appIDs, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ)
+	a.So(errors.Resemble(err, ErrSourceRestricted), should.BeTrue)
+
+	fetcher.restrictions = &ttnpb.APIKeyRestrictions{Methods: []string{"/ttn.lorawan.v3.As/GetLink"}}
+	err = RequireApplication(newContext(fetcher, "10.1.2.3"), appIDs, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ)
+	a.So(errors.Resemble(err, ErrMethodRestricted), should.BeTrue)
+
+	// Requests fail if the restrictions can not be fetched.
+	fetcher.restrictions, fetcher.restrictionsError = nil, errTestUnimplemented.New()
+	_, err = checkRestrictions(newContext(fetcher, "10.1.2.3"), "/ttn.lorawan.v3.As/GetLink", nil)
+	a.So(errors.Resemble(err, errTestUnimplemented), should.BeTrue)
+	err = RequireApplication(newContext(fetcher, "10.1.2.3"), appIDs, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ)
+	a.So(errors.Resemble(err, errTestUnimplemented), should.BeTrue)
+
+	// Invalid credentials are left to the handler, but fetching rights with them fails.
+	fetcher.restrictionsError = errTestPermissionDenied.New()
+	ctx, err := checkRestrictions(newContext(fetcher, "10.1.2.3"), "/ttn.lorawan.v3.As/GetLink", nil)
+	if a.So(err, should.BeNil) {
+		err = RequireApplication(ctx, appIDs, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ)
+		a.So(errors.Resemble(err, errTestPermissionDenied), should.BeTrue)
+	}
+}
+
+// credentialMethodPattern matches the names of methods that create or extend credentials.
+var credentialMethodPattern = regexp.MustCompile(`^(CreateAPIKey|UpdateAPIKey|SetCollaborator|Create\w*Token|\w*Password)$`)
+
+func TestCredentialMethods(t *testing.T) {
+	a := assertions.New(t)
+
+	files, err := filepath.Glob(filepath.Join("..", "..", "..", "api", "*.proto"))
+	if !a.So(err, should.BeNil) || !a.So(files, should.NotBeEmpty) {
+		t.FailNow()
+	}
+	var methods []string
+	for _, file := range files {
+		if strings.HasPrefix(filepath.Base(file), "_") {
+			// Files with an underscore prefix only set options and are not registered.
+			continue
+		}
+		compressed := proto.FileDescriptor("lorawan-stack/api/" + filepath.Base(file))
+		if !a.So(compressed, should.NotBeEmpty) {
+			t.Fatalf("File descriptor of %s not registered", file)
+		}
+		r, err := gzip.NewReader(bytes.NewReader(compressed))
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		b, err := ioutil.ReadAll(r)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		fd := &descriptor.FileDescriptorProto{}
+		if !a.So(proto.Unmarshal(b, fd), should.BeNil) {
+			t.FailNow()
+		}
+		for _, service := range fd.Service {
+			for _, method := range service.Method {
+				fullMethod := fmt.Sprintf("/%s.%s/%s", fd.GetPackage(), service.GetName(), method.GetName())
+				if credentialMethodPattern.MatchString(method.GetName()) ||
+					fullMethod == "/ttn.lorawan.v3.ClientRegistry/Create" {
+					methods = append(methods, fullMethod)
+				}
+			}
+		}
+	}
+
+	a.So(methods, should.HaveLength, len(credentialMethods))
+	for _, method := range methods {
+		a.So(credentialMethods, should.ContainKey, method)
+	}
+}
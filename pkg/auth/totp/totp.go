@@ -0,0 +1,135 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package totp implements time-based one-time passwords as specified in RFC 6238.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+const (
+	// Digits is the number of digits of the generated codes.
+	Digits = 6
+	// Period is the duration of a time step.
+	Period = 30 * time.Second
+	// Skew is the number of time steps before and after the current time step for which codes are accepted.
+	Skew = 1
+	// SecretSize is the size of the generated secrets in bytes.
+	SecretSize = 20
+)
+
+var enc = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+var errInvalidSecret = errors.DefineInvalidArgument("invalid_secret", "invalid TOTP secret")
+
+// GenerateSecret generates a new random secret, encoded as unpadded base32.
+func GenerateSecret() (string, error) {
+	var b [SecretSize]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return enc.EncodeToString(b[:]), nil
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	key, err := enc.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil || len(key) == 0 {
+		return nil, errInvalidSecret.WithCause(err)
+	}
+	return key, nil
+}
+
+// Step returns the time step of t, which is the number of whole Periods since the Unix epoch.
+// Steps are monotonically increasing, so a stored step can be compared to reject reuse of codes.
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period/time.Second)
+}
+
+func code(key []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, value%1000000)
+}
+
+// Code returns the code of Digits digits for the base32 encoded secret at time t.
+// It returns an error if the secret can not be decoded.
+func Code(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return code(key, Step(t)), nil
+}
+
+// Validate validates the code for the secret at time t, accepting codes of Skew time steps before and after t.
+// If the code is valid, Validate returns the time step of the code, which can be used to reject reuse of a code.
+func Validate(secret, c string, t time.Time) (step int64, ok bool, err error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false, err
+	}
+	c = strings.ReplaceAll(c, " ", "")
+	if len(c) != Digits {
+		return 0, false, nil
+	}
+	current := Step(t)
+	for s := current - Skew; s <= current+Skew; s++ {
+		if subtle.ConstantTimeCompare([]byte(code(key, s)), []byte(c)) == 1 {
+			return s, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// URI returns the otpauth URI of the base32 encoded secret, which is used to enrol the secret in authenticator apps.
+// The label is the account name, prefixed with the issuer if it is not empty. The URI includes the algorithm,
+// Digits and Period, so that authenticator apps generate codes that Validate accepts.
+func URI(issuer, accountName, secret string) string {
+	label := accountName
+	if issuer != "" {
+		label = issuer + ":" + accountName
+	}
+	query := url.Values{
+		"secret":    {secret},
+		"algorithm": {"SHA1"},
+		"digits":    {fmt.Sprint(Digits)},
+		"period":    {fmt.Sprint(int(Period / time.Second))},
+	}
+	if issuer != "" {
+		query.Set("issuer", issuer)
+	}
+	return (&url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + label,
+		RawQuery: query.Encode(),
+	}).String()
+}
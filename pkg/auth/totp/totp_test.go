@@ -0,0 +1,93 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp_test
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// rfc6238Secret is the SHA1 secret of the test vectors of RFC 6238.
+var rfc6238Secret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestCode(t *testing.T) {
+	for _, tc := range []struct {
+		Time int64
+		Code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	} {
+		a := assertions.New(t)
+		code, err := totp.Code(rfc6238Secret, time.Unix(tc.Time, 0))
+		a.So(err, should.BeNil)
+		a.So(code, should.Equal, tc.Code)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	a := assertions.New(t)
+
+	secret, err := totp.GenerateSecret()
+	a.So(err, should.BeNil)
+	a.So(secret, should.HaveLength, 32)
+
+	now := time.Unix(1234567890, 0)
+	for _, tc := range []struct {
+		Offset time.Duration
+		OK     bool
+	}{
+		{0, true},
+		{-totp.Period, true},
+		{totp.Period, true},
+		{-2 * totp.Period, false},
+		{2 * totp.Period, false},
+	} {
+		code, err := totp.Code(secret, now.Add(tc.Offset))
+		a.So(err, should.BeNil)
+		step, ok, err := totp.Validate(secret, code, now)
+		a.So(err, should.BeNil)
+		a.So(ok, should.Equal, tc.OK)
+		if tc.OK {
+			a.So(step, should.Equal, totp.Step(now.Add(tc.Offset)))
+		}
+	}
+
+	_, ok, err := totp.Validate(secret, "12345", now)
+	a.So(err, should.BeNil)
+	a.So(ok, should.BeFalse)
+
+	_, _, err = totp.Validate("not base32!", "123456", now)
+	a.So(err, should.NotBeNil)
+}
+
+func TestURI(t *testing.T) {
+	a := assertions.New(t)
+
+	uri := totp.URI("The Things Stack", "admin", "JBSWY3DPEHPK3PXP")
+	a.So(strings.HasPrefix(uri, "otpauth://totp/The%20Things%20Stack:admin?"), should.BeTrue)
+	a.So(uri, should.ContainSubstring, "secret=JBSWY3DPEHPK3PXP")
+	a.So(uri, should.ContainSubstring, "issuer=The+Things+Stack")
+}
@@ -0,0 +1,127 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cleanup
+
+import (
+	"context"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// applicationPurgeEvent is the name of the event that the Identity Server publishes when an application is purged.
+const applicationPurgeEvent = "application.purge"
+
+// ApplicationDataCleaner deletes the data of applications from a registry.
+type ApplicationDataCleaner interface {
+	DeleteApplicationData(ctx context.Context, applicationList []string) error
+}
+
+// ComputeDevicesOfApplications returns the devices of the device set that belong to the applications in the list.
+func ComputeDevicesOfApplications(ctx context.Context, deviceSet map[string]struct{}, applicationList []string) []string {
+	applicationSet := make(map[string]struct{}, len(applicationList))
+	for _, uid := range applicationList {
+		applicationSet[uid] = struct{}{}
+	}
+	var devices []string
+	for uid := range deviceSet {
+		devIDs, err := unique.ToDeviceID(uid)
+		if err != nil {
+			continue
+		}
+		if _, ok := applicationSet[unique.ID(ctx, devIDs.ApplicationIdentifiers)]; ok {
+			devices = append(devices, uid)
+		}
+	}
+	return devices
+}
+
+// ComputeSetIntersection returns the elements of the list that are in the set.
+func ComputeSetIntersection(set map[string]struct{}, list []string) []string {
+	var intersection []string
+	for _, id := range list {
+		if _, ok := set[id]; ok {
+			intersection = append(intersection, id)
+		}
+	}
+	return intersection
+}
+
+// NewPurgedApplicationsTask returns a task that subscribes to the events of applications that are purged from the
+// Identity Server, and deletes the data of these applications using the cleaners.
+// Purged applications stay pending until all cleaners succeeded. If a cleaner fails, the task returns the error and
+// retries the pending applications when it is restarted.
+func NewPurgedApplicationsTask(cleaners ...ApplicationDataCleaner) func(context.Context) error {
+	var (
+		pendingMu sync.Mutex
+		pending   = make(map[string]struct{})
+		notify    = make(chan struct{}, 1)
+	)
+	signal := func() {
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}
+	return func(ctx context.Context) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		err := events.Subscribe(ctx, []string{applicationPurgeEvent}, nil, events.HandlerFunc(func(evt events.Event) {
+			pendingMu.Lock()
+			for _, ids := range evt.Identifiers() {
+				appIDs := ids.GetApplicationIds()
+				if appIDs == nil {
+					continue
+				}
+				pending[unique.ID(evt.Context(), appIDs)] = struct{}{}
+			}
+			pendingMu.Unlock()
+			signal()
+		}))
+		if err != nil {
+			return err
+		}
+		// Applications that are pending from a previous run are retried immediately.
+		signal()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-notify:
+			}
+			pendingMu.Lock()
+			uids := make([]string, 0, len(pending))
+			for uid := range pending {
+				uids = append(uids, uid)
+			}
+			pendingMu.Unlock()
+			for _, uid := range uids {
+				logger := log.FromContext(ctx).WithField("application_uid", uid)
+				for _, cleaner := range cleaners {
+					if err := cleaner.DeleteApplicationData(ctx, []string{uid}); err != nil {
+						logger.WithError(err).Warn("Failed to delete data of purged application")
+						return err
+					}
+				}
+				pendingMu.Lock()
+				delete(pending, uid)
+				pendingMu.Unlock()
+				logger.Debug("Deleted data of purged application")
+			}
+		}
+	}
+}
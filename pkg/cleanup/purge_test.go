@@ -0,0 +1,232 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cleanup_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/cleanup"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/events/basic"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestComputeDevicesOfApplications(t *testing.T) {
+	a, ctx := test.New(t)
+
+	deviceSet := map[string]struct{}{
+		"app-1.dev-1": {},
+		"app-1.dev-2": {},
+		"app-2.dev-1": {},
+		"app-3.dev-1": {},
+	}
+	devices := cleanup.ComputeDevicesOfApplications(ctx, deviceSet, []string{"app-1", "app-3"})
+	sort.Strings(devices)
+	a.So(devices, should.Resemble, []string{"app-1.dev-1", "app-1.dev-2", "app-3.dev-1"})
+
+	a.So(cleanup.ComputeSetIntersection(map[string]struct{}{"app-1": {}, "app-2": {}}, []string{"app-2", "app-3"}),
+		should.Resemble, []string{"app-2"})
+}
+
+type mockApplicationDataCleaner chan []string
+
+func (c mockApplicationDataCleaner) DeleteApplicationData(_ context.Context, applicationList []string) error {
+	c <- applicationList
+	return nil
+}
+
+var evtPurgeApplication = events.Define("application.purge", "purge application")
+
+func TestPurgedApplicationsTask(t *testing.T) {
+	a, ctx := test.New(t)
+	events.SetDefaultPubSub(basic.NewPubSub())
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cleaner := make(mockApplicationDataCleaner, 1)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- cleanup.NewPurgedApplicationsTask(cleaner)(ctx)
+	}()
+	// Wait for the task to subscribe.
+	time.Sleep(test.Delay)
+
+	events.Publish(evtPurgeApplication.NewWithIdentifiersAndData(ctx, &ttnpb.ApplicationIdentifiers{ApplicationId: "foo"}, nil))
+	events.Publish(events.New(ctx, "application.delete", "delete application",
+		events.WithIdentifiers(&ttnpb.ApplicationIdentifiers{ApplicationId: "bar"}),
+	))
+
+	select {
+	case applicationList := <-cleaner:
+		a.So(applicationList, should.Resemble, []string{"foo"})
+	case <-time.After(test.Delay * 10):
+		t.Fatal("Timed out waiting for cleanup of purged application")
+	}
+	select {
+	case applicationList := <-cleaner:
+		t.Fatalf("Unexpected cleanup of %v", applicationList)
+	case <-time.After(test.Delay * 5):
+	}
+
+	cancel()
+	a.So(<-errCh, should.Equal, context.Canceled)
+}
+
+type failingApplicationDataCleaner struct {
+	mockApplicationDataCleaner
+	fail chan struct{}
+}
+
+func (c failingApplicationDataCleaner) DeleteApplicationData(ctx context.Context, applicationList []string) error {
+	select {
+	case <-c.fail:
+		return errTestCleanup.New()
+	default:
+	}
+	return c.mockApplicationDataCleaner.DeleteApplicationData(ctx, applicationList)
+}
+
+var errTestCleanup = errors.DefineUnavailable("test_cleanup", "test cleanup")
+
+func TestPurgedApplicationsTaskRetry(t *testing.T) {
+	a, ctx := test.New(t)
+	events.SetDefaultPubSub(basic.NewPubSub())
+
+	cleaner := failingApplicationDataCleaner{
+		mockApplicationDataCleaner: make(mockApplicationDataCleaner, 1),
+		fail:                       make(chan struct{}, 1),
+	}
+	cleaner.fail <- struct{}{}
+	task := cleanup.NewPurgedApplicationsTask(cleaner)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- task(ctx)
+	}()
+	time.Sleep(test.Delay)
+
+	events.Publish(evtPurgeApplication.NewWithIdentifiersAndData(ctx, &ttnpb.ApplicationIdentifiers{ApplicationId: "foo"}, nil))
+	select {
+	case err := <-errCh:
+		a.So(errors.IsUnavailable(err), should.BeTrue)
+	case <-time.After(test.Delay * 10):
+		t.Fatal("Timed out waiting for the task to fail")
+	}
+
+	// The purged application is retried when the task is restarted.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		errCh <- task(ctx)
+	}()
+	select {
+	case applicationList := <-cleaner.mockApplicationDataCleaner:
+		a.So(applicationList, should.Resemble, []string{"foo"})
+	case <-time.After(test.Delay * 10):
+		t.Fatal("Timed out waiting for cleanup of purged application")
+	}
+
+	cancel()
+	a.So(<-errCh, should.Equal, context.Canceled)
+}
+
+type mockReconciler struct {
+	localApplicationSet, localDeviceSet map[string]struct{}
+	deletedApplications, deletedDevices []string
+}
+
+func (r *mockReconciler) RangeToLocalSet(context.Context) error {
+	return nil
+}
+
+func (r *mockReconciler) CleanData(_ context.Context, isApplicationSet, isDeviceSet map[string]struct{}) error {
+	for uid := range cleanup.ComputeSetComplement(isApplicationSet, r.localApplicationSet) {
+		r.deletedApplications = append(r.deletedApplications, uid)
+	}
+	for uid := range cleanup.ComputeSetComplement(isDeviceSet, r.localDeviceSet) {
+		r.deletedDevices = append(r.deletedDevices, uid)
+	}
+	return nil
+}
+
+func TestReconcile(t *testing.T) {
+	a, ctx := test.New(t)
+
+	r := &mockReconciler{
+		localApplicationSet: map[string]struct{}{"app-1": {}, "app-2": {}},
+		localDeviceSet:      map[string]struct{}{"app-1.dev-1": {}, "app-2.dev-1": {}},
+	}
+	err := cleanup.Reconcile(ctx, func(context.Context) (map[string]struct{}, map[string]struct{}, error) {
+		return map[string]struct{}{"app-1": {}}, map[string]struct{}{"app-1.dev-1": {}}, nil
+	}, r)
+	a.So(err, should.BeNil)
+	a.So(r.deletedApplications, should.Resemble, []string{"app-2"})
+	a.So(r.deletedDevices, should.Resemble, []string{"app-2.dev-1"})
+
+	// Nothing is deleted if the sets of the Identity Server cannot be fetched.
+	r.deletedApplications, r.deletedDevices = nil, nil
+	err = cleanup.Reconcile(ctx, func(context.Context) (map[string]struct{}, map[string]struct{}, error) {
+		return nil, nil, errTestCleanup.New()
+	}, r)
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+	a.So(r.deletedApplications, should.BeEmpty)
+	a.So(r.deletedDevices, should.BeEmpty)
+}
+
+func TestReconcileTask(t *testing.T) {
+	a, ctx := test.New(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	r := &mockReconciler{
+		localApplicationSet: map[string]struct{}{"app-1": {}, "app-2": {}},
+		localDeviceSet:      map[string]struct{}{"app-1.dev-1": {}, "app-1.dev-2": {}, "app-2.dev-1": {}},
+	}
+	listings, listed := make(chan map[string]struct{}), make(chan struct{}, 3)
+	task := cleanup.NewReconcileTask(test.Delay, func(ctx context.Context) (map[string]struct{}, map[string]struct{}, error) {
+		listed <- struct{}{}
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case deviceSet := <-listings:
+			return map[string]struct{}{"app-1": {}}, deviceSet, nil
+		}
+	}, r)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- task(ctx)
+	}()
+
+	// The first listing misses app-1.dev-2, the second listing misses app-1.dev-1.
+	listings <- map[string]struct{}{"app-1.dev-1": {}}
+	listings <- map[string]struct{}{"app-1.dev-2": {}}
+	for i := 0; i < 3; i++ {
+		<-listed
+	}
+
+	// Only the entities that are missing from two consecutive listings are deleted.
+	a.So(r.deletedApplications, should.Resemble, []string{"app-2"})
+	a.So(r.deletedDevices, should.Resemble, []string{"app-2.dev-1"})
+
+	cancel()
+	a.So(<-errCh, should.Equal, context.Canceled)
+}
@@ -0,0 +1,222 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cleanup
+
+import (
+	"context"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/grpc"
+)
+
+// identityServerPaginationLimit is the page size used when listing the entities of the Identity Server.
+const identityServerPaginationLimit = 1000
+
+// Reconciler deletes the local data of applications and devices that no longer exist in the Identity Server.
+type Reconciler interface {
+	// RangeToLocalSet loads the applications and devices that have local data.
+	RangeToLocalSet(ctx context.Context) error
+	// CleanData deletes the data of the applications and devices of the local set that are not in the sets of the
+	// Identity Server.
+	CleanData(ctx context.Context, isApplicationSet, isDeviceSet map[string]struct{}) error
+}
+
+// SetCleaner is a registry cleaner that cleans the data of either applications or devices.
+type SetCleaner interface {
+	RangeToLocalSet(ctx context.Context) error
+	CleanData(ctx context.Context, isSet map[string]struct{}) error
+}
+
+// DeviceAndApplicationSetCleaner is a registry cleaner that cleans the data of both devices and applications.
+type DeviceAndApplicationSetCleaner interface {
+	RangeToLocalSet(ctx context.Context) error
+	CleanData(ctx context.Context, isDeviceSet, isApplicationSet map[string]struct{}) error
+}
+
+type applicationSetReconciler struct {
+	SetCleaner
+}
+
+func (r applicationSetReconciler) CleanData(ctx context.Context, isApplicationSet, _ map[string]struct{}) error {
+	return r.SetCleaner.CleanData(ctx, isApplicationSet)
+}
+
+// ApplicationSetReconciler returns a Reconciler that cleans the data of applications using the cleaner.
+func ApplicationSetReconciler(cleaner SetCleaner) Reconciler {
+	return applicationSetReconciler{cleaner}
+}
+
+type deviceSetReconciler struct {
+	SetCleaner
+}
+
+func (r deviceSetReconciler) CleanData(ctx context.Context, _, isDeviceSet map[string]struct{}) error {
+	return r.SetCleaner.CleanData(ctx, isDeviceSet)
+}
+
+// DeviceSetReconciler returns a Reconciler that cleans the data of devices using the cleaner.
+func DeviceSetReconciler(cleaner SetCleaner) Reconciler {
+	return deviceSetReconciler{cleaner}
+}
+
+type deviceAndApplicationSetReconciler struct {
+	DeviceAndApplicationSetCleaner
+}
+
+func (r deviceAndApplicationSetReconciler) CleanData(ctx context.Context, isApplicationSet, isDeviceSet map[string]struct{}) error {
+	return r.DeviceAndApplicationSetCleaner.CleanData(ctx, isDeviceSet, isApplicationSet)
+}
+
+// DeviceAndApplicationSetReconciler returns a Reconciler that cleans the data of devices and applications using the
+// cleaner.
+func DeviceAndApplicationSetReconciler(cleaner DeviceAndApplicationSetCleaner) Reconciler {
+	return deviceAndApplicationSetReconciler{cleaner}
+}
+
+// IdentityServerSetsFunc returns the sets of unique IDs of the applications and devices in the Identity Server.
+type IdentityServerSetsFunc func(ctx context.Context) (applicationSet, deviceSet map[string]struct{}, err error)
+
+// Cluster is the cluster that is used to connect to the Identity Server.
+type Cluster interface {
+	GetPeerConn(ctx context.Context, role ttnpb.ClusterRole, ids cluster.EntityIdentifiers) (*grpc.ClientConn, error)
+	WithClusterAuth() grpc.CallOption
+}
+
+// ClusterIdentityServerSets returns an IdentityServerSetsFunc that lists the applications and devices of the
+// Identity Server in the cluster. Deleted applications are included, as their data is kept until they are purged.
+func ClusterIdentityServerSets(c Cluster) IdentityServerSetsFunc {
+	return func(ctx context.Context) (map[string]struct{}, map[string]struct{}, error) {
+		cc, err := c.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		applicationSet := make(map[string]struct{})
+		appClient := ttnpb.NewApplicationRegistryClient(cc)
+		for page := uint32(1); ; page++ {
+			res, err := appClient.List(ctx, &ttnpb.ListApplicationsRequest{
+				FieldMask: &pbtypes.FieldMask{Paths: []string{"ids"}},
+				Limit:     identityServerPaginationLimit,
+				Page:      page,
+				Deleted:   true,
+			}, c.WithClusterAuth())
+			if err != nil {
+				return nil, nil, err
+			}
+			if len(res.Applications) == 0 {
+				break
+			}
+			for _, app := range res.Applications {
+				applicationSet[unique.ID(ctx, app.GetIds())] = struct{}{}
+			}
+		}
+		deviceSet := make(map[string]struct{})
+		devClient := ttnpb.NewEndDeviceRegistryClient(cc)
+		for page := uint32(1); ; page++ {
+			res, err := devClient.List(ctx, &ttnpb.ListEndDevicesRequest{
+				FieldMask: &pbtypes.FieldMask{Paths: []string{"ids"}},
+				Limit:     identityServerPaginationLimit,
+				Page:      page,
+			}, c.WithClusterAuth())
+			if err != nil {
+				return nil, nil, err
+			}
+			if len(res.EndDevices) == 0 {
+				break
+			}
+			for _, dev := range res.EndDevices {
+				deviceSet[unique.ID(ctx, dev.EndDeviceIdentifiers)] = struct{}{}
+			}
+		}
+		return applicationSet, deviceSet, nil
+	}
+}
+
+// Reconcile deletes the local data of the applications and devices that are not in the Identity Server.
+// The local sets are loaded before the sets of the Identity Server are fetched, so that entities that are created
+// in the meantime are never considered stale.
+func Reconcile(ctx context.Context, isSets IdentityServerSetsFunc, reconcilers ...Reconciler) error {
+	for _, r := range reconcilers {
+		if err := r.RangeToLocalSet(ctx); err != nil {
+			return err
+		}
+	}
+	applicationSet, deviceSet, err := isSets(ctx)
+	if err != nil {
+		return err
+	}
+	for _, r := range reconcilers {
+		if err := r.CleanData(ctx, applicationSet, deviceSet); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unionSets returns the union of the sets.
+func unionSets(sets ...map[string]struct{}) map[string]struct{} {
+	union := make(map[string]struct{})
+	for _, set := range sets {
+		for id := range set {
+			union[id] = struct{}{}
+		}
+	}
+	return union
+}
+
+// NewReconcileTask returns a task that reconciles the local data with the Identity Server at every interval. This
+// deletes the data of applications and devices of which the purge events were missed.
+// The Identity Server is listed page by page, so entities that move between pages while listing may be missed.
+// Therefore, data is only deleted if the application or device is missing from two consecutive listings; the first
+// listing, when the task starts, is only recorded.
+// The task returns on the first error, so that it is restarted with backoff.
+func NewReconcileTask(interval time.Duration, isSets IdentityServerSetsFunc, reconcilers ...Reconciler) func(context.Context) error {
+	var previousApplicationSet, previousDeviceSet map[string]struct{}
+	confirmedSets := func(ctx context.Context) (map[string]struct{}, map[string]struct{}, error) {
+		applicationSet, deviceSet, err := isSets(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		confirmedApplicationSet := unionSets(applicationSet, previousApplicationSet)
+		confirmedDeviceSet := unionSets(deviceSet, previousDeviceSet)
+		previousApplicationSet, previousDeviceSet = applicationSet, deviceSet
+		return confirmedApplicationSet, confirmedDeviceSet, nil
+	}
+	return func(ctx context.Context) error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			var err error
+			if previousApplicationSet == nil {
+				_, _, err = confirmedSets(ctx)
+			} else {
+				err = Reconcile(ctx, confirmedSets, reconcilers...)
+			}
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to reconcile data with the Identity Server")
+				return err
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+			}
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"net"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
@@ -41,6 +42,8 @@ func (c *Component) initGRPC() {
 		rpcserver.WithTrustedProxies(c.config.GRPC.TrustedProxies...),
 		rpcserver.WithLogIgnoreMethods(c.config.GRPC.LogIgnoreMethods),
 		rpcserver.WithRateLimiter(c.RateLimiter()),
+		rpcserver.WithStreamInterceptors(rights.StreamServerInterceptor()),
+		rpcserver.WithUnaryInterceptors(rights.UnaryServerInterceptor()),
 	)
 }
 
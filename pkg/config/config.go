@@ -596,6 +596,11 @@ func (m *Manager) setDefaults(prefix string, flags *pflag.FlagSet, config interf
 						name = prefix
 					}
 					m.setDefaults(name, flags, fieldValue.Interface())
+				case reflect.Slice:
+					if fieldValue.Type().Elem().Kind() != reflect.Struct {
+						panic(fmt.Errorf(`config: cannot work with "%v" in configuration at name "%s"`, field.Type, name))
+					}
+					// Slices of structs can only be set in the config file. Do not add command-line options
 				case reflect.Invalid:
 					// TODO: Remove setDefaults, traverse reflect.Type instead of values directly.
 					fmt.Fprintf(os.Stderr, `config: skip "%v" in configuration at name "%s"`+"\n", field.Type, name)
@@ -163,9 +163,11 @@ type KeyVaultCache struct {
 
 // KeyVault represents configuration for key vaults.
 type KeyVault struct {
-	Provider string            `name:"provider" description:"Provider (static)"`
-	Cache    KeyVaultCache     `name:"cache"`
-	Static   map[string][]byte `name:"static"`
+	Provider string                  `name:"provider" description:"Provider (static, vault, pkcs11)"`
+	Cache    KeyVaultCache           `name:"cache"`
+	Static   map[string][]byte       `name:"static"`
+	Vault    cryptoutil.VaultConfig  `name:"vault"`
+	PKCS11   cryptoutil.PKCS11Config `name:"pkcs11"`
 
 	HTTPClient *http.Client `name:"-"`
 }
@@ -179,6 +181,22 @@ func (v KeyVault) KeyVault() (crypto.KeyVault, error) {
 		kv.Separator = ":"
 		kv.ReplaceOldNew = []string{":", "_"}
 		vault = kv
+	case "vault":
+		kv, err := cryptoutil.NewVaultKeyVault(v.Vault, v.HTTPClient)
+		if err != nil {
+			return nil, err
+		}
+		kv.Separator = ":"
+		kv.ReplaceOldNew = []string{":", "_"}
+		vault = kv
+	case "pkcs11":
+		kv, err := cryptoutil.NewPKCS11KeyVault(v.PKCS11)
+		if err != nil {
+			return nil, err
+		}
+		kv.Separator = ":"
+		kv.ReplaceOldNew = []string{":", "_"}
+		vault = kv
 	}
 	if v.Cache.Size > 0 {
 		vault = cryptoutil.NewCacheKeyVault(vault, v.Cache.TTL, v.Cache.Size)
@@ -428,6 +446,9 @@ type InteropServer struct {
 	SenderClientCADeprecated map[string]string `name:"sender-client-cas" description:"Path to PEM encoded file with client CAs of sender IDs to trust; deprecated - use sender-client-ca instead"`
 
 	PacketBroker PacketBrokerInteropAuth `name:"packet-broker"`
+
+	SenderNSIDs     map[string][]string `name:"sender-ns-ids" description:"NSIDs that Network Servers of the sender NetID are allowed to use. If none are configured for a NetID, any NSID is allowed"`
+	AsyncAnswerURLs map[string]string   `name:"async-answer-urls" description:"URLs to deliver answers asynchronously to, by sender ID. Answers to senders without URL are delivered synchronously"`
 }
 
 // ServiceBase represents base service configuration.
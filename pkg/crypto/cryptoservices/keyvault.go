@@ -0,0 +1,235 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoservices
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+type keyVault struct {
+	kv crypto.OpaqueKeyVault
+	nwkKeyLabel,
+	appKeyLabel string
+}
+
+// NewKeyVault returns a network and application service using root keys that are held by the given key vault.
+// The root keys are referenced by label and never leave the key vault. Only the derived keys are returned.
+func NewKeyVault(kv crypto.OpaqueKeyVault, nwkKeyLabel, appKeyLabel string) NetworkApplication {
+	return &keyVault{
+		kv:          kv,
+		nwkKeyLabel: nwkKeyLabel,
+		appKeyLabel: appKeyLabel,
+	}
+}
+
+func (d *keyVault) getNwkKeyLabel(version ttnpb.MACVersion) (string, error) {
+	switch {
+	case version.Compare(ttnpb.MAC_V1_1) >= 0:
+		if d.nwkKeyLabel == "" {
+			return "", errNoNwkKey.New()
+		}
+		return d.nwkKeyLabel, nil
+	default:
+		if d.appKeyLabel == "" {
+			return "", errNoAppKey.New()
+		}
+		return d.appKeyLabel, nil
+	}
+}
+
+// deriveKey derives a key by encrypting the given block, which is in the format |t(1)|input(...)|padding|,
+// with the root key of the given label. The input is in little endian.
+func (d *keyVault) deriveKey(ctx context.Context, label string, t byte, input ...[]byte) (types.AES128Key, error) {
+	buf := make([]byte, 1, 16)
+	buf[0] = t
+	for _, in := range input {
+		for i := len(in) - 1; i >= 0; i-- {
+			buf = append(buf, in[i])
+		}
+	}
+	buf = buf[:16]
+	derived, err := d.kv.EncryptBlocks(ctx, buf, label)
+	if err != nil {
+		return types.AES128Key{}, err
+	}
+	var key types.AES128Key
+	copy(key[:], derived)
+	return key, nil
+}
+
+var errPayloadSize = errors.DefineInvalidArgument("payload_size", "invalid payload size of {size} bytes")
+
+func (d *keyVault) JoinRequestMIC(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, payload []byte) ([4]byte, error) {
+	label, err := d.getNwkKeyLabel(version)
+	if err != nil {
+		return [4]byte{}, err
+	}
+	if len(payload) != 19 {
+		return [4]byte{}, errPayloadSize.WithAttributes("size", len(payload))
+	}
+	mac, err := d.kv.CMAC(ctx, payload, label)
+	if err != nil {
+		return [4]byte{}, err
+	}
+	var mic [4]byte
+	copy(mic[:], mac)
+	return mic, nil
+}
+
+func (d *keyVault) JoinAcceptMIC(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, joinReqType byte, dn types.DevNonce, payload []byte) ([4]byte, error) {
+	if dev.JoinEui == nil {
+		return [4]byte{}, errNoJoinEUI.New()
+	}
+	if dev.DevEui == nil || dev.DevEui.IsZero() {
+		return [4]byte{}, errNoDevEUI.New()
+	}
+	label, err := d.getNwkKeyLabel(version)
+	if err != nil {
+		return [4]byte{}, err
+	}
+	switch {
+	case version.Compare(ttnpb.MAC_V1_1) >= 0:
+		jsIntKey, err := d.deriveKey(ctx, label, 0x06, dev.DevEui[:])
+		if err != nil {
+			return [4]byte{}, err
+		}
+		return crypto.ComputeJoinAcceptMIC(jsIntKey, joinReqType, *dev.JoinEui, dn, payload)
+	default:
+		if len(payload) != 13 && len(payload) != 29 {
+			return [4]byte{}, errPayloadSize.WithAttributes("size", len(payload))
+		}
+		mac, err := d.kv.CMAC(ctx, payload, label)
+		if err != nil {
+			return [4]byte{}, err
+		}
+		var mic [4]byte
+		copy(mic[:], mac)
+		return mic, nil
+	}
+}
+
+func (d *keyVault) EncryptJoinAccept(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, payload []byte) ([]byte, error) {
+	label, err := d.getNwkKeyLabel(version)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) != 16 && len(payload) != 32 {
+		return nil, errPayloadSize.WithAttributes("size", len(payload))
+	}
+	// The join-accept message is encrypted with the AES decrypt operation.
+	return d.kv.DecryptBlocks(ctx, payload, label)
+}
+
+func (d *keyVault) EncryptRejoinAccept(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, payload []byte) ([]byte, error) {
+	if version.Compare(ttnpb.MAC_V1_1) < 0 {
+		panic("This statement is unreachable. Please version check.")
+	}
+	if dev.JoinEui == nil {
+		return nil, errNoJoinEUI.New()
+	}
+	if dev.DevEui == nil || dev.DevEui.IsZero() {
+		return nil, errNoDevEUI.New()
+	}
+	if d.nwkKeyLabel == "" {
+		return nil, errNoNwkKey.New()
+	}
+	jsEncKey, err := d.deriveKey(ctx, d.nwkKeyLabel, 0x05, dev.DevEui[:])
+	if err != nil {
+		return nil, err
+	}
+	return crypto.EncryptJoinAccept(jsEncKey, payload)
+}
+
+func (d *keyVault) DeriveNwkSKeys(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, jn types.JoinNonce, dn types.DevNonce, nid types.NetID) (NwkSKeys, error) {
+	if dev.JoinEui == nil {
+		return NwkSKeys{}, errNoJoinEUI.New()
+	}
+	if dev.DevEui == nil || dev.DevEui.IsZero() {
+		return NwkSKeys{}, errNoDevEUI.New()
+	}
+	switch {
+	case version.Compare(ttnpb.MAC_V1_1) >= 0:
+		if d.nwkKeyLabel == "" {
+			return NwkSKeys{}, errNoNwkKey.New()
+		}
+		var keys NwkSKeys
+		for _, k := range []struct {
+			t   byte
+			key *types.AES128Key
+		}{
+			{t: 0x01, key: &keys.FNwkSIntKey},
+			{t: 0x03, key: &keys.SNwkSIntKey},
+			{t: 0x04, key: &keys.NwkSEncKey},
+		} {
+			key, err := d.deriveKey(ctx, d.nwkKeyLabel, k.t, jn[:], dev.JoinEui[:], dn[:])
+			if err != nil {
+				return NwkSKeys{}, err
+			}
+			*k.key = key
+		}
+		return keys, nil
+
+	default:
+		if d.appKeyLabel == "" {
+			return NwkSKeys{}, errNoAppKey.New()
+		}
+		fNwkSIntKey, err := d.deriveKey(ctx, d.appKeyLabel, 0x01, jn[:], nid[:], dn[:])
+		if err != nil {
+			return NwkSKeys{}, err
+		}
+		return NwkSKeys{
+			FNwkSIntKey: fNwkSIntKey,
+		}, nil
+	}
+}
+
+var errRootKeyNotExportable = errors.DefineFailedPrecondition("root_key_not_exportable", "root key `{label}` is held by the key vault and cannot be exported")
+
+func (d *keyVault) GetNwkKey(ctx context.Context, dev *ttnpb.EndDevice) (*types.AES128Key, error) {
+	if d.nwkKeyLabel == "" {
+		return nil, errNoNwkKey.New()
+	}
+	return nil, errRootKeyNotExportable.WithAttributes("label", d.nwkKeyLabel)
+}
+
+func (d *keyVault) DeriveAppSKey(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, jn types.JoinNonce, dn types.DevNonce, nid types.NetID) (types.AES128Key, error) {
+	if dev.JoinEui == nil {
+		return types.AES128Key{}, errNoJoinEUI.New()
+	}
+	if dev.DevEui == nil || dev.DevEui.IsZero() {
+		return types.AES128Key{}, errNoDevEUI.New()
+	}
+	if d.appKeyLabel == "" {
+		return types.AES128Key{}, errNoAppKey.New()
+	}
+	switch {
+	case version.Compare(ttnpb.MAC_V1_1) >= 0:
+		return d.deriveKey(ctx, d.appKeyLabel, 0x02, jn[:], dev.JoinEui[:], dn[:])
+	default:
+		return d.deriveKey(ctx, d.appKeyLabel, 0x02, jn[:], nid[:], dn[:])
+	}
+}
+
+func (d *keyVault) GetAppKey(ctx context.Context, dev *ttnpb.EndDevice) (*types.AES128Key, error) {
+	if d.appKeyLabel == "" {
+		return nil, errNoAppKey.New()
+	}
+	return nil, errRootKeyNotExportable.WithAttributes("label", d.appKeyLabel)
+}
@@ -0,0 +1,123 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoservices_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoservices"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestKeyVaultCryptoService(t *testing.T) {
+	ctx := test.Context()
+	nwkKey := types.AES128Key{0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1}
+	appKey := types.AES128Key{0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2}
+	memSvc := NewMemory(&nwkKey, &appKey)
+	kvSvc := NewKeyVault(cryptoutil.NewMemKeyVault(map[string][]byte{
+		"nwk-key": nwkKey[:],
+		"app-key": appKey[:],
+	}), "nwk-key", "app-key")
+	dev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			JoinEui: eui64Ptr(types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}),
+			DevEui:  eui64Ptr(types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}),
+		},
+	}
+	jn := types.JoinNonce{0x1, 0x2, 0x3}
+	dn := types.DevNonce{0x4, 0x5}
+	nid := types.NetID{0x6, 0x7, 0x8}
+
+	for _, version := range []ttnpb.MACVersion{ttnpb.MAC_V1_0_3, ttnpb.MAC_V1_1} {
+		t.Run(version.String(), func(t *testing.T) {
+			a := assertions.New(t)
+
+			payload := bytes.Repeat([]byte{0x1}, 19)
+			expectedMIC, err := memSvc.JoinRequestMIC(ctx, dev, version, payload)
+			a.So(err, should.BeNil)
+			mic, err := kvSvc.JoinRequestMIC(ctx, dev, version, payload)
+			a.So(err, should.BeNil)
+			a.So(mic, should.Equal, expectedMIC)
+
+			payload = bytes.Repeat([]byte{0x2}, 13)
+			expectedMIC, err = memSvc.JoinAcceptMIC(ctx, dev, version, 0xff, dn, payload)
+			a.So(err, should.BeNil)
+			mic, err = kvSvc.JoinAcceptMIC(ctx, dev, version, 0xff, dn, payload)
+			a.So(err, should.BeNil)
+			a.So(mic, should.Equal, expectedMIC)
+
+			for _, payload := range [][]byte{
+				bytes.Repeat([]byte{0x3}, 16),
+				bytes.Repeat([]byte{0x3}, 32),
+			} {
+				expected, err := memSvc.EncryptJoinAccept(ctx, dev, version, payload)
+				a.So(err, should.BeNil)
+				actual, err := kvSvc.EncryptJoinAccept(ctx, dev, version, payload)
+				a.So(err, should.BeNil)
+				a.So(actual, should.Resemble, expected)
+			}
+			_, err = kvSvc.EncryptJoinAccept(ctx, dev, version, bytes.Repeat([]byte{0x3}, 48))
+			a.So(err, should.NotBeNil)
+
+			if version.Compare(ttnpb.MAC_V1_1) >= 0 {
+				payload := bytes.Repeat([]byte{0x4}, 32)
+				expected, err := memSvc.EncryptRejoinAccept(ctx, dev, version, payload)
+				a.So(err, should.BeNil)
+				actual, err := kvSvc.EncryptRejoinAccept(ctx, dev, version, payload)
+				a.So(err, should.BeNil)
+				a.So(actual, should.Resemble, expected)
+			}
+
+			expectedNwkSKeys, err := memSvc.DeriveNwkSKeys(ctx, dev, version, jn, dn, nid)
+			a.So(err, should.BeNil)
+			nwkSKeys, err := kvSvc.DeriveNwkSKeys(ctx, dev, version, jn, dn, nid)
+			a.So(err, should.BeNil)
+			a.So(nwkSKeys, should.Resemble, expectedNwkSKeys)
+
+			expectedAppSKey, err := memSvc.DeriveAppSKey(ctx, dev, version, jn, dn, nid)
+			a.So(err, should.BeNil)
+			appSKey, err := kvSvc.DeriveAppSKey(ctx, dev, version, jn, dn, nid)
+			a.So(err, should.BeNil)
+			a.So(appSKey, should.Resemble, expectedAppSKey)
+		})
+	}
+
+	t.Run("RootKeys", func(t *testing.T) {
+		a := assertions.New(t)
+		// Root keys never leave the key vault.
+		key, err := kvSvc.GetNwkKey(ctx, dev)
+		a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+		a.So(key, should.BeNil)
+		key, err = kvSvc.GetAppKey(ctx, dev)
+		a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+		a.So(key, should.BeNil)
+	})
+
+	t.Run("NoLabels", func(t *testing.T) {
+		a := assertions.New(t)
+		svc := NewKeyVault(cryptoutil.NewMemKeyVault(map[string][]byte{}), "", "")
+		_, err := svc.JoinRequestMIC(ctx, dev, ttnpb.MAC_V1_1, bytes.Repeat([]byte{0x1}, 19))
+		a.So(err, should.NotBeNil)
+		_, err = svc.DeriveAppSKey(ctx, dev, ttnpb.MAC_V1_1, jn, dn, nid)
+		a.So(err, should.NotBeNil)
+	})
+}
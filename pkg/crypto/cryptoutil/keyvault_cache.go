@@ -21,6 +21,7 @@ import (
 
 	"github.com/bluele/gcache"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 )
 
 type unwrapEntry struct {
@@ -33,15 +34,24 @@ type cachedVault struct {
 	unwrapCache gcache.Cache
 }
 
+// NewCacheKeyVault returns a KeyVault that caches unwrapped keys of main.
+// If main is an OpaqueKeyVault, the returned KeyVault is an OpaqueKeyVault as well.
 func NewCacheKeyVault(main crypto.KeyVault, ttl time.Duration, size int) crypto.KeyVault {
 	builder := gcache.New(size).ARC()
 	if ttl != 0 {
 		builder = builder.Expiration(ttl)
 	}
-	return &cachedVault{
+	cached := &cachedVault{
 		KeyVault:    main,
 		unwrapCache: builder.Build(),
 	}
+	if opaque, ok := main.(crypto.OpaqueKeyVault); ok {
+		return &cachedOpaqueVault{
+			cachedVault: cached,
+			opaque:      opaque,
+		}
+	}
+	return cached
 }
 
 func unwrapCacheKey(ciphertext []byte, kekLabel string) string {
@@ -59,5 +69,30 @@ func (c *cachedVault) Unwrap(ctx context.Context, ciphertext []byte, kekLabel st
 	c.unwrapCache.Set(id, v)
 	crypto.RegisterCacheMiss(ctx, "unwrap")
 	v.value, v.err = c.KeyVault.Unwrap(ctx, ciphertext, kekLabel)
+	if isTransientError(v.err) {
+		// Do not cache errors of remote key vaults that may succeed on retry.
+		c.unwrapCache.Remove(id)
+	}
 	return v.value, v.err
 }
+
+func isTransientError(err error) bool {
+	return errors.IsUnavailable(err) || errors.IsDeadlineExceeded(err) || errors.IsCanceled(err)
+}
+
+type cachedOpaqueVault struct {
+	*cachedVault
+	opaque crypto.OpaqueKeyVault
+}
+
+func (c *cachedOpaqueVault) EncryptBlocks(ctx context.Context, plaintext []byte, label string) ([]byte, error) {
+	return c.opaque.EncryptBlocks(ctx, plaintext, label)
+}
+
+func (c *cachedOpaqueVault) DecryptBlocks(ctx context.Context, ciphertext []byte, label string) ([]byte, error) {
+	return c.opaque.DecryptBlocks(ctx, ciphertext, label)
+}
+
+func (c *cachedOpaqueVault) CMAC(ctx context.Context, data []byte, label string) ([]byte, error) {
+	return c.opaque.CMAC(ctx, data, label)
+}
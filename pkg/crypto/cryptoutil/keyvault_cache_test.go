@@ -23,6 +23,7 @@ import (
 
 	"github.com/smartystreets/assertions"
 	. "go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	ttnerrors "go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
@@ -130,3 +131,37 @@ func TestCacheUsed(t *testing.T) {
 
 	// Delay based evictions are left out since they may lead to flakyness.
 }
+
+var (
+	errTestUnavailable = ttnerrors.DefineUnavailable("test_unavailable", "unavailable")
+	errTestInvalid     = ttnerrors.DefineInvalidArgument("test_invalid", "invalid")
+)
+
+func TestCacheTransientError(t *testing.T) {
+	a := assertions.New(t)
+	m := &mockKeyVault{}
+
+	ctx := test.Context()
+	ck := NewCacheKeyVault(m, 0, 1)
+
+	// Transient errors are not cached.
+	m.results.err = errTestUnavailable.New()
+	_, err := ck.Unwrap(ctx, []byte{0x01, 0x02}, "foo")
+	a.So(ttnerrors.IsUnavailable(err), should.BeTrue)
+	a.So(m.calls.count, should.Equal, 1)
+
+	m.results.key = []byte{0x03, 0x04}
+	m.results.err = nil
+	key, err := ck.Unwrap(ctx, []byte{0x01, 0x02}, "foo")
+	a.So(err, should.BeNil)
+	a.So(key, should.Resemble, m.results.key)
+	a.So(m.calls.count, should.Equal, 2)
+
+	// Other errors are cached.
+	m.results.err = errTestInvalid.New()
+	_, err = ck.Unwrap(ctx, []byte{0x05, 0x06}, "foo")
+	a.So(ttnerrors.IsInvalidArgument(err), should.BeTrue)
+	_, err = ck.Unwrap(ctx, []byte{0x05, 0x06}, "foo")
+	a.So(ttnerrors.IsInvalidArgument(err), should.BeTrue)
+	a.So(m.calls.count, should.Equal, 3)
+}
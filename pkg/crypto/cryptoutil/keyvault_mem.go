@@ -17,11 +17,13 @@ package cryptoutil
 import (
 	"bytes"
 	"context"
+	"crypto/aes"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/pem"
 	"strings"
 
+	"github.com/jacobsa/crypto/cmac"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 )
@@ -85,6 +87,62 @@ func (v MemKeyVault) Decrypt(ctx context.Context, ciphertext []byte, id string)
 	return crypto.Decrypt(key, ciphertext)
 }
 
+// EncryptBlocks implements OpaqueKeyVault.
+func (v MemKeyVault) EncryptBlocks(ctx context.Context, plaintext []byte, label string) ([]byte, error) {
+	key, ok := v.m[label]
+	if !ok {
+		return nil, errKeyNotFound.WithAttributes("id", label)
+	}
+	if len(plaintext)%aes.BlockSize != 0 {
+		return nil, errInvalidLength.New()
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext := make([]byte, len(plaintext))
+	for i := 0; i < len(plaintext); i += aes.BlockSize {
+		block.Encrypt(ciphertext[i:i+aes.BlockSize], plaintext[i:i+aes.BlockSize])
+	}
+	return ciphertext, nil
+}
+
+// DecryptBlocks implements OpaqueKeyVault.
+func (v MemKeyVault) DecryptBlocks(ctx context.Context, ciphertext []byte, label string) ([]byte, error) {
+	key, ok := v.m[label]
+	if !ok {
+		return nil, errKeyNotFound.WithAttributes("id", label)
+	}
+	if len(ciphertext)%aes.BlockSize != 0 {
+		return nil, errInvalidLength.New()
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	plaintext := make([]byte, len(ciphertext))
+	for i := 0; i < len(ciphertext); i += aes.BlockSize {
+		block.Decrypt(plaintext[i:i+aes.BlockSize], ciphertext[i:i+aes.BlockSize])
+	}
+	return plaintext, nil
+}
+
+// CMAC implements OpaqueKeyVault.
+func (v MemKeyVault) CMAC(ctx context.Context, data []byte, label string) ([]byte, error) {
+	key, ok := v.m[label]
+	if !ok {
+		return nil, errKeyNotFound.WithAttributes("id", label)
+	}
+	hash, err := cmac.New(key)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := hash.Write(data); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
+
 // GetCertificate implements KeyVault.
 func (v MemKeyVault) GetCertificate(ctx context.Context, id string) (*x509.Certificate, error) {
 	raw, ok := v.m[id]
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
@@ -130,3 +131,38 @@ TPERi9uMQjERns1qXG/9DJLe/Qxi0r84hA==
 		a.So(errors.IsNotFound(err), should.BeTrue)
 	}
 }
+
+func TestMemKeyVaultOpaque(t *testing.T) {
+	a := assertions.New(t)
+
+	// Test vectors from FIPS 197 and RFC 4493.
+	key, _ := hex.DecodeString("2B7E151628AED2A6ABF7158809CF4F3C")
+	plaintext, _ := hex.DecodeString("6BC1BEE22E409F96E93D7E117393172A")
+	ciphertext, _ := hex.DecodeString("3AD77BB40D7A3660A89ECAF32466EF97")
+	mac, _ := hex.DecodeString("070A16B46B4D4144F79BDD9DD04A287C")
+
+	var v crypto.OpaqueKeyVault = cryptoutil.NewMemKeyVault(map[string][]byte{
+		"key1": key,
+	})
+	for _, kv := range []crypto.OpaqueKeyVault{
+		v,
+		cryptoutil.NewCacheKeyVault(v, 0, 10).(crypto.OpaqueKeyVault),
+	} {
+		actual, err := kv.EncryptBlocks(test.Context(), plaintext, "key1")
+		a.So(err, should.BeNil)
+		a.So(actual, should.Resemble, ciphertext)
+
+		actual, err = kv.DecryptBlocks(test.Context(), ciphertext, "key1")
+		a.So(err, should.BeNil)
+		a.So(actual, should.Resemble, plaintext)
+
+		actual, err = kv.CMAC(test.Context(), plaintext, "key1")
+		a.So(err, should.BeNil)
+		a.So(actual, should.Resemble, mac)
+
+		_, err = kv.EncryptBlocks(test.Context(), plaintext[:15], "key1")
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		_, err = kv.CMAC(test.Context(), plaintext, "key2")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+}
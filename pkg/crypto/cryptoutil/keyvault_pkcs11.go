@@ -0,0 +1,35 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil
+
+import "go.thethings.network/lorawan-stack/v3/pkg/errors"
+
+// PKCS11Config is the configuration of a PKCS11KeyVault.
+type PKCS11Config struct {
+	Module     string `name:"module" description:"Path to the PKCS#11 module"`
+	TokenLabel string `name:"token-label" description:"Label of the token that holds the keys"`
+	PIN        string `name:"pin" description:"User PIN of the token"`
+	Sessions   int    `name:"sessions" description:"Number of sessions to open for concurrent operations"`
+}
+
+var (
+	errPKCS11Module      = errors.DefineInvalidArgument("pkcs11_module", "no PKCS#11 module configured")
+	errPKCS11Load        = errors.DefineFailedPrecondition("pkcs11_load", "load PKCS#11 module `{module}`")
+	errPKCS11Token       = errors.DefineNotFound("pkcs11_token", "PKCS#11 token with label `{label}` not found")
+	errPKCS11Operation   = errors.DefineUnavailable("pkcs11_operation", "PKCS#11 operation `{operation}` failed")
+	errPKCS11InvalidData = errors.DefineInvalidArgument("pkcs11_invalid_data", "invalid data for PKCS#11 operation `{operation}`")
+	errPKCS11KeyType     = errors.DefineUnimplemented("pkcs11_key_type", "PKCS#11 key type `{type}` not supported")
+	errPKCS11Unavailable = errors.DefineUnimplemented("pkcs11_unavailable", "PKCS#11 support is not available in builds without cgo, such as the released binaries and Docker images")
+)
@@ -0,0 +1,545 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+// +build cgo
+
+package cryptoutil
+
+import (
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/asn1"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+// PKCS11KeyVault is a KeyVault that uses a PKCS#11 token, i.e. a hardware security module.
+// KEKs, encryption keys and private keys are token objects that are referenced by their label (CKA_LABEL).
+// Keys never leave the token: wrapping, encryption, signing and the AES operations of OpaqueKeyVault are performed by
+// the token. Certificates are stored as certificate objects with the same label as the private key.
+type PKCS11KeyVault struct {
+	ComponentPrefixKEKLabeler
+
+	module   *pkcs11.Ctx
+	sessions chan pkcs11.SessionHandle
+
+	objectsMu sync.RWMutex
+	objects   map[pkcs11ObjectKey]pkcs11.ObjectHandle
+}
+
+type pkcs11ObjectKey struct {
+	class uint
+	label string
+}
+
+// NewPKCS11KeyVault returns a PKCS11KeyVault that uses the token with the configured label.
+// The configured number of sessions is opened and logged in as user.
+func NewPKCS11KeyVault(config PKCS11Config) (*PKCS11KeyVault, error) {
+	if config.Module == "" {
+		return nil, errPKCS11Module.New()
+	}
+	module := pkcs11.New(config.Module)
+	if module == nil {
+		return nil, errPKCS11Load.WithAttributes("module", config.Module)
+	}
+	if err := module.Initialize(); err != nil {
+		module.Destroy()
+		return nil, errPKCS11Load.WithCause(err).WithAttributes("module", config.Module)
+	}
+	v := &PKCS11KeyVault{
+		module:  module,
+		objects: make(map[pkcs11ObjectKey]pkcs11.ObjectHandle),
+	}
+	slot, err := v.findSlot(config.TokenLabel)
+	if err != nil {
+		v.Close()
+		return nil, err
+	}
+	sessions := config.Sessions
+	if sessions <= 0 {
+		sessions = 1
+	}
+	v.sessions = make(chan pkcs11.SessionHandle, sessions)
+	for i := 0; i < sessions; i++ {
+		sh, err := module.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+		if err != nil {
+			v.Close()
+			return nil, pkcs11Error("open_session", err)
+		}
+		v.sessions <- sh
+		if i == 0 {
+			// The login state is shared by all sessions of the application.
+			if err := module.Login(sh, pkcs11.CKU_USER, config.PIN); err != nil && err != pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+				v.Close()
+				return nil, pkcs11Error("login", err)
+			}
+		}
+	}
+	return v, nil
+}
+
+func (v *PKCS11KeyVault) findSlot(label string) (uint, error) {
+	slots, err := v.module.GetSlotList(true)
+	if err != nil {
+		return 0, pkcs11Error("get_slot_list", err)
+	}
+	for _, slot := range slots {
+		info, err := v.module.GetTokenInfo(slot)
+		if err != nil {
+			return 0, pkcs11Error("get_token_info", err)
+		}
+		if strings.TrimRight(info.Label, " \x00") == label {
+			return slot, nil
+		}
+	}
+	return 0, errPKCS11Token.WithAttributes("label", label)
+}
+
+// Close closes the sessions and finalizes the PKCS#11 module.
+func (v *PKCS11KeyVault) Close() error {
+	if v.sessions != nil {
+		close(v.sessions)
+		for sh := range v.sessions {
+			v.module.CloseSession(sh)
+		}
+	}
+	err := v.module.Finalize()
+	v.module.Destroy()
+	if err != nil {
+		return pkcs11Error("finalize", err)
+	}
+	return nil
+}
+
+func pkcs11Error(operation string, err error) error {
+	if code, ok := err.(pkcs11.Error); ok {
+		switch code {
+		case pkcs11.CKR_DATA_INVALID,
+			pkcs11.CKR_DATA_LEN_RANGE,
+			pkcs11.CKR_ENCRYPTED_DATA_INVALID,
+			pkcs11.CKR_ENCRYPTED_DATA_LEN_RANGE,
+			pkcs11.CKR_WRAPPED_KEY_INVALID,
+			pkcs11.CKR_WRAPPED_KEY_LEN_RANGE:
+			return errPKCS11InvalidData.WithCause(err).WithAttributes("operation", operation)
+		}
+	}
+	return errPKCS11Operation.WithCause(err).WithAttributes("operation", operation)
+}
+
+// isPKCS11HandleInvalid returns whether err is caused by an object handle that is no longer valid.
+func isPKCS11HandleInvalid(err error) bool {
+	var code pkcs11.Error
+	if !errors.As(err, &code) {
+		return false
+	}
+	return code == pkcs11.CKR_OBJECT_HANDLE_INVALID || code == pkcs11.CKR_KEY_HANDLE_INVALID
+}
+
+// withSession calls f with a session from the pool. The session is returned to the pool when f returns.
+// If f fails because a cached object handle is no longer valid, for example because the object has been replaced on
+// the token, the cached object handles are discarded and f is called once more.
+func (v *PKCS11KeyVault) withSession(ctx context.Context, f func(pkcs11.SessionHandle) error) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case sh := <-v.sessions:
+		defer func() { v.sessions <- sh }()
+		err := f(sh)
+		if isPKCS11HandleInvalid(err) {
+			v.objectsMu.Lock()
+			v.objects = make(map[pkcs11ObjectKey]pkcs11.ObjectHandle)
+			v.objectsMu.Unlock()
+			err = f(sh)
+		}
+		return err
+	}
+}
+
+// findObject returns the handle of the object with the given class and label.
+// The returned bool is false if the object is not found.
+func (v *PKCS11KeyVault) findObject(sh pkcs11.SessionHandle, class uint, label string) (pkcs11.ObjectHandle, bool, error) {
+	key := pkcs11ObjectKey{class: class, label: label}
+	v.objectsMu.RLock()
+	oh, ok := v.objects[key]
+	v.objectsMu.RUnlock()
+	if ok {
+		return oh, true, nil
+	}
+	if err := v.module.FindObjectsInit(sh, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}); err != nil {
+		return 0, false, pkcs11Error("find_objects", err)
+	}
+	ohs, _, err := v.module.FindObjects(sh, 1)
+	if finalErr := v.module.FindObjectsFinal(sh); err == nil {
+		err = finalErr
+	}
+	if err != nil {
+		return 0, false, pkcs11Error("find_objects", err)
+	}
+	if len(ohs) == 0 {
+		return 0, false, nil
+	}
+	v.objectsMu.Lock()
+	v.objects[key] = ohs[0]
+	v.objectsMu.Unlock()
+	return ohs[0], true, nil
+}
+
+func (v *PKCS11KeyVault) findSecretKey(sh pkcs11.SessionHandle, id string) (pkcs11.ObjectHandle, error) {
+	oh, ok, err := v.findObject(sh, pkcs11.CKO_SECRET_KEY, id)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, errKeyNotFound.WithAttributes("id", id)
+	}
+	return oh, nil
+}
+
+// pkcs11TemporaryKeyTemplate is the template of session keys that are created for wrapping and unwrapping.
+var pkcs11TemporaryKeyTemplate = []*pkcs11.Attribute{
+	pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+	pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_AES),
+	pkcs11.NewAttribute(pkcs11.CKA_TOKEN, false),
+	pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, false),
+	pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, true),
+}
+
+// Wrap implements KeyVault.
+// The plaintext is imported as session key and wrapped with CKM_AES_KEY_WRAP (RFC 3394).
+func (v *PKCS11KeyVault) Wrap(ctx context.Context, plaintext []byte, kekLabel string) (ciphertext []byte, err error) {
+	if l := len(plaintext); l != 16 && l != 24 && l != 32 {
+		return nil, errInvalidLength.New()
+	}
+	err = v.withSession(ctx, func(sh pkcs11.SessionHandle) error {
+		kek, ok, err := v.findObject(sh, pkcs11.CKO_SECRET_KEY, kekLabel)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errKEKNotFound.WithAttributes("label", kekLabel)
+		}
+		key, err := v.module.CreateObject(sh, append(pkcs11TemporaryKeyTemplate[:len(pkcs11TemporaryKeyTemplate):len(pkcs11TemporaryKeyTemplate)],
+			pkcs11.NewAttribute(pkcs11.CKA_VALUE, plaintext),
+		))
+		if err != nil {
+			return pkcs11Error("create_object", err)
+		}
+		defer v.module.DestroyObject(sh, key)
+		ciphertext, err = v.module.WrapKey(sh, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_KEY_WRAP, nil)}, kek, key)
+		if err != nil {
+			return pkcs11Error("wrap_key", err)
+		}
+		return nil
+	})
+	return ciphertext, err
+}
+
+// Unwrap implements KeyVault.
+// The ciphertext is unwrapped with CKM_AES_KEY_WRAP (RFC 3394) as session key, of which the value is returned.
+func (v *PKCS11KeyVault) Unwrap(ctx context.Context, ciphertext []byte, kekLabel string) (plaintext []byte, err error) {
+	err = v.withSession(ctx, func(sh pkcs11.SessionHandle) error {
+		kek, ok, err := v.findObject(sh, pkcs11.CKO_SECRET_KEY, kekLabel)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errKEKNotFound.WithAttributes("label", kekLabel)
+		}
+		key, err := v.module.UnwrapKey(sh, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_KEY_WRAP, nil)}, kek, ciphertext, pkcs11TemporaryKeyTemplate)
+		if err != nil {
+			return pkcs11Error("unwrap_key", err)
+		}
+		defer v.module.DestroyObject(sh, key)
+		attrs, err := v.module.GetAttributeValue(sh, key, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_VALUE, nil),
+		})
+		if err != nil {
+			return pkcs11Error("get_attribute_value", err)
+		}
+		plaintext = attrs[0].Value
+		return nil
+	})
+	return plaintext, err
+}
+
+const pkcs11GCMNonceSize, pkcs11GCMTagBits = 12, 128
+
+// Encrypt implements KeyVault.
+// The returned ciphertext is in the same format as crypto.Encrypt.
+func (v *PKCS11KeyVault) Encrypt(ctx context.Context, plaintext []byte, id string) (ciphertext []byte, err error) {
+	nonce := make([]byte, pkcs11GCMNonceSize)
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	err = v.withSession(ctx, func(sh pkcs11.SessionHandle) error {
+		key, err := v.findSecretKey(sh, id)
+		if err != nil {
+			return err
+		}
+		params := pkcs11.NewGCMParams(nonce, nil, pkcs11GCMTagBits)
+		defer params.Free()
+		if err := v.module.EncryptInit(sh, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_GCM, params)}, key); err != nil {
+			return pkcs11Error("encrypt_init", err)
+		}
+		sealed, err := v.module.Encrypt(sh, plaintext)
+		if err != nil {
+			return pkcs11Error("encrypt", err)
+		}
+		ciphertext = append(nonce, sealed...)
+		return nil
+	})
+	return ciphertext, err
+}
+
+// Decrypt implements KeyVault.
+func (v *PKCS11KeyVault) Decrypt(ctx context.Context, ciphertext []byte, id string) (plaintext []byte, err error) {
+	if len(ciphertext) < pkcs11GCMNonceSize+pkcs11GCMTagBits/8 {
+		return nil, errInvalidLength.New()
+	}
+	err = v.withSession(ctx, func(sh pkcs11.SessionHandle) error {
+		key, err := v.findSecretKey(sh, id)
+		if err != nil {
+			return err
+		}
+		params := pkcs11.NewGCMParams(ciphertext[:pkcs11GCMNonceSize], nil, pkcs11GCMTagBits)
+		defer params.Free()
+		if err := v.module.DecryptInit(sh, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_GCM, params)}, key); err != nil {
+			return pkcs11Error("decrypt_init", err)
+		}
+		plaintext, err = v.module.Decrypt(sh, ciphertext[pkcs11GCMNonceSize:])
+		if err != nil {
+			return pkcs11Error("decrypt", err)
+		}
+		return nil
+	})
+	return plaintext, err
+}
+
+// EncryptBlocks implements OpaqueKeyVault.
+func (v *PKCS11KeyVault) EncryptBlocks(ctx context.Context, plaintext []byte, label string) (ciphertext []byte, err error) {
+	if len(plaintext)%aes.BlockSize != 0 {
+		return nil, errInvalidLength.New()
+	}
+	err = v.withSession(ctx, func(sh pkcs11.SessionHandle) error {
+		key, err := v.findSecretKey(sh, label)
+		if err != nil {
+			return err
+		}
+		if err := v.module.EncryptInit(sh, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_ECB, nil)}, key); err != nil {
+			return pkcs11Error("encrypt_init", err)
+		}
+		if ciphertext, err = v.module.Encrypt(sh, plaintext); err != nil {
+			return pkcs11Error("encrypt", err)
+		}
+		return nil
+	})
+	return ciphertext, err
+}
+
+// DecryptBlocks implements OpaqueKeyVault.
+func (v *PKCS11KeyVault) DecryptBlocks(ctx context.Context, ciphertext []byte, label string) (plaintext []byte, err error) {
+	if len(ciphertext)%aes.BlockSize != 0 {
+		return nil, errInvalidLength.New()
+	}
+	err = v.withSession(ctx, func(sh pkcs11.SessionHandle) error {
+		key, err := v.findSecretKey(sh, label)
+		if err != nil {
+			return err
+		}
+		if err := v.module.DecryptInit(sh, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_ECB, nil)}, key); err != nil {
+			return pkcs11Error("decrypt_init", err)
+		}
+		if plaintext, err = v.module.Decrypt(sh, ciphertext); err != nil {
+			return pkcs11Error("decrypt", err)
+		}
+		return nil
+	})
+	return plaintext, err
+}
+
+// CMAC implements OpaqueKeyVault.
+func (v *PKCS11KeyVault) CMAC(ctx context.Context, data []byte, label string) (mac []byte, err error) {
+	err = v.withSession(ctx, func(sh pkcs11.SessionHandle) error {
+		key, err := v.findSecretKey(sh, label)
+		if err != nil {
+			return err
+		}
+		if err := v.module.SignInit(sh, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_CMAC, nil)}, key); err != nil {
+			return pkcs11Error("sign_init", err)
+		}
+		if mac, err = v.module.Sign(sh, data); err != nil {
+			return pkcs11Error("sign", err)
+		}
+		return nil
+	})
+	return mac, err
+}
+
+// GetCertificate implements KeyVault.
+func (v *PKCS11KeyVault) GetCertificate(ctx context.Context, id string) (cert *x509.Certificate, err error) {
+	err = v.withSession(ctx, func(sh pkcs11.SessionHandle) error {
+		oh, ok, err := v.findObject(sh, pkcs11.CKO_CERTIFICATE, id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errCertificateNotFound.WithAttributes("id", id)
+		}
+		attrs, err := v.module.GetAttributeValue(sh, oh, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_VALUE, nil),
+		})
+		if err != nil {
+			return pkcs11Error("get_attribute_value", err)
+		}
+		cert, err = x509.ParseCertificate(attrs[0].Value)
+		return err
+	})
+	return cert, err
+}
+
+// ExportCertificate implements KeyVault.
+// The private key of the returned certificate is a crypto.Signer that signs using the token.
+func (v *PKCS11KeyVault) ExportCertificate(ctx context.Context, id string) (*tls.Certificate, error) {
+	cert, err := v.GetCertificate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	switch cert.PublicKey.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey:
+	default:
+		return nil, errPKCS11KeyType.WithAttributes("type", fmt.Sprintf("%T", cert.PublicKey))
+	}
+	var key pkcs11.ObjectHandle
+	if err := v.withSession(ctx, func(sh pkcs11.SessionHandle) error {
+		oh, ok, err := v.findObject(sh, pkcs11.CKO_PRIVATE_KEY, id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errCertificateNotFound.WithAttributes("id", id)
+		}
+		key = oh
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &tls.Certificate{
+		Certificate: [][]byte{cert.Raw},
+		PrivateKey: &pkcs11Signer{
+			vault:  v,
+			key:    key,
+			public: cert.PublicKey,
+		},
+		Leaf: cert,
+	}, nil
+}
+
+type pkcs11Signer struct {
+	vault  *PKCS11KeyVault
+	key    pkcs11.ObjectHandle
+	public crypto.PublicKey
+}
+
+// Public implements crypto.Signer.
+func (s *pkcs11Signer) Public() crypto.PublicKey {
+	return s.public
+}
+
+// pkcs1DigestInfoPrefixes are the ASN.1 DER DigestInfo prefixes of PKCS #1 v1.5 signatures.
+var pkcs1DigestInfoPrefixes = map[crypto.Hash][]byte{
+	crypto.SHA1:   {0x30, 0x21, 0x30, 0x09, 0x06, 0x05, 0x2b, 0x0e, 0x03, 0x02, 0x1a, 0x05, 0x00, 0x04, 0x14},
+	crypto.SHA256: {0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
+	crypto.SHA384: {0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
+	crypto.SHA512: {0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
+}
+
+// pkcs11PSSHashes are the hash mechanisms and mask generation functions of RSA PSS signatures.
+var pkcs11PSSHashes = map[crypto.Hash][2]uint{
+	crypto.SHA256: {pkcs11.CKM_SHA256, pkcs11.CKG_MGF1_SHA256},
+	crypto.SHA384: {pkcs11.CKM_SHA384, pkcs11.CKG_MGF1_SHA384},
+	crypto.SHA512: {pkcs11.CKM_SHA512, pkcs11.CKG_MGF1_SHA512},
+}
+
+var errPKCS11Hash = errors.DefineInvalidArgument("pkcs11_hash", "hash function `{hash}` not supported")
+
+// Sign implements crypto.Signer.
+func (s *pkcs11Signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) (signature []byte, err error) {
+	var (
+		mechanism *pkcs11.Mechanism
+		input     = digest
+	)
+	switch s.public.(type) {
+	case *ecdsa.PublicKey:
+		mechanism = pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)
+	case *rsa.PublicKey:
+		if pssOpts, ok := opts.(*rsa.PSSOptions); ok {
+			hash, ok := pkcs11PSSHashes[opts.HashFunc()]
+			if !ok {
+				return nil, errPKCS11Hash.WithAttributes("hash", opts.HashFunc().String())
+			}
+			saltLength := pssOpts.SaltLength
+			if saltLength == rsa.PSSSaltLengthAuto || saltLength == rsa.PSSSaltLengthEqualsHash {
+				saltLength = opts.HashFunc().Size()
+			}
+			mechanism = pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS_PSS, pkcs11.NewPSSParams(hash[0], hash[1], uint(saltLength)))
+		} else {
+			prefix, ok := pkcs1DigestInfoPrefixes[opts.HashFunc()]
+			if !ok {
+				return nil, errPKCS11Hash.WithAttributes("hash", opts.HashFunc().String())
+			}
+			input = append(prefix[:len(prefix):len(prefix)], digest...)
+			mechanism = pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS, nil)
+		}
+	default:
+		return nil, errPKCS11KeyType.WithAttributes("type", fmt.Sprintf("%T", s.public))
+	}
+	if err := s.vault.withSession(context.Background(), func(sh pkcs11.SessionHandle) error {
+		if err := s.vault.module.SignInit(sh, []*pkcs11.Mechanism{mechanism}, s.key); err != nil {
+			return pkcs11Error("sign_init", err)
+		}
+		if signature, err = s.vault.module.Sign(sh, input); err != nil {
+			return pkcs11Error("sign", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if _, ok := s.public.(*ecdsa.PublicKey); ok {
+		// PKCS#11 returns ECDSA signatures as r || s, while Go expects the ASN.1 DER encoding.
+		n := len(signature) / 2
+		return asn1.Marshal(struct {
+			R, S *big.Int
+		}{
+			R: new(big.Int).SetBytes(signature[:n]),
+			S: new(big.Int).SetBytes(signature[n:]),
+		})
+	}
+	return signature, nil
+}
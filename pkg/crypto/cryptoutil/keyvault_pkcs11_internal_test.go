@@ -0,0 +1,253 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+// +build cgo
+
+package cryptoutil
+
+import (
+	"context"
+	stdcrypto "crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/miekg/pkcs11"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+// newTestPKCS11KeyVault returns a PKCS11KeyVault for the token configured in the environment.
+// With SoftHSM, a token can be initialized with:
+//
+//	softhsm2-util --init-token --free --label test --pin 1234 --so-pin 1234
+//	export PKCS11_MODULE=/usr/lib/softhsm/libsofthsm2.so PKCS11_TOKEN_LABEL=test PKCS11_PIN=1234
+func newTestPKCS11KeyVault(t *testing.T) *PKCS11KeyVault {
+	module := os.Getenv("PKCS11_MODULE")
+	if module == "" {
+		t.Skip("PKCS11_MODULE not set")
+	}
+	v, err := NewPKCS11KeyVault(PKCS11Config{
+		Module:     module,
+		TokenLabel: os.Getenv("PKCS11_TOKEN_LABEL"),
+		PIN:        os.Getenv("PKCS11_PIN"),
+		Sessions:   2,
+	})
+	if err != nil {
+		t.Fatalf("Failed to open PKCS#11 key vault: %v", err)
+	}
+	return v
+}
+
+// createTestObjects creates session objects, which are destroyed when the key vault is closed.
+func createTestObjects(t *testing.T, v *PKCS11KeyVault, secretKeys map[string][]byte, certID string) {
+	if err := v.withSession(context.Background(), func(sh pkcs11.SessionHandle) error {
+		for label, value := range secretKeys {
+			if _, err := v.module.CreateObject(sh, []*pkcs11.Attribute{
+				pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+				pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_AES),
+				pkcs11.NewAttribute(pkcs11.CKA_TOKEN, false),
+				pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+				pkcs11.NewAttribute(pkcs11.CKA_VALUE, value),
+				pkcs11.NewAttribute(pkcs11.CKA_ENCRYPT, true),
+				pkcs11.NewAttribute(pkcs11.CKA_DECRYPT, true),
+				pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+				pkcs11.NewAttribute(pkcs11.CKA_WRAP, true),
+				pkcs11.NewAttribute(pkcs11.CKA_UNWRAP, true),
+			}); err != nil {
+				return err
+			}
+		}
+		ecParams, _ := asn1.Marshal(asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7})
+		pub, _, err := v.module.GenerateKeyPair(sh, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_EC_KEY_PAIR_GEN, nil)},
+			[]*pkcs11.Attribute{
+				pkcs11.NewAttribute(pkcs11.CKA_TOKEN, false),
+				pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, ecParams),
+				pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+			},
+			[]*pkcs11.Attribute{
+				pkcs11.NewAttribute(pkcs11.CKA_TOKEN, false),
+				pkcs11.NewAttribute(pkcs11.CKA_LABEL, certID),
+				pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+			},
+		)
+		if err != nil {
+			return err
+		}
+		attrs, err := v.module.GetAttributeValue(sh, pub, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+		})
+		if err != nil {
+			return err
+		}
+		var point []byte
+		if _, err := asn1.Unmarshal(attrs[0].Value, &point); err != nil {
+			return err
+		}
+		x, y := elliptic.Unmarshal(elliptic.P256(), point)
+		publicKey := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+		key, ok, err := v.findObject(sh, pkcs11.CKO_PRIVATE_KEY, certID)
+		if err != nil || !ok {
+			return errors.New("private key not found")
+		}
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: certID},
+			NotBefore:    time.Now().Add(-time.Minute),
+			NotAfter:     time.Now().Add(time.Hour),
+		}
+		// Release the session, as the signer takes a session from the pool.
+		v.sessions <- sh
+		der, err := x509.CreateCertificate(rand.Reader, template, template, publicKey, &pkcs11Signer{
+			vault:  v,
+			key:    key,
+			public: publicKey,
+		})
+		sh = <-v.sessions
+		if err != nil {
+			return err
+		}
+		_, err = v.module.CreateObject(sh, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_CERTIFICATE),
+			pkcs11.NewAttribute(pkcs11.CKA_CERTIFICATE_TYPE, pkcs11.CKC_X_509),
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, false),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, certID),
+			pkcs11.NewAttribute(pkcs11.CKA_VALUE, der),
+		})
+		return err
+	}); err != nil {
+		t.Fatalf("Failed to create test objects: %v", err)
+	}
+}
+
+func TestPKCS11KeyVault(t *testing.T) {
+	v := newTestPKCS11KeyVault(t)
+	defer v.Close()
+	a := assertions.New(t)
+	ctx := context.Background()
+
+	plaintext, _ := hex.DecodeString("00112233445566778899AABBCCDDEEFF")
+	kek, _ := hex.DecodeString("000102030405060708090A0B0C0D0E0F")
+	ciphertext, _ := hex.DecodeString("1FA68B0A8112B447AEF34BD8FB5A7B829D3E862371D2CFE5")
+	createTestObjects(t, v, map[string][]byte{
+		"test-kek1": kek,
+		"test-key1": kek,
+	}, "test-cert1")
+
+	var _ crypto.OpaqueKeyVault = v
+
+	// Wrapping is compatible with RFC 3394 as implemented by MemKeyVault.
+	actual, err := v.Wrap(ctx, plaintext, "test-kek1")
+	a.So(err, assertions.ShouldBeNil)
+	a.So(actual, assertions.ShouldResemble, ciphertext)
+	actual, err = v.Unwrap(ctx, ciphertext, "test-kek1")
+	a.So(err, assertions.ShouldBeNil)
+	a.So(actual, assertions.ShouldResemble, plaintext)
+	_, err = v.Wrap(ctx, plaintext, "test-kek2")
+	a.So(errors.IsNotFound(err), assertions.ShouldBeTrue)
+	_, err = v.Unwrap(ctx, ciphertext, "test-kek2")
+	a.So(errors.IsNotFound(err), assertions.ShouldBeTrue)
+
+	// Encryption is compatible with MemKeyVault.
+	mem := NewMemKeyVault(map[string][]byte{"test-key1": kek})
+	secret := []byte("thisisabigsecret")
+	encrypted, err := v.Encrypt(ctx, secret, "test-key1")
+	a.So(err, assertions.ShouldBeNil)
+	actual, err = mem.Decrypt(ctx, encrypted, "test-key1")
+	a.So(err, assertions.ShouldBeNil)
+	a.So(actual, assertions.ShouldResemble, secret)
+	encrypted, err = mem.Encrypt(ctx, secret, "test-key1")
+	a.So(err, assertions.ShouldBeNil)
+	actual, err = v.Decrypt(ctx, encrypted, "test-key1")
+	a.So(err, assertions.ShouldBeNil)
+	a.So(actual, assertions.ShouldResemble, secret)
+	_, err = v.Encrypt(ctx, secret, "test-key2")
+	a.So(errors.IsNotFound(err), assertions.ShouldBeTrue)
+
+	// AES operations are compatible with MemKeyVault.
+	for _, f := range []func(crypto.OpaqueKeyVault) ([]byte, error){
+		func(kv crypto.OpaqueKeyVault) ([]byte, error) {
+			return kv.EncryptBlocks(ctx, ciphertext[:16], "test-key1")
+		},
+		func(kv crypto.OpaqueKeyVault) ([]byte, error) {
+			return kv.DecryptBlocks(ctx, ciphertext[:16], "test-key1")
+		},
+		func(kv crypto.OpaqueKeyVault) ([]byte, error) { return kv.CMAC(ctx, ciphertext, "test-key1") },
+	} {
+		expected, err := f(mem)
+		a.So(err, assertions.ShouldBeNil)
+		actual, err := f(v)
+		a.So(err, assertions.ShouldBeNil)
+		a.So(actual, assertions.ShouldResemble, expected)
+	}
+
+	cert, err := v.GetCertificate(ctx, "test-cert1")
+	if a.So(err, assertions.ShouldBeNil) {
+		a.So(cert.Subject.CommonName, assertions.ShouldEqual, "test-cert1")
+	}
+	tlsCert, err := v.ExportCertificate(ctx, "test-cert1")
+	if a.So(err, assertions.ShouldBeNil) {
+		a.So(tlsCert.Leaf.Subject.CommonName, assertions.ShouldEqual, "test-cert1")
+		digest := sha256.Sum256([]byte("test"))
+		signature, err := tlsCert.PrivateKey.(stdcrypto.Signer).Sign(rand.Reader, digest[:], stdcrypto.SHA256)
+		a.So(err, assertions.ShouldBeNil)
+		a.So(ecdsa.VerifyASN1(tlsCert.Leaf.PublicKey.(*ecdsa.PublicKey), digest[:], signature), assertions.ShouldBeTrue)
+	}
+	_, err = v.GetCertificate(ctx, "test-cert2")
+	a.So(errors.IsNotFound(err), assertions.ShouldBeTrue)
+}
+
+func TestPKCS11KeyVaultReplacedObject(t *testing.T) {
+	v := newTestPKCS11KeyVault(t)
+	defer v.Close()
+	a := assertions.New(t)
+	ctx := context.Background()
+
+	key1, _ := hex.DecodeString("000102030405060708090A0B0C0D0E0F")
+	key2, _ := hex.DecodeString("F0E0D0C0B0A090807060504030201000")
+	block := make([]byte, 16)
+	createTestObjects(t, v, map[string][]byte{"test-key3": key1}, "test-cert3")
+
+	_, err := v.EncryptBlocks(ctx, block, "test-key3")
+	a.So(err, assertions.ShouldBeNil)
+
+	// Replace the key on the token, while its handle is cached.
+	if err := v.withSession(ctx, func(sh pkcs11.SessionHandle) error {
+		oh, _, err := v.findObject(sh, pkcs11.CKO_SECRET_KEY, "test-key3")
+		if err != nil {
+			return err
+		}
+		return v.module.DestroyObject(sh, oh)
+	}); err != nil {
+		t.Fatalf("Failed to destroy test object: %v", err)
+	}
+	createTestObjects(t, v, map[string][]byte{"test-key3": key2}, "test-cert4")
+
+	expected, err := NewMemKeyVault(map[string][]byte{"test-key3": key2}).EncryptBlocks(ctx, block, "test-key3")
+	a.So(err, assertions.ShouldBeNil)
+	actual, err := v.EncryptBlocks(ctx, block, "test-key3")
+	a.So(err, assertions.ShouldBeNil)
+	a.So(actual, assertions.ShouldResemble, expected)
+}
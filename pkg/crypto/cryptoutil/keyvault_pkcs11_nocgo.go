@@ -0,0 +1,85 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !cgo
+// +build !cgo
+
+package cryptoutil
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+)
+
+// PKCS11KeyVault is a KeyVault that uses a PKCS#11 token.
+// PKCS#11 requires cgo; in builds without cgo, the key vault is not available.
+type PKCS11KeyVault struct {
+	ComponentPrefixKEKLabeler
+}
+
+// NewPKCS11KeyVault returns an error as PKCS#11 is not available in builds without cgo.
+func NewPKCS11KeyVault(config PKCS11Config) (*PKCS11KeyVault, error) {
+	return nil, errPKCS11Unavailable.New()
+}
+
+// Close implements io.Closer.
+func (v *PKCS11KeyVault) Close() error {
+	return errPKCS11Unavailable.New()
+}
+
+// Wrap implements KeyVault.
+func (v *PKCS11KeyVault) Wrap(ctx context.Context, plaintext []byte, kekLabel string) ([]byte, error) {
+	return nil, errPKCS11Unavailable.New()
+}
+
+// Unwrap implements KeyVault.
+func (v *PKCS11KeyVault) Unwrap(ctx context.Context, ciphertext []byte, kekLabel string) ([]byte, error) {
+	return nil, errPKCS11Unavailable.New()
+}
+
+// Encrypt implements KeyVault.
+func (v *PKCS11KeyVault) Encrypt(ctx context.Context, plaintext []byte, id string) ([]byte, error) {
+	return nil, errPKCS11Unavailable.New()
+}
+
+// Decrypt implements KeyVault.
+func (v *PKCS11KeyVault) Decrypt(ctx context.Context, ciphertext []byte, id string) ([]byte, error) {
+	return nil, errPKCS11Unavailable.New()
+}
+
+// EncryptBlocks implements OpaqueKeyVault.
+func (v *PKCS11KeyVault) EncryptBlocks(ctx context.Context, plaintext []byte, label string) ([]byte, error) {
+	return nil, errPKCS11Unavailable.New()
+}
+
+// DecryptBlocks implements OpaqueKeyVault.
+func (v *PKCS11KeyVault) DecryptBlocks(ctx context.Context, ciphertext []byte, label string) ([]byte, error) {
+	return nil, errPKCS11Unavailable.New()
+}
+
+// CMAC implements OpaqueKeyVault.
+func (v *PKCS11KeyVault) CMAC(ctx context.Context, data []byte, label string) ([]byte, error) {
+	return nil, errPKCS11Unavailable.New()
+}
+
+// GetCertificate implements KeyVault.
+func (v *PKCS11KeyVault) GetCertificate(ctx context.Context, id string) (*x509.Certificate, error) {
+	return nil, errPKCS11Unavailable.New()
+}
+
+// ExportCertificate implements KeyVault.
+func (v *PKCS11KeyVault) ExportCertificate(ctx context.Context, id string) (*tls.Certificate, error) {
+	return nil, errPKCS11Unavailable.New()
+}
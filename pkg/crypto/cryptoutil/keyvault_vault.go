@@ -0,0 +1,408 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"golang.org/x/sync/singleflight"
+)
+
+// defaultVaultRequestTimeout is the timeout of requests to Vault if none is configured.
+const defaultVaultRequestTimeout = 10 * time.Second
+
+// VaultAppRoleConfig is the configuration of the Vault AppRole authentication method.
+type VaultAppRoleConfig struct {
+	Mount    string `name:"mount" description:"Mount path of the AppRole authentication method"`
+	RoleID   string `name:"role-id" description:"AppRole role ID"`
+	SecretID string `name:"secret-id" description:"AppRole secret ID"`
+}
+
+// VaultConfig is the configuration of a VaultKeyVault.
+type VaultConfig struct {
+	Address        string             `name:"address" description:"Address of the Vault server"`
+	Namespace      string             `name:"namespace" description:"Vault namespace"`
+	Token          string             `name:"token" description:"Vault token. Used if no AppRole role ID is configured"`
+	AppRole        VaultAppRoleConfig `name:"approle"`
+	RequestTimeout time.Duration      `name:"request-timeout" description:"Timeout of requests to Vault"`
+	TransitMount   string             `name:"transit-mount" description:"Mount path of the Transit secrets engine"`
+	PKIMount       string             `name:"pki-mount" description:"Mount path of the PKI secrets engine"`
+	PKIRole        string             `name:"pki-role" description:"Role of the PKI secrets engine to issue certificates with"`
+	PKITTL         time.Duration      `name:"pki-ttl" description:"Validity of issued certificates. The role default is used if 0"`
+}
+
+var (
+	errVaultAddress          = errors.DefineInvalidArgument("vault_address", "no Vault address configured")
+	errVaultAuthentication   = errors.DefineInvalidArgument("vault_authentication", "no Vault token or AppRole configured")
+	errVaultPKIRole          = errors.DefineFailedPrecondition("vault_pki_role", "no Vault PKI role configured")
+	errVaultRequest          = errors.DefineUnavailable("vault_request", "Vault request failed with status `{status}`", "errors")
+	errVaultNotFound         = errors.DefineNotFound("vault_not_found", "Vault resource not found", "errors")
+	errVaultPermissionDenied = errors.DefinePermissionDenied("vault_permission_denied", "Vault permission denied", "errors")
+	errVaultInvalidRequest   = errors.DefineInvalidArgument("vault_invalid_request", "invalid Vault request", "errors")
+	errVaultResponse         = errors.DefineDataLoss("vault_response", "invalid Vault response")
+)
+
+// VaultKeyVault is a KeyVault that uses HashiCorp Vault.
+// KEKs are keys of the Transit secrets engine, named by label. Characters of the label that are not allowed in Transit
+// key names are replaced with an underscore. Keys are wrapped and unwrapped by Vault, so KEKs never leave Vault.
+// Encryption keys are keys of the Transit secrets engine, named by ID.
+// Certificates are issued by the PKI secrets engine, using the ID as common name.
+type VaultKeyVault struct {
+	ComponentPrefixKEKLabeler
+
+	config     VaultConfig
+	httpClient *http.Client
+
+	tokenMu        sync.Mutex
+	token          string
+	tokenRenewAt   time.Time
+	tokenRenewable bool
+
+	certsMu    sync.Mutex
+	certs      map[string]*vaultCertificate
+	certsGroup singleflight.Group
+}
+
+type vaultCertificate struct {
+	cert    *tls.Certificate
+	renewAt time.Time
+}
+
+// NewVaultKeyVault returns a VaultKeyVault. If httpClient is nil, a client with the request timeout is used.
+func NewVaultKeyVault(config VaultConfig, httpClient *http.Client) (*VaultKeyVault, error) {
+	if config.Address == "" {
+		return nil, errVaultAddress.New()
+	}
+	if config.Token == "" && config.AppRole.RoleID == "" {
+		return nil, errVaultAuthentication.New()
+	}
+	if config.RequestTimeout == 0 {
+		config.RequestTimeout = defaultVaultRequestTimeout
+	}
+	if config.TransitMount == "" {
+		config.TransitMount = "transit"
+	}
+	if config.PKIMount == "" {
+		config.PKIMount = "pki"
+	}
+	if config.AppRole.Mount == "" {
+		config.AppRole.Mount = "approle"
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: config.RequestTimeout}
+	}
+	return &VaultKeyVault{
+		config:     config,
+		httpClient: httpClient,
+		token:      config.Token,
+		// Static tokens are renewed on first use. Tokens that are not renewable are used as is.
+		tokenRenewable: config.Token != "",
+		certs:          make(map[string]*vaultCertificate),
+	}, nil
+}
+
+type vaultResponse struct {
+	Data json.RawMessage `json:"data"`
+	Auth *struct {
+		ClientToken   string `json:"client_token"`
+		LeaseDuration int64  `json:"lease_duration"`
+		Renewable     bool   `json:"renewable"`
+	} `json:"auth"`
+	Errors []string `json:"errors"`
+}
+
+// request performs a request to Vault. If body is nil, a GET request is performed.
+func (v *VaultKeyVault) request(ctx context.Context, token, path string, body interface{}) (*vaultResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, v.config.RequestTimeout)
+	defer cancel()
+	method, reqBody := http.MethodGet, []byte(nil)
+	if body != nil {
+		buf, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		method, reqBody = http.MethodPost, buf
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(v.config.Address, "/")+"/v1/"+path, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if v.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.config.Namespace)
+	}
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errVaultRequest.WithCause(err).WithAttributes("status", 0, "errors", "")
+	}
+	defer res.Body.Close()
+	vaultRes := &vaultResponse{}
+	if err := json.NewDecoder(res.Body).Decode(vaultRes); err != nil && res.StatusCode < 300 {
+		return nil, errVaultResponse.WithCause(err)
+	}
+	if res.StatusCode < 300 {
+		return vaultRes, nil
+	}
+	vaultErrors := strings.Join(vaultRes.Errors, "; ")
+	switch {
+	case res.StatusCode == http.StatusNotFound,
+		res.StatusCode == http.StatusBadRequest && strings.Contains(vaultErrors, "not found"):
+		return nil, errVaultNotFound.WithAttributes("errors", vaultErrors)
+	case res.StatusCode == http.StatusForbidden:
+		return nil, errVaultPermissionDenied.WithAttributes("errors", vaultErrors)
+	case res.StatusCode == http.StatusBadRequest:
+		return nil, errVaultInvalidRequest.WithAttributes("errors", vaultErrors)
+	default:
+		return nil, errVaultRequest.WithAttributes("status", res.StatusCode, "errors", vaultErrors)
+	}
+}
+
+// setToken sets the token and schedules its renewal after three quarters of its lease duration.
+// The caller must hold tokenMu.
+func (v *VaultKeyVault) setToken(res *vaultResponse) error {
+	if res.Auth == nil || res.Auth.ClientToken == "" {
+		return errVaultResponse.New()
+	}
+	v.token = res.Auth.ClientToken
+	v.tokenRenewable = res.Auth.Renewable && res.Auth.LeaseDuration > 0
+	v.tokenRenewAt = time.Now().Add(time.Duration(res.Auth.LeaseDuration) * time.Second * 3 / 4)
+	return nil
+}
+
+// login returns the token to authenticate with. Renewable tokens are renewed after three quarters of their lease
+// duration. If an AppRole is configured, the token is obtained using the AppRole when there is no token, when the
+// token cannot be renewed or when relogin is true.
+func (v *VaultKeyVault) login(ctx context.Context, relogin bool) (string, error) {
+	v.tokenMu.Lock()
+	defer v.tokenMu.Unlock()
+	appRole := v.config.AppRole.RoleID != ""
+	if v.token != "" && !(relogin && appRole) {
+		if !v.tokenRenewable || time.Now().Before(v.tokenRenewAt) {
+			return v.token, nil
+		}
+		res, err := v.request(ctx, v.token, "auth/token/renew-self", struct{}{})
+		if err == nil {
+			err = v.setToken(res)
+		}
+		switch {
+		case err == nil:
+			return v.token, nil
+		case !appRole && errors.IsInvalidArgument(err):
+			// The token cannot be renewed, for example because it has no lease. Use it as is.
+			v.tokenRenewable = false
+			return v.token, nil
+		case !appRole:
+			return "", err
+		}
+	}
+	if !appRole {
+		return v.token, nil
+	}
+	res, err := v.request(ctx, "", fmt.Sprintf("auth/%s/login", v.config.AppRole.Mount), map[string]string{
+		"role_id":   v.config.AppRole.RoleID,
+		"secret_id": v.config.AppRole.SecretID,
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := v.setToken(res); err != nil {
+		return "", err
+	}
+	return v.token, nil
+}
+
+// do performs an authenticated request and decodes the response data in data.
+// If the request is denied and an AppRole is configured, the request is retried once with a new token.
+func (v *VaultKeyVault) do(ctx context.Context, path string, body, data interface{}) error {
+	token, err := v.login(ctx, false)
+	if err != nil {
+		return err
+	}
+	res, err := v.request(ctx, token, path, body)
+	if errors.IsPermissionDenied(err) && v.config.AppRole.RoleID != "" {
+		if token, err = v.login(ctx, true); err != nil {
+			return err
+		}
+		res, err = v.request(ctx, token, path, body)
+	}
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(res.Data, data); err != nil {
+		return errVaultResponse.WithCause(err)
+	}
+	return nil
+}
+
+func (v *VaultKeyVault) transitEncrypt(ctx context.Context, plaintext []byte, name string) ([]byte, error) {
+	var data struct {
+		Ciphertext string `json:"ciphertext"`
+	}
+	if err := v.do(ctx, fmt.Sprintf("%s/encrypt/%s", v.config.TransitMount, url.PathEscape(name)), map[string]string{
+		"plaintext": base64.StdEncoding.EncodeToString(plaintext),
+	}, &data); err != nil {
+		return nil, err
+	}
+	return []byte(data.Ciphertext), nil
+}
+
+func (v *VaultKeyVault) transitDecrypt(ctx context.Context, ciphertext []byte, name string) ([]byte, error) {
+	var data struct {
+		Plaintext string `json:"plaintext"`
+	}
+	if err := v.do(ctx, fmt.Sprintf("%s/decrypt/%s", v.config.TransitMount, url.PathEscape(name)), map[string]string{
+		"ciphertext": string(ciphertext),
+	}, &data); err != nil {
+		return nil, err
+	}
+	plaintext, err := base64.StdEncoding.DecodeString(data.Plaintext)
+	if err != nil {
+		return nil, errVaultResponse.WithCause(err)
+	}
+	return plaintext, nil
+}
+
+// transitKEKName returns the name of the Transit key of the KEK with the given label.
+func transitKEKName(kekLabel string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '.':
+			return r
+		default:
+			return '_'
+		}
+	}, kekLabel)
+}
+
+// Wrap implements KeyVault.
+func (v *VaultKeyVault) Wrap(ctx context.Context, plaintext []byte, kekLabel string) ([]byte, error) {
+	ciphertext, err := v.transitEncrypt(ctx, plaintext, transitKEKName(kekLabel))
+	if errors.IsNotFound(err) {
+		return nil, errKEKNotFound.WithCause(err).WithAttributes("label", kekLabel)
+	}
+	return ciphertext, err
+}
+
+// Unwrap implements KeyVault.
+func (v *VaultKeyVault) Unwrap(ctx context.Context, ciphertext []byte, kekLabel string) ([]byte, error) {
+	plaintext, err := v.transitDecrypt(ctx, ciphertext, transitKEKName(kekLabel))
+	if errors.IsNotFound(err) {
+		return nil, errKEKNotFound.WithCause(err).WithAttributes("label", kekLabel)
+	}
+	return plaintext, err
+}
+
+// Encrypt implements KeyVault.
+func (v *VaultKeyVault) Encrypt(ctx context.Context, plaintext []byte, id string) ([]byte, error) {
+	ciphertext, err := v.transitEncrypt(ctx, plaintext, id)
+	if errors.IsNotFound(err) {
+		return nil, errKeyNotFound.WithCause(err).WithAttributes("id", id)
+	}
+	return ciphertext, err
+}
+
+// Decrypt implements KeyVault.
+func (v *VaultKeyVault) Decrypt(ctx context.Context, ciphertext []byte, id string) ([]byte, error) {
+	plaintext, err := v.transitDecrypt(ctx, ciphertext, id)
+	if errors.IsNotFound(err) {
+		return nil, errKeyNotFound.WithCause(err).WithAttributes("id", id)
+	}
+	return plaintext, err
+}
+
+// GetCertificate implements KeyVault.
+func (v *VaultKeyVault) GetCertificate(ctx context.Context, id string) (*x509.Certificate, error) {
+	cert, err := v.ExportCertificate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return cert.Leaf, nil
+}
+
+// ExportCertificate implements KeyVault.
+// Certificates are issued by the PKI secrets engine and reused until two thirds of their validity has passed.
+// Concurrent calls for the same ID share the issued certificate.
+func (v *VaultKeyVault) ExportCertificate(ctx context.Context, id string) (*tls.Certificate, error) {
+	v.certsMu.Lock()
+	cached, ok := v.certs[id]
+	v.certsMu.Unlock()
+	if ok && time.Now().Before(cached.renewAt) {
+		return cached.cert, nil
+	}
+	res, err, _ := v.certsGroup.Do(id, func() (interface{}, error) {
+		return v.issueCertificate(ctx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*tls.Certificate), nil
+}
+
+// issueCertificate issues a certificate for the given ID and caches it.
+func (v *VaultKeyVault) issueCertificate(ctx context.Context, id string) (*tls.Certificate, error) {
+	if v.config.PKIRole == "" {
+		return nil, errVaultPKIRole.New()
+	}
+	req := map[string]string{
+		"common_name": id,
+	}
+	if v.config.PKITTL > 0 {
+		req["ttl"] = v.config.PKITTL.String()
+	}
+	var data struct {
+		Certificate string   `json:"certificate"`
+		PrivateKey  string   `json:"private_key"`
+		CAChain     []string `json:"ca_chain"`
+	}
+	if err := v.do(ctx, fmt.Sprintf("%s/issue/%s", v.config.PKIMount, url.PathEscape(v.config.PKIRole)), req, &data); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errCertificateNotFound.WithCause(err).WithAttributes("id", id)
+		}
+		return nil, err
+	}
+	certPEM := strings.Join(append([]string{data.Certificate}, data.CAChain...), "\n")
+	cert, err := tls.X509KeyPair([]byte(certPEM), []byte(data.PrivateKey))
+	if err != nil {
+		return nil, errVaultResponse.WithCause(err)
+	}
+	if cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0]); err != nil {
+		return nil, errVaultResponse.WithCause(err)
+	}
+	validity := cert.Leaf.NotAfter.Sub(cert.Leaf.NotBefore)
+	v.certsMu.Lock()
+	v.certs[id] = &vaultCertificate{
+		cert:    &cert,
+		renewAt: cert.Leaf.NotBefore.Add(validity * 2 / 3),
+	}
+	v.certsMu.Unlock()
+	return &cert, nil
+}
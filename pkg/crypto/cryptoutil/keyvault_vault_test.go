@@ -0,0 +1,405 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil_test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// mockVault implements the subset of the Vault HTTP API used by VaultKeyVault.
+type mockVault struct {
+	keys      map[string][]byte
+	roleID    string
+	secretID  string
+	tokens    map[string]bool
+	logins    int32
+	renewals  int32
+	issued    int32
+	pkiRole   string
+	token     string
+	namespace string
+}
+
+func (m *mockVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	writeError := func(status int, msg string) {
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(map[string][]string{"errors": {msg}})
+	}
+	writeData := func(data interface{}) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}
+	if m.namespace != "" && r.Header.Get("X-Vault-Namespace") != m.namespace {
+		writeError(http.StatusForbidden, "permission denied")
+		return
+	}
+	var req map[string]string
+	if r.Method == http.MethodPost {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	if r.URL.Path == "/v1/auth/approle/login" {
+		if req["role_id"] != m.roleID || req["secret_id"] != m.secretID {
+			writeError(http.StatusBadRequest, "invalid role or secret ID")
+			return
+		}
+		token := hex.EncodeToString([]byte{byte(atomic.AddInt32(&m.logins, 1))})
+		m.tokens[token] = true
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"auth": map[string]interface{}{
+				"client_token":   token,
+				"lease_duration": 3600,
+				"renewable":      true,
+			},
+		})
+		return
+	}
+	if token := r.Header.Get("X-Vault-Token"); token != m.token && !m.tokens[token] {
+		writeError(http.StatusForbidden, "permission denied")
+		return
+	}
+	if r.URL.Path == "/v1/auth/token/renew-self" {
+		atomic.AddInt32(&m.renewals, 1)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"auth": map[string]interface{}{
+				"client_token":   r.Header.Get("X-Vault-Token"),
+				"lease_duration": 3600,
+				"renewable":      true,
+			},
+		})
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/v1/"), "/")
+	if len(parts) != 3 {
+		writeError(http.StatusNotFound, "unsupported path")
+		return
+	}
+	switch parts[0] + "/" + parts[1] {
+	case "transit/encrypt", "transit/decrypt":
+		key, ok := m.keys[parts[2]]
+		if !ok {
+			writeError(http.StatusBadRequest, "encryption key not found")
+			return
+		}
+		block, _ := aes.NewCipher(key)
+		gcm, _ := cipher.NewGCM(block)
+		if parts[1] == "encrypt" {
+			plaintext, err := base64.StdEncoding.DecodeString(req["plaintext"])
+			if err != nil {
+				writeError(http.StatusBadRequest, err.Error())
+				return
+			}
+			nonce := make([]byte, gcm.NonceSize())
+			rand.Read(nonce)
+			writeData(map[string]string{
+				"ciphertext": "vault:v1:" + base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, plaintext, nil)),
+			})
+			return
+		}
+		buf, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(req["ciphertext"], "vault:v1:"))
+		if err != nil || len(buf) < gcm.NonceSize() {
+			writeError(http.StatusBadRequest, "invalid ciphertext")
+			return
+		}
+		plaintext, err := gcm.Open(nil, buf[:gcm.NonceSize()], buf[gcm.NonceSize():], nil)
+		if err != nil {
+			writeError(http.StatusBadRequest, "cipher: message authentication failed")
+			return
+		}
+		writeData(map[string]string{
+			"plaintext": base64.StdEncoding.EncodeToString(plaintext),
+		})
+	case "pki/issue":
+		if parts[2] != m.pkiRole {
+			writeError(http.StatusBadRequest, "unknown role: "+parts[2])
+			return
+		}
+		atomic.AddInt32(&m.issued, 1)
+		priv, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: req["common_name"]},
+			NotBefore:    time.Now().Add(-time.Minute),
+			NotAfter:     time.Now().Add(time.Hour),
+		}
+		der, _ := x509.CreateCertificate(rand.Reader, template, template, &priv.PublicKey, priv)
+		keyDER, _ := x509.MarshalECPrivateKey(priv)
+		writeData(map[string]interface{}{
+			"certificate": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+			"private_key": string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+		})
+	default:
+		writeError(http.StatusNotFound, "unsupported path")
+	}
+}
+
+func TestVaultKeyVault(t *testing.T) {
+	a := assertions.New(t)
+
+	_, err := cryptoutil.NewVaultKeyVault(cryptoutil.VaultConfig{}, nil)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	_, err = cryptoutil.NewVaultKeyVault(cryptoutil.VaultConfig{Address: "http://localhost:8200"}, nil)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	key, _ := hex.DecodeString("000102030405060708090A0B0C0D0E0F")
+	otherKey, _ := hex.DecodeString("0F0E0D0C0B0A09080706050403020100")
+	mock := &mockVault{
+		keys: map[string][]byte{
+			"kek1":           key,
+			"ns_000013_host": otherKey,
+			"key1":           key,
+		},
+		roleID:    "role",
+		secretID:  "secret",
+		tokens:    map[string]bool{},
+		token:     "root",
+		pkiRole:   "ttn",
+		namespace: "ns1",
+	}
+	srv := httptest.NewServer(mock)
+	defer srv.Close()
+
+	for _, tc := range []struct {
+		Name   string
+		Config cryptoutil.VaultConfig
+	}{
+		{
+			Name: "Token",
+			Config: cryptoutil.VaultConfig{
+				Address:   srv.URL,
+				Namespace: "ns1",
+				Token:     "root",
+				PKIRole:   "ttn",
+			},
+		},
+		{
+			Name: "AppRole",
+			Config: cryptoutil.VaultConfig{
+				Address:   srv.URL,
+				Namespace: "ns1",
+				AppRole: cryptoutil.VaultAppRoleConfig{
+					RoleID:   "role",
+					SecretID: "secret",
+				},
+				PKIRole: "ttn",
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := test.Context()
+
+			v, err := cryptoutil.NewVaultKeyVault(tc.Config, srv.Client())
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			plaintext, _ := hex.DecodeString("00112233445566778899AABBCCDDEEFF")
+			ciphertext, err := v.Wrap(ctx, plaintext, "kek1")
+			a.So(err, should.BeNil)
+			a.So(string(ciphertext), should.StartWith, "vault:v1:")
+			actual, err := v.Unwrap(ctx, ciphertext, "kek1")
+			a.So(err, should.BeNil)
+			a.So(actual, should.Resemble, plaintext)
+
+			// Characters that are not allowed in Transit key names are replaced.
+			ciphertext, err = v.Wrap(ctx, plaintext, "ns:000013/host")
+			a.So(err, should.BeNil)
+			actual, err = v.Unwrap(ctx, ciphertext, "ns:000013/host")
+			a.So(err, should.BeNil)
+			a.So(actual, should.Resemble, plaintext)
+
+			_, err = v.Wrap(ctx, plaintext, "kek2")
+			a.So(errors.IsNotFound(err), should.BeTrue)
+			_, err = v.Unwrap(ctx, ciphertext, "kek2")
+			a.So(errors.IsNotFound(err), should.BeTrue)
+			_, err = v.Unwrap(ctx, ciphertext, "kek1")
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+			secret := []byte("thisisabigsecret")
+			ciphertext, err = v.Encrypt(ctx, secret, "key1")
+			a.So(err, should.BeNil)
+			actual, err = v.Decrypt(ctx, ciphertext, "key1")
+			a.So(err, should.BeNil)
+			a.So(actual, should.Resemble, secret)
+			_, err = v.Encrypt(ctx, secret, "key2")
+			a.So(errors.IsNotFound(err), should.BeTrue)
+			_, err = v.Decrypt(ctx, []byte("vault:v1:invalid"), "key1")
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+			issued := atomic.LoadInt32(&mock.issued)
+			cert, err := v.ExportCertificate(ctx, "gs.example.com")
+			if a.So(err, should.BeNil) {
+				a.So(cert.Leaf.Subject.CommonName, should.Equal, "gs.example.com")
+			}
+			leaf, err := v.GetCertificate(ctx, "gs.example.com")
+			if a.So(err, should.BeNil) {
+				a.So(leaf.Subject.CommonName, should.Equal, "gs.example.com")
+			}
+			a.So(atomic.LoadInt32(&mock.issued), should.Equal, issued+1)
+		})
+	}
+
+	t.Run("TokenRevoked", func(t *testing.T) {
+		a := assertions.New(t)
+		ctx := test.Context()
+
+		v, err := cryptoutil.NewVaultKeyVault(cryptoutil.VaultConfig{
+			Address:   srv.URL,
+			Namespace: "ns1",
+			AppRole: cryptoutil.VaultAppRoleConfig{
+				RoleID:   "role",
+				SecretID: "secret",
+			},
+		}, srv.Client())
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		_, err = v.Encrypt(ctx, []byte("secret"), "key1")
+		a.So(err, should.BeNil)
+		logins := atomic.LoadInt32(&mock.logins)
+
+		mock.tokens = map[string]bool{}
+		_, err = v.Encrypt(ctx, []byte("secret"), "key1")
+		a.So(err, should.BeNil)
+		a.So(atomic.LoadInt32(&mock.logins), should.Equal, logins+1)
+
+		_, err = v.ExportCertificate(ctx, "gs.example.com")
+		a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+	})
+
+	t.Run("TokenRenewal", func(t *testing.T) {
+		a := assertions.New(t)
+		ctx := test.Context()
+
+		v, err := cryptoutil.NewVaultKeyVault(cryptoutil.VaultConfig{
+			Address:   srv.URL,
+			Namespace: "ns1",
+			Token:     "root",
+		}, srv.Client())
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		renewals := atomic.LoadInt32(&mock.renewals)
+		for i := 0; i < 2; i++ {
+			_, err = v.Encrypt(ctx, []byte("secret"), "key1")
+			a.So(err, should.BeNil)
+		}
+		// The token is renewed on first use, and then after three quarters of its lease duration.
+		a.So(atomic.LoadInt32(&mock.renewals), should.Equal, renewals+1)
+	})
+
+	t.Run("ConcurrentCertificates", func(t *testing.T) {
+		a := assertions.New(t)
+		ctx := test.Context()
+
+		v, err := cryptoutil.NewVaultKeyVault(cryptoutil.VaultConfig{
+			Address:   srv.URL,
+			Namespace: "ns1",
+			Token:     "root",
+			PKIRole:   "ttn",
+		}, srv.Client())
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		issued := atomic.LoadInt32(&mock.issued)
+		var wg sync.WaitGroup
+		for i := 0; i < 2; i++ {
+			id := fmt.Sprintf("gs%d.example.com", i)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				cert, err := v.ExportCertificate(ctx, id)
+				if a.So(err, should.BeNil) {
+					a.So(cert.Leaf.Subject.CommonName, should.Equal, id)
+				}
+			}()
+		}
+		wg.Wait()
+		a.So(atomic.LoadInt32(&mock.issued), should.Equal, issued+2)
+	})
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		a := assertions.New(t)
+
+		v, err := cryptoutil.NewVaultKeyVault(cryptoutil.VaultConfig{
+			Address:   srv.URL,
+			Namespace: "ns1",
+			Token:     "invalid",
+		}, srv.Client())
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		_, err = v.Encrypt(test.Context(), []byte("secret"), "key1")
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+}
+
+// TestVaultKeyVaultDevServer runs against a Vault dev server when VAULT_ADDR and VAULT_TOKEN are set.
+// The Transit secrets engine must be enabled with the KEK kek1:
+//
+//	vault secrets enable transit
+//	vault write -f transit/keys/kek1
+func TestVaultKeyVaultDevServer(t *testing.T) {
+	addr, token := os.Getenv("VAULT_ADDR"), os.Getenv("VAULT_TOKEN")
+	if addr == "" || token == "" {
+		t.Skip("VAULT_ADDR and VAULT_TOKEN not set")
+	}
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	v, err := cryptoutil.NewVaultKeyVault(cryptoutil.VaultConfig{
+		Address: addr,
+		Token:   token,
+	}, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	plaintext, _ := hex.DecodeString("00112233445566778899AABBCCDDEEFF")
+	ciphertext, err := v.Wrap(ctx, plaintext, "kek1")
+	a.So(err, should.BeNil)
+	actual, err := v.Unwrap(ctx, ciphertext, "kek1")
+	a.So(err, should.BeNil)
+	a.So(actual, should.Resemble, plaintext)
+	_, err = v.Unwrap(ctx, ciphertext, "non-existent")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
@@ -0,0 +1,186 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil
+
+import (
+	"context"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// rewrapProgressInterval is the number of entities after which the progress of re-wrapping keys is logged.
+const rewrapProgressInterval = 1000
+
+var errRewrapSameKEK = errors.DefineInvalidArgument("rewrap_same_kek", "cannot rewrap keys from KEK `{label}` to the same KEK")
+
+// KEKRewrap re-wraps key envelopes that are wrapped with one KEK with another KEK.
+// It is used to rotate the KEK that wraps keys at rest.
+type KEKRewrap struct {
+	KeyVault crypto.KeyVault
+	// From is the label of the KEK that is rotated. The empty label matches keys that are stored in the clear.
+	From string
+	// To is the label of the KEK that keys are wrapped with. If the label is empty, keys are stored in the clear.
+	To string
+}
+
+// Validate returns an error if the rewrap is a no-op.
+func (r KEKRewrap) Validate() error {
+	if r.From == r.To {
+		return errRewrapSameKEK.WithAttributes("label", r.From)
+	}
+	return nil
+}
+
+// Matches returns whether the key envelope is wrapped with the KEK that is rotated.
+// If the empty label is rotated, key envelopes that hold the key in the clear match as well.
+func (r KEKRewrap) Matches(ke *ttnpb.KeyEnvelope) bool {
+	if ke.GetKekLabel() != r.From {
+		return false
+	}
+	return len(ke.GetEncryptedKey()) > 0 || r.From == "" && !ke.GetKey().IsZero()
+}
+
+// Rewrap unwraps the key envelope and wraps the key with the new KEK.
+// Rewrap returns ke if it is not wrapped with the KEK that is rotated.
+func (r KEKRewrap) Rewrap(ctx context.Context, ke *ttnpb.KeyEnvelope) (*ttnpb.KeyEnvelope, error) {
+	if !r.Matches(ke) {
+		return ke, nil
+	}
+	key, err := UnwrapAES128Key(ctx, ke, r.KeyVault)
+	if err != nil {
+		return nil, err
+	}
+	return WrapAES128Key(ctx, key, r.To, r.KeyVault)
+}
+
+// MatchingKeyEnvelopes returns the number of key envelopes that are wrapped with the KEK that is rotated.
+// The key envelopes are given by their field path.
+func (r KEKRewrap) MatchingKeyEnvelopes(envelopes map[string]**ttnpb.KeyEnvelope) (n uint64) {
+	for _, ke := range envelopes {
+		if r.Matches(*ke) {
+			n++
+		}
+	}
+	return n
+}
+
+// RewrapKeyEnvelopes re-wraps the key envelopes that are wrapped with the KEK that is rotated in place.
+// The key envelopes are given by their field path. The sorted paths of the re-wrapped key envelopes are returned.
+func (r KEKRewrap) RewrapKeyEnvelopes(ctx context.Context, envelopes map[string]**ttnpb.KeyEnvelope) ([]string, error) {
+	var paths []string
+	for path, ke := range envelopes {
+		if !r.Matches(*ke) {
+			continue
+		}
+		rewrapped, err := r.Rewrap(ctx, *ke)
+		if err != nil {
+			return nil, err
+		}
+		*ke = rewrapped
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// RewrapStats contains the statistics of re-wrapping the keys of a registry.
+type RewrapStats struct {
+	// Entities is the number of entities that have keys wrapped with the KEK that is rotated.
+	Entities uint64
+	// Keys is the number of keys wrapped with the KEK that is rotated.
+	Keys uint64
+	// Rewrapped is the number of keys that have been re-wrapped.
+	Rewrapped uint64
+	// Failed is the number of entities of which the keys could not be re-wrapped.
+	Failed uint64
+}
+
+// Add adds the statistics of other to s.
+func (s *RewrapStats) Add(other RewrapStats) {
+	s.Entities += other.Entities
+	s.Keys += other.Keys
+	s.Rewrapped += other.Rewrapped
+	s.Failed += other.Failed
+}
+
+// Remaining returns the number of keys that are still wrapped with the KEK that is rotated.
+func (s RewrapStats) Remaining() uint64 {
+	return s.Keys - s.Rewrapped
+}
+
+// RewrapRegistry is a registry of entities with keys at rest.
+type RewrapRegistry interface {
+	// Range calls f with the identifiers and the key envelopes of each entity in the registry.
+	// The key envelopes are given by their field path.
+	Range(ctx context.Context, f func(ctx context.Context, ids ttnpb.IDStringer, envelopes map[string]**ttnpb.KeyEnvelope) bool) error
+	// Update calls f with the key envelopes of the entity and stores the fields at the paths returned by f.
+	// Update does not call f if the entity no longer exists.
+	Update(ctx context.Context, ids ttnpb.IDStringer, f func(ctx context.Context, envelopes map[string]**ttnpb.KeyEnvelope) ([]string, error)) error
+}
+
+// RewrapRegistry re-wraps the keys of the entities in the registry that are wrapped with the KEK that is rotated.
+// If dryRun is true, the keys are only counted. Entities of which the keys cannot be re-wrapped are logged and
+// counted as failed.
+func (r KEKRewrap) RewrapRegistry(ctx context.Context, registry RewrapRegistry, dryRun bool) (RewrapStats, error) {
+	var stats RewrapStats
+	if err := r.Validate(); err != nil {
+		return stats, err
+	}
+	var entityIDs []ttnpb.IDStringer
+	if err := registry.Range(ctx, func(ctx context.Context, ids ttnpb.IDStringer, envelopes map[string]**ttnpb.KeyEnvelope) bool {
+		if n := r.MatchingKeyEnvelopes(envelopes); n > 0 {
+			stats.Entities++
+			stats.Keys += n
+			entityIDs = append(entityIDs, ids)
+		}
+		return true
+	}); err != nil {
+		return stats, err
+	}
+	if dryRun {
+		return stats, nil
+	}
+
+	logger := log.FromContext(ctx)
+	for i, ids := range entityIDs {
+		if i > 0 && i%rewrapProgressInterval == 0 {
+			logger.WithField("count", i).WithField("total", len(entityIDs)).Info("Re-wrapping keys")
+		}
+		ctx := log.NewContextWithFields(ctx, log.Fields(
+			"entity_type", ids.EntityType(),
+			"entity_uid", unique.ID(ctx, ids),
+		))
+		var rewrapped uint64
+		if err := registry.Update(ctx, ids, func(ctx context.Context, envelopes map[string]**ttnpb.KeyEnvelope) ([]string, error) {
+			paths, err := r.RewrapKeyEnvelopes(ctx, envelopes)
+			if err != nil {
+				return nil, err
+			}
+			rewrapped = uint64(len(paths))
+			return paths, nil
+		}); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to re-wrap keys")
+			stats.Failed++
+			continue
+		}
+		stats.Rewrapped += rewrapped
+	}
+	return stats, nil
+}
@@ -0,0 +1,167 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestKEKRewrap(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	v := NewMemKeyVault(map[string][]byte{
+		"old": {0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf},
+		"new": {0xf, 0xe, 0xd, 0xc, 0xb, 0xa, 0x9, 0x8, 0x7, 0x6, 0x5, 0x4, 0x3, 0x2, 0x1, 0x0},
+	})
+	appKey := types.AES128Key{0x0, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}
+	nwkKey := types.AES128Key{0xff, 0xee, 0xdd, 0xcc, 0xbb, 0xaa, 0x99, 0x88, 0x77, 0x66, 0x55, 0x44, 0x33, 0x22, 0x11, 0x0}
+
+	a.So(errors.IsInvalidArgument(KEKRewrap{KeyVault: v, From: "old", To: "old"}.Validate()), should.BeTrue)
+
+	for _, tc := range []struct {
+		Name string
+		From string
+		To   string
+	}{
+		{
+			Name: "Wrapped to wrapped",
+			From: "old",
+			To:   "new",
+		},
+		{
+			Name: "Plaintext to wrapped",
+			From: "",
+			To:   "new",
+		},
+		{
+			Name: "Wrapped to plaintext",
+			From: "old",
+			To:   "",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			rw := KEKRewrap{
+				KeyVault: v,
+				From:     tc.From,
+				To:       tc.To,
+			}
+			if !a.So(rw.Validate(), should.BeNil) {
+				t.FailNow()
+			}
+
+			appKeyEnvelope, err := WrapAES128Key(ctx, appKey, tc.From, v)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			nwkKeyEnvelope, err := WrapAES128Key(ctx, nwkKey, "other", NewMemKeyVault(map[string][]byte{
+				"other": {0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf},
+			}))
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			vaultEnvelope := &ttnpb.KeyEnvelope{
+				KekLabel: tc.From,
+			}
+			var nilEnvelope *ttnpb.KeyEnvelope
+			envelopes := map[string]**ttnpb.KeyEnvelope{
+				"app_key":   &appKeyEnvelope,
+				"nwk_key":   &nwkKeyEnvelope,
+				"vault_key": &vaultEnvelope,
+				"nil_key":   &nilEnvelope,
+			}
+			nwkKeyEnvelopeBefore := nwkKeyEnvelope
+
+			a.So(rw.MatchingKeyEnvelopes(envelopes), should.Equal, 1)
+
+			paths, err := rw.RewrapKeyEnvelopes(ctx, envelopes)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(paths, should.Resemble, []string{"app_key"})
+			a.So(appKeyEnvelope.KekLabel, should.Equal, tc.To)
+			a.So(nwkKeyEnvelope, should.Equal, nwkKeyEnvelopeBefore)
+			a.So(vaultEnvelope, should.Resemble, &ttnpb.KeyEnvelope{KekLabel: tc.From})
+			a.So(nilEnvelope, should.BeNil)
+			a.So(rw.MatchingKeyEnvelopes(envelopes), should.Equal, 0)
+
+			key, err := UnwrapAES128Key(ctx, appKeyEnvelope, v)
+			a.So(err, should.BeNil)
+			a.So(key, should.Resemble, appKey)
+		})
+	}
+
+	t.Run("Plaintext key to wrapped", func(t *testing.T) {
+		a := assertions.New(t)
+		rw := KEKRewrap{
+			KeyVault: v,
+			To:       "new",
+		}
+		ke := &ttnpb.KeyEnvelope{
+			Key: &appKey,
+		}
+		envelopes := map[string]**ttnpb.KeyEnvelope{"key": &ke}
+		a.So(rw.MatchingKeyEnvelopes(envelopes), should.Equal, 1)
+		paths, err := rw.RewrapKeyEnvelopes(ctx, envelopes)
+		a.So(err, should.BeNil)
+		a.So(paths, should.Resemble, []string{"key"})
+		a.So(ke.Key, should.BeNil)
+		a.So(ke.KekLabel, should.Equal, "new")
+		key, err := UnwrapAES128Key(ctx, ke, v)
+		a.So(err, should.BeNil)
+		a.So(key, should.Resemble, appKey)
+
+		// Plaintext keys only match when rotating from the empty label.
+		ke = &ttnpb.KeyEnvelope{
+			Key: &appKey,
+		}
+		a.So(KEKRewrap{KeyVault: v, From: "old", To: "new"}.MatchingKeyEnvelopes(envelopes), should.Equal, 0)
+	})
+
+	t.Run("Unknown KEK", func(t *testing.T) {
+		a := assertions.New(t)
+		rw := KEKRewrap{
+			KeyVault: v,
+			From:     "unknown",
+			To:       "new",
+		}
+		ke := &ttnpb.KeyEnvelope{
+			EncryptedKey: []byte{0x1f, 0xa6, 0x8b, 0xa, 0x81, 0x12, 0xb4, 0x47, 0xae, 0xf3, 0x4b, 0xd8, 0xfb, 0x5a, 0x7b, 0x82, 0x9d, 0x3e, 0x86, 0x23, 0x71, 0xd2, 0xcf, 0xe5},
+			KekLabel:     "unknown",
+		}
+		paths, err := rw.RewrapKeyEnvelopes(ctx, map[string]**ttnpb.KeyEnvelope{"key": &ke})
+		a.So(errors.IsNotFound(err), should.BeTrue)
+		a.So(paths, should.BeEmpty)
+		a.So(ke.KekLabel, should.Equal, "unknown")
+	})
+}
+
+func TestRewrapStats(t *testing.T) {
+	a := assertions.New(t)
+	stats := RewrapStats{Entities: 2, Keys: 3, Rewrapped: 1}
+	stats.Add(RewrapStats{Entities: 1, Keys: 1, Rewrapped: 1, Failed: 1})
+	a.So(stats, should.Resemble, RewrapStats{Entities: 3, Keys: 4, Rewrapped: 2, Failed: 1})
+	a.So(stats.Remaining(), should.Equal, 2)
+}
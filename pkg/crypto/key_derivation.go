@@ -98,3 +98,13 @@ func DeriveJSIntKey(key types.AES128Key, devEUI types.EUI64) types.AES128Key {
 func DeriveJSEncKey(key types.AES128Key, devEUI types.EUI64) types.AES128Key {
 	return deriveDeviceKey(key, 0x05, devEUI)
 }
+
+// DeriveRootWorSKey derives the LoRaWAN TS011 relay Root Wake On Radio Session Key of a served end device.
+// The NwkSEncKey of the served end device is used as "nwkSEncKey". For LoRaWAN 1.0 devices, this is the NwkSKey.
+func DeriveRootWorSKey(nwkSEncKey types.AES128Key) (derived types.AES128Key) {
+	buf := make([]byte, 16)
+	buf[0] = 0x01
+	block, _ := aes.NewCipher(nwkSEncKey[:])
+	block.Encrypt(derived[:], buf)
+	return
+}
@@ -56,4 +56,7 @@ func TestKeyDerivation(t *testing.T) {
 
 	jsEncKey := DeriveJSEncKey(key, devEUI)
 	a.So(jsEncKey, should.Equal, types.AES128Key{0xBB, 0x71, 0x1E, 0xEF, 0xB9, 0x82, 0x9B, 0x4A, 0x75, 0x86, 0x6F, 0x86, 0x16, 0xBA, 0xCD, 0x6D})
+
+	rootWorSKey := DeriveRootWorSKey(key)
+	a.So(rootWorSKey, should.Equal, types.AES128Key{0x95, 0xE3, 0xC0, 0x1B, 0xA0, 0x78, 0xBC, 0xB1, 0x88, 0x7F, 0xD5, 0x9C, 0x8D, 0x17, 0x8A, 0xB0})
 }
@@ -43,3 +43,18 @@ type KeyVault interface {
 	// ExportCertificate exports the X.509 certificate and private key of the given identifier.
 	ExportCertificate(ctx context.Context, id string) (*tls.Certificate, error)
 }
+
+// OpaqueKeyVault is a KeyVault that performs AES operations with keys that are held by the key vault.
+// The keys are referenced using labels and never leave the key vault.
+type OpaqueKeyVault interface {
+	KeyVault
+
+	// EncryptBlocks encrypts the given blocks using AES in ECB mode.
+	// The length of the plaintext must be a multiple of the AES block size.
+	EncryptBlocks(ctx context.Context, plaintext []byte, label string) ([]byte, error)
+	// DecryptBlocks decrypts the given blocks using AES in ECB mode.
+	// The length of the ciphertext must be a multiple of the AES block size.
+	DecryptBlocks(ctx context.Context, ciphertext []byte, label string) ([]byte, error)
+	// CMAC computes the AES-CMAC of the given data.
+	CMAC(ctx context.Context, data []byte, label string) ([]byte, error)
+}
@@ -0,0 +1,135 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deviceclaimingserver implements the Device Claiming Server component.
+package deviceclaimingserver
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/hooks"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/rpclog"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// DeviceClaimingServer implements the Device Claiming Server component.
+//
+// The Device Claiming Server exposes the EndDeviceClaimingServer and GatewayClaimingServer services.
+type DeviceClaimingServer struct {
+	*component.Component
+	ctx context.Context
+
+	config *Config
+
+	grpc struct {
+		endDeviceClaimingServer *endDeviceClaimingServer
+		gatewayClaimingServer   *gatewayClaimingServer
+	}
+}
+
+var errNoRegistry = errors.DefineFailedPrecondition("no_registry", "no {registry} registry configured")
+
+// New returns a new *DeviceClaimingServer.
+func New(c *component.Component, conf *Config) (*DeviceClaimingServer, error) {
+	if conf.AuthorizedApplications == nil {
+		return nil, errNoRegistry.WithAttributes("registry", "authorized applications")
+	}
+	if conf.AuthorizedGateways == nil {
+		return nil, errNoRegistry.WithAttributes("registry", "authorized gateways")
+	}
+	dcs := &DeviceClaimingServer{
+		Component: c,
+		ctx:       log.NewContextWithField(c.Context(), "namespace", "deviceclaimingserver"),
+		config:    conf,
+	}
+	dcs.grpc.endDeviceClaimingServer = &endDeviceClaimingServer{DCS: dcs}
+	dcs.grpc.gatewayClaimingServer = &gatewayClaimingServer{DCS: dcs}
+
+	c.RegisterGRPC(dcs)
+
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.EndDeviceClaimingServer", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("deviceclaimingserver"))
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.EndDeviceClaimingServer", cluster.HookName, c.ClusterAuthUnaryHook())
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.GatewayClaimingServer", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("deviceclaimingserver"))
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.GatewayClaimingServer", cluster.HookName, c.ClusterAuthUnaryHook())
+	return dcs, nil
+}
+
+// Context returns the context of the Device Claiming Server.
+func (dcs *DeviceClaimingServer) Context() context.Context {
+	return dcs.ctx
+}
+
+// Roles returns the roles that the Device Claiming Server fulfills.
+func (dcs *DeviceClaimingServer) Roles() []ttnpb.ClusterRole {
+	return []ttnpb.ClusterRole{ttnpb.ClusterRole_DEVICE_CLAIMING_SERVER}
+}
+
+// RegisterServices registers services provided by dcs at s.
+func (dcs *DeviceClaimingServer) RegisterServices(s *grpc.Server) {
+	ttnpb.RegisterEndDeviceClaimingServerServer(s, dcs.grpc.endDeviceClaimingServer)
+	ttnpb.RegisterGatewayClaimingServerServer(s, dcs.grpc.gatewayClaimingServer)
+}
+
+// RegisterHandlers registers gRPC handlers.
+func (dcs *DeviceClaimingServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
+	ttnpb.RegisterEndDeviceClaimingServerHandler(dcs.Context(), s, conn)
+	ttnpb.RegisterGatewayClaimingServerHandler(dcs.Context(), s, conn)
+}
+
+// encryptAPIKey encrypts the API key with the configured encryption key, if any.
+func (dcs *DeviceClaimingServer) encryptAPIKey(ctx context.Context, apiKey string) (*ttnpb.Secret, error) {
+	value := []byte(apiKey)
+	if dcs.config.EncryptionKeyID != "" {
+		var err error
+		value, err = dcs.KeyVault.Encrypt(ctx, value, dcs.config.EncryptionKeyID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		log.FromContext(ctx).Warn("No encryption key defined, store API key in plaintext")
+	}
+	return &ttnpb.Secret{
+		KeyId: dcs.config.EncryptionKeyID,
+		Value: value,
+	}, nil
+}
+
+// decryptAPIKey decrypts the stored API key.
+func (dcs *DeviceClaimingServer) decryptAPIKey(ctx context.Context, secret *ttnpb.Secret) (string, error) {
+	value := secret.Value
+	if secret.KeyId != "" {
+		var err error
+		value, err = dcs.KeyVault.Decrypt(ctx, value, secret.KeyId)
+		if err != nil {
+			return "", err
+		}
+	}
+	return string(value), nil
+}
+
+// apiKeyCallOpt returns the call option that authenticates calls with the given API key.
+func (dcs *DeviceClaimingServer) apiKeyCallOpt(apiKey string) grpc.CallOption {
+	return grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     apiKey,
+		AllowInsecure: dcs.AllowInsecureForCredentials(),
+	})
+}
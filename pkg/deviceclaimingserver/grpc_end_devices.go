@@ -0,0 +1,494 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/qrcode"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/grpc"
+)
+
+var (
+	errNoSourceDevice           = errors.DefineInvalidArgument("no_source_device", "no source device specified")
+	errQRCodeData               = errors.DefineInvalidArgument("qr_code_data", "invalid QR code data")
+	errSameApplication          = errors.DefineInvalidArgument("same_application", "end device is already in the target application")
+	errTargetEndDeviceExists    = errors.DefineAlreadyExists("target_end_device_exists", "end device `{device_id}` already exists in the target application")
+	errApplicationNotAuthorized = errors.DefinePermissionDenied("application_not_authorized", "source application is not authorized for claiming")
+	errApplicationAPIKeyRights  = errors.DefinePermissionDenied("application_api_key_rights", "API key does not have the application rights required for claiming")
+	errEndDeviceNotClaimable    = errors.DefineFailedPrecondition("end_device_not_claimable", "end device is not claimable")
+	errClaimAuthenticationCode  = errors.DefinePermissionDenied("claim_authentication_code", "invalid claim authentication code")
+	errTransferEndDevice        = errors.Define("transfer_end_device", "transfer end device")
+	errRestoreSourceEndDevice   = errors.Define("restore_source_end_device", "restore source end device")
+)
+
+// claimApplicationRights are the rights that the API key of an application that is authorized for claiming must have.
+var claimApplicationRights = []ttnpb.Right{
+	ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+	ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+	ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+}
+
+var (
+	isClaimGetPaths = []string{
+		"attributes",
+		"description",
+		"application_server_address",
+		"join_server_address",
+		"locations",
+		"name",
+		"network_server_address",
+		"picture",
+		"service_profile_id",
+		"version_ids",
+	}
+	jsClaimGetPaths = []string{
+		"application_server_address",
+		"application_server_id",
+		"application_server_kek_label",
+		"claim_authentication_code",
+		"last_dev_nonce",
+		"last_join_nonce",
+		"last_rj_count_0",
+		"last_rj_count_1",
+		"net_id",
+		"network_server_address",
+		"network_server_kek_label",
+		"provisioner_id",
+		"provisioning_data",
+		"resets_join_nonces",
+		"root_keys.app_key.key",
+		"root_keys.nwk_key.key",
+		"root_keys.root_key_id",
+		"used_dev_nonces",
+	}
+	nsClaimGetPaths = []string{
+		"frequency_plan_id",
+		"lorawan_phy_version",
+		"lorawan_version",
+		"mac_settings",
+		"supports_class_b",
+		"supports_class_c",
+		"supports_join",
+	}
+	asClaimGetPaths = []string{
+		"formatters",
+		"skip_payload_crypto_override",
+	}
+)
+
+type endDeviceClaimingServer struct {
+	ttnpb.UnimplementedEndDeviceClaimingServerServer
+
+	DCS *DeviceClaimingServer
+}
+
+// endDeviceRegistration is the registration of an end device in the Identity Server, Join Server,
+// Network Server and Application Server. The Network Server and Application Server registrations are optional.
+type endDeviceRegistration struct {
+	is, js, ns, as *ttnpb.EndDevice
+}
+
+func withPaths(dev *ttnpb.EndDevice, paths ...string) *ttnpb.SetEndDeviceRequest {
+	return &ttnpb.SetEndDeviceRequest{
+		EndDevice: *dev,
+		FieldMask: &pbtypes.FieldMask{
+			Paths: ttnpb.AddFields(append(paths[:0:0], paths...), "ids.dev_eui", "ids.join_eui"),
+		},
+	}
+}
+
+func jsClaimSetPaths(dev *ttnpb.EndDevice) []string {
+	paths := ttnpb.ExcludeFields(jsClaimGetPaths, "root_keys.app_key.key", "root_keys.nwk_key.key")
+	if !dev.GetRootKeys().GetAppKey().GetKey().IsZero() {
+		paths = append(paths, "root_keys.app_key.key")
+	}
+	if !dev.GetRootKeys().GetNwkKey().GetKey().IsZero() {
+		paths = append(paths, "root_keys.nwk_key.key")
+	}
+	return paths
+}
+
+// register registers the end device in the cluster.
+// If allowExisting is set, the end device may already exist in the Identity Server.
+// The returned registration contains the parts that this call registered, also if registration fails,
+// so that a failed registration can be rolled back without touching end devices registered by others.
+func (srv *endDeviceClaimingServer) register(ctx context.Context, reg endDeviceRegistration, allowExisting bool, callOpt grpc.CallOption) (registered endDeviceRegistration, err error) {
+	isConn, err := srv.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return registered, err
+	}
+	if _, err := ttnpb.NewEndDeviceRegistryClient(isConn).Create(ctx, &ttnpb.CreateEndDeviceRequest{
+		EndDevice: *reg.is,
+	}, callOpt); err != nil {
+		if !allowExisting || !errors.IsAlreadyExists(err) {
+			return registered, err
+		}
+	} else {
+		registered.is = reg.is
+	}
+	jsConn, err := srv.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_JOIN_SERVER, nil)
+	if err != nil {
+		return registered, err
+	}
+	if _, err := ttnpb.NewJsEndDeviceRegistryClient(jsConn).Set(ctx, withPaths(reg.js, jsClaimSetPaths(reg.js)...), callOpt); err != nil {
+		return registered, err
+	}
+	registered.js = reg.js
+	if reg.ns != nil {
+		nsConn, err := srv.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+		if err != nil {
+			return registered, err
+		}
+		if _, err := ttnpb.NewNsEndDeviceRegistryClient(nsConn).Set(ctx, withPaths(reg.ns, nsClaimGetPaths...), callOpt); err != nil {
+			return registered, err
+		}
+		registered.ns = reg.ns
+	}
+	if reg.as != nil {
+		asConn, err := srv.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, nil)
+		if err != nil {
+			return registered, err
+		}
+		if _, err := ttnpb.NewAsEndDeviceRegistryClient(asConn).Set(ctx, withPaths(reg.as, asClaimGetPaths...), callOpt); err != nil {
+			return registered, err
+		}
+		registered.as = reg.as
+	}
+	return registered, nil
+}
+
+// deregister deletes the parts of the registration of the end device from the cluster.
+// The end device is deleted in reverse order of registration.
+func (srv *endDeviceClaimingServer) deregister(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, reg endDeviceRegistration, callOpt grpc.CallOption) error {
+	if reg.as != nil {
+		asConn, err := srv.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := ttnpb.NewAsEndDeviceRegistryClient(asConn).Delete(ctx, &ids, callOpt); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	if reg.ns != nil {
+		nsConn, err := srv.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := ttnpb.NewNsEndDeviceRegistryClient(nsConn).Delete(ctx, &ids, callOpt); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	if reg.js != nil {
+		jsConn, err := srv.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_JOIN_SERVER, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := ttnpb.NewJsEndDeviceRegistryClient(jsConn).Delete(ctx, &ids, callOpt); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	if reg.is != nil {
+		isConn, err := srv.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := ttnpb.NewEndDeviceRegistryClient(isConn).Delete(ctx, &ids, callOpt); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// getRegistration returns the registration of the end device in the cluster.
+// The Network Server and Application Server registrations are only retrieved if the end device refers to them.
+func (srv *endDeviceClaimingServer) getRegistration(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, callOpt grpc.CallOption) (*endDeviceRegistration, error) {
+	get := func(paths ...string) *ttnpb.GetEndDeviceRequest {
+		return &ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: ids,
+			FieldMask:            &pbtypes.FieldMask{Paths: paths},
+		}
+	}
+	var (
+		reg endDeviceRegistration
+		err error
+	)
+	jsConn, err := srv.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_JOIN_SERVER, nil)
+	if err != nil {
+		return nil, err
+	}
+	if reg.js, err = ttnpb.NewJsEndDeviceRegistryClient(jsConn).Get(ctx, get(jsClaimGetPaths...), callOpt); err != nil {
+		return nil, err
+	}
+	isConn, err := srv.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return nil, err
+	}
+	if reg.is, err = ttnpb.NewEndDeviceRegistryClient(isConn).Get(ctx, get(isClaimGetPaths...), callOpt); err != nil {
+		return nil, err
+	}
+	if reg.is.NetworkServerAddress != "" {
+		nsConn, err := srv.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+		if err != nil {
+			return nil, err
+		}
+		if reg.ns, err = ttnpb.NewNsEndDeviceRegistryClient(nsConn).Get(ctx, get(nsClaimGetPaths...), callOpt); err != nil {
+			if !errors.IsNotFound(err) {
+				return nil, err
+			}
+			reg.ns = nil
+		}
+	}
+	if reg.is.ApplicationServerAddress != "" {
+		asConn, err := srv.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, nil)
+		if err != nil {
+			return nil, err
+		}
+		if reg.as, err = ttnpb.NewAsEndDeviceRegistryClient(asConn).Get(ctx, get(asClaimGetPaths...), callOpt); err != nil {
+			if !errors.IsNotFound(err) {
+				return nil, err
+			}
+			reg.as = nil
+		}
+	}
+	return &reg, nil
+}
+
+func validateClaimAuthenticationCode(code *ttnpb.EndDeviceAuthenticationCode, value string, now time.Time) error {
+	if code.GetValue() == "" ||
+		code.ValidFrom != nil && now.Before(*code.ValidFrom) ||
+		code.ValidTo != nil && now.After(*code.ValidTo) {
+		return errEndDeviceNotClaimable.New()
+	}
+	if subtle.ConstantTimeCompare([]byte(code.Value), []byte(value)) != 1 {
+		return errClaimAuthenticationCode.New()
+	}
+	return nil
+}
+
+// Claim implements ttnpb.EndDeviceClaimingServerServer.
+func (srv *endDeviceClaimingServer) Claim(ctx context.Context, req *ttnpb.ClaimEndDeviceRequest) (*ttnpb.EndDeviceIdentifiers, error) {
+	var (
+		joinEUI, devEUI    types.EUI64
+		authenticationCode string
+	)
+	switch source := req.SourceDevice.(type) {
+	case *ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers_:
+		joinEUI = source.AuthenticatedIdentifiers.JoinEui
+		devEUI = source.AuthenticatedIdentifiers.DevEui
+		authenticationCode = source.AuthenticatedIdentifiers.AuthenticationCode
+	case *ttnpb.ClaimEndDeviceRequest_QrCode:
+		data, err := qrcode.Parse(source.QrCode)
+		if err != nil {
+			return nil, errQRCodeData.WithCause(err)
+		}
+		authIDs, ok := data.(qrcode.AuthenticatedEndDeviceIdentifiers)
+		if !ok {
+			return nil, errQRCodeData.New()
+		}
+		joinEUI, devEUI, authenticationCode = authIDs.AuthenticatedEndDeviceIdentifiers()
+	default:
+		return nil, errNoSourceDevice.New()
+	}
+
+	if err := rights.RequireApplication(ctx, *req.TargetApplicationIds,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	targetCallOpt, err := rpcmetadata.WithForwardedAuth(ctx, srv.DCS.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+
+	isConn, err := srv.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return nil, err
+	}
+	sourceIDs, err := ttnpb.NewEndDeviceRegistryClient(isConn).GetIdentifiersForEUIs(ctx, &ttnpb.GetEndDeviceIdentifiersForEUIsRequest{
+		JoinEui: joinEUI,
+		DevEui:  devEUI,
+	}, srv.DCS.WithClusterAuth())
+	if err != nil {
+		return nil, err
+	}
+	if err := ratelimit.Require(srv.DCS.RateLimiter(), ratelimit.EndDeviceClaimResource(joinEUI, devEUI)); err != nil {
+		return nil, err
+	}
+	if sourceIDs.ApplicationIdentifiers.Equal(*req.TargetApplicationIds) {
+		return nil, errSameApplication.New()
+	}
+	secret, err := srv.DCS.config.AuthorizedApplications.Get(ctx, sourceIDs.ApplicationIdentifiers)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errApplicationNotAuthorized.New()
+		}
+		return nil, err
+	}
+	apiKey, err := srv.DCS.decryptAPIKey(ctx, secret)
+	if err != nil {
+		return nil, err
+	}
+	sourceCallOpt := srv.DCS.apiKeyCallOpt(apiKey)
+
+	source, err := srv.getRegistration(ctx, *sourceIDs, sourceCallOpt)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateClaimAuthenticationCode(source.js.ClaimAuthenticationCode, authenticationCode, time.Now()); err != nil {
+		return nil, err
+	}
+
+	targetIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: *req.TargetApplicationIds,
+		DeviceId:               req.TargetDeviceId,
+		JoinEui:                &joinEUI,
+		DevEui:                 &devEUI,
+	}
+	if targetIDs.DeviceId == "" {
+		targetIDs.DeviceId = sourceIDs.DeviceId
+	}
+	// Check that the target end device does not exist before the source end device is deregistered.
+	if _, err := ttnpb.NewEndDeviceRegistryClient(isConn).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: targetIDs.ApplicationIdentifiers,
+			DeviceId:               targetIDs.DeviceId,
+		},
+		FieldMask: &pbtypes.FieldMask{Paths: []string{"ids"}},
+	}, srv.DCS.WithClusterAuth()); err == nil {
+		return nil, errTargetEndDeviceExists.WithAttributes("device_id", targetIDs.DeviceId)
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	}
+	target := endDeviceRegistration{
+		is: &ttnpb.EndDevice{
+			EndDeviceIdentifiers:     targetIDs,
+			Name:                     source.is.Name,
+			Description:              source.is.Description,
+			Attributes:               source.is.Attributes,
+			VersionIds:               source.is.VersionIds,
+			ServiceProfileId:         source.is.ServiceProfileId,
+			JoinServerAddress:        source.is.JoinServerAddress,
+			NetworkServerAddress:     req.TargetNetworkServerAddress,
+			ApplicationServerAddress: req.TargetApplicationServerAddress,
+		},
+		js: &ttnpb.EndDevice{
+			EndDeviceIdentifiers:      targetIDs,
+			RootKeys:                  source.js.RootKeys,
+			ProvisionerId:             source.js.ProvisionerId,
+			ProvisioningData:          source.js.ProvisioningData,
+			ResetsJoinNonces:          source.js.ResetsJoinNonces,
+			LastDevNonce:              source.js.LastDevNonce,
+			LastJoinNonce:             source.js.LastJoinNonce,
+			LastRjCount_0:             source.js.LastRjCount_0,
+			LastRjCount_1:             source.js.LastRjCount_1,
+			UsedDevNonces:             source.js.UsedDevNonces,
+			NetId:                     req.TargetNetId,
+			NetworkServerAddress:      req.TargetNetworkServerAddress,
+			NetworkServerKekLabel:     req.TargetNetworkServerKekLabel,
+			ApplicationServerAddress:  req.TargetApplicationServerAddress,
+			ApplicationServerKekLabel: req.TargetApplicationServerKekLabel,
+			ApplicationServerId:       req.TargetApplicationServerId,
+		},
+	}
+	if !req.InvalidateAuthenticationCode {
+		target.js.ClaimAuthenticationCode = source.js.ClaimAuthenticationCode
+	}
+	if source.ns != nil && req.TargetNetworkServerAddress != "" {
+		target.ns = source.ns
+		target.ns.EndDeviceIdentifiers = targetIDs
+	}
+	if source.as != nil && req.TargetApplicationServerAddress != "" {
+		target.as = source.as
+		target.as.EndDeviceIdentifiers = targetIDs
+	}
+
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"source_application_id", sourceIDs.ApplicationId,
+		"source_device_id", sourceIDs.DeviceId,
+		"target_application_id", targetIDs.ApplicationId,
+		"target_device_id", targetIDs.DeviceId,
+	))
+	// registered is the part of the target registration that this call registered.
+	// Only that part is deleted when the source end device is restored.
+	var registered endDeviceRegistration
+	restore := func(cause error) error {
+		if err := srv.deregister(ctx, targetIDs, registered, targetCallOpt); err != nil {
+			logger.WithError(err).Warn("Failed to delete target end device")
+		}
+		if _, err := srv.register(ctx, *source, true, sourceCallOpt); err != nil {
+			logger.WithError(err).Error("Failed to restore source end device")
+			return errRestoreSourceEndDevice.WithCause(err)
+		}
+		return errTransferEndDevice.WithCause(cause)
+	}
+	if err := srv.deregister(ctx, *sourceIDs, *source, sourceCallOpt); err != nil {
+		return nil, restore(err)
+	}
+	if registered, err = srv.register(ctx, target, false, targetCallOpt); err != nil {
+		return nil, restore(err)
+	}
+	logger.Info("End device claimed")
+	return &targetIDs, nil
+}
+
+// AuthorizeApplication implements ttnpb.EndDeviceClaimingServerServer.
+func (srv *endDeviceClaimingServer) AuthorizeApplication(ctx context.Context, req *ttnpb.AuthorizeApplicationRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, *req.ApplicationIds, claimApplicationRights...); err != nil {
+		return nil, err
+	}
+	conn, err := srv.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_ACCESS, nil)
+	if err != nil {
+		return nil, err
+	}
+	keyRights, err := ttnpb.NewApplicationAccessClient(conn).ListRights(ctx, req.ApplicationIds, srv.DCS.apiKeyCallOpt(req.ApiKey))
+	if err != nil {
+		return nil, err
+	}
+	if !keyRights.IncludesAll(claimApplicationRights...) {
+		return nil, errApplicationAPIKeyRights.New()
+	}
+	secret, err := srv.DCS.encryptAPIKey(ctx, req.ApiKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := srv.DCS.config.AuthorizedApplications.Set(ctx, *req.ApplicationIds, secret); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// UnauthorizeApplication implements ttnpb.EndDeviceClaimingServerServer.
+func (srv *endDeviceClaimingServer) UnauthorizeApplication(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, *ids, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if err := srv.DCS.config.AuthorizedApplications.Set(ctx, *ids, nil); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
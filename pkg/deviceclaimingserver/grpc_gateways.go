@@ -0,0 +1,261 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	errNoSourceGateway                = errors.DefineInvalidArgument("no_source_gateway", "no source gateway specified")
+	errNoCollaborator                 = errors.DefineInvalidArgument("no_collaborator", "no collaborator specified")
+	errGatewayQRCodeNotSupported      = errors.DefineUnimplemented("gateway_qr_code_not_supported", "claiming gateways by QR code is not supported")
+	errCUPSRedirectionNotSupported    = errors.DefineUnimplemented("cups_redirection_not_supported", "CUPS redirection is not supported")
+	errGatewayNotAuthorized           = errors.DefinePermissionDenied("gateway_not_authorized", "gateway is not authorized for claiming")
+	errGatewayAPIKeyRights            = errors.DefinePermissionDenied("gateway_api_key_rights", "API key does not have the gateway rights required for claiming")
+	errGatewayNotClaimable            = errors.DefineFailedPrecondition("gateway_not_claimable", "gateway is not claimable")
+	errGatewayClaimAuthenticationCode = errors.DefinePermissionDenied("gateway_claim_authentication_code", "invalid gateway claim authentication code")
+	errTransferGateway                = errors.Define("transfer_gateway", "transfer gateway")
+	errRestoreSourceGateway           = errors.Define("restore_source_gateway", "restore source gateway")
+)
+
+// claimGatewayRights are the rights that the API key of a gateway that is authorized for claiming must have.
+var claimGatewayRights = []ttnpb.Right{
+	ttnpb.RIGHT_GATEWAY_DELETE,
+	ttnpb.RIGHT_GATEWAY_INFO,
+	ttnpb.RIGHT_GATEWAY_READ_SECRETS,
+	ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC,
+}
+
+// gatewayClaimGetPaths are the paths of the source gateway that are transferred to the target gateway.
+var gatewayClaimGetPaths = []string{
+	"antennas",
+	"claim_authentication_code",
+	"enforce_duty_cycle",
+	"frequency_plan_ids",
+	"gateway_server_address",
+	"require_authenticated_connection",
+	"schedule_anytime_delay",
+	"schedule_downlink_late",
+	"version_ids",
+}
+
+type gatewayClaimingServer struct {
+	ttnpb.UnimplementedGatewayClaimingServerServer
+
+	DCS *DeviceClaimingServer
+}
+
+func validateGatewayClaimAuthenticationCode(code *ttnpb.GatewayClaimAuthenticationCode, value []byte, now time.Time) error {
+	if len(code.GetSecret().GetValue()) == 0 ||
+		code.ValidFrom != nil && now.Before(*code.ValidFrom) ||
+		code.ValidTo != nil && now.After(*code.ValidTo) {
+		return errGatewayNotClaimable.New()
+	}
+	if subtle.ConstantTimeCompare(code.Secret.Value, value) != 1 {
+		return errGatewayClaimAuthenticationCode.New()
+	}
+	return nil
+}
+
+// Claim implements ttnpb.GatewayClaimingServerServer.
+// The source gateway is deleted and a new gateway with the same EUI is created for the collaborator.
+func (srv *gatewayClaimingServer) Claim(ctx context.Context, req *ttnpb.ClaimGatewayRequest) (*ttnpb.GatewayIdentifiers, error) {
+	var authIDs *ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers
+	switch source := req.SourceGateway.(type) {
+	case *ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers_:
+		authIDs = source.AuthenticatedIdentifiers
+	case *ttnpb.ClaimGatewayRequest_QrCode:
+		return nil, errGatewayQRCodeNotSupported.New()
+	default:
+		return nil, errNoSourceGateway.New()
+	}
+	if req.CupsRedirection != nil {
+		return nil, errCUPSRedirectionNotSupported.New()
+	}
+
+	switch {
+	case req.Collaborator.GetUserIds() != nil:
+		if err := rights.RequireUser(ctx, *req.Collaborator.GetUserIds(), ttnpb.RIGHT_USER_GATEWAYS_CREATE); err != nil {
+			return nil, err
+		}
+	case req.Collaborator.GetOrganizationIds() != nil:
+		if err := rights.RequireOrganization(ctx, *req.Collaborator.GetOrganizationIds(), ttnpb.RIGHT_ORGANIZATION_GATEWAYS_CREATE); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errNoCollaborator.New()
+	}
+	targetCallOpt, err := rpcmetadata.WithForwardedAuth(ctx, srv.DCS.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := srv.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := ttnpb.NewGatewayRegistryClient(conn)
+	sourceIDs, err := client.GetIdentifiersForEUI(ctx, &ttnpb.GetGatewayIdentifiersForEUIRequest{
+		Eui: &authIDs.GatewayEui,
+	}, srv.DCS.WithClusterAuth())
+	if err != nil {
+		return nil, err
+	}
+	if err := ratelimit.Require(srv.DCS.RateLimiter(), ratelimit.GatewayClaimResource(authIDs.GatewayEui)); err != nil {
+		return nil, err
+	}
+	secret, err := srv.DCS.config.AuthorizedGateways.Get(ctx, *sourceIDs)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errGatewayNotAuthorized.New()
+		}
+		return nil, err
+	}
+	apiKey, err := srv.DCS.decryptAPIKey(ctx, secret)
+	if err != nil {
+		return nil, err
+	}
+	sourceCallOpt := srv.DCS.apiKeyCallOpt(apiKey)
+
+	source, err := client.Get(ctx, &ttnpb.GetGatewayRequest{
+		GatewayIds: sourceIDs,
+		FieldMask:  &pbtypes.FieldMask{Paths: gatewayClaimGetPaths},
+	}, sourceCallOpt)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateGatewayClaimAuthenticationCode(source.ClaimAuthenticationCode, authIDs.AuthenticationCode, time.Now()); err != nil {
+		return nil, err
+	}
+
+	targetIDs := &ttnpb.GatewayIdentifiers{
+		GatewayId: req.TargetGatewayId,
+		Eui:       &authIDs.GatewayEui,
+	}
+	if targetIDs.GatewayId == "" {
+		targetIDs.GatewayId = strings.ToLower(authIDs.GatewayEui.String())
+	}
+	target := &ttnpb.Gateway{
+		Ids:                            targetIDs,
+		VersionIds:                     source.VersionIds,
+		GatewayServerAddress:           source.GatewayServerAddress,
+		FrequencyPlanIds:               source.FrequencyPlanIds,
+		Antennas:                       source.Antennas,
+		EnforceDutyCycle:               source.EnforceDutyCycle,
+		ScheduleDownlinkLate:           source.ScheduleDownlinkLate,
+		ScheduleAnytimeDelay:           source.ScheduleAnytimeDelay,
+		RequireAuthenticatedConnection: source.RequireAuthenticatedConnection,
+	}
+	if req.TargetGatewayServerAddress != "" {
+		target.GatewayServerAddress = req.TargetGatewayServerAddress
+	}
+	if req.TargetFrequencyPlanId != "" {
+		target.FrequencyPlanIds = []string{req.TargetFrequencyPlanId}
+	}
+
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"source_gateway_id", sourceIDs.GatewayId,
+		"target_gateway_id", targetIDs.GatewayId,
+		"gateway_eui", authIDs.GatewayEui,
+	))
+	// The EUI of the source gateway is cleared before deleting it, as the EUI of deleted gateways remains reserved.
+	setSourceEUI := func(eui *types.EUI64) error {
+		_, err := client.Update(ctx, &ttnpb.UpdateGatewayRequest{
+			Gateway: &ttnpb.Gateway{
+				Ids: &ttnpb.GatewayIdentifiers{
+					GatewayId: sourceIDs.GatewayId,
+					Eui:       eui,
+				},
+			},
+			FieldMask: &pbtypes.FieldMask{Paths: []string{"ids.eui"}},
+		}, sourceCallOpt)
+		return err
+	}
+	if err := setSourceEUI(nil); err != nil {
+		return nil, errTransferGateway.WithCause(err)
+	}
+	if _, err := client.Delete(ctx, sourceIDs, sourceCallOpt); err != nil {
+		if err := setSourceEUI(sourceIDs.Eui); err != nil {
+			logger.WithError(err).Error("Failed to restore source gateway EUI")
+		}
+		return nil, errTransferGateway.WithCause(err)
+	}
+	if _, err := client.Create(ctx, &ttnpb.CreateGatewayRequest{
+		Gateway:      target,
+		Collaborator: req.Collaborator,
+	}, targetCallOpt); err != nil {
+		if _, err := client.Restore(ctx, sourceIDs, sourceCallOpt); err != nil {
+			logger.WithError(err).Error("Failed to restore source gateway")
+			return nil, errRestoreSourceGateway.WithCause(err)
+		}
+		if err := setSourceEUI(sourceIDs.Eui); err != nil {
+			logger.WithError(err).Error("Failed to restore source gateway EUI")
+			return nil, errRestoreSourceGateway.WithCause(err)
+		}
+		return nil, errTransferGateway.WithCause(err)
+	}
+	logger.Info("Gateway claimed")
+	return targetIDs, nil
+}
+
+// AuthorizeGateway implements ttnpb.GatewayClaimingServerServer.
+func (srv *gatewayClaimingServer) AuthorizeGateway(ctx context.Context, req *ttnpb.AuthorizeGatewayRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireGateway(ctx, *req.GatewayIds, claimGatewayRights...); err != nil {
+		return nil, err
+	}
+	conn, err := srv.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_ACCESS, nil)
+	if err != nil {
+		return nil, err
+	}
+	keyRights, err := ttnpb.NewGatewayAccessClient(conn).ListRights(ctx, req.GatewayIds, srv.DCS.apiKeyCallOpt(req.ApiKey))
+	if err != nil {
+		return nil, err
+	}
+	if !keyRights.IncludesAll(claimGatewayRights...) {
+		return nil, errGatewayAPIKeyRights.New()
+	}
+	secret, err := srv.DCS.encryptAPIKey(ctx, req.ApiKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := srv.DCS.config.AuthorizedGateways.Set(ctx, *req.GatewayIds, secret); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// UnauthorizeGateway implements ttnpb.GatewayClaimingServerServer.
+func (srv *gatewayClaimingServer) UnauthorizeGateway(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*pbtypes.Empty, error) {
+	if err := rights.RequireGateway(ctx, *ids, ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	if err := srv.DCS.config.AuthorizedGateways.Set(ctx, *ids, nil); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
@@ -0,0 +1,434 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+var errNotAuthorized = errors.DefineNotFound("not_authorized", "not authorized")
+
+type mockApplicationRegistry struct {
+	mu   sync.Mutex
+	keys map[string]*ttnpb.Secret
+}
+
+func (r *mockApplicationRegistry) Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (*ttnpb.Secret, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key, ok := r.keys[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errNotAuthorized.New()
+	}
+	return key, nil
+}
+
+func (r *mockApplicationRegistry) Set(ctx context.Context, ids ttnpb.ApplicationIdentifiers, apiKey *ttnpb.Secret) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if apiKey == nil {
+		delete(r.keys, unique.ID(ctx, ids))
+		return nil
+	}
+	r.keys[unique.ID(ctx, ids)] = apiKey
+	return nil
+}
+
+type mockGatewayRegistry struct {
+	mu   sync.Mutex
+	keys map[string]*ttnpb.Secret
+}
+
+func (r *mockGatewayRegistry) Get(ctx context.Context, ids ttnpb.GatewayIdentifiers) (*ttnpb.Secret, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key, ok := r.keys[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errNotAuthorized.New()
+	}
+	return key, nil
+}
+
+func (r *mockGatewayRegistry) Set(ctx context.Context, ids ttnpb.GatewayIdentifiers, apiKey *ttnpb.Secret) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if apiKey == nil {
+		delete(r.keys, unique.ID(ctx, ids))
+		return nil
+	}
+	r.keys[unique.ID(ctx, ids)] = apiKey
+	return nil
+}
+
+func credentials(key string) grpc.CallOption {
+	return grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     key,
+		AllowInsecure: true,
+	})
+}
+
+var testEncryptionKey = []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F}
+
+func startDeviceClaimingServer(t *testing.T, isAddr, jsAddr string) (*deviceclaimingserver.DeviceClaimingServer, *deviceclaimingserver.Config, func()) {
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			KeyVault: config.KeyVault{
+				Provider: "static",
+				Static: map[string][]byte{
+					"dcs-test": testEncryptionKey,
+				},
+			},
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+				JoinServer:     jsAddr,
+			},
+			RateLimiting: config.RateLimiting{
+				Profiles: []config.RateLimitingProfile{
+					{
+						Name:         "claim",
+						MaxPerMin:    5,
+						Associations: []string{"dcs:claim"},
+					},
+				},
+			},
+		},
+	})
+	conf := &deviceclaimingserver.Config{
+		AuthorizedApplications: &mockApplicationRegistry{keys: make(map[string]*ttnpb.Secret)},
+		AuthorizedGateways:     &mockGatewayRegistry{keys: make(map[string]*ttnpb.Secret)},
+		EncryptionKeyID:        "dcs-test",
+	}
+	dcs, err := deviceclaimingserver.New(c, conf)
+	test.Must(dcs, err)
+	componenttest.StartComponent(t, c)
+	mustHavePeer(test.Context(), c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+	if jsAddr != "" {
+		mustHavePeer(test.Context(), c, ttnpb.ClusterRole_JOIN_SERVER)
+	}
+	return dcs, conf, c.Close
+}
+
+func TestClaimEndDevice(t *testing.T) {
+	ctx := test.Context()
+
+	is, isAddr := startMockIS(ctx)
+	js, jsAddr := startMockJS(ctx, is.mockRights)
+	dcs, conf, stop := startDeviceClaimingServer(t, isAddr, jsAddr)
+	defer stop()
+	client := ttnpb.NewEndDeviceClaimingServerClient(dcs.LoopbackConn())
+
+	sourceAppIDs := ttnpb.ApplicationIdentifiers{ApplicationId: "source-app"}
+	targetAppIDs := ttnpb.ApplicationIdentifiers{ApplicationId: "target-app"}
+	joinEUI := types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}
+	devEUI := types.EUI64{0x00, 0x04, 0xa3, 0x0b, 0x00, 0x1c, 0x05, 0x30}
+	sourceIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: sourceAppIDs,
+		DeviceId:               "source-dev",
+		JoinEui:                &joinEUI,
+		DevEui:                 &devEUI,
+	}
+	appKey := types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+
+	is.add("owner-key", unique.ID(ctx, sourceAppIDs), ttnpb.AllApplicationRights.GetRights()...)
+	is.add("source-key", unique.ID(ctx, sourceAppIDs),
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+	)
+	is.add("weak-key", unique.ID(ctx, sourceAppIDs), ttnpb.RIGHT_APPLICATION_DEVICES_READ)
+	is.add("target-key", unique.ID(ctx, targetAppIDs), ttnpb.AllApplicationRights.GetRights()...)
+
+	is.devices.add(&ttnpb.EndDevice{
+		EndDeviceIdentifiers: sourceIDs,
+		Name:                 "Source Device",
+		JoinServerAddress:    "localhost",
+	})
+	js.add(&ttnpb.EndDevice{
+		EndDeviceIdentifiers: sourceIDs,
+		RootKeys: &ttnpb.RootKeys{
+			AppKey: &ttnpb.KeyEnvelope{Key: &appKey},
+		},
+		ClaimAuthenticationCode: &ttnpb.EndDeviceAuthenticationCode{
+			Value: "SECRET",
+		},
+		LastDevNonce: 42,
+	})
+
+	claimAs := func(code, targetDeviceID string) (*ttnpb.EndDeviceIdentifiers, error) {
+		return client.Claim(ctx, &ttnpb.ClaimEndDeviceRequest{
+			SourceDevice: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers_{
+				AuthenticatedIdentifiers: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers{
+					JoinEui:            joinEUI,
+					DevEui:             devEUI,
+					AuthenticationCode: code,
+				},
+			},
+			TargetApplicationIds:         &targetAppIDs,
+			TargetDeviceId:               targetDeviceID,
+			InvalidateAuthenticationCode: true,
+		}, credentials("target-key"))
+	}
+	claim := func(code string) (*ttnpb.EndDeviceIdentifiers, error) {
+		return claimAs(code, "target-dev")
+	}
+
+	t.Run("NotAuthorized", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := claim("SECRET")
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+
+	t.Run("Authorize", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := client.AuthorizeApplication(ctx, &ttnpb.AuthorizeApplicationRequest{
+			ApplicationIds: &sourceAppIDs,
+			ApiKey:         "source-key",
+		}, credentials("target-key"))
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+		_, err = client.AuthorizeApplication(ctx, &ttnpb.AuthorizeApplicationRequest{
+			ApplicationIds: &sourceAppIDs,
+			ApiKey:         "weak-key",
+		}, credentials("owner-key"))
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+		_, err = client.AuthorizeApplication(ctx, &ttnpb.AuthorizeApplicationRequest{
+			ApplicationIds: &sourceAppIDs,
+			ApiKey:         "source-key",
+		}, credentials("owner-key"))
+		a.So(err, should.BeNil)
+
+		secret, err := conf.AuthorizedApplications.Get(ctx, sourceAppIDs)
+		if a.So(err, should.BeNil) {
+			a.So(secret.KeyId, should.Equal, "dcs-test")
+			a.So(string(secret.Value), should.NotContainSubstring, "source-key")
+		}
+	})
+
+	t.Run("InvalidAuthenticationCode", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := claim("WRONG")
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		_, ok := is.devices.get(ctx, sourceIDs)
+		a.So(ok, should.BeTrue)
+	})
+
+	t.Run("TargetExists", func(t *testing.T) {
+		a := assertions.New(t)
+		takenIDs := ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: targetAppIDs,
+			DeviceId:               "taken-dev",
+		}
+		is.devices.add(&ttnpb.EndDevice{
+			EndDeviceIdentifiers: takenIDs,
+			Name:                 "Taken Device",
+		})
+		_, err := claimAs("SECRET", "taken-dev")
+		a.So(errors.IsAlreadyExists(err), should.BeTrue)
+
+		_, ok := is.devices.get(ctx, sourceIDs)
+		a.So(ok, should.BeTrue)
+		_, ok = js.get(ctx, sourceIDs)
+		a.So(ok, should.BeTrue)
+		takenDev, ok := is.devices.get(ctx, takenIDs)
+		if a.So(ok, should.BeTrue) {
+			a.So(takenDev.Name, should.Equal, "Taken Device")
+		}
+	})
+
+	t.Run("Claim", func(t *testing.T) {
+		a := assertions.New(t)
+		ids, err := claim("SECRET")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(ids.ApplicationId, should.Equal, "target-app")
+		a.So(ids.DeviceId, should.Equal, "target-dev")
+
+		_, ok := is.devices.get(ctx, sourceIDs)
+		a.So(ok, should.BeFalse)
+		_, ok = js.get(ctx, sourceIDs)
+		a.So(ok, should.BeFalse)
+
+		isDev, ok := is.devices.get(ctx, *ids)
+		if a.So(ok, should.BeTrue) {
+			a.So(isDev.Name, should.Equal, "Source Device")
+			a.So(isDev.JoinServerAddress, should.Equal, "localhost")
+		}
+		jsDev, ok := js.get(ctx, *ids)
+		if a.So(ok, should.BeTrue) {
+			a.So(*jsDev.RootKeys.AppKey.Key, should.Equal, appKey)
+			a.So(jsDev.LastDevNonce, should.Equal, 42)
+			a.So(jsDev.ClaimAuthenticationCode, should.BeNil)
+		}
+	})
+
+	t.Run("RateLimit", func(t *testing.T) {
+		a := assertions.New(t)
+		var err error
+		for i := 0; i < 10 && !errors.IsResourceExhausted(err); i++ {
+			_, err = claim("WRONG")
+		}
+		a.So(errors.IsResourceExhausted(err), should.BeTrue)
+	})
+
+	t.Run("Unauthorize", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := client.UnauthorizeApplication(ctx, &sourceAppIDs, credentials("owner-key"))
+		a.So(err, should.BeNil)
+	})
+}
+
+func TestClaimGateway(t *testing.T) {
+	ctx := test.Context()
+
+	is, isAddr := startMockIS(ctx)
+	dcs, conf, stop := startDeviceClaimingServer(t, isAddr, "")
+	defer stop()
+	client := ttnpb.NewGatewayClaimingServerClient(dcs.LoopbackConn())
+
+	eui := types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x01}
+	sourceIDs := &ttnpb.GatewayIdentifiers{GatewayId: "source-gtw", Eui: &eui}
+	userIDs := &ttnpb.UserIdentifiers{UserId: "target-usr"}
+	validTo := time.Now().Add(time.Hour)
+
+	is.add("owner-key", unique.ID(ctx, sourceIDs), ttnpb.AllGatewayRights.GetRights()...)
+	is.add("source-key", unique.ID(ctx, sourceIDs),
+		ttnpb.RIGHT_GATEWAY_DELETE,
+		ttnpb.RIGHT_GATEWAY_INFO,
+		ttnpb.RIGHT_GATEWAY_READ_SECRETS,
+		ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC,
+	)
+	is.add("target-key", unique.ID(ctx, userIDs), ttnpb.RIGHT_USER_GATEWAYS_CREATE)
+
+	is.gateways.add(&ttnpb.Gateway{
+		Ids:                  sourceIDs,
+		FrequencyPlanIds:     []string{"EU_863_870"},
+		GatewayServerAddress: "localhost",
+		ClaimAuthenticationCode: &ttnpb.GatewayClaimAuthenticationCode{
+			Secret:  &ttnpb.Secret{Value: []byte("SECRET")},
+			ValidTo: &validTo,
+		},
+	})
+
+	claim := func(code string) (*ttnpb.GatewayIdentifiers, error) {
+		return client.Claim(ctx, &ttnpb.ClaimGatewayRequest{
+			SourceGateway: &ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers_{
+				AuthenticatedIdentifiers: &ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers{
+					GatewayEui:         eui,
+					AuthenticationCode: []byte(code),
+				},
+			},
+			Collaborator:          userIDs.GetOrganizationOrUserIdentifiers(),
+			TargetFrequencyPlanId: "US_902_928_FSB_2",
+		}, credentials("target-key"))
+	}
+
+	t.Run("NotAuthorized", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := claim("SECRET")
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+
+	t.Run("Authorize", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := client.AuthorizeGateway(ctx, &ttnpb.AuthorizeGatewayRequest{
+			GatewayIds: sourceIDs,
+			ApiKey:     "owner-key",
+		}, credentials("target-key"))
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+		_, err = client.AuthorizeGateway(ctx, &ttnpb.AuthorizeGatewayRequest{
+			GatewayIds: sourceIDs,
+			ApiKey:     "source-key",
+		}, credentials("owner-key"))
+		a.So(err, should.BeNil)
+
+		secret, err := conf.AuthorizedGateways.Get(ctx, *sourceIDs)
+		if a.So(err, should.BeNil) {
+			a.So(secret.KeyId, should.Equal, "dcs-test")
+			a.So(string(secret.Value), should.NotContainSubstring, "source-key")
+		}
+	})
+
+	t.Run("InvalidAuthenticationCode", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := claim("WRONG")
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		_, ok := is.gateways.get(ctx, sourceIDs)
+		a.So(ok, should.BeTrue)
+	})
+
+	t.Run("NoCollaborator", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := client.Claim(ctx, &ttnpb.ClaimGatewayRequest{
+			SourceGateway: &ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers_{
+				AuthenticatedIdentifiers: &ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers{
+					GatewayEui:         eui,
+					AuthenticationCode: []byte("SECRET"),
+				},
+			},
+			TargetFrequencyPlanId: "US_902_928_FSB_2",
+		}, credentials("target-key"))
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		gtw, ok := is.gateways.get(ctx, sourceIDs)
+		if a.So(ok, should.BeTrue) {
+			a.So(*gtw.Ids.Eui, should.Equal, eui)
+		}
+	})
+
+	t.Run("Claim", func(t *testing.T) {
+		a := assertions.New(t)
+		ids, err := claim("SECRET")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(ids.GatewayId, should.Equal, "58a0cbfffe800001")
+
+		_, ok := is.gateways.get(ctx, sourceIDs)
+		a.So(ok, should.BeFalse)
+		gtw, ok := is.gateways.get(ctx, ids)
+		if a.So(ok, should.BeTrue) {
+			a.So(*gtw.Ids.Eui, should.Equal, eui)
+			a.So(gtw.FrequencyPlanIds, should.Resemble, []string{"US_902_928_FSB_2"})
+			a.So(gtw.GatewayServerAddress, should.Equal, "localhost")
+			a.So(gtw.ClaimAuthenticationCode, should.BeNil)
+		}
+	})
+}
@@ -0,0 +1,95 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the Device Claiming Server registries using Redis.
+package redis
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errNotAuthorized      = errors.DefineNotFound("not_authorized", "`{uid}` is not authorized for claiming")
+)
+
+func getAPIKey(ctx context.Context, cl *ttnredis.Client, uid string) (*ttnpb.Secret, error) {
+	apiKey := &ttnpb.Secret{}
+	if err := ttnredis.GetProto(ctx, cl, cl.Key("uid", uid)).ScanProto(apiKey); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errNotAuthorized.WithAttributes("uid", uid)
+		}
+		return nil, err
+	}
+	return apiKey, nil
+}
+
+func setAPIKey(ctx context.Context, cl *ttnredis.Client, uid string, apiKey *ttnpb.Secret) error {
+	k := cl.Key("uid", uid)
+	if apiKey == nil {
+		return ttnredis.ConvertError(cl.Del(ctx, k).Err())
+	}
+	cmd, err := ttnredis.SetProto(ctx, cl, k, apiKey, 0)
+	if err != nil {
+		return err
+	}
+	return ttnredis.ConvertError(cmd.Err())
+}
+
+// AuthorizedApplicationRegistry is a Redis registry of applications authorized for claiming.
+type AuthorizedApplicationRegistry struct {
+	Redis *ttnredis.Client
+}
+
+// Get implements deviceclaimingserver.AuthorizedApplicationRegistry.
+func (r *AuthorizedApplicationRegistry) Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (*ttnpb.Secret, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, errInvalidIdentifiers.WithCause(err)
+	}
+	return getAPIKey(ctx, r.Redis, unique.ID(ctx, ids))
+}
+
+// Set implements deviceclaimingserver.AuthorizedApplicationRegistry.
+func (r *AuthorizedApplicationRegistry) Set(ctx context.Context, ids ttnpb.ApplicationIdentifiers, apiKey *ttnpb.Secret) error {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return errInvalidIdentifiers.WithCause(err)
+	}
+	return setAPIKey(ctx, r.Redis, unique.ID(ctx, ids), apiKey)
+}
+
+// AuthorizedGatewayRegistry is a Redis registry of gateways authorized for claiming.
+type AuthorizedGatewayRegistry struct {
+	Redis *ttnredis.Client
+}
+
+// Get implements deviceclaimingserver.AuthorizedGatewayRegistry.
+func (r *AuthorizedGatewayRegistry) Get(ctx context.Context, ids ttnpb.GatewayIdentifiers) (*ttnpb.Secret, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, errInvalidIdentifiers.WithCause(err)
+	}
+	return getAPIKey(ctx, r.Redis, unique.ID(ctx, ids))
+}
+
+// Set implements deviceclaimingserver.AuthorizedGatewayRegistry.
+func (r *AuthorizedGatewayRegistry) Set(ctx context.Context, ids ttnpb.GatewayIdentifiers, apiKey *ttnpb.Secret) error {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return errInvalidIdentifiers.WithCause(err)
+	}
+	return setAPIKey(ctx, r.Redis, unique.ID(ctx, ids), apiKey)
+}
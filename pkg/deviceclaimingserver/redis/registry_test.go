@@ -0,0 +1,71 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+
+	. "go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestAuthorizedApplicationRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	registry := &AuthorizedApplicationRegistry{Redis: cl}
+	ids := ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+
+	_, err := registry.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	secret := &ttnpb.Secret{KeyId: "test-key", Value: []byte{0x01, 0x02, 0x03}}
+	a.So(registry.Set(ctx, ids, secret), should.BeNil)
+	key, err := registry.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(key, should.Resemble, secret)
+
+	a.So(registry.Set(ctx, ids, nil), should.BeNil)
+	_, err = registry.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestAuthorizedGatewayRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	registry := &AuthorizedGatewayRegistry{Redis: cl}
+	ids := ttnpb.GatewayIdentifiers{GatewayId: "test-gtw"}
+
+	_, err := registry.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	secret := &ttnpb.Secret{KeyId: "test-key", Value: []byte{0x01, 0x02, 0x03}}
+	a.So(registry.Set(ctx, ids, secret), should.BeNil)
+	key, err := registry.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(key, should.Resemble, secret)
+
+	a.So(registry.Set(ctx, ids, nil), should.BeNil)
+	_, err = registry.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
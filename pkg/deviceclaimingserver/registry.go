@@ -0,0 +1,39 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// AuthorizedApplicationRegistry stores the API keys of applications that are authorized for claiming.
+type AuthorizedApplicationRegistry interface {
+	// Get returns the API key of the application.
+	// Get returns a NotFound error if the application is not authorized for claiming.
+	Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (*ttnpb.Secret, error)
+	// Set sets the API key of the application. A nil API key removes the authorization.
+	Set(ctx context.Context, ids ttnpb.ApplicationIdentifiers, apiKey *ttnpb.Secret) error
+}
+
+// AuthorizedGatewayRegistry stores the API keys of gateways that are authorized for claiming.
+type AuthorizedGatewayRegistry interface {
+	// Get returns the API key of the gateway.
+	// Get returns a NotFound error if the gateway is not authorized for claiming.
+	Get(ctx context.Context, ids ttnpb.GatewayIdentifiers) (*ttnpb.Secret, error)
+	// Set sets the API key of the gateway. A nil API key removes the authorization.
+	Set(ctx context.Context, ids ttnpb.GatewayIdentifiers, apiKey *ttnpb.Secret) error
+}
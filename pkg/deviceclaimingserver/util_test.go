@@ -0,0 +1,355 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	errNotFound         = errors.DefineNotFound("not_found", "not found")
+	errAlreadyExists    = errors.DefineAlreadyExists("already_exists", "already exists")
+	errPermissionDenied = errors.DefinePermissionDenied("permission_denied", "permission denied")
+)
+
+func mustHavePeer(ctx context.Context, c *component.Component, role ttnpb.ClusterRole) {
+	for i := 0; i < 20; i++ {
+		time.Sleep(20 * time.Millisecond)
+		if _, err := c.GetPeer(ctx, role, nil); err == nil {
+			return
+		}
+	}
+	panic("could not connect to peer")
+}
+
+func startServer(ctx context.Context, register func(*rpcserver.Server)) string {
+	srv := rpcserver.New(ctx)
+	register(srv)
+	lis, err := net.Listen("tcp", ":0")
+	if err != nil {
+		panic(err)
+	}
+	go srv.Serve(lis)
+	return lis.Addr().String()
+}
+
+// mockRights holds the rights of API keys on entities.
+type mockRights struct {
+	mu     sync.Mutex
+	rights map[string]map[string][]ttnpb.Right
+}
+
+func (m *mockRights) add(key, uid string, rights ...ttnpb.Right) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.rights == nil {
+		m.rights = make(map[string]map[string][]ttnpb.Right)
+	}
+	if m.rights[key] == nil {
+		m.rights[key] = make(map[string][]ttnpb.Right)
+	}
+	m.rights[key][uid] = append(m.rights[key][uid], rights...)
+}
+
+func (m *mockRights) list(ctx context.Context, uid string) *ttnpb.Rights {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	res := &ttnpb.Rights{}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md["authorization"]) == 0 {
+		return res
+	}
+	var key string
+	if n, _ := fmt.Sscanf(md["authorization"][0], "Bearer %s", &key); n != 1 {
+		return res
+	}
+	res.Rights = append(res.Rights, m.rights[key][uid]...)
+	return res
+}
+
+func (m *mockRights) require(ctx context.Context, uid string, right ttnpb.Right) error {
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md["authorization"]) > 0 &&
+		strings.HasPrefix(md["authorization"][0], clusterauth.AuthType+" ") {
+		return nil
+	}
+	if !m.list(ctx, uid).IncludesAll(right) {
+		return errPermissionDenied.New()
+	}
+	return nil
+}
+
+type mockApplicationAccess struct {
+	ttnpb.ApplicationAccessServer
+	*mockRights
+}
+
+func (m *mockApplicationAccess) ListRights(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*ttnpb.Rights, error) {
+	return m.list(ctx, unique.ID(ctx, ids)), nil
+}
+
+type mockGatewayAccess struct {
+	ttnpb.GatewayAccessServer
+	*mockRights
+}
+
+func (m *mockGatewayAccess) ListRights(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*ttnpb.Rights, error) {
+	return m.list(ctx, unique.ID(ctx, ids)), nil
+}
+
+type mockUserAccess struct {
+	ttnpb.UserAccessServer
+	*mockRights
+}
+
+func (m *mockUserAccess) ListRights(ctx context.Context, ids *ttnpb.UserIdentifiers) (*ttnpb.Rights, error) {
+	return m.list(ctx, unique.ID(ctx, ids)), nil
+}
+
+// mockDevices is an end device registry of the Identity Server or Join Server.
+type mockDevices struct {
+	ttnpb.EndDeviceRegistryServer
+	*mockRights
+
+	mu      sync.Mutex
+	devices map[string]*ttnpb.EndDevice
+}
+
+func (m *mockDevices) add(dev *ttnpb.EndDevice) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.devices == nil {
+		m.devices = make(map[string]*ttnpb.EndDevice)
+	}
+	m.devices[unique.ID(context.Background(), dev.EndDeviceIdentifiers)] = dev
+}
+
+func (m *mockDevices) get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDevice, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	dev, ok := m.devices[unique.ID(ctx, ids)]
+	return dev, ok
+}
+
+func (m *mockDevices) GetIdentifiersForEUIs(ctx context.Context, req *ttnpb.GetEndDeviceIdentifiersForEUIsRequest) (*ttnpb.EndDeviceIdentifiers, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, dev := range m.devices {
+		if dev.JoinEui != nil && dev.DevEui != nil && dev.JoinEui.Equal(req.JoinEui) && dev.DevEui.Equal(req.DevEui) {
+			return &dev.EndDeviceIdentifiers, nil
+		}
+	}
+	return nil, errNotFound.New()
+}
+
+func (m *mockDevices) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	if err := m.require(ctx, unique.ID(ctx, req.ApplicationIdentifiers), ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	dev, ok := m.get(ctx, req.EndDeviceIdentifiers)
+	if !ok {
+		return nil, errNotFound.New()
+	}
+	return dev, nil
+}
+
+func (m *mockDevices) Create(ctx context.Context, req *ttnpb.CreateEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	if err := m.require(ctx, unique.ID(ctx, req.ApplicationIdentifiers), ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if _, ok := m.get(ctx, req.EndDeviceIdentifiers); ok {
+		return nil, errAlreadyExists.New()
+	}
+	m.add(&req.EndDevice)
+	return &req.EndDevice, nil
+}
+
+func (m *mockDevices) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	if err := m.require(ctx, unique.ID(ctx, req.EndDevice.ApplicationIdentifiers), ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	m.add(&req.EndDevice)
+	return &req.EndDevice, nil
+}
+
+func (m *mockDevices) Delete(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
+	if err := m.require(ctx, unique.ID(ctx, ids.ApplicationIdentifiers), ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	uid := unique.ID(ctx, ids)
+	if _, ok := m.devices[uid]; !ok {
+		return nil, errNotFound.New()
+	}
+	delete(m.devices, uid)
+	return ttnpb.Empty, nil
+}
+
+type mockJsDevices struct {
+	ttnpb.JsEndDeviceRegistryServer
+	*mockDevices
+}
+
+func (m *mockJsDevices) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	return m.mockDevices.Get(ctx, req)
+}
+
+func (m *mockJsDevices) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	return m.mockDevices.Set(ctx, req)
+}
+
+func (m *mockJsDevices) Delete(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
+	return m.mockDevices.Delete(ctx, ids)
+}
+
+// mockGateways is a gateway registry of the Identity Server.
+type mockGateways struct {
+	ttnpb.GatewayRegistryServer
+	*mockRights
+
+	mu       sync.Mutex
+	gateways map[string]*ttnpb.Gateway
+	deleted  map[string]*ttnpb.Gateway
+}
+
+func (m *mockGateways) add(gtw *ttnpb.Gateway) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.gateways == nil {
+		m.gateways = make(map[string]*ttnpb.Gateway)
+		m.deleted = make(map[string]*ttnpb.Gateway)
+	}
+	m.gateways[unique.ID(context.Background(), gtw.GetIds())] = gtw
+}
+
+func (m *mockGateways) get(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*ttnpb.Gateway, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	gtw, ok := m.gateways[unique.ID(ctx, ids)]
+	return gtw, ok
+}
+
+func (m *mockGateways) GetIdentifiersForEUI(ctx context.Context, req *ttnpb.GetGatewayIdentifiersForEUIRequest) (*ttnpb.GatewayIdentifiers, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, gtw := range m.gateways {
+		if gtw.GetIds().GetEui() != nil && gtw.Ids.Eui.Equal(*req.Eui) {
+			return &ttnpb.GatewayIdentifiers{GatewayId: gtw.Ids.GatewayId, Eui: gtw.Ids.Eui}, nil
+		}
+	}
+	return nil, errNotFound.New()
+}
+
+func (m *mockGateways) Get(ctx context.Context, req *ttnpb.GetGatewayRequest) (*ttnpb.Gateway, error) {
+	if err := m.require(ctx, unique.ID(ctx, req.GatewayIds), ttnpb.RIGHT_GATEWAY_READ_SECRETS); err != nil {
+		return nil, err
+	}
+	gtw, ok := m.get(ctx, req.GatewayIds)
+	if !ok {
+		return nil, errNotFound.New()
+	}
+	return gtw, nil
+}
+
+func (m *mockGateways) Create(ctx context.Context, req *ttnpb.CreateGatewayRequest) (*ttnpb.Gateway, error) {
+	if err := m.require(ctx, unique.ID(ctx, req.Collaborator.GetUserIds()), ttnpb.RIGHT_USER_GATEWAYS_CREATE); err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	for _, gtw := range m.gateways {
+		if gtw.GetIds().GetEui() != nil && gtw.Ids.Eui.Equal(*req.Gateway.Ids.Eui) {
+			m.mu.Unlock()
+			return nil, errAlreadyExists.New()
+		}
+	}
+	m.mu.Unlock()
+	m.add(req.Gateway)
+	return req.Gateway, nil
+}
+
+func (m *mockGateways) Update(ctx context.Context, req *ttnpb.UpdateGatewayRequest) (*ttnpb.Gateway, error) {
+	if err := m.require(ctx, unique.ID(ctx, req.Gateway.GetIds()), ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	gtw, ok := m.get(ctx, req.Gateway.GetIds())
+	if !ok {
+		return nil, errNotFound.New()
+	}
+	if err := gtw.SetFields(req.Gateway, req.FieldMask.GetPaths()...); err != nil {
+		return nil, err
+	}
+	return gtw, nil
+}
+
+func (m *mockGateways) Delete(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*pbtypes.Empty, error) {
+	if err := m.require(ctx, unique.ID(ctx, ids), ttnpb.RIGHT_GATEWAY_DELETE); err != nil {
+		return nil, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	uid := unique.ID(ctx, ids)
+	gtw, ok := m.gateways[uid]
+	if !ok {
+		return nil, errNotFound.New()
+	}
+	m.deleted[uid] = gtw
+	delete(m.gateways, uid)
+	return ttnpb.Empty, nil
+}
+
+type mockIS struct {
+	*mockRights
+	devices  *mockDevices
+	gateways *mockGateways
+}
+
+func startMockIS(ctx context.Context) (*mockIS, string) {
+	rights := &mockRights{}
+	is := &mockIS{
+		mockRights: rights,
+		devices:    &mockDevices{mockRights: rights},
+		gateways:   &mockGateways{mockRights: rights},
+	}
+	return is, startServer(ctx, func(srv *rpcserver.Server) {
+		ttnpb.RegisterApplicationAccessServer(srv.Server, &mockApplicationAccess{mockRights: rights})
+		ttnpb.RegisterGatewayAccessServer(srv.Server, &mockGatewayAccess{mockRights: rights})
+		ttnpb.RegisterUserAccessServer(srv.Server, &mockUserAccess{mockRights: rights})
+		ttnpb.RegisterEndDeviceRegistryServer(srv.Server, is.devices)
+		ttnpb.RegisterGatewayRegistryServer(srv.Server, is.gateways)
+	})
+}
+
+func startMockJS(ctx context.Context, rights *mockRights) (*mockJsDevices, string) {
+	js := &mockJsDevices{
+		mockDevices: &mockDevices{mockRights: rights},
+	}
+	return js, startServer(ctx, func(srv *rpcserver.Server) {
+		ttnpb.RegisterJsEndDeviceRegistryServer(srv.Server, js)
+	})
+}
@@ -0,0 +1,282 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package devicemigration implements encrypted bundles to migrate end devices with their sessions between deployments.
+package devicemigration
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mohae/deepcopy"
+	ttncrypto "go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+// KEKLabel is the KEK label of the keys that are wrapped with the bundle key encryption key.
+const KEKLabel = "migration"
+
+var (
+	// NetworkServerPaths are the end device fields in a bundle that are stored by the Network Server.
+	NetworkServerPaths = []string{
+		"frequency_plan_id",
+		"lorawan_phy_version",
+		"lorawan_version",
+		"mac_settings",
+		"mac_state",
+		"multicast",
+		"session.dev_addr",
+		"session.keys.f_nwk_s_int_key.key",
+		"session.keys.nwk_s_enc_key.key",
+		"session.keys.s_nwk_s_int_key.key",
+		"session.keys.session_key_id",
+		"session.last_conf_f_cnt_down",
+		"session.last_f_cnt_up",
+		"session.last_n_f_cnt_down",
+		"session.started_at",
+		"supports_class_b",
+		"supports_class_c",
+		"supports_join",
+	}
+	// ApplicationServerPaths are the end device fields in a bundle that are stored by the Application Server.
+	ApplicationServerPaths = []string{
+		"session.dev_addr",
+		"session.keys.app_s_key.key",
+		"session.keys.session_key_id",
+		"session.last_a_f_cnt_down",
+		"skip_payload_crypto",
+	}
+	// JoinServerPaths are the end device fields in a bundle that are stored by the Join Server.
+	// These fields are only used for end devices that support join.
+	JoinServerPaths = []string{
+		"last_dev_nonce",
+		"last_join_nonce",
+		"last_rj_count_0",
+		"last_rj_count_1",
+		"net_id",
+		"resets_join_nonces",
+		"root_keys.app_key.key",
+		"root_keys.nwk_key.key",
+		"root_keys.root_key_id",
+		"used_dev_nonces",
+	}
+)
+
+var (
+	errRecipientKey = errors.DefineInvalidArgument("recipient_key", "unsupported recipient key type `{type}`")
+	errEncryptKEK   = errors.DefineInternal("encrypt_kek", "encrypt bundle key encryption key")
+	errDecryptKEK   = errors.DefinePermissionDenied("decrypt_kek", "decrypt bundle key encryption key")
+	errPlaintextKey = errors.DefineInvalidArgument("plaintext_key", "key of end device `{device_uid}` is not in plaintext")
+	errKEKLabel     = errors.DefineInvalidArgument("kek_label", "key of end device `{device_uid}` has invalid KEK label `{kek_label}`")
+	errWrapKey      = errors.DefineInvalidArgument("wrap_key", "wrap key of end device `{device_uid}`")
+	errUnwrapKey    = errors.DefineInvalidArgument("unwrap_key", "unwrap key of end device `{device_uid}`")
+	errDecodeDevice = errors.DefineInvalidArgument("decode_device", "decode end device")
+	errMissingKEK   = errors.DefineInvalidArgument("missing_kek", "missing bundle key encryption key")
+)
+
+// Bundle is a migration bundle of end devices.
+// The keys of the end devices are wrapped with the bundle key encryption key, which is encrypted for the recipient.
+type Bundle struct {
+	// KEK is the bundle key encryption key, encrypted for the recipient as JSON Web Encryption in compact serialization.
+	KEK string
+	// EndDevices are the end devices with their sessions, MAC state and frame counters.
+	EndDevices []*ttnpb.EndDevice
+}
+
+type jsonBundle struct {
+	KEK        string            `json:"kek"`
+	EndDevices []json.RawMessage `json:"end_devices"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b Bundle) MarshalJSON() ([]byte, error) {
+	res := jsonBundle{
+		KEK:        b.KEK,
+		EndDevices: make([]json.RawMessage, 0, len(b.EndDevices)),
+	}
+	for _, dev := range b.EndDevices {
+		buf, err := jsonpb.TTN().Marshal(dev)
+		if err != nil {
+			return nil, err
+		}
+		res.EndDevices = append(res.EndDevices, buf)
+	}
+	return json.Marshal(res)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *Bundle) UnmarshalJSON(data []byte) error {
+	var res jsonBundle
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+	devs := make([]*ttnpb.EndDevice, 0, len(res.EndDevices))
+	for _, buf := range res.EndDevices {
+		dev := &ttnpb.EndDevice{}
+		if err := jsonpb.TTN().Unmarshal(buf, dev); err != nil {
+			return errDecodeDevice.WithCause(err)
+		}
+		devs = append(devs, dev)
+	}
+	*b = Bundle{
+		KEK:        res.KEK,
+		EndDevices: devs,
+	}
+	return nil
+}
+
+// keyEnvelopes returns the non-nil key envelopes of the end device.
+func keyEnvelopes(dev *ttnpb.EndDevice) []*ttnpb.KeyEnvelope {
+	var envs []*ttnpb.KeyEnvelope
+	appendSessionKeys := func(keys *ttnpb.SessionKeys) {
+		envs = append(envs, keys.FNwkSIntKey, keys.SNwkSIntKey, keys.NwkSEncKey, keys.AppSKey)
+	}
+	if dev.RootKeys != nil {
+		envs = append(envs, dev.RootKeys.AppKey, dev.RootKeys.NwkKey)
+	}
+	if dev.Session != nil {
+		appendSessionKeys(&dev.Session.SessionKeys)
+	}
+	if dev.PendingSession != nil {
+		appendSessionKeys(&dev.PendingSession.SessionKeys)
+	}
+	if dev.MacState != nil && dev.MacState.QueuedJoinAccept != nil {
+		appendSessionKeys(&dev.MacState.QueuedJoinAccept.Keys)
+	}
+	if dev.PendingMacState != nil && dev.PendingMacState.QueuedJoinAccept != nil {
+		appendSessionKeys(&dev.PendingMacState.QueuedJoinAccept.Keys)
+	}
+	res := envs[:0]
+	for _, env := range envs {
+		if env != nil {
+			res = append(res, env)
+		}
+	}
+	return res
+}
+
+// Seal returns a new bundle with the given end devices for the recipient.
+// The keys of the end devices must be in plaintext. The given end devices are not modified.
+// Supported recipient keys are ECDSA and RSA public keys.
+func Seal(ctx context.Context, devs []*ttnpb.EndDevice, recipient crypto.PublicKey) (*Bundle, error) {
+	var alg jose.KeyAlgorithm
+	switch recipient.(type) {
+	case *ecdsa.PublicKey:
+		alg = jose.ECDH_ES_A256KW
+	case *rsa.PublicKey:
+		alg = jose.RSA_OAEP_256
+	default:
+		return nil, errRecipientKey.WithAttributes("type", fmt.Sprintf("%T", recipient))
+	}
+	kek := make([]byte, 32)
+	if _, err := rand.Read(kek); err != nil {
+		return nil, errEncryptKEK.WithCause(err)
+	}
+	encrypter, err := jose.NewEncrypter(jose.A256GCM, jose.Recipient{
+		Algorithm: alg,
+		Key:       recipient,
+	}, nil)
+	if err != nil {
+		return nil, errEncryptKEK.WithCause(err)
+	}
+	obj, err := encrypter.Encrypt(kek)
+	if err != nil {
+		return nil, errEncryptKEK.WithCause(err)
+	}
+	encryptedKEK, err := obj.CompactSerialize()
+	if err != nil {
+		return nil, errEncryptKEK.WithCause(err)
+	}
+
+	res := make([]*ttnpb.EndDevice, 0, len(devs))
+	for _, dev := range devs {
+		dev = deepcopy.Copy(dev).(*ttnpb.EndDevice)
+		for _, env := range keyEnvelopes(dev) {
+			if env.Key == nil {
+				if len(env.EncryptedKey) > 0 {
+					return nil, errPlaintextKey.WithAttributes("device_uid", unique.ID(ctx, dev.EndDeviceIdentifiers))
+				}
+				continue
+			}
+			encryptedKey, err := ttncrypto.WrapKey(env.Key[:], kek)
+			if err != nil {
+				return nil, errWrapKey.WithAttributes("device_uid", unique.ID(ctx, dev.EndDeviceIdentifiers)).WithCause(err)
+			}
+			*env = ttnpb.KeyEnvelope{
+				KekLabel:     KEKLabel,
+				EncryptedKey: encryptedKey,
+			}
+		}
+		res = append(res, dev)
+	}
+	return &Bundle{
+		KEK:        encryptedKEK,
+		EndDevices: res,
+	}, nil
+}
+
+// Open returns the end devices in the bundle with their keys in plaintext, using the private key of the recipient.
+func (b *Bundle) Open(ctx context.Context, recipient crypto.PrivateKey) ([]*ttnpb.EndDevice, error) {
+	if b.KEK == "" {
+		return nil, errMissingKEK.New()
+	}
+	obj, err := jose.ParseEncrypted(b.KEK)
+	if err != nil {
+		return nil, errDecryptKEK.WithCause(err)
+	}
+	kek, err := obj.Decrypt(recipient)
+	if err != nil {
+		return nil, errDecryptKEK.WithCause(err)
+	}
+
+	res := make([]*ttnpb.EndDevice, 0, len(b.EndDevices))
+	for _, dev := range b.EndDevices {
+		dev = deepcopy.Copy(dev).(*ttnpb.EndDevice)
+		for _, env := range keyEnvelopes(dev) {
+			if env.Key != nil || len(env.EncryptedKey) == 0 {
+				continue
+			}
+			if env.KekLabel != KEKLabel {
+				return nil, errKEKLabel.WithAttributes(
+					"device_uid", unique.ID(ctx, dev.EndDeviceIdentifiers),
+					"kek_label", env.KekLabel,
+				)
+			}
+			keyBytes, err := ttncrypto.UnwrapKey(env.EncryptedKey, kek)
+			if err != nil {
+				return nil, errUnwrapKey.WithAttributes("device_uid", unique.ID(ctx, dev.EndDeviceIdentifiers)).WithCause(err)
+			}
+			if len(keyBytes) != len(types.AES128Key{}) {
+				return nil, errUnwrapKey.WithAttributes("device_uid", unique.ID(ctx, dev.EndDeviceIdentifiers))
+			}
+			var key types.AES128Key
+			copy(key[:], keyBytes)
+			*env = ttnpb.KeyEnvelope{
+				Key: &key,
+			}
+		}
+		res = append(res, dev)
+	}
+	return res, nil
+}
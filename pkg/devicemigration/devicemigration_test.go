@@ -0,0 +1,332 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicemigration_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/devicemigration"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+func TestPaths(t *testing.T) {
+	for _, tc := range []struct {
+		Name    string
+		Paths   []string
+		Service string
+	}{
+		{
+			Name:    "NetworkServer",
+			Paths:   NetworkServerPaths,
+			Service: "NsEndDeviceRegistry",
+		},
+		{
+			Name:    "ApplicationServer",
+			Paths:   ApplicationServerPaths,
+			Service: "AsEndDeviceRegistry",
+		},
+		{
+			Name:    "JoinServer",
+			Paths:   JoinServerPaths,
+			Service: "JsEndDeviceRegistry",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			for _, method := range []string{"Get", "Set"} {
+				allowed := ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3."+tc.Service+"/"+method].Allowed
+				a.So(ttnpb.AllowedFields(tc.Paths, allowed), should.Resemble, tc.Paths)
+			}
+		})
+	}
+}
+
+func testEndDevice() *ttnpb.EndDevice {
+	devEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	joinEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x00}
+	return &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+			DeviceId:               "test-dev",
+			DevEui:                 &devEUI,
+			JoinEui:                &joinEUI,
+		},
+		LorawanVersion:    ttnpb.MAC_V1_0_3,
+		LorawanPhyVersion: ttnpb.RP001_V1_0_3_REV_A,
+		FrequencyPlanId:   "EU_863_870",
+		SupportsJoin:      true,
+		RootKeys: &ttnpb.RootKeys{
+			AppKey: &ttnpb.KeyEnvelope{Key: &types.AES128Key{0x01}},
+		},
+		Session: &ttnpb.Session{
+			DevAddr: types.DevAddr{0x26, 0x01, 0x02, 0x03},
+			SessionKeys: ttnpb.SessionKeys{
+				SessionKeyId: []byte{0x01, 0x02},
+				FNwkSIntKey:  &ttnpb.KeyEnvelope{Key: &types.AES128Key{0x02}},
+				SNwkSIntKey:  &ttnpb.KeyEnvelope{Key: &types.AES128Key{0x02}},
+				NwkSEncKey:   &ttnpb.KeyEnvelope{Key: &types.AES128Key{0x02}},
+				AppSKey:      &ttnpb.KeyEnvelope{Key: &types.AES128Key{0x03}},
+			},
+			LastFCntUp:    42,
+			LastNFCntDown: 12,
+			LastAFCntDown: 13,
+			StartedAt:     time.Unix(1600000000, 0).UTC(),
+		},
+		MacState: &ttnpb.MACState{
+			LorawanVersion:     ttnpb.MAC_V1_0_3,
+			RxWindowsAvailable: true,
+		},
+	}
+}
+
+func TestBundle(t *testing.T) {
+	ctx := test.Context()
+
+	ecdsaKey := test.Must(ecdsa.GenerateKey(elliptic.P256(), rand.Reader)).(*ecdsa.PrivateKey)
+	rsaKey := test.Must(rsa.GenerateKey(rand.Reader, 2048)).(*rsa.PrivateKey)
+	otherKey := test.Must(ecdsa.GenerateKey(elliptic.P256(), rand.Reader)).(*ecdsa.PrivateKey)
+
+	for _, tc := range []struct {
+		Name string
+		Key  crypto.Signer
+	}{
+		{
+			Name: "ECDSA",
+			Key:  ecdsaKey,
+		},
+		{
+			Name: "RSA",
+			Key:  rsaKey,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			dev := testEndDevice()
+			bundle, err := Seal(ctx, []*ttnpb.EndDevice{dev}, tc.Key.Public())
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(dev, should.Resemble, testEndDevice())
+			if !a.So(bundle.EndDevices, should.HaveLength, 1) {
+				t.FailNow()
+			}
+			for _, env := range []*ttnpb.KeyEnvelope{
+				bundle.EndDevices[0].RootKeys.AppKey,
+				bundle.EndDevices[0].Session.FNwkSIntKey,
+				bundle.EndDevices[0].Session.AppSKey,
+			} {
+				a.So(env.Key, should.BeNil)
+				a.So(env.KekLabel, should.Equal, KEKLabel)
+				a.So(env.EncryptedKey, should.NotBeEmpty)
+			}
+
+			buf, err := json.Marshal(bundle)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			var decoded Bundle
+			if !a.So(json.Unmarshal(buf, &decoded), should.BeNil) {
+				t.FailNow()
+			}
+
+			_, err = decoded.Open(ctx, otherKey)
+			a.So(err, should.NotBeNil)
+
+			devs, err := decoded.Open(ctx, tc.Key)
+			if !a.So(err, should.BeNil) || !a.So(devs, should.HaveLength, 1) {
+				t.FailNow()
+			}
+			a.So(devs[0], should.Resemble, testEndDevice())
+		})
+	}
+
+	t.Run("EncryptedKey", func(t *testing.T) {
+		a := assertions.New(t)
+
+		dev := testEndDevice()
+		dev.Session.AppSKey = &ttnpb.KeyEnvelope{
+			KekLabel:     "as",
+			EncryptedKey: []byte{0x01, 0x02, 0x03},
+		}
+		_, err := Seal(ctx, []*ttnpb.EndDevice{dev}, ecdsaKey.Public())
+		a.So(err, should.NotBeNil)
+	})
+
+	t.Run("UnsupportedKey", func(t *testing.T) {
+		a := assertions.New(t)
+
+		_, err := Seal(ctx, []*ttnpb.EndDevice{testEndDevice()}, []byte{0x01})
+		a.So(err, should.NotBeNil)
+	})
+}
+
+func TestSignedBundle(t *testing.T) {
+	ctx := test.Context()
+
+	recipientKey := test.Must(ecdsa.GenerateKey(elliptic.P256(), rand.Reader)).(*ecdsa.PrivateKey)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey := test.Must(rsa.GenerateKey(rand.Reader, 2048)).(*rsa.PrivateKey)
+	otherKey := test.Must(ecdsa.GenerateKey(elliptic.P256(), rand.Reader)).(*ecdsa.PrivateKey)
+
+	bundle, err := Seal(ctx, []*ttnpb.EndDevice{testEndDevice()}, recipientKey.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		Name string
+		Key  crypto.Signer
+	}{
+		{
+			Name: "ECDSA",
+			Key:  test.Must(ecdsa.GenerateKey(elliptic.P384(), rand.Reader)).(*ecdsa.PrivateKey),
+		},
+		{
+			Name: "RSA",
+			Key:  rsaKey,
+		},
+		{
+			Name: "Ed25519",
+			Key:  ed25519Key,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			signed, err := bundle.Sign(tc.Key)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			buf, err := json.Marshal(signed)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			var decoded SignedBundle
+			if !a.So(json.Unmarshal(buf, &decoded), should.BeNil) {
+				t.FailNow()
+			}
+
+			_, err = decoded.Verify(otherKey.Public())
+			a.So(err, should.NotBeNil)
+
+			verified, err := decoded.Verify(tc.Key.Public())
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			devs, err := verified.Open(ctx, recipientKey)
+			if a.So(err, should.BeNil) && a.So(devs, should.HaveLength, 1) {
+				a.So(devs[0], should.Resemble, testEndDevice())
+			}
+		})
+	}
+
+	t.Run("Tampered", func(t *testing.T) {
+		a := assertions.New(t)
+
+		signed, err := bundle.Sign(otherKey)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		buf, err := json.Marshal(signed)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		var jws map[string]string
+		if !a.So(json.Unmarshal(buf, &jws), should.BeNil) {
+			t.FailNow()
+		}
+		jws["payload"] = base64.RawURLEncoding.EncodeToString([]byte(`{"kek":"","end_devices":[]}`))
+		buf, err = json.Marshal(jws)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		var decoded SignedBundle
+		if !a.So(json.Unmarshal(buf, &decoded), should.BeNil) {
+			t.FailNow()
+		}
+		_, err = decoded.Verify(otherKey.Public())
+		a.So(err, should.NotBeNil)
+	})
+
+	t.Run("UnsupportedAlgorithm", func(t *testing.T) {
+		a := assertions.New(t)
+
+		signer := test.Must(jose.NewSigner(jose.SigningKey{
+			Algorithm: jose.RS256,
+			Key:       rsaKey,
+		}, nil)).(jose.Signer)
+		jws := test.Must(signer.Sign(test.Must(json.Marshal(bundle)).([]byte))).(*jose.JSONWebSignature)
+		var decoded SignedBundle
+		if !a.So(json.Unmarshal([]byte(jws.FullSerialize()), &decoded), should.BeNil) {
+			t.FailNow()
+		}
+		_, err := decoded.Verify(rsaKey.Public())
+		a.So(err, should.NotBeNil)
+	})
+
+	t.Run("UnsupportedKey", func(t *testing.T) {
+		a := assertions.New(t)
+
+		_, err := bundle.Sign([]byte{0x01})
+		a.So(err, should.NotBeNil)
+	})
+}
+
+func TestParseKeys(t *testing.T) {
+	a := assertions.New(t)
+
+	key := test.Must(ecdsa.GenerateKey(elliptic.P256(), rand.Reader)).(*ecdsa.PrivateKey)
+
+	publicKeyDER := test.Must(x509.MarshalPKIXPublicKey(key.Public())).([]byte)
+	publicKey, err := ParsePublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER}))
+	a.So(err, should.BeNil)
+	a.So(publicKey, should.Resemble, key.Public())
+
+	privateKeyDER := test.Must(x509.MarshalECPrivateKey(key)).([]byte)
+	privateKey, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privateKeyDER}))
+	a.So(err, should.BeNil)
+	a.So(privateKey, should.Resemble, key)
+
+	pkcs8DER := test.Must(x509.MarshalPKCS8PrivateKey(key)).([]byte)
+	privateKey, err = ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8DER}))
+	a.So(err, should.BeNil)
+	a.So(privateKey, should.Resemble, key)
+
+	_, err = ParsePublicKey([]byte("garbage"))
+	a.So(err, should.NotBeNil)
+
+	_, err = ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER}))
+	a.So(err, should.NotBeNil)
+}
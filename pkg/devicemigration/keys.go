@@ -0,0 +1,79 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicemigration
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+var (
+	errPEM          = errors.DefineInvalidArgument("pem", "invalid PEM data")
+	errPEMBlockType = errors.DefineInvalidArgument("pem_block_type", "unsupported PEM block type `{type}`")
+	errParseKey     = errors.DefineInvalidArgument("parse_key", "parse key")
+)
+
+// ParsePublicKey parses the PEM encoded public key or certificate of a recipient or sender.
+func ParsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errPEM.New()
+	}
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, errParseKey.WithCause(err)
+		}
+		return key, nil
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errParseKey.WithCause(err)
+		}
+		return cert.PublicKey, nil
+	default:
+		return nil, errPEMBlockType.WithAttributes("type", block.Type)
+	}
+}
+
+// ParsePrivateKey parses the PEM encoded private key of a recipient or sender.
+func ParsePrivateKey(data []byte) (crypto.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errPEM.New()
+	}
+	var (
+		key crypto.PrivateKey
+		err error
+	)
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return nil, errPEMBlockType.WithAttributes("type", block.Type)
+	}
+	if err != nil {
+		return nil, errParseKey.WithCause(err)
+	}
+	return key, nil
+}
@@ -0,0 +1,139 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicemigration
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+var (
+	errSenderKey          = errors.DefineInvalidArgument("sender_key", "unsupported sender key type `{type}`")
+	errSign               = errors.DefineInternal("sign", "sign bundle")
+	errSignature          = errors.DefinePermissionDenied("signature", "invalid bundle signature")
+	errSignatureAlgorithm = errors.DefinePermissionDenied("signature_algorithm", "unsupported bundle signature algorithm `{algorithm}`")
+	errDecodeBundle       = errors.DefineInvalidArgument("decode_bundle", "decode bundle")
+)
+
+// signatureAlgorithms are the supported JWS algorithms of signed bundles.
+var signatureAlgorithms = map[jose.SignatureAlgorithm]struct{}{
+	jose.EdDSA: {},
+	jose.ES256: {},
+	jose.ES384: {},
+	jose.ES512: {},
+	jose.PS256: {},
+	jose.PS384: {},
+	jose.PS512: {},
+}
+
+// signatureAlgorithm returns the JWS algorithm for the private key of the sender.
+func signatureAlgorithm(sender crypto.PrivateKey) (jose.SignatureAlgorithm, error) {
+	switch key := sender.(type) {
+	case *ecdsa.PrivateKey:
+		switch key.Curve.Params().BitSize {
+		case 256:
+			return jose.ES256, nil
+		case 384:
+			return jose.ES384, nil
+		case 521:
+			return jose.ES512, nil
+		}
+	case *rsa.PrivateKey:
+		return jose.PS256, nil
+	case ed25519.PrivateKey:
+		return jose.EdDSA, nil
+	}
+	return "", errSenderKey.WithAttributes("type", fmt.Sprintf("%T", sender))
+}
+
+// SignedBundle is a migration bundle that is signed by the sender.
+// The bundle is the payload of a JSON Web Signature in JSON serialization.
+type SignedBundle struct {
+	jws *jose.JSONWebSignature
+}
+
+// Sign returns the bundle signed with the private key of the sender.
+// Supported sender keys are ECDSA, RSA and Ed25519 private keys.
+func (b *Bundle) Sign(sender crypto.PrivateKey) (*SignedBundle, error) {
+	alg, err := signatureAlgorithm(sender)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: alg,
+		Key:       sender,
+	}, nil)
+	if err != nil {
+		return nil, errSign.WithCause(err)
+	}
+	payload, err := json.Marshal(b)
+	if err != nil {
+		return nil, errSign.WithCause(err)
+	}
+	jws, err := signer.Sign(payload)
+	if err != nil {
+		return nil, errSign.WithCause(err)
+	}
+	return &SignedBundle{
+		jws: jws,
+	}, nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s SignedBundle) MarshalJSON() ([]byte, error) {
+	if s.jws == nil {
+		return []byte("null"), nil
+	}
+	return []byte(s.jws.FullSerialize()), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SignedBundle) UnmarshalJSON(data []byte) error {
+	jws, err := jose.ParseSigned(string(data))
+	if err != nil {
+		return errDecodeBundle.WithCause(err)
+	}
+	*s = SignedBundle{
+		jws: jws,
+	}
+	return nil
+}
+
+// Verify returns the bundle if it is signed with the private key of the given public key of the sender.
+func (s *SignedBundle) Verify(sender crypto.PublicKey) (*Bundle, error) {
+	if s.jws == nil || len(s.jws.Signatures) != 1 {
+		return nil, errSignature.New()
+	}
+	alg := s.jws.Signatures[0].Protected.Algorithm
+	if _, ok := signatureAlgorithms[jose.SignatureAlgorithm(alg)]; !ok {
+		return nil, errSignatureAlgorithm.WithAttributes("algorithm", alg)
+	}
+	payload, err := s.jws.Verify(sender)
+	if err != nil {
+		return nil, errSignature.WithCause(err)
+	}
+	b := &Bundle{}
+	if err := json.Unmarshal(payload, b); err != nil {
+		return nil, errDecodeBundle.WithCause(err)
+	}
+	return b, nil
+}
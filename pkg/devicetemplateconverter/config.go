@@ -17,5 +17,6 @@ package devicetemplateconverter
 
 // Config represents the DeviceTemplateConverter configuration.
 type Config struct {
-	Enabled []string `name:"enabled" description:"Enabled converters"`
+	Enabled    []string            `name:"enabled" description:"Enabled converters"`
+	VendorKeys map[string][]string `name:"vendor-keys" description:"Paths to PEM encoded vendor certificates and public keys that are trusted to sign manifests, by converter"`
 }
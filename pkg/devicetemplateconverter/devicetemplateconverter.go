@@ -17,6 +17,7 @@ package devicetemplateconverter
 
 import (
 	"context"
+	"os"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
@@ -41,7 +42,13 @@ type DeviceTemplateConverter struct {
 	}
 }
 
-var errNotFound = errors.DefineNotFound("converter", "converter `{id}` not found")
+var (
+	errNotFound               = errors.DefineNotFound("converter", "converter `{id}` not found")
+	errVendorKeysNotSupported = errors.DefineInvalidArgument("vendor_keys_not_supported", "converter `{id}` does not support vendor keys")
+	errVendorKeysNotEnabled   = errors.DefineInvalidArgument("vendor_keys_not_enabled", "vendor keys configured for converter `{id}` that is not enabled")
+	errReadVendorKeys         = errors.DefineInvalidArgument("read_vendor_keys", "read vendor keys from `{path}`")
+	errParseVendorKeys        = errors.DefineInvalidArgument("parse_vendor_keys", "parse vendor keys from `{path}`")
+)
 
 // New returns a new *DeviceTemplateConverter.
 func New(c *component.Component, conf *Config) (*DeviceTemplateConverter, error) {
@@ -54,8 +61,35 @@ func New(c *component.Component, conf *Config) (*DeviceTemplateConverter, error)
 		if converter == nil {
 			return nil, errNotFound.WithAttributes("id", id)
 		}
+		if paths := conf.VendorKeys[id]; len(paths) > 0 {
+			vkc, ok := converter.(devicetemplates.VendorKeyConverter)
+			if !ok {
+				return nil, errVendorKeysNotSupported.WithAttributes("id", id)
+			}
+			for _, path := range paths {
+				data, err := os.ReadFile(path)
+				if err != nil {
+					return nil, errReadVendorKeys.WithAttributes("path", path).WithCause(err)
+				}
+				keys, err := devicetemplates.ParseVendorKeys(data)
+				if err != nil {
+					return nil, errParseVendorKeys.WithAttributes("path", path).WithCause(err)
+				}
+				vkc = vkc.WithVendorKeys(keys)
+			}
+			converter = vkc
+		}
 		converters[id] = converter
 	}
+	for id, paths := range conf.VendorKeys {
+		if _, ok := converters[id]; ok || len(paths) == 0 {
+			continue
+		}
+		if devicetemplates.GetConverter(id) == nil {
+			return nil, errNotFound.WithAttributes("id", id)
+		}
+		return nil, errVendorKeysNotEnabled.WithAttributes("id", id)
+	}
 
 	dtc := &DeviceTemplateConverter{
 		Component:  c,
@@ -15,14 +15,24 @@
 package devicetemplateconverter_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	. "go.thethings.network/lorawan-stack/v3/pkg/devicetemplateconverter"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 )
 
 func TestDeviceTemplateConverter(t *testing.T) {
@@ -37,3 +47,73 @@ func TestDeviceTemplateConverter(t *testing.T) {
 
 	mustHavePeer(ctx, c, ttnpb.ClusterRole_DEVICE_TEMPLATE_CONVERTER)
 }
+
+func TestDeviceTemplateConverterVendorKeys(t *testing.T) {
+	key := test.Must(ecdsa.GenerateKey(elliptic.P256(), rand.Reader)).(*ecdsa.PrivateKey)
+	publicKeyDER := test.Must(x509.MarshalPKIXPublicKey(key.Public())).([]byte)
+	keyPath := filepath.Join(t.TempDir(), "vendor.pem")
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		Name       string
+		Enabled    []string
+		VendorKeys map[string][]string
+		Assertion  func(error) bool
+	}{
+		{
+			Name:    "Valid",
+			Enabled: []string{"signed-manifest"},
+			VendorKeys: map[string][]string{
+				"signed-manifest": {keyPath},
+			},
+		},
+		{
+			Name:    "NotSupported",
+			Enabled: []string{"microchip-atecc608a-tnglora"},
+			VendorKeys: map[string][]string{
+				"microchip-atecc608a-tnglora": {keyPath},
+			},
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:    "NotEnabled",
+			Enabled: []string{"microchip-atecc608a-tnglora"},
+			VendorKeys: map[string][]string{
+				"signed-manifest": {keyPath},
+			},
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:    "UnknownConverter",
+			Enabled: []string{"signed-manifest"},
+			VendorKeys: map[string][]string{
+				"signed-manifests": {keyPath},
+			},
+			Assertion: errors.IsNotFound,
+		},
+		{
+			Name:    "NotFound",
+			Enabled: []string{"signed-manifest"},
+			VendorKeys: map[string][]string{
+				"signed-manifest": {filepath.Join(t.TempDir(), "missing.pem")},
+			},
+			Assertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			c := componenttest.NewComponent(t, &component.Config{})
+			_, err := New(c, &Config{
+				Enabled:    tc.Enabled,
+				VendorKeys: tc.VendorKeys,
+			})
+			if tc.Assertion == nil {
+				a.So(err, should.BeNil)
+			} else if a.So(err, should.NotBeNil) {
+				a.So(tc.Assertion(err), should.BeTrue)
+			}
+		})
+	}
+}
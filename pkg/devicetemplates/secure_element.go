@@ -0,0 +1,328 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicetemplates
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"strings"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+// SecureElementEntry is a verified entry of a secure element manifest.
+type SecureElementEntry struct {
+	DevEUI   types.EUI64
+	JoinEUI  types.EUI64
+	UniqueID string
+	// ProvisioningData is the provisioning data of the end device.
+	// The provisioning data is stored in the end device registries and returned by the API, so it must not contain
+	// secrets, such as claim PINs, of the secure element.
+	ProvisioningData *pbtypes.Struct
+}
+
+// SecureElement is a secure element with manifests of which the entries are JSON Web Signatures signed by the vendor.
+type SecureElement interface {
+	// Format returns the format of the manifest.
+	Format() *ttnpb.EndDeviceTemplateFormat
+	// ProvisionerID returns the provisioner ID of the end devices.
+	ProvisionerID() string
+	// Entry returns the entry of the verified payload.
+	Entry(payload *pbtypes.Struct) (*SecureElementEntry, error)
+}
+
+// VendorKeyConverter is a Converter that verifies manifests with vendor public keys.
+type VendorKeyConverter interface {
+	Converter
+	// WithVendorKeys returns a VendorKeyConverter that also trusts the given vendor public keys by key ID.
+	WithVendorKeys(keys map[string]crypto.PublicKey) VendorKeyConverter
+}
+
+var (
+	errSecureElementData  = errors.DefineInvalidArgument("secure_element_data", "invalid `{format}` data")
+	errSecureElementField = errors.DefineInvalidArgument("secure_element_field", "invalid field `{field}`")
+	errVendorPublicKey    = errors.DefineInvalidArgument("vendor_public_key", "unknown vendor public key ID `{id}`")
+	errNoVendorKeys       = errors.DefineFailedPrecondition("no_vendor_keys", "no vendor public keys configured for `{format}`")
+	errVendorKeys         = errors.DefineInvalidArgument("vendor_keys", "invalid vendor public keys")
+	errVendorKeyType      = errors.DefineInvalidArgument("vendor_key_type", "unsupported vendor key PEM block type `{type}`")
+	errSignatureAlgorithm = errors.DefineInvalidArgument("signature_algorithm", "unsupported signature algorithm `{algorithm}`")
+)
+
+// secureElementAlgorithms are the supported JWS algorithms of manifest entries.
+// Only asymmetric algorithms are supported, as the manifests are verified with vendor public keys.
+// RSA PKCS #1 v1.5 signatures are not supported.
+var secureElementAlgorithms = map[jose.SignatureAlgorithm]struct{}{
+	jose.EdDSA: {},
+	jose.ES256: {},
+	jose.ES384: {},
+	jose.ES512: {},
+	jose.PS256: {},
+	jose.PS384: {},
+	jose.PS512: {},
+}
+
+type secureElementConverter struct {
+	SecureElement
+	keys map[string]crypto.PublicKey
+}
+
+// NewSecureElementConverter returns a new VendorKeyConverter for the manifests of the given secure element.
+// The manifest is a JSON array of JSON Web Signatures in compact or JSON serialization.
+// The returned converter does not trust any vendor public key; use WithVendorKeys to configure them.
+func NewSecureElementConverter(se SecureElement) VendorKeyConverter {
+	return &secureElementConverter{
+		SecureElement: se,
+	}
+}
+
+// WithVendorKeys implements VendorKeyConverter.
+func (c *secureElementConverter) WithVendorKeys(keys map[string]crypto.PublicKey) VendorKeyConverter {
+	merged := make(map[string]crypto.PublicKey, len(c.keys)+len(keys))
+	for kid, key := range c.keys {
+		merged[kid] = key
+	}
+	for kid, key := range keys {
+		merged[kid] = key
+	}
+	return &secureElementConverter{
+		SecureElement: c.SecureElement,
+		keys:          merged,
+	}
+}
+
+type secureElementJWS struct {
+	*jose.JSONWebSignature
+}
+
+func (e *secureElementJWS) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	// Entries in compact serialization are JSON strings.
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+	jws, err := jose.ParseSigned(s)
+	if err != nil {
+		return err
+	}
+	e.JSONWebSignature = jws
+	return nil
+}
+
+// Convert implements the devicetemplates.Converter interface.
+func (c *secureElementConverter) Convert(ctx context.Context, r io.Reader, ch chan<- *ttnpb.EndDeviceTemplate) error {
+	defer close(ch)
+
+	format := c.Format().Name
+	if len(c.keys) == 0 {
+		return errNoVendorKeys.WithAttributes("format", format)
+	}
+	errData := errSecureElementData.WithAttributes("format", format)
+
+	dec := json.NewDecoder(r)
+	delim, err := dec.Token()
+	if err != nil {
+		return errData.WithCause(err)
+	}
+	if delim != json.Delim('[') {
+		return errData.New()
+	}
+
+	for dec.More() {
+		var jws secureElementJWS
+		if err := dec.Decode(&jws); err != nil {
+			return errData.WithCause(err)
+		}
+		if len(jws.Signatures) != 1 {
+			return errData.New()
+		}
+		header := jws.Signatures[0].Protected
+		if _, ok := secureElementAlgorithms[jose.SignatureAlgorithm(header.Algorithm)]; !ok {
+			return errSignatureAlgorithm.WithAttributes("algorithm", header.Algorithm)
+		}
+		kid := jws.Signatures[0].Header.KeyID
+		key, ok := c.keys[kid]
+		if !ok {
+			return errVendorPublicKey.WithAttributes("id", kid)
+		}
+		buf, err := jws.Verify(key)
+		if err != nil {
+			return errData.WithCause(err)
+		}
+		m := make(map[string]interface{})
+		if err := json.Unmarshal(buf, &m); err != nil {
+			return errData.WithCause(err)
+		}
+		s, err := gogoproto.Struct(m)
+		if err != nil {
+			return errData.WithCause(err)
+		}
+		entry, err := c.Entry(s)
+		if err != nil {
+			return errData.WithCause(err)
+		}
+
+		tmpl := &ttnpb.EndDeviceTemplate{
+			EndDevice: ttnpb.EndDevice{
+				ProvisionerId:    c.ProvisionerID(),
+				ProvisioningData: entry.ProvisioningData,
+				RootKeys: &ttnpb.RootKeys{
+					RootKeyId: entry.UniqueID,
+				},
+				SupportsJoin: true,
+			},
+			FieldMask: &pbtypes.FieldMask{
+				Paths: []string{
+					"provisioner_id",
+					"provisioning_data",
+					"root_keys.root_key_id",
+					"supports_join",
+				},
+			},
+			MappingKey: entry.UniqueID,
+		}
+		if !entry.DevEUI.IsZero() {
+			devEUI := entry.DevEUI
+			tmpl.EndDevice.DeviceId = strings.ToLower(fmt.Sprintf("eui-%s", devEUI))
+			tmpl.EndDevice.DevEui = &devEUI
+			tmpl.FieldMask.Paths = append(tmpl.FieldMask.Paths, "ids.device_id", "ids.dev_eui")
+		}
+		if !entry.JoinEUI.IsZero() {
+			joinEUI := entry.JoinEUI
+			tmpl.EndDevice.JoinEui = &joinEUI
+			tmpl.FieldMask.Paths = append(tmpl.FieldMask.Paths, "ids.join_eui")
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ch <- tmpl:
+		}
+	}
+	return nil
+}
+
+// secureElementString returns the string field of the payload.
+func secureElementString(payload *pbtypes.Struct, field string, required bool) (string, error) {
+	s := payload.Fields[field].GetStringValue()
+	if s == "" && required {
+		return "", errSecureElementField.WithAttributes("field", field)
+	}
+	return s, nil
+}
+
+// secureElementEUI returns the EUI field of the payload.
+func secureElementEUI(payload *pbtypes.Struct, field string, required bool) (types.EUI64, error) {
+	var eui types.EUI64
+	s, err := secureElementString(payload, field, required)
+	if err != nil || s == "" {
+		return eui, err
+	}
+	if err := eui.UnmarshalText([]byte(s)); err != nil {
+		return eui, errSecureElementField.WithAttributes("field", field).WithCause(err)
+	}
+	return eui, nil
+}
+
+// secureElementProvisioningData returns the provisioning data with only the given fields of the payload.
+func secureElementProvisioningData(payload *pbtypes.Struct, fields ...string) *pbtypes.Struct {
+	data := &pbtypes.Struct{
+		Fields: make(map[string]*pbtypes.Value, len(fields)),
+	}
+	for _, field := range fields {
+		if v, ok := payload.Fields[field]; ok {
+			data.Fields[field] = v
+		}
+	}
+	return data
+}
+
+// VendorKeyID returns the key ID of the given DER encoded public key info.
+// The key ID is the base64 URL encoding of the SHA-1 hash of the public key, as the Subject Key Identifier of RFC 5280.
+func VendorKeyID(publicKeyInfo []byte) (string, error) {
+	var info struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(publicKeyInfo, &info); err != nil {
+		return "", errVendorKeys.WithCause(err)
+	}
+	sum := sha1.Sum(info.PublicKey.RightAlign())
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// ParseVendorKeys parses the PEM encoded certificates and public keys by key ID.
+// The key ID of certificates is the Subject Key Identifier, if present.
+// Otherwise, the key ID is determined by VendorKeyID.
+func ParseVendorKeys(data []byte) (map[string]crypto.PublicKey, error) {
+	keys := make(map[string]crypto.PublicKey)
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		var (
+			key           crypto.PublicKey
+			publicKeyInfo []byte
+			kid           string
+		)
+		switch block.Type {
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, errVendorKeys.WithCause(err)
+			}
+			key, publicKeyInfo = cert.PublicKey, cert.RawSubjectPublicKeyInfo
+			if len(cert.SubjectKeyId) > 0 {
+				kid = base64.RawURLEncoding.EncodeToString(cert.SubjectKeyId)
+			}
+		case "PUBLIC KEY":
+			var err error
+			if key, err = x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+				return nil, errVendorKeys.WithCause(err)
+			}
+			publicKeyInfo = block.Bytes
+		default:
+			return nil, errVendorKeyType.WithAttributes("type", block.Type)
+		}
+		if kid == "" {
+			var err error
+			if kid, err = VendorKeyID(publicKeyInfo); err != nil {
+				return nil, err
+			}
+		}
+		keys[kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errVendorKeys.New()
+	}
+	return keys, nil
+}
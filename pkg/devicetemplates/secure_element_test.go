@@ -0,0 +1,231 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicetemplates_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/devicetemplates"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/provisioning"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	jose "gopkg.in/square/go-jose.v2"
+)
+
+func signManifest(t *testing.T, alg jose.SignatureAlgorithm, key crypto.Signer, kid string, payloads ...interface{}) []byte {
+	t.Helper()
+	signer, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: alg,
+		Key:       key,
+	}, (&jose.SignerOptions{}).WithHeader("kid", kid))
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := make([]json.RawMessage, 0, len(payloads))
+	for i, payload := range payloads {
+		buf, err := json.Marshal(payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		jws, err := signer.Sign(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Alternate between the JSON and compact serialization.
+		var s string
+		if i%2 == 0 {
+			s = jws.FullSerialize()
+		} else {
+			compact, err := jws.CompactSerialize()
+			if err != nil {
+				t.Fatal(err)
+			}
+			s = fmt.Sprintf("%q", compact)
+		}
+		entries = append(entries, json.RawMessage(s))
+	}
+	buf, err := json.Marshal(entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf
+}
+
+func convertManifest(t *testing.T, converter Converter, data []byte) ([]*ttnpb.EndDeviceTemplate, error) {
+	t.Helper()
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ch := make(chan *ttnpb.EndDeviceTemplate)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- converter.Convert(ctx, bytes.NewReader(data), ch)
+	}()
+	var res []*ttnpb.EndDeviceTemplate
+	for tmpl := range ch {
+		res = append(res, tmpl)
+	}
+	return res, <-errCh
+}
+
+func TestParseVendorKeys(t *testing.T) {
+	a := assertions.New(t)
+
+	key := test.Must(ecdsa.GenerateKey(elliptic.P256(), rand.Reader)).(*ecdsa.PrivateKey)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "Vendor Signer"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		SubjectKeyId: []byte{0x01, 0x02, 0x03, 0x04},
+	}
+	certDER := test.Must(x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)).([]byte)
+	publicKeyDER := test.Must(x509.MarshalPKIXPublicKey(key.Public())).([]byte)
+
+	data := append(
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER})...,
+	)
+	keys, err := ParseVendorKeys(data)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	kid, err := VendorKeyID(publicKeyDER)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(keys, should.HaveLength, 2)
+	a.So(keys, should.ContainKey, base64.RawURLEncoding.EncodeToString(tmpl.SubjectKeyId))
+	a.So(keys, should.ContainKey, kid)
+
+	_, err = ParseVendorKeys([]byte("garbage"))
+	a.So(err, should.NotBeNil)
+
+	_, err = ParseVendorKeys(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte{0x01}}))
+	a.So(err, should.NotBeNil)
+}
+
+func TestSignedManifestConverter(t *testing.T) {
+	a := assertions.New(t)
+
+	key := test.Must(ecdsa.GenerateKey(elliptic.P256(), rand.Reader)).(*ecdsa.PrivateKey)
+	otherKey := test.Must(ecdsa.GenerateKey(elliptic.P256(), rand.Reader)).(*ecdsa.PrivateKey)
+	rsaKey := test.Must(rsa.GenerateKey(rand.Reader, 2048)).(*rsa.PrivateKey)
+	keys := map[string]crypto.PublicKey{
+		"vendor": key.Public(),
+		"rsa":    rsaKey.Public(),
+	}
+
+	devEUI := types.EUI64{0x00, 0x04, 0xa3, 0x10, 0x00, 0x1a, 0xa9, 0x0a}
+	joinEUI := types.EUI64{0x00, 0x16, 0xc0, 0x01, 0xff, 0xfe, 0x00, 0x01}
+	payload := map[string]interface{}{
+		"uniqueId": "abcd",
+		"devEui":   "0004a310001aa90a",
+		"joinEui":  "0016c001fffe0001",
+		"pin":      "1A2B3C4D",
+	}
+
+	converter, ok := GetConverter("signed-manifest").(VendorKeyConverter)
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(converter.Format().Name, should.Equal, "Signed JSON Manifest File")
+
+	data := signManifest(t, jose.ES256, key, "vendor", payload, payload)
+
+	// No vendor keys configured.
+	_, err := convertManifest(t, converter, data)
+	a.So(err, should.NotBeNil)
+
+	converter = converter.WithVendorKeys(keys)
+
+	templates, err := convertManifest(t, converter, data)
+	if !a.So(err, should.BeNil) || !a.So(templates, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	for _, tmpl := range templates {
+		a.So(tmpl.EndDevice.ProvisionerId, should.Equal, provisioning.SignedManifest)
+		a.So(tmpl.EndDevice.ProvisioningData.Fields, should.HaveLength, 3)
+		a.So(tmpl.EndDevice.ProvisioningData.Fields, should.NotContainKey, "pin")
+		a.So(tmpl.EndDevice.RootKeys.GetRootKeyId(), should.Equal, "abcd")
+		a.So(tmpl.EndDevice.SupportsJoin, should.BeTrue)
+		a.So(tmpl.EndDevice.DevEui, should.Resemble, &devEUI)
+		a.So(tmpl.EndDevice.JoinEui, should.Resemble, &joinEUI)
+		a.So(tmpl.EndDevice.DeviceId, should.Equal, "eui-0004a310001aa90a")
+		a.So(tmpl.FieldMask.Paths, should.Contain, "ids.dev_eui")
+		a.So(tmpl.MappingKey, should.Equal, "abcd")
+
+		uniqueID, err := provisioning.Get(provisioning.SignedManifest).UniqueID(tmpl.EndDevice.ProvisioningData)
+		a.So(err, should.BeNil)
+		a.So(uniqueID, should.Equal, "ABCD")
+	}
+
+	// Optional EUIs.
+	templates, err = convertManifest(t, converter, signManifest(t, jose.ES256, key, "vendor", map[string]interface{}{
+		"uniqueId": "abcd",
+	}))
+	if a.So(err, should.BeNil) && a.So(templates, should.HaveLength, 1) {
+		a.So(templates[0].EndDevice.DevEui, should.BeNil)
+		a.So(templates[0].EndDevice.JoinEui, should.BeNil)
+		a.So(templates[0].EndDevice.ProvisioningData.Fields, should.HaveLength, 1)
+	}
+
+	// RSA-PSS signature.
+	_, err = convertManifest(t, converter, signManifest(t, jose.PS256, rsaKey, "rsa", payload))
+	a.So(err, should.BeNil)
+
+	// RSA PKCS #1 v1.5 signature.
+	_, err = convertManifest(t, converter, signManifest(t, jose.RS256, rsaKey, "rsa", payload))
+	a.So(err, should.HaveSameErrorDefinitionAs, ErrSignatureAlgorithm)
+
+	// Unknown key ID.
+	_, err = convertManifest(t, converter, signManifest(t, jose.ES256, key, "other", payload))
+	a.So(err, should.NotBeNil)
+
+	// Invalid signature.
+	_, err = convertManifest(t, converter, signManifest(t, jose.ES256, otherKey, "vendor", payload))
+	a.So(err, should.NotBeNil)
+
+	// Invalid payload.
+	_, err = convertManifest(t, converter, signManifest(t, jose.ES256, key, "vendor", map[string]interface{}{
+		"devEui": "0004a310001aa90a",
+	}))
+	a.So(err, should.NotBeNil)
+	_, err = convertManifest(t, converter, signManifest(t, jose.ES256, key, "vendor", map[string]interface{}{
+		"uniqueId": "abcd",
+		"devEui":   "garbage",
+	}))
+	a.So(err, should.NotBeNil)
+
+	// Garbage.
+	_, err = convertManifest(t, converter, []byte(`garbage`))
+	a.So(err, should.NotBeNil)
+}
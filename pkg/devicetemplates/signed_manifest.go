@@ -0,0 +1,63 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicetemplates
+
+import (
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/provisioning"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// signedManifest is a generic device provisioner for JSON manifests signed by a vendor public key.
+// The entries contain the unique ID of the secure element, and optionally the DevEUI and JoinEUI.
+// Other fields of the entries are not stored in the provisioning data.
+type signedManifest struct{}
+
+func (*signedManifest) Format() *ttnpb.EndDeviceTemplateFormat {
+	return &ttnpb.EndDeviceTemplateFormat{
+		Name:           "Signed JSON Manifest File",
+		Description:    "JSON manifest file with entries signed by a configured vendor public key.",
+		FileExtensions: []string{".json"},
+	}
+}
+
+func (*signedManifest) ProvisionerID() string {
+	return provisioning.SignedManifest
+}
+
+func (*signedManifest) Entry(payload *pbtypes.Struct) (*SecureElementEntry, error) {
+	uniqueID, err := secureElementString(payload, "uniqueId", true)
+	if err != nil {
+		return nil, err
+	}
+	devEUI, err := secureElementEUI(payload, "devEui", false)
+	if err != nil {
+		return nil, err
+	}
+	joinEUI, err := secureElementEUI(payload, "joinEui", false)
+	if err != nil {
+		return nil, err
+	}
+	return &SecureElementEntry{
+		DevEUI:           devEUI,
+		JoinEUI:          joinEUI,
+		UniqueID:         uniqueID,
+		ProvisioningData: secureElementProvisioningData(payload, "uniqueId", "devEui", "joinEui"),
+	}, nil
+}
+
+func init() {
+	RegisterConverter("signed-manifest", NewSecureElementConverter(&signedManifest{}))
+}
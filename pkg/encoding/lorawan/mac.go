@@ -904,6 +904,278 @@ var DefaultMACCommands = MACCommandSpec{
 			return nil
 		}),
 	},
+
+	ttnpb.CID_RELAY_CONF: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		UplinkLength: 1,
+		AppendUplink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayConfAns()
+			var v byte
+			if pld.SecondChannelFrequencyAck {
+				v |= 1
+			}
+			if pld.SecondChannelAckOffsetAck {
+				v |= (1 << 1)
+			}
+			if pld.SecondChannelDataRateIndexAck {
+				v |= (1 << 2)
+			}
+			if pld.SecondChannelIndexAck {
+				v |= (1 << 3)
+			}
+			if pld.DefaultChannelIndexAck {
+				v |= (1 << 4)
+			}
+			if pld.CadPeriodicityAck {
+				v |= (1 << 5)
+			}
+			b = append(b, v)
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_CONF, "RelayConfAns", 1, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			cmd.Payload = &ttnpb.MACCommand_RelayConfAns_{
+				RelayConfAns: &ttnpb.MACCommand_RelayConfAns{
+					SecondChannelFrequencyAck:     b[0]&1 == 1,
+					SecondChannelAckOffsetAck:     (b[0]>>1)&1 == 1,
+					SecondChannelDataRateIndexAck: (b[0]>>2)&1 == 1,
+					SecondChannelIndexAck:         (b[0]>>3)&1 == 1,
+					DefaultChannelIndexAck:        (b[0]>>4)&1 == 1,
+					CadPeriodicityAck:             (b[0]>>5)&1 == 1,
+				},
+			}
+			return nil
+		}),
+
+		DownlinkLength:    5,
+		AppendDownlink:    appendRelayConfReq,
+		UnmarshalDownlink: newMACUnmarshaler(ttnpb.CID_RELAY_CONF, "RelayConfReq", 5, unmarshalRelayConfReq),
+	},
+
+	ttnpb.CID_RELAY_END_DEVICE_CONF: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		UplinkLength: 1,
+		AppendUplink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayEndDeviceConfAns()
+			var v byte
+			if pld.BackoffAck {
+				v |= 1
+			}
+			if pld.SecondChannelIndexAck {
+				v |= (1 << 1)
+			}
+			if pld.SecondChannelDataRateIndexAck {
+				v |= (1 << 2)
+			}
+			if pld.SecondChannelFrequencyAck {
+				v |= (1 << 3)
+			}
+			b = append(b, v)
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_END_DEVICE_CONF, "EndDeviceConfAns", 1, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			cmd.Payload = &ttnpb.MACCommand_RelayEndDeviceConfAns_{
+				RelayEndDeviceConfAns: &ttnpb.MACCommand_RelayEndDeviceConfAns{
+					BackoffAck:                    b[0]&1 == 1,
+					SecondChannelIndexAck:         (b[0]>>1)&1 == 1,
+					SecondChannelDataRateIndexAck: (b[0]>>2)&1 == 1,
+					SecondChannelFrequencyAck:     (b[0]>>3)&1 == 1,
+				},
+			}
+			return nil
+		}),
+
+		DownlinkLength:    6,
+		AppendDownlink:    appendRelayEndDeviceConfReq,
+		UnmarshalDownlink: newMACUnmarshaler(ttnpb.CID_RELAY_END_DEVICE_CONF, "EndDeviceConfReq", 6, unmarshalRelayEndDeviceConfReq),
+	},
+
+	ttnpb.CID_RELAY_FILTER_LIST: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		UplinkLength: 1,
+		AppendUplink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayFilterListAns()
+			var v byte
+			if pld.RuleIndexAck {
+				v |= 1
+			}
+			if pld.ActionAck {
+				v |= (1 << 1)
+			}
+			if pld.CombinedRulesAck {
+				v |= (1 << 2)
+			}
+			b = append(b, v)
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_FILTER_LIST, "FilterListAns", 1, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			cmd.Payload = &ttnpb.MACCommand_RelayFilterListAns_{
+				RelayFilterListAns: &ttnpb.MACCommand_RelayFilterListAns{
+					RuleIndexAck:     b[0]&1 == 1,
+					ActionAck:        (b[0]>>1)&1 == 1,
+					CombinedRulesAck: (b[0]>>2)&1 == 1,
+				},
+			}
+			return nil
+		}),
+
+		DownlinkLength: relayFilterListReqLength,
+		AppendDownlink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayFilterListReq()
+			if pld.RuleIndex > 15 {
+				return nil, errExpectedLowerOrEqual("FilterListIdx", 15)(pld.RuleIndex)
+			}
+			if pld.Action > 3 {
+				return nil, errExpectedLowerOrEqual("FilterListAction", 3)(pld.Action)
+			}
+			b = append(b, byte(pld.Action)<<5|byte(pld.RuleIndex))
+			b = appendReverse(b, pld.JoinEui[:]...)
+			b = appendReverse(b, pld.DevEui[:]...)
+			return b, nil
+		},
+		UnmarshalDownlink: newMACUnmarshaler(ttnpb.CID_RELAY_FILTER_LIST, "FilterListReq", relayFilterListReqLength, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			pld := &ttnpb.MACCommand_RelayFilterListReq{
+				RuleIndex: uint32(b[0] & 0x1f),
+				Action:    ttnpb.RelayJoinRequestFilterAction((b[0] >> 5) & 0x3),
+			}
+			copyReverse(pld.JoinEui[:], b[1:9])
+			copyReverse(pld.DevEui[:], b[9:17])
+			cmd.Payload = &ttnpb.MACCommand_RelayFilterListReq_{
+				RelayFilterListReq: pld,
+			}
+			return nil
+		}),
+	},
+
+	ttnpb.CID_RELAY_UPDATE_UPLINK_LIST: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		AppendUplink: func(phy band.Band, b []byte, _ ttnpb.MACCommand) ([]byte, error) {
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_UPDATE_UPLINK_LIST, "UpdateUplinkListAns", 0, nil),
+
+		DownlinkLength: relayUpdateUplinkListReqLength,
+		AppendDownlink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayUpdateUplinkListReq()
+			if pld.RuleIndex > 15 {
+				return nil, errExpectedLowerOrEqual("UplinkListIdx", 15)(pld.RuleIndex)
+			}
+			b = append(b, byte(pld.RuleIndex))
+			b, err := appendRelayForwardLimits(b, pld.ForwardLimits)
+			if err != nil {
+				return nil, err
+			}
+			b = appendReverse(b, pld.DevAddr[:]...)
+			b = byteutil.AppendUint32(b, pld.WFCnt, 4)
+			b = append(b, pld.RootWorSKey[:]...)
+			return b, nil
+		},
+		UnmarshalDownlink: newMACUnmarshaler(ttnpb.CID_RELAY_UPDATE_UPLINK_LIST, "UpdateUplinkListReq", relayUpdateUplinkListReqLength, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			pld := &ttnpb.MACCommand_RelayUpdateUplinkListReq{
+				RuleIndex:     uint32(b[0] & 0xf),
+				ForwardLimits: parseRelayForwardLimits(b[1]),
+				WFCnt:         byteutil.ParseUint32(b[6:10]),
+			}
+			copyReverse(pld.DevAddr[:], b[2:6])
+			copy(pld.RootWorSKey[:], b[10:26])
+			cmd.Payload = &ttnpb.MACCommand_RelayUpdateUplinkListReq_{
+				RelayUpdateUplinkListReq: pld,
+			}
+			return nil
+		}),
+	},
+
+	ttnpb.CID_RELAY_CTRL_UPLINK_LIST: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		UplinkLength: 5,
+		AppendUplink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayCtrlUplinkListAns()
+			var v byte
+			if pld.RuleIndexAck {
+				v |= 1
+			}
+			b = append(b, v)
+			b = byteutil.AppendUint32(b, pld.WFCnt, 4)
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_CTRL_UPLINK_LIST, "CtrlUplinkListAns", 5, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			cmd.Payload = &ttnpb.MACCommand_RelayCtrlUplinkListAns_{
+				RelayCtrlUplinkListAns: &ttnpb.MACCommand_RelayCtrlUplinkListAns{
+					RuleIndexAck: b[0]&1 == 1,
+					WFCnt:        byteutil.ParseUint32(b[1:5]),
+				},
+			}
+			return nil
+		}),
+
+		DownlinkLength: 1,
+		AppendDownlink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayCtrlUplinkListReq()
+			if pld.RuleIndex > 15 {
+				return nil, errExpectedLowerOrEqual("UplinkListIdx", 15)(pld.RuleIndex)
+			}
+			if pld.Action > 15 {
+				return nil, errExpectedLowerOrEqual("CtrlUplinkAction", 15)(pld.Action)
+			}
+			b = append(b, byte(pld.Action)<<4|byte(pld.RuleIndex))
+			return b, nil
+		},
+		UnmarshalDownlink: newMACUnmarshaler(ttnpb.CID_RELAY_CTRL_UPLINK_LIST, "CtrlUplinkListReq", 1, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			cmd.Payload = &ttnpb.MACCommand_RelayCtrlUplinkListReq_{
+				RelayCtrlUplinkListReq: &ttnpb.MACCommand_RelayCtrlUplinkListReq{
+					RuleIndex: uint32(b[0] & 0xf),
+					Action:    ttnpb.RelayCtrlUplinkListAction(b[0] >> 4),
+				},
+			}
+			return nil
+		}),
+	},
+
+	ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT: &MACCommandDescriptor{
+		InitiatedByDevice: false,
+
+		// NOTE: ConfigureFwdLimit is not managed by the Network Server, so its payloads are represented as raw payloads.
+		AppendUplink:    appendRawMACPayload("ConfigureFwdLimitAns", 0),
+		UnmarshalUplink: newRawMACUnmarshaler(ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT, "ConfigureFwdLimitAns", 0),
+
+		DownlinkLength:    relayConfigureFwdLimitReqLength,
+		AppendDownlink:    appendRawMACPayload("ConfigureFwdLimitReq", relayConfigureFwdLimitReqLength),
+		UnmarshalDownlink: newRawMACUnmarshaler(ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT, "ConfigureFwdLimitReq", relayConfigureFwdLimitReqLength),
+	},
+
+	ttnpb.CID_RELAY_NOTIFY_NEW_END_DEVICE: &MACCommandDescriptor{
+		InitiatedByDevice: true,
+
+		UplinkLength: 6,
+		AppendUplink: func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+			pld := cmd.GetRelayNotifyNewEndDeviceReq()
+			if pld.Snr < -20 || pld.Snr > 11 {
+				return nil, errExpectedBetween("SNR", -20, 11)(pld.Snr)
+			}
+			if pld.Rssi < -142 || pld.Rssi > -15 {
+				return nil, errExpectedBetween("RSSI", -142, -15)(pld.Rssi)
+			}
+			b = appendReverse(b, pld.DevAddr[:]...)
+			b = byteutil.AppendUint16(b, uint16(pld.Snr+20)|uint16(-pld.Rssi-15)<<5, 2)
+			return b, nil
+		},
+		UnmarshalUplink: newMACUnmarshaler(ttnpb.CID_RELAY_NOTIFY_NEW_END_DEVICE, "NotifyNewEndDeviceReq", 6, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+			v := byteutil.ParseUint32(b[4:6])
+			pld := &ttnpb.MACCommand_RelayNotifyNewEndDeviceReq{
+				Snr:  int32(v&0x1f) - 20,
+				Rssi: -int32((v>>5)&0x7f) - 15,
+			}
+			copyReverse(pld.DevAddr[:], b[0:4])
+			cmd.Payload = &ttnpb.MACCommand_RelayNotifyNewEndDeviceReq_{
+				RelayNotifyNewEndDeviceReq: pld,
+			}
+			return nil
+		}),
+	},
 }
 
 var (
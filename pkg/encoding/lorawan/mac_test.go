@@ -25,6 +25,7 @@ import (
 	. "go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 )
@@ -371,6 +372,172 @@ func TestLoRaWANEncodingMAC(t *testing.T) {
 			[]byte{0x20, 0x02},
 			false,
 		},
+		{
+			"RelayConfReq/Disabled",
+			&ttnpb.MACCommand_RelayConfReq{},
+			[]byte{0x40, 0x00, 0x00, 0x00, 0x00, 0x00},
+			false,
+		},
+		{
+			"RelayConfReq/Enabled",
+			&ttnpb.MACCommand_RelayConfReq{
+				Configuration: &ttnpb.MACCommand_RelayConfReq_Configuration{
+					SecondChannel: &ttnpb.RelaySecondChannel{
+						AckOffset:     ttnpb.RELAY_SECOND_CH_ACK_OFFSET_200,
+						DataRateIndex: ttnpb.DATA_RATE_3,
+						Frequency:     868100000,
+					},
+					DefaultChannelIndex: 2,
+					CadPeriodicity:      ttnpb.RELAY_CAD_PERIODICITY_100_MILLISECONDS,
+				},
+			},
+			[]byte{0x40, 0x99, 0x2e, 0x28, 0x76, 0x84},
+			false,
+		},
+		{
+			"RelayConfReq/NoSecondChannel",
+			&ttnpb.MACCommand_RelayConfReq{
+				Configuration: &ttnpb.MACCommand_RelayConfReq_Configuration{
+					DefaultChannelIndex: 1,
+				},
+			},
+			[]byte{0x40, 0x00, 0x21, 0x00, 0x00, 0x00},
+			false,
+		},
+		{
+			"RelayConfAns",
+			&ttnpb.MACCommand_RelayConfAns{
+				SecondChannelFrequencyAck:     true,
+				SecondChannelAckOffsetAck:     true,
+				SecondChannelDataRateIndexAck: true,
+				SecondChannelIndexAck:         true,
+				DefaultChannelIndexAck:        true,
+				CadPeriodicityAck:             true,
+			},
+			[]byte{0x40, 0x3f},
+			true,
+		},
+		{
+			"RelayEndDeviceConfReq/Disabled",
+			&ttnpb.MACCommand_RelayEndDeviceConfReq{},
+			[]byte{0x41, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			false,
+		},
+		{
+			"RelayEndDeviceConfReq/Dynamic",
+			&ttnpb.MACCommand_RelayEndDeviceConfReq{
+				Configuration: &ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration{
+					Mode: &ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic{
+						Dynamic: &ttnpb.RelayEndDeviceDynamicMode{
+							SmartEnableLevel: ttnpb.RELAY_SMART_ENABLE_LEVEL_32,
+						},
+					},
+					Backoff: 8,
+					SecondChannel: &ttnpb.RelaySecondChannel{
+						AckOffset:     ttnpb.RELAY_SECOND_CH_ACK_OFFSET_200,
+						DataRateIndex: ttnpb.DATA_RATE_3,
+						Frequency:     868100000,
+					},
+				},
+			},
+			[]byte{0x41, 0x0a, 0x99, 0x08, 0x28, 0x76, 0x84},
+			false,
+		},
+		{
+			"RelayEndDeviceConfReq/EndDeviceControlled",
+			&ttnpb.MACCommand_RelayEndDeviceConfReq{
+				Configuration: &ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration{
+					Mode: &ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled{
+						EndDeviceControlled: &ttnpb.RelayEndDeviceControlledMode{},
+					},
+					Backoff: 63,
+				},
+			},
+			[]byte{0x41, 0x0c, 0x00, 0x3f, 0x00, 0x00, 0x00},
+			false,
+		},
+		{
+			"RelayEndDeviceConfAns",
+			&ttnpb.MACCommand_RelayEndDeviceConfAns{
+				BackoffAck:                true,
+				SecondChannelFrequencyAck: true,
+			},
+			[]byte{0x41, 0x09},
+			true,
+		},
+		{
+			"RelayFilterListReq",
+			&ttnpb.MACCommand_RelayFilterListReq{
+				RuleIndex: 2,
+				Action:    ttnpb.RELAY_JOIN_REQUEST_FILTER_ACTION_FORWARD,
+				JoinEui:   types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01},
+				DevEui:    types.EUI64{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07},
+			},
+			[]byte{
+				0x42, 0x22,
+				0x01, 0x00, 0x00, 0xd0, 0x7e, 0xd5, 0xb3, 0x70,
+				0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01, 0x00,
+			},
+			false,
+		},
+		{
+			"RelayFilterListAns",
+			&ttnpb.MACCommand_RelayFilterListAns{
+				RuleIndexAck:     true,
+				CombinedRulesAck: true,
+			},
+			[]byte{0x42, 0x05},
+			true,
+		},
+		{
+			"RelayUpdateUplinkListReq",
+			&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+				RuleIndex: 1,
+				ForwardLimits: &ttnpb.RelayForwardLimits{
+					BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_4,
+					ReloadRate: 10,
+				},
+				DevAddr:     types.DevAddr{0x01, 0x02, 0x03, 0x04},
+				WFCnt:       0x10,
+				RootWorSKey: types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+			},
+			append([]byte{0x43, 0x01, 0x8a, 0x04, 0x03, 0x02, 0x01, 0x10, 0x00, 0x00, 0x00}, bytes.Repeat([]byte{0x42}, 16)...),
+			false,
+		},
+		{
+			"RelayUpdateUplinkListAns",
+			ttnpb.CID_RELAY_UPDATE_UPLINK_LIST,
+			[]byte{0x43},
+			true,
+		},
+		{
+			"RelayCtrlUplinkListReq",
+			&ttnpb.MACCommand_RelayCtrlUplinkListReq{
+				RuleIndex: 1,
+				Action:    ttnpb.RELAY_CTRL_UPLINK_LIST_ACTION_REMOVE_TRUSTED_END_DEVICE,
+			},
+			[]byte{0x44, 0x11},
+			false,
+		},
+		{
+			"RelayCtrlUplinkListAns",
+			&ttnpb.MACCommand_RelayCtrlUplinkListAns{
+				RuleIndexAck: true,
+				WFCnt:        0x10,
+			},
+			[]byte{0x44, 0x01, 0x10, 0x00, 0x00, 0x00},
+			true,
+		},
+		{
+			"RelayNotifyNewEndDeviceReq",
+			&ttnpb.MACCommand_RelayNotifyNewEndDeviceReq{
+				DevAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04},
+				Snr:     -6,
+				Rssi:    -61,
+			},
+			[]byte{0x46, 0x04, 0x03, 0x02, 0x01, 0xce, 0x05},
+			true,
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
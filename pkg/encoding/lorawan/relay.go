@@ -0,0 +1,306 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lorawan
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/byteutil"
+)
+
+// RelayFPort is the FPort of messages between a relay and the Network Server, as specified in LoRaWAN TS011.
+// Uplinks on RelayFPort contain ForwardUplinkReq and downlinks on RelayFPort contain ForwardDownlinkReq.
+const RelayFPort uint8 = 226
+
+const (
+	// relayConfigureFwdLimitReqLength is the length of ConfigureFwdLimitReq.
+	relayConfigureFwdLimitReqLength = 5
+	// relayFilterListReqLength is the length of FilterListReq, which always carries both the JoinEUI and the DevEUI.
+	relayFilterListReqLength = 17
+	// relayUpdateUplinkListReqLength is the length of UpdateUplinkListReq.
+	relayUpdateUplinkListReqLength = 26
+)
+
+func appendRawMACPayload(name string, n uint8) func(band.Band, []byte, ttnpb.MACCommand) ([]byte, error) {
+	return func(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+		pld := cmd.GetRawPayload()
+		if len(pld) != int(n) {
+			return nil, errExpectedLengthEqual(name, int(n))(len(pld))
+		}
+		return append(b, pld...), nil
+	}
+}
+
+func newRawMACUnmarshaler(cid ttnpb.MACCommandIdentifier, name string, n uint8) func(band.Band, []byte, *ttnpb.MACCommand) error {
+	return newMACUnmarshaler(cid, name, n, func(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+		cmd.Payload = &ttnpb.MACCommand_RawPayload{
+			RawPayload: append([]byte(nil), b...),
+		}
+		return nil
+	})
+}
+
+// appendRelaySecondChannel appends the frequency of sc to b and returns the channel settings byte of sc.
+// The channel settings byte contains the second channel index in bit 7, the data rate index in bits 6..3 and
+// the acknowledgment offset in bits 2..0. If sc is nil, the second channel is disabled.
+func appendRelaySecondChannel(phy band.Band, b []byte, sc *ttnpb.RelaySecondChannel) ([]byte, byte, error) {
+	if sc == nil {
+		return append(b, 0, 0, 0), 0, nil
+	}
+	if sc.AckOffset > 7 {
+		return nil, 0, errExpectedLowerOrEqual("SecondChAckOffset", 7)(sc.AckOffset)
+	}
+	if sc.DataRateIndex > 15 {
+		return nil, 0, errExpectedLowerOrEqual("SecondChDataRate", 15)(sc.DataRateIndex)
+	}
+	if sc.Frequency < 100000 || sc.Frequency > byteutil.MaxUint24*phy.FreqMultiplier {
+		return nil, 0, errExpectedBetween("SecondChFrequency", 100000, byteutil.MaxUint24*phy.FreqMultiplier)(sc.Frequency)
+	}
+	v := byte(1<<7) | byte(sc.DataRateIndex)<<3 | byte(sc.AckOffset)
+	return byteutil.AppendUint64(b, sc.Frequency/phy.FreqMultiplier, 3), v, nil
+}
+
+// parseRelaySecondChannel parses the channel settings byte v and the frequency in b.
+// It returns nil if the second channel is disabled.
+func parseRelaySecondChannel(phy band.Band, v byte, b []byte) *ttnpb.RelaySecondChannel {
+	if v>>7 == 0 {
+		return nil
+	}
+	return &ttnpb.RelaySecondChannel{
+		AckOffset:     ttnpb.RelaySecondChAckOffset(v & 0x7),
+		DataRateIndex: ttnpb.DataRateIndex((v >> 3) & 0xf),
+		Frequency:     byteutil.ParseUint64(b[0:3]) * phy.FreqMultiplier,
+	}
+}
+
+func appendRelayConfReq(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+	conf := cmd.GetRelayConfReq().GetConfiguration()
+	if conf == nil {
+		return append(b, 0, 0, 0, 0, 0), nil
+	}
+	if conf.CadPeriodicity > 7 {
+		return nil, errExpectedLowerOrEqual("CADPeriodicity", 7)(conf.CadPeriodicity)
+	}
+	if conf.DefaultChannelIndex > 3 {
+		return nil, errExpectedLowerOrEqual("DefaultChIdx", 3)(conf.DefaultChannelIndex)
+	}
+	freq, sc, err := appendRelaySecondChannel(phy, nil, conf.SecondChannel)
+	if err != nil {
+		return nil, err
+	}
+	v := uint16(1<<13) | uint16(conf.CadPeriodicity)<<10 | uint16(conf.DefaultChannelIndex)<<8 | uint16(sc)
+	b = byteutil.AppendUint16(b, v, 2)
+	return append(b, freq...), nil
+}
+
+func unmarshalRelayConfReq(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+	pld := &ttnpb.MACCommand_RelayConfReq{}
+	v := uint16(byteutil.ParseUint32(b[0:2]))
+	if (v>>13)&1 == 1 {
+		pld.Configuration = &ttnpb.MACCommand_RelayConfReq_Configuration{
+			SecondChannel:       parseRelaySecondChannel(phy, byte(v), b[2:5]),
+			DefaultChannelIndex: uint32((v >> 8) & 0x3),
+			CadPeriodicity:      ttnpb.RelayCADPeriodicity((v >> 10) & 0x7),
+		}
+	}
+	cmd.Payload = &ttnpb.MACCommand_RelayConfReq_{
+		RelayConfReq: pld,
+	}
+	return nil
+}
+
+func appendRelayEndDeviceConfReq(phy band.Band, b []byte, cmd ttnpb.MACCommand) ([]byte, error) {
+	conf := cmd.GetRelayEndDeviceConfReq().GetConfiguration()
+	if conf == nil {
+		return append(b, 0, 0, 0, 0, 0, 0), nil
+	}
+	var mode byte
+	switch conf.Mode.(type) {
+	case *ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration_Always:
+		mode = 1 << 2
+	case *ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic:
+		level := conf.GetDynamic().SmartEnableLevel
+		if level > 3 {
+			return nil, errExpectedLowerOrEqual("SmartEnableLevel", 3)(level)
+		}
+		mode = 2<<2 | byte(level)
+	case *ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled:
+		mode = 3 << 2
+	default:
+		return nil, errUnknown("RelayModeActivation")(conf.Mode)
+	}
+	if conf.Backoff > 63 {
+		return nil, errExpectedLowerOrEqual("BackOff", 63)(conf.Backoff)
+	}
+	freq, sc, err := appendRelaySecondChannel(phy, nil, conf.SecondChannel)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, mode, sc, byte(conf.Backoff))
+	return append(b, freq...), nil
+}
+
+func unmarshalRelayEndDeviceConfReq(phy band.Band, b []byte, cmd *ttnpb.MACCommand) error {
+	pld := &ttnpb.MACCommand_RelayEndDeviceConfReq{}
+	conf := &ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration{
+		Backoff:       uint32(b[2] & 0x3f),
+		SecondChannel: parseRelaySecondChannel(phy, b[1], b[3:6]),
+	}
+	switch (b[0] >> 2) & 0x3 {
+	case 1:
+		conf.Mode = &ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration_Always{
+			Always: &ttnpb.RelayEndDeviceAlwaysMode{},
+		}
+		pld.Configuration = conf
+	case 2:
+		conf.Mode = &ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic{
+			Dynamic: &ttnpb.RelayEndDeviceDynamicMode{
+				SmartEnableLevel: ttnpb.RelaySmartEnableLevel(b[0] & 0x3),
+			},
+		}
+		pld.Configuration = conf
+	case 3:
+		conf.Mode = &ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled{
+			EndDeviceControlled: &ttnpb.RelayEndDeviceControlledMode{},
+		}
+		pld.Configuration = conf
+	}
+	cmd.Payload = &ttnpb.MACCommand_RelayEndDeviceConfReq_{
+		RelayEndDeviceConfReq: pld,
+	}
+	return nil
+}
+
+func appendRelayForwardLimits(b []byte, limits *ttnpb.RelayForwardLimits) ([]byte, error) {
+	if limits == nil {
+		return nil, errMissing("UplinkLimit")
+	}
+	if limits.BucketSize > 3 {
+		return nil, errExpectedLowerOrEqual("BucketSize", 3)(limits.BucketSize)
+	}
+	if limits.ReloadRate > 63 {
+		return nil, errExpectedLowerOrEqual("ReloadRate", 63)(limits.ReloadRate)
+	}
+	return append(b, byte(limits.BucketSize)<<6|byte(limits.ReloadRate)), nil
+}
+
+func parseRelayForwardLimits(v byte) *ttnpb.RelayForwardLimits {
+	return &ttnpb.RelayForwardLimits{
+		BucketSize: ttnpb.RelayLimitBucketSize(v >> 6),
+		ReloadRate: uint32(v & 0x3f),
+	}
+}
+
+// RelayUplinkMetadata is the metadata of an uplink received by a relay.
+type RelayUplinkMetadata struct {
+	DataRateIndex ttnpb.DataRateIndex
+	// SNR is the signal-to-noise ratio in dB, between -20 and 11.
+	SNR int32
+	// RSSI is the received signal strength in dBm, between -142 and -15.
+	RSSI int32
+	// WORChannel is the index of the wake-on-radio channel the uplink was received on.
+	WORChannel uint8
+}
+
+// ForwardUplinkReq is an uplink of an end device that is forwarded by a relay to the Network Server.
+type ForwardUplinkReq struct {
+	Metadata RelayUplinkMetadata
+	// Frequency is the frequency in Hz the uplink was received on.
+	Frequency  uint64
+	PHYPayload []byte
+}
+
+// AppendForwardUplinkReq appends encoded msg to dst.
+func AppendForwardUplinkReq(phy band.Band, dst []byte, msg ForwardUplinkReq) ([]byte, error) {
+	md := msg.Metadata
+	if md.DataRateIndex > 15 {
+		return nil, errExpectedLowerOrEqual("DataRate", 15)(md.DataRateIndex)
+	}
+	if md.SNR < -20 || md.SNR > 11 {
+		return nil, errExpectedBetween("SNR", -20, 11)(md.SNR)
+	}
+	if md.RSSI < -142 || md.RSSI > -15 {
+		return nil, errExpectedBetween("RSSI", -142, -15)(md.RSSI)
+	}
+	if md.WORChannel > 3 {
+		return nil, errExpectedLowerOrEqual("WORChannel", 3)(md.WORChannel)
+	}
+	if msg.Frequency > byteutil.MaxUint24*phy.FreqMultiplier {
+		return nil, errExpectedLowerOrEqual("Frequency", byteutil.MaxUint24*phy.FreqMultiplier)(msg.Frequency)
+	}
+	if len(msg.PHYPayload) == 0 {
+		return nil, errMissing("PHYPayload")
+	}
+	v := uint32(md.DataRateIndex)
+	v |= uint32(md.SNR+20) << 4
+	v |= uint32(-md.RSSI-15) << 9
+	v |= uint32(md.WORChannel) << 16
+	dst = byteutil.AppendUint32(dst, v, 3)
+	dst = byteutil.AppendUint64(dst, msg.Frequency/phy.FreqMultiplier, 3)
+	return append(dst, msg.PHYPayload...), nil
+}
+
+// MarshalForwardUplinkReq returns encoded msg.
+func MarshalForwardUplinkReq(phy band.Band, msg ForwardUplinkReq) ([]byte, error) {
+	return AppendForwardUplinkReq(phy, make([]byte, 0, 6+len(msg.PHYPayload)), msg)
+}
+
+// UnmarshalForwardUplinkReq unmarshals b into msg.
+func UnmarshalForwardUplinkReq(phy band.Band, b []byte, msg *ForwardUplinkReq) error {
+	if len(b) < 7 {
+		return errExpectedLengthHigherOrEqual("ForwardUplinkReq", 7)(len(b))
+	}
+	v := byteutil.ParseUint32(b[0:3])
+	*msg = ForwardUplinkReq{
+		Metadata: RelayUplinkMetadata{
+			DataRateIndex: ttnpb.DataRateIndex(v & 0xf),
+			SNR:           int32((v>>4)&0x1f) - 20,
+			RSSI:          -int32((v>>9)&0x7f) - 15,
+			WORChannel:    uint8((v >> 16) & 0x3),
+		},
+		Frequency:  byteutil.ParseUint64(b[3:6]) * phy.FreqMultiplier,
+		PHYPayload: append([]byte(nil), b[6:]...),
+	}
+	return nil
+}
+
+// ForwardDownlinkReq is a downlink for an end device that is forwarded by the Network Server to a relay.
+type ForwardDownlinkReq struct {
+	PHYPayload []byte
+}
+
+// AppendForwardDownlinkReq appends encoded msg to dst.
+func AppendForwardDownlinkReq(dst []byte, msg ForwardDownlinkReq) ([]byte, error) {
+	if len(msg.PHYPayload) == 0 {
+		return nil, errMissing("PHYPayload")
+	}
+	return append(dst, msg.PHYPayload...), nil
+}
+
+// MarshalForwardDownlinkReq returns encoded msg.
+func MarshalForwardDownlinkReq(msg ForwardDownlinkReq) ([]byte, error) {
+	return AppendForwardDownlinkReq(make([]byte, 0, len(msg.PHYPayload)), msg)
+}
+
+// UnmarshalForwardDownlinkReq unmarshals b into msg.
+func UnmarshalForwardDownlinkReq(b []byte, msg *ForwardDownlinkReq) error {
+	if len(b) == 0 {
+		return errExpectedLengthHigherOrEqual("ForwardDownlinkReq", 1)(len(b))
+	}
+	*msg = ForwardDownlinkReq{
+		PHYPayload: append([]byte(nil), b...),
+	}
+	return nil
+}
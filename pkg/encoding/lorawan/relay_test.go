@@ -0,0 +1,271 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lorawan_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	. "go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRelayConfigureFwdLimit(t *testing.T) {
+	phy := test.Must(band.Get(band.EU_863_870, ttnpb.RP001_V1_1_REV_B)).(band.Band)
+
+	for _, tc := range []struct {
+		Name     string
+		Bytes    []byte
+		IsUplink bool
+	}{
+		{
+			Name:  "ConfigureFwdLimitReq",
+			Bytes: []byte{0x45, 0x01, 0x02, 0x03, 0x04, 0x05},
+		},
+		{
+			Name:     "ConfigureFwdLimitAns",
+			Bytes:    []byte{0x45},
+			IsUplink: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			appender := DefaultMACCommands.AppendUplink
+			reader := DefaultMACCommands.ReadUplink
+			if !tc.IsUplink {
+				appender = DefaultMACCommands.AppendDownlink
+				reader = DefaultMACCommands.ReadDownlink
+			}
+
+			var raw []byte
+			if len(tc.Bytes) > 1 {
+				raw = tc.Bytes[1:]
+			}
+			expected := &ttnpb.MACCommand{
+				Cid: ttnpb.CID_RELAY_CONFIGURE_FWD_LIMIT,
+				Payload: &ttnpb.MACCommand_RawPayload{
+					RawPayload: raw,
+				},
+			}
+
+			b, err := appender(phy, []byte{}, *expected)
+			if a.So(err, should.BeNil) {
+				a.So(b, should.Resemble, tc.Bytes)
+			}
+
+			cmd := &ttnpb.MACCommand{}
+			err = reader(phy, bytes.NewReader(tc.Bytes), cmd)
+			if a.So(err, should.BeNil) {
+				a.So(cmd, should.Resemble, expected)
+			}
+
+			_, err = appender(phy, []byte{}, ttnpb.MACCommand{
+				Cid: expected.Cid,
+				Payload: &ttnpb.MACCommand_RawPayload{
+					RawPayload: append(append([]byte(nil), raw...), 0x42),
+				},
+			})
+			a.So(err, should.NotBeNil)
+		})
+	}
+}
+
+func TestRelayMACCommandsInvalid(t *testing.T) {
+	phy := test.Must(band.Get(band.EU_863_870, ttnpb.RP001_V1_1_REV_B)).(band.Band)
+
+	for _, tc := range []struct {
+		Name     string
+		Command  *ttnpb.MACCommand
+		IsUplink bool
+	}{
+		{
+			Name: "RelayConfReq/DefaultChannelIndex",
+			Command: (&ttnpb.MACCommand_RelayConfReq{
+				Configuration: &ttnpb.MACCommand_RelayConfReq_Configuration{
+					DefaultChannelIndex: 4,
+				},
+			}).MACCommand(),
+		},
+		{
+			Name: "RelayConfReq/SecondChannelFrequency",
+			Command: (&ttnpb.MACCommand_RelayConfReq{
+				Configuration: &ttnpb.MACCommand_RelayConfReq_Configuration{
+					SecondChannel: &ttnpb.RelaySecondChannel{
+						Frequency: 42,
+					},
+				},
+			}).MACCommand(),
+		},
+		{
+			Name: "RelayEndDeviceConfReq/NoMode",
+			Command: (&ttnpb.MACCommand_RelayEndDeviceConfReq{
+				Configuration: &ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration{},
+			}).MACCommand(),
+		},
+		{
+			Name: "RelayEndDeviceConfReq/Backoff",
+			Command: (&ttnpb.MACCommand_RelayEndDeviceConfReq{
+				Configuration: &ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration{
+					Mode: &ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration_Always{
+						Always: &ttnpb.RelayEndDeviceAlwaysMode{},
+					},
+					Backoff: 64,
+				},
+			}).MACCommand(),
+		},
+		{
+			Name: "RelayFilterListReq/RuleIndex",
+			Command: (&ttnpb.MACCommand_RelayFilterListReq{
+				RuleIndex: 16,
+			}).MACCommand(),
+		},
+		{
+			Name: "RelayUpdateUplinkListReq/NoForwardLimits",
+			Command: (&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+				RuleIndex: 1,
+			}).MACCommand(),
+		},
+		{
+			Name: "RelayCtrlUplinkListReq/RuleIndex",
+			Command: (&ttnpb.MACCommand_RelayCtrlUplinkListReq{
+				RuleIndex: 16,
+			}).MACCommand(),
+		},
+		{
+			Name: "RelayNotifyNewEndDeviceReq/RSSI",
+			Command: (&ttnpb.MACCommand_RelayNotifyNewEndDeviceReq{
+				Rssi: -10,
+			}).MACCommand(),
+			IsUplink: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			appender := DefaultMACCommands.AppendUplink
+			if !tc.IsUplink {
+				appender = DefaultMACCommands.AppendDownlink
+			}
+			_, err := appender(phy, []byte{}, *tc.Command)
+			a.So(err, should.NotBeNil)
+		})
+	}
+}
+
+func TestForwardUplinkReq(t *testing.T) {
+	phy := test.Must(band.Get(band.EU_863_870, ttnpb.RP001_V1_1_REV_B)).(band.Band)
+
+	for _, tc := range []struct {
+		Name  string
+		Bytes []byte
+		Msg   ForwardUplinkReq
+	}{
+		{
+			Name:  "Min",
+			Bytes: []byte{0x00, 0xfe, 0x00, 0x28, 0x76, 0x84, 0x40},
+			Msg: ForwardUplinkReq{
+				Metadata: RelayUplinkMetadata{
+					SNR:  -20,
+					RSSI: -142,
+				},
+				Frequency:  868100000,
+				PHYPayload: []byte{0x40},
+			},
+		},
+		{
+			Name:  "Max",
+			Bytes: []byte{0xff, 0x01, 0x03, 0xd2, 0xad, 0x84, 0x40, 0x01, 0x02, 0x03, 0x04},
+			Msg: ForwardUplinkReq{
+				Metadata: RelayUplinkMetadata{
+					DataRateIndex: 15,
+					SNR:           11,
+					RSSI:          -15,
+					WORChannel:    3,
+				},
+				Frequency:  869525000,
+				PHYPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+			},
+		},
+		{
+			Name:  "Typical",
+			Bytes: []byte{0xe5, 0x5c, 0x00, 0x28, 0x76, 0x84, 0x40, 0x01, 0x02, 0x03, 0x04},
+			Msg: ForwardUplinkReq{
+				Metadata: RelayUplinkMetadata{
+					DataRateIndex: 5,
+					SNR:           -6,
+					RSSI:          -61,
+				},
+				Frequency:  868100000,
+				PHYPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			b, err := MarshalForwardUplinkReq(phy, tc.Msg)
+			if a.So(err, should.BeNil) {
+				a.So(b, should.Resemble, tc.Bytes)
+			}
+
+			var msg ForwardUplinkReq
+			if a.So(UnmarshalForwardUplinkReq(phy, tc.Bytes, &msg), should.BeNil) {
+				a.So(msg, should.Resemble, tc.Msg)
+			}
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		a := assertions.New(t)
+
+		for _, msg := range []ForwardUplinkReq{
+			{Metadata: RelayUplinkMetadata{SNR: 12, RSSI: -50}, Frequency: 868100000, PHYPayload: []byte{0x40}},
+			{Metadata: RelayUplinkMetadata{RSSI: -10}, Frequency: 868100000, PHYPayload: []byte{0x40}},
+			{Metadata: RelayUplinkMetadata{RSSI: -50, WORChannel: 4}, Frequency: 868100000, PHYPayload: []byte{0x40}},
+			{Metadata: RelayUplinkMetadata{RSSI: -50}, Frequency: 868100000},
+		} {
+			_, err := MarshalForwardUplinkReq(phy, msg)
+			a.So(err, should.NotBeNil)
+		}
+		a.So(UnmarshalForwardUplinkReq(phy, []byte{0x00, 0xfe, 0x00, 0x28, 0x76, 0x84}, &ForwardUplinkReq{}), should.NotBeNil)
+	})
+}
+
+func TestForwardDownlinkReq(t *testing.T) {
+	a := assertions.New(t)
+
+	b, err := MarshalForwardDownlinkReq(ForwardDownlinkReq{
+		PHYPayload: []byte{0x60, 0x01, 0x02, 0x03, 0x04},
+	})
+	if a.So(err, should.BeNil) {
+		a.So(b, should.Resemble, []byte{0x60, 0x01, 0x02, 0x03, 0x04})
+	}
+
+	var msg ForwardDownlinkReq
+	if a.So(UnmarshalForwardDownlinkReq([]byte{0x60, 0x01, 0x02, 0x03, 0x04}, &msg), should.BeNil) {
+		a.So(msg, should.Resemble, ForwardDownlinkReq{
+			PHYPayload: []byte{0x60, 0x01, 0x02, 0x03, 0x04},
+		})
+	}
+
+	_, err = MarshalForwardDownlinkReq(ForwardDownlinkReq{})
+	a.So(err, should.NotBeNil)
+	a.So(UnmarshalForwardDownlinkReq(nil, &msg), should.NotBeNil)
+}
@@ -38,6 +38,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 const qosDownlink byte = 0
@@ -75,6 +76,8 @@ func (s *srv) accept() error {
 
 		remoteAddr := mqttConn.RemoteAddr().String()
 		ctx := log.NewContextWithFields(s.ctx, log.Fields("remote_addr", remoteAddr))
+		// The remote address is checked against the source address restrictions of API keys.
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: mqttConn.RemoteAddr()})
 
 		resource := ratelimit.GatewayAcceptMQTTConnectionResource(remoteAddr)
 		if err := ratelimit.Require(s.server.RateLimiter(), resource); err != nil {
@@ -0,0 +1,61 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errRestrictedAPIKey                    = errors.DefinePermissionDenied("restricted_api_key", "API key with restrictions can not be used for this request")
+	errEndDeviceRestrictionsNotApplication = errors.DefineInvalidArgument("end_device_restrictions_not_application", "end device restrictions are only supported for application API keys")
+	errInvalidRestrictions                 = errors.DefineInvalidArgument("invalid_restrictions", "invalid restrictions")
+)
+
+// requireUnrestricted returns an error if the caller uses an API key with restrictions.
+// This is used by requests for which the restrictions can not be enforced, and by
+// requests that manage API keys, so that restricted API keys can not create unrestricted ones.
+func (is *IdentityServer) requireUnrestricted(ctx context.Context) error {
+	restrictions, err := is.Restrictions(ctx)
+	if err != nil {
+		return err
+	}
+	if !restrictions.IsZero() {
+		return errRestrictedAPIKey.New()
+	}
+	return nil
+}
+
+// validateAPIKeyRestrictions validates the restrictions of an API key of the entity.
+// API keys with restrictions can not be used to create or update API keys.
+func (is *IdentityServer) validateAPIKeyRestrictions(ctx context.Context, ids *ttnpb.EntityIdentifiers, restrictions *ttnpb.APIKeyRestrictions) error {
+	if err := is.requireUnrestricted(ctx); err != nil {
+		return err
+	}
+	if restrictions.IsZero() {
+		return nil
+	}
+	if err := rights.ValidateRestrictions(restrictions); err != nil {
+		return errInvalidRestrictions.WithCause(err)
+	}
+	if restrictions.RestrictsEndDevices() && ids.GetApplicationIds() == nil {
+		return errEndDeviceRestrictionsNotApplication.New()
+	}
+	return nil
+}
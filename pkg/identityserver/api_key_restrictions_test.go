@@ -0,0 +1,225 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+func TestAPIKeyRestrictions(t *testing.T) {
+	a, ctx := test.New(t)
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		creds := userCreds(defaultUserIdx)
+		appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "restricted-app"}
+
+		_, err := ttnpb.NewApplicationRegistryClient(cc).Create(ctx, &ttnpb.CreateApplicationRequest{
+			Application:  &ttnpb.Application{Ids: appIDs},
+			Collaborator: *defaultUser.GetIds().GetOrganizationOrUserIdentifiers(),
+		}, creds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		reg := ttnpb.NewEndDeviceRegistryClient(cc)
+		defer func() {
+			for _, id := range []string{"restricted-dev-1", "restricted-dev-2", "restricted-dev-3"} {
+				reg.Delete(ctx, &ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: *appIDs, DeviceId: id}, creds)
+			}
+			ttnpb.NewApplicationRegistryClient(cc).Purge(ctx, appIDs, userCreds(adminUserIdx))
+		}()
+		for id, attributes := range map[string]map[string]string{
+			"restricted-dev-1": {"installer": "alice"},
+			"restricted-dev-2": {"installer": "bob"},
+			"restricted-dev-3": nil,
+		} {
+			_, err := reg.Create(ctx, &ttnpb.CreateEndDeviceRequest{
+				EndDevice: ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						ApplicationIdentifiers: *appIDs,
+						DeviceId:               id,
+					},
+					Attributes: attributes,
+				},
+			}, creds)
+			a.So(err, should.BeNil)
+		}
+
+		access := ttnpb.NewApplicationAccessClient(cc)
+
+		_, err = access.CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+			ApplicationIds: appIDs,
+			Rights:         []ttnpb.Right{ttnpb.RIGHT_APPLICATION_DEVICES_READ},
+			Restrictions: &ttnpb.APIKeyRestrictions{
+				SourceCidrs: []string{"not-a-cidr"},
+			},
+		}, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		restrictions := &ttnpb.APIKeyRestrictions{
+			EndDeviceIds:        []string{"restricted-dev-3"},
+			EndDeviceAttributes: map[string]string{"installer": "alice"},
+		}
+		apiKey, err := access.CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+			ApplicationIds: appIDs,
+			Name:           "restricted key",
+			Rights:         []ttnpb.Right{ttnpb.RIGHT_APPLICATION_DEVICES_READ, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE},
+			Restrictions:   restrictions,
+		}, creds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(apiKey.Restrictions, should.Resemble, restrictions)
+
+		got, err := access.GetAPIKey(ctx, &ttnpb.GetApplicationAPIKeyRequest{
+			ApplicationIds: appIDs,
+			KeyId:          apiKey.Id,
+		}, creds)
+		if a.So(err, should.BeNil) {
+			a.So(got.Restrictions, should.Resemble, restrictions)
+		}
+
+		restrictedCreds := grpc.PerRPCCredentials(rpcmetadata.MD{
+			AuthType:      "bearer",
+			AuthValue:     apiKey.Key,
+			AllowInsecure: true,
+		})
+
+		updateRestrictions := func(restrictions *ttnpb.APIKeyRestrictions, callOpt grpc.CallOption) error {
+			_, err := access.UpdateAPIKey(ctx, &ttnpb.UpdateApplicationAPIKeyRequest{
+				ApplicationIds: appIDs,
+				ApiKey: &ttnpb.APIKey{
+					Id:           apiKey.Id,
+					Restrictions: restrictions,
+				},
+				FieldMask: &pbtypes.FieldMask{Paths: []string{"restrictions"}},
+			}, callOpt)
+			return err
+		}
+
+		// The restricted key can not change its own restrictions.
+		if err := updateRestrictions(nil, restrictedCreds); a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		getDevice := func(id string) error {
+			_, err := reg.Get(ctx, &ttnpb.GetEndDeviceRequest{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: *appIDs,
+					DeviceId:               id,
+				},
+				FieldMask: &pbtypes.FieldMask{Paths: []string{"name"}},
+			}, restrictedCreds)
+			return err
+		}
+
+		a.So(getDevice("restricted-dev-1"), should.BeNil)
+		a.So(getDevice("restricted-dev-3"), should.BeNil)
+		if err := getDevice("restricted-dev-2"); a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		list, err := reg.List(ctx, &ttnpb.ListEndDevicesRequest{
+			ApplicationIds: appIDs,
+			FieldMask:      &pbtypes.FieldMask{Paths: []string{"name"}},
+		}, restrictedCreds)
+		if a.So(err, should.BeNil) && a.So(list.EndDevices, should.HaveLength, 2) {
+			a.So(list.EndDevices[0].DeviceId, should.Equal, "restricted-dev-1")
+			a.So(list.EndDevices[1].DeviceId, should.Equal, "restricted-dev-3")
+		}
+
+		_, err = reg.Create(ctx, &ttnpb.CreateEndDeviceRequest{
+			EndDevice: ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: *appIDs,
+					DeviceId:               "restricted-dev-4",
+				},
+				Attributes: map[string]string{"installer": "bob"},
+			},
+		}, restrictedCreds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = access.CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+			ApplicationIds: appIDs,
+			Rights:         []ttnpb.Right{ttnpb.RIGHT_APPLICATION_DEVICES_READ},
+		}, restrictedCreds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		a.So(updateRestrictions(&ttnpb.APIKeyRestrictions{
+			SourceCidrs: []string{"10.0.0.0/8"},
+			Methods:     []string{"/ttn.lorawan.v3.EndDeviceRegistry/Get"},
+		}, creds), should.BeNil)
+
+		if err := getDevice("restricted-dev-2"); a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		a.So(updateRestrictions(&ttnpb.APIKeyRestrictions{
+			Methods: []string{"/ttn.lorawan.v3.EndDeviceRegistry/Get"},
+		}, creds), should.BeNil)
+
+		a.So(getDevice("restricted-dev-2"), should.BeNil)
+		_, err = reg.List(ctx, &ttnpb.ListEndDevicesRequest{
+			ApplicationIds: appIDs,
+		}, restrictedCreds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		keys, err := access.ListAPIKeys(ctx, &ttnpb.ListApplicationAPIKeysRequest{
+			ApplicationIds: appIDs,
+		}, creds)
+		if a.So(err, should.BeNil) {
+			for _, key := range keys.ApiKeys {
+				if key.Id == apiKey.Id {
+					a.So(key.Restrictions.GetMethods(), should.Resemble, []string{"/ttn.lorawan.v3.EndDeviceRegistry/Get"})
+				}
+			}
+		}
+
+		a.So(updateRestrictions(nil, creds), should.BeNil)
+
+		_, err = reg.List(ctx, &ttnpb.ListEndDevicesRequest{
+			ApplicationIds: appIDs,
+		}, restrictedCreds)
+		a.So(err, should.BeNil)
+
+		// End device restrictions are only supported for application API keys.
+		_, err = ttnpb.NewUserAccessClient(cc).CreateAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+			UserIds: defaultUser.GetIds(),
+			Rights:  []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+			Restrictions: &ttnpb.APIKeyRestrictions{
+				EndDeviceIds: []string{"restricted-dev-1"},
+			},
+		}, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+	})
+}
@@ -86,19 +86,24 @@ func (is *IdentityServer) createApplicationAPIKey(ctx context.Context, req *ttnp
 	if err = rights.RequireApplication(ctx, *req.GetApplicationIds(), req.Rights...); err != nil {
 		return nil, err
 	}
+	if err = is.validateAPIKeyRestrictions(ctx, req.GetApplicationIds().GetEntityIdentifiers(), req.Restrictions); err != nil {
+		return nil, err
+	}
 	key, token, err := GenerateAPIKey(ctx, req.Name, req.ExpiresAt, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	key.Restrictions = req.Restrictions
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		key, err = store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.GetApplicationIds().GetEntityIdentifiers(), key)
 		return err
+	}, func() events.Event {
+		return evtCreateApplicationAPIKey.NewWithIdentifiersAndData(ctx, req.GetApplicationIds(), nil)
 	})
 	if err != nil {
 		return nil, err
 	}
 	key.Key = token
-	events.Publish(evtCreateApplicationAPIKey.NewWithIdentifiersAndData(ctx, req.GetApplicationIds(), nil))
 	err = is.SendContactsEmail(ctx, req, func(data emails.Data) email.MessageData {
 		data.SetEntity(req)
 		return &emails.APIKeyCreated{Data: data, Key: key, Rights: key.Rights}
@@ -159,8 +164,11 @@ func (is *IdentityServer) updateApplicationAPIKey(ctx context.Context, req *ttnp
 	if err := rights.RequireApplication(ctx, *req.GetApplicationIds(), ttnpb.RIGHT_APPLICATION_SETTINGS_API_KEYS); err != nil {
 		return nil, err
 	}
+	if err = is.validateAPIKeyRestrictions(ctx, req.GetApplicationIds().GetEntityIdentifiers(), req.ApiKey.GetRestrictions()); err != nil {
+		return nil, err
+	}
 
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		if len(req.ApiKey.Rights) > 0 {
 			_, key, err := store.GetAPIKeyStore(db).GetAPIKey(ctx, req.ApiKey.Id)
 			if err != nil {
@@ -182,16 +190,19 @@ func (is *IdentityServer) updateApplicationAPIKey(ctx context.Context, req *ttnp
 
 		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.ApplicationIds.GetEntityIdentifiers(), req.ApiKey, req.FieldMask)
 		return err
+	}, func() events.Event {
+		if key == nil { // API key was deleted.
+			return evtDeleteApplicationAPIKey.NewWithIdentifiersAndData(ctx, req.GetApplicationIds(), nil)
+		}
+		return evtUpdateApplicationAPIKey.NewWithIdentifiersAndData(ctx, req.GetApplicationIds(), req.FieldMask.GetPaths())
 	})
 	if err != nil {
 		return nil, err
 	}
 	if key == nil { // API key was deleted.
-		events.Publish(evtDeleteApplicationAPIKey.NewWithIdentifiersAndData(ctx, req.GetApplicationIds(), nil))
 		return &ttnpb.APIKey{}, nil
 	}
 	key.Key = ""
-	events.Publish(evtUpdateApplicationAPIKey.NewWithIdentifiersAndData(ctx, req.GetApplicationIds(), nil))
 	err = is.SendContactsEmail(ctx, req, func(data emails.Data) email.MessageData {
 		data.SetEntity(req)
 		return &emails.APIKeyChanged{Data: data, Key: key, Rights: key.Rights}
@@ -236,7 +247,7 @@ func (is *IdentityServer) setApplicationCollaborator(ctx context.Context, req *t
 		return nil, err
 	}
 
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
 		store := is.getMembershipStore(ctx, db)
 		existingRights, err := store.GetMember(
 			ctx,
@@ -291,12 +302,16 @@ func (is *IdentityServer) setApplicationCollaborator(ctx context.Context, req *t
 			req.GetApplicationIds().GetEntityIdentifiers(),
 			ttnpb.RightsFrom(req.Collaborator.Rights...),
 		)
+	}, func() events.Event {
+		if len(req.GetCollaborator().GetRights()) > 0 {
+			return evtUpdateApplicationCollaborator.New(ctx, events.WithIdentifiers(req.GetApplicationIds(), req.GetCollaborator().GetIds()))
+		}
+		return evtDeleteApplicationCollaborator.New(ctx, events.WithIdentifiers(req.GetApplicationIds(), req.GetCollaborator().GetIds()))
 	})
 	if err != nil {
 		return nil, err
 	}
 	if len(req.GetCollaborator().GetRights()) > 0 {
-		events.Publish(evtUpdateApplicationCollaborator.New(ctx, events.WithIdentifiers(req.GetApplicationIds(), req.GetCollaborator().GetIds())))
 		err = is.SendContactsEmail(ctx, req, func(data emails.Data) email.MessageData {
 			data.SetEntity(req)
 			return &emails.CollaboratorChanged{Data: data, Collaborator: *req.GetCollaborator()}
@@ -304,8 +319,6 @@ func (is *IdentityServer) setApplicationCollaborator(ctx context.Context, req *t
 		if err != nil {
 			log.FromContext(ctx).WithError(err).Error("Could not send collaborator updated notification email")
 		}
-	} else {
-		events.Publish(evtDeleteApplicationCollaborator.New(ctx, events.WithIdentifiers(req.GetApplicationIds(), req.GetCollaborator().GetIds())))
 	}
 	return ttnpb.Empty, nil
 }
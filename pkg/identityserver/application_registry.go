@@ -94,7 +94,7 @@ func (is *IdentityServer) createApplication(ctx context.Context, req *ttnpb.Crea
 	if err := validateContactInfo(req.Application.ContactInfo); err != nil {
 		return nil, err
 	}
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		app, err = store.GetApplicationStore(db).CreateApplication(ctx, req.Application)
 		if err != nil {
 			return err
@@ -115,11 +115,12 @@ func (is *IdentityServer) createApplication(ctx context.Context, req *ttnpb.Crea
 			}
 		}
 		return nil
+	}, func() events.Event {
+		return evtCreateApplication.NewWithIdentifiersAndData(ctx, req.Application.GetIds(), nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtCreateApplication.NewWithIdentifiersAndData(ctx, req.Application.GetIds(), nil))
 	return app, nil
 }
 
@@ -265,7 +266,7 @@ func (is *IdentityServer) updateApplication(ctx context.Context, req *ttnpb.Upda
 			return nil, err
 		}
 	}
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		app, err = store.GetApplicationStore(db).UpdateApplication(ctx, req.Application, req.FieldMask)
 		if err != nil {
 			return err
@@ -278,11 +279,12 @@ func (is *IdentityServer) updateApplication(ctx context.Context, req *ttnpb.Upda
 			}
 		}
 		return nil
+	}, func() events.Event {
+		return evtUpdateApplication.NewWithIdentifiersAndData(ctx, req.Application.GetIds(), req.FieldMask.GetPaths())
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtUpdateApplication.NewWithIdentifiersAndData(ctx, req.Application.GetIds(), req.FieldMask.GetPaths()))
 	return app, nil
 }
 
@@ -292,7 +294,7 @@ func (is *IdentityServer) deleteApplication(ctx context.Context, ids *ttnpb.Appl
 	if err := rights.RequireApplication(ctx, *ids, ttnpb.RIGHT_APPLICATION_DELETE); err != nil {
 		return nil, err
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
 		total, err := store.GetEndDeviceStore(db).CountEndDevices(ctx, ids)
 		if err != nil {
 			return err
@@ -301,11 +303,12 @@ func (is *IdentityServer) deleteApplication(ctx context.Context, ids *ttnpb.Appl
 			return errApplicationHasDevices.WithAttributes("count", int(total))
 		}
 		return store.GetApplicationStore(db).DeleteApplication(ctx, ids)
+	}, func() events.Event {
+		return evtDeleteApplication.NewWithIdentifiersAndData(ctx, ids, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtDeleteApplication.NewWithIdentifiersAndData(ctx, ids, nil))
 	return ttnpb.Empty, nil
 }
 
@@ -313,7 +316,7 @@ func (is *IdentityServer) restoreApplication(ctx context.Context, ids *ttnpb.App
 	if err := rights.RequireApplication(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_APPLICATION_DELETE); err != nil {
 		return nil, err
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
 		appStore := store.GetApplicationStore(db)
 		app, err := appStore.GetApplication(store.WithSoftDeleted(ctx, true), ids, softDeleteFieldMask)
 		if err != nil {
@@ -322,15 +325,16 @@ func (is *IdentityServer) restoreApplication(ctx context.Context, ids *ttnpb.App
 		if app.DeletedAt == nil {
 			panic("store.WithSoftDeleted(ctx, true) returned result that is not deleted")
 		}
-		if time.Since(*app.DeletedAt) > is.configFromContext(ctx).Delete.Restore {
+		if time.Since(*app.DeletedAt) > is.configFromContext(ctx).DeleteRetention("application") {
 			return errRestoreWindowExpired.New()
 		}
 		return appStore.RestoreApplication(ctx, ids)
+	}, func() events.Event {
+		return evtRestoreApplication.NewWithIdentifiersAndData(ctx, ids, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtRestoreApplication.NewWithIdentifiersAndData(ctx, ids, nil))
 	return ttnpb.Empty, nil
 }
 
@@ -338,35 +342,14 @@ func (is *IdentityServer) purgeApplication(ctx context.Context, ids *ttnpb.Appli
 	if !is.IsAdmin(ctx) {
 		return nil, errAdminsPurgeApplications.New()
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		total, err := store.GetEndDeviceStore(db).CountEndDevices(ctx, ids)
-		if err != nil {
-			return err
-		}
-		if total > 0 {
-			return errApplicationHasDevices.WithAttributes("count", int(total))
-		}
-		// delete related API keys before purging the application
-		err = store.GetAPIKeyStore(db).DeleteEntityAPIKeys(ctx, ids.GetEntityIdentifiers())
-		if err != nil {
-			return err
-		}
-		// delete related memberships before purging the application
-		err = store.GetMembershipStore(db).DeleteEntityMembers(ctx, ids.GetEntityIdentifiers())
-		if err != nil {
-			return err
-		}
-		// delete related contact info before purging the application
-		err = store.GetContactInfoStore(db).DeleteEntityContactInfo(ctx, ids)
-		if err != nil {
-			return err
-		}
-		return store.GetApplicationStore(db).PurgeApplication(ctx, ids)
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
+		return PurgeEntity(ctx, db, ids.GetEntityIdentifiers())
+	}, func() events.Event {
+		return evtPurgeApplication.NewWithIdentifiersAndData(ctx, ids, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtPurgeApplication.NewWithIdentifiersAndData(ctx, ids, nil))
 	return ttnpb.Empty, nil
 }
 
@@ -378,18 +361,19 @@ func (is *IdentityServer) issueDevEUI(ctx context.Context, ids *ttnpb.Applicatio
 		return nil, errDevEUIIssuingNotEnabled.New()
 	}
 	res := &ttnpb.IssueDevEUIResponse{}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
 		devEUI, err := store.GetEUIStore(db).IssueDevEUIForApplication(ctx, ids, is.config.DevEUIBlock.ApplicationLimit)
 		if err != nil {
 			return err
 		}
 		res.DevEui = *devEUI
 		return nil
+	}, func() events.Event {
+		return evtIssueDevEUIForApplication.NewWithIdentifiersAndData(ctx, ids, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtIssueDevEUIForApplication.NewWithIdentifiersAndData(ctx, ids, nil))
 	return res, nil
 }
 
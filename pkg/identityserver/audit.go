@@ -0,0 +1,150 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// auditRetentionInterval is the interval at which audit entries that exceeded the retention are deleted.
+const auditRetentionInterval = time.Hour
+
+// recordAuditEntry records the event in the audit log using the given database (or transaction).
+func (is *IdentityServer) recordAuditEntry(ctx context.Context, db *gorm.DB, evt events.Event) error {
+	if !is.config.Audit.Enabled {
+		return nil
+	}
+	return store.GetAuditStore(db).CreateAuditEntry(ctx, is.auditEntry(ctx, evt))
+}
+
+// withAuditedDatabase runs f in a database transaction and records the event that evt returns in the audit log in
+// the same transaction, so that changes are never committed without their audit entry. evt is called after f
+// succeeds, so that the event can depend on the outcome of f. The event is published after the transaction is
+// committed.
+func (is *IdentityServer) withAuditedDatabase(ctx context.Context, f func(*gorm.DB) error, evt func() events.Event) error {
+	var e events.Event
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		if err := f(db); err != nil {
+			return err
+		}
+		e = evt()
+		return is.recordAuditEntry(ctx, db, e)
+	})
+	if err != nil {
+		return err
+	}
+	events.Publish(e)
+	return nil
+}
+
+// publishEvent publishes the event and records it in the audit log. This is used for events that are not the result
+// of a change in the database, such as failed password attempts; changes use withAuditedDatabase.
+func (is *IdentityServer) publishEvent(evt events.Event) {
+	events.Publish(evt)
+	ctx := evt.Context()
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return is.recordAuditEntry(ctx, db, evt)
+	})
+	if err != nil {
+		log.FromContext(ctx).WithError(err).WithField("action", evt.Name()).Warn("Failed to record audit entry")
+	}
+}
+
+func entityString(ctx context.Context, ids *ttnpb.EntityIdentifiers) string {
+	return fmt.Sprintf("%s:%s", ids.EntityType(), unique.ID(ctx, ids))
+}
+
+// auditEntry returns the audit entry of the event. The actor is the account or entity that authenticated the request.
+func (is *IdentityServer) auditEntry(ctx context.Context, evt events.Event) *store.AuditEntry {
+	entry := &store.AuditEntry{
+		Action:        evt.Name(),
+		AuthType:      evt.AuthType(),
+		AuthTokenType: evt.AuthTokenType(),
+		AuthTokenID:   evt.AuthTokenID(),
+		SourceIP:      evt.RemoteIP(),
+		UserAgent:     evt.UserAgent(),
+	}
+	for i, ids := range evt.Identifiers() {
+		if i == 0 {
+			entry.EntityType, entry.EntityID = ids.EntityType(), unique.ID(ctx, ids)
+			continue
+		}
+		entry.RelatedEntities = append(entry.RelatedEntities, entityString(ctx, ids))
+	}
+	if paths, ok := evt.Data().([]string); ok {
+		entry.Paths = paths
+	}
+	if authInfo, err := is.authInfo(ctx); err == nil {
+		switch {
+		case authInfo.GetApiKey() != nil:
+			if ids := authInfo.GetApiKey().GetEntityIds(); ids.Ids != nil {
+				entry.ActorType, entry.ActorID = ids.EntityType(), unique.ID(ctx, ids)
+			}
+		case authInfo.GetOauthAccessToken() != nil:
+			entry.ActorType, entry.ActorID = "user", authInfo.GetOauthAccessToken().GetUserIds().UserId
+		case authInfo.GetUserSession() != nil:
+			entry.ActorType, entry.ActorID = "user", authInfo.GetUserSession().GetUserIds().UserId
+		case authInfo.GetUniversalRights() != nil:
+			entry.ActorType = "cluster"
+		}
+	}
+	return entry
+}
+
+// registerAuditRetention registers the task that deletes audit entries that exceeded the retention.
+func (is *IdentityServer) registerAuditRetention() {
+	retention := is.config.Audit.Retention
+	if !is.config.Audit.Enabled || retention <= 0 {
+		return
+	}
+	is.RegisterTask(&component.TaskConfig{
+		Context: is.Context(),
+		ID:      "audit_retention",
+		Func: func(ctx context.Context) error {
+			ticker := time.NewTicker(auditRetentionInterval)
+			defer ticker.Stop()
+			for {
+				var deleted int64
+				err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+					deleted, err = store.GetAuditStore(db).DeleteAuditEntries(ctx, time.Now().Add(-retention))
+					return err
+				})
+				if err != nil {
+					return err
+				}
+				if deleted > 0 {
+					log.FromContext(ctx).WithField("count", deleted).Debug("Deleted expired audit entries")
+				}
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-ticker.C:
+				}
+			}
+		},
+		Restart: component.TaskRestartOnFailure,
+		Backoff: component.DefaultTaskBackoffConfig,
+	})
+}
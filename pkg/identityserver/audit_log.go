@@ -0,0 +1,110 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+func auditEntryToPB(model *store.AuditEntry) *ttnpb.AuditEntry {
+	createdAt := model.CreatedAt
+	return &ttnpb.AuditEntry{
+		Id:              model.ID,
+		CreatedAt:       &createdAt,
+		Action:          model.Action,
+		EntityType:      model.EntityType,
+		EntityId:        model.EntityID,
+		RelatedEntities: model.RelatedEntities,
+		Paths:           model.Paths,
+		ActorType:       model.ActorType,
+		ActorId:         model.ActorID,
+		AuthType:        model.AuthType,
+		AuthTokenType:   model.AuthTokenType,
+		AuthTokenId:     model.AuthTokenID,
+		SourceIp:        model.SourceIP,
+		UserAgent:       model.UserAgent,
+	}
+}
+
+// requireAuditRights requires the audit log right on the entity. Only admins can list the entries of all entities.
+func (is *IdentityServer) requireAuditRights(ctx context.Context, ids *ttnpb.EntityIdentifiers) error {
+	if is.IsAdmin(ctx) {
+		return nil
+	}
+	switch ids := ids.GetIds().(type) {
+	case *ttnpb.EntityIdentifiers_ApplicationIds:
+		return rights.RequireApplication(ctx, *ids.ApplicationIds, ttnpb.RIGHT_APPLICATION_AUDIT_LOG)
+	case *ttnpb.EntityIdentifiers_ClientIds:
+		return rights.RequireClient(ctx, *ids.ClientIds, ttnpb.RIGHT_CLIENT_ALL)
+	case *ttnpb.EntityIdentifiers_DeviceIds:
+		return rights.RequireApplication(ctx, ids.DeviceIds.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_AUDIT_LOG)
+	case *ttnpb.EntityIdentifiers_GatewayIds:
+		return rights.RequireGateway(ctx, *ids.GatewayIds, ttnpb.RIGHT_GATEWAY_AUDIT_LOG)
+	case *ttnpb.EntityIdentifiers_OrganizationIds:
+		return rights.RequireOrganization(ctx, *ids.OrganizationIds, ttnpb.RIGHT_ORGANIZATION_AUDIT_LOG)
+	case *ttnpb.EntityIdentifiers_UserIds:
+		return rights.RequireUser(ctx, *ids.UserIds, ttnpb.RIGHT_USER_AUDIT_LOG)
+	default:
+		return errPermissionDenied.New()
+	}
+}
+
+func (is *IdentityServer) listAuditEntries(ctx context.Context, req *ttnpb.ListAuditEntriesRequest) (entries *ttnpb.AuditEntries, err error) {
+	if err := is.requireUnrestricted(ctx); err != nil {
+		return nil, err
+	}
+	entityIDs := req.GetEntityIds()
+	if entityIDs.GetIds() == nil {
+		entityIDs = nil
+	}
+	if err := is.requireAuditRights(ctx, entityIDs); err != nil {
+		return nil, err
+	}
+	var total uint64
+	ctx = store.WithPagination(ctx, req.Limit, req.Page, &total)
+	defer func() {
+		if err == nil {
+			setTotalHeader(ctx, total)
+		}
+	}()
+	var models []*store.AuditEntry
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		models, err = store.GetAuditStore(db).FindAuditEntries(ctx, entityIDs, req.After, req.Before)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	entries = &ttnpb.AuditEntries{
+		Entries: make([]*ttnpb.AuditEntry, len(models)),
+	}
+	for i, model := range models {
+		entries.Entries[i] = auditEntryToPB(model)
+	}
+	return entries, nil
+}
+
+type auditLog struct {
+	*IdentityServer
+}
+
+func (al *auditLog) ListAuditEntries(ctx context.Context, req *ttnpb.ListAuditEntriesRequest) (*ttnpb.AuditEntries, error) {
+	return al.listAuditEntries(ctx, req)
+}
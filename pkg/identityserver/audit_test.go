@@ -0,0 +1,133 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"google.golang.org/grpc"
+)
+
+func TestAuditLog(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		user, creds := defaultUser, userCreds(defaultUserIdx)
+		appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "audit-app"}
+
+		reg := ttnpb.NewApplicationRegistryClient(cc)
+		_, err := reg.Create(ctx, &ttnpb.CreateApplicationRequest{
+			Application:  &ttnpb.Application{Ids: appIDs},
+			Collaborator: *user.GetIds().OrganizationOrUserIdentifiers(),
+		}, creds)
+		a.So(err, should.BeNil)
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateApplicationRequest{
+			Application: &ttnpb.Application{Ids: appIDs, Name: "Audit Application"},
+			FieldMask:   &pbtypes.FieldMask{Paths: []string{"name"}},
+		}, creds)
+		a.So(err, should.BeNil)
+
+		var entries []*store.AuditEntry
+		err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+			entries, err = store.GetAuditStore(db).FindAuditEntries(ctx, appIDs.GetEntityIdentifiers(), nil, nil)
+			return err
+		})
+		if a.So(err, should.BeNil) && a.So(entries, should.HaveLength, 2) {
+			a.So(entries[0].Action, should.Equal, "application.update")
+			a.So([]string(entries[0].Paths), should.Resemble, []string{"name"})
+			a.So(entries[1].Action, should.Equal, "application.create")
+			for _, entry := range entries {
+				a.So(entry.EntityType, should.Equal, "application")
+				a.So(entry.EntityID, should.Equal, "audit-app")
+				a.So(entry.ActorType, should.Equal, "user")
+				a.So(entry.ActorID, should.Equal, user.GetIds().GetUserId())
+			}
+		}
+
+		auditLog := ttnpb.NewAuditLogClient(cc)
+		res, err := auditLog.ListAuditEntries(ctx, &ttnpb.ListAuditEntriesRequest{
+			EntityIds: appIDs.GetEntityIdentifiers(),
+		}, creds)
+		if a.So(err, should.BeNil) && a.So(res.Entries, should.HaveLength, 2) {
+			a.So(res.Entries[0].Action, should.Equal, "application.update")
+			a.So(res.Entries[0].CreatedAt, should.NotBeNil)
+		}
+
+		_, err = auditLog.ListAuditEntries(ctx, &ttnpb.ListAuditEntriesRequest{}, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		key, err := ttnpb.NewApplicationAccessClient(cc).CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+			ApplicationIds: appIDs,
+			Rights:         []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+		}, creds)
+		if a.So(err, should.BeNil) {
+			_, err = auditLog.ListAuditEntries(ctx, &ttnpb.ListAuditEntriesRequest{
+				EntityIds: appIDs.GetEntityIdentifiers(),
+			}, grpc.PerRPCCredentials(rpcmetadata.MD{
+				AuthType:      "bearer",
+				AuthValue:     key.Key,
+				AllowInsecure: true,
+			}))
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsPermissionDenied(err), should.BeTrue)
+			}
+
+			_, err = ttnpb.NewApplicationAccessClient(cc).UpdateAPIKey(ctx, &ttnpb.UpdateApplicationAPIKeyRequest{
+				ApplicationIds: appIDs,
+				ApiKey:         &ttnpb.APIKey{Id: key.Id, Name: "Audit API Key"},
+				FieldMask:      &pbtypes.FieldMask{Paths: []string{"name"}},
+			}, creds)
+			a.So(err, should.BeNil)
+
+			res, err = auditLog.ListAuditEntries(ctx, &ttnpb.ListAuditEntriesRequest{
+				EntityIds: appIDs.GetEntityIdentifiers(),
+			}, creds)
+			if a.So(err, should.BeNil) && a.So(res.Entries, should.HaveLength, 4) {
+				a.So(res.Entries[0].Action, should.Equal, "application.api-key.update")
+				a.So(res.Entries[0].Paths, should.Resemble, []string{"name"})
+				a.So(res.Entries[1].Action, should.Equal, "application.api-key.create")
+			}
+		}
+
+		_, err = ttnpb.NewUserRegistryClient(cc).UpdatePassword(ctx, &ttnpb.UpdateUserPasswordRequest{
+			UserIds: user.GetIds(),
+			Old:     "incorrect-old-password",
+			New:     "N3w-p@ssw0rd-f0r-aud1t",
+		}, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsUnauthenticated(err), should.BeTrue)
+		}
+		err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+			entries, err = store.GetAuditStore(db).FindAuditEntries(ctx, user.GetIds().GetEntityIdentifiers(), nil, nil)
+			return err
+		})
+		if a.So(err, should.BeNil) && a.So(entries, should.NotBeEmpty) {
+			a.So(entries[0].Action, should.Equal, "user.update.incorrect_password")
+		}
+	})
+}
@@ -92,7 +92,7 @@ func (is *IdentityServer) setClientCollaborator(ctx context.Context, req *ttnpb.
 		return nil, err
 	}
 
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
 		store := is.getMembershipStore(ctx, db)
 
 		existingRights, err := store.GetMember(
@@ -148,12 +148,16 @@ func (is *IdentityServer) setClientCollaborator(ctx context.Context, req *ttnpb.
 			req.GetClientIds().GetEntityIdentifiers(),
 			ttnpb.RightsFrom(req.Collaborator.Rights...),
 		)
+	}, func() events.Event {
+		if len(req.Collaborator.Rights) > 0 {
+			return evtUpdateClientCollaborator.New(ctx, events.WithIdentifiers(req.GetClientIds(), req.GetCollaborator().GetIds()))
+		}
+		return evtDeleteClientCollaborator.New(ctx, events.WithIdentifiers(req.GetClientIds(), req.GetCollaborator().GetIds()))
 	})
 	if err != nil {
 		return nil, err
 	}
 	if len(req.Collaborator.Rights) > 0 {
-		events.Publish(evtUpdateClientCollaborator.New(ctx, events.WithIdentifiers(req.GetClientIds(), req.GetCollaborator().GetIds())))
 		err = is.SendContactsEmail(ctx, req, func(data emails.Data) email.MessageData {
 			data.SetEntity(req)
 			return &emails.CollaboratorChanged{Data: data, Collaborator: *req.GetCollaborator()}
@@ -161,8 +165,6 @@ func (is *IdentityServer) setClientCollaborator(ctx context.Context, req *ttnpb.
 		if err != nil {
 			log.FromContext(ctx).WithError(err).Error("Could not send collaborator updated notification email")
 		}
-	} else {
-		events.Publish(evtDeleteClientCollaborator.New(ctx, events.WithIdentifiers(req.GetClientIds(), req.GetCollaborator().GetIds())))
 	}
 	return ttnpb.Empty, nil
 }
@@ -114,7 +114,7 @@ func (is *IdentityServer) createClient(ctx context.Context, req *ttnpb.CreateCli
 		req.Client.Endorsed = false
 	}
 
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		cli, err = store.GetClientStore(db).CreateClient(ctx, req.Client)
 		if err != nil {
 			return err
@@ -135,6 +135,8 @@ func (is *IdentityServer) createClient(ctx context.Context, req *ttnpb.CreateCli
 			}
 		}
 		return nil
+	}, func() events.Event {
+		return evtCreateClient.NewWithIdentifiersAndData(ctx, req.Client.GetIds(), nil)
 	})
 	if err != nil {
 		return nil, err
@@ -156,7 +158,6 @@ func (is *IdentityServer) createClient(ctx context.Context, req *ttnpb.CreateCli
 
 	cli.Secret = secret // Return the unhashed secret, in case it was generated.
 
-	events.Publish(evtCreateClient.NewWithIdentifiersAndData(ctx, req.Client.GetIds(), nil))
 	return cli, nil
 }
 
@@ -305,7 +306,7 @@ func (is *IdentityServer) updateClient(ctx context.Context, req *ttnpb.UpdateCli
 		}
 	}
 
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		cli, err = store.GetClientStore(db).UpdateClient(ctx, req.Client, req.FieldMask)
 		if err != nil {
 			return err
@@ -318,11 +319,12 @@ func (is *IdentityServer) updateClient(ctx context.Context, req *ttnpb.UpdateCli
 			}
 		}
 		return nil
+	}, func() events.Event {
+		return evtUpdateClient.NewWithIdentifiersAndData(ctx, req.Client.GetIds(), req.FieldMask.GetPaths())
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtUpdateClient.NewWithIdentifiersAndData(ctx, req.Client.GetIds(), req.FieldMask.GetPaths()))
 	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "state") {
 		err = is.SendContactsEmail(ctx, req, func(data emails.Data) email.MessageData {
 			data.SetEntity(req)
@@ -343,13 +345,14 @@ func (is *IdentityServer) deleteClient(ctx context.Context, ids *ttnpb.ClientIde
 	if err := rights.RequireClient(ctx, *ids, ttnpb.RIGHT_CLIENT_ALL); err != nil {
 		return nil, err
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
 		return store.GetClientStore(db).DeleteClient(ctx, ids)
+	}, func() events.Event {
+		return evtDeleteClient.NewWithIdentifiersAndData(ctx, ids, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtDeleteClient.NewWithIdentifiersAndData(ctx, ids, nil))
 	return ttnpb.Empty, nil
 }
 
@@ -357,7 +360,7 @@ func (is *IdentityServer) restoreClient(ctx context.Context, ids *ttnpb.ClientId
 	if err := rights.RequireClient(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_CLIENT_ALL); err != nil {
 		return nil, err
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
 		cliStore := store.GetClientStore(db)
 		cli, err := cliStore.GetClient(store.WithSoftDeleted(ctx, true), ids, softDeleteFieldMask)
 		if err != nil {
@@ -366,15 +369,16 @@ func (is *IdentityServer) restoreClient(ctx context.Context, ids *ttnpb.ClientId
 		if cli.DeletedAt == nil {
 			panic("store.WithSoftDeleted(ctx, true) returned result that is not deleted")
 		}
-		if time.Since(*cli.DeletedAt) > is.configFromContext(ctx).Delete.Restore {
+		if time.Since(*cli.DeletedAt) > is.configFromContext(ctx).DeleteRetention("client") {
 			return errRestoreWindowExpired.New()
 		}
 		return cliStore.RestoreClient(ctx, ids)
+	}, func() events.Event {
+		return evtRestoreClient.NewWithIdentifiersAndData(ctx, ids, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtRestoreClient.NewWithIdentifiersAndData(ctx, ids, nil))
 	return ttnpb.Empty, nil
 }
 
@@ -382,28 +386,14 @@ func (is *IdentityServer) purgeClient(ctx context.Context, ids *ttnpb.ClientIden
 	if !is.IsAdmin(ctx) {
 		return nil, errAdminsPurgeClients.New()
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		// delete related authorizations before purging the client
-		err := store.GetOAuthStore(db).DeleteClientAuthorizations(ctx, ids)
-		if err != nil {
-			return err
-		}
-		// delete related memberships before purging the client
-		err = store.GetMembershipStore(db).DeleteEntityMembers(ctx, ids.GetEntityIdentifiers())
-		if err != nil {
-			return err
-		}
-		// delete related contact info before purging the client
-		err = store.GetContactInfoStore(db).DeleteEntityContactInfo(ctx, ids)
-		if err != nil {
-			return err
-		}
-		return store.GetClientStore(db).PurgeClient(ctx, ids)
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
+		return PurgeEntity(ctx, db, ids.GetEntityIdentifiers())
+	}, func() events.Event {
+		return evtPurgeClient.NewWithIdentifiersAndData(ctx, ids, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtPurgeClient.NewWithIdentifiersAndData(ctx, ids, nil))
 	return ttnpb.Empty, nil
 }
 
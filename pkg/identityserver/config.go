@@ -85,6 +85,7 @@ type Config struct {
 		Enabled  bool          `name:"enabled" description:"enable users requesting login tokens"`
 		TokenTTL time.Duration `name:"token-ttl" description:"TTL of login tokens"`
 	} `name:"login-tokens"`
+	MFA   oauth.MFAConfig `name:"mfa" description:"Multi-factor authentication policy"`
 	Email struct {
 		email.Config `name:",squash"`
 		SendGrid     sendgrid.Config      `name:"sendgrid"`
@@ -94,8 +95,24 @@ type Config struct {
 	Gateways struct {
 		EncryptionKeyID string `name:"encryption-key-id" description:"ID of the key used to encrypt gateway secrets at rest"`
 	} `name:"gateways"`
+	Audit struct {
+		Enabled   bool          `name:"enabled" description:"Record changes of entities in the audit log"`
+		Retention time.Duration `name:"retention" description:"How long entries are kept in the audit log (0 is forever)"`
+	} `name:"audit"`
+	SCIM struct {
+		Enabled      bool     `name:"enabled" description:"Enable the SCIM 2.0 endpoint for provisioning users and organizations"`
+		MemberRights []string `name:"member-rights" description:"Rights of users that are provisioned as organization members"`
+	} `name:"scim"`
 	Delete struct {
-		Restore time.Duration `name:"restore" description:"How long after soft-deletion an entity can be restored"`
+		Restore   time.Duration `name:"restore" description:"How long after soft-deletion an entity can be restored"`
+		Retention struct {
+			Applications  time.Duration `name:"applications" description:"How long after soft-deletion an application can be restored (default restore)"`
+			Clients       time.Duration `name:"clients" description:"How long after soft-deletion an OAuth client can be restored (default restore)"`
+			Gateways      time.Duration `name:"gateways" description:"How long after soft-deletion a gateway can be restored (default restore)"`
+			Organizations time.Duration `name:"organizations" description:"How long after soft-deletion an organization can be restored (default restore)"`
+			Users         time.Duration `name:"users" description:"How long after soft-deletion a user can be restored (default restore)"`
+		} `name:"retention"`
+		PurgeInterval time.Duration `name:"purge-interval" description:"Interval at which soft-deleted entities that can no longer be restored are purged (0 is disabled)"`
 	} `name:"delete"`
 	DevEUIBlock struct {
 		Enabled          bool                 `name:"enabled" description:"Enable DevEUI address issuing from IEEE MAC block"`
@@ -197,6 +214,28 @@ func (c Config) toProto() *ttnpb.IsConfiguration {
 	}
 }
 
+// DeleteRetention returns how long after soft-deletion entities of the given type can be restored,
+// after which they are purged.
+func (c Config) DeleteRetention(entityType string) time.Duration {
+	var retention time.Duration
+	switch entityType {
+	case "application":
+		retention = c.Delete.Retention.Applications
+	case "client":
+		retention = c.Delete.Retention.Clients
+	case "gateway":
+		retention = c.Delete.Retention.Gateways
+	case "organization":
+		retention = c.Delete.Retention.Organizations
+	case "user":
+		retention = c.Delete.Retention.Users
+	}
+	if retention == 0 {
+		return c.Delete.Restore
+	}
+	return retention
+}
+
 // GetConfiguration implements the RPC that returns the configuration of the Identity Server.
 func (is *IdentityServer) GetConfiguration(ctx context.Context, _ *ttnpb.GetIsConfigurationRequest) (*ttnpb.GetIsConfigurationResponse, error) {
 	return &ttnpb.GetIsConfigurationResponse{
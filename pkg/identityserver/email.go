@@ -19,9 +19,11 @@ import (
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/v3/pkg/account"
 	"go.thethings.network/lorawan-stack/v3/pkg/email"
 	"go.thethings.network/lorawan-stack/v3/pkg/email/sendgrid"
 	"go.thethings.network/lorawan-stack/v3/pkg/email/smtp"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/emails"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
@@ -44,29 +46,34 @@ func (is *IdentityServer) initEmailTemplates(ctx context.Context) (*email.Templa
 	return email.NewTemplateRegistry(fetcher, config.Includes...)
 }
 
-// SendEmail sends an email.
-func (is *IdentityServer) SendEmail(ctx context.Context, f func(emails.Data) email.MessageData) (err error) {
+// renderEmail renders the email. The returned message is nil if there is no email to send.
+func (is *IdentityServer) renderEmail(ctx context.Context, f func(emails.Data) email.MessageData) (email.MessageData, *email.Message, error) {
 	isConfig := is.configFromContext(ctx)
-	var sender email.Sender
-	switch isConfig.Email.Provider {
-	case "sendgrid":
-		sender, err = sendgrid.New(ctx, isConfig.Email.Config, isConfig.Email.SendGrid)
-	case "smtp":
-		sender, err = smtp.New(ctx, isConfig.Email.Config, isConfig.Email.SMTP)
-	}
-	if err != nil {
-		return err
-	}
 	var data emails.Data
 	data.Network.Name = isConfig.Email.Network.Name
 	data.Network.IdentityServerURL = isConfig.Email.Network.IdentityServerURL
 	data.Network.ConsoleURL = isConfig.Email.Network.ConsoleURL
 	messageData := f(data)
 	if messageData == nil {
-		return nil
+		return nil, nil, nil
 	}
-
 	message, err := is.emailTemplates.Render(messageData)
+	if err != nil {
+		return nil, nil, err
+	}
+	return messageData, message, nil
+}
+
+// sendEmail sends the rendered email.
+func (is *IdentityServer) sendEmail(ctx context.Context, message *email.Message) (err error) {
+	isConfig := is.configFromContext(ctx)
+	var sender email.Sender
+	switch isConfig.Email.Provider {
+	case "sendgrid":
+		sender, err = sendgrid.New(ctx, isConfig.Email.Config, isConfig.Email.SendGrid)
+	case "smtp":
+		sender, err = smtp.New(ctx, isConfig.Email.Config, isConfig.Email.SMTP)
+	}
 	if err != nil {
 		return err
 	}
@@ -81,6 +88,15 @@ func (is *IdentityServer) SendEmail(ctx context.Context, f func(emails.Data) ema
 	return sender.Send(message)
 }
 
+// SendEmail sends an email.
+func (is *IdentityServer) SendEmail(ctx context.Context, f func(emails.Data) email.MessageData) error {
+	_, message, err := is.renderEmail(ctx, f)
+	if err != nil || message == nil {
+		return err
+	}
+	return is.sendEmail(ctx, message)
+}
+
 // SendUserEmail sends an email to the given user.
 func (is *IdentityServer) SendUserEmail(ctx context.Context, userIDs *ttnpb.UserIdentifiers, makeMessage func(emails.Data) email.MessageData) error {
 	var usr *ttnpb.User
@@ -96,7 +112,7 @@ func (is *IdentityServer) SendUserEmail(ctx context.Context, userIDs *ttnpb.User
 	if err != nil {
 		return err
 	}
-	err = is.SendEmail(ctx, func(data emails.Data) email.MessageData {
+	err = is.sendUserEmail(ctx, usr, func(data emails.Data) email.MessageData {
 		data.SetUser(usr)
 		return makeMessage(data)
 	})
@@ -106,6 +122,13 @@ func (is *IdentityServer) SendUserEmail(ctx context.Context, userIDs *ttnpb.User
 	return nil
 }
 
+// notifyMFAChange sends an email to the user about the change of their multi-factor authentication.
+func (is *IdentityServer) notifyMFAChange(ctx context.Context, userIDs *ttnpb.UserIdentifiers, change account.MFAChange) error {
+	return is.SendUserEmail(ctx, userIDs, func(data emails.Data) email.MessageData {
+		return &emails.MFAChanged{Data: data, Change: string(change)}
+	})
+}
+
 // SendAdminsEmail sends an email to the admins of the network.
 func (is *IdentityServer) SendAdminsEmail(ctx context.Context, makeMessage func(emails.Data) email.MessageData) error {
 	var users []*ttnpb.User
@@ -122,7 +145,7 @@ func (is *IdentityServer) SendAdminsEmail(ctx context.Context, makeMessage func(
 		return err
 	}
 	for _, usr := range users {
-		err = is.SendEmail(ctx, func(data emails.Data) email.MessageData {
+		err = is.sendUserEmail(ctx, usr, func(data emails.Data) email.MessageData {
 			data.SetUser(usr)
 			return makeMessage(data)
 		})
@@ -134,11 +157,34 @@ func (is *IdentityServer) SendAdminsEmail(ctx context.Context, makeMessage func(
 }
 
 // SendContactsEmail sends an email to the contacts of the given entity.
+// Contacts that are the primary email address of a user are notified as that user.
 func (is *IdentityServer) SendContactsEmail(ctx context.Context, ids ttnpb.IDStringer, makeMessage func(emails.Data) email.MessageData) error {
 	var contacts []*ttnpb.ContactInfo
+	receivers := make(map[string]*ttnpb.User)
 	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		contacts, err = store.GetContactInfoStore(db).GetContactInfo(ctx, ids)
-		return err
+		if err != nil {
+			return err
+		}
+		for _, contactInfo := range contacts {
+			if contactInfo.ContactMethod != ttnpb.CONTACT_METHOD_EMAIL {
+				continue
+			}
+			if _, ok := receivers[contactInfo.Value]; ok {
+				continue
+			}
+			usr, err := store.GetUserStore(db).GetUserByPrimaryEmailAddress(ctx, contactInfo.Value, &pbtypes.FieldMask{
+				Paths: []string{"name", "primary_email_address"},
+			})
+			if err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return err
+			}
+			receivers[contactInfo.Value] = usr
+		}
+		return nil
 	})
 	if err != nil {
 		return err
@@ -147,11 +193,20 @@ func (is *IdentityServer) SendContactsEmail(ctx context.Context, ids ttnpb.IDStr
 		if contactInfo.ContactMethod != ttnpb.CONTACT_METHOD_EMAIL {
 			continue
 		}
-		err := is.SendEmail(ctx, func(data emails.Data) email.MessageData {
-			data.SetEntity(ids)
-			data.SetContact(contactInfo)
-			return makeMessage(data)
-		})
+		if usr, ok := receivers[contactInfo.Value]; ok {
+			err = is.sendUserEmail(ctx, usr, func(data emails.Data) email.MessageData {
+				data.SetUser(usr)
+				data.SetEntity(ids)
+				data.SetContact(contactInfo)
+				return makeMessage(data)
+			})
+		} else {
+			err = is.SendEmail(ctx, func(data emails.Data) email.MessageData {
+				data.SetEntity(ids)
+				data.SetContact(contactInfo)
+				return makeMessage(data)
+			})
+		}
 		if err != nil {
 			return err
 		}
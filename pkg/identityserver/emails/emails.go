@@ -76,6 +76,11 @@ func (d *Data) SetContact(contact *ttnpb.ContactInfo) {
 	}
 }
 
+// GetData returns the data of the email.
+func (d Data) GetData() Data {
+	return d
+}
+
 // Recipient returns the recipient info of the email.
 func (d Data) Recipient() (name, address string) {
 	return d.User.Name, d.User.Email
@@ -0,0 +1,50 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emails
+
+// MFAChanged is the email that is sent when users change their multi-factor authentication.
+type MFAChanged struct {
+	Data
+	// Change is the change of the multi-factor authentication, such as enabled, disabled or
+	// recovery_codes_regenerated.
+	Change string
+}
+
+// TemplateName returns the name of the template to use for this email.
+func (MFAChanged) TemplateName() string { return "mfa_changed" }
+
+const mfaChangedSubject = `Multi-factor authentication for {{.User.ID}} was changed`
+
+const mfaChangedText = `Dear {{.User.Name}},
+
+{{ if eq .Change "enabled" -}}
+Multi-factor authentication was just enabled for your user "{{.User.ID}}" on {{.Network.Name}}.
+{{- else if eq .Change "disabled" -}}
+Multi-factor authentication was just disabled for your user "{{.User.ID}}" on {{.Network.Name}}.
+{{- else if eq .Change "recovery_codes_regenerated" -}}
+The multi-factor authentication recovery codes of your user "{{.User.ID}}" on {{.Network.Name}} were just regenerated. Your previous recovery codes can no longer be used.
+{{- else if eq .Change "recovery_code_used" -}}
+A multi-factor authentication recovery code was just used to log in to your user "{{.User.ID}}" on {{.Network.Name}}.
+{{- else -}}
+The multi-factor authentication of your user "{{.User.ID}}" on {{.Network.Name}} was just changed.
+{{- end }}
+
+If this was not done by you, please contact us as soon as possible.
+`
+
+// DefaultTemplates returns the default templates for this email.
+func (MFAChanged) DefaultTemplates() (subject, html, text string) {
+	return mfaChangedSubject, "", mfaChangedText
+}
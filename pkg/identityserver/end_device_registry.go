@@ -71,12 +71,14 @@ func (is *IdentityServer) createEndDevice(ctx context.Context, req *ttnpb.Create
 	}
 	defer func() { is.setFullEndDevicePictureURL(ctx, dev) }()
 
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		dev, err = store.GetEndDeviceStore(db).CreateEndDevice(ctx, &req.EndDevice)
 		if err != nil {
 			return err
 		}
 		return nil
+	}, func() events.Event {
+		return evtCreateEndDevice.NewWithIdentifiersAndData(ctx, &req.EndDeviceIdentifiers, nil)
 	})
 	if err != nil {
 		if errors.IsAlreadyExists(err) && errors.Resemble(err, store.ErrEUITaken) {
@@ -94,7 +96,6 @@ func (is *IdentityServer) createEndDevice(ctx context.Context, req *ttnpb.Create
 		}
 		return nil, err
 	}
-	events.Publish(evtCreateEndDevice.NewWithIdentifiersAndData(ctx, &req.EndDeviceIdentifiers, nil))
 	return dev, nil
 }
 
@@ -146,8 +147,14 @@ func (is *IdentityServer) listEndDevices(ctx context.Context, req *ttnpb.ListEnd
 			return nil, err
 		}
 		req.FieldMask = cleanFieldMaskPaths([]string{"ids"}, req.FieldMask, nil, []string{"created_at", "updated_at"})
-	} else if err = rights.RequireApplication(ctx, *req.GetApplicationIds(), ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
-		return nil, err
+	} else {
+		ctx = rights.WithEndDeviceFilter(ctx)
+		if err = rights.RequireApplication(ctx, *req.GetApplicationIds(), ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+			return nil, err
+		}
+		if deviceIDs, ok := rights.RestrictedEndDeviceIDs(ctx); ok {
+			ctx = store.WithEndDeviceIDsFilter(ctx, deviceIDs)
+		}
 	}
 	req.FieldMask = cleanFieldMaskPaths(ttnpb.EndDeviceFieldPathsNested, req.FieldMask, getPaths, nil)
 	ctx = store.WithOrder(ctx, req.Order)
@@ -215,14 +222,15 @@ func (is *IdentityServer) updateEndDevice(ctx context.Context, req *ttnpb.Update
 		defer func() { is.setFullEndDevicePictureURL(ctx, dev) }()
 	}
 
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		dev, err = store.GetEndDeviceStore(db).UpdateEndDevice(ctx, &req.EndDevice, req.FieldMask)
 		return err
+	}, func() events.Event {
+		return evtUpdateEndDevice.NewWithIdentifiersAndData(ctx, &req.EndDeviceIdentifiers, req.FieldMask.GetPaths())
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtUpdateEndDevice.NewWithIdentifiersAndData(ctx, &req.EndDeviceIdentifiers, req.FieldMask.GetPaths()))
 	return dev, nil
 }
 
@@ -230,13 +238,14 @@ func (is *IdentityServer) deleteEndDevice(ctx context.Context, ids *ttnpb.EndDev
 	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
 		return store.GetEndDeviceStore(db).DeleteEndDevice(ctx, ids)
+	}, func() events.Event {
+		return evtDeleteEndDevice.NewWithIdentifiersAndData(ctx, ids, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtDeleteEndDevice.NewWithIdentifiersAndData(ctx, ids, nil))
 	return ttnpb.Empty, nil
 }
 
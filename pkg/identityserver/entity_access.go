@@ -54,8 +54,10 @@ type requestAccessKeyType struct{}
 var requestAccessKey requestAccessKeyType
 
 type requestAccess struct {
-	authInfo     *ttnpb.AuthInfoResponse
-	entityRights map[*ttnpb.EntityIdentifiers]*ttnpb.Rights
+	authInfo            *ttnpb.AuthInfoResponse
+	entityRights        map[*ttnpb.EntityIdentifiers]*ttnpb.Rights
+	restrictions        *ttnpb.APIKeyRestrictions
+	restrictionsFetched bool
 }
 
 func (is *IdentityServer) withRequestAccessCache(ctx context.Context) context.Context {
@@ -311,6 +313,48 @@ func (is *IdentityServer) AuthInfo(ctx context.Context) (*ttnpb.AuthInfoResponse
 	return is.authInfo(ctx)
 }
 
+// Restrictions implements rights.RestrictionsFetcher.
+// The end device attributes of the restrictions of application API keys are
+// resolved to the IDs of the end devices that currently have those attributes.
+func (is *IdentityServer) Restrictions(ctx context.Context) (restrictions *ttnpb.APIKeyRestrictions, err error) {
+	if access, ok := ctx.Value(requestAccessKey).(*requestAccess); ok {
+		if access.restrictionsFetched {
+			return access.restrictions, nil
+		}
+		defer func() {
+			if err == nil {
+				access.restrictions, access.restrictionsFetched = restrictions, true
+			}
+		}()
+	}
+
+	authInfo, err := is.authInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	apiKey := authInfo.GetApiKey()
+	restrictions = apiKey.GetApiKey().GetRestrictions()
+	entityIDs := apiKey.GetEntityIds()
+	appIDs := entityIDs.GetApplicationIds()
+	if len(restrictions.GetEndDeviceAttributes()) == 0 || appIDs == nil {
+		return restrictions, nil
+	}
+	var deviceIDs []string
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		deviceIDs, err = store.GetEndDeviceStore(db).FindEndDeviceIDsByAttributes(ctx, appIDs, restrictions.EndDeviceAttributes)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.APIKeyRestrictions{
+		EndDeviceIds:        append(append([]string(nil), restrictions.EndDeviceIds...), deviceIDs...),
+		EndDeviceAttributes: restrictions.EndDeviceAttributes,
+		SourceCidrs:         restrictions.SourceCidrs,
+		Methods:             restrictions.Methods,
+	}, nil
+}
+
 // RequireAuthenticated checks the request context for authentication presence
 // and returns an error if there is none.
 func (is *IdentityServer) RequireAuthenticated(ctx context.Context) error {
@@ -412,5 +456,18 @@ type entityAccess struct {
 }
 
 func (ea *entityAccess) AuthInfo(ctx context.Context, _ *pbtypes.Empty) (*ttnpb.AuthInfoResponse, error) {
-	return ea.authInfo(ctx)
+	authInfo, err := ea.authInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	restrictions, err := ea.Restrictions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if apiKey := authInfo.GetApiKey().GetApiKey(); apiKey != nil {
+		// NOTE: Other components can not resolve the end device attributes of the restrictions,
+		// so they get the restrictions with the end devices that currently have those attributes.
+		apiKey.Restrictions = restrictions
+	}
+	return authInfo, nil
 }
@@ -86,19 +86,24 @@ func (is *IdentityServer) createGatewayAPIKey(ctx context.Context, req *ttnpb.Cr
 	if err = rights.RequireGateway(ctx, *req.GetGatewayIds(), req.Rights...); err != nil {
 		return nil, err
 	}
+	if err = is.validateAPIKeyRestrictions(ctx, req.GetGatewayIds().GetEntityIdentifiers(), req.Restrictions); err != nil {
+		return nil, err
+	}
 	key, token, err := GenerateAPIKey(ctx, req.Name, req.ExpiresAt, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	key.Restrictions = req.Restrictions
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		key, err = store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.GetGatewayIds().GetEntityIdentifiers(), key)
 		return err
+	}, func() events.Event {
+		return evtCreateGatewayAPIKey.NewWithIdentifiersAndData(ctx, req.GetGatewayIds(), nil)
 	})
 	if err != nil {
 		return nil, err
 	}
 	key.Key = token
-	events.Publish(evtCreateGatewayAPIKey.NewWithIdentifiersAndData(ctx, req.GetGatewayIds(), nil))
 	err = is.SendContactsEmail(ctx, req, func(data emails.Data) email.MessageData {
 		data.SetEntity(req)
 		return &emails.APIKeyCreated{Data: data, Key: key, Rights: key.Rights}
@@ -159,9 +164,12 @@ func (is *IdentityServer) updateGatewayAPIKey(ctx context.Context, req *ttnpb.Up
 	if err = rights.RequireGateway(ctx, *req.GetGatewayIds(), ttnpb.RIGHT_GATEWAY_SETTINGS_API_KEYS); err != nil {
 		return nil, err
 	}
+	if err = is.validateAPIKeyRestrictions(ctx, req.GetGatewayIds().GetEntityIdentifiers(), req.ApiKey.GetRestrictions()); err != nil {
+		return nil, err
+	}
 
 	apiKey := req.GetApiKey()
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		if len(apiKey.Rights) > 0 {
 			_, key, err := store.GetAPIKeyStore(db).GetAPIKey(ctx, apiKey.Id)
 			if err != nil {
@@ -183,16 +191,19 @@ func (is *IdentityServer) updateGatewayAPIKey(ctx context.Context, req *ttnpb.Up
 
 		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.GetGatewayIds().GetEntityIdentifiers(), apiKey, req.FieldMask)
 		return err
+	}, func() events.Event {
+		if key == nil { // API key was deleted.
+			return evtDeleteGatewayAPIKey.NewWithIdentifiersAndData(ctx, req.GetGatewayIds(), nil)
+		}
+		return evtUpdateGatewayAPIKey.NewWithIdentifiersAndData(ctx, req.GetGatewayIds(), req.FieldMask.GetPaths())
 	})
 	if err != nil {
 		return nil, err
 	}
 	if key == nil { // API key was deleted.
-		events.Publish(evtDeleteGatewayAPIKey.NewWithIdentifiersAndData(ctx, req.GetGatewayIds(), nil))
 		return &ttnpb.APIKey{}, nil
 	}
 	key.Key = ""
-	events.Publish(evtUpdateGatewayAPIKey.NewWithIdentifiersAndData(ctx, req.GetGatewayIds(), nil))
 	err = is.SendContactsEmail(ctx, req, func(data emails.Data) email.MessageData {
 		data.SetEntity(req)
 		return &emails.APIKeyChanged{Data: data, Key: key, Rights: key.Rights}
@@ -236,7 +247,7 @@ func (is *IdentityServer) setGatewayCollaborator(ctx context.Context, req *ttnpb
 	if err := rights.RequireGateway(ctx, *req.GetGatewayIds(), ttnpb.RIGHT_GATEWAY_SETTINGS_COLLABORATORS); err != nil {
 		return nil, err
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
 		store := is.getMembershipStore(ctx, db)
 
 		existingRights, err := store.GetMember(
@@ -292,12 +303,16 @@ func (is *IdentityServer) setGatewayCollaborator(ctx context.Context, req *ttnpb
 			req.GetGatewayIds().GetEntityIdentifiers(),
 			ttnpb.RightsFrom(req.GetCollaborator().GetRights()...),
 		)
+	}, func() events.Event {
+		if len(req.GetCollaborator().GetRights()) > 0 {
+			return evtUpdateGatewayCollaborator.New(ctx, events.WithIdentifiers(req.GetGatewayIds(), req.GetCollaborator().GetIds()))
+		}
+		return evtDeleteGatewayCollaborator.New(ctx, events.WithIdentifiers(req.GetGatewayIds(), req.GetCollaborator().GetIds()))
 	})
 	if err != nil {
 		return nil, err
 	}
 	if len(req.GetCollaborator().GetRights()) > 0 {
-		events.Publish(evtUpdateGatewayCollaborator.New(ctx, events.WithIdentifiers(req.GetGatewayIds(), req.GetCollaborator().GetIds())))
 		err = is.SendContactsEmail(ctx, req, func(data emails.Data) email.MessageData {
 			data.SetEntity(req)
 			return &emails.CollaboratorChanged{Data: data, Collaborator: *req.GetCollaborator()}
@@ -305,8 +320,6 @@ func (is *IdentityServer) setGatewayCollaborator(ctx context.Context, req *ttnpb
 		if err != nil {
 			log.FromContext(ctx).WithError(err).Error("Could not send collaborator updated notification email")
 		}
-	} else {
-		events.Publish(evtDeleteGatewayCollaborator.New(ctx, events.WithIdentifiers(req.GetGatewayIds(), req.GetCollaborator().GetIds())))
 	}
 	return ttnpb.Empty, nil
 }
@@ -138,7 +138,7 @@ func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGa
 		reqGtw.ClaimAuthenticationCode.Secret.Value = value
 		reqGtw.ClaimAuthenticationCode.Secret.KeyId = is.config.Gateways.EncryptionKeyID
 	}
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		gtw, err = store.GetGatewayStore(db).CreateGateway(ctx, reqGtw)
 		if err != nil {
 			return err
@@ -159,6 +159,8 @@ func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGa
 			}
 		}
 		return nil
+	}, func() events.Event {
+		return evtCreateGateway.NewWithIdentifiersAndData(ctx, reqGtw.GetIds(), nil)
 	})
 	if err != nil {
 		if errors.IsAlreadyExists(err) && errors.Resemble(err, store.ErrEUITaken) {
@@ -173,7 +175,6 @@ func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGa
 		}
 		return nil, err
 	}
-	events.Publish(evtCreateGateway.NewWithIdentifiersAndData(ctx, reqGtw.GetIds(), nil))
 
 	return gtw, nil
 }
@@ -537,7 +538,7 @@ func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGa
 		}
 	}
 
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		gtw, err = store.GetGatewayStore(db).UpdateGateway(ctx, reqGtw, req.FieldMask)
 		if err != nil {
 			return err
@@ -550,11 +551,12 @@ func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGa
 			}
 		}
 		return nil
+	}, func() events.Event {
+		return evtUpdateGateway.NewWithIdentifiersAndData(ctx, reqGtw.GetIds(), req.FieldMask.GetPaths())
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtUpdateGateway.NewWithIdentifiersAndData(ctx, reqGtw.GetIds(), req.FieldMask.GetPaths()))
 
 	if len(ptCACSecret) != 0 {
 		gtw.ClaimAuthenticationCode.Secret.Value = ptCACSecret
@@ -573,13 +575,14 @@ func (is *IdentityServer) deleteGateway(ctx context.Context, ids *ttnpb.GatewayI
 	if err := rights.RequireGateway(ctx, *ids, ttnpb.RIGHT_GATEWAY_DELETE); err != nil {
 		return nil, err
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
 		return store.GetGatewayStore(db).DeleteGateway(ctx, ids)
+	}, func() events.Event {
+		return evtDeleteGateway.NewWithIdentifiersAndData(ctx, ids, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtDeleteGateway.NewWithIdentifiersAndData(ctx, ids, nil))
 	return ttnpb.Empty, nil
 }
 
@@ -587,7 +590,7 @@ func (is *IdentityServer) restoreGateway(ctx context.Context, ids *ttnpb.Gateway
 	if err := rights.RequireGateway(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_GATEWAY_DELETE); err != nil {
 		return nil, err
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
 		gtwStore := store.GetGatewayStore(db)
 		gtw, err := gtwStore.GetGateway(store.WithSoftDeleted(ctx, true), ids, softDeleteFieldMask)
 		if err != nil {
@@ -596,15 +599,16 @@ func (is *IdentityServer) restoreGateway(ctx context.Context, ids *ttnpb.Gateway
 		if gtw.DeletedAt == nil {
 			panic("store.WithSoftDeleted(ctx, true) returned result that is not deleted")
 		}
-		if time.Since(*gtw.DeletedAt) > is.configFromContext(ctx).Delete.Restore {
+		if time.Since(*gtw.DeletedAt) > is.configFromContext(ctx).DeleteRetention("gateway") {
 			return errRestoreWindowExpired.New()
 		}
 		return gtwStore.RestoreGateway(ctx, ids)
+	}, func() events.Event {
+		return evtRestoreGateway.NewWithIdentifiersAndData(ctx, ids, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtRestoreGateway.NewWithIdentifiersAndData(ctx, ids, nil))
 	return ttnpb.Empty, nil
 }
 
@@ -612,28 +616,14 @@ func (is *IdentityServer) purgeGateway(ctx context.Context, ids *ttnpb.GatewayId
 	if !is.IsAdmin(ctx) {
 		return nil, errAdminsPurgeGateways.New()
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		// delete related API keys before purging the gateway
-		err := store.GetAPIKeyStore(db).DeleteEntityAPIKeys(ctx, ids.GetEntityIdentifiers())
-		if err != nil {
-			return err
-		}
-		// delete related memberships before purging the gateway
-		err = store.GetMembershipStore(db).DeleteEntityMembers(ctx, ids.GetEntityIdentifiers())
-		if err != nil {
-			return err
-		}
-		// delete related contact info before purging the gateway
-		err = store.GetContactInfoStore(db).DeleteEntityContactInfo(ctx, ids)
-		if err != nil {
-			return err
-		}
-		return store.GetGatewayStore(db).PurgeGateway(ctx, ids)
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
+		return PurgeEntity(ctx, db, ids.GetEntityIdentifiers())
+	}, func() events.Event {
+		return evtPurgeGateway.NewWithIdentifiersAndData(ctx, ids, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtPurgeGateway.NewWithIdentifiersAndData(ctx, ids, nil))
 	return ttnpb.Empty, nil
 }
 
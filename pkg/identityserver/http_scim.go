@@ -0,0 +1,1127 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/gorilla/mux"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/webmiddleware"
+)
+
+const (
+	scimPathPrefix   = "/is/scim/v2"
+	scimContentType  = "application/scim+json"
+	scimDefaultCount = 100
+	scimMaxCount     = 200
+	scimMaxBodySize  = 1 << 20
+)
+
+var (
+	errSCIMAdminAPIKey         = errors.DefinePermissionDenied("scim_admin_api_key", "SCIM provisioning requires an API key of an admin user")
+	errSCIMInvalidBody         = errors.DefineInvalidArgument("scim_invalid_body", "invalid request body")
+	errSCIMInvalidIndex        = errors.DefineInvalidArgument("scim_invalid_index", "invalid `{parameter}` `{value}`")
+	errSCIMUserName            = errors.DefineInvalidArgument("scim_user_name", "no user ID can be derived from user name `{user_name}`")
+	errSCIMDisplayName         = errors.DefineInvalidArgument("scim_display_name", "no organization ID can be derived from display name `{display_name}`")
+	errSCIMMemberRight         = errors.DefineFailedPrecondition("scim_member_right", "invalid SCIM member right `{right}`")
+	errSCIMNotProvisioned      = errors.DefineFailedPrecondition("scim_not_provisioned", "user `{user_id}` is not provisioned through SCIM")
+	errSCIMGroupNotProvisioned = errors.DefineFailedPrecondition("scim_group_not_provisioned", "organization `{organization_id}` is not provisioned through SCIM")
+	errSCIMUserIDTaken         = errors.DefineAlreadyExists("scim_user_id_taken", "user ID `{user_id}` derived from user name `{user_name}` is already taken")
+	errSCIMUserNameTaken       = errors.DefineAlreadyExists("scim_user_name_taken", "user name `{user_name}` is already provisioned for user `{user_id}`")
+)
+
+var (
+	scimUserFieldMask = &pbtypes.FieldMask{Paths: []string{
+		"attributes", "created_at", "name", "primary_email_address", "state", "updated_at",
+	}}
+	scimOrganizationFieldMask = &pbtypes.FieldMask{Paths: []string{
+		"attributes", "created_at", "name", "updated_at",
+	}}
+)
+
+type scimAdminKeyType struct{}
+
+var scimAdminKey scimAdminKeyType
+
+// scimAdminFromContext returns the identifiers of the admin user that owns the API key of the SCIM request.
+// This admin user becomes the owner of provisioned organizations.
+func scimAdminFromContext(ctx context.Context) *ttnpb.UserIdentifiers {
+	ids, _ := ctx.Value(scimAdminKey).(*ttnpb.UserIdentifiers)
+	return ids
+}
+
+// scimWebAPI implements the SCIM 2.0 protocol for provisioning users and organizations from an identity provider.
+// SCIM Users map onto users, SCIM Groups map onto organizations and their user members.
+type scimWebAPI struct {
+	*IdentityServer
+}
+
+// RegisterRoutes implements web.Registerer.
+func (api *scimWebAPI) RegisterRoutes(server *web.Server) {
+	router := server.Prefix(ttnpb.HTTPAPIPrefix + scimPathPrefix).Subrouter()
+	router.Use(
+		mux.MiddlewareFunc(webmiddleware.Namespace("identityserver/scim")),
+		mux.MiddlewareFunc(webmiddleware.Metadata("Authorization")),
+		api.requireAdminAPIKey,
+	)
+	router.HandleFunc("/ServiceProviderConfig", api.handleServiceProviderConfig).Methods(http.MethodGet)
+	router.HandleFunc("/ResourceTypes", api.handleResourceTypes).Methods(http.MethodGet)
+	router.HandleFunc("/Users", api.handleListUsers).Methods(http.MethodGet)
+	router.HandleFunc("/Users", api.handleCreateUser).Methods(http.MethodPost)
+	router.HandleFunc("/Users/{id}", api.handleGetUser).Methods(http.MethodGet)
+	router.HandleFunc("/Users/{id}", api.handleReplaceUser).Methods(http.MethodPut)
+	router.HandleFunc("/Users/{id}", api.handlePatchUser).Methods(http.MethodPatch)
+	router.HandleFunc("/Users/{id}", api.handleDeleteUser).Methods(http.MethodDelete)
+	router.HandleFunc("/Groups", api.handleListGroups).Methods(http.MethodGet)
+	router.HandleFunc("/Groups", api.handleCreateGroup).Methods(http.MethodPost)
+	router.HandleFunc("/Groups/{id}", api.handleGetGroup).Methods(http.MethodGet)
+	router.HandleFunc("/Groups/{id}", api.handleReplaceGroup).Methods(http.MethodPut)
+	router.HandleFunc("/Groups/{id}", api.handlePatchGroup).Methods(http.MethodPatch)
+	router.HandleFunc("/Groups/{id}", api.handleDeleteGroup).Methods(http.MethodDelete)
+}
+
+// requireAdminAPIKey requires that requests are authenticated with an API key of an admin user. Identity providers
+// are configured with a long-lived token, and an API key allows to revoke that token and to limit its rights.
+func (api *scimWebAPI) requireAdminAPIKey(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		authInfo, err := api.authInfo(ctx)
+		if err != nil {
+			writeSCIMError(res, err)
+			return
+		}
+		apiKey := authInfo.GetApiKey()
+		if apiKey == nil || !authInfo.IsAdmin {
+			writeSCIMError(res, errSCIMAdminAPIKey.New())
+			return
+		}
+		if err := api.requireUnrestricted(ctx); err != nil {
+			writeSCIMError(res, err)
+			return
+		}
+		entityIDs := apiKey.GetEntityIds()
+		ctx = context.WithValue(ctx, scimAdminKey, entityIDs.GetUserIds())
+		next.ServeHTTP(res, req.WithContext(ctx))
+	})
+}
+
+func writeSCIM(res http.ResponseWriter, status int, v interface{}) {
+	res.Header().Set("Content-Type", scimContentType)
+	res.WriteHeader(status)
+	json.NewEncoder(res).Encode(v)
+}
+
+func writeSCIMError(res http.ResponseWriter, err error) {
+	status := errors.ToHTTPStatusCode(err)
+	scimErr := &scimError{
+		Schemas: []string{scimSchemaError},
+		Status:  strconv.Itoa(status),
+		Detail:  err.Error(),
+	}
+	switch {
+	case errors.IsAlreadyExists(err):
+		scimErr.SCIMType = "uniqueness"
+	case errors.Resemble(err, errSCIMInvalidFilter):
+		scimErr.SCIMType = "invalidFilter"
+	case errors.Resemble(err, errSCIMInvalidPath):
+		scimErr.SCIMType = "invalidPath"
+	case errors.Resemble(err, errSCIMNoTarget):
+		scimErr.SCIMType = "noTarget"
+	case errors.Resemble(err, errSCIMInvalidBody):
+		scimErr.SCIMType = "invalidSyntax"
+	case errors.IsInvalidArgument(err):
+		scimErr.SCIMType = "invalidValue"
+	}
+	writeSCIM(res, status, scimErr)
+}
+
+func readSCIM(req *http.Request, v interface{}) error {
+	if err := json.NewDecoder(io.LimitReader(req.Body, scimMaxBodySize)).Decode(v); err != nil {
+		return errSCIMInvalidBody.WithCause(err)
+	}
+	return nil
+}
+
+// scimBaseURL returns the absolute URL of the SCIM endpoint, used for resource locations.
+func scimBaseURL(req *http.Request) string {
+	return req.URL.Scheme + "://" + req.URL.Host + ttnpb.HTTPAPIPrefix + scimPathPrefix
+}
+
+// scimPagination is the pagination of a SCIM list request. SCIM uses a 1-based start index and a count, which
+// are mapped onto pages, so start indices that are not at a page boundary are rounded down.
+type scimPagination struct {
+	startIndex, count uint64
+}
+
+func parseSCIMPagination(req *http.Request) (scimPagination, error) {
+	p := scimPagination{startIndex: 1, count: scimDefaultCount}
+	query := req.URL.Query()
+	if v := query.Get("startIndex"); v != "" {
+		n, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return p, errSCIMInvalidIndex.WithAttributes("parameter", "startIndex", "value", v)
+		}
+		if n > 1 {
+			p.startIndex = n
+		}
+	}
+	if v := query.Get("count"); v != "" {
+		n, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return p, errSCIMInvalidIndex.WithAttributes("parameter", "count", "value", v)
+		}
+		if n > scimMaxCount {
+			n = scimMaxCount
+		}
+		p.count = n
+	}
+	return p, nil
+}
+
+func (p scimPagination) context(ctx context.Context, total *uint64) context.Context {
+	if p.count == 0 {
+		return store.WithPagination(ctx, 1, 1, total)
+	}
+	return store.WithPagination(ctx, uint32(p.count), uint32((p.startIndex-1)/p.count+1), total)
+}
+
+func (p scimPagination) response(resources []interface{}, total uint64) *scimListResponse {
+	if p.count == 0 {
+		resources = nil
+	}
+	if resources == nil {
+		resources = []interface{}{}
+	}
+	startIndex := uint64(1)
+	if p.count > 0 {
+		startIndex = (p.startIndex-1)/p.count*p.count + 1
+	}
+	return &scimListResponse{
+		Schemas:      []string{scimSchemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+func (api *scimWebAPI) handleServiceProviderConfig(res http.ResponseWriter, req *http.Request) {
+	writeSCIM(res, http.StatusOK, map[string]interface{}{
+		"schemas":        []string{scimSchemaServiceProviderConfig},
+		"patch":          map[string]interface{}{"supported": true},
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": scimMaxCount},
+		"changePassword": map[string]interface{}{"supported": true},
+		"sort":           map[string]interface{}{"supported": false},
+		"etag":           map[string]interface{}{"supported": false},
+		"authenticationSchemes": []interface{}{
+			map[string]interface{}{
+				"type":        "oauthbearertoken",
+				"name":        "API Key",
+				"description": "Authentication with an API key of an admin user",
+				"primary":     true,
+			},
+		},
+		"meta": &scimMeta{
+			ResourceType: "ServiceProviderConfig",
+			Location:     scimBaseURL(req) + "/ServiceProviderConfig",
+		},
+	})
+}
+
+func (api *scimWebAPI) handleResourceTypes(res http.ResponseWriter, req *http.Request) {
+	baseURL := scimBaseURL(req)
+	resourceType := func(name, schema string) interface{} {
+		return map[string]interface{}{
+			"schemas":  []string{scimSchemaResourceType},
+			"id":       name,
+			"name":     name,
+			"endpoint": "/" + name + "s",
+			"schema":   schema,
+			"meta": &scimMeta{
+				ResourceType: "ResourceType",
+				Location:     baseURL + "/ResourceTypes/" + name,
+			},
+		}
+	}
+	resources := []interface{}{
+		resourceType("User", scimSchemaUser),
+		resourceType("Group", scimSchemaGroup),
+	}
+	writeSCIM(res, http.StatusOK, &scimListResponse{
+		Schemas:      []string{scimSchemaListResponse},
+		TotalResults: uint64(len(resources)),
+		StartIndex:   1,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+func scimUserFromUser(usr *ttnpb.User, baseURL string) *scimUser {
+	userName := usr.Attributes[scimUserNameAttribute]
+	if userName == "" {
+		userName = usr.GetIds().GetUserId()
+	}
+	active := scimBool(usr.State == ttnpb.STATE_APPROVED)
+	res := &scimUser{
+		Schemas:     []string{scimSchemaUser},
+		ID:          usr.GetIds().GetUserId(),
+		ExternalID:  usr.Attributes[scimExternalIDAttribute],
+		UserName:    userName,
+		DisplayName: usr.Name,
+		Active:      &active,
+		Meta: &scimMeta{
+			ResourceType: "User",
+			Created:      usr.CreatedAt,
+			LastModified: usr.UpdatedAt,
+			Location:     baseURL + "/Users/" + usr.GetIds().GetUserId(),
+		},
+	}
+	if usr.Name != "" {
+		res.Name = &scimName{Formatted: usr.Name}
+		if parts := strings.SplitN(usr.Name, " ", 2); len(parts) == 2 {
+			res.Name.GivenName, res.Name.FamilyName = parts[0], parts[1]
+		}
+	}
+	if usr.PrimaryEmailAddress != "" {
+		res.Emails = []scimMultiValue{{Value: usr.PrimaryEmailAddress, Type: "work", Primary: true}}
+	}
+	return res
+}
+
+// setSCIMAttributes sets the attributes that hold SCIM attributes, and returns whether the attributes changed.
+func setSCIMAttributes(attributes *map[string]string, values map[string]string) bool {
+	var changed bool
+	for key, value := range values {
+		if (*attributes)[key] == value {
+			continue
+		}
+		changed = true
+		if *attributes == nil {
+			*attributes = make(map[string]string)
+		}
+		if value == "" {
+			delete(*attributes, key)
+		} else {
+			(*attributes)[key] = value
+		}
+	}
+	return changed
+}
+
+// applySCIMUser applies the SCIM user to the user, and returns the paths of the fields that changed. Email addresses
+// are considered validated, since the identity provider is trusted.
+func applySCIMUser(usr *ttnpb.User, su *scimUser) (paths []string) {
+	if name := su.fullName(); name != usr.Name {
+		usr.Name = name
+		paths = append(paths, "name")
+	}
+	if email := su.primaryEmail(); email != "" && email != usr.PrimaryEmailAddress {
+		now := time.Now()
+		usr.PrimaryEmailAddress = email
+		usr.PrimaryEmailAddressValidatedAt = &now
+		paths = append(paths, "primary_email_address", "primary_email_address_validated_at")
+	}
+	if su.Active != nil {
+		state := ttnpb.STATE_SUSPENDED
+		if *su.Active {
+			state = ttnpb.STATE_APPROVED
+		}
+		if state != usr.State {
+			usr.State = state
+			usr.StateDescription = ""
+			if state == ttnpb.STATE_SUSPENDED {
+				usr.StateDescription = "deactivated by SCIM provisioning"
+			}
+			paths = append(paths, "state", "state_description")
+		}
+	}
+	if setSCIMAttributes(&usr.Attributes, map[string]string{
+		scimUserNameAttribute:   su.UserName,
+		scimExternalIDAttribute: su.ExternalID,
+	}) {
+		paths = append(paths, "attributes")
+	}
+	return paths
+}
+
+func (is *IdentityServer) findSCIMUsers(ctx context.Context, attr, value string) (users []*ttnpb.User, err error) {
+	var searchAttribute string
+	switch strings.ToLower(attr) {
+	case "username":
+		searchAttribute = scimUserNameAttribute
+	case "externalid":
+		searchAttribute = scimExternalIDAttribute
+	default:
+		return nil, errSCIMInvalidFilter.WithAttributes("filter", attr)
+	}
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		ids, err := store.GetEntitySearch(db).FindUsers(ctx, nil, &ttnpb.SearchUsersRequest{
+			AttributesContain: map[string]string{searchAttribute: value},
+		})
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		found, err := store.GetUserStore(db).FindUsers(ctx, ids, scimUserFieldMask)
+		if err != nil {
+			return err
+		}
+		seen := make(map[string]bool, len(found))
+		for _, usr := range found {
+			if seen[usr.GetIds().GetUserId()] {
+				continue
+			}
+			seen[usr.GetIds().GetUserId()] = true
+			if usr.Attributes[searchAttribute] != value {
+				continue
+			}
+			users = append(users, usr)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (api *scimWebAPI) handleListUsers(res http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	pagination, err := parseSCIMPagination(req)
+	if err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	var (
+		users []*ttnpb.User
+		total uint64
+	)
+	if filter := req.URL.Query().Get("filter"); filter != "" {
+		attr, value, err := parseSCIMFilter(filter)
+		if err != nil {
+			writeSCIMError(res, err)
+			return
+		}
+		if users, err = api.findSCIMUsers(ctx, attr, value); err != nil {
+			writeSCIMError(res, err)
+			return
+		}
+		total = uint64(len(users))
+	} else {
+		paginateCtx := pagination.context(ctx, &total)
+		err = api.withDatabase(ctx, func(db *gorm.DB) (err error) {
+			users, err = store.GetUserStore(db).FindUsers(paginateCtx, nil, scimUserFieldMask)
+			return err
+		})
+		if err != nil {
+			writeSCIMError(res, err)
+			return
+		}
+	}
+	baseURL := scimBaseURL(req)
+	resources := make([]interface{}, len(users))
+	for i, usr := range users {
+		resources[i] = scimUserFromUser(usr, baseURL)
+	}
+	writeSCIM(res, http.StatusOK, pagination.response(resources, total))
+}
+
+func (api *scimWebAPI) getSCIMUser(ctx context.Context, userID string) (*ttnpb.User, error) {
+	getReq := &ttnpb.GetUserRequest{
+		UserIds:   &ttnpb.UserIdentifiers{UserId: userID},
+		FieldMask: scimUserFieldMask,
+	}
+	if err := getReq.ValidateFields(); err != nil {
+		return nil, err
+	}
+	return api.getUser(ctx, getReq)
+}
+
+// isSCIMUser returns whether the user is provisioned through SCIM. Users that are provisioned through SCIM carry
+// the SCIM user name in their attributes.
+func isSCIMUser(usr *ttnpb.User) bool {
+	_, ok := usr.GetAttributes()[scimUserNameAttribute]
+	return ok
+}
+
+// getProvisionedSCIMUser returns the user, if the user is provisioned through SCIM. The identity provider does not
+// manage users that are registered in The Things Stack, even if their user ID matches a SCIM user name.
+func (api *scimWebAPI) getProvisionedSCIMUser(ctx context.Context, userID string) (*ttnpb.User, error) {
+	usr, err := api.getSCIMUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !isSCIMUser(usr) {
+		return nil, errSCIMNotProvisioned.WithAttributes("user_id", userID)
+	}
+	return usr, nil
+}
+
+// checkSCIMUserName checks that the user name is not provisioned for another user than the given user.
+// User IDs are derived from user names, so different user names may result in the same user ID.
+func (api *scimWebAPI) checkSCIMUserName(ctx context.Context, userName, userID string) error {
+	users, err := api.findSCIMUsers(ctx, "userName", userName)
+	if err != nil {
+		return err
+	}
+	for _, usr := range users {
+		if otherID := usr.GetIds().GetUserId(); otherID != userID {
+			return errSCIMUserNameTaken.WithAttributes("user_name", userName, "user_id", otherID)
+		}
+	}
+	return nil
+}
+
+func (api *scimWebAPI) handleGetUser(res http.ResponseWriter, req *http.Request) {
+	usr, err := api.getSCIMUser(req.Context(), mux.Vars(req)["id"])
+	if err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	writeSCIM(res, http.StatusOK, scimUserFromUser(usr, scimBaseURL(req)))
+}
+
+func (api *scimWebAPI) handleCreateUser(res http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	var su scimUser
+	if err := readSCIM(req, &su); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	userID := scimID(su.UserName)
+	if userID == "" {
+		writeSCIMError(res, errSCIMUserName.WithAttributes("user_name", su.UserName))
+		return
+	}
+	if err := api.checkSCIMUserName(ctx, su.UserName, ""); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	// Different user names may result in the same user ID, and the existing user is not adopted.
+	err := api.withDatabase(ctx, func(db *gorm.DB) error {
+		_, err := store.GetUserStore(db).GetUser(ctx, &ttnpb.UserIdentifiers{UserId: userID}, &pbtypes.FieldMask{
+			Paths: []string{"ids"},
+		})
+		return err
+	})
+	switch {
+	case err == nil:
+		writeSCIMError(res, errSCIMUserIDTaken.WithAttributes("user_id", userID, "user_name", su.UserName))
+		return
+	case !errors.IsNotFound(err):
+		writeSCIMError(res, err)
+		return
+	}
+	usr := &ttnpb.User{
+		Ids:   &ttnpb.UserIdentifiers{UserId: userID},
+		State: ttnpb.STATE_APPROVED,
+	}
+	applySCIMUser(usr, &su)
+	usr.ContactInfo = []*ttnpb.ContactInfo{{
+		ContactMethod: ttnpb.CONTACT_METHOD_EMAIL,
+		Value:         usr.PrimaryEmailAddress,
+		ValidatedAt:   usr.PrimaryEmailAddressValidatedAt,
+	}}
+	usr.Password = su.Password
+	if usr.Password == "" {
+		requirements := api.configFromContext(ctx).UserRegistration.PasswordRequirements
+		usr.Password = scimPassword(
+			requirements.MinLength, requirements.MinUppercase, requirements.MinDigits, requirements.MinSpecial,
+		)
+	}
+	createReq := &ttnpb.CreateUserRequest{User: usr}
+	if err := createReq.ValidateFields(); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	if _, err := api.createUser(ctx, createReq); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	created, err := api.getSCIMUser(ctx, userID)
+	if err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	resource := scimUserFromUser(created, scimBaseURL(req))
+	res.Header().Set("Location", resource.Meta.Location)
+	writeSCIM(res, http.StatusCreated, resource)
+}
+
+// replaceSCIMUser updates the user to match the SCIM user.
+func (api *scimWebAPI) replaceSCIMUser(ctx context.Context, usr *ttnpb.User, su *scimUser) (*ttnpb.User, error) {
+	if su.UserName == "" {
+		return nil, errSCIMUserName.WithAttributes("user_name", su.UserName)
+	}
+	if su.UserName != usr.Attributes[scimUserNameAttribute] {
+		if err := api.checkSCIMUserName(ctx, su.UserName, usr.GetIds().GetUserId()); err != nil {
+			return nil, err
+		}
+	}
+	if paths := applySCIMUser(usr, su); len(paths) > 0 {
+		updateReq := &ttnpb.UpdateUserRequest{
+			User:      usr,
+			FieldMask: &pbtypes.FieldMask{Paths: paths},
+		}
+		if err := updateReq.ValidateFields(); err != nil {
+			return nil, err
+		}
+		if _, err := api.updateUser(ctx, updateReq); err != nil {
+			return nil, err
+		}
+	}
+	if su.Password != "" {
+		if err := api.setSCIMUserPassword(ctx, usr.GetIds(), su.Password); err != nil {
+			return nil, err
+		}
+	}
+	return api.getSCIMUser(ctx, usr.GetIds().GetUserId())
+}
+
+// setSCIMUserPassword sets the password of the user. The identity provider does not know the old password, so this
+// does not go through the regular password update.
+func (is *IdentityServer) setSCIMUserPassword(ctx context.Context, ids *ttnpb.UserIdentifiers, password string) error {
+	if err := is.validatePasswordStrength(ctx, ids.GetUserId(), password); err != nil {
+		return err
+	}
+	hashedPassword, err := auth.Hash(ctx, password)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
+		_, err := store.GetUserStore(db).UpdateUser(ctx, &ttnpb.User{
+			Ids:               ids,
+			Password:          hashedPassword,
+			PasswordUpdatedAt: &now,
+		}, updatePasswordFieldMask)
+		return err
+	}, func() events.Event {
+		return evtUpdateUser.NewWithIdentifiersAndData(ctx, ids, updatePasswordFieldMask.GetPaths())
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (api *scimWebAPI) handleReplaceUser(res http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	var su scimUser
+	if err := readSCIM(req, &su); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	usr, err := api.getProvisionedSCIMUser(ctx, mux.Vars(req)["id"])
+	if err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	if usr, err = api.replaceSCIMUser(ctx, usr, &su); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	writeSCIM(res, http.StatusOK, scimUserFromUser(usr, scimBaseURL(req)))
+}
+
+func (api *scimWebAPI) handlePatchUser(res http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	var patch scimPatchRequest
+	if err := readSCIM(req, &patch); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	usr, err := api.getProvisionedSCIMUser(ctx, mux.Vars(req)["id"])
+	if err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	su := scimUserFromUser(usr, scimBaseURL(req))
+	if err := applySCIMPatch(su, patch.Operations); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	if usr, err = api.replaceSCIMUser(ctx, usr, su); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	writeSCIM(res, http.StatusOK, scimUserFromUser(usr, scimBaseURL(req)))
+}
+
+func (api *scimWebAPI) handleDeleteUser(res http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	usr, err := api.getProvisionedSCIMUser(ctx, mux.Vars(req)["id"])
+	if err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	if _, err := api.deleteUser(ctx, usr.GetIds()); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	res.WriteHeader(http.StatusNoContent)
+}
+
+func scimGroupFromOrganization(org *ttnpb.Organization, members []string, baseURL string) *scimGroup {
+	res := &scimGroup{
+		Schemas:     []string{scimSchemaGroup},
+		ID:          org.GetIds().GetOrganizationId(),
+		ExternalID:  org.Attributes[scimExternalIDAttribute],
+		DisplayName: org.Name,
+		Meta: &scimMeta{
+			ResourceType: "Group",
+			Created:      org.CreatedAt,
+			LastModified: org.UpdatedAt,
+			Location:     baseURL + "/Groups/" + org.GetIds().GetOrganizationId(),
+		},
+	}
+	for _, member := range members {
+		res.Members = append(res.Members, scimMultiValue{
+			Value: member,
+			Ref:   baseURL + "/Users/" + member,
+		})
+	}
+	return res
+}
+
+// scimMemberRights returns the rights of users that are provisioned as organization members.
+func (is *IdentityServer) scimMemberRights(ctx context.Context) ([]ttnpb.Right, error) {
+	names := is.configFromContext(ctx).SCIM.MemberRights
+	rights := make([]ttnpb.Right, len(names))
+	for i, name := range names {
+		if err := rights[i].UnmarshalText([]byte(name)); err != nil {
+			return nil, errSCIMMemberRight.WithCause(err).WithAttributes("right", name)
+		}
+	}
+	return rights, nil
+}
+
+// getSCIMMembers returns the user IDs of the members of the organization that are provisioned through SCIM.
+// Members with all rights are the owners of the organization; they are managed in The Things Stack and are not part
+// of the SCIM group. Neither are members that are not provisioned through SCIM.
+func (is *IdentityServer) getSCIMMembers(ctx context.Context, ids *ttnpb.OrganizationIdentifiers) (members []string, owners map[string]bool, err error) {
+	owners = make(map[string]bool)
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		memberRights, err := is.getMembershipStore(ctx, db).FindMembers(ctx, ids.GetEntityIdentifiers())
+		if err != nil {
+			return err
+		}
+		var memberIDs []*ttnpb.UserIdentifiers
+		for member, rights := range memberRights {
+			usrIDs := member.GetUserIds()
+			if usrIDs == nil {
+				continue
+			}
+			if rights.Implied().IncludesAll(ttnpb.RIGHT_ORGANIZATION_ALL) {
+				owners[usrIDs.GetUserId()] = true
+				continue
+			}
+			memberIDs = append(memberIDs, usrIDs)
+		}
+		if len(memberIDs) == 0 {
+			return nil
+		}
+		users, err := store.GetUserStore(db).FindUsers(ctx, memberIDs, scimUserFieldMask)
+		if err != nil {
+			return err
+		}
+		for _, usr := range users {
+			if isSCIMUser(usr) {
+				members = append(members, usr.GetIds().GetUserId())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Strings(members)
+	return members, owners, nil
+}
+
+// requireSCIMUser requires that the user is provisioned through SCIM.
+func (is *IdentityServer) requireSCIMUser(ctx context.Context, userID string) error {
+	ids := &ttnpb.UserIdentifiers{UserId: userID}
+	if err := ids.ValidateFields(); err != nil {
+		return err
+	}
+	return is.withDatabase(ctx, func(db *gorm.DB) error {
+		usr, err := store.GetUserStore(db).GetUser(ctx, ids, scimUserFieldMask)
+		if err != nil {
+			return err
+		}
+		if !isSCIMUser(usr) {
+			return errSCIMNotProvisioned.WithAttributes("user_id", userID)
+		}
+		return nil
+	})
+}
+
+// setSCIMMembers adds and removes members of the organization so that the members that are provisioned through SCIM
+// match the SCIM members. Only users that are provisioned through SCIM can be added.
+func (is *IdentityServer) setSCIMMembers(ctx context.Context, ids *ttnpb.OrganizationIdentifiers, members []scimMultiValue) error {
+	memberRights, err := is.scimMemberRights(ctx)
+	if err != nil {
+		return err
+	}
+	current, owners, err := is.getSCIMMembers(ctx, ids)
+	if err != nil {
+		return err
+	}
+	isCurrent := make(map[string]bool, len(current))
+	for _, member := range current {
+		isCurrent[member] = true
+	}
+	desired := make(map[string]bool, len(members))
+	setMember := func(userID string, rights []ttnpb.Right) error {
+		setReq := &ttnpb.SetOrganizationCollaboratorRequest{
+			OrganizationIds: ids,
+			Collaborator: &ttnpb.Collaborator{
+				Ids:    (&ttnpb.UserIdentifiers{UserId: userID}).OrganizationOrUserIdentifiers(),
+				Rights: rights,
+			},
+		}
+		if err := setReq.ValidateFields(); err != nil {
+			return err
+		}
+		_, err := is.setOrganizationCollaborator(ctx, setReq)
+		return err
+	}
+	for _, member := range members {
+		desired[member.Value] = true
+		if isCurrent[member.Value] || owners[member.Value] {
+			continue
+		}
+		if err := is.requireSCIMUser(ctx, member.Value); err != nil {
+			return err
+		}
+		if err := setMember(member.Value, memberRights); err != nil {
+			return err
+		}
+	}
+	for _, member := range current {
+		if desired[member] {
+			continue
+		}
+		if err := setMember(member, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (is *IdentityServer) findSCIMOrganizations(ctx context.Context, attr, value string) (orgs []*ttnpb.Organization, err error) {
+	searchReq := &ttnpb.SearchOrganizationsRequest{}
+	switch strings.ToLower(attr) {
+	case "displayname":
+		searchReq.NameContains = value
+	case "externalid":
+		searchReq.AttributesContain = map[string]string{scimExternalIDAttribute: value}
+	default:
+		return nil, errSCIMInvalidFilter.WithAttributes("filter", attr)
+	}
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		ids, err := store.GetEntitySearch(db).FindOrganizations(ctx, nil, searchReq)
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		found, err := store.GetOrganizationStore(db).FindOrganizations(ctx, ids, scimOrganizationFieldMask)
+		if err != nil {
+			return err
+		}
+		for _, org := range found {
+			if searchReq.NameContains != "" && !strings.EqualFold(org.Name, value) {
+				continue
+			}
+			if searchReq.AttributesContain != nil && org.Attributes[scimExternalIDAttribute] != value {
+				continue
+			}
+			orgs = append(orgs, org)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orgs, nil
+}
+
+func scimExcludesMembers(req *http.Request) bool {
+	for _, attr := range strings.Split(req.URL.Query().Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(attr), "members") {
+			return true
+		}
+	}
+	return false
+}
+
+func (api *scimWebAPI) handleListGroups(res http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	pagination, err := parseSCIMPagination(req)
+	if err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	var (
+		orgs  []*ttnpb.Organization
+		total uint64
+	)
+	if filter := req.URL.Query().Get("filter"); filter != "" {
+		attr, value, err := parseSCIMFilter(filter)
+		if err != nil {
+			writeSCIMError(res, err)
+			return
+		}
+		if orgs, err = api.findSCIMOrganizations(ctx, attr, value); err != nil {
+			writeSCIMError(res, err)
+			return
+		}
+		total = uint64(len(orgs))
+	} else {
+		paginateCtx := pagination.context(ctx, &total)
+		err = api.withDatabase(ctx, func(db *gorm.DB) (err error) {
+			orgs, err = store.GetOrganizationStore(db).FindOrganizations(paginateCtx, nil, scimOrganizationFieldMask)
+			return err
+		})
+		if err != nil {
+			writeSCIMError(res, err)
+			return
+		}
+	}
+	baseURL := scimBaseURL(req)
+	excludeMembers := scimExcludesMembers(req)
+	resources := make([]interface{}, len(orgs))
+	for i, org := range orgs {
+		var members []string
+		if !excludeMembers {
+			if members, _, err = api.getSCIMMembers(ctx, org.GetIds()); err != nil {
+				writeSCIMError(res, err)
+				return
+			}
+		}
+		resources[i] = scimGroupFromOrganization(org, members, baseURL)
+	}
+	writeSCIM(res, http.StatusOK, pagination.response(resources, total))
+}
+
+func (api *scimWebAPI) getSCIMGroup(ctx context.Context, orgID, baseURL string, withMembers bool) (*ttnpb.Organization, *scimGroup, error) {
+	getReq := &ttnpb.GetOrganizationRequest{
+		OrganizationIds: &ttnpb.OrganizationIdentifiers{OrganizationId: orgID},
+		FieldMask:       scimOrganizationFieldMask,
+	}
+	if err := getReq.ValidateFields(); err != nil {
+		return nil, nil, err
+	}
+	org, err := api.getOrganization(ctx, getReq)
+	if err != nil {
+		return nil, nil, err
+	}
+	var members []string
+	if withMembers {
+		if members, _, err = api.getSCIMMembers(ctx, org.GetIds()); err != nil {
+			return nil, nil, err
+		}
+	}
+	return org, scimGroupFromOrganization(org, members, baseURL), nil
+}
+
+// isSCIMGroup returns whether the organization is provisioned through SCIM.
+func isSCIMGroup(org *ttnpb.Organization) bool {
+	_, ok := org.GetAttributes()[scimGroupAttribute]
+	return ok
+}
+
+// getProvisionedSCIMGroup returns the organization and the SCIM group, if the organization is provisioned through
+// SCIM. The identity provider does not manage organizations that are created in The Things Stack.
+func (api *scimWebAPI) getProvisionedSCIMGroup(ctx context.Context, orgID, baseURL string, withMembers bool) (*ttnpb.Organization, *scimGroup, error) {
+	org, group, err := api.getSCIMGroup(ctx, orgID, baseURL, withMembers)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !isSCIMGroup(org) {
+		return nil, nil, errSCIMGroupNotProvisioned.WithAttributes("organization_id", orgID)
+	}
+	return org, group, nil
+}
+
+func (api *scimWebAPI) handleGetGroup(res http.ResponseWriter, req *http.Request) {
+	_, group, err := api.getSCIMGroup(req.Context(), mux.Vars(req)["id"], scimBaseURL(req), !scimExcludesMembers(req))
+	if err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	writeSCIM(res, http.StatusOK, group)
+}
+
+func (api *scimWebAPI) handleCreateGroup(res http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	var group scimGroup
+	if err := readSCIM(req, &group); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	orgID := scimID(group.DisplayName)
+	if orgID == "" {
+		writeSCIMError(res, errSCIMDisplayName.WithAttributes("display_name", group.DisplayName))
+		return
+	}
+	org := &ttnpb.Organization{
+		Ids:  &ttnpb.OrganizationIdentifiers{OrganizationId: orgID},
+		Name: group.DisplayName,
+	}
+	setSCIMAttributes(&org.Attributes, map[string]string{
+		scimExternalIDAttribute: group.ExternalID,
+		scimGroupAttribute:      "true",
+	})
+	createReq := &ttnpb.CreateOrganizationRequest{
+		Organization: org,
+		Collaborator: scimAdminFromContext(ctx).OrganizationOrUserIdentifiers(),
+	}
+	if err := createReq.ValidateFields(); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	// Check the members first, so that the organization is not created if a member can not be added.
+	for _, member := range group.Members {
+		if err := api.requireSCIMUser(ctx, member.Value); err != nil {
+			writeSCIMError(res, err)
+			return
+		}
+	}
+	if _, err := api.createOrganization(ctx, createReq); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	if err := api.setSCIMMembers(ctx, org.GetIds(), group.Members); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	_, created, err := api.getSCIMGroup(ctx, orgID, scimBaseURL(req), true)
+	if err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	res.Header().Set("Location", created.Meta.Location)
+	writeSCIM(res, http.StatusCreated, created)
+}
+
+// replaceSCIMGroup updates the organization and its members to match the SCIM group.
+func (api *scimWebAPI) replaceSCIMGroup(ctx context.Context, org *ttnpb.Organization, group *scimGroup) error {
+	var paths []string
+	if group.DisplayName != "" && group.DisplayName != org.Name {
+		org.Name = group.DisplayName
+		paths = append(paths, "name")
+	}
+	if setSCIMAttributes(&org.Attributes, map[string]string{scimExternalIDAttribute: group.ExternalID}) {
+		paths = append(paths, "attributes")
+	}
+	if len(paths) > 0 {
+		updateReq := &ttnpb.UpdateOrganizationRequest{
+			Organization: org,
+			FieldMask:    &pbtypes.FieldMask{Paths: paths},
+		}
+		if err := updateReq.ValidateFields(); err != nil {
+			return err
+		}
+		if _, err := api.updateOrganization(ctx, updateReq); err != nil {
+			return err
+		}
+	}
+	return api.setSCIMMembers(ctx, org.GetIds(), group.Members)
+}
+
+func (api *scimWebAPI) handleReplaceGroup(res http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	var group scimGroup
+	if err := readSCIM(req, &group); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	org, _, err := api.getProvisionedSCIMGroup(ctx, mux.Vars(req)["id"], scimBaseURL(req), false)
+	if err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	if err := api.replaceSCIMGroup(ctx, org, &group); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	_, updated, err := api.getSCIMGroup(ctx, org.GetIds().GetOrganizationId(), scimBaseURL(req), true)
+	if err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	writeSCIM(res, http.StatusOK, updated)
+}
+
+func (api *scimWebAPI) handlePatchGroup(res http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	var patch scimPatchRequest
+	if err := readSCIM(req, &patch); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	org, group, err := api.getProvisionedSCIMGroup(ctx, mux.Vars(req)["id"], scimBaseURL(req), true)
+	if err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	if err := applySCIMPatch(group, patch.Operations); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	if err := api.replaceSCIMGroup(ctx, org, group); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	_, updated, err := api.getSCIMGroup(ctx, org.GetIds().GetOrganizationId(), scimBaseURL(req), true)
+	if err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	writeSCIM(res, http.StatusOK, updated)
+}
+
+func (api *scimWebAPI) handleDeleteGroup(res http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	org, _, err := api.getProvisionedSCIMGroup(ctx, mux.Vars(req)["id"], scimBaseURL(req), false)
+	if err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	if _, err := api.deleteOrganization(ctx, org.GetIds()); err != nil {
+		writeSCIMError(res, err)
+		return
+	}
+	res.WriteHeader(http.StatusNoContent)
+}
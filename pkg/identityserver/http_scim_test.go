@@ -0,0 +1,207 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+func TestSCIMID(t *testing.T) {
+	a, _ := test.New(t)
+	for input, expected := range map[string]string{
+		"jane.doe@example.com":     "jane-doe",
+		"John Doe":                 "john-doe",
+		"--foo__bar--":             "foo-bar",
+		"Engineering & Operations": "engineering-operations",
+		"a-very-long-group-name-that-exceeds-the": "a-very-long-group-name-that-exceeds",
+		"@@@": "",
+	} {
+		a.So(scimID(input), should.Equal, expected)
+	}
+}
+
+func TestSCIMPatch(t *testing.T) {
+	a, _ := test.New(t)
+
+	active := scimBool(true)
+	usr := &scimUser{
+		UserName: "jane.doe@example.com",
+		Name:     &scimName{Formatted: "Jane Doe"},
+		Emails:   []scimMultiValue{{Value: "jane.doe@example.com", Type: "work", Primary: true}},
+		Active:   &active,
+	}
+	err := applySCIMPatch(usr, []scimPatchOperation{
+		{Op: "Replace", Value: json.RawMessage(`{"active":"False"}`)},
+		{Op: "replace", Path: "name.formatted", Value: json.RawMessage(`"Jane Smith"`)},
+		{Op: "replace", Path: `emails[type eq "work"].value`, Value: json.RawMessage(`"jane.smith@example.com"`)},
+		{Op: "add", Path: "urn:ietf:params:scim:schemas:core:2.0:User:externalId", Value: json.RawMessage(`"00u1"`)},
+	})
+	if a.So(err, should.BeNil) {
+		a.So(bool(*usr.Active), should.BeFalse)
+		a.So(usr.fullName(), should.Equal, "Jane Smith")
+		a.So(usr.primaryEmail(), should.Equal, "jane.smith@example.com")
+		a.So(usr.ExternalID, should.Equal, "00u1")
+	}
+
+	group := &scimGroup{
+		DisplayName: "Engineering",
+		Members:     []scimMultiValue{{Value: "alice"}, {Value: "bob"}},
+	}
+	err = applySCIMPatch(group, []scimPatchOperation{
+		{Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"bob"},{"value":"carol"}]`)},
+		{Op: "remove", Path: `members[value eq "alice"]`},
+		{Op: "remove", Path: "members", Value: json.RawMessage(`[{"value":"carol"}]`)},
+		{Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"dave"}]`)},
+	})
+	if a.So(err, should.BeNil) {
+		a.So(group.Members, should.Resemble, []scimMultiValue{{Value: "bob"}, {Value: "dave"}})
+	}
+
+	for _, op := range []scimPatchOperation{
+		{Op: "move", Path: "members"},
+		{Op: "remove"},
+		{Op: "replace", Path: "members[value co \"a\"]"},
+	} {
+		a.So(applySCIMPatch(group, []scimPatchOperation{op}), should.NotBeNil)
+	}
+}
+
+func TestSCIMProvisioning(t *testing.T) {
+	a, ctx := test.New(t)
+
+	testWithIdentityServer(t, func(is *IdentityServer, _ *grpc.ClientConn) {
+		adminKey := userAPIKeys(adminUser.GetIds()).ApiKeys[0].Key
+		userKey := userAPIKeys(defaultUser.GetIds()).ApiKeys[0].Key
+
+		do := func(method, path, key string, body interface{}, res interface{}) int {
+			var buf bytes.Buffer
+			if body != nil {
+				json.NewEncoder(&buf).Encode(body)
+			}
+			req := httptest.NewRequest(method, "http://localhost"+ttnpb.HTTPAPIPrefix+scimPathPrefix+path, &buf).WithContext(ctx)
+			req.Header.Set("Authorization", "Bearer "+key)
+			req.Header.Set("Content-Type", scimContentType)
+			rec := httptest.NewRecorder()
+			is.ServeHTTP(rec, req)
+			if res != nil {
+				json.NewDecoder(rec.Body).Decode(res)
+			}
+			return rec.Code
+		}
+
+		a.So(do(http.MethodGet, "/Users", userKey, nil, nil), should.Equal, http.StatusForbidden)
+
+		var created scimUser
+		a.So(do(http.MethodPost, "/Users", adminKey, map[string]interface{}{
+			"schemas":  []string{scimSchemaUser},
+			"userName": "scim.user@example.com",
+			"name":     map[string]string{"givenName": "SCIM", "familyName": "User"},
+			"active":   true,
+		}, &created), should.Equal, http.StatusCreated)
+		a.So(created.ID, should.Equal, "scim-user")
+		a.So(created.UserName, should.Equal, "scim.user@example.com")
+		a.So(created.DisplayName, should.Equal, "SCIM User")
+		a.So(created.primaryEmail(), should.Equal, "scim.user@example.com")
+
+		var list scimListResponse
+		a.So(do(http.MethodGet, `/Users?filter=userName+eq+%22scim.user@example.com%22`, adminKey, nil, &list), should.Equal, http.StatusOK)
+		a.So(list.TotalResults, should.Equal, 1)
+
+		// The user ID derived from this user name collides with the provisioned user.
+		a.So(do(http.MethodPost, "/Users", adminKey, map[string]interface{}{
+			"schemas":  []string{scimSchemaUser},
+			"userName": "scim.user@other.example.com",
+			"active":   true,
+		}, nil), should.Equal, http.StatusConflict)
+
+		// Users that are not provisioned through SCIM are not matched, updated or deleted.
+		defaultUserID := defaultUser.GetIds().GetUserId()
+		a.So(do(http.MethodGet, `/Users?filter=userName+eq+%22`+defaultUserID+`%22`, adminKey, nil, &list), should.Equal, http.StatusOK)
+		a.So(list.TotalResults, should.Equal, 0)
+		a.So(do(http.MethodPatch, "/Users/"+defaultUserID, adminKey, map[string]interface{}{
+			"schemas":    []string{scimSchemaPatchOp},
+			"Operations": []map[string]interface{}{{"op": "replace", "value": map[string]interface{}{"active": false}}},
+		}, nil), should.Equal, http.StatusBadRequest)
+		a.So(do(http.MethodPut, "/Users/"+defaultUserID, adminKey, map[string]interface{}{
+			"schemas":  []string{scimSchemaUser},
+			"userName": defaultUserID,
+			"password": "Th3R3placedPassw0rd!",
+		}, nil), should.Equal, http.StatusBadRequest)
+		a.So(do(http.MethodDelete, "/Users/"+defaultUserID, adminKey, nil, nil), should.Equal, http.StatusBadRequest)
+
+		var patched scimUser
+		a.So(do(http.MethodPatch, "/Users/scim-user", adminKey, map[string]interface{}{
+			"schemas":    []string{scimSchemaPatchOp},
+			"Operations": []map[string]interface{}{{"op": "replace", "value": map[string]interface{}{"active": false}}},
+		}, &patched), should.Equal, http.StatusOK)
+		if a.So(patched.Active, should.NotBeNil) {
+			a.So(bool(*patched.Active), should.BeFalse)
+		}
+		usr, err := store.GetUserStore(is.db).GetUser(ctx, &ttnpb.UserIdentifiers{UserId: "scim-user"}, scimUserFieldMask)
+		if a.So(err, should.BeNil) {
+			a.So(usr.State, should.Equal, ttnpb.STATE_SUSPENDED)
+		}
+
+		var group scimGroup
+		a.So(do(http.MethodPost, "/Groups", adminKey, map[string]interface{}{
+			"schemas":     []string{scimSchemaGroup},
+			"displayName": "SCIM Group",
+			"members":     []map[string]string{{"value": "scim-user"}},
+		}, &group), should.Equal, http.StatusCreated)
+		a.So(group.ID, should.Equal, "scim-group")
+		a.So(group.Members, should.HaveLength, 1)
+
+		// Users that are not provisioned through SCIM can not become member of SCIM groups.
+		a.So(do(http.MethodPost, "/Groups", adminKey, map[string]interface{}{
+			"schemas":     []string{scimSchemaGroup},
+			"displayName": "Other SCIM Group",
+			"members":     []map[string]string{{"value": defaultUserID}},
+		}, nil), should.Equal, http.StatusBadRequest)
+		a.So(do(http.MethodGet, "/Groups/other-scim-group", adminKey, nil, nil), should.Equal, http.StatusNotFound)
+		a.So(do(http.MethodPatch, "/Groups/scim-group", adminKey, map[string]interface{}{
+			"schemas":    []string{scimSchemaPatchOp},
+			"Operations": []map[string]interface{}{{"op": "add", "path": "members", "value": []map[string]string{{"value": defaultUserID}}}},
+		}, nil), should.Equal, http.StatusBadRequest)
+
+		// Organizations that are not provisioned through SCIM are not updated or deleted.
+		orgID := userOrganizations(defaultUser.GetIds()).Organizations[0].GetIds().GetOrganizationId()
+		a.So(do(http.MethodPatch, "/Groups/"+orgID, adminKey, map[string]interface{}{
+			"schemas":    []string{scimSchemaPatchOp},
+			"Operations": []map[string]interface{}{{"op": "replace", "path": "members", "value": []map[string]string{}}},
+		}, nil), should.Equal, http.StatusBadRequest)
+		a.So(do(http.MethodDelete, "/Groups/"+orgID, adminKey, nil, nil), should.Equal, http.StatusBadRequest)
+
+		var patchedGroup scimGroup
+		a.So(do(http.MethodPatch, "/Groups/scim-group", adminKey, map[string]interface{}{
+			"schemas":    []string{scimSchemaPatchOp},
+			"Operations": []map[string]interface{}{{"op": "remove", "path": `members[value eq "scim-user"]`}},
+		}, &patchedGroup), should.Equal, http.StatusOK)
+		a.So(patchedGroup.Members, should.BeEmpty)
+
+		a.So(do(http.MethodDelete, "/Groups/scim-group", adminKey, nil, nil), should.Equal, http.StatusNoContent)
+		a.So(do(http.MethodDelete, "/Users/scim-user", adminKey, nil, nil), should.Equal, http.StatusNoContent)
+		a.So(do(http.MethodGet, "/Users/scim-user", adminKey, nil, nil), should.Equal, http.StatusNotFound)
+	})
+}
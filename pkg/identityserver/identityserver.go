@@ -117,6 +117,9 @@ type accountAppStore struct {
 	store.UserStore
 	store.LoginTokenStore
 	store.UserSessionStore
+	store.UserMFAStore
+	store.FederatedIdentityStore
+	store.MembershipStore
 }
 
 // WithSoftDeleted implements account_store.Interface.
@@ -138,6 +141,10 @@ func createAccountAppStore(db *gorm.DB) account_store.Interface {
 		UserStore:        store.GetUserStore(db),
 		LoginTokenStore:  store.GetLoginTokenStore(db),
 		UserSessionStore: store.GetUserSessionStore(db),
+		UserMFAStore:     store.GetUserMFAStore(db),
+
+		FederatedIdentityStore: store.GetFederatedIdentityStore(db),
+		MembershipStore:        store.GetMembershipStore(db),
 	}
 }
 
@@ -185,23 +192,33 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 
 	is.config.OAuth.CSRFAuthKey = is.GetBaseConfig(is.Context()).HTTP.Cookie.HashKey
 	is.config.OAuth.UI.FrontendConfig.EnableUserRegistration = is.config.UserRegistration.Enabled
+	is.config.OAuth.MFA = is.config.MFA
+	is.config.OAuth.AdminApprovalRequired = is.config.UserRegistration.AdminApproval.Required
 	is.oauth, err = oauth.NewServer(c, struct {
 		store.UserStore
 		store.UserSessionStore
 		store.ClientStore
 		store.OAuthStore
+		store.UserMFAStore
 	}{
 		UserStore:        store.GetUserStore(is.db),
 		UserSessionStore: store.GetUserSessionStore(is.db),
 		ClientStore:      store.GetClientStore(is.db),
 		OAuthStore:       store.GetOAuthStore(is.db),
+		UserMFAStore:     store.GetUserMFAStore(is.db),
 	}, is.config.OAuth, GenerateCSPString)
 
-	is.account, err = account.NewServer(c, createAccountAppStore(is.db), is.config.OAuth, GenerateCSPString)
+	is.account, err = account.NewServer(
+		c, createAccountAppStore(is.db), is.config.OAuth, GenerateCSPString,
+		account.WithMFANotifier(is.notifyMFAChange),
+	)
 	if err != nil {
 		return nil, err
 	}
 
+	is.registerAuditRetention()
+	is.registerPurge()
+
 	c.AddContextFiller(func(ctx context.Context) context.Context {
 		ctx = is.withRequestAccessCache(ctx)
 		ctx = rights.NewContextWithFetcher(ctx, is)
@@ -217,6 +234,8 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 		{cluster.HookName, c.ClusterAuthUnaryHook()},
 	} {
 		hooks.RegisterUnaryHook("/ttn.lorawan.v3.Is", hook.name, hook.middleware)
+		hooks.RegisterUnaryHook("/ttn.lorawan.v3.AuditLog", hook.name, hook.middleware)
+		hooks.RegisterUnaryHook("/ttn.lorawan.v3.NotificationService", hook.name, hook.middleware)
 		hooks.RegisterUnaryHook("/ttn.lorawan.v3.ApplicationRegistry", hook.name, hook.middleware)
 		hooks.RegisterUnaryHook("/ttn.lorawan.v3.ApplicationAccess", hook.name, hook.middleware)
 		hooks.RegisterUnaryHook("/ttn.lorawan.v3.ClientRegistry", hook.name, hook.middleware)
@@ -237,6 +256,9 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 	c.RegisterGRPC(is)
 	c.RegisterWeb(is.oauth)
 	c.RegisterWeb(is.account)
+	if is.config.SCIM.Enabled {
+		c.RegisterWeb(&scimWebAPI{IdentityServer: is})
+	}
 	c.RegisterInterop(is)
 
 	return is, nil
@@ -267,6 +289,8 @@ func (is *IdentityServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterEndDeviceRegistrySearchServer(s, &registrySearch{IdentityServer: is})
 	ttnpb.RegisterOAuthAuthorizationRegistryServer(s, &oauthRegistry{IdentityServer: is})
 	ttnpb.RegisterContactInfoRegistryServer(s, &contactInfoRegistry{IdentityServer: is})
+	ttnpb.RegisterAuditLogServer(s, &auditLog{IdentityServer: is})
+	ttnpb.RegisterNotificationServiceServer(s, &notificationService{IdentityServer: is})
 }
 
 // RegisterHandlers registers gRPC handlers.
@@ -290,6 +314,8 @@ func (is *IdentityServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.Clien
 	ttnpb.RegisterEndDeviceRegistrySearchHandler(is.Context(), s, conn)
 	ttnpb.RegisterOAuthAuthorizationRegistryHandler(is.Context(), s, conn)
 	ttnpb.RegisterContactInfoRegistryHandler(is.Context(), s, conn)
+	ttnpb.RegisterAuditLogHandler(is.Context(), s, conn)
+	ttnpb.RegisterNotificationServiceHandler(is.Context(), s, conn)
 }
 
 // RegisterInterop registers the LoRaWAN Backend Interfaces interoperability services.
@@ -312,6 +338,14 @@ func (is *IdentityServer) getMembershipStore(ctx context.Context, db *gorm.DB) s
 	return s
 }
 
+// invalidateMembershipOrganizations invalidates the organizations that accounts are nested members of in the
+// membership cache, if the membership cache is enabled.
+func (is *IdentityServer) invalidateMembershipOrganizations(ctx context.Context) {
+	if is.redis != nil && is.configFromContext(ctx).AuthCache.MembershipTTL > 0 {
+		store.InvalidateMembershipOrganizations(ctx, is.redis)
+	}
+}
+
 var softDeleteFieldMask = &pbtypes.FieldMask{Paths: []string{"deleted_at"}}
 
 var errRestoreWindowExpired = errors.DefineFailedPrecondition("restore_window_expired", "this entity can no longer be restored")
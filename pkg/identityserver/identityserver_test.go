@@ -17,6 +17,7 @@ package identityserver
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -296,7 +297,16 @@ func getIdentityServer(t *testing.T) (*IdentityServer, *grpc.ClientConn) {
 		if dbAuth == "" {
 			dbAuth = "root"
 		}
-		dbConnString = fmt.Sprintf("postgresql://%s@%s/%s?sslmode=disable", dbAuth, dbAddress, dbName)
+		switch os.Getenv("SQL_DB_DRIVER") {
+		case "sqlite":
+			dir, err := os.MkdirTemp("", dbName)
+			if err != nil {
+				panic(err)
+			}
+			dbConnString = fmt.Sprintf("sqlite://%s", filepath.Join(dir, dbName+".db"))
+		default:
+			dbConnString = fmt.Sprintf("postgresql://%s@%s/%s?sslmode=disable", dbAuth, dbAddress, dbName)
+		}
 		db, err := store.Open(ctx, dbConnString)
 		if err != nil {
 			panic(err)
@@ -344,6 +354,10 @@ func getIdentityServer(t *testing.T) (*IdentityServer, *grpc.ClientConn) {
 	conf.UserRights.CreateGateways = true
 	conf.UserRights.CreateOrganizations = true
 	conf.AdminRights.All = true
+	conf.Delete.Restore = time.Hour
+	conf.Audit.Enabled = true
+	conf.SCIM.Enabled = true
+	conf.SCIM.MemberRights = []string{"RIGHT_ORGANIZATION_INFO"}
 	var euiBlock types.EUI64Prefix
 	euiBlock.UnmarshalConfigString("70B3D57ED0000000/36")
 	conf.DevEUIBlock.Enabled = true
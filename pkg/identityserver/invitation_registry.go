@@ -61,14 +61,15 @@ func (is *IdentityServer) sendInvitation(ctx context.Context, in *ttnpb.SendInvi
 		Token:     token,
 		ExpiresAt: &expires,
 	}
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		invitation, err = store.GetInvitationStore(db).CreateInvitation(ctx, invitation)
 		return err
+	}, func() events.Event {
+		return evtCreateInvitation.NewWithIdentifiersAndData(ctx, nil, invitation)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtCreateInvitation.NewWithIdentifiersAndData(ctx, nil, invitation))
 	err = is.SendEmail(ctx, func(data emails.Data) email.MessageData {
 		data.User.Email = in.Email
 		return &emails.Invitation{
@@ -0,0 +1,340 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/email"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/emails"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const createNotificationEvent = "user.notification.create"
+
+var evtCreateNotification = events.Define(
+	createNotificationEvent, "create notification",
+	events.WithVisibility(ttnpb.RIGHT_USER_INFO),
+	events.WithDataType(&pbtypes.StringValue{Value: "notification-id"}),
+)
+
+// notificationTypes are the types of emails that are also delivered to the notification inbox of the user.
+// Emails with tokens or passwords, such as validation emails and temporary passwords, are not notifications.
+var notificationTypes = map[string]bool{
+	"api_key_changed":      true,
+	"api_key_created":      true,
+	"client_requested":     true,
+	"collaborator_changed": true,
+	"entity_state_changed": true,
+	"mfa_changed":          true,
+	"password_changed":     true,
+	"user_requested":       true,
+}
+
+// mandatoryEmailNotificationTypes are the types of notifications that are always sent by email, because they are
+// about the security of the user account.
+var mandatoryEmailNotificationTypes = map[string]bool{
+	"mfa_changed":      true,
+	"password_changed": true,
+}
+
+var notificationStatusToStore = map[ttnpb.NotificationStatus]string{
+	ttnpb.NotificationStatus_NOTIFICATION_STATUS_UNREAD:   store.NotificationStatusUnread,
+	ttnpb.NotificationStatus_NOTIFICATION_STATUS_READ:     store.NotificationStatusRead,
+	ttnpb.NotificationStatus_NOTIFICATION_STATUS_ARCHIVED: store.NotificationStatusArchived,
+}
+
+var notificationStatusFromStore = map[string]ttnpb.NotificationStatus{
+	store.NotificationStatusUnread:   ttnpb.NotificationStatus_NOTIFICATION_STATUS_UNREAD,
+	store.NotificationStatusRead:     ttnpb.NotificationStatus_NOTIFICATION_STATUS_READ,
+	store.NotificationStatusArchived: ttnpb.NotificationStatus_NOTIFICATION_STATUS_ARCHIVED,
+}
+
+var (
+	errUnknownNotificationType    = errors.DefineInvalidArgument("unknown_notification_type", "unknown notification type `{type}`")
+	errMandatoryEmailNotification = errors.DefineInvalidArgument("mandatory_email_notification", "email notifications of type `{type}` can not be disabled")
+)
+
+func notificationToPB(model *store.Notification) *ttnpb.Notification {
+	createdAt, statusUpdatedAt := model.CreatedAt, model.UpdatedAt
+	return &ttnpb.Notification{
+		Id:               model.ID,
+		CreatedAt:        &createdAt,
+		NotificationType: model.NotificationType,
+		EntityType:       model.EntityType,
+		EntityId:         model.EntityID,
+		Subject:          model.Subject,
+		Body:             model.Body,
+		Status:           notificationStatusFromStore[model.Status],
+		StatusUpdatedAt:  &statusUpdatedAt,
+	}
+}
+
+// sendUserEmail delivers the email to the notification inbox of the user if it is a notification, and sends the
+// email unless the user disabled emails for the type of notification.
+func (is *IdentityServer) sendUserEmail(ctx context.Context, usr *ttnpb.User, f func(emails.Data) email.MessageData) error {
+	messageData, message, err := is.renderEmail(ctx, f)
+	if err != nil || message == nil {
+		return err
+	}
+	if !notificationTypes[message.TemplateName] {
+		return is.sendEmail(ctx, message)
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"user_id", usr.GetIds().GetUserId(),
+		"notification_type", message.TemplateName,
+	))
+	notification := &store.Notification{
+		NotificationType: message.TemplateName,
+		Subject:          message.Subject,
+		Body:             message.TextBody,
+	}
+	if data, ok := messageData.(interface{ GetData() emails.Data }); ok {
+		entity := data.GetData().Entity
+		notification.EntityType, notification.EntityID = entity.Type, entity.ID
+	}
+	var disabledTypes []string
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
+		notificationStore := store.GetNotificationStore(db)
+		notification, err = notificationStore.CreateNotification(ctx, usr.GetIds(), notification)
+		if err != nil {
+			return err
+		}
+		disabledTypes, err = notificationStore.GetDisabledEmailNotifications(ctx, usr.GetIds())
+		return err
+	}, func() events.Event {
+		return evtCreateNotification.NewWithIdentifiersAndData(ctx, usr.GetIds(), &pbtypes.StringValue{
+			Value: notification.ID,
+		})
+	})
+	if err != nil {
+		// The email is still sent, so that the user does not miss the notification.
+		logger.WithError(err).Warn("Failed to create notification")
+		return is.sendEmail(ctx, message)
+	}
+	for _, disabledType := range disabledTypes {
+		if disabledType == message.TemplateName && !mandatoryEmailNotificationTypes[disabledType] {
+			logger.Debug("Skip email of notification that is disabled by user")
+			return nil
+		}
+	}
+	return is.sendEmail(ctx, message)
+}
+
+func (is *IdentityServer) listNotifications(ctx context.Context, req *ttnpb.ListNotificationsRequest) (notifications *ttnpb.Notifications, err error) {
+	if err := is.requireUnrestricted(ctx); err != nil {
+		return nil, err
+	}
+	if err := rights.RequireUser(ctx, *req.GetReceiverIds(), ttnpb.RIGHT_USER_INFO); err != nil {
+		return nil, err
+	}
+	statuses := make([]string, len(req.Status))
+	for i, status := range req.Status {
+		statuses[i] = notificationStatusToStore[status]
+	}
+	var total uint64
+	ctx = store.WithPagination(ctx, req.Limit, req.Page, &total)
+	defer func() {
+		if err == nil {
+			setTotalHeader(ctx, total)
+		}
+	}()
+	var models []*store.Notification
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		models, err = store.GetNotificationStore(db).ListNotifications(ctx, req.GetReceiverIds(), statuses...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	notifications = &ttnpb.Notifications{
+		Notifications: make([]*ttnpb.Notification, len(models)),
+	}
+	for i, model := range models {
+		notifications.Notifications[i] = notificationToPB(model)
+	}
+	return notifications, nil
+}
+
+func (is *IdentityServer) updateNotificationStatus(ctx context.Context, req *ttnpb.UpdateNotificationStatusRequest) (*pbtypes.Empty, error) {
+	if err := is.requireUnrestricted(ctx); err != nil {
+		return nil, err
+	}
+	if err := rights.RequireUser(ctx, *req.GetReceiverIds(), ttnpb.RIGHT_USER_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetNotificationStore(db).UpdateNotificationStatus(ctx, req.GetReceiverIds(), req.Ids, notificationStatusToStore[req.Status])
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// streamNotifications calls f for every new notification in the inbox of the user, until the context is done or f
+// returns an error. Notifications are queued while f is busy, so that slow streams do not miss notifications.
+func (is *IdentityServer) streamNotifications(ctx context.Context, req *ttnpb.StreamNotificationsRequest, f func(*ttnpb.Notification) error) error {
+	if err := is.requireUnrestricted(ctx); err != nil {
+		return err
+	}
+	userIDs := req.GetReceiverIds()
+	if err := rights.RequireUser(ctx, *userIDs, ttnpb.RIGHT_USER_INFO); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		pendingMu sync.Mutex
+		pending   []string
+		notify    = make(chan struct{}, 1)
+	)
+	err := events.Subscribe(ctx, []string{createNotificationEvent}, []*ttnpb.EntityIdentifiers{
+		userIDs.GetEntityIdentifiers(),
+	}, events.HandlerFunc(func(evt events.Event) {
+		id, ok := evt.Data().(*pbtypes.StringValue)
+		if !ok {
+			return
+		}
+		pendingMu.Lock()
+		pending = append(pending, id.Value)
+		pendingMu.Unlock()
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}))
+	if err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-notify:
+		}
+		pendingMu.Lock()
+		ids := pending
+		pending = nil
+		pendingMu.Unlock()
+		for _, id := range ids {
+			var model *store.Notification
+			err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+				model, err = store.GetNotificationStore(db).GetNotification(ctx, userIDs, id)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			if err := f(notificationToPB(model)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (is *IdentityServer) getNotificationEmailPreferences(ctx context.Context, userIDs *ttnpb.UserIdentifiers) (*ttnpb.NotificationEmailPreferences, error) {
+	if err := is.requireUnrestricted(ctx); err != nil {
+		return nil, err
+	}
+	if err := rights.RequireUser(ctx, *userIDs, ttnpb.RIGHT_USER_INFO); err != nil {
+		return nil, err
+	}
+	var disabledTypes []string
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		disabledTypes, err = store.GetNotificationStore(db).GetDisabledEmailNotifications(ctx, userIDs)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	preferences := &ttnpb.NotificationEmailPreferences{
+		Email: make(map[string]bool, len(notificationTypes)),
+	}
+	for notificationType := range notificationTypes {
+		preferences.Email[notificationType] = true
+	}
+	for _, disabledType := range disabledTypes {
+		if notificationTypes[disabledType] && !mandatoryEmailNotificationTypes[disabledType] {
+			preferences.Email[disabledType] = false
+		}
+	}
+	return preferences, nil
+}
+
+// setNotificationEmailPreferences sets the preferences of the user for receiving notifications by email.
+// Notification types that are not in the preferences are sent by email.
+func (is *IdentityServer) setNotificationEmailPreferences(ctx context.Context, req *ttnpb.SetNotificationEmailPreferencesRequest) (*ttnpb.NotificationEmailPreferences, error) {
+	if err := is.requireUnrestricted(ctx); err != nil {
+		return nil, err
+	}
+	if err := rights.RequireUser(ctx, *req.GetUserIds(), ttnpb.RIGHT_USER_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	var disabledTypes []string
+	for notificationType, enabled := range req.GetPreferences().GetEmail() {
+		if !notificationTypes[notificationType] {
+			return nil, errUnknownNotificationType.WithAttributes("type", notificationType)
+		}
+		if enabled {
+			continue
+		}
+		if mandatoryEmailNotificationTypes[notificationType] {
+			return nil, errMandatoryEmailNotification.WithAttributes("type", notificationType)
+		}
+		disabledTypes = append(disabledTypes, notificationType)
+	}
+	sort.Strings(disabledTypes)
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetNotificationStore(db).SetDisabledEmailNotifications(ctx, req.GetUserIds(), disabledTypes)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return is.getNotificationEmailPreferences(ctx, req.GetUserIds())
+}
+
+type notificationService struct {
+	*IdentityServer
+}
+
+func (ns *notificationService) List(ctx context.Context, req *ttnpb.ListNotificationsRequest) (*ttnpb.Notifications, error) {
+	return ns.listNotifications(ctx, req)
+}
+
+func (ns *notificationService) UpdateStatus(ctx context.Context, req *ttnpb.UpdateNotificationStatusRequest) (*pbtypes.Empty, error) {
+	return ns.updateNotificationStatus(ctx, req)
+}
+
+func (ns *notificationService) Stream(req *ttnpb.StreamNotificationsRequest, stream ttnpb.NotificationService_StreamServer) error {
+	return ns.streamNotifications(stream.Context(), req, stream.Send)
+}
+
+func (ns *notificationService) GetEmailPreferences(ctx context.Context, req *ttnpb.UserIdentifiers) (*ttnpb.NotificationEmailPreferences, error) {
+	return ns.getNotificationEmailPreferences(ctx, req)
+}
+
+func (ns *notificationService) SetEmailPreferences(ctx context.Context, req *ttnpb.SetNotificationEmailPreferencesRequest) (*ttnpb.NotificationEmailPreferences, error) {
+	return ns.setNotificationEmailPreferences(ctx, req)
+}
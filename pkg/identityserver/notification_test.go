@@ -0,0 +1,165 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/events/basic"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+func TestNotifications(t *testing.T) {
+	a, ctx := test.New(t)
+
+	defer events.SetDefaultPubSub(events.DefaultPubSub())
+	events.SetDefaultPubSub(basic.NewPubSub())
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userIDs := defaultUser.GetIds()
+		creds := userCreds(defaultUserIdx)
+		cli := ttnpb.NewNotificationServiceClient(cc)
+
+		preferences, err := cli.GetEmailPreferences(ctx, userIDs, creds)
+		if a.So(err, should.BeNil) {
+			a.So(preferences.Email["api_key_created"], should.BeTrue)
+			a.So(preferences.Email["password_changed"], should.BeTrue)
+		}
+
+		_, err = cli.SetEmailPreferences(ctx, &ttnpb.SetNotificationEmailPreferencesRequest{
+			UserIds:     userIDs,
+			Preferences: &ttnpb.NotificationEmailPreferences{Email: map[string]bool{"password_changed": false}},
+		}, creds)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		_, err = cli.SetEmailPreferences(ctx, &ttnpb.SetNotificationEmailPreferencesRequest{
+			UserIds:     userIDs,
+			Preferences: &ttnpb.NotificationEmailPreferences{Email: map[string]bool{"unknown": false}},
+		}, creds)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+		preferences, err = cli.SetEmailPreferences(ctx, &ttnpb.SetNotificationEmailPreferencesRequest{
+			UserIds: userIDs,
+			Preferences: &ttnpb.NotificationEmailPreferences{
+				Email: map[string]bool{"api_key_created": false, "api_key_changed": true},
+			},
+		}, creds)
+		if a.So(err, should.BeNil) {
+			a.So(preferences.Email["api_key_created"], should.BeFalse)
+			a.So(preferences.Email["api_key_changed"], should.BeTrue)
+		}
+
+		streamCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		stream, err := cli.Stream(streamCtx, &ttnpb.StreamNotificationsRequest{ReceiverIds: userIDs}, creds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		// Wait for the stream to subscribe.
+		time.Sleep(test.Delay)
+
+		reg := ttnpb.NewUserAccessClient(cc)
+		var apiKeyIDs []string
+		for _, name := range []string{"notification key", "other notification key"} {
+			apiKey, err := reg.CreateAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+				UserIds: userIDs,
+				Name:    name,
+				Rights:  []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+			}, creds)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			apiKeyIDs = append(apiKeyIDs, apiKey.Id)
+		}
+		defer func() {
+			for _, id := range apiKeyIDs {
+				reg.UpdateAPIKey(ctx, &ttnpb.UpdateUserAPIKeyRequest{
+					UserIds:   userIDs,
+					ApiKey:    &ttnpb.APIKey{Id: id},
+					FieldMask: &pbtypes.FieldMask{Paths: []string{"rights"}},
+				}, creds)
+			}
+		}()
+
+		// Both notifications are streamed, also if the stream is not read while they are created.
+		streamed, err := stream.Recv()
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(streamed.NotificationType, should.Equal, "api_key_created")
+		a.So(streamed.EntityType, should.Equal, "user")
+		a.So(streamed.EntityId, should.Equal, userIDs.GetUserId())
+		a.So(streamed.Status, should.Equal, ttnpb.NotificationStatus_NOTIFICATION_STATUS_UNREAD)
+		a.So(streamed.Body, should.ContainSubstring, "notification key")
+		other, err := stream.Recv()
+		if a.So(err, should.BeNil) {
+			a.So(other.Body, should.ContainSubstring, "other notification key")
+		}
+
+		list, err := cli.List(ctx, &ttnpb.ListNotificationsRequest{
+			ReceiverIds: userIDs,
+			Status:      []ttnpb.NotificationStatus{ttnpb.NotificationStatus_NOTIFICATION_STATUS_UNREAD},
+		}, creds)
+		if a.So(err, should.BeNil) && a.So(list.Notifications, should.HaveLength, 2) {
+			a.So([]string{list.Notifications[0].Id, list.Notifications[1].Id}, should.Contain, streamed.Id)
+		}
+
+		_, err = cli.UpdateStatus(ctx, &ttnpb.UpdateNotificationStatusRequest{
+			ReceiverIds: userIDs,
+			Ids:         []string{streamed.Id},
+			Status:      ttnpb.NotificationStatus(42),
+		}, creds)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		_, err = cli.UpdateStatus(ctx, &ttnpb.UpdateNotificationStatusRequest{
+			ReceiverIds: userIDs,
+			Ids:         []string{streamed.Id},
+			Status:      ttnpb.NotificationStatus_NOTIFICATION_STATUS_ARCHIVED,
+		}, creds)
+		a.So(err, should.BeNil)
+
+		list, err = cli.List(ctx, &ttnpb.ListNotificationsRequest{
+			ReceiverIds: userIDs,
+			Status: []ttnpb.NotificationStatus{
+				ttnpb.NotificationStatus_NOTIFICATION_STATUS_UNREAD,
+				ttnpb.NotificationStatus_NOTIFICATION_STATUS_READ,
+			},
+		}, creds)
+		if a.So(err, should.BeNil) {
+			for _, notification := range list.Notifications {
+				a.So(notification.Id, should.NotEqual, streamed.Id)
+			}
+		}
+		list, err = cli.List(ctx, &ttnpb.ListNotificationsRequest{
+			ReceiverIds: userIDs,
+			Status:      []ttnpb.NotificationStatus{ttnpb.NotificationStatus_NOTIFICATION_STATUS_ARCHIVED},
+		}, creds)
+		if a.So(err, should.BeNil) && a.So(list.Notifications, should.HaveLength, 1) {
+			a.So(list.Notifications[0].Id, should.Equal, streamed.Id)
+			a.So(list.Notifications[0].Status, should.Equal, ttnpb.NotificationStatus_NOTIFICATION_STATUS_ARCHIVED)
+		}
+
+		_, err = cli.List(ctx, &ttnpb.ListNotificationsRequest{
+			ReceiverIds: &ttnpb.UserIdentifiers{UserId: userIDs.GetUserId() + "-other"},
+		}, creds)
+		a.So(errors.IsPermissionDenied(err) || errors.IsNotFound(err), should.BeTrue)
+	})
+}
@@ -63,9 +63,9 @@ var (
 )
 
 var (
-	errNestedOrganizations       = errors.DefineInvalidArgument("nested_organizations", "organizations can not be nested")
-	errAdminsCreateOrganizations = errors.DefinePermissionDenied("admins_create_organizations", "organizations may only be created by admins")
-	errAdminsPurgeOrganizations  = errors.DefinePermissionDenied("admins_purge_organizations", "organizations may only be purged by admins")
+	errOrganizationsCreateOrganizations = errors.DefineInvalidArgument("organizations_create_organizations", "organizations can not create organizations")
+	errAdminsCreateOrganizations        = errors.DefinePermissionDenied("admins_create_organizations", "organizations may only be created by admins")
+	errAdminsPurgeOrganizations         = errors.DefinePermissionDenied("admins_purge_organizations", "organizations may only be purged by admins")
 )
 
 func (is *IdentityServer) createOrganization(ctx context.Context, req *ttnpb.CreateOrganizationRequest) (org *ttnpb.Organization, err error) {
@@ -80,12 +80,12 @@ func (is *IdentityServer) createOrganization(ctx context.Context, req *ttnpb.Cre
 			return nil, err
 		}
 	} else if orgIDs := req.GetCollaborator().GetOrganizationIds(); orgIDs != nil {
-		return nil, errNestedOrganizations.New()
+		return nil, errOrganizationsCreateOrganizations.New()
 	}
 	if err := validateContactInfo(req.Organization.ContactInfo); err != nil {
 		return nil, err
 	}
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		org, err = store.GetOrganizationStore(db).CreateOrganization(ctx, req.Organization)
 		if err != nil {
 			return err
@@ -106,11 +106,12 @@ func (is *IdentityServer) createOrganization(ctx context.Context, req *ttnpb.Cre
 			}
 		}
 		return nil
+	}, func() events.Event {
+		return evtCreateOrganization.NewWithIdentifiersAndData(ctx, req.Organization.GetIds(), nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtCreateOrganization.NewWithIdentifiersAndData(ctx, req.Organization.GetIds(), nil))
 	return org, nil
 }
 
@@ -164,7 +165,9 @@ func (is *IdentityServer) listOrganizations(ctx context.Context, req *ttnpb.List
 			return nil, err
 		}
 	} else if orgIDs := req.Collaborator.GetOrganizationIds(); orgIDs != nil {
-		return nil, errNestedOrganizations.New()
+		if err = rights.RequireOrganization(ctx, *orgIDs, ttnpb.RIGHT_ORGANIZATION_INFO); err != nil {
+			return nil, err
+		}
 	}
 
 	if req.Deleted {
@@ -235,7 +238,7 @@ func (is *IdentityServer) updateOrganization(ctx context.Context, req *ttnpb.Upd
 			return nil, err
 		}
 	}
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		org, err = store.GetOrganizationStore(db).UpdateOrganization(ctx, req.Organization, req.FieldMask)
 		if err != nil {
 			return err
@@ -248,11 +251,12 @@ func (is *IdentityServer) updateOrganization(ctx context.Context, req *ttnpb.Upd
 			}
 		}
 		return nil
+	}, func() events.Event {
+		return evtUpdateOrganization.NewWithIdentifiersAndData(ctx, req.Organization.GetIds(), req.FieldMask.GetPaths())
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtUpdateOrganization.NewWithIdentifiersAndData(ctx, req.Organization.GetIds(), req.FieldMask.GetPaths()))
 	return org, nil
 }
 
@@ -260,13 +264,15 @@ func (is *IdentityServer) deleteOrganization(ctx context.Context, ids *ttnpb.Org
 	if err := rights.RequireOrganization(ctx, *ids, ttnpb.RIGHT_ORGANIZATION_DELETE); err != nil {
 		return nil, err
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
 		return store.GetOrganizationStore(db).DeleteOrganization(ctx, ids)
+	}, func() events.Event {
+		return evtDeleteOrganization.NewWithIdentifiersAndData(ctx, ids, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtDeleteOrganization.NewWithIdentifiersAndData(ctx, ids, nil))
+	is.invalidateMembershipOrganizations(ctx)
 	return ttnpb.Empty, nil
 }
 
@@ -274,7 +280,7 @@ func (is *IdentityServer) restoreOrganization(ctx context.Context, ids *ttnpb.Or
 	if err := rights.RequireOrganization(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_ORGANIZATION_DELETE); err != nil {
 		return nil, err
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
 		orgStore := store.GetOrganizationStore(db)
 		org, err := orgStore.GetOrganization(store.WithSoftDeleted(ctx, true), ids, softDeleteFieldMask)
 		if err != nil {
@@ -283,15 +289,17 @@ func (is *IdentityServer) restoreOrganization(ctx context.Context, ids *ttnpb.Or
 		if org.DeletedAt == nil {
 			panic("store.WithSoftDeleted(ctx, true) returned result that is not deleted")
 		}
-		if time.Since(*org.DeletedAt) > is.configFromContext(ctx).Delete.Restore {
+		if time.Since(*org.DeletedAt) > is.configFromContext(ctx).DeleteRetention("organization") {
 			return errRestoreWindowExpired.New()
 		}
 		return orgStore.RestoreOrganization(ctx, ids)
+	}, func() events.Event {
+		return evtRestoreOrganization.NewWithIdentifiersAndData(ctx, ids, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtRestoreOrganization.NewWithIdentifiersAndData(ctx, ids, nil))
+	is.invalidateMembershipOrganizations(ctx)
 	return ttnpb.Empty, nil
 }
 
@@ -299,26 +307,14 @@ func (is *IdentityServer) purgeOrganization(ctx context.Context, ids *ttnpb.Orga
 	if !is.IsAdmin(ctx) {
 		return nil, errAdminsPurgeOrganizations.New()
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		err := store.GetContactInfoStore(db).DeleteEntityContactInfo(ctx, ids)
-		if err != nil {
-			return err
-		}
-		// Delete related API keys before purging the organization.
-		err = store.GetAPIKeyStore(db).DeleteEntityAPIKeys(ctx, ids.GetEntityIdentifiers())
-		if err != nil {
-			return err
-		}
-		err = store.GetMembershipStore(db).DeleteAccountMembers(ctx, ids.GetOrganizationOrUserIdentifiers())
-		if err != nil {
-			return err
-		}
-		return store.GetOrganizationStore(db).PurgeOrganization(ctx, ids)
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
+		return PurgeEntity(ctx, db, ids.GetEntityIdentifiers())
+	}, func() events.Event {
+		return evtPurgeOrganization.NewWithIdentifiersAndData(ctx, ids, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtPurgeOrganization.NewWithIdentifiersAndData(ctx, ids, nil))
 	return ttnpb.Empty, nil
 }
 
@@ -53,7 +53,7 @@ func init() {
 	}
 }
 
-func TestOrganizationsNestedError(t *testing.T) {
+func TestOrganizationsNested(t *testing.T) {
 	a := assertions.New(t)
 	ctx := test.Context()
 
@@ -63,6 +63,7 @@ func TestOrganizationsNestedError(t *testing.T) {
 		org := userOrganizations(userID).Organizations[0]
 
 		reg := ttnpb.NewOrganizationRegistryClient(cc)
+		access := ttnpb.NewOrganizationAccessClient(cc)
 
 		_, err := reg.Create(ctx, &ttnpb.CreateOrganizationRequest{
 			Organization: &ttnpb.Organization{
@@ -75,13 +76,47 @@ func TestOrganizationsNestedError(t *testing.T) {
 			a.So(errors.IsInvalidArgument(err), should.BeTrue)
 		}
 
-		_, err = reg.List(ctx, &ttnpb.ListOrganizationsRequest{
+		parent, err := reg.Create(ctx, &ttnpb.CreateOrganizationRequest{
+			Organization: &ttnpb.Organization{
+				Ids: &ttnpb.OrganizationIdentifiers{OrganizationId: "parent-org"},
+			},
+			Collaborator: userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		defer reg.Delete(ctx, parent.GetIds(), creds)
+
+		_, err = access.SetCollaborator(ctx, &ttnpb.SetOrganizationCollaboratorRequest{
+			OrganizationIds: parent.GetIds(),
+			Collaborator: &ttnpb.Collaborator{
+				Ids:    org.OrganizationOrUserIdentifiers(),
+				Rights: []ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_INFO},
+			},
+		}, creds)
+
+		a.So(err, should.BeNil)
+
+		list, err := reg.List(ctx, &ttnpb.ListOrganizationsRequest{
 			FieldMask:    &pbtypes.FieldMask{Paths: []string{"name"}},
 			Collaborator: org.OrganizationOrUserIdentifiers(),
 		}, creds)
 
+		if a.So(err, should.BeNil) && a.So(list.Organizations, should.HaveLength, 1) {
+			a.So(list.Organizations[0].GetIds().GetOrganizationId(), should.Equal, "parent-org")
+		}
+
+		_, err = access.SetCollaborator(ctx, &ttnpb.SetOrganizationCollaboratorRequest{
+			OrganizationIds: org.GetIds(),
+			Collaborator: &ttnpb.Collaborator{
+				Ids:    parent.GetIds().GetOrganizationOrUserIdentifiers(),
+				Rights: []ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_INFO},
+			},
+		}, creds)
+
 		if a.So(err, should.NotBeNil) {
-			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			a.So(errors.IsFailedPrecondition(err), should.BeTrue)
 		}
 	})
 }
@@ -0,0 +1,264 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var expiredFieldMask = &pbtypes.FieldMask{Paths: []string{"ids", "deleted_at"}}
+
+// ExpiredEntities returns the soft-deleted entities in the database that can no longer be restored,
+// according to the retention in the config.
+func ExpiredEntities(ctx context.Context, db *gorm.DB, config Config) ([]*ttnpb.EntityIdentifiers, error) {
+	ctx = store.WithSoftDeleted(ctx, true)
+	var expired []*ttnpb.EntityIdentifiers
+
+	apps, err := store.GetApplicationStore(db).FindApplications(
+		store.WithExpired(ctx, config.DeleteRetention("application")), []*ttnpb.ApplicationIdentifiers{}, expiredFieldMask,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, app := range apps {
+		expired = append(expired, app.GetIds().GetEntityIdentifiers())
+	}
+	usrs, err := store.GetUserStore(db).FindUsers(
+		store.WithExpired(ctx, config.DeleteRetention("user")), []*ttnpb.UserIdentifiers{}, expiredFieldMask,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, usr := range usrs {
+		expired = append(expired, usr.GetIds().GetEntityIdentifiers())
+	}
+	orgs, err := store.GetOrganizationStore(db).FindOrganizations(
+		store.WithExpired(ctx, config.DeleteRetention("organization")), []*ttnpb.OrganizationIdentifiers{}, expiredFieldMask,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, org := range orgs {
+		expired = append(expired, org.GetIds().GetEntityIdentifiers())
+	}
+	gtws, err := store.GetGatewayStore(db).FindGateways(
+		store.WithExpired(ctx, config.DeleteRetention("gateway")), []*ttnpb.GatewayIdentifiers{}, expiredFieldMask,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, gtw := range gtws {
+		expired = append(expired, gtw.GetIds().GetEntityIdentifiers())
+	}
+	clis, err := store.GetClientStore(db).FindClients(
+		store.WithExpired(ctx, config.DeleteRetention("client")), []*ttnpb.ClientIdentifiers{}, expiredFieldMask,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, cli := range clis {
+		expired = append(expired, cli.GetIds().GetEntityIdentifiers())
+	}
+	return expired, nil
+}
+
+// PurgeEntity purges the entity from the database, together with its API keys, memberships, contact info
+// and other related data. Applications that still have end devices can not be purged.
+func PurgeEntity(ctx context.Context, db *gorm.DB, ids *ttnpb.EntityIdentifiers) error {
+	switch ids := ids.GetIds().(type) {
+	case *ttnpb.EntityIdentifiers_ApplicationIds:
+		total, err := store.GetEndDeviceStore(db).CountEndDevices(ctx, ids.ApplicationIds)
+		if err != nil {
+			return err
+		}
+		if total > 0 {
+			return errApplicationHasDevices.WithAttributes("count", int(total))
+		}
+		// Delete related API keys before purging the application.
+		err = store.GetAPIKeyStore(db).DeleteEntityAPIKeys(ctx, ids.ApplicationIds.GetEntityIdentifiers())
+		if err != nil {
+			return err
+		}
+		// Delete related memberships before purging the application.
+		err = store.GetMembershipStore(db).DeleteEntityMembers(ctx, ids.ApplicationIds.GetEntityIdentifiers())
+		if err != nil {
+			return err
+		}
+		// Delete related contact info before purging the application.
+		err = store.GetContactInfoStore(db).DeleteEntityContactInfo(ctx, ids.ApplicationIds)
+		if err != nil {
+			return err
+		}
+		return store.GetApplicationStore(db).PurgeApplication(ctx, ids.ApplicationIds)
+
+	case *ttnpb.EntityIdentifiers_ClientIds:
+		// Delete related authorizations before purging the client.
+		err := store.GetOAuthStore(db).DeleteClientAuthorizations(ctx, ids.ClientIds)
+		if err != nil {
+			return err
+		}
+		// Delete related memberships before purging the client.
+		err = store.GetMembershipStore(db).DeleteEntityMembers(ctx, ids.ClientIds.GetEntityIdentifiers())
+		if err != nil {
+			return err
+		}
+		// Delete related contact info before purging the client.
+		err = store.GetContactInfoStore(db).DeleteEntityContactInfo(ctx, ids.ClientIds)
+		if err != nil {
+			return err
+		}
+		return store.GetClientStore(db).PurgeClient(ctx, ids.ClientIds)
+
+	case *ttnpb.EntityIdentifiers_GatewayIds:
+		// Delete related API keys before purging the gateway.
+		err := store.GetAPIKeyStore(db).DeleteEntityAPIKeys(ctx, ids.GatewayIds.GetEntityIdentifiers())
+		if err != nil {
+			return err
+		}
+		// Delete related memberships before purging the gateway.
+		err = store.GetMembershipStore(db).DeleteEntityMembers(ctx, ids.GatewayIds.GetEntityIdentifiers())
+		if err != nil {
+			return err
+		}
+		// Delete related contact info before purging the gateway.
+		err = store.GetContactInfoStore(db).DeleteEntityContactInfo(ctx, ids.GatewayIds)
+		if err != nil {
+			return err
+		}
+		return store.GetGatewayStore(db).PurgeGateway(ctx, ids.GatewayIds)
+
+	case *ttnpb.EntityIdentifiers_OrganizationIds:
+		err := store.GetContactInfoStore(db).DeleteEntityContactInfo(ctx, ids.OrganizationIds)
+		if err != nil {
+			return err
+		}
+		// Delete related API keys before purging the organization.
+		err = store.GetAPIKeyStore(db).DeleteEntityAPIKeys(ctx, ids.OrganizationIds.GetEntityIdentifiers())
+		if err != nil {
+			return err
+		}
+		err = store.GetMembershipStore(db).DeleteAccountMembers(ctx, ids.OrganizationIds.GetOrganizationOrUserIdentifiers())
+		if err != nil {
+			return err
+		}
+		return store.GetOrganizationStore(db).PurgeOrganization(ctx, ids.OrganizationIds)
+
+	case *ttnpb.EntityIdentifiers_UserIds:
+		err := store.GetContactInfoStore(db).DeleteEntityContactInfo(ctx, ids.UserIds)
+		if err != nil {
+			return err
+		}
+		// Delete related API keys before purging the user.
+		err = store.GetAPIKeyStore(db).DeleteEntityAPIKeys(ctx, ids.UserIds.GetEntityIdentifiers())
+		if err != nil {
+			return err
+		}
+		err = store.GetMembershipStore(db).DeleteAccountMembers(ctx, ids.UserIds.GetOrganizationOrUserIdentifiers())
+		if err != nil {
+			return err
+		}
+		err = store.GetOAuthStore(db).DeleteUserAuthorizations(ctx, ids.UserIds)
+		if err != nil {
+			return err
+		}
+		err = store.GetUserSessionStore(db).DeleteAllUserSessions(ctx, ids.UserIds)
+		if err != nil {
+			return err
+		}
+		err = store.GetUserMFAStore(db).DeleteUserMFA(ctx, ids.UserIds)
+		if err != nil {
+			return err
+		}
+		err = store.GetFederatedIdentityStore(db).DeleteUserFederatedIdentities(ctx, ids.UserIds)
+		if err != nil {
+			return err
+		}
+		err = store.GetNotificationStore(db).DeleteUserNotifications(ctx, ids.UserIds)
+		if err != nil {
+			return err
+		}
+		return store.GetUserStore(db).PurgeUser(ctx, ids.UserIds)
+
+	default:
+		panic("unsupported entity type")
+	}
+}
+
+var purgeEvents = map[string]events.Builder{
+	"application":  evtPurgeApplication,
+	"client":       evtPurgeClient,
+	"gateway":      evtPurgeGateway,
+	"organization": evtPurgeOrganization,
+	"user":         evtPurgeUser,
+}
+
+// registerPurge registers the task that purges soft-deleted entities that can no longer be restored.
+// The purge events that are published for purged entities are used by other components to clean up their data.
+func (is *IdentityServer) registerPurge() {
+	interval := is.config.Delete.PurgeInterval
+	if interval <= 0 {
+		return
+	}
+	is.RegisterTask(&component.TaskConfig{
+		Context: is.Context(),
+		ID:      "purge_deleted_entities",
+		Func: func(ctx context.Context) error {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-ticker.C:
+				}
+				var expired []*ttnpb.EntityIdentifiers
+				err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+					expired, err = ExpiredEntities(ctx, db, *is.configFromContext(ctx))
+					return err
+				})
+				if err != nil {
+					return err
+				}
+				for _, ids := range expired {
+					logger := log.FromContext(ctx).WithFields(log.Fields(
+						"entity_type", ids.EntityType(),
+						"entity_id", ids.IDString(),
+					))
+					err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
+						return PurgeEntity(ctx, db, ids)
+					}, func() events.Event {
+						return purgeEvents[ids.EntityType()].NewWithIdentifiersAndData(ctx, ids, nil)
+					})
+					if err != nil {
+						logger.WithError(err).Warn("Failed to purge deleted entity")
+						continue
+					}
+					logger.Debug("Purged deleted entity")
+				}
+			}
+		},
+		Restart: component.TaskRestartOnFailure,
+		Backoff: component.DefaultTaskBackoffConfig,
+	})
+}
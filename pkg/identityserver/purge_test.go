@@ -0,0 +1,115 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+func TestDeleteRetention(t *testing.T) {
+	a := assertions.New(t)
+
+	var conf Config
+	conf.Delete.Restore = 24 * time.Hour
+	conf.Delete.Retention.Gateways = time.Hour
+	a.So(conf.DeleteRetention("gateway"), should.Equal, time.Hour)
+	a.So(conf.DeleteRetention("organization"), should.Equal, 24*time.Hour)
+}
+
+func TestRestoreAndPurge(t *testing.T) {
+	a, ctx := test.New(t)
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		creds := userCreds(defaultUserIdx)
+		orgReg := ttnpb.NewOrganizationRegistryClient(cc)
+		gtwReg := ttnpb.NewGatewayRegistryClient(cc)
+
+		org, err := orgReg.Create(ctx, &ttnpb.CreateOrganizationRequest{
+			Organization: &ttnpb.Organization{Ids: &ttnpb.OrganizationIdentifiers{OrganizationId: "purge-org"}},
+			Collaborator: defaultUser.GetIds().OrganizationOrUserIdentifiers(),
+		}, creds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		gtw, err := gtwReg.Create(ctx, &ttnpb.CreateGatewayRequest{
+			Gateway: &ttnpb.Gateway{
+				Ids:             &ttnpb.GatewayIdentifiers{GatewayId: "purge-gtw"},
+				FrequencyPlanId: test.EUFrequencyPlanID,
+			},
+			Collaborator: defaultUser.GetIds().OrganizationOrUserIdentifiers(),
+		}, creds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		_, err = orgReg.Delete(ctx, org.GetIds(), creds)
+		a.So(err, should.BeNil)
+		_, err = gtwReg.Delete(ctx, gtw.GetIds(), creds)
+		a.So(err, should.BeNil)
+
+		// Organizations are retained shorter than gateways.
+		is.config.Delete.Retention.Organizations = time.Nanosecond
+
+		// The owner can restore the gateway within the retention.
+		_, err = gtwReg.Restore(ctx, gtw.GetIds(), creds)
+		a.So(err, should.BeNil)
+
+		// The owner can not restore the organization after the retention.
+		_, err = orgReg.Restore(ctx, org.GetIds(), creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+		}
+
+		var expired []*ttnpb.EntityIdentifiers
+		err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+			expired, err = ExpiredEntities(ctx, db, *is.config)
+			return err
+		})
+		if a.So(err, should.BeNil) {
+			var expiredIDs []string
+			for _, ids := range expired {
+				expiredIDs = append(expiredIDs, ids.EntityType()+":"+ids.IDString())
+			}
+			a.So(expiredIDs, should.Contain, "organization:purge-org")
+			a.So(expiredIDs, should.NotContain, "gateway:purge-gtw")
+		}
+
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			return PurgeEntity(ctx, db, org.GetIds().GetEntityIdentifiers())
+		})
+		a.So(err, should.BeNil)
+
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			_, err := store.GetOrganizationStore(db).GetOrganization(store.WithSoftDeleted(ctx, false), org.GetIds(), nil)
+			return err
+		})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		_, err = gtwReg.Get(ctx, &ttnpb.GetGatewayRequest{GatewayIds: gtw.GetIds()}, creds)
+		a.So(err, should.BeNil)
+	})
+}
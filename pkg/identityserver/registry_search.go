@@ -439,10 +439,14 @@ func (rs *registrySearch) SearchUsers(ctx context.Context, req *ttnpb.SearchUser
 }
 
 func (rs *registrySearch) SearchEndDevices(ctx context.Context, req *ttnpb.SearchEndDevicesRequest) (*ttnpb.EndDevices, error) {
+	ctx = rights.WithEndDeviceFilter(ctx)
 	err := rights.RequireApplication(ctx, *req.ApplicationIds, ttnpb.RIGHT_APPLICATION_DEVICES_READ)
 	if err != nil {
 		return nil, err
 	}
+	if deviceIDs, ok := rights.RestrictedEndDeviceIDs(ctx); ok {
+		ctx = store.WithEndDeviceIDsFilter(ctx, deviceIDs)
+	}
 	var searchFields []string
 	if req.IdContains != "" {
 		searchFields = append(searchFields, "ids")
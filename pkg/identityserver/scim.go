@@ -0,0 +1,499 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+)
+
+// SCIM 2.0 (RFC 7643, RFC 7644) schemas.
+const (
+	scimSchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimSchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimSchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimSchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	scimSchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimSchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	scimSchemaResourceType          = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+)
+
+// Attributes of users and organizations that hold SCIM attributes that do not map onto fields.
+const (
+	scimUserNameAttribute   = "scim-user-name"
+	scimExternalIDAttribute = "scim-external-id"
+	// scimGroupAttribute marks organizations that are provisioned through SCIM.
+	scimGroupAttribute = "scim-group"
+)
+
+var (
+	errSCIMInvalidFilter  = errors.DefineInvalidArgument("scim_invalid_filter", "invalid or unsupported filter `{filter}`")
+	errSCIMInvalidPath    = errors.DefineInvalidArgument("scim_invalid_path", "invalid or unsupported path `{path}`")
+	errSCIMInvalidPatchOp = errors.DefineInvalidArgument("scim_invalid_patch_op", "invalid patch operation `{op}`")
+	errSCIMInvalidValue   = errors.DefineInvalidArgument("scim_invalid_value", "invalid value for `{path}`")
+	errSCIMNoTarget       = errors.DefineInvalidArgument("scim_no_target", "no path in `{op}` operation")
+)
+
+// scimBool is a boolean that also accepts the "True" and "False" strings that some identity providers send.
+type scimBool bool
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *scimBool) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		switch strings.ToLower(s) {
+		case "true":
+			*b = true
+		case "false":
+			*b = false
+		default:
+			return errSCIMInvalidValue.WithAttributes("path", s)
+		}
+		return nil
+	}
+	var v bool
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*b = scimBool(v)
+	return nil
+}
+
+type scimMeta struct {
+	ResourceType string     `json:"resourceType,omitempty"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Location     string     `json:"location,omitempty"`
+}
+
+type scimName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type scimMultiValue struct {
+	Value   string   `json:"value"`
+	Display string   `json:"display,omitempty"`
+	Type    string   `json:"type,omitempty"`
+	Primary scimBool `json:"primary,omitempty"`
+	Ref     string   `json:"$ref,omitempty"`
+}
+
+type scimUser struct {
+	Schemas     []string         `json:"schemas"`
+	ID          string           `json:"id,omitempty"`
+	ExternalID  string           `json:"externalId,omitempty"`
+	UserName    string           `json:"userName"`
+	Name        *scimName        `json:"name,omitempty"`
+	DisplayName string           `json:"displayName,omitempty"`
+	Emails      []scimMultiValue `json:"emails,omitempty"`
+	Active      *scimBool        `json:"active,omitempty"`
+	Password    string           `json:"password,omitempty"`
+	Meta        *scimMeta        `json:"meta,omitempty"`
+}
+
+// primaryEmail returns the primary email address of the user, the first work email address or the first email
+// address. It falls back to the user name if that is an email address.
+func (u *scimUser) primaryEmail() string {
+	for _, email := range u.Emails {
+		if email.Primary {
+			return email.Value
+		}
+	}
+	for _, email := range u.Emails {
+		if strings.EqualFold(email.Type, "work") {
+			return email.Value
+		}
+	}
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	if strings.Contains(u.UserName, "@") {
+		return u.UserName
+	}
+	return ""
+}
+
+// fullName returns the formatted name of the user, or combines the given and family names.
+func (u *scimUser) fullName() string {
+	if u.Name != nil {
+		if u.Name.Formatted != "" {
+			return u.Name.Formatted
+		}
+		if name := strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName); name != "" {
+			return name
+		}
+	}
+	return u.DisplayName
+}
+
+type scimGroup struct {
+	Schemas     []string         `json:"schemas"`
+	ID          string           `json:"id,omitempty"`
+	ExternalID  string           `json:"externalId,omitempty"`
+	DisplayName string           `json:"displayName"`
+	Members     []scimMultiValue `json:"members,omitempty"`
+	Meta        *scimMeta        `json:"meta,omitempty"`
+}
+
+type scimListResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults uint64      `json:"totalResults"`
+	StartIndex   uint64      `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+type scimError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	SCIMType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// scimID derives an identifier from a SCIM user name or group display name. Email addresses are reduced to their
+// local part, everything that is not a letter or a digit is replaced with a dash and the result is truncated to
+// the maximum length of identifiers.
+func scimID(s string) string {
+	s = strings.ToLower(s)
+	if i := strings.IndexByte(s, '@'); i > 0 {
+		s = s[:i]
+	}
+	var b strings.Builder
+	dash := false
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+			dash = false
+		case b.Len() > 0 && !dash:
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	id := b.String()
+	if len(id) > 36 {
+		id = id[:36]
+	}
+	return strings.TrimRight(id, "-")
+}
+
+// scimPassword generates a random password that satisfies the password requirements. Users that are provisioned
+// without a password log in through the identity provider or reset their password.
+func scimPassword(minLength, minUppercase, minDigits, minSpecial int) string {
+	const (
+		lowercase = "abcdefghijklmnopqrstuvwxyz"
+		uppercase = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+		digits    = "0123456789"
+		special   = "!#$%&()*+,-./:;<=>?@[]^_{|}~"
+	)
+	var b strings.Builder
+	pick := func(chars string, n int) {
+		for i := 0; i < n; i++ {
+			b.WriteByte(chars[random.Intn(len(chars))])
+		}
+	}
+	pick(uppercase, minUppercase)
+	pick(digits, minDigits)
+	pick(special, minSpecial)
+	n := 32
+	if minLength > n {
+		n = minLength
+	}
+	pick(lowercase+uppercase+digits, n-b.Len())
+	return b.String()
+}
+
+var scimFilterRegexp = regexp.MustCompile(`^\s*([A-Za-z][\w.$-]*)\s+(?i:eq)\s+"([^"]*)"\s*$`)
+
+// parseSCIMFilter parses the equality filters that identity providers use to look up resources.
+func parseSCIMFilter(filter string) (attr, value string, err error) {
+	matches := scimFilterRegexp.FindStringSubmatch(filter)
+	if matches == nil {
+		return "", "", errSCIMInvalidFilter.WithAttributes("filter", filter)
+	}
+	return matches[1], matches[2], nil
+}
+
+type scimPath struct {
+	attr        string
+	filterAttr  string
+	filterValue string
+	sub         string
+}
+
+var scimPathRegexp = regexp.MustCompile(`^([A-Za-z][\w$-]*)(?:\[\s*([A-Za-z][\w$-]*)\s+(?i:eq)\s+(?:"([^"]*)"|([^\s\]]+))\s*\])?(?:\.([A-Za-z][\w$-]*))?$`)
+
+func parseSCIMPath(path string) (scimPath, error) {
+	p := path
+	if strings.HasPrefix(strings.ToLower(p), "urn:") {
+		end := strings.IndexByte(p, '[')
+		if end < 0 {
+			end = len(p)
+		}
+		p = p[strings.LastIndexByte(p[:end], ':')+1:]
+	}
+	matches := scimPathRegexp.FindStringSubmatch(p)
+	if matches == nil {
+		return scimPath{}, errSCIMInvalidPath.WithAttributes("path", path)
+	}
+	res := scimPath{
+		attr:        matches[1],
+		filterAttr:  matches[2],
+		filterValue: matches[3] + matches[4],
+		sub:         matches[5],
+	}
+	return res, nil
+}
+
+func (p scimPath) matches(v interface{}) bool {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	return strings.EqualFold(fmt.Sprint(m[scimKey(m, p.filterAttr)]), p.filterValue)
+}
+
+// scimKey returns the key in m that matches the attribute name, as SCIM attribute names are case insensitive.
+func scimKey(m map[string]interface{}, attr string) string {
+	if _, ok := m[attr]; ok {
+		return attr
+	}
+	for k := range m {
+		if strings.EqualFold(k, attr) {
+			return k
+		}
+	}
+	return attr
+}
+
+// scimValueOf returns the "value" sub-attribute of a multi-valued attribute element.
+func scimValueOf(v interface{}) (string, bool) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	value, ok := m[scimKey(m, "value")].(string)
+	return value, ok
+}
+
+type scimPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []scimPatchOperation `json:"Operations"`
+}
+
+type scimPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// apply applies the operation to the JSON representation of a resource.
+func (op scimPatchOperation) apply(resource map[string]interface{}) error {
+	var value interface{}
+	if len(op.Value) > 0 {
+		if err := json.Unmarshal(op.Value, &value); err != nil {
+			return errSCIMInvalidValue.WithCause(err).WithAttributes("path", op.Path)
+		}
+	}
+	switch strings.ToLower(op.Op) {
+	case "add", "replace":
+		add := strings.EqualFold(op.Op, "add")
+		if op.Path == "" {
+			values, ok := value.(map[string]interface{})
+			if !ok {
+				return errSCIMInvalidValue.WithAttributes("path", op.Path)
+			}
+			for attr, value := range values {
+				p, err := parseSCIMPath(attr)
+				if err != nil {
+					return err
+				}
+				p.set(resource, value, add)
+			}
+			return nil
+		}
+		p, err := parseSCIMPath(op.Path)
+		if err != nil {
+			return err
+		}
+		p.set(resource, value, add)
+		return nil
+	case "remove":
+		if op.Path == "" {
+			return errSCIMNoTarget.WithAttributes("op", op.Op)
+		}
+		p, err := parseSCIMPath(op.Path)
+		if err != nil {
+			return err
+		}
+		p.remove(resource, value)
+		return nil
+	default:
+		return errSCIMInvalidPatchOp.WithAttributes("op", op.Op)
+	}
+}
+
+func scimMerge(existing, value interface{}) interface{} {
+	existingMap, ok := existing.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	valueMap, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	for k, v := range valueMap {
+		existingMap[scimKey(existingMap, k)] = v
+	}
+	return existingMap
+}
+
+func (p scimPath) set(resource map[string]interface{}, value interface{}, add bool) {
+	key := scimKey(resource, p.attr)
+	if p.filterAttr != "" {
+		elements, _ := resource[key].([]interface{})
+		var found bool
+		for i, element := range elements {
+			if !p.matches(element) {
+				continue
+			}
+			found = true
+			if p.sub != "" {
+				m := element.(map[string]interface{})
+				m[scimKey(m, p.sub)] = value
+			} else {
+				elements[i] = scimMerge(element, value)
+			}
+		}
+		if !found {
+			element := map[string]interface{}{p.filterAttr: p.filterValue}
+			if p.sub != "" {
+				element[p.sub] = value
+			} else {
+				element = scimMerge(element, value).(map[string]interface{})
+			}
+			resource[key] = append(elements, element)
+		}
+		return
+	}
+	if p.sub != "" {
+		m, ok := resource[key].(map[string]interface{})
+		if !ok {
+			m = make(map[string]interface{})
+			resource[key] = m
+		}
+		m[scimKey(m, p.sub)] = value
+		return
+	}
+	existing, isArray := resource[key].([]interface{})
+	if add && isArray {
+		values, ok := value.([]interface{})
+		if !ok {
+			values = []interface{}{value}
+		}
+	nextValue:
+		for _, value := range values {
+			if v, ok := scimValueOf(value); ok {
+				for _, element := range existing {
+					if e, ok := scimValueOf(element); ok && e == v {
+						continue nextValue
+					}
+				}
+			}
+			existing = append(existing, value)
+		}
+		resource[key] = existing
+		return
+	}
+	resource[key] = scimMerge(resource[key], value)
+}
+
+func (p scimPath) remove(resource map[string]interface{}, value interface{}) {
+	key := scimKey(resource, p.attr)
+	if p.filterAttr != "" {
+		elements, _ := resource[key].([]interface{})
+		remaining := elements[:0]
+		for _, element := range elements {
+			if p.matches(element) {
+				if p.sub == "" {
+					continue
+				}
+				m := element.(map[string]interface{})
+				delete(m, scimKey(m, p.sub))
+			}
+			remaining = append(remaining, element)
+		}
+		resource[key] = remaining
+		return
+	}
+	if p.sub != "" {
+		if m, ok := resource[key].(map[string]interface{}); ok {
+			delete(m, scimKey(m, p.sub))
+		}
+		return
+	}
+	elements, isArray := resource[key].([]interface{})
+	values, hasValues := value.([]interface{})
+	if !isArray || !hasValues {
+		delete(resource, key)
+		return
+	}
+	remove := make(map[string]bool, len(values))
+	for _, value := range values {
+		if v, ok := scimValueOf(value); ok {
+			remove[v] = true
+		}
+	}
+	remaining := elements[:0]
+	for _, element := range elements {
+		if v, ok := scimValueOf(element); ok && remove[v] {
+			continue
+		}
+		remaining = append(remaining, element)
+	}
+	resource[key] = remaining
+}
+
+// applySCIMPatch applies the operations to the resource by way of its JSON representation.
+func applySCIMPatch(resource interface{}, operations []scimPatchOperation) error {
+	b, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	for _, op := range operations {
+		if err := op.apply(m); err != nil {
+			return err
+		}
+	}
+	if b, err = json.Marshal(m); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, resource); err != nil {
+		return errSCIMInvalidValue.WithCause(err).WithAttributes("path", "")
+	}
+	return nil
+}
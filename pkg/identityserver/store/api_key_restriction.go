@@ -0,0 +1,67 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"strings"
+
+	"github.com/lib/pq"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// APIKeyRestriction restricts the requests that an API key can be used for.
+type APIKeyRestriction struct {
+	Model
+
+	APIKey   *APIKey
+	APIKeyID string `gorm:"type:UUID;unique_index:api_key_restriction_api_key_index;not null"`
+
+	EndDeviceIDs pq.StringArray `gorm:"type:VARCHAR ARRAY;column:end_device_ids"`
+	// EndDeviceAttributes are the attributes that select end devices, formatted as key=value.
+	EndDeviceAttributes pq.StringArray `gorm:"type:VARCHAR ARRAY;column:end_device_attributes"`
+	SourceCIDRs         pq.StringArray `gorm:"type:VARCHAR ARRAY;column:source_cidrs"`
+	Methods             pq.StringArray `gorm:"type:VARCHAR ARRAY;column:methods"`
+}
+
+func init() {
+	registerModel(&APIKeyRestriction{})
+}
+
+func (r *APIKeyRestriction) fromPB(pb *ttnpb.APIKeyRestrictions) {
+	r.EndDeviceIDs = pb.GetEndDeviceIds()
+	r.EndDeviceAttributes = make(pq.StringArray, 0, len(pb.GetEndDeviceAttributes()))
+	for key, value := range pb.GetEndDeviceAttributes() {
+		r.EndDeviceAttributes = append(r.EndDeviceAttributes, key+"="+value)
+	}
+	r.SourceCIDRs = pb.GetSourceCidrs()
+	r.Methods = pb.GetMethods()
+}
+
+func (r APIKeyRestriction) toPB() *ttnpb.APIKeyRestrictions {
+	pb := &ttnpb.APIKeyRestrictions{
+		EndDeviceIds: r.EndDeviceIDs,
+		SourceCidrs:  r.SourceCIDRs,
+		Methods:      r.Methods,
+	}
+	if len(r.EndDeviceAttributes) > 0 {
+		pb.EndDeviceAttributes = make(map[string]string, len(r.EndDeviceAttributes))
+		for _, attribute := range r.EndDeviceAttributes {
+			if i := strings.IndexByte(attribute, '='); i >= 0 {
+				pb.EndDeviceAttributes[attribute[:i]] = attribute[i+1:]
+			}
+		}
+	}
+	return pb
+}
@@ -50,6 +50,8 @@ func selectAPIKeyFields(ctx context.Context, query *gorm.DB, fieldMask *pbtypes.
 			apiKeyColumns = append(apiKeyColumns, "rights")
 		case "name":
 			apiKeyColumns = append(apiKeyColumns, "name")
+		case "restrictions":
+			// stored in api_key_restrictions
 		default:
 			notFoundPaths = append(notFoundPaths, path)
 		}
@@ -60,6 +62,45 @@ func selectAPIKeyFields(ctx context.Context, query *gorm.DB, fieldMask *pbtypes.
 	return query.Select(cleanFields(append(append(modelColumns, "updated_at"), apiKeyColumns...)...))
 }
 
+// findRestrictions returns the restrictions of the given API keys by their primary key.
+func (s *apiKeyStore) findRestrictions(ctx context.Context, keyModels ...APIKey) (map[string]*ttnpb.APIKeyRestrictions, error) {
+	if len(keyModels) == 0 {
+		return nil, nil
+	}
+	keyIDs := make([]string, len(keyModels))
+	for i, keyModel := range keyModels {
+		keyIDs[i] = keyModel.PrimaryKey()
+	}
+	var restrictionModels []APIKeyRestriction
+	if err := s.query(ctx, APIKeyRestriction{}).Where("api_key_id IN (?)", keyIDs).Find(&restrictionModels).Error; err != nil {
+		return nil, err
+	}
+	restrictions := make(map[string]*ttnpb.APIKeyRestrictions, len(restrictionModels))
+	for _, restrictionModel := range restrictionModels {
+		restrictions[restrictionModel.APIKeyID] = restrictionModel.toPB()
+	}
+	return restrictions, nil
+}
+
+// setRestrictions sets the restrictions of the API key. Empty restrictions remove the restrictions.
+func (s *apiKeyStore) setRestrictions(ctx context.Context, keyModel *APIKey, restrictions *ttnpb.APIKeyRestrictions) error {
+	query := s.query(ctx, APIKeyRestriction{}).Where(APIKeyRestriction{APIKeyID: keyModel.PrimaryKey()})
+	if restrictions.IsZero() {
+		return query.Delete(&APIKeyRestriction{}).Error
+	}
+	var restrictionModel APIKeyRestriction
+	if err := query.First(&restrictionModel).Error; err != nil {
+		if !gorm.IsRecordNotFoundError(err) {
+			return err
+		}
+		restrictionModel.APIKeyID = keyModel.PrimaryKey()
+		restrictionModel.fromPB(restrictions)
+		return convertError(s.createEntity(ctx, &restrictionModel))
+	}
+	restrictionModel.fromPB(restrictions)
+	return s.updateEntity(ctx, &restrictionModel, "end_device_ids", "end_device_attributes", "source_cidrs", "methods")
+}
+
 func (s *apiKeyStore) CreateAPIKey(ctx context.Context, entityID *ttnpb.EntityIdentifiers, key *ttnpb.APIKey) (*ttnpb.APIKey, error) {
 	defer trace.StartRegion(ctx, "create api key").End()
 	entity, err := s.findEntity(ctx, entityID, "id")
@@ -78,7 +119,14 @@ func (s *apiKeyStore) CreateAPIKey(ctx context.Context, entityID *ttnpb.EntityId
 	if err = s.createEntity(ctx, model); err != nil {
 		return nil, err
 	}
-	return model.toPB(), nil
+	pb := model.toPB()
+	if !key.Restrictions.IsZero() {
+		if err = s.setRestrictions(ctx, model, key.Restrictions); err != nil {
+			return nil, err
+		}
+		pb.Restrictions = key.Restrictions
+	}
+	return pb, nil
 }
 
 func (s *apiKeyStore) FindAPIKeys(ctx context.Context, entityID *ttnpb.EntityIdentifiers) ([]*ttnpb.APIKey, error) {
@@ -101,9 +149,14 @@ func (s *apiKeyStore) FindAPIKeys(ctx context.Context, entityID *ttnpb.EntityIde
 		return nil, err
 	}
 	setTotal(ctx, uint64(len(keyModels)))
+	restrictions, err := s.findRestrictions(ctx, keyModels...)
+	if err != nil {
+		return nil, err
+	}
 	keyProtos := make([]*ttnpb.APIKey, len(keyModels))
 	for i, apiKey := range keyModels {
 		keyProtos[i] = apiKey.toPB()
+		keyProtos[i].Restrictions = restrictions[apiKey.PrimaryKey()]
 	}
 	return keyProtos, nil
 }
@@ -132,7 +185,13 @@ func (s *apiKeyStore) GetAPIKey(ctx context.Context, id string) (*ttnpb.EntityId
 	if !ok {
 		return nil, nil, errAPIKeyEntity.New()
 	}
-	return ids, keyModel.toPB(), nil
+	restrictions, err := s.findRestrictions(ctx, keyModel)
+	if err != nil {
+		return nil, nil, err
+	}
+	pb := keyModel.toPB()
+	pb.Restrictions = restrictions[keyModel.PrimaryKey()]
+	return ids, pb, nil
 }
 
 func (s *apiKeyStore) UpdateAPIKey(ctx context.Context, entityID *ttnpb.EntityIdentifiers, key *ttnpb.APIKey, fieldMask *pbtypes.FieldMask) (*ttnpb.APIKey, error) {
@@ -160,6 +219,10 @@ func (s *apiKeyStore) UpdateAPIKey(ctx context.Context, entityID *ttnpb.EntityId
 	}
 	// If empty rights are passed and rights are in the fieldmask, delete the key.
 	if len(key.Rights) == 0 && ttnpb.HasAnyField(fieldMask.GetPaths(), "rights") {
+		err = s.query(ctx, APIKeyRestriction{}).Where(APIKeyRestriction{APIKeyID: keyModel.PrimaryKey()}).Delete(&APIKeyRestriction{}).Error
+		if err != nil {
+			return nil, err
+		}
 		return nil, query.Delete(&keyModel).Error
 	}
 	query = selectAPIKeyFields(ctx, query, fieldMask)
@@ -175,7 +238,30 @@ func (s *apiKeyStore) UpdateAPIKey(ctx context.Context, entityID *ttnpb.EntityId
 	if err = query.Save(&keyModel).Error; err != nil {
 		return nil, err
 	}
-	return keyModel.toPB(), nil
+	restrictions, err := s.findRestrictions(ctx, keyModel)
+	if err != nil {
+		return nil, err
+	}
+	pb := keyModel.toPB()
+	pb.Restrictions = restrictions[keyModel.PrimaryKey()]
+	var restrictionsPaths []string
+	for _, path := range fieldMask.GetPaths() {
+		if path == "restrictions" || strings.HasPrefix(path, "restrictions.") {
+			restrictionsPaths = append(restrictionsPaths, path)
+		}
+	}
+	if len(restrictionsPaths) > 0 {
+		if err = pb.SetFields(key, restrictionsPaths...); err != nil {
+			return nil, err
+		}
+		if err = s.setRestrictions(ctx, &keyModel, pb.Restrictions); err != nil {
+			return nil, err
+		}
+		if pb.Restrictions.IsZero() {
+			pb.Restrictions = nil
+		}
+	}
+	return pb, nil
 }
 
 func (s *apiKeyStore) DeleteEntityAPIKeys(ctx context.Context, entityID *ttnpb.EntityIdentifiers) error {
@@ -184,8 +270,13 @@ func (s *apiKeyStore) DeleteEntityAPIKeys(ctx context.Context, entityID *ttnpb.E
 	if err != nil {
 		return err
 	}
-	return s.query(ctx, APIKey{}).Where(&APIKey{
+	keys := s.query(ctx, APIKey{}).Where(&APIKey{
 		EntityID:   entity.PrimaryKey(),
 		EntityType: entityTypeForID(entityID),
-	}).Delete(&APIKey{}).Error
+	})
+	err = s.query(ctx, APIKeyRestriction{}).Where("api_key_id IN (?)", keys.Select("id").QueryExpr()).Delete(&APIKeyRestriction{}).Error
+	if err != nil {
+		return err
+	}
+	return keys.Delete(&APIKey{}).Error
 }
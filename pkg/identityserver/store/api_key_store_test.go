@@ -34,7 +34,7 @@ func TestAPIKeyStore(t *testing.T) {
 
 	WithDB(t, func(t *testing.T, db *gorm.DB) {
 		prepareTest(db,
-			&APIKey{},
+			&APIKey{}, &APIKeyRestriction{},
 			&Account{}, &User{}, &Organization{},
 			&Application{}, &Client{}, &Gateway{},
 		)
@@ -158,6 +158,61 @@ func TestAPIKeyStore(t *testing.T) {
 			})
 		}
 
+		t.Run("Restrictions", func(t *testing.T) {
+			a := assertions.New(t)
+
+			restrictions := &ttnpb.APIKeyRestrictions{
+				EndDeviceIds:        []string{"dev-1"},
+				EndDeviceAttributes: map[string]string{"installer": "alice"},
+				SourceCidrs:         []string{"10.0.0.0/8"},
+			}
+			created, err := store.CreateAPIKey(ctx, appIDs.GetEntityIdentifiers(), &ttnpb.APIKey{
+				Id:           "RESTRICTEDKEYID",
+				Key:          "RESTRICTEDKEY",
+				Rights:       []ttnpb.Right{ttnpb.RIGHT_APPLICATION_DEVICES_READ},
+				Restrictions: restrictions,
+			})
+			if a.So(err, should.BeNil) && a.So(created, should.NotBeNil) {
+				a.So(created.Restrictions, should.Resemble, restrictions)
+			}
+
+			_, got, err := store.GetAPIKey(ctx, "RESTRICTEDKEYID")
+			if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+				a.So(got.Restrictions, should.Resemble, restrictions)
+			}
+
+			keys, err := store.FindAPIKeys(ctx, appIDs.GetEntityIdentifiers())
+			if a.So(err, should.BeNil) && a.So(keys, should.HaveLength, 1) {
+				a.So(keys[0].Restrictions, should.Resemble, restrictions)
+			}
+
+			updated, err := store.UpdateAPIKey(ctx, appIDs.GetEntityIdentifiers(), &ttnpb.APIKey{
+				Id: "RESTRICTEDKEYID",
+				Restrictions: &ttnpb.APIKeyRestrictions{
+					Methods: []string{"/ttn.lorawan.v3.EndDeviceRegistry/*"},
+				},
+			}, &pbtypes.FieldMask{Paths: []string{"restrictions.methods", "restrictions.source_cidrs"}})
+			if a.So(err, should.BeNil) && a.So(updated, should.NotBeNil) {
+				a.So(updated.Restrictions, should.Resemble, &ttnpb.APIKeyRestrictions{
+					EndDeviceIds:        []string{"dev-1"},
+					EndDeviceAttributes: map[string]string{"installer": "alice"},
+					Methods:             []string{"/ttn.lorawan.v3.EndDeviceRegistry/*"},
+				})
+			}
+
+			updated, err = store.UpdateAPIKey(ctx, appIDs.GetEntityIdentifiers(), &ttnpb.APIKey{
+				Id: "RESTRICTEDKEYID",
+			}, &pbtypes.FieldMask{Paths: []string{"restrictions"}})
+			if a.So(err, should.BeNil) && a.So(updated, should.NotBeNil) {
+				a.So(updated.Restrictions, should.BeNil)
+			}
+
+			_, got, err = store.GetAPIKey(ctx, "RESTRICTEDKEYID")
+			if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+				a.So(got.Restrictions, should.BeNil)
+			}
+		})
+
 		t.Run("Delete entity API keys", func(t *testing.T) {
 			a := assertions.New(t)
 
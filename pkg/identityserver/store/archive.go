@@ -0,0 +1,608 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"runtime/trace"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// ArchiveVersion is the version of the archives written by Export.
+const ArchiveVersion = 1
+
+// archivePageSize is the number of entities that are read from the database at once.
+const archivePageSize = 1000
+
+var (
+	errArchiveVersion    = errors.DefineInvalidArgument("archive_version", "unsupported archive version `{version}`")
+	errArchiveRecordType = errors.DefineInvalidArgument("archive_record_type", "unknown archive record type `{type}`")
+)
+
+// Archive record types.
+const (
+	archiveUser              = "user"
+	archiveOrganization      = "organization"
+	archiveApplication       = "application"
+	archiveGateway           = "gateway"
+	archiveClient            = "client"
+	archiveEndDevice         = "end_device"
+	archiveMembership        = "membership"
+	archiveAPIKey            = "api_key"
+	archiveContactInfo       = "contact_info"
+	archiveUserMFA           = "user_mfa"
+	archiveFederatedIdentity = "federated_identity"
+	archiveEmailPreferences  = "notification_email_preferences"
+)
+
+type archiveHeader struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	Database  string    `json:"database,omitempty"`
+}
+
+// archiveRecord is a record in the archive. The Data contains the JSON encoding of the entity, the rights of the
+// membership, the API key, the list of contact info, the multi-factor authentication, the federated identity or the
+// notification email preferences of a user, depending on the Type.
+type archiveRecord struct {
+	Type      string          `json:"type"`
+	EntityIDs json.RawMessage `json:"entity_ids,omitempty"`
+	MemberIDs json.RawMessage `json:"member_ids,omitempty"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// archiveUserMFAData is the multi-factor authentication configuration of a user in the archive. Encrypted TOTP secrets
+// are exported encrypted, so the key with the TOTPSecretKeyID must be available where the archive is imported.
+type archiveUserMFAData struct {
+	TOTPSecret      string     `json:"totp_secret"`
+	TOTPSecretKeyID string     `json:"totp_secret_key_id,omitempty"`
+	TOTPLastStep    int64      `json:"totp_last_step,omitempty"`
+	RecoveryCodes   []string   `json:"recovery_codes,omitempty"`
+	EnabledAt       *time.Time `json:"enabled_at,omitempty"`
+}
+
+// archiveFederatedIdentityData links a user to an identity at an upstream OpenID Connect provider in the archive.
+type archiveFederatedIdentityData struct {
+	ProviderID string `json:"provider_id"`
+	Subject    string `json:"subject"`
+}
+
+// archiveEmailPreferencesData are the types of notifications that a user does not receive by email.
+type archiveEmailPreferencesData struct {
+	DisabledTypes []string `json:"disabled_types"`
+}
+
+// ArchiveCounts are the numbers of records by type in an archive.
+type ArchiveCounts map[string]int
+
+type archiveWriter struct {
+	enc    *json.Encoder
+	counts ArchiveCounts
+}
+
+func marshalArchivePB(pb proto.Message) (json.RawMessage, error) {
+	return jsonpb.TTN().Marshal(pb)
+}
+
+func (w *archiveWriter) write(record *archiveRecord) error {
+	w.counts[record.Type]++
+	return w.enc.Encode(record)
+}
+
+func (w *archiveWriter) writeEntity(recordType string, pb proto.Message) error {
+	data, err := marshalArchivePB(pb)
+	if err != nil {
+		return err
+	}
+	return w.write(&archiveRecord{Type: recordType, Data: data})
+}
+
+// exportPages calls f with the context for each page of entities, until f returns less than a full page.
+func exportPages(ctx context.Context, f func(ctx context.Context) (int, error)) error {
+	for page := uint32(1); ; page++ {
+		n, err := f(WithPagination(ctx, archivePageSize, page, nil))
+		if err != nil {
+			return err
+		}
+		if n < archivePageSize {
+			return nil
+		}
+	}
+}
+
+func archiveAccountKey(ids *ttnpb.OrganizationOrUserIdentifiers) string {
+	return ids.EntityType() + ":" + ids.IDString()
+}
+
+type archiveEntity interface {
+	GetEntityIdentifiers() *ttnpb.EntityIdentifiers
+}
+
+// Export writes the users, organizations, applications, gateways, OAuth clients and end devices in the database,
+// with their memberships, API keys and contact info, to a gzip-compressed archive. The multi-factor authentication,
+// the federated identities and the notification email preferences of users are exported too. Deleted entities are not exported.
+// API keys, client secrets and recovery codes are exported hashed, as they are stored.
+func Export(ctx context.Context, db *gorm.DB, w io.Writer) (ArchiveCounts, error) {
+	defer trace.StartRegion(ctx, "export archive").End()
+	gz := gzip.NewWriter(w)
+	aw := &archiveWriter{enc: json.NewEncoder(gz), counts: make(ArchiveCounts)}
+	if err := aw.enc.Encode(&archiveHeader{
+		Version:   ArchiveVersion,
+		CreatedAt: time.Now().UTC(),
+		Database:  DatabaseKind(db),
+	}); err != nil {
+		return nil, err
+	}
+
+	var (
+		accounts = make(map[string]bool)
+		entities []archiveEntity
+		apps     []*ttnpb.ApplicationIdentifiers
+	)
+	err := exportPages(ctx, func(ctx context.Context) (int, error) {
+		usrs, err := GetUserStore(db).FindUsers(ctx, nil, nil)
+		if err != nil {
+			return 0, err
+		}
+		for _, usr := range usrs {
+			accounts[archiveAccountKey(usr.GetIds().GetOrganizationOrUserIdentifiers())] = true
+			entities = append(entities, usr.GetIds())
+			if err := aw.writeEntity(archiveUser, usr); err != nil {
+				return 0, err
+			}
+		}
+		return len(usrs), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = exportPages(ctx, func(ctx context.Context) (int, error) {
+		orgs, err := GetOrganizationStore(db).FindOrganizations(ctx, nil, nil)
+		if err != nil {
+			return 0, err
+		}
+		for _, org := range orgs {
+			accounts[archiveAccountKey(org.GetIds().GetOrganizationOrUserIdentifiers())] = true
+			entities = append(entities, org.GetIds())
+			if err := aw.writeEntity(archiveOrganization, org); err != nil {
+				return 0, err
+			}
+		}
+		return len(orgs), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = exportPages(ctx, func(ctx context.Context) (int, error) {
+		list, err := GetApplicationStore(db).FindApplications(ctx, nil, nil)
+		if err != nil {
+			return 0, err
+		}
+		for _, app := range list {
+			entities = append(entities, app.GetIds())
+			apps = append(apps, app.GetIds())
+			if err := aw.writeEntity(archiveApplication, app); err != nil {
+				return 0, err
+			}
+		}
+		return len(list), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = exportPages(ctx, func(ctx context.Context) (int, error) {
+		gtws, err := GetGatewayStore(db).FindGateways(ctx, nil, nil)
+		if err != nil {
+			return 0, err
+		}
+		for _, gtw := range gtws {
+			entities = append(entities, gtw.GetIds())
+			if err := aw.writeEntity(archiveGateway, gtw); err != nil {
+				return 0, err
+			}
+		}
+		return len(gtws), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = exportPages(ctx, func(ctx context.Context) (int, error) {
+		clis, err := GetClientStore(db).FindClients(ctx, nil, nil)
+		if err != nil {
+			return 0, err
+		}
+		for _, cli := range clis {
+			entities = append(entities, cli.GetIds())
+			if err := aw.writeEntity(archiveClient, cli); err != nil {
+				return 0, err
+			}
+		}
+		return len(clis), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, appIDs := range apps {
+		err = exportPages(ctx, func(ctx context.Context) (int, error) {
+			devs, err := GetEndDeviceStore(db).ListEndDevices(ctx, appIDs, nil)
+			if err != nil {
+				return 0, err
+			}
+			for _, dev := range devs {
+				if err := aw.writeEntity(archiveEndDevice, dev); err != nil {
+					return 0, err
+				}
+			}
+			return len(devs), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, entity := range entities {
+		entityIDs := entity.GetEntityIdentifiers()
+		entityIDsData, err := marshalArchivePB(entityIDs)
+		if err != nil {
+			return nil, err
+		}
+		if entityIDs.GetUserIds() == nil {
+			members, err := GetMembershipStore(db).FindMembers(ctx, entityIDs)
+			if err != nil {
+				return nil, err
+			}
+			for member, memberRights := range members {
+				if !accounts[archiveAccountKey(member)] {
+					continue
+				}
+				memberIDsData, err := marshalArchivePB(member)
+				if err != nil {
+					return nil, err
+				}
+				data, err := marshalArchivePB(memberRights)
+				if err != nil {
+					return nil, err
+				}
+				if err := aw.write(&archiveRecord{
+					Type:      archiveMembership,
+					EntityIDs: entityIDsData,
+					MemberIDs: memberIDsData,
+					Data:      data,
+				}); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if entityIDs.GetClientIds() == nil {
+			keys, err := GetAPIKeyStore(db).FindAPIKeys(ctx, entityIDs)
+			if err != nil {
+				return nil, err
+			}
+			for _, key := range keys {
+				data, err := marshalArchivePB(key)
+				if err != nil {
+					return nil, err
+				}
+				if err := aw.write(&archiveRecord{
+					Type:      archiveAPIKey,
+					EntityIDs: entityIDsData,
+					Data:      data,
+				}); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if userIDs := entityIDs.GetUserIds(); userIDs != nil {
+			if err := exportUserAuthentication(ctx, db, aw, userIDs, entityIDsData); err != nil {
+				return nil, err
+			}
+			disabledTypes, err := GetNotificationStore(db).GetDisabledEmailNotifications(ctx, userIDs)
+			if err != nil {
+				return nil, err
+			}
+			if len(disabledTypes) > 0 {
+				data, err := json.Marshal(&archiveEmailPreferencesData{DisabledTypes: disabledTypes})
+				if err != nil {
+					return nil, err
+				}
+				if err := aw.write(&archiveRecord{
+					Type:      archiveEmailPreferences,
+					EntityIDs: entityIDsData,
+					Data:      data,
+				}); err != nil {
+					return nil, err
+				}
+			}
+		}
+		contactInfo, err := GetContactInfoStore(db).GetContactInfo(ctx, entityIDs)
+		if err != nil {
+			return nil, err
+		}
+		if len(contactInfo) > 0 {
+			items := make([]json.RawMessage, len(contactInfo))
+			for i, info := range contactInfo {
+				if items[i], err = marshalArchivePB(info); err != nil {
+					return nil, err
+				}
+			}
+			data, err := json.Marshal(items)
+			if err != nil {
+				return nil, err
+			}
+			if err := aw.write(&archiveRecord{
+				Type:      archiveContactInfo,
+				EntityIDs: entityIDsData,
+				Data:      data,
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return aw.counts, nil
+}
+
+// exportUserAuthentication writes the multi-factor authentication and the federated identities of the user.
+func exportUserAuthentication(
+	ctx context.Context, db *gorm.DB, aw *archiveWriter, userIDs *ttnpb.UserIdentifiers, entityIDsData json.RawMessage,
+) error {
+	mfa, err := GetUserMFAStore(db).GetUserMFA(ctx, userIDs)
+	switch {
+	case errors.IsNotFound(err):
+	case err != nil:
+		return err
+	default:
+		data, err := json.Marshal(&archiveUserMFAData{
+			TOTPSecret:      mfa.TOTPSecret,
+			TOTPSecretKeyID: mfa.TOTPSecretKeyID,
+			TOTPLastStep:    mfa.TOTPLastStep,
+			RecoveryCodes:   mfa.RecoveryCodes,
+			EnabledAt:       mfa.EnabledAt,
+		})
+		if err != nil {
+			return err
+		}
+		if err := aw.write(&archiveRecord{
+			Type:      archiveUserMFA,
+			EntityIDs: entityIDsData,
+			Data:      data,
+		}); err != nil {
+			return err
+		}
+	}
+	identities, err := GetFederatedIdentityStore(db).FindUserFederatedIdentities(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	for _, identity := range identities {
+		data, err := json.Marshal(&archiveFederatedIdentityData{
+			ProviderID: identity.ProviderID,
+			Subject:    identity.Subject,
+		})
+		if err != nil {
+			return err
+		}
+		if err := aw.write(&archiveRecord{
+			Type:      archiveFederatedIdentity,
+			EntityIDs: entityIDsData,
+			Data:      data,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unmarshalArchivePB(data json.RawMessage, pb proto.Message) error {
+	return jsonpb.TTN().Unmarshal(data, pb)
+}
+
+// Import reads an archive written by Export and creates its contents in the database. The records are created in
+// the order of the archive, so that entities exist before their memberships, API keys and contact info. Import
+// fails if any of the entities already exists; callers should run it in a transaction. The creation and update
+// times of the entities and end devices are restored from the archive.
+func Import(ctx context.Context, db *gorm.DB, r io.Reader) (ArchiveCounts, error) {
+	defer trace.StartRegion(ctx, "import archive").End()
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	dec := json.NewDecoder(gz)
+	var header archiveHeader
+	if err := dec.Decode(&header); err != nil {
+		return nil, err
+	}
+	if header.Version != ArchiveVersion {
+		return nil, errArchiveVersion.WithAttributes("version", header.Version)
+	}
+
+	counts := make(ArchiveCounts)
+	for {
+		var record archiveRecord
+		if err := dec.Decode(&record); err != nil {
+			if err == io.EOF {
+				return counts, nil
+			}
+			return nil, err
+		}
+		if err := importRecord(ctx, db, &record); err != nil {
+			return nil, err
+		}
+		counts[record.Type]++
+	}
+}
+
+func importRecord(ctx context.Context, db *gorm.DB, record *archiveRecord) error {
+	var entityIDs *ttnpb.EntityIdentifiers
+	if len(record.EntityIDs) > 0 {
+		entityIDs = &ttnpb.EntityIdentifiers{}
+		if err := unmarshalArchivePB(record.EntityIDs, entityIDs); err != nil {
+			return err
+		}
+	}
+	switch record.Type {
+	case archiveUser:
+		var usr ttnpb.User
+		if err := unmarshalArchivePB(record.Data, &usr); err != nil {
+			return err
+		}
+		if _, err := GetUserStore(db).CreateUser(ctx, &usr); err != nil {
+			return err
+		}
+		return restoreArchiveTimestamps(ctx, db, usr.GetIds(), usr.CreatedAt, usr.UpdatedAt)
+	case archiveOrganization:
+		var org ttnpb.Organization
+		if err := unmarshalArchivePB(record.Data, &org); err != nil {
+			return err
+		}
+		if _, err := GetOrganizationStore(db).CreateOrganization(ctx, &org); err != nil {
+			return err
+		}
+		return restoreArchiveTimestamps(ctx, db, org.GetIds(), org.CreatedAt, org.UpdatedAt)
+	case archiveApplication:
+		var app ttnpb.Application
+		if err := unmarshalArchivePB(record.Data, &app); err != nil {
+			return err
+		}
+		if _, err := GetApplicationStore(db).CreateApplication(ctx, &app); err != nil {
+			return err
+		}
+		return restoreArchiveTimestamps(ctx, db, app.GetIds(), app.CreatedAt, app.UpdatedAt)
+	case archiveGateway:
+		var gtw ttnpb.Gateway
+		if err := unmarshalArchivePB(record.Data, &gtw); err != nil {
+			return err
+		}
+		if _, err := GetGatewayStore(db).CreateGateway(ctx, &gtw); err != nil {
+			return err
+		}
+		return restoreArchiveTimestamps(ctx, db, gtw.GetIds(), gtw.CreatedAt, gtw.UpdatedAt)
+	case archiveClient:
+		var cli ttnpb.Client
+		if err := unmarshalArchivePB(record.Data, &cli); err != nil {
+			return err
+		}
+		if _, err := GetClientStore(db).CreateClient(ctx, &cli); err != nil {
+			return err
+		}
+		return restoreArchiveTimestamps(ctx, db, cli.GetIds(), cli.CreatedAt, cli.UpdatedAt)
+	case archiveEndDevice:
+		var dev ttnpb.EndDevice
+		if err := unmarshalArchivePB(record.Data, &dev); err != nil {
+			return err
+		}
+		if _, err := GetEndDeviceStore(db).CreateEndDevice(ctx, &dev); err != nil {
+			return err
+		}
+		return restoreArchiveTimestamps(ctx, db, &dev.EndDeviceIdentifiers, &dev.CreatedAt, &dev.UpdatedAt)
+	case archiveMembership:
+		var memberIDs ttnpb.OrganizationOrUserIdentifiers
+		if err := unmarshalArchivePB(record.MemberIDs, &memberIDs); err != nil {
+			return err
+		}
+		var memberRights ttnpb.Rights
+		if err := unmarshalArchivePB(record.Data, &memberRights); err != nil {
+			return err
+		}
+		return GetMembershipStore(db).SetMember(ctx, &memberIDs, entityIDs, &memberRights)
+	case archiveAPIKey:
+		var key ttnpb.APIKey
+		if err := unmarshalArchivePB(record.Data, &key); err != nil {
+			return err
+		}
+		_, err := GetAPIKeyStore(db).CreateAPIKey(ctx, entityIDs, &key)
+		return err
+	case archiveContactInfo:
+		var items []json.RawMessage
+		if err := json.Unmarshal(record.Data, &items); err != nil {
+			return err
+		}
+		contactInfo := make([]*ttnpb.ContactInfo, len(items))
+		for i, item := range items {
+			contactInfo[i] = &ttnpb.ContactInfo{}
+			if err := unmarshalArchivePB(item, contactInfo[i]); err != nil {
+				return err
+			}
+		}
+		_, err := GetContactInfoStore(db).SetContactInfo(ctx, entityIDs, contactInfo)
+		return err
+	case archiveUserMFA:
+		var mfa archiveUserMFAData
+		if err := json.Unmarshal(record.Data, &mfa); err != nil {
+			return err
+		}
+		_, err := GetUserMFAStore(db).SetUserMFA(ctx, entityIDs.GetUserIds(), &UserMFA{
+			TOTPSecret:      mfa.TOTPSecret,
+			TOTPSecretKeyID: mfa.TOTPSecretKeyID,
+			TOTPLastStep:    mfa.TOTPLastStep,
+			RecoveryCodes:   mfa.RecoveryCodes,
+			EnabledAt:       mfa.EnabledAt,
+		})
+		return err
+	case archiveFederatedIdentity:
+		var identity archiveFederatedIdentityData
+		if err := json.Unmarshal(record.Data, &identity); err != nil {
+			return err
+		}
+		return GetFederatedIdentityStore(db).CreateFederatedIdentity(
+			ctx, entityIDs.GetUserIds(), identity.ProviderID, identity.Subject,
+		)
+	case archiveEmailPreferences:
+		var preferences archiveEmailPreferencesData
+		if err := json.Unmarshal(record.Data, &preferences); err != nil {
+			return err
+		}
+		return GetNotificationStore(db).SetDisabledEmailNotifications(
+			ctx, entityIDs.GetUserIds(), preferences.DisabledTypes,
+		)
+	default:
+		return errArchiveRecordType.WithAttributes("type", record.Type)
+	}
+}
+
+// restoreArchiveTimestamps sets the creation and update times of the imported entity, which are otherwise set to
+// the time of the import.
+func restoreArchiveTimestamps(
+	ctx context.Context, db *gorm.DB, id ttnpb.IDStringer, createdAt, updatedAt *time.Time,
+) error {
+	columns := make(map[string]interface{}, 2)
+	if createdAt != nil && !createdAt.IsZero() {
+		columns["created_at"] = cleanTime(*createdAt)
+	}
+	if updatedAt != nil && !updatedAt.IsZero() {
+		columns["updated_at"] = cleanTime(*updatedAt)
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+	model, err := newStore(db).findEntity(ctx, id, "id")
+	if err != nil {
+		return err
+	}
+	return db.Model(model).UpdateColumns(columns).Error
+}
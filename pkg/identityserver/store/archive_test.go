@@ -0,0 +1,162 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+)
+
+func TestArchive(t *testing.T) {
+	a, ctx := test.New(t)
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		if err := Clear(db); err != nil {
+			t.Fatal(err)
+		}
+		population := NewPopulator(4, 42)
+		if err := population.Populate(ctx, db); err != nil {
+			t.Fatal(err)
+		}
+
+		usr := population.Users[0]
+		_, err := GetContactInfoStore(db).SetContactInfo(ctx, usr.GetIds(), []*ttnpb.ContactInfo{
+			{ContactType: ttnpb.CONTACT_TYPE_TECHNICAL, ContactMethod: ttnpb.CONTACT_METHOD_EMAIL, Value: "tech@example.com"},
+		})
+		a.So(err, should.BeNil)
+
+		var apiKey *ttnpb.APIKey
+		var apiKeyEntityIDs *ttnpb.EntityIdentifiers
+		for ids, keys := range population.APIKeys {
+			if ids.GetApplicationIds() != nil {
+				apiKey, apiKeyEntityIDs = keys[0], ids
+				break
+			}
+		}
+		restrictions := &ttnpb.APIKeyRestrictions{SourceCidrs: []string{"10.0.0.0/8"}}
+		_, err = GetAPIKeyStore(db).UpdateAPIKey(ctx, apiKeyEntityIDs, &ttnpb.APIKey{
+			Id:           apiKey.Id,
+			Restrictions: restrictions,
+		}, &pbtypes.FieldMask{Paths: []string{"restrictions"}})
+		a.So(err, should.BeNil)
+
+		enabledAt := time.Now().UTC().Truncate(time.Second)
+		mfa := &UserMFA{
+			TOTPSecret:      "ZW5jcnlwdGVkLXNlY3JldA==",
+			TOTPSecretKeyID: "mfa",
+			TOTPLastStep:    42,
+			RecoveryCodes:   []string{"hashed-code-1", "hashed-code-2"},
+			EnabledAt:       &enabledAt,
+		}
+		_, err = GetUserMFAStore(db).SetUserMFA(ctx, usr.GetIds(), mfa)
+		a.So(err, should.BeNil)
+		a.So(GetFederatedIdentityStore(db).CreateFederatedIdentity(ctx, usr.GetIds(), "foo-provider", "foo-subject"), should.BeNil)
+
+		createdAt, updatedAt := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		a.So(restoreArchiveTimestamps(ctx, db, usr.GetIds(), &createdAt, &updatedAt), should.BeNil)
+
+		a.So(GetNotificationStore(db).SetDisabledEmailNotifications(ctx, usr.GetIds(), []string{"api_key_created"}), should.BeNil)
+
+		storedUsr, err := GetUserStore(db).GetUser(ctx, usr.GetIds(), nil)
+		a.So(err, should.BeNil)
+		_, storedKey, err := GetAPIKeyStore(db).GetAPIKey(ctx, apiKey.Id)
+		a.So(err, should.BeNil)
+
+		var archive bytes.Buffer
+		exported, err := Export(ctx, db, &archive)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(exported[archiveUser], should.Equal, len(population.Users))
+		a.So(exported[archiveOrganization], should.Equal, len(population.Organizations))
+		a.So(exported[archiveApplication], should.Equal, len(population.Applications))
+		a.So(exported[archiveGateway], should.Equal, len(population.Gateways))
+		a.So(exported[archiveClient], should.Equal, len(population.Clients))
+		a.So(exported[archiveEndDevice], should.Equal, len(population.EndDevices))
+		a.So(exported[archiveContactInfo], should.Equal, 1)
+		a.So(exported[archiveUserMFA], should.Equal, 1)
+		a.So(exported[archiveFederatedIdentity], should.Equal, 1)
+		a.So(exported[archiveEmailPreferences], should.Equal, 1)
+
+		if err := Clear(db); err != nil {
+			t.Fatal(err)
+		}
+
+		imported, err := Import(ctx, db, bytes.NewReader(archive.Bytes()))
+		if a.So(err, should.BeNil) {
+			a.So(imported, should.Resemble, exported)
+		}
+
+		importedUsr, err := GetUserStore(db).GetUser(ctx, usr.GetIds(), nil)
+		if a.So(err, should.BeNil) {
+			a.So(importedUsr.Password, should.Equal, storedUsr.Password)
+			a.So(importedUsr.PrimaryEmailAddress, should.Equal, storedUsr.PrimaryEmailAddress)
+			a.So(*importedUsr.CreatedAt, should.Equal, createdAt)
+			a.So(*importedUsr.UpdatedAt, should.Equal, updatedAt)
+		}
+		contactInfo, err := GetContactInfoStore(db).GetContactInfo(ctx, usr.GetIds())
+		if a.So(err, should.BeNil) && a.So(contactInfo, should.HaveLength, 1) {
+			a.So(contactInfo[0].Value, should.Equal, "tech@example.com")
+		}
+		importedMFA, err := GetUserMFAStore(db).GetUserMFA(ctx, usr.GetIds())
+		if a.So(err, should.BeNil) {
+			a.So(importedMFA.TOTPSecret, should.Equal, mfa.TOTPSecret)
+			a.So(importedMFA.TOTPSecretKeyID, should.Equal, mfa.TOTPSecretKeyID)
+			a.So(importedMFA.TOTPLastStep, should.Equal, mfa.TOTPLastStep)
+			a.So([]string(importedMFA.RecoveryCodes), should.Resemble, []string(mfa.RecoveryCodes))
+			if a.So(importedMFA.EnabledAt, should.NotBeNil) {
+				a.So(*importedMFA.EnabledAt, should.Equal, enabledAt)
+			}
+		}
+		federatedUser, err := GetFederatedIdentityStore(db).GetFederatedUser(ctx, "foo-provider", "foo-subject")
+		if a.So(err, should.BeNil) {
+			a.So(federatedUser, should.Resemble, usr.GetIds())
+		}
+		disabledTypes, err := GetNotificationStore(db).GetDisabledEmailNotifications(ctx, usr.GetIds())
+		if a.So(err, should.BeNil) {
+			a.So(disabledTypes, should.Resemble, []string{"api_key_created"})
+		}
+		_, importedKey, err := GetAPIKeyStore(db).GetAPIKey(ctx, apiKey.Id)
+		if a.So(err, should.BeNil) {
+			a.So(importedKey.Key, should.Equal, storedKey.Key)
+			a.So(importedKey.Rights, should.Resemble, storedKey.Rights)
+			a.So(importedKey.Restrictions, should.Resemble, restrictions)
+		}
+		for ids, members := range population.Memberships {
+			for _, member := range members {
+				memberRights, err := GetMembershipStore(db).GetMember(ctx, member.GetIds(), ids)
+				if a.So(err, should.BeNil) {
+					a.So(memberRights.GetRights(), should.Resemble, member.GetRights())
+				}
+			}
+		}
+
+		var reexported bytes.Buffer
+		counts, err := Export(ctx, db, &reexported)
+		if a.So(err, should.BeNil) {
+			a.So(counts, should.Resemble, exported)
+		}
+
+		_, err = Import(ctx, db, bytes.NewReader(archive.Bytes()))
+		a.So(err, should.NotBeNil)
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// AuditEntry is an entry in the append-only audit log of changes in the Identity Server.
+// Audit entries do not reference the entities and accounts, so that they are kept when those are purged.
+type AuditEntry struct {
+	// ID is generated by the store, so that it does not depend on database specific UUID functions.
+	ID        string    `gorm:"type:UUID;primary_key"`
+	CreatedAt time.Time `gorm:"not null;index:audit_entry_created_at_index"`
+
+	// Action is the name of the event that triggered the entry, such as application.update.
+	Action string `gorm:"type:VARCHAR;not null"`
+
+	EntityType string `gorm:"type:VARCHAR(32);index:audit_entry_entity_index"`
+	EntityID   string `gorm:"type:VARCHAR;index:audit_entry_entity_index"`
+	// RelatedEntities are the other entities of the change, such as collaborators, as type:id.
+	RelatedEntities pq.StringArray `gorm:"type:VARCHAR ARRAY"`
+	// Paths are the field mask paths of the change.
+	Paths pq.StringArray `gorm:"type:VARCHAR ARRAY"`
+
+	ActorType     string `gorm:"type:VARCHAR(32)"`
+	ActorID       string `gorm:"type:VARCHAR"`
+	AuthType      string `gorm:"type:VARCHAR(32)"`
+	AuthTokenType string `gorm:"type:VARCHAR(32)"`
+	AuthTokenID   string `gorm:"type:VARCHAR"`
+
+	SourceIP  string `gorm:"type:VARCHAR(64)"`
+	UserAgent string `gorm:"type:VARCHAR"`
+}
+
+func init() {
+	registerModel(&AuditEntry{})
+}
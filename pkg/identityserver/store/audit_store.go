@@ -0,0 +1,77 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	uuid "github.com/satori/go.uuid"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// GetAuditStore returns an AuditStore on the given db (or transaction).
+func GetAuditStore(db *gorm.DB) AuditStore {
+	return &auditStore{store: newStore(db)}
+}
+
+type auditStore struct {
+	*store
+}
+
+func (s *auditStore) CreateAuditEntry(ctx context.Context, entry *AuditEntry) error {
+	defer trace.StartRegion(ctx, "create audit entry").End()
+	entry.ID = uuid.NewV4().String()
+	return s.DB.Create(entry).Error
+}
+
+func (s *auditStore) FindAuditEntries(ctx context.Context, entityID *ttnpb.EntityIdentifiers, after, before *time.Time) ([]*AuditEntry, error) {
+	defer trace.StartRegion(ctx, "find audit entries").End()
+	query := s.query(ctx, AuditEntry{})
+	if entityID != nil {
+		query = query.Where(AuditEntry{
+			EntityType: entityID.EntityType(),
+			EntityID:   unique.ID(ctx, entityID),
+		})
+	}
+	if after != nil {
+		query = query.Where("created_at >= ?", *after)
+	}
+	if before != nil {
+		query = query.Where("created_at < ?", *before)
+	}
+	query = query.Order(orderFromContext(ctx, "audit_entries", "created_at", "DESC"))
+	if limit, offset := limitAndOffsetFromContext(ctx); limit != 0 {
+		countTotal(ctx, query.Model(&AuditEntry{}))
+		query = query.Limit(limit).Offset(offset)
+	}
+	var entries []*AuditEntry
+	if err := query.Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (s *auditStore) DeleteAuditEntries(ctx context.Context, before time.Time) (int64, error) {
+	defer trace.StartRegion(ctx, "delete audit entries").End()
+	res := s.query(ctx, AuditEntry{}).Where("created_at < ?", before).Delete(&AuditEntry{})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	return res.RowsAffected, nil
+}
@@ -0,0 +1,85 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+)
+
+func TestAuditStore(t *testing.T) {
+	a, ctx := test.New(t)
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db, &AuditEntry{})
+		store := GetAuditStore(db)
+
+		appIDs := (&ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}).GetEntityIdentifiers()
+		gtwIDs := (&ttnpb.GatewayIdentifiers{GatewayId: "foo-gtw"}).GetEntityIdentifiers()
+
+		for _, entry := range []*AuditEntry{
+			{Action: "application.create", EntityType: "application", EntityID: "foo-app", ActorType: "user", ActorID: "foo-usr"},
+			{Action: "application.update", EntityType: "application", EntityID: "foo-app", Paths: []string{"name"}, SourceIP: "192.0.2.1"},
+			{Action: "gateway.create", EntityType: "gateway", EntityID: "foo-gtw"},
+		} {
+			err := store.CreateAuditEntry(ctx, entry)
+			a.So(err, should.BeNil)
+			time.Sleep(time.Millisecond)
+		}
+
+		entries, err := store.FindAuditEntries(ctx, appIDs, nil, nil)
+		if a.So(err, should.BeNil) && a.So(entries, should.HaveLength, 2) {
+			a.So(entries[0].Action, should.Equal, "application.update")
+			a.So(entries[0].Paths, should.Resemble, pq.StringArray{"name"})
+			a.So(entries[0].SourceIP, should.Equal, "192.0.2.1")
+			a.So(entries[1].Action, should.Equal, "application.create")
+			a.So(entries[1].ActorID, should.Equal, "foo-usr")
+		}
+
+		entries, err = store.FindAuditEntries(ctx, gtwIDs, nil, nil)
+		if a.So(err, should.BeNil) {
+			a.So(entries, should.HaveLength, 1)
+		}
+
+		var total uint64
+		entries, err = store.FindAuditEntries(WithPagination(ctx, 2, 1, &total), nil, nil, nil)
+		if a.So(err, should.BeNil) {
+			a.So(entries, should.HaveLength, 2)
+			a.So(total, should.Equal, 3)
+		}
+
+		future := time.Now().Add(time.Hour)
+		entries, err = store.FindAuditEntries(ctx, nil, &future, nil)
+		if a.So(err, should.BeNil) {
+			a.So(entries, should.BeEmpty)
+		}
+
+		deleted, err := store.DeleteAuditEntries(ctx, future)
+		if a.So(err, should.BeNil) {
+			a.So(deleted, should.Equal, 3)
+		}
+
+		entries, err = store.FindAuditEntries(ctx, nil, nil, nil)
+		if a.So(err, should.BeNil) {
+			a.So(entries, should.BeEmpty)
+		}
+	})
+}
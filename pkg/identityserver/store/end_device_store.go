@@ -37,6 +37,29 @@ type deviceStore struct {
 	*store
 }
 
+type endDeviceIDsFilterKeyType struct{}
+
+var endDeviceIDsFilterKey endDeviceIDsFilterKeyType
+
+// WithEndDeviceIDsFilter returns a context that tells the store to only list and search
+// the end devices with the given IDs.
+func WithEndDeviceIDsFilter(ctx context.Context, deviceIDs []string) context.Context {
+	return context.WithValue(ctx, endDeviceIDsFilterKey, deviceIDs)
+}
+
+func withEndDeviceIDsFilter(ctx context.Context) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		deviceIDs, ok := ctx.Value(endDeviceIDsFilterKey).([]string)
+		if !ok {
+			return db
+		}
+		if len(deviceIDs) == 0 {
+			return db.Where("1 = 0")
+		}
+		return db.Where(`"end_devices"."device_id" IN (?)`, deviceIDs)
+	}
+}
+
 // selectEndDeviceFields selects relevant fields (based on fieldMask) and preloads details if needed.
 func selectEndDeviceFields(ctx context.Context, query *gorm.DB, fieldMask *pbtypes.FieldMask) *gorm.DB {
 	if len(fieldMask.GetPaths()) == 0 {
@@ -115,7 +138,7 @@ func (s *deviceStore) CountEndDevices(ctx context.Context, ids *ttnpb.Applicatio
 
 func (s *deviceStore) ListEndDevices(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, fieldMask *pbtypes.FieldMask) ([]*ttnpb.EndDevice, error) {
 	// NOTE: tracing done in s.findEndDevices.
-	query := s.query(ctx, EndDevice{})
+	query := s.query(ctx, EndDevice{}, withEndDeviceIDsFilter(ctx))
 	if ids != nil {
 		query = query.Scopes(withApplicationID(ids.GetApplicationId()))
 	}
@@ -215,3 +238,24 @@ func (s *deviceStore) DeleteEndDevice(ctx context.Context, id *ttnpb.EndDeviceId
 	defer trace.StartRegion(ctx, "delete end device").End()
 	return s.deleteEntity(ctx, id)
 }
+
+func (s *deviceStore) FindEndDeviceIDsByAttributes(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, attributes map[string]string) ([]string, error) {
+	defer trace.StartRegion(ctx, "find end device ids by attributes").End()
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+	query := s.query(ctx, EndDevice{}, withApplicationID(ids.GetApplicationId()))
+	for key, value := range attributes {
+		sub := s.query(ctx, &Attribute{}).Select("entity_id").Where(&Attribute{
+			EntityType: "device",
+			Key:        key,
+			Value:      value,
+		})
+		query = query.Where(`"end_devices"."id" IN (?)`, sub.QueryExpr())
+	}
+	var deviceIDs []string
+	if err := query.Order("device_id").Pluck("device_id", &deviceIDs).Error; err != nil {
+		return nil, err
+	}
+	return deviceIDs, nil
+}
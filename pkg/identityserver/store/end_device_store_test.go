@@ -282,3 +282,43 @@ func TestEndDeviceStore(t *testing.T) {
 		a.So(devices, should.BeNil)
 	})
 }
+
+func TestEndDeviceStoreFindByAttributes(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db, &EndDevice{}, &Attribute{}, &EndDeviceLocation{})
+		store := GetEndDeviceStore(db)
+
+		appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+		for deviceID, attributes := range map[string]map[string]string{
+			"dev-1": {"installer": "alice", "site": "north"},
+			"dev-2": {"installer": "alice"},
+			"dev-3": {"installer": "bob"},
+		} {
+			_, err := store.CreateEndDevice(ctx, &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: *appIDs,
+					DeviceId:               deviceID,
+				},
+				Attributes: attributes,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		deviceIDs, err := store.FindEndDeviceIDsByAttributes(ctx, appIDs, map[string]string{"installer": "alice"})
+		a.So(err, should.BeNil)
+		a.So(deviceIDs, should.Resemble, []string{"dev-1", "dev-2"})
+
+		deviceIDs, err = store.FindEndDeviceIDsByAttributes(ctx, appIDs, map[string]string{"installer": "alice", "site": "north"})
+		a.So(err, should.BeNil)
+		a.So(deviceIDs, should.Resemble, []string{"dev-1"})
+
+		deviceIDs, err = store.FindEndDeviceIDsByAttributes(ctx, appIDs, map[string]string{"installer": "carol"})
+		a.So(err, should.BeNil)
+		a.So(deviceIDs, should.BeEmpty)
+	})
+}
@@ -40,24 +40,26 @@ type metaFields interface {
 }
 
 func (s *entitySearch) queryMetaFields(ctx context.Context, query *gorm.DB, entityType string, req metaFields) *gorm.DB {
+	like := ilike(query)
 	if v := req.GetIdContains(); v != "" {
 		switch entityType {
 		case "organization", "user":
-			query = query.Where(`"accounts"."uid" ILIKE ?`, "%"+v+"%")
+			query = query.Where(fmt.Sprintf(`"accounts"."uid" %s ?`, like), "%"+v+"%")
 		case "end_device":
-			query = query.Where(`"end_devices"."device_id" ILIKE ?`, "%"+v+"%")
+			query = query.Where(fmt.Sprintf(`"end_devices"."device_id" %s ?`, like), "%"+v+"%")
 		default:
-			query = query.Where(fmt.Sprintf(`"%[1]ss"."%[1]s_id" ILIKE ?`, entityType), "%"+v+"%")
+			query = query.Where(fmt.Sprintf(`"%[1]ss"."%[1]s_id" %[2]s ?`, entityType, like), "%"+v+"%")
 		}
 	}
 	if v := req.GetNameContains(); v != "" {
-		query = query.Where("name ILIKE ?", fmt.Sprintf("%%%s%%", v))
+		query = query.Where(fmt.Sprintf("name %s ?", like), fmt.Sprintf("%%%s%%", v))
 	}
 	if v := req.GetDescriptionContains(); v != "" {
+		// Full-text search is only available in PostgreSQL, other databases fall back to substring matching.
 		if dbKind, ok := query.Get("db:kind"); ok && dbKind == "PostgreSQL" {
 			query = query.Where("to_tsvector('english', description) @@ websearch_to_tsquery('english', ?)", v)
 		} else {
-			query = query.Where("description ILIKE ?", fmt.Sprintf("%%%s%%", v))
+			query = query.Where(fmt.Sprintf("description %s ?", like), fmt.Sprintf("%%%s%%", v))
 		}
 	}
 	if kv := req.GetAttributesContain(); len(kv) > 0 {
@@ -69,24 +71,32 @@ func (s *entitySearch) queryMetaFields(ctx context.Context, query *gorm.DB, enti
 			sub = sub.Where("entity_type = ?", entityType)
 		}
 		for k, v := range kv {
-			sub = sub.Where("key = ? AND value ILIKE ?", k, fmt.Sprintf("%%%s%%", v))
+			sub = sub.Where(fmt.Sprintf("key = ? AND value %s ?", like), k, fmt.Sprintf("%%%s%%", v))
 		}
 		query = query.Where(fmt.Sprintf(`"%ss"."id" IN (?)`, entityType), sub.QueryExpr())
 	}
 	return query
 }
 
-func (s *entitySearch) queryMembership(ctx context.Context, query *gorm.DB, entityType string, member *ttnpb.OrganizationOrUserIdentifiers) *gorm.DB {
+func (s *entitySearch) queryMembership(ctx context.Context, query *gorm.DB, entityType string, member *ttnpb.OrganizationOrUserIdentifiers) (*gorm.DB, error) {
 	if member == nil {
-		return query
+		return query, nil
 	}
-	membershipsQuery := (&membershipStore{store: s.store}).queryMemberships(ctx, member, entityType, nil, true).Select(`"direct_memberships"."entity_id"`).QueryExpr()
+	memberships := &membershipStore{store: s.store}
+	account, organizations, err := memberships.findMemberAccounts(ctx, member, true)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return query.Where("1 = 0"), nil
+	}
+	membershipsQuery := memberships.queryMembershipEntityIDs(ctx, account, organizations, entityType).QueryExpr()
 	if entityType == "organization" {
 		query = query.Where(`"accounts"."account_type" = ? AND "accounts"."account_id" IN (?)`, entityType, membershipsQuery)
 	} else {
 		query = query.Where(fmt.Sprintf(`"%[1]ss"."id" IN (?)`, entityType), membershipsQuery)
 	}
-	return query
+	return query, nil
 }
 
 type searchResult struct {
@@ -120,7 +130,10 @@ func (s *entitySearch) FindApplications(ctx context.Context, member *ttnpb.Organ
 	}
 	query := s.query(ctx, &Application{})
 	query = query.Select(fmt.Sprintf(`"%[1]ss"."%[1]s_id" AS "friendly_id"`, application))
-	query = s.queryMembership(ctx, query, application, member)
+	query, err := s.queryMembership(ctx, query, application, member)
+	if err != nil {
+		return nil, err
+	}
 	query = s.queryMetaFields(ctx, query, application, req)
 	results, err := s.runPaginatedQuery(ctx, query, application)
 	if err != nil {
@@ -142,7 +155,10 @@ func (s *entitySearch) FindClients(ctx context.Context, member *ttnpb.Organizati
 	}
 	query := s.query(ctx, &Client{})
 	query = query.Select(fmt.Sprintf(`"%[1]ss"."%[1]s_id" AS "friendly_id"`, client))
-	query = s.queryMembership(ctx, query, client, member)
+	query, err := s.queryMembership(ctx, query, client, member)
+	if err != nil {
+		return nil, err
+	}
 	query = s.queryMetaFields(ctx, query, client, req)
 	if len(req.State) > 0 {
 		stateNumbers := make([]int, len(req.State))
@@ -171,10 +187,13 @@ func (s *entitySearch) FindGateways(ctx context.Context, member *ttnpb.Organizat
 	}
 	query := s.query(ctx, &Gateway{})
 	query = query.Select(fmt.Sprintf(`"%[1]ss"."%[1]s_id" AS "friendly_id"`, gateway))
-	query = s.queryMembership(ctx, query, gateway, member)
+	query, err := s.queryMembership(ctx, query, gateway, member)
+	if err != nil {
+		return nil, err
+	}
 	query = s.queryMetaFields(ctx, query, gateway, req)
 	if v := req.EuiContains; v != "" {
-		query = query.Where(fmt.Sprintf(`"%[1]ss"."gateway_eui" ILIKE ?`, gateway), fmt.Sprintf("%%%s%%", v))
+		query = query.Where(fmt.Sprintf(`"%[1]ss"."gateway_eui" %[2]s ?`, gateway, ilike(query)), fmt.Sprintf("%%%s%%", v))
 	}
 	results, err := s.runPaginatedQuery(ctx, query, gateway)
 	if err != nil {
@@ -198,7 +217,10 @@ func (s *entitySearch) FindOrganizations(ctx context.Context, member *ttnpb.Orga
 	query = query.
 		Joins(`JOIN "accounts" ON "accounts"."account_type" = 'organization' AND "accounts"."account_id" = "organizations"."id"`).
 		Select(`"accounts"."uid" AS "friendly_id"`)
-	query = s.queryMembership(ctx, query, organization, member)
+	query, err := s.queryMembership(ctx, query, organization, member)
+	if err != nil {
+		return nil, err
+	}
 	query = s.queryMetaFields(ctx, query, organization, req)
 	results, err := s.runPaginatedQuery(ctx, query, organization)
 	if err != nil {
@@ -222,7 +244,10 @@ func (s *entitySearch) FindUsers(ctx context.Context, member *ttnpb.Organization
 	query = query.
 		Joins(`JOIN "accounts" ON "accounts"."account_type" = 'user' AND "accounts"."account_id" = "users"."id"`).
 		Select(`"accounts"."uid" AS "friendly_id"`)
-	query = s.queryMembership(ctx, query, user, member)
+	query, err := s.queryMembership(ctx, query, user, member)
+	if err != nil {
+		return nil, err
+	}
 	query = s.queryMetaFields(ctx, query, user, req)
 	if len(req.State) > 0 {
 		stateNumbers := make([]int, len(req.State))
@@ -247,14 +272,15 @@ func (s *entitySearch) FindEndDevices(ctx context.Context, req *ttnpb.SearchEndD
 
 	query := s.query(ctx, &EndDevice{}).
 		Where(&EndDevice{ApplicationID: req.GetApplicationIds().GetApplicationId()}).
+		Scopes(withEndDeviceIDsFilter(ctx)).
 		Select(`"end_devices"."device_id" AS "friendly_id"`)
 	query = s.queryMetaFields(ctx, query, "end_device", req)
 
 	if v := req.DevEuiContains; v != "" {
-		query = query.Where("dev_eui ILIKE ?", fmt.Sprintf("%%%s%%", v))
+		query = query.Where(fmt.Sprintf("dev_eui %s ?", ilike(query)), fmt.Sprintf("%%%s%%", v))
 	}
 	if v := req.JoinEuiContains; v != "" {
-		query = query.Where("join_eui ILIKE ?", fmt.Sprintf("%%%s%%", v))
+		query = query.Where(fmt.Sprintf("join_eui %s ?", ilike(query)), fmt.Sprintf("%%%s%%", v))
 	}
 	// DevAddrContains
 
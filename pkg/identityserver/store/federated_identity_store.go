@@ -0,0 +1,101 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// GetFederatedIdentityStore returns an FederatedIdentityStore on the given db (or transaction).
+func GetFederatedIdentityStore(db *gorm.DB) FederatedIdentityStore {
+	return &federatedIdentityStore{store: newStore(db)}
+}
+
+type federatedIdentityStore struct {
+	*store
+}
+
+var errFederatedIdentityNotFound = errors.DefineNotFound("federated_identity_not_found", "identity `{subject}` of provider `{provider_id}` not found")
+
+func (s *federatedIdentityStore) GetFederatedUser(ctx context.Context, providerID, subject string) (*ttnpb.UserIdentifiers, error) {
+	defer trace.StartRegion(ctx, "get federated user").End()
+	var identityModel FederatedIdentity
+	if err := s.query(ctx, FederatedIdentity{}).Where(FederatedIdentity{
+		ProviderID: providerID,
+		Subject:    subject,
+	}).First(&identityModel).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errFederatedIdentityNotFound.WithAttributes("provider_id", providerID, "subject", subject)
+		}
+		return nil, err
+	}
+	var accountModel Account
+	if err := s.query(ctx, Account{}).Where(Account{
+		AccountID:   identityModel.UserID,
+		AccountType: "user",
+	}).First(&accountModel).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errFederatedIdentityNotFound.WithAttributes("provider_id", providerID, "subject", subject)
+		}
+		return nil, err
+	}
+	return &ttnpb.UserIdentifiers{UserId: accountModel.UID}, nil
+}
+
+func (s *federatedIdentityStore) CreateFederatedIdentity(ctx context.Context, userIDs *ttnpb.UserIdentifiers, providerID, subject string) error {
+	defer trace.StartRegion(ctx, "create federated identity").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return err
+	}
+	identityModel := FederatedIdentity{
+		UserID:     user.PrimaryKey(),
+		ProviderID: providerID,
+		Subject:    subject,
+	}
+	if err = s.createEntity(ctx, &identityModel); err != nil {
+		return convertError(err)
+	}
+	return nil
+}
+
+func (s *federatedIdentityStore) FindUserFederatedIdentities(ctx context.Context, userIDs *ttnpb.UserIdentifiers) ([]*FederatedIdentity, error) {
+	defer trace.StartRegion(ctx, "find user federated identities").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return nil, err
+	}
+	var identityModels []*FederatedIdentity
+	if err = s.query(ctx, FederatedIdentity{}).Where(FederatedIdentity{
+		UserID: user.PrimaryKey(),
+	}).Order("provider_id, subject").Find(&identityModels).Error; err != nil {
+		return nil, err
+	}
+	return identityModels, nil
+}
+
+func (s *federatedIdentityStore) DeleteUserFederatedIdentities(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error {
+	defer trace.StartRegion(ctx, "delete user federated identities").End()
+	user, err := s.findDeletedEntity(ctx, userIDs, "id")
+	if err != nil {
+		return err
+	}
+	return s.query(ctx, FederatedIdentity{}).Where(FederatedIdentity{UserID: user.PrimaryKey()}).Delete(&FederatedIdentity{}).Error
+}
@@ -0,0 +1,80 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+)
+
+func TestFederatedIdentityStore(t *testing.T) {
+	a, ctx := test.New(t)
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		s := newStore(db)
+		store := GetFederatedIdentityStore(db)
+
+		prepareTest(db,
+			&Account{}, &User{},
+			&FederatedIdentity{},
+		)
+
+		usr := &User{Account: Account{UID: "federated-identity-test-user"}}
+		s.createEntity(ctx, usr)
+		userIDs := &ttnpb.UserIdentifiers{UserId: usr.Account.UID}
+
+		_, err := store.GetFederatedUser(ctx, "foo-provider", "foo-subject")
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		err = store.CreateFederatedIdentity(ctx, userIDs, "foo-provider", "foo-subject")
+		a.So(err, should.BeNil)
+
+		err = store.CreateFederatedIdentity(ctx, userIDs, "foo-provider", "foo-subject")
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsAlreadyExists(err), should.BeTrue)
+		}
+
+		got, err := store.GetFederatedUser(ctx, "foo-provider", "foo-subject")
+		if a.So(err, should.BeNil) {
+			a.So(got, should.Resemble, userIDs)
+		}
+
+		_, err = store.GetFederatedUser(ctx, "bar-provider", "foo-subject")
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		identities, err := store.FindUserFederatedIdentities(ctx, userIDs)
+		if a.So(err, should.BeNil) && a.So(identities, should.HaveLength, 1) {
+			a.So(identities[0].ProviderID, should.Equal, "foo-provider")
+			a.So(identities[0].Subject, should.Equal, "foo-subject")
+		}
+
+		err = store.DeleteUserFederatedIdentities(ctx, userIDs)
+		a.So(err, should.BeNil)
+
+		_, err = store.GetFederatedUser(ctx, "foo-provider", "foo-subject")
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+	})
+}
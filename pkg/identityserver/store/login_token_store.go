@@ -43,7 +43,7 @@ func (s *loginTokenStore) FindActiveLoginTokens(ctx context.Context, userIDs *tt
 	if err := s.query(ctx, LoginToken{}).Where(
 		"user_id = ?", user.PrimaryKey(),
 	).Where(
-		"expires_at > now()",
+		"expires_at > ?", cleanTime(time.Now()),
 	).Where(
 		"used = false",
 	).Find(&loginTokenModels).Error; err != nil {
@@ -16,6 +16,7 @@ package store
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -36,15 +37,81 @@ type membershipCache struct {
 // Make sure to not call FindIndirectMemberships or GetMember after calling
 // SetMember in the same transaction, this may result in an inconsistent cache.
 func GetMembershipCache(store MembershipStore, redis *redis.Client, ttl time.Duration) MembershipStore {
-	return &membershipCache{
+	c := &membershipCache{
 		MembershipStore: store,
 		redis:           redis,
 		ttl:             ttl,
 	}
+	if s, ok := store.(*membershipStore); ok {
+		s.organizationsCache = c
+	}
+	return c
 }
 
 // TODO: Add FindIndirectMemberships (https://github.com/TheThingsNetwork/lorawan-stack/issues/443).
 
+// organizationsVersionKey is the key of the version of the organization hierarchy. The version is incremented when
+// memberships on organizations change, which invalidates all cached organizations of accounts.
+func organizationsVersionKey(redis *redis.Client) string {
+	return redis.Key("membership", "organizations-version")
+}
+
+// InvalidateMembershipOrganizations invalidates the cached organizations that accounts are nested members of.
+// This must be called after organizations are deleted or restored, as the cached organizations do not include
+// deleted organizations.
+func InvalidateMembershipOrganizations(ctx context.Context, redis *redis.Client) {
+	if cacheErr := redis.Incr(ctx, organizationsVersionKey(redis)).Err(); cacheErr != nil {
+		log.FromContext(ctx).WithError(cacheErr).Error("Failed to invalidate organizations cache")
+	}
+}
+
+func (c *membershipCache) organizationsVersionKey() string {
+	return organizationsVersionKey(c.redis)
+}
+
+func (c *membershipCache) organizationsCacheKey(ctx context.Context, account *Account) (string, error) {
+	version, err := c.redis.Get(ctx, c.organizationsVersionKey()).Result()
+	if err != nil {
+		if !errors.IsNotFound(redis.ConvertError(err)) {
+			return "", err
+		}
+		version = "0"
+	}
+	return c.redis.Key("membership", "organizations", version, account.AccountType, account.UID), nil
+}
+
+func (c *membershipCache) findAccountOrganizations(
+	ctx context.Context,
+	account *Account,
+	uncached func(context.Context, *Account) ([]*accountOrganization, error),
+) ([]*accountOrganization, error) {
+	cacheKey, err := c.organizationsCacheKey(ctx, account)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("Failed to get organizations cache version")
+		return uncached(ctx, account)
+	}
+	if cached, err := c.redis.Get(ctx, cacheKey).Bytes(); err == nil {
+		var organizations []*accountOrganization
+		if err = json.Unmarshal(cached, &organizations); err == nil {
+			return organizations, nil
+		}
+	}
+	organizations, err := uncached(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	if cache, err := json.Marshal(organizations); err == nil {
+		if cacheErr := c.redis.Set(ctx, cacheKey, cache, c.ttl).Err(); cacheErr != nil {
+			log.FromContext(ctx).WithError(cacheErr).Error("Failed to set organizations cache")
+		}
+	}
+	return organizations, nil
+}
+
+func (c *membershipCache) invalidateOrganizations(ctx context.Context) {
+	InvalidateMembershipOrganizations(ctx, c.redis)
+}
+
 func (c *membershipCache) cacheKey(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID *ttnpb.EntityIdentifiers) string {
 	return c.redis.Key("membership", id.EntityType(), unique.ID(ctx, id), entityID.EntityType(), unique.ID(ctx, entityID))
 }
@@ -91,5 +158,28 @@ func (c *membershipCache) SetMember(ctx context.Context, id *ttnpb.OrganizationO
 	if cacheErr := c.redis.Del(ctx, c.cacheKey(ctx, id, entityID)).Err(); cacheErr != nil {
 		log.FromContext(ctx).WithError(cacheErr).Error("Failed to invalidate membership cache")
 	}
+	if entityID.EntityType() == "organization" {
+		c.invalidateOrganizations(ctx)
+	}
+	return nil
+}
+
+func (c *membershipCache) DeleteEntityMembers(ctx context.Context, entityID *ttnpb.EntityIdentifiers) error {
+	if err := c.MembershipStore.DeleteEntityMembers(ctx, entityID); err != nil {
+		return err
+	}
+	if entityID.EntityType() == "organization" {
+		c.invalidateOrganizations(ctx)
+	}
+	return nil
+}
+
+func (c *membershipCache) DeleteAccountMembers(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers) error {
+	if err := c.MembershipStore.DeleteAccountMembers(ctx, id); err != nil {
+		return err
+	}
+	if id.EntityType() == "organization" {
+		c.invalidateOrganizations(ctx)
+	}
 	return nil
 }
@@ -31,17 +31,15 @@ func GetMembershipStore(db *gorm.DB) MembershipStore {
 
 type membershipStore struct {
 	*store
+	organizationsCache *membershipCache
 }
 
-func (s *membershipStore) queryWithIndirectMemberships(ctx context.Context, entityType string, entityIDs ...string) *gorm.DB {
+func (s *membershipStore) queryWithDirectMemberships(ctx context.Context, entityType string, entityIDs ...string) *gorm.DB {
 	idColumnName := fmt.Sprintf(`"%[1]ss"."%[1]s_id"`, entityType)
 	if entityType == "organization" {
 		idColumnName = `"organization_accounts"."uid"`
 	}
 	query := s.query(ctx, modelForEntityType(entityType)).Select([]string{
-		`"indirect_accounts"."account_type" "indirect_account_type"`,
-		`"indirect_accounts"."uid" "indirect_account_friendly_id"`,
-		`"indirect_memberships"."rights" "indirect_account_rights"`,
 		`"direct_accounts"."account_type" "direct_account_type"`,
 		`"direct_accounts"."uid" "direct_account_friendly_id"`,
 		`"direct_memberships"."rights" "direct_account_rights"`,
@@ -55,54 +53,144 @@ func (s *membershipStore) queryWithIndirectMemberships(ctx context.Context, enti
 		query = query.Joins(`JOIN "accounts" "organization_accounts" ON "organization_accounts"."account_id" = "organizations"."id" AND "organization_accounts"."account_type" = 'organization'`)
 	}
 	query = query.Joins(fmt.Sprintf(`JOIN "memberships" "direct_memberships" ON "direct_memberships"."entity_id" = "%[1]ss"."id" AND "direct_memberships"."entity_type" = '%[1]s'`, entityType)).
-		Joins(`JOIN "accounts" "direct_accounts" ON "direct_accounts"."id" = "direct_memberships"."account_id"`).
-		Joins(`LEFT JOIN "memberships" "indirect_memberships" ON "indirect_memberships"."entity_id" = "direct_accounts"."account_id" AND "indirect_memberships"."entity_type" = "direct_accounts"."account_type"`).
-		Joins(`LEFT JOIN "accounts" "indirect_accounts" ON "indirect_accounts"."id" = "indirect_memberships"."account_id"`)
-	query = query.Where(`"direct_accounts"."deleted_at" IS NULL AND "indirect_accounts"."deleted_at" IS NULL`)
+		Joins(`JOIN "accounts" "direct_accounts" ON "direct_accounts"."id" = "direct_memberships"."account_id"`)
+	query = query.Where(`"direct_accounts"."deleted_at" IS NULL`)
 	return query
 }
 
-func (s *membershipStore) queryWithDirectMemberships(ctx context.Context, entityType string, entityIDs ...string) *gorm.DB {
-	idColumnName := fmt.Sprintf(`"%[1]ss"."%[1]s_id"`, entityType)
-	if entityType == "organization" {
-		idColumnName = `"organization_accounts"."uid"`
+// maxOrganizationDepth is the maximum number of nested organizations in a membership chain.
+const maxOrganizationDepth = 5
+
+// accountOrganization is an organization that an account is a direct or nested member of.
+type accountOrganization struct {
+	// AccountID is the primary key of the account of the organization.
+	AccountID string `json:"account_id"`
+	// Path is the IDs of the organizations from the organization that the account is a direct member of,
+	// to this organization.
+	Path []string `json:"path"`
+	// PathRights is the rights of each member on the next organization in the path.
+	PathRights []*ttnpb.Rights `json:"path_rights"`
+}
+
+// OrganizationID returns the ID of the organization.
+func (o *accountOrganization) OrganizationID() string {
+	return o.Path[len(o.Path)-1]
+}
+
+func (o *accountOrganization) hasInPath(organizationID string) bool {
+	for _, id := range o.Path {
+		if id == organizationID {
+			return true
+		}
 	}
-	query := s.query(ctx, modelForEntityType(entityType)).Select([]string{
-		`"direct_accounts"."account_type" "direct_account_type"`,
-		`"direct_accounts"."uid" "direct_account_friendly_id"`,
-		`"direct_memberships"."rights" "direct_account_rights"`,
-		`"direct_memberships"."entity_type" "entity_type"`,
-		idColumnName + ` "entity_friendly_id"`,
-	})
-	if len(entityIDs) > 0 {
-		query = query.Where(idColumnName+" IN (?)", entityIDs)
+	return false
+}
+
+// findAccountOrganizations returns the organizations that the account is a direct or nested member of.
+// An organization is returned once for every path through which the account is a member of it.
+func (s *membershipStore) findAccountOrganizations(ctx context.Context, account *Account) ([]*accountOrganization, error) {
+	if s.organizationsCache != nil {
+		return s.organizationsCache.findAccountOrganizations(ctx, account, s.findAccountOrganizationsUncached)
 	}
-	if entityType == "organization" {
-		query = query.Joins(`JOIN "accounts" "organization_accounts" ON "organization_accounts"."account_id" = "organizations"."id" AND "organization_accounts"."account_type" = 'organization'`)
+	return s.findAccountOrganizationsUncached(ctx, account)
+}
+
+func (s *membershipStore) findAccountOrganizationsUncached(ctx context.Context, account *Account) ([]*accountOrganization, error) {
+	defer trace.StartRegion(ctx, "find organizations of account").End()
+	var organizations []*accountOrganization
+	frontier := []*accountOrganization{{AccountID: account.ID}}
+	for depth := 0; depth < maxOrganizationDepth && len(frontier) > 0; depth++ {
+		accountIDs := make([]string, len(frontier))
+		for i, member := range frontier {
+			accountIDs[i] = member.AccountID
+		}
+		var results []struct {
+			MemberAccountID string
+			Rights          Rights
+			AccountID       string
+			UID             string
+		}
+		err := s.query(ctx, Membership{}).Select([]string{
+			`"memberships"."account_id" "member_account_id"`,
+			`"memberships"."rights" "rights"`,
+			`"accounts"."id" "account_id"`,
+			`"accounts"."uid" "uid"`,
+		}).
+			Joins(`JOIN "accounts" ON "accounts"."account_type" = 'organization' AND "accounts"."account_id" = "memberships"."entity_id"`).
+			Where(`"memberships"."entity_type" = 'organization' AND "memberships"."account_id" IN (?) AND "accounts"."deleted_at" IS NULL`, accountIDs).
+			Scan(&results).Error
+		if err != nil {
+			return nil, err
+		}
+		var next []*accountOrganization
+		for _, member := range frontier {
+			for _, result := range results {
+				if result.MemberAccountID != member.AccountID || member.hasInPath(result.UID) || result.UID == account.UID {
+					continue
+				}
+				rights := ttnpb.Rights(result.Rights)
+				organization := &accountOrganization{
+					AccountID:  result.AccountID,
+					Path:       append(append(make([]string, 0, len(member.Path)+1), member.Path...), result.UID),
+					PathRights: append(append(make([]*ttnpb.Rights, 0, len(member.PathRights)+1), member.PathRights...), &rights),
+				}
+				next = append(next, organization)
+			}
+		}
+		organizations = append(organizations, next...)
+		frontier = next
 	}
-	query = query.Joins(fmt.Sprintf(`JOIN "memberships" "direct_memberships" ON "direct_memberships"."entity_id" = "%[1]ss"."id" AND "direct_memberships"."entity_type" = '%[1]s'`, entityType)).
-		Joins(`JOIN "accounts" "direct_accounts" ON "direct_accounts"."id" = "direct_memberships"."account_id"`)
-	query = query.Where(`"direct_accounts"."deleted_at" IS NULL`)
-	return query
+	return organizations, nil
 }
 
-func (s *membershipStore) queryMemberships(ctx context.Context, accountID *ttnpb.OrganizationOrUserIdentifiers, entityType string, entityIDs []string, includeIndirect bool) *gorm.DB {
-	if accountID.EntityType() == "user" && includeIndirect {
-		return s.queryWithIndirectMemberships(ctx, entityType, entityIDs...).Where(
-			`("direct_accounts"."account_type" = 'user' AND "direct_accounts"."uid" = ?) OR ("indirect_accounts"."account_type" = 'user' AND "indirect_accounts"."uid" = ?)`,
-			accountID.IDString(), accountID.IDString(),
-		)
+// findMemberAccounts returns the account and, if includeIndirect is true, the organizations that the account is a
+// direct or nested member of. The returned account is nil if it does not exist.
+func (s *membershipStore) findMemberAccounts(ctx context.Context, accountID *ttnpb.OrganizationOrUserIdentifiers, includeIndirect bool) (*Account, []*accountOrganization, error) {
+	var account Account
+	err := s.query(ctx, Account{}).Where(Account{
+		UID:         accountID.IDString(),
+		AccountType: accountID.EntityType(),
+	}).First(&account).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	if !includeIndirect {
+		return &account, nil, nil
+	}
+	organizations, err := s.findAccountOrganizations(ctx, &account)
+	if err != nil {
+		return nil, nil, err
 	}
-	return s.queryWithDirectMemberships(ctx, entityType, entityIDs...).Where(
-		fmt.Sprintf(`"direct_accounts"."account_type" = '%s' AND "direct_accounts"."uid" = ?`, accountID.EntityType()),
-		accountID.IDString(),
+	return &account, organizations, nil
+}
+
+// queryMembershipEntityIDs returns a query that selects the primary keys of the entities of the given type that
+// the account or any of the given organizations is a direct member of.
+func (s *membershipStore) queryMembershipEntityIDs(ctx context.Context, account *Account, organizations []*accountOrganization, entityType string) *gorm.DB {
+	accountIDs := []string{account.ID}
+	for _, organization := range organizations {
+		accountIDs = append(accountIDs, organization.AccountID)
+	}
+	return s.query(ctx, Membership{}).Select(`"memberships"."entity_id"`).Where(
+		`"memberships"."entity_type" = ? AND "memberships"."account_id" IN (?)`, entityType, accountIDs,
 	)
 }
 
 func (s *membershipStore) FindMemberships(ctx context.Context, accountID *ttnpb.OrganizationOrUserIdentifiers, entityType string, includeIndirect bool) ([]*ttnpb.EntityIdentifiers, error) {
 	defer trace.StartRegion(ctx, fmt.Sprintf("find %s memberships of %s", entityType, accountID.IDString())).End()
 
-	membershipsQuery := s.queryMemberships(ctx, accountID, entityType, nil, includeIndirect).Select(`"direct_memberships"."entity_id"`).QueryExpr()
+	account, organizations, err := s.findMemberAccounts(ctx, accountID, includeIndirect)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		setTotal(ctx, 0)
+		return nil, nil
+	}
+	membershipsQuery := s.queryMembershipEntityIDs(ctx, account, organizations, entityType).QueryExpr()
 	query := s.query(ctx, modelForEntityType(entityType)).Where(fmt.Sprintf(`"%[1]ss"."id" IN (?)`, entityType), membershipsQuery)
 	switch entityType {
 	case "organization":
@@ -138,18 +226,14 @@ func (s *membershipStore) FindMemberships(ctx context.Context, accountID *ttnpb.
 }
 
 type membershipChain struct {
-	IndirectAccountType       string
-	IndirectAccountFriendlyID string
-	IndirectAccountRights     Rights
-	DirectAccountType         string
-	DirectAccountFriendlyID   string
-	DirectAccountRights       Rights
-	EntityType                string
-	EntityFriendlyID          string
+	DirectAccountType       string
+	DirectAccountFriendlyID string
+	DirectAccountRights     Rights
+	EntityType              string
+	EntityFriendlyID        string
 }
 
 func (m membershipChain) GetMembershipChain() *MembershipChain {
-	indirectAccountRights := ttnpb.Rights(m.IndirectAccountRights)
 	directAccountRights := ttnpb.Rights(m.DirectAccountRights)
 	c := &MembershipChain{
 		RightsOnEntity:    &directAccountRights,
@@ -159,30 +243,36 @@ func (m membershipChain) GetMembershipChain() *MembershipChain {
 	case "user":
 		c.UserIdentifiers = &ttnpb.UserIdentifiers{UserId: m.DirectAccountFriendlyID}
 	case "organization":
-		if m.IndirectAccountType == "user" {
-			c.UserIdentifiers = &ttnpb.UserIdentifiers{UserId: m.IndirectAccountFriendlyID}
-			c.RightsOnOrganization = &indirectAccountRights
-		}
 		c.OrganizationIdentifiers = &ttnpb.OrganizationIdentifiers{OrganizationId: m.DirectAccountFriendlyID}
 	}
 	return c
 }
 
-// MembershipChain is a User -> (Membership -> Organization) -> Membership -> Entity chain.
+// MembershipChain is a User -> (Membership -> Organization) -> (Membership -> Parent Organization)* -> Membership ->
+// Entity chain.
 type MembershipChain struct {
 	UserIdentifiers         *ttnpb.UserIdentifiers
 	RightsOnOrganization    *ttnpb.Rights
 	OrganizationIdentifiers *ttnpb.OrganizationIdentifiers
-	RightsOnEntity          *ttnpb.Rights
-	EntityIdentifiers       *ttnpb.EntityIdentifiers
+	// ParentOrganizationIdentifiers are the organizations through which the organization is a nested member of the
+	// entity, from the parent of the organization to the organization that is a direct member of the entity.
+	ParentOrganizationIdentifiers []*ttnpb.OrganizationIdentifiers
+	// RightsOnParentOrganizations are the intersected rights of the organization on its parent organizations.
+	RightsOnParentOrganizations *ttnpb.Rights
+	RightsOnEntity              *ttnpb.Rights
+	EntityIdentifiers           *ttnpb.EntityIdentifiers
 }
 
 // GetRights returns the intersected rights.
 func (m *MembershipChain) GetRights() *ttnpb.Rights {
-	if m.RightsOnOrganization == nil {
-		return m.RightsOnEntity.Implied()
+	rights := m.RightsOnEntity.Implied()
+	if m.RightsOnOrganization != nil {
+		rights = rights.Intersect(m.RightsOnOrganization.Implied())
+	}
+	if m.RightsOnParentOrganizations != nil {
+		rights = rights.Intersect(m.RightsOnParentOrganizations.Implied())
 	}
-	return m.RightsOnEntity.Implied().Intersect(m.RightsOnOrganization.Implied())
+	return rights
 }
 
 // MembershipChains is a list of membership chains.
@@ -212,16 +302,67 @@ func (c MembershipChains) GetRights(member *ttnpb.OrganizationOrUserIdentifiers,
 	return entityRights
 }
 
+// buildMembershipChain builds the chain of the account through the organization path to the direct membership of
+// the last organization in the path on the entity.
+func buildMembershipChain(account *Account, path *accountOrganization, membership membershipChain) *MembershipChain {
+	c := membership.GetMembershipChain()
+	if path == nil {
+		return c
+	}
+	parents := path.Path
+	parentRights := path.PathRights
+	if account.AccountType == "user" {
+		c.UserIdentifiers = &ttnpb.UserIdentifiers{UserId: account.UID}
+		c.RightsOnOrganization = path.PathRights[0]
+		c.OrganizationIdentifiers = &ttnpb.OrganizationIdentifiers{OrganizationId: path.Path[0]}
+		parents, parentRights = parents[1:], parentRights[1:]
+	} else {
+		c.OrganizationIdentifiers = &ttnpb.OrganizationIdentifiers{OrganizationId: account.UID}
+	}
+	if len(parents) > 0 {
+		c.ParentOrganizationIdentifiers = make([]*ttnpb.OrganizationIdentifiers, len(parents))
+		for i, id := range parents {
+			c.ParentOrganizationIdentifiers[i] = &ttnpb.OrganizationIdentifiers{OrganizationId: id}
+		}
+		c.RightsOnParentOrganizations = parentRights[0].Implied()
+		for _, rights := range parentRights[1:] {
+			c.RightsOnParentOrganizations = c.RightsOnParentOrganizations.Intersect(rights.Implied())
+		}
+	}
+	return c
+}
+
 func (s *membershipStore) FindAccountMembershipChains(ctx context.Context, accountID *ttnpb.OrganizationOrUserIdentifiers, entityType string, entityIDs ...string) ([]*MembershipChain, error) {
 	defer trace.StartRegion(ctx, fmt.Sprintf("find membership chains of user on %ss", entityType)).End()
-	query := s.queryMemberships(ctx, accountID, entityType, entityIDs, true)
+	account, organizations, err := s.findMemberAccounts(ctx, accountID, true)
+	if err != nil || account == nil {
+		return nil, err
+	}
+	accountIDs := []string{account.ID}
+	paths := make(map[string][]*accountOrganization, len(organizations))
+	for _, organization := range organizations {
+		if _, ok := paths[organization.OrganizationID()]; !ok {
+			accountIDs = append(accountIDs, organization.AccountID)
+		}
+		paths[organization.OrganizationID()] = append(paths[organization.OrganizationID()], organization)
+	}
+	query := s.queryWithDirectMemberships(ctx, entityType, entityIDs...).Where(`"direct_accounts"."id" IN (?)`, accountIDs)
 	var results []membershipChain
 	if err := query.Scan(&results).Error; err != nil {
 		return nil, err
 	}
-	chains := make([]*MembershipChain, len(results))
-	for i, result := range results {
-		chains[i] = result.GetMembershipChain()
+	var chains []*MembershipChain
+	for _, result := range results {
+		if result.DirectAccountType == account.AccountType && result.DirectAccountFriendlyID == account.UID {
+			chains = append(chains, buildMembershipChain(account, nil, result))
+			continue
+		}
+		if result.DirectAccountType != "organization" {
+			continue
+		}
+		for _, path := range paths[result.DirectAccountFriendlyID] {
+			chains = append(chains, buildMembershipChain(account, path, result))
+		}
 	}
 	return chains, nil
 }
@@ -282,11 +423,90 @@ func (s *membershipStore) GetMember(ctx context.Context, id *ttnpb.OrganizationO
 	return results[0].GetMembershipChain().RightsOnEntity, nil
 }
 
-var errAccountType = errors.DefineInvalidArgument(
-	"account_type",
-	"account of type `{account_type}` can not collaborate on `{entity_type}`",
+var (
+	errOrganizationCycle = errors.DefineFailedPrecondition(
+		"organization_cycle",
+		"organization `{organization_id}` can not be a member of organization `{parent_organization_id}` because it is an ancestor of it",
+	)
+	errOrganizationDepth = errors.DefineFailedPrecondition(
+		"organization_depth",
+		"organization `{organization_id}` can not be a member of organization `{parent_organization_id}` because organizations can be nested at most `{max_depth}` deep",
+	)
 )
 
+// findDescendantsDepth returns the number of levels of organizations that are nested members of the organization.
+func (s *membershipStore) findDescendantsDepth(ctx context.Context, organizationID string) (int, error) {
+	frontier := []string{organizationID}
+	seen := map[string]bool{organizationID: true}
+	for depth := 0; depth <= maxOrganizationDepth; depth++ {
+		var results []struct {
+			ID string
+		}
+		err := s.query(ctx, Membership{}).Select(`"accounts"."account_id" "id"`).
+			Joins(`JOIN "accounts" ON "accounts"."id" = "memberships"."account_id"`).
+			Where(`"memberships"."entity_type" = 'organization' AND "memberships"."entity_id" IN (?)`, frontier).
+			Where(`"accounts"."account_type" = 'organization' AND "accounts"."deleted_at" IS NULL`).
+			Scan(&results).Error
+		if err != nil {
+			return 0, err
+		}
+		frontier = frontier[:0]
+		for _, result := range results {
+			if !seen[result.ID] {
+				seen[result.ID] = true
+				frontier = append(frontier, result.ID)
+			}
+		}
+		if len(frontier) == 0 {
+			return depth, nil
+		}
+	}
+	return maxOrganizationDepth + 1, nil
+}
+
+// checkOrganizationNesting checks that making the account a member of the organization does not result in a cycle,
+// or in a hierarchy of organizations that is deeper than maxOrganizationDepth.
+func (s *membershipStore) checkOrganizationNesting(ctx context.Context, account *Account, organization *Organization) error {
+	var organizationAccount Account
+	err := s.query(ctx, Account{}).Where(Account{
+		AccountID:   organization.PrimaryKey(),
+		AccountType: "organization",
+	}).First(&organizationAccount).Error
+	if err != nil {
+		return err
+	}
+	errAttributes := []interface{}{
+		"organization_id", account.UID,
+		"parent_organization_id", organizationAccount.UID,
+		"max_depth", maxOrganizationDepth,
+	}
+	if account.ID == organizationAccount.ID {
+		return errOrganizationCycle.WithAttributes(errAttributes...)
+	}
+	ancestors, err := s.findAccountOrganizationsUncached(ctx, &organizationAccount)
+	if err != nil {
+		return err
+	}
+	ancestorsDepth := 0
+	for _, ancestor := range ancestors {
+		if ancestor.OrganizationID() == account.UID {
+			return errOrganizationCycle.WithAttributes(errAttributes...)
+		}
+		if len(ancestor.Path) > ancestorsDepth {
+			ancestorsDepth = len(ancestor.Path)
+		}
+	}
+	descendantsDepth, err := s.findDescendantsDepth(ctx, account.AccountID)
+	if err != nil {
+		return err
+	}
+	// The hierarchy consists of the descendants of the account, the account itself, the organization and its ancestors.
+	if descendantsDepth+2+ancestorsDepth > maxOrganizationDepth {
+		return errOrganizationDepth.WithAttributes(errAttributes...)
+	}
+	return nil
+}
+
 func (s *membershipStore) SetMember(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID *ttnpb.EntityIdentifiers, rights *ttnpb.Rights) error {
 	defer trace.StartRegion(ctx, "update membership").End()
 
@@ -309,8 +529,10 @@ func (s *membershipStore) SetMember(ctx context.Context, id *ttnpb.OrganizationO
 	if err != nil {
 		return err
 	}
-	if _, ok := entity.(*Organization); ok && account.AccountType != "user" {
-		return errAccountType.WithAttributes("account_type", account.AccountType, "entity_type", "organization")
+	if organization, ok := entity.(*Organization); ok && account.AccountType == "organization" && len(rights.Rights) > 0 {
+		if err := s.checkOrganizationNesting(ctx, &account, organization); err != nil {
+			return err
+		}
 	}
 	if err := ctx.Err(); err != nil { // Early exit if context canceled
 		return err
@@ -15,6 +15,7 @@
 package store
 
 import (
+	"fmt"
 	"os"
 	"testing"
 	"time"
@@ -91,6 +92,95 @@ func TestFindIndirectMemberships(t *testing.T) {
 	})
 }
 
+func TestNestedOrganizationMemberships(t *testing.T) {
+	a, ctx := test.New(t)
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		s := newStore(db)
+		store := GetMembershipStore(db)
+
+		prepareTest(db,
+			&Membership{},
+			&Account{}, &User{}, &Organization{},
+			&Application{},
+		)
+
+		if os.Getenv("TEST_REDIS") == "1" {
+			redis, flush := test.NewRedis(ctx, "is_nested_memberships")
+			defer flush()
+			store = GetMembershipCache(store, redis, time.Minute)
+		}
+
+		usr := &User{Account: Account{UID: "test-user"}}
+		s.createEntity(ctx, usr)
+		usrIDs := usr.Account.OrganizationOrUserIdentifiers()
+		orgIDs := make([]*ttnpb.OrganizationIdentifiers, maxOrganizationDepth+1)
+		for i := range orgIDs {
+			org := &Organization{Account: Account{UID: fmt.Sprintf("test-org-%d", i)}}
+			s.createEntity(ctx, org)
+			orgIDs[i] = &ttnpb.OrganizationIdentifiers{OrganizationId: org.Account.UID}
+		}
+		s.createEntity(ctx, &Application{ApplicationID: "test-app"})
+		appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+
+		// test-user -> test-org-0 (team) -> test-org-1 (department) -> test-app
+		err := store.SetMember(ctx, usrIDs, orgIDs[0].GetEntityIdentifiers(), ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_ALL, ttnpb.RIGHT_ORGANIZATION_ALL))
+		a.So(err, should.BeNil)
+		err = store.SetMember(ctx, orgIDs[0].GetOrganizationOrUserIdentifiers(), orgIDs[1].GetEntityIdentifiers(), ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_INFO, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ))
+		a.So(err, should.BeNil)
+		err = store.SetMember(ctx, orgIDs[1].GetOrganizationOrUserIdentifiers(), appIDs.GetEntityIdentifiers(), ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_INFO, ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC))
+		a.So(err, should.BeNil)
+
+		memberships, err := store.FindMemberships(ctx, usrIDs, "application", true)
+		if a.So(err, should.BeNil) && a.So(memberships, should.HaveLength, 1) {
+			a.So(memberships[0], should.Resemble, appIDs.GetEntityIdentifiers())
+		}
+
+		memberships, err = store.FindMemberships(ctx, usrIDs, "application", false)
+		if a.So(err, should.BeNil) {
+			a.So(memberships, should.BeEmpty)
+		}
+
+		chains, err := store.FindAccountMembershipChains(ctx, usrIDs, "application", "test-app")
+		if a.So(err, should.BeNil) && a.So(chains, should.HaveLength, 1) {
+			a.So(chains[0].OrganizationIdentifiers, should.Resemble, orgIDs[0])
+			a.So(chains[0].ParentOrganizationIdentifiers, should.Resemble, []*ttnpb.OrganizationIdentifiers{orgIDs[1]})
+			a.So(chains[0].GetRights().GetRights(), should.Resemble, []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO})
+			a.So(MembershipChains(chains).GetRights(usrIDs, appIDs).GetRights(), should.Resemble, []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO})
+		}
+
+		chains, err = store.FindAccountMembershipChains(ctx, orgIDs[0].GetOrganizationOrUserIdentifiers(), "application", "test-app")
+		if a.So(err, should.BeNil) && a.So(chains, should.HaveLength, 1) {
+			a.So(chains[0].GetRights().GetRights(), should.Resemble, []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO})
+		}
+
+		// test-org-1 can not become a member of test-org-0, since test-org-0 is a member of test-org-1.
+		err = store.SetMember(ctx, orgIDs[1].GetOrganizationOrUserIdentifiers(), orgIDs[0].GetEntityIdentifiers(), ttnpb.RightsFrom(ttnpb.RIGHT_ORGANIZATION_ALL))
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+		}
+
+		// Nest the organizations up to the maximum depth.
+		for i := 2; i < maxOrganizationDepth; i++ {
+			err = store.SetMember(ctx, orgIDs[i-1].GetOrganizationOrUserIdentifiers(), orgIDs[i].GetEntityIdentifiers(), ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_INFO))
+			a.So(err, should.BeNil)
+		}
+		err = store.SetMember(ctx, orgIDs[maxOrganizationDepth-1].GetOrganizationOrUserIdentifiers(), orgIDs[maxOrganizationDepth].GetEntityIdentifiers(), ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_INFO))
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+		}
+
+		// Removing the department removes the inherited rights.
+		err = store.SetMember(ctx, orgIDs[0].GetOrganizationOrUserIdentifiers(), orgIDs[1].GetEntityIdentifiers(), ttnpb.RightsFrom())
+		a.So(err, should.BeNil)
+
+		memberships, err = store.FindMemberships(ctx, usrIDs, "application", true)
+		if a.So(err, should.BeNil) {
+			a.So(memberships, should.BeEmpty)
+		}
+	})
+}
+
 func TestMembershipStore(t *testing.T) {
 	WithDB(t, func(t *testing.T, db *gorm.DB) {
 		_, ctx := test.New(t)
@@ -306,15 +396,28 @@ func TestMembershipStore(t *testing.T) {
 		t.Run("Organization-Organization", func(t *testing.T) {
 			a := assertions.New(t)
 
-			err := store.SetMember(ctx,
-				orgIDs,
-				(&ttnpb.OrganizationIdentifiers{OrganizationId: "other-org"}).GetEntityIdentifiers(),
-				ttnpb.RightsFrom([]ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_ALL}...),
-			)
+			testOrgIDs := &ttnpb.OrganizationIdentifiers{OrganizationId: "test-org"}
+			otherOrgIDs := &ttnpb.OrganizationIdentifiers{OrganizationId: "other-org"}
+
+			err := store.SetMember(ctx, orgIDs, otherOrgIDs.GetEntityIdentifiers(), ttnpb.RightsFrom(ttnpb.RIGHT_ORGANIZATION_ALL))
+
+			a.So(err, should.BeNil)
+
+			err = store.SetMember(ctx, orgIDs, testOrgIDs.GetEntityIdentifiers(), ttnpb.RightsFrom(ttnpb.RIGHT_ORGANIZATION_ALL))
 
 			if a.So(err, should.NotBeNil) {
-				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+				a.So(errors.IsFailedPrecondition(err), should.BeTrue)
 			}
+
+			err = store.SetMember(ctx, otherOrgIDs.GetOrganizationOrUserIdentifiers(), testOrgIDs.GetEntityIdentifiers(), ttnpb.RightsFrom(ttnpb.RIGHT_ORGANIZATION_ALL))
+
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+			}
+
+			err = store.SetMember(ctx, orgIDs, otherOrgIDs.GetEntityIdentifiers(), ttnpb.RightsFrom())
+
+			a.So(err, should.BeNil)
 		})
 
 		userNotFoundIDs := ttnpb.UserIdentifiers{UserId: "test-usr-not-found"}.OrganizationOrUserIdentifiers()
@@ -32,6 +32,27 @@ type Migration interface {
 	Rollback(context.Context, *gorm.DB) error
 }
 
+// DialectMigration is a Migration that only applies to some kinds of databases.
+// On other kinds of databases, the migration is recorded as applied without applying its changes.
+type DialectMigration interface {
+	Migration
+	// Dialects returns the kinds of databases that the migration applies to, as returned by store.DatabaseKind.
+	Dialects() []string
+}
+
+func appliesTo(migration Migration, dbKind string) bool {
+	dialectMigration, ok := migration.(DialectMigration)
+	if !ok {
+		return true
+	}
+	for _, dialect := range dialectMigration.Dialects() {
+		if dialect == dbKind {
+			return true
+		}
+	}
+	return false
+}
+
 // Apply applies the list of migrations on the database connection.
 func Apply(ctx context.Context, transact func(context.Context, func(*gorm.DB) error) error, migrations ...Migration) error {
 	applyMigration := func(db *gorm.DB, migration Migration) error {
@@ -41,8 +62,10 @@ func Apply(ctx context.Context, transact func(context.Context, func(*gorm.DB) er
 		} else if err == nil {
 			return nil
 		}
-		if err := migration.Apply(ctx, db); err != nil {
-			return err
+		if appliesTo(migration, store.DatabaseKind(db)) {
+			if err := migration.Apply(ctx, db); err != nil {
+				return err
+			}
 		}
 		return migrationStore.CreateMigration(ctx, &store.Migration{
 			Name: migration.Name(),
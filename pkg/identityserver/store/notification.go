@@ -0,0 +1,60 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import "github.com/lib/pq"
+
+// Statuses of notifications.
+const (
+	NotificationStatusUnread   = "unread"
+	NotificationStatusRead     = "read"
+	NotificationStatusArchived = "archived"
+)
+
+// Notification is a notification in the inbox of a user.
+// The UpdatedAt time of the notification is the time of the last change of its status.
+type Notification struct {
+	Model
+
+	Receiver   *User
+	ReceiverID string `gorm:"type:UUID;index:notification_receiver_index;not null"`
+
+	// NotificationType is the name of the email template of the notification, such as api_key_created.
+	NotificationType string `gorm:"type:VARCHAR;not null"`
+
+	EntityType string `gorm:"type:VARCHAR(32)"`
+	EntityID   string `gorm:"type:VARCHAR"`
+
+	Subject string `gorm:"type:VARCHAR"`
+	Body    string `gorm:"type:VARCHAR"`
+
+	Status string `gorm:"type:VARCHAR(32);index:notification_receiver_index;not null"`
+}
+
+// NotificationEmailPreferences are the preferences of a user for receiving notifications by email.
+type NotificationEmailPreferences struct {
+	Model
+
+	User   *User
+	UserID string `gorm:"type:UUID;unique_index:notification_email_preferences_user_index;not null"`
+
+	// DisabledTypes are the types of notifications that the user does not want to receive by email.
+	DisabledTypes pq.StringArray `gorm:"type:VARCHAR ARRAY;column:disabled_types"`
+}
+
+func init() {
+	registerModel(&Notification{})
+	registerModel(&NotificationEmailPreferences{})
+}
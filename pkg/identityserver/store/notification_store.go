@@ -0,0 +1,167 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// GetNotificationStore returns a NotificationStore on the given db (or transaction).
+func GetNotificationStore(db *gorm.DB) NotificationStore {
+	return &notificationStore{store: newStore(db)}
+}
+
+type notificationStore struct {
+	*store
+}
+
+func (s *notificationStore) CreateNotification(ctx context.Context, receiverIDs *ttnpb.UserIdentifiers, notification *Notification) (*Notification, error) {
+	defer trace.StartRegion(ctx, "create notification").End()
+	user, err := s.findEntity(ctx, receiverIDs, "id")
+	if err != nil {
+		return nil, err
+	}
+	notificationModel := &Notification{
+		ReceiverID:       user.PrimaryKey(),
+		NotificationType: notification.NotificationType,
+		EntityType:       notification.EntityType,
+		EntityID:         notification.EntityID,
+		Subject:          notification.Subject,
+		Body:             notification.Body,
+		Status:           NotificationStatusUnread,
+	}
+	if err = s.createEntity(ctx, notificationModel); err != nil {
+		return nil, convertError(err)
+	}
+	return notificationModel, nil
+}
+
+var errNotificationNotFound = errors.DefineNotFound("notification_not_found", "notification `{notification_id}` not found")
+
+func (s *notificationStore) GetNotification(ctx context.Context, receiverIDs *ttnpb.UserIdentifiers, id string) (*Notification, error) {
+	defer trace.StartRegion(ctx, "get notification").End()
+	user, err := s.findEntity(ctx, receiverIDs, "id")
+	if err != nil {
+		return nil, err
+	}
+	var notificationModel Notification
+	err = s.query(ctx, Notification{}).Where(Notification{Model: Model{ID: id}, ReceiverID: user.PrimaryKey()}).First(&notificationModel).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errNotificationNotFound.WithAttributes("notification_id", id)
+		}
+		return nil, convertError(err)
+	}
+	return &notificationModel, nil
+}
+
+func (s *notificationStore) ListNotifications(ctx context.Context, receiverIDs *ttnpb.UserIdentifiers, statuses ...string) ([]*Notification, error) {
+	defer trace.StartRegion(ctx, "list notifications").End()
+	user, err := s.findEntity(ctx, receiverIDs, "id")
+	if err != nil {
+		return nil, err
+	}
+	query := s.query(ctx, Notification{}).Where(Notification{ReceiverID: user.PrimaryKey()})
+	if len(statuses) > 0 {
+		query = query.Where("status IN (?)", statuses)
+	}
+	query = query.Order(orderFromContext(ctx, "notifications", "created_at", "DESC"))
+	if limit, offset := limitAndOffsetFromContext(ctx); limit != 0 {
+		countTotal(ctx, query.Model(&Notification{}))
+		query = query.Limit(limit).Offset(offset)
+	}
+	var notifications []*Notification
+	if err := query.Find(&notifications).Error; err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+func (s *notificationStore) UpdateNotificationStatus(ctx context.Context, receiverIDs *ttnpb.UserIdentifiers, ids []string, status string) error {
+	defer trace.StartRegion(ctx, "update notification status").End()
+	user, err := s.findEntity(ctx, receiverIDs, "id")
+	if err != nil {
+		return err
+	}
+	return s.query(ctx, Notification{}).
+		Where(Notification{ReceiverID: user.PrimaryKey()}).
+		Where("id IN (?)", ids).
+		Updates(map[string]interface{}{"status": status, "updated_at": gorm.NowFunc()}).Error
+}
+
+func (s *notificationStore) DeleteUserNotifications(ctx context.Context, receiverIDs *ttnpb.UserIdentifiers) error {
+	defer trace.StartRegion(ctx, "delete user notifications").End()
+	user, err := s.findDeletedEntity(ctx, receiverIDs, "id")
+	if err != nil {
+		return err
+	}
+	err = s.query(ctx, Notification{}).Where(Notification{ReceiverID: user.PrimaryKey()}).Delete(&Notification{}).Error
+	if err != nil {
+		return err
+	}
+	return s.query(ctx, NotificationEmailPreferences{}).Where(NotificationEmailPreferences{UserID: user.PrimaryKey()}).Delete(&NotificationEmailPreferences{}).Error
+}
+
+func (s *notificationStore) findEmailPreferences(ctx context.Context, userID string) (*NotificationEmailPreferences, error) {
+	var preferencesModel NotificationEmailPreferences
+	err := s.query(ctx, NotificationEmailPreferences{}).Where(NotificationEmailPreferences{UserID: userID}).First(&preferencesModel).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &preferencesModel, nil
+}
+
+func (s *notificationStore) GetDisabledEmailNotifications(ctx context.Context, userIDs *ttnpb.UserIdentifiers) ([]string, error) {
+	defer trace.StartRegion(ctx, "get disabled email notifications").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return nil, err
+	}
+	preferencesModel, err := s.findEmailPreferences(ctx, user.PrimaryKey())
+	if err != nil || preferencesModel == nil {
+		return nil, err
+	}
+	return preferencesModel.DisabledTypes, nil
+}
+
+func (s *notificationStore) SetDisabledEmailNotifications(ctx context.Context, userIDs *ttnpb.UserIdentifiers, notificationTypes []string) error {
+	defer trace.StartRegion(ctx, "set disabled email notifications").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return err
+	}
+	preferencesModel, err := s.findEmailPreferences(ctx, user.PrimaryKey())
+	if err != nil {
+		return err
+	}
+	if preferencesModel == nil {
+		preferencesModel = &NotificationEmailPreferences{
+			UserID:        user.PrimaryKey(),
+			DisabledTypes: pq.StringArray(notificationTypes),
+		}
+		return convertError(s.createEntity(ctx, preferencesModel))
+	}
+	preferencesModel.DisabledTypes = pq.StringArray(notificationTypes)
+	return s.updateEntity(ctx, preferencesModel, "disabled_types")
+}
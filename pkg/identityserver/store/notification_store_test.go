@@ -0,0 +1,124 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+)
+
+func TestNotificationStore(t *testing.T) {
+	a, ctx := test.New(t)
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		s := newStore(db)
+		store := GetNotificationStore(db)
+
+		prepareTest(db,
+			&Account{}, &User{},
+			&Notification{}, &NotificationEmailPreferences{},
+		)
+
+		usr := &User{Account: Account{UID: "notification-test-user"}}
+		s.createEntity(ctx, usr)
+		userIDs := &ttnpb.UserIdentifiers{UserId: usr.Account.UID}
+
+		_, err := store.CreateNotification(ctx, &ttnpb.UserIdentifiers{UserId: "unknown-user"}, &Notification{
+			NotificationType: "api_key_created",
+		})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		first, err := store.CreateNotification(ctx, userIDs, &Notification{
+			NotificationType: "api_key_created",
+			EntityType:       "application",
+			EntityID:         "foo-app",
+			Subject:          "An API key has been created",
+			Body:             "Dear user",
+		})
+		if a.So(err, should.BeNil) && a.So(first, should.NotBeNil) {
+			a.So(first.ID, should.NotBeEmpty)
+			a.So(first.Status, should.Equal, NotificationStatusUnread)
+		}
+
+		second, err := store.CreateNotification(ctx, userIDs, &Notification{
+			NotificationType: "collaborator_changed",
+		})
+		a.So(err, should.BeNil)
+
+		got, err := store.GetNotification(ctx, userIDs, first.ID)
+		if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+			a.So(got.NotificationType, should.Equal, "api_key_created")
+			a.So(got.Body, should.Equal, "Dear user")
+		}
+
+		_, err = store.GetNotification(ctx, &ttnpb.UserIdentifiers{UserId: "unknown-user"}, first.ID)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		notifications, err := store.ListNotifications(ctx, userIDs)
+		if a.So(err, should.BeNil) && a.So(notifications, should.HaveLength, 2) {
+			a.So(notifications[0].EntityID+notifications[1].EntityID, should.Equal, "foo-app")
+		}
+
+		err = store.UpdateNotificationStatus(ctx, userIDs, []string{first.ID}, NotificationStatusArchived)
+		a.So(err, should.BeNil)
+
+		notifications, err = store.ListNotifications(ctx, userIDs, NotificationStatusUnread, NotificationStatusRead)
+		if a.So(err, should.BeNil) && a.So(notifications, should.HaveLength, 1) {
+			a.So(notifications[0].ID, should.Equal, second.ID)
+		}
+
+		notifications, err = store.ListNotifications(ctx, userIDs, NotificationStatusArchived)
+		if a.So(err, should.BeNil) && a.So(notifications, should.HaveLength, 1) {
+			a.So(notifications[0].ID, should.Equal, first.ID)
+			a.So(notifications[0].Subject, should.Equal, "An API key has been created")
+		}
+
+		disabled, err := store.GetDisabledEmailNotifications(ctx, userIDs)
+		if a.So(err, should.BeNil) {
+			a.So(disabled, should.BeEmpty)
+		}
+
+		err = store.SetDisabledEmailNotifications(ctx, userIDs, []string{"api_key_created", "api_key_changed"})
+		a.So(err, should.BeNil)
+		err = store.SetDisabledEmailNotifications(ctx, userIDs, []string{"collaborator_changed"})
+		a.So(err, should.BeNil)
+
+		disabled, err = store.GetDisabledEmailNotifications(ctx, userIDs)
+		if a.So(err, should.BeNil) {
+			a.So(disabled, should.Resemble, []string{"collaborator_changed"})
+		}
+
+		err = store.DeleteUserNotifications(ctx, userIDs)
+		a.So(err, should.BeNil)
+
+		notifications, err = store.ListNotifications(ctx, userIDs)
+		if a.So(err, should.BeNil) {
+			a.So(notifications, should.BeEmpty)
+		}
+		disabled, err = store.GetDisabledEmailNotifications(ctx, userIDs)
+		if a.So(err, should.BeNil) {
+			a.So(disabled, should.BeEmpty)
+		}
+	})
+}
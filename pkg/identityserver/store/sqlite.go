@@ -0,0 +1,167 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"database/sql"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	uuid "github.com/satori/go.uuid"
+	_ "modernc.org/sqlite" // SQLite driver.
+)
+
+const sqliteDialectName = "ttn_sqlite3"
+
+// sqliteDialect is the gorm SQLite dialect without the PostgreSQL column defaults of the models.
+type sqliteDialect struct {
+	gorm.Dialect
+}
+
+var sqliteDefaultRegex = regexp.MustCompile(`(?i)\s*DEFAULT gen_random_uuid\(\)`)
+
+// SetDB implements gorm.Dialect.
+// gorm instantiates a new dialect for every connection, so this also instantiates the embedded SQLite dialect.
+func (d *sqliteDialect) SetDB(db gorm.SQLCommon) {
+	prototype, _ := gorm.GetDialect("sqlite3")
+	d.Dialect = reflect.New(reflect.TypeOf(prototype).Elem()).Interface().(gorm.Dialect)
+	d.Dialect.SetDB(db)
+}
+
+// GetName implements gorm.Dialect.
+// gorm instantiates the dialect of cloned connections by name, so this must return the name of this dialect.
+func (*sqliteDialect) GetName() string {
+	return sqliteDialectName
+}
+
+// DataTypeOf implements gorm.Dialect.
+func (d *sqliteDialect) DataTypeOf(field *gorm.StructField) string {
+	return sqliteDefaultRegex.ReplaceAllString(d.Dialect.DataTypeOf(field), "")
+}
+
+func init() {
+	gorm.RegisterDialect(sqliteDialectName, &sqliteDialect{})
+}
+
+// isSQLite returns whether the database is SQLite.
+func isSQLite(db *gorm.DB) bool {
+	return DatabaseKind(db) == "SQLite"
+}
+
+// ilike returns the case insensitive LIKE operator of the database.
+// The LIKE operator of SQLite is case insensitive for ASCII characters.
+func ilike(db *gorm.DB) string {
+	if isSQLite(db) {
+		return "LIKE"
+	}
+	return "ILIKE"
+}
+
+// generateUUIDs generates the UUID primary keys of models, since SQLite has no gen_random_uuid().
+func generateUUIDs(scope *gorm.Scope) {
+	if scope.Dialect().GetName() != sqliteDialectName {
+		return
+	}
+	field, ok := scope.FieldByName("ID")
+	if !ok || !field.IsPrimaryKey || !field.IsBlank || field.Field.Kind() != reflect.String {
+		return
+	}
+	field.Set(uuid.NewV4().String())
+}
+
+func init() {
+	gorm.DefaultCallback.Create().Before("gorm:create").Register("ttn:generate_uuids", generateUUIDs)
+}
+
+// openSQLite opens the SQLite database at the path of a sqlite:// DSN. The query parameters of the DSN are passed
+// to the driver, with a busy timeout, write-ahead logging and immediate transactions by default, so that concurrent
+// requests wait for each other instead of failing. Transactions take the write lock when they begin, since
+// transactions that read before they write can not wait for the lock once another transaction writes.
+// The driver is implemented in Go, so that SQLite is also supported in binaries that are built without cgo.
+func openSQLite(dsn string) (*gorm.DB, error) {
+	path := strings.TrimPrefix(strings.TrimPrefix(dsn, "sqlite3://"), "sqlite://")
+	var query url.Values
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		var err error
+		if query, err = url.ParseQuery(path[i+1:]); err != nil {
+			return nil, err
+		}
+		path = path[:i]
+	} else {
+		query = make(url.Values)
+	}
+	if !hasSQLitePragma(query, "busy_timeout") {
+		query.Add("_pragma", "busy_timeout(10000)")
+	}
+	if !hasSQLitePragma(query, "journal_mode") && query.Get("mode") != "memory" {
+		query.Add("_pragma", "journal_mode(WAL)")
+	}
+	if query.Get("_txlock") == "" {
+		query.Set("_txlock", "immediate")
+	}
+	if query.Get("_time_format") == "" {
+		query.Set("_time_format", "sqlite")
+	}
+	sqlDB, err := sql.Open("sqlite", "file:"+path+"?"+query.Encode())
+	if err != nil {
+		return nil, err
+	}
+	db, err := gorm.Open(sqliteDialectName, sqlDB)
+	if err != nil {
+		sqlDB.Close()
+		return nil, err
+	}
+	db = db.Set("db:name", path)
+	db = db.Set("db:kind", "SQLite")
+	return db, nil
+}
+
+// hasSQLitePragma returns whether the query parameters of the DSN set the pragma.
+func hasSQLitePragma(query url.Values, name string) bool {
+	for _, pragma := range query["_pragma"] {
+		if strings.HasPrefix(strings.ToLower(strings.TrimSpace(pragma)), name) {
+			return true
+		}
+	}
+	return false
+}
+
+var sqliteUniqueViolationRegex = regexp.MustCompile(`UNIQUE constraint failed: (.+?)(?: \(\d+\))?$`)
+
+// convertSQLiteError converts unique constraint violations of SQLite, and returns nil for other errors.
+// SQLite reports the columns instead of the name of the index, so the columns are matched against the naming
+// convention of the indexes.
+func convertSQLiteError(err error) error {
+	match := sqliteUniqueViolationRegex.FindStringSubmatch(err.Error())
+	if match == nil {
+		return nil
+	}
+	columns := strings.Split(match[1], ", ")
+	column := columns[len(columns)-1]
+	if i := strings.IndexByte(column, '.'); i >= 0 {
+		column = column[i+1:]
+	}
+	switch {
+	case column == "uid", strings.HasSuffix(column, "_id"):
+		return ErrIDTaken.WithCause(err)
+	case strings.HasSuffix(column, "_eui"):
+		return ErrEUITaken.WithCause(err)
+	default:
+		return errAlreadyExists.WithCause(err).WithAttributes("index", match[1])
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+)
+
+func TestSQLiteConcurrentTransactions(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	db, err := Open(ctx, fmt.Sprintf("sqlite://%s", filepath.Join(t.TempDir(), "concurrent.db")))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer db.Close()
+	a.So(DatabaseKind(db), should.Equal, "SQLite")
+	a.So(db.Exec("CREATE TABLE counters (id INTEGER PRIMARY KEY, value INTEGER NOT NULL)").Error, should.BeNil)
+	a.So(db.Exec("INSERT INTO counters (id, value) VALUES (1, 0)").Error, should.BeNil)
+
+	// The transactions read before they write, which fails when two deferred transactions upgrade their locks.
+	const n = 16
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = Transact(ctx, db, func(db *gorm.DB) error {
+				var value int
+				if err := db.Raw("SELECT value FROM counters WHERE id = 1").Row().Scan(&value); err != nil {
+					return err
+				}
+				time.Sleep(test.Delay)
+				return db.Exec("UPDATE counters SET value = ? WHERE id = 1", value+1).Error
+			})
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		a.So(err, should.BeNil)
+	}
+
+	var value int
+	a.So(db.Raw("SELECT value FROM counters WHERE id = 1").Row().Scan(&value), should.BeNil)
+	a.So(value, should.Equal, n)
+}
@@ -167,11 +167,30 @@ func convertError(err error) error {
 			return errDatabase.WithCause(err).WithAttributes("code", pqErr.Code.Name())
 		}
 	}
+	if sqliteErr := convertSQLiteError(err); sqliteErr != nil {
+		return sqliteErr
+	}
 	return errDatabase.WithCause(err)
 }
 
 // Open opens a new database connection.
+// The DSN is a PostgreSQL connection URI, which is also used for CockroachDB, or a sqlite:// URI with the path to
+// the SQLite database file.
 func Open(ctx context.Context, dsn string) (*gorm.DB, error) {
+	if strings.HasPrefix(dsn, "sqlite://") || strings.HasPrefix(dsn, "sqlite3://") {
+		db, err := openSQLite(dsn)
+		if err != nil {
+			return nil, err
+		}
+		var dbVersion string
+		if err = db.Raw("SELECT sqlite_version()").Row().Scan(&dbVersion); err != nil {
+			db.Close()
+			return nil, err
+		}
+		db = db.Set("db:version", "SQLite "+dbVersion)
+		SetLogger(db, log.FromContext(ctx))
+		return db, nil
+	}
 	dbURI, err := url.Parse(dsn)
 	if err != nil {
 		return nil, err
@@ -198,6 +217,17 @@ func Open(ctx context.Context, dsn string) (*gorm.DB, error) {
 	return db, nil
 }
 
+// DatabaseKind returns the kind of the database: PostgreSQL, CockroachDB or SQLite.
+// It returns an empty string if the kind of the database is unknown.
+func DatabaseKind(db *gorm.DB) string {
+	if dbKind, ok := db.Get("db:kind"); ok {
+		if dbKind, ok := dbKind.(string); ok {
+			return dbKind
+		}
+	}
+	return ""
+}
+
 // Initialize initializes the database.
 func Initialize(db *gorm.DB) error {
 	if dbKind, ok := db.Get("db:kind"); ok {
@@ -16,6 +16,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -62,6 +63,8 @@ type EndDeviceStore interface {
 	GetEndDevice(ctx context.Context, id *ttnpb.EndDeviceIdentifiers, fieldMask *pbtypes.FieldMask) (*ttnpb.EndDevice, error)
 	UpdateEndDevice(ctx context.Context, dev *ttnpb.EndDevice, fieldMask *pbtypes.FieldMask) (*ttnpb.EndDevice, error)
 	DeleteEndDevice(ctx context.Context, id *ttnpb.EndDeviceIdentifiers) error
+	// Find the IDs of the end devices of the application that have all the given attributes.
+	FindEndDeviceIDsByAttributes(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, attributes map[string]string) ([]string, error)
 }
 
 // GatewayStore interface for storing Gateways.
@@ -121,6 +124,59 @@ type UserSessionStore interface {
 	DeleteAllUserSessions(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error
 }
 
+// UserMFAStore interface for storing the multi-factor authentication configuration of users.
+//
+// For internal use (by the account app) only.
+type UserMFAStore interface {
+	GetUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers) (*UserMFA, error)
+	// Create or update the multi-factor authentication configuration of the user.
+	SetUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers, mfa *UserMFA) (*UserMFA, error)
+	DeleteUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error
+}
+
+// AuditStore interface for the append-only audit log of changes in the Identity Server.
+type AuditStore interface {
+	// Create an audit entry.
+	CreateAuditEntry(ctx context.Context, entry *AuditEntry) error
+	// Find the audit entries, optionally of the given entity only and within the given time range, newest first.
+	FindAuditEntries(ctx context.Context, entityID *ttnpb.EntityIdentifiers, after, before *time.Time) ([]*AuditEntry, error)
+	// Delete the audit entries that were created before the given time. Used for enforcing the retention.
+	DeleteAuditEntries(ctx context.Context, before time.Time) (int64, error)
+}
+
+// NotificationStore interface for storing the notification inbox and notification preferences of users.
+type NotificationStore interface {
+	// Create a notification in the inbox of the user.
+	CreateNotification(ctx context.Context, receiverIDs *ttnpb.UserIdentifiers, notification *Notification) (*Notification, error)
+	// Get the notification of the user with the given ID.
+	GetNotification(ctx context.Context, receiverIDs *ttnpb.UserIdentifiers, id string) (*Notification, error)
+	// List the notifications of the user, newest first. If statuses are given, only notifications with those
+	// statuses are listed.
+	ListNotifications(ctx context.Context, receiverIDs *ttnpb.UserIdentifiers, statuses ...string) ([]*Notification, error)
+	// Update the status of the notifications of the user with the given IDs.
+	UpdateNotificationStatus(ctx context.Context, receiverIDs *ttnpb.UserIdentifiers, ids []string, status string) error
+	// Delete all notifications of the user. Used when purging users.
+	DeleteUserNotifications(ctx context.Context, receiverIDs *ttnpb.UserIdentifiers) error
+
+	// Get the types of notifications that the user does not want to receive by email.
+	GetDisabledEmailNotifications(ctx context.Context, userIDs *ttnpb.UserIdentifiers) ([]string, error)
+	// Set the types of notifications that the user does not want to receive by email.
+	SetDisabledEmailNotifications(ctx context.Context, userIDs *ttnpb.UserIdentifiers, notificationTypes []string) error
+}
+
+// FederatedIdentityStore interface for storing the links between users and identities at upstream OpenID Connect
+// providers.
+//
+// For internal use (by the account app) only.
+type FederatedIdentityStore interface {
+	// Get the user that is linked to the identity of the provider.
+	GetFederatedUser(ctx context.Context, providerID, subject string) (*ttnpb.UserIdentifiers, error)
+	CreateFederatedIdentity(ctx context.Context, userIDs *ttnpb.UserIdentifiers, providerID, subject string) error
+	// Find the identities that are linked to the user.
+	FindUserFederatedIdentities(ctx context.Context, userIDs *ttnpb.UserIdentifiers) ([]*FederatedIdentity, error)
+	DeleteUserFederatedIdentities(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error
+}
+
 // MembershipStore interface for storing membership (collaboration) relations
 // between accounts (users or organizations) and entities (applications, clients,
 // gateways or organizations).
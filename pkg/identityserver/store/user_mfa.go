@@ -0,0 +1,52 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// UserMFA is the multi-factor authentication configuration of a user.
+type UserMFA struct {
+	Model
+
+	User   *User
+	UserID string `gorm:"type:UUID;unique_index:user_mfa_user_index;not null"`
+
+	// TOTPSecret is the secret of the time-based one-time passwords. If TOTPSecretKeyID is set, the secret is
+	// encrypted with that key and base64 encoded, otherwise it is the base32 encoded plaintext secret.
+	TOTPSecret string `gorm:"type:VARCHAR;not null"`
+	// TOTPSecretKeyID is the ID of the key that is used to encrypt the TOTPSecret.
+	TOTPSecretKeyID string `gorm:"type:VARCHAR"`
+	// TOTPLastStep is the time step of the last accepted one-time password, used to prevent reuse of codes.
+	TOTPLastStep int64
+	// RecoveryCodes are the hashes of the unused recovery codes.
+	RecoveryCodes pq.StringArray `gorm:"type:VARCHAR ARRAY;column:recovery_codes"`
+
+	// EnabledAt is the time at which the user confirmed the enrolment. Multi-factor authentication is not enforced
+	// for the user until the enrolment is confirmed.
+	EnabledAt *time.Time
+}
+
+func init() {
+	registerModel(&UserMFA{})
+}
+
+// Enabled returns whether the user confirmed the enrolment.
+func (mfa *UserMFA) Enabled() bool {
+	return mfa != nil && mfa.EnabledAt != nil
+}
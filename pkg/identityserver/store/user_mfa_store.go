@@ -0,0 +1,106 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// GetUserMFAStore returns an UserMFAStore on the given db (or transaction).
+func GetUserMFAStore(db *gorm.DB) UserMFAStore {
+	return &userMFAStore{store: newStore(db)}
+}
+
+type userMFAStore struct {
+	*store
+}
+
+var errUserMFANotFound = errors.DefineNotFound("user_mfa_not_found", "multi-factor authentication of user `{user_id}` not found")
+
+func (s *userMFAStore) findUserMFA(ctx context.Context, userID string) (*UserMFA, error) {
+	var mfaModel UserMFA
+	if err := s.query(ctx, UserMFA{}).Where(UserMFA{UserID: userID}).First(&mfaModel).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &mfaModel, nil
+}
+
+func (s *userMFAStore) GetUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers) (*UserMFA, error) {
+	defer trace.StartRegion(ctx, "get user mfa").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return nil, err
+	}
+	mfaModel, err := s.findUserMFA(ctx, user.PrimaryKey())
+	if err != nil {
+		return nil, err
+	}
+	if mfaModel == nil {
+		return nil, errUserMFANotFound.WithAttributes("user_id", userIDs.GetUserId())
+	}
+	return mfaModel, nil
+}
+
+func (s *userMFAStore) SetUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers, mfa *UserMFA) (*UserMFA, error) {
+	defer trace.StartRegion(ctx, "set user mfa").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return nil, err
+	}
+	mfaModel, err := s.findUserMFA(ctx, user.PrimaryKey())
+	if err != nil {
+		return nil, err
+	}
+	if mfaModel == nil {
+		mfaModel = &UserMFA{
+			UserID:          user.PrimaryKey(),
+			TOTPSecret:      mfa.TOTPSecret,
+			TOTPSecretKeyID: mfa.TOTPSecretKeyID,
+			TOTPLastStep:    mfa.TOTPLastStep,
+			RecoveryCodes:   mfa.RecoveryCodes,
+			EnabledAt:       cleanTimePtr(mfa.EnabledAt),
+		}
+		if err = s.createEntity(ctx, mfaModel); err != nil {
+			return nil, convertError(err)
+		}
+		return mfaModel, nil
+	}
+	mfaModel.TOTPSecret = mfa.TOTPSecret
+	mfaModel.TOTPSecretKeyID = mfa.TOTPSecretKeyID
+	mfaModel.TOTPLastStep = mfa.TOTPLastStep
+	mfaModel.RecoveryCodes = mfa.RecoveryCodes
+	mfaModel.EnabledAt = cleanTimePtr(mfa.EnabledAt)
+	if err = s.updateEntity(ctx, mfaModel, "totp_secret", "totp_secret_key_id", "totp_last_step", "recovery_codes", "enabled_at"); err != nil {
+		return nil, err
+	}
+	return mfaModel, nil
+}
+
+func (s *userMFAStore) DeleteUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error {
+	defer trace.StartRegion(ctx, "delete user mfa").End()
+	user, err := s.findDeletedEntity(ctx, userIDs, "id")
+	if err != nil {
+		return err
+	}
+	return s.query(ctx, UserMFA{}).Where(UserMFA{UserID: user.PrimaryKey()}).Delete(&UserMFA{}).Error
+}
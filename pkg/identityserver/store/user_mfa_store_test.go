@@ -0,0 +1,85 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+)
+
+func TestUserMFAStore(t *testing.T) {
+	a, ctx := test.New(t)
+	now := cleanTime(time.Now())
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		s := newStore(db)
+		store := GetUserMFAStore(db)
+
+		prepareTest(db,
+			&Account{}, &User{},
+			&UserMFA{},
+		)
+
+		usr := &User{Account: Account{UID: "user-mfa-test-user"}}
+		s.createEntity(ctx, usr)
+		userIDs := &ttnpb.UserIdentifiers{UserId: usr.Account.UID}
+
+		_, err := store.GetUserMFA(ctx, userIDs)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		created, err := store.SetUserMFA(ctx, userIDs, &UserMFA{
+			TOTPSecret: "JBSWY3DPEHPK3PXP",
+		})
+		if a.So(err, should.BeNil) && a.So(created, should.NotBeNil) {
+			a.So(created.Enabled(), should.BeFalse)
+		}
+
+		updated, err := store.SetUserMFA(ctx, userIDs, &UserMFA{
+			TOTPSecret:      "ZW5jcnlwdGVk",
+			TOTPSecretKeyID: "mfa-key",
+			TOTPLastStep:    42,
+			RecoveryCodes:   []string{"hash-1", "hash-2"},
+			EnabledAt:       &now,
+		})
+		if a.So(err, should.BeNil) && a.So(updated, should.NotBeNil) {
+			a.So(updated.ID, should.Equal, created.ID)
+		}
+
+		got, err := store.GetUserMFA(ctx, userIDs)
+		if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+			a.So(got.Enabled(), should.BeTrue)
+			a.So(got.TOTPLastStep, should.Equal, 42)
+			a.So(got.TOTPSecret, should.Equal, "ZW5jcnlwdGVk")
+			a.So(got.TOTPSecretKeyID, should.Equal, "mfa-key")
+			a.So(got.RecoveryCodes, should.Resemble, updated.RecoveryCodes)
+		}
+
+		err = store.DeleteUserMFA(ctx, userIDs)
+		a.So(err, should.BeNil)
+
+		_, err = store.GetUserMFA(ctx, userIDs)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+	})
+}
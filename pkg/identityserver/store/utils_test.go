@@ -17,6 +17,7 @@ package store
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 
@@ -45,7 +46,16 @@ func WithDB(t *testing.T, f func(t *testing.T, db *gorm.DB)) {
 		if dbAuth == "" {
 			dbAuth = "root"
 		}
-		dbConnString = fmt.Sprintf("postgresql://%s@%s/%s?sslmode=disable", dbAuth, dbAddress, dbName)
+		switch os.Getenv("SQL_DB_DRIVER") {
+		case "sqlite":
+			dir, err := os.MkdirTemp("", dbName)
+			if err != nil {
+				panic(err)
+			}
+			dbConnString = fmt.Sprintf("sqlite://%s", filepath.Join(dir, dbName+".db"))
+		default:
+			dbConnString = fmt.Sprintf("postgresql://%s@%s/%s?sslmode=disable", dbAuth, dbAddress, dbName)
+		}
 		db, err := Open(test.Context(), dbConnString)
 		if err != nil {
 			panic(err)
@@ -69,19 +69,24 @@ func (is *IdentityServer) createUserAPIKey(ctx context.Context, req *ttnpb.Creat
 	if err = rights.RequireUser(ctx, *req.GetUserIds(), req.Rights...); err != nil {
 		return nil, err
 	}
+	if err = is.validateAPIKeyRestrictions(ctx, req.GetUserIds().GetEntityIdentifiers(), req.Restrictions); err != nil {
+		return nil, err
+	}
 	key, token, err := GenerateAPIKey(ctx, req.Name, req.ExpiresAt, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	key.Restrictions = req.Restrictions
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		key, err = store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.GetUserIds().GetEntityIdentifiers(), key)
 		return err
+	}, func() events.Event {
+		return evtCreateUserAPIKey.NewWithIdentifiersAndData(ctx, req.GetUserIds(), nil)
 	})
 	if err != nil {
 		return nil, err
 	}
 	key.Key = token
-	events.Publish(evtCreateUserAPIKey.NewWithIdentifiersAndData(ctx, req.GetUserIds(), nil))
 	err = is.SendUserEmail(ctx, req.GetUserIds(), func(data emails.Data) email.MessageData {
 		data.SetEntity(req)
 		return &emails.APIKeyCreated{Data: data, Key: key, Rights: key.Rights}
@@ -142,8 +147,11 @@ func (is *IdentityServer) updateUserAPIKey(ctx context.Context, req *ttnpb.Updat
 	if err = rights.RequireUser(ctx, *req.GetUserIds(), ttnpb.RIGHT_USER_SETTINGS_API_KEYS); err != nil {
 		return nil, err
 	}
+	if err = is.validateAPIKeyRestrictions(ctx, req.GetUserIds().GetEntityIdentifiers(), req.ApiKey.GetRestrictions()); err != nil {
+		return nil, err
+	}
 
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		if len(req.ApiKey.Rights) > 0 {
 			_, key, err := store.GetAPIKeyStore(db).GetAPIKey(ctx, req.ApiKey.Id)
 			if err != nil {
@@ -165,16 +173,19 @@ func (is *IdentityServer) updateUserAPIKey(ctx context.Context, req *ttnpb.Updat
 
 		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.UserIds.GetEntityIdentifiers(), req.ApiKey, req.FieldMask)
 		return err
+	}, func() events.Event {
+		if key == nil { // API key was deleted.
+			return evtDeleteUserAPIKey.NewWithIdentifiersAndData(ctx, req.GetUserIds(), nil)
+		}
+		return evtUpdateUserAPIKey.NewWithIdentifiersAndData(ctx, req.GetUserIds(), req.FieldMask.GetPaths())
 	})
 	if err != nil {
 		return nil, err
 	}
 	if key == nil { // API key was deleted.
-		events.Publish(evtDeleteUserAPIKey.NewWithIdentifiersAndData(ctx, req.GetUserIds(), nil))
 		return &ttnpb.APIKey{}, nil
 	}
 	key.Key = ""
-	events.Publish(evtUpdateUserAPIKey.NewWithIdentifiersAndData(ctx, req.GetUserIds(), nil))
 	err = is.SendUserEmail(ctx, req.GetUserIds(), func(data emails.Data) email.MessageData {
 		data.SetEntity(req)
 		return &emails.APIKeyChanged{Data: data, Key: key, Rights: key.Rights}
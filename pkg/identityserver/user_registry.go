@@ -248,7 +248,7 @@ func (is *IdentityServer) createUser(ctx context.Context, req *ttnpb.CreateUserR
 	}
 	defer func() { is.setFullProfilePictureURL(ctx, usr) }()
 
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		if req.InvitationToken != "" {
 			invitationToken, err := store.GetInvitationStore(db).GetInvitation(ctx, req.InvitationToken)
 			if err != nil {
@@ -278,6 +278,8 @@ func (is *IdentityServer) createUser(ctx context.Context, req *ttnpb.CreateUserR
 		}
 
 		return nil
+	}, func() events.Event {
+		return evtCreateUser.NewWithIdentifiersAndData(ctx, req.User.GetIds(), nil)
 	})
 	if err != nil {
 		return nil, err
@@ -302,7 +304,6 @@ func (is *IdentityServer) createUser(ctx context.Context, req *ttnpb.CreateUserR
 	}
 
 	usr.Password = "" // Create doesn't have a FieldMask, so we need to manually remove the password.
-	events.Publish(evtCreateUser.NewWithIdentifiersAndData(ctx, req.User.GetIds(), nil))
 	return usr, nil
 }
 
@@ -472,7 +473,7 @@ func (is *IdentityServer) updateUser(ctx context.Context, req *ttnpb.UpdateUserR
 		defer func() { is.setFullProfilePictureURL(ctx, usr) }()
 	}
 
-	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) (err error) {
 		updatingContactInfo := ttnpb.HasAnyField(req.FieldMask.GetPaths(), "contact_info")
 		var contactInfo []*ttnpb.ContactInfo
 		updatingPrimaryEmailAddress := ttnpb.HasAnyField(req.FieldMask.GetPaths(), "primary_email_address")
@@ -509,11 +510,12 @@ func (is *IdentityServer) updateUser(ctx context.Context, req *ttnpb.UpdateUserR
 			usr.ContactInfo = contactInfo
 		}
 		return nil
+	}, func() events.Event {
+		return evtUpdateUser.NewWithIdentifiersAndData(ctx, req.User.GetIds(), req.FieldMask.GetPaths())
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtUpdateUser.NewWithIdentifiersAndData(ctx, req.User.GetIds(), req.FieldMask.GetPaths()))
 
 	// TODO: Send emails (https://github.com/TheThingsNetwork/lorawan-stack/issues/72).
 	// - If primary email address changed
@@ -564,7 +566,10 @@ func (is *IdentityServer) updateUserPassword(ctx context.Context, req *ttnpb.Upd
 		return nil, err
 	}
 	updateMask := updatePasswordFieldMask
-	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+	// NOTE: The failed attempt is published after the transaction is rolled back, as publishEvent records the audit
+	// entry in its own transaction.
+	var incorrectPassword bool
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
 		usr, err := store.GetUserStore(db).GetUser(ctx, req.GetUserIds(), temporaryPasswordFieldMask)
 		if err != nil {
 			return err
@@ -582,7 +587,7 @@ func (is *IdentityServer) updateUserPassword(ctx context.Context, req *ttnpb.Upd
 			// }
 		} else {
 			if usr.TemporaryPassword == "" {
-				events.Publish(evtUpdateUserIncorrectPassword.NewWithIdentifiersAndData(ctx, req.GetUserIds(), nil))
+				incorrectPassword = true
 				return errIncorrectPassword.New()
 			}
 			region := trace.StartRegion(ctx, "validate temporary password")
@@ -592,10 +597,10 @@ func (is *IdentityServer) updateUserPassword(ctx context.Context, req *ttnpb.Upd
 			case err != nil:
 				return err
 			case !valid:
-				events.Publish(evtUpdateUserIncorrectPassword.NewWithIdentifiersAndData(ctx, req.GetUserIds(), nil))
+				incorrectPassword = true
 				return errIncorrectPassword.New()
 			case usr.TemporaryPasswordExpiresAt.Before(time.Now()):
-				events.Publish(evtUpdateUserIncorrectPassword.NewWithIdentifiersAndData(ctx, req.GetUserIds(), nil))
+				incorrectPassword = true
 				return errTemporaryPasswordExpired.New()
 			}
 			usr.TemporaryPassword, usr.TemporaryPasswordCreatedAt, usr.TemporaryPasswordExpiresAt = "", nil, nil
@@ -635,11 +640,15 @@ func (is *IdentityServer) updateUserPassword(ctx context.Context, req *ttnpb.Upd
 		usr.Password, usr.PasswordUpdatedAt, usr.RequirePasswordUpdate = hashedPassword, &now, false
 		usr, err = store.GetUserStore(db).UpdateUser(ctx, usr, updateMask)
 		return err
+	}, func() events.Event {
+		return evtUpdateUser.NewWithIdentifiersAndData(ctx, req.GetUserIds(), updateMask)
 	})
+	if incorrectPassword {
+		is.publishEvent(evtUpdateUserIncorrectPassword.NewWithIdentifiersAndData(ctx, req.GetUserIds(), nil))
+	}
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtUpdateUser.NewWithIdentifiersAndData(ctx, req.GetUserIds(), updateMask))
 	err = is.SendUserEmail(ctx, req.GetUserIds(), func(data emails.Data) email.MessageData {
 		return &emails.PasswordChanged{Data: data}
 	})
@@ -663,7 +672,7 @@ func (is *IdentityServer) createTemporaryPassword(ctx context.Context, req *ttnp
 	now := time.Now()
 	ttl := time.Hour
 	expires := now.Add(ttl)
-	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+	err = is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
 		usr, err := store.GetUserStore(db).GetUser(ctx, req.GetUserIds(), temporaryPasswordFieldMask)
 		if err != nil {
 			return err
@@ -675,6 +684,8 @@ func (is *IdentityServer) createTemporaryPassword(ctx context.Context, req *ttnp
 		usr.TemporaryPasswordCreatedAt, usr.TemporaryPasswordExpiresAt = &now, &expires
 		usr, err = store.GetUserStore(db).UpdateUser(ctx, usr, updateTemporaryPasswordFieldMask)
 		return err
+	}, func() events.Event {
+		return evtUpdateUser.NewWithIdentifiersAndData(ctx, req.GetUserIds(), updateTemporaryPasswordFieldMask)
 	})
 	if err != nil {
 		return nil, err
@@ -683,7 +694,6 @@ func (is *IdentityServer) createTemporaryPassword(ctx context.Context, req *ttnp
 		"user_uid", unique.ID(ctx, req.GetUserIds()),
 		"temporary_password", temporaryPassword,
 	)).Info("Created temporary password")
-	events.Publish(evtUpdateUser.NewWithIdentifiersAndData(ctx, req.GetUserIds(), updateTemporaryPasswordFieldMask))
 	err = is.SendUserEmail(ctx, req.GetUserIds(), func(data emails.Data) email.MessageData {
 		return &emails.TemporaryPassword{
 			Data:              data,
@@ -701,18 +711,19 @@ func (is *IdentityServer) deleteUser(ctx context.Context, ids *ttnpb.UserIdentif
 	if err := rights.RequireUser(ctx, *ids, ttnpb.RIGHT_USER_DELETE); err != nil {
 		return nil, err
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
 		err := store.GetUserStore(db).DeleteUser(ctx, ids)
 		if err != nil {
 			return err
 		}
 		// Also delete the the user's sessions to enforce logouts.
 		return store.GetUserSessionStore(db).DeleteAllUserSessions(ctx, ids)
+	}, func() events.Event {
+		return evtDeleteUser.NewWithIdentifiersAndData(ctx, ids, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtDeleteUser.NewWithIdentifiersAndData(ctx, ids, nil))
 	return ttnpb.Empty, nil
 }
 
@@ -720,7 +731,7 @@ func (is *IdentityServer) restoreUser(ctx context.Context, ids *ttnpb.UserIdenti
 	if err := rights.RequireUser(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_USER_DELETE); err != nil {
 		return nil, err
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
 		usrStore := store.GetUserStore(db)
 		usr, err := usrStore.GetUser(store.WithSoftDeleted(ctx, true), ids, softDeleteFieldMask)
 		if err != nil {
@@ -729,15 +740,16 @@ func (is *IdentityServer) restoreUser(ctx context.Context, ids *ttnpb.UserIdenti
 		if usr.DeletedAt == nil {
 			panic("store.WithSoftDeleted(ctx, true) returned result that is not deleted")
 		}
-		if time.Since(*usr.DeletedAt) > is.configFromContext(ctx).Delete.Restore {
+		if time.Since(*usr.DeletedAt) > is.configFromContext(ctx).DeleteRetention("user") {
 			return errRestoreWindowExpired.New()
 		}
 		return usrStore.RestoreUser(ctx, ids)
+	}, func() events.Event {
+		return evtRestoreUser.NewWithIdentifiersAndData(ctx, ids, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtRestoreUser.NewWithIdentifiersAndData(ctx, ids, nil))
 	return ttnpb.Empty, nil
 }
 
@@ -745,34 +757,14 @@ func (is *IdentityServer) purgeUser(ctx context.Context, ids *ttnpb.UserIdentifi
 	if !is.IsAdmin(ctx) {
 		return nil, errAdminsPurgeUsers.New()
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		err := store.GetContactInfoStore(db).DeleteEntityContactInfo(ctx, ids)
-		if err != nil {
-			return err
-		}
-		// delete related API keys before purging the user
-		err = store.GetAPIKeyStore(db).DeleteEntityAPIKeys(ctx, ids.GetEntityIdentifiers())
-		if err != nil {
-			return err
-		}
-		err = store.GetMembershipStore(db).DeleteAccountMembers(ctx, ids.GetOrganizationOrUserIdentifiers())
-		if err != nil {
-			return err
-		}
-		err = store.GetOAuthStore(db).DeleteUserAuthorizations(ctx, ids)
-		if err != nil {
-			return err
-		}
-		err = store.GetUserSessionStore(db).DeleteAllUserSessions(ctx, ids)
-		if err != nil {
-			return err
-		}
-		return store.GetUserStore(db).PurgeUser(ctx, ids)
+	err := is.withAuditedDatabase(ctx, func(db *gorm.DB) error {
+		return PurgeEntity(ctx, db, ids.GetEntityIdentifiers())
+	}, func() events.Event {
+		return evtPurgeUser.NewWithIdentifiersAndData(ctx, ids, nil)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtPurgeUser.NewWithIdentifiersAndData(ctx, ids, nil))
 	return ttnpb.Empty, nil
 }
 
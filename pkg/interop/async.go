@@ -0,0 +1,226 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+const (
+	// asyncAnswerTimeout is the maximum time to wait for an answer that is delivered asynchronously.
+	asyncAnswerTimeout = 10 * time.Second
+	// maxAsyncAnswerConcurrency is the maximum number of requests that are handled concurrently to deliver the answer
+	// asynchronously. When the concurrency limit is reached, requests are answered synchronously instead.
+	maxAsyncAnswerConcurrency = 1024
+)
+
+var (
+	errNoAsyncAnswers = errors.DefineFailedPrecondition("no_async_answers", "asynchronous answers not supported without interop server")
+	errAnswerTimeout  = errors.DefineDeadlineExceeded("answer_timeout", "no answer received within timeout")
+)
+
+// normalizeID returns the NetID or EUI-64 in the given sender or receiver ID in its canonical form, so that IDs that
+// only differ in case or prefix are equal. Other IDs, such as AS-IDs, are returned as is.
+func normalizeID(id string) string {
+	var buf Buffer
+	if err := buf.UnmarshalText([]byte(id)); err != nil {
+		return id
+	}
+	switch len(buf) {
+	case 3:
+		var netID types.NetID
+		copy(netID[:], buf)
+		return netID.String()
+	case 8:
+		var eui types.EUI64
+		copy(eui[:], buf)
+		return eui.String()
+	default:
+		return id
+	}
+}
+
+type transaction struct {
+	receiverID string
+	answerType MessageType
+	answers    chan []byte
+}
+
+// transactions correlates answers that are delivered asynchronously with pending requests by transaction ID.
+// Pending transactions are kept in process memory, so answers must be delivered to the interop server of the instance
+// that sent the request. If answers reach another instance, the request times out.
+type transactions struct {
+	mu         sync.Mutex
+	pending    map[uint32]*transaction
+	registered bool // Whether an interop server delivers the answers.
+}
+
+func newTransactions() *transactions {
+	return &transactions{
+		pending: make(map[uint32]*transaction),
+	}
+}
+
+func (t *transactions) register() {
+	t.mu.Lock()
+	t.registered = true
+	t.mu.Unlock()
+}
+
+func (t *transactions) isRegistered() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.registered
+}
+
+// begin starts a transaction with the given receiver that expects an answer of the given type.
+// The returned channel receives the answer. The caller must call the returned function when done.
+func (t *transactions) begin(receiverID string, answerType MessageType) (uint32, <-chan []byte, func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var id uint32
+	for {
+		var b [4]byte
+		if _, err := rand.Read(b[:]); err != nil {
+			panic(err)
+		}
+		id = binary.BigEndian.Uint32(b[:])
+		if _, ok := t.pending[id]; id != 0 && !ok {
+			break
+		}
+	}
+	tx := &transaction{
+		receiverID: normalizeID(receiverID),
+		answerType: answerType,
+		answers:    make(chan []byte, 1),
+	}
+	t.pending[id] = tx
+	return id, tx.answers, func() {
+		t.mu.Lock()
+		delete(t.pending, id)
+		t.mu.Unlock()
+	}
+}
+
+// deliver delivers the answer to the pending transaction.
+// The answer is only delivered if the sender of the answer is the receiver of the request, and if the answer is of
+// the type that answers the request.
+func (t *transactions) deliver(header MessageHeader, data []byte) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tx, ok := t.pending[header.TransactionID]
+	if !ok || normalizeID(header.SenderID) != tx.receiverID {
+		return false
+	}
+	if header.MessageType != tx.answerType {
+		return false
+	}
+	delete(t.pending, header.TransactionID)
+	tx.answers <- data
+	return true
+}
+
+// asyncHTTPExchange sends the request and waits for the answer to be delivered asynchronously.
+// The request is assigned a transaction ID that correlates the answer with the request.
+// If the receiver answers synchronously anyway, the answer in the HTTP response body is used.
+func asyncHTTPExchange(
+	ctx context.Context,
+	txs *transactions,
+	receiverID string,
+	req, res interface{},
+	newRequest func(interface{}) (*http.Request, error),
+	do func(*http.Request) (*http.Response, error),
+) error {
+	if txs == nil || !txs.isRegistered() {
+		return errNoAsyncAnswers.New()
+	}
+	var answerType MessageType
+	if msg, ok := req.(interface{ messageType() MessageType }); ok {
+		answerType, _ = msg.messageType().Answer()
+	}
+	id, answers, done := txs.begin(receiverID, answerType)
+	defer done()
+	if msg, ok := req.(interface{ setTransactionID(uint32) }); ok {
+		msg.setTransactionID(id)
+	}
+
+	httpReq, err := newRequest(req)
+	if err != nil {
+		return err
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"url", httpReq.URL,
+		"transaction_id", id,
+	))
+
+	logger.Debug("Send interop HTTP request")
+	httpRes, err := do(httpReq.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer httpRes.Body.Close()
+
+	logger = logger.WithField("http_code", httpRes.StatusCode)
+	logger.Debug("Receive interop HTTP response")
+
+	if httpRes.StatusCode < 200 || httpRes.StatusCode > 299 {
+		return errors.FromHTTPStatusCode(httpRes.StatusCode)
+	}
+	b, err := ioutil.ReadAll(httpRes.Body)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to read HTTP response body")
+		return errors.FromHTTPStatusCode(httpRes.StatusCode)
+	}
+	if len(bytes.TrimSpace(b)) > 0 {
+		if err := json.Unmarshal(b, res); err != nil {
+			logger.WithError(err).Warn("Failed to decode HTTP response body")
+			return errors.FromHTTPStatusCode(httpRes.StatusCode)
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, asyncAnswerTimeout)
+	defer cancel()
+	select {
+	case <-ctx.Done():
+		return errAnswerTimeout.WithCause(ctx.Err())
+	case data := <-answers:
+		logger.Debug("Receive asynchronous interop answer")
+		return json.Unmarshal(data, res)
+	}
+}
+
+// asyncContext is a context with the values of a request context, but that is not done when the request is done.
+type asyncContext struct {
+	context.Context
+	values context.Context
+}
+
+// Value implements context.Context.
+func (c asyncContext) Value(key interface{}) interface{} {
+	return c.values.Value(key)
+}
@@ -65,6 +65,12 @@ func (p jsRPCPaths) rejoin() string  { return p.Rejoin }
 func (p jsRPCPaths) appSKey() string { return p.AppSKey }
 func (p jsRPCPaths) homeNS() string  { return p.HomeNS }
 
+type nsRPCPaths struct {
+	Profile string `yaml:"profile"`
+}
+
+func (p nsRPCPaths) profile() string { return p.Profile }
+
 func serverURL(scheme, fqdn, path string, port uint32) string {
 	if scheme == "" {
 		scheme = "https"
@@ -94,6 +100,16 @@ func newHTTPRequest(url string, pld interface{}, headers map[string]string) (*ht
 	return req, nil
 }
 
+// NetworkServerFQDN constructs Network Server FQDN using specified NetID under domain
+// according to LoRaWAN Backend Interfaces specification.
+// If domain is empty, LoRaAllianceNetIDDomain is used.
+func NetworkServerFQDN(netID types.NetID, domain string) string {
+	if domain == "" {
+		domain = LoRaAllianceNetIDDomain
+	}
+	return fmt.Sprintf("%x.%s", netID[:], domain)
+}
+
 // JoinServerFQDN constructs Join Server FQDN using specified EUI under domain
 // according to LoRaWAN Backend Interfaces specification.
 // If domain is empty, LoRaAllianceJoinEUIDomain is used.
@@ -148,9 +164,17 @@ type joinServerHTTPClient struct {
 	Client         http.Client
 	NewRequestFunc func(types.EUI64, func(jsRPCPaths) string, interface{}) (*http.Request, error)
 	Protocol       ProtocolVersion
+	NSID           *types.EUI64
+	Async          bool
+	Transactions   *transactions
 }
 
 func (cl joinServerHTTPClient) exchange(ctx context.Context, joinEUI types.EUI64, pathFunc func(jsRPCPaths) string, req, res interface{}) error {
+	if cl.Async {
+		return asyncHTTPExchange(ctx, cl.Transactions, joinEUI.String(), req, res, func(req interface{}) (*http.Request, error) {
+			return cl.NewRequestFunc(joinEUI, pathFunc, req)
+		}, cl.Client.Do)
+	}
 	httpReq, err := cl.NewRequestFunc(joinEUI, pathFunc, req)
 	if err != nil {
 		return err
@@ -158,6 +182,14 @@ func (cl joinServerHTTPClient) exchange(ctx context.Context, joinEUI types.EUI64
 	return httpExchange(ctx, httpReq.WithContext(ctx), res, cl.Client.Do)
 }
 
+// senderNSID returns the NSID to send in messages from the Network Server, if the protocol supports NSID.
+func senderNSID(protocol ProtocolVersion, nsID *types.EUI64) *EUI64 {
+	if nsID == nil || !protocol.SupportsNSID() {
+		return nil
+	}
+	return (*EUI64)(nsID)
+}
+
 func parseResult(r Result) error {
 	if r.ResultCode == ResultSuccess {
 		return nil
@@ -232,6 +264,7 @@ func (cl joinServerHTTPClient) HandleJoinRequest(ctx context.Context, netID type
 			},
 			SenderID:   NetID(netID),
 			ReceiverID: EUI64(pld.JoinEui),
+			SenderNSID: senderNSID(cl.Protocol, cl.NSID),
 		},
 		MACVersion: MACVersion(req.SelectedMacVersion),
 		PHYPayload: Buffer(req.RawPayload),
@@ -294,6 +327,64 @@ func makeJoinServerHTTPRequestFunc(scheme, dns, fqdn string, port uint32, rpcPat
 	}
 }
 
+type networkServerHTTPClient struct {
+	Client         http.Client
+	NewRequestFunc func(types.NetID, func(nsRPCPaths) string, interface{}) (*http.Request, error)
+	Protocol       ProtocolVersion
+	NSID           *types.EUI64
+	Async          bool
+	Transactions   *transactions
+}
+
+func (cl networkServerHTTPClient) exchange(ctx context.Context, netID types.NetID, pathFunc func(nsRPCPaths) string, req, res interface{}) error {
+	if cl.Async {
+		return asyncHTTPExchange(ctx, cl.Transactions, netID.String(), req, res, func(req interface{}) (*http.Request, error) {
+			return cl.NewRequestFunc(netID, pathFunc, req)
+		}, cl.Client.Do)
+	}
+	httpReq, err := cl.NewRequestFunc(netID, pathFunc, req)
+	if err != nil {
+		return err
+	}
+	return httpExchange(ctx, httpReq.WithContext(ctx), res, cl.Client.Do)
+}
+
+// GetDeviceProfile performs Profile request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) GetDeviceProfile(ctx context.Context, netID, homeNetID types.NetID, devEUI types.EUI64) (*ProfileAns, error) {
+	interopAns := &ProfileAns{}
+	if err := cl.exchange(ctx, homeNetID, nsRPCPaths.profile, &ProfileReq{
+		NsNsMessageHeader: NsNsMessageHeader{
+			MessageHeader: MessageHeader{
+				ProtocolVersion: cl.Protocol,
+				MessageType:     MessageTypeProfileReq,
+			},
+			SenderID:   NetID(netID),
+			ReceiverID: NetID(homeNetID),
+			SenderNSID: senderNSID(cl.Protocol, cl.NSID),
+		},
+		DevEUI: EUI64(devEUI),
+	}, interopAns); err != nil {
+		return nil, err
+	}
+	if err := parseResult(interopAns.Result); err != nil {
+		return nil, err
+	}
+	return interopAns, nil
+}
+
+func makeNetworkServerHTTPRequestFunc(scheme, dns, fqdn string, port uint32, rpcPaths nsRPCPaths, headers map[string]string) func(types.NetID, func(nsRPCPaths) string, interface{}) (*http.Request, error) {
+	if port == 0 {
+		port = defaultHTTPSPort
+	}
+	return func(netID types.NetID, pathFunc func(nsRPCPaths) string, pld interface{}) (*http.Request, error) {
+		fqdn := fqdn
+		if fqdn == "" {
+			fqdn = NetworkServerFQDN(netID, dns)
+		}
+		return newHTTPRequest(serverURL(scheme, fqdn, pathFunc(rpcPaths), port), pld, headers)
+	}
+}
+
 type joinServerClient interface {
 	HandleJoinRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
 	GetAppSKey(ctx context.Context, asID string, req *ttnpb.SessionKeyRequest) (*ttnpb.AppSKeyResponse, error)
@@ -304,8 +395,14 @@ type prefixJoinServerClient struct {
 	prefix types.EUI64Prefix
 }
 
+type networkServerClient interface {
+	GetDeviceProfile(ctx context.Context, netID, homeNetID types.NetID, devEUI types.EUI64) (*ProfileAns, error)
+}
+
 type Client struct {
-	joinServers []prefixJoinServerClient // Sorted by JoinEUI prefix range length.
+	joinServers    []prefixJoinServerClient // Sorted by JoinEUI prefix range length.
+	networkServers map[types.NetID]networkServerClient
+	transactions   *transactions
 }
 
 var errUnknownConfig = errors.DefineNotFound("unknown_config", "configuration is unknown")
@@ -334,10 +431,15 @@ func NewClient(ctx context.Context, conf config.InteropClient) (*Client, error)
 	}
 
 	var yamlConf struct {
+		NSID        *types.EUI64 `yaml:"ns-id"`
 		JoinServers []struct {
 			File     string              `yaml:"file"`
 			JoinEUIs []types.EUI64Prefix `yaml:"join-euis"`
 		} `yaml:"join-servers"`
+		NetworkServers []struct {
+			File   string        `yaml:"file"`
+			NetIDs []types.NetID `yaml:"net-ids"`
+		} `yaml:"network-servers"`
 	}
 	if err := yaml.UnmarshalStrict(confFileBytes, &yamlConf); err != nil {
 		return nil, err
@@ -349,8 +451,32 @@ func NewClient(ctx context.Context, conf config.InteropClient) (*Client, error)
 		Port    uint32            `yaml:"port"`
 		Headers map[string]string `yaml:"headers"`
 		TLS     tlsConfig         `yaml:"tls"`
+		// Async indicates that the server delivers answers asynchronously to the interop server.
+		// The answers must reach the instance that sent the request, as pending requests are not shared between instances.
+		Async bool `yaml:"async"`
 	}
 
+	newHTTPClient := func(fetcher fetch.Interface, tlsConfig tlsConfig) (*http.Client, error) {
+		tlsConf := fallbackTLS
+		if !tlsConfig.IsZero() {
+			tlsConf, err = tlsConfig.TLSConfig(fetcher)
+			if err != nil {
+				return nil, err
+			}
+		}
+		tr := http.DefaultTransport.(*http.Transport).Clone()
+		if transport, ok := conf.HTTPClient.Transport.(*http.Transport); ok {
+			tr = transport.Clone()
+		}
+		if tlsConf != nil {
+			tr.TLSClientConfig = tlsConf
+		}
+		client := *conf.HTTPClient
+		client.Transport = tr
+		return &client, nil
+	}
+	txs := newTransactions()
+
 	jss := make([]prefixJoinServerClient, 0, len(yamlConf.JoinServers))
 	for _, jsConf := range yamlConf.JoinServers {
 		jsConfEls := strings.Split(filepath.ToSlash(jsConf.File), "/")
@@ -373,27 +499,17 @@ func NewClient(ctx context.Context, conf config.InteropClient) (*Client, error)
 		var js joinServerClient
 		switch yamlJSConf.Protocol {
 		case ProtocolV1_0, ProtocolV1_1:
-			tlsConf := fallbackTLS
-			if !yamlJSConf.TLS.IsZero() {
-				tlsConf, err = yamlJSConf.TLS.TLSConfig(fetcher)
-				if err != nil {
-					return nil, err
-				}
-			}
-
-			tr := http.DefaultTransport.(*http.Transport).Clone()
-			if transport, ok := conf.HTTPClient.Transport.(*http.Transport); ok {
-				tr = transport.Clone()
+			client, err := newHTTPClient(fetcher, yamlJSConf.TLS)
+			if err != nil {
+				return nil, err
 			}
-			if tlsConf != nil {
-				tr.TLSClientConfig = tlsConf
-			}
-			client := *conf.HTTPClient
-			client.Transport = tr
 			js = &joinServerHTTPClient{
-				Client:         client,
+				Client:         *client,
 				NewRequestFunc: makeJoinServerHTTPRequestFunc("https", yamlJSConf.DNS, yamlJSConf.FQDN, yamlJSConf.Port, yamlJSConf.Paths, yamlJSConf.Headers),
 				Protocol:       yamlJSConf.Protocol,
+				NSID:           yamlConf.NSID,
+				Async:          yamlJSConf.Async,
+				Transactions:   txs,
 			}
 		default:
 			return nil, errUnknownProtocol.New()
@@ -412,11 +528,61 @@ func NewClient(ctx context.Context, conf config.InteropClient) (*Client, error)
 		}
 		return pi.EUI64.MarshalNumber() > pj.EUI64.MarshalNumber()
 	})
+
+	nss := make(map[types.NetID]networkServerClient)
+	for _, nsConf := range yamlConf.NetworkServers {
+		nsConfEls := strings.Split(filepath.ToSlash(nsConf.File), "/")
+
+		fetcher := fetch.WithBasePath(fetcher, nsConfEls[:len(nsConfEls)-1]...)
+		nsFileBytes, err := fetcher.File(nsConfEls[len(nsConfEls)-1])
+		if err != nil {
+			return nil, err
+		}
+
+		var yamlNSConf struct {
+			ComponentConfig `yaml:",inline"`
+			Paths           nsRPCPaths      `yaml:"paths"`
+			Protocol        ProtocolVersion `yaml:"protocol"`
+		}
+		if err := yaml.UnmarshalStrict(nsFileBytes, &yamlNSConf); err != nil {
+			return nil, err
+		}
+
+		var ns networkServerClient
+		switch yamlNSConf.Protocol {
+		case ProtocolV1_0, ProtocolV1_1:
+			client, err := newHTTPClient(fetcher, yamlNSConf.TLS)
+			if err != nil {
+				return nil, err
+			}
+			ns = &networkServerHTTPClient{
+				Client:         *client,
+				NewRequestFunc: makeNetworkServerHTTPRequestFunc("https", yamlNSConf.DNS, yamlNSConf.FQDN, yamlNSConf.Port, yamlNSConf.Paths, yamlNSConf.Headers),
+				Protocol:       yamlNSConf.Protocol,
+				NSID:           yamlConf.NSID,
+				Async:          yamlNSConf.Async,
+				Transactions:   txs,
+			}
+		default:
+			return nil, errUnknownProtocol.New()
+		}
+		for _, netID := range nsConf.NetIDs {
+			nss[netID] = ns
+		}
+	}
 	return &Client{
-		joinServers: jss,
+		joinServers:    jss,
+		networkServers: nss,
+		transactions:   txs,
 	}, nil
 }
 
+// RegisterInterop implements Registerer.
+// The interop server delivers answers to requests that are sent asynchronously to the client.
+func (cl *Client) RegisterInterop(s *Server) {
+	s.registerTransactions(cl.transactions)
+}
+
 func (cl Client) joinServer(joinEUI types.EUI64) (joinServerClient, bool) {
 	// NOTE: joinServers slice is sorted by prefix length and the range start decreasing, hence the first match is the most specific one.
 	for _, js := range cl.joinServers {
@@ -436,6 +602,15 @@ func (cl Client) GetAppSKey(ctx context.Context, asID string, req *ttnpb.Session
 	return js.GetAppSKey(ctx, asID, req)
 }
 
+// GetDeviceProfile performs Profile request to the Network Server associated with homeNetID.
+func (cl Client) GetDeviceProfile(ctx context.Context, netID, homeNetID types.NetID, devEUI types.EUI64) (*ProfileAns, error) {
+	ns, ok := cl.networkServers[homeNetID]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns.GetDeviceProfile(ctx, netID, homeNetID, devEUI)
+}
+
 // HandleJoinRequest performs Join request to Join Server associated with req.JoinEUI.
 func (cl Client) HandleJoinRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
 	pld := req.Payload.GetJoinRequestPayload()
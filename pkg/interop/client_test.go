@@ -15,8 +15,10 @@
 package interop_test
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -1360,3 +1362,240 @@ paths:
 		})
 	}
 }
+
+func writeClientConfig(t *testing.T, conf, server string) config.InteropClient {
+	confDir := t.TempDir()
+	test.MustMultiple(os.Mkdir(filepath.Join(confDir, "testdata"), 0755))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, ClientCertPath), ClientCert, 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, ClientKeyPath), ClientKey, 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, RootCAPath), RootCA, 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, InteropClientConfigurationName), []byte(conf), 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, "server.yml"), []byte(server), 0644))
+	return config.InteropClient{
+		Directory:            confDir,
+		GetFallbackTLSConfig: func(context.Context) (*tls.Config, error) { return nil, nil },
+		HTTPClient:           http.DefaultClient,
+	}
+}
+
+func splitHostPort(t *testing.T, srv *httptest.Server) (string, uint32) {
+	host := strings.Split(test.Must(url.Parse(srv.URL)).(*url.URL).Host, ":")
+	if len(host) != 2 {
+		t.Fatalf("Invalid server host: %s", host)
+	}
+	return host[0], uint32(test.Must(strconv.ParseUint(host[1], 10, 32)).(uint64))
+}
+
+func TestGetDeviceProfile(t *testing.T) {
+	a, ctx := test.New(t)
+
+	srv := newTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.So(r.Method, should.Equal, http.MethodPost)
+		a.So(r.URL.Path, should.Equal, "/test-profile-path")
+
+		b, err := ioutil.ReadAll(r.Body)
+		a.So(err, should.BeNil)
+		a.So(string(b), should.Equal, `{"ProtocolVersion":"1.1","TransactionID":0,"MessageType":"ProfileReq","SenderID":"42FFFF","ReceiverID":"000013","SenderNSID":"0102030405060708","DevEUI":"0102030405060708"}
+`)
+
+		_, err = w.Write([]byte(`{
+  "ProtocolVersion": "1.1",
+  "MessageType": "ProfileAns",
+  "SenderID": "000013",
+  "ReceiverID": "42FFFF",
+  "ReceiverNSID": "0102030405060708",
+  "Result": {
+    "ResultCode": "Success"
+  },
+  "DeviceProfile": {
+    "MACVersion": "1.0.3",
+    "SupportsJoin": true,
+    "RFRegion": "EU868"
+  },
+  "RoamingActivationType": "Passive"
+}`))
+		a.So(err, should.BeNil)
+	}))
+	defer srv.Close()
+
+	fqdn, port := splitHostPort(t, srv)
+	conf := writeClientConfig(t, `ns-id: 0102030405060708
+network-servers:
+   - file: server.yml
+     net-ids:
+        - 000013`, fmt.Sprintf(`fqdn: %s
+port: %d
+protocol: BI1.1
+paths:
+   profile: test-profile-path
+tls:
+   root-ca: %s
+   certificate: %s
+   key: %s`,
+		fqdn,
+		port,
+		RootCAPath,
+		ClientCertPath,
+		ClientKeyPath,
+	))
+
+	cl, err := NewClient(ctx, conf)
+	if !a.So(err, should.BeNil) {
+		t.Fatalf("Failed to create new client: %s", err)
+	}
+
+	_, err = cl.GetDeviceProfile(ctx, types.NetID{0x42, 0xff, 0xff}, types.NetID{0x0, 0x0, 0x14}, types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	ans, err := cl.GetDeviceProfile(ctx, types.NetID{0x42, 0xff, 0xff}, types.NetID{0x0, 0x0, 0x13}, types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(ans.DeviceProfile, should.Resemble, &DeviceProfile{
+		MACVersion:   MACVersion(ttnpb.MAC_V1_0_3),
+		SupportsJoin: true,
+		RFRegion:     "EU868",
+	})
+	a.So(ans.RoamingActivationType, should.Equal, RoamingActivationPassive)
+}
+
+func TestHandleJoinRequestAsync(t *testing.T) {
+	a, ctx := test.New(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	s, err := NewServer(&mockComponent{ctx}, nil, config.InteropServer{
+		SenderClientCA: config.SenderClientCA{
+			Static: map[string][]byte{
+				"70B3D57ED0000000": RootCA,
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.Fatal("Failed to instantiate interop server")
+	}
+	interopSrv := newTLSServer(s)
+	defer interopSrv.Close()
+
+	answerClient := interopSrv.Client()
+	answerClient.Transport.(*http.Transport).TLSClientConfig = makeClientTLSConfig()
+
+	jsSrv := newTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req JoinReq
+		if !a.So(json.NewDecoder(r.Body).Decode(&req), should.BeNil) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		a.So(req.TransactionID, should.NotBeZeroValue)
+		a.So(req.SenderNSID, should.Resemble, &EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08})
+
+		// Answer asynchronously after the request is acknowledged.
+		go func() {
+			// An answer of another type with the same transaction ID is not delivered.
+			buf, err := json.Marshal(&HomeNSAns{
+				JsNsMessageHeader: JsNsMessageHeader{
+					MessageHeader: MessageHeader{
+						ProtocolVersion: req.ProtocolVersion,
+						MessageType:     MessageTypeHomeNSAns,
+						TransactionID:   req.TransactionID,
+					},
+					SenderID:     req.ReceiverID,
+					ReceiverID:   req.SenderID,
+					ReceiverNSID: req.SenderNSID,
+				},
+				Result: Result{
+					ResultCode: ResultSuccess,
+				},
+			})
+			if !a.So(err, should.BeNil) {
+				return
+			}
+			ansReq := test.Must(http.NewRequest(http.MethodPost, interopSrv.URL, bytes.NewReader(buf))).(*http.Request)
+			ansReq.Header.Set("Content-Type", "application/json")
+			res, err := answerClient.Do(ansReq)
+			if a.So(err, should.BeNil) {
+				a.So(res.StatusCode, should.Equal, http.StatusNotFound)
+				res.Body.Close()
+			}
+
+			buf, err = json.Marshal(&JoinAns{
+				JsNsMessageHeader: JsNsMessageHeader{
+					MessageHeader: MessageHeader{
+						ProtocolVersion: req.ProtocolVersion,
+						MessageType:     MessageTypeJoinAns,
+						TransactionID:   req.TransactionID,
+					},
+					SenderID:     req.ReceiverID,
+					ReceiverID:   req.SenderID,
+					ReceiverNSID: req.SenderNSID,
+				},
+				PHYPayload:   Buffer{0x20, 0x42},
+				SessionKeyID: Buffer{0x01, 0x02},
+				Result: Result{
+					ResultCode: ResultSuccess,
+				},
+			})
+			if !a.So(err, should.BeNil) {
+				return
+			}
+			// The SenderID of the answer is normalized, so that it matches the receiver of the request.
+			buf = bytes.Replace(buf, []byte(`"SenderID":"70B3D57ED0000000"`), []byte(`"SenderID":"0x70b3d57ed0000000"`), 1)
+			ansReq = test.Must(http.NewRequest(http.MethodPost, interopSrv.URL, bytes.NewReader(buf))).(*http.Request)
+			ansReq.Header.Set("Content-Type", "application/json")
+			res, err = answerClient.Do(ansReq)
+			if a.So(err, should.BeNil) {
+				a.So(res.StatusCode, should.Equal, http.StatusOK)
+				res.Body.Close()
+			}
+		}()
+	}))
+	defer jsSrv.Close()
+
+	fqdn, port := splitHostPort(t, jsSrv)
+	conf := writeClientConfig(t, `ns-id: 0102030405060708
+join-servers:
+   - file: server.yml
+     join-euis:
+        - 70b3d57ed0000000/40`, fmt.Sprintf(`fqdn: %s
+port: %d
+protocol: BI1.1
+async: true
+tls:
+   root-ca: %s
+   certificate: %s
+   key: %s`,
+		fqdn,
+		port,
+		RootCAPath,
+		ClientCertPath,
+		ClientKeyPath,
+	))
+
+	cl, err := NewClient(ctx, conf)
+	if !a.So(err, should.BeNil) {
+		t.Fatalf("Failed to create new client: %s", err)
+	}
+
+	req := &ttnpb.JoinRequest{
+		SelectedMacVersion: ttnpb.MAC_V1_0_3,
+		Payload: &ttnpb.Message{
+			Payload: &ttnpb.Message_JoinRequestPayload{
+				JoinRequestPayload: &ttnpb.JoinRequestPayload{
+					JoinEui: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00},
+					DevEui:  types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+				},
+			},
+		},
+	}
+
+	_, err = cl.HandleJoinRequest(ctx, types.NetID{0x0, 0x0, 0x01}, req)
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+
+	cl.RegisterInterop(s)
+	res, err := cl.HandleJoinRequest(ctx, types.NetID{0x0, 0x0, 0x01}, req)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(res.RawPayload, should.Resemble, []byte{0x20, 0x42})
+	a.So(res.SessionKeys.SessionKeyId, should.Resemble, []byte{0x01, 0x02})
+}
@@ -28,6 +28,7 @@ var (
 	errCallerNotAuthorized = errors.DefinePermissionDenied("caller_not_authorized", "caller is not authorized for `{target}`")
 	errUnauthenticated     = errors.DefineUnauthenticated("unauthenticated", "unauthenticated")
 	errInvalidVendorID     = errors.DefineInvalidArgument("invalid_vendor_id", "invalid vendor ID")
+	errInvalidSenderNSID   = errors.DefineInvalidArgument("invalid_sender_ns_id", "invalid NSID `{ns_id}` of sender NetID `{net_id}`")
 
 	ErrNoAction           = errors.DefineAborted("no_action", "no action")
 	ErrMIC                = errors.DefineCorruption("mic", "MIC failed")
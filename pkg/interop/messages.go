@@ -15,6 +15,8 @@
 package interop
 
 import (
+	"time"
+
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
@@ -51,6 +53,14 @@ func (h MessageHeader) AnswerHeader() (MessageHeader, error) {
 	}, nil
 }
 
+func (h *MessageHeader) setTransactionID(id uint32) {
+	h.TransactionID = id
+}
+
+func (h MessageHeader) messageType() MessageType {
+	return h.MessageType
+}
+
 // VSExtension is a vendor-specific extension.
 type VSExtension struct {
 	VendorID VendorID
@@ -75,6 +85,15 @@ type NsMessageHeader struct {
 	SenderNSID *EUI64 `json:",omitempty"`
 }
 
+// NsNsMessageHeader contains the message header for NS to NS messages.
+type NsNsMessageHeader struct {
+	MessageHeader
+	SenderID     NetID
+	ReceiverID   NetID
+	SenderNSID   *EUI64 `json:",omitempty"`
+	ReceiverNSID *EUI64 `json:",omitempty"`
+}
+
 // AsMessageHeader contains the message header for AS messages.
 type AsMessageHeader struct {
 	MessageHeader
@@ -169,3 +188,50 @@ type HomeNSAns struct {
 	HNSID  *EUI64 `json:",omitempty"`
 	HNetID NetID
 }
+
+// RoamingActivationType is the roaming activation type.
+type RoamingActivationType string
+
+const (
+	RoamingActivationPassive  RoamingActivationType = "Passive"
+	RoamingActivationHandover RoamingActivationType = "Handover"
+)
+
+// DeviceProfile is the device profile of an end device.
+type DeviceProfile struct {
+	DeviceProfileID    string `json:",omitempty"`
+	SupportsClassB     bool
+	ClassBTimeout      uint32  `json:",omitempty"`
+	PingSlotPeriod     uint32  `json:",omitempty"`
+	PingSlotDR         uint32  `json:",omitempty"`
+	PingSlotFreq       float64 `json:",omitempty"` // MHz.
+	SupportsClassC     bool
+	ClassCTimeout      uint32 `json:",omitempty"`
+	MACVersion         MACVersion
+	RegParamsRevision  string `json:",omitempty"`
+	SupportsJoin       bool
+	RXDelay1           uint32    `json:",omitempty"`
+	RXDROffset1        uint32    `json:",omitempty"`
+	RXDataRate2        uint32    `json:",omitempty"`
+	RXFreq2            float64   `json:",omitempty"` // MHz.
+	FactoryPresetFreqs []float64 `json:",omitempty"` // MHz.
+	MaxEIRP            int32     `json:",omitempty"`
+	MaxDutyCycle       float64   `json:",omitempty"`
+	RFRegion           string    `json:",omitempty"`
+	Supports32bitFCnt  bool
+}
+
+// ProfileReq is a device profile request message.
+type ProfileReq struct {
+	NsNsMessageHeader
+	DevEUI EUI64
+}
+
+// ProfileAns is an answer to a ProfileReq message.
+type ProfileAns struct {
+	NsNsMessageHeader
+	Result                 Result
+	DeviceProfile          *DeviceProfile        `json:",omitempty"`
+	DeviceProfileTimestamp *time.Time            `json:",omitempty"`
+	RoamingActivationType  RoamingActivationType `json:",omitempty"`
+}
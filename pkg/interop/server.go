@@ -15,6 +15,7 @@
 package interop
 
 import (
+	"bytes"
 	"context"
 	"crypto/x509"
 	"encoding/json"
@@ -24,12 +25,15 @@ import (
 
 	"github.com/gorilla/mux"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/fillcontext"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
 	"go.thethings.network/lorawan-stack/v3/pkg/webmiddleware"
+	"golang.org/x/sync/semaphore"
 )
 
 // Registerer allows components to register their interop services to the web server.
@@ -49,6 +53,11 @@ type JoinServer interface {
 	HomeNSRequest(context.Context, *HomeNSReq) (*TTIHomeNSAns, error)
 }
 
+// NetworkServer represents a Network Server as specified in LoRaWAN Backend Interfaces.
+type NetworkServer interface {
+	ProfileRequest(context.Context, *ProfileReq) (*ProfileAns, error)
+}
+
 type noopServer struct{}
 
 func (noopServer) JoinRequest(context.Context, *JoinReq) (*JoinAns, error) {
@@ -63,8 +72,13 @@ func (noopServer) HomeNSRequest(context.Context, *HomeNSReq) (*TTIHomeNSAns, err
 	return nil, ErrMalformedMessage.New()
 }
 
+func (noopServer) ProfileRequest(context.Context, *ProfileReq) (*ProfileAns, error) {
+	return nil, ErrMalformedMessage.New()
+}
+
 // Server is the server.
 type Server struct {
+	ctx    context.Context
 	config config.InteropServer
 
 	router *mux.Router
@@ -74,8 +88,16 @@ type Server struct {
 
 	tokenVerifiers map[string]tokenVerifier
 
+	senderNSIDs map[string][]types.EUI64
+
+	httpClient           *http.Client
+	asyncAnswerURLs      map[string]string
+	asyncAnswerSemaphore *semaphore.Weighted
+	answers              []*transactions
+
 	is IdentityServer
 	js JoinServer
+	ns NetworkServer
 }
 
 // Components represents the Component to the Interop Server.
@@ -116,12 +138,41 @@ func NewServer(c Component, contextFillers []fillcontext.Filler, conf config.Int
 		tokenVerifiers[iss] = tokenVerifier
 	}
 
+	senderNSIDs := make(map[string][]types.EUI64, len(conf.SenderNSIDs))
+	for netID, nsIDs := range conf.SenderNSIDs {
+		for _, nsID := range nsIDs {
+			var eui types.EUI64
+			if err := eui.UnmarshalText([]byte(nsID)); err != nil {
+				return nil, errInvalidSenderNSID.WithCause(err).WithAttributes("net_id", netID, "ns_id", nsID)
+			}
+			senderNSIDs[netID] = append(senderNSIDs[netID], eui)
+		}
+	}
+
+	var httpClient *http.Client
+	if len(conf.AsyncAnswerURLs) > 0 {
+		httpClient, err = c.HTTPClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	asyncAnswerURLs := make(map[string]string, len(conf.AsyncAnswerURLs))
+	for senderID, url := range conf.AsyncAnswerURLs {
+		asyncAnswerURLs[normalizeID(senderID)] = url
+	}
+
 	s := &Server{
-		config:             conf,
-		senderClientCAs:    senderClientCAs,
-		senderClientCAPool: senderClientCAPool,
-		tokenVerifiers:     tokenVerifiers,
-		js:                 &noopServer{},
+		ctx:                  ctx,
+		config:               conf,
+		senderClientCAs:      senderClientCAs,
+		senderClientCAPool:   senderClientCAPool,
+		tokenVerifiers:       tokenVerifiers,
+		senderNSIDs:          senderNSIDs,
+		httpClient:           httpClient,
+		asyncAnswerURLs:      asyncAnswerURLs,
+		asyncAnswerSemaphore: semaphore.NewWeighted(maxAsyncAnswerConcurrency),
+		js:                   &noopServer{},
+		ns:                   &noopServer{},
 	}
 
 	s.router = mux.NewRouter()
@@ -160,6 +211,17 @@ func (s *Server) RegisterJS(js JoinServer) {
 	s.js = js
 }
 
+// RegisterNS registers the Network Server for NS-NS messages.
+func (s *Server) RegisterNS(ns NetworkServer) {
+	s.ns = ns
+}
+
+// registerTransactions registers the pending transactions to deliver asynchronous answers to.
+func (s *Server) registerTransactions(txs *transactions) {
+	txs.register()
+	s.answers = append(s.answers, txs)
+}
+
 // ClientCAPool returns a certificate pool of all configured client CAs.
 func (s *Server) ClientCAPool() *x509.CertPool {
 	return s.senderClientCAPool
@@ -178,6 +240,12 @@ func (s *Server) handle() http.Handler {
 		MessageTypeRejoinReq:  senderAuthenticatorFunc(s.authenticateNS),
 		MessageTypeAppSKeyReq: senderAuthenticatorFunc(s.authenticateAS),
 		MessageTypeHomeNSReq:  senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypeProfileReq: senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypeJoinAns:    senderAuthenticatorFunc(s.authenticateJS),
+		MessageTypeRejoinAns:  senderAuthenticatorFunc(s.authenticateJS),
+		MessageTypeAppSKeyAns: senderAuthenticatorFunc(s.authenticateJS),
+		MessageTypeHomeNSAns:  senderAuthenticatorFunc(s.authenticateJS),
+		MessageTypeProfileAns: senderAuthenticatorFunc(s.authenticateNS),
 	}
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -204,6 +272,7 @@ func (s *Server) handle() http.Handler {
 			"protocol_version", header.ProtocolVersion,
 			"sender_id", header.SenderID,
 			"receiver_id", header.ReceiverID,
+			"transaction_id", header.TransactionID,
 		))
 		ctx = log.NewContext(ctx, logger)
 
@@ -225,6 +294,19 @@ func (s *Server) handle() http.Handler {
 			return
 		}
 
+		if !header.MessageType.IsRequest() {
+			// The message is an answer to a request that was sent asynchronously.
+			for _, txs := range s.answers {
+				if txs.deliver(header, data) {
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+			}
+			logger.Debug("Unknown transaction")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
 		var msg interface{}
 		switch header.MessageType {
 		case MessageTypeJoinReq, MessageTypeRejoinReq:
@@ -233,6 +315,8 @@ func (s *Server) handle() http.Handler {
 			msg = &AppSKeyReq{}
 		case MessageTypeHomeNSReq:
 			msg = &HomeNSReq{}
+		case MessageTypeProfileReq:
+			msg = &ProfileReq{}
 		default:
 			writeError(w, r, header, ErrMalformedMessage.New())
 			return
@@ -243,23 +327,27 @@ func (s *Server) handle() http.Handler {
 			return
 		}
 
-		var ans interface{}
-		switch req := msg.(type) {
-		case *JoinReq:
-			ans, err = s.js.JoinRequest(ctx, req)
-		case *HomeNSReq:
-			// The registered Identity Server takes precedence over a registered Join Server to handle HomeNSRequest.
-			js := s.is
-			if js == nil {
-				js = s.js
-			}
-			ans, err = js.HomeNSRequest(ctx, req)
-		case *AppSKeyReq:
-			ans, err = s.js.AppSKeyRequest(ctx, req)
-		default:
-			writeError(w, r, header, ErrMalformedMessage.New())
+		if url, ok := s.asyncAnswerURLs[normalizeID(header.SenderID)]; ok && s.asyncAnswerSemaphore.TryAcquire(1) {
+			// The sender expects the answer to be delivered asynchronously to its URL. When too many answers are pending,
+			// the answer is returned synchronously instead.
+			w.WriteHeader(http.StatusOK)
+			go func() {
+				defer s.asyncAnswerSemaphore.Release(1)
+				ctx, cancel := context.WithTimeout(asyncContext{Context: s.ctx, values: ctx}, asyncAnswerTimeout)
+				defer cancel()
+				ans, err := s.handleRequest(ctx, msg)
+				if err != nil {
+					logger.WithError(err).Warn("Failed to handle request")
+					ans, _ = errorMessage(header, err)
+				}
+				if err := s.sendAnswer(ctx, url, ans); err != nil {
+					logger.WithError(err).WithField("url", url).Warn("Failed to send answer")
+				}
+			}()
 			return
 		}
+
+		ans, err := s.handleRequest(ctx, msg)
 		if err != nil {
 			logger.WithError(err).Warn("Failed to handle request")
 			writeError(w, r, header, err)
@@ -269,3 +357,47 @@ func (s *Server) handle() http.Handler {
 		json.NewEncoder(w).Encode(ans)
 	})
 }
+
+func (s *Server) handleRequest(ctx context.Context, msg interface{}) (interface{}, error) {
+	switch req := msg.(type) {
+	case *JoinReq:
+		return s.js.JoinRequest(ctx, req)
+	case *HomeNSReq:
+		// The registered Identity Server takes precedence over a registered Join Server to handle HomeNSRequest.
+		js := s.is
+		if js == nil {
+			js = s.js
+		}
+		return js.HomeNSRequest(ctx, req)
+	case *AppSKeyReq:
+		return s.js.AppSKeyRequest(ctx, req)
+	case *ProfileReq:
+		return s.ns.ProfileRequest(ctx, req)
+	default:
+		return nil, ErrMalformedMessage.New()
+	}
+}
+
+var errAnswerDelivery = errors.DefineUnavailable("answer_delivery", "deliver answer to `{url}`")
+
+// sendAnswer sends the answer to the given URL of the sender.
+func (s *Server) sendAnswer(ctx context.Context, url string, ans interface{}) error {
+	buf := &bytes.Buffer{}
+	if err := json.NewEncoder(buf).Encode(ans); err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return errAnswerDelivery.WithCause(err).WithAttributes("url", url)
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return errAnswerDelivery.WithCause(errors.FromHTTPStatusCode(res.StatusCode)).WithAttributes("url", url)
+	}
+	return nil
+}
@@ -33,17 +33,30 @@ type authInfo interface {
 type NetworkServerAuthInfo struct {
 	NetID     types.NetID
 	Addresses []string
+	// NSIDs are the NSIDs that the Network Server is allowed to use. If empty, any or no NSID is allowed.
+	NSIDs []types.EUI64
 }
 
 func (n NetworkServerAuthInfo) addressPatterns() []string { return n.Addresses }
 
 // Require returns an error if the given NetID or NSID does not match.
+// If NSIDs are configured, the NSID must be set.
 func (n NetworkServerAuthInfo) Require(netID types.NetID, nsID *EUI64) error {
 	if !n.NetID.Equal(netID) {
 		return errUnauthenticated.New()
 	}
-	// TODO: Verify NSID (https://github.com/TheThingsNetwork/lorawan-stack/issues/4741).
-	return nil
+	if len(n.NSIDs) == 0 {
+		return nil
+	}
+	if nsID == nil {
+		return errUnauthenticated.New()
+	}
+	for _, id := range n.NSIDs {
+		if id.Equal(types.EUI64(*nsID)) {
+			return nil
+		}
+	}
+	return errUnauthenticated.New()
 }
 
 type nsAuthInfoKeyType struct{}
@@ -96,6 +109,7 @@ type tokenVerifier interface {
 // If the client presents a TLS client certificate, it is verified against the trusted CAs of the NetID.
 // Any DNS names in the X.509 Subject Alternative Names are taken as address patterns used to verify the NSID. If there
 // are no DNS names, the Common Name is used as the single address pattern.
+// If NSIDs are configured for the NetID, the SenderNSID must be set to one of them.
 // If the TLS client certificate verification fails, this method returns an error.
 //
 // If the client presents a Bearer token in the Authorization header of the HTTP request, it is verified as token issued
@@ -122,6 +136,7 @@ func (s *Server) authenticateNS(ctx context.Context, r *http.Request, data []byt
 			return &NetworkServerAuthInfo{
 				NetID:     types.NetID(header.SenderID),
 				Addresses: addrs,
+				NSIDs:     s.senderNSIDs[types.NetID(header.SenderID).String()],
 			}, nil
 		},
 		// Verify token in a best-effort manner.
@@ -188,6 +203,28 @@ func (s *Server) authenticateAS(ctx context.Context, r *http.Request, data []byt
 	}), nil
 }
 
+// authenticateJS authenticates the client as a Join Server that delivers an answer asynchronously.
+// The client must present a TLS client certificate that is verified against the trusted CAs of the JoinEUI.
+func (s *Server) authenticateJS(ctx context.Context, r *http.Request, data []byte) (context.Context, error) {
+	var header struct {
+		MessageHeader
+		// SenderID is a JoinEUI.
+		SenderID EUI64
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, ErrMalformedMessage.WithCause(err)
+	}
+
+	state := r.TLS
+	if state == nil {
+		return nil, ErrUnknownSender.New()
+	}
+	if _, err := s.verifySenderCertificate(ctx, types.EUI64(header.SenderID).String(), state); err != nil {
+		return nil, ErrUnknownSender.WithCause(err)
+	}
+	return ctx, nil
+}
+
 type senderAuthenticatorFunc func(ctx context.Context, r *http.Request, data []byte) (context.Context, error)
 
 func (f senderAuthenticatorFunc) Authenticate(ctx context.Context, r *http.Request, data []byte) (context.Context, error) {
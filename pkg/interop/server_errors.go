@@ -22,11 +22,21 @@ import (
 )
 
 func writeError(w http.ResponseWriter, r *http.Request, header MessageHeader, err error) {
-	answerHeader, headerErr := header.AnswerHeader()
-	if headerErr != nil {
+	msg, ok := errorMessage(header, err)
+	if !ok {
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
+	json.NewEncoder(w).Encode(msg)
+}
+
+// errorMessage returns the answer message with the result of the given error.
+// If the message type of the header is not a request, this function returns false.
+func errorMessage(header MessageHeader, err error) (*ErrorMessage, bool) {
+	answerHeader, headerErr := header.AnswerHeader()
+	if headerErr != nil {
+		return nil, false
+	}
 
 	code, desc := ResultOther, err.Error()
 	for errDef, protocolCode := range errorResultCodes {
@@ -43,12 +53,11 @@ func writeError(w http.ResponseWriter, r *http.Request, header MessageHeader, er
 		}
 	}
 
-	msg := ErrorMessage{
+	return &ErrorMessage{
 		MessageHeader: answerHeader,
 		Result: Result{
 			ResultCode:  code,
 			Description: desc,
 		},
-	}
-	json.NewEncoder(w).Encode(msg)
+	}, true
 }
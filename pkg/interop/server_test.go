@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/smartystreets/assertions"
@@ -84,6 +85,7 @@ func TestServer(t *testing.T) {
 		JS                interop.JoinServer
 		ClientTLSConfig   *tls.Config
 		PacketBrokerToken bool
+		SenderNSIDs       map[string][]string
 		RequestBody       interface{}
 		ResponseAssertion func(*testing.T, *http.Response) bool
 	}{
@@ -246,6 +248,67 @@ func TestServer(t *testing.T) {
 					a.So(msg.ReceiverID, should.Resemble, interop.NetID{0x0, 0x0, 0x01})
 			},
 		},
+		{
+			Name:            "ClientTLS/JoinReq/InvalidSenderNSID",
+			ClientTLSConfig: makeClientTLSConfig(),
+			SenderNSIDs: map[string][]string{
+				"000001": {"0102030405060708"},
+			},
+			RequestBody: &interop.JoinReq{
+				NsJsMessageHeader: interop.NsJsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						MessageType:     interop.MessageTypeJoinReq,
+						ProtocolVersion: interop.ProtocolV1_1,
+					},
+					SenderID:   interop.NetID{0x0, 0x0, 0x01},
+					ReceiverID: interop.EUI64{0x42, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+					SenderNSID: &interop.EUI64{0x42, 0x42, 0x42, 0x0, 0x0, 0x0, 0x0, 0x0},
+				},
+				MACVersion: interop.MACVersion(ttnpb.MAC_V1_0_3),
+			},
+			ResponseAssertion: func(t *testing.T, res *http.Response) bool {
+				a := assertions.New(t)
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg interop.ErrorMessage
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				if !a.So(err, should.BeNil) {
+					return false
+				}
+				return a.So(msg.Result.ResultCode, should.Equal, interop.ResultUnknownSender)
+			},
+		},
+		{
+			Name:            "ClientTLS/JoinReq/NoSenderNSID",
+			ClientTLSConfig: makeClientTLSConfig(),
+			SenderNSIDs: map[string][]string{
+				"000001": {"0102030405060708"},
+			},
+			RequestBody: &interop.JoinReq{
+				NsJsMessageHeader: interop.NsJsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						MessageType:     interop.MessageTypeJoinReq,
+						ProtocolVersion: interop.ProtocolV1_1,
+					},
+					SenderID:   interop.NetID{0x0, 0x0, 0x01},
+					ReceiverID: interop.EUI64{0x42, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+				},
+				MACVersion: interop.MACVersion(ttnpb.MAC_V1_0_3),
+			},
+			ResponseAssertion: func(t *testing.T, res *http.Response) bool {
+				a := assertions.New(t)
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg interop.ErrorMessage
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				if !a.So(err, should.BeNil) {
+					return false
+				}
+				return a.So(msg.Result.ResultCode, should.Equal, interop.ResultUnknownSender)
+			},
+		},
 		{
 			Name: "PacketBroker/HomeNSReq/Success",
 			JS: mockTarget{
@@ -328,6 +391,7 @@ func TestServer(t *testing.T) {
 						TokenIssuer: pbIssuer, // Subject is the NSID and is used as authorized address.
 					},
 					PublicTLSAddress: "https://localhost", // Used as Packet Broker token audience.
+					SenderNSIDs:      tc.SenderNSIDs,
 				})
 				if !a.So(err, should.BeNil) {
 					t.Fatal("Failed to instantiate interop server")
@@ -365,3 +429,122 @@ func TestServer(t *testing.T) {
 		})
 	}
 }
+
+type mockNetworkServer struct {
+	ProfileRequestFunc func(context.Context, *interop.ProfileReq) (*interop.ProfileAns, error)
+}
+
+func (m mockNetworkServer) ProfileRequest(ctx context.Context, req *interop.ProfileReq) (*interop.ProfileAns, error) {
+	if m.ProfileRequestFunc != nil {
+		return m.ProfileRequestFunc(ctx, req)
+	}
+	panic("ProfileRequest called but not registered")
+}
+
+func TestServerAsync(t *testing.T) {
+	a, ctx := test.New(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	answerCh := make(chan *interop.ProfileAns, 1)
+	answerSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ans := &interop.ProfileAns{}
+		if err := json.NewDecoder(r.Body).Decode(ans); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		answerCh <- ans
+	}))
+	defer answerSrv.Close()
+
+	s, err := interop.NewServer(&mockComponent{ctx}, nil, config.InteropServer{
+		SenderClientCA: config.SenderClientCA{
+			Source:    "directory",
+			Directory: "testdata",
+		},
+		AsyncAnswerURLs: map[string]string{
+			// The sender ID is normalized, so that it matches the SenderID of the request.
+			"0x000001": answerSrv.URL,
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.Fatal("Failed to instantiate interop server")
+	}
+	s.RegisterNS(mockNetworkServer{
+		ProfileRequestFunc: func(ctx context.Context, req *interop.ProfileReq) (*interop.ProfileAns, error) {
+			if err := (interop.Authorizer{}).RequireNetID(ctx, types.NetID{0x0, 0x0, 0x1}); err != nil {
+				return nil, err
+			}
+			return &interop.ProfileAns{
+				NsNsMessageHeader: interop.NsNsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						ProtocolVersion: req.ProtocolVersion,
+						MessageType:     interop.MessageTypeProfileAns,
+						TransactionID:   req.TransactionID,
+					},
+					SenderID:     req.ReceiverID,
+					ReceiverID:   req.SenderID,
+					ReceiverNSID: req.SenderNSID,
+				},
+				Result: interop.Result{
+					ResultCode: interop.ResultSuccess,
+				},
+				DeviceProfile: &interop.DeviceProfile{
+					MACVersion:   interop.MACVersion(ttnpb.MAC_V1_0_3),
+					SupportsJoin: true,
+					RFRegion:     "EU868",
+				},
+				RoamingActivationType: interop.RoamingActivationPassive,
+			}, nil
+		},
+	})
+
+	srv := newTLSServer(s)
+	defer srv.Close()
+
+	buf, err := json.Marshal(&interop.ProfileReq{
+		NsNsMessageHeader: interop.NsNsMessageHeader{
+			MessageHeader: interop.MessageHeader{
+				MessageType:     interop.MessageTypeProfileReq,
+				ProtocolVersion: interop.ProtocolV1_1,
+				TransactionID:   42,
+			},
+			SenderID:   interop.NetID{0x0, 0x0, 0x01},
+			ReceiverID: interop.NetID{0x0, 0x0, 0x02},
+			SenderNSID: &interop.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+		},
+		DevEUI: interop.EUI64{0x42, 0xff, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+	})
+	if !a.So(err, should.BeNil) {
+		t.Fatal("Failed to marshal request body")
+	}
+	req := test.Must(http.NewRequest(http.MethodPost, srv.URL, bytes.NewReader(buf))).(*http.Request)
+	req.Header.Set("Content-Type", "application/json")
+
+	client := srv.Client()
+	client.Transport.(*http.Transport).TLSClientConfig = makeClientTLSConfig()
+	res, err := client.Do(req)
+	if !a.So(err, should.BeNil) {
+		t.Fatal("Request failed")
+	}
+	defer res.Body.Close()
+	a.So(res.StatusCode, should.Equal, http.StatusOK)
+	a.So(res.ContentLength, should.BeZeroValue)
+
+	select {
+	case <-ctx.Done():
+		t.Fatal("Timed out waiting for answer")
+	case ans := <-answerCh:
+		a.So(ans.TransactionID, should.Equal, 42)
+		a.So(ans.Result.ResultCode, should.Equal, interop.ResultSuccess)
+		a.So(ans.SenderID, should.Resemble, interop.NetID{0x0, 0x0, 0x02})
+		a.So(ans.ReceiverID, should.Resemble, interop.NetID{0x0, 0x0, 0x01})
+		a.So(ans.ReceiverNSID, should.Resemble, &interop.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08})
+		a.So(ans.DeviceProfile, should.Resemble, &interop.DeviceProfile{
+			MACVersion:   interop.MACVersion(ttnpb.MAC_V1_0_3),
+			SupportsJoin: true,
+			RFRegion:     "EU868",
+		})
+		a.So(ans.RoamingActivationType, should.Equal, interop.RoamingActivationPassive)
+	}
+}
@@ -102,3 +102,15 @@ func (cleaner *RegistryCleaner) CleanData(ctx context.Context, isDeviceSet, isAp
 	}
 	return nil
 }
+
+// DeleteApplicationData deletes registry application and device data of the applications in the application list
+// and their devices.
+func (cleaner *RegistryCleaner) DeleteApplicationData(ctx context.Context, applicationList []string) error {
+	if err := cleaner.RangeToLocalSet(ctx); err != nil {
+		return err
+	}
+	return cleaner.DeleteApplicationAndDeviceData(ctx,
+		cleanup.ComputeDevicesOfApplications(ctx, cleaner.LocalDeviceSet, applicationList),
+		cleanup.ComputeSetIntersection(cleaner.LocalApplicationSet, applicationList),
+	)
+}
@@ -14,13 +14,22 @@
 
 package joinserver
 
-import "go.thethings.network/lorawan-stack/v3/pkg/types"
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
 
 // Config represents the JoinServer configuration.
 type Config struct {
 	Devices                       DeviceRegistry                       `name:"-"`
 	Keys                          KeyRegistry                          `name:"-"`
 	ApplicationActivationSettings ApplicationActivationSettingRegistry `name:"-"`
+	JoinEUIRanges                 JoinEUIRangeRegistry                 `name:"-"`
+	JoinEUIRangesCacheTTL         time.Duration                        `name:"join-eui-ranges-cache-ttl" description:"Time for which the JoinEUI ranges are cached to match join-requests. Changes through other Join Server instances take effect after this time (0 is disabled)"`
 	JoinEUIPrefixes               []types.EUI64Prefix                  `name:"join-eui-prefix" description:"JoinEUI prefixes handled by this JS"`
 	DeviceKEKLabel                string                               `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
+	KeyVaultRootKeyLabelPrefixes  []string                             `name:"key-vault-root-key-label-prefix" description:"Label prefixes of root keys held by the key vault that end devices can reference. {application_id} is replaced by the application ID of the end device"`
+	CleanupPurgedApplications     bool                                 `name:"cleanup-purged-applications" description:"Delete the devices and activation settings of applications that are purged from the Identity Server"`
+	CleanupReconcileInterval      time.Duration                        `name:"cleanup-reconcile-interval" description:"Interval at which the data of applications and devices that no longer exist in the Identity Server is deleted, if cleanup of purged applications is enabled (0 is disabled)"`
 }
@@ -17,20 +17,32 @@ package joinserver
 import "go.thethings.network/lorawan-stack/v3/pkg/errors"
 
 var (
+	errApplicationServerIDNotAllowed  = errors.DefinePermissionDenied("application_server_id_not_allowed", "AS-ID `{id}` is not allowed in JoinEUI range `{prefix}`")
+	errApplicationServerNotAllowed    = errors.DefinePermissionDenied("application_server_not_allowed", "Application Server is not allowed in JoinEUI range `{prefix}`")
 	errComputeMIC                     = errors.DefineInvalidArgument("compute_mic", "failed to compute MIC")
 	errDecodePayload                  = errors.DefineInvalidArgument("decode_payload", "failed to decode payload")
 	errDeriveAppSKey                  = errors.Define("derive_app_s_key", "failed to derive application session key")
 	errDeriveNwkSKeys                 = errors.Define("derive_nwk_s_keys", "failed to derive network session keys")
+	errDeviceJoinRate                 = errors.DefineResourceExhausted("device_join_rate", "join-request rate of device exceeded")
 	errDeviceNotFound                 = errors.DefineNotFound("device_not_found", "device not found")
+	errDevNonceCounterReset           = errors.DefineInvalidArgument("dev_nonce_counter_reset", "DevNonce `{dev_nonce}` indicates a reset of the DevNonce counter")
+	errDevNonceReplay                 = errors.DefineInvalidArgument("dev_nonce_replay", "DevNonce `{dev_nonce}` indicates a replayed join-request")
 	errDevNonceTooSmall               = errors.DefineInvalidArgument("dev_nonce_too_small", "DevNonce is too small")
 	errDuplicateIdentifiers           = errors.DefineAlreadyExists("duplicate_identifiers", "a device identified by the identifiers already exists")
 	errEncodePayload                  = errors.DefineInvalidArgument("encode_payload", "failed to encode payload")
 	errEncryptPayload                 = errors.Define("encrypt_payload", "failed to encrypt JoinAccept")
 	errGenerateSessionKeyID           = errors.Define("generate_session_key_id", "failed to generate session key ID")
+	errInvalidJoinEUIRange            = errors.DefineInvalidArgument("invalid_join_eui_range", "invalid JoinEUI range `{prefix}`")
+	errJoinEUIJoinRate                = errors.DefineResourceExhausted("join_eui_join_rate", "join-request rate of JoinEUI `{join_eui}` exceeded")
+	errJoinEUIRangeNoOwner            = errors.DefineInvalidArgument("join_eui_range_no_owner", "JoinEUI range `{prefix}` must be allocated to either an application or an organization")
+	errJoinEUIRangeOwner              = errors.DefinePermissionDenied("join_eui_range_owner", "JoinEUI `{join_eui}` is allocated to a different owner")
 	errJoinNonceTooHigh               = errors.Define("join_nonce_too_high", "JoinNonce is too high")
 	errLookupNetID                    = errors.Define("lookup_net_id", "lookup NetID")
 	errMICMismatch                    = errors.DefineInvalidArgument("mic_mismatch", "MIC mismatch")
 	errNetIDMismatch                  = errors.DefineInvalidArgument("net_id_mismatch", "NetID `{net_id}` does not match")
+	errNetIDNotAllowed                = errors.DefinePermissionDenied("net_id_not_allowed", "NetID `{net_id}` is not allowed in JoinEUI range `{prefix}`")
+	errNetworkServerAddressNotAllowed = errors.DefinePermissionDenied("network_server_address_not_allowed", "Network Server address `{address}` is not allowed in JoinEUI range `{prefix}`")
+	errNetworkServerNotAllowed        = errors.DefinePermissionDenied("network_server_not_allowed", "Network Server is not allowed in JoinEUI range `{prefix}`")
 	errNoAppKey                       = errors.DefineFailedPrecondition("no_app_key", "no AppKey specified")
 	errNoApplicationServerID          = errors.DefineFailedPrecondition("no_application_server_id", "no AS-ID specified")
 	errNoAppSKey                      = errors.DefineCorruption("no_app_s_key", "no AppSKey specified")
@@ -34,6 +34,15 @@ func (srv jsServer) GetJoinEUIPrefixes(ctx context.Context, _ *pbtypes.Empty) (*
 			Length:  uint32(p.Length),
 		})
 	}
+	if srv.JS.joinEUIRanges != nil {
+		ranges, err := srv.JS.joinEUIRanges.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range ranges {
+			prefixes = append(prefixes, r.Prefix)
+		}
+	}
 	return &ttnpb.JoinEUIPrefixes{
 		Prefixes: prefixes,
 	}, nil
@@ -99,6 +99,7 @@ func (srv jsEndDeviceRegistryServer) Get(ctx context.Context, req *ttnpb.GetEndD
 	if !dev.ApplicationIdentifiers.Equal(req.ApplicationIdentifiers) {
 		return nil, errDeviceNotFound.New()
 	}
+	paths := req.FieldMask.GetPaths()
 	if ttnpb.HasAnyField(req.FieldMask.GetPaths(),
 		"root_keys.app_key.key",
 		"root_keys.nwk_key.key",
@@ -129,6 +130,12 @@ func (srv jsEndDeviceRegistryServer) Get(ctx context.Context, req *ttnpb.GetEndD
 				dev.RootKeys.NwkKey = &ttnpb.KeyEnvelope{
 					Key: &nwkKey,
 				}
+			case rootKeysEnc.GetNwkKey().GetKekLabel() != "":
+				// The root key is held by the key vault and cannot be exported.
+				dev.RootKeys.NwkKey = &ttnpb.KeyEnvelope{
+					KekLabel: rootKeysEnc.GetNwkKey().GetKekLabel(),
+				}
+				paths = ttnpb.AddFields(paths, "root_keys.nwk_key.kek_label")
 			case cc != nil && dev.ProvisionerId != "":
 				nwkKey, err := cryptoservices.NewNetworkRPCClient(cc, srv.JS.KeyVault, srv.JS.WithClusterAuth()).GetNwkKey(ctx, dev)
 				if err != nil {
@@ -156,6 +163,12 @@ func (srv jsEndDeviceRegistryServer) Get(ctx context.Context, req *ttnpb.GetEndD
 				dev.RootKeys.AppKey = &ttnpb.KeyEnvelope{
 					Key: &appKey,
 				}
+			case rootKeysEnc.GetAppKey().GetKekLabel() != "":
+				// The root key is held by the key vault and cannot be exported.
+				dev.RootKeys.AppKey = &ttnpb.KeyEnvelope{
+					KekLabel: rootKeysEnc.GetAppKey().GetKekLabel(),
+				}
+				paths = ttnpb.AddFields(paths, "root_keys.app_key.kek_label")
 			case cc != nil && dev.ProvisionerId != "":
 				appKey, err := cryptoservices.NewApplicationRPCClient(cc, srv.JS.KeyVault, srv.JS.WithClusterAuth()).GetAppKey(ctx, dev)
 				if err != nil {
@@ -169,12 +182,13 @@ func (srv jsEndDeviceRegistryServer) Get(ctx context.Context, req *ttnpb.GetEndD
 			}
 		}
 	}
-	return ttnpb.FilterGetEndDevice(dev, req.FieldMask.GetPaths()...)
+	return ttnpb.FilterGetEndDevice(dev, paths...)
 }
 
 var (
 	errInvalidFieldMask  = errors.DefineInvalidArgument("field_mask", "invalid field mask")
 	errInvalidFieldValue = errors.DefineInvalidArgument("field_value", "invalid value of field `{field}`")
+	errRootKeyAndLabel   = errors.DefineInvalidArgument("root_key_and_label", "root key `{key}` and its KEK label cannot be set together")
 )
 
 // Set implements ttnpb.JsEndDeviceRegistryServer.
@@ -190,11 +204,28 @@ func (srv jsEndDeviceRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndD
 		return nil, errInvalidFieldValue.WithAttributes("field", "root_keys.app_key.key")
 	}
 
+	for _, k := range []string{"app_key", "nwk_key"} {
+		if !ttnpb.HasAnyField(req.FieldMask.GetPaths(), "root_keys."+k+".kek_label") {
+			continue
+		}
+		if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "root_keys."+k+".key") {
+			return nil, errInvalidFieldMask.WithCause(errRootKeyAndLabel.WithAttributes("key", k))
+		}
+	}
+	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "root_keys.app_key.kek_label") && req.EndDevice.GetRootKeys().GetAppKey().GetKekLabel() == "" {
+		return nil, errInvalidFieldValue.WithAttributes("field", "root_keys.app_key.kek_label")
+	}
+	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "root_keys.nwk_key.kek_label") && req.EndDevice.GetRootKeys().GetNwkKey().GetKekLabel() == "" {
+		return nil, errInvalidFieldValue.WithAttributes("field", "root_keys.nwk_key.kek_label")
+	}
+
 	if err = rights.RequireApplication(ctx, req.EndDevice.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
 	if ttnpb.HasAnyField(req.FieldMask.GetPaths(),
+		"root_keys.app_key.kek_label",
 		"root_keys.app_key.key",
+		"root_keys.nwk_key.kek_label",
 		"root_keys.nwk_key.key",
 		"root_keys.root_key_id",
 	) {
@@ -202,6 +233,41 @@ func (srv jsEndDeviceRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndD
 			return nil, err
 		}
 	}
+	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "root_keys.app_key.kek_label") {
+		if err := srv.JS.checkRootKeyLabel(req.EndDevice.ApplicationIdentifiers, req.EndDevice.RootKeys.AppKey.KekLabel); err != nil {
+			return nil, err
+		}
+	}
+	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "root_keys.nwk_key.kek_label") {
+		if err := srv.JS.checkRootKeyLabel(req.EndDevice.ApplicationIdentifiers, req.EndDevice.RootKeys.NwkKey.KekLabel); err != nil {
+			return nil, err
+		}
+	}
+
+	joinEUIRange, err := srv.JS.joinEUIRange(ctx, *req.EndDevice.JoinEui)
+	if err != nil {
+		return nil, err
+	}
+	if joinEUIRange != nil {
+		if err := srv.JS.requireJoinEUIRangeOwner(ctx, joinEUIRange, *req.EndDevice.JoinEui, req.EndDevice.ApplicationIdentifiers); err != nil {
+			return nil, err
+		}
+		if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "net_id") && req.EndDevice.NetId != nil {
+			if err := joinEUIRange.RequireNetID(*req.EndDevice.NetId); err != nil {
+				return nil, err
+			}
+		}
+		if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "network_server_address") && req.EndDevice.NetworkServerAddress != "" {
+			if err := joinEUIRange.RequireNetworkServerAddress(req.EndDevice.NetworkServerAddress); err != nil {
+				return nil, err
+			}
+		}
+		if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "application_server_id") && req.EndDevice.ApplicationServerId != "" {
+			if err := joinEUIRange.RequireApplicationServerID(req.EndDevice.ApplicationServerId); err != nil {
+				return nil, err
+			}
+		}
+	}
 
 	sets := append(req.FieldMask.GetPaths()[:0:0], req.FieldMask.GetPaths()...)
 	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "root_keys.app_key.key") {
@@ -241,6 +307,16 @@ func (srv jsEndDeviceRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndD
 		)
 	}
 
+	// Root keys that are held by the key vault are referenced by KEK label only.
+	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "root_keys.app_key.kek_label") {
+		req.EndDevice.RootKeys.AppKey.EncryptedKey = nil
+		sets = ttnpb.AddFields(sets, "root_keys.app_key.encrypted_key")
+	}
+	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "root_keys.nwk_key.kek_label") {
+		req.EndDevice.RootKeys.NwkKey.EncryptedKey = nil
+		sets = ttnpb.AddFields(sets, "root_keys.nwk_key.encrypted_key")
+	}
+
 	var evt events.Event
 	dev, err = srv.JS.devices.SetByID(ctx, req.EndDevice.ApplicationIdentifiers, req.EndDevice.DeviceId, req.FieldMask.GetPaths(), func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 		if dev != nil {
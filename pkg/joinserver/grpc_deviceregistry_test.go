@@ -318,6 +318,50 @@ func TestDeviceRegistryGet(t *testing.T) {
 			GetByIDCalls: 1,
 		},
 
+		{
+			Name: "Get keys/key vault root keys",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationId: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+							ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+						),
+					},
+				})
+			},
+			GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, error) {
+				ret := CopyEndDevice(registeredDevice)
+				ret.RootKeys.AppKey = &ttnpb.KeyEnvelope{
+					KekLabel: "hsm-app-key",
+				}
+				ret.RootKeys.NwkKey = &ttnpb.KeyEnvelope{
+					KekLabel: "hsm-nwk-key",
+				}
+				return ret, nil
+			},
+			DeviceRequest: &ttnpb.GetEndDeviceRequest{
+				EndDeviceIdentifiers: deepcopy.Copy(registeredDevice.EndDeviceIdentifiers).(ttnpb.EndDeviceIdentifiers),
+				FieldMask: &pbtypes.FieldMask{
+					Paths: []string{"ids", "root_keys.app_key.key", "root_keys.nwk_key.key"},
+				},
+			},
+			DeviceAssertion: func(t *testing.T, dev *ttnpb.EndDevice) bool {
+				a := assertions.New(t)
+				expected := CopyEndDevice(registeredDevice)
+				expected.RootKeys = &ttnpb.RootKeys{
+					NwkKey: &ttnpb.KeyEnvelope{
+						KekLabel: "hsm-nwk-key",
+					},
+					AppKey: &ttnpb.KeyEnvelope{
+						KekLabel: "hsm-app-key",
+					},
+				}
+				return a.So(dev, should.Resemble, expected)
+			},
+			GetByIDCalls: 1,
+		},
+
 		{
 			Name: "Get keys/AppKey plaintext/NwkKey encrypted",
 			ContextFunc: func(ctx context.Context) context.Context {
@@ -439,6 +483,19 @@ func TestDeviceRegistrySet(t *testing.T) {
 	registeredAppKey := &types.AES128Key{0x0, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}
 	unregisteredJoinEUI := eui64Ptr(types.EUI64{0x42, 0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
 	unregisteredDevEUI := eui64Ptr(types.EUI64{0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})
+	rangeJoinEUI := eui64Ptr(types.EUI64{0x42, 0x42, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00})
+	otherRangeJoinEUI := eui64Ptr(types.EUI64{0x42, 0x42, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00})
+	joinEUIRanges := []*ttnpb.JoinEUIRange{
+		{
+			Prefix:         &ttnpb.JoinEUIPrefix{JoinEui: *otherRangeJoinEUI, Length: 24},
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "other-application"},
+		},
+		{
+			Prefix:         &ttnpb.JoinEUIPrefix{JoinEui: *rangeJoinEUI, Length: 24},
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: registeredApplicationID},
+			NetIds:         []types.NetID{{0x00, 0x00, 0x13}},
+		},
+	}
 	keyVault := cryptoutil.NewMemKeyVault(map[string][]byte{
 		"test": {0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf},
 	})
@@ -558,6 +615,141 @@ func TestDeviceRegistrySet(t *testing.T) {
 			},
 		},
 
+		{
+			Name: "JoinEUI range of other application",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), deepcopy.Copy(registeredDevice.EndDeviceIdentifiers.ApplicationIdentifiers).(ttnpb.ApplicationIdentifiers)): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					},
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				EndDevice: ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+							ApplicationId: registeredApplicationID,
+						},
+						DeviceId: registeredDeviceID,
+						JoinEui:  otherRangeJoinEUI,
+						DevEui:   registeredDevEUI,
+					},
+				},
+				FieldMask: &pbtypes.FieldMask{
+					Paths: []string{"ids"},
+				},
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				test.MustTFromContext(ctx).Errorf("SetByIDFunc must not be called")
+				return nil, errors.New("SetByIDFunc must not be called")
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(err, should.HaveSameErrorDefinitionAs, ErrJoinEUIRangeOwner)
+			},
+		},
+
+		{
+			Name: "NetID not allowed in JoinEUI range",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), deepcopy.Copy(registeredDevice.EndDeviceIdentifiers.ApplicationIdentifiers).(ttnpb.ApplicationIdentifiers)): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					},
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				EndDevice: ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+							ApplicationId: registeredApplicationID,
+						},
+						DeviceId: registeredDeviceID,
+						JoinEui:  rangeJoinEUI,
+						DevEui:   registeredDevEUI,
+					},
+					NetId: &types.NetID{0x00, 0x00, 0x42},
+				},
+				FieldMask: &pbtypes.FieldMask{
+					Paths: []string{"ids", "net_id"},
+				},
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				test.MustTFromContext(ctx).Errorf("SetByIDFunc must not be called")
+				return nil, errors.New("SetByIDFunc must not be called")
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(err, should.HaveSameErrorDefinitionAs, ErrNetIDNotAllowed)
+			},
+		},
+
+		{
+			Name: "Set key and KEK label",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), deepcopy.Copy(registeredDevice.EndDeviceIdentifiers.ApplicationIdentifiers).(ttnpb.ApplicationIdentifiers)): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+						),
+					},
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				EndDevice: *CopyEndDevice(registeredDevice),
+				FieldMask: &pbtypes.FieldMask{
+					Paths: []string{"root_keys.app_key.kek_label", "root_keys.app_key.key"},
+				},
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				test.MustTFromContext(ctx).Errorf("SetByIDFunc must not be called")
+				return nil, errors.New("SetByIDFunc must not be called")
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+		},
+
+		{
+			Name: "Set KEK label of other application",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), deepcopy.Copy(registeredDevice.EndDeviceIdentifiers.ApplicationIdentifiers).(ttnpb.ApplicationIdentifiers)): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+						),
+					},
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				EndDevice: *func() *ttnpb.EndDevice {
+					dev := CopyEndDevice(registeredDevice)
+					dev.RootKeys.AppKey = &ttnpb.KeyEnvelope{
+						KekLabel: "other-app:app-key",
+					}
+					return dev
+				}(),
+				FieldMask: &pbtypes.FieldMask{
+					Paths: []string{"root_keys.app_key.kek_label"},
+				},
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				test.MustTFromContext(ctx).Errorf("SetByIDFunc must not be called")
+				return nil, errors.New("SetByIDFunc must not be called")
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsPermissionDenied(err), should.BeTrue)
+			},
+		},
+
 		{
 			Name: "Create",
 			ContextFunc: func(ctx context.Context) context.Context {
@@ -785,12 +977,18 @@ func TestDeviceRegistrySet(t *testing.T) {
 			js := test.Must(New(
 				componenttest.NewComponent(t, &component.Config{}),
 				&Config{
+					KeyVaultRootKeyLabelPrefixes: []string{"{application_id}:"},
 					Devices: &MockDeviceRegistry{
 						SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
 							atomic.AddUint64(&setByIDCalls, 1)
 							return tc.SetByIDFunc(ctx, appID, devID, paths, cb)
 						},
 					},
+					JoinEUIRanges: &MockJoinEUIRangeRegistry{
+						ListFunc: func(context.Context) ([]*ttnpb.JoinEUIRange, error) {
+							return joinEUIRanges, nil
+						},
+					},
 				},
 			)).(*JoinServer)
 			js.KeyVault = keyVault
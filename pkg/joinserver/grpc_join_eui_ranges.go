@@ -0,0 +1,119 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	errInvalidPrefix       = errors.DefineInvalidArgument("invalid_prefix", "invalid JoinEUI prefix `{join_eui}/{length}`")
+	errJoinEUIRangeOverlap = errors.DefineAlreadyExists("join_eui_range_overlap", "JoinEUI range `{prefix}` overlaps with `{other}`")
+)
+
+// jsJoinEUIRangeRegistryServer implements ttnpb.JsJoinEUIRangeRegistryServer.
+// Only admins can manage JoinEUI ranges.
+type jsJoinEUIRangeRegistryServer struct {
+	JS *JoinServer
+}
+
+// parsePrefix returns the JoinEUI prefix with the given JoinEUI in hexadecimal notation and length.
+// The bits of the JoinEUI beyond the length are cleared, as JoinEUI ranges are stored by their masked prefix.
+func parsePrefix(joinEUI string, length uint32) (types.EUI64Prefix, error) {
+	prefix := types.EUI64Prefix{Length: uint8(length)}
+	if err := prefix.EUI64.UnmarshalText([]byte(joinEUI)); err != nil {
+		return types.EUI64Prefix{}, errInvalidPrefix.WithAttributes("join_eui", joinEUI, "length", length).WithCause(err)
+	}
+	prefix.EUI64 = prefix.EUI64.Mask(prefix.Length)
+	return prefix, nil
+}
+
+// List implements ttnpb.JsJoinEUIRangeRegistryServer.
+func (srv jsJoinEUIRangeRegistryServer) List(ctx context.Context, _ *pbtypes.Empty) (*ttnpb.JoinEUIRanges, error) {
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	ranges, err := srv.JS.joinEUIRanges.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.JoinEUIRanges{
+		Ranges: ranges,
+	}, nil
+}
+
+// Get implements ttnpb.JsJoinEUIRangeRegistryServer.
+func (srv jsJoinEUIRangeRegistryServer) Get(ctx context.Context, req *ttnpb.GetJoinEUIRangeRequest) (*ttnpb.JoinEUIRange, error) {
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	prefix, err := parsePrefix(req.JoinEui, req.Length)
+	if err != nil {
+		return nil, err
+	}
+	return srv.JS.joinEUIRanges.Get(ctx, prefix)
+}
+
+// Set implements ttnpb.JsJoinEUIRangeRegistryServer.
+func (srv jsJoinEUIRangeRegistryServer) Set(ctx context.Context, req *ttnpb.SetJoinEUIRangeRequest) (*ttnpb.JoinEUIRange, error) {
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	r := joinEUIRange{req.JoinEuiRange}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	r.Prefix.JoinEui = r.Prefix.JoinEui.Mask(uint8(r.Prefix.Length))
+	prefix := r.EUI64Prefix()
+	if err := srv.JS.joinEUIRanges.Set(ctx, prefix, func(ranges []*ttnpb.JoinEUIRange) (*ttnpb.JoinEUIRange, error) {
+		// JoinEUI ranges may not overlap, as the JoinEUIs in a range belong to a single owner.
+		for _, other := range ranges {
+			otherPrefix := joinEUIPrefix(other.Prefix)
+			if otherPrefix.Equal(prefix) || !r.Overlaps(otherPrefix) {
+				continue
+			}
+			return nil, errJoinEUIRangeOverlap.WithAttributes("prefix", prefix, "other", otherPrefix)
+		}
+		return req.JoinEuiRange, nil
+	}); err != nil {
+		return nil, err
+	}
+	srv.JS.joinEUIRangeCache.invalidate()
+	return req.JoinEuiRange, nil
+}
+
+// Delete implements ttnpb.JsJoinEUIRangeRegistryServer.
+func (srv jsJoinEUIRangeRegistryServer) Delete(ctx context.Context, req *ttnpb.DeleteJoinEUIRangeRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	prefix, err := parsePrefix(req.JoinEui, req.Length)
+	if err != nil {
+		return nil, err
+	}
+	if err := srv.JS.joinEUIRanges.Set(ctx, prefix, func([]*ttnpb.JoinEUIRange) (*ttnpb.JoinEUIRange, error) {
+		return nil, nil
+	}); err != nil {
+		return nil, err
+	}
+	srv.JS.joinEUIRangeCache.invalidate()
+	return ttnpb.Empty, nil
+}
@@ -0,0 +1,289 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// joinEUIPrefix returns the EUI64 prefix of the JoinEUI prefix.
+func joinEUIPrefix(p *ttnpb.JoinEUIPrefix) types.EUI64Prefix {
+	if p == nil {
+		return types.EUI64Prefix{}
+	}
+	return types.EUI64Prefix{
+		EUI64:  p.JoinEui,
+		Length: uint8(p.Length),
+	}
+}
+
+// joinEUIRange is a range of JoinEUIs that is allocated to an application or organization.
+// The allow-lists restrict the NetIDs, Network Servers and Application Servers of the end devices in the range.
+// An empty allow-list allows all.
+type joinEUIRange struct {
+	*ttnpb.JoinEUIRange
+}
+
+// EUI64Prefix returns the EUI64 prefix of the JoinEUI range.
+func (r joinEUIRange) EUI64Prefix() types.EUI64Prefix {
+	return joinEUIPrefix(r.Prefix)
+}
+
+// Validate returns an error if the JoinEUI range is invalid.
+func (r joinEUIRange) Validate() error {
+	if err := r.ValidateFields(); err != nil {
+		return errInvalidJoinEUIRange.WithAttributes("prefix", r.EUI64Prefix()).WithCause(err)
+	}
+	if length := r.Prefix.Length; length == 0 || length > 64 {
+		return errInvalidJoinEUIRange.WithAttributes("prefix", r.EUI64Prefix())
+	}
+	if (r.ApplicationIds == nil) == (r.OrganizationIds == nil) {
+		return errJoinEUIRangeNoOwner.WithAttributes("prefix", r.EUI64Prefix())
+	}
+	return nil
+}
+
+// Overlaps returns true if the JoinEUI range overlaps with the given prefix.
+func (r joinEUIRange) Overlaps(prefix types.EUI64Prefix) bool {
+	return r.EUI64Prefix().Matches(prefix.EUI64) || prefix.Matches(r.Prefix.JoinEui)
+}
+
+// RequireNetID returns an error if the NetID is not allowed in the JoinEUI range.
+func (r joinEUIRange) RequireNetID(netID types.NetID) error {
+	if len(r.NetIds) == 0 {
+		return nil
+	}
+	for _, id := range r.NetIds {
+		if id.Equal(netID) {
+			return nil
+		}
+	}
+	return errNetIDNotAllowed.WithAttributes("net_id", netID, "prefix", r.EUI64Prefix())
+}
+
+// RequireNetworkServerAddress returns an error if the Network Server address is not allowed in the JoinEUI range.
+func (r joinEUIRange) RequireNetworkServerAddress(addr string) error {
+	if len(r.NetworkServerAddresses) == 0 {
+		return nil
+	}
+	for _, allowed := range r.NetworkServerAddresses {
+		if strings.EqualFold(allowed, addr) {
+			return nil
+		}
+	}
+	return errNetworkServerAddressNotAllowed.WithAttributes("address", addr, "prefix", r.EUI64Prefix())
+}
+
+// RequireApplicationServerID returns an error if the AS-ID is not allowed in the JoinEUI range.
+func (r joinEUIRange) RequireApplicationServerID(id string) error {
+	if len(r.ApplicationServerIds) == 0 {
+		return nil
+	}
+	for _, allowed := range r.ApplicationServerIds {
+		if allowed == id {
+			return nil
+		}
+	}
+	return errApplicationServerIDNotAllowed.WithAttributes("id", id, "prefix", r.EUI64Prefix())
+}
+
+// RequireNetworkServer returns an error if the Network Server that the authorizer authenticated is not allowed in the
+// JoinEUI range.
+func (r joinEUIRange) RequireNetworkServer(ctx context.Context, authorizer ExternalAuthorizer) error {
+	if len(r.NetIds) > 0 {
+		authorized := false
+		for _, netID := range r.NetIds {
+			if authorizer.RequireNetID(ctx, netID) == nil {
+				authorized = true
+				break
+			}
+		}
+		if !authorized {
+			return errNetworkServerNotAllowed.WithAttributes("prefix", r.EUI64Prefix())
+		}
+	}
+	if len(r.NetworkServerAddresses) > 0 {
+		authorized := false
+		for _, addr := range r.NetworkServerAddresses {
+			if authorizer.RequireAddress(ctx, addr) == nil {
+				authorized = true
+				break
+			}
+		}
+		if !authorized {
+			return errNetworkServerNotAllowed.WithAttributes("prefix", r.EUI64Prefix())
+		}
+	}
+	return nil
+}
+
+// RequireApplicationServer returns an error if the Application Server that the authorizer authenticated is not
+// allowed in the JoinEUI range.
+func (r joinEUIRange) RequireApplicationServer(ctx context.Context, authorizer ExternalAuthorizer) error {
+	if len(r.ApplicationServerIds) == 0 {
+		return nil
+	}
+	for _, id := range r.ApplicationServerIds {
+		if authorizer.RequireASID(ctx, id) == nil {
+			return nil
+		}
+	}
+	return errApplicationServerNotAllowed.WithAttributes("prefix", r.EUI64Prefix())
+}
+
+// joinEUIRangeIndex indexes JoinEUI ranges by their masked prefix.
+type joinEUIRangeIndex struct {
+	// lengths are the distinct prefix lengths, most specific first.
+	lengths []uint8
+	ranges  map[types.EUI64Prefix]*ttnpb.JoinEUIRange
+}
+
+// newJoinEUIRangeIndex returns the index of the given JoinEUI ranges.
+func newJoinEUIRangeIndex(ranges []*ttnpb.JoinEUIRange) *joinEUIRangeIndex {
+	idx := &joinEUIRangeIndex{
+		ranges: make(map[types.EUI64Prefix]*ttnpb.JoinEUIRange, len(ranges)),
+	}
+	for _, r := range ranges {
+		prefix := joinEUIPrefix(r.Prefix)
+		prefix.EUI64 = prefix.EUI64.Mask(prefix.Length)
+		if _, ok := idx.ranges[prefix]; ok {
+			continue
+		}
+		idx.ranges[prefix] = r
+		if i := sort.Search(len(idx.lengths), func(i int) bool {
+			return idx.lengths[i] <= prefix.Length
+		}); i == len(idx.lengths) || idx.lengths[i] != prefix.Length {
+			idx.lengths = append(idx.lengths, 0)
+			copy(idx.lengths[i+1:], idx.lengths[i:])
+			idx.lengths[i] = prefix.Length
+		}
+	}
+	return idx
+}
+
+// match returns the most specific JoinEUI range that contains the JoinEUI, or nil if there is none.
+func (idx *joinEUIRangeIndex) match(joinEUI types.EUI64) *ttnpb.JoinEUIRange {
+	for _, length := range idx.lengths {
+		if r, ok := idx.ranges[types.EUI64Prefix{EUI64: joinEUI.Mask(length), Length: length}]; ok {
+			return r
+		}
+	}
+	return nil
+}
+
+// joinEUIRangeCache caches the index of the JoinEUI ranges in the registry.
+type joinEUIRangeCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	index   *joinEUIRangeIndex
+	expires time.Time
+}
+
+// invalidate invalidates the cached index, so that the next lookup lists the JoinEUI ranges.
+func (c *joinEUIRangeCache) invalidate() {
+	c.mu.Lock()
+	c.index = nil
+	c.mu.Unlock()
+}
+
+// joinEUIRangeIndex returns the index of the JoinEUI ranges. The index is cached for the configured TTL.
+func (js *JoinServer) joinEUIRangeIndex(ctx context.Context) (*joinEUIRangeIndex, error) {
+	c := &js.joinEUIRangeCache
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.index != nil && time.Now().Before(c.expires) {
+		return c.index, nil
+	}
+	ranges, err := js.joinEUIRanges.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.index, c.expires = newJoinEUIRangeIndex(ranges), time.Now().Add(c.ttl)
+	return c.index, nil
+}
+
+// joinEUIRange returns the JoinEUI range that contains the JoinEUI, or nil if there is none.
+func (js *JoinServer) joinEUIRange(ctx context.Context, joinEUI types.EUI64) (*joinEUIRange, error) {
+	if js.joinEUIRanges == nil {
+		return nil, nil
+	}
+	idx, err := js.joinEUIRangeIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	match := idx.match(joinEUI)
+	if match == nil {
+		return nil, nil
+	}
+	return &joinEUIRange{match}, nil
+}
+
+// applicationsListLimit is the page size used to list the applications of an organization.
+const applicationsListLimit = 1000
+
+// requireJoinEUIRangeOwner returns an error if the application with the given identifiers may not use the JoinEUI range.
+// Ranges allocated to an organization can be used by the applications on which the organization is a collaborator.
+// The Identity Server is requested with the credentials of the caller, which therefore needs the right to list the
+// applications of the organization.
+func (js *JoinServer) requireJoinEUIRangeOwner(ctx context.Context, r *joinEUIRange, joinEUI types.EUI64, ids ttnpb.ApplicationIdentifiers) error {
+	if r.ApplicationIds != nil {
+		if r.ApplicationIds.ApplicationId != ids.ApplicationId {
+			return errJoinEUIRangeOwner.WithAttributes("join_eui", joinEUI)
+		}
+		return nil
+	}
+	if err := rights.RequireOrganization(ctx, *r.OrganizationIds, ttnpb.RIGHT_ORGANIZATION_APPLICATIONS_LIST); err != nil {
+		return errJoinEUIRangeOwner.WithAttributes("join_eui", joinEUI).WithCause(err)
+	}
+	cc, err := js.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return err
+	}
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, js.AllowInsecureForCredentials())
+	if err != nil {
+		return err
+	}
+	client := ttnpb.NewApplicationRegistryClient(cc)
+	for page := uint32(1); ; page++ {
+		apps, err := client.List(ctx, &ttnpb.ListApplicationsRequest{
+			Collaborator: r.OrganizationIds.OrganizationOrUserIdentifiers(),
+			FieldMask:    &pbtypes.FieldMask{Paths: []string{"ids"}},
+			Limit:        applicationsListLimit,
+			Page:         page,
+		}, callOpt)
+		if err != nil {
+			return err
+		}
+		for _, app := range apps.Applications {
+			if app.GetIds().GetApplicationId() == ids.ApplicationId {
+				return nil
+			}
+		}
+		if len(apps.Applications) < applicationsListLimit {
+			return errJoinEUIRangeOwner.WithAttributes("join_eui", joinEUI)
+		}
+	}
+}
@@ -0,0 +1,619 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	. "go.thethings.network/lorawan-stack/v3/pkg/joinserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestJoinEUIRangeValidate(t *testing.T) {
+	prefix := &ttnpb.JoinEUIPrefix{JoinEui: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}, Length: 36}
+	for _, tc := range []struct {
+		Name  string
+		Range *ttnpb.JoinEUIRange
+		Valid bool
+	}{
+		{
+			Name: "Application",
+			Range: &ttnpb.JoinEUIRange{
+				Prefix:         prefix,
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+				NetIds:         []types.NetID{{0x00, 0x00, 0x13}},
+			},
+			Valid: true,
+		},
+		{
+			Name: "Organization",
+			Range: &ttnpb.JoinEUIRange{
+				Prefix:               prefix,
+				OrganizationIds:      &ttnpb.OrganizationIdentifiers{OrganizationId: "test-org"},
+				ApplicationServerIds: []string{"as.example.com"},
+			},
+			Valid: true,
+		},
+		{
+			Name: "No owner",
+			Range: &ttnpb.JoinEUIRange{
+				Prefix: prefix,
+			},
+		},
+		{
+			Name: "Both owners",
+			Range: &ttnpb.JoinEUIRange{
+				Prefix:          prefix,
+				ApplicationIds:  &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+				OrganizationIds: &ttnpb.OrganizationIdentifiers{OrganizationId: "test-org"},
+			},
+		},
+		{
+			Name: "Invalid application ID",
+			Range: &ttnpb.JoinEUIRange{
+				Prefix:         prefix,
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "_"},
+			},
+		},
+		{
+			Name: "No prefix",
+			Range: &ttnpb.JoinEUIRange{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+			},
+		},
+		{
+			Name: "Empty prefix",
+			Range: &ttnpb.JoinEUIRange{
+				Prefix:         &ttnpb.JoinEUIPrefix{JoinEui: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}},
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+			},
+		},
+		{
+			Name: "Empty Network Server address",
+			Range: &ttnpb.JoinEUIRange{
+				Prefix:                 prefix,
+				ApplicationIds:         &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+				NetworkServerAddresses: []string{""},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := JoinEUIRange{tc.Range}.Validate()
+			if tc.Valid {
+				assertions.New(t).So(err, should.BeNil)
+			} else {
+				assertions.New(t).So(errors.IsInvalidArgument(err), should.BeTrue)
+			}
+		})
+	}
+}
+
+func TestMatchJoinEUIRange(t *testing.T) {
+	a := assertions.New(t)
+	outer := &ttnpb.JoinEUIRange{
+		Prefix:         &ttnpb.JoinEUIPrefix{JoinEui: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}, Length: 24},
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "outer"},
+	}
+	inner := &ttnpb.JoinEUIRange{
+		Prefix:         &ttnpb.JoinEUIPrefix{JoinEui: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}, Length: 40},
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "inner"},
+	}
+	// The bits beyond the prefix length are ignored.
+	other := &ttnpb.JoinEUIRange{
+		Prefix:         &ttnpb.JoinEUIPrefix{JoinEui: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd2, 0xff, 0xff, 0xff}, Length: 40},
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "other"},
+	}
+	ranges := []*ttnpb.JoinEUIRange{outer, inner, other}
+	a.So(MatchJoinEUIRange(ranges, types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}), should.Equal, inner)
+	a.So(MatchJoinEUIRange(ranges, types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd1, 0x00, 0x00, 0x01}), should.Equal, outer)
+	a.So(MatchJoinEUIRange(ranges, types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd2, 0x00, 0x00, 0x01}), should.Equal, other)
+	a.So(MatchJoinEUIRange(ranges, types.EUI64{0x70, 0xb3, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x01}), should.BeNil)
+	a.So(MatchJoinEUIRange(nil, types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}), should.BeNil)
+
+	outerRange, innerRange := JoinEUIRange{outer}, JoinEUIRange{inner}
+	a.So(outerRange.Overlaps(innerRange.EUI64Prefix()), should.BeTrue)
+	a.So(innerRange.Overlaps(outerRange.EUI64Prefix()), should.BeTrue)
+	a.So(innerRange.Overlaps(types.EUI64Prefix{EUI64: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd1, 0x00, 0x00, 0x00}, Length: 40}), should.BeFalse)
+}
+
+func TestJoinEUIRangeRequire(t *testing.T) {
+	a, ctx := test.New(t)
+	r := JoinEUIRange{&ttnpb.JoinEUIRange{
+		Prefix:                 &ttnpb.JoinEUIPrefix{JoinEui: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}, Length: 36},
+		ApplicationIds:         &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		NetIds:                 []types.NetID{{0x00, 0x00, 0x13}},
+		NetworkServerAddresses: []string{"ns.example.com"},
+		ApplicationServerIds:   []string{"as.example.com"},
+	}}
+
+	a.So(r.RequireNetID(types.NetID{0x00, 0x00, 0x13}), should.BeNil)
+	a.So(r.RequireNetID(types.NetID{0x00, 0x00, 0x42}), should.HaveSameErrorDefinitionAs, ErrNetIDNotAllowed)
+	a.So(r.RequireNetworkServerAddress("NS.example.com"), should.BeNil)
+	a.So(errors.IsPermissionDenied(r.RequireNetworkServerAddress("ns.other.com")), should.BeTrue)
+	a.So(r.RequireApplicationServerID("as.example.com"), should.BeNil)
+	a.So(errors.IsPermissionDenied(r.RequireApplicationServerID("as.other.com")), should.BeTrue)
+
+	for _, tc := range []struct {
+		Name     string
+		AuthInfo *interop.NetworkServerAuthInfo
+		Allowed  bool
+	}{
+		{
+			Name: "Allowed",
+			AuthInfo: &interop.NetworkServerAuthInfo{
+				NetID:     types.NetID{0x00, 0x00, 0x13},
+				Addresses: []string{"*.example.com"},
+			},
+			Allowed: true,
+		},
+		{
+			Name: "Other NetID",
+			AuthInfo: &interop.NetworkServerAuthInfo{
+				NetID:     types.NetID{0x00, 0x00, 0x42},
+				Addresses: []string{"*.example.com"},
+			},
+		},
+		{
+			Name: "Other address",
+			AuthInfo: &interop.NetworkServerAuthInfo{
+				NetID:     types.NetID{0x00, 0x00, 0x13},
+				Addresses: []string{"*.other.com"},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := interop.NewContextWithNetworkServerAuthInfo(ctx, tc.AuthInfo)
+			err := r.RequireNetworkServer(ctx, InteropAuthorizer)
+			if tc.Allowed {
+				assertions.New(t).So(err, should.BeNil)
+			} else {
+				assertions.New(t).So(errors.IsPermissionDenied(err), should.BeTrue)
+			}
+		})
+	}
+
+	asCtx := interop.NewContextWithApplicationServerAuthInfo(ctx, &interop.ApplicationServerAuthInfo{ASID: "as.example.com"})
+	a.So(r.RequireApplicationServer(asCtx, InteropAuthorizer), should.BeNil)
+	asCtx = interop.NewContextWithApplicationServerAuthInfo(ctx, &interop.ApplicationServerAuthInfo{ASID: "as.other.com"})
+	a.So(errors.IsPermissionDenied(r.RequireApplicationServer(asCtx, InteropAuthorizer)), should.BeTrue)
+}
+
+type mockApplicationRegistryServer struct {
+	ttnpb.ApplicationRegistryServer
+	ListFunc func(context.Context, *ttnpb.ListApplicationsRequest) (*ttnpb.Applications, error)
+}
+
+func (m *mockApplicationRegistryServer) List(ctx context.Context, req *ttnpb.ListApplicationsRequest) (*ttnpb.Applications, error) {
+	return m.ListFunc(ctx, req)
+}
+
+func TestRequireJoinEUIRangeOwner(t *testing.T) {
+	joinEUI := types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}
+	orgIDs := ttnpb.OrganizationIdentifiers{OrganizationId: "test-org"}
+	prefix := &ttnpb.JoinEUIPrefix{JoinEui: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}, Length: 36}
+
+	newJS := func(t *testing.T, ctx context.Context) *JoinServer {
+		_, a := test.MustNewTFromContext(ctx)
+		c := componenttest.NewComponent(t, &component.Config{},
+			component.WithClusterNew(func(context.Context, *cluster.Config, ...cluster.Option) (cluster.Cluster, error) {
+				return &test.MockCluster{
+					JoinFunc: test.ClusterJoinNilFunc,
+					GetPeerFunc: func(reqCtx context.Context, role ttnpb.ClusterRole, ids cluster.EntityIdentifiers) (cluster.Peer, error) {
+						a.So(role, should.Equal, ttnpb.ClusterRole_ENTITY_REGISTRY)
+						return test.Must(test.NewGRPCServerPeer(ctx, &mockApplicationRegistryServer{
+							ListFunc: func(ctx context.Context, req *ttnpb.ListApplicationsRequest) (*ttnpb.Applications, error) {
+								md, _ := metadata.FromIncomingContext(ctx)
+								a.So(md.Get("authorization"), should.Resemble, []string{"Bearer key"})
+								a.So(req.Collaborator, should.Resemble, orgIDs.OrganizationOrUserIdentifiers())
+								return &ttnpb.Applications{
+									Applications: []*ttnpb.Application{
+										{Ids: &ttnpb.ApplicationIdentifiers{ApplicationId: "org-app"}},
+									},
+								}, nil
+							},
+						}, ttnpb.RegisterApplicationRegistryServer)).(cluster.Peer), nil
+					},
+				}, nil
+			}),
+		)
+		js := test.Must(New(c, &Config{})).(*JoinServer)
+		componenttest.StartComponent(t, c)
+		return js
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Range          *ttnpb.JoinEUIRange
+		Rights         []ttnpb.Right
+		IDs            ttnpb.ApplicationIdentifiers
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "Application/owner",
+			Range: &ttnpb.JoinEUIRange{
+				Prefix:         prefix,
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+			},
+			IDs: ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		},
+		{
+			Name: "Application/other",
+			Range: &ttnpb.JoinEUIRange{
+				Prefix:         prefix,
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+			},
+			IDs: ttnpb.ApplicationIdentifiers{ApplicationId: "other-app"},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, ErrJoinEUIRangeOwner)
+			},
+		},
+		{
+			Name: "Organization/collaborator",
+			Range: &ttnpb.JoinEUIRange{
+				Prefix:          prefix,
+				OrganizationIds: &orgIDs,
+			},
+			Rights: []ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_APPLICATIONS_LIST},
+			IDs:    ttnpb.ApplicationIdentifiers{ApplicationId: "org-app"},
+		},
+		{
+			Name: "Organization/other",
+			Range: &ttnpb.JoinEUIRange{
+				Prefix:          prefix,
+				OrganizationIds: &orgIDs,
+			},
+			Rights: []ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_APPLICATIONS_LIST},
+			IDs:    ttnpb.ApplicationIdentifiers{ApplicationId: "other-app"},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, ErrJoinEUIRangeOwner)
+			},
+		},
+		{
+			Name: "Organization/no rights",
+			Range: &ttnpb.JoinEUIRange{
+				Prefix:          prefix,
+				OrganizationIds: &orgIDs,
+			},
+			IDs: ttnpb.ApplicationIdentifiers{ApplicationId: "org-app"},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, ErrJoinEUIRangeOwner)
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				js := newJS(t, ctx)
+				defer js.Close()
+
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer key"))
+				ctx = rights.NewContext(ctx, rights.Rights{
+					OrganizationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, orgIDs): ttnpb.RightsFrom(tc.Rights...),
+					},
+				})
+				err := RequireJoinEUIRangeOwner(ctx, js, tc.Range, joinEUI, tc.IDs)
+				if tc.ErrorAssertion == nil {
+					a.So(err, should.BeNil)
+				} else if a.So(err, should.BeError) {
+					a.So(tc.ErrorAssertion(err), should.BeTrue)
+				}
+			},
+		})
+	}
+}
+
+func TestJoinEUIRangeCache(t *testing.T) {
+	a, ctx := test.New(t)
+
+	var (
+		mu     sync.Mutex
+		lists  int
+		ranges []*ttnpb.JoinEUIRange
+	)
+	c := componenttest.NewComponent(t, &component.Config{})
+	js := test.Must(New(c, &Config{
+		JoinEUIRanges: &MockJoinEUIRangeRegistry{
+			SetFunc: func(_ context.Context, _ types.EUI64Prefix, f func([]*ttnpb.JoinEUIRange) (*ttnpb.JoinEUIRange, error)) error {
+				mu.Lock()
+				defer mu.Unlock()
+				r, err := f(ranges)
+				if err != nil {
+					return err
+				}
+				ranges = append(ranges, r)
+				return nil
+			},
+			ListFunc: func(context.Context) ([]*ttnpb.JoinEUIRange, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				lists++
+				return ranges, nil
+			},
+		},
+		JoinEUIRangesCacheTTL: time.Hour,
+	})).(*JoinServer)
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	joinEUI := types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}
+	for i := 0; i < 2; i++ {
+		r, err := GetJoinEUIRange(ctx, js, joinEUI)
+		a.So(err, should.BeNil)
+		a.So(r, should.BeNil)
+	}
+	a.So(lists, should.Equal, 1)
+
+	// Setting a JoinEUI range invalidates the cache.
+	joinEUIRange := &ttnpb.JoinEUIRange{
+		Prefix:         &ttnpb.JoinEUIPrefix{JoinEui: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}, Length: 36},
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+	}
+	_, err := JsJoinEUIRangeServer{JS: js}.Set(
+		rights.NewContextWithAuthInfo(ctx, &ttnpb.AuthInfoResponse{IsAdmin: true}),
+		&ttnpb.SetJoinEUIRangeRequest{JoinEuiRange: joinEUIRange},
+	)
+	a.So(err, should.BeNil)
+	for i := 0; i < 2; i++ {
+		r, err := GetJoinEUIRange(ctx, js, joinEUI)
+		a.So(err, should.BeNil)
+		a.So(r, should.Equal, joinEUIRange)
+	}
+	a.So(lists, should.Equal, 2)
+}
+
+func TestJoinEUIRangeRegistryServer(t *testing.T) {
+	a, ctx := test.New(t)
+
+	var (
+		mu     sync.Mutex
+		ranges = map[string]*ttnpb.JoinEUIRange{}
+	)
+	c := componenttest.NewComponent(t, &component.Config{})
+	js := test.Must(New(c, &Config{
+		JoinEUIRanges: &MockJoinEUIRangeRegistry{
+			GetFunc: func(_ context.Context, prefix types.EUI64Prefix) (*ttnpb.JoinEUIRange, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				r, ok := ranges[prefix.String()]
+				if !ok {
+					return nil, errNotFound.New()
+				}
+				return r, nil
+			},
+			SetFunc: func(_ context.Context, prefix types.EUI64Prefix, f func([]*ttnpb.JoinEUIRange) (*ttnpb.JoinEUIRange, error)) error {
+				mu.Lock()
+				defer mu.Unlock()
+				stored := make([]*ttnpb.JoinEUIRange, 0, len(ranges))
+				for _, r := range ranges {
+					stored = append(stored, r)
+				}
+				r, err := f(stored)
+				if err != nil {
+					return err
+				}
+				if r == nil {
+					delete(ranges, prefix.String())
+				} else {
+					ranges[prefix.String()] = r
+				}
+				return nil
+			},
+			ListFunc: func(context.Context) ([]*ttnpb.JoinEUIRange, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				res := make([]*ttnpb.JoinEUIRange, 0, len(ranges))
+				for _, r := range ranges {
+					res = append(res, r)
+				}
+				return res, nil
+			},
+		},
+	})).(*JoinServer)
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	srv := JsJoinEUIRangeServer{JS: js}
+	adminCtx := rights.NewContextWithAuthInfo(ctx, &ttnpb.AuthInfoResponse{IsAdmin: true})
+	userCtx := rights.NewContextWithAuthInfo(ctx, &ttnpb.AuthInfoResponse{})
+
+	joinEUIRange := &ttnpb.JoinEUIRange{
+		Prefix:         &ttnpb.JoinEUIPrefix{JoinEui: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}, Length: 36},
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		NetIds:         []types.NetID{{0x00, 0x00, 0x13}},
+	}
+
+	_, err := srv.Set(userCtx, &ttnpb.SetJoinEUIRangeRequest{JoinEuiRange: joinEUIRange})
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	_, err = srv.List(userCtx, ttnpb.Empty)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	_, err = srv.Set(adminCtx, &ttnpb.SetJoinEUIRangeRequest{JoinEuiRange: &ttnpb.JoinEUIRange{
+		Prefix: joinEUIRange.Prefix,
+	}})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	res, err := srv.Set(adminCtx, &ttnpb.SetJoinEUIRangeRequest{JoinEuiRange: joinEUIRange})
+	if a.So(err, should.BeNil) {
+		a.So(res, should.Resemble, joinEUIRange)
+	}
+
+	_, err = srv.Set(adminCtx, &ttnpb.SetJoinEUIRangeRequest{JoinEuiRange: &ttnpb.JoinEUIRange{
+		Prefix:          &ttnpb.JoinEUIPrefix{JoinEui: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}, Length: 40},
+		OrganizationIds: &ttnpb.OrganizationIdentifiers{OrganizationId: "test-org"},
+	}})
+	a.So(errors.IsAlreadyExists(err), should.BeTrue)
+
+	res, err = srv.Get(adminCtx, &ttnpb.GetJoinEUIRangeRequest{JoinEui: "70B3D57ED0000000", Length: 36})
+	if a.So(err, should.BeNil) {
+		a.So(res, should.Resemble, joinEUIRange)
+	}
+	// The bits beyond the prefix length are cleared, so that ranges are found by any JoinEUI in the range.
+	res, err = srv.Get(adminCtx, &ttnpb.GetJoinEUIRangeRequest{JoinEui: "70B3D57ED0000042", Length: 36})
+	if a.So(err, should.BeNil) {
+		a.So(res, should.Resemble, joinEUIRange)
+	}
+	res, err = srv.Set(adminCtx, &ttnpb.SetJoinEUIRangeRequest{JoinEuiRange: &ttnpb.JoinEUIRange{
+		Prefix:         &ttnpb.JoinEUIPrefix{JoinEui: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x42}, Length: 36},
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		NetIds:         []types.NetID{{0x00, 0x00, 0x13}},
+	}})
+	if a.So(err, should.BeNil) {
+		a.So(res, should.Resemble, joinEUIRange)
+	}
+
+	_, err = srv.Get(adminCtx, &ttnpb.GetJoinEUIRangeRequest{JoinEui: "not-an-eui", Length: 36})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	list, err := srv.List(adminCtx, ttnpb.Empty)
+	if a.So(err, should.BeNil) {
+		a.So(list.Ranges, should.Resemble, []*ttnpb.JoinEUIRange{joinEUIRange})
+	}
+
+	_, err = srv.Delete(adminCtx, &ttnpb.DeleteJoinEUIRangeRequest{JoinEui: "70B3D57ED0000000", Length: 36})
+	a.So(err, should.BeNil)
+	_, err = srv.Get(adminCtx, &ttnpb.GetJoinEUIRangeRequest{JoinEui: "70B3D57ED0000000", Length: 36})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestHandleJoinJoinEUIRange(t *testing.T) {
+	joinEUIRange := &ttnpb.JoinEUIRange{
+		Prefix:                 &ttnpb.JoinEUIPrefix{JoinEui: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}, Length: 36},
+		ApplicationIds:         &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		NetIds:                 []types.NetID{{0x00, 0x00, 0x13}},
+		NetworkServerAddresses: []string{"ns.example.com"},
+	}
+	newJoinRequest := func(joinEUI byte, netID types.NetID) *ttnpb.JoinRequest {
+		return &ttnpb.JoinRequest{
+			SelectedMacVersion: ttnpb.MAC_V1_1,
+			RawPayload: []byte{
+				/* MHDR */
+				0x00,
+				/* MACPayload */
+				/** JoinEUI **/
+				joinEUI, 0x00, 0x00, 0xd0, 0x7e, 0xd5, 0xb3, 0x70,
+				/** DevEUI **/
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+				/** DevNonce **/
+				0x00, 0x00,
+				/* MIC */
+				0x55, 0x17, 0x54, 0x8e,
+			},
+			DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+			NetId:   netID,
+		}
+	}
+	newDevice := func(nsAddr string) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				DeviceId:               "test-dev",
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+				JoinEui:                &types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01},
+				DevEui:                 &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			},
+			NetworkServerAddress: nsAddr,
+		}
+	}
+
+	for _, tc := range []struct {
+		Name        string
+		Ranges      []*ttnpb.JoinEUIRange
+		Device      *ttnpb.EndDevice
+		JoinRequest *ttnpb.JoinRequest
+		Assertion   func(error) bool
+	}{
+		{
+			Name:        "Allowed NetID",
+			Ranges:      []*ttnpb.JoinEUIRange{joinEUIRange},
+			JoinRequest: newJoinRequest(0x01, types.NetID{0x00, 0x00, 0x13}),
+			Assertion:   errors.IsNotFound,
+		},
+		{
+			Name:        "Other NetID",
+			Ranges:      []*ttnpb.JoinEUIRange{joinEUIRange},
+			JoinRequest: newJoinRequest(0x01, types.NetID{0x00, 0x00, 0x42}),
+			Assertion: func(err error) bool {
+				return errors.Resemble(err, ErrNetIDNotAllowed)
+			},
+		},
+		{
+			Name:        "Other Network Server address",
+			Ranges:      []*ttnpb.JoinEUIRange{joinEUIRange},
+			Device:      newDevice("ns.other.com"),
+			JoinRequest: newJoinRequest(0x01, types.NetID{0x00, 0x00, 0x13}),
+			Assertion:   errors.IsPermissionDenied,
+		},
+		{
+			Name:        "Unknown JoinEUI",
+			JoinRequest: newJoinRequest(0x01, types.NetID{0x00, 0x00, 0x13}),
+			Assertion:   errors.IsInvalidArgument,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				c := componenttest.NewComponent(t, &component.Config{})
+				js := test.Must(New(
+					c,
+					&Config{
+						Devices: &MockDeviceRegistry{
+							SetByEUIFunc: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.ContextualEndDevice, error) {
+								if tc.Device == nil {
+									return nil, errNotFound.New()
+								}
+								_, _, err := f(ctx, tc.Device)
+								return nil, err
+							},
+						},
+						JoinEUIRanges: &MockJoinEUIRangeRegistry{
+							ListFunc: func(context.Context) ([]*ttnpb.JoinEUIRange, error) {
+								return tc.Ranges, nil
+							},
+						},
+						JoinEUIPrefixes: joinEUIPrefixes,
+					},
+				)).(*JoinServer)
+				componenttest.StartComponent(t, c)
+				defer c.Close()
+
+				ctx = clusterauth.NewContext(ctx, nil)
+				_, err := js.HandleJoin(ctx, tc.JoinRequest, ClusterAuthorizer(ctx))
+				if a.So(err, should.BeError) {
+					a.So(tc.Assertion(err), should.BeTrue)
+				}
+			},
+		})
+	}
+}
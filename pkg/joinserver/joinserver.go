@@ -21,12 +21,15 @@ import (
 	"crypto/rand"
 	"encoding/binary"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	ulid "github.com/oklog/ulid/v2"
+	"go.thethings.network/lorawan-stack/v3/pkg/cleanup"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoservices"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
@@ -34,10 +37,12 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/interop"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/hooks"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/rpclog"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"google.golang.org/grpc"
 )
 
@@ -51,9 +56,15 @@ type JoinServer struct {
 	devices                       DeviceRegistry
 	keys                          KeyRegistry
 	applicationActivationSettings ApplicationActivationSettingRegistry
+	joinEUIRanges                 JoinEUIRangeRegistry
+	joinEUIRangeCache             joinEUIRangeCache
 
 	euiPrefixes []types.EUI64Prefix
 
+	rootKeyLabelPrefixes []string
+
+	limitAnomalyEvents ratelimit.Interface
+
 	grpc struct {
 		nsJs                          nsJsServer
 		asJs                          asJsServer
@@ -61,6 +72,7 @@ type JoinServer struct {
 		jsDevices                     jsEndDeviceRegistryServer
 		js                            jsServer
 		applicationActivationSettings applicationActivationSettingsRegistryServer
+		joinEUIRanges                 jsJoinEUIRangeRegistryServer
 	}
 	interop interopServer
 }
@@ -70,6 +82,11 @@ func (js *JoinServer) Context() context.Context {
 	return js.ctx
 }
 
+var (
+	limitAnomalyEventsConfig      = config.RateLimitingProfile{MaxPerMin: 1}
+	limitAnomalyEventsSize   uint = 1 << 13
+)
+
 // New returns new *JoinServer.
 func New(c *component.Component, conf *Config) (*JoinServer, error) {
 	js := &JoinServer{
@@ -79,9 +96,21 @@ func New(c *component.Component, conf *Config) (*JoinServer, error) {
 		devices:                       conf.Devices,
 		keys:                          conf.Keys,
 		applicationActivationSettings: conf.ApplicationActivationSettings,
+		joinEUIRanges:                 conf.JoinEUIRanges,
+		joinEUIRangeCache: joinEUIRangeCache{
+			ttl: conf.JoinEUIRangesCacheTTL,
+		},
 
 		euiPrefixes: conf.JoinEUIPrefixes,
+
+		rootKeyLabelPrefixes: conf.KeyVaultRootKeyLabelPrefixes,
+	}
+
+	limitAnomalyEvents, err := ratelimit.NewProfile(js.ctx, limitAnomalyEventsConfig, limitAnomalyEventsSize)
+	if err != nil {
+		return nil, err
 	}
+	js.limitAnomalyEvents = limitAnomalyEvents
 
 	js.grpc.applicationActivationSettings = applicationActivationSettingsRegistryServer{
 		JS:       js,
@@ -95,6 +124,7 @@ func New(c *component.Component, conf *Config) (*JoinServer, error) {
 	js.grpc.asJs = asJsServer{JS: js}
 	js.grpc.appJs = appJsServer{JS: js}
 	js.grpc.js = jsServer{JS: js}
+	js.grpc.joinEUIRanges = jsJoinEUIRangeRegistryServer{JS: js}
 	js.interop = interopServer{JS: js}
 
 	// TODO: Support authentication from non-cluster-local NS and AS (https://github.com/TheThingsNetwork/lorawan-stack/issues/4).
@@ -107,6 +137,33 @@ func New(c *component.Component, conf *Config) (*JoinServer, error) {
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.AsJs", cluster.HookName, c.ClusterAuthUnaryHook())
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.Js", cluster.HookName, c.ClusterAuthUnaryHook())
 
+	if conf.CleanupPurgedApplications {
+		js.RegisterTask(&component.TaskConfig{
+			Context: js.ctx,
+			ID:      "cleanup_purged_applications",
+			Func: cleanup.NewPurgedApplicationsTask(&RegistryCleaner{
+				DevRegistry:   conf.Devices,
+				AppAsRegistry: conf.ApplicationActivationSettings,
+			}),
+			Restart: component.TaskRestartOnFailure,
+			Backoff: component.DefaultTaskBackoffConfig,
+		})
+		if conf.CleanupReconcileInterval > 0 {
+			js.RegisterTask(&component.TaskConfig{
+				Context: js.ctx,
+				ID:      "cleanup_reconcile",
+				Func: cleanup.NewReconcileTask(conf.CleanupReconcileInterval, cleanup.ClusterIdentityServerSets(js),
+					cleanup.DeviceAndApplicationSetReconciler(&RegistryCleaner{
+						DevRegistry:   conf.Devices,
+						AppAsRegistry: conf.ApplicationActivationSettings,
+					}),
+				),
+				Restart: component.TaskRestartOnFailure,
+				Backoff: component.DefaultTaskBackoffConfig,
+			})
+		}
+	}
+
 	c.RegisterGRPC(js)
 	c.RegisterInterop(js)
 	return js, nil
@@ -125,6 +182,9 @@ func (js *JoinServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterJsEndDeviceRegistryServer(s, js.grpc.jsDevices)
 	ttnpb.RegisterJsServer(s, js.grpc.js)
 	ttnpb.RegisterApplicationActivationSettingRegistryServer(s, js.grpc.applicationActivationSettings)
+	if js.joinEUIRanges != nil {
+		ttnpb.RegisterJsJoinEUIRangeRegistryServer(s, js.grpc.joinEUIRanges)
+	}
 }
 
 // RegisterHandlers registers gRPC handlers.
@@ -132,6 +192,9 @@ func (js *JoinServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientCon
 	ttnpb.RegisterJsHandler(js.Context(), s, conn)
 	ttnpb.RegisterJsEndDeviceRegistryHandler(js.Context(), s, conn)
 	ttnpb.RegisterApplicationActivationSettingRegistryHandler(js.Context(), s, conn)
+	if js.joinEUIRanges != nil {
+		ttnpb.RegisterJsJoinEUIRangeRegistryHandler(js.Context(), s, conn)
+	}
 }
 
 // RegisterInterop registers the NS-JS and AS-JS interop services.
@@ -188,6 +251,79 @@ var (
 	errNoKEK                            = errors.DefineNotFound("kek", "KEK not found")
 )
 
+// keyVaultRootKeyLabel returns the label of the root key that is held by the key vault, if the given key envelope
+// references such a key. These key envelopes only contain a KEK label.
+func keyVaultRootKeyLabel(ke *ttnpb.KeyEnvelope) (string, bool) {
+	return ke.GetKekLabel(), ke.GetKey() == nil && len(ke.GetEncryptedKey()) == 0 && ke.GetKekLabel() != ""
+}
+
+var (
+	errKeyVaultRootKey      = errors.DefineFailedPrecondition("key_vault_root_key", "key vault does not hold root key `{label}`")
+	errKeyVaultRootKeyLabel = errors.DefinePermissionDenied("key_vault_root_key_label", "root key label `{label}` is not allowed for application `{application_id}`")
+)
+
+// checkRootKeyLabel checks that the application can reference the root key with the given label in the key vault.
+// The label must start with one of the configured prefixes, so that end devices cannot reference KEKs, encryption keys
+// or the root keys of other applications.
+func (js *JoinServer) checkRootKeyLabel(ids ttnpb.ApplicationIdentifiers, label string) error {
+	for _, prefix := range js.rootKeyLabelPrefixes {
+		prefix = strings.ReplaceAll(prefix, "{application_id}", ids.ApplicationId)
+		if prefix != "" && strings.HasPrefix(label, prefix) && len(label) > len(prefix) {
+			return nil
+		}
+	}
+	return errKeyVaultRootKeyLabel.WithAttributes(
+		"label", label,
+		"application_id", ids.ApplicationId,
+	)
+}
+
+// rootKeyCryptoService returns the crypto service for the given NwkKey or AppKey of the application.
+// If the root key is held by the key vault, the cryptographic operations are performed by the key vault.
+// Otherwise, the root key is unwrapped and the operations are performed in memory.
+func (js *JoinServer) rootKeyCryptoService(ctx context.Context, ids ttnpb.ApplicationIdentifiers, ke *ttnpb.KeyEnvelope, isNwkKey bool) (cryptoservices.NetworkApplication, error) {
+	if label, ok := keyVaultRootKeyLabel(ke); ok {
+		if err := js.checkRootKeyLabel(ids, label); err != nil {
+			return nil, err
+		}
+		kv, ok := js.KeyVault.(crypto.OpaqueKeyVault)
+		if !ok {
+			return nil, errKeyVaultRootKey.WithAttributes("label", label)
+		}
+		if isNwkKey {
+			return cryptoservices.NewKeyVault(kv, label, ""), nil
+		}
+		return cryptoservices.NewKeyVault(kv, "", label), nil
+	}
+	key, err := cryptoutil.UnwrapAES128Key(ctx, ke, js.KeyVault)
+	if err != nil {
+		return nil, err
+	}
+	if isNwkKey {
+		return cryptoservices.NewMemory(&key, nil), nil
+	}
+	return cryptoservices.NewMemory(nil, &key), nil
+}
+
+// devNonceAnomaly returns the anomaly that is indicated by the rejected DevNonce dn of the device.
+// If the DevNonce is expected to increment, a DevNonce that is lower than the last DevNonce indicates a reset of
+// the DevNonce counter. Otherwise, reuse of a DevNonce indicates a reset of the DevNonce counter if all DevNonces
+// up to and including the reused DevNonce have been used, i.e. the device uses a counter starting at zero.
+// Any other rejected DevNonce indicates a replayed join-request.
+func devNonceAnomaly(dev *ttnpb.EndDevice, incrementDevNonce bool, dn uint32) error {
+	if incrementDevNonce {
+		if dn < dev.LastDevNonce {
+			return errDevNonceCounterReset.WithAttributes("dev_nonce", dn, "last_dev_nonce", dev.LastDevNonce)
+		}
+		return errDevNonceReplay.WithAttributes("dev_nonce", dn)
+	}
+	i := sort.Search(len(dev.UsedDevNonces), func(i int) bool { return dev.UsedDevNonces[i] >= dn })
+	if i < len(dev.UsedDevNonces) && dev.UsedDevNonces[i] == dn && dn == uint32(i) {
+		return errDevNonceCounterReset.WithAttributes("dev_nonce", dn)
+	}
+	return errDevNonceReplay.WithAttributes("dev_nonce", dn)
+}
+
 // HandleJoin handles the given join-request.
 func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, authorizer Authorizer) (res *ttnpb.JoinResponse, err error) {
 	if err := authorizer.RequireAuthorized(ctx); err != nil {
@@ -236,7 +372,11 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 		"dev_eui", pld.DevEui,
 	))
 
-	var match bool
+	joinEUIRange, err := js.joinEUIRange(ctx, pld.JoinEui)
+	if err != nil {
+		return nil, errRegistryOperation.WithCause(err)
+	}
+	match := joinEUIRange != nil
 	for _, p := range js.euiPrefixes {
 		if p.Matches(pld.JoinEui) {
 			match = true
@@ -246,8 +386,22 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 	if !match {
 		return nil, errUnknownJoinEUI.New()
 	}
+	if joinEUIRange != nil {
+		if err := joinEUIRange.RequireNetID(req.NetId); err != nil {
+			return nil, err
+		}
+		if externalAuth, ok := authorizer.(ExternalAuthorizer); ok {
+			if err := joinEUIRange.RequireNetworkServer(ctx, externalAuth); err != nil {
+				return nil, err
+			}
+		}
+	}
 
-	var handled bool
+	var (
+		handled    bool
+		anomaly    error
+		anomalyIDs ttnpb.EndDeviceIdentifiers
+	)
 	dev, err := js.devices.SetByEUI(ctx, pld.JoinEui, pld.DevEui,
 		[]string{
 			"application_server_address",
@@ -265,6 +419,11 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 			"used_dev_nonces",
 		},
 		func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			anomaly = nil
+			if dev == nil {
+				return nil, nil, errDeviceNotFound.New()
+			}
+			anomalyIDs = dev.EndDeviceIdentifiers
 			if entityAuth, ok := authorizer.(EntityAuthorizer); ok {
 				if err := entityAuth.RequireEntityContext(ctx); err != nil {
 					return nil, nil, err
@@ -313,30 +472,16 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 					}
 				}
 			}
-
-			paths := make([]string, 0, 3)
-
-			dn := uint32(binary.BigEndian.Uint16(pld.DevNonce[:]))
-			if req.SelectedMacVersion.IncrementDevNonce() {
-				if (dn != 0 || dev.LastDevNonce != 0 || dev.LastJoinNonce != 0) && !dev.ResetsJoinNonces {
-					if dn <= dev.LastDevNonce {
-						return nil, nil, errDevNonceTooSmall.New()
-					}
-				}
-				dev.LastDevNonce = dn
-				paths = append(paths, "last_dev_nonce")
-			} else {
-				i := sort.Search(len(dev.UsedDevNonces), func(i int) bool { return dev.UsedDevNonces[i] >= dn })
-				if i >= len(dev.UsedDevNonces) || dev.UsedDevNonces[i] != dn {
-					dev.UsedDevNonces = append(dev.UsedDevNonces, 0)
-					copy(dev.UsedDevNonces[i+1:], dev.UsedDevNonces[i:])
-					dev.UsedDevNonces[i] = dn
-					paths = append(paths, "used_dev_nonces")
-				} else if !dev.ResetsJoinNonces {
-					return nil, nil, errReuseDevNonce.New()
+			if joinEUIRange != nil {
+				// Network Servers in the cluster do not authenticate with an address, so the Network Server address of
+				// the end device must be allowed in the JoinEUI range as well.
+				if err := joinEUIRange.RequireNetworkServerAddress(dev.NetworkServerAddress); err != nil {
+					return nil, nil, err
 				}
 			}
 
+			paths := make([]string, 0, 3)
+
 			var b []byte
 			if req.CfList == nil {
 				b = make([]byte, 0, 17)
@@ -390,25 +535,24 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 			var networkCryptoService cryptoservices.Network
 			if req.SelectedMacVersion.UseNwkKey() && dev.RootKeys != nil && dev.RootKeys.NwkKey != nil {
 				// LoRaWAN 1.1 and higher use a NwkKey.
-				nwkKey, err := cryptoutil.UnwrapAES128Key(ctx, dev.RootKeys.NwkKey, js.KeyVault)
+				networkCryptoService, err = js.rootKeyCryptoService(ctx, dev.ApplicationIdentifiers, dev.RootKeys.NwkKey, true)
 				if err != nil {
 					return nil, nil, err
 				}
-				networkCryptoService = cryptoservices.NewMemory(&nwkKey, nil)
 			} else if cc != nil && dev.ProvisionerId != "" {
 				networkCryptoService = cryptoservices.NewNetworkRPCClient(cc, js.KeyVault, js.WithClusterAuth())
 			}
 
 			var applicationCryptoService cryptoservices.Application
 			if dev.RootKeys != nil && dev.RootKeys.AppKey != nil {
-				appKey, err := cryptoutil.UnwrapAES128Key(ctx, dev.RootKeys.AppKey, js.KeyVault)
+				appKeyCryptoService, err := js.rootKeyCryptoService(ctx, dev.ApplicationIdentifiers, dev.RootKeys.AppKey, false)
 				if err != nil {
 					return nil, nil, err
 				}
-				applicationCryptoService = cryptoservices.NewMemory(nil, &appKey)
+				applicationCryptoService = appKeyCryptoService
 				if !req.SelectedMacVersion.UseNwkKey() {
 					// LoRaWAN 1.0.x use the AppKey for network security operations.
-					networkCryptoService = cryptoservices.NewMemory(nil, &appKey)
+					networkCryptoService = appKeyCryptoService
 				}
 			} else if cc != nil && dev.ProvisionerId != "" {
 				applicationCryptoService = cryptoservices.NewApplicationRPCClient(cc, js.KeyVault, js.WithClusterAuth())
@@ -431,6 +575,41 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 			if !bytes.Equal(reqMIC[:], req.RawPayload[19:]) {
 				return nil, nil, errMICMismatch.New()
 			}
+
+			// Only join-requests with a valid MIC count towards the join-request rates and are classified as anomalies,
+			// so that forged join-requests cannot throttle or flag end devices.
+			if err := ratelimit.Require(js.RateLimiter(), ratelimit.DeviceJoinRequestResource(ctx, dev.EndDeviceIdentifiers)); err != nil {
+				anomaly = errDeviceJoinRate.WithCause(err)
+				return nil, nil, anomaly
+			}
+			if err := ratelimit.Require(js.RateLimiter(), ratelimit.JoinEUIJoinRequestResource(ctx, dev.ApplicationIdentifiers, pld.JoinEui)); err != nil {
+				anomaly = errJoinEUIJoinRate.WithAttributes("join_eui", pld.JoinEui).WithCause(err)
+				return nil, nil, anomaly
+			}
+
+			dn := uint32(binary.BigEndian.Uint16(pld.DevNonce[:]))
+			if req.SelectedMacVersion.IncrementDevNonce() {
+				if (dn != 0 || dev.LastDevNonce != 0 || dev.LastJoinNonce != 0) && !dev.ResetsJoinNonces {
+					if dn <= dev.LastDevNonce {
+						anomaly = devNonceAnomaly(dev, true, dn)
+						return nil, nil, errDevNonceTooSmall.New()
+					}
+				}
+				dev.LastDevNonce = dn
+				paths = append(paths, "last_dev_nonce")
+			} else {
+				i := sort.Search(len(dev.UsedDevNonces), func(i int) bool { return dev.UsedDevNonces[i] >= dn })
+				if i >= len(dev.UsedDevNonces) || dev.UsedDevNonces[i] != dn {
+					dev.UsedDevNonces = append(dev.UsedDevNonces, 0)
+					copy(dev.UsedDevNonces[i+1:], dev.UsedDevNonces[i:])
+					dev.UsedDevNonces[i] = dn
+					paths = append(paths, "used_dev_nonces")
+				} else if !dev.ResetsJoinNonces {
+					anomaly = devNonceAnomaly(dev, false, dn)
+					return nil, nil, errReuseDevNonce.New()
+				}
+			}
+
 			resMIC, err := networkCryptoService.JoinAcceptMIC(ctx, cryptoDev, req.SelectedMacVersion, 0xff, pld.DevNonce, b)
 			if err != nil {
 				return nil, nil, errComputeMIC.WithCause(err)
@@ -556,6 +735,12 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 			return dev, paths, nil
 		},
 	)
+	if anomaly != nil {
+		// Anomalies are counted, but events are published at most once per minute per end device, so that floods of
+		// join-requests do not flood the events as well.
+		publish := ratelimit.Require(js.limitAnomalyEvents, ratelimit.NewCustomResource(unique.ID(ctx, anomalyIDs))) == nil
+		registerJoinAnomaly(ctx, anomalyIDs, anomaly, publish)
+	}
 	if err != nil {
 		logger := logger.WithError(err)
 		if !handled {
@@ -576,6 +761,10 @@ func (js *JoinServer) GetNwkSKeys(ctx context.Context, req *ttnpb.SessionKeyRequ
 		return nil, err
 	}
 
+	joinEUIRange, err := js.joinEUIRange(ctx, req.JoinEui)
+	if err != nil {
+		return nil, errRegistryOperation.WithCause(err)
+	}
 	if externalAuth, ok := authorizer.(ExternalAuthorizer); ok {
 		dev, err := js.devices.GetByEUI(ctx, req.JoinEui, req.DevEui,
 			[]string{
@@ -615,6 +804,25 @@ func (js *JoinServer) GetNwkSKeys(ctx context.Context, req *ttnpb.SessionKeyRequ
 				return nil, err
 			}
 		}
+		if joinEUIRange != nil {
+			if err := joinEUIRange.RequireNetworkServer(ctx, externalAuth); err != nil {
+				return nil, err
+			}
+		}
+	} else if joinEUIRange != nil {
+		// Network Servers in the cluster do not authenticate with an address, so the Network Server address of the end
+		// device must be allowed in the JoinEUI range.
+		dev, err := js.devices.GetByEUI(ctx, req.JoinEui, req.DevEui,
+			[]string{
+				"network_server_address",
+			},
+		)
+		if err != nil {
+			return nil, errRegistryOperation.WithCause(err)
+		}
+		if err := joinEUIRange.RequireNetworkServerAddress(dev.NetworkServerAddress); err != nil {
+			return nil, err
+		}
 	}
 
 	ks, err := js.keys.GetByID(ctx, req.JoinEui, req.DevEui, req.SessionKeyId,
@@ -692,6 +900,15 @@ func (js *JoinServer) GetAppSKey(ctx context.Context, req *ttnpb.SessionKeyReque
 				return nil, err
 			}
 		}
+		joinEUIRange, err := js.joinEUIRange(ctx, req.JoinEui)
+		if err != nil {
+			return nil, errRegistryOperation.WithCause(err)
+		}
+		if joinEUIRange != nil {
+			if err := joinEUIRange.RequireApplicationServer(ctx, externalAuth); err != nil {
+				return nil, err
+			}
+		}
 	}
 	if appAuth, ok := authorizer.(ApplicationAccessAuthorizer); ok {
 		dev, err := js.devices.GetByEUI(ctx, req.JoinEui, req.DevEui, nil)
@@ -22,18 +22,45 @@ import (
 )
 
 var (
-	ErrDevNonceTooSmall  = errDevNonceTooSmall
-	ErrNoAppSKey         = errNoAppSKey
-	ErrNoFNwkSIntKey     = errNoFNwkSIntKey
-	ErrNoNwkSEncKey      = errNoNwkSEncKey
-	ErrNoSNwkSIntKey     = errNoSNwkSIntKey
-	ErrRegistryOperation = errRegistryOperation
-	ErrReuseDevNonce     = errReuseDevNonce
+	ErrDevNonceCounterReset = errDevNonceCounterReset
+	ErrDevNonceReplay       = errDevNonceReplay
+	ErrDevNonceTooSmall     = errDevNonceTooSmall
+	ErrDeviceJoinRate       = errDeviceJoinRate
+	ErrJoinEUIJoinRate      = errJoinEUIJoinRate
+	ErrMICMismatch          = errMICMismatch
+	ErrJoinEUIRangeOwner    = errJoinEUIRangeOwner
+	ErrNetIDNotAllowed      = errNetIDNotAllowed
+	ErrNoAppSKey            = errNoAppSKey
+	ErrNoFNwkSIntKey        = errNoFNwkSIntKey
+	ErrNoNwkSEncKey         = errNoNwkSEncKey
+	ErrNoSNwkSIntKey        = errNoSNwkSIntKey
+	ErrRegistryOperation    = errRegistryOperation
+	ErrReuseDevNonce        = errReuseDevNonce
+
+	DevNonceAnomaly = devNonceAnomaly
 )
 
 type AsJsServer = asJsServer
 type NsJsServer = nsJsServer
 type JsDeviceServer = jsEndDeviceRegistryServer
+type JsJoinEUIRangeServer = jsJoinEUIRangeRegistryServer
+type JoinEUIRange = joinEUIRange
+
+func MatchJoinEUIRange(ranges []*ttnpb.JoinEUIRange, joinEUI types.EUI64) *ttnpb.JoinEUIRange {
+	return newJoinEUIRangeIndex(ranges).match(joinEUI)
+}
+
+func GetJoinEUIRange(ctx context.Context, js *JoinServer, joinEUI types.EUI64) (*ttnpb.JoinEUIRange, error) {
+	r, err := js.joinEUIRange(ctx, joinEUI)
+	if err != nil || r == nil {
+		return nil, err
+	}
+	return r.JoinEUIRange, nil
+}
+
+func RequireJoinEUIRangeOwner(ctx context.Context, js *JoinServer, r *ttnpb.JoinEUIRange, joinEUI types.EUI64, ids ttnpb.ApplicationIdentifiers) error {
+	return js.requireJoinEUIRangeOwner(ctx, &joinEUIRange{r}, joinEUI, ids)
+}
 
 type MockDeviceRegistry struct {
 	GetByEUIFunc  func(context.Context, types.EUI64, types.EUI64, []string) (*ttnpb.ContextualEndDevice, error)
@@ -84,8 +111,9 @@ func (m MockDeviceRegistry) RangeByID(ctx context.Context, paths []string, f fun
 }
 
 type MockKeyRegistry struct {
-	GetByIDFunc func(context.Context, types.EUI64, types.EUI64, []byte, []string) (*ttnpb.SessionKeys, error)
-	SetByIDFunc func(context.Context, types.EUI64, types.EUI64, []byte, []string, func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error)) (*ttnpb.SessionKeys, error)
+	GetByIDFunc   func(context.Context, types.EUI64, types.EUI64, []byte, []string) (*ttnpb.SessionKeys, error)
+	SetByIDFunc   func(context.Context, types.EUI64, types.EUI64, []byte, []string, func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error)) (*ttnpb.SessionKeys, error)
+	RangeByIDFunc func(context.Context, []string, func(context.Context, types.EUI64, types.EUI64, []byte, *ttnpb.SessionKeys) bool) error
 }
 
 // GetByID calls GetByIDFunc if set and panics otherwise.
@@ -103,3 +131,41 @@ func (m MockKeyRegistry) SetByID(ctx context.Context, joinEUI, devEUI types.EUI6
 	}
 	return m.SetByIDFunc(ctx, joinEUI, devEUI, id, paths, f)
 }
+
+// RangeByID calls RangeByIDFunc if set and panics otherwise.
+func (m MockKeyRegistry) RangeByID(ctx context.Context, paths []string, f func(context.Context, types.EUI64, types.EUI64, []byte, *ttnpb.SessionKeys) bool) error {
+	if m.RangeByIDFunc == nil {
+		panic("RangeByID called, but not set")
+	}
+	return m.RangeByIDFunc(ctx, paths, f)
+}
+
+type MockJoinEUIRangeRegistry struct {
+	GetFunc  func(context.Context, types.EUI64Prefix) (*ttnpb.JoinEUIRange, error)
+	SetFunc  func(context.Context, types.EUI64Prefix, func([]*ttnpb.JoinEUIRange) (*ttnpb.JoinEUIRange, error)) error
+	ListFunc func(context.Context) ([]*ttnpb.JoinEUIRange, error)
+}
+
+// Get calls GetFunc if set and panics otherwise.
+func (m MockJoinEUIRangeRegistry) Get(ctx context.Context, prefix types.EUI64Prefix) (*ttnpb.JoinEUIRange, error) {
+	if m.GetFunc == nil {
+		panic("Get called, but not set")
+	}
+	return m.GetFunc(ctx, prefix)
+}
+
+// Set calls SetFunc if set and panics otherwise.
+func (m MockJoinEUIRangeRegistry) Set(ctx context.Context, prefix types.EUI64Prefix, f func([]*ttnpb.JoinEUIRange) (*ttnpb.JoinEUIRange, error)) error {
+	if m.SetFunc == nil {
+		panic("Set called, but not set")
+	}
+	return m.SetFunc(ctx, prefix, f)
+}
+
+// List calls ListFunc if set and panics otherwise.
+func (m MockJoinEUIRangeRegistry) List(ctx context.Context) ([]*ttnpb.JoinEUIRange, error) {
+	if m.ListFunc == nil {
+		panic("List called, but not set")
+	}
+	return m.ListFunc(ctx)
+}
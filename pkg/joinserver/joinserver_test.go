@@ -327,6 +327,165 @@ func TestHandleJoin(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:        "1.1.0/cluster auth/new device/key vault root keys",
+			ContextFunc: func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, nil) },
+			Authorizer:  ClusterAuthorizer(ctx),
+			KeyVault: map[string][]byte{
+				"test-app:app-key": appKey[:],
+				"test-app:nwk-key": nwkKey[:],
+			},
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DevEui:                 &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+					JoinEui:                &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+					DeviceId:               "test-dev",
+				},
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{
+						KekLabel: "test-app:app-key",
+					},
+					NwkKey: &ttnpb.KeyEnvelope{
+						KekLabel: "test-app:nwk-key",
+					},
+				},
+				LorawanVersion:       ttnpb.MAC_V1_1,
+				NetworkServerAddress: nsAddr,
+			},
+			NextLastJoinNonce: 1,
+			JoinRequest: &ttnpb.JoinRequest{
+				SelectedMacVersion: ttnpb.MAC_V1_1,
+				RawPayload: []byte{
+					/* MHDR */
+					0x00,
+
+					/* MACPayload */
+					/** JoinEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+					/** DevEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+					/** DevNonce **/
+					0x00, 0x00,
+
+					/* MIC */
+					0x55, 0x17, 0x54, 0x8e,
+				},
+				DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+				NetId:   types.NetID{0x42, 0xff, 0xff},
+				DownlinkSettings: ttnpb.DLSettings{
+					OptNeg:      true,
+					Rx1DrOffset: 0x7,
+					Rx2Dr:       0xf,
+				},
+				RxDelay: 0x42,
+			},
+			JoinResponse: &ttnpb.JoinResponse{
+				RawPayload: append([]byte{
+					/* MHDR */
+					0x20,
+				},
+					mustEncryptJoinAccept(nwkKey, []byte{
+						/* JoinNonce */
+						0x01, 0x00, 0x00,
+						/* NetID */
+						0xff, 0xff, 0x42,
+						/* DevAddr */
+						0xff, 0xff, 0xff, 0x42,
+						/* DLSettings */
+						0xff,
+						/* RxDelay */
+						0x42,
+
+						/* MIC */
+						0xeb, 0xcd, 0x74, 0x59,
+					})...),
+				SessionKeys: ttnpb.SessionKeys{
+					AppSKey: &ttnpb.KeyEnvelope{
+						Key: keyPtr(crypto.DeriveAppSKey(
+							appKey,
+							types.JoinNonce{0x00, 0x00, 0x01},
+							types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+							types.DevNonce{0x00, 0x00})),
+					},
+					SNwkSIntKey: &ttnpb.KeyEnvelope{
+						Key: keyPtr(crypto.DeriveSNwkSIntKey(
+							nwkKey,
+							types.JoinNonce{0x00, 0x00, 0x01},
+							types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+							types.DevNonce{0x00, 0x00})),
+					},
+					FNwkSIntKey: &ttnpb.KeyEnvelope{
+						Key: keyPtr(crypto.DeriveFNwkSIntKey(
+							nwkKey,
+							types.JoinNonce{0x00, 0x00, 0x01},
+							types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+							types.DevNonce{0x00, 0x00})),
+					},
+					NwkSEncKey: &ttnpb.KeyEnvelope{
+						Key: keyPtr(crypto.DeriveNwkSEncKey(
+							nwkKey,
+							types.JoinNonce{0x00, 0x00, 0x01},
+							types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+							types.DevNonce{0x00, 0x00})),
+					},
+				},
+			},
+		},
+		{
+			Name:        "1.1.0/cluster auth/new device/key vault root keys of other application",
+			ContextFunc: func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, nil) },
+			Authorizer:  ClusterAuthorizer(ctx),
+			KeyVault: map[string][]byte{
+				"other-app:app-key": appKey[:],
+				"other-app:nwk-key": nwkKey[:],
+			},
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DevEui:                 &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+					JoinEui:                &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+					DeviceId:               "test-dev",
+				},
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{
+						KekLabel: "other-app:app-key",
+					},
+					NwkKey: &ttnpb.KeyEnvelope{
+						KekLabel: "other-app:nwk-key",
+					},
+				},
+				LorawanVersion:       ttnpb.MAC_V1_1,
+				NetworkServerAddress: nsAddr,
+			},
+			JoinRequest: &ttnpb.JoinRequest{
+				SelectedMacVersion: ttnpb.MAC_V1_1,
+				RawPayload: []byte{
+					/* MHDR */
+					0x00,
+
+					/* MACPayload */
+					/** JoinEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+					/** DevEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+					/** DevNonce **/
+					0x00, 0x00,
+
+					/* MIC */
+					0x55, 0x17, 0x54, 0x8e,
+				},
+				DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+				NetId:   types.NetID{0x42, 0xff, 0xff},
+				DownlinkSettings: ttnpb.DLSettings{
+					OptNeg:      true,
+					Rx1DrOffset: 0x7,
+					Rx2Dr:       0xf,
+				},
+				RxDelay: 0x42,
+			},
+			ErrorAssertion: errors.IsPermissionDenied,
+		},
 		{
 			Name:        "1.1.0/cluster auth/new device/wrapped keys/addr KEKs",
 			ContextFunc: func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, nil) },
@@ -2087,6 +2246,7 @@ func TestHandleJoin(t *testing.T) {
 						Devices:                       devReg,
 						Keys:                          keyReg,
 						JoinEUIPrefixes:               joinEUIPrefixes,
+						KeyVaultRootKeyLabelPrefixes:  []string{"{application_id}:"},
 					},
 				)).(*JoinServer)
 				componenttest.StartComponent(t, c)
@@ -2206,6 +2366,299 @@ func TestHandleJoin(t *testing.T) {
 	}
 }
 
+func TestDevNonceAnomaly(t *testing.T) {
+	for _, tc := range []struct {
+		Name              string
+		Device            *ttnpb.EndDevice
+		IncrementDevNonce bool
+		DevNonce          uint32
+		Expected          error
+	}{
+		{
+			Name:              "Increment/counter reset",
+			Device:            &ttnpb.EndDevice{LastDevNonce: 0x42},
+			IncrementDevNonce: true,
+			DevNonce:          0x01,
+			Expected:          ErrDevNonceCounterReset,
+		},
+		{
+			Name:              "Increment/replay",
+			Device:            &ttnpb.EndDevice{LastDevNonce: 0x42},
+			IncrementDevNonce: true,
+			DevNonce:          0x42,
+			Expected:          ErrDevNonceReplay,
+		},
+		{
+			Name:     "Used/counter reset",
+			Device:   &ttnpb.EndDevice{UsedDevNonces: []uint32{0x00, 0x01, 0x02, 0x03}},
+			DevNonce: 0x02,
+			Expected: ErrDevNonceCounterReset,
+		},
+		{
+			Name:     "Used/replay",
+			Device:   &ttnpb.EndDevice{UsedDevNonces: []uint32{0x03, 0x17, 0x42}},
+			DevNonce: 0x17,
+			Expected: ErrDevNonceReplay,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				err := DevNonceAnomaly(tc.Device, tc.IncrementDevNonce, tc.DevNonce)
+				a.So(err, should.HaveSameErrorDefinitionAs, tc.Expected)
+			},
+		})
+	}
+}
+
+func TestHandleJoinRateLimit(t *testing.T) {
+	newJoinRequest := func(mic ...byte) *ttnpb.JoinRequest {
+		return &ttnpb.JoinRequest{
+			SelectedMacVersion: ttnpb.MAC_V1_1,
+			RawPayload: append([]byte{
+				/* MHDR */
+				0x00,
+				/* MACPayload */
+				/** JoinEUI **/
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+				/** DevEUI **/
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+				/** DevNonce **/
+				0x00, 0x00,
+			}, mic...),
+			DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+			NetId:   types.NetID{0x42, 0xff, 0xff},
+		}
+	}
+	validMIC := []byte{0x55, 0x17, 0x54, 0x8e}
+	invalidMIC := []byte{0x42, 0x42, 0x42, 0x42}
+
+	isDevNonceTooSmall := func(err error) bool {
+		return errors.Resemble(err, ErrDevNonceTooSmall)
+	}
+	isMICMismatch := func(err error) bool {
+		return errors.Resemble(err, ErrMICMismatch)
+	}
+
+	for _, tc := range []struct {
+		Name            string
+		Class           string
+		FirstMIC        []byte
+		FirstAssertion  func(error) bool
+		SecondAppID     string
+		SecondAssertion func(error) bool
+	}{
+		{
+			Name:           "JoinEUI",
+			Class:          "js:join:join_eui",
+			FirstMIC:       validMIC,
+			FirstAssertion: isDevNonceTooSmall,
+			SecondAppID:    "test-app",
+			SecondAssertion: func(err error) bool {
+				return errors.IsResourceExhausted(err) && errors.Resemble(err, ErrJoinEUIJoinRate)
+			},
+		},
+		{
+			Name:            "JoinEUI/other application",
+			Class:           "js:join:join_eui",
+			FirstMIC:        validMIC,
+			FirstAssertion:  isDevNonceTooSmall,
+			SecondAppID:     "other-app",
+			SecondAssertion: isDevNonceTooSmall,
+		},
+		{
+			Name:           "Device",
+			Class:          "js:join:dev",
+			FirstMIC:       validMIC,
+			FirstAssertion: isDevNonceTooSmall,
+			SecondAppID:    "test-app",
+			SecondAssertion: func(err error) bool {
+				return errors.IsResourceExhausted(err) && errors.Resemble(err, ErrDeviceJoinRate)
+			},
+		},
+		{
+			Name:            "Device/invalid MIC",
+			Class:           "js:join",
+			FirstMIC:        invalidMIC,
+			FirstAssertion:  isMICMismatch,
+			SecondAppID:     "test-app",
+			SecondAssertion: isDevNonceTooSmall,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name: tc.Name,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				c := componenttest.NewComponent(t, &component.Config{
+					ServiceBase: config.ServiceBase{
+						RateLimiting: config.RateLimiting{
+							Profiles: []config.RateLimitingProfile{{
+								Name:         "join-requests",
+								MaxPerMin:    1,
+								MaxBurst:     1,
+								Associations: []string{tc.Class},
+							}},
+						},
+					},
+				})
+				appID := "test-app"
+				js := test.Must(New(
+					c,
+					&Config{
+						Devices: &MockDeviceRegistry{
+							SetByEUIFunc: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.ContextualEndDevice, error) {
+								_, _, err := f(ctx, &ttnpb.EndDevice{
+									EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+										ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: appID},
+										DeviceId:               "test-dev",
+										JoinEui:                &joinEUI,
+										DevEui:                 &devEUI,
+									},
+									RootKeys: &ttnpb.RootKeys{
+										AppKey: &ttnpb.KeyEnvelope{
+											Key: &appKey,
+										},
+										NwkKey: &ttnpb.KeyEnvelope{
+											Key: &nwkKey,
+										},
+									},
+									LastDevNonce: 0x42,
+								})
+								return nil, err
+							},
+						},
+						JoinEUIPrefixes: joinEUIPrefixes,
+					},
+				)).(*JoinServer)
+				componenttest.StartComponent(t, c)
+				defer c.Close()
+
+				ctx = clusterauth.NewContext(ctx, nil)
+
+				_, err := js.HandleJoin(ctx, newJoinRequest(tc.FirstMIC...), ClusterAuthorizer(ctx))
+				if a.So(err, should.BeError) {
+					a.So(tc.FirstAssertion(err), should.BeTrue)
+				}
+				appID = tc.SecondAppID
+				_, err = js.HandleJoin(ctx, newJoinRequest(validMIC...), ClusterAuthorizer(ctx))
+				if a.So(err, should.BeError) {
+					a.So(tc.SecondAssertion(err), should.BeTrue)
+				}
+			},
+		})
+	}
+}
+
+func TestHandleJoinAnomalyEvents(t *testing.T) {
+	a, ctx := test.New(t)
+	c := componenttest.NewComponent(t, &component.Config{})
+	js := test.Must(New(
+		c,
+		&Config{
+			Devices: &MockDeviceRegistry{
+				SetByEUIFunc: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.ContextualEndDevice, error) {
+					_, _, err := f(ctx, &ttnpb.EndDevice{
+						EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+							ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+							DeviceId:               "test-dev",
+							JoinEui:                &joinEUI,
+							DevEui:                 &devEUI,
+						},
+						RootKeys: &ttnpb.RootKeys{
+							AppKey: &ttnpb.KeyEnvelope{
+								Key: &appKey,
+							},
+							NwkKey: &ttnpb.KeyEnvelope{
+								Key: &nwkKey,
+							},
+						},
+						LastDevNonce: 0x42,
+					})
+					return nil, err
+				},
+			},
+			JoinEUIPrefixes: joinEUIPrefixes,
+		},
+	)).(*JoinServer)
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	ctx = clusterauth.NewContext(ctx, nil)
+	evs := test.CollectEvents(func() {
+		for i := 0; i < 3; i++ {
+			_, err := js.HandleJoin(ctx, &ttnpb.JoinRequest{
+				SelectedMacVersion: ttnpb.MAC_V1_1,
+				RawPayload: []byte{
+					/* MHDR */
+					0x00,
+					/* MACPayload */
+					/** JoinEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+					/** DevEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+					/** DevNonce **/
+					0x00, 0x00,
+					/* MIC */
+					0x55, 0x17, 0x54, 0x8e,
+				},
+				DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+				NetId:   types.NetID{0x42, 0xff, 0xff},
+			}, ClusterAuthorizer(ctx))
+			a.So(errors.Resemble(err, ErrDevNonceTooSmall), should.BeTrue)
+		}
+	})
+	var anomalies int
+	for _, ev := range evs {
+		if ev.Name() == "js.join.anomaly" {
+			anomalies++
+		}
+	}
+	// Events of anomalies are published at most once per minute per end device.
+	a.So(anomalies, should.Equal, 1)
+}
+
+func TestHandleJoinDeviceNotFound(t *testing.T) {
+	a, ctx := test.New(t)
+	c := componenttest.NewComponent(t, &component.Config{})
+	js := test.Must(New(
+		c,
+		&Config{
+			Devices: &MockDeviceRegistry{
+				SetByEUIFunc: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.ContextualEndDevice, error) {
+					_, _, err := f(ctx, nil)
+					return nil, err
+				},
+			},
+			JoinEUIPrefixes: joinEUIPrefixes,
+		},
+	)).(*JoinServer)
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	ctx = clusterauth.NewContext(ctx, nil)
+	_, err := js.HandleJoin(ctx, &ttnpb.JoinRequest{
+		SelectedMacVersion: ttnpb.MAC_V1_1,
+		RawPayload: []byte{
+			/* MHDR */
+			0x00,
+			/* MACPayload */
+			/** JoinEUI **/
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+			/** DevEUI **/
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+			/** DevNonce **/
+			0x00, 0x00,
+			/* MIC */
+			0x55, 0x17, 0x54, 0x8e,
+		},
+		DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+		NetId:   types.NetID{0x42, 0xff, 0xff},
+	}, ClusterAuthorizer(ctx))
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
 func TestGetNwkSKeys(t *testing.T) {
 	_, ctx := test.New(t)
 	errTest := errors.New("test")
@@ -34,6 +34,11 @@ var (
 		"js.join.accept", "accept join-request",
 		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
 	)
+	evtJoinAnomaly = events.Define(
+		"js.join.anomaly", "detect join-request anomaly",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithErrorDataType(),
+	)
 )
 
 const (
@@ -58,6 +63,14 @@ var jsMetrics = &messageMetrics{
 		},
 		[]string{"error"},
 	),
+	joinAnomalies: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "join_anomaly_total",
+			Help:      "Total number of detected join-request anomalies",
+		},
+		[]string{"anomaly"},
+	),
 }
 
 func init() {
@@ -65,18 +78,21 @@ func init() {
 }
 
 type messageMetrics struct {
-	joinAccepted *metrics.ContextualCounterVec
-	joinRejected *metrics.ContextualCounterVec
+	joinAccepted  *metrics.ContextualCounterVec
+	joinRejected  *metrics.ContextualCounterVec
+	joinAnomalies *metrics.ContextualCounterVec
 }
 
 func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.joinAccepted.Describe(ch)
 	m.joinRejected.Describe(ch)
+	m.joinAnomalies.Describe(ch)
 }
 
 func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.joinAccepted.Collect(ch)
 	m.joinRejected.Collect(ch)
+	m.joinAnomalies.Collect(ch)
 }
 
 func registerAcceptJoin(ctx context.Context, dev *ttnpb.EndDevice, msg *ttnpb.JoinRequest) {
@@ -92,3 +108,14 @@ func registerRejectJoin(ctx context.Context, req *ttnpb.JoinRequest, err error)
 		jsMetrics.joinRejected.WithLabelValues(ctx, unknown).Inc()
 	}
 }
+
+func registerJoinAnomaly(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, err error, publish bool) {
+	if publish {
+		events.Publish(evtJoinAnomaly.NewWithIdentifiersAndData(ctx, &ids, err))
+	}
+	if ttnErr, ok := errors.From(err); ok {
+		jsMetrics.joinAnomalies.WithLabelValues(ctx, ttnErr.Name()).Inc()
+	} else {
+		jsMetrics.joinAnomalies.WithLabelValues(ctx, unknown).Inc()
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-redis/redis/v8"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var errJoinEUIRangeNotFound = errors.DefineNotFound("join_eui_range_not_found", "JoinEUI range `{prefix}` not found")
+
+// JoinEUIRangeRegistry is an implementation of joinserver.JoinEUIRangeRegistry.
+// The JoinEUI ranges are stored in a single hash, keyed by prefix.
+type JoinEUIRangeRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *JoinEUIRangeRegistry) key() string {
+	return r.Redis.Key("ranges")
+}
+
+// Get implements joinserver.JoinEUIRangeRegistry.
+func (r *JoinEUIRangeRegistry) Get(ctx context.Context, prefix types.EUI64Prefix) (*ttnpb.JoinEUIRange, error) {
+	s, err := r.Redis.HGet(ctx, r.key(), prefix.String()).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, errJoinEUIRangeNotFound.WithAttributes("prefix", prefix)
+		}
+		return nil, ttnredis.ConvertError(err)
+	}
+	rng := &ttnpb.JoinEUIRange{}
+	if err := ttnredis.UnmarshalProto(s, rng); err != nil {
+		return nil, err
+	}
+	return rng, nil
+}
+
+// Set implements joinserver.JoinEUIRangeRegistry.
+// The hash is watched while f is called, so that concurrent changes to the JoinEUI ranges abort the transaction.
+func (r *JoinEUIRangeRegistry) Set(ctx context.Context, prefix types.EUI64Prefix, f func([]*ttnpb.JoinEUIRange) (*ttnpb.JoinEUIRange, error)) error {
+	k := r.key()
+	err := r.Redis.Watch(ctx, func(tx *redis.Tx) error {
+		ranges, err := r.list(ctx, tx)
+		if err != nil {
+			return err
+		}
+		rng, err := f(ranges)
+		if err != nil {
+			return err
+		}
+		var s string
+		if rng != nil {
+			if s, err = ttnredis.MarshalProto(rng); err != nil {
+				return err
+			}
+		}
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			if rng == nil {
+				p.HDel(ctx, k, prefix.String())
+			} else {
+				p.HSet(ctx, k, prefix.String(), s)
+			}
+			return nil
+		})
+		return err
+	}, k)
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// List implements joinserver.JoinEUIRangeRegistry.
+// The JoinEUI ranges are sorted by prefix.
+func (r *JoinEUIRangeRegistry) List(ctx context.Context) ([]*ttnpb.JoinEUIRange, error) {
+	return r.list(ctx, r.Redis)
+}
+
+func (r *JoinEUIRangeRegistry) list(ctx context.Context, cmd redis.Cmdable) ([]*ttnpb.JoinEUIRange, error) {
+	values, err := cmd.HGetAll(ctx, r.key()).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	ranges := make([]*ttnpb.JoinEUIRange, 0, len(keys))
+	for _, k := range keys {
+		rng := &ttnpb.JoinEUIRange{}
+		if err := ttnredis.UnmarshalProto(values[k], rng); err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, rng)
+	}
+	return ranges, nil
+}
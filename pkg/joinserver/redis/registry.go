@@ -20,6 +20,7 @@ import (
 	"encoding/base64"
 	"regexp"
 	"runtime/trace"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -560,6 +561,38 @@ func (r *KeyRegistry) SetByID(ctx context.Context, joinEUI, devEUI types.EUI64,
 	return pb, nil
 }
 
+// RangeByID ranges over the session keys and calls f with the JoinEUI, DevEUI and session key ID of each of them.
+func (r *KeyRegistry) RangeByID(ctx context.Context, paths []string, f func(context.Context, types.EUI64, types.EUI64, []byte, *ttnpb.SessionKeys) bool) error {
+	idPrefix := r.Redis.Key("id") + ":"
+	return ttnredis.RangeRedisKeys(ctx, r.Redis, idPrefix+"*", ttnredis.DefaultRangeCount, func(key string) (bool, error) {
+		parts := strings.Split(strings.TrimPrefix(key, idPrefix), ":")
+		if len(parts) != 3 {
+			// Skip lock keys.
+			return true, nil
+		}
+		var joinEUI, devEUI types.EUI64
+		if err := joinEUI.UnmarshalText([]byte(parts[0])); err != nil {
+			return false, err
+		}
+		if err := devEUI.UnmarshalText([]byte(parts[1])); err != nil {
+			return false, err
+		}
+		id, err := base64.RawStdEncoding.DecodeString(parts[2])
+		if err != nil {
+			return false, err
+		}
+		pb := &ttnpb.SessionKeys{}
+		if err := ttnredis.GetProto(ctx, r.Redis, key).ScanProto(pb); err != nil {
+			return false, err
+		}
+		pb, err = ttnpb.FilterGetSessionKeys(pb, paths...)
+		if err != nil {
+			return false, err
+		}
+		return f(ctx, joinEUI, devEUI, id, pb), nil
+	})
+}
+
 // applyApplicationActivationSettingsFieldMask applies fields specified by paths from src to dst and returns the result.
 // If dst is nil, a new ApplicationActivationSettings is created.
 func applyApplicationActivationSettingsFieldMask(dst, src *ttnpb.ApplicationActivationSettings, paths ...string) (*ttnpb.ApplicationActivationSettings, error) {
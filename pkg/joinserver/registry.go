@@ -42,6 +42,7 @@ func DeleteDevice(ctx context.Context, r DeviceRegistry, appID ttnpb.Application
 type KeyRegistry interface {
 	GetByID(ctx context.Context, joinEUI, devEUI types.EUI64, id []byte, paths []string) (*ttnpb.SessionKeys, error)
 	SetByID(ctx context.Context, joinEUI, devEUI types.EUI64, id []byte, paths []string, f func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error)) (*ttnpb.SessionKeys, error)
+	RangeByID(ctx context.Context, paths []string, f func(context.Context, types.EUI64, types.EUI64, []byte, *ttnpb.SessionKeys) bool) error
 }
 
 // DeleteKeys deletes session keys identified by devEUI, id pair from r.
@@ -66,3 +67,15 @@ func DeleteApplicationActivationSettings(ctx context.Context, r ApplicationActiv
 	})
 	return err
 }
+
+// JoinEUIRangeRegistry is a registry, containing the JoinEUI ranges allocated to applications and organizations.
+type JoinEUIRangeRegistry interface {
+	// Get returns the JoinEUI range with the given prefix.
+	Get(ctx context.Context, prefix types.EUI64Prefix) (*ttnpb.JoinEUIRange, error)
+	// Set creates, replaces or deletes the JoinEUI range with the given prefix.
+	// f is called with all stored JoinEUI ranges and returns the range to store. A nil range deletes the range.
+	// The ranges passed to f may not change until the range returned by f is stored.
+	Set(ctx context.Context, prefix types.EUI64Prefix, f func([]*ttnpb.JoinEUIRange) (*ttnpb.JoinEUIRange, error)) error
+	// List returns all JoinEUI ranges.
+	List(ctx context.Context) ([]*ttnpb.JoinEUIRange, error)
+}
@@ -347,6 +347,19 @@ func handleKeyRegistryTest(t *testing.T, reg KeyRegistry) {
 	a.So(err, should.BeNil)
 	a.So(ret, should.HaveEmptyDiff, pbOther)
 
+	ranged := map[types.EUI64]*ttnpb.SessionKeys{}
+	err = reg.RangeByID(ctx, []string{"app_s_key"}, func(ctx context.Context, rangeJoinEUI, rangeDevEUI types.EUI64, id []byte, keys *ttnpb.SessionKeys) bool {
+		a.So(id, should.Resemble, pb.SessionKeyId)
+		a.So(rangeJoinEUI, should.BeIn, []types.EUI64{joinEUI, joinEUIOther})
+		ranged[rangeDevEUI] = keys
+		return true
+	})
+	a.So(err, should.BeNil)
+	a.So(ranged, should.Resemble, map[types.EUI64]*ttnpb.SessionKeys{
+		devEUI:      {AppSKey: pb.AppSKey},
+		devEUIOther: {AppSKey: pbOther.AppSKey},
+	})
+
 	err = DeleteKeys(ctx, reg, joinEUI, devEUI, pb.SessionKeyId)
 	if !a.So(err, should.BeNil) {
 		t.FailNow()
@@ -428,3 +441,68 @@ func TestSessionKeyRegistries(t *testing.T) {
 		}
 	}
 }
+
+func TestJoinEUIRangeRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "joinserver_test")
+	defer func() {
+		flush()
+		cl.Close()
+	}()
+	reg := &redis.JoinEUIRangeRegistry{Redis: cl}
+
+	prefixA := types.EUI64Prefix{EUI64: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}, Length: 36}
+	rangeA := &ttnpb.JoinEUIRange{
+		Prefix:         &ttnpb.JoinEUIPrefix{JoinEui: prefixA.EUI64, Length: uint32(prefixA.Length)},
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+	}
+	prefixB := types.EUI64Prefix{EUI64: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x01, 0x00, 0x00}, Length: 40}
+	rangeB := &ttnpb.JoinEUIRange{
+		Prefix:          &ttnpb.JoinEUIPrefix{JoinEui: prefixB.EUI64, Length: uint32(prefixB.Length)},
+		OrganizationIds: &ttnpb.OrganizationIdentifiers{OrganizationId: "test-org"},
+	}
+
+	err := reg.Set(ctx, prefixA, func(ranges []*ttnpb.JoinEUIRange) (*ttnpb.JoinEUIRange, error) {
+		a.So(ranges, should.BeEmpty)
+		return rangeA, nil
+	})
+	a.So(err, should.BeNil)
+
+	// The JoinEUI ranges are not changed if the callback fails.
+	err = reg.Set(ctx, prefixB, func(ranges []*ttnpb.JoinEUIRange) (*ttnpb.JoinEUIRange, error) {
+		if a.So(ranges, should.HaveLength, 1) {
+			a.So(ranges[0], should.Resemble, rangeA)
+		}
+		return nil, errors.New("test")
+	})
+	a.So(err, should.NotBeNil)
+	ranges, err := reg.List(ctx)
+	if a.So(err, should.BeNil) && a.So(ranges, should.HaveLength, 1) {
+		a.So(ranges[0], should.Resemble, rangeA)
+	}
+
+	// The transaction is aborted if the JoinEUI ranges change while the callback is called.
+	err = reg.Set(ctx, prefixB, func([]*ttnpb.JoinEUIRange) (*ttnpb.JoinEUIRange, error) {
+		a.So(reg.Set(ctx, prefixA, func([]*ttnpb.JoinEUIRange) (*ttnpb.JoinEUIRange, error) {
+			return nil, nil
+		}), should.BeNil)
+		return rangeB, nil
+	})
+	a.So(errors.IsAborted(err), should.BeTrue)
+	ranges, err = reg.List(ctx)
+	if a.So(err, should.BeNil) {
+		a.So(ranges, should.BeEmpty)
+	}
+
+	err = reg.Set(ctx, prefixB, func([]*ttnpb.JoinEUIRange) (*ttnpb.JoinEUIRange, error) {
+		return rangeB, nil
+	})
+	a.So(err, should.BeNil)
+	stored, err := reg.Get(ctx, prefixB)
+	if a.So(err, should.BeNil) {
+		a.So(stored, should.Resemble, rangeB)
+	}
+	_, err = reg.Get(ctx, prefixA)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
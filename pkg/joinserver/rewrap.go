@@ -0,0 +1,186 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var rewrapDevicePaths = []string{
+	"ids",
+	"root_keys.app_key",
+	"root_keys.nwk_key",
+}
+
+func deviceKeyEnvelopes(dev *ttnpb.EndDevice) map[string]**ttnpb.KeyEnvelope {
+	if dev.RootKeys == nil {
+		return nil
+	}
+	return map[string]**ttnpb.KeyEnvelope{
+		"root_keys.app_key": &dev.RootKeys.AppKey,
+		"root_keys.nwk_key": &dev.RootKeys.NwkKey,
+	}
+}
+
+var rewrapSessionKeysPaths = []string{
+	"app_s_key",
+	"f_nwk_s_int_key",
+	"nwk_s_enc_key",
+	"s_nwk_s_int_key",
+}
+
+func sessionKeysKeyEnvelopes(keys *ttnpb.SessionKeys) map[string]**ttnpb.KeyEnvelope {
+	return map[string]**ttnpb.KeyEnvelope{
+		"app_s_key":       &keys.AppSKey,
+		"f_nwk_s_int_key": &keys.FNwkSIntKey,
+		"nwk_s_enc_key":   &keys.NwkSEncKey,
+		"s_nwk_s_int_key": &keys.SNwkSIntKey,
+	}
+}
+
+func applicationActivationSettingsKeyEnvelopes(sets *ttnpb.ApplicationActivationSettings) map[string]**ttnpb.KeyEnvelope {
+	return map[string]**ttnpb.KeyEnvelope{
+		"kek": &sets.Kek,
+	}
+}
+
+// deviceRewrapRegistry is the cryptoutil.RewrapRegistry of the root keys of devices.
+type deviceRewrapRegistry struct {
+	DeviceRegistry
+}
+
+func (r deviceRewrapRegistry) Range(ctx context.Context, f func(context.Context, ttnpb.IDStringer, map[string]**ttnpb.KeyEnvelope) bool) error {
+	return r.RangeByID(ctx, rewrapDevicePaths, func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, dev *ttnpb.EndDevice) bool {
+		return f(ctx, ids, deviceKeyEnvelopes(dev))
+	})
+}
+
+func (r deviceRewrapRegistry) Update(ctx context.Context, ids ttnpb.IDStringer, f func(context.Context, map[string]**ttnpb.KeyEnvelope) ([]string, error)) error {
+	devIDs := ids.(ttnpb.EndDeviceIdentifiers)
+	_, err := r.SetByID(ctx, devIDs.ApplicationIdentifiers, devIDs.DeviceId, rewrapDevicePaths, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		if dev == nil {
+			return nil, nil, nil
+		}
+		sets, err := f(ctx, deviceKeyEnvelopes(dev))
+		if err != nil {
+			return nil, nil, err
+		}
+		return dev, sets, nil
+	})
+	return err
+}
+
+// sessionKeysIdentifiers identifies session keys in the KeyRegistry.
+type sessionKeysIdentifiers struct {
+	joinEUI, devEUI types.EUI64
+	id              []byte
+}
+
+// EntityType implements ttnpb.IDStringer.
+func (ids sessionKeysIdentifiers) EntityType() string {
+	return "session keys"
+}
+
+// IDString implements ttnpb.IDStringer.
+func (ids sessionKeysIdentifiers) IDString() string {
+	return fmt.Sprintf("%s.%s.%X", ids.joinEUI, ids.devEUI, ids.id)
+}
+
+// sessionKeysRewrapRegistry is the cryptoutil.RewrapRegistry of the session keys.
+type sessionKeysRewrapRegistry struct {
+	KeyRegistry
+}
+
+func (r sessionKeysRewrapRegistry) Range(ctx context.Context, f func(context.Context, ttnpb.IDStringer, map[string]**ttnpb.KeyEnvelope) bool) error {
+	return r.RangeByID(ctx, rewrapSessionKeysPaths, func(ctx context.Context, joinEUI, devEUI types.EUI64, id []byte, keys *ttnpb.SessionKeys) bool {
+		return f(ctx, sessionKeysIdentifiers{joinEUI: joinEUI, devEUI: devEUI, id: id}, sessionKeysKeyEnvelopes(keys))
+	})
+}
+
+func (r sessionKeysRewrapRegistry) Update(ctx context.Context, ids ttnpb.IDStringer, f func(context.Context, map[string]**ttnpb.KeyEnvelope) ([]string, error)) error {
+	keysIDs := ids.(sessionKeysIdentifiers)
+	_, err := r.SetByID(ctx, keysIDs.joinEUI, keysIDs.devEUI, keysIDs.id, rewrapSessionKeysPaths, func(keys *ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error) {
+		if keys == nil {
+			return nil, nil, nil
+		}
+		sets, err := f(ctx, sessionKeysKeyEnvelopes(keys))
+		if err != nil {
+			return nil, nil, err
+		}
+		return keys, sets, nil
+	})
+	return err
+}
+
+// applicationActivationSettingsRewrapRegistry is the cryptoutil.RewrapRegistry of the KEKs of application
+// activation settings.
+type applicationActivationSettingsRewrapRegistry struct {
+	ApplicationActivationSettingRegistry
+}
+
+func (r applicationActivationSettingsRewrapRegistry) Range(ctx context.Context, f func(context.Context, ttnpb.IDStringer, map[string]**ttnpb.KeyEnvelope) bool) error {
+	return r.ApplicationActivationSettingRegistry.Range(ctx, []string{"kek"}, func(ctx context.Context, ids ttnpb.ApplicationIdentifiers, sets *ttnpb.ApplicationActivationSettings) bool {
+		return f(ctx, ids, applicationActivationSettingsKeyEnvelopes(sets))
+	})
+}
+
+func (r applicationActivationSettingsRewrapRegistry) Update(ctx context.Context, ids ttnpb.IDStringer, f func(context.Context, map[string]**ttnpb.KeyEnvelope) ([]string, error)) error {
+	_, err := r.SetByID(ctx, ids.(ttnpb.ApplicationIdentifiers), []string{"kek"}, func(sets *ttnpb.ApplicationActivationSettings) (*ttnpb.ApplicationActivationSettings, []string, error) {
+		if sets == nil {
+			return nil, nil, nil
+		}
+		paths, err := f(ctx, applicationActivationSettingsKeyEnvelopes(sets))
+		if err != nil {
+			return nil, nil, err
+		}
+		return sets, paths, nil
+	})
+	return err
+}
+
+// RegistryRewrapper is a service responsible for re-wrapping the keys at rest in the device, session key and
+// application activation settings registries with another KEK.
+type RegistryRewrapper struct {
+	DevRegistry   DeviceRegistry
+	KeyRegistry   KeyRegistry
+	AppAsRegistry ApplicationActivationSettingRegistry
+	KEKRewrap     cryptoutil.KEKRewrap
+	// DryRun only counts the keys that are wrapped with the KEK that is rotated.
+	DryRun bool
+}
+
+// RewrapData re-wraps the root keys of the devices, the session keys and the KEKs of the application activation
+// settings.
+// Entities of which the keys cannot be re-wrapped are logged and counted as failed.
+func (rw *RegistryRewrapper) RewrapData(ctx context.Context) (cryptoutil.RewrapStats, error) {
+	var stats cryptoutil.RewrapStats
+	for _, registry := range []cryptoutil.RewrapRegistry{
+		deviceRewrapRegistry{rw.DevRegistry},
+		sessionKeysRewrapRegistry{rw.KeyRegistry},
+		applicationActivationSettingsRewrapRegistry{rw.AppAsRegistry},
+	} {
+		registryStats, err := rw.KEKRewrap.RewrapRegistry(ctx, registry, rw.DryRun)
+		stats.Add(registryStats)
+		if err != nil {
+			return stats, err
+		}
+	}
+	return stats, nil
+}
@@ -0,0 +1,280 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver_test
+
+import (
+	"context"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	. "go.thethings.network/lorawan-stack/v3/pkg/joinserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type mockApplicationActivationSettingRegistry map[string]*ttnpb.ApplicationActivationSettings
+
+func (m mockApplicationActivationSettingRegistry) GetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, paths []string) (*ttnpb.ApplicationActivationSettings, error) {
+	panic("GetByID called, but not set")
+}
+
+func (m mockApplicationActivationSettingRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, paths []string, f func(*ttnpb.ApplicationActivationSettings) (*ttnpb.ApplicationActivationSettings, []string, error)) (*ttnpb.ApplicationActivationSettings, error) {
+	uid := unique.ID(ctx, appID)
+	var stored *ttnpb.ApplicationActivationSettings
+	if m[uid] != nil {
+		stored = &ttnpb.ApplicationActivationSettings{}
+		if err := stored.SetFields(m[uid], paths...); err != nil {
+			return nil, err
+		}
+	}
+	pb, sets, err := f(stored)
+	if err != nil || pb == nil {
+		return nil, err
+	}
+	if err := m[uid].SetFields(pb, sets...); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+func (m mockApplicationActivationSettingRegistry) Range(ctx context.Context, paths []string, f func(context.Context, ttnpb.ApplicationIdentifiers, *ttnpb.ApplicationActivationSettings) bool) error {
+	for uid, sets := range m {
+		appID, err := unique.ToApplicationID(uid)
+		if err != nil {
+			return err
+		}
+		pb := &ttnpb.ApplicationActivationSettings{}
+		if err := pb.SetFields(sets, paths...); err != nil {
+			return err
+		}
+		if !f(ctx, appID, pb) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func newMockRewrapDeviceRegistry(devs map[string]*ttnpb.EndDevice) *MockDeviceRegistry {
+	return &MockDeviceRegistry{
+		SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+			uid := unique.ID(ctx, ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appID, DeviceId: devID})
+			var stored *ttnpb.EndDevice
+			if devs[uid] != nil {
+				var err error
+				stored, err = ttnpb.FilterGetEndDevice(devs[uid], paths...)
+				if err != nil {
+					return nil, err
+				}
+			}
+			pb, sets, err := f(stored)
+			if err != nil || pb == nil {
+				return nil, err
+			}
+			devs[uid], err = ttnpb.ApplyEndDeviceFieldMask(devs[uid], pb, sets...)
+			if err != nil {
+				return nil, err
+			}
+			return pb, nil
+		},
+		RangeByIDFunc: func(ctx context.Context, paths []string, f func(context.Context, ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool) error {
+			for _, dev := range devs {
+				pb, err := ttnpb.FilterGetEndDevice(dev, paths...)
+				if err != nil {
+					return err
+				}
+				if !f(ctx, pb.EndDeviceIdentifiers, pb) {
+					return nil
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func newMockRewrapKeyRegistry(joinEUI, devEUI types.EUI64, keys map[string]*ttnpb.SessionKeys) *MockKeyRegistry {
+	return &MockKeyRegistry{
+		SetByIDFunc: func(ctx context.Context, _, _ types.EUI64, id []byte, paths []string, f func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error)) (*ttnpb.SessionKeys, error) {
+			var stored *ttnpb.SessionKeys
+			if keys[string(id)] != nil {
+				var err error
+				stored, err = ttnpb.FilterGetSessionKeys(keys[string(id)], paths...)
+				if err != nil {
+					return nil, err
+				}
+			}
+			pb, sets, err := f(stored)
+			if err != nil || pb == nil {
+				return nil, err
+			}
+			keys[string(id)], err = ttnpb.ApplySessionKeysFieldMask(keys[string(id)], pb, sets...)
+			if err != nil {
+				return nil, err
+			}
+			return pb, nil
+		},
+		RangeByIDFunc: func(ctx context.Context, paths []string, f func(context.Context, types.EUI64, types.EUI64, []byte, *ttnpb.SessionKeys) bool) error {
+			for id, sk := range keys {
+				pb, err := ttnpb.FilterGetSessionKeys(sk, paths...)
+				if err != nil {
+					return err
+				}
+				if !f(ctx, joinEUI, devEUI, []byte(id), pb) {
+					return nil
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func TestRegistryRewrapper(t *testing.T) {
+	a, ctx := test.New(t)
+
+	keyVault := cryptoutil.NewMemKeyVault(map[string][]byte{
+		"old":   {0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf},
+		"new":   {0xf, 0xe, 0xd, 0xc, 0xb, 0xa, 0x9, 0x8, 0x7, 0x6, 0x5, 0x4, 0x3, 0x2, 0x1, 0x0},
+		"other": {0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1},
+	})
+	mustWrap := func(key types.AES128Key, kekLabel string) *ttnpb.KeyEnvelope {
+		ke, err := cryptoutil.WrapAES128Key(ctx, key, kekLabel, keyVault)
+		if err != nil {
+			t.Fatalf("Failed to wrap key: %s", err)
+		}
+		return ke
+	}
+	kek := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+
+	newDevice := func(devID string, appKey, nwkKey *ttnpb.KeyEnvelope) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+				DeviceId:               devID,
+				JoinEui:                &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+				DevEui:                 &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			},
+			RootKeys: &ttnpb.RootKeys{
+				AppKey: appKey,
+				NwkKey: nwkKey,
+			},
+		}
+	}
+	devs := map[string]*ttnpb.EndDevice{
+		"test-app.dev-1": newDevice("dev-1", mustWrap(appKey, "old"), mustWrap(nwkKey, "old")),
+		"test-app.dev-2": newDevice("dev-2", mustWrap(appKey, "old"), nil),
+		"test-app.dev-3": newDevice("dev-3", mustWrap(appKey, "new"), mustWrap(nwkKey, "new")),
+		"test-app.dev-4": newDevice("dev-4", &ttnpb.KeyEnvelope{KekLabel: "old"}, nil),
+		"test-app.dev-5": newDevice("dev-5", &ttnpb.KeyEnvelope{EncryptedKey: []byte{0x1}, KekLabel: "old"}, nil),
+	}
+	sessionKeys := map[string]*ttnpb.SessionKeys{
+		"\x01": {
+			SessionKeyId: []byte{0x01},
+			AppSKey:      mustWrap(appKey, "old"),
+			FNwkSIntKey:  mustWrap(nwkKey, "old"),
+			SNwkSIntKey:  mustWrap(nwkKey, "other"),
+			NwkSEncKey:   mustWrap(nwkKey, "new"),
+		},
+		"\x02": {
+			SessionKeyId: []byte{0x02},
+			AppSKey:      mustWrap(appKey, "new"),
+			FNwkSIntKey:  mustWrap(nwkKey, "new"),
+		},
+	}
+	appAsRegistry := mockApplicationActivationSettingRegistry{
+		"test-app":  {Kek: mustWrap(kek, "old")},
+		"other-app": {Kek: mustWrap(kek, "other")},
+	}
+
+	rewrapper := &RegistryRewrapper{
+		DevRegistry: newMockRewrapDeviceRegistry(devs),
+		KeyRegistry: newMockRewrapKeyRegistry(
+			types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			sessionKeys,
+		),
+		AppAsRegistry: appAsRegistry,
+		KEKRewrap: cryptoutil.KEKRewrap{
+			KeyVault: keyVault,
+			From:     "old",
+			To:       "new",
+		},
+		DryRun: true,
+	}
+	stats, err := rewrapper.RewrapData(ctx)
+	a.So(err, should.BeNil)
+	a.So(stats, should.Resemble, cryptoutil.RewrapStats{
+		Entities: 5,
+		Keys:     7,
+	})
+	a.So(devs["test-app.dev-1"].RootKeys.AppKey.KekLabel, should.Equal, "old")
+
+	rewrapper.DryRun = false
+	stats, err = rewrapper.RewrapData(ctx)
+	a.So(err, should.BeNil)
+	a.So(stats, should.Resemble, cryptoutil.RewrapStats{
+		Entities:  5,
+		Keys:      7,
+		Rewrapped: 6,
+		Failed:    1,
+	})
+	for _, ke := range []*ttnpb.KeyEnvelope{
+		devs["test-app.dev-1"].RootKeys.AppKey,
+		devs["test-app.dev-2"].RootKeys.AppKey,
+		devs["test-app.dev-3"].RootKeys.AppKey,
+	} {
+		a.So(ke.KekLabel, should.Equal, "new")
+		key, err := cryptoutil.UnwrapAES128Key(ctx, ke, keyVault)
+		a.So(err, should.BeNil)
+		a.So(key, should.Resemble, appKey)
+	}
+	key, err := cryptoutil.UnwrapAES128Key(ctx, devs["test-app.dev-1"].RootKeys.NwkKey, keyVault)
+	a.So(err, should.BeNil)
+	a.So(key, should.Resemble, nwkKey)
+	a.So(devs["test-app.dev-2"].RootKeys.NwkKey, should.BeNil)
+	a.So(devs["test-app.dev-4"].RootKeys.AppKey, should.Resemble, &ttnpb.KeyEnvelope{KekLabel: "old"})
+	a.So(devs["test-app.dev-5"].RootKeys.AppKey.KekLabel, should.Equal, "old")
+
+	for _, ke := range []*ttnpb.KeyEnvelope{
+		sessionKeys["\x01"].FNwkSIntKey,
+		sessionKeys["\x01"].NwkSEncKey,
+	} {
+		a.So(ke.KekLabel, should.Equal, "new")
+		key, err := cryptoutil.UnwrapAES128Key(ctx, ke, keyVault)
+		a.So(err, should.BeNil)
+		a.So(key, should.Resemble, nwkKey)
+	}
+	a.So(sessionKeys["\x01"].AppSKey.KekLabel, should.Equal, "new")
+	key, err = cryptoutil.UnwrapAES128Key(ctx, sessionKeys["\x01"].AppSKey, keyVault)
+	a.So(err, should.BeNil)
+	a.So(key, should.Resemble, appKey)
+	a.So(sessionKeys["\x01"].SNwkSIntKey.KekLabel, should.Equal, "other")
+	a.So(sessionKeys["\x02"].NwkSEncKey, should.BeNil)
+
+	a.So(appAsRegistry["test-app"].Kek.KekLabel, should.Equal, "new")
+	key, err = cryptoutil.UnwrapAES128Key(ctx, appAsRegistry["test-app"].Kek, keyVault)
+	a.So(err, should.BeNil)
+	a.So(key, should.Resemble, kek)
+	a.So(appAsRegistry["other-app"].Kek.KekLabel, should.Equal, "other")
+
+	stats, err = rewrapper.RewrapData(ctx)
+	a.So(err, should.BeNil)
+	a.So(stats, should.Resemble, cryptoutil.RewrapStats{
+		Entities: 1,
+		Keys:     1,
+		Failed:   1,
+	})
+}
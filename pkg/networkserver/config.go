@@ -121,21 +121,23 @@ func (c DownlinkPriorityConfig) Parse() (DownlinkPriorities, error) {
 
 // Config represents the NetworkServer configuration.
 type Config struct {
-	ApplicationUplinkQueue   ApplicationUplinkQueueConfig `name:"application-uplink-queue"`
-	Devices                  DeviceRegistry               `name:"-"`
-	DownlinkTaskQueue        DownlinkTaskQueueConfig      `name:"downlink-task-queue"`
-	UplinkDeduplicator       UplinkDeduplicator           `name:"-"`
-	ScheduledDownlinkMatcher ScheduledDownlinkMatcher     `name:"-"`
-	NetID                    types.NetID                  `name:"net-id" description:"NetID of this Network Server"`
-	ClusterID                string                       `name:"cluster-id" description:"Cluster ID of this Network Server"`
-	DevAddrPrefixes          []types.DevAddrPrefix        `name:"dev-addr-prefixes" description:"Device address prefixes of this Network Server"`
-	DeduplicationWindow      time.Duration                `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`
-	CooldownWindow           time.Duration                `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"`
-	DownlinkPriorities       DownlinkPriorityConfig       `name:"downlink-priorities" description:"Downlink message priorities"`
-	DefaultMACSettings       MACSettingConfig             `name:"default-mac-settings" description:"Default MAC settings to fallback to if not specified by device, band or frequency plan"`
-	Interop                  config.InteropClient         `name:"interop" description:"Interop client configuration"`
-	DeviceKEKLabel           string                       `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
-	DownlinkQueueCapacity    int                          `name:"downlink-queue-capacity" description:"Maximum downlink queue size per-session"`
+	ApplicationUplinkQueue    ApplicationUplinkQueueConfig `name:"application-uplink-queue"`
+	Devices                   DeviceRegistry               `name:"-"`
+	DownlinkTaskQueue         DownlinkTaskQueueConfig      `name:"downlink-task-queue"`
+	UplinkDeduplicator        UplinkDeduplicator           `name:"-"`
+	ScheduledDownlinkMatcher  ScheduledDownlinkMatcher     `name:"-"`
+	NetID                     types.NetID                  `name:"net-id" description:"NetID of this Network Server"`
+	ClusterID                 string                       `name:"cluster-id" description:"Cluster ID of this Network Server"`
+	DevAddrPrefixes           []types.DevAddrPrefix        `name:"dev-addr-prefixes" description:"Device address prefixes of this Network Server"`
+	DeduplicationWindow       time.Duration                `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`
+	CooldownWindow            time.Duration                `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"`
+	DownlinkPriorities        DownlinkPriorityConfig       `name:"downlink-priorities" description:"Downlink message priorities"`
+	DefaultMACSettings        MACSettingConfig             `name:"default-mac-settings" description:"Default MAC settings to fallback to if not specified by device, band or frequency plan"`
+	Interop                   config.InteropClient         `name:"interop" description:"Interop client configuration"`
+	DeviceKEKLabel            string                       `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
+	DownlinkQueueCapacity     int                          `name:"downlink-queue-capacity" description:"Maximum downlink queue size per-session"`
+	CleanupPurgedApplications bool                         `name:"cleanup-purged-applications" description:"Delete the devices of applications that are purged from the Identity Server"`
+	CleanupReconcileInterval  time.Duration                `name:"cleanup-reconcile-interval" description:"Interval at which the data of applications and devices that no longer exist in the Identity Server is deleted, if cleanup of purged applications is enabled (0 is disabled)"`
 }
 
 // DefaultConfig is the default Network Server configuration.
@@ -162,5 +164,6 @@ var DefaultConfig = Config{
 		StatusTimePeriodicity:  func(v time.Duration) *time.Duration { return &v }(mac.DefaultStatusTimePeriodicity),
 		StatusCountPeriodicity: func(v uint32) *uint32 { return &v }(mac.DefaultStatusCountPeriodicity),
 	},
-	DownlinkQueueCapacity: 10000,
+	DownlinkQueueCapacity:    10000,
+	CleanupReconcileInterval: 24 * time.Hour,
 }
@@ -35,6 +35,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"google.golang.org/grpc"
 )
@@ -91,6 +92,7 @@ type generateDownlinkState struct {
 	ifScheduledApplicationUps []*ttnpb.ApplicationUp
 
 	ApplicationDownlink           *ttnpb.ApplicationDownlink
+	RelayDownlink                 *ttnpb.RelayForwardDownlinkReq
 	EventBuilders                 events.Builders
 	NeedsDownlinkQueueUpdate      bool
 	EvictDownlinkQueueIfScheduled bool
@@ -219,7 +221,7 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 		dev.MacState.QueuedResponses = nil
 		dev.MacState.PendingRequests = dev.MacState.PendingRequests[:0]
 
-		enqueuers := make([]func(context.Context, *ttnpb.EndDevice, uint16, uint16) mac.EnqueueState, 0, 13)
+		enqueuers := make([]func(context.Context, *ttnpb.EndDevice, uint16, uint16) mac.EnqueueState, 0, 18)
 		if dev.MacState.LorawanVersion.Compare(ttnpb.MAC_V1_0) >= 0 {
 			enqueuers = append(enqueuers,
 				mac.EnqueueDutyCycleReq,
@@ -274,6 +276,17 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 				mac.EnqueueRejoinParamSetupReq,
 			)
 		}
+		if dev.MacState.LorawanVersion.Compare(ttnpb.MAC_V1_0_4) >= 0 {
+			enqueuers = append(enqueuers,
+				mac.EnqueueRelayConfReq,
+				mac.EnqueueRelayEndDeviceConfReq,
+				mac.EnqueueRelayFilterListReq,
+				func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
+					return mac.EnqueueRelayUpdateUplinkListReq(ctx, dev, maxDownLen, maxUpLen, ns.relayServedDeviceSession)
+				},
+				mac.EnqueueRelayCtrlUplinkListReq,
+			)
+		}
 
 		for _, f := range enqueuers {
 			st := f(ctx, dev, maxDownLen, maxUpLen)
@@ -294,6 +307,12 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 				return nil, generateDownlinkState{}, errEncodeMAC.WithCause(err)
 			}
 		}
+		for _, cmd := range dev.MacState.PendingRequests {
+			if req := cmd.GetRelayUpdateUplinkListReq(); req != nil {
+				// NOTE: The root wake-on-radio session key is not stored in the MAC state.
+				req.RootWorSKey = types.AES128Key{}
+			}
+		}
 		logger = logger.WithFields(log.Fields(
 			"mac_count", len(cmds),
 			"mac_length", len(b),
@@ -342,7 +361,23 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 	ctx = log.NewContext(ctx, logger)
 
 	cmdsInFOpts := len(cmdBuf) <= fOptsCapacity
-	if cmdsInFOpts {
+	var relayPayload []byte
+	if relayDown := dev.MacState.PendingRelayDownlink; relayDown != nil && class == ttnpb.CLASS_A && cmdsInFOpts {
+		b, err := lorawan.MarshalForwardDownlinkReq(lorawan.ForwardDownlinkReq{
+			PHYPayload: relayDown.RawPayload,
+		})
+		switch {
+		case err != nil:
+			logger.WithError(err).Warn("Failed to encode relay downlink, skip")
+		case len(b) > int(maxDownLen):
+			logger.Debug("Skip relay downlink with payload length exceeding band regulations")
+		default:
+			logger.Debug("Add relay downlink to buffer")
+			genState.RelayDownlink = relayDown
+			relayPayload = b
+		}
+	}
+	if cmdsInFOpts && genState.RelayDownlink == nil {
 		appDowns := dev.Session.QueuedApplicationDownlinks[:0:0]
 	outer:
 		for i, down := range dev.Session.QueuedApplicationDownlinks {
@@ -460,7 +495,7 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 			mType = ttnpb.MType_CONFIRMED_DOWN
 		}
 
-	case len(cmdBuf) > 0, needsDownlink:
+	case genState.RelayDownlink != nil, len(cmdBuf) > 0, needsDownlink:
 		pld.FullFCnt = func() uint32 {
 			for i := len(dev.MacState.RecentDownlinks) - 1; i >= 0; i-- {
 				down := dev.MacState.RecentDownlinks[i]
@@ -493,6 +528,22 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 	}
 	pld.FHDR.FCnt = pld.FullFCnt & 0xffff
 
+	if genState.RelayDownlink != nil {
+		if dev.Session.NwkSEncKey == nil || len(dev.Session.NwkSEncKey.Key) == 0 {
+			return nil, genState, errUnknownNwkSEncKey.New()
+		}
+		key, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.NwkSEncKey, ns.KeyVault)
+		if err != nil {
+			logger.WithField("kek_label", dev.Session.NwkSEncKey.KekLabel).WithError(err).Warn("Failed to unwrap NwkSEncKey")
+			return nil, genState, err
+		}
+		pld.FPort = uint32(lorawan.RelayFPort)
+		pld.FrmPayload, err = crypto.EncryptDownlink(key, dev.Session.DevAddr, pld.FullFCnt, relayPayload, false)
+		if err != nil {
+			return nil, genState, errEncryptMAC.WithCause(err)
+		}
+	}
+
 	logger = logger.WithFields(log.Fields(
 		"f_cnt", pld.FHDR.FCnt,
 		"full_f_cnt", pld.FullFCnt,
@@ -511,7 +562,7 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 			return nil, genState, err
 		}
 		fCnt := pld.FullFCnt
-		if pld.FPort != 0 {
+		if genState.ApplicationDownlink != nil {
 			fCnt = dev.Session.LastNFCntDown
 		}
 		cmdBuf, err = crypto.EncryptDownlink(key, dev.Session.DevAddr, fCnt, cmdBuf, cmdsInFOpts)
@@ -524,7 +575,7 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 	} else {
 		pld.FrmPayload = cmdBuf
 	}
-	if pld.FPort == 0 && dev.MacState.LorawanVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+	if (pld.FPort == 0 || genState.RelayDownlink != nil) && dev.MacState.LorawanVersion.Compare(ttnpb.MAC_V1_1) < 0 {
 		genState.ifScheduledApplicationUps = append(genState.ifScheduledApplicationUps, &ttnpb.ApplicationUp{
 			EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
 			CorrelationIds:       events.CorrelationIDsFromContext(ctx),
@@ -616,7 +667,7 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 			priority = max
 		}
 	}
-	if (pld.FPort == 0 || len(cmdBuf) > 0) && priority < ns.downlinkPriorities.MACCommands {
+	if (pld.FPort == 0 || len(cmdBuf) > 0 || genState.RelayDownlink != nil) && priority < ns.downlinkPriorities.MACCommands {
 		priority = ns.downlinkPriorities.MACCommands
 	}
 
@@ -636,6 +687,10 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 type downlinkPath struct {
 	*ttnpb.GatewayIdentifiers
 	*ttnpb.DownlinkPath
+
+	// Relay is the metadata of the relay, which forwarded the uplink.
+	// If Relay is set, the downlink is forwarded by the relay and GatewayIdentifiers and DownlinkPath are not set.
+	Relay *ttnpb.RelayMetadata
 }
 
 func computeWantedRSSI(snr float32, channelRSSI float32) float32 {
@@ -690,7 +745,18 @@ func downlinkPathsFromMetadata(ctx context.Context, mds ...*ttnpb.RxMetadata) []
 	head := make([]downlinkPath, 0, len(mds))
 	body := make([]downlinkPath, 0, len(mds))
 	tail := make([]downlinkPath, 0, len(mds))
+	relays := make(map[string]struct{})
 	for _, md := range mds {
+		if md.Relay != nil {
+			// NOTE: The downlink is scheduled through the relay, regardless of the gateways that received the relay.
+			if _, ok := relays[md.Relay.DeviceId]; !ok {
+				relays[md.Relay.DeviceId] = struct{}{}
+				head = append(head, downlinkPath{
+					Relay: md.Relay,
+				})
+			}
+			continue
+		}
 		if len(md.UplinkToken) == 0 || md.DownlinkPathConstraint == ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER {
 			continue
 		}
@@ -855,6 +921,49 @@ func (t *packetBrokerDownlinkTarget) Schedule(ctx context.Context, msg *ttnpb.Do
 	return peeringScheduleDelay, nil
 }
 
+type relayDownlinkTarget struct {
+	ns  *NetworkServer
+	ids ttnpb.EndDeviceIdentifiers
+}
+
+func (t *relayDownlinkTarget) Equal(target downlinkTarget) bool {
+	other, ok := target.(*relayDownlinkTarget)
+	if !ok {
+		return false
+	}
+	return other.ids.ApplicationIdentifiers == t.ids.ApplicationIdentifiers && other.ids.DeviceId == t.ids.DeviceId
+}
+
+// Schedule stores msg in the MAC state of the relay, which is transmitted to the relay in its next downlink slot.
+func (t *relayDownlinkTarget) Schedule(ctx context.Context, msg *ttnpb.DownlinkMessage, _ ...grpc.CallOption) (time.Duration, error) {
+	_, ctx, err := t.ns.devices.SetByID(ctx, t.ids.ApplicationIdentifiers, t.ids.DeviceId,
+		[]string{
+			"mac_state",
+		},
+		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if stored == nil {
+				return nil, nil, errDeviceNotFound.New()
+			}
+			if stored.MacState.GetCurrentParameters().Relay.GetServing() == nil {
+				return nil, nil, errNotRelay.WithAttributes("device_uid", unique.ID(ctx, t.ids))
+			}
+			stored.MacState.PendingRelayDownlink = &ttnpb.RelayForwardDownlinkReq{
+				RawPayload: msg.RawPayload,
+			}
+			return stored, []string{
+				"mac_state.pending_relay_downlink",
+			}, nil
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+	if err := t.ns.downlinkTasks.Add(ctx, t.ids, time.Now(), true); err != nil {
+		return 0, err
+	}
+	return relayScheduleDelay, nil
+}
+
 // scheduleDownlinkByPaths attempts to schedule payload b using parameters in req using paths.
 // scheduleDownlinkByPaths discards req.TxRequest.DownlinkPaths and mutates it arbitrarily.
 // scheduleDownlinkByPaths returns the scheduled downlink or error.
@@ -874,7 +983,17 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *sched
 	attempts := make([]*attempt, 0, len(paths))
 	for _, path := range paths {
 		var target downlinkTarget
-		if path.GatewayIdentifiers != nil {
+		switch {
+		case path.Relay != nil:
+			ids := ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: req.ApplicationIdentifiers,
+				DeviceId:               path.Relay.DeviceId,
+			}
+			target = &relayDownlinkTarget{
+				ns:  ns,
+				ids: ids,
+			}
+		case path.GatewayIdentifiers != nil:
 			logger := logger.WithFields(log.Fields(
 				"target", "gateway_server",
 				"gateway_uid", unique.ID(ctx, path.GatewayIdentifiers),
@@ -885,7 +1004,7 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *sched
 				continue
 			}
 			target = &gatewayServerDownlinkTarget{peer: peer}
-		} else {
+		default:
 			logger := logger.WithField("target", "packet_broker_agent")
 			peer, err := ns.GetPeer(ctx, ttnpb.ClusterRole_PACKET_BROKER_AGENT, nil)
 			if err != nil {
@@ -907,7 +1026,9 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *sched
 			}
 			attempts = append(attempts, a)
 		}
-		a.paths = append(a.paths, path.DownlinkPath)
+		if path.DownlinkPath != nil {
+			a.paths = append(a.paths, path.DownlinkPath)
+		}
 	}
 
 	var (
@@ -1127,6 +1248,9 @@ func recordDataDownlink(dev *ttnpb.EndDevice, genState generateDownlinkState, ne
 	}
 	if class := down.Message.GetRequest().GetClass(); class == ttnpb.CLASS_B || class == ttnpb.CLASS_C {
 		dev.MacState.LastNetworkInitiatedDownlinkAt = TimePtr(down.TransmitAt)
+	} else {
+		// NOTE: The relay forwards downlink only in the receive windows following the relayed uplink.
+		dev.MacState.PendingRelayDownlink = nil
 	}
 
 	if genState.ApplicationDownlink != nil && genState.ApplicationDownlink.Confirmed {
@@ -1352,6 +1476,7 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 			"mac_state.last_confirmed_downlink_at",
 			"mac_state.last_downlink_at",
 			"mac_state.pending_application_downlink",
+			"mac_state.pending_relay_downlink",
 			"mac_state.pending_requests",
 			"mac_state.queued_responses",
 			"mac_state.recent_downlinks",
@@ -1453,6 +1578,14 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 		}
 	} else {
 		paths = downlinkPathsFromRecentUplinks(ctx, dev.MacState.RecentUplinks...)
+		// NOTE: Relays only forward downlink in the receive windows following a relayed uplink.
+		gatewayPaths := paths[:0]
+		for _, path := range paths {
+			if path.Relay == nil {
+				gatewayPaths = append(gatewayPaths, path)
+			}
+		}
+		paths = gatewayPaths
 		if len(paths) == 0 {
 			log.FromContext(ctx).Error("No downlink path available, skip class B/C downlink slot")
 			if genState.ApplicationDownlink != nil && ttnpb.HasAnyField(sets, "session.queued_application_downlinks") {
@@ -143,6 +143,19 @@ func TestGenerateDataDownlink(t *testing.T) {
 		return append(b, mic[:]...)
 	}
 
+	relayDownlinkPHYPayload := []byte{0x60, 0x42, 0x42, 0xff, 0xff, 0x00, 0x01, 0x00, 0x01, 0x02, 0x03, 0x04}
+	relayFRMPayload, err := crypto.EncryptDownlink(nwkSEncKey, devAddr, 0, relayDownlinkPHYPayload, false)
+	if err != nil {
+		t.Fatal("Failed to encrypt relay downlink")
+	}
+	makeRelayParameters := func() *ttnpb.RelayParameters {
+		return &ttnpb.RelayParameters{
+			Mode: &ttnpb.RelayParameters_Serving{
+				Serving: &ttnpb.ServingRelayParameters{},
+			},
+		}
+	}
+
 	for _, tc := range []struct {
 		Name                         string
 		Device                       *ttnpb.EndDevice
@@ -1057,6 +1070,69 @@ func TestGenerateDataDownlink(t *testing.T) {
 				})
 			},
 		},
+		{
+			Name: "1.1/relay downlink/no MAC/no ack",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: appID,
+					DeviceId:               devID,
+					DevAddr:                &devAddr,
+				},
+				MacState: &ttnpb.MACState{
+					LorawanVersion: ttnpb.MAC_V1_1,
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeRelayParameters(),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: makeRelayParameters(),
+					},
+					RecentUplinks: []*ttnpb.UplinkMessage{{
+						Payload: &ttnpb.Message{
+							MHDR: ttnpb.MHDR{
+								MType: ttnpb.MType_UNCONFIRMED_UP,
+							},
+							Payload: &ttnpb.Message_MacPayload{MacPayload: &ttnpb.MACPayload{}},
+						},
+					}},
+					RxWindowsAvailable: true,
+					PendingRelayDownlink: &ttnpb.RelayForwardDownlinkReq{
+						RawPayload: relayDownlinkPHYPayload,
+					},
+				},
+				Session: &ttnpb.Session{
+					DevAddr: devAddr,
+					SessionKeys: ttnpb.SessionKeys{
+						NwkSEncKey: &ttnpb.KeyEnvelope{
+							Key: &nwkSEncKey,
+						},
+						SNwkSIntKey: &ttnpb.KeyEnvelope{
+							Key: &sNwkSIntKey,
+						},
+					},
+				},
+				LorawanPhyVersion: ttnpb.RP001_V1_1_REV_B,
+				FrequencyPlanId:   band.EU_863_870,
+			},
+			Payload: &ttnpb.Message{
+				MHDR: ttnpb.MHDR{
+					MType: ttnpb.MType_UNCONFIRMED_DOWN,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				Payload: &ttnpb.Message_MacPayload{
+					MacPayload: &ttnpb.MACPayload{
+						FHDR: ttnpb.FHDR{
+							DevAddr: devAddr,
+							FCtrl: ttnpb.FCtrl{
+								Ack: false,
+								Adr: true,
+							},
+						},
+						FPort:      uint32(lorawan.RelayFPort),
+						FrmPayload: relayFRMPayload,
+					},
+				},
+			},
+		},
 	} {
 		tc := tc
 		test.RunSubtest(t, test.SubtestConfig{
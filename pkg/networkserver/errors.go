@@ -45,6 +45,8 @@ var (
 	errInvalidPayload                     = errors.DefineInvalidArgument("payload", "invalid payload")
 	errJoinServerNotFound                 = errors.DefineNotFound("join_server_not_found", "Join Server not found")
 	errNoPath                             = errors.DefineNotFound("no_downlink_path", "no downlink path available")
+	errNotRelay                           = errors.DefineFailedPrecondition("not_relay", "device `{device_uid}` is not a relay")
+	errNotServedByRelay                   = errors.DefineFailedPrecondition("not_served_by_relay", "device is not served by the relay")
 	errOutdatedData                       = errors.DefineFailedPrecondition("outdated_data", "data is outdated")
 	errRawPayloadTooShort                 = errors.Define("raw_payload_too_short", "length of RawPayload must not be less than 4")
 	errSchedule                           = errors.Define("schedule", "all downlink scheduling attempts failed")
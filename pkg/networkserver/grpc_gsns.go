@@ -192,6 +192,10 @@ func applyCFList(cfList *ttnpb.CFList, phy *band.Band, chs ...*ttnpb.MACParamete
 
 // matchAndHandleDataUplink handles and matches a device prematched by CMACF check.
 func (ns *NetworkServer) matchAndHandleDataUplink(ctx context.Context, dev *ttnpb.EndDevice, up *ttnpb.UplinkMessage, deduplicated bool, cmacFMatchResult cmacFMatchingResult) (*matchResult, bool, error) {
+	if relayIDs, ok := relayIdentifiersFromContext(ctx); ok && !deviceServedByRelay(dev, relayIDs) {
+		log.FromContext(ctx).Debug("Device is not served by the relay, which forwarded the uplink")
+		return nil, false, nil
+	}
 	phy, err := DeviceBand(dev, ns.FrequencyPlans)
 	if err != nil {
 		log.FromContext(ctx).WithError(err).Warn("Failed to get device's versioned band")
@@ -556,6 +560,18 @@ macLoop:
 			evs, err = mac.HandleBeaconFreqAns(ctx, dev, cmd.GetBeaconFreqAns())
 		case ttnpb.CID_DEVICE_MODE:
 			evs, err = mac.HandleDeviceModeInd(ctx, dev, cmd.GetDeviceModeInd())
+		case ttnpb.CID_RELAY_CONF:
+			evs, err = mac.HandleRelayConfAns(ctx, dev, cmd.GetRelayConfAns())
+		case ttnpb.CID_RELAY_END_DEVICE_CONF:
+			evs, err = mac.HandleRelayEndDeviceConfAns(ctx, dev, cmd.GetRelayEndDeviceConfAns())
+		case ttnpb.CID_RELAY_FILTER_LIST:
+			evs, err = mac.HandleRelayFilterListAns(ctx, dev, cmd.GetRelayFilterListAns())
+		case ttnpb.CID_RELAY_UPDATE_UPLINK_LIST:
+			evs, err = mac.HandleRelayUpdateUplinkListAns(ctx, dev)
+		case ttnpb.CID_RELAY_CTRL_UPLINK_LIST:
+			evs, err = mac.HandleRelayCtrlUplinkListAns(ctx, dev, cmd.GetRelayCtrlUplinkListAns())
+		case ttnpb.CID_RELAY_NOTIFY_NEW_END_DEVICE:
+			evs, err = mac.HandleRelayNotifyNewEndDeviceReq(ctx, dev, cmd.GetRelayNotifyNewEndDeviceReq())
 		default:
 			logger.Warn("Unknown MAC command received, skip the rest")
 			break macLoop
@@ -629,6 +645,11 @@ macLoop:
 		}
 	}
 	dev.MacState.RxWindowsAvailable = true
+	if matchType != currentRetransmissionMatch {
+		// NOTE: Relays only forward downlink in the receive windows following the relayed uplink, so downlink
+		// that was not transmitted in the receive windows of a previous uplink is stale.
+		dev.MacState.PendingRelayDownlink = nil
+	}
 	dev.Session.LastFCntUp = cmacFMatchResult.FullFCnt
 
 	var queuedApplicationUplinks []*ttnpb.ApplicationUp
@@ -947,7 +968,9 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	if err := ns.updateDataDownlinkTask(ctx, stored, time.Time{}); err != nil {
 		log.FromContext(ctx).WithError(err).Error("Failed to update downlink task queue after data uplink")
 	}
-	if !matched.IsRetransmission {
+	// NOTE: Uplinks on RelayFPort of a relay contain uplinks of served devices and are not sent to the Application Server.
+	relayForward := pld.FPort == uint32(lorawan.RelayFPort) && stored.MacState.CurrentParameters.Relay.GetServing() != nil
+	if !matched.IsRetransmission && !relayForward {
 		var frmPayload []byte
 		if pld.FPort != 0 {
 			frmPayload = pld.FrmPayload
@@ -976,6 +999,11 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	}
 	queuedEvents = append(queuedEvents, evtProcessDataUplink.NewWithIdentifiersAndData(ctx, &matched.Device.EndDeviceIdentifiers, up))
 	registerProcessUplink(ctx, up)
+	if !matched.IsRetransmission && relayForward {
+		if err := ns.handleRelayForwardUplink(ctx, stored, matched.phy, up); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to handle uplink forwarded by relay")
+		}
+	}
 	return nil
 }
 
@@ -1071,6 +1099,11 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		publishEvents(ctx, queuedEvents...)
 	}()
 
+	if relayIDs, ok := relayIdentifiersFromContext(ctx); ok && !deviceServedByRelay(matched, relayIDs) {
+		log.FromContext(ctx).Debug("Device is not served by the relay, which forwarded the join-request")
+		queuedEvents = append(queuedEvents, evtDropJoinRequest.NewWithIdentifiersAndData(ctx, &matched.EndDeviceIdentifiers, errNotServedByRelay))
+		return nil
+	}
 	if !matched.SupportsJoin {
 		log.FromContext(ctx).Warn("ABP device sent a join-request, drop")
 		queuedEvents = append(queuedEvents, evtDropJoinRequest.NewWithIdentifiersAndData(ctx, &matched.EndDeviceIdentifiers, errABPJoinRequest))
@@ -1246,10 +1279,17 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	registerUplinkLatency(ctx, up)
 
 	up.ReceivedAt = time.Now().UTC()
+	if err := ns.handleUplink(ctx, up); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
 
+// handleUplink handles the uplink up, which is either received by the gateways or forwarded by a relay.
+func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessage) (err error) {
 	up.Payload = &ttnpb.Message{}
 	if err := lorawan.UnmarshalMessage(up.RawPayload, up.Payload); err != nil {
-		return nil, errDecodePayload.WithCause(err)
+		return errDecodePayload.WithCause(err)
 	}
 	registerReceiveUplink(ctx, up)
 	defer func() {
@@ -1258,7 +1298,7 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 		}
 	}()
 	if up.Payload.Major != ttnpb.Major_LORAWAN_R1 {
-		return nil, errUnsupportedLoRaWANVersion.WithAttributes(
+		return errUnsupportedLoRaWANVersion.WithAttributes(
 			"version", up.Payload.Major,
 		)
 	}
@@ -1281,7 +1321,7 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 			"spreading_factor", dr.Lora.GetSpreadingFactor(),
 		))
 	default:
-		return nil, errDataRateNotFound.New()
+		return errDataRateNotFound.New()
 	}
 	ctx = log.NewContext(ctx, logger)
 
@@ -1292,14 +1332,14 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	}
 	switch up.Payload.MType {
 	case ttnpb.MType_CONFIRMED_UP, ttnpb.MType_UNCONFIRMED_UP:
-		return ttnpb.Empty, ns.handleDataUplink(ctx, up)
+		return ns.handleDataUplink(ctx, up)
 	case ttnpb.MType_JOIN_REQUEST:
-		return ttnpb.Empty, ns.handleJoinRequest(ctx, up)
+		return ns.handleJoinRequest(ctx, up)
 	case ttnpb.MType_REJOIN_REQUEST:
-		return ttnpb.Empty, ns.handleRejoinRequest(ctx, up)
+		return ns.handleRejoinRequest(ctx, up)
 	}
 	logger.Debug("Unmatched MType")
-	return ttnpb.Empty, nil
+	return nil
 }
 
 // ReportTxAcknowledgment is called by the Gateway Server when a tx acknowledgment arrives.
@@ -1324,7 +1364,7 @@ func (ns *NetworkServer) ReportTxAcknowledgment(ctx context.Context, up *ttnpb.G
 	if pld == nil {
 		return ttnpb.Empty, nil
 	}
-	if pld.GetFPort() == 0 {
+	if pld.GetFPort() == 0 || pld.GetFPort() == uint32(lorawan.RelayFPort) {
 		return ttnpb.Empty, nil
 	}
 	ns.enqueueApplicationUplinks(ctx, &ttnpb.ApplicationUp{
@@ -0,0 +1,138 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicemigration"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+var evtExportEndDeviceSession = events.Define(
+	"ns.end_device.session.export", "export end device session",
+	events.WithVisibility(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+	events.WithAuthFromContext(),
+	events.WithClientInfoFromContext(),
+)
+
+// applicationServerSessionPaths are the session fields of the Application Server in a migration bundle.
+// The other session fields are exported from the Network Server.
+var applicationServerSessionPaths = []string{
+	"session.keys.app_s_key.key",
+	"session.last_a_f_cnt_down",
+}
+
+// ExportSession implements ttnpb.NsEndDeviceMigrationServer.
+// The device is not deleted from the registry; the client deletes the device once the migration bundle is stored, so
+// that the session is not lost if storing the bundle fails.
+func (ns *NetworkServer) ExportSession(ctx context.Context, req *ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDevice, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, ns.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+	asConn, err := ns.GetPeerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, nil)
+	if err != nil {
+		return nil, err
+	}
+	// End devices that do not support join are not registered on the Join Server, and the Join Server of end devices
+	// that do may not be in the cluster.
+	var jsConn *grpc.ClientConn
+	if conn, err := ns.GetPeerConn(ctx, ttnpb.ClusterRole_JOIN_SERVER, nil); err == nil {
+		jsConn = conn
+	} else {
+		log.FromContext(ctx).WithError(err).Debug("No Join Server available, export session without Join Server fields")
+	}
+
+	stored, ctx, err := ns.devices.GetByID(ctx, req.ApplicationIdentifiers, req.DeviceId, ttnpb.EndDeviceFieldPathsTopLevel)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to get device from registry")
+		return nil, err
+	}
+	dev, err := ns.exportSession(ctx, stored, asConn, jsConn, callOpt)
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtExportEndDeviceSession.NewWithIdentifiersAndData(ctx, req, nil))
+	return dev, nil
+}
+
+// exportSession returns the device with the fields of a migration bundle of the stored device, the Application Server
+// and the Join Server.
+func (ns *NetworkServer) exportSession(ctx context.Context, stored *ttnpb.EndDevice, asConn, jsConn *grpc.ClientConn, callOpt grpc.CallOption) (*ttnpb.EndDevice, error) {
+	dev, err := ttnpb.FilterGetEndDevice(stored, devicemigration.NetworkServerPaths...)
+	if err != nil {
+		return nil, err
+	}
+	if err := unwrapSelectedSessionKeys(ctx, ns.KeyVault, dev, devicemigration.NetworkServerPaths...); err != nil {
+		log.FromContext(ctx).WithError(err).Error("Failed to unwrap selected keys")
+		return nil, err
+	}
+
+	asDev, err := ttnpb.NewAsEndDeviceRegistryClient(asConn).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+		FieldMask: &pbtypes.FieldMask{
+			Paths: devicemigration.ApplicationServerPaths,
+		},
+	}, callOpt)
+	if err != nil {
+		return nil, err
+	}
+	if err := dev.SetFields(asDev, "skip_payload_crypto"); err != nil {
+		return nil, err
+	}
+	// The Application Server session is only exported if it is the current session of the Network Server.
+	if dev.Session != nil && asDev.Session != nil && bytes.Equal(dev.Session.SessionKeyId, asDev.Session.SessionKeyId) {
+		if err := dev.SetFields(asDev, applicationServerSessionPaths...); err != nil {
+			return nil, err
+		}
+	} else {
+		log.FromContext(ctx).Debug("No current Application Server session, export session without Application Server session fields")
+	}
+
+	if !dev.SupportsJoin || jsConn == nil {
+		return dev, nil
+	}
+	jsDev, err := ttnpb.NewJsEndDeviceRegistryClient(jsConn).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+		FieldMask: &pbtypes.FieldMask{
+			Paths: devicemigration.JoinServerPaths,
+		},
+	}, callOpt)
+	if errors.IsNotFound(err) {
+		log.FromContext(ctx).Debug("Device not found on Join Server, export session without Join Server fields")
+		return dev, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := dev.SetFields(jsDev, devicemigration.JoinServerPaths...); err != nil {
+		return nil, err
+	}
+	return dev, nil
+}
@@ -0,0 +1,324 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mohae/deepcopy"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicemigration"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+type mockAsEndDeviceRegistryServer struct {
+	ttnpb.AsEndDeviceRegistryServer
+	GetFunc func(context.Context, *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error)
+}
+
+func (m *mockAsEndDeviceRegistryServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	return m.GetFunc(ctx, req)
+}
+
+type mockJsEndDeviceRegistryServer struct {
+	ttnpb.JsEndDeviceRegistryServer
+	GetFunc func(context.Context, *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error)
+}
+
+func (m *mockJsEndDeviceRegistryServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	return m.GetFunc(ctx, req)
+}
+
+var (
+	errASUnavailable  = errors.DefineUnavailable("test_as_unavailable", "Application Server unavailable")
+	errDeviceNotFound = errors.DefineNotFound("test_device_not_found", "device not found")
+)
+
+func TestExportSession(t *testing.T) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		DeviceId:               "test-dev-id",
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "test-app-id"},
+		JoinEui:                &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+		DevEui:                 &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+	}
+	nwkKey := types.AES128Key{0x01}
+	appSKey := types.AES128Key{0x02}
+	appKey := types.AES128Key{0x03}
+	makeStoredDevice := func() *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ids,
+			FrequencyPlanId:      test.EUFrequencyPlanID,
+			LorawanVersion:       ttnpb.MAC_V1_0_3,
+			LorawanPhyVersion:    ttnpb.RP001_V1_0_3_REV_A,
+			SupportsJoin:         true,
+			Session: &ttnpb.Session{
+				DevAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04},
+				SessionKeys: ttnpb.SessionKeys{
+					SessionKeyId: []byte{0x11},
+					FNwkSIntKey:  &ttnpb.KeyEnvelope{Key: &nwkKey},
+					SNwkSIntKey:  &ttnpb.KeyEnvelope{Key: &nwkKey},
+					NwkSEncKey:   &ttnpb.KeyEnvelope{Key: &nwkKey},
+				},
+				LastFCntUp:    42,
+				LastNFCntDown: 24,
+			},
+		}
+	}
+	rightsContext := func(rs ...ttnpb.Right) func(context.Context) context.Context {
+		return func(ctx context.Context) context.Context {
+			return rights.NewContext(ctx, rights.Rights{
+				ApplicationRights: map[string]*ttnpb.Rights{
+					unique.ID(test.Context(), ids.ApplicationIdentifiers): {
+						Rights: rs,
+					},
+				},
+			})
+		}
+	}
+	allRights := rightsContext(
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+	)
+	getDevice := func(stored *ttnpb.EndDevice) func(context.Context, ttnpb.ApplicationIdentifiers, string, []string) (*ttnpb.EndDevice, context.Context, error) {
+		return func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string) (*ttnpb.EndDevice, context.Context, error) {
+			a := assertions.New(test.MustTFromContext(ctx))
+			a.So(appID, should.Resemble, ids.ApplicationIdentifiers)
+			a.So(devID, should.Equal, ids.DeviceId)
+			a.So(gets, should.Resemble, ttnpb.EndDeviceFieldPathsTopLevel)
+			if stored == nil {
+				return nil, ctx, errDeviceNotFound.New()
+			}
+			return stored, ctx, nil
+		}
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		ContextFunc    func(context.Context) context.Context
+		GetByIDFunc    func(context.Context, ttnpb.ApplicationIdentifiers, string, []string) (*ttnpb.EndDevice, context.Context, error)
+		GetPeers       bool
+		AsGetFunc      func(context.Context, *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error)
+		JsGetFunc      func(context.Context, *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error)
+		ErrorAssertion func(*testing.T, error) bool
+		Expected       *ttnpb.EndDevice
+	}{
+		{
+			Name: "No device key rights",
+			ContextFunc: rightsContext(
+				ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+			),
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(errors.IsPermissionDenied(err), should.BeTrue)
+			},
+		},
+		{
+			Name:        "Non-existing device",
+			ContextFunc: allRights,
+			GetByIDFunc: getDevice(nil),
+			GetPeers:    true,
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(errors.IsNotFound(err), should.BeTrue)
+			},
+		},
+		{
+			Name:        "Application Server failure",
+			ContextFunc: allRights,
+			GetByIDFunc: getDevice(makeStoredDevice()),
+			GetPeers:    true,
+			AsGetFunc: func(context.Context, *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+				return nil, errASUnavailable.New()
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(errors.IsUnavailable(err), should.BeTrue)
+			},
+		},
+		{
+			Name:        "Existing device",
+			ContextFunc: allRights,
+			GetByIDFunc: getDevice(makeStoredDevice()),
+			GetPeers:    true,
+			AsGetFunc: func(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(req.EndDeviceIdentifiers, should.Resemble, ids)
+				a.So(req.FieldMask.Paths, should.Resemble, devicemigration.ApplicationServerPaths)
+				return &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					Session: &ttnpb.Session{
+						DevAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04},
+						SessionKeys: ttnpb.SessionKeys{
+							SessionKeyId: []byte{0x11},
+							AppSKey:      &ttnpb.KeyEnvelope{Key: &appSKey},
+						},
+						LastAFCntDown: 12,
+					},
+				}, nil
+			},
+			JsGetFunc: func(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(req.EndDeviceIdentifiers, should.Resemble, ids)
+				a.So(req.FieldMask.Paths, should.Resemble, devicemigration.JoinServerPaths)
+				return &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					RootKeys: &ttnpb.RootKeys{
+						RootKeyId: "test",
+						AppKey:    &ttnpb.KeyEnvelope{Key: &appKey},
+					},
+					LastDevNonce: 0x42,
+				}, nil
+			},
+			Expected: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ids,
+				FrequencyPlanId:      test.EUFrequencyPlanID,
+				LorawanVersion:       ttnpb.MAC_V1_0_3,
+				LorawanPhyVersion:    ttnpb.RP001_V1_0_3_REV_A,
+				SupportsJoin:         true,
+				Session: &ttnpb.Session{
+					DevAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04},
+					SessionKeys: ttnpb.SessionKeys{
+						SessionKeyId: []byte{0x11},
+						FNwkSIntKey:  &ttnpb.KeyEnvelope{Key: &nwkKey},
+						SNwkSIntKey:  &ttnpb.KeyEnvelope{Key: &nwkKey},
+						NwkSEncKey:   &ttnpb.KeyEnvelope{Key: &nwkKey},
+						AppSKey:      &ttnpb.KeyEnvelope{Key: &appSKey},
+					},
+					LastFCntUp:    42,
+					LastNFCntDown: 24,
+					LastAFCntDown: 12,
+				},
+				RootKeys: &ttnpb.RootKeys{
+					RootKeyId: "test",
+					AppKey:    &ttnpb.KeyEnvelope{Key: &appKey},
+				},
+				LastDevNonce: 0x42,
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				var getByIDCalls uint64
+
+				ns, ctx, env, stop := StartTest(
+					ctx,
+					TestConfig{
+						NetworkServer: Config{
+							Devices: &MockDeviceRegistry{
+								GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string) (*ttnpb.EndDevice, context.Context, error) {
+									atomic.AddUint64(&getByIDCalls, 1)
+									if tc.GetByIDFunc == nil {
+										t.Error("Unexpected GetByID call")
+										return nil, ctx, errors.New("unexpected GetByID call")
+									}
+									return tc.GetByIDFunc(ctx, appID, devID, gets)
+								},
+								SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+									t.Error("SetByID must not be called")
+									return nil, ctx, errors.New("unexpected SetByID call")
+								},
+							},
+						},
+						TaskStarter: StartTaskExclude(
+							DownlinkProcessTaskName,
+						),
+					},
+				)
+				defer stop()
+
+				go LogEvents(t, env.Events)
+
+				ns.AddContextFiller(tc.ContextFunc)
+				ns.AddContextFiller(func(ctx context.Context) context.Context {
+					return test.ContextWithTB(ctx, t)
+				})
+
+				type exportResult struct {
+					Device *ttnpb.EndDevice
+					Error  error
+				}
+				resCh := make(chan exportResult, 1)
+				req := deepcopy.Copy(&ids).(*ttnpb.EndDeviceIdentifiers)
+				go func() {
+					dev, err := ttnpb.NewNsEndDeviceMigrationClient(ns.LoopbackConn()).ExportSession(ctx, req, grpc.PerRPCCredentials(rpcmetadata.MD{
+						AuthType:      "Bearer",
+						AuthValue:     "key",
+						AllowInsecure: true,
+					}))
+					resCh <- exportResult{
+						Device: dev,
+						Error:  err,
+					}
+				}()
+
+				if tc.GetPeers {
+					if !a.So(test.AssertClusterGetPeerRequest(ctx, env.Cluster.GetPeer, func(ctx, reqCtx context.Context, role ttnpb.ClusterRole, ids cluster.EntityIdentifiers) (test.ClusterGetPeerResponse, bool) {
+						return test.ClusterGetPeerResponse{
+							Peer: test.Must(test.NewGRPCServerPeer(ctx, &mockAsEndDeviceRegistryServer{
+								GetFunc: func(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+									return tc.AsGetFunc(test.ContextWithTB(ctx, t), req)
+								},
+							}, ttnpb.RegisterAsEndDeviceRegistryServer)).(cluster.Peer),
+						}, a.So(role, should.Equal, ttnpb.ClusterRole_APPLICATION_SERVER)
+					}), should.BeTrue) {
+						t.Fatal("Application Server peer look-up assertion failed")
+					}
+					if !a.So(test.AssertClusterGetPeerRequest(ctx, env.Cluster.GetPeer, func(ctx, reqCtx context.Context, role ttnpb.ClusterRole, ids cluster.EntityIdentifiers) (test.ClusterGetPeerResponse, bool) {
+						return test.ClusterGetPeerResponse{
+							Peer: test.Must(test.NewGRPCServerPeer(ctx, &mockJsEndDeviceRegistryServer{
+								GetFunc: func(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+									return tc.JsGetFunc(test.ContextWithTB(ctx, t), req)
+								},
+							}, ttnpb.RegisterJsEndDeviceRegistryServer)).(cluster.Peer),
+						}, a.So(role, should.Equal, ttnpb.ClusterRole_JOIN_SERVER)
+					}), should.BeTrue) {
+						t.Fatal("Join Server peer look-up assertion failed")
+					}
+				}
+
+				var res exportResult
+				select {
+				case <-ctx.Done():
+					t.Fatal("Timed out while waiting for ExportSession to return")
+				case res = <-resCh:
+				}
+				if tc.GetByIDFunc != nil {
+					a.So(getByIDCalls, should.Equal, 1)
+				} else {
+					a.So(getByIDCalls, should.Equal, 0)
+				}
+				if tc.ErrorAssertion != nil && a.So(tc.ErrorAssertion(t, res.Error), should.BeTrue) {
+					a.So(res.Device, should.BeNil)
+				} else if a.So(res.Error, should.BeNil) {
+					a.So(res.Device, should.Resemble, tc.Expected)
+				}
+				a.So(req, should.Resemble, &ids)
+			},
+		})
+	}
+}
@@ -31,4 +31,5 @@ var (
 	ErrMACHandler           = errors.DefineCorruption("mac_handler", "missing MAC handler")
 	ErrChannelDataRateRange = errors.DefineCorruption("channel_data_rate_range", "could not generate channel datarate range")
 	ErrChannelMask          = errors.DefineCorruption("channel_mask", "could not generate channel mask")
+	ErrRelay                = errors.DefineCorruption("relay", "device is not a relay")
 )
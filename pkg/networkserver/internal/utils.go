@@ -129,6 +129,11 @@ func CopyEndDevice(pb *ttnpb.EndDevice) *ttnpb.EndDevice {
 	return deepcopy.Copy(pb).(*ttnpb.EndDevice)
 }
 
+// CopyRelayParameters returns a deep copy of ttnpb.RelayParameters pb.
+func CopyRelayParameters(pb *ttnpb.RelayParameters) *ttnpb.RelayParameters {
+	return deepcopy.Copy(pb).(*ttnpb.RelayParameters)
+}
+
 // CopyUplinkMessage returns a deep copy of ttnpb.UplinkMessage pb.
 func CopyUplinkMessage(pb *ttnpb.UplinkMessage) *ttnpb.UplinkMessage {
 	return deepcopy.Copy(pb).(*ttnpb.UplinkMessage)
@@ -0,0 +1,153 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// rfRegions are the Backend Interfaces RF regions by band ID.
+var rfRegions = map[string]string{
+	band.AS_923:     "AS923",
+	band.AU_915_928: "AU915",
+	band.CN_470_510: "CN470",
+	band.CN_779_787: "CN779",
+	band.EU_433:     "EU433",
+	band.EU_863_870: "EU868",
+	band.IN_865_867: "IN865",
+	band.KR_920_923: "KR920",
+	band.RU_864_870: "RU864",
+	band.US_902_928: "US902",
+}
+
+// deviceProfilePaths are the end device fields that are used for the Backend Interfaces device profile.
+var deviceProfilePaths = []string{
+	"frequency_plan_id",
+	"ids",
+	"lorawan_phy_version",
+	"lorawan_version",
+	"mac_settings",
+	"supports_class_b",
+	"supports_class_c",
+	"supports_join",
+	"updated_at",
+}
+
+type interopServer struct {
+	NS *NetworkServer
+}
+
+// ProfileRequest implements interop.NetworkServer.
+// The end device is looked up by DevEUI in all applications, which requires ranging over the device registry.
+func (srv interopServer) ProfileRequest(ctx context.Context, in *interop.ProfileReq) (*interop.ProfileAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+
+	if !types.NetID(in.ReceiverID).Equal(srv.NS.netID) {
+		return nil, interop.ErrUnknownReceiver.New()
+	}
+
+	var dev *ttnpb.EndDevice
+	if err := srv.NS.devices.Range(ctx, deviceProfilePaths, func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, stored *ttnpb.EndDevice) bool {
+		if ids.DevEui == nil || !ids.DevEui.Equal(types.EUI64(in.DevEUI)) {
+			return true
+		}
+		dev = stored
+		return false
+	}); err != nil {
+		return nil, err
+	}
+	if dev == nil {
+		return nil, interop.ErrUnknownDevEUI.New()
+	}
+	profile, err := srv.NS.deviceProfile(dev)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to determine device profile")
+		return nil, interop.ErrUnknownDevEUI.WithCause(err)
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	updatedAt := dev.UpdatedAt
+	return &interop.ProfileAns{
+		NsNsMessageHeader: interop.NsNsMessageHeader{
+			MessageHeader: header,
+			SenderID:      in.ReceiverID,
+			ReceiverID:    in.SenderID,
+			SenderNSID:    in.ReceiverNSID,
+			ReceiverNSID:  in.SenderNSID,
+		},
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		DeviceProfile:          profile,
+		DeviceProfileTimestamp: &updatedAt,
+	}, nil
+}
+
+func hzToMHz(f uint64) float64 {
+	return float64(f) / 1e6
+}
+
+// deviceProfile returns the Backend Interfaces device profile of the end device.
+// The MAC parameters are the defaults of the end device on activation.
+func (ns *NetworkServer) deviceProfile(dev *ttnpb.EndDevice) (*interop.DeviceProfile, error) {
+	fp, phy, err := DeviceFrequencyPlanAndBand(dev, ns.FrequencyPlans)
+	if err != nil {
+		return nil, err
+	}
+	defaults := ns.defaultMACSettings
+	profile := &interop.DeviceProfile{
+		SupportsClassB:    dev.SupportsClassB,
+		SupportsClassC:    dev.SupportsClassC,
+		MACVersion:        interop.MACVersion(dev.LorawanVersion),
+		SupportsJoin:      dev.SupportsJoin,
+		RXDelay1:          uint32(mac.DeviceDefaultRX1Delay(dev, phy, defaults)),
+		RXDROffset1:       uint32(mac.DeviceDefaultRX1DataRateOffset(dev, defaults)),
+		RXDataRate2:       uint32(mac.DeviceDefaultRX2DataRateIndex(dev, phy, defaults)),
+		RXFreq2:           hzToMHz(mac.DeviceDefaultRX2Frequency(dev, phy, defaults)),
+		MaxEIRP:           int32(mac.DeviceDesiredMaxEIRP(dev, phy, fp, defaults)),
+		RFRegion:          rfRegions[phy.ID],
+		Supports32bitFCnt: mac.DeviceSupports32BitFCnt(dev, defaults),
+	}
+	if dev.SupportsClassB {
+		profile.ClassBTimeout = uint32(mac.DeviceClassBTimeout(dev, defaults) / time.Second)
+		if v := mac.DeviceDefaultPingSlotPeriodicity(dev, defaults); v != nil {
+			// The ping slot period is 2^k seconds for periodicity k.
+			profile.PingSlotPeriod = 1 << uint32(v.Value)
+		}
+		if v := mac.DeviceDefaultPingSlotDataRateIndexValue(dev, phy, defaults); v != nil {
+			profile.PingSlotDR = uint32(v.Value)
+		}
+		profile.PingSlotFreq = hzToMHz(mac.DeviceDefaultPingSlotFrequency(dev, phy, defaults))
+	}
+	if dev.SupportsClassC {
+		profile.ClassCTimeout = uint32(mac.DeviceClassCTimeout(dev, defaults) / time.Second)
+	}
+	for _, f := range dev.GetMacSettings().GetFactoryPresetFrequencies() {
+		profile.FactoryPresetFreqs = append(profile.FactoryPresetFreqs, hzToMHz(f))
+	}
+	return profile, nil
+}
@@ -0,0 +1,175 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestInteropProfileRequest(t *testing.T) {
+	netID := types.NetID{0x00, 0x00, 0x13}
+	updatedAt := time.Unix(42, 0).UTC()
+	devices := []*ttnpb.EndDevice{
+		{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				DeviceId:               "test-dev-abp",
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "test-app-id"},
+			},
+			FrequencyPlanId:   test.EUFrequencyPlanID,
+			LorawanVersion:    ttnpb.MAC_V1_0_3,
+			LorawanPhyVersion: ttnpb.RP001_V1_0_3_REV_A,
+		},
+		{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				DeviceId:               "test-dev-id",
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "test-app-id"},
+				JoinEui:                &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+				DevEui:                 &types.EUI64{0x42, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			},
+			FrequencyPlanId:   test.EUFrequencyPlanID,
+			LorawanVersion:    ttnpb.MAC_V1_0_3,
+			LorawanPhyVersion: ttnpb.RP001_V1_0_3_REV_A,
+			SupportsJoin:      true,
+			SupportsClassC:    true,
+			MacSettings: &ttnpb.MACSettings{
+				ClassCTimeout:            DurationPtr(10 * time.Second),
+				FactoryPresetFrequencies: []uint64{868100000, 868300000},
+			},
+			UpdatedAt: updatedAt,
+		},
+	}
+
+	makeRequest := func(receiverID interop.NetID, devEUI interop.EUI64) *interop.ProfileReq {
+		return &interop.ProfileReq{
+			NsNsMessageHeader: interop.NsNsMessageHeader{
+				MessageHeader: interop.MessageHeader{
+					MessageType:     interop.MessageTypeProfileReq,
+					ProtocolVersion: interop.ProtocolV1_1,
+					TransactionID:   42,
+				},
+				SenderID:   interop.NetID{0x00, 0x00, 0x01},
+				ReceiverID: receiverID,
+				SenderNSID: &interop.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+			},
+			DevEUI: devEUI,
+		}
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Request        *interop.ProfileReq
+		ErrorAssertion func(error) bool
+		Answer         *interop.ProfileAns
+	}{
+		{
+			Name:    "UnknownReceiver",
+			Request: makeRequest(interop.NetID{0x00, 0x00, 0x14}, interop.EUI64{0x42, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}),
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, interop.ErrUnknownReceiver)
+			},
+		},
+		{
+			Name:    "UnknownDevEUI",
+			Request: makeRequest(interop.NetID(netID), interop.EUI64{0x42, 0xfe, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}),
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, interop.ErrUnknownDevEUI)
+			},
+		},
+		{
+			Name:    "ClassC",
+			Request: makeRequest(interop.NetID(netID), interop.EUI64{0x42, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}),
+			Answer: &interop.ProfileAns{
+				NsNsMessageHeader: interop.NsNsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						MessageType:     interop.MessageTypeProfileAns,
+						ProtocolVersion: interop.ProtocolV1_1,
+						TransactionID:   42,
+					},
+					SenderID:     interop.NetID(netID),
+					ReceiverID:   interop.NetID{0x00, 0x00, 0x01},
+					ReceiverNSID: &interop.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+				},
+				Result: interop.Result{
+					ResultCode: interop.ResultSuccess,
+				},
+				DeviceProfile: &interop.DeviceProfile{
+					SupportsClassC:     true,
+					ClassCTimeout:      10,
+					MACVersion:         interop.MACVersion(ttnpb.MAC_V1_0_3),
+					SupportsJoin:       true,
+					RXDelay1:           1,
+					RXFreq2:            869.525,
+					FactoryPresetFreqs: []float64{868.1, 868.3},
+					MaxEIRP:            16,
+					RFRegion:           "EU868",
+					Supports32bitFCnt:  true,
+				},
+				DeviceProfileTimestamp: &updatedAt,
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				ns, ctx, _, stop := StartTest(
+					ctx,
+					TestConfig{
+						NetworkServer: Config{
+							NetID: netID,
+							Devices: &MockDeviceRegistry{
+								RangeFunc: func(ctx context.Context, paths []string, f func(context.Context, ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool) error {
+									for _, dev := range devices {
+										if !f(ctx, dev.EndDeviceIdentifiers, dev) {
+											break
+										}
+									}
+									return nil
+								},
+							},
+						},
+						TaskStarter: StartTaskExclude(
+							DownlinkProcessTaskName,
+						),
+					},
+				)
+				defer stop()
+
+				ans, err := InteropServer(ns).ProfileRequest(ctx, tc.Request)
+				if tc.ErrorAssertion != nil {
+					a.So(tc.ErrorAssertion(err), should.BeTrue)
+					a.So(ans, should.BeNil)
+					return
+				}
+				if a.So(err, should.BeNil) {
+					a.So(ans, should.Resemble, tc.Answer)
+				}
+			},
+		})
+	}
+}
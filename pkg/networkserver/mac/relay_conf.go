@@ -0,0 +1,138 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	EvtEnqueueRelayConfRequest = defineEnqueueMACRequestEvent(
+		"relay_conf", "relay configuration",
+		events.WithDataType(&ttnpb.MACCommand_RelayConfReq{}),
+	)()
+	EvtReceiveRelayConfAccept = defineReceiveMACAcceptEvent(
+		"relay_conf", "relay configuration",
+		events.WithDataType(&ttnpb.MACCommand_RelayConfAns{}),
+	)()
+	EvtReceiveRelayConfReject = defineReceiveMACRejectEvent(
+		"relay_conf", "relay configuration",
+		events.WithDataType(&ttnpb.MACCommand_RelayConfAns{}),
+	)()
+)
+
+// relayConfiguration returns the relay configuration of p, which is configured using RelayConfReq.
+// relayConfiguration returns nil if p does not represent a relay.
+func relayConfiguration(p *ttnpb.RelayParameters) *ttnpb.MACCommand_RelayConfReq_Configuration {
+	serving := p.GetServing()
+	if serving == nil {
+		return nil
+	}
+	return &ttnpb.MACCommand_RelayConfReq_Configuration{
+		SecondChannel:       serving.SecondChannel,
+		DefaultChannelIndex: serving.DefaultChannelIndex,
+		CadPeriodicity:      serving.CadPeriodicity,
+	}
+}
+
+func DeviceNeedsRelayConfReq(dev *ttnpb.EndDevice) bool {
+	if dev.GetMulticast() || dev.GetMacState() == nil {
+		return false
+	}
+	return !relayConfiguration(dev.MacState.DesiredParameters.Relay).Equal(relayConfiguration(dev.MacState.CurrentParameters.Relay))
+}
+
+func EnqueueRelayConfReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16) EnqueueState {
+	if !DeviceNeedsRelayConfReq(dev) {
+		return EnqueueState{
+			MaxDownLen: maxDownLen,
+			MaxUpLen:   maxUpLen,
+			Ok:         true,
+		}
+	}
+
+	var st EnqueueState
+	dev.MacState.PendingRequests, st = enqueueMACCommand(ttnpb.CID_RELAY_CONF, maxDownLen, maxUpLen, func(nDown, nUp uint16) ([]*ttnpb.MACCommand, uint16, events.Builders, bool) {
+		if nDown < 1 || nUp < 1 {
+			return nil, 0, nil, false
+		}
+		req := &ttnpb.MACCommand_RelayConfReq{
+			Configuration: relayConfiguration(dev.MacState.DesiredParameters.Relay),
+		}
+		log.FromContext(ctx).WithFields(log.Fields(
+			"enabled", req.Configuration != nil,
+		)).Debug("Enqueued RelayConfReq")
+		return []*ttnpb.MACCommand{
+				req.MACCommand(),
+			},
+			1,
+			events.Builders{
+				EvtEnqueueRelayConfRequest.With(events.WithData(req)),
+			},
+			true
+	}, dev.MacState.PendingRequests...)
+	return st
+}
+
+func HandleRelayConfAns(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCommand_RelayConfAns) (events.Builders, error) {
+	if pld == nil {
+		return nil, ErrNoPayload.New()
+	}
+
+	accepted := pld.SecondChannelFrequencyAck &&
+		pld.SecondChannelAckOffsetAck &&
+		pld.SecondChannelDataRateIndexAck &&
+		pld.SecondChannelIndexAck &&
+		pld.DefaultChannelIndexAck &&
+		pld.CadPeriodicityAck
+	var err error
+	dev.MacState.PendingRequests, err = handleMACResponse(ttnpb.CID_RELAY_CONF, func(cmd *ttnpb.MACCommand) error {
+		req := cmd.GetRelayConfReq()
+		if req.Configuration == nil {
+			// NOTE: The relay ignores the configuration fields when the relay is disabled.
+			accepted = true
+			dev.MacState.CurrentParameters.Relay = nil
+			return nil
+		}
+		if !accepted {
+			return nil
+		}
+
+		serving := dev.MacState.CurrentParameters.Relay.GetServing()
+		if serving == nil {
+			serving = &ttnpb.ServingRelayParameters{}
+			dev.MacState.CurrentParameters.Relay = &ttnpb.RelayParameters{
+				Mode: &ttnpb.RelayParameters_Serving{
+					Serving: serving,
+				},
+			}
+		}
+		serving.SecondChannel = req.Configuration.SecondChannel
+		serving.DefaultChannelIndex = req.Configuration.DefaultChannelIndex
+		serving.CadPeriodicity = req.Configuration.CadPeriodicity
+		return nil
+	}, dev.MacState.PendingRequests...)
+	ev := EvtReceiveRelayConfAccept
+	if !accepted {
+		ev = EvtReceiveRelayConfReject
+	}
+	return events.Builders{
+		ev.With(events.WithData(pld)),
+	}, err
+}
@@ -0,0 +1,451 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestNeedsRelayConfReq(t *testing.T) {
+	for _, tc := range []struct {
+		Name        string
+		InputDevice *ttnpb.EndDevice
+		Needs       bool
+	}{
+		{
+			Name:        "no MAC state",
+			InputDevice: &ttnpb.EndDevice{},
+		},
+		{
+			Name: "current(nil),desired(nil)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+		},
+		{
+			Name: "current(nil),desired(served)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Served{
+								Served: &ttnpb.ServedRelayParameters{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "current(nil),desired(serving)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Serving{
+								Serving: &ttnpb.ServingRelayParameters{},
+							},
+						},
+					},
+				},
+			},
+			Needs: true,
+		},
+		{
+			Name: "current(serving,cad:1s),desired(serving,cad:1s,rules:1)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Serving{
+								Serving: &ttnpb.ServingRelayParameters{},
+							},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Serving{
+								Serving: &ttnpb.ServingRelayParameters{
+									UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+										{DeviceId: "test-device"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "current(serving,cad:1s),desired(serving,cad:20ms)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Serving{
+								Serving: &ttnpb.ServingRelayParameters{},
+							},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Serving{
+								Serving: &ttnpb.ServingRelayParameters{
+									CadPeriodicity: ttnpb.RELAY_CAD_PERIODICITY_20_MILLISECONDS,
+								},
+							},
+						},
+					},
+				},
+			},
+			Needs: true,
+		},
+		{
+			Name: "current(serving),desired(nil)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Serving{
+								Serving: &ttnpb.ServingRelayParameters{},
+							},
+						},
+					},
+				},
+			},
+			Needs: true,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+				res := DeviceNeedsRelayConfReq(dev)
+				if tc.Needs {
+					a.So(res, should.BeTrue)
+				} else {
+					a.So(res, should.BeFalse)
+				}
+				a.So(dev, should.Resemble, tc.InputDevice)
+			},
+		})
+	}
+}
+
+func TestEnqueueRelayConfReq(t *testing.T) {
+	serving := &ttnpb.RelayParameters{
+		Mode: &ttnpb.RelayParameters_Serving{
+			Serving: &ttnpb.ServingRelayParameters{
+				SecondChannel: &ttnpb.RelaySecondChannel{
+					AckOffset:     ttnpb.RELAY_SECOND_CH_ACK_OFFSET_200,
+					DataRateIndex: ttnpb.DATA_RATE_3,
+					Frequency:     868300000,
+				},
+				DefaultChannelIndex: 1,
+				CadPeriodicity:      ttnpb.RELAY_CAD_PERIODICITY_100_MILLISECONDS,
+			},
+		},
+	}
+	req := &ttnpb.MACCommand_RelayConfReq{
+		Configuration: &ttnpb.MACCommand_RelayConfReq_Configuration{
+			SecondChannel: &ttnpb.RelaySecondChannel{
+				AckOffset:     ttnpb.RELAY_SECOND_CH_ACK_OFFSET_200,
+				DataRateIndex: ttnpb.DATA_RATE_3,
+				Frequency:     868300000,
+			},
+			DefaultChannelIndex: 1,
+			CadPeriodicity:      ttnpb.RELAY_CAD_PERIODICITY_100_MILLISECONDS,
+		},
+	}
+	for _, tc := range []struct {
+		Name                        string
+		InputDevice, ExpectedDevice *ttnpb.EndDevice
+		MaxDownlinkLength           uint16
+		MaxUplinkLength             uint16
+		State                       EnqueueState
+	}{
+		{
+			Name: "payload fits/enable",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: serving,
+					},
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: serving,
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						req.MACCommand(),
+					},
+				},
+			},
+			MaxDownlinkLength: 42,
+			MaxUplinkLength:   24,
+			State: EnqueueState{
+				MaxDownLen: 36,
+				MaxUpLen:   22,
+				Ok:         true,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayConfRequest.With(events.WithData(req)),
+				},
+			},
+		},
+		{
+			Name: "payload fits/disable",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: serving,
+					},
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: serving,
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayConfReq{}).MACCommand(),
+					},
+				},
+			},
+			MaxDownlinkLength: 42,
+			MaxUplinkLength:   24,
+			State: EnqueueState{
+				MaxDownLen: 36,
+				MaxUpLen:   22,
+				Ok:         true,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayConfRequest.With(events.WithData(&ttnpb.MACCommand_RelayConfReq{})),
+				},
+			},
+		},
+		{
+			Name: "downlink does not fit",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: serving,
+					},
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: serving,
+					},
+				},
+			},
+			MaxDownlinkLength: 5,
+			MaxUplinkLength:   24,
+			State: EnqueueState{
+				MaxDownLen: 5,
+				MaxUpLen:   24,
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+
+				st := EnqueueRelayConfReq(ctx, dev, tc.MaxDownlinkLength, tc.MaxUplinkLength)
+				a.So(dev, should.Resemble, tc.ExpectedDevice)
+				a.So(st.QueuedEvents, should.ResembleEventBuilders, tc.State.QueuedEvents)
+				st.QueuedEvents = tc.State.QueuedEvents
+				a.So(st, should.Resemble, tc.State)
+			},
+		})
+	}
+}
+
+func TestHandleRelayConfAns(t *testing.T) {
+	configuration := &ttnpb.MACCommand_RelayConfReq_Configuration{
+		SecondChannel: &ttnpb.RelaySecondChannel{
+			AckOffset:     ttnpb.RELAY_SECOND_CH_ACK_OFFSET_200,
+			DataRateIndex: ttnpb.DATA_RATE_3,
+			Frequency:     868300000,
+		},
+		DefaultChannelIndex: 1,
+		CadPeriodicity:      ttnpb.RELAY_CAD_PERIODICITY_100_MILLISECONDS,
+	}
+	allAck := &ttnpb.MACCommand_RelayConfAns{
+		SecondChannelFrequencyAck:     true,
+		SecondChannelAckOffsetAck:     true,
+		SecondChannelDataRateIndexAck: true,
+		SecondChannelIndexAck:         true,
+		DefaultChannelIndexAck:        true,
+		CadPeriodicityAck:             true,
+	}
+	for _, tc := range []struct {
+		Name             string
+		Device, Expected *ttnpb.EndDevice
+		Payload          *ttnpb.MACCommand_RelayConfAns
+		Events           events.Builders
+		Error            error
+	}{
+		{
+			Name: "nil payload",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Error: ErrNoPayload,
+		},
+		{
+			Name: "no request",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Payload: allAck,
+			Events: events.Builders{
+				EvtReceiveRelayConfAccept.With(events.WithData(allAck)),
+			},
+			Error: ErrRequestNotFound,
+		},
+		{
+			Name: "enable/all ack",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayConfReq{
+							Configuration: configuration,
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Serving{
+								Serving: &ttnpb.ServingRelayParameters{
+									SecondChannel:       configuration.SecondChannel,
+									DefaultChannelIndex: configuration.DefaultChannelIndex,
+									CadPeriodicity:      configuration.CadPeriodicity,
+								},
+							},
+						},
+					},
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: allAck,
+			Events: events.Builders{
+				EvtReceiveRelayConfAccept.With(events.WithData(allAck)),
+			},
+		},
+		{
+			Name: "enable/CAD periodicity nack",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayConfReq{
+							Configuration: configuration,
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: &ttnpb.MACCommand_RelayConfAns{
+				SecondChannelFrequencyAck:     true,
+				SecondChannelAckOffsetAck:     true,
+				SecondChannelDataRateIndexAck: true,
+				SecondChannelIndexAck:         true,
+				DefaultChannelIndexAck:        true,
+			},
+			Events: events.Builders{
+				EvtReceiveRelayConfReject.With(events.WithData(&ttnpb.MACCommand_RelayConfAns{
+					SecondChannelFrequencyAck:     true,
+					SecondChannelAckOffsetAck:     true,
+					SecondChannelDataRateIndexAck: true,
+					SecondChannelIndexAck:         true,
+					DefaultChannelIndexAck:        true,
+				})),
+			},
+		},
+		{
+			Name: "disable",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Serving{
+								Serving: &ttnpb.ServingRelayParameters{
+									CadPeriodicity: ttnpb.RELAY_CAD_PERIODICITY_100_MILLISECONDS,
+								},
+							},
+						},
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayConfReq{}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: &ttnpb.MACCommand_RelayConfAns{},
+			Events: events.Builders{
+				EvtReceiveRelayConfAccept.With(events.WithData(&ttnpb.MACCommand_RelayConfAns{})),
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.Device)
+
+				evs, err := HandleRelayConfAns(ctx, dev, tc.Payload)
+				if tc.Error != nil && !a.So(err, should.EqualErrorOrDefinition, tc.Error) ||
+					tc.Error == nil && !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(dev, should.Resemble, tc.Expected)
+				a.So(evs, should.ResembleEventBuilders, tc.Events)
+			},
+		})
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestNeedsRelayCtrlUplinkListReq(t *testing.T) {
+	for _, tc := range []struct {
+		Name        string
+		InputDevice *ttnpb.EndDevice
+		Needs       bool
+	}{
+		{
+			Name:        "no MAC state",
+			InputDevice: &ttnpb.EndDevice{},
+		},
+		{
+			Name: "current(rules:1),desired(rules:1)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+								{DeviceId: "test-device"},
+							},
+						}),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+								{DeviceId: "other-device"},
+							},
+						}),
+					},
+				},
+			},
+		},
+		{
+			Name: "current(rules:1),desired(rules:0)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+								{DeviceId: "test-device"},
+							},
+						}),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{}),
+					},
+				},
+			},
+			Needs: true,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+				res := DeviceNeedsRelayCtrlUplinkListReq(dev)
+				if tc.Needs {
+					a.So(res, should.BeTrue)
+				} else {
+					a.So(res, should.BeFalse)
+				}
+				a.So(dev, should.Resemble, tc.InputDevice)
+			},
+		})
+	}
+}
+
+func TestHandleRelayCtrlUplinkListAns(t *testing.T) {
+	for _, tc := range []struct {
+		Name             string
+		Device, Expected *ttnpb.EndDevice
+		Payload          *ttnpb.MACCommand_RelayCtrlUplinkListAns
+		Events           events.Builders
+		Error            error
+	}{
+		{
+			Name: "nil payload",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Error: ErrNoPayload,
+		},
+		{
+			Name: "no request",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Payload: &ttnpb.MACCommand_RelayCtrlUplinkListAns{
+				RuleIndexAck: true,
+			},
+			Events: events.Builders{
+				EvtReceiveRelayCtrlUplinkListAccept.With(events.WithData(&ttnpb.MACCommand_RelayCtrlUplinkListAns{
+					RuleIndexAck: true,
+				})),
+			},
+			Error: ErrRequestNotFound,
+		},
+		{
+			Name: "read WFCnt/ack",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+								{DeviceId: "test-device"},
+							},
+						}),
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayCtrlUplinkListReq{
+							Action: ttnpb.RELAY_CTRL_UPLINK_LIST_ACTION_READ_W_F_CNT,
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+								{
+									DeviceId:  "test-device",
+									LastWFCnt: 42,
+								},
+							},
+						}),
+					},
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: &ttnpb.MACCommand_RelayCtrlUplinkListAns{
+				RuleIndexAck: true,
+				WFCnt:        42,
+			},
+			Events: events.Builders{
+				EvtReceiveRelayCtrlUplinkListAccept.With(events.WithData(&ttnpb.MACCommand_RelayCtrlUplinkListAns{
+					RuleIndexAck: true,
+					WFCnt:        42,
+				})),
+			},
+		},
+		{
+			Name: "remove/nack",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+								{},
+								{DeviceId: "test-device"},
+							},
+						}),
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayCtrlUplinkListReq{
+							RuleIndex: 1,
+							Action:    ttnpb.RELAY_CTRL_UPLINK_LIST_ACTION_REMOVE_TRUSTED_END_DEVICE,
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+								{},
+								{},
+							},
+						}),
+					},
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: &ttnpb.MACCommand_RelayCtrlUplinkListAns{},
+			Events: events.Builders{
+				EvtReceiveRelayCtrlUplinkListReject.With(events.WithData(&ttnpb.MACCommand_RelayCtrlUplinkListAns{})),
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.Device)
+
+				evs, err := HandleRelayCtrlUplinkListAns(ctx, dev, tc.Payload)
+				if tc.Error != nil && !a.So(err, should.EqualErrorOrDefinition, tc.Error) ||
+					tc.Error == nil && !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(dev, should.Resemble, tc.Expected)
+				a.So(evs, should.ResembleEventBuilders, tc.Events)
+			},
+		})
+	}
+}
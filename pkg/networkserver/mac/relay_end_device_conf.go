@@ -0,0 +1,163 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	EvtEnqueueRelayEndDeviceConfRequest = defineEnqueueMACRequestEvent(
+		"relay_end_device_conf", "relay end device configuration",
+		events.WithDataType(&ttnpb.MACCommand_RelayEndDeviceConfReq{}),
+	)()
+	EvtReceiveRelayEndDeviceConfAccept = defineReceiveMACAcceptEvent(
+		"relay_end_device_conf", "relay end device configuration",
+		events.WithDataType(&ttnpb.MACCommand_RelayEndDeviceConfAns{}),
+	)()
+	EvtReceiveRelayEndDeviceConfReject = defineReceiveMACRejectEvent(
+		"relay_end_device_conf", "relay end device configuration",
+		events.WithDataType(&ttnpb.MACCommand_RelayEndDeviceConfAns{}),
+	)()
+)
+
+// relayEndDeviceConfiguration returns the relay configuration of p, which is configured using EndDeviceConfReq.
+// relayEndDeviceConfiguration returns nil if p does not represent an end device served by a relay.
+func relayEndDeviceConfiguration(p *ttnpb.RelayParameters) *ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration {
+	served := p.GetServed()
+	if served == nil {
+		return nil
+	}
+	conf := &ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration{
+		Backoff:       served.Backoff,
+		SecondChannel: served.SecondChannel,
+	}
+	switch mode := served.Mode.(type) {
+	case *ttnpb.ServedRelayParameters_Always:
+		conf.Mode = &ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration_Always{
+			Always: mode.Always,
+		}
+	case *ttnpb.ServedRelayParameters_Dynamic:
+		conf.Mode = &ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic{
+			Dynamic: mode.Dynamic,
+		}
+	case *ttnpb.ServedRelayParameters_EndDeviceControlled:
+		conf.Mode = &ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled{
+			EndDeviceControlled: mode.EndDeviceControlled,
+		}
+	}
+	return conf
+}
+
+func DeviceNeedsRelayEndDeviceConfReq(dev *ttnpb.EndDevice) bool {
+	if dev.GetMulticast() || dev.GetMacState() == nil {
+		return false
+	}
+	return !relayEndDeviceConfiguration(dev.MacState.DesiredParameters.Relay).Equal(relayEndDeviceConfiguration(dev.MacState.CurrentParameters.Relay))
+}
+
+func EnqueueRelayEndDeviceConfReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16) EnqueueState {
+	if !DeviceNeedsRelayEndDeviceConfReq(dev) {
+		return EnqueueState{
+			MaxDownLen: maxDownLen,
+			MaxUpLen:   maxUpLen,
+			Ok:         true,
+		}
+	}
+
+	var st EnqueueState
+	dev.MacState.PendingRequests, st = enqueueMACCommand(ttnpb.CID_RELAY_END_DEVICE_CONF, maxDownLen, maxUpLen, func(nDown, nUp uint16) ([]*ttnpb.MACCommand, uint16, events.Builders, bool) {
+		if nDown < 1 || nUp < 1 {
+			return nil, 0, nil, false
+		}
+		req := &ttnpb.MACCommand_RelayEndDeviceConfReq{
+			Configuration: relayEndDeviceConfiguration(dev.MacState.DesiredParameters.Relay),
+		}
+		log.FromContext(ctx).WithFields(log.Fields(
+			"enabled", req.Configuration != nil,
+		)).Debug("Enqueued EndDeviceConfReq")
+		return []*ttnpb.MACCommand{
+				req.MACCommand(),
+			},
+			1,
+			events.Builders{
+				EvtEnqueueRelayEndDeviceConfRequest.With(events.WithData(req)),
+			},
+			true
+	}, dev.MacState.PendingRequests...)
+	return st
+}
+
+func HandleRelayEndDeviceConfAns(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCommand_RelayEndDeviceConfAns) (events.Builders, error) {
+	if pld == nil {
+		return nil, ErrNoPayload.New()
+	}
+
+	accepted := pld.SecondChannelFrequencyAck &&
+		pld.SecondChannelDataRateIndexAck &&
+		pld.SecondChannelIndexAck &&
+		pld.BackoffAck
+	var err error
+	dev.MacState.PendingRequests, err = handleMACResponse(ttnpb.CID_RELAY_END_DEVICE_CONF, func(cmd *ttnpb.MACCommand) error {
+		req := cmd.GetRelayEndDeviceConfReq()
+		if req.Configuration == nil {
+			// NOTE: The end device ignores the configuration fields when relay mode is disabled.
+			accepted = true
+			dev.MacState.CurrentParameters.Relay = nil
+			return nil
+		}
+		if !accepted {
+			return nil
+		}
+
+		served := &ttnpb.ServedRelayParameters{
+			Backoff:       req.Configuration.Backoff,
+			SecondChannel: req.Configuration.SecondChannel,
+			// NOTE: The end device is not aware of the relay serving it.
+			ServingDeviceId: dev.MacState.DesiredParameters.Relay.GetServed().GetServingDeviceId(),
+		}
+		switch mode := req.Configuration.Mode.(type) {
+		case *ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration_Always:
+			served.Mode = &ttnpb.ServedRelayParameters_Always{
+				Always: mode.Always,
+			}
+		case *ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic:
+			served.Mode = &ttnpb.ServedRelayParameters_Dynamic{
+				Dynamic: mode.Dynamic,
+			}
+		case *ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled:
+			served.Mode = &ttnpb.ServedRelayParameters_EndDeviceControlled{
+				EndDeviceControlled: mode.EndDeviceControlled,
+			}
+		}
+		dev.MacState.CurrentParameters.Relay = &ttnpb.RelayParameters{
+			Mode: &ttnpb.RelayParameters_Served{
+				Served: served,
+			},
+		}
+		return nil
+	}, dev.MacState.PendingRequests...)
+	ev := EvtReceiveRelayEndDeviceConfAccept
+	if !accepted {
+		ev = EvtReceiveRelayEndDeviceConfReject
+	}
+	return events.Builders{
+		ev.With(events.WithData(pld)),
+	}, err
+}
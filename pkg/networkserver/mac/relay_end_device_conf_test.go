@@ -0,0 +1,330 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestNeedsRelayEndDeviceConfReq(t *testing.T) {
+	for _, tc := range []struct {
+		Name        string
+		InputDevice *ttnpb.EndDevice
+		Needs       bool
+	}{
+		{
+			Name:        "no MAC state",
+			InputDevice: &ttnpb.EndDevice{},
+		},
+		{
+			Name: "current(nil),desired(serving)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Serving{
+								Serving: &ttnpb.ServingRelayParameters{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "current(nil),desired(served,always)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Served{
+								Served: &ttnpb.ServedRelayParameters{
+									Mode: &ttnpb.ServedRelayParameters_Always{
+										Always: &ttnpb.RelayEndDeviceAlwaysMode{},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Needs: true,
+		},
+		{
+			Name: "current(served,always,relay:a),desired(served,always,relay:b)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Served{
+								Served: &ttnpb.ServedRelayParameters{
+									Mode: &ttnpb.ServedRelayParameters_Always{
+										Always: &ttnpb.RelayEndDeviceAlwaysMode{},
+									},
+									ServingDeviceId: "relay-a",
+								},
+							},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Served{
+								Served: &ttnpb.ServedRelayParameters{
+									Mode: &ttnpb.ServedRelayParameters_Always{
+										Always: &ttnpb.RelayEndDeviceAlwaysMode{},
+									},
+									ServingDeviceId: "relay-b",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "current(served,always),desired(served,dynamic)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Served{
+								Served: &ttnpb.ServedRelayParameters{
+									Mode: &ttnpb.ServedRelayParameters_Always{
+										Always: &ttnpb.RelayEndDeviceAlwaysMode{},
+									},
+								},
+							},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Served{
+								Served: &ttnpb.ServedRelayParameters{
+									Mode: &ttnpb.ServedRelayParameters_Dynamic{
+										Dynamic: &ttnpb.RelayEndDeviceDynamicMode{
+											SmartEnableLevel: ttnpb.RELAY_SMART_ENABLE_LEVEL_32,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Needs: true,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+				res := DeviceNeedsRelayEndDeviceConfReq(dev)
+				if tc.Needs {
+					a.So(res, should.BeTrue)
+				} else {
+					a.So(res, should.BeFalse)
+				}
+				a.So(dev, should.Resemble, tc.InputDevice)
+			},
+		})
+	}
+}
+
+func TestHandleRelayEndDeviceConfAns(t *testing.T) {
+	configuration := &ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration{
+		Mode: &ttnpb.MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic{
+			Dynamic: &ttnpb.RelayEndDeviceDynamicMode{
+				SmartEnableLevel: ttnpb.RELAY_SMART_ENABLE_LEVEL_32,
+			},
+		},
+		Backoff: 12,
+	}
+	allAck := &ttnpb.MACCommand_RelayEndDeviceConfAns{
+		SecondChannelFrequencyAck:     true,
+		SecondChannelDataRateIndexAck: true,
+		SecondChannelIndexAck:         true,
+		BackoffAck:                    true,
+	}
+	for _, tc := range []struct {
+		Name             string
+		Device, Expected *ttnpb.EndDevice
+		Payload          *ttnpb.MACCommand_RelayEndDeviceConfAns
+		Events           events.Builders
+		Error            error
+	}{
+		{
+			Name: "nil payload",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Error: ErrNoPayload,
+		},
+		{
+			Name: "no request",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Payload: allAck,
+			Events: events.Builders{
+				EvtReceiveRelayEndDeviceConfAccept.With(events.WithData(allAck)),
+			},
+			Error: ErrRequestNotFound,
+		},
+		{
+			Name: "enable/all ack",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Served{
+								Served: &ttnpb.ServedRelayParameters{
+									ServingDeviceId: "test-relay",
+								},
+							},
+						},
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayEndDeviceConfReq{
+							Configuration: configuration,
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Served{
+								Served: &ttnpb.ServedRelayParameters{
+									Mode: &ttnpb.ServedRelayParameters_Dynamic{
+										Dynamic: &ttnpb.RelayEndDeviceDynamicMode{
+											SmartEnableLevel: ttnpb.RELAY_SMART_ENABLE_LEVEL_32,
+										},
+									},
+									Backoff:         12,
+									ServingDeviceId: "test-relay",
+								},
+							},
+						},
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Served{
+								Served: &ttnpb.ServedRelayParameters{
+									ServingDeviceId: "test-relay",
+								},
+							},
+						},
+					},
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: allAck,
+			Events: events.Builders{
+				EvtReceiveRelayEndDeviceConfAccept.With(events.WithData(allAck)),
+			},
+		},
+		{
+			Name: "enable/backoff nack",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayEndDeviceConfReq{
+							Configuration: configuration,
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: &ttnpb.MACCommand_RelayEndDeviceConfAns{
+				SecondChannelFrequencyAck:     true,
+				SecondChannelDataRateIndexAck: true,
+				SecondChannelIndexAck:         true,
+			},
+			Events: events.Builders{
+				EvtReceiveRelayEndDeviceConfReject.With(events.WithData(&ttnpb.MACCommand_RelayEndDeviceConfAns{
+					SecondChannelFrequencyAck:     true,
+					SecondChannelDataRateIndexAck: true,
+					SecondChannelIndexAck:         true,
+				})),
+			},
+		},
+		{
+			Name: "disable",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Served{
+								Served: &ttnpb.ServedRelayParameters{
+									ServingDeviceId: "test-relay",
+								},
+							},
+						},
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayEndDeviceConfReq{}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: &ttnpb.MACCommand_RelayEndDeviceConfAns{},
+			Events: events.Builders{
+				EvtReceiveRelayEndDeviceConfAccept.With(events.WithData(&ttnpb.MACCommand_RelayEndDeviceConfAns{})),
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.Device)
+
+				evs, err := HandleRelayEndDeviceConfAns(ctx, dev, tc.Payload)
+				if tc.Error != nil && !a.So(err, should.EqualErrorOrDefinition, tc.Error) ||
+					tc.Error == nil && !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(dev, should.Resemble, tc.Expected)
+				a.So(evs, should.ResembleEventBuilders, tc.Events)
+			},
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	EvtEnqueueRelayFilterListRequest = defineEnqueueMACRequestEvent(
+		"relay_filter_list", "relay join-request filter list",
+		events.WithDataType(&ttnpb.MACCommand_RelayFilterListReq{}),
+	)()
+	EvtReceiveRelayFilterListAccept = defineReceiveMACAcceptEvent(
+		"relay_filter_list", "relay join-request filter list",
+		events.WithDataType(&ttnpb.MACCommand_RelayFilterListAns{}),
+	)()
+	EvtReceiveRelayFilterListReject = defineReceiveMACRejectEvent(
+		"relay_filter_list", "relay join-request filter list",
+		events.WithDataType(&ttnpb.MACCommand_RelayFilterListAns{}),
+	)()
+)
+
+// relayMaxRules is the maximum amount of uplink forwarding rules and join-request filters of a relay.
+const relayMaxRules = 16
+
+// relayJoinRequestFilterAt returns the join-request filter at index i of p.
+// relayJoinRequestFilterAt returns an empty filter, which does not have a rule set, if the filter is not defined.
+func relayJoinRequestFilterAt(p *ttnpb.ServingRelayParameters, i int) *ttnpb.ServingRelayParameters_JoinRequestFilter {
+	if i >= len(p.GetJoinRequestFilters()) || p.JoinRequestFilters[i] == nil {
+		return &ttnpb.ServingRelayParameters_JoinRequestFilter{}
+	}
+	return p.JoinRequestFilters[i]
+}
+
+func DeviceNeedsRelayFilterListReqAtIndex(dev *ttnpb.EndDevice, i int) bool {
+	current, desired := dev.MacState.CurrentParameters.Relay.GetServing(), dev.MacState.DesiredParameters.Relay.GetServing()
+	if current == nil || desired == nil || i >= relayMaxRules {
+		return false
+	}
+	return !relayJoinRequestFilterAt(desired, i).Equal(relayJoinRequestFilterAt(current, i))
+}
+
+func DeviceNeedsRelayFilterListReq(dev *ttnpb.EndDevice) bool {
+	if dev.GetMulticast() || dev.GetMacState() == nil {
+		return false
+	}
+	current, desired := dev.MacState.CurrentParameters.Relay.GetServing(), dev.MacState.DesiredParameters.Relay.GetServing()
+	if current == nil || desired == nil {
+		return false
+	}
+	for i := 0; i < len(desired.JoinRequestFilters) || i < len(current.JoinRequestFilters); i++ {
+		if DeviceNeedsRelayFilterListReqAtIndex(dev, i) {
+			return true
+		}
+	}
+	return false
+}
+
+func EnqueueRelayFilterListReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16) EnqueueState {
+	if !DeviceNeedsRelayFilterListReq(dev) {
+		return EnqueueState{
+			MaxDownLen: maxDownLen,
+			MaxUpLen:   maxUpLen,
+			Ok:         true,
+		}
+	}
+
+	var st EnqueueState
+	dev.MacState.PendingRequests, st = enqueueMACCommand(ttnpb.CID_RELAY_FILTER_LIST, maxDownLen, maxUpLen, func(nDown, nUp uint16) ([]*ttnpb.MACCommand, uint16, events.Builders, bool) {
+		current, desired := dev.MacState.CurrentParameters.Relay.GetServing(), dev.MacState.DesiredParameters.Relay.GetServing()
+
+		var cmds []*ttnpb.MACCommand
+		var evs events.Builders
+		for i := 0; i < len(desired.JoinRequestFilters) || i < len(current.JoinRequestFilters); i++ {
+			if !DeviceNeedsRelayFilterListReqAtIndex(dev, i) {
+				continue
+			}
+			if nDown < 1 || nUp < 1 {
+				return cmds, uint16(len(cmds)), evs, false
+			}
+			nDown--
+			nUp--
+
+			filter := relayJoinRequestFilterAt(desired, i)
+			req := &ttnpb.MACCommand_RelayFilterListReq{
+				RuleIndex: uint32(i),
+				Action:    filter.Action,
+				JoinEui:   filter.JoinEui,
+				DevEui:    filter.DevEui,
+			}
+			cmds = append(cmds, req.MACCommand())
+			evs = append(evs, EvtEnqueueRelayFilterListRequest.With(events.WithData(req)))
+			log.FromContext(ctx).WithFields(log.Fields(
+				"rule_index", req.RuleIndex,
+				"action", req.Action,
+				"join_eui", req.JoinEui,
+				"dev_eui", req.DevEui,
+			)).Debug("Enqueued FilterListReq")
+		}
+		return cmds, uint16(len(cmds)), evs, true
+	}, dev.MacState.PendingRequests...)
+	return st
+}
+
+func HandleRelayFilterListAns(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCommand_RelayFilterListAns) (events.Builders, error) {
+	if pld == nil {
+		return nil, ErrNoPayload.New()
+	}
+
+	var err error
+	dev.MacState.PendingRequests, err = handleMACResponse(ttnpb.CID_RELAY_FILTER_LIST, func(cmd *ttnpb.MACCommand) error {
+		if !pld.RuleIndexAck || !pld.ActionAck || !pld.CombinedRulesAck {
+			return nil
+		}
+		req := cmd.GetRelayFilterListReq()
+
+		serving := dev.MacState.CurrentParameters.Relay.GetServing()
+		if serving == nil {
+			return ErrCorruptedMACState.
+				WithAttributes(
+					"rule_index", req.RuleIndex,
+				).
+				WithCause(ErrRelay)
+		}
+		for uint32(len(serving.JoinRequestFilters)) <= req.RuleIndex {
+			serving.JoinRequestFilters = append(serving.JoinRequestFilters, &ttnpb.ServingRelayParameters_JoinRequestFilter{})
+		}
+		serving.JoinRequestFilters[req.RuleIndex] = &ttnpb.ServingRelayParameters_JoinRequestFilter{
+			Action:  req.Action,
+			JoinEui: req.JoinEui,
+			DevEui:  req.DevEui,
+		}
+		return nil
+	}, dev.MacState.PendingRequests...)
+	ev := EvtReceiveRelayFilterListAccept
+	if !pld.RuleIndexAck || !pld.ActionAck || !pld.CombinedRulesAck {
+		ev = EvtReceiveRelayFilterListReject
+	}
+	return events.Builders{
+		ev.With(events.WithData(pld)),
+	}, err
+}
@@ -0,0 +1,425 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func makeServingRelayParameters(serving *ttnpb.ServingRelayParameters) *ttnpb.RelayParameters {
+	return &ttnpb.RelayParameters{
+		Mode: &ttnpb.RelayParameters_Serving{
+			Serving: serving,
+		},
+	}
+}
+
+func TestNeedsRelayFilterListReq(t *testing.T) {
+	for _, tc := range []struct {
+		Name        string
+		InputDevice *ttnpb.EndDevice
+		Needs       bool
+	}{
+		{
+			Name:        "no MAC state",
+			InputDevice: &ttnpb.EndDevice{},
+		},
+		{
+			Name: "current(nil),desired(filters:1)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							JoinRequestFilters: []*ttnpb.ServingRelayParameters_JoinRequestFilter{
+								{Action: ttnpb.RELAY_JOIN_REQUEST_FILTER_ACTION_FORWARD},
+							},
+						}),
+					},
+				},
+			},
+		},
+		{
+			Name: "current(filters:1),desired(filters:1)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							JoinRequestFilters: []*ttnpb.ServingRelayParameters_JoinRequestFilter{
+								{Action: ttnpb.RELAY_JOIN_REQUEST_FILTER_ACTION_FORWARD},
+							},
+						}),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							JoinRequestFilters: []*ttnpb.ServingRelayParameters_JoinRequestFilter{
+								{Action: ttnpb.RELAY_JOIN_REQUEST_FILTER_ACTION_FORWARD},
+							},
+						}),
+					},
+				},
+			},
+		},
+		{
+			Name: "current(filters:0),desired(filters:1)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{}),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							JoinRequestFilters: []*ttnpb.ServingRelayParameters_JoinRequestFilter{
+								{Action: ttnpb.RELAY_JOIN_REQUEST_FILTER_ACTION_FORWARD},
+							},
+						}),
+					},
+				},
+			},
+			Needs: true,
+		},
+		{
+			Name: "current(filters:1),desired(filters:0)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							JoinRequestFilters: []*ttnpb.ServingRelayParameters_JoinRequestFilter{
+								{Action: ttnpb.RELAY_JOIN_REQUEST_FILTER_ACTION_FILTER},
+							},
+						}),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{}),
+					},
+				},
+			},
+			Needs: true,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+				res := DeviceNeedsRelayFilterListReq(dev)
+				if tc.Needs {
+					a.So(res, should.BeTrue)
+				} else {
+					a.So(res, should.BeFalse)
+				}
+				a.So(dev, should.Resemble, tc.InputDevice)
+			},
+		})
+	}
+}
+
+func TestEnqueueRelayFilterListReq(t *testing.T) {
+	joinEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	current := makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+		JoinRequestFilters: []*ttnpb.ServingRelayParameters_JoinRequestFilter{
+			{Action: ttnpb.RELAY_JOIN_REQUEST_FILTER_ACTION_FORWARD},
+			{Action: ttnpb.RELAY_JOIN_REQUEST_FILTER_ACTION_FILTER},
+		},
+	})
+	desired := makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+		JoinRequestFilters: []*ttnpb.ServingRelayParameters_JoinRequestFilter{
+			{Action: ttnpb.RELAY_JOIN_REQUEST_FILTER_ACTION_FORWARD},
+			nil,
+			{Action: ttnpb.RELAY_JOIN_REQUEST_FILTER_ACTION_FORWARD, JoinEui: joinEUI},
+		},
+	})
+	for _, tc := range []struct {
+		Name                        string
+		InputDevice, ExpectedDevice *ttnpb.EndDevice
+		MaxDownlinkLength           uint16
+		MaxUplinkLength             uint16
+		State                       EnqueueState
+	}{
+		{
+			Name: "payload fits/2 filters",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: current,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: desired,
+					},
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: current,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: desired,
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayFilterListReq{
+							RuleIndex: 1,
+						}).MACCommand(),
+						(&ttnpb.MACCommand_RelayFilterListReq{
+							RuleIndex: 2,
+							Action:    ttnpb.RELAY_JOIN_REQUEST_FILTER_ACTION_FORWARD,
+							JoinEui:   joinEUI,
+						}).MACCommand(),
+					},
+				},
+			},
+			MaxDownlinkLength: 42,
+			MaxUplinkLength:   24,
+			State: EnqueueState{
+				MaxDownLen: 6,
+				MaxUpLen:   20,
+				Ok:         true,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayFilterListRequest.With(events.WithData(&ttnpb.MACCommand_RelayFilterListReq{
+						RuleIndex: 1,
+					})),
+					EvtEnqueueRelayFilterListRequest.With(events.WithData(&ttnpb.MACCommand_RelayFilterListReq{
+						RuleIndex: 2,
+						Action:    ttnpb.RELAY_JOIN_REQUEST_FILTER_ACTION_FORWARD,
+						JoinEui:   joinEUI,
+					})),
+				},
+			},
+		},
+		{
+			Name: "payload fits/1 of 2 filters",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: current,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: desired,
+					},
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: current,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: desired,
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayFilterListReq{
+							RuleIndex: 1,
+						}).MACCommand(),
+					},
+				},
+			},
+			MaxDownlinkLength: 30,
+			MaxUplinkLength:   24,
+			State: EnqueueState{
+				MaxDownLen: 12,
+				MaxUpLen:   22,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayFilterListRequest.With(events.WithData(&ttnpb.MACCommand_RelayFilterListReq{
+						RuleIndex: 1,
+					})),
+				},
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+
+				st := EnqueueRelayFilterListReq(ctx, dev, tc.MaxDownlinkLength, tc.MaxUplinkLength)
+				a.So(dev, should.Resemble, tc.ExpectedDevice)
+				a.So(st.QueuedEvents, should.ResembleEventBuilders, tc.State.QueuedEvents)
+				st.QueuedEvents = tc.State.QueuedEvents
+				a.So(st, should.Resemble, tc.State)
+			},
+		})
+	}
+}
+
+func TestHandleRelayFilterListAns(t *testing.T) {
+	joinEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	allAck := &ttnpb.MACCommand_RelayFilterListAns{
+		ActionAck:        true,
+		RuleIndexAck:     true,
+		CombinedRulesAck: true,
+	}
+	for _, tc := range []struct {
+		Name             string
+		Device, Expected *ttnpb.EndDevice
+		Payload          *ttnpb.MACCommand_RelayFilterListAns
+		Events           events.Builders
+		Error            error
+	}{
+		{
+			Name: "nil payload",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Error: ErrNoPayload,
+		},
+		{
+			Name: "no request",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Payload: allAck,
+			Events: events.Builders{
+				EvtReceiveRelayFilterListAccept.With(events.WithData(allAck)),
+			},
+			Error: ErrRequestNotFound,
+		},
+		{
+			Name: "index 1/all ack",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{}),
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayFilterListReq{
+							RuleIndex: 1,
+							Action:    ttnpb.RELAY_JOIN_REQUEST_FILTER_ACTION_FILTER,
+							JoinEui:   joinEUI,
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							JoinRequestFilters: []*ttnpb.ServingRelayParameters_JoinRequestFilter{
+								{},
+								{
+									Action:  ttnpb.RELAY_JOIN_REQUEST_FILTER_ACTION_FILTER,
+									JoinEui: joinEUI,
+								},
+							},
+						}),
+					},
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: allAck,
+			Events: events.Builders{
+				EvtReceiveRelayFilterListAccept.With(events.WithData(allAck)),
+			},
+		},
+		{
+			Name: "index 1/combined rules nack",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{}),
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayFilterListReq{
+							RuleIndex: 1,
+							Action:    ttnpb.RELAY_JOIN_REQUEST_FILTER_ACTION_FILTER,
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{}),
+					},
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: &ttnpb.MACCommand_RelayFilterListAns{
+				ActionAck:    true,
+				RuleIndexAck: true,
+			},
+			Events: events.Builders{
+				EvtReceiveRelayFilterListReject.With(events.WithData(&ttnpb.MACCommand_RelayFilterListAns{
+					ActionAck:    true,
+					RuleIndexAck: true,
+				})),
+			},
+		},
+		{
+			Name: "not a relay",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayFilterListReq{
+							RuleIndex: 1,
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayFilterListReq{
+							RuleIndex: 1,
+						}).MACCommand(),
+					},
+				},
+			},
+			Payload: allAck,
+			Events: events.Builders{
+				EvtReceiveRelayFilterListAccept.With(events.WithData(allAck)),
+			},
+			Error: ErrCorruptedMACState.
+				WithAttributes(
+					"rule_index", uint32(1),
+				).
+				WithCause(ErrRelay),
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.Device)
+
+				evs, err := HandleRelayFilterListAns(ctx, dev, tc.Payload)
+				if tc.Error != nil && !a.So(err, should.EqualErrorOrDefinition, tc.Error) ||
+					tc.Error == nil && !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(dev, should.Resemble, tc.Expected)
+				a.So(evs, should.ResembleEventBuilders, tc.Events)
+			},
+		})
+	}
+}
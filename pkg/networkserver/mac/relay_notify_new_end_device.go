@@ -0,0 +1,44 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var EvtReceiveRelayNotifyNewEndDeviceRequest = defineReceiveMACRequestEvent(
+	"relay_notify_new_end_device", "relay new end device notification",
+	events.WithDataType(&ttnpb.MACCommand_RelayNotifyNewEndDeviceReq{}),
+)()
+
+func HandleRelayNotifyNewEndDeviceReq(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCommand_RelayNotifyNewEndDeviceReq) (events.Builders, error) {
+	if pld == nil {
+		return nil, ErrNoPayload.New()
+	}
+
+	// NOTE: The end device is served by the relay once an uplink forwarding rule is configured for it.
+	log.FromContext(ctx).WithFields(log.Fields(
+		"served_dev_addr", pld.DevAddr,
+		"snr", pld.Snr,
+		"rssi", pld.Rssi,
+	)).Debug("Relay received uplink of end device without uplink forwarding rule")
+	return events.Builders{
+		EvtReceiveRelayNotifyNewEndDeviceRequest.With(events.WithData(pld)),
+	}, nil
+}
@@ -0,0 +1,174 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	EvtEnqueueRelayUpdateUplinkListRequest = defineEnqueueMACRequestEvent(
+		"relay_update_uplink_list", "relay update uplink list",
+		events.WithDataType(&ttnpb.MACCommand_RelayUpdateUplinkListReq{}),
+	)()
+	EvtReceiveRelayUpdateUplinkListAnswer = defineReceiveMACAnswerEvent(
+		"relay_update_uplink_list", "relay update uplink list",
+	)()
+)
+
+// RelayServedDeviceSessionFunc returns the DevAddr and the root wake-on-radio session key of the end device
+// identified by ids, which is served by a relay.
+type RelayServedDeviceSessionFunc func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (types.DevAddr, types.AES128Key, error)
+
+// relayUplinkForwardingRuleAt returns the device ID and the forward limits of the uplink forwarding rule at index i of p.
+// relayUplinkForwardingRuleAt returns a rule without device ID, which is not used, if the rule is not defined.
+func relayUplinkForwardingRuleAt(p *ttnpb.ServingRelayParameters, i int) *ttnpb.ServingRelayParameters_UplinkForwardingRule {
+	if i >= len(p.GetUplinkForwardingRules()) || p.UplinkForwardingRules[i] == nil {
+		return &ttnpb.ServingRelayParameters_UplinkForwardingRule{}
+	}
+	rule := p.UplinkForwardingRules[i]
+	limits := rule.Limits
+	if limits == nil {
+		limits = &ttnpb.RelayForwardLimits{}
+	}
+	return &ttnpb.ServingRelayParameters_UplinkForwardingRule{
+		DeviceId: rule.DeviceId,
+		Limits:   limits,
+	}
+}
+
+func DeviceNeedsRelayUpdateUplinkListReqAtIndex(dev *ttnpb.EndDevice, i int) bool {
+	current, desired := dev.MacState.CurrentParameters.Relay.GetServing(), dev.MacState.DesiredParameters.Relay.GetServing()
+	if current == nil || desired == nil || i >= relayMaxRules {
+		return false
+	}
+	desiredRule := relayUplinkForwardingRuleAt(desired, i)
+	// NOTE: Rules are removed from the relay using CtrlUplinkListReq.
+	return desiredRule.DeviceId != "" && !desiredRule.Equal(relayUplinkForwardingRuleAt(current, i))
+}
+
+func DeviceNeedsRelayUpdateUplinkListReq(dev *ttnpb.EndDevice) bool {
+	if dev.GetMulticast() || dev.GetMacState() == nil {
+		return false
+	}
+	for i := range dev.MacState.DesiredParameters.Relay.GetServing().GetUplinkForwardingRules() {
+		if DeviceNeedsRelayUpdateUplinkListReqAtIndex(dev, i) {
+			return true
+		}
+	}
+	return false
+}
+
+func EnqueueRelayUpdateUplinkListReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16, servedDeviceSession RelayServedDeviceSessionFunc) EnqueueState {
+	if !DeviceNeedsRelayUpdateUplinkListReq(dev) {
+		return EnqueueState{
+			MaxDownLen: maxDownLen,
+			MaxUpLen:   maxUpLen,
+			Ok:         true,
+		}
+	}
+
+	var st EnqueueState
+	dev.MacState.PendingRequests, st = enqueueMACCommand(ttnpb.CID_RELAY_UPDATE_UPLINK_LIST, maxDownLen, maxUpLen, func(nDown, nUp uint16) ([]*ttnpb.MACCommand, uint16, events.Builders, bool) {
+		current, desired := dev.MacState.CurrentParameters.Relay.GetServing(), dev.MacState.DesiredParameters.Relay.GetServing()
+
+		var cmds []*ttnpb.MACCommand
+		var evs events.Builders
+		for i := range desired.UplinkForwardingRules {
+			if !DeviceNeedsRelayUpdateUplinkListReqAtIndex(dev, i) {
+				continue
+			}
+			if nDown < 1 || nUp < 1 {
+				return cmds, uint16(len(cmds)), evs, false
+			}
+			rule := relayUplinkForwardingRuleAt(desired, i)
+			logger := log.FromContext(ctx).WithFields(log.Fields(
+				"rule_index", i,
+				"served_device_id", rule.DeviceId,
+			))
+			devAddr, rootWorSKey, err := servedDeviceSession(ctx, ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: dev.ApplicationIdentifiers,
+				DeviceId:               rule.DeviceId,
+			})
+			if err != nil {
+				logger.WithError(err).Warn("Failed to get session of served end device, skip UpdateUplinkListReq")
+				continue
+			}
+			nDown--
+			nUp--
+
+			var wFCnt uint32
+			if currentRule := relayUplinkForwardingRuleAt(current, i); currentRule.DeviceId == rule.DeviceId {
+				wFCnt = current.UplinkForwardingRules[i].LastWFCnt
+			}
+			req := &ttnpb.MACCommand_RelayUpdateUplinkListReq{
+				RuleIndex:     uint32(i),
+				ForwardLimits: rule.Limits,
+				DevAddr:       devAddr,
+				WFCnt:         wFCnt,
+				RootWorSKey:   rootWorSKey,
+			}
+			cmds = append(cmds, req.MACCommand())
+			// NOTE: The root wake-on-radio session key is not included in the event.
+			evs = append(evs, EvtEnqueueRelayUpdateUplinkListRequest.With(events.WithData(&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+				RuleIndex:     req.RuleIndex,
+				ForwardLimits: req.ForwardLimits,
+				DevAddr:       req.DevAddr,
+				WFCnt:         req.WFCnt,
+			})))
+			logger.WithFields(log.Fields(
+				"dev_addr", req.DevAddr,
+				"w_f_cnt", req.WFCnt,
+			)).Debug("Enqueued UpdateUplinkListReq")
+		}
+		return cmds, uint16(len(cmds)), evs, true
+	}, dev.MacState.PendingRequests...)
+	return st
+}
+
+func HandleRelayUpdateUplinkListAns(ctx context.Context, dev *ttnpb.EndDevice) (events.Builders, error) {
+	var err error
+	dev.MacState.PendingRequests, err = handleMACResponse(ttnpb.CID_RELAY_UPDATE_UPLINK_LIST, func(cmd *ttnpb.MACCommand) error {
+		req := cmd.GetRelayUpdateUplinkListReq()
+
+		current, desired := dev.MacState.CurrentParameters.Relay.GetServing(), dev.MacState.DesiredParameters.Relay.GetServing()
+		if current == nil {
+			return ErrCorruptedMACState.
+				WithAttributes(
+					"rule_index", req.RuleIndex,
+				).
+				WithCause(ErrRelay)
+		}
+		for uint32(len(current.UplinkForwardingRules)) <= req.RuleIndex {
+			current.UplinkForwardingRules = append(current.UplinkForwardingRules, &ttnpb.ServingRelayParameters_UplinkForwardingRule{})
+		}
+		current.UplinkForwardingRules[req.RuleIndex] = &ttnpb.ServingRelayParameters_UplinkForwardingRule{
+			// NOTE: UpdateUplinkListReq does not contain the device ID, which is only known to the Network Server.
+			DeviceId:  relayUplinkForwardingRuleAt(desired, int(req.RuleIndex)).DeviceId,
+			Limits:    req.ForwardLimits,
+			LastWFCnt: req.WFCnt,
+		}
+		return nil
+	}, dev.MacState.PendingRequests...)
+	return events.Builders{
+		EvtReceiveRelayUpdateUplinkListAnswer,
+	}, err
+}
@@ -0,0 +1,390 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestNeedsRelayUpdateUplinkListReq(t *testing.T) {
+	for _, tc := range []struct {
+		Name        string
+		InputDevice *ttnpb.EndDevice
+		Needs       bool
+	}{
+		{
+			Name:        "no MAC state",
+			InputDevice: &ttnpb.EndDevice{},
+		},
+		{
+			Name: "current(rules:0),desired(rules:0)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{}),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{}),
+					},
+				},
+			},
+		},
+		{
+			Name: "current(rules:0),desired(rules:1)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{}),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+								{DeviceId: "test-device"},
+							},
+						}),
+					},
+				},
+			},
+			Needs: true,
+		},
+		{
+			Name: "current(rules:1,w_f_cnt:42),desired(rules:1)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+								{
+									DeviceId:  "test-device",
+									Limits:    &ttnpb.RelayForwardLimits{},
+									LastWFCnt: 42,
+								},
+							},
+						}),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+								{DeviceId: "test-device"},
+							},
+						}),
+					},
+				},
+			},
+		},
+		{
+			Name: "current(rules:1),desired(rules:1,limits)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+								{DeviceId: "test-device"},
+							},
+						}),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+								{
+									DeviceId: "test-device",
+									Limits: &ttnpb.RelayForwardLimits{
+										BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_4,
+										ReloadRate: 12,
+									},
+								},
+							},
+						}),
+					},
+				},
+			},
+			Needs: true,
+		},
+		{
+			Name: "current(rules:1),desired(rules:0)",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+								{DeviceId: "test-device"},
+							},
+						}),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{}),
+					},
+				},
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+				res := DeviceNeedsRelayUpdateUplinkListReq(dev)
+				if tc.Needs {
+					a.So(res, should.BeTrue)
+				} else {
+					a.So(res, should.BeFalse)
+				}
+				a.So(dev, should.Resemble, tc.InputDevice)
+			},
+		})
+	}
+}
+
+func TestEnqueueRelayUpdateUplinkListReq(t *testing.T) {
+	devAddr := types.DevAddr{0x42, 0x42, 0xff, 0xff}
+	rootWorSKey := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	limits := &ttnpb.RelayForwardLimits{
+		BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_4,
+		ReloadRate: 12,
+	}
+	current := makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+		UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+			{
+				DeviceId:  "test-device",
+				LastWFCnt: 42,
+			},
+		},
+	})
+	desired := makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+		UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+			{
+				DeviceId: "test-device",
+				Limits:   limits,
+			},
+			{
+				DeviceId: "unknown-device",
+			},
+		},
+	})
+	errNotFound := errors.DefineNotFound("test_not_found", "not found")
+	servedDeviceSession := func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (types.DevAddr, types.AES128Key, error) {
+		if ids.DeviceId != "test-device" {
+			return types.DevAddr{}, types.AES128Key{}, errNotFound.New()
+		}
+		return devAddr, rootWorSKey, nil
+	}
+	for _, tc := range []struct {
+		Name                        string
+		InputDevice, ExpectedDevice *ttnpb.EndDevice
+		MaxDownlinkLength           uint16
+		MaxUplinkLength             uint16
+		State                       EnqueueState
+	}{
+		{
+			Name: "payload fits/unknown device skipped",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: current,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: desired,
+					},
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: current,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: desired,
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+							ForwardLimits: limits,
+							DevAddr:       devAddr,
+							WFCnt:         42,
+							RootWorSKey:   rootWorSKey,
+						}).MACCommand(),
+					},
+				},
+			},
+			MaxDownlinkLength: 60,
+			MaxUplinkLength:   24,
+			State: EnqueueState{
+				MaxDownLen: 33,
+				MaxUpLen:   23,
+				Ok:         true,
+				QueuedEvents: events.Builders{
+					EvtEnqueueRelayUpdateUplinkListRequest.With(events.WithData(&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+						ForwardLimits: limits,
+						DevAddr:       devAddr,
+						WFCnt:         42,
+					})),
+				},
+			},
+		},
+		{
+			Name: "downlink does not fit",
+			InputDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: current,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: desired,
+					},
+				},
+			},
+			ExpectedDevice: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: current,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: desired,
+					},
+				},
+			},
+			MaxDownlinkLength: 26,
+			MaxUplinkLength:   24,
+			State: EnqueueState{
+				MaxDownLen: 26,
+				MaxUpLen:   24,
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.InputDevice)
+
+				st := EnqueueRelayUpdateUplinkListReq(ctx, dev, tc.MaxDownlinkLength, tc.MaxUplinkLength, servedDeviceSession)
+				a.So(dev, should.Resemble, tc.ExpectedDevice)
+				a.So(st.QueuedEvents, should.ResembleEventBuilders, tc.State.QueuedEvents)
+				st.QueuedEvents = tc.State.QueuedEvents
+				a.So(st, should.Resemble, tc.State)
+			},
+		})
+	}
+}
+
+func TestHandleRelayUpdateUplinkListAns(t *testing.T) {
+	devAddr := types.DevAddr{0x42, 0x42, 0xff, 0xff}
+	limits := &ttnpb.RelayForwardLimits{
+		BucketSize: ttnpb.RELAY_LIMIT_BUCKET_SIZE_4,
+		ReloadRate: 12,
+	}
+	desired := makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+		UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+			nil,
+			{
+				DeviceId: "test-device",
+				Limits:   limits,
+			},
+		},
+	})
+	for _, tc := range []struct {
+		Name             string
+		Device, Expected *ttnpb.EndDevice
+		Events           events.Builders
+		Error            error
+	}{
+		{
+			Name: "no request",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{},
+			},
+			Events: events.Builders{
+				EvtReceiveRelayUpdateUplinkListAnswer,
+			},
+			Error: ErrRequestNotFound,
+		},
+		{
+			Name: "index 1",
+			Device: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{}),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: desired,
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_RelayUpdateUplinkListReq{
+							RuleIndex:     1,
+							ForwardLimits: limits,
+							DevAddr:       devAddr,
+							WFCnt:         42,
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServingRelayParameters(&ttnpb.ServingRelayParameters{
+							UplinkForwardingRules: []*ttnpb.ServingRelayParameters_UplinkForwardingRule{
+								{},
+								{
+									DeviceId:  "test-device",
+									Limits:    limits,
+									LastWFCnt: 42,
+								},
+							},
+						}),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Relay: desired,
+					},
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Events: events.Builders{
+				EvtReceiveRelayUpdateUplinkListAnswer,
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := CopyEndDevice(tc.Device)
+
+				evs, err := HandleRelayUpdateUplinkListAns(ctx, dev)
+				if tc.Error != nil && !a.So(err, should.EqualErrorOrDefinition, tc.Error) ||
+					tc.Error == nil && !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(dev, should.Resemble, tc.Expected)
+				a.So(evs, should.ResembleEventBuilders, tc.Events)
+			},
+		})
+	}
+}
@@ -583,6 +583,17 @@ func DeviceClassBCDownlinkInterval(dev *ttnpb.EndDevice, defaults ttnpb.MACSetti
 	return defaultClassBCDownlinkInterval
 }
 
+func DeviceDefaultRelayParameters(dev *ttnpb.EndDevice) *ttnpb.RelayParameters {
+	return CopyRelayParameters(dev.GetMacSettings().GetRelay())
+}
+
+func DeviceDesiredRelayParameters(dev *ttnpb.EndDevice) *ttnpb.RelayParameters {
+	if p := dev.GetMacSettings().GetDesiredRelay(); p != nil {
+		return CopyRelayParameters(p)
+	}
+	return DeviceDefaultRelayParameters(dev)
+}
+
 func NewState(dev *ttnpb.EndDevice, fps *frequencyplans.Store, defaults ttnpb.MACSettings) (*ttnpb.MACState, error) {
 	fp, phy, err := DeviceFrequencyPlanAndBand(dev, fps)
 	if err != nil {
@@ -610,6 +621,7 @@ func NewState(dev *ttnpb.EndDevice, fps *frequencyplans.Store, defaults ttnpb.MA
 		AdrAckLimitExponent:        &ttnpb.ADRAckLimitExponentValue{Value: phy.ADRAckLimit},
 		AdrAckDelayExponent:        &ttnpb.ADRAckDelayExponentValue{Value: phy.ADRAckDelay},
 		PingSlotDataRateIndexValue: DeviceDefaultPingSlotDataRateIndexValue(dev, phy, defaults),
+		Relay:                      DeviceDefaultRelayParameters(dev),
 	}
 	desired := current
 	if !dev.Multicast {
@@ -632,6 +644,7 @@ func NewState(dev *ttnpb.EndDevice, fps *frequencyplans.Store, defaults ttnpb.MA
 			AdrAckLimitExponent:        DeviceDesiredADRAckLimitExponent(dev, phy, defaults),
 			AdrAckDelayExponent:        DeviceDesiredADRAckDelayExponent(dev, phy, defaults),
 			PingSlotDataRateIndexValue: DeviceDesiredPingSlotDataRateIndexValue(dev, phy, fp, defaults),
+			Relay:                      DeviceDesiredRelayParameters(dev),
 		}
 	}
 	// TODO: Support rejoins. (https://github.com/TheThingsNetwork/lorawan-stack/issues/8)
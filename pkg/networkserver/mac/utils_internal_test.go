@@ -77,6 +77,63 @@ func TestNewState(t *testing.T) {
 			}(),
 			FrequencyPlanStore: frequencyplans.NewStore(test.FrequencyPlansFetcher),
 		},
+		{
+			Name: "1.0.4/EU868/relay",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanId:   test.EUFrequencyPlanID,
+				LorawanVersion:    ttnpb.MAC_V1_0_4,
+				LorawanPhyVersion: ttnpb.RP001_V1_1_REV_B,
+				MacSettings: &ttnpb.MACSettings{
+					Relay: &ttnpb.RelayParameters{
+						Mode: &ttnpb.RelayParameters_Served{
+							Served: &ttnpb.ServedRelayParameters{
+								Mode: &ttnpb.ServedRelayParameters_Always{
+									Always: &ttnpb.RelayEndDeviceAlwaysMode{},
+								},
+								ServingDeviceId: "test-relay",
+							},
+						},
+					},
+					DesiredRelay: &ttnpb.RelayParameters{
+						Mode: &ttnpb.RelayParameters_Served{
+							Served: &ttnpb.ServedRelayParameters{
+								Mode: &ttnpb.ServedRelayParameters_EndDeviceControlled{
+									EndDeviceControlled: &ttnpb.RelayEndDeviceControlledMode{},
+								},
+								Backoff:         12,
+								ServingDeviceId: "test-relay",
+							},
+						},
+					},
+				},
+			},
+			MACState: func() *ttnpb.MACState {
+				macState := MakeDefaultEU868MACState(ttnpb.CLASS_A, ttnpb.MAC_V1_0_4, ttnpb.RP001_V1_1_REV_B)
+				macState.CurrentParameters.Relay = &ttnpb.RelayParameters{
+					Mode: &ttnpb.RelayParameters_Served{
+						Served: &ttnpb.ServedRelayParameters{
+							Mode: &ttnpb.ServedRelayParameters_Always{
+								Always: &ttnpb.RelayEndDeviceAlwaysMode{},
+							},
+							ServingDeviceId: "test-relay",
+						},
+					},
+				}
+				macState.DesiredParameters.Relay = &ttnpb.RelayParameters{
+					Mode: &ttnpb.RelayParameters_Served{
+						Served: &ttnpb.ServedRelayParameters{
+							Mode: &ttnpb.ServedRelayParameters_EndDeviceControlled{
+								EndDeviceControlled: &ttnpb.RelayEndDeviceControlledMode{},
+							},
+							Backoff:         12,
+							ServingDeviceId: "test-relay",
+						},
+					},
+				}
+				return macState
+			}(),
+			FrequencyPlanStore: frequencyplans.NewStore(test.FrequencyPlansFetcher),
+		},
 		{
 			Name: "1.0.2/EU868/multicast/class A",
 			Device: &ttnpb.EndDevice{
@@ -23,6 +23,7 @@ import (
 	"sync"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/cleanup"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -55,6 +56,11 @@ const (
 	// When scheduling downlink via peering, the schedule delay is unknown, and should be sufficiently high to avoid conflicts.
 	peeringScheduleDelay = infrastructureDelay + 4*time.Second
 
+	// relayScheduleDelay is the schedule delay used for scheduling downlink via a relay.
+	// The downlink is transmitted to the relay in a subsequent downlink slot of the relay, after which the relay
+	// forwards it to the end device. The transmission time is therefore unknown.
+	relayScheduleDelay = infrastructureDelay + 2*time.Second
+
 	// maxUplinkSubmissionConcurrency represents the maximum number of concurrent tasks that submit uplinks to the Application Server
 	// on the fast path. The fast path is achieved by skipping the enqueuing process and submitting the uplinks directly.
 	// When the concurrency limit is reached, uplinks are enqueued instead.
@@ -215,10 +221,13 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 			interopConf.HTTPClient = httpClient
 		}
 
-		interopCl, err = interop.NewClient(ctx, interopConf)
+		cl, err := interop.NewClient(ctx, interopConf)
 		if err != nil {
 			return nil, err
 		}
+		// The interop server delivers answers from servers that answer asynchronously.
+		c.RegisterInterop(cl)
+		interopCl = cl
 	}
 
 	ns := &NetworkServer{
@@ -285,7 +294,28 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 			Backoff: processTaskBackoff,
 		})
 	}
+	if conf.CleanupPurgedApplications {
+		ns.RegisterTask(&component.TaskConfig{
+			Context: ctx,
+			ID:      "cleanup_purged_applications",
+			Func:    cleanup.NewPurgedApplicationsTask(&RegistryCleaner{DevRegistry: conf.Devices}),
+			Restart: component.TaskRestartOnFailure,
+			Backoff: component.DefaultTaskBackoffConfig,
+		})
+		if conf.CleanupReconcileInterval > 0 {
+			ns.RegisterTask(&component.TaskConfig{
+				Context: ctx,
+				ID:      "cleanup_reconcile",
+				Func: cleanup.NewReconcileTask(conf.CleanupReconcileInterval, cleanup.ClusterIdentityServerSets(ns),
+					cleanup.DeviceSetReconciler(&RegistryCleaner{DevRegistry: conf.Devices}),
+				),
+				Restart: component.TaskRestartOnFailure,
+				Backoff: component.DefaultTaskBackoffConfig,
+			})
+		}
+	}
 	c.RegisterGRPC(ns)
+	c.RegisterInterop(ns)
 	return ns, nil
 }
 
@@ -299,12 +329,19 @@ func (ns *NetworkServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterGsNsServer(s, ns)
 	ttnpb.RegisterAsNsServer(s, ns)
 	ttnpb.RegisterNsEndDeviceRegistryServer(s, ns)
+	ttnpb.RegisterNsEndDeviceMigrationServer(s, ns)
 	ttnpb.RegisterNsServer(s, ns)
 }
 
+// RegisterInterop registers the NS-NS interop services.
+func (ns *NetworkServer) RegisterInterop(srv *interop.Server) {
+	srv.RegisterNS(interopServer{NS: ns})
+}
+
 // RegisterHandlers registers gRPC handlers.
 func (ns *NetworkServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
 	ttnpb.RegisterNsEndDeviceRegistryHandler(ns.Context(), s, conn)
+	ttnpb.RegisterNsEndDeviceMigrationHandler(ns.Context(), s, conn)
 	ttnpb.RegisterNsHandler(ns.Context(), s, conn)
 }
 
@@ -127,4 +127,10 @@ func TestNetworkServerCleanup(t *testing.T) {
 	a.So(deviceRegistryCleaner.LocalSet, should.ContainKey, unique.ID(ctx, deviceList[1].EndDeviceIdentifiers))
 	a.So(deviceRegistryCleaner.LocalSet, should.ContainKey, unique.ID(ctx, deviceList[4].EndDeviceIdentifiers))
 	a.So(deviceRegistryCleaner.LocalSet, should.ContainKey, unique.ID(ctx, deviceList[5].EndDeviceIdentifiers))
+
+	err = deviceRegistryCleaner.DeleteApplicationData(ctx, []string{unique.ID(ctx, appList[3])})
+	a.So(err, should.BeNil)
+	deviceRegistryCleaner.RangeToLocalSet(ctx)
+	a.So(deviceRegistryCleaner.LocalSet, should.HaveLength, 1)
+	a.So(deviceRegistryCleaner.LocalSet, should.ContainKey, unique.ID(ctx, deviceList[1].EndDeviceIdentifiers))
 }
@@ -34,6 +34,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test"
@@ -109,6 +110,11 @@ func NSScheduleWindow() time.Duration {
 	return nsScheduleWindow()
 }
 
+// InteropServer returns the NS-NS interop server of ns.
+func InteropServer(ns *NetworkServer) interop.NetworkServer {
+	return interopServer{NS: ns}
+}
+
 var JoinRequestCorrelationIDs = [...]string{
 	"join-request-correlation-id-1",
 	"join-request-correlation-id-2",
@@ -2414,6 +2420,7 @@ var _ DeviceRegistry = MockDeviceRegistry{}
 type MockDeviceRegistry struct {
 	GetByIDFunc func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error)
 	SetByIDFunc func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error)
+	RangeFunc   func(ctx context.Context, paths []string, f func(context.Context, ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool) error
 }
 
 // GetByEUI panics.
@@ -2442,7 +2449,10 @@ func (m MockDeviceRegistry) RangeByUplinkMatches(context.Context, *ttnpb.UplinkM
 	panic("RangeByUplinkMatches must not be called")
 }
 
-// Range panics.
+// Range calls RangeFunc if set and panics otherwise.
 func (m MockDeviceRegistry) Range(ctx context.Context, paths []string, f func(context.Context, ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool) error {
-	panic("Range must not be called")
+	if m.RangeFunc == nil {
+		panic("Range called, but not set")
+	}
+	return m.RangeFunc(ctx, paths, f)
 }
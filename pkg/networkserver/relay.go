@@ -0,0 +1,139 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+type relayIdentifiersKeyType struct{}
+
+var relayIdentifiersKey relayIdentifiersKeyType
+
+// relayIdentifiersFromContext returns the identifiers of the relay, which forwarded the uplink handled in ctx.
+func relayIdentifiersFromContext(ctx context.Context) (ttnpb.EndDeviceIdentifiers, bool) {
+	ids, ok := ctx.Value(relayIdentifiersKey).(ttnpb.EndDeviceIdentifiers)
+	return ids, ok
+}
+
+// deviceServedByRelay reports whether dev is, or is being configured to be, served by the relay identified by ids.
+func deviceServedByRelay(dev *ttnpb.EndDevice, ids ttnpb.EndDeviceIdentifiers) bool {
+	if dev.ApplicationIdentifiers.ApplicationId != ids.ApplicationIdentifiers.ApplicationId {
+		return false
+	}
+	for _, p := range [...]*ttnpb.RelayParameters{
+		dev.GetMacSettings().GetRelay(),
+		dev.GetMacSettings().GetDesiredRelay(),
+		dev.GetMacState().GetCurrentParameters().Relay,
+		dev.GetMacState().GetDesiredParameters().Relay,
+	} {
+		if served := p.GetServed(); served != nil && served.ServingDeviceId == ids.DeviceId {
+			return true
+		}
+	}
+	return false
+}
+
+// relayServedDeviceSession returns the DevAddr and the root wake-on-radio session key of the end device identified by ids.
+func (ns *NetworkServer) relayServedDeviceSession(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (types.DevAddr, types.AES128Key, error) {
+	dev, ctx, err := ns.devices.GetByID(ctx, ids.ApplicationIdentifiers, ids.DeviceId, []string{
+		"session.dev_addr",
+		"session.keys.nwk_s_enc_key",
+	})
+	if err != nil {
+		return types.DevAddr{}, types.AES128Key{}, err
+	}
+	if dev.Session == nil {
+		return types.DevAddr{}, types.AES128Key{}, errEmptySession.New()
+	}
+	if dev.Session.NwkSEncKey == nil || len(dev.Session.NwkSEncKey.Key) == 0 {
+		return types.DevAddr{}, types.AES128Key{}, errUnknownNwkSEncKey.New()
+	}
+	key, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.NwkSEncKey, ns.KeyVault)
+	if err != nil {
+		return types.DevAddr{}, types.AES128Key{}, err
+	}
+	return dev.Session.DevAddr, crypto.DeriveRootWorSKey(key), nil
+}
+
+// handleRelayForwardUplink handles the ForwardUplinkReq contained in up, which was sent by the relay dev.
+// The forwarded uplink is handled as if it was received by the gateways, which received up.
+func (ns *NetworkServer) handleRelayForwardUplink(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, up *ttnpb.UplinkMessage) error {
+	pld := up.Payload.GetMacPayload()
+	if dev.Session.NwkSEncKey == nil || len(dev.Session.NwkSEncKey.Key) == 0 {
+		return errUnknownNwkSEncKey.New()
+	}
+	key, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.NwkSEncKey, ns.KeyVault)
+	if err != nil {
+		return err
+	}
+	b, err := crypto.DecryptUplink(key, pld.DevAddr, pld.FullFCnt, pld.FrmPayload, false)
+	if err != nil {
+		return errDecodePayload.WithCause(err)
+	}
+	var req lorawan.ForwardUplinkReq
+	if err := lorawan.UnmarshalForwardUplinkReq(*phy, b, &req); err != nil {
+		return errDecodePayload.WithCause(err)
+	}
+	relayMD := &ttnpb.RelayMetadata{
+		DeviceId:   dev.DeviceId,
+		WorChannel: uint32(req.Metadata.WORChannel),
+	}
+	if err := relayMD.ValidateFields(); err != nil {
+		return errDecodePayload.WithCause(err)
+	}
+	dr, ok := phy.DataRates[req.Metadata.DataRateIndex]
+	if !ok {
+		return errDataRateIndexNotFound.WithAttributes("index", req.Metadata.DataRateIndex)
+	}
+	settings := ttnpb.TxSettings{
+		DataRate:  dr.Rate,
+		Frequency: req.Frequency,
+	}
+	if dr.Rate.GetLora() != nil {
+		settings.CodingRate = phy.LoRaCodingRate
+	}
+	// NOTE: The forwarded uplink carries no uplink tokens, so that downlinks are only scheduled via the relay.
+	mds := make([]*ttnpb.RxMetadata, 0, len(up.RxMetadata))
+	for _, md := range up.RxMetadata {
+		mds = append(mds, &ttnpb.RxMetadata{
+			GatewayIds:   md.GatewayIds,
+			PacketBroker: md.PacketBroker,
+			Time:         md.Time,
+			Rssi:         float32(req.Metadata.RSSI),
+			ChannelRssi:  float32(req.Metadata.RSSI),
+			Snr:          float32(req.Metadata.SNR),
+			Relay:        relayMD,
+		})
+	}
+	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("ns:relay_uplink:%s", events.NewCorrelationID()))
+	ctx = context.WithValue(ctx, relayIdentifiersKey, dev.EndDeviceIdentifiers)
+	return ns.handleUplink(ctx, &ttnpb.UplinkMessage{
+		RawPayload:     req.PHYPayload,
+		Settings:       settings,
+		RxMetadata:     mds,
+		ReceivedAt:     up.ReceivedAt,
+		CorrelationIds: events.CorrelationIDsFromContext(ctx),
+	})
+}
@@ -0,0 +1,302 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDeviceServedByRelay(t *testing.T) {
+	relayIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:               "test-relay",
+	}
+	makeServedRelayParameters := func(servingDeviceID string) *ttnpb.RelayParameters {
+		return &ttnpb.RelayParameters{
+			Mode: &ttnpb.RelayParameters_Served{
+				Served: &ttnpb.ServedRelayParameters{
+					Mode: &ttnpb.ServedRelayParameters_Always{
+						Always: &ttnpb.RelayEndDeviceAlwaysMode{},
+					},
+					ServingDeviceId: servingDeviceID,
+				},
+			},
+		}
+	}
+	for _, tc := range []struct {
+		Name   string
+		Device *ttnpb.EndDevice
+		Served bool
+	}{
+		{
+			Name: "no relay",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: relayIDs.ApplicationIdentifiers,
+					DeviceId:               "test-dev",
+				},
+			},
+		},
+		{
+			Name: "MAC settings/served by relay",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: relayIDs.ApplicationIdentifiers,
+					DeviceId:               "test-dev",
+				},
+				MacSettings: &ttnpb.MACSettings{
+					Relay: makeServedRelayParameters("test-relay"),
+				},
+			},
+			Served: true,
+		},
+		{
+			Name: "MAC settings/served by other relay",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: relayIDs.ApplicationIdentifiers,
+					DeviceId:               "test-dev",
+				},
+				MacSettings: &ttnpb.MACSettings{
+					Relay: makeServedRelayParameters("other-relay"),
+				},
+			},
+		},
+		{
+			Name: "MAC settings/served by relay in other application",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "other-app"},
+					DeviceId:               "test-dev",
+				},
+				MacSettings: &ttnpb.MACSettings{
+					Relay: makeServedRelayParameters("test-relay"),
+				},
+			},
+		},
+		{
+			Name: "desired MAC settings/served by relay",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: relayIDs.ApplicationIdentifiers,
+					DeviceId:               "test-dev",
+				},
+				MacSettings: &ttnpb.MACSettings{
+					DesiredRelay: makeServedRelayParameters("test-relay"),
+				},
+			},
+			Served: true,
+		},
+		{
+			Name: "current parameters/served by relay",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: relayIDs.ApplicationIdentifiers,
+					DeviceId:               "test-dev",
+				},
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: makeServedRelayParameters("test-relay"),
+					},
+				},
+			},
+			Served: true,
+		},
+		{
+			Name: "current parameters/serving",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: relayIDs.ApplicationIdentifiers,
+					DeviceId:               "test-dev",
+				},
+				MacState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Relay: &ttnpb.RelayParameters{
+							Mode: &ttnpb.RelayParameters_Serving{
+								Serving: &ttnpb.ServingRelayParameters{},
+							},
+						},
+					},
+				},
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				if tc.Served {
+					a.So(deviceServedByRelay(tc.Device, relayIDs), should.BeTrue)
+				} else {
+					a.So(deviceServedByRelay(tc.Device, relayIDs), should.BeFalse)
+				}
+			},
+		})
+	}
+}
+
+func TestDownlinkPathsFromRelayMetadata(t *testing.T) {
+	relayMD := &ttnpb.RelayMetadata{
+		DeviceId: "test-relay",
+	}
+	otherRelayMD := &ttnpb.RelayMetadata{
+		DeviceId:   "other-relay",
+		WorChannel: 1,
+	}
+	for _, tc := range []struct {
+		Name     string
+		Metadata []*ttnpb.RxMetadata
+		Expected []downlinkPath
+	}{
+		{
+			Name: "single relay/multiple gateways",
+			Metadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gateway-1"},
+					Snr:        5,
+					Relay:      relayMD,
+				},
+				{
+					GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gateway-2"},
+					Snr:        5,
+					Relay:      relayMD,
+				},
+			},
+			Expected: []downlinkPath{
+				{Relay: relayMD},
+			},
+		},
+		{
+			Name: "multiple relays",
+			Metadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gateway-1"},
+					Snr:        -5,
+					Relay:      otherRelayMD,
+				},
+				{
+					GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gateway-1"},
+					Snr:        5,
+					Relay:      relayMD,
+				},
+			},
+			Expected: []downlinkPath{
+				{Relay: relayMD},
+				{Relay: otherRelayMD},
+			},
+		},
+		{
+			Name: "relay and gateway",
+			Metadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIds:  &ttnpb.GatewayIdentifiers{GatewayId: "gateway-1"},
+					Snr:         -5,
+					UplinkToken: []byte("token"),
+				},
+				{
+					GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gateway-2"},
+					Snr:        5,
+					Relay:      relayMD,
+				},
+			},
+			Expected: []downlinkPath{
+				{Relay: relayMD},
+				{
+					GatewayIdentifiers: &ttnpb.GatewayIdentifiers{GatewayId: "gateway-1"},
+					DownlinkPath: &ttnpb.DownlinkPath{
+						Path: &ttnpb.DownlinkPath_UplinkToken{
+							UplinkToken: []byte("token"),
+						},
+					},
+				},
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				a.So(downlinkPathsFromMetadata(ctx, tc.Metadata...), should.Resemble, tc.Expected)
+			},
+		})
+	}
+}
+
+func TestMatchDataUplinkDropsStaleRelayDownlink(t *testing.T) {
+	a, ctx := test.New(t)
+
+	c := component.MustNew(
+		log.Noop,
+		&component.Config{},
+		component.WithClusterNew(func(context.Context, *cluster.Config, ...cluster.Option) (cluster.Cluster, error) {
+			return &test.MockCluster{
+				JoinFunc: test.ClusterJoinNilFunc,
+			}, nil
+		}),
+	)
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	componenttest.StartComponent(t, c)
+
+	defaultMACSettings := DefaultConfig.DefaultMACSettings.Parse()
+	ns := &NetworkServer{
+		Component:          c,
+		ctx:                ctx,
+		defaultMACSettings: defaultMACSettings,
+	}
+
+	dev := MakeABPEndDevice(defaultMACSettings, false,
+		[]test.SessionOption{
+			SessionOptions.WithLastFCntUp(0x41),
+		},
+		[]test.MACStateOption{
+			func(macState ttnpb.MACState) ttnpb.MACState {
+				macState.PendingRelayDownlink = &ttnpb.RelayForwardDownlinkReq{
+					RawPayload: []byte{0x60, 0x42},
+				}
+				return macState
+			},
+		},
+	)
+	up := MakeDataUplink(WithDeviceDataUplinkConfig(dev, false, ttnpb.DATA_RATE_2, 1, 1)(DataUplinkConfig{
+		DecodePayload: true,
+		Matched:       true,
+	}))
+	fNwkSIntKey := *dev.Session.FNwkSIntKey.Key
+	matched, ok, err := ns.matchAndHandleDataUplink(ctx, dev, up, true, cmacFMatchingResult{
+		LastFCnt:       dev.Session.LastFCntUp,
+		FNwkSIntKey:    fNwkSIntKey,
+		LoRaWANVersion: dev.MacState.LorawanVersion,
+		FullFCnt:       dev.Session.LastFCntUp + 1,
+		CmacF:          MustComputeUplinkCMACF(fNwkSIntKey, dev.Session.DevAddr, dev.Session.LastFCntUp+1, up.RawPayload[:len(up.RawPayload)-4]...),
+	})
+	if !a.So(err, should.BeNil) || !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(matched.Device.MacState.PendingRelayDownlink, should.BeNil)
+}
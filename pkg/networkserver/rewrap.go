@@ -0,0 +1,90 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var rewrapDevicePaths = []string{
+	"ids",
+	"pending_mac_state.queued_join_accept.keys",
+	"pending_session.keys",
+	"session.keys",
+}
+
+func deviceKeyEnvelopes(dev *ttnpb.EndDevice) map[string]**ttnpb.KeyEnvelope {
+	envelopes := make(map[string]**ttnpb.KeyEnvelope)
+	addSessionKeys := func(prefix string, sk *ttnpb.SessionKeys) {
+		envelopes[prefix+".f_nwk_s_int_key"] = &sk.FNwkSIntKey
+		envelopes[prefix+".nwk_s_enc_key"] = &sk.NwkSEncKey
+		envelopes[prefix+".s_nwk_s_int_key"] = &sk.SNwkSIntKey
+	}
+	if dev.Session != nil {
+		addSessionKeys("session.keys", &dev.Session.SessionKeys)
+	}
+	if dev.PendingSession != nil {
+		addSessionKeys("pending_session.keys", &dev.PendingSession.SessionKeys)
+	}
+	if dev.PendingMacState.GetQueuedJoinAccept() != nil {
+		// NOTE: The AppSKey of the queued join-accept is wrapped for the Application Server and is left as-is.
+		addSessionKeys("pending_mac_state.queued_join_accept.keys", &dev.PendingMacState.QueuedJoinAccept.Keys)
+	}
+	return envelopes
+}
+
+// deviceRewrapRegistry is the cryptoutil.RewrapRegistry of the network session keys of devices.
+type deviceRewrapRegistry struct {
+	DeviceRegistry
+}
+
+func (r deviceRewrapRegistry) Range(ctx context.Context, f func(context.Context, ttnpb.IDStringer, map[string]**ttnpb.KeyEnvelope) bool) error {
+	return r.DeviceRegistry.Range(ctx, rewrapDevicePaths, func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, dev *ttnpb.EndDevice) bool {
+		return f(ctx, ids, deviceKeyEnvelopes(dev))
+	})
+}
+
+func (r deviceRewrapRegistry) Update(ctx context.Context, ids ttnpb.IDStringer, f func(context.Context, map[string]**ttnpb.KeyEnvelope) ([]string, error)) error {
+	devIDs := ids.(ttnpb.EndDeviceIdentifiers)
+	_, _, err := r.SetByID(ctx, devIDs.ApplicationIdentifiers, devIDs.DeviceId, rewrapDevicePaths, func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		if dev == nil {
+			return nil, nil, nil
+		}
+		sets, err := f(ctx, deviceKeyEnvelopes(dev))
+		if err != nil {
+			return nil, nil, err
+		}
+		return dev, sets, nil
+	})
+	return err
+}
+
+// RegistryRewrapper is a service responsible for re-wrapping the network session keys at rest in the
+// device registry with another KEK.
+type RegistryRewrapper struct {
+	DevRegistry DeviceRegistry
+	KEKRewrap   cryptoutil.KEKRewrap
+	// DryRun only counts the keys that are wrapped with the KEK that is rotated.
+	DryRun bool
+}
+
+// RewrapData re-wraps the network session keys of the devices.
+// Devices of which the keys cannot be re-wrapped are logged and counted as failed.
+func (rw *RegistryRewrapper) RewrapData(ctx context.Context) (cryptoutil.RewrapStats, error) {
+	return rw.KEKRewrap.RewrapRegistry(ctx, deviceRewrapRegistry{rw.DevRegistry}, rw.DryRun)
+}
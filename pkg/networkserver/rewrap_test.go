@@ -0,0 +1,184 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver_test
+
+import (
+	"context"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// rewrapDeviceRegistry is a device registry in memory that supports the methods used by the RegistryRewrapper.
+type rewrapDeviceRegistry struct {
+	networkserver.DeviceRegistry
+	devs map[string]*ttnpb.EndDevice
+}
+
+func (r rewrapDeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+	uid := unique.ID(ctx, ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appID, DeviceId: devID})
+	var stored *ttnpb.EndDevice
+	if r.devs[uid] != nil {
+		var err error
+		stored, err = ttnpb.FilterGetEndDevice(r.devs[uid], paths...)
+		if err != nil {
+			return nil, ctx, err
+		}
+	}
+	pb, sets, err := f(ctx, stored)
+	if err != nil || pb == nil {
+		return nil, ctx, err
+	}
+	r.devs[uid], err = ttnpb.ApplyEndDeviceFieldMask(r.devs[uid], pb, sets...)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return pb, ctx, nil
+}
+
+func (r rewrapDeviceRegistry) Range(ctx context.Context, paths []string, f func(context.Context, ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool) error {
+	for _, dev := range r.devs {
+		pb, err := ttnpb.FilterGetEndDevice(dev, paths...)
+		if err != nil {
+			return err
+		}
+		if !f(ctx, pb.EndDeviceIdentifiers, pb) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func TestRegistryRewrapper(t *testing.T) {
+	a, ctx := test.New(t)
+
+	keyVault := cryptoutil.NewMemKeyVault(map[string][]byte{
+		"old": {0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf},
+		"new": {0xf, 0xe, 0xd, 0xc, 0xb, 0xa, 0x9, 0x8, 0x7, 0x6, 0x5, 0x4, 0x3, 0x2, 0x1, 0x0},
+	})
+	mustWrap := func(key types.AES128Key, kekLabel string) *ttnpb.KeyEnvelope {
+		ke, err := cryptoutil.WrapAES128Key(ctx, key, kekLabel, keyVault)
+		if err != nil {
+			t.Fatalf("Failed to wrap key: %s", err)
+		}
+		return ke
+	}
+	fNwkSIntKey := types.AES128Key{0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1}
+	nwkSEncKey := types.AES128Key{0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2}
+	sNwkSIntKey := types.AES128Key{0x3, 0x3, 0x3, 0x3, 0x3, 0x3, 0x3, 0x3, 0x3, 0x3, 0x3, 0x3, 0x3, 0x3, 0x3, 0x3}
+	appSKey := types.AES128Key{0x4, 0x4, 0x4, 0x4, 0x4, 0x4, 0x4, 0x4, 0x4, 0x4, 0x4, 0x4, 0x4, 0x4, 0x4, 0x4}
+	sessionKeys := func(kekLabel string) ttnpb.SessionKeys {
+		return ttnpb.SessionKeys{
+			FNwkSIntKey: mustWrap(fNwkSIntKey, kekLabel),
+			NwkSEncKey:  mustWrap(nwkSEncKey, kekLabel),
+			SNwkSIntKey: mustWrap(sNwkSIntKey, kekLabel),
+		}
+	}
+	newDevice := func(devID string) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+				DeviceId:               devID,
+			},
+		}
+	}
+
+	dev1 := newDevice("dev-1")
+	dev1.Session = &ttnpb.Session{SessionKeys: sessionKeys("old")}
+	dev1.PendingSession = &ttnpb.Session{SessionKeys: sessionKeys("old")}
+	dev2 := newDevice("dev-2")
+	dev2.PendingMacState = &ttnpb.MACState{
+		QueuedJoinAccept: &ttnpb.MACState_JoinAccept{
+			Keys: sessionKeys("old"),
+		},
+	}
+	// The AppSKey of the queued join-accept is wrapped for the Application Server.
+	dev2.PendingMacState.QueuedJoinAccept.Keys.AppSKey = mustWrap(appSKey, "old")
+	dev3 := newDevice("dev-3")
+	dev3.Session = &ttnpb.Session{SessionKeys: sessionKeys("new")}
+	dev4 := newDevice("dev-4")
+	dev4.Session = &ttnpb.Session{SessionKeys: ttnpb.SessionKeys{
+		FNwkSIntKey: &ttnpb.KeyEnvelope{EncryptedKey: []byte{0x1}, KekLabel: "old"},
+	}}
+	devs := map[string]*ttnpb.EndDevice{
+		"test-app.dev-1": dev1,
+		"test-app.dev-2": dev2,
+		"test-app.dev-3": dev3,
+		"test-app.dev-4": dev4,
+	}
+
+	rewrapper := &networkserver.RegistryRewrapper{
+		DevRegistry: rewrapDeviceRegistry{devs: devs},
+		KEKRewrap: cryptoutil.KEKRewrap{
+			KeyVault: keyVault,
+			From:     "old",
+			To:       "new",
+		},
+		DryRun: true,
+	}
+	stats, err := rewrapper.RewrapData(ctx)
+	a.So(err, should.BeNil)
+	a.So(stats, should.Resemble, cryptoutil.RewrapStats{
+		Entities: 3,
+		Keys:     10,
+	})
+	a.So(dev1.Session.FNwkSIntKey.KekLabel, should.Equal, "old")
+
+	rewrapper.DryRun = false
+	stats, err = rewrapper.RewrapData(ctx)
+	a.So(err, should.BeNil)
+	a.So(stats, should.Resemble, cryptoutil.RewrapStats{
+		Entities:  3,
+		Keys:      10,
+		Rewrapped: 9,
+		Failed:    1,
+	})
+	for _, sk := range []ttnpb.SessionKeys{
+		devs["test-app.dev-1"].Session.SessionKeys,
+		devs["test-app.dev-1"].PendingSession.SessionKeys,
+		devs["test-app.dev-2"].PendingMacState.QueuedJoinAccept.Keys,
+		devs["test-app.dev-3"].Session.SessionKeys,
+	} {
+		for _, k := range []struct {
+			ke  *ttnpb.KeyEnvelope
+			key types.AES128Key
+		}{
+			{ke: sk.FNwkSIntKey, key: fNwkSIntKey},
+			{ke: sk.NwkSEncKey, key: nwkSEncKey},
+			{ke: sk.SNwkSIntKey, key: sNwkSIntKey},
+		} {
+			a.So(k.ke.KekLabel, should.Equal, "new")
+			key, err := cryptoutil.UnwrapAES128Key(ctx, k.ke, keyVault)
+			a.So(err, should.BeNil)
+			a.So(key, should.Resemble, k.key)
+		}
+	}
+	a.So(devs["test-app.dev-2"].PendingMacState.QueuedJoinAccept.Keys.AppSKey.KekLabel, should.Equal, "old")
+	a.So(devs["test-app.dev-4"].Session.FNwkSIntKey.KekLabel, should.Equal, "old")
+
+	stats, err = rewrapper.RewrapData(ctx)
+	a.So(err, should.BeNil)
+	a.So(stats, should.Resemble, cryptoutil.RewrapStats{
+		Entities: 1,
+		Keys:     1,
+		Failed:   1,
+	})
+}
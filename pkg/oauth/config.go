@@ -15,6 +15,7 @@
 package oauth
 
 import (
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/webui"
 )
 
@@ -40,7 +41,77 @@ type FrontendConfig struct {
 
 // Config is the configuration for the OAuth server.
 type Config struct {
-	Mount       string   `name:"mount" description:"Path on the server where the Account application and OAuth services will be served"`
-	UI          UIConfig `name:"ui"`
-	CSRFAuthKey []byte   `name:"-"`
+	Mount       string           `name:"mount" description:"Path on the server where the Account application and OAuth services will be served"`
+	UI          UIConfig         `name:"ui"`
+	CSRFAuthKey []byte           `name:"-"`
+	MFA         MFAConfig        `name:"-"`
+	Providers   []ProviderConfig `name:"providers" description:"Upstream OpenID Connect providers for federated login"`
+
+	// AdminApprovalRequired indicates that users that are created through federated login require admin approval.
+	AdminApprovalRequired bool `name:"-"`
+}
+
+// ProviderConfig is the configuration of an upstream OpenID Connect provider for federated login.
+type ProviderConfig struct {
+	ID                string                       `name:"id" description:"ID of the provider, which is used in the login and callback URLs"`
+	Name              string                       `name:"name" description:"Name of the provider that is shown to users"`
+	Issuer            string                       `name:"issuer" description:"Issuer URL of the provider"`
+	ClientID          string                       `name:"client-id" yaml:"client-id" description:"OAuth client ID at the provider"`
+	ClientSecret      string                       `name:"client-secret" yaml:"client-secret" description:"OAuth client secret at the provider"`
+	Scopes            []string                     `name:"scopes" description:"Additional scopes to request from the provider"`
+	Claims            ProviderClaimsConfig         `name:"claims"`
+	AllowRegistration bool                         `name:"allow-registration" yaml:"allow-registration" description:"Create users that log in through the provider for the first time"`
+	LinkVerifiedEmail bool                         `name:"link-verified-email" yaml:"link-verified-email" description:"Link existing users with a validated email address to the identity with the same verified email address"`
+	Organizations     []ProviderOrganizationConfig `name:"organizations" description:"Organization memberships that are granted by group claims"`
+}
+
+// ProviderClaimsConfig maps the claims of the ID tokens of an upstream OpenID Connect provider to user fields.
+// Empty claim names fall back to the standard claims.
+type ProviderClaimsConfig struct {
+	UserID        string `name:"user-id" yaml:"user-id" description:"Claim that contains the user ID of created users (default preferred_username)"`
+	Name          string `name:"name" description:"Claim that contains the name of the user (default name)"`
+	Email         string `name:"email" description:"Claim that contains the email address of the user (default email)"`
+	EmailVerified string `name:"email-verified" yaml:"email-verified" description:"Claim that indicates whether the email address is verified (default email_verified)"`
+	Groups        string `name:"groups" description:"Claim that contains the groups of the user (default groups)"`
+}
+
+// WithDefaults returns the claim mapping with the standard claims for the empty claim names.
+func (c ProviderClaimsConfig) WithDefaults() ProviderClaimsConfig {
+	for _, claim := range []struct {
+		name *string
+		def  string
+	}{
+		{&c.UserID, "preferred_username"},
+		{&c.Name, "name"},
+		{&c.Email, "email"},
+		{&c.EmailVerified, "email_verified"},
+		{&c.Groups, "groups"},
+	} {
+		if *claim.name == "" {
+			*claim.name = claim.def
+		}
+	}
+	return c
+}
+
+// ProviderOrganizationConfig grants the members of a group at an upstream OpenID Connect provider membership of an
+// organization.
+type ProviderOrganizationConfig struct {
+	Group          string   `name:"group" description:"Group in the groups claim"`
+	OrganizationID string   `name:"organization-id" yaml:"organization-id" description:"ID of the organization"`
+	Rights         []string `name:"rights" description:"Rights of the members (default RIGHT_ORGANIZATION_INFO)"`
+}
+
+// MFAConfig is the multi-factor authentication policy of the OAuth server and the Account application.
+type MFAConfig struct {
+	Issuer            string `name:"issuer" description:"Issuer name that authenticator apps show for one-time passwords"`
+	Required          bool   `name:"required" description:"Require all users to enable multi-factor authentication"`
+	RequiredForAdmins bool   `name:"required-for-admins" description:"Require admin users to enable multi-factor authentication"`
+	RecoveryCodes     int    `name:"recovery-codes" description:"Number of recovery codes that are generated for users"`
+	EncryptionKeyID   string `name:"encryption-key-id" description:"ID of the key used to encrypt TOTP secrets at rest"`
+}
+
+// RequiredFor returns whether the policy requires the user to enable multi-factor authentication.
+func (c MFAConfig) RequiredFor(user *ttnpb.User) bool {
+	return c.Required || c.RequiredForAdmins && user.GetAdmin()
 }
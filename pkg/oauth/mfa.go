@@ -0,0 +1,77 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errMFAEnrolmentRequired = errors.DefinePermissionDenied("mfa_enrolment_required", "user `{user_id}` must enable multi-factor authentication")
+	errPasswordGrantMFA     = errors.DefinePermissionDenied("password_grant_mfa", "password grant not allowed for user `{user_id}` with multi-factor authentication")
+)
+
+// mfaEnabled returns whether the user enabled multi-factor authentication.
+func (s *server) mfaEnabled(ctx context.Context, userIDs *ttnpb.UserIdentifiers) (bool, error) {
+	mfa, err := s.store.GetUserMFA(ctx, userIDs)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return mfa.Enabled(), nil
+}
+
+// requireMFAPolicy returns an error if the multi-factor authentication policy requires the user to enable
+// multi-factor authentication and the user did not do so yet.
+func (s *server) requireMFAPolicy(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error {
+	policy := s.configFromContext(ctx).MFA
+	if !policy.Required && !policy.RequiredForAdmins {
+		return nil
+	}
+	enabled, err := s.mfaEnabled(ctx, userIDs)
+	if err != nil || enabled {
+		return err
+	}
+	user, err := s.store.GetUser(ctx, userIDs, &types.FieldMask{Paths: []string{"admin"}})
+	if err != nil {
+		return err
+	}
+	if policy.RequiredFor(user) {
+		return errMFAEnrolmentRequired.WithAttributes("user_id", userIDs.GetUserId())
+	}
+	return nil
+}
+
+// requirePasswordGrantAllowed returns an error if the user can not use the password grant, because the password
+// grant does not support a second authentication factor.
+func (s *server) requirePasswordGrantAllowed(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error {
+	enabled, err := s.mfaEnabled(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	if enabled {
+		return errPasswordGrantMFA.WithAttributes("user_id", userIDs.GetUserId())
+	}
+	if err := s.requireMFAPolicy(ctx, userIDs); err != nil {
+		return errPasswordGrantMFA.WithCause(err).WithAttributes("user_id", userIDs.GetUserId())
+	}
+	return nil
+}
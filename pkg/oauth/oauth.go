@@ -81,6 +81,9 @@ func (s *server) Authorize(authorizePage echo.HandlerFunc) echo.HandlerFunc {
 		if err != nil {
 			return err
 		}
+		if err := s.requireMFAPolicy(req.Context(), session.GetUserIds()); err != nil {
+			return err
+		}
 		oauth2 := s.oauth2(req.Context())
 		resp := oauth2.NewResponse()
 		defer resp.Close()
@@ -276,6 +279,9 @@ func (s *server) Token(c echo.Context) error {
 			if err := s.session.DoLogin(req.Context(), ar.Username, ar.Password); err != nil {
 				return err
 			}
+			if err := s.requirePasswordGrantAllowed(req.Context(), &ttnpb.UserIdentifiers{UserId: ar.Username}); err != nil {
+				return err
+			}
 			ar.Authorized = true
 		}
 	}
@@ -60,6 +60,8 @@ type Store interface {
 	store.ClientStore
 	// OAuth is needed for OAuth authorizations.
 	store.OAuthStore
+	// UserMFAStore is needed for enforcing multi-factor authentication.
+	store.UserMFAStore
 }
 
 // NewServer returns a new OAuth server on top of the given store.
@@ -47,6 +47,7 @@ type mockStoreContents struct {
 		authorization     *ttnpb.OAuthClientAuthorization
 		authorizationCode *ttnpb.OAuthAuthorizationCode
 		accessToken       *ttnpb.OAuthAccessToken
+		userMFA           *store.UserMFA
 	}
 	err struct {
 		getUser                 error
@@ -61,6 +62,7 @@ type mockStoreContents struct {
 		createAccessToken       error
 		getAccessToken          error
 		deleteAccessToken       error
+		getUserMFA              error
 	}
 }
 
@@ -69,6 +71,7 @@ type mockStore struct {
 	store.UserSessionStore
 	store.ClientStore
 	store.OAuthStore
+	store.UserMFAStore
 
 	mockStoreContents
 }
@@ -156,3 +159,9 @@ func (s *mockStore) DeleteAccessToken(ctx context.Context, tokenID string) error
 	s.calls = append(s.calls, "DeleteAccessToken")
 	return s.err.deleteAccessToken
 }
+
+func (s *mockStore) GetUserMFA(ctx context.Context, userIDs *ttnpb.UserIdentifiers) (*store.UserMFA, error) {
+	s.req.ctx, s.req.userIDs = ctx, userIDs
+	s.calls = append(s.calls, "GetUserMFA")
+	return s.res.userMFA, s.err.getUserMFA
+}
@@ -16,6 +16,8 @@
 package provisioning
 
 import (
+	"strings"
+
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 )
@@ -43,3 +45,18 @@ func Get(id string) Provisioner {
 func Register(id string, p Provisioner) {
 	registry[id] = p
 }
+
+// fieldProvisioner is a provisioner that uses a string field of the entry as unique ID.
+type fieldProvisioner string
+
+// UniqueID returns the upper case value of the field.
+func (p fieldProvisioner) UniqueID(entry *pbtypes.Struct) (string, error) {
+	if entry == nil {
+		return "", errEntry.New()
+	}
+	id := entry.Fields[string(p)].GetStringValue()
+	if id == "" {
+		return "", errEntry.New()
+	}
+	return strings.ToUpper(id), nil
+}
@@ -18,7 +18,6 @@ import (
 	"context"
 
 	pbtypes "github.com/gogo/protobuf/types"
-	qrcodegen "github.com/skip2/go-qrcode"
 	"go.thethings.network/lorawan-stack/v3/pkg/qrcode"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
@@ -65,11 +64,7 @@ func (s *endDeviceQRCodeGeneratorServer) Generate(ctx context.Context, req *ttnp
 		Text: string(text),
 	}
 	if req.Image != nil {
-		qr, err := qrcodegen.New(string(text), qrcodegen.Medium)
-		if err != nil {
-			return nil, err
-		}
-		data, err := qr.PNG(int(req.Image.ImageSize))
+		data, err := RenderPNG(string(text), int(req.Image.ImageSize))
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,26 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package qrcodegenerator
+
+import qrcodegen "github.com/skip2/go-qrcode"
+
+// RenderPNG renders the text as a QR code PNG image of the given size in pixels.
+func RenderPNG(text string, size int) ([]byte, error) {
+	qr, err := qrcodegen.New(text, qrcodegen.Medium)
+	if err != nil {
+		return nil, err
+	}
+	return qr.PNG(size)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 )
 
@@ -157,6 +158,47 @@ func ApplicationWebhooksDownResource(ctx context.Context, ids ttnpb.EndDeviceIde
 	}
 }
 
+// JoinEUIJoinRequestResource represents join-requests with a JoinEUI of the end devices of an application.
+// The JoinEUI is scoped to the application, as JoinEUIs may be shared by the end devices of different applications.
+func JoinEUIJoinRequestResource(ctx context.Context, ids ttnpb.ApplicationIdentifiers, joinEUI types.EUI64) Resource {
+	return &resource{
+		key:     fmt.Sprintf("js:join:join_eui:app:%s:%s", unique.ID(ctx, ids), joinEUI),
+		classes: []string{"js:join:join_eui", "js:join"},
+	}
+}
+
+// DeviceJoinRequestResource represents join-requests of an end device.
+func DeviceJoinRequestResource(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) Resource {
+	return &resource{
+		key:     fmt.Sprintf("js:join:dev:%s", unique.ID(ctx, ids)),
+		classes: []string{"js:join:dev", "js:join"},
+	}
+}
+
+// UserMFAResource represents attempts to verify a one-time password or recovery code of a user.
+func UserMFAResource(ids *ttnpb.UserIdentifiers) Resource {
+	return &resource{
+		key:     fmt.Sprintf("account:mfa:usr:%s", ids.GetUserId()),
+		classes: []string{"account:mfa"},
+	}
+}
+
+// EndDeviceClaimResource represents attempts to claim an end device with a claim authentication code.
+func EndDeviceClaimResource(joinEUI, devEUI types.EUI64) Resource {
+	return &resource{
+		key:     fmt.Sprintf("dcs:claim:dev:%s:%s", joinEUI, devEUI),
+		classes: []string{"dcs:claim"},
+	}
+}
+
+// GatewayClaimResource represents attempts to claim a gateway with a claim authentication code.
+func GatewayClaimResource(eui types.EUI64) Resource {
+	return &resource{
+		key:     fmt.Sprintf("dcs:claim:gtw:%s", eui),
+		classes: []string{"dcs:claim"},
+	}
+}
+
 // NewCustomResource returns a new resource. It is used internally by other components.
 func NewCustomResource(key string, classes ...string) Resource {
 	return &resource{key, classes}
@@ -529,6 +529,7 @@ type CreateApplicationAPIKeyRequest struct {
 	Name                 string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Rights               []Right                 `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
 	ExpiresAt            *time.Time              `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	Restrictions         *APIKeyRestrictions     `protobuf:"bytes,5,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
 }
@@ -584,6 +585,13 @@ func (m *CreateApplicationAPIKeyRequest) GetExpiresAt() *time.Time {
 	return nil
 }
 
+func (m *CreateApplicationAPIKeyRequest) GetRestrictions() *APIKeyRestrictions {
+	if m != nil {
+		return m.Restrictions
+	}
+	return nil
+}
+
 type UpdateApplicationAPIKeyRequest struct {
 	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
 	ApiKey         *APIKey                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
@@ -820,80 +828,81 @@ func init() {
 }
 
 var fileDescriptor_57d90136b1f4f7b1 = []byte{
-	// 1166 bytes of a gzipped FileDescriptorProto
+	// 1184 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xf8, 0x57, 0xe2, 0x71, 0x7e, 0xb1, 0x10, 0x58, 0x25, 0xb0, 0x71, 0xb7, 0x51, 0x65,
-	0x02, 0xbb, 0x46, 0x0e, 0x45, 0xa4, 0x52, 0x95, 0x7a, 0x43, 0x08, 0x26, 0x94, 0xc0, 0x40, 0x90,
-	0x20, 0x2a, 0xd6, 0xd8, 0x3b, 0xde, 0x8c, 0x6c, 0xef, 0x2e, 0xb3, 0x63, 0xb7, 0x2e, 0x42, 0x42,
-	0x3d, 0x72, 0xa1, 0x2a, 0x37, 0x0e, 0x5c, 0xb8, 0x70, 0x81, 0x03, 0xe2, 0x0f, 0xe0, 0xc0, 0xa1,
-	0xe2, 0xc4, 0x11, 0x51, 0xa9, 0x88, 0xf4, 0xd2, 0x23, 0x5c, 0x73, 0x42, 0x3b, 0xbb, 0x5b, 0xaf,
-	0xed, 0x34, 0xb4, 0x04, 0x02, 0x27, 0xef, 0x8c, 0xbf, 0xf7, 0xe6, 0x7b, 0x6f, 0xbf, 0xef, 0xed,
-	0xc0, 0xd3, 0x2d, 0x87, 0xe1, 0xcb, 0xd8, 0xd6, 0x3c, 0x8e, 0xeb, 0xcd, 0x22, 0x76, 0x69, 0x11,
-	0xbb, 0x6e, 0x8b, 0xd6, 0x31, 0xa7, 0x8e, 0xad, 0xbb, 0xcc, 0xe1, 0x8e, 0x34, 0xcd, 0xb9, 0xad,
-	0x87, 0x40, 0xbd, 0xbb, 0x32, 0x5f, 0xb6, 0x28, 0xdf, 0xeb, 0xd4, 0xf4, 0xba, 0xd3, 0x2e, 0x12,
-	0xbb, 0xeb, 0xf4, 0x5c, 0xe6, 0x5c, 0xe9, 0x15, 0x05, 0xb8, 0xae, 0x59, 0xc4, 0xd6, 0xba, 0xb8,
-	0x45, 0x4d, 0xcc, 0x49, 0x71, 0xe4, 0x21, 0x48, 0x39, 0xaf, 0xc5, 0x52, 0x58, 0x8e, 0xe5, 0x04,
-	0xc1, 0xb5, 0x4e, 0x43, 0xac, 0xc4, 0x42, 0x3c, 0x85, 0xf0, 0xbc, 0xe5, 0x38, 0x56, 0x8b, 0xf4,
-	0x51, 0x0d, 0x4a, 0x5a, 0x66, 0xb5, 0x8d, 0xbd, 0x66, 0x88, 0x58, 0x1c, 0x46, 0x70, 0xda, 0x26,
-	0x1e, 0xc7, 0x6d, 0x37, 0x04, 0x2c, 0x8d, 0x56, 0x5a, 0x77, 0x6c, 0x8e, 0xeb, 0xbc, 0x4a, 0xed,
-	0x46, 0x74, 0xd0, 0x21, 0xfd, 0xa0, 0x26, 0xb1, 0x39, 0x6d, 0x50, 0xc2, 0xbc, 0x10, 0xa4, 0x8c,
-	0x82, 0x18, 0xb5, 0xf6, 0x78, 0xf8, 0xbf, 0xfa, 0x59, 0x1a, 0xe6, 0xca, 0xfd, 0x2e, 0x4a, 0x06,
-	0x4c, 0x52, 0xd3, 0x93, 0x41, 0x1e, 0x14, 0x72, 0xa5, 0x33, 0xfa, 0x60, 0x37, 0xf5, 0x18, 0xb2,
-	0xd2, 0x3f, 0xca, 0x98, 0x38, 0x30, 0xd2, 0x9f, 0x80, 0xc4, 0x2c, 0x40, 0x7e, 0xb0, 0xb4, 0x06,
-	0x61, 0x9d, 0x11, 0xcc, 0x89, 0x59, 0xc5, 0x5c, 0x4e, 0x88, 0x54, 0xf3, 0x7a, 0x50, 0xb4, 0x1e,
-	0x15, 0xad, 0xbf, 0x1d, 0x15, 0x6d, 0xa4, 0xae, 0xff, 0xba, 0x08, 0x50, 0x36, 0x8c, 0x29, 0x73,
-	0x3f, 0x41, 0xc7, 0x35, 0xa3, 0x04, 0xc9, 0x07, 0x4d, 0x10, 0xc6, 0x04, 0x09, 0x4c, 0xd2, 0x22,
-	0x61, 0x82, 0x89, 0x07, 0x4d, 0x10, 0xc6, 0x94, 0xb9, 0xb4, 0x00, 0x53, 0x36, 0x6e, 0x13, 0x39,
-	0x95, 0x07, 0x85, 0xac, 0x31, 0x7e, 0x60, 0xa4, 0x58, 0x42, 0x2e, 0x21, 0xb1, 0x29, 0x2d, 0xc3,
-	0x9c, 0x49, 0xbc, 0x3a, 0xa3, 0xae, 0xdf, 0x08, 0x39, 0x2d, 0x30, 0x7e, 0x0f, 0x58, 0x52, 0xfe,
-	0x69, 0x06, 0xc5, 0xff, 0x94, 0xae, 0x01, 0x08, 0x31, 0xe7, 0x8c, 0xd6, 0x3a, 0x9c, 0x78, 0x72,
-	0x26, 0x9f, 0x2c, 0xe4, 0x4a, 0xcf, 0x1c, 0xd1, 0x57, 0xbd, 0x7c, 0x0f, 0xbd, 0x61, 0x73, 0xd6,
-	0x33, 0xce, 0x1e, 0x18, 0xa5, 0xcf, 0x41, 0x71, 0x16, 0xaa, 0x4b, 0x4c, 0x95, 0x97, 0x4a, 0xca,
-	0xfb, 0xbb, 0x58, 0xbb, 0xfa, 0x9c, 0xb6, 0x7a, 0xa9, 0xb0, 0x76, 0x6e, 0x57, 0xbb, 0xb4, 0x16,
-	0x2d, 0x9f, 0xfe, 0xb0, 0xf4, 0xec, 0x47, 0x4b, 0xcb, 0x3e, 0x8b, 0x9b, 0x00, 0xc5, 0x4e, 0x95,
-	0x5e, 0x81, 0x93, 0x71, 0xfd, 0xc8, 0xe3, 0x82, 0xc5, 0xc2, 0x30, 0x8b, 0xf5, 0x00, 0x53, 0xb1,
-	0x1b, 0x8e, 0x28, 0xe7, 0x06, 0x48, 0xcc, 0x42, 0x94, 0xab, 0xf7, 0xb7, 0xa5, 0x33, 0x70, 0xc6,
-	0x24, 0xdd, 0x2a, 0xe9, 0xd0, 0x6a, 0xdd, 0xe9, 0xd8, 0x9c, 0x30, 0x39, 0x9b, 0x07, 0x85, 0x29,
-	0x34, 0x65, 0x92, 0xee, 0x46, 0x87, 0xae, 0x07, 0x9b, 0xf3, 0xe7, 0xe1, 0xcc, 0x50, 0x1d, 0xd2,
-	0x2c, 0x4c, 0x36, 0x49, 0x4f, 0x28, 0x2b, 0x8b, 0xfc, 0x47, 0xe9, 0x31, 0x98, 0xee, 0xe2, 0x56,
-	0x87, 0x08, 0x89, 0x64, 0x51, 0xb0, 0x38, 0x97, 0x78, 0x11, 0xa8, 0xdb, 0x70, 0x32, 0xd6, 0x12,
-	0x5f, 0x51, 0x93, 0x31, 0xab, 0xfb, 0xf2, 0x3c, 0xb4, 0x80, 0x58, 0x0c, 0x1a, 0x08, 0x50, 0xdb,
-	0xf0, 0xd1, 0x8a, 0xe7, 0x75, 0xc8, 0x4b, 0xa4, 0xbb, 0xb1, 0x53, 0x41, 0xc4, 0x73, 0x1d, 0xdb,
-	0x23, 0xd2, 0x3b, 0x70, 0x3c, 0x2c, 0x47, 0xf0, 0x9a, 0x34, 0xce, 0xdf, 0xbc, 0xbd, 0x38, 0xf6,
-	0xcb, 0xed, 0xc5, 0xb3, 0x96, 0xa3, 0xf3, 0x3d, 0xc2, 0xf7, 0xa8, 0x6d, 0x79, 0xba, 0x4d, 0xf8,
-	0x65, 0x87, 0x35, 0x8b, 0x83, 0x5e, 0xea, 0xae, 0x14, 0xdd, 0xa6, 0x55, 0xe4, 0x3d, 0x97, 0x78,
-	0xfa, 0xc6, 0x4e, 0xe5, 0x85, 0xe7, 0x51, 0x26, 0xe8, 0x82, 0xfa, 0x35, 0x80, 0x73, 0x9b, 0x84,
-	0xc7, 0xf9, 0x90, 0x0f, 0x3a, 0xc4, 0xe3, 0xd2, 0xbb, 0x70, 0x26, 0x46, 0xac, 0x7a, 0x1c, 0xaf,
-	0x4d, 0xe3, 0x38, 0xc2, 0x93, 0x56, 0x21, 0xec, 0x8f, 0x9a, 0xfb, 0xda, 0xee, 0x65, 0x1f, 0x72,
-	0x11, 0x7b, 0x4d, 0x94, 0x6d, 0x44, 0x8f, 0xea, 0xad, 0x04, 0x7c, 0xe2, 0x35, 0xea, 0xc5, 0x09,
-	0x7b, 0x11, 0xe3, 0x37, 0x7d, 0xf1, 0xb4, 0x5a, 0xb8, 0xe6, 0x30, 0xcc, 0x1d, 0x16, 0xd2, 0xd5,
-	0x86, 0xe9, 0x6e, 0x33, 0x0b, 0xdb, 0xf4, 0xaa, 0x88, 0xdd, 0x66, 0x3b, 0x1e, 0x61, 0x31, 0xd6,
-	0x68, 0x20, 0xc5, 0x31, 0x98, 0x4a, 0x26, 0x4c, 0x3b, 0xcc, 0x24, 0x4c, 0x4c, 0x85, 0xac, 0xf1,
-	0xfa, 0x81, 0xb1, 0xc5, 0x2a, 0x68, 0x6c, 0xa0, 0x19, 0x55, 0x6a, 0xa2, 0x19, 0x6d, 0x68, 0x43,
-	0x58, 0x17, 0xa5, 0x35, 0xf1, 0x13, 0x9b, 0x4f, 0x28, 0xa7, 0xc5, 0x16, 0x41, 0x72, 0x49, 0x81,
-	0xe9, 0x16, 0x6d, 0x53, 0x2e, 0xfc, 0x3f, 0x25, 0x7a, 0xbe, 0x9c, 0x94, 0xef, 0x8e, 0xa3, 0x60,
-	0x5b, 0x92, 0x60, 0xca, 0xc5, 0x16, 0x11, 0xd6, 0x9f, 0x42, 0xe2, 0x59, 0x92, 0x7d, 0x2d, 0x89,
-	0xf9, 0x21, 0x67, 0xf2, 0xa0, 0x30, 0x81, 0xa2, 0xa5, 0xfa, 0x03, 0x80, 0xf2, 0xba, 0x38, 0xe3,
-	0x10, 0x41, 0x6c, 0xc2, 0x5c, 0x8c, 0x69, 0xd8, 0xdd, 0xa3, 0x94, 0x1d, 0x53, 0x40, 0x3c, 0x52,
-	0xaa, 0x0e, 0xbd, 0xa7, 0xc4, 0xdf, 0x78, 0x4f, 0xc6, 0x64, 0x94, 0xdb, 0xf7, 0xc1, 0xe0, 0x5b,
-	0x53, 0xbf, 0x00, 0x50, 0xde, 0x11, 0x23, 0xf6, 0xdf, 0x2c, 0xe3, 0x18, 0x2a, 0xfe, 0x06, 0xc0,
-	0xa7, 0x86, 0x54, 0x5c, 0x7e, 0xa3, 0xb2, 0x45, 0x7a, 0xde, 0x09, 0xb8, 0xef, 0x9e, 0x64, 0x12,
-	0x47, 0x4b, 0x26, 0xd9, 0x97, 0x8c, 0xfa, 0x29, 0x80, 0x0b, 0x83, 0x63, 0x22, 0xe0, 0x7b, 0x02,
-	0x74, 0xe7, 0x60, 0xa6, 0x49, 0x7a, 0x55, 0x6a, 0x46, 0xc3, 0xb7, 0x49, 0x7a, 0x15, 0x53, 0xfd,
-	0x32, 0x01, 0x95, 0x11, 0xa9, 0x9e, 0x18, 0xa9, 0xe8, 0xab, 0x9b, 0x38, 0xec, 0xab, 0x7b, 0x01,
-	0x66, 0x82, 0x9b, 0x8b, 0x9c, 0xcc, 0x27, 0x0b, 0xd3, 0xa5, 0xb9, 0xe1, 0xe3, 0x90, 0xff, 0xaf,
-	0xf1, 0xc8, 0x81, 0x31, 0x7d, 0x03, 0xe4, 0x26, 0x80, 0x0c, 0xd4, 0xf4, 0x35, 0x71, 0x4c, 0x18,
-	0x27, 0x6d, 0x42, 0x48, 0xae, 0xb8, 0x94, 0x11, 0xcf, 0xbf, 0x15, 0xa4, 0xfe, 0xf2, 0x56, 0xe0,
-	0x9b, 0xe1, 0x5b, 0x90, 0xb8, 0x00, 0x82, 0xdb, 0x41, 0x18, 0x5b, 0xe6, 0xea, 0x1f, 0x00, 0x2a,
-	0x23, 0x4e, 0x38, 0xb1, 0x2e, 0xad, 0xc2, 0x71, 0xec, 0xd2, 0xaa, 0xff, 0x31, 0x0d, 0xec, 0xf1,
-	0xf8, 0x48, 0x4a, 0x41, 0x25, 0x96, 0x22, 0x83, 0x5d, 0xba, 0x45, 0x7a, 0x43, 0xe6, 0x4a, 0x3e,
-	0x8c, 0xb9, 0xbe, 0x03, 0xf0, 0xf4, 0x90, 0xb9, 0xd6, 0x63, 0xd3, 0xe1, 0xff, 0x6a, 0xb1, 0x5b,
-	0x00, 0x9e, 0x1a, 0xb4, 0x58, 0x9c, 0xf5, 0x09, 0x90, 0xde, 0xfd, 0x27, 0xc6, 0x72, 0x3f, 0xfd,
-	0xe0, 0x48, 0xfe, 0x11, 0xc0, 0x53, 0x6f, 0xfd, 0x97, 0xd5, 0xbd, 0x7a, 0x68, 0x75, 0x4f, 0x8e,
-	0xde, 0x2c, 0xfb, 0x98, 0xfb, 0x15, 0x63, 0x5c, 0xfc, 0xf9, 0x37, 0x65, 0xec, 0xe3, 0x7d, 0x05,
-	0x7c, 0xb5, 0xaf, 0x80, 0xbb, 0xfb, 0xca, 0xd8, 0xef, 0xfb, 0x0a, 0xb8, 0x7e, 0x47, 0x19, 0xfb,
-	0xfe, 0x8e, 0x02, 0xde, 0x2b, 0x3e, 0xc4, 0x8d, 0x8c, 0xdb, 0x6e, 0xad, 0x96, 0x11, 0x7a, 0x5e,
-	0xf9, 0x33, 0x00, 0x00, 0xff, 0xff, 0xde, 0x26, 0x4d, 0xf1, 0x37, 0x0e, 0x00, 0x00,
+	0x14, 0xce, 0xd8, 0xb1, 0x1d, 0x8f, 0x9d, 0x1f, 0x2c, 0x14, 0x56, 0x09, 0x6c, 0xdc, 0x6d, 0x54,
+	0x99, 0x80, 0xd7, 0xc8, 0xa1, 0x88, 0x54, 0xaa, 0x52, 0x6f, 0x48, 0x83, 0x09, 0x25, 0x30, 0x10,
+	0x24, 0x88, 0x8a, 0x35, 0xf6, 0x8e, 0x37, 0x23, 0xdb, 0xbb, 0xcb, 0xec, 0xd8, 0xad, 0x8b, 0x90,
+	0x50, 0x8f, 0x5c, 0xa8, 0xca, 0x8d, 0x03, 0x67, 0x2e, 0x70, 0x40, 0xfc, 0x01, 0x1c, 0x38, 0x54,
+	0x9c, 0x38, 0x22, 0x2a, 0x15, 0x91, 0x5e, 0x2a, 0x71, 0x81, 0x6b, 0x4e, 0x68, 0x67, 0x77, 0xeb,
+	0xf5, 0x8f, 0x44, 0x2d, 0x81, 0xc0, 0xc9, 0x3b, 0xe3, 0xef, 0xbd, 0xf9, 0xde, 0xdb, 0xef, 0x7b,
+	0x3b, 0xf0, 0x4c, 0xcb, 0x66, 0xf8, 0x2a, 0xb6, 0x0a, 0x2e, 0xc7, 0xf5, 0x66, 0x11, 0x3b, 0xb4,
+	0x88, 0x1d, 0xa7, 0x45, 0xeb, 0x98, 0x53, 0xdb, 0xd2, 0x1c, 0x66, 0x73, 0x5b, 0x9a, 0xe1, 0xdc,
+	0xd2, 0x02, 0xa0, 0xd6, 0x5d, 0x99, 0x2f, 0x9b, 0x94, 0xef, 0x75, 0x6a, 0x5a, 0xdd, 0x6e, 0x17,
+	0x89, 0xd5, 0xb5, 0x7b, 0x0e, 0xb3, 0xaf, 0xf5, 0x8a, 0x02, 0x5c, 0x2f, 0x98, 0xc4, 0x2a, 0x74,
+	0x71, 0x8b, 0x1a, 0x98, 0x93, 0xe2, 0xc8, 0x83, 0x9f, 0x72, 0xbe, 0x10, 0x49, 0x61, 0xda, 0xa6,
+	0xed, 0x07, 0xd7, 0x3a, 0x0d, 0xb1, 0x12, 0x0b, 0xf1, 0x14, 0xc0, 0x73, 0xa6, 0x6d, 0x9b, 0x2d,
+	0xd2, 0x47, 0x35, 0x28, 0x69, 0x19, 0xd5, 0x36, 0x76, 0x9b, 0x01, 0x62, 0x71, 0x18, 0xc1, 0x69,
+	0x9b, 0xb8, 0x1c, 0xb7, 0x9d, 0x00, 0xb0, 0x34, 0x5a, 0x69, 0xdd, 0xb6, 0x38, 0xae, 0xf3, 0x2a,
+	0xb5, 0x1a, 0xe1, 0x41, 0x63, 0xfa, 0x41, 0x0d, 0x62, 0x71, 0xda, 0xa0, 0x84, 0xb9, 0x01, 0x48,
+	0x19, 0x05, 0x31, 0x6a, 0xee, 0xf1, 0xe0, 0x7f, 0xf5, 0xf3, 0x04, 0xcc, 0x94, 0xfb, 0x5d, 0x94,
+	0x74, 0x18, 0xa7, 0x86, 0x2b, 0x83, 0x1c, 0xc8, 0x67, 0x4a, 0x67, 0xb5, 0xc1, 0x6e, 0x6a, 0x11,
+	0x64, 0xa5, 0x7f, 0x94, 0x3e, 0x75, 0xa0, 0x27, 0x3e, 0x05, 0xb1, 0x39, 0x80, 0xbc, 0x60, 0x69,
+	0x0d, 0xc2, 0x3a, 0x23, 0x98, 0x13, 0xa3, 0x8a, 0xb9, 0x1c, 0x13, 0xa9, 0xe6, 0x35, 0xbf, 0x68,
+	0x2d, 0x2c, 0x5a, 0x7b, 0x27, 0x2c, 0x5a, 0x9f, 0xbc, 0xf9, 0xeb, 0x22, 0x40, 0xe9, 0x20, 0xa6,
+	0xcc, 0xbd, 0x04, 0x1d, 0xc7, 0x08, 0x13, 0xc4, 0x1f, 0x36, 0x41, 0x10, 0xe3, 0x27, 0x30, 0x48,
+	0x8b, 0x04, 0x09, 0xa6, 0x1e, 0x36, 0x41, 0x10, 0x53, 0xe6, 0xd2, 0x02, 0x9c, 0xb4, 0x70, 0x9b,
+	0xc8, 0x93, 0x39, 0x90, 0x4f, 0xeb, 0xa9, 0x03, 0x7d, 0x92, 0xc5, 0xe4, 0x12, 0x12, 0x9b, 0xd2,
+	0x32, 0xcc, 0x18, 0xc4, 0xad, 0x33, 0xea, 0x78, 0x8d, 0x90, 0x13, 0x02, 0xe3, 0xf5, 0x80, 0xc5,
+	0xe5, 0x9f, 0x66, 0x51, 0xf4, 0x4f, 0xe9, 0x06, 0x80, 0x10, 0x73, 0xce, 0x68, 0xad, 0xc3, 0x89,
+	0x2b, 0x27, 0x73, 0xf1, 0x7c, 0xa6, 0xf4, 0xdc, 0x11, 0x7d, 0xd5, 0xca, 0x0f, 0xd0, 0x1b, 0x16,
+	0x67, 0x3d, 0xfd, 0xdc, 0x81, 0x5e, 0xfa, 0x02, 0x14, 0xe7, 0xa0, 0xba, 0xc4, 0x54, 0x79, 0xa9,
+	0xa4, 0x7c, 0xb0, 0x8b, 0x0b, 0xd7, 0x5f, 0x28, 0xac, 0x5e, 0xc9, 0xaf, 0x9d, 0xdf, 0x2d, 0x5c,
+	0x59, 0x0b, 0x97, 0xcf, 0x7e, 0x54, 0x7a, 0xfe, 0xe3, 0xa5, 0x65, 0x8f, 0xc5, 0x6d, 0x80, 0x22,
+	0xa7, 0x4a, 0xaf, 0xc2, 0x6c, 0x54, 0x3f, 0x72, 0x4a, 0xb0, 0x58, 0x18, 0x66, 0xb1, 0xee, 0x63,
+	0x2a, 0x56, 0xc3, 0x16, 0xe5, 0xdc, 0x02, 0xb1, 0x39, 0x88, 0x32, 0xf5, 0xfe, 0xb6, 0x74, 0x16,
+	0xce, 0x1a, 0xa4, 0x5b, 0x25, 0x1d, 0x5a, 0xad, 0xdb, 0x1d, 0x8b, 0x13, 0x26, 0xa7, 0x73, 0x20,
+	0x3f, 0x8d, 0xa6, 0x0d, 0xd2, 0xdd, 0xe8, 0xd0, 0x75, 0x7f, 0x73, 0xfe, 0x02, 0x9c, 0x1d, 0xaa,
+	0x43, 0x9a, 0x83, 0xf1, 0x26, 0xe9, 0x09, 0x65, 0xa5, 0x91, 0xf7, 0x28, 0x3d, 0x01, 0x13, 0x5d,
+	0xdc, 0xea, 0x10, 0x21, 0x91, 0x34, 0xf2, 0x17, 0xe7, 0x63, 0x2f, 0x03, 0x75, 0x1b, 0x66, 0x23,
+	0x2d, 0xf1, 0x14, 0x95, 0x8d, 0x58, 0xdd, 0x93, 0xe7, 0xd8, 0x02, 0x22, 0x31, 0x68, 0x20, 0x40,
+	0x6d, 0xc3, 0xc7, 0x2b, 0xae, 0xdb, 0x21, 0xaf, 0x90, 0xee, 0xc6, 0x4e, 0x05, 0x11, 0xd7, 0xb1,
+	0x2d, 0x97, 0x48, 0xef, 0xc2, 0x54, 0x50, 0x8e, 0xe0, 0x95, 0xd5, 0x2f, 0xdc, 0xbe, 0xbb, 0x38,
+	0xf1, 0xcb, 0xdd, 0xc5, 0x73, 0xa6, 0xad, 0xf1, 0x3d, 0xc2, 0xf7, 0xa8, 0x65, 0xba, 0x9a, 0x45,
+	0xf8, 0x55, 0x9b, 0x35, 0x8b, 0x83, 0x5e, 0xea, 0xae, 0x14, 0x9d, 0xa6, 0x59, 0xe4, 0x3d, 0x87,
+	0xb8, 0xda, 0xc6, 0x4e, 0xe5, 0xa5, 0x17, 0x51, 0xd2, 0xef, 0x82, 0xfa, 0x35, 0x80, 0xa7, 0x36,
+	0x09, 0x8f, 0xf2, 0x21, 0x1f, 0x76, 0x88, 0xcb, 0xa5, 0xf7, 0xe0, 0x6c, 0x84, 0x58, 0xf5, 0x38,
+	0x5e, 0x9b, 0xc1, 0x51, 0x84, 0x2b, 0xad, 0x42, 0xd8, 0x1f, 0x35, 0x87, 0xda, 0xee, 0x92, 0x07,
+	0xb9, 0x8c, 0xdd, 0x26, 0x4a, 0x37, 0xc2, 0x47, 0xf5, 0x4e, 0x0c, 0x3e, 0xf5, 0x3a, 0x75, 0xa3,
+	0x84, 0xdd, 0x90, 0xf1, 0x5b, 0x9e, 0x78, 0x5a, 0x2d, 0x5c, 0xb3, 0x19, 0xe6, 0x36, 0x0b, 0xe8,
+	0x16, 0x86, 0xe9, 0x6e, 0x33, 0x13, 0x5b, 0xf4, 0xba, 0x88, 0xdd, 0x66, 0x3b, 0x2e, 0x61, 0x11,
+	0xd6, 0x68, 0x20, 0xc5, 0x31, 0x98, 0x4a, 0x06, 0x4c, 0xd8, 0xcc, 0x20, 0x4c, 0x4c, 0x85, 0xb4,
+	0xfe, 0xc6, 0x81, 0xbe, 0xc5, 0x2a, 0x68, 0x62, 0xa0, 0x19, 0x55, 0x6a, 0xa0, 0xd9, 0xc2, 0xd0,
+	0x86, 0xb0, 0x2e, 0x4a, 0x14, 0xc4, 0x4f, 0x64, 0x3e, 0xa1, 0x4c, 0x21, 0xb2, 0xf0, 0x93, 0x4b,
+	0x0a, 0x4c, 0xb4, 0x68, 0x9b, 0x72, 0xe1, 0xff, 0x69, 0xd1, 0xf3, 0xe5, 0xb8, 0x7c, 0x3f, 0x85,
+	0xfc, 0x6d, 0x49, 0x82, 0x93, 0x0e, 0x36, 0x89, 0xb0, 0xfe, 0x34, 0x12, 0xcf, 0x92, 0x0c, 0x53,
+	0xc1, 0xfc, 0x90, 0x93, 0x39, 0x90, 0x9f, 0x42, 0xe1, 0x52, 0xfd, 0x01, 0x40, 0x79, 0x5d, 0x9c,
+	0x31, 0x46, 0x10, 0x9b, 0x30, 0x13, 0x61, 0x1a, 0x74, 0xf7, 0x28, 0x65, 0x47, 0x14, 0x10, 0x8d,
+	0x94, 0xaa, 0x43, 0xef, 0x29, 0xf6, 0x37, 0xde, 0x93, 0x9e, 0x0d, 0x73, 0x7b, 0x3e, 0x18, 0x7c,
+	0x6b, 0xea, 0x97, 0x00, 0xca, 0x3b, 0x62, 0xc4, 0xfe, 0x9b, 0x65, 0x1c, 0x43, 0xc5, 0xdf, 0x00,
+	0xf8, 0xcc, 0x90, 0x8a, 0xcb, 0x6f, 0x56, 0xb6, 0x48, 0xcf, 0x3d, 0x01, 0xf7, 0x3d, 0x90, 0x4c,
+	0xec, 0x68, 0xc9, 0xc4, 0xfb, 0x92, 0x51, 0x3f, 0x03, 0x70, 0x61, 0x93, 0x8c, 0xf2, 0x3d, 0x01,
+	0xba, 0xa7, 0x60, 0xb2, 0x49, 0x7a, 0x55, 0x6a, 0x84, 0xc3, 0xb7, 0x49, 0x7a, 0x15, 0x43, 0xfd,
+	0x3d, 0x06, 0x95, 0x11, 0xa9, 0x9e, 0x18, 0xa9, 0xf0, 0xab, 0x1b, 0x1b, 0xf7, 0xd5, 0xbd, 0x08,
+	0x93, 0xfe, 0xcd, 0x45, 0x8e, 0xe7, 0xe2, 0xf9, 0x99, 0xd2, 0xa9, 0xe1, 0xe3, 0x90, 0xf7, 0xaf,
+	0xfe, 0xd8, 0x81, 0x3e, 0x73, 0x0b, 0x64, 0xa6, 0x80, 0x0c, 0xd4, 0xc4, 0x0d, 0x71, 0x4c, 0x10,
+	0x27, 0x6d, 0x42, 0x48, 0xae, 0x39, 0x94, 0x11, 0xb7, 0x8a, 0x7d, 0x6b, 0x1f, 0x7d, 0x2b, 0xf0,
+	0xcc, 0xf0, 0x2d, 0x88, 0x5d, 0x04, 0xfe, 0xed, 0x20, 0x88, 0x2d, 0x73, 0xe9, 0x12, 0xcc, 0x32,
+	0xe2, 0x72, 0x46, 0xeb, 0xfe, 0xe7, 0x28, 0x21, 0x52, 0xa9, 0x23, 0xf5, 0x07, 0x7d, 0xeb, 0x23,
+	0xd1, 0x40, 0x9c, 0xfa, 0x27, 0x80, 0xca, 0x88, 0xa3, 0x4e, 0xac, 0xdb, 0xab, 0x30, 0x85, 0x1d,
+	0x5a, 0xf5, 0x3e, 0xca, 0xbe, 0xcd, 0x9e, 0x1c, 0x5f, 0x40, 0x24, 0x45, 0x12, 0x3b, 0x74, 0x8b,
+	0xf4, 0x86, 0x4c, 0x1a, 0x7f, 0x14, 0x93, 0x7e, 0x07, 0xe0, 0x99, 0x21, 0x93, 0xae, 0x47, 0xa6,
+	0xcc, 0xff, 0xd5, 0xaa, 0x77, 0x00, 0x3c, 0xbd, 0x49, 0x0e, 0x63, 0x7d, 0x02, 0xa4, 0x77, 0xff,
+	0x89, 0xf1, 0xde, 0x4f, 0x3f, 0x38, 0xda, 0x7f, 0x04, 0xf0, 0xf4, 0xdb, 0xff, 0x65, 0x75, 0xaf,
+	0x8d, 0xad, 0xee, 0xe9, 0xd1, 0x1b, 0x6a, 0x1f, 0x73, 0x58, 0x31, 0xfa, 0xe5, 0x9f, 0x7f, 0x53,
+	0x26, 0x3e, 0xd9, 0x57, 0xc0, 0x57, 0xfb, 0x0a, 0xb8, 0xbf, 0xaf, 0x4c, 0xfc, 0xb1, 0xaf, 0x80,
+	0x9b, 0xf7, 0x94, 0x89, 0xef, 0xef, 0x29, 0xe0, 0xfd, 0xe2, 0x23, 0xdc, 0xec, 0xb8, 0xe5, 0xd4,
+	0x6a, 0x49, 0xa1, 0xe7, 0x95, 0xbf, 0x06, 0x00, 0xbf, 0x68, 0x39, 0xff, 0x7f, 0x0e, 0x00, 0x00,
 }
 
 func (this *Application) Equal(that interface{}) bool {
@@ -1236,6 +1245,9 @@ func (this *CreateApplicationAPIKeyRequest) Equal(that interface{}) bool {
 	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
 		return false
 	}
+	if !this.Restrictions.Equal(that1.Restrictions) {
+		return false
+	}
 	return true
 }
 func (this *UpdateApplicationAPIKeyRequest) Equal(that interface{}) bool {
@@ -1490,6 +1502,7 @@ func (this *CreateApplicationAPIKeyRequest) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
 		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Restrictions:` + strings.Replace(fmt.Sprintf("%v", this.Restrictions), "APIKeyRestrictions", "APIKeyRestrictions", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -144,6 +144,11 @@ var CreateApplicationAPIKeyRequestFieldPathsNested = []string{
 	"application_ids.application_id",
 	"expires_at",
 	"name",
+	"restrictions",
+	"restrictions.end_device_attributes",
+	"restrictions.end_device_ids",
+	"restrictions.methods",
+	"restrictions.source_cidrs",
 	"rights",
 }
 
@@ -151,6 +156,7 @@ var CreateApplicationAPIKeyRequestFieldPathsTopLevel = []string{
 	"application_ids",
 	"expires_at",
 	"name",
+	"restrictions",
 	"rights",
 }
 var UpdateApplicationAPIKeyRequestFieldPathsNested = []string{
@@ -160,6 +166,11 @@ var UpdateApplicationAPIKeyRequestFieldPathsNested = []string{
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
+	"api_key.restrictions",
+	"api_key.restrictions.end_device_attributes",
+	"api_key.restrictions.end_device_ids",
+	"api_key.restrictions.methods",
+	"api_key.restrictions.source_cidrs",
 	"api_key.rights",
 	"api_key.updated_at",
 	"application_ids",
@@ -1209,6 +1209,18 @@ func (m *CreateApplicationAPIKeyRequest) ValidateFields(paths ...string) error {
 
 			}
 
+		case "restrictions":
+
+			if v, ok := interface{}(m.GetRestrictions()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateApplicationAPIKeyRequestValidationError{
+						field:  "restrictions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return CreateApplicationAPIKeyRequestValidationError{
 				field:  name,
@@ -346,6 +346,12 @@ func (x *CreateApplicationAPIKeyRequest) MarshalProtoJSON(s *jsonplugin.MarshalS
 			s.WriteTime(*x.ExpiresAt)
 		}
 	}
+	if x.Restrictions != nil || s.HasField("restrictions") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("restrictions")
+		// NOTE: APIKeyRestrictions does not seem to implement MarshalProtoJSON.
+		gogo.MarshalMessage(s, x.Restrictions)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -381,6 +387,12 @@ func (x *CreateApplicationAPIKeyRequest) UnmarshalProtoJSON(s *jsonplugin.Unmars
 				return
 			}
 			x.ExpiresAt = v
+		case "restrictions":
+			s.AddField("restrictions")
+			// NOTE: APIKeyRestrictions does not seem to implement UnmarshalProtoJSON.
+			var v APIKeyRestrictions
+			gogo.UnmarshalMessage(s, &v)
+			x.Restrictions = &v
 		}
 	})
 }
@@ -63,6 +63,46 @@ func (AsConfiguration_PubSub_Providers_Status) EnumDescriptor() ([]byte, []int)
 	return fileDescriptor_df9d75a19dc066e1, []int{4, 0, 0, 0}
 }
 
+type ApplicationDownlinkStatus_State int32
+
+const (
+	// The downlink message is in the downlink queue of the Network Server.
+	ApplicationDownlinkStatus_QUEUED ApplicationDownlinkStatus_State = 0
+	// The downlink message has been sent to the end device.
+	ApplicationDownlinkStatus_SENT ApplicationDownlinkStatus_State = 1
+	// The end device acknowledged the confirmed downlink message.
+	ApplicationDownlinkStatus_ACKED ApplicationDownlinkStatus_State = 2
+	// The end device did not acknowledge the confirmed downlink message.
+	// The Application Server pushes nacked downlink messages to the downlink queue again.
+	ApplicationDownlinkStatus_NACKED ApplicationDownlinkStatus_State = 3
+	// The downlink message could not be queued or sent.
+	ApplicationDownlinkStatus_FAILED ApplicationDownlinkStatus_State = 4
+	// The absolute time of the downlink message or the deferred downlink passed before it could be sent.
+	ApplicationDownlinkStatus_EXPIRED ApplicationDownlinkStatus_State = 5
+)
+
+var ApplicationDownlinkStatus_State_name = map[int32]string{
+	0: "QUEUED",
+	1: "SENT",
+	2: "ACKED",
+	3: "NACKED",
+	4: "FAILED",
+	5: "EXPIRED",
+}
+
+var ApplicationDownlinkStatus_State_value = map[string]int32{
+	"QUEUED":  0,
+	"SENT":    1,
+	"ACKED":   2,
+	"NACKED":  3,
+	"FAILED":  4,
+	"EXPIRED": 5,
+}
+
+func (ApplicationDownlinkStatus_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{14, 0}
+}
+
 type ApplicationLink struct {
 	// Default message payload formatters to use when there are no formatters
 	// defined on the end device level.
@@ -819,321 +859,1161 @@ func (m *DecodeDownlinkResponse) GetDownlink() *ApplicationDownlink {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status", AsConfiguration_PubSub_Providers_Status_name, AsConfiguration_PubSub_Providers_Status_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status", AsConfiguration_PubSub_Providers_Status_name, AsConfiguration_PubSub_Providers_Status_value)
-	proto.RegisterType((*ApplicationLink)(nil), "ttn.lorawan.v3.ApplicationLink")
-	golang_proto.RegisterType((*ApplicationLink)(nil), "ttn.lorawan.v3.ApplicationLink")
-	proto.RegisterType((*GetApplicationLinkRequest)(nil), "ttn.lorawan.v3.GetApplicationLinkRequest")
-	golang_proto.RegisterType((*GetApplicationLinkRequest)(nil), "ttn.lorawan.v3.GetApplicationLinkRequest")
-	proto.RegisterType((*SetApplicationLinkRequest)(nil), "ttn.lorawan.v3.SetApplicationLinkRequest")
-	golang_proto.RegisterType((*SetApplicationLinkRequest)(nil), "ttn.lorawan.v3.SetApplicationLinkRequest")
-	proto.RegisterType((*ApplicationLinkStats)(nil), "ttn.lorawan.v3.ApplicationLinkStats")
-	golang_proto.RegisterType((*ApplicationLinkStats)(nil), "ttn.lorawan.v3.ApplicationLinkStats")
-	proto.RegisterType((*AsConfiguration)(nil), "ttn.lorawan.v3.AsConfiguration")
-	golang_proto.RegisterType((*AsConfiguration)(nil), "ttn.lorawan.v3.AsConfiguration")
-	proto.RegisterType((*AsConfiguration_PubSub)(nil), "ttn.lorawan.v3.AsConfiguration.PubSub")
-	golang_proto.RegisterType((*AsConfiguration_PubSub)(nil), "ttn.lorawan.v3.AsConfiguration.PubSub")
-	proto.RegisterType((*AsConfiguration_PubSub_Providers)(nil), "ttn.lorawan.v3.AsConfiguration.PubSub.Providers")
-	golang_proto.RegisterType((*AsConfiguration_PubSub_Providers)(nil), "ttn.lorawan.v3.AsConfiguration.PubSub.Providers")
-	proto.RegisterType((*GetAsConfigurationRequest)(nil), "ttn.lorawan.v3.GetAsConfigurationRequest")
-	golang_proto.RegisterType((*GetAsConfigurationRequest)(nil), "ttn.lorawan.v3.GetAsConfigurationRequest")
-	proto.RegisterType((*GetAsConfigurationResponse)(nil), "ttn.lorawan.v3.GetAsConfigurationResponse")
-	golang_proto.RegisterType((*GetAsConfigurationResponse)(nil), "ttn.lorawan.v3.GetAsConfigurationResponse")
-	proto.RegisterType((*NsAsHandleUplinkRequest)(nil), "ttn.lorawan.v3.NsAsHandleUplinkRequest")
-	golang_proto.RegisterType((*NsAsHandleUplinkRequest)(nil), "ttn.lorawan.v3.NsAsHandleUplinkRequest")
-	proto.RegisterType((*EncodeDownlinkRequest)(nil), "ttn.lorawan.v3.EncodeDownlinkRequest")
-	golang_proto.RegisterType((*EncodeDownlinkRequest)(nil), "ttn.lorawan.v3.EncodeDownlinkRequest")
-	proto.RegisterType((*EncodeDownlinkResponse)(nil), "ttn.lorawan.v3.EncodeDownlinkResponse")
-	golang_proto.RegisterType((*EncodeDownlinkResponse)(nil), "ttn.lorawan.v3.EncodeDownlinkResponse")
-	proto.RegisterType((*DecodeUplinkRequest)(nil), "ttn.lorawan.v3.DecodeUplinkRequest")
-	golang_proto.RegisterType((*DecodeUplinkRequest)(nil), "ttn.lorawan.v3.DecodeUplinkRequest")
-	proto.RegisterType((*DecodeUplinkResponse)(nil), "ttn.lorawan.v3.DecodeUplinkResponse")
-	golang_proto.RegisterType((*DecodeUplinkResponse)(nil), "ttn.lorawan.v3.DecodeUplinkResponse")
-	proto.RegisterType((*DecodeDownlinkRequest)(nil), "ttn.lorawan.v3.DecodeDownlinkRequest")
-	golang_proto.RegisterType((*DecodeDownlinkRequest)(nil), "ttn.lorawan.v3.DecodeDownlinkRequest")
-	proto.RegisterType((*DecodeDownlinkResponse)(nil), "ttn.lorawan.v3.DecodeDownlinkResponse")
-	golang_proto.RegisterType((*DecodeDownlinkResponse)(nil), "ttn.lorawan.v3.DecodeDownlinkResponse")
+// ApplicationDownlinkStatus is the delivery status of an application downlink message.
+type ApplicationDownlinkStatus struct {
+	// The correlation ID that the Application Server assigned to the downlink message.
+	CorrelationId string               `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	EndDeviceIds  EndDeviceIdentifiers `protobuf:"bytes,2,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids"`
+	FPort         uint32               `protobuf:"varint,3,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	FCnt          uint32               `protobuf:"varint,4,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	Confirmed     bool                 `protobuf:"varint,5,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// The current delivery state of the downlink message.
+	State ApplicationDownlinkStatus_State `protobuf:"varint,6,opt,name=state,proto3,enum=ttn.lorawan.v3.ApplicationDownlinkStatus_State" json:"state,omitempty"`
+	// The error of the current delivery state, if any.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// The delivery state transitions of the downlink message, oldest first.
+	History              []*ApplicationDownlinkStatus_Transition `protobuf:"bytes,8,rep,name=history,proto3" json:"history,omitempty"`
+	CreatedAt            time.Time                               `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	UpdatedAt            time.Time                               `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("lorawan-stack/api/applicationserver.proto", fileDescriptor_df9d75a19dc066e1)
+func (m *ApplicationDownlinkStatus) Reset()      { *m = ApplicationDownlinkStatus{} }
+func (*ApplicationDownlinkStatus) ProtoMessage() {}
+func (*ApplicationDownlinkStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{14}
 }
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/applicationserver.proto", fileDescriptor_df9d75a19dc066e1)
+func (m *ApplicationDownlinkStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationDownlinkStatus.Unmarshal(m, b)
+}
+func (m *ApplicationDownlinkStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationDownlinkStatus.Marshal(b, m, deterministic)
+}
+func (m *ApplicationDownlinkStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDownlinkStatus.Merge(m, src)
+}
+func (m *ApplicationDownlinkStatus) XXX_Size() int {
+	return xxx_messageInfo_ApplicationDownlinkStatus.Size(m)
+}
+func (m *ApplicationDownlinkStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDownlinkStatus.DiscardUnknown(m)
 }
 
-var fileDescriptor_df9d75a19dc066e1 = []byte{
-	// 1865 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xf6, 0x90, 0x94, 0x4c, 0x8e, 0x65, 0x99, 0x1e, 0x39, 0x8e, 0xc4, 0xa4, 0xb2, 0xba, 0x71,
-	0x5c, 0x49, 0x0d, 0x77, 0x5d, 0xba, 0x3f, 0xb1, 0x8a, 0x46, 0x58, 0xfd, 0x58, 0x96, 0x2a, 0x29,
-	0xf2, 0x52, 0x8e, 0x11, 0x27, 0x0e, 0xb1, 0xe4, 0x8e, 0xa8, 0x2d, 0xc9, 0xdd, 0xc9, 0xce, 0x2c,
-	0x15, 0xf9, 0x07, 0x28, 0x82, 0xa0, 0x05, 0x72, 0x68, 0x83, 0xb4, 0x01, 0x7a, 0xef, 0xa5, 0xe8,
-	0xad, 0xcd, 0xa1, 0xb7, 0x22, 0x40, 0x51, 0xa0, 0x40, 0x4f, 0x41, 0x2e, 0x05, 0x7a, 0x28, 0xea,
-	0xb4, 0x45, 0xd1, 0x02, 0x45, 0xce, 0x39, 0x15, 0x33, 0xbb, 0x4b, 0xee, 0x0f, 0x29, 0x51, 0x8e,
-	0x21, 0xa3, 0x40, 0x6f, 0xb3, 0x3b, 0xef, 0x7d, 0xf3, 0x7d, 0xef, 0xbd, 0x79, 0xfb, 0x44, 0xc1,
-	0x99, 0xa6, 0xed, 0xe8, 0x7b, 0xba, 0x55, 0xa4, 0x4c, 0xaf, 0x35, 0x14, 0x9d, 0x98, 0x8a, 0x4e,
-	0x48, 0xd3, 0xac, 0xe9, 0xcc, 0xb4, 0x2d, 0x8a, 0x9d, 0x36, 0x76, 0x64, 0xe2, 0xd8, 0xcc, 0x46,
-	0xa3, 0x8c, 0x59, 0xb2, 0x6f, 0x2e, 0xb7, 0xaf, 0x14, 0xd4, 0xba, 0xc9, 0x76, 0xdd, 0xaa, 0x5c,
-	0xb3, 0x5b, 0x0a, 0xb6, 0xda, 0xf6, 0x3e, 0x71, 0xec, 0xb7, 0xf6, 0x15, 0x61, 0x5c, 0x2b, 0xd6,
-	0xb1, 0x55, 0x6c, 0xeb, 0x4d, 0xd3, 0xd0, 0x19, 0x56, 0x12, 0x0b, 0x0f, 0xb2, 0x50, 0x0c, 0x41,
-	0xd4, 0xed, 0xba, 0xed, 0x39, 0x57, 0xdd, 0x1d, 0xf1, 0x24, 0x1e, 0xc4, 0xca, 0x37, 0x5f, 0x0c,
-	0x99, 0x6f, 0xef, 0xe2, 0xed, 0x5d, 0xd3, 0xaa, 0xd3, 0x55, 0xcb, 0x70, 0x29, 0x73, 0x4c, 0x4c,
-	0xc3, 0x47, 0xd7, 0xed, 0xe2, 0xf7, 0xa8, 0x6d, 0x29, 0xba, 0x65, 0xd9, 0xcc, 0xd3, 0xe2, 0x83,
-	0x3c, 0x5b, 0xb7, 0xed, 0x7a, 0x13, 0x7b, 0x52, 0x13, 0xbb, 0xcf, 0xf8, 0xbb, 0x1d, 0x22, 0xb8,
-	0x45, 0xd8, 0xbe, 0xbf, 0x39, 0x15, 0xdf, 0xdc, 0x31, 0x71, 0xd3, 0xa8, 0xb4, 0x74, 0xda, 0xf0,
-	0x2d, 0x2e, 0xc4, 0x2d, 0x98, 0xd9, 0xc2, 0x94, 0xe9, 0x2d, 0xe2, 0x1b, 0x4c, 0xc6, 0x0d, 0xf6,
-	0x1c, 0x9d, 0x10, 0xec, 0x04, 0xe7, 0x4b, 0xc9, 0x7c, 0x60, 0xcb, 0xa8, 0x18, 0xb8, 0x6d, 0xd6,
-	0x82, 0xa8, 0x3d, 0x97, 0xb4, 0x31, 0x0d, 0x6c, 0x31, 0x73, 0xc7, 0xec, 0x02, 0x4d, 0x25, 0x8d,
-	0x5a, 0x98, 0x52, 0xbd, 0x8e, 0x3b, 0x81, 0xe8, 0x61, 0xf1, 0x26, 0x63, 0xde, 0xae, 0xf4, 0x07,
-	0x00, 0xcf, 0xa8, 0xdd, 0x4a, 0x58, 0x37, 0xad, 0x06, 0xba, 0x05, 0x91, 0x81, 0x77, 0x74, 0xb7,
-	0xc9, 0x2a, 0x3b, 0xb6, 0xd3, 0xd2, 0x19, 0xc3, 0x0e, 0x1d, 0x4f, 0x4f, 0x81, 0xe9, 0x53, 0xa5,
-	0x69, 0x39, 0x5a, 0x1e, 0xf2, 0x86, 0x77, 0xda, 0x96, 0xbe, 0xdf, 0xb4, 0x75, 0xe3, 0x5a, 0xc7,
-	0x5e, 0x3b, 0xeb, 0x63, 0x74, 0x5f, 0xa1, 0x35, 0x38, 0x46, 0x1b, 0x26, 0xa9, 0x10, 0xcf, 0xb8,
-	0x52, 0x73, 0xf6, 0x09, 0xb3, 0xc7, 0x87, 0x04, 0x72, 0x41, 0xf6, 0x62, 0x26, 0x07, 0x31, 0x93,
-	0x17, 0x6c, 0xbb, 0xf9, 0x8a, 0xde, 0x74, 0xb1, 0x76, 0x96, 0xbb, 0xf9, 0x47, 0x2c, 0x0a, 0xa7,
-	0xb5, 0x4c, 0x16, 0xe4, 0x53, 0x6b, 0x99, 0x6c, 0x2a, 0x9f, 0x5e, 0xcb, 0x64, 0x33, 0xf9, 0x21,
-	0xe9, 0x57, 0x00, 0x4e, 0xac, 0x60, 0x16, 0x53, 0xa3, 0xe1, 0x37, 0x5d, 0x4c, 0x19, 0x7a, 0x15,
-	0x9e, 0x09, 0x55, 0x7c, 0xc5, 0x34, 0xe8, 0x38, 0x10, 0xe7, 0x5e, 0x8a, 0x2b, 0x0a, 0x01, 0xac,
-	0x76, 0xe3, 0xbd, 0x90, 0xfd, 0x7c, 0x61, 0xe8, 0x5d, 0x90, 0xca, 0x03, 0x6d, 0x54, 0x0f, 0x5b,
-	0x50, 0x74, 0x15, 0xc2, 0x6e, 0x85, 0x8c, 0xa7, 0xfa, 0xa8, 0xb9, 0xc6, 0x4d, 0x36, 0x74, 0xda,
-	0xd0, 0x72, 0x3b, 0xc1, 0x52, 0xfa, 0x0c, 0xc0, 0x89, 0xf2, 0x93, 0xe0, 0xfc, 0x1d, 0x98, 0x69,
-	0x9a, 0x56, 0xc0, 0xf6, 0xc2, 0x01, 0x78, 0x9c, 0x50, 0x08, 0x48, 0xb8, 0xc5, 0x24, 0xa7, 0x8f,
-	0x22, 0xf9, 0xc7, 0x19, 0x78, 0x2e, 0x06, 0x5f, 0x66, 0x3a, 0xe3, 0x94, 0x72, 0x1c, 0x1b, 0x1b,
-	0x15, 0x9d, 0xf9, 0x3a, 0x93, 0x90, 0xdb, 0xc1, 0x45, 0x5b, 0xc8, 0xbc, 0xf7, 0x97, 0x0b, 0x40,
-	0xcb, 0x7a, 0x2e, 0x2a, 0x43, 0xbf, 0x07, 0xf0, 0xbc, 0x85, 0xd9, 0x9e, 0xed, 0x34, 0x2a, 0x5e,
-	0x43, 0xab, 0xe8, 0x86, 0xe1, 0x60, 0x4a, 0x85, 0xc8, 0xdc, 0xc2, 0x8f, 0xc0, 0xe7, 0x0b, 0xef,
-	0x02, 0xe7, 0x87, 0xa0, 0xf4, 0x0e, 0x78, 0x63, 0x7a, 0x7e, 0x6e, 0x7a, 0x7e, 0xee, 0x35, 0xbd,
-	0x78, 0x57, 0x2d, 0xde, 0xbe, 0x5c, 0xbc, 0x7a, 0xe7, 0x7e, 0x68, 0xdd, 0x5d, 0xbe, 0x5e, 0xbc,
-	0x33, 0x1b, 0xda, 0x98, 0x79, 0x5d, 0x9e, 0x99, 0xe5, 0x7e, 0x6a, 0xf1, 0xb6, 0x5e, 0xbc, 0xeb,
-	0xf9, 0x75, 0xd7, 0xdd, 0xa5, 0xf0, 0xeb, 0x6e, 0xcc, 0x4c, 0xcf, 0xcf, 0xcd, 0xbd, 0xc6, 0x57,
-	0xf7, 0xbe, 0xf6, 0xc2, 0x37, 0x1e, 0xcc, 0xcc, 0x5f, 0xbc, 0xff, 0xc6, 0x45, 0xed, 0x9c, 0x4f,
-	0xb7, 0x2c, 0xd8, 0xaa, 0x1e, 0x59, 0xf4, 0x32, 0x1c, 0x6b, 0xea, 0x94, 0x55, 0x5c, 0x52, 0x71,
-	0x70, 0x0d, 0x9b, 0x6d, 0x2f, 0x20, 0xe9, 0x01, 0x03, 0x92, 0xe7, 0xce, 0x37, 0x89, 0xe6, 0xbb,
-	0xaa, 0x0c, 0x4d, 0xc0, 0xac, 0x4b, 0x2a, 0x35, 0xdb, 0xb5, 0xd8, 0x78, 0x66, 0x0a, 0x4c, 0x67,
-	0xb4, 0x93, 0x2e, 0x59, 0xe4, 0x8f, 0xe8, 0x0e, 0x2c, 0x88, 0xb3, 0x0c, 0x7b, 0xcf, 0xe2, 0x81,
-	0xe4, 0xf7, 0x7d, 0x4f, 0x77, 0x0c, 0xef, 0xc8, 0xa1, 0x01, 0x8f, 0x7c, 0x9a, 0x63, 0x2c, 0xf9,
-	0x10, 0xd7, 0x02, 0x04, 0x95, 0xa1, 0xe7, 0xe1, 0x68, 0x07, 0xd9, 0x3b, 0x7f, 0x58, 0x9c, 0x7f,
-	0x3a, 0x78, 0x2b, 0x58, 0x48, 0x3f, 0x4d, 0xc3, 0x33, 0x2a, 0x5d, 0xb4, 0xad, 0x1d, 0xb3, 0xee,
-	0x3a, 0xa2, 0x2a, 0xd0, 0x4b, 0x70, 0x98, 0xb8, 0x55, 0xea, 0x56, 0xfb, 0x56, 0x7c, 0xd4, 0x41,
-	0xde, 0x72, 0xab, 0x65, 0xb7, 0xaa, 0xf9, 0x5e, 0x85, 0x8f, 0x52, 0x70, 0xd8, 0x7b, 0x85, 0x36,
-	0x61, 0x8e, 0x38, 0x76, 0xdb, 0x34, 0x78, 0x17, 0xf3, 0xd0, 0x2e, 0x0f, 0x86, 0x26, 0x6f, 0x05,
-	0x7e, 0x5a, 0x17, 0xa2, 0xf0, 0x77, 0x00, 0x73, 0x9d, 0x0d, 0xf4, 0x5d, 0x98, 0xe1, 0xed, 0x54,
-	0x00, 0x8f, 0x96, 0xbe, 0x75, 0x54, 0x60, 0x99, 0xd7, 0xbe, 0x4b, 0x35, 0x01, 0xc2, 0xc1, 0x2c,
-	0x9d, 0x79, 0x05, 0xfb, 0x45, 0xc0, 0x38, 0x88, 0xf4, 0x22, 0x1c, 0xf6, 0x9e, 0xd1, 0x29, 0x78,
-	0x72, 0x79, 0x53, 0x5d, 0x58, 0x5f, 0x5e, 0xca, 0x9f, 0xe0, 0x0f, 0xb7, 0x54, 0x6d, 0x73, 0x75,
-	0x73, 0x25, 0x0f, 0xd0, 0x08, 0xcc, 0x2e, 0xad, 0x96, 0xbd, 0xad, 0x54, 0x61, 0xf8, 0x5f, 0xbf,
-	0x9c, 0x48, 0x8d, 0x83, 0xb5, 0x4c, 0x36, 0x9d, 0xcf, 0x48, 0xcf, 0x78, 0xed, 0x34, 0x7a, 0xa6,
-	0xdf, 0x9a, 0xa4, 0x1a, 0x2c, 0xf4, 0xda, 0xa4, 0x84, 0x4f, 0x13, 0x68, 0x19, 0x9e, 0xae, 0x85,
-	0x37, 0xfc, 0xb0, 0x5f, 0x38, 0x44, 0x90, 0x16, 0xf5, 0x92, 0x1a, 0xf0, 0xe9, 0x4d, 0xaa, 0xd2,
-	0xeb, 0xba, 0x65, 0x34, 0xf1, 0x4d, 0xd2, 0x0c, 0xb5, 0xc6, 0xad, 0x68, 0x6b, 0x74, 0x09, 0x4f,
-	0x6d, 0x7a, 0xfa, 0x54, 0xe9, 0x4b, 0x07, 0xb4, 0xb2, 0x9b, 0x44, 0x34, 0xb2, 0xf7, 0x41, 0x2a,
-	0x1b, 0xed, 0x88, 0x37, 0x09, 0x95, 0xfe, 0x93, 0x82, 0x4f, 0x2d, 0x5b, 0x35, 0xdb, 0xc0, 0x41,
-	0x29, 0x07, 0x67, 0x6d, 0xc3, 0xd1, 0xee, 0xc7, 0x39, 0xd4, 0x85, 0x2f, 0xc6, 0x8f, 0x5a, 0xb6,
-	0x8c, 0x25, 0x61, 0xd4, 0xbb, 0x07, 0x8f, 0xe0, 0xee, 0x3e, 0x45, 0xeb, 0xf0, 0x54, 0x1b, 0x3b,
-	0x34, 0x68, 0xec, 0x5e, 0x23, 0xfe, 0x6a, 0x5f, 0xc8, 0x57, 0x3c, 0xdb, 0x10, 0xb2, 0x06, 0xdb,
-	0xc1, 0x3b, 0x8a, 0x56, 0x61, 0x36, 0xb8, 0x54, 0x7e, 0xab, 0x78, 0xee, 0x80, 0x40, 0x04, 0x0a,
-	0x43, 0xe4, 0x3a, 0xee, 0xe8, 0x3a, 0xcc, 0x75, 0x3e, 0xfb, 0xa2, 0x61, 0x8c, 0x96, 0xa6, 0xe2,
-	0x58, 0xf1, 0xcf, 0xbd, 0x00, 0x7a, 0x5b, 0x00, 0x75, 0x9d, 0xd1, 0xb3, 0x30, 0x47, 0x74, 0x47,
-	0x6f, 0x61, 0x8e, 0xc4, 0xbb, 0x49, 0x4e, 0xeb, 0xbe, 0x90, 0x5e, 0x85, 0xe7, 0xe3, 0xf1, 0xf6,
-	0xcb, 0x67, 0x3e, 0x24, 0x06, 0x0c, 0x2c, 0xa6, 0x2b, 0x41, 0xfa, 0x47, 0x0a, 0x8e, 0x2d, 0x61,
-	0x8e, 0x1d, 0xad, 0x9a, 0xff, 0x85, 0x4c, 0x2e, 0xc2, 0x61, 0x97, 0x84, 0xf2, 0xf8, 0xe5, 0x03,
-	0x0b, 0x3a, 0x96, 0x45, 0xdf, 0xf5, 0xd8, 0x72, 0x78, 0x03, 0x9e, 0x8b, 0xc6, 0xd9, 0xcf, 0xe0,
-	0xd5, 0x8e, 0x08, 0x30, 0xa0, 0x88, 0x80, 0xba, 0xb8, 0x87, 0x1e, 0xe6, 0xff, 0xef, 0xe1, 0x71,
-	0xdd, 0xc3, 0x78, 0xbc, 0x1f, 0xd3, 0x3d, 0x2c, 0x7d, 0x3c, 0x04, 0x53, 0x2a, 0x45, 0x1f, 0x00,
-	0x78, 0x72, 0x05, 0x33, 0xf1, 0xc7, 0xc5, 0x4c, 0x1c, 0xa1, 0xef, 0xc8, 0x5e, 0x38, 0x6c, 0x2a,
-	0x95, 0x5e, 0x7a, 0xfb, 0x93, 0xbf, 0xfd, 0x24, 0xf5, 0x22, 0xfa, 0xa6, 0xa2, 0xd3, 0xc8, 0xdf,
-	0xb3, 0xca, 0xbd, 0xd8, 0xdc, 0x2c, 0x47, 0x9f, 0x1f, 0x28, 0x22, 0xc2, 0x3f, 0x03, 0xf0, 0x64,
-	0xb9, 0x1f, 0xaf, 0xf2, 0xa3, 0xf3, 0x52, 0x05, 0xaf, 0x6f, 0x17, 0x1e, 0x91, 0xd7, 0x1c, 0x98,
-	0x45, 0xf7, 0x21, 0x5c, 0xc2, 0x4d, 0xcc, 0xb0, 0x20, 0x37, 0xe0, 0xbc, 0x5f, 0x38, 0x9f, 0x98,
-	0xd5, 0x96, 0xf9, 0xdf, 0xb5, 0x92, 0x2c, 0x08, 0x4d, 0xcf, 0x5e, 0x3a, 0x8c, 0x90, 0x1f, 0x98,
-	0xf7, 0x01, 0x1c, 0xf1, 0x13, 0xe6, 0xcd, 0xe6, 0x83, 0x12, 0xb8, 0x78, 0x48, 0x68, 0x04, 0x9a,
-	0xf4, 0x75, 0x41, 0x47, 0x46, 0x2f, 0x0c, 0x46, 0x47, 0xa1, 0x82, 0xc3, 0x3b, 0x00, 0xe6, 0x57,
-	0x30, 0x8b, 0xce, 0x89, 0x3d, 0xcb, 0xa9, 0xe7, 0xc8, 0x52, 0x98, 0x1d, 0xc4, 0xd4, 0xab, 0x7c,
-	0x69, 0x42, 0x30, 0x1c, 0x43, 0x67, 0x39, 0xc3, 0xc8, 0x50, 0x52, 0xba, 0x05, 0x33, 0x7c, 0x28,
-	0x41, 0x2f, 0xc3, 0x91, 0xf0, 0x60, 0x82, 0xbe, 0x12, 0x87, 0xef, 0x33, 0xba, 0xf4, 0x4b, 0x52,
-	0xe9, 0xc3, 0xd3, 0x70, 0x48, 0x25, 0x44, 0xa5, 0x68, 0x1b, 0xe6, 0xca, 0x6e, 0x95, 0xd6, 0x1c,
-	0xb3, 0x8a, 0x07, 0x0e, 0xfd, 0xc1, 0x83, 0xcf, 0x65, 0x80, 0xfe, 0x08, 0xe0, 0xd9, 0xe0, 0x8e,
-	0xde, 0x70, 0xb1, 0x8b, 0xb7, 0x5c, 0xba, 0x8b, 0x12, 0x19, 0x8b, 0x98, 0x1c, 0xc2, 0x59, 0x7a,
-	0x4b, 0xc4, 0xc9, 0x91, 0x5a, 0xc9, 0x4c, 0x46, 0x5b, 0xb5, 0x7c, 0x58, 0xe1, 0x7b, 0xa6, 0x49,
-	0xbf, 0xce, 0xf2, 0x81, 0xc2, 0xdb, 0x8a, 0x42, 0x5c, 0xba, 0xcb, 0x2f, 0xc8, 0xc7, 0x00, 0x9e,
-	0x8b, 0x51, 0x25, 0x4d, 0xbd, 0x86, 0xbf, 0xa0, 0xa0, 0x7b, 0x42, 0x90, 0x2b, 0x91, 0x63, 0x13,
-	0xe4, 0x78, 0xbc, 0xb9, 0xa6, 0x0f, 0xe3, 0x19, 0x5a, 0x37, 0x29, 0x43, 0x03, 0x7d, 0xde, 0x0e,
-	0xbc, 0x79, 0x01, 0x26, 0x95, 0x34, 0x21, 0x6f, 0x1d, 0xad, 0x1d, 0xbd, 0x33, 0x75, 0xf4, 0xc4,
-	0x04, 0xa0, 0x9f, 0x03, 0xf8, 0xd4, 0x0a, 0x66, 0x1b, 0x37, 0xb6, 0xb7, 0x17, 0x6d, 0xcb, 0xc2,
-	0x35, 0x51, 0x99, 0xd6, 0x8e, 0x3d, 0x70, 0xe9, 0x4a, 0x89, 0x1f, 0x95, 0x12, 0x58, 0x83, 0xf7,
-	0xfa, 0x07, 0xe2, 0xe7, 0xad, 0x62, 0xad, 0xe3, 0x5e, 0x34, 0x39, 0x97, 0xdf, 0x01, 0x38, 0x5a,
-	0x36, 0x5b, 0x6e, 0x53, 0x67, 0xc1, 0x8d, 0x3d, 0xf8, 0xc6, 0xf4, 0x2d, 0x91, 0xbb, 0x82, 0x09,
-	0x93, 0xec, 0xe3, 0x28, 0x11, 0x97, 0x28, 0xd4, 0x67, 0xcd, 0x2b, 0xe4, 0xcf, 0x00, 0x8e, 0x46,
-	0x87, 0x66, 0xf4, 0x7c, 0xb2, 0x3c, 0x7a, 0x0c, 0x4f, 0x85, 0x4b, 0x87, 0x99, 0xf9, 0x9d, 0xef,
-	0x58, 0xd5, 0x89, 0x0b, 0x80, 0x05, 0x11, 0xae, 0xee, 0x13, 0x00, 0x47, 0xc2, 0xe3, 0x24, 0x4a,
-	0x8c, 0x1b, 0x3d, 0x86, 0xfa, 0x64, 0xe5, 0xf7, 0x9a, 0x48, 0x8f, 0xb7, 0x53, 0xb9, 0x44, 0x31,
-	0x70, 0xa0, 0x8a, 0xe7, 0x2c, 0x3a, 0x60, 0x25, 0x73, 0xd6, 0x73, 0xe0, 0x4d, 0xe6, 0xac, 0xf7,
-	0x9c, 0xf6, 0x04, 0x72, 0xd6, 0x51, 0x57, 0xfa, 0x77, 0x06, 0x8e, 0xa9, 0xb4, 0xd3, 0x92, 0x34,
-	0x5c, 0x37, 0x29, 0x73, 0xf6, 0xd1, 0xaf, 0x01, 0x4c, 0xaf, 0x60, 0x96, 0x4c, 0xe1, 0x0a, 0x66,
-	0x21, 0x6b, 0x4f, 0xe8, 0x44, 0xdf, 0x16, 0x27, 0x35, 0x84, 0x36, 0x8c, 0x6a, 0xc7, 0xa0, 0x0d,
-	0xfd, 0x20, 0x05, 0xd3, 0xe5, 0x5e, 0xa4, 0xcb, 0x47, 0x23, 0xfd, 0x5b, 0x20, 0x58, 0xff, 0x06,
-	0x14, 0x0e, 0xa4, 0x2d, 0x3f, 0x22, 0x6d, 0x39, 0x4a, 0x7b, 0x0e, 0xcc, 0xde, 0xde, 0x90, 0xae,
-	0x3f, 0xae, 0x93, 0x78, 0xcd, 0x7e, 0x00, 0xe0, 0xb0, 0x37, 0x7f, 0x0e, 0xf8, 0xf9, 0xe9, 0xd7,
-	0x2c, 0x37, 0x44, 0x20, 0x56, 0x66, 0x97, 0x1f, 0xcb, 0x07, 0x67, 0x61, 0xe3, 0x4f, 0x7f, 0x9d,
-	0x3c, 0xf1, 0xfd, 0x87, 0x93, 0xe0, 0x17, 0x0f, 0x27, 0xc1, 0x3f, 0x1f, 0x4e, 0x9e, 0xf8, 0xec,
-	0xe1, 0x24, 0x78, 0xef, 0xd3, 0xc9, 0x13, 0x1f, 0x7d, 0x3a, 0x09, 0x6e, 0x2b, 0x75, 0x5b, 0x66,
-	0xbb, 0x98, 0x89, 0x7f, 0x18, 0xc9, 0xfe, 0xaf, 0xab, 0x4a, 0xf4, 0xff, 0x1f, 0xed, 0x2b, 0x0a,
-	0x69, 0xd4, 0x15, 0xc6, 0x2c, 0x52, 0xad, 0x0e, 0x0b, 0xb6, 0x57, 0xfe, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x0b, 0x5c, 0x5e, 0xbd, 0x1f, 0x1b, 0x00, 0x00,
+var xxx_messageInfo_ApplicationDownlinkStatus proto.InternalMessageInfo
+
+func (m *ApplicationDownlinkStatus) GetCorrelationId() string {
+	if m != nil {
+		return m.CorrelationId
+	}
+	return ""
 }
 
-func (x AsConfiguration_PubSub_Providers_Status) String() string {
-	s, ok := AsConfiguration_PubSub_Providers_Status_name[int32(x)]
-	if ok {
-		return s
+func (m *ApplicationDownlinkStatus) GetEndDeviceIds() EndDeviceIdentifiers {
+	if m != nil {
+		return m.EndDeviceIds
 	}
-	return strconv.Itoa(int(x))
+	return EndDeviceIdentifiers{}
 }
-func (this *ApplicationLink) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ApplicationDownlinkStatus) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
 	}
+	return 0
+}
 
-	that1, ok := that.(*ApplicationLink)
-	if !ok {
-		that2, ok := that.(ApplicationLink)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ApplicationDownlinkStatus) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *ApplicationDownlinkStatus) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
 	}
-	if !this.DefaultFormatters.Equal(that1.DefaultFormatters) {
-		return false
+	return false
+}
+
+func (m *ApplicationDownlinkStatus) GetState() ApplicationDownlinkStatus_State {
+	if m != nil {
+		return m.State
 	}
-	if !this.SkipPayloadCrypto.Equal(that1.SkipPayloadCrypto) {
-		return false
+	return ApplicationDownlinkStatus_QUEUED
+}
+
+func (m *ApplicationDownlinkStatus) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return true
+	return ""
 }
-func (this *GetApplicationLinkRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ApplicationDownlinkStatus) GetHistory() []*ApplicationDownlinkStatus_Transition {
+	if m != nil {
+		return m.History
 	}
+	return nil
+}
 
-	that1, ok := that.(*GetApplicationLinkRequest)
-	if !ok {
-		that2, ok := that.(GetApplicationLinkRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ApplicationDownlinkStatus) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return time.Time{}
+}
+
+func (m *ApplicationDownlinkStatus) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	if !this.ApplicationIds.Equal(that1.ApplicationIds) {
-		return false
+	return time.Time{}
+}
+
+type ApplicationDownlinkStatus_Transition struct {
+	State                ApplicationDownlinkStatus_State `protobuf:"varint,1,opt,name=state,proto3,enum=ttn.lorawan.v3.ApplicationDownlinkStatus_State" json:"state,omitempty"`
+	Time                 time.Time                       `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	Error                string                          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *ApplicationDownlinkStatus_Transition) Reset()      { *m = ApplicationDownlinkStatus_Transition{} }
+func (*ApplicationDownlinkStatus_Transition) ProtoMessage() {}
+func (*ApplicationDownlinkStatus_Transition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{14, 0}
+}
+func (m *ApplicationDownlinkStatus_Transition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationDownlinkStatus_Transition.Unmarshal(m, b)
+}
+func (m *ApplicationDownlinkStatus_Transition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationDownlinkStatus_Transition.Marshal(b, m, deterministic)
+}
+func (m *ApplicationDownlinkStatus_Transition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDownlinkStatus_Transition.Merge(m, src)
+}
+func (m *ApplicationDownlinkStatus_Transition) XXX_Size() int {
+	return xxx_messageInfo_ApplicationDownlinkStatus_Transition.Size(m)
+}
+func (m *ApplicationDownlinkStatus_Transition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDownlinkStatus_Transition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDownlinkStatus_Transition proto.InternalMessageInfo
+
+func (m *ApplicationDownlinkStatus_Transition) GetState() ApplicationDownlinkStatus_State {
+	if m != nil {
+		return m.State
 	}
-	if !this.FieldMask.Equal(that1.FieldMask) {
-		return false
+	return ApplicationDownlinkStatus_QUEUED
+}
+
+func (m *ApplicationDownlinkStatus_Transition) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	return true
+	return time.Time{}
 }
-func (this *SetApplicationLinkRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ApplicationDownlinkStatus_Transition) GetError() string {
+	if m != nil {
+		return m.Error
 	}
+	return ""
+}
 
-	that1, ok := that.(*SetApplicationLinkRequest)
-	if !ok {
-		that2, ok := that.(SetApplicationLinkRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+type ApplicationDownlinkStatuses struct {
+	Statuses             []*ApplicationDownlinkStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ApplicationDownlinkStatuses) Reset()      { *m = ApplicationDownlinkStatuses{} }
+func (*ApplicationDownlinkStatuses) ProtoMessage() {}
+func (*ApplicationDownlinkStatuses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{15}
+}
+func (m *ApplicationDownlinkStatuses) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationDownlinkStatuses.Unmarshal(m, b)
+}
+func (m *ApplicationDownlinkStatuses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationDownlinkStatuses.Marshal(b, m, deterministic)
+}
+func (m *ApplicationDownlinkStatuses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDownlinkStatuses.Merge(m, src)
+}
+func (m *ApplicationDownlinkStatuses) XXX_Size() int {
+	return xxx_messageInfo_ApplicationDownlinkStatuses.Size(m)
+}
+func (m *ApplicationDownlinkStatuses) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDownlinkStatuses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDownlinkStatuses proto.InternalMessageInfo
+
+func (m *ApplicationDownlinkStatuses) GetStatuses() []*ApplicationDownlinkStatus {
+	if m != nil {
+		return m.Statuses
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+type GetApplicationDownlinkStatusRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	CorrelationId        string   `protobuf:"bytes,2,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetApplicationDownlinkStatusRequest) Reset()      { *m = GetApplicationDownlinkStatusRequest{} }
+func (*GetApplicationDownlinkStatusRequest) ProtoMessage() {}
+func (*GetApplicationDownlinkStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{16}
+}
+func (m *GetApplicationDownlinkStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetApplicationDownlinkStatusRequest.Unmarshal(m, b)
+}
+func (m *GetApplicationDownlinkStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetApplicationDownlinkStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GetApplicationDownlinkStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApplicationDownlinkStatusRequest.Merge(m, src)
+}
+func (m *GetApplicationDownlinkStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetApplicationDownlinkStatusRequest.Size(m)
+}
+func (m *GetApplicationDownlinkStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApplicationDownlinkStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetApplicationDownlinkStatusRequest proto.InternalMessageInfo
+
+func (m *GetApplicationDownlinkStatusRequest) GetCorrelationId() string {
+	if m != nil {
+		return m.CorrelationId
 	}
-	if !this.ApplicationIds.Equal(that1.ApplicationIds) {
-		return false
+	return ""
+}
+
+type ListApplicationDownlinkStatusesRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	// Limit the number of results per page.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Page number for pagination. 0 is interpreted as 1.
+	Page                 uint32   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListApplicationDownlinkStatusesRequest) Reset() {
+	*m = ListApplicationDownlinkStatusesRequest{}
+}
+func (*ListApplicationDownlinkStatusesRequest) ProtoMessage() {}
+func (*ListApplicationDownlinkStatusesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{17}
+}
+func (m *ListApplicationDownlinkStatusesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApplicationDownlinkStatusesRequest.Unmarshal(m, b)
+}
+func (m *ListApplicationDownlinkStatusesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApplicationDownlinkStatusesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListApplicationDownlinkStatusesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationDownlinkStatusesRequest.Merge(m, src)
+}
+func (m *ListApplicationDownlinkStatusesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListApplicationDownlinkStatusesRequest.Size(m)
+}
+func (m *ListApplicationDownlinkStatusesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationDownlinkStatusesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApplicationDownlinkStatusesRequest proto.InternalMessageInfo
+
+func (m *ListApplicationDownlinkStatusesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	if !this.Link.Equal(that1.Link) {
-		return false
+	return 0
+}
+
+func (m *ListApplicationDownlinkStatusesRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
 	}
-	if !this.FieldMask.Equal(that1.FieldMask) {
-		return false
+	return 0
+}
+
+// ApplicationDeferredDownlink is a set of application downlink messages that the Application Server pushes to the
+// downlink queue of the end device once not_before has passed.
+type ApplicationDeferredDownlink struct {
+	// The ID that the Application Server assigned to the deferred downlink.
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EndDeviceIds EndDeviceIdentifiers   `protobuf:"bytes,2,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids"`
+	Downlinks    []*ApplicationDownlink `protobuf:"bytes,3,rep,name=downlinks,proto3" json:"downlinks,omitempty"`
+	// The time after which the downlink messages are pushed to the downlink queue.
+	NotBefore time.Time `protobuf:"bytes,4,opt,name=not_before,json=notBefore,proto3,stdtime" json:"not_before"`
+	// The time after which the downlink messages are dropped, if they could not be pushed before.
+	ExpiresAt *time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	CreatedAt time.Time  `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// The number of failed attempts to push the downlink messages.
+	Attempts             uint32   `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationDeferredDownlink) Reset()      { *m = ApplicationDeferredDownlink{} }
+func (*ApplicationDeferredDownlink) ProtoMessage() {}
+func (*ApplicationDeferredDownlink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{18}
+}
+func (m *ApplicationDeferredDownlink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationDeferredDownlink.Unmarshal(m, b)
+}
+func (m *ApplicationDeferredDownlink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationDeferredDownlink.Marshal(b, m, deterministic)
+}
+func (m *ApplicationDeferredDownlink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDeferredDownlink.Merge(m, src)
+}
+func (m *ApplicationDeferredDownlink) XXX_Size() int {
+	return xxx_messageInfo_ApplicationDeferredDownlink.Size(m)
+}
+func (m *ApplicationDeferredDownlink) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDeferredDownlink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDeferredDownlink proto.InternalMessageInfo
+
+func (m *ApplicationDeferredDownlink) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return true
+	return ""
 }
-func (this *ApplicationLinkStats) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ApplicationDeferredDownlink) GetEndDeviceIds() EndDeviceIdentifiers {
+	if m != nil {
+		return m.EndDeviceIds
 	}
+	return EndDeviceIdentifiers{}
+}
 
-	that1, ok := that.(*ApplicationLinkStats)
-	if !ok {
-		that2, ok := that.(ApplicationLinkStats)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ApplicationDeferredDownlink) GetDownlinks() []*ApplicationDownlink {
+	if m != nil {
+		return m.Downlinks
+	}
+	return nil
+}
+
+func (m *ApplicationDeferredDownlink) GetNotBefore() time.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return time.Time{}
+}
+
+func (m *ApplicationDeferredDownlink) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *ApplicationDeferredDownlink) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *ApplicationDeferredDownlink) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+type ApplicationDeferredDownlinks struct {
+	DeferredDownlinks    []*ApplicationDeferredDownlink `protobuf:"bytes,1,rep,name=deferred_downlinks,json=deferredDownlinks,proto3" json:"deferred_downlinks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *ApplicationDeferredDownlinks) Reset()      { *m = ApplicationDeferredDownlinks{} }
+func (*ApplicationDeferredDownlinks) ProtoMessage() {}
+func (*ApplicationDeferredDownlinks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{19}
+}
+func (m *ApplicationDeferredDownlinks) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationDeferredDownlinks.Unmarshal(m, b)
+}
+func (m *ApplicationDeferredDownlinks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationDeferredDownlinks.Marshal(b, m, deterministic)
+}
+func (m *ApplicationDeferredDownlinks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDeferredDownlinks.Merge(m, src)
+}
+func (m *ApplicationDeferredDownlinks) XXX_Size() int {
+	return xxx_messageInfo_ApplicationDeferredDownlinks.Size(m)
+}
+func (m *ApplicationDeferredDownlinks) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDeferredDownlinks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDeferredDownlinks proto.InternalMessageInfo
+
+func (m *ApplicationDeferredDownlinks) GetDeferredDownlinks() []*ApplicationDeferredDownlink {
+	if m != nil {
+		return m.DeferredDownlinks
+	}
+	return nil
+}
+
+type AddApplicationDeferredDownlinkRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	Downlinks            []*ApplicationDownlink `protobuf:"bytes,2,rep,name=downlinks,proto3" json:"downlinks,omitempty"`
+	NotBefore            time.Time              `protobuf:"bytes,3,opt,name=not_before,json=notBefore,proto3,stdtime" json:"not_before"`
+	ExpiresAt            *time.Time             `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *AddApplicationDeferredDownlinkRequest) Reset()      { *m = AddApplicationDeferredDownlinkRequest{} }
+func (*AddApplicationDeferredDownlinkRequest) ProtoMessage() {}
+func (*AddApplicationDeferredDownlinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{20}
+}
+func (m *AddApplicationDeferredDownlinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddApplicationDeferredDownlinkRequest.Unmarshal(m, b)
+}
+func (m *AddApplicationDeferredDownlinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddApplicationDeferredDownlinkRequest.Marshal(b, m, deterministic)
+}
+func (m *AddApplicationDeferredDownlinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddApplicationDeferredDownlinkRequest.Merge(m, src)
+}
+func (m *AddApplicationDeferredDownlinkRequest) XXX_Size() int {
+	return xxx_messageInfo_AddApplicationDeferredDownlinkRequest.Size(m)
+}
+func (m *AddApplicationDeferredDownlinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddApplicationDeferredDownlinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddApplicationDeferredDownlinkRequest proto.InternalMessageInfo
+
+func (m *AddApplicationDeferredDownlinkRequest) GetDownlinks() []*ApplicationDownlink {
+	if m != nil {
+		return m.Downlinks
+	}
+	return nil
+}
+
+func (m *AddApplicationDeferredDownlinkRequest) GetNotBefore() time.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return time.Time{}
+}
+
+func (m *AddApplicationDeferredDownlinkRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+type ListApplicationDeferredDownlinksRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	// Limit the number of results per page.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Page number for pagination. 0 is interpreted as 1.
+	Page                 uint32   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListApplicationDeferredDownlinksRequest) Reset() {
+	*m = ListApplicationDeferredDownlinksRequest{}
+}
+func (*ListApplicationDeferredDownlinksRequest) ProtoMessage() {}
+func (*ListApplicationDeferredDownlinksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{21}
+}
+func (m *ListApplicationDeferredDownlinksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApplicationDeferredDownlinksRequest.Unmarshal(m, b)
+}
+func (m *ListApplicationDeferredDownlinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApplicationDeferredDownlinksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListApplicationDeferredDownlinksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationDeferredDownlinksRequest.Merge(m, src)
+}
+func (m *ListApplicationDeferredDownlinksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListApplicationDeferredDownlinksRequest.Size(m)
+}
+func (m *ListApplicationDeferredDownlinksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationDeferredDownlinksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApplicationDeferredDownlinksRequest proto.InternalMessageInfo
+
+func (m *ListApplicationDeferredDownlinksRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListApplicationDeferredDownlinksRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+type DeleteApplicationDeferredDownlinkRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteApplicationDeferredDownlinkRequest) Reset() {
+	*m = DeleteApplicationDeferredDownlinkRequest{}
+}
+func (*DeleteApplicationDeferredDownlinkRequest) ProtoMessage() {}
+func (*DeleteApplicationDeferredDownlinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{22}
+}
+func (m *DeleteApplicationDeferredDownlinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteApplicationDeferredDownlinkRequest.Unmarshal(m, b)
+}
+func (m *DeleteApplicationDeferredDownlinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteApplicationDeferredDownlinkRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteApplicationDeferredDownlinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteApplicationDeferredDownlinkRequest.Merge(m, src)
+}
+func (m *DeleteApplicationDeferredDownlinkRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteApplicationDeferredDownlinkRequest.Size(m)
+}
+func (m *DeleteApplicationDeferredDownlinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteApplicationDeferredDownlinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteApplicationDeferredDownlinkRequest proto.InternalMessageInfo
+
+func (m *DeleteApplicationDeferredDownlinkRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status", AsConfiguration_PubSub_Providers_Status_name, AsConfiguration_PubSub_Providers_Status_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status", AsConfiguration_PubSub_Providers_Status_name, AsConfiguration_PubSub_Providers_Status_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ApplicationDownlinkStatus_State", ApplicationDownlinkStatus_State_name, ApplicationDownlinkStatus_State_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationDownlinkStatus_State", ApplicationDownlinkStatus_State_name, ApplicationDownlinkStatus_State_value)
+	proto.RegisterType((*ApplicationLink)(nil), "ttn.lorawan.v3.ApplicationLink")
+	golang_proto.RegisterType((*ApplicationLink)(nil), "ttn.lorawan.v3.ApplicationLink")
+	proto.RegisterType((*GetApplicationLinkRequest)(nil), "ttn.lorawan.v3.GetApplicationLinkRequest")
+	golang_proto.RegisterType((*GetApplicationLinkRequest)(nil), "ttn.lorawan.v3.GetApplicationLinkRequest")
+	proto.RegisterType((*SetApplicationLinkRequest)(nil), "ttn.lorawan.v3.SetApplicationLinkRequest")
+	golang_proto.RegisterType((*SetApplicationLinkRequest)(nil), "ttn.lorawan.v3.SetApplicationLinkRequest")
+	proto.RegisterType((*ApplicationLinkStats)(nil), "ttn.lorawan.v3.ApplicationLinkStats")
+	golang_proto.RegisterType((*ApplicationLinkStats)(nil), "ttn.lorawan.v3.ApplicationLinkStats")
+	proto.RegisterType((*AsConfiguration)(nil), "ttn.lorawan.v3.AsConfiguration")
+	golang_proto.RegisterType((*AsConfiguration)(nil), "ttn.lorawan.v3.AsConfiguration")
+	proto.RegisterType((*AsConfiguration_PubSub)(nil), "ttn.lorawan.v3.AsConfiguration.PubSub")
+	golang_proto.RegisterType((*AsConfiguration_PubSub)(nil), "ttn.lorawan.v3.AsConfiguration.PubSub")
+	proto.RegisterType((*AsConfiguration_PubSub_Providers)(nil), "ttn.lorawan.v3.AsConfiguration.PubSub.Providers")
+	golang_proto.RegisterType((*AsConfiguration_PubSub_Providers)(nil), "ttn.lorawan.v3.AsConfiguration.PubSub.Providers")
+	proto.RegisterType((*GetAsConfigurationRequest)(nil), "ttn.lorawan.v3.GetAsConfigurationRequest")
+	golang_proto.RegisterType((*GetAsConfigurationRequest)(nil), "ttn.lorawan.v3.GetAsConfigurationRequest")
+	proto.RegisterType((*GetAsConfigurationResponse)(nil), "ttn.lorawan.v3.GetAsConfigurationResponse")
+	golang_proto.RegisterType((*GetAsConfigurationResponse)(nil), "ttn.lorawan.v3.GetAsConfigurationResponse")
+	proto.RegisterType((*NsAsHandleUplinkRequest)(nil), "ttn.lorawan.v3.NsAsHandleUplinkRequest")
+	golang_proto.RegisterType((*NsAsHandleUplinkRequest)(nil), "ttn.lorawan.v3.NsAsHandleUplinkRequest")
+	proto.RegisterType((*EncodeDownlinkRequest)(nil), "ttn.lorawan.v3.EncodeDownlinkRequest")
+	golang_proto.RegisterType((*EncodeDownlinkRequest)(nil), "ttn.lorawan.v3.EncodeDownlinkRequest")
+	proto.RegisterType((*EncodeDownlinkResponse)(nil), "ttn.lorawan.v3.EncodeDownlinkResponse")
+	golang_proto.RegisterType((*EncodeDownlinkResponse)(nil), "ttn.lorawan.v3.EncodeDownlinkResponse")
+	proto.RegisterType((*DecodeUplinkRequest)(nil), "ttn.lorawan.v3.DecodeUplinkRequest")
+	golang_proto.RegisterType((*DecodeUplinkRequest)(nil), "ttn.lorawan.v3.DecodeUplinkRequest")
+	proto.RegisterType((*DecodeUplinkResponse)(nil), "ttn.lorawan.v3.DecodeUplinkResponse")
+	golang_proto.RegisterType((*DecodeUplinkResponse)(nil), "ttn.lorawan.v3.DecodeUplinkResponse")
+	proto.RegisterType((*DecodeDownlinkRequest)(nil), "ttn.lorawan.v3.DecodeDownlinkRequest")
+	golang_proto.RegisterType((*DecodeDownlinkRequest)(nil), "ttn.lorawan.v3.DecodeDownlinkRequest")
+	proto.RegisterType((*DecodeDownlinkResponse)(nil), "ttn.lorawan.v3.DecodeDownlinkResponse")
+	golang_proto.RegisterType((*DecodeDownlinkResponse)(nil), "ttn.lorawan.v3.DecodeDownlinkResponse")
+	proto.RegisterType((*ApplicationDownlinkStatus)(nil), "ttn.lorawan.v3.ApplicationDownlinkStatus")
+	golang_proto.RegisterType((*ApplicationDownlinkStatus)(nil), "ttn.lorawan.v3.ApplicationDownlinkStatus")
+	proto.RegisterType((*ApplicationDownlinkStatus_Transition)(nil), "ttn.lorawan.v3.ApplicationDownlinkStatus.Transition")
+	golang_proto.RegisterType((*ApplicationDownlinkStatus_Transition)(nil), "ttn.lorawan.v3.ApplicationDownlinkStatus.Transition")
+	proto.RegisterType((*ApplicationDownlinkStatuses)(nil), "ttn.lorawan.v3.ApplicationDownlinkStatuses")
+	golang_proto.RegisterType((*ApplicationDownlinkStatuses)(nil), "ttn.lorawan.v3.ApplicationDownlinkStatuses")
+	proto.RegisterType((*GetApplicationDownlinkStatusRequest)(nil), "ttn.lorawan.v3.GetApplicationDownlinkStatusRequest")
+	golang_proto.RegisterType((*GetApplicationDownlinkStatusRequest)(nil), "ttn.lorawan.v3.GetApplicationDownlinkStatusRequest")
+	proto.RegisterType((*ListApplicationDownlinkStatusesRequest)(nil), "ttn.lorawan.v3.ListApplicationDownlinkStatusesRequest")
+	golang_proto.RegisterType((*ListApplicationDownlinkStatusesRequest)(nil), "ttn.lorawan.v3.ListApplicationDownlinkStatusesRequest")
+	proto.RegisterType((*ApplicationDeferredDownlink)(nil), "ttn.lorawan.v3.ApplicationDeferredDownlink")
+	golang_proto.RegisterType((*ApplicationDeferredDownlink)(nil), "ttn.lorawan.v3.ApplicationDeferredDownlink")
+	proto.RegisterType((*ApplicationDeferredDownlinks)(nil), "ttn.lorawan.v3.ApplicationDeferredDownlinks")
+	golang_proto.RegisterType((*ApplicationDeferredDownlinks)(nil), "ttn.lorawan.v3.ApplicationDeferredDownlinks")
+	proto.RegisterType((*AddApplicationDeferredDownlinkRequest)(nil), "ttn.lorawan.v3.AddApplicationDeferredDownlinkRequest")
+	golang_proto.RegisterType((*AddApplicationDeferredDownlinkRequest)(nil), "ttn.lorawan.v3.AddApplicationDeferredDownlinkRequest")
+	proto.RegisterType((*ListApplicationDeferredDownlinksRequest)(nil), "ttn.lorawan.v3.ListApplicationDeferredDownlinksRequest")
+	golang_proto.RegisterType((*ListApplicationDeferredDownlinksRequest)(nil), "ttn.lorawan.v3.ListApplicationDeferredDownlinksRequest")
+	proto.RegisterType((*DeleteApplicationDeferredDownlinkRequest)(nil), "ttn.lorawan.v3.DeleteApplicationDeferredDownlinkRequest")
+	golang_proto.RegisterType((*DeleteApplicationDeferredDownlinkRequest)(nil), "ttn.lorawan.v3.DeleteApplicationDeferredDownlinkRequest")
+}
+
+func init() {
+	proto.RegisterFile("lorawan-stack/api/applicationserver.proto", fileDescriptor_df9d75a19dc066e1)
+}
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/applicationserver.proto", fileDescriptor_df9d75a19dc066e1)
+}
+
+var fileDescriptor_df9d75a19dc066e1 = []byte{
+	// 2627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x5b, 0x6c, 0x1c, 0x57,
+	0x19, 0xce, 0xd9, 0x9b, 0x77, 0xff, 0xc4, 0xee, 0xe6, 0x38, 0x49, 0xd7, 0xdb, 0xe2, 0x84, 0x69,
+	0x9a, 0x3a, 0x6e, 0x77, 0x37, 0x38, 0xbd, 0xa4, 0x41, 0x34, 0x9a, 0xb5, 0x1d, 0xd7, 0x69, 0xec,
+	0x3a, 0x63, 0xbb, 0x97, 0xf4, 0xb2, 0x1a, 0xef, 0x9c, 0x5d, 0x0f, 0x5e, 0xcf, 0x4c, 0xe6, 0x9c,
+	0x71, 0xe2, 0x5c, 0x50, 0x55, 0x2a, 0x90, 0xfa, 0x00, 0x55, 0x69, 0x25, 0xde, 0xfb, 0x82, 0x90,
+	0x78, 0x80, 0x0a, 0x55, 0x02, 0x09, 0x55, 0x42, 0xa0, 0x4a, 0xbc, 0x50, 0xf5, 0x05, 0x81, 0x54,
+	0x44, 0x0a, 0xa8, 0x2a, 0x12, 0xea, 0x03, 0x12, 0x22, 0x2f, 0xa0, 0x73, 0x66, 0x66, 0x2f, 0x33,
+	0xde, 0xf5, 0x38, 0x35, 0xdb, 0x22, 0xf1, 0x76, 0x66, 0xce, 0xff, 0x7f, 0xe7, 0xbf, 0x9d, 0xef,
+	0x9c, 0xf9, 0x77, 0xe1, 0x78, 0xc3, 0xb4, 0xd5, 0xcb, 0xaa, 0x51, 0xa0, 0x4c, 0xad, 0xae, 0x95,
+	0x54, 0x4b, 0x2f, 0xa9, 0x96, 0xd5, 0xd0, 0xab, 0x2a, 0xd3, 0x4d, 0x83, 0x12, 0x7b, 0x83, 0xd8,
+	0x45, 0xcb, 0x36, 0x99, 0x89, 0x87, 0x18, 0x33, 0x8a, 0x9e, 0x78, 0x71, 0xe3, 0x64, 0x5e, 0xae,
+	0xeb, 0x6c, 0xd5, 0x59, 0x29, 0x56, 0xcd, 0xf5, 0x12, 0x31, 0x36, 0xcc, 0x4d, 0xcb, 0x36, 0xaf,
+	0x6c, 0x96, 0x84, 0x70, 0xb5, 0x50, 0x27, 0x46, 0x61, 0x43, 0x6d, 0xe8, 0x9a, 0xca, 0x48, 0x29,
+	0x34, 0x70, 0x21, 0xf3, 0x85, 0x36, 0x88, 0xba, 0x59, 0x37, 0x5d, 0xe5, 0x15, 0xa7, 0x26, 0x9e,
+	0xc4, 0x83, 0x18, 0x79, 0xe2, 0x93, 0x6d, 0xe2, 0x4b, 0xab, 0x64, 0x69, 0x55, 0x37, 0xea, 0x74,
+	0xd6, 0xd0, 0x1c, 0xca, 0x6c, 0x9d, 0xd0, 0xf6, 0xa5, 0xeb, 0x66, 0xe1, 0xeb, 0xd4, 0x34, 0x4a,
+	0xaa, 0x61, 0x98, 0xcc, 0xf5, 0xc5, 0x03, 0xb9, 0xbb, 0x6e, 0x9a, 0xf5, 0x06, 0x71, 0x5d, 0x0d,
+	0xcd, 0xde, 0xe5, 0xcd, 0x36, 0x0d, 0x21, 0xeb, 0x16, 0xdb, 0xf4, 0x26, 0x8f, 0x04, 0x27, 0x6b,
+	0x3a, 0x69, 0x68, 0x95, 0x75, 0x95, 0xae, 0x79, 0x12, 0x87, 0x83, 0x12, 0x4c, 0x5f, 0x27, 0x94,
+	0xa9, 0xeb, 0x96, 0x27, 0x30, 0x1a, 0x14, 0xb8, 0x6c, 0xab, 0x96, 0x45, 0x6c, 0x7f, 0x7d, 0x29,
+	0x9c, 0x0f, 0x62, 0x68, 0x15, 0x8d, 0x6c, 0xe8, 0x55, 0x3f, 0x6a, 0xf7, 0x84, 0x65, 0x74, 0x8d,
+	0x18, 0x4c, 0xaf, 0xe9, 0x2d, 0xa0, 0x23, 0x61, 0xa1, 0x75, 0x42, 0xa9, 0x5a, 0x27, 0xcd, 0x40,
+	0x6c, 0x21, 0x71, 0x89, 0x31, 0x77, 0x56, 0x7a, 0x0f, 0xc1, 0x1d, 0x72, 0xab, 0x12, 0xce, 0xeb,
+	0xc6, 0x1a, 0x7e, 0x1a, 0xb0, 0x46, 0x6a, 0xaa, 0xd3, 0x60, 0x95, 0x9a, 0x69, 0xaf, 0xab, 0x8c,
+	0x11, 0x9b, 0xe6, 0xe2, 0x47, 0xd0, 0xd8, 0xde, 0x89, 0xb1, 0x62, 0x67, 0x79, 0x14, 0xe7, 0xdc,
+	0xd5, 0x16, 0xd4, 0xcd, 0x86, 0xa9, 0x6a, 0x67, 0x9b, 0xf2, 0xca, 0x7e, 0x0f, 0xa3, 0xf5, 0x0a,
+	0x9f, 0x83, 0x61, 0xba, 0xa6, 0x5b, 0x15, 0xcb, 0x15, 0xae, 0x54, 0xed, 0x4d, 0x8b, 0x99, 0xb9,
+	0xa4, 0x40, 0xce, 0x17, 0xdd, 0x98, 0x15, 0xfd, 0x98, 0x15, 0xcb, 0xa6, 0xd9, 0x78, 0x4a, 0x6d,
+	0x38, 0x44, 0xd9, 0xcf, 0xd5, 0xbc, 0x25, 0x26, 0x85, 0xd2, 0xb9, 0x44, 0x1a, 0x65, 0x63, 0xe7,
+	0x12, 0xe9, 0x58, 0x36, 0x7e, 0x2e, 0x91, 0x4e, 0x64, 0x93, 0xd2, 0x8f, 0x11, 0x8c, 0xcc, 0x10,
+	0x16, 0xf0, 0x46, 0x21, 0x97, 0x1c, 0x42, 0x19, 0x7e, 0x16, 0xee, 0x68, 0xab, 0xf8, 0x8a, 0xae,
+	0xd1, 0x1c, 0x12, 0xeb, 0x1e, 0x0b, 0x7a, 0xd4, 0x06, 0x30, 0xdb, 0x8a, 0x77, 0x39, 0x7d, 0xab,
+	0x9c, 0x7c, 0x15, 0xc5, 0xb2, 0x48, 0x19, 0x52, 0xdb, 0x25, 0x28, 0x7e, 0x14, 0xa0, 0x55, 0x21,
+	0xb9, 0x58, 0x17, 0x6f, 0xce, 0x72, 0x91, 0x39, 0x95, 0xae, 0x29, 0x99, 0x9a, 0x3f, 0x94, 0x3e,
+	0x45, 0x30, 0xb2, 0xf8, 0x79, 0xd8, 0xfc, 0x35, 0x48, 0x34, 0x74, 0xc3, 0xb7, 0xf6, 0x70, 0x0f,
+	0x3c, 0x6e, 0x50, 0x1b, 0x90, 0x50, 0x0b, 0xb8, 0x1c, 0xdf, 0x89, 0xcb, 0xdf, 0x4d, 0xc0, 0x81,
+	0x00, 0xfc, 0x22, 0x53, 0x19, 0x37, 0x29, 0xc3, 0xb1, 0x89, 0x56, 0x51, 0x59, 0x0e, 0x75, 0x81,
+	0x5c, 0xf2, 0x37, 0x5a, 0x39, 0xf1, 0xda, 0x1f, 0x0f, 0x23, 0x25, 0xed, 0xaa, 0xc8, 0x0c, 0xff,
+	0x0a, 0xc1, 0x21, 0x83, 0xb0, 0xcb, 0xa6, 0xbd, 0x56, 0x71, 0x09, 0xad, 0xa2, 0x6a, 0x9a, 0x4d,
+	0x28, 0x15, 0x4e, 0x66, 0xca, 0xdf, 0x41, 0xb7, 0xca, 0xaf, 0x22, 0xfb, 0xdb, 0x68, 0xe2, 0x15,
+	0xf4, 0xe2, 0xd8, 0x99, 0xd3, 0x63, 0x67, 0x4e, 0x3f, 0xa7, 0x16, 0xae, 0xca, 0x85, 0x8b, 0x27,
+	0x0a, 0x8f, 0xbe, 0x70, 0xbd, 0x6d, 0xdc, 0x1a, 0x3e, 0x5f, 0x78, 0x61, 0xbc, 0x6d, 0xe2, 0xf8,
+	0xf3, 0xc5, 0xe3, 0xe3, 0x5c, 0x4f, 0x2e, 0x5c, 0x54, 0x0b, 0x57, 0x5d, 0xbd, 0xd6, 0xb8, 0x35,
+	0x14, 0x7a, 0xad, 0x89, 0xe3, 0x63, 0x67, 0x4e, 0x9f, 0x7e, 0x8e, 0x8f, 0xae, 0x7d, 0xe5, 0x81,
+	0x87, 0x6e, 0x1c, 0x3f, 0x73, 0xf4, 0xfa, 0x8b, 0x47, 0x95, 0x03, 0x9e, 0xb9, 0x8b, 0xc2, 0x5a,
+	0xd9, 0x35, 0x16, 0x3f, 0x09, 0xc3, 0x0d, 0x95, 0xb2, 0x8a, 0x63, 0x55, 0x6c, 0x52, 0x25, 0xfa,
+	0x86, 0x1b, 0x90, 0x78, 0xc4, 0x80, 0x64, 0xb9, 0xf2, 0xb2, 0xa5, 0x78, 0xaa, 0x32, 0xc3, 0x23,
+	0x90, 0x76, 0xac, 0x4a, 0xd5, 0x74, 0x0c, 0x96, 0x4b, 0x1c, 0x41, 0x63, 0x09, 0x65, 0xc0, 0xb1,
+	0x26, 0xf9, 0x23, 0x7e, 0x01, 0xf2, 0x62, 0x2d, 0xcd, 0xbc, 0x6c, 0xf0, 0x40, 0xf2, 0xfd, 0x7e,
+	0x59, 0xb5, 0x35, 0x77, 0xc9, 0x64, 0xc4, 0x25, 0xef, 0xe4, 0x18, 0x53, 0x1e, 0xc4, 0x59, 0x1f,
+	0x41, 0x66, 0xf8, 0x5e, 0x18, 0x6a, 0x22, 0xbb, 0xeb, 0xa7, 0xc4, 0xfa, 0x83, 0xfe, 0x5b, 0x61,
+	0x85, 0xf4, 0x46, 0x1c, 0xee, 0x90, 0xe9, 0xa4, 0x69, 0xd4, 0xf4, 0xba, 0x63, 0x8b, 0xaa, 0xc0,
+	0x8f, 0x41, 0xca, 0x72, 0x56, 0xa8, 0xb3, 0xd2, 0xb5, 0xe2, 0x3b, 0x15, 0x8a, 0x0b, 0xce, 0xca,
+	0xa2, 0xb3, 0xa2, 0x78, 0x5a, 0xf9, 0x77, 0x63, 0x90, 0x72, 0x5f, 0xe1, 0x79, 0xc8, 0x58, 0xb6,
+	0xb9, 0xa1, 0x6b, 0x9c, 0xc5, 0x5c, 0xb4, 0x13, 0xd1, 0xd0, 0x8a, 0x0b, 0xbe, 0x9e, 0xd2, 0x82,
+	0xc8, 0xff, 0x05, 0x41, 0xa6, 0x39, 0x81, 0x9f, 0x80, 0x04, 0xa7, 0x53, 0x01, 0x3c, 0x34, 0xf1,
+	0xc8, 0x4e, 0x81, 0x8b, 0xbc, 0xf6, 0x1d, 0xaa, 0x08, 0x10, 0x0e, 0x66, 0xa8, 0xcc, 0x2d, 0xd8,
+	0xcf, 0x02, 0xc6, 0x41, 0xa4, 0x53, 0x90, 0x72, 0x9f, 0xf1, 0x5e, 0x18, 0x98, 0x9e, 0x97, 0xcb,
+	0xe7, 0xa7, 0xa7, 0xb2, 0x7b, 0xf8, 0xc3, 0xd3, 0xb2, 0x32, 0x3f, 0x3b, 0x3f, 0x93, 0x45, 0x78,
+	0x1f, 0xa4, 0xa7, 0x66, 0x17, 0xdd, 0xa9, 0x58, 0x3e, 0xf5, 0xc9, 0x0f, 0x47, 0x62, 0x39, 0x74,
+	0x2e, 0x91, 0x8e, 0x67, 0x13, 0xd2, 0x5d, 0x2e, 0x9d, 0x76, 0xae, 0xe9, 0x51, 0x93, 0x54, 0x85,
+	0xfc, 0x56, 0x93, 0xd4, 0x32, 0x0d, 0x4a, 0xf0, 0x34, 0x0c, 0x56, 0xdb, 0x27, 0x72, 0xa8, 0x0b,
+	0xcd, 0x04, 0xf4, 0x3b, 0xb5, 0xa4, 0x35, 0xb8, 0x73, 0x9e, 0xca, 0xf4, 0x71, 0xd5, 0xd0, 0x1a,
+	0x64, 0xd9, 0x6a, 0xb4, 0x51, 0xe3, 0x42, 0x27, 0x35, 0x3a, 0x16, 0x4f, 0x6d, 0x7c, 0x6c, 0xef,
+	0xc4, 0x97, 0x7a, 0x50, 0xd9, 0xb2, 0x25, 0x88, 0xec, 0x75, 0x14, 0x4b, 0x77, 0x32, 0xe2, 0xb2,
+	0x45, 0xa5, 0xbf, 0xc7, 0xe0, 0xe0, 0xb4, 0x51, 0x35, 0x35, 0xe2, 0x97, 0xb2, 0xbf, 0xd6, 0x12,
+	0x0c, 0xb5, 0x0e, 0xe7, 0x36, 0x16, 0x3e, 0x1a, 0x5c, 0x6a, 0xda, 0xd0, 0xa6, 0x84, 0xd0, 0xd6,
+	0x1c, 0xbc, 0x8f, 0xb4, 0xe6, 0x29, 0x3e, 0x0f, 0x7b, 0x37, 0x88, 0x4d, 0x7d, 0x62, 0x77, 0x89,
+	0xf8, 0xfe, 0xae, 0x90, 0x4f, 0xb9, 0xb2, 0x6d, 0xc8, 0x0a, 0x6c, 0xf8, 0xef, 0x28, 0x9e, 0x85,
+	0xb4, 0xbf, 0xa9, 0x3c, 0xaa, 0xb8, 0xa7, 0x47, 0x20, 0x7c, 0x0f, 0xdb, 0x8c, 0x6b, 0xaa, 0xe3,
+	0xc7, 0x21, 0xd3, 0x3c, 0xf6, 0x05, 0x61, 0x0c, 0x4d, 0x1c, 0x09, 0x62, 0x05, 0x8f, 0x7b, 0x01,
+	0xf4, 0xb2, 0x00, 0x6a, 0x29, 0xe3, 0xbb, 0x21, 0x63, 0xa9, 0xb6, 0xba, 0x4e, 0x38, 0x12, 0x67,
+	0x93, 0x8c, 0xd2, 0x7a, 0x21, 0x3d, 0x0b, 0x87, 0x82, 0xf1, 0xf6, 0xca, 0xe7, 0x4c, 0x9b, 0x33,
+	0x28, 0xb2, 0x33, 0x2d, 0x17, 0xa4, 0xbf, 0xc6, 0x60, 0x78, 0x8a, 0x70, 0xec, 0x65, 0xeb, 0x7f,
+	0x2d, 0x93, 0x93, 0x90, 0x72, 0xac, 0xb6, 0x3c, 0x7e, 0xb9, 0x67, 0x41, 0x07, 0xb2, 0xe8, 0xa9,
+	0xf6, 0x2d, 0x87, 0x17, 0xe0, 0x40, 0x67, 0x9c, 0xbd, 0x0c, 0x3e, 0xda, 0x74, 0x02, 0x45, 0x74,
+	0xc2, 0x37, 0x5d, 0xec, 0x43, 0x17, 0xf3, 0xff, 0xfb, 0xb0, 0x5f, 0xfb, 0x30, 0x18, 0xef, 0xdd,
+	0xda, 0x87, 0xef, 0xa4, 0x60, 0x64, 0x0b, 0x09, 0xef, 0x58, 0x2a, 0xc2, 0x50, 0xd5, 0xb4, 0x6d,
+	0xd2, 0xf0, 0xaf, 0xb7, 0x62, 0x91, 0x4c, 0x79, 0xe0, 0x56, 0x39, 0x61, 0xc7, 0x72, 0x9a, 0x32,
+	0xd8, 0x36, 0x3d, 0xab, 0xe1, 0x67, 0x42, 0xf9, 0x8f, 0xed, 0x20, 0xff, 0xfb, 0xfc, 0x10, 0xbf,
+	0xf7, 0xe1, 0xe1, 0x3d, 0x81, 0x1a, 0x38, 0x08, 0xa9, 0x5a, 0xc5, 0x32, 0x6d, 0xf7, 0x9a, 0x35,
+	0xa8, 0x24, 0x6b, 0x0b, 0xa6, 0xcd, 0xf0, 0x30, 0x24, 0x6b, 0x95, 0xaa, 0x77, 0x6d, 0x1a, 0x54,
+	0x12, 0xb5, 0x49, 0x83, 0xf1, 0x60, 0x8a, 0x53, 0xca, 0x5e, 0x27, 0x9a, 0x08, 0x66, 0x5a, 0x69,
+	0xbd, 0xc0, 0x4f, 0x42, 0x92, 0x32, 0x95, 0x11, 0x71, 0xd3, 0x19, 0x9a, 0x28, 0x45, 0x88, 0x97,
+	0x1b, 0x0d, 0x71, 0x76, 0x93, 0xb6, 0xfc, 0xb9, 0x38, 0xf8, 0x00, 0x24, 0x89, 0x6d, 0x9b, 0x76,
+	0x6e, 0x40, 0xe4, 0xcd, 0x7d, 0xc0, 0xf3, 0x30, 0xb0, 0xaa, 0x53, 0x66, 0xda, 0x9b, 0xb9, 0xb4,
+	0x38, 0xf6, 0x1e, 0x8c, 0xbe, 0xd0, 0x92, 0xad, 0x1a, 0x54, 0x17, 0xe7, 0xad, 0x0f, 0x82, 0x27,
+	0x01, 0xaa, 0x36, 0x51, 0x99, 0x7b, 0xf1, 0xcb, 0x6c, 0x7b, 0xf1, 0x4b, 0xf3, 0x20, 0x8a, 0xcb,
+	0x5f, 0xc6, 0xd3, 0x93, 0x19, 0x07, 0x71, 0x2c, 0xcd, 0x07, 0x81, 0x9d, 0x80, 0x78, 0x7a, 0x32,
+	0xcb, 0xff, 0x08, 0x01, 0xb4, 0x2c, 0x6c, 0xc5, 0x13, 0xed, 0x52, 0x3c, 0x4f, 0x41, 0x82, 0x7f,
+	0xac, 0xe7, 0x62, 0x3b, 0x30, 0x4f, 0x68, 0xb4, 0x32, 0x11, 0x6f, 0xcb, 0x84, 0xb4, 0x0c, 0x49,
+	0xb1, 0x12, 0x06, 0x48, 0x5d, 0x58, 0x9e, 0x5e, 0x16, 0x77, 0xac, 0x34, 0x24, 0x16, 0xa7, 0xe7,
+	0x97, 0xb2, 0x08, 0x67, 0x20, 0x29, 0x4f, 0x3e, 0xc1, 0x6f, 0x57, 0x5c, 0x60, 0xde, 0x1d, 0xc7,
+	0xf9, 0xf8, 0xac, 0x3c, 0xcb, 0x6f, 0x5d, 0x09, 0x71, 0x3b, 0x7b, 0x66, 0x61, 0x56, 0x99, 0x9e,
+	0xca, 0x26, 0xfd, 0x2b, 0x98, 0xa4, 0xc1, 0x5d, 0x5d, 0x5d, 0x23, 0x14, 0x4f, 0x43, 0x9a, 0x7a,
+	0x63, 0xef, 0xde, 0x73, 0x3c, 0x72, 0x64, 0x94, 0xa6, 0xaa, 0xf4, 0x53, 0x04, 0xf7, 0x74, 0x7e,
+	0x32, 0x07, 0x44, 0x3d, 0xe6, 0x7d, 0xfe, 0x33, 0x31, 0x6f, 0xb6, 0x7d, 0xe7, 0xfd, 0xf6, 0xc3,
+	0xc3, 0x41, 0x06, 0x3e, 0x11, 0xe2, 0x01, 0xf7, 0x83, 0x2d, 0x73, 0xab, 0x9c, 0xb2, 0x13, 0x59,
+	0x14, 0x62, 0x02, 0xe9, 0x67, 0x08, 0x8e, 0x9d, 0xd7, 0x29, 0xeb, 0x11, 0xa2, 0xfe, 0x98, 0x3e,
+	0x0a, 0xc9, 0x86, 0xbe, 0xae, 0x33, 0x61, 0xf1, 0xa0, 0xa8, 0xb6, 0xf1, 0x78, 0xee, 0xe3, 0x01,
+	0xc5, 0x7d, 0x8d, 0x31, 0x24, 0x2c, 0xb5, 0x4e, 0x3c, 0x5a, 0x11, 0x63, 0xe9, 0xd7, 0xf1, 0xce,
+	0xdc, 0x92, 0x1a, 0xb1, 0x6d, 0xa2, 0xf9, 0x0e, 0xe0, 0x3b, 0x21, 0x16, 0xa4, 0xc2, 0xbc, 0x12,
+	0xd3, 0xff, 0x9b, 0xfc, 0x37, 0x07, 0x19, 0x9f, 0xb3, 0x79, 0xa3, 0x27, 0x1e, 0xf5, 0xd8, 0x82,
+	0x5b, 0xe5, 0x81, 0xd7, 0x11, 0x6f, 0xc5, 0x64, 0x95, 0x16, 0x02, 0x27, 0x02, 0xc3, 0x64, 0x95,
+	0x15, 0x52, 0x33, 0x6d, 0x92, 0x4b, 0xec, 0x60, 0xa7, 0x65, 0x0c, 0x93, 0x95, 0x85, 0x1a, 0x3e,
+	0x03, 0x40, 0xae, 0x58, 0xba, 0x4d, 0xe8, 0x4e, 0xbe, 0x45, 0x33, 0x9e, 0x8e, 0x4b, 0x47, 0x6d,
+	0x9c, 0x96, 0xba, 0x3d, 0x4e, 0xcb, 0x43, 0x5a, 0x65, 0x8c, 0x77, 0x07, 0xa9, 0x60, 0xe0, 0x41,
+	0xa5, 0xf9, 0x2c, 0x5d, 0x85, 0xbb, 0x7b, 0xe4, 0x91, 0xe2, 0x8b, 0xa2, 0x8f, 0x26, 0x5e, 0x56,
+	0x5a, 0xe1, 0x75, 0xb7, 0xeb, 0xfd, 0xbd, 0xc2, 0x1b, 0x40, 0x12, 0xad, 0xb4, 0x4e, 0x6c, 0xe9,
+	0xf7, 0x31, 0xb8, 0x57, 0xd6, 0xb4, 0x5e, 0x5a, 0x7d, 0xd9, 0x00, 0x1d, 0x95, 0x13, 0xdb, 0xe5,
+	0xca, 0x89, 0xef, 0x46, 0xe5, 0x24, 0x76, 0x5c, 0x39, 0xd2, 0xcf, 0x11, 0xdc, 0x17, 0xa4, 0x97,
+	0x60, 0x06, 0xbe, 0xb8, 0xfc, 0xf2, 0x16, 0x82, 0xb1, 0x29, 0xd2, 0x20, 0x8c, 0x7c, 0xee, 0xd5,
+	0x31, 0x22, 0xa8, 0x2c, 0xc0, 0xe6, 0x82, 0xcc, 0x26, 0xde, 0x4f, 0x42, 0x4c, 0xa6, 0xf8, 0x4d,
+	0x04, 0x03, 0x33, 0x84, 0x89, 0xbe, 0x73, 0xe8, 0x08, 0xeb, 0xda, 0xcd, 0xcd, 0x6f, 0xd7, 0xb0,
+	0x94, 0x1e, 0x7b, 0xf9, 0x83, 0x3f, 0x7f, 0x2f, 0x76, 0x0a, 0x3f, 0x5c, 0x52, 0x69, 0xc7, 0x4f,
+	0x1d, 0xa5, 0x6b, 0x81, 0x96, 0x6a, 0xb1, 0xf3, 0xf9, 0x46, 0x49, 0x90, 0xf0, 0xf7, 0x11, 0x0c,
+	0x2c, 0x76, 0xb3, 0x6b, 0xf1, 0xf6, 0xed, 0x92, 0x85, 0x5d, 0x5f, 0xcd, 0xdf, 0xa6, 0x5d, 0xa7,
+	0xd1, 0x38, 0xbe, 0x0e, 0xe0, 0xa6, 0x57, 0x18, 0x17, 0xb1, 0x15, 0x9c, 0x3f, 0x14, 0xda, 0x00,
+	0xd3, 0xfc, 0x27, 0x0f, 0xa9, 0x28, 0x0c, 0x1a, 0x1b, 0x3f, 0xb6, 0x9d, 0x41, 0x5e, 0x60, 0x5e,
+	0x47, 0xb0, 0xcf, 0x4b, 0x98, 0xdb, 0xb6, 0x8d, 0x6a, 0xc0, 0xd1, 0x6d, 0x42, 0x23, 0xd0, 0xa4,
+	0x07, 0x85, 0x39, 0x45, 0xfc, 0x40, 0x34, 0x73, 0x4a, 0x54, 0xd8, 0xf0, 0x0a, 0x82, 0xec, 0x0c,
+	0x61, 0x9d, 0x2d, 0xc4, 0x2d, 0xcb, 0x69, 0xcb, 0x6e, 0x56, 0x7e, 0x3c, 0x8a, 0xa8, 0xfb, 0x51,
+	0x24, 0x8d, 0x08, 0x0b, 0x87, 0xf1, 0x7e, 0x6e, 0x61, 0x47, 0xbf, 0x6a, 0xe2, 0x69, 0x48, 0xf0,
+	0x7e, 0x15, 0x7e, 0x12, 0xf6, 0xb5, 0xf7, 0xac, 0xf0, 0x7d, 0x41, 0xf8, 0x2e, 0x5d, 0xad, 0x6e,
+	0x49, 0x9a, 0x78, 0x7b, 0x10, 0x92, 0xb2, 0x65, 0xc9, 0x14, 0x2f, 0x41, 0x66, 0xd1, 0x59, 0xa1,
+	0x55, 0x5b, 0x5f, 0x21, 0x91, 0x43, 0xdf, 0xbb, 0x27, 0x76, 0x02, 0xe1, 0xdf, 0x20, 0xd8, 0xef,
+	0x33, 0xc3, 0x05, 0x87, 0x38, 0x64, 0xc1, 0xa1, 0xab, 0x38, 0x94, 0xb1, 0x0e, 0x91, 0x6d, 0x6c,
+	0x96, 0xae, 0x88, 0x38, 0xd9, 0xd2, 0x7a, 0x38, 0x93, 0x9d, 0x8c, 0x53, 0xdc, 0xae, 0xf0, 0x5d,
+	0xd1, 0xb0, 0x5e, 0x73, 0x78, 0xa3, 0xc4, 0x4f, 0x90, 0x92, 0xe5, 0xd0, 0x55, 0xbe, 0x41, 0xde,
+	0x47, 0x70, 0x20, 0x60, 0xaa, 0xd5, 0x50, 0xab, 0xe4, 0x33, 0x3a, 0x74, 0x4d, 0x38, 0xe4, 0x48,
+	0x56, 0xdf, 0x1c, 0xb2, 0x5d, 0xbb, 0xb9, 0x4f, 0x6f, 0x07, 0x33, 0xc4, 0xcf, 0x27, 0x1c, 0x89,
+	0xa5, 0x7b, 0xee, 0xbc, 0xd6, 0x8d, 0x42, 0x11, 0xee, 0x9d, 0xc7, 0xe7, 0x76, 0xce, 0x4c, 0x4d,
+	0x7f, 0x02, 0x0e, 0xe0, 0xb7, 0x10, 0x1c, 0x9c, 0x21, 0x6c, 0xee, 0xc2, 0xd2, 0xd2, 0xa4, 0x69,
+	0x18, 0xa4, 0x2a, 0x2a, 0xd3, 0xa8, 0x99, 0x91, 0x4b, 0x57, 0x0a, 0xfd, 0xde, 0x18, 0xc2, 0x8a,
+	0xce, 0xf5, 0x37, 0xc4, 0x2f, 0x9f, 0x85, 0x6a, 0x53, 0xbd, 0xa0, 0x73, 0x5b, 0x7e, 0x89, 0x60,
+	0x68, 0x51, 0x5f, 0x77, 0x1a, 0x2a, 0xf3, 0x77, 0x6c, 0xef, 0x1d, 0xd3, 0xb5, 0x44, 0xae, 0x0a,
+	0x4b, 0x98, 0x64, 0xf6, 0xa3, 0x44, 0x1c, 0xab, 0x44, 0x3d, 0xab, 0x79, 0x85, 0xfc, 0x01, 0xc1,
+	0x50, 0x67, 0x3f, 0x15, 0xdf, 0x1b, 0x2e, 0x8f, 0x2d, 0xfa, 0x6a, 0xf9, 0x63, 0xdb, 0x89, 0x79,
+	0xcc, 0xd7, 0x57, 0xef, 0xc4, 0x06, 0x20, 0xc2, 0x10, 0xee, 0xdd, 0x07, 0x08, 0xf6, 0xb5, 0x77,
+	0x1a, 0x71, 0xe8, 0x96, 0xb9, 0x45, 0xbf, 0x37, 0x7f, 0xb4, 0xb7, 0x90, 0xe7, 0x57, 0x5f, 0x99,
+	0xca, 0xb1, 0x4a, 0x1a, 0xf1, 0xbd, 0xe2, 0x39, 0x9b, 0x22, 0xbd, 0x73, 0x36, 0x45, 0x22, 0xe5,
+	0x6c, 0x8a, 0x7c, 0x41, 0x72, 0xd6, 0xf4, 0x6e, 0xe2, 0x6f, 0x09, 0x18, 0x96, 0x69, 0x93, 0x92,
+	0x14, 0x52, 0xd7, 0x29, 0xb3, 0x37, 0xf1, 0x4f, 0x10, 0xc4, 0x67, 0x08, 0x0b, 0xa7, 0x70, 0x86,
+	0xb0, 0x36, 0x69, 0xd7, 0xd1, 0x91, 0xae, 0x14, 0x27, 0xad, 0x09, 0xdf, 0x08, 0xae, 0xf6, 0xc1,
+	0x37, 0xfc, 0xad, 0x18, 0xc4, 0x17, 0xb7, 0x32, 0x7a, 0x71, 0x67, 0x46, 0xff, 0x02, 0x09, 0xab,
+	0xdf, 0x41, 0xf9, 0x9e, 0x66, 0x17, 0x6f, 0xd3, 0xec, 0x62, 0xa7, 0xd9, 0xa7, 0xd1, 0xf8, 0xc5,
+	0x39, 0xe9, 0xf1, 0xdd, 0x5a, 0x89, 0xd7, 0xec, 0x9b, 0x08, 0x52, 0xee, 0xfd, 0x33, 0xe2, 0xf1,
+	0xd3, 0x8d, 0x2c, 0xe7, 0x44, 0x20, 0x66, 0xc6, 0xa7, 0x77, 0xe5, 0xc0, 0x99, 0x78, 0x23, 0x01,
+	0x39, 0x99, 0x06, 0xfb, 0x57, 0x5e, 0xc9, 0xbd, 0x14, 0x83, 0xfd, 0x33, 0x84, 0x75, 0xce, 0xe2,
+	0x93, 0xbd, 0x3f, 0x38, 0xb6, 0xec, 0x85, 0xe5, 0xa3, 0x37, 0xda, 0xa4, 0x57, 0xdd, 0x5c, 0x7f,
+	0x13, 0xe1, 0x97, 0x50, 0xdf, 0xf6, 0x9f, 0xdb, 0xdc, 0x2b, 0x5d, 0xeb, 0x6c, 0xa9, 0xdd, 0xc0,
+	0xff, 0x40, 0x70, 0x80, 0x5f, 0x1a, 0x42, 0xbd, 0xc4, 0x87, 0x83, 0x0e, 0x45, 0xeb, 0xac, 0xe5,
+	0xef, 0x8f, 0x1c, 0x08, 0x42, 0xa5, 0xcb, 0x22, 0x12, 0x97, 0xb0, 0xd9, 0xe7, 0x38, 0x4c, 0xfc,
+	0x3b, 0x09, 0x79, 0x99, 0x86, 0x3f, 0x7f, 0xbd, 0xc2, 0xf8, 0x27, 0x82, 0x61, 0x59, 0xd3, 0x82,
+	0xf3, 0xf8, 0xa1, 0x90, 0x73, 0x51, 0x9a, 0x2d, 0xf9, 0x9d, 0xb4, 0x75, 0xa4, 0xeb, 0x22, 0x26,
+	0x1b, 0xd2, 0xa5, 0x3e, 0x72, 0xb3, 0x6b, 0x02, 0xdf, 0xc7, 0xff, 0x42, 0x70, 0x50, 0xd4, 0x43,
+	0xa8, 0x6f, 0xf5, 0xc8, 0x76, 0x05, 0xd1, 0xa5, 0x17, 0x92, 0x7f, 0x60, 0x07, 0xde, 0x53, 0x69,
+	0x53, 0xb8, 0x4f, 0x71, 0xff, 0xdd, 0xc7, 0x9f, 0x20, 0x38, 0xe4, 0x72, 0x58, 0x28, 0xf1, 0xa7,
+	0xc2, 0x07, 0x6b, 0xb4, 0x56, 0x4a, 0x57, 0x9e, 0xfb, 0x86, 0xf0, 0xf3, 0xca, 0xf8, 0x46, 0xdf,
+	0xfd, 0x2c, 0x5d, 0xd3, 0xb5, 0x1b, 0xe5, 0xb9, 0xdf, 0xfd, 0x69, 0x74, 0xcf, 0x4b, 0x37, 0x47,
+	0xd1, 0x0f, 0x6e, 0x8e, 0xa2, 0x8f, 0x6f, 0x8e, 0xee, 0xf9, 0xf4, 0xe6, 0x28, 0x7a, 0xed, 0xa3,
+	0xd1, 0x3d, 0xef, 0x7e, 0x34, 0x8a, 0x2e, 0x96, 0xea, 0x66, 0x91, 0xad, 0x12, 0x26, 0xfe, 0x64,
+	0x59, 0xf4, 0xfe, 0x91, 0x54, 0xea, 0xfc, 0xcf, 0xe0, 0xc6, 0xc9, 0x92, 0xb5, 0x56, 0x2f, 0x31,
+	0x66, 0x58, 0x2b, 0x2b, 0x29, 0xe1, 0xde, 0xc9, 0xff, 0x0c, 0x00, 0x9d, 0x15, 0x75, 0xad, 0x53,
+	0x2a, 0x00, 0x00,
+}
+
+func (x AsConfiguration_PubSub_Providers_Status) String() string {
+	s, ok := AsConfiguration_PubSub_Providers_Status_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x ApplicationDownlinkStatus_State) String() string {
+	s, ok := ApplicationDownlinkStatus_State_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *ApplicationLink) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationLink)
+	if !ok {
+		that2, ok := that.(ApplicationLink)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DefaultFormatters.Equal(that1.DefaultFormatters) {
+		return false
+	}
+	if !this.SkipPayloadCrypto.Equal(that1.SkipPayloadCrypto) {
+		return false
+	}
+	return true
+}
+func (this *GetApplicationLinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetApplicationLinkRequest)
+	if !ok {
+		that2, ok := that.(GetApplicationLinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIds.Equal(that1.ApplicationIds) {
+		return false
+	}
+	if !this.FieldMask.Equal(that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *SetApplicationLinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetApplicationLinkRequest)
+	if !ok {
+		that2, ok := that.(SetApplicationLinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIds.Equal(that1.ApplicationIds) {
+		return false
+	}
+	if !this.Link.Equal(that1.Link) {
+		return false
+	}
+	if !this.FieldMask.Equal(that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationLinkStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationLinkStats)
+	if !ok {
+		that2, ok := that.(ApplicationLinkStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.LinkedAt == nil {
+		if this.LinkedAt != nil {
+			return false
+		}
+	} else if !this.LinkedAt.Equal(*that1.LinkedAt) {
+		return false
+	}
+	if this.NetworkServerAddress != that1.NetworkServerAddress {
+		return false
+	}
+	if that1.LastUpReceivedAt == nil {
+		if this.LastUpReceivedAt != nil {
+			return false
+		}
+	} else if !this.LastUpReceivedAt.Equal(*that1.LastUpReceivedAt) {
+		return false
+	}
+	if this.UpCount != that1.UpCount {
+		return false
+	}
+	if that1.LastDownlinkForwardedAt == nil {
+		if this.LastDownlinkForwardedAt != nil {
+			return false
+		}
+	} else if !this.LastDownlinkForwardedAt.Equal(*that1.LastDownlinkForwardedAt) {
+		return false
+	}
+	if this.DownlinkCount != that1.DownlinkCount {
+		return false
+	}
+	return true
+}
+func (this *AsConfiguration) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AsConfiguration)
+	if !ok {
+		that2, ok := that.(AsConfiguration)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pubsub.Equal(that1.Pubsub) {
+		return false
+	}
+	return true
+}
+func (this *AsConfiguration_PubSub) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AsConfiguration_PubSub)
+	if !ok {
+		that2, ok := that.(AsConfiguration_PubSub)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Providers.Equal(that1.Providers) {
+		return false
+	}
+	return true
+}
+func (this *AsConfiguration_PubSub_Providers) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AsConfiguration_PubSub_Providers)
+	if !ok {
+		that2, ok := that.(AsConfiguration_PubSub_Providers)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Mqtt != that1.Mqtt {
+		return false
+	}
+	if this.Nats != that1.Nats {
+		return false
+	}
+	return true
+}
+func (this *GetAsConfigurationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetAsConfigurationRequest)
+	if !ok {
+		that2, ok := that.(GetAsConfigurationRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *GetAsConfigurationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetAsConfigurationResponse)
+	if !ok {
+		that2, ok := that.(GetAsConfigurationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Configuration.Equal(that1.Configuration) {
+		return false
+	}
+	return true
+}
+func (this *NsAsHandleUplinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NsAsHandleUplinkRequest)
+	if !ok {
+		that2, ok := that.(NsAsHandleUplinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
 	if that1 == nil {
 		return this == nil
 	} else if this == nil {
 		return false
 	}
-	if that1.LinkedAt == nil {
-		if this.LinkedAt != nil {
+	if len(this.ApplicationUps) != len(that1.ApplicationUps) {
+		return false
+	}
+	for i := range this.ApplicationUps {
+		if !this.ApplicationUps[i].Equal(that1.ApplicationUps[i]) {
 			return false
 		}
-	} else if !this.LinkedAt.Equal(*that1.LinkedAt) {
-		return false
 	}
-	if this.NetworkServerAddress != that1.NetworkServerAddress {
-		return false
+	return true
+}
+func (this *EncodeDownlinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if that1.LastUpReceivedAt == nil {
-		if this.LastUpReceivedAt != nil {
+
+	that1, ok := that.(*EncodeDownlinkRequest)
+	if !ok {
+		that2, ok := that.(EncodeDownlinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
-	} else if !this.LastUpReceivedAt.Equal(*that1.LastUpReceivedAt) {
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.UpCount != that1.UpCount {
+	if !this.EndDeviceIds.Equal(that1.EndDeviceIds) {
 		return false
 	}
-	if that1.LastDownlinkForwardedAt == nil {
-		if this.LastDownlinkForwardedAt != nil {
+	if !this.VersionIds.Equal(that1.VersionIds) {
+		return false
+	}
+	if !this.Downlink.Equal(that1.Downlink) {
+		return false
+	}
+	if this.Formatter != that1.Formatter {
+		return false
+	}
+	if this.Parameter != that1.Parameter {
+		return false
+	}
+	return true
+}
+func (this *EncodeDownlinkResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EncodeDownlinkResponse)
+	if !ok {
+		that2, ok := that.(EncodeDownlinkResponse)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
-	} else if !this.LastDownlinkForwardedAt.Equal(*that1.LastDownlinkForwardedAt) {
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.DownlinkCount != that1.DownlinkCount {
+	if !this.Downlink.Equal(that1.Downlink) {
 		return false
 	}
 	return true
 }
-func (this *AsConfiguration) Equal(that interface{}) bool {
+func (this *DecodeUplinkRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AsConfiguration)
+	that1, ok := that.(*DecodeUplinkRequest)
 	if !ok {
-		that2, ok := that.(AsConfiguration)
+		that2, ok := that.(DecodeUplinkRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1145,19 +2025,31 @@ func (this *AsConfiguration) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Pubsub.Equal(that1.Pubsub) {
+	if !this.EndDeviceIds.Equal(that1.EndDeviceIds) {
+		return false
+	}
+	if !this.VersionIds.Equal(that1.VersionIds) {
+		return false
+	}
+	if !this.Uplink.Equal(that1.Uplink) {
+		return false
+	}
+	if this.Formatter != that1.Formatter {
+		return false
+	}
+	if this.Parameter != that1.Parameter {
 		return false
 	}
 	return true
 }
-func (this *AsConfiguration_PubSub) Equal(that interface{}) bool {
+func (this *DecodeUplinkResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AsConfiguration_PubSub)
+	that1, ok := that.(*DecodeUplinkResponse)
 	if !ok {
-		that2, ok := that.(AsConfiguration_PubSub)
+		that2, ok := that.(DecodeUplinkResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1169,19 +2061,19 @@ func (this *AsConfiguration_PubSub) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Providers.Equal(that1.Providers) {
+	if !this.Uplink.Equal(that1.Uplink) {
 		return false
 	}
 	return true
 }
-func (this *AsConfiguration_PubSub_Providers) Equal(that interface{}) bool {
+func (this *DecodeDownlinkRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AsConfiguration_PubSub_Providers)
+	that1, ok := that.(*DecodeDownlinkRequest)
 	if !ok {
-		that2, ok := that.(AsConfiguration_PubSub_Providers)
+		that2, ok := that.(DecodeDownlinkRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1193,22 +2085,31 @@ func (this *AsConfiguration_PubSub_Providers) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Mqtt != that1.Mqtt {
+	if !this.EndDeviceIds.Equal(that1.EndDeviceIds) {
 		return false
 	}
-	if this.Nats != that1.Nats {
+	if !this.VersionIds.Equal(that1.VersionIds) {
+		return false
+	}
+	if !this.Downlink.Equal(that1.Downlink) {
+		return false
+	}
+	if this.Formatter != that1.Formatter {
+		return false
+	}
+	if this.Parameter != that1.Parameter {
 		return false
 	}
 	return true
 }
-func (this *GetAsConfigurationRequest) Equal(that interface{}) bool {
+func (this *DecodeDownlinkResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetAsConfigurationRequest)
+	that1, ok := that.(*DecodeDownlinkResponse)
 	if !ok {
-		that2, ok := that.(GetAsConfigurationRequest)
+		that2, ok := that.(DecodeDownlinkResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1220,16 +2121,19 @@ func (this *GetAsConfigurationRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !this.Downlink.Equal(that1.Downlink) {
+		return false
+	}
 	return true
 }
-func (this *GetAsConfigurationResponse) Equal(that interface{}) bool {
+func (this *ApplicationDownlinkStatus) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetAsConfigurationResponse)
+	that1, ok := that.(*ApplicationDownlinkStatus)
 	if !ok {
-		that2, ok := that.(GetAsConfigurationResponse)
+		that2, ok := that.(ApplicationDownlinkStatus)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1241,19 +2145,51 @@ func (this *GetAsConfigurationResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Configuration.Equal(that1.Configuration) {
+	if this.CorrelationId != that1.CorrelationId {
+		return false
+	}
+	if !this.EndDeviceIds.Equal(&that1.EndDeviceIds) {
+		return false
+	}
+	if this.FPort != that1.FPort {
+		return false
+	}
+	if this.FCnt != that1.FCnt {
+		return false
+	}
+	if this.Confirmed != that1.Confirmed {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if len(this.History) != len(that1.History) {
+		return false
+	}
+	for i := range this.History {
+		if !this.History[i].Equal(that1.History[i]) {
+			return false
+		}
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
 		return false
 	}
 	return true
 }
-func (this *NsAsHandleUplinkRequest) Equal(that interface{}) bool {
+func (this *ApplicationDownlinkStatus_Transition) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*NsAsHandleUplinkRequest)
+	that1, ok := that.(*ApplicationDownlinkStatus_Transition)
 	if !ok {
-		that2, ok := that.(NsAsHandleUplinkRequest)
+		that2, ok := that.(ApplicationDownlinkStatus_Transition)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1265,24 +2201,25 @@ func (this *NsAsHandleUplinkRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.ApplicationUps) != len(that1.ApplicationUps) {
+	if this.State != that1.State {
 		return false
 	}
-	for i := range this.ApplicationUps {
-		if !this.ApplicationUps[i].Equal(that1.ApplicationUps[i]) {
-			return false
-		}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
 	}
 	return true
 }
-func (this *EncodeDownlinkRequest) Equal(that interface{}) bool {
+func (this *ApplicationDownlinkStatuses) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*EncodeDownlinkRequest)
+	that1, ok := that.(*ApplicationDownlinkStatuses)
 	if !ok {
-		that2, ok := that.(EncodeDownlinkRequest)
+		that2, ok := that.(ApplicationDownlinkStatuses)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1294,31 +2231,51 @@ func (this *EncodeDownlinkRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.EndDeviceIds.Equal(that1.EndDeviceIds) {
+	if len(this.Statuses) != len(that1.Statuses) {
 		return false
 	}
-	if !this.VersionIds.Equal(that1.VersionIds) {
-		return false
+	for i := range this.Statuses {
+		if !this.Statuses[i].Equal(that1.Statuses[i]) {
+			return false
+		}
 	}
-	if !this.Downlink.Equal(that1.Downlink) {
+	return true
+}
+func (this *GetApplicationDownlinkStatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetApplicationDownlinkStatusRequest)
+	if !ok {
+		that2, ok := that.(GetApplicationDownlinkStatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.Formatter != that1.Formatter {
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
 		return false
 	}
-	if this.Parameter != that1.Parameter {
+	if this.CorrelationId != that1.CorrelationId {
 		return false
 	}
 	return true
 }
-func (this *EncodeDownlinkResponse) Equal(that interface{}) bool {
+func (this *ListApplicationDownlinkStatusesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*EncodeDownlinkResponse)
+	that1, ok := that.(*ListApplicationDownlinkStatusesRequest)
 	if !ok {
-		that2, ok := that.(EncodeDownlinkResponse)
+		that2, ok := that.(ListApplicationDownlinkStatusesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1330,19 +2287,25 @@ func (this *EncodeDownlinkResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Downlink.Equal(that1.Downlink) {
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.Page != that1.Page {
 		return false
 	}
 	return true
 }
-func (this *DecodeUplinkRequest) Equal(that interface{}) bool {
+func (this *ApplicationDeferredDownlink) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DecodeUplinkRequest)
+	that1, ok := that.(*ApplicationDeferredDownlink)
 	if !ok {
-		that2, ok := that.(DecodeUplinkRequest)
+		that2, ok := that.(ApplicationDeferredDownlink)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1354,55 +2317,117 @@ func (this *DecodeUplinkRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.EndDeviceIds.Equal(that1.EndDeviceIds) {
+	if this.Id != that1.Id {
 		return false
 	}
-	if !this.VersionIds.Equal(that1.VersionIds) {
+	if !this.EndDeviceIds.Equal(&that1.EndDeviceIds) {
 		return false
 	}
-	if !this.Uplink.Equal(that1.Uplink) {
+	if len(this.Downlinks) != len(that1.Downlinks) {
 		return false
 	}
-	if this.Formatter != that1.Formatter {
+	for i := range this.Downlinks {
+		if !this.Downlinks[i].Equal(that1.Downlinks[i]) {
+			return false
+		}
+	}
+	if !this.NotBefore.Equal(that1.NotBefore) {
 		return false
 	}
-	if this.Parameter != that1.Parameter {
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	if this.Attempts != that1.Attempts {
 		return false
 	}
 	return true
 }
-func (this *DecodeUplinkResponse) Equal(that interface{}) bool {
+func (this *ApplicationDeferredDownlinks) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DecodeUplinkResponse)
+	that1, ok := that.(*ApplicationDeferredDownlinks)
 	if !ok {
-		that2, ok := that.(DecodeUplinkResponse)
+		that2, ok := that.(ApplicationDeferredDownlinks)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.DeferredDownlinks) != len(that1.DeferredDownlinks) {
+		return false
+	}
+	for i := range this.DeferredDownlinks {
+		if !this.DeferredDownlinks[i].Equal(that1.DeferredDownlinks[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AddApplicationDeferredDownlinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddApplicationDeferredDownlinkRequest)
+	if !ok {
+		that2, ok := that.(AddApplicationDeferredDownlinkRequest)
 		if ok {
 			that1 = &that2
 		} else {
 			return false
 		}
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if len(this.Downlinks) != len(that1.Downlinks) {
+		return false
+	}
+	for i := range this.Downlinks {
+		if !this.Downlinks[i].Equal(that1.Downlinks[i]) {
+			return false
+		}
+	}
+	if !this.NotBefore.Equal(that1.NotBefore) {
 		return false
 	}
-	if !this.Uplink.Equal(that1.Uplink) {
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
 		return false
 	}
 	return true
 }
-func (this *DecodeDownlinkRequest) Equal(that interface{}) bool {
+func (this *ListApplicationDeferredDownlinksRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DecodeDownlinkRequest)
+	that1, ok := that.(*ListApplicationDeferredDownlinksRequest)
 	if !ok {
-		that2, ok := that.(DecodeDownlinkRequest)
+		that2, ok := that.(ListApplicationDeferredDownlinksRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1414,31 +2439,25 @@ func (this *DecodeDownlinkRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.EndDeviceIds.Equal(that1.EndDeviceIds) {
-		return false
-	}
-	if !this.VersionIds.Equal(that1.VersionIds) {
-		return false
-	}
-	if !this.Downlink.Equal(that1.Downlink) {
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
 		return false
 	}
-	if this.Formatter != that1.Formatter {
+	if this.Limit != that1.Limit {
 		return false
 	}
-	if this.Parameter != that1.Parameter {
+	if this.Page != that1.Page {
 		return false
 	}
 	return true
 }
-func (this *DecodeDownlinkResponse) Equal(that interface{}) bool {
+func (this *DeleteApplicationDeferredDownlinkRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DecodeDownlinkResponse)
+	that1, ok := that.(*DeleteApplicationDeferredDownlinkRequest)
 	if !ok {
-		that2, ok := that.(DecodeDownlinkResponse)
+		that2, ok := that.(DeleteApplicationDeferredDownlinkRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1450,7 +2469,10 @@ func (this *DecodeDownlinkResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Downlink.Equal(that1.Downlink) {
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if this.Id != that1.Id {
 		return false
 	}
 	return true
@@ -2330,6 +3352,268 @@ var _AsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 	Metadata: "lorawan-stack/api/applicationserver.proto",
 }
 
+// AsDownlinkStatusRegistryClient is the client API for AsDownlinkStatusRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AsDownlinkStatusRegistryClient interface {
+	// Get the delivery status of the downlink message with the given correlation ID.
+	GetDownlinkStatus(ctx context.Context, in *GetApplicationDownlinkStatusRequest, opts ...grpc.CallOption) (*ApplicationDownlinkStatus, error)
+	// List the delivery statuses of the downlink messages of the end device, most recent first.
+	ListDownlinkStatuses(ctx context.Context, in *ListApplicationDownlinkStatusesRequest, opts ...grpc.CallOption) (*ApplicationDownlinkStatuses, error)
+}
+
+type asDownlinkStatusRegistryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAsDownlinkStatusRegistryClient(cc *grpc.ClientConn) AsDownlinkStatusRegistryClient {
+	return &asDownlinkStatusRegistryClient{cc}
+}
+
+func (c *asDownlinkStatusRegistryClient) GetDownlinkStatus(ctx context.Context, in *GetApplicationDownlinkStatusRequest, opts ...grpc.CallOption) (*ApplicationDownlinkStatus, error) {
+	out := new(ApplicationDownlinkStatus)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsDownlinkStatusRegistry/GetDownlinkStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asDownlinkStatusRegistryClient) ListDownlinkStatuses(ctx context.Context, in *ListApplicationDownlinkStatusesRequest, opts ...grpc.CallOption) (*ApplicationDownlinkStatuses, error) {
+	out := new(ApplicationDownlinkStatuses)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsDownlinkStatusRegistry/ListDownlinkStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AsDownlinkStatusRegistryServer is the server API for AsDownlinkStatusRegistry service.
+type AsDownlinkStatusRegistryServer interface {
+	// Get the delivery status of the downlink message with the given correlation ID.
+	GetDownlinkStatus(context.Context, *GetApplicationDownlinkStatusRequest) (*ApplicationDownlinkStatus, error)
+	// List the delivery statuses of the downlink messages of the end device, most recent first.
+	ListDownlinkStatuses(context.Context, *ListApplicationDownlinkStatusesRequest) (*ApplicationDownlinkStatuses, error)
+}
+
+// UnimplementedAsDownlinkStatusRegistryServer can be embedded to have forward compatible implementations.
+type UnimplementedAsDownlinkStatusRegistryServer struct {
+}
+
+func (*UnimplementedAsDownlinkStatusRegistryServer) GetDownlinkStatus(ctx context.Context, req *GetApplicationDownlinkStatusRequest) (*ApplicationDownlinkStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDownlinkStatus not implemented")
+}
+func (*UnimplementedAsDownlinkStatusRegistryServer) ListDownlinkStatuses(ctx context.Context, req *ListApplicationDownlinkStatusesRequest) (*ApplicationDownlinkStatuses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDownlinkStatuses not implemented")
+}
+
+func RegisterAsDownlinkStatusRegistryServer(s *grpc.Server, srv AsDownlinkStatusRegistryServer) {
+	s.RegisterService(&_AsDownlinkStatusRegistry_serviceDesc, srv)
+}
+
+func _AsDownlinkStatusRegistry_GetDownlinkStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationDownlinkStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsDownlinkStatusRegistryServer).GetDownlinkStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsDownlinkStatusRegistry/GetDownlinkStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsDownlinkStatusRegistryServer).GetDownlinkStatus(ctx, req.(*GetApplicationDownlinkStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AsDownlinkStatusRegistry_ListDownlinkStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationDownlinkStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsDownlinkStatusRegistryServer).ListDownlinkStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsDownlinkStatusRegistry/ListDownlinkStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsDownlinkStatusRegistryServer).ListDownlinkStatuses(ctx, req.(*ListApplicationDownlinkStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AsDownlinkStatusRegistry_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.AsDownlinkStatusRegistry",
+	HandlerType: (*AsDownlinkStatusRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDownlinkStatus",
+			Handler:    _AsDownlinkStatusRegistry_GetDownlinkStatus_Handler,
+		},
+		{
+			MethodName: "ListDownlinkStatuses",
+			Handler:    _AsDownlinkStatusRegistry_ListDownlinkStatuses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/applicationserver.proto",
+}
+
+// AsDeferredDownlinkRegistryClient is the client API for AsDeferredDownlinkRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AsDeferredDownlinkRegistryClient interface {
+	// Add a deferred downlink to the end device.
+	AddDeferredDownlink(ctx context.Context, in *AddApplicationDeferredDownlinkRequest, opts ...grpc.CallOption) (*ApplicationDeferredDownlink, error)
+	// List the deferred downlinks of the end device, ordered by the time they are due.
+	ListDeferredDownlinks(ctx context.Context, in *ListApplicationDeferredDownlinksRequest, opts ...grpc.CallOption) (*ApplicationDeferredDownlinks, error)
+	// Delete the deferred downlink with the given ID.
+	DeleteDeferredDownlink(ctx context.Context, in *DeleteApplicationDeferredDownlinkRequest, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type asDeferredDownlinkRegistryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAsDeferredDownlinkRegistryClient(cc *grpc.ClientConn) AsDeferredDownlinkRegistryClient {
+	return &asDeferredDownlinkRegistryClient{cc}
+}
+
+func (c *asDeferredDownlinkRegistryClient) AddDeferredDownlink(ctx context.Context, in *AddApplicationDeferredDownlinkRequest, opts ...grpc.CallOption) (*ApplicationDeferredDownlink, error) {
+	out := new(ApplicationDeferredDownlink)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsDeferredDownlinkRegistry/AddDeferredDownlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asDeferredDownlinkRegistryClient) ListDeferredDownlinks(ctx context.Context, in *ListApplicationDeferredDownlinksRequest, opts ...grpc.CallOption) (*ApplicationDeferredDownlinks, error) {
+	out := new(ApplicationDeferredDownlinks)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsDeferredDownlinkRegistry/ListDeferredDownlinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asDeferredDownlinkRegistryClient) DeleteDeferredDownlink(ctx context.Context, in *DeleteApplicationDeferredDownlinkRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsDeferredDownlinkRegistry/DeleteDeferredDownlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AsDeferredDownlinkRegistryServer is the server API for AsDeferredDownlinkRegistry service.
+type AsDeferredDownlinkRegistryServer interface {
+	// Add a deferred downlink to the end device.
+	AddDeferredDownlink(context.Context, *AddApplicationDeferredDownlinkRequest) (*ApplicationDeferredDownlink, error)
+	// List the deferred downlinks of the end device, ordered by the time they are due.
+	ListDeferredDownlinks(context.Context, *ListApplicationDeferredDownlinksRequest) (*ApplicationDeferredDownlinks, error)
+	// Delete the deferred downlink with the given ID.
+	DeleteDeferredDownlink(context.Context, *DeleteApplicationDeferredDownlinkRequest) (*types.Empty, error)
+}
+
+// UnimplementedAsDeferredDownlinkRegistryServer can be embedded to have forward compatible implementations.
+type UnimplementedAsDeferredDownlinkRegistryServer struct {
+}
+
+func (*UnimplementedAsDeferredDownlinkRegistryServer) AddDeferredDownlink(ctx context.Context, req *AddApplicationDeferredDownlinkRequest) (*ApplicationDeferredDownlink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDeferredDownlink not implemented")
+}
+func (*UnimplementedAsDeferredDownlinkRegistryServer) ListDeferredDownlinks(ctx context.Context, req *ListApplicationDeferredDownlinksRequest) (*ApplicationDeferredDownlinks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeferredDownlinks not implemented")
+}
+func (*UnimplementedAsDeferredDownlinkRegistryServer) DeleteDeferredDownlink(ctx context.Context, req *DeleteApplicationDeferredDownlinkRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeferredDownlink not implemented")
+}
+
+func RegisterAsDeferredDownlinkRegistryServer(s *grpc.Server, srv AsDeferredDownlinkRegistryServer) {
+	s.RegisterService(&_AsDeferredDownlinkRegistry_serviceDesc, srv)
+}
+
+func _AsDeferredDownlinkRegistry_AddDeferredDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddApplicationDeferredDownlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsDeferredDownlinkRegistryServer).AddDeferredDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsDeferredDownlinkRegistry/AddDeferredDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsDeferredDownlinkRegistryServer).AddDeferredDownlink(ctx, req.(*AddApplicationDeferredDownlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AsDeferredDownlinkRegistry_ListDeferredDownlinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationDeferredDownlinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsDeferredDownlinkRegistryServer).ListDeferredDownlinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsDeferredDownlinkRegistry/ListDeferredDownlinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsDeferredDownlinkRegistryServer).ListDeferredDownlinks(ctx, req.(*ListApplicationDeferredDownlinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AsDeferredDownlinkRegistry_DeleteDeferredDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApplicationDeferredDownlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsDeferredDownlinkRegistryServer).DeleteDeferredDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsDeferredDownlinkRegistry/DeleteDeferredDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsDeferredDownlinkRegistryServer).DeleteDeferredDownlink(ctx, req.(*DeleteApplicationDeferredDownlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AsDeferredDownlinkRegistry_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.AsDeferredDownlinkRegistry",
+	HandlerType: (*AsDeferredDownlinkRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddDeferredDownlink",
+			Handler:    _AsDeferredDownlinkRegistry_AddDeferredDownlink_Handler,
+		},
+		{
+			MethodName: "ListDeferredDownlinks",
+			Handler:    _AsDeferredDownlinkRegistry_ListDeferredDownlinks_Handler,
+		},
+		{
+			MethodName: "DeleteDeferredDownlink",
+			Handler:    _AsDeferredDownlinkRegistry_DeleteDeferredDownlink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/applicationserver.proto",
+}
+
 func (this *ApplicationLink) String() string {
 	if this == nil {
 		return "nil"
@@ -2516,6 +3800,157 @@ func (this *DecodeDownlinkResponse) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationDownlinkStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHistory := "[]*ApplicationDownlinkStatus_Transition{"
+	for _, f := range this.History {
+		repeatedStringForHistory += strings.Replace(fmt.Sprintf("%v", f), "ApplicationDownlinkStatus_Transition", "ApplicationDownlinkStatus_Transition", 1) + ","
+	}
+	repeatedStringForHistory += "}"
+	s := strings.Join([]string{`&ApplicationDownlinkStatus{`,
+		`CorrelationId:` + fmt.Sprintf("%v", this.CorrelationId) + `,`,
+		`EndDeviceIds:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIds), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FPort:` + fmt.Sprintf("%v", this.FPort) + `,`,
+		`FCnt:` + fmt.Sprintf("%v", this.FCnt) + `,`,
+		`Confirmed:` + fmt.Sprintf("%v", this.Confirmed) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`History:` + repeatedStringForHistory + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationDownlinkStatus_Transition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDownlinkStatus_Transition{`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationDownlinkStatuses) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForStatuses := "[]*ApplicationDownlinkStatus{"
+	for _, f := range this.Statuses {
+		repeatedStringForStatuses += strings.Replace(f.String(), "ApplicationDownlinkStatus", "ApplicationDownlinkStatus", 1) + ","
+	}
+	repeatedStringForStatuses += "}"
+	s := strings.Join([]string{`&ApplicationDownlinkStatuses{`,
+		`Statuses:` + repeatedStringForStatuses + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetApplicationDownlinkStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetApplicationDownlinkStatusRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`CorrelationId:` + fmt.Sprintf("%v", this.CorrelationId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListApplicationDownlinkStatusesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListApplicationDownlinkStatusesRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationDeferredDownlink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDownlinks := "[]*ApplicationDownlink{"
+	for _, f := range this.Downlinks {
+		repeatedStringForDownlinks += strings.Replace(fmt.Sprintf("%v", f), "ApplicationDownlink", "ApplicationDownlink", 1) + ","
+	}
+	repeatedStringForDownlinks += "}"
+	s := strings.Join([]string{`&ApplicationDeferredDownlink{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`EndDeviceIds:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIds), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Downlinks:` + repeatedStringForDownlinks + `,`,
+		`NotBefore:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.NotBefore), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationDeferredDownlinks) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDeferredDownlinks := "[]*ApplicationDeferredDownlink{"
+	for _, f := range this.DeferredDownlinks {
+		repeatedStringForDeferredDownlinks += strings.Replace(f.String(), "ApplicationDeferredDownlink", "ApplicationDeferredDownlink", 1) + ","
+	}
+	repeatedStringForDeferredDownlinks += "}"
+	s := strings.Join([]string{`&ApplicationDeferredDownlinks{`,
+		`DeferredDownlinks:` + repeatedStringForDeferredDownlinks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddApplicationDeferredDownlinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDownlinks := "[]*ApplicationDownlink{"
+	for _, f := range this.Downlinks {
+		repeatedStringForDownlinks += strings.Replace(fmt.Sprintf("%v", f), "ApplicationDownlink", "ApplicationDownlink", 1) + ","
+	}
+	repeatedStringForDownlinks += "}"
+	s := strings.Join([]string{`&AddApplicationDeferredDownlinkRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Downlinks:` + repeatedStringForDownlinks + `,`,
+		`NotBefore:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.NotBefore), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListApplicationDeferredDownlinksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListApplicationDeferredDownlinksRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteApplicationDeferredDownlinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteApplicationDeferredDownlinkRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringApplicationserver(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -331,6 +331,159 @@ var DecodeDownlinkResponseFieldPathsNested = []string{
 var DecodeDownlinkResponseFieldPathsTopLevel = []string{
 	"downlink",
 }
+var ApplicationDownlinkStatusFieldPathsNested = []string{
+	"confirmed",
+	"correlation_id",
+	"created_at",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"error",
+	"f_cnt",
+	"f_port",
+	"history",
+	"state",
+	"updated_at",
+}
+
+var ApplicationDownlinkStatusFieldPathsTopLevel = []string{
+	"confirmed",
+	"correlation_id",
+	"created_at",
+	"end_device_ids",
+	"error",
+	"f_cnt",
+	"f_port",
+	"history",
+	"state",
+	"updated_at",
+}
+var ApplicationDownlinkStatusesFieldPathsNested = []string{
+	"statuses",
+}
+
+var ApplicationDownlinkStatusesFieldPathsTopLevel = []string{
+	"statuses",
+}
+var GetApplicationDownlinkStatusRequestFieldPathsNested = []string{
+	"correlation_id",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+}
+
+var GetApplicationDownlinkStatusRequestFieldPathsTopLevel = []string{
+	"correlation_id",
+	"end_device_ids",
+}
+var ListApplicationDownlinkStatusesRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"limit",
+	"page",
+}
+
+var ListApplicationDownlinkStatusesRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"limit",
+	"page",
+}
+var ApplicationDeferredDownlinkFieldPathsNested = []string{
+	"attempts",
+	"created_at",
+	"downlinks",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"expires_at",
+	"id",
+	"not_before",
+}
+
+var ApplicationDeferredDownlinkFieldPathsTopLevel = []string{
+	"attempts",
+	"created_at",
+	"downlinks",
+	"end_device_ids",
+	"expires_at",
+	"id",
+	"not_before",
+}
+var ApplicationDeferredDownlinksFieldPathsNested = []string{
+	"deferred_downlinks",
+}
+
+var ApplicationDeferredDownlinksFieldPathsTopLevel = []string{
+	"deferred_downlinks",
+}
+var AddApplicationDeferredDownlinkRequestFieldPathsNested = []string{
+	"downlinks",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"expires_at",
+	"not_before",
+}
+
+var AddApplicationDeferredDownlinkRequestFieldPathsTopLevel = []string{
+	"downlinks",
+	"end_device_ids",
+	"expires_at",
+	"not_before",
+}
+var ListApplicationDeferredDownlinksRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"limit",
+	"page",
+}
+
+var ListApplicationDeferredDownlinksRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"limit",
+	"page",
+}
+var DeleteApplicationDeferredDownlinkRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"id",
+}
+
+var DeleteApplicationDeferredDownlinkRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"id",
+}
 var AsConfiguration_PubSubFieldPathsNested = []string{
 	"providers",
 	"providers.mqtt",
@@ -349,3 +502,14 @@ var AsConfiguration_PubSub_ProvidersFieldPathsTopLevel = []string{
 	"mqtt",
 	"nats",
 }
+var ApplicationDownlinkStatus_TransitionFieldPathsNested = []string{
+	"error",
+	"state",
+	"time",
+}
+
+var ApplicationDownlinkStatus_TransitionFieldPathsTopLevel = []string{
+	"error",
+	"state",
+	"time",
+}
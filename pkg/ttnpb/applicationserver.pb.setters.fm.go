@@ -2,7 +2,10 @@
 
 package ttnpb
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+	time "time"
+)
 
 func (dst *ApplicationLink) SetFields(src *ApplicationLink, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
@@ -760,6 +763,484 @@ func (dst *DecodeDownlinkResponse) SetFields(src *DecodeDownlinkResponse, paths
 	return nil
 }
 
+func (dst *ApplicationDownlinkStatus) SetFields(src *ApplicationDownlinkStatus, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "correlation_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'correlation_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CorrelationId = src.CorrelationId
+			} else {
+				var zero string
+				dst.CorrelationId = zero
+			}
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIds
+				}
+				newDst = &dst.EndDeviceIds
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIds = zero
+				}
+			}
+		case "f_port":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_port' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FPort = src.FPort
+			} else {
+				var zero uint32
+				dst.FPort = zero
+			}
+		case "f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FCnt = src.FCnt
+			} else {
+				var zero uint32
+				dst.FCnt = zero
+			}
+		case "confirmed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'confirmed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Confirmed = src.Confirmed
+			} else {
+				var zero bool
+				dst.Confirmed = zero
+			}
+		case "state":
+			if len(subs) > 0 {
+				return fmt.Errorf("'state' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.State = src.State
+			} else {
+				var zero ApplicationDownlinkStatus_State
+				dst.State = zero
+			}
+		case "error":
+			if len(subs) > 0 {
+				return fmt.Errorf("'error' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Error = src.Error
+			} else {
+				var zero string
+				dst.Error = zero
+			}
+		case "history":
+			if len(subs) > 0 {
+				return fmt.Errorf("'history' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.History = src.History
+			} else {
+				dst.History = nil
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				var zero time.Time
+				dst.CreatedAt = zero
+			}
+		case "updated_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'updated_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpdatedAt = src.UpdatedAt
+			} else {
+				var zero time.Time
+				dst.UpdatedAt = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationDownlinkStatuses) SetFields(src *ApplicationDownlinkStatuses, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "statuses":
+			if len(subs) > 0 {
+				return fmt.Errorf("'statuses' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Statuses = src.Statuses
+			} else {
+				dst.Statuses = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetApplicationDownlinkStatusRequest) SetFields(src *GetApplicationDownlinkStatusRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				newDst = &dst.EndDeviceIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "correlation_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'correlation_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CorrelationId = src.CorrelationId
+			} else {
+				var zero string
+				dst.CorrelationId = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListApplicationDownlinkStatusesRequest) SetFields(src *ListApplicationDownlinkStatusesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				newDst = &dst.EndDeviceIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationDeferredDownlink) SetFields(src *ApplicationDeferredDownlink, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Id = src.Id
+			} else {
+				var zero string
+				dst.Id = zero
+			}
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIds
+				}
+				newDst = &dst.EndDeviceIds
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIds = zero
+				}
+			}
+		case "downlinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Downlinks = src.Downlinks
+			} else {
+				dst.Downlinks = nil
+			}
+		case "not_before":
+			if len(subs) > 0 {
+				return fmt.Errorf("'not_before' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NotBefore = src.NotBefore
+			} else {
+				var zero time.Time
+				dst.NotBefore = zero
+			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				var zero time.Time
+				dst.CreatedAt = zero
+			}
+		case "attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Attempts = src.Attempts
+			} else {
+				var zero uint32
+				dst.Attempts = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationDeferredDownlinks) SetFields(src *ApplicationDeferredDownlinks, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "deferred_downlinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deferred_downlinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeferredDownlinks = src.DeferredDownlinks
+			} else {
+				dst.DeferredDownlinks = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *AddApplicationDeferredDownlinkRequest) SetFields(src *AddApplicationDeferredDownlinkRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				newDst = &dst.EndDeviceIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "downlinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Downlinks = src.Downlinks
+			} else {
+				dst.Downlinks = nil
+			}
+		case "not_before":
+			if len(subs) > 0 {
+				return fmt.Errorf("'not_before' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NotBefore = src.NotBefore
+			} else {
+				var zero time.Time
+				dst.NotBefore = zero
+			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListApplicationDeferredDownlinksRequest) SetFields(src *ListApplicationDeferredDownlinksRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				newDst = &dst.EndDeviceIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DeleteApplicationDeferredDownlinkRequest) SetFields(src *DeleteApplicationDeferredDownlinkRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				newDst = &dst.EndDeviceIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Id = src.Id
+			} else {
+				var zero string
+				dst.Id = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *AsConfiguration_PubSub) SetFields(src *AsConfiguration_PubSub, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -826,3 +1307,44 @@ func (dst *AsConfiguration_PubSub_Providers) SetFields(src *AsConfiguration_PubS
 	}
 	return nil
 }
+
+func (dst *ApplicationDownlinkStatus_Transition) SetFields(src *ApplicationDownlinkStatus_Transition, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "state":
+			if len(subs) > 0 {
+				return fmt.Errorf("'state' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.State = src.State
+			} else {
+				var zero ApplicationDownlinkStatus_State
+				dst.State = zero
+			}
+		case "time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Time = src.Time
+			} else {
+				var zero time.Time
+				dst.Time = zero
+			}
+		case "error":
+			if len(subs) > 0 {
+				return fmt.Errorf("'error' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Error = src.Error
+			} else {
+				var zero string
+				dst.Error = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
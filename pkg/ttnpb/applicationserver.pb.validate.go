@@ -1577,6 +1577,1090 @@ var _ interface {
 	ErrorName() string
 } = DecodeDownlinkResponseValidationError{}
 
+// ValidateFields checks the field values on ApplicationDownlinkStatus with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationDownlinkStatus) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationDownlinkStatusFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "correlation_id":
+
+			if utf8.RuneCountInString(m.GetCorrelationId()) > 100 {
+				return ApplicationDownlinkStatusValidationError{
+					field:  "correlation_id",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIds).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationDownlinkStatusValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "f_port":
+			// no validation rules for FPort
+		case "f_cnt":
+			// no validation rules for FCnt
+		case "confirmed":
+			// no validation rules for Confirmed
+		case "state":
+
+			if _, ok := ApplicationDownlinkStatus_State_name[int32(m.GetState())]; !ok {
+				return ApplicationDownlinkStatusValidationError{
+					field:  "state",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "error":
+			// no validation rules for Error
+		case "history":
+
+			for idx, item := range m.GetHistory() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationDownlinkStatusValidationError{
+							field:  fmt.Sprintf("history[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "created_at":
+
+			if v, ok := interface{}(&m.CreatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationDownlinkStatusValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "updated_at":
+
+			if v, ok := interface{}(&m.UpdatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationDownlinkStatusValidationError{
+						field:  "updated_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ApplicationDownlinkStatusValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationDownlinkStatusValidationError is the validation error returned by
+// ApplicationDownlinkStatus.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationDownlinkStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationDownlinkStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationDownlinkStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationDownlinkStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationDownlinkStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationDownlinkStatusValidationError) ErrorName() string {
+	return "ApplicationDownlinkStatusValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationDownlinkStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationDownlinkStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationDownlinkStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationDownlinkStatusValidationError{}
+
+// ValidateFields checks the field values on ApplicationDownlinkStatuses with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationDownlinkStatuses) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationDownlinkStatusesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "statuses":
+
+			for idx, item := range m.GetStatuses() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationDownlinkStatusesValidationError{
+							field:  fmt.Sprintf("statuses[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationDownlinkStatusesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationDownlinkStatusesValidationError is the validation error returned
+// by ApplicationDownlinkStatuses.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationDownlinkStatusesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationDownlinkStatusesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationDownlinkStatusesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationDownlinkStatusesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationDownlinkStatusesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationDownlinkStatusesValidationError) ErrorName() string {
+	return "ApplicationDownlinkStatusesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationDownlinkStatusesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationDownlinkStatuses.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationDownlinkStatusesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationDownlinkStatusesValidationError{}
+
+// ValidateFields checks the field values on
+// GetApplicationDownlinkStatusRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is
+// returned.
+func (m *GetApplicationDownlinkStatusRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetApplicationDownlinkStatusRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetApplicationDownlinkStatusRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "correlation_id":
+
+			if l := utf8.RuneCountInString(m.GetCorrelationId()); l < 1 || l > 100 {
+				return GetApplicationDownlinkStatusRequestValidationError{
+					field:  "correlation_id",
+					reason: "value length must be between 1 and 100 runes, inclusive",
+				}
+			}
+
+		default:
+			return GetApplicationDownlinkStatusRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetApplicationDownlinkStatusRequestValidationError is the validation error
+// returned by GetApplicationDownlinkStatusRequest.ValidateFields if the
+// designated constraints aren't met.
+type GetApplicationDownlinkStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetApplicationDownlinkStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetApplicationDownlinkStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetApplicationDownlinkStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetApplicationDownlinkStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetApplicationDownlinkStatusRequestValidationError) ErrorName() string {
+	return "GetApplicationDownlinkStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetApplicationDownlinkStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetApplicationDownlinkStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetApplicationDownlinkStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetApplicationDownlinkStatusRequestValidationError{}
+
+// ValidateFields checks the field values on
+// ListApplicationDownlinkStatusesRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is
+// returned.
+func (m *ListApplicationDownlinkStatusesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListApplicationDownlinkStatusesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListApplicationDownlinkStatusesRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return ListApplicationDownlinkStatusesRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		default:
+			return ListApplicationDownlinkStatusesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListApplicationDownlinkStatusesRequestValidationError is the validation
+// error returned by ListApplicationDownlinkStatusesRequest.ValidateFields if
+// the designated constraints aren't met.
+type ListApplicationDownlinkStatusesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApplicationDownlinkStatusesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApplicationDownlinkStatusesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApplicationDownlinkStatusesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApplicationDownlinkStatusesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApplicationDownlinkStatusesRequestValidationError) ErrorName() string {
+	return "ListApplicationDownlinkStatusesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApplicationDownlinkStatusesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApplicationDownlinkStatusesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApplicationDownlinkStatusesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApplicationDownlinkStatusesRequestValidationError{}
+
+// ValidateFields checks the field values on ApplicationDeferredDownlink with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationDeferredDownlink) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationDeferredDownlinkFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "id":
+
+			if utf8.RuneCountInString(m.GetId()) > 26 {
+				return ApplicationDeferredDownlinkValidationError{
+					field:  "id",
+					reason: "value length must be at most 26 runes",
+				}
+			}
+
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIds).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationDeferredDownlinkValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "downlinks":
+
+			if l := len(m.GetDownlinks()); l < 1 || l > 16 {
+				return ApplicationDeferredDownlinkValidationError{
+					field:  "downlinks",
+					reason: "value must contain between 1 and 16 items, inclusive",
+				}
+			}
+
+			for idx, item := range m.GetDownlinks() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationDeferredDownlinkValidationError{
+							field:  fmt.Sprintf("downlinks[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "not_before":
+
+			if v, ok := interface{}(&m.NotBefore).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationDeferredDownlinkValidationError{
+						field:  "not_before",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationDeferredDownlinkValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "created_at":
+
+			if v, ok := interface{}(&m.CreatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationDeferredDownlinkValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "attempts":
+			// no validation rules for Attempts
+		default:
+			return ApplicationDeferredDownlinkValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationDeferredDownlinkValidationError is the validation error returned
+// by ApplicationDeferredDownlink.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationDeferredDownlinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationDeferredDownlinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationDeferredDownlinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationDeferredDownlinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationDeferredDownlinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationDeferredDownlinkValidationError) ErrorName() string {
+	return "ApplicationDeferredDownlinkValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationDeferredDownlinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationDeferredDownlink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationDeferredDownlinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationDeferredDownlinkValidationError{}
+
+// ValidateFields checks the field values on ApplicationDeferredDownlinks with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationDeferredDownlinks) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationDeferredDownlinksFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "deferred_downlinks":
+
+			for idx, item := range m.GetDeferredDownlinks() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationDeferredDownlinksValidationError{
+							field:  fmt.Sprintf("deferred_downlinks[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationDeferredDownlinksValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationDeferredDownlinksValidationError is the validation error returned
+// by ApplicationDeferredDownlinks.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationDeferredDownlinksValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationDeferredDownlinksValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationDeferredDownlinksValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationDeferredDownlinksValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationDeferredDownlinksValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationDeferredDownlinksValidationError) ErrorName() string {
+	return "ApplicationDeferredDownlinksValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationDeferredDownlinksValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationDeferredDownlinks.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationDeferredDownlinksValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationDeferredDownlinksValidationError{}
+
+// ValidateFields checks the field values on
+// AddApplicationDeferredDownlinkRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *AddApplicationDeferredDownlinkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = AddApplicationDeferredDownlinkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return AddApplicationDeferredDownlinkRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "downlinks":
+
+			if l := len(m.GetDownlinks()); l < 1 || l > 16 {
+				return AddApplicationDeferredDownlinkRequestValidationError{
+					field:  "downlinks",
+					reason: "value must contain between 1 and 16 items, inclusive",
+				}
+			}
+
+			for idx, item := range m.GetDownlinks() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return AddApplicationDeferredDownlinkRequestValidationError{
+							field:  fmt.Sprintf("downlinks[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "not_before":
+
+			if v, ok := interface{}(&m.NotBefore).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return AddApplicationDeferredDownlinkRequestValidationError{
+						field:  "not_before",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return AddApplicationDeferredDownlinkRequestValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return AddApplicationDeferredDownlinkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// AddApplicationDeferredDownlinkRequestValidationError is the validation error
+// returned by AddApplicationDeferredDownlinkRequest.ValidateFields if the
+// designated constraints aren't met.
+type AddApplicationDeferredDownlinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddApplicationDeferredDownlinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddApplicationDeferredDownlinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddApplicationDeferredDownlinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddApplicationDeferredDownlinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddApplicationDeferredDownlinkRequestValidationError) ErrorName() string {
+	return "AddApplicationDeferredDownlinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddApplicationDeferredDownlinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddApplicationDeferredDownlinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddApplicationDeferredDownlinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddApplicationDeferredDownlinkRequestValidationError{}
+
+// ValidateFields checks the field values on
+// ListApplicationDeferredDownlinksRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *ListApplicationDeferredDownlinksRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListApplicationDeferredDownlinksRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListApplicationDeferredDownlinksRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return ListApplicationDeferredDownlinksRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		default:
+			return ListApplicationDeferredDownlinksRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListApplicationDeferredDownlinksRequestValidationError is the validation
+// error returned by ListApplicationDeferredDownlinksRequest.ValidateFields if
+// the designated constraints aren't met.
+type ListApplicationDeferredDownlinksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApplicationDeferredDownlinksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApplicationDeferredDownlinksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApplicationDeferredDownlinksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApplicationDeferredDownlinksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApplicationDeferredDownlinksRequestValidationError) ErrorName() string {
+	return "ListApplicationDeferredDownlinksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApplicationDeferredDownlinksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApplicationDeferredDownlinksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApplicationDeferredDownlinksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApplicationDeferredDownlinksRequestValidationError{}
+
+// ValidateFields checks the field values on
+// DeleteApplicationDeferredDownlinkRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *DeleteApplicationDeferredDownlinkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DeleteApplicationDeferredDownlinkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DeleteApplicationDeferredDownlinkRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "id":
+
+			if l := utf8.RuneCountInString(m.GetId()); l < 1 || l > 26 {
+				return DeleteApplicationDeferredDownlinkRequestValidationError{
+					field:  "id",
+					reason: "value length must be between 1 and 26 runes, inclusive",
+				}
+			}
+
+		default:
+			return DeleteApplicationDeferredDownlinkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteApplicationDeferredDownlinkRequestValidationError is the validation
+// error returned by DeleteApplicationDeferredDownlinkRequest.ValidateFields if
+// the designated constraints aren't met.
+type DeleteApplicationDeferredDownlinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteApplicationDeferredDownlinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteApplicationDeferredDownlinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteApplicationDeferredDownlinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteApplicationDeferredDownlinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteApplicationDeferredDownlinkRequestValidationError) ErrorName() string {
+	return "DeleteApplicationDeferredDownlinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteApplicationDeferredDownlinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteApplicationDeferredDownlinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteApplicationDeferredDownlinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteApplicationDeferredDownlinkRequestValidationError{}
+
 // ValidateFields checks the field values on AsConfiguration_PubSub with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -1755,3 +2839,109 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = AsConfiguration_PubSub_ProvidersValidationError{}
+
+// ValidateFields checks the field values on
+// ApplicationDownlinkStatus_Transition with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is
+// returned.
+func (m *ApplicationDownlinkStatus_Transition) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationDownlinkStatus_TransitionFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "state":
+
+			if _, ok := ApplicationDownlinkStatus_State_name[int32(m.GetState())]; !ok {
+				return ApplicationDownlinkStatus_TransitionValidationError{
+					field:  "state",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "time":
+
+			if v, ok := interface{}(&m.Time).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationDownlinkStatus_TransitionValidationError{
+						field:  "time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "error":
+			// no validation rules for Error
+		default:
+			return ApplicationDownlinkStatus_TransitionValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationDownlinkStatus_TransitionValidationError is the validation error
+// returned by ApplicationDownlinkStatus_Transition.ValidateFields if the
+// designated constraints aren't met.
+type ApplicationDownlinkStatus_TransitionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationDownlinkStatus_TransitionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationDownlinkStatus_TransitionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationDownlinkStatus_TransitionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationDownlinkStatus_TransitionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationDownlinkStatus_TransitionValidationError) ErrorName() string {
+	return "ApplicationDownlinkStatus_TransitionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationDownlinkStatus_TransitionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationDownlinkStatus_Transition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationDownlinkStatus_TransitionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationDownlinkStatus_TransitionValidationError{}
@@ -655,3 +655,507 @@ func (x *DecodeDownlinkResponse) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState
 		}
 	})
 }
+
+// MarshalProtoJSON marshals the ApplicationDownlinkStatus_State to JSON.
+func (x ApplicationDownlinkStatus_State) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), ApplicationDownlinkStatus_State_name)
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationDownlinkStatus_State from JSON.
+func (x *ApplicationDownlinkStatus_State) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(ApplicationDownlinkStatus_State_value)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read State enum: %v", err)
+		return
+	}
+	*x = ApplicationDownlinkStatus_State(v)
+}
+
+// MarshalProtoJSON marshals the ApplicationDownlinkStatus_Transition message to JSON.
+func (x *ApplicationDownlinkStatus_Transition) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.State != 0 || s.HasField("state") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("state")
+		x.State.MarshalProtoJSON(s)
+	}
+	if true { // (gogoproto.nullable) = false
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("time")
+		s.WriteTime(x.Time)
+	}
+	if x.Error != "" || s.HasField("error") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("error")
+		s.WriteString(x.Error)
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationDownlinkStatus_Transition message from JSON.
+func (x *ApplicationDownlinkStatus_Transition) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "state":
+			s.AddField("state")
+			x.State.UnmarshalProtoJSON(s)
+		case "time":
+			s.AddField("time")
+			v := s.ReadTime()
+			if s.Err() != nil {
+				return
+			}
+			x.Time = *v
+		case "error":
+			s.AddField("error")
+			x.Error = s.ReadString()
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the ApplicationDownlinkStatus message to JSON.
+func (x *ApplicationDownlinkStatus) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.CorrelationId != "" || s.HasField("correlation_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("correlation_id")
+		s.WriteString(x.CorrelationId)
+	}
+	if true { // (gogoproto.nullable) = false
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		// NOTE: EndDeviceIdentifiers does not seem to implement MarshalProtoJSON.
+		gogo.MarshalMessage(s, &x.EndDeviceIds)
+	}
+	if x.FPort != 0 || s.HasField("f_port") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("f_port")
+		s.WriteUint32(x.FPort)
+	}
+	if x.FCnt != 0 || s.HasField("f_cnt") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("f_cnt")
+		s.WriteUint32(x.FCnt)
+	}
+	if x.Confirmed || s.HasField("confirmed") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("confirmed")
+		s.WriteBool(x.Confirmed)
+	}
+	if x.State != 0 || s.HasField("state") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("state")
+		x.State.MarshalProtoJSON(s)
+	}
+	if x.Error != "" || s.HasField("error") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("error")
+		s.WriteString(x.Error)
+	}
+	if len(x.History) > 0 || s.HasField("history") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("history")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.History {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("history"))
+		}
+		s.WriteArrayEnd()
+	}
+	if true { // (gogoproto.nullable) = false
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("created_at")
+		s.WriteTime(x.CreatedAt)
+	}
+	if true { // (gogoproto.nullable) = false
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("updated_at")
+		s.WriteTime(x.UpdatedAt)
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationDownlinkStatus message from JSON.
+func (x *ApplicationDownlinkStatus) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "correlation_id", "correlationId":
+			s.AddField("correlation_id")
+			x.CorrelationId = s.ReadString()
+		case "end_device_ids", "endDeviceIds":
+			s.AddField("end_device_ids")
+			// NOTE: EndDeviceIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v EndDeviceIdentifiers
+			gogo.UnmarshalMessage(s, &v)
+			x.EndDeviceIds = v
+		case "f_port", "fPort":
+			s.AddField("f_port")
+			x.FPort = s.ReadUint32()
+		case "f_cnt", "fCnt":
+			s.AddField("f_cnt")
+			x.FCnt = s.ReadUint32()
+		case "confirmed":
+			s.AddField("confirmed")
+			x.Confirmed = s.ReadBool()
+		case "state":
+			s.AddField("state")
+			x.State.UnmarshalProtoJSON(s)
+		case "error":
+			s.AddField("error")
+			x.Error = s.ReadString()
+		case "history":
+			s.AddField("history")
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.History = append(x.History, nil)
+					return
+				}
+				v := &ApplicationDownlinkStatus_Transition{}
+				v.UnmarshalProtoJSON(s.WithField("history", false))
+				if s.Err() != nil {
+					return
+				}
+				x.History = append(x.History, v)
+			})
+		case "created_at", "createdAt":
+			s.AddField("created_at")
+			v := s.ReadTime()
+			if s.Err() != nil {
+				return
+			}
+			x.CreatedAt = *v
+		case "updated_at", "updatedAt":
+			s.AddField("updated_at")
+			v := s.ReadTime()
+			if s.Err() != nil {
+				return
+			}
+			x.UpdatedAt = *v
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the ApplicationDownlinkStatuses message to JSON.
+func (x *ApplicationDownlinkStatuses) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Statuses) > 0 || s.HasField("statuses") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("statuses")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Statuses {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("statuses"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationDownlinkStatuses message from JSON.
+func (x *ApplicationDownlinkStatuses) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "statuses":
+			s.AddField("statuses")
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Statuses = append(x.Statuses, nil)
+					return
+				}
+				v := &ApplicationDownlinkStatus{}
+				v.UnmarshalProtoJSON(s.WithField("statuses", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Statuses = append(x.Statuses, v)
+			})
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the ApplicationDeferredDownlink message to JSON.
+func (x *ApplicationDeferredDownlink) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Id != "" || s.HasField("id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("id")
+		s.WriteString(x.Id)
+	}
+	if true { // (gogoproto.nullable) = false
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		// NOTE: EndDeviceIdentifiers does not seem to implement MarshalProtoJSON.
+		gogo.MarshalMessage(s, &x.EndDeviceIds)
+	}
+	if len(x.Downlinks) > 0 || s.HasField("downlinks") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("downlinks")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Downlinks {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("downlinks"))
+		}
+		s.WriteArrayEnd()
+	}
+	if true { // (gogoproto.nullable) = false
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("not_before")
+		s.WriteTime(x.NotBefore)
+	}
+	if x.ExpiresAt != nil || s.HasField("expires_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("expires_at")
+		if x.ExpiresAt == nil {
+			s.WriteNil()
+		} else {
+			s.WriteTime(*x.ExpiresAt)
+		}
+	}
+	if true { // (gogoproto.nullable) = false
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("created_at")
+		s.WriteTime(x.CreatedAt)
+	}
+	if x.Attempts != 0 || s.HasField("attempts") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("attempts")
+		s.WriteUint32(x.Attempts)
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationDeferredDownlink message from JSON.
+func (x *ApplicationDeferredDownlink) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "id":
+			s.AddField("id")
+			x.Id = s.ReadString()
+		case "end_device_ids", "endDeviceIds":
+			s.AddField("end_device_ids")
+			// NOTE: EndDeviceIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v EndDeviceIdentifiers
+			gogo.UnmarshalMessage(s, &v)
+			x.EndDeviceIds = v
+		case "downlinks":
+			s.AddField("downlinks")
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Downlinks = append(x.Downlinks, nil)
+					return
+				}
+				v := &ApplicationDownlink{}
+				v.UnmarshalProtoJSON(s.WithField("downlinks", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Downlinks = append(x.Downlinks, v)
+			})
+		case "not_before", "notBefore":
+			s.AddField("not_before")
+			v := s.ReadTime()
+			if s.Err() != nil {
+				return
+			}
+			x.NotBefore = *v
+		case "expires_at", "expiresAt":
+			s.AddField("expires_at")
+			v := s.ReadTime()
+			if s.Err() != nil {
+				return
+			}
+			x.ExpiresAt = v
+		case "created_at", "createdAt":
+			s.AddField("created_at")
+			v := s.ReadTime()
+			if s.Err() != nil {
+				return
+			}
+			x.CreatedAt = *v
+		case "attempts":
+			s.AddField("attempts")
+			x.Attempts = s.ReadUint32()
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the ApplicationDeferredDownlinks message to JSON.
+func (x *ApplicationDeferredDownlinks) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.DeferredDownlinks) > 0 || s.HasField("deferred_downlinks") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("deferred_downlinks")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.DeferredDownlinks {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("deferred_downlinks"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationDeferredDownlinks message from JSON.
+func (x *ApplicationDeferredDownlinks) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "deferred_downlinks", "deferredDownlinks":
+			s.AddField("deferred_downlinks")
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.DeferredDownlinks = append(x.DeferredDownlinks, nil)
+					return
+				}
+				v := &ApplicationDeferredDownlink{}
+				v.UnmarshalProtoJSON(s.WithField("deferred_downlinks", false))
+				if s.Err() != nil {
+					return
+				}
+				x.DeferredDownlinks = append(x.DeferredDownlinks, v)
+			})
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the AddApplicationDeferredDownlinkRequest message to JSON.
+func (x *AddApplicationDeferredDownlinkRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if true { // (gogoproto.nullable) = false
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		// NOTE: EndDeviceIdentifiers does not seem to implement MarshalProtoJSON.
+		gogo.MarshalMessage(s, &x.EndDeviceIdentifiers)
+	}
+	if len(x.Downlinks) > 0 || s.HasField("downlinks") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("downlinks")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Downlinks {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("downlinks"))
+		}
+		s.WriteArrayEnd()
+	}
+	if true { // (gogoproto.nullable) = false
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("not_before")
+		s.WriteTime(x.NotBefore)
+	}
+	if x.ExpiresAt != nil || s.HasField("expires_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("expires_at")
+		if x.ExpiresAt == nil {
+			s.WriteNil()
+		} else {
+			s.WriteTime(*x.ExpiresAt)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the AddApplicationDeferredDownlinkRequest message from JSON.
+func (x *AddApplicationDeferredDownlinkRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			s.AddField("end_device_ids")
+			// NOTE: EndDeviceIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v EndDeviceIdentifiers
+			gogo.UnmarshalMessage(s, &v)
+			x.EndDeviceIdentifiers = v
+		case "downlinks":
+			s.AddField("downlinks")
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Downlinks = append(x.Downlinks, nil)
+					return
+				}
+				v := &ApplicationDownlink{}
+				v.UnmarshalProtoJSON(s.WithField("downlinks", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Downlinks = append(x.Downlinks, v)
+			})
+		case "not_before", "notBefore":
+			s.AddField("not_before")
+			v := s.ReadTime()
+			if s.Err() != nil {
+				return
+			}
+			x.NotBefore = *v
+		case "expires_at", "expiresAt":
+			s.AddField("expires_at")
+			v := s.ReadTime()
+			if s.Err() != nil {
+				return
+			}
+			x.ExpiresAt = v
+		}
+	})
+}
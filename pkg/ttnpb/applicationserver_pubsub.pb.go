@@ -136,8 +136,11 @@ type ApplicationPubSub struct {
 	DownlinkQueueInvalidated *ApplicationPubSub_Message `protobuf:"bytes,19,opt,name=downlink_queue_invalidated,json=downlinkQueueInvalidated,proto3" json:"downlink_queue_invalidated,omitempty"`
 	LocationSolved           *ApplicationPubSub_Message `protobuf:"bytes,16,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
 	ServiceData              *ApplicationPubSub_Message `protobuf:"bytes,18,opt,name=service_data,json=serviceData,proto3" json:"service_data,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{}                   `json:"-"`
-	XXX_sizecache            int32                      `json:"-"`
+	// The routing rules of the Pub/Sub. Upstream messages are published if any of the rules match.
+	// If there are no rules, all upstream messages are published.
+	RoutingRules         []*ApplicationRoutingRule `protobuf:"bytes,20,rep,name=routing_rules,json=routingRules,proto3" json:"routing_rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *ApplicationPubSub) Reset()      { *m = ApplicationPubSub{} }
@@ -329,6 +332,13 @@ func (m *ApplicationPubSub) GetServiceData() *ApplicationPubSub_Message {
 	return nil
 }
 
+func (m *ApplicationPubSub) GetRoutingRules() []*ApplicationRoutingRule {
+	if m != nil {
+		return m.RoutingRules
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ApplicationPubSub) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1031,140 +1041,142 @@ func init() {
 }
 
 var fileDescriptor_1dce56ec18597200 = []byte{
-	// 2120 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x41, 0x6c, 0x13, 0xd9,
-	0x19, 0xce, 0xc4, 0x89, 0x13, 0xff, 0x76, 0x12, 0xf3, 0x96, 0x96, 0xc1, 0x40, 0xa0, 0xde, 0x68,
-	0xd7, 0x09, 0x8c, 0x0d, 0x4e, 0xbb, 0xbb, 0x18, 0x55, 0x60, 0x27, 0x01, 0xb2, 0x40, 0x20, 0x63,
-	0xaf, 0x28, 0x10, 0x18, 0x3d, 0x7b, 0x5e, 0x9c, 0x21, 0xe3, 0x99, 0x61, 0xde, 0x9b, 0x84, 0x2c,
-	0x20, 0xa1, 0x3d, 0x55, 0x3d, 0x54, 0x48, 0x3d, 0xb4, 0x52, 0x55, 0x55, 0x6a, 0x0f, 0x5d, 0xf5,
-	0xd2, 0x53, 0xcf, 0xdd, 0x63, 0xd5, 0x6b, 0x2f, 0xbd, 0x55, 0x65, 0x5b, 0xa9, 0xea, 0xa5, 0x3d,
-	0x56, 0xa9, 0x54, 0x55, 0xf3, 0xe6, 0x8d, 0x67, 0x62, 0xb3, 0x24, 0x86, 0xee, 0x29, 0xff, 0xf3,
-	0xff, 0xff, 0xdf, 0x7c, 0xff, 0xff, 0xfe, 0xfc, 0xff, 0x7b, 0x0f, 0xce, 0x9a, 0xb6, 0x8b, 0xb7,
-	0xb1, 0xa5, 0x50, 0x86, 0x5b, 0x9b, 0x25, 0xec, 0x18, 0x25, 0xec, 0x38, 0xa6, 0xd1, 0xc2, 0xcc,
-	0xb0, 0x2d, 0x4a, 0xdc, 0x2d, 0xe2, 0x6a, 0x8e, 0xd7, 0xa4, 0x5e, 0xb3, 0xe8, 0xb8, 0x36, 0xb3,
-	0xd1, 0x24, 0x63, 0x56, 0x51, 0x78, 0x15, 0xb7, 0xe6, 0x73, 0xd5, 0xb6, 0xc1, 0x36, 0xbc, 0x66,
-	0xb1, 0x65, 0x77, 0x4a, 0xc4, 0xda, 0xb2, 0x77, 0x1c, 0xd7, 0x7e, 0xbc, 0x53, 0xe2, 0xc6, 0x2d,
-	0xa5, 0x4d, 0x2c, 0x65, 0x0b, 0x9b, 0x86, 0x8e, 0x19, 0x29, 0xf5, 0x09, 0x01, 0x64, 0x4e, 0x89,
-	0x41, 0xb4, 0xed, 0xb6, 0x1d, 0x38, 0x37, 0xbd, 0x75, 0xbe, 0xe2, 0x0b, 0x2e, 0x09, 0xf3, 0x85,
-	0x98, 0x79, 0x63, 0x83, 0x34, 0x36, 0x0c, 0xab, 0x4d, 0x97, 0x2d, 0xdd, 0xa3, 0xcc, 0x35, 0x08,
-	0x8d, 0x7f, 0xba, 0x6d, 0x2b, 0x0f, 0xa9, 0x6d, 0x95, 0xb0, 0x65, 0xd9, 0x2c, 0x08, 0x49, 0x80,
-	0x1c, 0x6f, 0xdb, 0x76, 0xdb, 0x24, 0x41, 0xc4, 0x7d, 0xda, 0x69, 0xa1, 0xed, 0x12, 0xd1, 0x3d,
-	0x97, 0x1b, 0x08, 0xfd, 0xb1, 0x5e, 0x3d, 0xe9, 0x38, 0x6c, 0x47, 0x28, 0x4f, 0xf5, 0x2a, 0xd7,
-	0x0d, 0x62, 0xea, 0x5a, 0x07, 0xd3, 0x4d, 0x61, 0x71, 0xb2, 0xd7, 0x82, 0x19, 0x1d, 0x42, 0x19,
-	0xee, 0x38, 0xc2, 0xe0, 0xdd, 0xfe, 0x6d, 0x31, 0x74, 0x62, 0x31, 0x63, 0xdd, 0x20, 0xae, 0x20,
-	0x99, 0xff, 0x42, 0x82, 0xe3, 0xd5, 0x68, 0xb3, 0x6e, 0x79, 0xcd, 0xba, 0xd7, 0x5c, 0x8e, 0xcc,
-	0xd0, 0x1d, 0x98, 0x8a, 0x6d, 0xa6, 0x66, 0xe8, 0x54, 0x96, 0x4e, 0x49, 0x85, 0x74, 0xf9, 0xbd,
-	0xe2, 0xde, 0x4d, 0x2c, 0xc6, 0x60, 0x62, 0x00, 0xb5, 0xf1, 0xdd, 0xda, 0xe8, 0x0f, 0xa4, 0xe1,
-	0xac, 0xa4, 0x4e, 0xe2, 0xb8, 0x05, 0x45, 0x4b, 0x00, 0x8e, 0xd7, 0xd4, 0xa8, 0xd7, 0xd4, 0x0c,
-	0x5d, 0x1e, 0x3e, 0x25, 0x15, 0x52, 0xb5, 0xf7, 0x77, 0x6b, 0x33, 0x6e, 0x5e, 0x9e, 0x29, 0x4f,
-	0x3f, 0xb8, 0x87, 0x95, 0x4f, 0xcf, 0x2a, 0xe7, 0xef, 0x17, 0x2e, 0x56, 0xee, 0x29, 0xf7, 0x2f,
-	0x86, 0xcb, 0xd9, 0x27, 0xe5, 0x33, 0xcf, 0x66, 0xd4, 0x71, 0x47, 0x50, 0xcd, 0xff, 0xe6, 0x38,
-	0x1c, 0xea, 0x0b, 0x01, 0x5d, 0x85, 0x44, 0xc4, 0xf5, 0xcc, 0x6b, 0xb8, 0xf6, 0x85, 0x1c, 0x63,
-	0xec, 0x43, 0xa0, 0x8b, 0x00, 0x2d, 0x97, 0x60, 0x46, 0x74, 0x0d, 0x33, 0x4e, 0x33, 0x5d, 0xce,
-	0x15, 0x83, 0xec, 0x17, 0xc3, 0xec, 0x17, 0x1b, 0x61, 0xf6, 0x6b, 0x23, 0x2f, 0xfe, 0x7c, 0x52,
-	0x52, 0x53, 0xc2, 0xa7, 0xca, 0x7c, 0x00, 0xcf, 0xd1, 0x43, 0x80, 0xc4, 0x41, 0x01, 0x84, 0x0f,
-	0x07, 0x48, 0xae, 0xdb, 0x6e, 0x07, 0x33, 0x79, 0x24, 0x9e, 0xa4, 0xc3, 0xfb, 0x26, 0x49, 0xb8,
-	0xa1, 0x45, 0x18, 0xb1, 0x30, 0xa3, 0xf2, 0x21, 0xfe, 0xed, 0xe2, 0xbe, 0xd9, 0x28, 0xae, 0x54,
-	0x1b, 0xf5, 0x5b, 0xae, 0xbd, 0x65, 0xe8, 0xc4, 0xbd, 0x3a, 0xa4, 0x72, 0x6f, 0x1f, 0xa5, 0xf3,
-	0x88, 0x31, 0xf9, 0xe8, 0x41, 0x51, 0x6e, 0xac, 0x36, 0x1a, 0x71, 0x14, 0xdf, 0x1b, 0x5d, 0x83,
-	0x31, 0xbc, 0x4d, 0x35, 0xc3, 0x66, 0x32, 0xe1, 0x40, 0x67, 0xf7, 0x07, 0xaa, 0xde, 0xae, 0x2f,
-	0xdb, 0x71, 0xa8, 0x24, 0xde, 0xa6, 0xcb, 0x36, 0x43, 0xef, 0x01, 0x34, 0x31, 0x25, 0x1a, 0xb3,
-	0x1d, 0xa3, 0x25, 0x27, 0x79, 0x76, 0xc6, 0x76, 0x6b, 0x23, 0xee, 0xb0, 0xac, 0xab, 0x29, 0x5f,
-	0xd5, 0xf0, 0x35, 0x68, 0x05, 0x26, 0x74, 0x7b, 0xdb, 0x32, 0x0d, 0x6b, 0x53, 0x73, 0x3c, 0xba,
-	0x21, 0x8f, 0xf1, 0x4f, 0xcf, 0x1e, 0x20, 0x06, 0x42, 0x29, 0x6e, 0x13, 0x35, 0x13, 0xfa, 0xdf,
-	0xf2, 0xe8, 0x06, 0x6a, 0x40, 0xb6, 0x8b, 0xe7, 0x12, 0xc7, 0xc4, 0x2d, 0x22, 0x8f, 0x0f, 0x0a,
-	0x39, 0x15, 0x42, 0xa8, 0x01, 0x02, 0xba, 0x05, 0x93, 0x9e, 0xc3, 0x31, 0x3b, 0x81, 0x89, 0x9c,
-	0x1a, 0x14, 0x73, 0x22, 0x00, 0x10, 0x4b, 0xf4, 0x31, 0xa4, 0x1f, 0xda, 0x86, 0xa5, 0xe1, 0x56,
-	0x8b, 0x38, 0x4c, 0x86, 0x41, 0xe1, 0xc0, 0xf7, 0xae, 0x72, 0x67, 0x74, 0x1d, 0xba, 0x39, 0xd0,
-	0x70, 0x6b, 0x53, 0x4e, 0x0f, 0x0a, 0x96, 0x0e, 0xdd, 0xab, 0xad, 0xcd, 0x3d, 0x3b, 0x62, 0xf9,
-	0x70, 0x99, 0x37, 0xde, 0x91, 0x15, 0xdc, 0x83, 0x47, 0x89, 0xc5, 0xe4, 0x89, 0x37, 0xc6, 0xab,
-	0x13, 0x8b, 0x21, 0x15, 0xba, 0xdb, 0xa3, 0xad, 0x63, 0xc3, 0x24, 0xba, 0x3c, 0x39, 0x28, 0xe2,
-	0x64, 0x88, 0x70, 0x99, 0x03, 0xec, 0xc1, 0x7c, 0xe4, 0x11, 0x8f, 0xe8, 0xf2, 0xd4, 0x1b, 0x63,
-	0xae, 0x72, 0x00, 0xd4, 0x86, 0xdc, 0x5e, 0x4c, 0xcd, 0xb0, 0xc2, 0xd1, 0xa8, 0xcb, 0xef, 0x0c,
-	0x0a, 0x2f, 0xef, 0x81, 0x5f, 0x8e, 0xa0, 0x7c, 0xf2, 0xa6, 0x2d, 0xa6, 0x00, 0xb5, 0xcd, 0x2d,
-	0xa2, 0xcb, 0xd9, 0x81, 0xc9, 0x87, 0x08, 0x75, 0x0e, 0xe0, 0x97, 0x94, 0x7f, 0x3c, 0x30, 0x5a,
-	0x44, 0xd3, 0x31, 0xc3, 0x32, 0x1a, 0xb8, 0xa4, 0x84, 0xfb, 0x22, 0x66, 0x38, 0xf7, 0x21, 0x64,
-	0xe2, 0x7d, 0x0b, 0xbd, 0x0f, 0x20, 0x0e, 0x1f, 0x9e, 0x6b, 0xf2, 0x49, 0x90, 0xe2, 0xbd, 0xdd,
-	0x4d, 0x7c, 0x5f, 0x92, 0xd4, 0x54, 0xa0, 0xfb, 0xc4, 0x35, 0x73, 0x7f, 0x18, 0x85, 0x4c, 0xbc,
-	0x57, 0x1d, 0xd8, 0x13, 0xcd, 0x40, 0xaa, 0x65, 0x1a, 0xc4, 0x62, 0xd1, 0x04, 0x13, 0xed, 0xe7,
-	0x88, 0x3a, 0x1e, 0x68, 0x96, 0x75, 0xf4, 0x2e, 0x8c, 0x7b, 0x94, 0xb8, 0x16, 0xee, 0x10, 0xde,
-	0xfe, 0x63, 0x3d, 0xaa, 0xab, 0xf0, 0x8d, 0x1c, 0x4c, 0xe9, 0xb6, 0xed, 0xea, 0xa2, 0xcd, 0x47,
-	0x46, 0xa1, 0x02, 0xdd, 0x86, 0x09, 0xea, 0x35, 0x69, 0xcb, 0x35, 0x9a, 0x44, 0x7b, 0x64, 0x53,
-	0x79, 0xf4, 0x94, 0x54, 0x98, 0x2c, 0x97, 0x07, 0xeb, 0xc5, 0xc5, 0x55, 0xbb, 0xae, 0x66, 0xba,
-	0x40, 0xab, 0x36, 0x45, 0x75, 0x48, 0x3b, 0x5e, 0xd3, 0x34, 0xe8, 0x06, 0x87, 0x4d, 0xbe, 0x31,
-	0x2c, 0x08, 0x18, 0x1f, 0xf4, 0x08, 0x8c, 0x79, 0x7e, 0x73, 0x36, 0x29, 0xef, 0xb7, 0xe3, 0x6a,
-	0xd2, 0xa3, 0xa4, 0x61, 0x52, 0x74, 0x12, 0x92, 0xcc, 0xa4, 0x5a, 0x0b, 0xf3, 0xa6, 0x99, 0xe1,
-	0xb9, 0xfd, 0x34, 0x21, 0x3f, 0xbf, 0xa4, 0x8e, 0x32, 0x93, 0x2e, 0x60, 0x74, 0x16, 0xa6, 0xb8,
-	0x41, 0x90, 0xdb, 0x16, 0x71, 0x19, 0x6f, 0x85, 0x71, 0xcb, 0x09, 0xdf, 0x92, 0xeb, 0x17, 0x88,
-	0xcb, 0x50, 0x11, 0x26, 0x63, 0x1e, 0x9b, 0x64, 0x87, 0x37, 0xbb, 0xb8, 0x43, 0xa6, 0xeb, 0x70,
-	0x8d, 0xec, 0xa0, 0x4f, 0x60, 0x6c, 0x83, 0x60, 0x9d, 0xb8, 0x54, 0x4e, 0x9f, 0x4a, 0x14, 0xd2,
-	0xe5, 0x0b, 0x03, 0x06, 0x7b, 0x35, 0xf0, 0x5e, 0xb2, 0x98, 0xbb, 0xa3, 0x86, 0x58, 0xb9, 0x0a,
-	0x64, 0xe2, 0x0a, 0x94, 0x85, 0x84, 0xcf, 0x85, 0x97, 0x90, 0xea, 0x8b, 0xe8, 0x30, 0x8c, 0x6e,
-	0x61, 0xd3, 0x23, 0x41, 0xb9, 0xa8, 0xc1, 0xa2, 0x32, 0xfc, 0x91, 0x94, 0x5f, 0x84, 0xc4, 0xaa,
-	0x5d, 0x47, 0x59, 0xc8, 0x54, 0x1b, 0xda, 0x8d, 0x9b, 0xf5, 0x86, 0x76, 0x73, 0x65, 0x61, 0x29,
-	0x3b, 0x84, 0x0e, 0xc1, 0x44, 0xb5, 0xa1, 0x5d, 0x5f, 0xaa, 0x86, 0x3f, 0x49, 0xbe, 0xd1, 0xd2,
-	0xf7, 0xaa, 0x0b, 0x8d, 0xeb, 0x77, 0x82, 0x5f, 0x86, 0x73, 0xc9, 0x7f, 0xfc, 0xfa, 0xe8, 0xb0,
-	0x2c, 0xe5, 0xfe, 0x96, 0x82, 0xc9, 0xbd, 0xf3, 0x12, 0xfd, 0x74, 0x18, 0x92, 0x2e, 0x69, 0x1b,
-	0xb6, 0x25, 0x6a, 0xf9, 0xb3, 0xe1, 0xdd, 0xda, 0x7f, 0x25, 0xf7, 0x3f, 0x92, 0x0a, 0x78, 0x5d,
-	0xa1, 0xb6, 0xc7, 0x36, 0x94, 0x73, 0x6a, 0x0a, 0x3b, 0x0a, 0xc1, 0x94, 0x29, 0xe7, 0xfc, 0xa3,
-	0x9a, 0x62, 0xd9, 0x2e, 0xdb, 0x78, 0xe5, 0xba, 0xac, 0x02, 0x76, 0xba, 0x6e, 0x93, 0xa1, 0x1c,
-	0xb3, 0x8d, 0xd6, 0x65, 0x35, 0xd3, 0xc2, 0x4a, 0x8b, 0x58, 0xcc, 0xc5, 0xa6, 0x72, 0x4e, 0xcd,
-	0x10, 0x2f, 0xb6, 0x02, 0xe2, 0x05, 0xb8, 0x42, 0xee, 0x52, 0x21, 0x9e, 0xb2, 0x4d, 0x38, 0x5c,
-	0x57, 0x2c, 0x47, 0xe2, 0xbc, 0x0a, 0x1d, 0x12, 0x19, 0x53, 0x1c, 0xf2, 0x4e, 0x79, 0xb4, 0x4f,
-	0x2c, 0x73, 0x31, 0x44, 0x0b, 0xc5, 0xb2, 0x2a, 0x52, 0x82, 0xee, 0x00, 0xf8, 0xe3, 0x91, 0x52,
-	0x5e, 0x35, 0xc1, 0xf9, 0xae, 0x32, 0xe8, 0x99, 0xa4, 0x58, 0xe5, 0x10, 0xd7, 0xc8, 0x8e, 0x9a,
-	0xc2, 0xa1, 0x88, 0xd6, 0x20, 0x8d, 0x29, 0xf5, 0x3a, 0x44, 0x73, 0x6d, 0x93, 0x88, 0xa3, 0xdf,
-	0x85, 0xc1, 0xb1, 0x39, 0x86, 0x6a, 0x9b, 0x44, 0x05, 0xdc, 0x95, 0xd1, 0x2f, 0x25, 0xc8, 0x12,
-	0x4b, 0x77, 0x6c, 0xc3, 0x62, 0x1a, 0xd6, 0x75, 0x97, 0x50, 0x2a, 0x5a, 0xc7, 0xe3, 0xdd, 0x9a,
-	0xe7, 0x52, 0xf9, 0xb9, 0x54, 0xb6, 0x1e, 0x14, 0x0a, 0x05, 0xff, 0x58, 0x58, 0x55, 0xee, 0xfa,
-	0x27, 0xc3, 0xa7, 0x31, 0x39, 0x12, 0xd7, 0x94, 0xfb, 0x73, 0x31, 0xc5, 0xec, 0x5a, 0x71, 0x76,
-	0xae, 0x70, 0xaf, 0xaa, 0xdc, 0x15, 0xe7, 0xc9, 0xa7, 0x31, 0x39, 0x12, 0xb9, 0x57, 0xa4, 0x98,
-	0x7d, 0x3a, 0x3b, 0xa3, 0x4e, 0x85, 0x8c, 0xaa, 0x01, 0x21, 0xa4, 0xc1, 0x98, 0x4e, 0xd6, 0xb1,
-	0x67, 0x32, 0xde, 0xac, 0xd2, 0xe5, 0x85, 0x81, 0xe3, 0x5f, 0x0c, 0xfc, 0x97, 0x2d, 0x46, 0xda,
-	0xc1, 0x15, 0xea, 0xea, 0x90, 0x1a, 0xa2, 0xe6, 0x3e, 0x97, 0x20, 0xd5, 0xcd, 0x3e, 0xfa, 0x10,
-	0x26, 0xa2, 0xdd, 0xf4, 0xbb, 0x72, 0x50, 0xf1, 0xef, 0xec, 0xd6, 0xb2, 0xee, 0x64, 0x36, 0xeb,
-	0xa7, 0x64, 0xec, 0xc1, 0xbd, 0xb5, 0xed, 0xfb, 0x73, 0x33, 0x6a, 0xba, 0xbb, 0x53, 0xcb, 0x3a,
-	0x9a, 0x87, 0x43, 0x94, 0xb4, 0x5c, 0xc2, 0xb4, 0x9e, 0x6a, 0xe8, 0x36, 0xe2, 0x82, 0x3a, 0x15,
-	0x58, 0x44, 0x5f, 0x53, 0x60, 0x82, 0x12, 0x4a, 0xfd, 0x99, 0xc8, 0xec, 0x4d, 0x62, 0x89, 0xf6,
-	0x1e, 0xcc, 0x0a, 0xf9, 0xf9, 0xb0, 0x9a, 0x11, 0xea, 0x86, 0xaf, 0xcd, 0xbd, 0x94, 0x00, 0xa2,
-	0xcd, 0x44, 0xd7, 0x20, 0x81, 0xdd, 0xf0, 0x7f, 0xf2, 0xfc, 0x6e, 0xed, 0x03, 0xf7, 0xdb, 0xe5,
-	0xf2, 0x03, 0xec, 0x5a, 0x15, 0xbc, 0x4d, 0x2b, 0x06, 0xee, 0x54, 0x2a, 0xf7, 0xfc, 0xc4, 0x3e,
-	0x39, 0x57, 0x7e, 0x56, 0xf1, 0x2b, 0x68, 0xad, 0x14, 0xa5, 0x5b, 0x3b, 0xfd, 0xdd, 0x33, 0xc5,
-	0x4b, 0xca, 0xfd, 0xd3, 0x33, 0xaa, 0x8f, 0x82, 0x2e, 0x40, 0x9a, 0x3c, 0x66, 0xfe, 0x30, 0x31,
-	0xa3, 0x61, 0x94, 0xdb, 0xad, 0x1d, 0x71, 0xbf, 0x21, 0xff, 0x3e, 0x55, 0xce, 0xfa, 0x41, 0x73,
-	0x97, 0xca, 0x5a, 0x49, 0xf1, 0xa3, 0x87, 0xd0, 0x7c, 0x59, 0x47, 0x1f, 0x43, 0x36, 0x8c, 0x23,
-	0xbc, 0xa5, 0x8a, 0x6a, 0x3d, 0xda, 0x77, 0x51, 0x59, 0x14, 0x06, 0xb5, 0x91, 0x9f, 0xf8, 0xf7,
-	0x94, 0x29, 0xe1, 0x18, 0xfe, 0x9c, 0xbb, 0x0d, 0xa8, 0x7f, 0xc3, 0x50, 0x15, 0x80, 0xdf, 0x43,
-	0x35, 0x3e, 0x05, 0x83, 0x90, 0xf3, 0xbb, 0xb5, 0x93, 0xee, 0x09, 0x7f, 0x4b, 0xe4, 0x07, 0x22,
-	0xb0, 0x9e, 0xea, 0x9a, 0x51, 0x53, 0xdc, 0x6b, 0x05, 0x77, 0x48, 0x2d, 0x03, 0xa0, 0x13, 0xc7,
-	0xb4, 0x77, 0x3a, 0xc4, 0x62, 0xb9, 0x02, 0x8c, 0x85, 0xa7, 0xdc, 0x13, 0x30, 0x1a, 0x5c, 0x00,
-	0xa4, 0xbd, 0x73, 0x33, 0xf8, 0xb5, 0x36, 0x05, 0xe3, 0x4e, 0xd8, 0x0a, 0x13, 0xff, 0xae, 0x49,
-	0xf9, 0x55, 0x40, 0x7d, 0x35, 0x47, 0xd1, 0x05, 0x18, 0x0b, 0x1e, 0x29, 0xfc, 0x5b, 0xa3, 0x3f,
-	0x11, 0xbe, 0xb5, 0x6f, 0xa1, 0xaa, 0xa1, 0x47, 0xfe, 0x57, 0x12, 0xc8, 0x7d, 0xea, 0xcb, 0xfc,
-	0xf6, 0x45, 0xd1, 0x4d, 0x18, 0x0b, 0x2e, 0x62, 0x21, 0xf2, 0x77, 0xf6, 0x45, 0x16, 0xae, 0x45,
-	0xf1, 0x57, 0x4c, 0x19, 0x81, 0xe2, 0x4f, 0x99, 0xb8, 0x62, 0xa0, 0x29, 0xf3, 0x0b, 0x09, 0x8e,
-	0x5d, 0x21, 0xac, 0x3f, 0x16, 0xf2, 0xc8, 0x23, 0x94, 0xfd, 0x1f, 0x2f, 0xce, 0xe7, 0x01, 0xa2,
-	0x57, 0x8b, 0xaf, 0xbc, 0x38, 0x5f, 0xf6, 0x4d, 0x6e, 0x60, 0xba, 0xa9, 0xa6, 0xd6, 0x43, 0x31,
-	0xff, 0x5b, 0x09, 0x4e, 0x5c, 0x37, 0x68, 0x3f, 0x4b, 0x1a, 0xd2, 0xfc, 0x1a, 0xdf, 0x25, 0xde,
-	0x82, 0xf7, 0xcf, 0x24, 0x38, 0x56, 0x7f, 0x4d, 0x72, 0x17, 0x20, 0x19, 0x54, 0x8c, 0x20, 0xbb,
-	0x7f, 0x89, 0xc5, 0x78, 0x0a, 0xd7, 0xb7, 0xe0, 0x57, 0xfe, 0x5d, 0x12, 0x8e, 0xbe, 0x82, 0x5c,
-	0xdb, 0xa0, 0x7e, 0x19, 0x3d, 0x04, 0xb8, 0x42, 0x58, 0x58, 0xb5, 0xdf, 0xec, 0x83, 0x5c, 0xea,
-	0x38, 0x6c, 0x27, 0x57, 0x38, 0x68, 0xf1, 0xe6, 0x73, 0x9f, 0xfd, 0xf1, 0xaf, 0x3f, 0x1a, 0x3e,
-	0x8c, 0x50, 0x09, 0xd3, 0x52, 0x40, 0x5e, 0x11, 0x25, 0x8c, 0x7e, 0x2e, 0x41, 0xe2, 0x0a, 0x61,
-	0xe8, 0x74, 0x2f, 0xda, 0x6b, 0x6a, 0x33, 0xb7, 0x7f, 0xba, 0xf2, 0x57, 0xf9, 0x37, 0x6b, 0xe8,
-	0x52, 0xf4, 0xcd, 0xd2, 0x13, 0x43, 0xa7, 0xc5, 0x9e, 0x6a, 0xe9, 0x59, 0x3f, 0x0b, 0x8c, 0xa2,
-	0xf7, 0xa8, 0x67, 0xe8, 0x87, 0x12, 0x8c, 0xf8, 0x35, 0x88, 0x94, 0xde, 0xaf, 0xbe, 0xb6, 0x32,
-	0x73, 0xf9, 0x7d, 0x49, 0xd2, 0xfc, 0x3c, 0x67, 0xa9, 0xa0, 0xd3, 0x71, 0x96, 0xfb, 0x30, 0x44,
-	0xff, 0x94, 0x20, 0x51, 0x7f, 0x55, 0xca, 0xea, 0x6f, 0x97, 0xb2, 0x1f, 0x4b, 0x9c, 0xcd, 0x0b,
-	0x29, 0xb7, 0x12, 0xa7, 0x23, 0x5e, 0x6c, 0x0f, 0x94, 0xbb, 0x98, 0x6d, 0x2c, 0x85, 0x15, 0x69,
-	0xee, 0xee, 0x85, 0xfc, 0x07, 0x6f, 0x06, 0x5a, 0x91, 0xe6, 0xd0, 0x0b, 0x09, 0x92, 0x8b, 0xc4,
-	0x24, 0x8c, 0xa0, 0x81, 0x3a, 0x51, 0xee, 0x2b, 0x6a, 0x37, 0x7f, 0x89, 0x47, 0x5a, 0x99, 0xfb,
-	0x68, 0x80, 0xbc, 0x73, 0xd2, 0x61, 0x48, 0xb5, 0x1b, 0x7f, 0xfa, 0xcb, 0xf4, 0xd0, 0xf3, 0x97,
-	0xd3, 0xd2, 0xe7, 0x2f, 0xa7, 0xa5, 0xbf, 0xbf, 0x9c, 0x1e, 0xfa, 0xd7, 0xcb, 0x69, 0xe9, 0xc5,
-	0x97, 0xd3, 0x43, 0x5f, 0x7c, 0x39, 0x2d, 0xdd, 0x2d, 0xb5, 0xed, 0x22, 0xdb, 0x20, 0x8c, 0x3f,
-	0x27, 0x17, 0x2d, 0xc2, 0xb6, 0x6d, 0x77, 0xb3, 0xb4, 0xf7, 0x21, 0x76, 0x6b, 0xbe, 0xe4, 0x6c,
-	0xb6, 0x4b, 0x8c, 0x59, 0x4e, 0xb3, 0x99, 0xe4, 0x04, 0xe7, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff,
-	0x30, 0x5a, 0x8d, 0x6e, 0x44, 0x17, 0x00, 0x00,
+	// 2157 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0x41, 0x6c, 0xdb, 0xc8,
+	0x15, 0x40, 0x4d, 0xc9, 0x96, 0xad, 0x2f, 0xd9, 0x56, 0x66, 0xd3, 0x86, 0x51, 0x36, 0x8e, 0xab,
+	0x35, 0x76, 0x65, 0x27, 0x94, 0x12, 0xb9, 0xdd, 0xdd, 0x28, 0x28, 0x12, 0xc9, 0x76, 0x12, 0x6f,
+	0x12, 0x27, 0xa6, 0x14, 0xa4, 0x49, 0x9c, 0x10, 0x23, 0x71, 0x2c, 0x33, 0xa6, 0x48, 0x66, 0x66,
+	0x68, 0xc7, 0x9b, 0x04, 0x08, 0xf6, 0x50, 0x14, 0x3d, 0x14, 0x41, 0x7b, 0x68, 0x81, 0xa2, 0x28,
+	0xd0, 0x1e, 0xba, 0xe8, 0xbd, 0xe7, 0xee, 0xb1, 0xe8, 0xb5, 0x97, 0xde, 0x8a, 0x66, 0x5b, 0xa0,
+	0xe8, 0xa5, 0x3d, 0x16, 0x2e, 0x50, 0x14, 0x1c, 0x92, 0x12, 0x2d, 0x65, 0x63, 0xcb, 0x69, 0x4f,
+	0xfa, 0xc3, 0xff, 0xff, 0xe3, 0x9f, 0x3f, 0x5f, 0xf3, 0x67, 0x08, 0x67, 0x4d, 0x9b, 0xe2, 0x6d,
+	0x6c, 0x29, 0x8c, 0xe3, 0xe6, 0x66, 0x11, 0x3b, 0x46, 0x11, 0x3b, 0x8e, 0x69, 0x34, 0x31, 0x37,
+	0x6c, 0x8b, 0x11, 0xba, 0x45, 0xa8, 0xe6, 0xb8, 0x0d, 0xe6, 0x36, 0x0a, 0x0e, 0xb5, 0xb9, 0x8d,
+	0x26, 0x38, 0xb7, 0x0a, 0x81, 0x57, 0x61, 0x6b, 0x3e, 0x5b, 0x69, 0x19, 0x7c, 0xc3, 0x6d, 0x14,
+	0x9a, 0x76, 0xbb, 0x48, 0xac, 0x2d, 0x7b, 0xc7, 0xa1, 0xf6, 0x93, 0x9d, 0xa2, 0x30, 0x6e, 0x2a,
+	0x2d, 0x62, 0x29, 0x5b, 0xd8, 0x34, 0x74, 0xcc, 0x49, 0xb1, 0x4f, 0xf0, 0x91, 0x59, 0x25, 0x82,
+	0x68, 0xd9, 0x2d, 0xdb, 0x77, 0x6e, 0xb8, 0xeb, 0x62, 0x24, 0x06, 0x42, 0x0a, 0xcc, 0x17, 0x22,
+	0xe6, 0xf5, 0x0d, 0x52, 0xdf, 0x30, 0xac, 0x16, 0x5b, 0xb6, 0x74, 0x97, 0x71, 0x6a, 0x10, 0x16,
+	0x7d, 0x75, 0xcb, 0x56, 0x1e, 0x31, 0xdb, 0x2a, 0x62, 0xcb, 0xb2, 0xb9, 0x3f, 0xa5, 0x00, 0xf2,
+	0x6e, 0xcb, 0xb6, 0x5b, 0x26, 0xf1, 0x67, 0xdc, 0xa7, 0x9d, 0x0a, 0xb4, 0x9d, 0x40, 0x74, 0x97,
+	0x0a, 0x83, 0x40, 0x7f, 0xa2, 0x57, 0x4f, 0xda, 0x0e, 0xdf, 0x09, 0x94, 0xd3, 0xbd, 0xca, 0x75,
+	0x83, 0x98, 0xba, 0xd6, 0xc6, 0x6c, 0x33, 0xb0, 0x38, 0xd5, 0x6b, 0xc1, 0x8d, 0x36, 0x61, 0x1c,
+	0xb7, 0x9d, 0xc0, 0xe0, 0xbd, 0xfe, 0x65, 0x31, 0x74, 0x62, 0x71, 0x63, 0xdd, 0x20, 0x34, 0x0c,
+	0x72, 0xba, 0xdf, 0xa8, 0x4d, 0x18, 0xc3, 0x2d, 0x12, 0x58, 0xe4, 0xbe, 0x90, 0xe0, 0xdd, 0x4a,
+	0x77, 0x39, 0x6f, 0xb9, 0x8d, 0x9a, 0xdb, 0x58, 0xee, 0x82, 0xd0, 0x5d, 0x98, 0x8c, 0x2c, 0xb7,
+	0x66, 0xe8, 0x4c, 0x96, 0xa6, 0xa5, 0x7c, 0xaa, 0xf4, 0x7e, 0x61, 0xef, 0x32, 0x17, 0x22, 0x98,
+	0x08, 0xa0, 0x3a, 0xb6, 0x5b, 0x1d, 0xf9, 0xbe, 0x14, 0xcb, 0x48, 0xea, 0x04, 0x8e, 0x5a, 0x30,
+	0xb4, 0x04, 0xe0, 0xb8, 0x0d, 0x8d, 0xb9, 0x0d, 0xcd, 0xd0, 0xe5, 0xd8, 0xb4, 0x94, 0x4f, 0x56,
+	0x3f, 0xd8, 0xad, 0xce, 0xd0, 0x9c, 0x3c, 0x53, 0x9a, 0x7a, 0x78, 0x1f, 0x2b, 0x9f, 0x9e, 0x55,
+	0xce, 0x3f, 0xc8, 0x5f, 0x2c, 0xdf, 0x57, 0x1e, 0x5c, 0x0c, 0x87, 0xb3, 0x4f, 0x4b, 0x67, 0x9e,
+	0xcf, 0xa8, 0x63, 0x4e, 0x10, 0x6a, 0xee, 0xbb, 0x27, 0xe1, 0x48, 0xdf, 0x14, 0xd0, 0x55, 0x88,
+	0x77, 0x63, 0x3d, 0xf3, 0x86, 0x58, 0xfb, 0xa6, 0x1c, 0x89, 0xd8, 0x43, 0xa0, 0x8b, 0x00, 0x4d,
+	0x4a, 0x30, 0x27, 0xba, 0x86, 0xb9, 0x08, 0x33, 0x55, 0xca, 0x16, 0xfc, 0xf5, 0x29, 0x84, 0xeb,
+	0x53, 0xa8, 0x87, 0xeb, 0x53, 0x1d, 0x7e, 0xf9, 0xa7, 0x53, 0x92, 0x9a, 0x0c, 0x7c, 0x2a, 0xdc,
+	0x03, 0xb8, 0x8e, 0x1e, 0x02, 0xe2, 0x07, 0x05, 0x04, 0x3e, 0x02, 0x90, 0x58, 0xb7, 0x69, 0x1b,
+	0x73, 0x79, 0x38, 0x9a, 0xa4, 0xa3, 0xfb, 0x26, 0x29, 0x70, 0x43, 0x8b, 0x30, 0x6c, 0x61, 0xce,
+	0xe4, 0x23, 0xe2, 0xdd, 0x85, 0x7d, 0xb3, 0x51, 0x58, 0xa9, 0xd4, 0x6b, 0xb7, 0xa8, 0xbd, 0x65,
+	0xe8, 0x84, 0x5e, 0x1d, 0x52, 0x85, 0xb7, 0x47, 0x69, 0x3f, 0xe6, 0x5c, 0x3e, 0x7e, 0x50, 0xca,
+	0x8d, 0xd5, 0x7a, 0x3d, 0x4a, 0xf1, 0xbc, 0xd1, 0x35, 0x18, 0xc5, 0xdb, 0x4c, 0x33, 0x6c, 0x2e,
+	0x13, 0x01, 0x3a, 0xbb, 0x3f, 0xa8, 0x72, 0xa7, 0xb6, 0x6c, 0x47, 0x51, 0x09, 0xbc, 0xcd, 0x96,
+	0x6d, 0x8e, 0xde, 0x07, 0x68, 0x60, 0x46, 0x34, 0x6e, 0x3b, 0x46, 0x53, 0x4e, 0x88, 0xec, 0x8c,
+	0xee, 0x56, 0x87, 0x69, 0x4c, 0xd6, 0xd5, 0xa4, 0xa7, 0xaa, 0x7b, 0x1a, 0xb4, 0x02, 0xe3, 0xba,
+	0xbd, 0x6d, 0x99, 0x86, 0xb5, 0xa9, 0x39, 0x2e, 0xdb, 0x90, 0x47, 0xc5, 0xab, 0x67, 0x0f, 0x30,
+	0x07, 0xff, 0xff, 0xa2, 0xa6, 0x43, 0xff, 0x5b, 0x2e, 0xdb, 0x40, 0x75, 0xc8, 0x74, 0x78, 0x94,
+	0x38, 0x26, 0x6e, 0x12, 0x79, 0x6c, 0x50, 0xe4, 0x64, 0x88, 0x50, 0x7d, 0x02, 0xba, 0x05, 0x13,
+	0xae, 0x23, 0x98, 0xc1, 0xbf, 0x54, 0x4e, 0x0e, 0xca, 0x1c, 0xf7, 0x01, 0xc1, 0x10, 0x7d, 0x02,
+	0xa9, 0x47, 0xb6, 0x61, 0x69, 0xb8, 0xd9, 0x24, 0x0e, 0x97, 0x61, 0x50, 0x1c, 0x78, 0xde, 0x15,
+	0xe1, 0x8c, 0xae, 0x43, 0x27, 0x07, 0x1a, 0x6e, 0x6e, 0xca, 0xa9, 0x41, 0x61, 0xa9, 0xd0, 0xbd,
+	0xd2, 0xdc, 0xdc, 0xb3, 0x22, 0x96, 0x87, 0x4b, 0x1f, 0x7a, 0x45, 0x56, 0x70, 0x0f, 0x8f, 0x11,
+	0x8b, 0xcb, 0xe3, 0x87, 0xe6, 0xd5, 0x88, 0xc5, 0x91, 0x0a, 0x9d, 0xe5, 0xd1, 0xd6, 0xb1, 0x61,
+	0x12, 0x5d, 0x9e, 0x18, 0x94, 0x38, 0x11, 0x12, 0x2e, 0x0b, 0xc0, 0x1e, 0xe6, 0x63, 0x97, 0xb8,
+	0x44, 0x97, 0x27, 0x0f, 0xcd, 0x5c, 0x15, 0x00, 0xd4, 0x82, 0xec, 0x5e, 0xa6, 0x66, 0x58, 0x61,
+	0xf3, 0xd4, 0xe5, 0x77, 0x06, 0xc5, 0xcb, 0x7b, 0xf0, 0xcb, 0x5d, 0x94, 0x17, 0xbc, 0x69, 0x07,
+	0x5d, 0x80, 0xd9, 0xe6, 0x16, 0xd1, 0xe5, 0xcc, 0xc0, 0xc1, 0x87, 0x84, 0x9a, 0x00, 0x78, 0x25,
+	0xe5, 0x1d, 0x20, 0x8c, 0x26, 0xd1, 0x74, 0xcc, 0xb1, 0x8c, 0x06, 0x2e, 0xa9, 0xc0, 0x7d, 0x11,
+	0x73, 0x8c, 0x6e, 0xc3, 0x38, 0xb5, 0x5d, 0x6e, 0x58, 0x2d, 0x8d, 0xba, 0x26, 0x61, 0xf2, 0xd1,
+	0xe9, 0xf8, 0x3e, 0x8d, 0x4a, 0xf5, 0xed, 0x55, 0xd7, 0x24, 0x62, 0xdb, 0xff, 0xa1, 0x14, 0xcb,
+	0x64, 0xd4, 0x34, 0xed, 0x3e, 0x66, 0xd9, 0x8f, 0x20, 0x1d, 0xdd, 0x0e, 0xd1, 0x07, 0x00, 0xc1,
+	0xa9, 0xc7, 0xa5, 0xa6, 0x68, 0x30, 0x49, 0xe1, 0x4b, 0xe3, 0xdf, 0x93, 0x24, 0x35, 0xe9, 0xeb,
+	0x6e, 0x53, 0x33, 0xfb, 0xfb, 0x11, 0x48, 0x47, 0xb7, 0xc0, 0x03, 0x7b, 0xa2, 0x19, 0x48, 0x36,
+	0x4d, 0x83, 0x58, 0xbc, 0xdb, 0x18, 0x83, 0x5d, 0xed, 0x98, 0x3a, 0xe6, 0x6b, 0x96, 0x75, 0xf4,
+	0x1e, 0x8c, 0xb9, 0x8c, 0x50, 0x0b, 0xb7, 0x89, 0x1c, 0x8f, 0x1a, 0xe9, 0x6a, 0x47, 0xe1, 0x19,
+	0x39, 0x98, 0xb1, 0x6d, 0x9b, 0xea, 0xf2, 0x70, 0x8f, 0x51, 0xa8, 0x40, 0x77, 0x60, 0x9c, 0xb9,
+	0x0d, 0xd6, 0xa4, 0x46, 0x83, 0x68, 0x8f, 0x6d, 0x26, 0x8f, 0x4c, 0x4b, 0xf9, 0x89, 0x52, 0x69,
+	0xb0, 0x2d, 0xbe, 0xb0, 0x6a, 0xd7, 0xd4, 0x74, 0x07, 0xb4, 0x6a, 0x33, 0x54, 0x83, 0x94, 0xe3,
+	0x36, 0x4c, 0x83, 0x6d, 0x08, 0x6c, 0xe2, 0xd0, 0x58, 0x08, 0x30, 0x1e, 0xf4, 0x18, 0x8c, 0xba,
+	0xde, 0x9e, 0x6f, 0x32, 0xb1, 0x8d, 0x8f, 0xa9, 0x09, 0x97, 0x91, 0xba, 0xc9, 0xd0, 0x29, 0x48,
+	0x70, 0x93, 0x69, 0x4d, 0x2c, 0xf6, 0xe2, 0xb4, 0xc8, 0xed, 0xa7, 0x71, 0xf9, 0xc5, 0x25, 0x75,
+	0x84, 0x9b, 0x6c, 0x01, 0xa3, 0xb3, 0x30, 0x29, 0x0c, 0xfc, 0xdc, 0x36, 0x09, 0xe5, 0x72, 0xb2,
+	0xc7, 0x72, 0xdc, 0xb3, 0x14, 0xfa, 0x05, 0x42, 0x39, 0x2a, 0xc0, 0x44, 0xc4, 0x63, 0x93, 0xec,
+	0xc8, 0xd0, 0xe3, 0x90, 0xee, 0x38, 0x5c, 0x23, 0x3b, 0xe8, 0x36, 0x8c, 0x6e, 0x10, 0xac, 0x13,
+	0xca, 0xe4, 0x94, 0xa8, 0xbe, 0x0b, 0x03, 0x4e, 0xf6, 0xaa, 0xef, 0xbd, 0x64, 0x71, 0xba, 0xa3,
+	0x86, 0xac, 0x6c, 0x19, 0xd2, 0x51, 0x05, 0xca, 0x40, 0xdc, 0x8b, 0x45, 0x94, 0x90, 0xea, 0x89,
+	0xe8, 0x28, 0x8c, 0x6c, 0x61, 0xd3, 0x25, 0x7e, 0xb9, 0xa8, 0xfe, 0xa0, 0x1c, 0xfb, 0x58, 0xca,
+	0x2d, 0x42, 0x7c, 0xd5, 0xae, 0xa1, 0x0c, 0xa4, 0x2b, 0x75, 0xed, 0xc6, 0xcd, 0x5a, 0x5d, 0xbb,
+	0xb9, 0xb2, 0xb0, 0x94, 0x19, 0x42, 0x47, 0x60, 0xbc, 0x52, 0xd7, 0xae, 0x2f, 0x55, 0xc2, 0x47,
+	0x92, 0x67, 0xb4, 0xf4, 0x9d, 0xca, 0x42, 0xfd, 0xfa, 0x5d, 0xff, 0x49, 0x2c, 0x9b, 0xf8, 0xfb,
+	0xaf, 0x8f, 0xc7, 0x64, 0x29, 0xfb, 0xd7, 0x24, 0x4c, 0xec, 0x6d, 0xc3, 0xe8, 0xa7, 0x31, 0x48,
+	0x50, 0xd2, 0x32, 0x6c, 0x2b, 0xa8, 0xe5, 0xcf, 0x62, 0xbb, 0xd5, 0xff, 0x48, 0xf4, 0xdf, 0x92,
+	0x0a, 0x78, 0x5d, 0x61, 0xb6, 0xcb, 0x37, 0x94, 0x73, 0x6a, 0x12, 0x3b, 0x0a, 0xc1, 0x8c, 0x2b,
+	0xe7, 0xbc, 0x13, 0xa0, 0x62, 0xd9, 0x94, 0x6f, 0xbc, 0x76, 0x5c, 0x52, 0x01, 0x3b, 0x1d, 0xb7,
+	0x89, 0x50, 0x8e, 0xd8, 0x76, 0xc7, 0x25, 0x35, 0xdd, 0xc4, 0x4a, 0x93, 0x58, 0x9c, 0x62, 0x53,
+	0x39, 0xa7, 0xa6, 0x89, 0x1b, 0x19, 0x01, 0x71, 0x7d, 0x6e, 0x20, 0x77, 0x42, 0x21, 0xae, 0xb2,
+	0x4d, 0x18, 0x8f, 0x8a, 0xa5, 0xae, 0x38, 0xaf, 0x42, 0x9b, 0x74, 0x8d, 0x19, 0x0e, 0xe3, 0x4e,
+	0xba, 0xac, 0x4f, 0x2c, 0x09, 0x31, 0xa4, 0x85, 0x62, 0x49, 0x0d, 0x52, 0x82, 0xee, 0x02, 0x78,
+	0x5d, 0x97, 0x31, 0x51, 0x35, 0xfe, 0xb1, 0xb1, 0x3c, 0xe8, 0x51, 0xa7, 0x50, 0x11, 0x88, 0x6b,
+	0x64, 0x47, 0x4d, 0xe2, 0x50, 0x44, 0x6b, 0x90, 0xc2, 0x8c, 0xb9, 0x6d, 0xa2, 0x51, 0xdb, 0x24,
+	0xc1, 0x89, 0xf2, 0xc2, 0xe0, 0x6c, 0xc1, 0x50, 0x6d, 0x93, 0xa8, 0x80, 0x3b, 0x32, 0xfa, 0xa5,
+	0x04, 0x19, 0x62, 0xe9, 0x8e, 0x6d, 0x58, 0x5c, 0xc3, 0xba, 0x4e, 0x09, 0x63, 0xc1, 0xd6, 0xf1,
+	0x64, 0xb7, 0xea, 0x52, 0x26, 0xbf, 0x90, 0x4a, 0xd6, 0xc3, 0x7c, 0x3e, 0xef, 0x9d, 0x36, 0x2b,
+	0xca, 0x3d, 0xef, 0xc0, 0xf9, 0x2c, 0x22, 0x77, 0xc5, 0x35, 0xe5, 0xc1, 0x5c, 0x44, 0x31, 0xbb,
+	0x56, 0x98, 0x9d, 0xcb, 0xdf, 0xaf, 0x28, 0xf7, 0x82, 0x63, 0xea, 0xb3, 0x88, 0xdc, 0x15, 0x85,
+	0x57, 0x57, 0x31, 0xfb, 0x6c, 0x76, 0x46, 0x9d, 0x0c, 0x23, 0xaa, 0xf8, 0x01, 0x21, 0x0d, 0x46,
+	0x75, 0xb2, 0x8e, 0x5d, 0x93, 0x8b, 0xcd, 0x2a, 0x55, 0x5a, 0x18, 0x78, 0xfe, 0x8b, 0xbe, 0xff,
+	0xb2, 0xc5, 0x49, 0xcb, 0xbf, 0xbb, 0x5d, 0x1d, 0x52, 0x43, 0x6a, 0xf6, 0x73, 0x09, 0x92, 0x9d,
+	0xec, 0xa3, 0x8f, 0x60, 0xbc, 0xbb, 0x9a, 0xde, 0xae, 0xec, 0x57, 0xfc, 0x3b, 0xbb, 0xd5, 0x0c,
+	0x9d, 0xc8, 0x64, 0xbc, 0x94, 0x8c, 0x3e, 0xbc, 0xbf, 0xb6, 0xfd, 0x60, 0x6e, 0x46, 0x4d, 0x75,
+	0x56, 0x6a, 0x59, 0x47, 0xf3, 0x70, 0x84, 0x91, 0x26, 0x25, 0x5c, 0xeb, 0xa9, 0x86, 0xce, 0x46,
+	0x9c, 0x57, 0x27, 0x7d, 0x8b, 0xee, 0xdb, 0x14, 0x18, 0x67, 0x84, 0x31, 0xaf, 0xd5, 0x72, 0x7b,
+	0x93, 0x58, 0xc1, 0xf6, 0xee, 0xf7, 0x0a, 0xf9, 0x45, 0x4c, 0x4d, 0x07, 0xea, 0xba, 0xa7, 0xcd,
+	0xbe, 0x92, 0x00, 0xba, 0x8b, 0x89, 0xae, 0x41, 0x1c, 0xd3, 0xf0, 0x3f, 0x79, 0x7e, 0xb7, 0xfa,
+	0x21, 0xfd, 0x66, 0xa9, 0xf4, 0x10, 0x53, 0xab, 0x8c, 0xb7, 0x59, 0xd9, 0xc0, 0xed, 0x72, 0xf9,
+	0xbe, 0x97, 0xd8, 0xa7, 0xe7, 0x4a, 0xcf, 0xcb, 0x5e, 0x05, 0xad, 0x15, 0xbb, 0xe9, 0xd6, 0x4e,
+	0x7f, 0xfb, 0x4c, 0xe1, 0x92, 0xf2, 0xe0, 0xf4, 0x8c, 0xea, 0x51, 0xd0, 0x05, 0x48, 0x91, 0x27,
+	0xdc, 0x6b, 0x26, 0x66, 0xb7, 0x19, 0x65, 0x77, 0xab, 0xc7, 0xe8, 0xd7, 0xe4, 0xdf, 0x25, 0x4b,
+	0x19, 0x6f, 0xd2, 0xc2, 0xa5, 0xbc, 0x56, 0x54, 0xbc, 0xd9, 0x43, 0x68, 0xbe, 0xac, 0xa3, 0x4f,
+	0x20, 0x13, 0xce, 0x23, 0xbc, 0x1e, 0x07, 0xd5, 0x7a, 0xbc, 0xef, 0xfe, 0xb3, 0x18, 0x18, 0x54,
+	0x87, 0x7f, 0xe2, 0x5d, 0x7f, 0x26, 0x03, 0xc7, 0xf0, 0x71, 0xf6, 0x0e, 0xa0, 0xfe, 0x05, 0x43,
+	0x15, 0x00, 0x71, 0xb7, 0xd5, 0x44, 0x17, 0xf4, 0xa7, 0x9c, 0xdb, 0xad, 0x9e, 0xa2, 0x27, 0xbd,
+	0x25, 0x91, 0x1f, 0x06, 0x13, 0xeb, 0xa9, 0xae, 0x19, 0x35, 0x29, 0xbc, 0x56, 0x70, 0x9b, 0x54,
+	0xd3, 0x00, 0x3a, 0x71, 0x4c, 0x7b, 0xa7, 0x4d, 0x2c, 0x9e, 0xcd, 0xc3, 0x68, 0x78, 0x78, 0x3e,
+	0x09, 0x23, 0xfe, 0xbd, 0x42, 0xda, 0xdb, 0x37, 0xfd, 0xa7, 0xd5, 0x49, 0x18, 0x73, 0xc2, 0xad,
+	0x30, 0xfe, 0xaf, 0xaa, 0x94, 0x5b, 0x05, 0xd4, 0x57, 0x73, 0x0c, 0x5d, 0x80, 0x51, 0xff, 0xeb,
+	0x88, 0x77, 0x19, 0xf5, 0x3a, 0xc2, 0x37, 0xf6, 0x2d, 0x54, 0x35, 0xf4, 0xc8, 0xfd, 0x4a, 0x02,
+	0xb9, 0x4f, 0x7d, 0x59, 0x5c, 0xea, 0x18, 0xba, 0x09, 0xa3, 0xfe, 0xfd, 0x2e, 0x24, 0x7f, 0x6b,
+	0x5f, 0x72, 0xe0, 0x5a, 0x08, 0x7e, 0x83, 0x2e, 0x13, 0x50, 0xbc, 0x2e, 0x13, 0x55, 0x0c, 0xd4,
+	0x65, 0x7e, 0x21, 0xc1, 0x89, 0x2b, 0x84, 0xf7, 0xcf, 0x85, 0x3c, 0x76, 0x09, 0xe3, 0xff, 0xc3,
+	0xfb, 0xf8, 0x79, 0x80, 0xee, 0xe7, 0x92, 0xaf, 0xbc, 0x8f, 0x5f, 0xf6, 0x4c, 0x6e, 0x60, 0xb6,
+	0xa9, 0x26, 0xd7, 0x43, 0x31, 0xf7, 0x1b, 0x09, 0x4e, 0x5e, 0x37, 0x58, 0x7f, 0x94, 0x2c, 0x0c,
+	0xf3, 0xff, 0xf8, 0xb9, 0xe3, 0x2d, 0xe2, 0xfe, 0x99, 0x04, 0x27, 0x6a, 0x6f, 0x48, 0xee, 0x02,
+	0x24, 0xfc, 0x8a, 0x09, 0x82, 0xdd, 0xbf, 0xc4, 0x22, 0x71, 0x06, 0xae, 0x6f, 0x11, 0x5f, 0xe9,
+	0xb7, 0x09, 0x38, 0xfe, 0x9a, 0xe0, 0x5a, 0x06, 0xf3, 0xca, 0xe8, 0x11, 0xc0, 0x15, 0xc2, 0xc3,
+	0xaa, 0xfd, 0x7a, 0x1f, 0x72, 0xc9, 0xfb, 0x32, 0x96, 0xcd, 0x1f, 0xb4, 0x78, 0x73, 0xd9, 0xcf,
+	0xfe, 0xf0, 0x97, 0x1f, 0xc5, 0x8e, 0x22, 0x54, 0xc4, 0xac, 0xe8, 0x07, 0xaf, 0x04, 0x25, 0x8c,
+	0x7e, 0x2e, 0x41, 0xfc, 0x0a, 0xe1, 0xe8, 0x74, 0x2f, 0xed, 0x0d, 0xb5, 0x99, 0xdd, 0x3f, 0x5d,
+	0xb9, 0xab, 0xe2, 0x9d, 0x55, 0x74, 0xa9, 0xfb, 0xce, 0xe2, 0x53, 0x43, 0x67, 0x85, 0x9e, 0x6a,
+	0xe9, 0x19, 0x3f, 0xf7, 0x8d, 0xba, 0x9f, 0xb9, 0x9e, 0xa3, 0x1f, 0x48, 0x30, 0xec, 0xd5, 0x20,
+	0x52, 0x7a, 0xdf, 0xfa, 0xc6, 0xca, 0xcc, 0xe6, 0xf6, 0x0d, 0x92, 0xe5, 0xe6, 0x45, 0x94, 0x0a,
+	0x3a, 0x1d, 0x8d, 0x72, 0x9f, 0x08, 0xd1, 0x3f, 0x24, 0x88, 0xd7, 0x5e, 0x97, 0xb2, 0xda, 0xdb,
+	0xa5, 0xec, 0xc7, 0x92, 0x88, 0xe6, 0xa5, 0x94, 0x5d, 0x89, 0x86, 0xe3, 0xff, 0x16, 0x0e, 0x94,
+	0xbb, 0x88, 0x6d, 0x24, 0x85, 0x65, 0x69, 0xee, 0xde, 0x85, 0xdc, 0x87, 0x87, 0x83, 0x96, 0xa5,
+	0x39, 0xf4, 0x52, 0x82, 0xc4, 0x22, 0x31, 0x09, 0x27, 0x68, 0xa0, 0x9d, 0x28, 0xfb, 0x15, 0xb5,
+	0x9b, 0xbb, 0x24, 0x66, 0x5a, 0x9e, 0xfb, 0x78, 0x80, 0xbc, 0x17, 0x9f, 0x46, 0xa6, 0x54, 0xbd,
+	0xf1, 0xc7, 0x3f, 0x4f, 0x0d, 0xbd, 0x78, 0x35, 0x25, 0x7d, 0xfe, 0x6a, 0x4a, 0xfa, 0xdb, 0xab,
+	0xa9, 0xa1, 0x7f, 0xbe, 0x9a, 0x92, 0x5e, 0x7e, 0x39, 0x35, 0xf4, 0xc5, 0x97, 0x53, 0xd2, 0xbd,
+	0x62, 0xcb, 0x2e, 0xf0, 0x0d, 0xc2, 0xc5, 0x77, 0xec, 0x82, 0x45, 0xf8, 0xb6, 0x4d, 0x37, 0x8b,
+	0x7b, 0x3f, 0xee, 0x6e, 0xcd, 0x17, 0x9d, 0xcd, 0x56, 0x91, 0x73, 0xcb, 0x69, 0x34, 0x12, 0x22,
+	0xc0, 0xf9, 0xff, 0x0e, 0x00, 0x72, 0xda, 0xdf, 0x3a, 0xbd, 0x17, 0x00, 0x00,
 }
 
 func (x ApplicationPubSub_MQTTProvider_QoS) String() string {
@@ -1288,6 +1300,14 @@ func (this *ApplicationPubSub) Equal(that interface{}) bool {
 	if !this.ServiceData.Equal(that1.ServiceData) {
 		return false
 	}
+	if len(this.RoutingRules) != len(that1.RoutingRules) {
+		return false
+	}
+	for i := range this.RoutingRules {
+		if !this.RoutingRules[i].Equal(that1.RoutingRules[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ApplicationPubSub_Nats) Equal(that interface{}) bool {
@@ -2004,6 +2024,11 @@ func (this *ApplicationPubSub) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForRoutingRules := "[]*ApplicationRoutingRule{"
+	for _, f := range this.RoutingRules {
+		repeatedStringForRoutingRules += strings.Replace(fmt.Sprintf("%v", f), "ApplicationRoutingRule", "ApplicationRoutingRule", 1) + ","
+	}
+	repeatedStringForRoutingRules += "}"
 	s := strings.Join([]string{`&ApplicationPubSub{`,
 		`Ids:` + strings.Replace(this.Ids.String(), "ApplicationPubSubIdentifiers", "ApplicationPubSubIdentifiers", 1) + `,`,
 		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
@@ -2023,6 +2048,7 @@ func (this *ApplicationPubSub) String() string {
 		`DownlinkQueueInvalidated:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkQueueInvalidated), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
 		`LocationSolved:` + strings.Replace(fmt.Sprintf("%v", this.LocationSolved), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
 		`ServiceData:` + strings.Replace(fmt.Sprintf("%v", this.ServiceData), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
+		`RoutingRules:` + repeatedStringForRoutingRules + `,`,
 		`}`,
 	}, "")
 	return s
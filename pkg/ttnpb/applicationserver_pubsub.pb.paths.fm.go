@@ -69,6 +69,7 @@ var ApplicationPubSubFieldPathsNested = []string{
 	"provider.mqtt.username",
 	"provider.nats",
 	"provider.nats.server_url",
+	"routing_rules",
 	"service_data",
 	"service_data.topic",
 	"updated_at",
@@ -92,6 +93,7 @@ var ApplicationPubSubFieldPathsTopLevel = []string{
 	"join_accept",
 	"location_solved",
 	"provider",
+	"routing_rules",
 	"service_data",
 	"updated_at",
 	"uplink_message",
@@ -191,6 +193,7 @@ var SetApplicationPubSubRequestFieldPathsNested = []string{
 	"pubsub.provider.mqtt.username",
 	"pubsub.provider.nats",
 	"pubsub.provider.nats.server_url",
+	"pubsub.routing_rules",
 	"pubsub.service_data",
 	"pubsub.service_data.topic",
 	"pubsub.updated_at",
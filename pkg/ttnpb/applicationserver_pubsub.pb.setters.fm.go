@@ -416,6 +416,15 @@ func (dst *ApplicationPubSub) SetFields(src *ApplicationPubSub, paths ...string)
 					dst.ServiceData = nil
 				}
 			}
+		case "routing_rules":
+			if len(subs) > 0 {
+				return fmt.Errorf("'routing_rules' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RoutingRules = src.RoutingRules
+			} else {
+				dst.RoutingRules = nil
+			}
 
 		case "provider":
 			if len(subs) == 0 && src == nil {
@@ -446,6 +446,30 @@ func (m *ApplicationPubSub) ValidateFields(paths ...string) error {
 
 				}
 			}
+		case "routing_rules":
+
+			if len(m.GetRoutingRules()) > 16 {
+				return ApplicationPubSubValidationError{
+					field:  "routing_rules",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetRoutingRules() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationPubSubValidationError{
+							field:  fmt.Sprintf("routing_rules[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
 		default:
 			return ApplicationPubSubValidationError{
 				field:  name,
@@ -280,6 +280,18 @@ func (x *ApplicationPubSub) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		// NOTE: ApplicationPubSub_Message does not seem to implement MarshalProtoJSON.
 		gogo.MarshalMessage(s, x.ServiceData)
 	}
+	if len(x.RoutingRules) > 0 || s.HasField("routing_rules") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("routing_rules")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.RoutingRules {
+			s.WriteMoreIf(&wroteElement)
+			// NOTE: ApplicationRoutingRule does not seem to implement MarshalProtoJSON.
+			gogo.MarshalMessage(s, element)
+		}
+		s.WriteArrayEnd()
+	}
 	s.WriteObjectEnd()
 }
 
@@ -413,6 +425,14 @@ func (x *ApplicationPubSub) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 			var v ApplicationPubSub_Message
 			gogo.UnmarshalMessage(s, &v)
 			x.ServiceData = &v
+		case "routing_rules", "routingRules":
+			s.AddField("routing_rules")
+			s.ReadArray(func() {
+				// NOTE: ApplicationRoutingRule does not seem to implement UnmarshalProtoJSON.
+				var v ApplicationRoutingRule
+				gogo.UnmarshalMessage(s, &v)
+				x.RoutingRules = append(x.RoutingRules, &v)
+			})
 		}
 	})
 }
@@ -18,6 +18,7 @@ import (
 	status "google.golang.org/grpc/status"
 	math "math"
 	reflect "reflect"
+	strconv "strconv"
 	strings "strings"
 	time "time"
 )
@@ -35,6 +36,32 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type ApplicationWebhook_BodyTemplate_Language int32
+
+const (
+	// Go text/template, with the JSON representation of the message as data, like `{{ .uplink_message.f_port }}`.
+	// Templates can use actions like `if`, `range` and `with`, the `json` and `contentType` functions and a restricted set
+	// of built-in functions. Templates can not define or execute other templates, and range actions are limited.
+	ApplicationWebhook_BodyTemplate_GO_TEMPLATE ApplicationWebhook_BodyTemplate_Language = 0
+	// JavaScript `formatUp(up)` function which returns either the body as string,
+	// or an object with the `body` and `contentType` fields.
+	ApplicationWebhook_BodyTemplate_JAVASCRIPT ApplicationWebhook_BodyTemplate_Language = 1
+)
+
+var ApplicationWebhook_BodyTemplate_Language_name = map[int32]string{
+	0: "GO_TEMPLATE",
+	1: "JAVASCRIPT",
+}
+
+var ApplicationWebhook_BodyTemplate_Language_value = map[string]int32{
+	"GO_TEMPLATE": 0,
+	"JAVASCRIPT":  1,
+}
+
+func (ApplicationWebhook_BodyTemplate_Language) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{5, 3, 0}
+}
+
 type ApplicationWebhookIdentifiers struct {
 	ApplicationIds       *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
 	WebhookId            string                  `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
@@ -495,8 +522,13 @@ type ApplicationWebhook struct {
 	DownlinkQueueInvalidated *ApplicationWebhook_Message `protobuf:"bytes,19,opt,name=downlink_queue_invalidated,json=downlinkQueueInvalidated,proto3" json:"downlink_queue_invalidated,omitempty"`
 	LocationSolved           *ApplicationWebhook_Message `protobuf:"bytes,14,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
 	ServiceData              *ApplicationWebhook_Message `protobuf:"bytes,18,opt,name=service_data,json=serviceData,proto3" json:"service_data,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{}                    `json:"-"`
-	XXX_sizecache            int32                       `json:"-"`
+	// The template that renders the body of upstream messages, if the format is `template`.
+	BodyTemplate *ApplicationWebhook_BodyTemplate `protobuf:"bytes,20,opt,name=body_template,json=bodyTemplate,proto3" json:"body_template,omitempty"`
+	// The routing rules of the webhook. Upstream messages are sent to the webhook if any of the rules match.
+	// If there are no rules, all upstream messages are sent to the webhook.
+	RoutingRules         []*ApplicationRoutingRule `protobuf:"bytes,21,rep,name=routing_rules,json=routingRules,proto3" json:"routing_rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *ApplicationWebhook) Reset()      { *m = ApplicationWebhook{} }
@@ -655,6 +687,20 @@ func (m *ApplicationWebhook) GetServiceData() *ApplicationWebhook_Message {
 	return nil
 }
 
+func (m *ApplicationWebhook) GetBodyTemplate() *ApplicationWebhook_BodyTemplate {
+	if m != nil {
+		return m.BodyTemplate
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetRoutingRules() []*ApplicationRoutingRule {
+	if m != nil {
+		return m.RoutingRules
+	}
+	return nil
+}
+
 type ApplicationWebhook_Message struct {
 	// Path to append to the base URL.
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
@@ -692,6 +738,50 @@ func (m *ApplicationWebhook_Message) GetPath() string {
 	return ""
 }
 
+type ApplicationWebhook_BodyTemplate struct {
+	Language             ApplicationWebhook_BodyTemplate_Language `protobuf:"varint,1,opt,name=language,proto3,enum=ttn.lorawan.v3.ApplicationWebhook_BodyTemplate_Language" json:"language,omitempty"`
+	Template             string                                   `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *ApplicationWebhook_BodyTemplate) Reset()      { *m = ApplicationWebhook_BodyTemplate{} }
+func (*ApplicationWebhook_BodyTemplate) ProtoMessage() {}
+func (*ApplicationWebhook_BodyTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{5, 3}
+}
+func (m *ApplicationWebhook_BodyTemplate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationWebhook_BodyTemplate.Unmarshal(m, b)
+}
+func (m *ApplicationWebhook_BodyTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationWebhook_BodyTemplate.Marshal(b, m, deterministic)
+}
+func (m *ApplicationWebhook_BodyTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhook_BodyTemplate.Merge(m, src)
+}
+func (m *ApplicationWebhook_BodyTemplate) XXX_Size() int {
+	return xxx_messageInfo_ApplicationWebhook_BodyTemplate.Size(m)
+}
+func (m *ApplicationWebhook_BodyTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhook_BodyTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhook_BodyTemplate proto.InternalMessageInfo
+
+func (m *ApplicationWebhook_BodyTemplate) GetLanguage() ApplicationWebhook_BodyTemplate_Language {
+	if m != nil {
+		return m.Language
+	}
+	return ApplicationWebhook_BodyTemplate_GO_TEMPLATE
+}
+
+func (m *ApplicationWebhook_BodyTemplate) GetTemplate() string {
+	if m != nil {
+		return m.Template
+	}
+	return ""
+}
+
 type ApplicationWebhooks struct {
 	Webhooks             []*ApplicationWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -980,6 +1070,8 @@ func (m *ListApplicationWebhookTemplatesRequest) GetFieldMask() *types.FieldMask
 }
 
 func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.ApplicationWebhook_BodyTemplate_Language", ApplicationWebhook_BodyTemplate_Language_name, ApplicationWebhook_BodyTemplate_Language_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationWebhook_BodyTemplate_Language", ApplicationWebhook_BodyTemplate_Language_name, ApplicationWebhook_BodyTemplate_Language_value)
 	proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
 	golang_proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
 	proto.RegisterType((*ApplicationWebhookTemplateIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplateIdentifiers")
@@ -1002,6 +1094,8 @@ func init() {
 	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.TemplateFieldsEntry")
 	proto.RegisterType((*ApplicationWebhook_Message)(nil), "ttn.lorawan.v3.ApplicationWebhook.Message")
 	golang_proto.RegisterType((*ApplicationWebhook_Message)(nil), "ttn.lorawan.v3.ApplicationWebhook.Message")
+	proto.RegisterType((*ApplicationWebhook_BodyTemplate)(nil), "ttn.lorawan.v3.ApplicationWebhook.BodyTemplate")
+	golang_proto.RegisterType((*ApplicationWebhook_BodyTemplate)(nil), "ttn.lorawan.v3.ApplicationWebhook.BodyTemplate")
 	proto.RegisterType((*ApplicationWebhooks)(nil), "ttn.lorawan.v3.ApplicationWebhooks")
 	golang_proto.RegisterType((*ApplicationWebhooks)(nil), "ttn.lorawan.v3.ApplicationWebhooks")
 	proto.RegisterType((*ApplicationWebhookFormats)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats")
@@ -1028,115 +1122,132 @@ func init() {
 }
 
 var fileDescriptor_2652f2d8eaceda0e = []byte{
-	// 1691 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcf, 0x6f, 0x23, 0x49,
-	0x15, 0x9e, 0xb2, 0x13, 0x3b, 0x7e, 0x76, 0x32, 0x99, 0x4a, 0x66, 0xb7, 0x71, 0x66, 0x3d, 0x51,
-	0xcf, 0x30, 0x9b, 0x84, 0x71, 0x1b, 0x79, 0x08, 0xcb, 0x44, 0x68, 0x83, 0xad, 0x6c, 0xb2, 0x61,
-	0x09, 0x4b, 0xda, 0x3b, 0x2c, 0xbb, 0xa3, 0xc5, 0xaa, 0xb8, 0xcb, 0x4e, 0xe3, 0x76, 0x77, 0x4f,
-	0x77, 0x39, 0x21, 0xac, 0x22, 0xad, 0x10, 0x07, 0xc4, 0x85, 0x15, 0x7b, 0xe0, 0x86, 0x40, 0x70,
-	0x80, 0x3b, 0xe2, 0x8c, 0xf8, 0x17, 0xb8, 0x70, 0x43, 0x64, 0x39, 0x70, 0x42, 0x70, 0x43, 0x73,
-	0x42, 0x5d, 0x5d, 0x6d, 0x77, 0xfb, 0x47, 0xd2, 0x76, 0x66, 0x4e, 0xe9, 0xea, 0x7a, 0xf5, 0xd5,
-	0xf7, 0x5e, 0xbd, 0x7a, 0xdf, 0x73, 0x07, 0x8a, 0x86, 0xe5, 0x90, 0x53, 0x62, 0x16, 0x5d, 0x46,
-	0x1a, 0xed, 0x12, 0xb1, 0xf5, 0x12, 0xb1, 0x6d, 0x43, 0x6f, 0x10, 0xa6, 0x5b, 0xa6, 0x4b, 0x9d,
-	0x13, 0xea, 0xd4, 0x4f, 0xe9, 0x91, 0x62, 0x3b, 0x16, 0xb3, 0xf0, 0x02, 0x63, 0xa6, 0x22, 0x96,
-	0x28, 0x27, 0x8f, 0xf2, 0x95, 0x96, 0xce, 0x8e, 0xbb, 0x47, 0x4a, 0xc3, 0xea, 0x94, 0xa8, 0x79,
-	0x62, 0x9d, 0xd9, 0x8e, 0xf5, 0xc3, 0xb3, 0x12, 0x37, 0x6e, 0x14, 0x5b, 0xd4, 0x2c, 0x9e, 0x10,
-	0x43, 0xd7, 0x08, 0xa3, 0xa5, 0xa1, 0x07, 0x1f, 0x32, 0x5f, 0x0c, 0x41, 0xb4, 0xac, 0x96, 0xe5,
-	0x2f, 0x3e, 0xea, 0x36, 0xf9, 0x88, 0x0f, 0xf8, 0x93, 0x30, 0xbf, 0xd3, 0xb2, 0xac, 0x96, 0x41,
-	0x7d, 0xa6, 0xa6, 0x69, 0x31, 0x9f, 0xa8, 0x98, 0x5d, 0x11, 0xb3, 0x3d, 0x0c, 0xda, 0xb1, 0xd9,
-	0x99, 0x98, 0x5c, 0x1d, 0x9c, 0x6c, 0xea, 0xd4, 0xd0, 0xea, 0x1d, 0xe2, 0xb6, 0x85, 0xc5, 0xdd,
-	0x41, 0x0b, 0xa6, 0x77, 0xa8, 0xcb, 0x48, 0xc7, 0x16, 0x06, 0xf7, 0x86, 0xc3, 0xa5, 0x6b, 0xd4,
-	0x64, 0x7a, 0x53, 0xa7, 0x8e, 0x20, 0x21, 0xff, 0x05, 0xc1, 0x6b, 0x95, 0x7e, 0x10, 0xdf, 0xa7,
-	0x47, 0xc7, 0x96, 0xd5, 0xde, 0xef, 0xdb, 0xe1, 0x0f, 0xe0, 0x66, 0x28, 0xca, 0x75, 0x5d, 0x73,
-	0x25, 0xb4, 0x8a, 0xd6, 0xb2, 0xe5, 0x07, 0x4a, 0x34, 0xc0, 0x4a, 0x08, 0x27, 0x04, 0x50, 0x9d,
-	0x7b, 0x5e, 0x9d, 0xfd, 0x19, 0x4a, 0x2c, 0x22, 0x75, 0x81, 0x84, 0x2d, 0x5c, 0xbc, 0x0b, 0x70,
-	0xea, 0x6f, 0x58, 0xd7, 0x35, 0x29, 0xb1, 0x8a, 0xd6, 0x32, 0xd5, 0xd7, 0x9f, 0x57, 0xef, 0x3b,
-	0xb2, 0x74, 0xbf, 0x5c, 0xf8, 0xfe, 0x53, 0x52, 0xfc, 0xd1, 0x97, 0x8b, 0x8f, 0x3f, 0x5a, 0xdb,
-	0xde, 0x7a, 0x5a, 0xfc, 0x68, 0x3b, 0x18, 0xae, 0x7f, 0x5c, 0x7e, 0x78, 0x7e, 0x5f, 0xcd, 0x9c,
-	0x06, 0x5c, 0xe5, 0x67, 0xf0, 0xc5, 0x61, 0x1f, 0xde, 0xa3, 0x1d, 0xdb, 0x20, 0x8c, 0x86, 0x7d,
-	0x79, 0x1b, 0xb2, 0x4c, 0xbc, 0xf6, 0x76, 0x44, 0x93, 0xed, 0x08, 0xac, 0x07, 0x29, 0xff, 0x24,
-	0x01, 0x77, 0xc7, 0xef, 0xb9, 0xeb, 0x1d, 0x16, 0x7e, 0x03, 0x12, 0x93, 0x6f, 0x92, 0xd0, 0x35,
-	0xbc, 0x02, 0x33, 0x26, 0xe9, 0x50, 0x11, 0x91, 0xf4, 0xf3, 0xea, 0x8c, 0x93, 0x90, 0x96, 0x55,
-	0xfe, 0x12, 0xaf, 0x43, 0x56, 0xa3, 0x6e, 0xc3, 0xd1, 0x6d, 0x6f, 0x63, 0x29, 0x19, 0xb6, 0xd1,
-	0xd4, 0xf0, 0x1c, 0x7e, 0x05, 0x52, 0x2e, 0x6d, 0x38, 0x94, 0x49, 0x33, 0xab, 0x68, 0x6d, 0x4e,
-	0x15, 0x23, 0xfc, 0x10, 0xe6, 0x35, 0xda, 0x24, 0x5d, 0x83, 0xd5, 0x4f, 0x88, 0xd1, 0xa5, 0xd2,
-	0x6c, 0x14, 0x24, 0x27, 0x66, 0xbf, 0xeb, 0x4d, 0xe2, 0x3c, 0xcc, 0x59, 0x1c, 0x8f, 0x18, 0x52,
-	0x8a, 0xe3, 0xf4, 0xc6, 0xf2, 0xbf, 0x73, 0x90, 0x1f, 0x1f, 0x06, 0x7c, 0x08, 0xc9, 0x7e, 0xbe,
-	0x6c, 0x5e, 0x92, 0x2f, 0xe3, 0xcf, 0x2c, 0x94, 0x3e, 0x1e, 0xd6, 0x0b, 0x8b, 0xcd, 0x3d, 0x98,
-	0x33, 0xac, 0x96, 0x55, 0xef, 0x3a, 0x06, 0x8f, 0x4e, 0x86, 0x6f, 0xe4, 0x24, 0x7f, 0x8a, 0x90,
-	0x9a, 0xf6, 0x66, 0x9e, 0x38, 0x86, 0x67, 0xa4, 0x9b, 0x4d, 0xdf, 0x68, 0x76, 0xd0, 0xc8, 0x9b,
-	0xf1, 0x8c, 0x36, 0xe1, 0x96, 0x66, 0x35, 0xba, 0x1d, 0x6a, 0xfa, 0xf7, 0x9b, 0x5b, 0xa7, 0x06,
-	0xac, 0x17, 0x23, 0x26, 0x02, 0xfb, 0x88, 0xb8, 0x94, 0x5b, 0xa7, 0x07, 0xb1, 0xbd, 0x19, 0xcf,
-	0xe8, 0x10, 0xd2, 0xc7, 0x94, 0x68, 0xd4, 0x71, 0xa5, 0xb9, 0xd5, 0xe4, 0x5a, 0xb6, 0xfc, 0x46,
-	0xfc, 0x20, 0x2a, 0x6f, 0xfb, 0x2b, 0xdf, 0x32, 0x99, 0x73, 0xa6, 0x06, 0x38, 0x78, 0x1b, 0x52,
-	0x4d, 0xcb, 0xe9, 0x10, 0x26, 0x65, 0xc2, 0x99, 0xb9, 0x7c, 0x65, 0x66, 0x8a, 0x65, 0x78, 0x0f,
-	0x52, 0xbc, 0x18, 0xb9, 0x12, 0x70, 0x4a, 0xa5, 0xf8, 0x94, 0xf8, 0xbd, 0x50, 0xc5, 0x72, 0xbc,
-	0x09, 0xaf, 0x36, 0x1c, 0xea, 0xdd, 0x45, 0xcd, 0x3a, 0x35, 0x0d, 0xdd, 0x6c, 0xd7, 0x89, 0xad,
-	0xd7, 0xdb, 0xf4, 0x4c, 0x5a, 0xe2, 0x79, 0xb6, 0xec, 0x4f, 0xef, 0x88, 0xd9, 0x8a, 0xad, 0xbf,
-	0x43, 0xcf, 0xf0, 0x07, 0xb0, 0xd0, 0xb5, 0xb9, 0x75, 0x87, 0xba, 0x2e, 0x69, 0x51, 0x29, 0xcb,
-	0xf3, 0xab, 0x3c, 0x41, 0x68, 0x0e, 0xfc, 0x95, 0xea, 0xbc, 0x8f, 0x24, 0x86, 0xb8, 0x06, 0xd9,
-	0x1f, 0x58, 0xba, 0x59, 0x27, 0x8d, 0x06, 0xb5, 0x99, 0x94, 0x9b, 0x1a, 0x17, 0x3c, 0x98, 0x0a,
-	0x47, 0xc1, 0x4f, 0x20, 0xd7, 0xf7, 0xaf, 0xd1, 0x96, 0xe6, 0xa7, 0x46, 0xcd, 0x06, 0x38, 0x95,
-	0x46, 0x1b, 0xbf, 0x0f, 0xf3, 0x3d, 0x58, 0xd3, 0xc3, 0x5d, 0x98, 0x1a, 0xb7, 0xc7, 0xef, 0xdb,
-	0x64, 0x00, 0xd8, 0xa5, 0x26, 0x93, 0x6e, 0x5e, 0x1f, 0xb8, 0x46, 0x4d, 0x86, 0x9f, 0xc2, 0xcd,
-	0x1e, 0x70, 0x93, 0xe8, 0x06, 0xd5, 0xa4, 0xc5, 0xa9, 0xa1, 0x17, 0x02, 0xa8, 0x5d, 0x8e, 0x14,
-	0x01, 0x7f, 0xd6, 0xa5, 0x5d, 0xaa, 0x49, 0xb7, 0xae, 0x0f, 0x7e, 0xc8, 0x91, 0xb0, 0x0d, 0xf9,
-	0x28, 0x78, 0x5d, 0x37, 0x83, 0xd6, 0x40, 0x93, 0x6e, 0x4f, 0xbd, 0x8f, 0x14, 0xd9, 0x67, 0xbf,
-	0x8f, 0xe9, 0xb9, 0x63, 0x58, 0x42, 0x72, 0x5d, 0xcb, 0x38, 0xa1, 0x9a, 0x84, 0xa7, 0x77, 0x27,
-	0x80, 0xaa, 0x71, 0x24, 0x2f, 0x23, 0xbd, 0x6e, 0x49, 0x6f, 0xd0, 0xba, 0x46, 0x18, 0x91, 0x96,
-	0xa7, 0xcf, 0x48, 0x81, 0xb3, 0x43, 0x18, 0xc9, 0x6f, 0x41, 0x2e, 0x5c, 0x72, 0xf0, 0x22, 0x24,
-	0xbd, 0xbb, 0xcc, 0x05, 0x50, 0xf5, 0x1e, 0xf1, 0x32, 0xcc, 0xfa, 0x82, 0xc3, 0xab, 0xb7, 0xea,
-	0x0f, 0xb6, 0x12, 0x5f, 0x43, 0xf9, 0x07, 0x90, 0x0e, 0x2e, 0xe1, 0x0a, 0xcc, 0xd8, 0x84, 0x1d,
-	0x0b, 0xe1, 0x14, 0xd5, 0xfb, 0x1b, 0x2a, 0x7f, 0x29, 0xb7, 0x60, 0x65, 0x3c, 0x2d, 0x4f, 0xe0,
-	0x33, 0x81, 0x48, 0x7b, 0xb2, 0xe3, 0x95, 0xa7, 0x8d, 0xf8, 0x6e, 0xa9, 0xfd, 0xc5, 0xf2, 0x7f,
-	0x73, 0x80, 0x87, 0x2d, 0xf1, 0x7e, 0x58, 0xd1, 0x8a, 0x57, 0x43, 0x5f, 0xa2, 0x64, 0xdb, 0x00,
-	0x7e, 0x7d, 0xd3, 0xea, 0x84, 0xf1, 0x88, 0x64, 0xcb, 0x79, 0xc5, 0xef, 0xea, 0x94, 0xa0, 0xab,
-	0x53, 0xde, 0x0b, 0xba, 0xba, 0xea, 0xcc, 0xa7, 0x7f, 0xbf, 0x8b, 0xd4, 0x8c, 0x58, 0x53, 0x61,
-	0x1e, 0x40, 0xd7, 0xd6, 0x02, 0x80, 0x64, 0x5c, 0x00, 0xb1, 0xa6, 0xc2, 0x22, 0x12, 0x34, 0x33,
-	0x4e, 0x82, 0xf6, 0xfb, 0x12, 0x34, 0x1b, 0xb7, 0xde, 0x5f, 0x29, 0x3d, 0xa9, 0xe9, 0xa4, 0xe7,
-	0x7b, 0x90, 0x0b, 0xf5, 0x6f, 0xae, 0xa8, 0x4c, 0xd3, 0x35, 0x16, 0x6a, 0xb6, 0xdf, 0xce, 0xb9,
-	0xb8, 0x0e, 0x37, 0x7b, 0xc8, 0x42, 0xdd, 0x16, 0xb9, 0xb7, 0x5f, 0x8d, 0xe1, 0x6d, 0x44, 0xde,
-	0x84, 0xd3, 0x0b, 0x2c, 0xf2, 0x12, 0x97, 0x61, 0x71, 0x48, 0xe5, 0x6e, 0x85, 0x62, 0x2e, 0x7d,
-	0x82, 0xfa, 0x65, 0x47, 0x28, 0xdd, 0xe1, 0x90, 0xd2, 0xa5, 0xb9, 0xc3, 0x31, 0x52, 0x7a, 0x9c,
-	0xc2, 0xbd, 0x13, 0x55, 0xb8, 0xb9, 0x89, 0xf1, 0xc2, 0xca, 0x76, 0x30, 0xa0, 0x6c, 0x99, 0x89,
-	0xd1, 0x22, 0x8a, 0xf6, 0xee, 0xa0, 0xa2, 0xc1, 0xc4, 0x78, 0x51, 0x25, 0x7b, 0x77, 0x50, 0xc9,
-	0xb2, 0xd3, 0x03, 0x72, 0x05, 0xab, 0x0d, 0x2b, 0x58, 0x6e, 0x62, 0xc8, 0x41, 0xe5, 0xaa, 0x0d,
-	0x2b, 0xd7, 0xfc, 0xf4, 0xa0, 0x42, 0xb1, 0x8e, 0x2f, 0x55, 0xac, 0xa5, 0x89, 0xf1, 0xc7, 0x2b,
-	0x55, 0x6d, 0x58, 0xa9, 0x16, 0x26, 0xa7, 0x3f, 0xa0, 0x50, 0x07, 0x03, 0x0a, 0x85, 0x27, 0xcf,
-	0xac, 0x17, 0xa5, 0x4c, 0x15, 0x58, 0x1a, 0x71, 0xbf, 0x5f, 0x8a, 0xb8, 0x3d, 0x81, 0xa5, 0x61,
-	0x8f, 0x5c, 0xfc, 0x26, 0xcc, 0x89, 0xdf, 0xba, 0x81, 0xa6, 0xc9, 0x57, 0x07, 0x42, 0xed, 0xad,
-	0x91, 0xff, 0x80, 0xe0, 0x0b, 0xc3, 0x06, 0xbb, 0xbc, 0xa4, 0xba, 0xf8, 0x3b, 0x90, 0xf6, 0xab,
-	0x6b, 0x00, 0x1e, 0xa3, 0xe2, 0x89, 0xb5, 0x8a, 0xf8, 0x2b, 0xca, 0xbc, 0x80, 0xf1, 0xa2, 0x1d,
-	0x9e, 0x98, 0x24, 0x54, 0xf2, 0xef, 0x10, 0xdc, 0xd9, 0xa3, 0x6c, 0x84, 0x3f, 0xf4, 0x59, 0x97,
-	0xba, 0xec, 0x45, 0x0a, 0xf0, 0x63, 0x80, 0xfe, 0x57, 0x95, 0xb1, 0x02, 0xcc, 0x0f, 0xfd, 0x80,
-	0xb8, 0x6d, 0x35, 0xd3, 0x0c, 0x1e, 0xe5, 0x3f, 0x21, 0x28, 0x7c, 0x4b, 0x77, 0x47, 0xf0, 0x74,
-	0x03, 0xa2, 0x2f, 0xf1, 0xbb, 0xc9, 0x35, 0x88, 0xff, 0x06, 0xc1, 0x9d, 0xda, 0x65, 0xf1, 0xdd,
-	0x85, 0xb4, 0x48, 0x1c, 0x41, 0x37, 0x46, 0xae, 0x85, 0xa8, 0x06, 0x8b, 0xaf, 0xc3, 0xf1, 0x8f,
-	0x08, 0xee, 0x8f, 0xcc, 0x81, 0x5e, 0x9f, 0x26, 0xb8, 0xbe, 0x84, 0xcf, 0x0b, 0xd7, 0xa0, 0xdd,
-	0x80, 0x07, 0xa3, 0x53, 0xa2, 0xd7, 0x9e, 0x06, 0xbc, 0xa3, 0x9b, 0xa0, 0x09, 0x36, 0x29, 0xff,
-	0x3c, 0x33, 0xea, 0x83, 0x8b, 0x4a, 0x5b, 0xba, 0xeb, 0x5d, 0x35, 0x03, 0x60, 0x8f, 0xb2, 0xe0,
-	0x6a, 0xbf, 0x32, 0x84, 0xf9, 0x56, 0xc7, 0x66, 0x67, 0xf9, 0xf5, 0xd8, 0x37, 0x5c, 0x5e, 0xf9,
-	0xf1, 0x5f, 0xff, 0xf9, 0x59, 0xe2, 0x36, 0x5e, 0x2a, 0x11, 0xb7, 0x24, 0xce, 0xb6, 0x28, 0x2e,
-	0x3a, 0xfe, 0x35, 0x82, 0xec, 0x1e, 0x65, 0xbd, 0xcf, 0x3d, 0x5f, 0x19, 0xc4, 0x8d, 0x73, 0x8a,
-	0xf9, 0x09, 0x1a, 0x74, 0xb9, 0xc4, 0xe9, 0xac, 0xe3, 0xd7, 0xc3, 0x74, 0x7a, 0x4d, 0x7b, 0xe9,
-	0x63, 0x5d, 0x73, 0x95, 0x50, 0x87, 0x78, 0x8e, 0x3f, 0x43, 0x30, 0xef, 0x9d, 0x4a, 0xff, 0x27,
-	0xc2, 0x50, 0x79, 0x8b, 0x77, 0x68, 0xf9, 0x2f, 0xc5, 0xa7, 0xe9, 0xca, 0xaf, 0x71, 0x9e, 0xaf,
-	0xe2, 0xdb, 0x23, 0x79, 0xe2, 0xdf, 0x22, 0x48, 0xee, 0x51, 0x86, 0x1f, 0xc6, 0x0a, 0x58, 0xc0,
-	0x20, 0xc6, 0x4d, 0x94, 0xbf, 0xc9, 0x37, 0xde, 0xc1, 0xd5, 0xd0, 0xc6, 0x22, 0x2e, 0x03, 0xd5,
-	0x68, 0x60, 0x7c, 0xee, 0x1b, 0xf5, 0xbf, 0xc7, 0x9e, 0xe3, 0x5f, 0x20, 0x98, 0xf1, 0x82, 0x83,
-	0x95, 0x78, 0x21, 0xeb, 0x85, 0xea, 0xde, 0xd5, 0x44, 0x5d, 0x79, 0x93, 0x33, 0x2d, 0xe1, 0x62,
-	0x94, 0xe9, 0x15, 0x2c, 0xf1, 0xff, 0x10, 0x24, 0x6b, 0xa3, 0x42, 0x57, 0xbb, 0x6e, 0xe8, 0x7e,
-	0x85, 0x38, 0xa3, 0x5f, 0xa2, 0xbc, 0x1a, 0xa5, 0x24, 0x9e, 0x94, 0x58, 0x41, 0x0c, 0x1b, 0x87,
-	0x82, 0xb9, 0x85, 0x36, 0x3e, 0x7c, 0x53, 0x7e, 0x3c, 0x35, 0xf0, 0x16, 0xda, 0xf0, 0x72, 0x39,
-	0xb5, 0x43, 0x0d, 0xca, 0x28, 0x9e, 0x4c, 0xf8, 0xf2, 0x63, 0x0a, 0x81, 0x5c, 0xe5, 0x1e, 0x7f,
-	0x7d, 0x63, 0x6b, 0xa2, 0x33, 0xe8, 0x11, 0xf7, 0x06, 0xd5, 0x83, 0xbf, 0xfd, 0xa3, 0x70, 0xe3,
-	0x93, 0x8b, 0x02, 0xfa, 0xfd, 0x45, 0x01, 0xfd, 0xeb, 0xa2, 0x70, 0xe3, 0x3f, 0x17, 0x05, 0xf4,
-	0xe9, 0xe7, 0x85, 0x1b, 0x7f, 0xfe, 0xbc, 0x80, 0x3e, 0x2c, 0xb5, 0x2c, 0x85, 0x1d, 0x53, 0x76,
-	0xac, 0x9b, 0x2d, 0x57, 0x31, 0x29, 0x3b, 0xb5, 0x9c, 0x76, 0x29, 0xfa, 0x9f, 0x89, 0x93, 0x47,
-	0x25, 0xbb, 0xdd, 0x2a, 0x31, 0x66, 0xda, 0x47, 0x47, 0x29, 0x4e, 0xf1, 0xd1, 0xff, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x82, 0x74, 0x9f, 0xd0, 0xed, 0x19, 0x00, 0x00,
+	// 1851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xc1, 0x73, 0xdb, 0xc6,
+	0xf5, 0xf6, 0x92, 0x12, 0x45, 0x3e, 0x52, 0x14, 0xbd, 0x92, 0x13, 0xfc, 0x28, 0x87, 0xd6, 0xc0,
+	0x8e, 0x23, 0x3b, 0x21, 0xf8, 0x1b, 0xba, 0x6e, 0x62, 0x4d, 0x27, 0x2e, 0x59, 0x5b, 0x8a, 0x12,
+	0xab, 0xb6, 0x41, 0x39, 0x69, 0xe2, 0x49, 0x38, 0x4b, 0x62, 0x45, 0xa1, 0x04, 0x01, 0x18, 0x58,
+	0x4a, 0x55, 0x33, 0x9e, 0xf1, 0x64, 0x7a, 0xe8, 0xf4, 0xd2, 0x4c, 0x72, 0xe8, 0xad, 0xd3, 0x4e,
+	0x7b, 0x68, 0xef, 0x9d, 0x9e, 0x3b, 0xf9, 0x17, 0x7a, 0xe9, 0xad, 0x53, 0xbb, 0x87, 0x9e, 0x3a,
+	0x3d, 0x76, 0x7c, 0xea, 0x60, 0xb1, 0x00, 0x41, 0x52, 0xb4, 0x40, 0x2a, 0x3e, 0x11, 0x8b, 0x7d,
+	0xfb, 0xbd, 0x6f, 0xdf, 0xbe, 0x7d, 0xdf, 0x62, 0x09, 0x65, 0xc3, 0x72, 0xc8, 0x21, 0x31, 0xcb,
+	0x2e, 0x23, 0xed, 0x6e, 0x85, 0xd8, 0x7a, 0x85, 0xd8, 0xb6, 0xa1, 0xb7, 0x09, 0xd3, 0x2d, 0xd3,
+	0xa5, 0xce, 0x01, 0x75, 0x9a, 0x87, 0xb4, 0xa5, 0xd8, 0x8e, 0xc5, 0x2c, 0x9c, 0x67, 0xcc, 0x54,
+	0xc4, 0x10, 0xe5, 0xe0, 0x5a, 0xb1, 0xd6, 0xd1, 0xd9, 0x7e, 0xbf, 0xa5, 0xb4, 0xad, 0x5e, 0x85,
+	0x9a, 0x07, 0xd6, 0x91, 0xed, 0x58, 0x3f, 0x39, 0xaa, 0x70, 0xe3, 0x76, 0xb9, 0x43, 0xcd, 0xf2,
+	0x01, 0x31, 0x74, 0x8d, 0x30, 0x5a, 0x19, 0x7b, 0xf0, 0x21, 0x8b, 0xe5, 0x08, 0x44, 0xc7, 0xea,
+	0x58, 0xfe, 0xe0, 0x56, 0x7f, 0x8f, 0xb7, 0x78, 0x83, 0x3f, 0x09, 0xf3, 0xf3, 0x1d, 0xcb, 0xea,
+	0x18, 0xd4, 0x67, 0x6a, 0x9a, 0x16, 0xf3, 0x89, 0x8a, 0xde, 0x55, 0xd1, 0x1b, 0x62, 0xd0, 0x9e,
+	0xcd, 0x8e, 0x44, 0xe7, 0xda, 0x68, 0xe7, 0x9e, 0x4e, 0x0d, 0xad, 0xd9, 0x23, 0x6e, 0x57, 0x58,
+	0x5c, 0x18, 0xb5, 0x60, 0x7a, 0x8f, 0xba, 0x8c, 0xf4, 0x6c, 0x61, 0x70, 0x71, 0x3c, 0x5c, 0xba,
+	0x46, 0x4d, 0xa6, 0xef, 0xe9, 0xd4, 0x09, 0x48, 0xac, 0x8d, 0x1b, 0xf5, 0xa8, 0xeb, 0x92, 0x0e,
+	0x15, 0x16, 0xf2, 0x37, 0x08, 0x5e, 0xab, 0x0d, 0xc2, 0xfc, 0x11, 0x6d, 0xed, 0x5b, 0x56, 0x77,
+	0x7b, 0x80, 0x84, 0x3f, 0x86, 0xa5, 0xc8, 0x3a, 0x34, 0x75, 0xcd, 0x95, 0xd0, 0x1a, 0x5a, 0xcf,
+	0x56, 0x2f, 0x2b, 0xc3, 0x4b, 0xa0, 0x44, 0x70, 0x22, 0x00, 0xf5, 0xf4, 0xf3, 0xfa, 0xfc, 0x2f,
+	0x50, 0xa2, 0x80, 0xd4, 0x3c, 0x89, 0x5a, 0xb8, 0x78, 0x13, 0xe0, 0xd0, 0x77, 0xd8, 0xd4, 0x35,
+	0x29, 0xb1, 0x86, 0xd6, 0x33, 0xf5, 0x37, 0x9e, 0xd7, 0x2f, 0x39, 0xb2, 0x74, 0xa9, 0x5a, 0xfa,
+	0xec, 0x21, 0x29, 0xff, 0xf4, 0xff, 0xcb, 0x37, 0x3e, 0x5d, 0xbf, 0xb9, 0xf1, 0xb0, 0xfc, 0xe9,
+	0xcd, 0xa0, 0x79, 0xe5, 0xf3, 0xea, 0x5b, 0x8f, 0x2f, 0xa9, 0x99, 0xc3, 0x80, 0xab, 0xfc, 0x08,
+	0x5e, 0x1f, 0x9f, 0xc3, 0x2e, 0xed, 0xd9, 0x06, 0x61, 0x34, 0x3a, 0x97, 0xf7, 0x20, 0xcb, 0xc4,
+	0x6b, 0xcf, 0x23, 0x9a, 0xce, 0x23, 0xb0, 0x10, 0x52, 0xfe, 0x59, 0x02, 0x2e, 0x4c, 0xf6, 0xb9,
+	0xe9, 0x2d, 0x27, 0x7e, 0x1b, 0x12, 0xd3, 0x3b, 0x49, 0xe8, 0x1a, 0x5e, 0x85, 0x39, 0x93, 0xf4,
+	0xa8, 0x88, 0xc8, 0xc2, 0xf3, 0xfa, 0x9c, 0x93, 0x90, 0x56, 0x54, 0xfe, 0x12, 0x5f, 0x81, 0xac,
+	0x46, 0xdd, 0xb6, 0xa3, 0xdb, 0x9e, 0x63, 0x29, 0x19, 0xb5, 0xd1, 0xd4, 0x68, 0x1f, 0x7e, 0x05,
+	0x52, 0x2e, 0x6d, 0x3b, 0x94, 0x49, 0x73, 0x6b, 0x68, 0x3d, 0xad, 0x8a, 0x16, 0x7e, 0x0b, 0x16,
+	0x35, 0xba, 0x47, 0xfa, 0x06, 0x6b, 0x1e, 0x10, 0xa3, 0x4f, 0xa5, 0xf9, 0x61, 0x90, 0x9c, 0xe8,
+	0xfd, 0xd0, 0xeb, 0xc4, 0x45, 0x48, 0x5b, 0x1c, 0x8f, 0x18, 0x52, 0x8a, 0xe3, 0x84, 0x6d, 0xf9,
+	0xdf, 0x39, 0x28, 0x4e, 0x0e, 0x03, 0xbe, 0x0f, 0xc9, 0x41, 0xbe, 0x5c, 0x7f, 0x41, 0xbe, 0x4c,
+	0x5e, 0xb3, 0x48, 0xfa, 0x78, 0x58, 0xdf, 0x5a, 0x6c, 0x2e, 0x42, 0xda, 0xb0, 0x3a, 0x56, 0xb3,
+	0xef, 0x18, 0x3c, 0x3a, 0x19, 0xee, 0xc8, 0x49, 0xfe, 0x1c, 0x21, 0x75, 0xc1, 0xeb, 0x79, 0xe0,
+	0x18, 0x9e, 0x91, 0x6e, 0xee, 0xf9, 0x46, 0xf3, 0xa3, 0x46, 0x5e, 0x8f, 0x67, 0x74, 0x1d, 0xce,
+	0x6a, 0x56, 0xbb, 0xdf, 0xa3, 0xa6, 0x5f, 0x01, 0xb8, 0x75, 0x6a, 0xc4, 0xba, 0x30, 0x64, 0x22,
+	0xb0, 0x5b, 0xc4, 0xa5, 0xdc, 0x7a, 0x61, 0x14, 0xdb, 0xeb, 0xf1, 0x8c, 0xee, 0xc3, 0xc2, 0x3e,
+	0x25, 0x1a, 0x75, 0x5c, 0x29, 0xbd, 0x96, 0x5c, 0xcf, 0x56, 0xdf, 0x8e, 0x1f, 0x44, 0xe5, 0x3d,
+	0x7f, 0xe4, 0x6d, 0x93, 0x39, 0x47, 0x6a, 0x80, 0x83, 0x6f, 0x42, 0x6a, 0xcf, 0x72, 0x7a, 0x84,
+	0x49, 0x99, 0x68, 0x66, 0xae, 0x9c, 0x98, 0x99, 0x62, 0x18, 0xde, 0x82, 0x14, 0x2f, 0x57, 0xae,
+	0x04, 0x9c, 0x52, 0x25, 0x3e, 0x25, 0xbe, 0x2f, 0x54, 0x31, 0x1c, 0x5f, 0x87, 0x57, 0xdb, 0x0e,
+	0xf5, 0xf6, 0xa2, 0x66, 0x1d, 0x9a, 0x86, 0x6e, 0x76, 0x9b, 0xc4, 0xd6, 0x9b, 0x5d, 0x7a, 0x24,
+	0x2d, 0xf3, 0x3c, 0x5b, 0xf1, 0xbb, 0x6f, 0x89, 0xde, 0x9a, 0xad, 0x7f, 0x40, 0x8f, 0xf0, 0xc7,
+	0x90, 0xef, 0xdb, 0xdc, 0x5a, 0xd4, 0x32, 0x29, 0xcb, 0xf3, 0xab, 0x3a, 0x45, 0x68, 0x76, 0xfc,
+	0x91, 0xea, 0xa2, 0x8f, 0x24, 0x9a, 0xb8, 0x01, 0xd9, 0x1f, 0x5b, 0xba, 0xd9, 0x24, 0xed, 0x36,
+	0xb5, 0x99, 0x94, 0x9b, 0x19, 0x17, 0x3c, 0x98, 0x1a, 0x47, 0xc1, 0x0f, 0x20, 0x37, 0x98, 0x5f,
+	0xbb, 0x2b, 0x2d, 0xce, 0x8c, 0x9a, 0x0d, 0x70, 0x6a, 0xed, 0x2e, 0xfe, 0x08, 0x16, 0x43, 0x58,
+	0xd3, 0xc3, 0xcd, 0xcf, 0x8c, 0x1b, 0xf2, 0xfb, 0x21, 0x19, 0x01, 0x76, 0xa9, 0xc9, 0xa4, 0xa5,
+	0xd3, 0x03, 0x37, 0xa8, 0xc9, 0xf0, 0x43, 0x58, 0x0a, 0x81, 0xf7, 0x88, 0x6e, 0x50, 0x4d, 0x2a,
+	0xcc, 0x0c, 0x9d, 0x0f, 0xa0, 0x36, 0x39, 0xd2, 0x10, 0xf8, 0xa3, 0x3e, 0xed, 0x53, 0x4d, 0x3a,
+	0x7b, 0x7a, 0xf0, 0xfb, 0x1c, 0x09, 0xdb, 0x50, 0x1c, 0x06, 0x6f, 0xea, 0x66, 0x70, 0x78, 0xd0,
+	0xa4, 0x73, 0x33, 0xfb, 0x91, 0x86, 0xfc, 0x6c, 0x0f, 0x30, 0xbd, 0xe9, 0x18, 0x96, 0x90, 0x5c,
+	0xd7, 0x32, 0x0e, 0xa8, 0x26, 0xe1, 0xd9, 0xa7, 0x13, 0x40, 0x35, 0x38, 0x92, 0x97, 0x91, 0xde,
+	0x79, 0x4a, 0x6f, 0xd3, 0xa6, 0x46, 0x18, 0x91, 0x56, 0x66, 0xcf, 0x48, 0x81, 0x73, 0x8b, 0x30,
+	0x52, 0xdc, 0x80, 0x5c, 0xb4, 0xe4, 0xe0, 0x02, 0x24, 0xbd, 0xbd, 0xcc, 0x05, 0x50, 0xf5, 0x1e,
+	0xf1, 0x0a, 0xcc, 0xfb, 0x82, 0xc3, 0xab, 0xb7, 0xea, 0x37, 0x36, 0x12, 0xef, 0xa0, 0xe2, 0x65,
+	0x58, 0x08, 0x36, 0xe1, 0x2a, 0xcc, 0xd9, 0x84, 0xed, 0x4b, 0x28, 0x5a, 0xbd, 0xbf, 0xaf, 0xf2,
+	0x97, 0x72, 0x07, 0x56, 0x27, 0xd3, 0xf2, 0x04, 0x3e, 0x13, 0x88, 0xb4, 0x27, 0x3b, 0x5e, 0x79,
+	0xba, 0x1a, 0x7f, 0x5a, 0xea, 0x60, 0xb0, 0xfc, 0x6c, 0x09, 0xf0, 0xb8, 0x25, 0xde, 0x8e, 0x2a,
+	0x5a, 0xf9, 0x64, 0xe8, 0x17, 0x28, 0xd9, 0x4d, 0x00, 0xbf, 0xbe, 0x69, 0x4d, 0xc2, 0x78, 0x44,
+	0xb2, 0xd5, 0xa2, 0xe2, 0x9f, 0xfb, 0x94, 0xe0, 0xdc, 0xa7, 0xec, 0x06, 0xe7, 0xbe, 0xfa, 0xdc,
+	0x97, 0x7f, 0xbf, 0x80, 0xd4, 0x8c, 0x18, 0x53, 0x63, 0x1e, 0x40, 0xdf, 0xd6, 0x02, 0x80, 0x64,
+	0x5c, 0x00, 0x31, 0xa6, 0xc6, 0x86, 0x24, 0x68, 0x6e, 0x92, 0x04, 0x6d, 0x0f, 0x24, 0x68, 0x3e,
+	0x6e, 0xbd, 0x3f, 0x51, 0x7a, 0x52, 0xb3, 0x49, 0xcf, 0x8f, 0x20, 0x17, 0x39, 0xbf, 0xb9, 0xd2,
+	0xd2, 0x29, 0x0e, 0x16, 0x6a, 0x76, 0x70, 0x9c, 0x73, 0x71, 0x13, 0x96, 0x42, 0x64, 0xa1, 0x6e,
+	0x05, 0x3e, 0xdb, 0xef, 0xc6, 0x98, 0xed, 0x90, 0xbc, 0x89, 0x49, 0xe7, 0xd9, 0xd0, 0x4b, 0x5c,
+	0x85, 0xc2, 0x98, 0xca, 0x9d, 0x8d, 0xc4, 0x5c, 0x7a, 0x82, 0x06, 0x65, 0x47, 0x28, 0xdd, 0xfd,
+	0x31, 0xa5, 0x5b, 0x58, 0x43, 0xf1, 0x52, 0x7a, 0x92, 0xc2, 0x7d, 0x30, 0xac, 0x70, 0xe9, 0xa9,
+	0xf1, 0xa2, 0xca, 0xb6, 0x33, 0xa2, 0x6c, 0x99, 0xa9, 0xd1, 0x86, 0x14, 0xed, 0xee, 0xa8, 0xa2,
+	0xc1, 0xd4, 0x78, 0xc3, 0x4a, 0x76, 0x77, 0x54, 0xc9, 0xb2, 0xb3, 0x03, 0x72, 0x05, 0x6b, 0x8c,
+	0x2b, 0x58, 0x6e, 0x6a, 0xc8, 0x51, 0xe5, 0x6a, 0x8c, 0x2b, 0xd7, 0xe2, 0xec, 0xa0, 0x42, 0xb1,
+	0xf6, 0x5f, 0xa8, 0x58, 0xcb, 0x53, 0xe3, 0x4f, 0x56, 0xaa, 0xc6, 0xb8, 0x52, 0xe5, 0xa7, 0xa7,
+	0x3f, 0xa2, 0x50, 0x3b, 0x23, 0x0a, 0x85, 0xa7, 0xcf, 0xac, 0x88, 0x32, 0xe1, 0x5d, 0x58, 0x6c,
+	0x59, 0xda, 0x51, 0x33, 0xd8, 0x93, 0x42, 0xf1, 0xe2, 0x54, 0xb2, 0xba, 0xa5, 0x1d, 0x85, 0xfa,
+	0x90, 0x6b, 0x45, 0x5a, 0xf8, 0x01, 0x2c, 0x3a, 0x56, 0x9f, 0xe9, 0x66, 0xa7, 0xe9, 0xf4, 0x0d,
+	0xea, 0x4a, 0xe7, 0xd6, 0x92, 0x27, 0x7c, 0x17, 0xab, 0xbe, 0xbd, 0xda, 0x37, 0x28, 0xdf, 0xf7,
+	0x5f, 0xa1, 0x44, 0xa1, 0xa0, 0xe6, 0x9c, 0xc1, 0x6b, 0xf7, 0x54, 0x32, 0x5a, 0x83, 0xe5, 0x63,
+	0x8a, 0xd1, 0xcb, 0x50, 0xe2, 0xe2, 0x37, 0x08, 0x72, 0xd1, 0xe0, 0xe0, 0xcf, 0x20, 0x6d, 0x10,
+	0xb3, 0xd3, 0xf7, 0xea, 0x94, 0x37, 0x22, 0x5f, 0x7d, 0x67, 0xca, 0xf8, 0x2a, 0x77, 0xc4, 0x78,
+	0x1e, 0x9b, 0x2f, 0xb8, 0x54, 0x86, 0x98, 0xf8, 0x75, 0x48, 0x87, 0xeb, 0xe7, 0x7f, 0xfd, 0x65,
+	0x9e, 0xd7, 0x53, 0xce, 0x9c, 0xf4, 0xe4, 0x09, 0x52, 0xc3, 0x2e, 0xf9, 0x4d, 0x48, 0x07, 0x30,
+	0x78, 0x09, 0xb2, 0x5b, 0x77, 0x9b, 0xbb, 0xb7, 0x77, 0xee, 0xdd, 0xa9, 0xed, 0xde, 0x2e, 0x9c,
+	0xc1, 0x79, 0x80, 0xf7, 0x6b, 0x1f, 0xd6, 0x1a, 0x3f, 0x50, 0xb7, 0xef, 0xed, 0x16, 0x90, 0xfc,
+	0x00, 0x96, 0xc7, 0x39, 0xb9, 0xf8, 0x5d, 0x48, 0x8b, 0xdb, 0x85, 0xe0, 0x14, 0x21, 0x9f, 0x3c,
+	0x15, 0x35, 0x1c, 0x23, 0xff, 0x11, 0xc1, 0xff, 0x8d, 0x1b, 0x6c, 0x72, 0x11, 0x73, 0xf1, 0x3d,
+	0x58, 0xf0, 0xf5, 0x2c, 0x00, 0x8f, 0xa1, 0x31, 0x62, 0xac, 0x22, 0x7e, 0x85, 0xb0, 0x0a, 0x18,
+	0x2f, 0x65, 0xa2, 0x1d, 0xd3, 0xac, 0xb7, 0xfc, 0x7b, 0x04, 0xe7, 0xb7, 0x28, 0x3b, 0x66, 0x3e,
+	0xf4, 0x51, 0x9f, 0xba, 0xec, 0xdb, 0x3c, 0xf2, 0xdc, 0x00, 0x18, 0xdc, 0x74, 0x4d, 0x3c, 0xf2,
+	0xf0, 0xcc, 0xdd, 0x21, 0x6e, 0x57, 0xcd, 0xec, 0x05, 0x8f, 0xf2, 0x9f, 0x11, 0x94, 0xee, 0xe8,
+	0xee, 0x31, 0x3c, 0xdd, 0x80, 0xe8, 0x4b, 0xbc, 0xa9, 0x3a, 0x05, 0xf1, 0xdf, 0x22, 0x38, 0xdf,
+	0x78, 0x51, 0x7c, 0x37, 0x61, 0x41, 0x24, 0x8e, 0xa0, 0x1b, 0x23, 0xd7, 0x22, 0x54, 0x83, 0xc1,
+	0xa7, 0xe1, 0xf8, 0x27, 0x04, 0x97, 0x8e, 0xcd, 0x81, 0xb0, 0xf2, 0x09, 0xae, 0x2f, 0xe1, 0x42,
+	0xe7, 0x14, 0xb4, 0xdb, 0x70, 0xf9, 0xf8, 0x94, 0x08, 0xdc, 0x86, 0xa9, 0x31, 0xec, 0x04, 0x4d,
+	0xe1, 0xa4, 0xfa, 0xcb, 0xcc, 0x71, 0x57, 0x5c, 0x2a, 0xed, 0xe8, 0xae, 0xb7, 0xd5, 0x0c, 0x80,
+	0x2d, 0xca, 0x82, 0xad, 0xfd, 0xca, 0x18, 0xe6, 0x6d, 0xef, 0xda, 0xb7, 0x78, 0x25, 0xf6, 0x0e,
+	0x97, 0x57, 0xbf, 0xf8, 0xeb, 0x3f, 0xbf, 0x4e, 0x9c, 0xc3, 0xcb, 0x15, 0xe2, 0x56, 0xc4, 0xda,
+	0x96, 0xc5, 0x46, 0xc7, 0xbf, 0x41, 0x90, 0xdd, 0xa2, 0x2c, 0xac, 0xb9, 0xdf, 0x19, 0xc5, 0x8d,
+	0xb3, 0x8a, 0xc5, 0x29, 0x3e, 0x89, 0xe4, 0x0a, 0xa7, 0x73, 0x05, 0xbf, 0x11, 0xa5, 0x13, 0x7e,
+	0x26, 0x55, 0x3e, 0xd7, 0x35, 0x57, 0x89, 0x9c, 0xc9, 0x1f, 0xe3, 0xaf, 0x11, 0x2c, 0x7a, 0xab,
+	0x32, 0xf8, 0x28, 0x1b, 0x2b, 0x6f, 0xf1, 0x16, 0xad, 0xf8, 0x66, 0x7c, 0x9a, 0xae, 0xfc, 0x1a,
+	0xe7, 0xf9, 0x2a, 0x3e, 0x77, 0x2c, 0x4f, 0xfc, 0x3b, 0x04, 0xc9, 0x2d, 0xef, 0xea, 0x33, 0x56,
+	0xc0, 0x02, 0x06, 0x31, 0x76, 0xa2, 0xfc, 0x3e, 0x77, 0x7c, 0x0b, 0xd7, 0x23, 0x8e, 0x45, 0x5c,
+	0x46, 0xaa, 0xd1, 0x48, 0xfb, 0xb1, 0x6f, 0x34, 0xb8, 0x01, 0x7f, 0x8c, 0xbf, 0x42, 0x30, 0xe7,
+	0x05, 0x07, 0x2b, 0xf1, 0x42, 0x16, 0x86, 0xea, 0xe2, 0xc9, 0x44, 0x5d, 0xf9, 0x3a, 0x67, 0x5a,
+	0xc1, 0xe5, 0x61, 0xa6, 0x27, 0xb0, 0xc4, 0xff, 0x45, 0x90, 0x6c, 0x1c, 0x17, 0xba, 0xc6, 0x69,
+	0x43, 0xf7, 0x6b, 0xc4, 0x19, 0xfd, 0x0a, 0x15, 0xd5, 0x61, 0x4a, 0xe2, 0x49, 0x89, 0x15, 0xc4,
+	0xa8, 0x71, 0x24, 0x98, 0x1b, 0xe8, 0xea, 0x27, 0xef, 0xca, 0x37, 0x66, 0x06, 0xde, 0x40, 0x57,
+	0xbd, 0x5c, 0x4e, 0xdd, 0xa2, 0x06, 0x65, 0x14, 0x4f, 0x27, 0x7c, 0xc5, 0x09, 0x85, 0x40, 0xae,
+	0xf3, 0x19, 0x7f, 0xef, 0xea, 0xc6, 0x54, 0x6b, 0x10, 0x12, 0xf7, 0x1a, 0xf5, 0x9d, 0xbf, 0xfd,
+	0xa3, 0x74, 0xe6, 0xc9, 0xd3, 0x12, 0xfa, 0xc3, 0xd3, 0x12, 0xfa, 0xd7, 0xd3, 0xd2, 0x99, 0xff,
+	0x3c, 0x2d, 0xa1, 0x2f, 0x9f, 0x95, 0xce, 0xfc, 0xe5, 0x59, 0x09, 0x7d, 0x52, 0xe9, 0x58, 0x0a,
+	0xdb, 0xa7, 0x6c, 0x5f, 0x37, 0x3b, 0xae, 0x62, 0x52, 0x76, 0x68, 0x39, 0xdd, 0xca, 0xf0, 0x1f,
+	0x41, 0x07, 0xd7, 0x2a, 0x76, 0xb7, 0x53, 0x61, 0xcc, 0xb4, 0x5b, 0xad, 0x14, 0xa7, 0x78, 0xed,
+	0x7f, 0x03, 0x00, 0x00, 0xee, 0xe2, 0x2b, 0x81, 0x1b, 0x00, 0x00,
+}
+
+func (x ApplicationWebhook_BodyTemplate_Language) String() string {
+	s, ok := ApplicationWebhook_BodyTemplate_Language_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
 }
-
 func (this *ApplicationWebhookIdentifiers) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1468,6 +1579,17 @@ func (this *ApplicationWebhook) Equal(that interface{}) bool {
 	if !this.ServiceData.Equal(that1.ServiceData) {
 		return false
 	}
+	if !this.BodyTemplate.Equal(that1.BodyTemplate) {
+		return false
+	}
+	if len(this.RoutingRules) != len(that1.RoutingRules) {
+		return false
+	}
+	for i := range this.RoutingRules {
+		if !this.RoutingRules[i].Equal(that1.RoutingRules[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ApplicationWebhook_Message) Equal(that interface{}) bool {
@@ -1494,6 +1616,33 @@ func (this *ApplicationWebhook_Message) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ApplicationWebhook_BodyTemplate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhook_BodyTemplate)
+	if !ok {
+		that2, ok := that.(ApplicationWebhook_BodyTemplate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Language != that1.Language {
+		return false
+	}
+	if this.Template != that1.Template {
+		return false
+	}
+	return true
+}
 func (this *ApplicationWebhooks) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2091,6 +2240,11 @@ func (this *ApplicationWebhook) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForRoutingRules := "[]*ApplicationRoutingRule{"
+	for _, f := range this.RoutingRules {
+		repeatedStringForRoutingRules += strings.Replace(fmt.Sprintf("%v", f), "ApplicationRoutingRule", "ApplicationRoutingRule", 1) + ","
+	}
+	repeatedStringForRoutingRules += "}"
 	keysForHeaders := make([]string, 0, len(this.Headers))
 	for k := range this.Headers {
 		keysForHeaders = append(keysForHeaders, k)
@@ -2131,6 +2285,8 @@ func (this *ApplicationWebhook) String() string {
 		`DownlinkQueueInvalidated:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkQueueInvalidated), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
 		`LocationSolved:` + strings.Replace(fmt.Sprintf("%v", this.LocationSolved), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
 		`ServiceData:` + strings.Replace(fmt.Sprintf("%v", this.ServiceData), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`BodyTemplate:` + strings.Replace(fmt.Sprintf("%v", this.BodyTemplate), "ApplicationWebhook_BodyTemplate", "ApplicationWebhook_BodyTemplate", 1) + `,`,
+		`RoutingRules:` + repeatedStringForRoutingRules + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2145,6 +2301,17 @@ func (this *ApplicationWebhook_Message) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationWebhook_BodyTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhook_BodyTemplate{`,
+		`Language:` + fmt.Sprintf("%v", this.Language) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationWebhooks) String() string {
 	if this == nil {
 		return "nil"
@@ -103,6 +103,9 @@ var ApplicationWebhookTemplatesFieldPathsTopLevel = []string{
 }
 var ApplicationWebhookFieldPathsNested = []string{
 	"base_url",
+	"body_template",
+	"body_template.language",
+	"body_template.template",
 	"created_at",
 	"downlink_ack",
 	"downlink_ack.path",
@@ -127,6 +130,7 @@ var ApplicationWebhookFieldPathsNested = []string{
 	"join_accept.path",
 	"location_solved",
 	"location_solved.path",
+	"routing_rules",
 	"service_data",
 	"service_data.path",
 	"template_fields",
@@ -139,6 +143,7 @@ var ApplicationWebhookFieldPathsNested = []string{
 
 var ApplicationWebhookFieldPathsTopLevel = []string{
 	"base_url",
+	"body_template",
 	"created_at",
 	"downlink_ack",
 	"downlink_api_key",
@@ -152,6 +157,7 @@ var ApplicationWebhookFieldPathsTopLevel = []string{
 	"ids",
 	"join_accept",
 	"location_solved",
+	"routing_rules",
 	"service_data",
 	"template_fields",
 	"template_ids",
@@ -198,6 +204,9 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"field_mask",
 	"webhook",
 	"webhook.base_url",
+	"webhook.body_template",
+	"webhook.body_template.language",
+	"webhook.body_template.template",
 	"webhook.created_at",
 	"webhook.downlink_ack",
 	"webhook.downlink_ack.path",
@@ -222,6 +231,7 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"webhook.join_accept.path",
 	"webhook.location_solved",
 	"webhook.location_solved.path",
+	"webhook.routing_rules",
 	"webhook.service_data",
 	"webhook.service_data.path",
 	"webhook.template_fields",
@@ -267,3 +277,12 @@ var ApplicationWebhook_MessageFieldPathsNested = []string{
 var ApplicationWebhook_MessageFieldPathsTopLevel = []string{
 	"path",
 }
+var ApplicationWebhook_BodyTemplateFieldPathsNested = []string{
+	"language",
+	"template",
+}
+
+var ApplicationWebhook_BodyTemplateFieldPathsTopLevel = []string{
+	"language",
+	"template",
+}
@@ -915,6 +915,40 @@ func (dst *ApplicationWebhook) SetFields(src *ApplicationWebhook, paths ...strin
 					dst.ServiceData = nil
 				}
 			}
+		case "body_template":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhook_BodyTemplate
+				if (src == nil || src.BodyTemplate == nil) && dst.BodyTemplate == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.BodyTemplate
+				}
+				if dst.BodyTemplate != nil {
+					newDst = dst.BodyTemplate
+				} else {
+					newDst = &ApplicationWebhook_BodyTemplate{}
+					dst.BodyTemplate = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.BodyTemplate = src.BodyTemplate
+				} else {
+					dst.BodyTemplate = nil
+				}
+			}
+		case "routing_rules":
+			if len(subs) > 0 {
+				return fmt.Errorf("'routing_rules' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RoutingRules = src.RoutingRules
+			} else {
+				dst.RoutingRules = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1204,3 +1238,34 @@ func (dst *ApplicationWebhook_Message) SetFields(src *ApplicationWebhook_Message
 	}
 	return nil
 }
+
+func (dst *ApplicationWebhook_BodyTemplate) SetFields(src *ApplicationWebhook_BodyTemplate, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "language":
+			if len(subs) > 0 {
+				return fmt.Errorf("'language' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Language = src.Language
+			} else {
+				var zero ApplicationWebhook_BodyTemplate_Language
+				dst.Language = zero
+			}
+		case "template":
+			if len(subs) > 0 {
+				return fmt.Errorf("'template' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Template = src.Template
+			} else {
+				var zero string
+				dst.Template = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -1056,6 +1056,42 @@ func (m *ApplicationWebhook) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "body_template":
+
+			if v, ok := interface{}(m.GetBodyTemplate()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookValidationError{
+						field:  "body_template",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "routing_rules":
+
+			if len(m.GetRoutingRules()) > 16 {
+				return ApplicationWebhookValidationError{
+					field:  "routing_rules",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetRoutingRules() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationWebhookValidationError{
+							field:  fmt.Sprintf("routing_rules[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
 		default:
 			return ApplicationWebhookValidationError{
 				field:  name,
@@ -2033,3 +2069,103 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ApplicationWebhook_MessageValidationError{}
+
+// ValidateFields checks the field values on ApplicationWebhook_BodyTemplate
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ApplicationWebhook_BodyTemplate) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhook_BodyTemplateFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "language":
+
+			if _, ok := ApplicationWebhook_BodyTemplate_Language_name[int32(m.GetLanguage())]; !ok {
+				return ApplicationWebhook_BodyTemplateValidationError{
+					field:  "language",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "template":
+
+			if utf8.RuneCountInString(m.GetTemplate()) > 16384 {
+				return ApplicationWebhook_BodyTemplateValidationError{
+					field:  "template",
+					reason: "value length must be at most 16384 runes",
+				}
+			}
+
+		default:
+			return ApplicationWebhook_BodyTemplateValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhook_BodyTemplateValidationError is the validation error
+// returned by ApplicationWebhook_BodyTemplate.ValidateFields if the designated
+// constraints aren't met.
+type ApplicationWebhook_BodyTemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhook_BodyTemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhook_BodyTemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhook_BodyTemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhook_BodyTemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhook_BodyTemplateValidationError) ErrorName() string {
+	return "ApplicationWebhook_BodyTemplateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhook_BodyTemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhook_BodyTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhook_BodyTemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhook_BodyTemplateValidationError{}
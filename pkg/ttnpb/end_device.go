@@ -123,6 +123,60 @@ func (v *EndDeviceAuthenticationCode) FieldIsZero(p string) bool {
 	panic(fmt.Sprintf("unknown path '%s'", p))
 }
 
+// FieldIsZero returns whether path p is zero.
+func (v *RelayParameters) FieldIsZero(p string) bool {
+	if v == nil {
+		return true
+	}
+	switch p {
+	case "mode":
+		return v.Mode == nil
+	case "mode.served":
+		return v.GetServed() == nil
+	case "mode.served.backoff":
+		return v.GetServed().GetBackoff() == 0
+	case "mode.served.mode":
+		return v.GetServed().GetMode() == nil
+	case "mode.served.mode.always":
+		return v.GetServed().GetAlways() == nil
+	case "mode.served.mode.dynamic":
+		return v.GetServed().GetDynamic() == nil
+	case "mode.served.mode.dynamic.smart_enable_level":
+		return v.GetServed().GetDynamic().GetSmartEnableLevel() == 0
+	case "mode.served.mode.end_device_controlled":
+		return v.GetServed().GetEndDeviceControlled() == nil
+	case "mode.served.second_channel":
+		return v.GetServed().GetSecondChannel() == nil
+	case "mode.served.second_channel.ack_offset":
+		return v.GetServed().GetSecondChannel().GetAckOffset() == 0
+	case "mode.served.second_channel.data_rate_index":
+		return v.GetServed().GetSecondChannel().GetDataRateIndex() == 0
+	case "mode.served.second_channel.frequency":
+		return v.GetServed().GetSecondChannel().GetFrequency() == 0
+	case "mode.served.serving_device_id":
+		return v.GetServed().GetServingDeviceId() == ""
+	case "mode.serving":
+		return v.GetServing() == nil
+	case "mode.serving.cad_periodicity":
+		return v.GetServing().GetCadPeriodicity() == 0
+	case "mode.serving.default_channel_index":
+		return v.GetServing().GetDefaultChannelIndex() == 0
+	case "mode.serving.join_request_filters":
+		return v.GetServing().GetJoinRequestFilters() == nil
+	case "mode.serving.second_channel":
+		return v.GetServing().GetSecondChannel() == nil
+	case "mode.serving.second_channel.ack_offset":
+		return v.GetServing().GetSecondChannel().GetAckOffset() == 0
+	case "mode.serving.second_channel.data_rate_index":
+		return v.GetServing().GetSecondChannel().GetDataRateIndex() == 0
+	case "mode.serving.second_channel.frequency":
+		return v.GetServing().GetSecondChannel().GetFrequency() == 0
+	case "mode.serving.uplink_forwarding_rules":
+		return v.GetServing().GetUplinkForwardingRules() == nil
+	}
+	panic(fmt.Sprintf("unknown path '%s'", p))
+}
+
 // FieldIsZero returns whether path p is zero.
 func (v *MACSettings) FieldIsZero(p string) bool {
 	if v == nil {
@@ -161,6 +215,8 @@ func (v *MACSettings) FieldIsZero(p string) bool {
 		return v.DesiredMaxEirp == nil
 	case "desired_max_eirp.value":
 		return v.DesiredMaxEirp.FieldIsZero("value")
+	case "desired_relay":
+		return v.DesiredRelay == nil
 	case "desired_ping_slot_data_rate_index":
 		return v.DesiredPingSlotDataRateIndex == nil
 	case "desired_ping_slot_data_rate_index.value":
@@ -203,6 +259,8 @@ func (v *MACSettings) FieldIsZero(p string) bool {
 		return v.PingSlotPeriodicity == nil
 	case "ping_slot_periodicity.value":
 		return v.PingSlotPeriodicity.FieldIsZero("value")
+	case "relay":
+		return v.Relay == nil
 	case "resets_f_cnt":
 		return v.ResetsFCnt == nil
 	case "resets_f_cnt.value":
@@ -236,6 +294,12 @@ func (v *MACSettings) FieldIsZero(p string) bool {
 	case "use_adr.value":
 		return v.UseAdr.FieldIsZero("value")
 	}
+	switch {
+	case strings.HasPrefix(p, "desired_relay."):
+		return v.DesiredRelay.FieldIsZero(strings.TrimPrefix(p, "desired_relay."))
+	case strings.HasPrefix(p, "relay."):
+		return v.Relay.FieldIsZero(strings.TrimPrefix(p, "relay."))
+	}
 	panic(fmt.Sprintf("unknown path '%s'", p))
 }
 
@@ -287,6 +351,8 @@ func (v *MACParameters) FieldIsZero(p string) bool {
 		return v.RejoinCountPeriodicity == 0
 	case "rejoin_time_periodicity":
 		return v.RejoinTimePeriodicity == 0
+	case "relay":
+		return v.Relay == nil
 	case "rx1_data_rate_offset":
 		return v.Rx1DataRateOffset == 0
 	case "rx1_delay":
@@ -300,6 +366,9 @@ func (v *MACParameters) FieldIsZero(p string) bool {
 	case "uplink_dwell_time.value":
 		return v.UplinkDwellTime.FieldIsZero("value")
 	}
+	if strings.HasPrefix(p, "relay.") {
+		return v.Relay.FieldIsZero(strings.TrimPrefix(p, "relay."))
+	}
 	panic(fmt.Sprintf("unknown path '%s'", p))
 }
 
@@ -455,6 +524,8 @@ func (v *MACState) FieldIsZero(p string) bool {
 		return v.CurrentParameters.FieldIsZero("rejoin_count_periodicity")
 	case "current_parameters.rejoin_time_periodicity":
 		return v.CurrentParameters.FieldIsZero("rejoin_time_periodicity")
+	case "current_parameters.relay":
+		return v.CurrentParameters.FieldIsZero("relay")
 	case "current_parameters.rx1_data_rate_offset":
 		return v.CurrentParameters.FieldIsZero("rx1_data_rate_offset")
 	case "current_parameters.rx1_delay":
@@ -511,6 +582,8 @@ func (v *MACState) FieldIsZero(p string) bool {
 		return v.DesiredParameters.FieldIsZero("rejoin_count_periodicity")
 	case "desired_parameters.rejoin_time_periodicity":
 		return v.DesiredParameters.FieldIsZero("rejoin_time_periodicity")
+	case "desired_parameters.relay":
+		return v.DesiredParameters.FieldIsZero("relay")
 	case "desired_parameters.rx1_data_rate_offset":
 		return v.DesiredParameters.FieldIsZero("rx1_data_rate_offset")
 	case "desired_parameters.rx1_delay":
@@ -685,6 +758,10 @@ func (v *MACState) FieldIsZero(p string) bool {
 		return v.PendingJoinRequest.FieldIsZero("rx_delay")
 	case "pending_join_request.selected_mac_version":
 		return v.PendingJoinRequest.FieldIsZero("selected_mac_version")
+	case "pending_relay_downlink":
+		return v.PendingRelayDownlink == nil
+	case "pending_relay_downlink.raw_payload":
+		return v.PendingRelayDownlink.GetRawPayload() == nil
 	case "pending_requests":
 		return v.PendingRequests == nil
 	case "ping_slot_periodicity":
@@ -774,6 +851,12 @@ func (v *MACState) FieldIsZero(p string) bool {
 	case "rx_windows_available":
 		return !v.RxWindowsAvailable
 	}
+	switch {
+	case strings.HasPrefix(p, "current_parameters.relay."):
+		return v.CurrentParameters.FieldIsZero(strings.TrimPrefix(p, "current_parameters."))
+	case strings.HasPrefix(p, "desired_parameters.relay."):
+		return v.DesiredParameters.FieldIsZero(strings.TrimPrefix(p, "desired_parameters."))
+	}
 	panic(fmt.Sprintf("unknown path '%s'", p))
 }
 
@@ -967,6 +1050,8 @@ func (v *EndDevice) FieldIsZero(p string) bool {
 		return v.MacSettings.FieldIsZero("desired_max_eirp")
 	case "mac_settings.desired_max_eirp.value":
 		return v.MacSettings.FieldIsZero("desired_max_eirp.value")
+	case "mac_settings.desired_relay":
+		return v.MacSettings.FieldIsZero("desired_relay")
 	case "mac_settings.desired_ping_slot_data_rate_index":
 		return v.MacSettings.FieldIsZero("desired_ping_slot_data_rate_index")
 	case "mac_settings.desired_ping_slot_data_rate_index.value":
@@ -1009,6 +1094,8 @@ func (v *EndDevice) FieldIsZero(p string) bool {
 		return v.MacSettings.FieldIsZero("ping_slot_periodicity")
 	case "mac_settings.ping_slot_periodicity.value":
 		return v.MacSettings.FieldIsZero("ping_slot_periodicity.value")
+	case "mac_settings.relay":
+		return v.MacSettings.FieldIsZero("relay")
 	case "mac_settings.resets_f_cnt":
 		return v.MacSettings.FieldIsZero("resets_f_cnt")
 	case "mac_settings.resets_f_cnt.value":
@@ -1233,6 +1320,8 @@ func (v *EndDevice) FieldIsZero(p string) bool {
 		return v.VersionIds.FieldIsZero("band_id")
 	}
 	switch {
+	case strings.HasPrefix(p, "mac_settings.relay."), strings.HasPrefix(p, "mac_settings.desired_relay."):
+		return v.MacSettings.FieldIsZero(strings.TrimPrefix(p, "mac_settings."))
 	case strings.HasPrefix(p, "mac_state."):
 		return v.MacState.FieldIsZero(strings.TrimPrefix(p, "mac_state."))
 	case strings.HasPrefix(p, "pending_mac_state."):
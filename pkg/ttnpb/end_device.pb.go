@@ -233,8 +233,10 @@ type MACParameters struct {
 	AdrAckDelayExponent *ADRAckDelayExponentValue `protobuf:"bytes,23,opt,name=adr_ack_delay_exponent,json=adrAckDelayExponent,proto3" json:"adr_ack_delay_exponent,omitempty"`
 	// Data rate index of the class B ping slot.
 	PingSlotDataRateIndexValue *DataRateIndexValue `protobuf:"bytes,24,opt,name=ping_slot_data_rate_index_value,json=pingSlotDataRateIndexValue,proto3" json:"ping_slot_data_rate_index_value,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}            `json:"-"`
-	XXX_sizecache              int32               `json:"-"`
+	// Relay parameters.
+	Relay                *RelayParameters `protobuf:"bytes,25,opt,name=relay,proto3" json:"relay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *MACParameters) Reset()      { *m = MACParameters{} }
@@ -417,6 +419,13 @@ func (m *MACParameters) GetPingSlotDataRateIndexValue() *DataRateIndexValue {
 	return nil
 }
 
+func (m *MACParameters) GetRelay() *RelayParameters {
+	if m != nil {
+		return m.Relay
+	}
+	return nil
+}
+
 type MACParameters_Channel struct {
 	// Uplink frequency of the channel (Hz).
 	UplinkFrequency uint64 `protobuf:"varint,1,opt,name=uplink_frequency,json=uplinkFrequency,proto3" json:"uplink_frequency,omitempty"`
@@ -719,8 +728,14 @@ type MACSettings struct {
 	DesiredMaxEirp *DeviceEIRPValue `protobuf:"bytes,30,opt,name=desired_max_eirp,json=desiredMaxEirp,proto3" json:"desired_max_eirp,omitempty"`
 	// The minimum duration passed before a network-initiated(e.g. Class B or C) downlink following an arbitrary downlink.
 	ClassBCDownlinkInterval *time.Duration `protobuf:"bytes,31,opt,name=class_b_c_downlink_interval,json=classBCDownlinkInterval,proto3,stdduration" json:"class_b_c_downlink_interval,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}       `json:"-"`
-	XXX_sizecache           int32          `json:"-"`
+	// The relay parameters the end device is using.
+	// If unset, the end device neither is a relay nor is served by a relay.
+	Relay *RelayParameters `protobuf:"bytes,32,opt,name=relay,proto3" json:"relay,omitempty"`
+	// The relay parameters the Network Server should configure the end device to use via MAC commands.
+	// If unset, the relay parameters the end device is using are kept.
+	DesiredRelay         *RelayParameters `protobuf:"bytes,33,opt,name=desired_relay,json=desiredRelay,proto3" json:"desired_relay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *MACSettings) Reset()      { *m = MACSettings{} }
@@ -956,6 +971,378 @@ func (m *MACSettings) GetClassBCDownlinkInterval() *time.Duration {
 	return nil
 }
 
+func (m *MACSettings) GetRelay() *RelayParameters {
+	if m != nil {
+		return m.Relay
+	}
+	return nil
+}
+
+func (m *MACSettings) GetDesiredRelay() *RelayParameters {
+	if m != nil {
+		return m.DesiredRelay
+	}
+	return nil
+}
+
+type ServingRelayParameters struct {
+	SecondChannel       *RelaySecondChannel `protobuf:"bytes,1,opt,name=second_channel,json=secondChannel,proto3" json:"second_channel,omitempty"`
+	DefaultChannelIndex uint32              `protobuf:"varint,2,opt,name=default_channel_index,json=defaultChannelIndex,proto3" json:"default_channel_index,omitempty"`
+	CadPeriodicity      RelayCADPeriodicity `protobuf:"varint,3,opt,name=cad_periodicity,json=cadPeriodicity,proto3,enum=ttn.lorawan.v3.RelayCADPeriodicity" json:"cad_periodicity,omitempty"`
+	// Uplink forwarding rules of the relay. The position of a rule is its index.
+	UplinkForwardingRules []*ServingRelayParameters_UplinkForwardingRule `protobuf:"bytes,4,rep,name=uplink_forwarding_rules,json=uplinkForwardingRules,proto3" json:"uplink_forwarding_rules,omitempty"`
+	// Join-request filters of the relay. The position of a filter is its index.
+	JoinRequestFilters   []*ServingRelayParameters_JoinRequestFilter `protobuf:"bytes,5,rep,name=join_request_filters,json=joinRequestFilters,proto3" json:"join_request_filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *ServingRelayParameters) Reset()      { *m = ServingRelayParameters{} }
+func (*ServingRelayParameters) ProtoMessage() {}
+func (*ServingRelayParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{5}
+}
+func (m *ServingRelayParameters) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServingRelayParameters.Unmarshal(m, b)
+}
+func (m *ServingRelayParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServingRelayParameters.Marshal(b, m, deterministic)
+}
+func (m *ServingRelayParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServingRelayParameters.Merge(m, src)
+}
+func (m *ServingRelayParameters) XXX_Size() int {
+	return xxx_messageInfo_ServingRelayParameters.Size(m)
+}
+func (m *ServingRelayParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServingRelayParameters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServingRelayParameters proto.InternalMessageInfo
+
+func (m *ServingRelayParameters) GetSecondChannel() *RelaySecondChannel {
+	if m != nil {
+		return m.SecondChannel
+	}
+	return nil
+}
+
+func (m *ServingRelayParameters) GetDefaultChannelIndex() uint32 {
+	if m != nil {
+		return m.DefaultChannelIndex
+	}
+	return 0
+}
+
+func (m *ServingRelayParameters) GetCadPeriodicity() RelayCADPeriodicity {
+	if m != nil {
+		return m.CadPeriodicity
+	}
+	return RELAY_CAD_PERIODICITY_1_SECOND
+}
+
+func (m *ServingRelayParameters) GetUplinkForwardingRules() []*ServingRelayParameters_UplinkForwardingRule {
+	if m != nil {
+		return m.UplinkForwardingRules
+	}
+	return nil
+}
+
+func (m *ServingRelayParameters) GetJoinRequestFilters() []*ServingRelayParameters_JoinRequestFilter {
+	if m != nil {
+		return m.JoinRequestFilters
+	}
+	return nil
+}
+
+type ServingRelayParameters_UplinkForwardingRule struct {
+	// Identifier of the served end device, in the application of the relay.
+	// If unset, the rule is not used.
+	DeviceId string              `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Limits   *RelayForwardLimits `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
+	// Wake-on-radio frame counter of the served end device, last reported by the relay.
+	LastWFCnt            uint32   `protobuf:"varint,3,opt,name=last_w_f_cnt,json=lastWFCnt,proto3" json:"last_w_f_cnt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServingRelayParameters_UplinkForwardingRule) Reset() {
+	*m = ServingRelayParameters_UplinkForwardingRule{}
+}
+func (*ServingRelayParameters_UplinkForwardingRule) ProtoMessage() {}
+func (*ServingRelayParameters_UplinkForwardingRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{5, 0}
+}
+func (m *ServingRelayParameters_UplinkForwardingRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServingRelayParameters_UplinkForwardingRule.Unmarshal(m, b)
+}
+func (m *ServingRelayParameters_UplinkForwardingRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServingRelayParameters_UplinkForwardingRule.Marshal(b, m, deterministic)
+}
+func (m *ServingRelayParameters_UplinkForwardingRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServingRelayParameters_UplinkForwardingRule.Merge(m, src)
+}
+func (m *ServingRelayParameters_UplinkForwardingRule) XXX_Size() int {
+	return xxx_messageInfo_ServingRelayParameters_UplinkForwardingRule.Size(m)
+}
+func (m *ServingRelayParameters_UplinkForwardingRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServingRelayParameters_UplinkForwardingRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServingRelayParameters_UplinkForwardingRule proto.InternalMessageInfo
+
+func (m *ServingRelayParameters_UplinkForwardingRule) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
+func (m *ServingRelayParameters_UplinkForwardingRule) GetLimits() *RelayForwardLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func (m *ServingRelayParameters_UplinkForwardingRule) GetLastWFCnt() uint32 {
+	if m != nil {
+		return m.LastWFCnt
+	}
+	return 0
+}
+
+type ServingRelayParameters_JoinRequestFilter struct {
+	Action               RelayJoinRequestFilterAction                          `protobuf:"varint,1,opt,name=action,proto3,enum=ttn.lorawan.v3.RelayJoinRequestFilterAction" json:"action,omitempty"`
+	JoinEui              go_thethings_network_lorawan_stack_v3_pkg_types.EUI64 `protobuf:"bytes,2,opt,name=join_eui,json=joinEui,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.EUI64" json:"join_eui"`
+	DevEui               go_thethings_network_lorawan_stack_v3_pkg_types.EUI64 `protobuf:"bytes,3,opt,name=dev_eui,json=devEui,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.EUI64" json:"dev_eui"`
+	XXX_NoUnkeyedLiteral struct{}                                              `json:"-"`
+	XXX_sizecache        int32                                                 `json:"-"`
+}
+
+func (m *ServingRelayParameters_JoinRequestFilter) Reset() {
+	*m = ServingRelayParameters_JoinRequestFilter{}
+}
+func (*ServingRelayParameters_JoinRequestFilter) ProtoMessage() {}
+func (*ServingRelayParameters_JoinRequestFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{5, 1}
+}
+func (m *ServingRelayParameters_JoinRequestFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServingRelayParameters_JoinRequestFilter.Unmarshal(m, b)
+}
+func (m *ServingRelayParameters_JoinRequestFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServingRelayParameters_JoinRequestFilter.Marshal(b, m, deterministic)
+}
+func (m *ServingRelayParameters_JoinRequestFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServingRelayParameters_JoinRequestFilter.Merge(m, src)
+}
+func (m *ServingRelayParameters_JoinRequestFilter) XXX_Size() int {
+	return xxx_messageInfo_ServingRelayParameters_JoinRequestFilter.Size(m)
+}
+func (m *ServingRelayParameters_JoinRequestFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServingRelayParameters_JoinRequestFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServingRelayParameters_JoinRequestFilter proto.InternalMessageInfo
+
+func (m *ServingRelayParameters_JoinRequestFilter) GetAction() RelayJoinRequestFilterAction {
+	if m != nil {
+		return m.Action
+	}
+	return RELAY_JOIN_REQUEST_FILTER_ACTION_NO_RULE
+}
+
+type ServedRelayParameters struct {
+	// Types that are valid to be assigned to Mode:
+	//	*ServedRelayParameters_Always
+	//	*ServedRelayParameters_Dynamic
+	//	*ServedRelayParameters_EndDeviceControlled
+	Mode isServedRelayParameters_Mode `protobuf_oneof:"mode"`
+	// Number of uplinks the end device sends without acknowledgment from the relay before it sends uplinks directly.
+	Backoff       uint32              `protobuf:"varint,4,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	SecondChannel *RelaySecondChannel `protobuf:"bytes,5,opt,name=second_channel,json=secondChannel,proto3" json:"second_channel,omitempty"`
+	// Identifier of the relay serving the end device, in the application of the end device.
+	ServingDeviceId      string   `protobuf:"bytes,6,opt,name=serving_device_id,json=servingDeviceId,proto3" json:"serving_device_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServedRelayParameters) Reset()      { *m = ServedRelayParameters{} }
+func (*ServedRelayParameters) ProtoMessage() {}
+func (*ServedRelayParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{6}
+}
+func (m *ServedRelayParameters) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServedRelayParameters.Unmarshal(m, b)
+}
+func (m *ServedRelayParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServedRelayParameters.Marshal(b, m, deterministic)
+}
+func (m *ServedRelayParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServedRelayParameters.Merge(m, src)
+}
+func (m *ServedRelayParameters) XXX_Size() int {
+	return xxx_messageInfo_ServedRelayParameters.Size(m)
+}
+func (m *ServedRelayParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServedRelayParameters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServedRelayParameters proto.InternalMessageInfo
+
+type isServedRelayParameters_Mode interface {
+	isServedRelayParameters_Mode()
+	Equal(interface{}) bool
+}
+
+type ServedRelayParameters_Always struct {
+	Always *RelayEndDeviceAlwaysMode `protobuf:"bytes,1,opt,name=always,proto3,oneof" json:"always,omitempty"`
+}
+type ServedRelayParameters_Dynamic struct {
+	Dynamic *RelayEndDeviceDynamicMode `protobuf:"bytes,2,opt,name=dynamic,proto3,oneof" json:"dynamic,omitempty"`
+}
+type ServedRelayParameters_EndDeviceControlled struct {
+	EndDeviceControlled *RelayEndDeviceControlledMode `protobuf:"bytes,3,opt,name=end_device_controlled,json=endDeviceControlled,proto3,oneof" json:"end_device_controlled,omitempty"`
+}
+
+func (*ServedRelayParameters_Always) isServedRelayParameters_Mode()              {}
+func (*ServedRelayParameters_Dynamic) isServedRelayParameters_Mode()             {}
+func (*ServedRelayParameters_EndDeviceControlled) isServedRelayParameters_Mode() {}
+
+func (m *ServedRelayParameters) GetMode() isServedRelayParameters_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return nil
+}
+
+func (m *ServedRelayParameters) GetAlways() *RelayEndDeviceAlwaysMode {
+	if x, ok := m.GetMode().(*ServedRelayParameters_Always); ok {
+		return x.Always
+	}
+	return nil
+}
+
+func (m *ServedRelayParameters) GetDynamic() *RelayEndDeviceDynamicMode {
+	if x, ok := m.GetMode().(*ServedRelayParameters_Dynamic); ok {
+		return x.Dynamic
+	}
+	return nil
+}
+
+func (m *ServedRelayParameters) GetEndDeviceControlled() *RelayEndDeviceControlledMode {
+	if x, ok := m.GetMode().(*ServedRelayParameters_EndDeviceControlled); ok {
+		return x.EndDeviceControlled
+	}
+	return nil
+}
+
+func (m *ServedRelayParameters) GetBackoff() uint32 {
+	if m != nil {
+		return m.Backoff
+	}
+	return 0
+}
+
+func (m *ServedRelayParameters) GetSecondChannel() *RelaySecondChannel {
+	if m != nil {
+		return m.SecondChannel
+	}
+	return nil
+}
+
+func (m *ServedRelayParameters) GetServingDeviceId() string {
+	if m != nil {
+		return m.ServingDeviceId
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ServedRelayParameters) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ServedRelayParameters_Always)(nil),
+		(*ServedRelayParameters_Dynamic)(nil),
+		(*ServedRelayParameters_EndDeviceControlled)(nil),
+	}
+}
+
+type RelayParameters struct {
+	// Types that are valid to be assigned to Mode:
+	//	*RelayParameters_Serving
+	//	*RelayParameters_Served
+	Mode                 isRelayParameters_Mode `protobuf_oneof:"mode"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *RelayParameters) Reset()      { *m = RelayParameters{} }
+func (*RelayParameters) ProtoMessage() {}
+func (*RelayParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{7}
+}
+func (m *RelayParameters) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelayParameters.Unmarshal(m, b)
+}
+func (m *RelayParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelayParameters.Marshal(b, m, deterministic)
+}
+func (m *RelayParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayParameters.Merge(m, src)
+}
+func (m *RelayParameters) XXX_Size() int {
+	return xxx_messageInfo_RelayParameters.Size(m)
+}
+func (m *RelayParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayParameters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayParameters proto.InternalMessageInfo
+
+type isRelayParameters_Mode interface {
+	isRelayParameters_Mode()
+	Equal(interface{}) bool
+}
+
+type RelayParameters_Serving struct {
+	Serving *ServingRelayParameters `protobuf:"bytes,1,opt,name=serving,proto3,oneof" json:"serving,omitempty"`
+}
+type RelayParameters_Served struct {
+	Served *ServedRelayParameters `protobuf:"bytes,2,opt,name=served,proto3,oneof" json:"served,omitempty"`
+}
+
+func (*RelayParameters_Serving) isRelayParameters_Mode() {}
+func (*RelayParameters_Served) isRelayParameters_Mode()  {}
+
+func (m *RelayParameters) GetMode() isRelayParameters_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return nil
+}
+
+func (m *RelayParameters) GetServing() *ServingRelayParameters {
+	if x, ok := m.GetMode().(*RelayParameters_Serving); ok {
+		return x.Serving
+	}
+	return nil
+}
+
+func (m *RelayParameters) GetServed() *ServedRelayParameters {
+	if x, ok := m.GetMode().(*RelayParameters_Served); ok {
+		return x.Served
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*RelayParameters) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*RelayParameters_Serving)(nil),
+		(*RelayParameters_Served)(nil),
+	}
+}
+
 // MACState represents the state of MAC layer of the device.
 // MACState is reset on each join for OTAA or ResetInd for ABP devices.
 // This is used internally by the Network Server.
@@ -1017,15 +1404,18 @@ type MACState struct {
 	// Data rate ranges rejected by the device per frequency.
 	RejectedDataRateRanges map[uint64]*MACState_DataRateRanges `protobuf:"bytes,21,rep,name=rejected_data_rate_ranges,json=rejectedDataRateRanges,proto3" json:"rejected_data_rate_ranges,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Frame counter of uplink, which confirmed the last ADR parameter change.
-	LastAdrChangeFCntUp  uint32   `protobuf:"varint,22,opt,name=last_adr_change_f_cnt_up,json=lastAdrChangeFCntUp,proto3" json:"last_adr_change_f_cnt_up,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	LastAdrChangeFCntUp uint32 `protobuf:"varint,22,opt,name=last_adr_change_f_cnt_up,json=lastAdrChangeFCntUp,proto3" json:"last_adr_change_f_cnt_up,omitempty"`
+	// Downlink of a served end device, which is pending to be forwarded by this relay.
+	// Set each time a downlink for a served end device is scheduled and removed each time it is forwarded to the relay.
+	PendingRelayDownlink *RelayForwardDownlinkReq `protobuf:"bytes,23,opt,name=pending_relay_downlink,json=pendingRelayDownlink,proto3" json:"pending_relay_downlink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *MACState) Reset()      { *m = MACState{} }
 func (*MACState) ProtoMessage() {}
 func (*MACState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{5}
+	return fileDescriptor_a656ee0551c94a80, []int{8}
 }
 func (m *MACState) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MACState.Unmarshal(m, b)
@@ -1199,6 +1589,13 @@ func (m *MACState) GetLastAdrChangeFCntUp() uint32 {
 	return 0
 }
 
+func (m *MACState) GetPendingRelayDownlink() *RelayForwardDownlinkReq {
+	if m != nil {
+		return m.PendingRelayDownlink
+	}
+	return nil
+}
+
 type MACState_JoinRequest struct {
 	DownlinkSettings     DLSettings `protobuf:"bytes,6,opt,name=downlink_settings,json=downlinkSettings,proto3" json:"downlink_settings"`
 	RxDelay              RxDelay    `protobuf:"varint,7,opt,name=rx_delay,json=rxDelay,proto3,enum=ttn.lorawan.v3.RxDelay" json:"rx_delay,omitempty"`
@@ -1210,7 +1607,7 @@ type MACState_JoinRequest struct {
 func (m *MACState_JoinRequest) Reset()      { *m = MACState_JoinRequest{} }
 func (*MACState_JoinRequest) ProtoMessage() {}
 func (*MACState_JoinRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{5, 0}
+	return fileDescriptor_a656ee0551c94a80, []int{8, 0}
 }
 func (m *MACState_JoinRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MACState_JoinRequest.Unmarshal(m, b)
@@ -1267,7 +1664,7 @@ type MACState_JoinAccept struct {
 func (m *MACState_JoinAccept) Reset()      { *m = MACState_JoinAccept{} }
 func (*MACState_JoinAccept) ProtoMessage() {}
 func (*MACState_JoinAccept) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{5, 1}
+	return fileDescriptor_a656ee0551c94a80, []int{8, 1}
 }
 func (m *MACState_JoinAccept) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MACState_JoinAccept.Unmarshal(m, b)
@@ -1325,7 +1722,7 @@ type MACState_DataRateRange struct {
 func (m *MACState_DataRateRange) Reset()      { *m = MACState_DataRateRange{} }
 func (*MACState_DataRateRange) ProtoMessage() {}
 func (*MACState_DataRateRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{5, 2}
+	return fileDescriptor_a656ee0551c94a80, []int{8, 2}
 }
 func (m *MACState_DataRateRange) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MACState_DataRateRange.Unmarshal(m, b)
@@ -1368,7 +1765,7 @@ type MACState_DataRateRanges struct {
 func (m *MACState_DataRateRanges) Reset()      { *m = MACState_DataRateRanges{} }
 func (*MACState_DataRateRanges) ProtoMessage() {}
 func (*MACState_DataRateRanges) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{5, 3}
+	return fileDescriptor_a656ee0551c94a80, []int{8, 3}
 }
 func (m *MACState_DataRateRanges) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MACState_DataRateRanges.Unmarshal(m, b)
@@ -1407,7 +1804,7 @@ type EndDeviceAuthenticationCode struct {
 func (m *EndDeviceAuthenticationCode) Reset()      { *m = EndDeviceAuthenticationCode{} }
 func (*EndDeviceAuthenticationCode) ProtoMessage() {}
 func (*EndDeviceAuthenticationCode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{6}
+	return fileDescriptor_a656ee0551c94a80, []int{9}
 }
 func (m *EndDeviceAuthenticationCode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EndDeviceAuthenticationCode.Unmarshal(m, b)
@@ -1607,7 +2004,7 @@ type EndDevice struct {
 func (m *EndDevice) Reset()      { *m = EndDevice{} }
 func (*EndDevice) ProtoMessage() {}
 func (*EndDevice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{7}
+	return fileDescriptor_a656ee0551c94a80, []int{10}
 }
 func (m *EndDevice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EndDevice.Unmarshal(m, b)
@@ -1972,7 +2369,7 @@ type EndDevices struct {
 func (m *EndDevices) Reset()      { *m = EndDevices{} }
 func (*EndDevices) ProtoMessage() {}
 func (*EndDevices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{8}
+	return fileDescriptor_a656ee0551c94a80, []int{11}
 }
 func (m *EndDevices) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EndDevices.Unmarshal(m, b)
@@ -2011,7 +2408,7 @@ type DevAddrPrefix struct {
 func (m *DevAddrPrefix) Reset()      { *m = DevAddrPrefix{} }
 func (*DevAddrPrefix) ProtoMessage() {}
 func (*DevAddrPrefix) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{9}
+	return fileDescriptor_a656ee0551c94a80, []int{12}
 }
 func (m *DevAddrPrefix) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DevAddrPrefix.Unmarshal(m, b)
@@ -2047,7 +2444,7 @@ type CreateEndDeviceRequest struct {
 func (m *CreateEndDeviceRequest) Reset()      { *m = CreateEndDeviceRequest{} }
 func (*CreateEndDeviceRequest) ProtoMessage() {}
 func (*CreateEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{10}
+	return fileDescriptor_a656ee0551c94a80, []int{13}
 }
 func (m *CreateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateEndDeviceRequest.Unmarshal(m, b)
@@ -2079,7 +2476,7 @@ type UpdateEndDeviceRequest struct {
 func (m *UpdateEndDeviceRequest) Reset()      { *m = UpdateEndDeviceRequest{} }
 func (*UpdateEndDeviceRequest) ProtoMessage() {}
 func (*UpdateEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{11}
+	return fileDescriptor_a656ee0551c94a80, []int{14}
 }
 func (m *UpdateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateEndDeviceRequest.Unmarshal(m, b)
@@ -2118,7 +2515,7 @@ type GetEndDeviceRequest struct {
 func (m *GetEndDeviceRequest) Reset()      { *m = GetEndDeviceRequest{} }
 func (*GetEndDeviceRequest) ProtoMessage() {}
 func (*GetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{12}
+	return fileDescriptor_a656ee0551c94a80, []int{15}
 }
 func (m *GetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetEndDeviceRequest.Unmarshal(m, b)
@@ -2155,7 +2552,7 @@ type GetEndDeviceIdentifiersForEUIsRequest struct {
 func (m *GetEndDeviceIdentifiersForEUIsRequest) Reset()      { *m = GetEndDeviceIdentifiersForEUIsRequest{} }
 func (*GetEndDeviceIdentifiersForEUIsRequest) ProtoMessage() {}
 func (*GetEndDeviceIdentifiersForEUIsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{13}
+	return fileDescriptor_a656ee0551c94a80, []int{16}
 }
 func (m *GetEndDeviceIdentifiersForEUIsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetEndDeviceIdentifiersForEUIsRequest.Unmarshal(m, b)
@@ -2194,7 +2591,7 @@ type ListEndDevicesRequest struct {
 func (m *ListEndDevicesRequest) Reset()      { *m = ListEndDevicesRequest{} }
 func (*ListEndDevicesRequest) ProtoMessage() {}
 func (*ListEndDevicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{14}
+	return fileDescriptor_a656ee0551c94a80, []int{17}
 }
 func (m *ListEndDevicesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListEndDevicesRequest.Unmarshal(m, b)
@@ -2261,7 +2658,7 @@ type SetEndDeviceRequest struct {
 func (m *SetEndDeviceRequest) Reset()      { *m = SetEndDeviceRequest{} }
 func (*SetEndDeviceRequest) ProtoMessage() {}
 func (*SetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{15}
+	return fileDescriptor_a656ee0551c94a80, []int{18}
 }
 func (m *SetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetEndDeviceRequest.Unmarshal(m, b)
@@ -2307,7 +2704,7 @@ type ResetAndGetEndDeviceRequest struct {
 func (m *ResetAndGetEndDeviceRequest) Reset()      { *m = ResetAndGetEndDeviceRequest{} }
 func (*ResetAndGetEndDeviceRequest) ProtoMessage() {}
 func (*ResetAndGetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{16}
+	return fileDescriptor_a656ee0551c94a80, []int{19}
 }
 func (m *ResetAndGetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResetAndGetEndDeviceRequest.Unmarshal(m, b)
@@ -2345,7 +2742,7 @@ type EndDeviceTemplate struct {
 func (m *EndDeviceTemplate) Reset()      { *m = EndDeviceTemplate{} }
 func (*EndDeviceTemplate) ProtoMessage() {}
 func (*EndDeviceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{17}
+	return fileDescriptor_a656ee0551c94a80, []int{20}
 }
 func (m *EndDeviceTemplate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EndDeviceTemplate.Unmarshal(m, b)
@@ -2397,7 +2794,7 @@ type EndDeviceTemplateFormat struct {
 func (m *EndDeviceTemplateFormat) Reset()      { *m = EndDeviceTemplateFormat{} }
 func (*EndDeviceTemplateFormat) ProtoMessage() {}
 func (*EndDeviceTemplateFormat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{18}
+	return fileDescriptor_a656ee0551c94a80, []int{21}
 }
 func (m *EndDeviceTemplateFormat) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EndDeviceTemplateFormat.Unmarshal(m, b)
@@ -2447,7 +2844,7 @@ type EndDeviceTemplateFormats struct {
 func (m *EndDeviceTemplateFormats) Reset()      { *m = EndDeviceTemplateFormats{} }
 func (*EndDeviceTemplateFormats) ProtoMessage() {}
 func (*EndDeviceTemplateFormats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{19}
+	return fileDescriptor_a656ee0551c94a80, []int{22}
 }
 func (m *EndDeviceTemplateFormats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EndDeviceTemplateFormats.Unmarshal(m, b)
@@ -2486,7 +2883,7 @@ type ConvertEndDeviceTemplateRequest struct {
 func (m *ConvertEndDeviceTemplateRequest) Reset()      { *m = ConvertEndDeviceTemplateRequest{} }
 func (*ConvertEndDeviceTemplateRequest) ProtoMessage() {}
 func (*ConvertEndDeviceTemplateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{20}
+	return fileDescriptor_a656ee0551c94a80, []int{23}
 }
 func (m *ConvertEndDeviceTemplateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConvertEndDeviceTemplateRequest.Unmarshal(m, b)
@@ -2535,6 +2932,16 @@ func init() {
 	golang_proto.RegisterType((*EndDeviceVersion)(nil), "ttn.lorawan.v3.EndDeviceVersion")
 	proto.RegisterType((*MACSettings)(nil), "ttn.lorawan.v3.MACSettings")
 	golang_proto.RegisterType((*MACSettings)(nil), "ttn.lorawan.v3.MACSettings")
+	proto.RegisterType((*ServingRelayParameters)(nil), "ttn.lorawan.v3.ServingRelayParameters")
+	golang_proto.RegisterType((*ServingRelayParameters)(nil), "ttn.lorawan.v3.ServingRelayParameters")
+	proto.RegisterType((*ServingRelayParameters_UplinkForwardingRule)(nil), "ttn.lorawan.v3.ServingRelayParameters.UplinkForwardingRule")
+	golang_proto.RegisterType((*ServingRelayParameters_UplinkForwardingRule)(nil), "ttn.lorawan.v3.ServingRelayParameters.UplinkForwardingRule")
+	proto.RegisterType((*ServingRelayParameters_JoinRequestFilter)(nil), "ttn.lorawan.v3.ServingRelayParameters.JoinRequestFilter")
+	golang_proto.RegisterType((*ServingRelayParameters_JoinRequestFilter)(nil), "ttn.lorawan.v3.ServingRelayParameters.JoinRequestFilter")
+	proto.RegisterType((*ServedRelayParameters)(nil), "ttn.lorawan.v3.ServedRelayParameters")
+	golang_proto.RegisterType((*ServedRelayParameters)(nil), "ttn.lorawan.v3.ServedRelayParameters")
+	proto.RegisterType((*RelayParameters)(nil), "ttn.lorawan.v3.RelayParameters")
+	golang_proto.RegisterType((*RelayParameters)(nil), "ttn.lorawan.v3.RelayParameters")
 	proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
 	golang_proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
 	proto.RegisterMapType((map[uint64]*MACState_DataRateRanges)(nil), "ttn.lorawan.v3.MACState.RejectedDataRateRangesEntry")
@@ -2593,311 +3000,343 @@ func init() {
 }
 
 var fileDescriptor_a656ee0551c94a80 = []byte{
-	// 4865 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7b, 0x4b, 0x6c, 0x1b, 0x49,
-	0x7a, 0xbf, 0x9a, 0xa2, 0x24, 0xf2, 0x13, 0x45, 0x52, 0xa5, 0x57, 0x8b, 0xb2, 0x65, 0x0d, 0x2d,
-	0xdb, 0xb4, 0xd7, 0xa2, 0x46, 0xf4, 0x78, 0xff, 0x3b, 0x9e, 0xff, 0xac, 0x97, 0xd4, 0x63, 0x2d,
-	0xf9, 0xa5, 0x69, 0xcb, 0x9e, 0x19, 0x7b, 0xec, 0xde, 0x56, 0x77, 0x89, 0xea, 0x11, 0xd9, 0xcd,
-	0xad, 0x2e, 0xca, 0xd2, 0xcc, 0x18, 0x99, 0x2c, 0xf2, 0x40, 0x16, 0x48, 0xb0, 0x98, 0xd3, 0x62,
-	0x2f, 0xbb, 0xc7, 0x60, 0x0f, 0x01, 0x72, 0x09, 0x72, 0xdb, 0xcd, 0x21, 0xc8, 0x20, 0xc0, 0x02,
-	0x73, 0x4c, 0x72, 0x98, 0x20, 0xb3, 0x97, 0xc5, 0x9e, 0x72, 0x16, 0x90, 0x20, 0xa8, 0xea, 0xea,
-	0x07, 0xd9, 0xa4, 0x44, 0x8d, 0x3d, 0xc8, 0xe6, 0xa4, 0x66, 0xd5, 0xf7, 0xfd, 0xaa, 0xba, 0xbe,
-	0x47, 0x7d, 0x8f, 0x16, 0xe4, 0x6b, 0x36, 0xd1, 0x9e, 0x6b, 0xd6, 0x82, 0x43, 0x35, 0x7d, 0x6f,
-	0x51, 0x6b, 0x98, 0x8b, 0xd8, 0x32, 0x54, 0x03, 0xef, 0x9b, 0x3a, 0x2e, 0x36, 0x88, 0x4d, 0x6d,
-	0x94, 0xa6, 0xd4, 0x2a, 0x0a, 0xba, 0xe2, 0xfe, 0xb5, 0x5c, 0xb9, 0x6a, 0xd2, 0xdd, 0xe6, 0x76,
-	0x51, 0xb7, 0xeb, 0x8b, 0xd8, 0xda, 0xb7, 0x0f, 0x1b, 0xc4, 0x3e, 0x38, 0x5c, 0xe4, 0xc4, 0xfa,
-	0x42, 0x15, 0x5b, 0x0b, 0xfb, 0x5a, 0xcd, 0x34, 0x34, 0x8a, 0x17, 0x23, 0x0f, 0x2e, 0x64, 0x6e,
-	0x21, 0x04, 0x51, 0xb5, 0xab, 0xb6, 0xcb, 0xbc, 0xdd, 0xdc, 0xe1, 0xbf, 0xf8, 0x0f, 0xfe, 0x24,
-	0xc8, 0x97, 0x43, 0xe4, 0x5b, 0xbb, 0x78, 0x6b, 0xd7, 0xb4, 0xaa, 0xce, 0xba, 0x65, 0x34, 0x1d,
-	0x4a, 0x4c, 0xec, 0x84, 0x97, 0xae, 0xda, 0x0b, 0x1f, 0x3a, 0xb6, 0xb5, 0xa8, 0x59, 0x96, 0x4d,
-	0x35, 0x6a, 0xda, 0x96, 0x23, 0x40, 0x66, 0xab, 0xb6, 0x5d, 0xad, 0xe1, 0x60, 0x29, 0xa3, 0x49,
-	0x38, 0x81, 0x98, 0x9f, 0x6b, 0x9f, 0xdf, 0x31, 0x71, 0xcd, 0x50, 0xeb, 0x9a, 0xb3, 0x27, 0x28,
-	0xce, 0xb4, 0x53, 0x38, 0x94, 0x34, 0x75, 0x2a, 0x66, 0xcf, 0xb5, 0xcf, 0x52, 0xb3, 0x8e, 0x1d,
-	0xaa, 0xd5, 0x1b, 0xdd, 0x36, 0xf0, 0x9c, 0x68, 0x8d, 0x06, 0x26, 0xde, 0x06, 0xcf, 0x47, 0x65,
-	0x61, 0x1a, 0xd8, 0xa2, 0xe6, 0x8e, 0x19, 0x10, 0x9d, 0x89, 0x12, 0xed, 0xe1, 0x43, 0x6f, 0xf6,
-	0x5c, 0x74, 0xd6, 0x13, 0x9c, 0x78, 0xc9, 0x28, 0x41, 0x1d, 0x3b, 0x8e, 0x56, 0xc5, 0xc7, 0x40,
-	0x34, 0x4c, 0x9d, 0x36, 0x09, 0x3e, 0x0e, 0x82, 0x6a, 0x86, 0x46, 0x35, 0x97, 0x22, 0xff, 0xc7,
-	0x71, 0x18, 0x7a, 0x80, 0x1d, 0xc7, 0xb4, 0x2d, 0xf4, 0x18, 0x12, 0x06, 0xde, 0x57, 0x35, 0xc3,
-	0x20, 0x72, 0x6c, 0x4e, 0x2a, 0xa4, 0x2a, 0x37, 0x3f, 0xff, 0xf2, 0x5c, 0xdf, 0xbf, 0x7d, 0x79,
-	0xee, 0xff, 0x55, 0xed, 0x22, 0xdd, 0xc5, 0x94, 0x0b, 0xb3, 0x68, 0x61, 0xfa, 0xdc, 0x26, 0x7b,
-	0x8b, 0xad, 0xe0, 0xfb, 0xd7, 0x16, 0x1b, 0x7b, 0xd5, 0x45, 0x7a, 0xd8, 0xc0, 0x4e, 0x71, 0x05,
-	0xef, 0x97, 0x0d, 0x83, 0x28, 0x43, 0x86, 0xfb, 0x80, 0xca, 0x10, 0x67, 0xef, 0x2e, 0xf7, 0xcf,
-	0x49, 0x85, 0xe1, 0xd2, 0x4c, 0xb1, 0x55, 0x4f, 0x8b, 0x62, 0x0b, 0xb7, 0xf1, 0xa1, 0x53, 0xc9,
-	0x1e, 0x55, 0x06, 0x7e, 0x2c, 0xc5, 0xb2, 0x12, 0x5b, 0xfc, 0x8b, 0x2f, 0xcf, 0x49, 0x0a, 0x67,
-	0x45, 0xaf, 0xc1, 0x48, 0x4d, 0x73, 0xa8, 0xba, 0xa3, 0xea, 0x16, 0x55, 0x9b, 0x0d, 0x39, 0x3e,
-	0x27, 0x15, 0x46, 0x14, 0x60, 0x83, 0x6b, 0xcb, 0x16, 0x7d, 0xd8, 0x40, 0x05, 0x18, 0xe5, 0x24,
-	0x96, 0x20, 0x32, 0xec, 0xe7, 0x96, 0x3c, 0xc0, 0xc9, 0x38, 0xef, 0x3d, 0x46, 0xb7, 0x62, 0x3f,
-	0xb7, 0x7c, 0x4a, 0x2d, 0x4c, 0x39, 0x18, 0x50, 0x96, 0x7d, 0xca, 0x22, 0x8c, 0x73, 0x4a, 0xdd,
-	0xb6, 0x76, 0xc2, 0xc4, 0x43, 0x9c, 0x38, 0xcb, 0xe6, 0x96, 0x6d, 0x6b, 0xc7, 0xa7, 0x5f, 0x06,
-	0x70, 0xa8, 0x46, 0x28, 0x36, 0x54, 0x8d, 0xca, 0x09, 0xfe, 0xbe, 0xb9, 0xa2, 0xab, 0x4f, 0x45,
-	0x4f, 0x9f, 0x8a, 0x5b, 0x9e, 0xc2, 0x55, 0x12, 0xec, 0x35, 0x7f, 0xf2, 0xef, 0xe7, 0x24, 0x25,
-	0x29, 0xf8, 0xca, 0x14, 0x61, 0x38, 0xf3, 0xc3, 0x26, 0x6e, 0x32, 0x8c, 0x46, 0xa3, 0x66, 0xea,
-	0x5c, 0xf9, 0xf9, 0xba, 0x35, 0xd3, 0xda, 0x73, 0xe4, 0xe4, 0x5c, 0x7f, 0x61, 0xb8, 0x74, 0xbe,
-	0xfd, 0x18, 0xcb, 0x01, 0xf1, 0x8a, 0xa0, 0x55, 0x72, 0x2e, 0x50, 0x87, 0x29, 0x67, 0x23, 0x9e,
-	0x90, 0xb2, 0xb1, 0xfc, 0x65, 0x48, 0x56, 0x6c, 0xbb, 0xf6, 0x48, 0xab, 0x35, 0x31, 0x1a, 0x87,
-	0x81, 0x7d, 0xf6, 0x20, 0x4b, 0x73, 0x52, 0x21, 0xa1, 0xb8, 0x3f, 0x6e, 0x0c, 0xfe, 0xfe, 0x97,
-	0xd3, 0x31, 0x59, 0xca, 0xff, 0x6d, 0x06, 0x46, 0xee, 0x96, 0x97, 0x37, 0x35, 0xa2, 0xd5, 0x31,
-	0xc5, 0xc4, 0x41, 0xd3, 0x90, 0xa8, 0x6b, 0x07, 0x2a, 0x36, 0x49, 0x83, 0xb3, 0xc4, 0x94, 0xa1,
-	0xba, 0x76, 0xb0, 0x6a, 0x92, 0x06, 0x7a, 0x04, 0x63, 0x9a, 0x41, 0x54, 0xa6, 0x6d, 0x2a, 0xd1,
-	0x28, 0x56, 0x4d, 0xcb, 0xc0, 0x07, 0x5c, 0x6c, 0xe9, 0xd2, 0xd9, 0xf6, 0xbd, 0xaf, 0x68, 0x54,
-	0x53, 0x34, 0x8a, 0xd7, 0x19, 0x51, 0x25, 0x71, 0x54, 0x19, 0xf8, 0x11, 0x53, 0x02, 0x25, 0xab,
-	0x19, 0xa4, 0x65, 0x0e, 0xbd, 0x01, 0x88, 0xe1, 0xd2, 0x03, 0xb5, 0x61, 0x3f, 0xc7, 0x44, 0xc0,
-	0x72, 0x31, 0x57, 0x86, 0x8e, 0x2a, 0xf1, 0x2b, 0x31, 0x39, 0xa3, 0x64, 0x34, 0x83, 0x6c, 0x1d,
-	0x6c, 0x32, 0x02, 0x97, 0xeb, 0x32, 0xa4, 0x18, 0x97, 0xb5, 0xad, 0x52, 0xa2, 0x59, 0x8e, 0x2b,
-	0xec, 0x80, 0x1e, 0x34, 0x83, 0xdc, 0xdb, 0xde, 0x62, 0x53, 0xe8, 0x22, 0x8c, 0x30, 0x52, 0x4d,
-	0xdf, 0x53, 0x6b, 0x66, 0xdd, 0xa4, 0xae, 0xac, 0x2b, 0x31, 0x59, 0x52, 0x86, 0x35, 0x83, 0x94,
-	0xf5, 0xbd, 0x3b, 0x6c, 0x38, 0x4c, 0x67, 0xe0, 0x9a, 0x76, 0xc8, 0xa5, 0xdd, 0x42, 0xb7, 0xc2,
-	0x86, 0xd1, 0x77, 0x21, 0x49, 0x0e, 0x96, 0x04, 0x4d, 0x92, 0xbf, 0xfe, 0x54, 0xfb, 0xeb, 0x2b,
-	0x07, 0x9c, 0x36, 0xf4, 0xe2, 0x09, 0x72, 0xb0, 0xe4, 0xf2, 0xbf, 0x0f, 0xe3, 0x9c, 0xdf, 0x3f,
-	0x48, 0x7b, 0x67, 0xc7, 0xc1, 0x54, 0x06, 0x0e, 0x35, 0xdb, 0xed, 0x24, 0xef, 0x73, 0xaa, 0x10,
-	0xe2, 0x28, 0x43, 0x6c, 0x99, 0x64, 0x32, 0x22, 0x07, 0xa5, 0x88, 0x8c, 0x86, 0x4f, 0x29, 0x23,
-	0x72, 0x50, 0x6a, 0x95, 0x51, 0x11, 0x46, 0x18, 0xee, 0x0e, 0xc1, 0x3f, 0x6c, 0x62, 0x4b, 0x3f,
-	0x94, 0x53, 0x73, 0x52, 0x21, 0x5e, 0x49, 0x1e, 0x55, 0x06, 0x4b, 0xf1, 0xc2, 0x2f, 0xfe, 0x72,
-	0x50, 0x49, 0x91, 0x83, 0xd2, 0x9a, 0x37, 0x8d, 0x1e, 0x40, 0x9a, 0xa9, 0x91, 0xd1, 0xa4, 0x87,
-	0xaa, 0x7e, 0xa8, 0xd7, 0xb0, 0x3c, 0xc2, 0xb7, 0x10, 0x55, 0xf1, 0x6a, 0x95, 0xe0, 0xaa, 0x46,
-	0xb1, 0xb1, 0xd2, 0xa4, 0x87, 0xcb, 0x8c, 0x34, 0xb4, 0x91, 0x54, 0x5d, 0x3b, 0xf0, 0xc7, 0x91,
-	0x01, 0x53, 0x04, 0x7f, 0x68, 0x9b, 0x96, 0xca, 0xfc, 0xbb, 0xda, 0xc0, 0xc4, 0xb4, 0x0d, 0x53,
-	0x37, 0xe9, 0xa1, 0x9c, 0xe6, 0xe8, 0xf9, 0x88, 0x14, 0x38, 0x39, 0x33, 0xce, 0xd5, 0x83, 0x86,
-	0x6d, 0x61, 0x2b, 0x7c, 0x7c, 0x13, 0xc4, 0x9f, 0xdd, 0x0c, 0xa0, 0x50, 0x15, 0x64, 0xb1, 0x8a,
-	0x6e, 0x37, 0x2d, 0xda, 0xb2, 0x4c, 0xa6, 0xf3, 0x4b, 0xb8, 0xcb, 0x2c, 0x33, 0xf2, 0x0e, 0xeb,
-	0x4c, 0x92, 0x60, 0x3a, 0xbc, 0xd0, 0x5b, 0x30, 0xd6, 0x30, 0xad, 0xaa, 0xea, 0xd4, 0x6c, 0x1a,
-	0x3a, 0xd9, 0x2c, 0x3f, 0xd9, 0xe1, 0xa3, 0x4a, 0xa2, 0x34, 0x28, 0xf7, 0xf1, 0xb3, 0x1d, 0x65,
-	0x74, 0x0f, 0x6a, 0x36, 0x0d, 0x0e, 0xf8, 0x09, 0x4c, 0x07, 0xcc, 0xed, 0xe2, 0x1e, 0xed, 0x45,
-	0xdc, 0x4c, 0xad, 0x27, 0x3c, 0xe0, 0x56, 0x69, 0x7f, 0x1b, 0xb2, 0xdb, 0x58, 0xd3, 0x6d, 0x2b,
-	0xb4, 0x2d, 0x14, 0xdd, 0x56, 0xc6, 0x25, 0x0a, 0x36, 0x75, 0x1b, 0x12, 0xfa, 0xae, 0x66, 0x59,
-	0xb8, 0xe6, 0xc8, 0x63, 0xdc, 0xa5, 0x5d, 0x68, 0xdf, 0x43, 0x8b, 0xb7, 0x29, 0x2e, 0xbb, 0xd4,
-	0xfc, 0xb0, 0x3e, 0x93, 0x62, 0x09, 0x49, 0xf1, 0x01, 0xd0, 0x2a, 0x8c, 0x36, 0x1b, 0xcc, 0xaf,
-	0xa9, 0xc6, 0x73, 0x5c, 0xab, 0x71, 0x99, 0xcb, 0xe3, 0xdc, 0xff, 0x4e, 0xb7, 0xa3, 0xfa, 0xfe,
-	0x4e, 0xc9, 0xb8, 0x3c, 0x2b, 0x8c, 0x85, 0x49, 0x16, 0xad, 0xc3, 0x98, 0xe7, 0x67, 0xc3, 0x40,
-	0x13, 0x27, 0x01, 0x8d, 0x7a, 0x5c, 0x01, 0xd4, 0x53, 0x98, 0x6c, 0xf1, 0x23, 0x2a, 0x16, 0xc2,
-	0x96, 0x27, 0x39, 0x5a, 0x21, 0xa2, 0xdc, 0x2b, 0x8a, 0xe7, 0x5c, 0x3c, 0xbd, 0x70, 0xc1, 0xc7,
-	0x42, 0x6e, 0xc7, 0x9b, 0x09, 0xc3, 0x73, 0xd7, 0x12, 0xc0, 0x4f, 0x1d, 0x07, 0xcf, 0x7d, 0x4a,
-	0x47, 0xf8, 0x96, 0x19, 0x54, 0x85, 0x73, 0x5d, 0x35, 0x46, 0x75, 0xef, 0x08, 0x99, 0xaf, 0x93,
-	0x3f, 0x56, 0x6f, 0xdc, 0x15, 0x72, 0x1d, 0x15, 0x87, 0xcf, 0xe5, 0xbe, 0x88, 0xc1, 0x90, 0x10,
-	0x2c, 0xd3, 0x24, 0x21, 0xc4, 0x40, 0x93, 0xa4, 0x0e, 0x9a, 0xe4, 0x12, 0x05, 0x9a, 0xf4, 0x1d,
-	0x40, 0xbe, 0xd4, 0x02, 0xce, 0x58, 0xbb, 0xd3, 0xf1, 0x85, 0x14, 0x70, 0x3e, 0x82, 0xb1, 0xba,
-	0x69, 0x45, 0x4c, 0xa2, 0xff, 0x94, 0x1e, 0xb0, 0x6e, 0x5a, 0xad, 0x36, 0xc1, 0x70, 0x99, 0x47,
-	0x7b, 0xc9, 0xdb, 0x8f, 0x39, 0xb4, 0x16, 0xdc, 0xf3, 0x30, 0x82, 0x2d, 0x6d, 0xbb, 0x86, 0x55,
-	0xf7, 0x0c, 0xf8, 0xc5, 0x97, 0x50, 0x52, 0xee, 0xe0, 0x43, 0x3e, 0xb6, 0x11, 0x4f, 0xc4, 0xb2,
-	0xfd, 0x1b, 0xf1, 0x44, 0x7f, 0x36, 0x9e, 0xff, 0xb3, 0x41, 0xc8, 0xae, 0x5a, 0xc6, 0x0a, 0xcf,
-	0x13, 0x1e, 0x61, 0xc2, 0x63, 0xbd, 0xfb, 0xd0, 0x6f, 0x1a, 0x0e, 0x3f, 0xda, 0xe1, 0xd2, 0xb7,
-	0xda, 0x77, 0xd3, 0x4e, 0xbe, 0x1e, 0xc4, 0xb6, 0x1d, 0xc2, 0x33, 0x86, 0x84, 0xee, 0x42, 0x46,
-	0x00, 0xa8, 0xfb, 0x2e, 0x13, 0x3f, 0xfd, 0x74, 0x29, 0xd7, 0xc1, 0xa2, 0x05, 0x6c, 0xe8, 0x3d,
-	0xd3, 0x82, 0xc0, 0xdb, 0xdf, 0x16, 0x8c, 0x79, 0x70, 0x8d, 0xdd, 0x43, 0x1f, 0xb2, 0xbf, 0x33,
-	0xe4, 0xe6, 0xad, 0xf7, 0xa3, 0x90, 0xa3, 0x82, 0x60, 0x73, 0xf7, 0xd0, 0x43, 0xbd, 0x06, 0xa3,
-	0xbe, 0x72, 0xa8, 0x8d, 0x9a, 0x66, 0xa9, 0xa6, 0xc1, 0x25, 0x92, 0xe4, 0x81, 0x00, 0x89, 0xc9,
-	0xdf, 0x53, 0x32, 0x3e, 0xc5, 0x66, 0x4d, 0xb3, 0xd6, 0x0d, 0x34, 0x07, 0x83, 0x8d, 0x5d, 0x9b,
-	0xda, 0x8e, 0x3c, 0x30, 0xd7, 0x5f, 0x48, 0x7a, 0xbe, 0x27, 0x0b, 0x8a, 0x18, 0x47, 0x05, 0xc8,
-	0x3a, 0xcd, 0x46, 0xc3, 0x26, 0xd4, 0x51, 0xf5, 0x9a, 0xe6, 0x38, 0xea, 0x36, 0x0f, 0x2f, 0x12,
-	0x4a, 0xda, 0x1b, 0x5f, 0x66, 0xc3, 0x95, 0x0e, 0x94, 0x3a, 0x0f, 0x2e, 0xda, 0x29, 0x97, 0xd1,
-	0x5d, 0x18, 0x37, 0xf0, 0x8e, 0xd6, 0xac, 0x51, 0xb5, 0xae, 0xe9, 0xaa, 0x83, 0x29, 0x65, 0xc1,
-	0xb7, 0x08, 0x28, 0x67, 0x3a, 0x1c, 0xea, 0x03, 0x41, 0xa2, 0x20, 0xc1, 0x78, 0x57, 0xd3, 0xbd,
-	0x31, 0xa6, 0x35, 0x4c, 0xcb, 0x03, 0xd3, 0x60, 0x61, 0x48, 0x5c, 0x49, 0xd5, 0xcd, 0x90, 0x3b,
-	0x66, 0x44, 0xda, 0x41, 0x88, 0x08, 0x04, 0x91, 0x76, 0xd0, 0x42, 0xe4, 0xbf, 0x02, 0xbb, 0xa6,
-	0x78, 0xac, 0x90, 0x50, 0x52, 0xde, 0xe0, 0x86, 0x6d, 0x5a, 0xe8, 0x2a, 0x20, 0x82, 0x1d, 0x2c,
-	0x48, 0x54, 0xcb, 0xb6, 0x74, 0xec, 0xf0, 0x18, 0x20, 0xa1, 0x64, 0xdd, 0x19, 0x46, 0x77, 0x8f,
-	0x8f, 0x23, 0x0c, 0xde, 0x96, 0xd5, 0x1d, 0x9b, 0xd4, 0x35, 0xca, 0x7c, 0x3d, 0x0f, 0x00, 0x3a,
-	0x38, 0xb1, 0xbb, 0x6e, 0x0e, 0xb4, 0xa9, 0x1d, 0xd6, 0x6c, 0xcd, 0x58, 0xf3, 0xe9, 0x2b, 0xa9,
-	0xb0, 0x62, 0x2a, 0xa3, 0x02, 0x31, 0x20, 0xc8, 0xff, 0x66, 0x0c, 0x86, 0x43, 0xe7, 0x84, 0xbe,
-	0x0f, 0x19, 0x21, 0x2d, 0xee, 0xe2, 0xed, 0x26, 0x15, 0xf6, 0x30, 0x1d, 0x09, 0xd7, 0x57, 0x44,
-	0xfe, 0x59, 0x89, 0xff, 0x94, 0x45, 0xea, 0x23, 0x9c, 0xaf, 0xb2, 0xe5, 0x72, 0xa1, 0x77, 0x61,
-	0x22, 0xf0, 0x94, 0xe1, 0xeb, 0x3f, 0xc6, 0xe1, 0x22, 0xd7, 0xff, 0xa6, 0xf0, 0x85, 0xee, 0xe5,
-	0x2e, 0x5c, 0x70, 0xa3, 0x65, 0xd0, 0xbd, 0xf1, 0x3f, 0x38, 0xee, 0xd2, 0xee, 0xef, 0xd9, 0xf9,
-	0x76, 0xb9, 0xb5, 0xef, 0x75, 0x8e, 0x27, 0xe2, 0x1c, 0x37, 0x12, 0x55, 0xfa, 0x1a, 0x20, 0xae,
-	0xbb, 0x68, 0x88, 0xb1, 0xde, 0x21, 0x0a, 0x98, 0xee, 0x09, 0x2c, 0x12, 0x18, 0xf8, 0xa2, 0xd1,
-	0x7d, 0xd1, 0x0c, 0x9c, 0x46, 0x34, 0xcb, 0x9e, 0x68, 0xde, 0x0c, 0x87, 0xde, 0x83, 0x1c, 0xe2,
-	0x4c, 0x97, 0xd0, 0xdb, 0xdd, 0x4a, 0x10, 0x75, 0x6f, 0x75, 0x89, 0xba, 0x87, 0x3a, 0x0b, 0xb5,
-	0x35, 0xb0, 0x16, 0x87, 0x14, 0x0d, 0xb8, 0xdf, 0xe9, 0x1c, 0x70, 0x27, 0x7a, 0x16, 0x66, 0x34,
-	0xd6, 0x5e, 0x6e, 0x8f, 0xb5, 0x93, 0x3d, 0x1d, 0x7a, 0x6b, 0x00, 0xbe, 0x06, 0xb9, 0x1d, 0x4d,
-	0xa7, 0x36, 0x39, 0x54, 0x1b, 0xdc, 0x40, 0x7d, 0x3c, 0x13, 0x3b, 0x32, 0xcc, 0xf5, 0x17, 0xe2,
-	0xbe, 0xe7, 0xfb, 0x81, 0x22, 0x0b, 0xda, 0x4d, 0x4e, 0xba, 0x16, 0x50, 0xa2, 0x7b, 0x91, 0x40,
-	0x7e, 0xb8, 0x4b, 0x30, 0x12, 0x0d, 0xe4, 0xc5, 0xbe, 0x5a, 0x62, 0xf8, 0xdb, 0x30, 0xe1, 0xbb,
-	0x9b, 0x6b, 0x25, 0x75, 0xdb, 0x14, 0x05, 0x00, 0xee, 0x4c, 0x8e, 0x0f, 0xc8, 0x3c, 0xbe, 0x6b,
-	0xa5, 0x8a, 0xc9, 0x2b, 0x04, 0xa8, 0x04, 0x43, 0x4d, 0x07, 0xab, 0x9a, 0x41, 0x84, 0x77, 0x39,
-	0x86, 0x7d, 0xb0, 0xe9, 0xe0, 0xb2, 0x41, 0xd0, 0x0d, 0x60, 0xa9, 0xa1, 0x5a, 0xd7, 0x48, 0xd5,
-	0xb4, 0x78, 0xde, 0xc0, 0xdc, 0x6f, 0xbb, 0x16, 0xae, 0xd5, 0x6c, 0x4d, 0x08, 0x3d, 0xa9, 0x19,
-	0xe4, 0x2e, 0xa7, 0x46, 0x6f, 0x41, 0x4a, 0xb8, 0x41, 0x77, 0xcf, 0x99, 0x93, 0x16, 0x05, 0x97,
-	0x9c, 0x6f, 0xf6, 0x5d, 0x98, 0x72, 0xa8, 0x46, 0x9b, 0x4e, 0x34, 0x7b, 0xc9, 0xf6, 0x66, 0x0b,
-	0x13, 0x2e, 0x7f, 0x7b, 0xc2, 0xf2, 0x08, 0x64, 0x01, 0x1c, 0x4d, 0x58, 0x46, 0x85, 0x89, 0xb4,
-	0x23, 0x3f, 0x5c, 0xb7, 0xe8, 0xb5, 0x92, 0xbb, 0xc9, 0x49, 0x97, 0x3b, 0x92, 0x9f, 0xdc, 0x82,
-	0x51, 0x03, 0x3b, 0x26, 0xc1, 0x86, 0x1a, 0xd8, 0x1c, 0xea, 0xc1, 0xe6, 0x32, 0x82, 0x4d, 0xf1,
-	0x4c, 0x4f, 0x87, 0x33, 0x2d, 0x48, 0xed, 0x26, 0x38, 0xd6, 0xbb, 0x09, 0xca, 0x21, 0xec, 0x56,
-	0x4b, 0xfc, 0x01, 0xcc, 0x04, 0x8b, 0x44, 0x2d, 0x72, 0xbc, 0x67, 0x8b, 0x9c, 0xf2, 0x97, 0x68,
-	0x33, 0x4c, 0x05, 0x26, 0xc2, 0x2b, 0x04, 0x06, 0x3a, 0xd1, 0x93, 0x81, 0x8e, 0x05, 0xb8, 0x81,
-	0x9d, 0x3e, 0x85, 0x49, 0x0f, 0xb3, 0xcd, 0xce, 0x26, 0x4f, 0x69, 0x67, 0x1e, 0xfc, 0xdd, 0xb0,
-	0xb9, 0xd5, 0x60, 0xd6, 0x83, 0xef, 0x92, 0xba, 0x4c, 0x9d, 0x32, 0x75, 0xc9, 0x09, 0xbc, 0x72,
-	0x87, 0x0c, 0xa6, 0xc3, 0x6a, 0x6d, 0x99, 0x8c, 0x7c, 0xca, 0x4c, 0xa6, 0x75, 0xb5, 0xd6, 0x84,
-	0x66, 0x0f, 0x5e, 0xf3, 0x56, 0xeb, 0x7e, 0xab, 0xce, 0xf4, 0x2c, 0x76, 0x4f, 0x45, 0x37, 0x3b,
-	0x5e, 0xae, 0x4f, 0x03, 0xed, 0xea, 0x74, 0xc9, 0x9e, 0xe9, 0x49, 0x03, 0xe4, 0xb6, 0x25, 0x02,
-	0x35, 0x78, 0x0f, 0xbc, 0x39, 0x35, 0x72, 0xe7, 0x9e, 0xed, 0x09, 0xdb, 0x53, 0xa3, 0x4a, 0xdb,
-	0xd5, 0xbb, 0x0e, 0xd9, 0xb0, 0x82, 0xf1, 0xc2, 0xde, 0x2c, 0x47, 0x3c, 0x17, 0x39, 0x14, 0x9e,
-	0x23, 0xac, 0xae, 0x2b, 0x9b, 0x2e, 0x64, 0x3a, 0xd0, 0x28, 0x5e, 0x00, 0x7c, 0x0a, 0x33, 0x5e,
-	0x80, 0xa5, 0xfb, 0xc5, 0x4b, 0xd5, 0xb4, 0x28, 0x26, 0xfb, 0x5a, 0x4d, 0x3e, 0xd7, 0x9b, 0x17,
-	0x9b, 0x72, 0x83, 0xad, 0x65, 0xaf, 0x6c, 0xb9, 0x2e, 0xf8, 0xf3, 0xff, 0x34, 0x09, 0x09, 0x16,
-	0xcf, 0x51, 0x8d, 0x62, 0xf4, 0x18, 0x90, 0xde, 0x24, 0x04, 0x33, 0x7f, 0xe6, 0xd7, 0x0b, 0x44,
-	0x3c, 0x77, 0xf6, 0xd8, 0xa2, 0x42, 0x7b, 0xe0, 0x28, 0x60, 0x42, 0x35, 0xce, 0xc7, 0x2c, 0x3e,
-	0x15, 0xb2, 0x0c, 0xb0, 0x63, 0x5f, 0x03, 0xdb, 0x93, 0x67, 0x80, 0x5d, 0x81, 0x94, 0xdb, 0xc1,
-	0x71, 0xf3, 0x01, 0x91, 0xe1, 0x4c, 0xb4, 0xa3, 0xba, 0xf9, 0x43, 0x90, 0xdc, 0x0c, 0xbb, 0x4c,
-	0x7c, 0xb8, 0x53, 0xee, 0x15, 0x7f, 0x89, 0xdc, 0xeb, 0x29, 0xe4, 0xfc, 0x8a, 0xb7, 0x49, 0xea,
-	0xd8, 0x08, 0x64, 0xa7, 0x79, 0x71, 0xd8, 0x71, 0x15, 0xed, 0x38, 0xaf, 0x66, 0x4f, 0x79, 0x95,
-	0x71, 0x0e, 0xe1, 0x09, 0xaf, 0x4c, 0xd1, 0x75, 0x90, 0x39, 0xbc, 0x81, 0xf7, 0x55, 0x71, 0x0f,
-	0xf9, 0x25, 0x7d, 0xb7, 0x02, 0x3f, 0xc6, 0xe6, 0x57, 0xf0, 0xfe, 0x03, 0x3e, 0x2b, 0x6a, 0xfb,
-	0x5d, 0x83, 0xec, 0xa1, 0x97, 0x0c, 0xb2, 0x31, 0x9c, 0x69, 0x60, 0xcb, 0x60, 0xd8, 0x9d, 0x8a,
-	0xed, 0x22, 0x34, 0xeb, 0xad, 0xd6, 0x2e, 0x80, 0x3a, 0xcc, 0xa1, 0x55, 0xc8, 0x8a, 0x92, 0x3e,
-	0xc1, 0x4e, 0xc3, 0xb6, 0x1c, 0xec, 0x95, 0xf1, 0x3b, 0x49, 0x69, 0xd9, 0xae, 0xd7, 0x35, 0xcb,
-	0x50, 0x32, 0x2e, 0x8f, 0xe2, 0xb1, 0x30, 0x18, 0x6f, 0xb7, 0xdc, 0x64, 0x1d, 0xea, 0x46, 0x67,
-	0x27, 0xc0, 0x08, 0x1e, 0x45, 0xb0, 0xa0, 0x77, 0x00, 0x89, 0xdd, 0xf0, 0x04, 0x4d, 0xd3, 0x75,
-	0xdc, 0xa0, 0x22, 0x54, 0x3b, 0xdf, 0x29, 0xb9, 0x64, 0x46, 0x56, 0x64, 0x39, 0x5b, 0x99, 0x93,
-	0x2a, 0xe2, 0x65, 0x82, 0x11, 0xf4, 0x08, 0xc6, 0xbd, 0x9d, 0x71, 0x4c, 0xb1, 0x3d, 0x11, 0xa8,
-	0xcd, 0x1f, 0x0b, 0x2a, 0xf6, 0xa5, 0x20, 0x81, 0x10, 0x1a, 0x43, 0xaf, 0xb3, 0x38, 0x5c, 0x7d,
-	0x6e, 0x5a, 0x86, 0xfd, 0xdc, 0x51, 0xb5, 0x7d, 0xcd, 0xac, 0x69, 0xdb, 0xa2, 0x40, 0x9c, 0x50,
-	0x10, 0x39, 0x78, 0xd7, 0x9d, 0x2a, 0x7b, 0x33, 0x68, 0x05, 0xd2, 0x04, 0xeb, 0x98, 0xab, 0x94,
-	0xdb, 0x2f, 0x49, 0xf3, 0x13, 0x8a, 0xd8, 0xaa, 0x5b, 0x2d, 0x11, 0x19, 0xa5, 0x32, 0xe2, 0x32,
-	0xb9, 0x83, 0x0e, 0xda, 0x80, 0xac, 0x40, 0x09, 0xfa, 0x2e, 0x19, 0x8e, 0x13, 0x75, 0x84, 0x82,
-	0xc0, 0x43, 0xca, 0xb8, 0x8c, 0x7e, 0xa3, 0x05, 0xd5, 0x20, 0xef, 0x36, 0xa6, 0xdc, 0xd6, 0x99,
-	0x6a, 0x5a, 0x26, 0x35, 0xd9, 0x9d, 0xdc, 0x62, 0x5a, 0xd9, 0x1e, 0x4d, 0x6b, 0x96, 0xf7, 0xb2,
-	0x5c, 0xa8, 0x75, 0x0f, 0x29, 0x64, 0x61, 0x04, 0x66, 0x09, 0xfe, 0x10, 0xeb, 0x54, 0xdc, 0xab,
-	0x6d, 0x77, 0x1c, 0x76, 0xe4, 0xd1, 0xb9, 0xfe, 0x93, 0xab, 0x50, 0x99, 0xa3, 0x4a, 0xea, 0x33,
-	0x29, 0x99, 0xcd, 0xe4, 0x85, 0xa3, 0xc8, 0x79, 0xa8, 0xe5, 0xb6, 0x96, 0x0c, 0x76, 0xd0, 0x26,
-	0x9c, 0x6d, 0x59, 0xb3, 0xb5, 0x3b, 0x83, 0x1d, 0x19, 0xcd, 0xf5, 0x17, 0x46, 0x2a, 0xe9, 0xa3,
-	0xca, 0xf0, 0x67, 0x52, 0x22, 0x9b, 0xc9, 0xbb, 0x6d, 0x97, 0xe9, 0x10, 0x64, 0xb8, 0x5d, 0x83,
-	0x1d, 0x54, 0x86, 0x71, 0x1f, 0x31, 0x9c, 0x8b, 0x8c, 0xf1, 0x5c, 0x44, 0x00, 0xe5, 0xbd, 0xca,
-	0xde, 0x98, 0x47, 0x1b, 0x4e, 0x46, 0x36, 0x20, 0xeb, 0xba, 0x9a, 0xd0, 0x21, 0x8f, 0xf7, 0x78,
-	0xc8, 0x69, 0xee, 0x84, 0x82, 0x43, 0xb5, 0xc1, 0xdf, 0x6b, 0xe8, 0x40, 0x89, 0x66, 0x55, 0xb1,
-	0x23, 0x4f, 0x70, 0xbd, 0x78, 0xa3, 0xab, 0x8e, 0x2b, 0x82, 0xd3, 0x3b, 0x35, 0x85, 0xb3, 0xad,
-	0x5a, 0x94, 0x1c, 0xf2, 0x72, 0x7f, 0x87, 0x49, 0xdf, 0x4f, 0xb2, 0xd3, 0xd4, 0x77, 0xd9, 0x58,
-	0xe0, 0x27, 0x27, 0x03, 0x3f, 0x59, 0x36, 0xc8, 0x32, 0x9f, 0x75, 0xfd, 0x64, 0xee, 0xbf, 0x24,
-	0x18, 0x0e, 0x9b, 0xcf, 0x43, 0xf0, 0x8b, 0x9e, 0x41, 0x15, 0x69, 0x50, 0x1c, 0x42, 0xbb, 0xfc,
-	0xef, 0x78, 0xc5, 0x91, 0xb6, 0x8b, 0x2b, 0xeb, 0x41, 0xf8, 0xc5, 0x93, 0xff, 0x0f, 0x09, 0x72,
-	0x20, 0x62, 0xfc, 0xa1, 0x5e, 0x5b, 0x5a, 0x43, 0xc4, 0x1d, 0x42, 0x8b, 0x30, 0xa4, 0xef, 0xa8,
-	0x35, 0xd3, 0xf1, 0x3a, 0xa4, 0x93, 0x91, 0x0b, 0x6f, 0xed, 0x8e, 0xe9, 0x50, 0x65, 0x50, 0xdf,
-	0x61, 0x7f, 0xdd, 0x4e, 0x65, 0xb8, 0xac, 0xb9, 0x11, 0x4f, 0xc4, 0xb3, 0x03, 0x1b, 0xf1, 0xc4,
-	0x40, 0x76, 0x70, 0x23, 0x9e, 0x48, 0x66, 0x61, 0x23, 0x9e, 0x80, 0xec, 0x70, 0xee, 0xe7, 0xfd,
-	0x00, 0x21, 0xaf, 0x74, 0x1e, 0x86, 0x1a, 0x6e, 0xa9, 0x88, 0x07, 0x03, 0x29, 0x5e, 0x0d, 0xfe,
-	0x28, 0x9e, 0x1d, 0x95, 0x5f, 0x53, 0xbc, 0x19, 0xb4, 0x02, 0x43, 0x9e, 0xb7, 0x8a, 0xf5, 0xee,
-	0xad, 0x2a, 0x71, 0x7e, 0x36, 0x1e, 0x2b, 0x7a, 0xbb, 0xf7, 0x1e, 0x77, 0xeb, 0xe9, 0xba, 0xfd,
-	0xed, 0xeb, 0x90, 0xd1, 0x6d, 0x42, 0x70, 0xcd, 0xbd, 0x7f, 0x4c, 0xc3, 0x91, 0xe3, 0xbc, 0xe0,
-	0x98, 0x3a, 0xaa, 0x24, 0x3f, 0x93, 0x06, 0xf3, 0x71, 0x12, 0x93, 0x0d, 0x25, 0x1d, 0x22, 0x5a,
-	0x37, 0x9c, 0x96, 0xae, 0xfd, 0xc0, 0x2b, 0xee, 0xda, 0x6f, 0xc1, 0xa0, 0x85, 0xa9, 0x6a, 0x1a,
-	0x5c, 0x61, 0x52, 0x95, 0xb7, 0x05, 0xf2, 0xf5, 0xd3, 0x22, 0xdf, 0xc3, 0x74, 0x7d, 0x45, 0x19,
-	0xb0, 0x30, 0x5d, 0x37, 0x72, 0xbf, 0x92, 0x60, 0xa4, 0x45, 0xd7, 0xbb, 0xd5, 0xe0, 0xa5, 0x6f,
-	0xa8, 0x06, 0x1f, 0x7b, 0xc9, 0x1a, 0x7c, 0xee, 0x31, 0xa4, 0xdb, 0x8c, 0xf5, 0x16, 0x0c, 0x0a,
-	0x57, 0x20, 0x71, 0x57, 0x70, 0xb1, 0xab, 0x02, 0xb5, 0x30, 0x86, 0x1a, 0x59, 0x82, 0x3f, 0x47,
-	0x60, 0xe6, 0x18, 0x6f, 0x81, 0xb2, 0xd0, 0xbf, 0x87, 0x45, 0x4f, 0x44, 0x61, 0x8f, 0xe8, 0x6d,
-	0xaf, 0x63, 0xef, 0xaa, 0xee, 0xa5, 0xde, 0x56, 0x76, 0xbc, 0xd6, 0x7e, 0xec, 0x3b, 0x52, 0xfe,
-	0x9f, 0x25, 0x98, 0xf1, 0x6b, 0xfe, 0xe5, 0x26, 0xdd, 0xc5, 0x16, 0x15, 0xf1, 0xcb, 0xb2, 0x6d,
-	0x60, 0xb4, 0x10, 0xfe, 0x28, 0x20, 0x59, 0x99, 0x3a, 0xaa, 0x8c, 0x13, 0x54, 0xca, 0x3e, 0x7b,
-	0x52, 0x5e, 0x78, 0xfc, 0xfa, 0xc2, 0x9b, 0x4f, 0x3f, 0x5e, 0xba, 0x7a, 0xad, 0xf4, 0x62, 0x5e,
-	0x40, 0xa2, 0x9b, 0x00, 0xfc, 0x23, 0x22, 0x75, 0x87, 0xd8, 0x75, 0xb1, 0xad, 0x93, 0x1d, 0x6e,
-	0x92, 0xf3, 0xac, 0x11, 0xbb, 0x8e, 0xde, 0x82, 0x84, 0x0b, 0x40, 0x6d, 0x61, 0x4d, 0x27, 0xb3,
-	0x0f, 0x71, 0x8e, 0x2d, 0x3b, 0xff, 0x0f, 0x67, 0x21, 0xe9, 0xbf, 0x0c, 0xba, 0x15, 0x6e, 0x74,
-	0xcc, 0x77, 0x6d, 0x74, 0xf4, 0xd0, 0xe1, 0x58, 0x06, 0xd0, 0x09, 0xd6, 0xc4, 0x87, 0x1d, 0xb1,
-	0xd3, 0x7c, 0xd8, 0x21, 0xf8, 0xca, 0x94, 0x81, 0x34, 0x1b, 0x86, 0x07, 0xd2, 0x7f, 0x1a, 0x10,
-	0xc1, 0x57, 0xa6, 0x68, 0x06, 0xe2, 0x96, 0x56, 0xc7, 0xad, 0x9d, 0x8b, 0x92, 0xc2, 0x07, 0xd1,
-	0x15, 0x18, 0x36, 0xb0, 0xa3, 0x13, 0xb3, 0xc1, 0xc4, 0xc7, 0x5d, 0x82, 0xdb, 0xb3, 0x20, 0xfd,
-	0xf2, 0x17, 0x19, 0x25, 0x3c, 0x89, 0x3e, 0x95, 0x00, 0x34, 0x4a, 0x89, 0xb9, 0xdd, 0xa4, 0x98,
-	0xdd, 0x0a, 0x4c, 0x75, 0x2f, 0x77, 0x3d, 0xa4, 0x62, 0xd9, 0xa7, 0xe5, 0xca, 0x58, 0xb9, 0x7e,
-	0x54, 0x29, 0xfd, 0x4c, 0x5a, 0xcc, 0x42, 0x7e, 0x9e, 0xe4, 0xe5, 0xf9, 0xd2, 0xec, 0xb3, 0x27,
-	0xda, 0xc2, 0x47, 0x4c, 0x23, 0x0a, 0x37, 0x6f, 0x3c, 0x59, 0x78, 0x7a, 0xd3, 0xfb, 0x79, 0xf9,
-	0xe3, 0xd2, 0xd5, 0x17, 0xf3, 0x57, 0xd8, 0x1e, 0x3e, 0x97, 0x94, 0xd0, 0x9a, 0xe8, 0x0e, 0x0c,
-	0x8b, 0x9c, 0x85, 0x7b, 0xbc, 0xa1, 0x53, 0x37, 0xa4, 0x14, 0xd8, 0xf7, 0xc6, 0x98, 0x0f, 0x45,
-	0x0e, 0x26, 0x3c, 0x9d, 0x6a, 0x10, 0x7b, 0xc7, 0xac, 0x61, 0xe6, 0xbc, 0x12, 0xad, 0x1d, 0x9e,
-	0xac, 0x20, 0xd9, 0x74, 0x29, 0xd6, 0x0d, 0xf4, 0x8f, 0x12, 0x4c, 0x7a, 0xa1, 0x19, 0x9b, 0xc4,
-	0x84, 0xfb, 0x53, 0xec, 0x38, 0xbc, 0x96, 0x9a, 0xac, 0xfc, 0x95, 0x74, 0x54, 0xf9, 0xb1, 0x44,
-	0xfe, 0x5c, 0x2a, 0xfd, 0x89, 0xf4, 0xac, 0x70, 0xf3, 0x06, 0x7b, 0x3d, 0x6d, 0xe1, 0x23, 0xa1,
-	0xfe, 0x9f, 0x84, 0x9e, 0x83, 0xc7, 0x0f, 0x16, 0x9e, 0x5e, 0x09, 0x4d, 0x5c, 0xfe, 0xa0, 0x78,
-	0xf9, 0x0a, 0xe3, 0x2b, 0x2f, 0x3c, 0x16, 0xa7, 0xf2, 0x49, 0xe8, 0x39, 0x78, 0xe4, 0x7c, 0xc1,
-	0xc4, 0xe5, 0xc2, 0xcd, 0x1b, 0x37, 0x9e, 0x08, 0x2b, 0xbb, 0xfe, 0xe2, 0xf2, 0xcd, 0xf9, 0x4f,
-	0x9e, 0xcd, 0x2b, 0xe3, 0x62, 0xbb, 0x0f, 0xf8, 0x6e, 0xcb, 0xee, 0x66, 0x51, 0x19, 0xe4, 0xb6,
-	0xd7, 0xd8, 0xc3, 0x7b, 0x6a, 0x4d, 0xdb, 0xc6, 0x35, 0x79, 0x31, 0xac, 0x08, 0x9f, 0x66, 0x95,
-	0x89, 0x16, 0x84, 0xdb, 0x78, 0xef, 0x0e, 0x23, 0x43, 0xbf, 0x91, 0x20, 0x17, 0x4e, 0x83, 0xda,
-	0x8e, 0x03, 0xfe, 0x30, 0x8f, 0x43, 0x0e, 0x6d, 0xb9, 0xf5, 0x48, 0xd6, 0xe1, 0x4c, 0x87, 0xd7,
-	0x09, 0x8e, 0xe5, 0xf5, 0xb6, 0x63, 0x99, 0x8e, 0x20, 0xf9, 0x47, 0xf3, 0x16, 0x4c, 0x74, 0x80,
-	0x32, 0x0d, 0x79, 0x29, 0xac, 0x5f, 0x86, 0x32, 0x16, 0x81, 0x58, 0x37, 0xd0, 0xaf, 0x24, 0x18,
-	0xe3, 0x69, 0x51, 0xdb, 0x81, 0x0e, 0xff, 0x61, 0x1e, 0xe8, 0x28, 0xdb, 0x6b, 0xeb, 0x49, 0x52,
-	0x48, 0xd6, 0x6c, 0xf7, 0xad, 0x1c, 0x39, 0xc5, 0x5d, 0x45, 0xa1, 0xbb, 0xab, 0xb8, 0xe3, 0x91,
-	0xba, 0x9e, 0xe2, 0xea, 0x51, 0xe5, 0xf2, 0xcf, 0xa4, 0x8b, 0xbd, 0xf9, 0x09, 0x25, 0x58, 0x08,
-	0x2d, 0xc1, 0x90, 0xf8, 0xa6, 0x51, 0x2e, 0x71, 0xdf, 0x30, 0x15, 0x4d, 0xf4, 0xf9, 0xb4, 0xe2,
-	0xd1, 0x75, 0x6c, 0xc7, 0x8e, 0xf4, 0xdc, 0x8e, 0x4d, 0x77, 0x69, 0xc7, 0x46, 0x4a, 0x2c, 0x99,
-	0x57, 0xdf, 0xde, 0xce, 0x7e, 0x03, 0xed, 0xed, 0xd1, 0x13, 0xda, 0xdb, 0x91, 0xce, 0x30, 0xea,
-	0xa5, 0x33, 0x3c, 0xd6, 0x4b, 0x67, 0x78, 0xbc, 0xe7, 0xce, 0xf0, 0x44, 0x97, 0xce, 0xf0, 0x75,
-	0x48, 0x12, 0xdb, 0xa6, 0x2a, 0x8f, 0xab, 0xdd, 0x02, 0xb7, 0x1c, 0x49, 0x33, 0x6c, 0x9b, 0xb2,
-	0xa0, 0x5a, 0x49, 0x10, 0xf1, 0x84, 0x36, 0xfd, 0xb8, 0x75, 0x8a, 0xc7, 0xad, 0x6f, 0xbe, 0x6c,
-	0xcc, 0x8a, 0xbe, 0x0b, 0xa9, 0x96, 0x36, 0xbc, 0x7c, 0x72, 0x1b, 0x7e, 0xb8, 0x1e, 0xea, 0xbf,
-	0x5f, 0x87, 0x24, 0xe7, 0x67, 0x81, 0x98, 0x68, 0x8a, 0xca, 0xdd, 0x02, 0x35, 0x25, 0xc1, 0x38,
-	0x79, 0x55, 0x73, 0x05, 0x46, 0xbd, 0x9a, 0x4a, 0xc0, 0x7e, 0xf5, 0x04, 0x76, 0xaf, 0xd8, 0x73,
-	0xd7, 0x43, 0x59, 0x82, 0x21, 0xc7, 0x4d, 0x3e, 0xe4, 0x5c, 0x67, 0x1b, 0x12, 0xb9, 0x89, 0xe2,
-	0xd1, 0xa1, 0xef, 0x81, 0x87, 0xa2, 0x7a, 0xac, 0x33, 0xc7, 0xb3, 0xa6, 0x05, 0xbd, 0xf7, 0x35,
-	0xf1, 0x3c, 0xa4, 0xfd, 0x32, 0x1f, 0x97, 0x32, 0xaf, 0x79, 0x8f, 0x28, 0x29, 0x51, 0xdc, 0xe3,
-	0x12, 0x46, 0x17, 0x21, 0xd3, 0x74, 0xb0, 0x11, 0x50, 0x39, 0xf2, 0xd9, 0xb9, 0xfe, 0xc2, 0x88,
-	0x32, 0xc2, 0x86, 0x3d, 0x32, 0x87, 0xd1, 0x71, 0xb4, 0x40, 0x69, 0x78, 0x51, 0x5a, 0x7c, 0xad,
-	0xeb, 0x6b, 0x0c, 0xba, 0x20, 0xe8, 0xc8, 0x87, 0xa2, 0xb9, 0xf5, 0x3a, 0x2f, 0x33, 0x8b, 0x65,
-	0x95, 0x0f, 0x79, 0xd3, 0xea, 0xf5, 0x28, 0xd9, 0x92, 0x3c, 0x17, 0x21, 0x5b, 0x42, 0xcf, 0x60,
-	0xa6, 0xbd, 0x54, 0x49, 0xb0, 0x8e, 0xcd, 0x7d, 0x37, 0x7c, 0x7b, 0xed, 0x34, 0xa5, 0x50, 0xbf,
-	0x9e, 0xa9, 0x08, 0x84, 0x32, 0x45, 0xab, 0x30, 0xec, 0x16, 0x49, 0x5c, 0xc1, 0xe6, 0xbb, 0x98,
-	0x3f, 0x23, 0xe1, 0x92, 0x0c, 0x99, 0x3f, 0x34, 0xfc, 0x51, 0xf4, 0x04, 0xd0, 0x36, 0xff, 0xc6,
-	0xe1, 0x50, 0x6d, 0x60, 0xa2, 0x63, 0x8b, 0x6a, 0x55, 0x2c, 0x9f, 0x3f, 0xb1, 0x55, 0xc9, 0x2b,
-	0x3c, 0x00, 0x67, 0xfb, 0xfa, 0x3e, 0xbd, 0xb9, 0xd0, 0xd7, 0xd7, 0xd7, 0xa7, 0x8c, 0x0a, 0x9c,
-	0x4d, 0x1f, 0x06, 0x5d, 0x82, 0x8c, 0x5f, 0x3f, 0x10, 0x4d, 0xd0, 0xf9, 0x39, 0xa9, 0x30, 0xa0,
-	0xa4, 0xbd, 0x61, 0xd1, 0xec, 0x3c, 0xe9, 0x9b, 0xe5, 0xc2, 0x2b, 0xf9, 0x66, 0x19, 0xdd, 0x02,
-	0x08, 0x7d, 0x24, 0x72, 0xf9, 0x74, 0x1f, 0x89, 0x28, 0x21, 0x5e, 0xf4, 0x0e, 0xa4, 0x1b, 0xc4,
-	0xde, 0x37, 0x99, 0xba, 0xba, 0x17, 0xf9, 0x15, 0xee, 0x2b, 0xaf, 0x1c, 0x55, 0x2e, 0x91, 0x0b,
-	0xf2, 0x7c, 0xe9, 0xb5, 0xe3, 0xaf, 0x29, 0x76, 0x4f, 0x8e, 0x84, 0x10, 0xd6, 0x0d, 0x6e, 0xaf,
-	0xde, 0x00, 0xb3, 0x1d, 0x96, 0x79, 0xca, 0xdf, 0x12, 0x86, 0xd3, 0x2e, 0x88, 0x07, 0xfc, 0x5f,
-	0x12, 0x94, 0x6c, 0x98, 0x83, 0xe5, 0x68, 0xe8, 0x0c, 0x24, 0xeb, 0xcd, 0x1a, 0xcb, 0xc0, 0x1c,
-	0x2a, 0x2f, 0x70, 0xd7, 0x18, 0x0c, 0xa0, 0x2a, 0x4c, 0xeb, 0x35, 0xcd, 0xac, 0xab, 0x5a, 0x4b,
-	0xa2, 0xa6, 0xea, 0xb6, 0x81, 0xe5, 0xe2, 0x09, 0xf1, 0x73, 0x34, 0xb9, 0xe3, 0xfd, 0x15, 0xb3,
-	0xde, 0x21, 0xeb, 0x2b, 0xc2, 0x98, 0xb3, 0x67, 0x36, 0x54, 0x51, 0x25, 0x51, 0x75, 0x72, 0xd8,
-	0xa0, 0xb6, 0x7c, 0x8d, 0x6f, 0x68, 0x94, 0x4d, 0x89, 0xf3, 0x5d, 0xe6, 0x13, 0xe8, 0x09, 0x9c,
-	0xe9, 0x40, 0xaf, 0xda, 0xfb, 0x98, 0x10, 0xd3, 0xc0, 0xf2, 0x1b, 0x5d, 0xcc, 0x25, 0x68, 0x7f,
-	0x4f, 0x47, 0x40, 0xef, 0x0b, 0x66, 0xb4, 0x0c, 0x29, 0x4d, 0xa7, 0xe6, 0xbe, 0x97, 0x3a, 0x5d,
-	0xef, 0xd1, 0xf6, 0x86, 0x7d, 0xae, 0x32, 0xcd, 0xbd, 0x0d, 0x99, 0xb6, 0x14, 0x26, 0x9c, 0x4f,
-	0x27, 0xdd, 0x7c, 0x7a, 0x3c, 0x9c, 0x4f, 0x27, 0x43, 0x69, 0x72, 0xee, 0x11, 0xa4, 0x5b, 0xc3,
-	0x9a, 0x0e, 0xdc, 0xc5, 0xd6, 0x6c, 0x3c, 0xe2, 0xa5, 0x3d, 0x80, 0x10, 0xee, 0x46, 0x3c, 0x71,
-	0x21, 0x7b, 0x71, 0x23, 0x9e, 0xb8, 0x98, 0xbd, 0xb4, 0x11, 0x4f, 0x5c, 0xca, 0x16, 0xf2, 0xb7,
-	0x00, 0x7c, 0x91, 0x39, 0xe8, 0x06, 0x0c, 0x07, 0xff, 0xe9, 0xe3, 0x55, 0x18, 0xa6, 0xbb, 0xca,
-	0x58, 0x01, 0xec, 0xf3, 0xe6, 0xff, 0x08, 0x46, 0x44, 0x59, 0x67, 0x93, 0xe0, 0x1d, 0xf3, 0x00,
-	0x3d, 0x0a, 0xd5, 0x8b, 0xdc, 0x8a, 0xd8, 0x5b, 0xaf, 0xa4, 0x56, 0x34, 0x09, 0x83, 0x35, 0x6c,
-	0x55, 0xe9, 0x2e, 0x7f, 0xf3, 0x11, 0x45, 0xfc, 0xca, 0x1b, 0x30, 0xb9, 0xcc, 0xd3, 0xdf, 0x60,
-	0x7f, 0xa2, 0x5e, 0xb6, 0x01, 0x10, 0xbc, 0x96, 0xff, 0xe9, 0x55, 0xb7, 0xb7, 0xea, 0x90, 0x96,
-	0x27, 0xfd, 0xf7, 0xcc, 0xff, 0x5c, 0x82, 0xc9, 0x87, 0x3c, 0x41, 0xfe, 0x26, 0x97, 0x41, 0x6f,
-	0x02, 0x04, 0xff, 0x6a, 0xd4, 0xb5, 0x06, 0xb0, 0xc6, 0x48, 0xee, 0x6a, 0xce, 0x9e, 0x92, 0xdc,
-	0xf1, 0x1e, 0xf3, 0x7f, 0x23, 0xc1, 0xd8, 0xf7, 0x31, 0x8d, 0x6c, 0xef, 0x03, 0x48, 0x07, 0xdb,
-	0x53, 0x5f, 0xbe, 0x56, 0x91, 0xc2, 0x01, 0x9d, 0xf3, 0x32, 0x1b, 0xfe, 0x57, 0x09, 0x2e, 0x84,
-	0x37, 0x1c, 0x5a, 0x76, 0xcd, 0x26, 0xab, 0x0f, 0xd7, 0x1d, 0xef, 0x15, 0xde, 0x83, 0x04, 0xbf,
-	0x97, 0x71, 0xd3, 0x14, 0x2a, 0xf5, 0xb5, 0x0b, 0x85, 0xab, 0x0f, 0xd7, 0xbf, 0xfd, 0x86, 0x32,
-	0xc4, 0xe0, 0x56, 0x9b, 0x26, 0x7a, 0x04, 0x4c, 0xbf, 0x38, 0x70, 0xec, 0x55, 0x00, 0x0f, 0x1a,
-	0x78, 0x7f, 0xb5, 0x69, 0xe6, 0x8f, 0x62, 0x30, 0x71, 0xc7, 0x74, 0x82, 0x97, 0xf3, 0xdf, 0xe5,
-	0x3e, 0x64, 0xc2, 0xd7, 0x57, 0x20, 0x8f, 0x8b, 0xc7, 0x5c, 0x5c, 0xe1, 0x72, 0x44, 0x5a, 0x0b,
-	0x8f, 0xbf, 0x8c, 0x04, 0xd0, 0x2f, 0x24, 0x18, 0xb0, 0x89, 0x81, 0x09, 0x2f, 0x14, 0x25, 0x2b,
-	0x7f, 0x21, 0x1d, 0x55, 0xfe, 0x54, 0x22, 0x3f, 0x92, 0x94, 0x3e, 0x25, 0xe9, 0x2b, 0x8c, 0x02,
-	0x0b, 0xc1, 0xb3, 0x2f, 0x08, 0x25, 0xb9, 0xe0, 0x3f, 0x7a, 0x67, 0xa8, 0x24, 0x16, 0xbc, 0x27,
-	0x5e, 0x2b, 0x52, 0x06, 0x16, 0xf8, 0x9f, 0x70, 0x4d, 0x48, 0x49, 0x2d, 0x84, 0x7f, 0x85, 0x4a,
-	0x5e, 0xca, 0xf0, 0x42, 0xe8, 0x87, 0xbb, 0x31, 0x34, 0x0b, 0x03, 0xee, 0xbf, 0xc8, 0xf0, 0x7f,
-	0xc6, 0xe2, 0xf1, 0xc9, 0x95, 0x7e, 0xf9, 0x77, 0x43, 0x8a, 0x3b, 0x8c, 0x10, 0xc4, 0x1b, 0x2c,
-	0x18, 0x71, 0xff, 0x09, 0x8b, 0x3f, 0xe7, 0x7f, 0x2a, 0xc1, 0xd8, 0x83, 0x0e, 0x96, 0xb0, 0x76,
-	0x3a, 0x43, 0x6d, 0xad, 0xa1, 0xbf, 0x1a, 0x23, 0xfd, 0x3b, 0x09, 0x66, 0x14, 0x96, 0x84, 0x94,
-	0x2d, 0xe3, 0xff, 0x94, 0xb1, 0xfe, 0x5a, 0x82, 0x51, 0x7f, 0xcd, 0x2d, 0x5c, 0x6f, 0xd4, 0x58,
-	0x60, 0xf8, 0xbf, 0x7f, 0xa2, 0xa8, 0x00, 0xc3, 0x75, 0xad, 0xc1, 0x3b, 0xf7, 0xec, 0xa6, 0xec,
-	0x6f, 0x2d, 0x95, 0x80, 0x98, 0xbb, 0x8d, 0x0f, 0xf3, 0x7f, 0x2f, 0xc1, 0x54, 0xe4, 0x15, 0xdc,
-	0xd0, 0xcd, 0xaf, 0x78, 0x4a, 0xad, 0xec, 0x1d, 0x2b, 0x9e, 0xb1, 0x70, 0x45, 0xe7, 0x73, 0xa9,
-	0xb5, 0xe2, 0xb9, 0x05, 0x19, 0x5e, 0x15, 0xc4, 0x07, 0x14, 0x5b, 0x0e, 0x2f, 0x65, 0xf4, 0xf3,
-	0x26, 0xcb, 0xb7, 0x8e, 0x2a, 0x85, 0xcf, 0xa4, 0x0b, 0x59, 0x43, 0x96, 0xf2, 0xe7, 0xc8, 0xd9,
-	0xd2, 0xcc, 0xb3, 0xc2, 0xcd, 0x1b, 0x1f, 0x14, 0xbd, 0x88, 0xef, 0xe3, 0xa5, 0xab, 0x4b, 0xdf,
-	0x7e, 0x71, 0xf9, 0xe3, 0xa5, 0xab, 0xa5, 0x17, 0xf3, 0x4a, 0x9a, 0x61, 0xac, 0xfa, 0x10, 0xf9,
-	0xff, 0x96, 0x40, 0xee, 0xb2, 0x75, 0x07, 0xbd, 0x80, 0x21, 0x37, 0xe8, 0xf4, 0x6e, 0xee, 0xeb,
-	0x5d, 0x25, 0xd0, 0xc6, 0x5a, 0x14, 0x7f, 0xbf, 0x4e, 0x09, 0xc5, 0x5b, 0x33, 0xa7, 0x43, 0x2a,
-	0x0c, 0xd3, 0x21, 0x64, 0x39, 0xa9, 0x81, 0xd0, 0x65, 0x7b, 0xe1, 0x06, 0xc2, 0xc7, 0x70, 0x6e,
-	0xd9, 0xb6, 0xf6, 0x31, 0xa1, 0x11, 0x62, 0xcf, 0x74, 0x56, 0x20, 0xe9, 0x6e, 0x89, 0x05, 0xda,
-	0xae, 0x1c, 0x2f, 0x1d, 0x55, 0x7a, 0x7b, 0x99, 0x84, 0xcb, 0xb9, 0x6e, 0x30, 0x7f, 0xc2, 0x63,
-	0x6a, 0x7e, 0x1b, 0x28, 0xfc, 0xf9, 0xca, 0x43, 0x80, 0x20, 0x45, 0x42, 0xa3, 0x30, 0xb2, 0x79,
-	0xff, 0xdd, 0x55, 0x45, 0x7d, 0x78, 0xef, 0xf6, 0xbd, 0xfb, 0xef, 0xde, 0xcb, 0xf6, 0x05, 0x43,
-	0x95, 0xf2, 0xd6, 0xd6, 0xaa, 0xf2, 0x7e, 0x56, 0x42, 0x08, 0xd2, 0xee, 0xd0, 0xea, 0x7b, 0x5b,
-	0xab, 0xca, 0xbd, 0xf2, 0x9d, 0x6c, 0x2c, 0x37, 0xf2, 0xfb, 0x5f, 0x4e, 0x27, 0x65, 0xe9, 0xca,
-	0x00, 0x9f, 0xa9, 0xdc, 0xfd, 0x97, 0xff, 0x98, 0xed, 0xfb, 0xf4, 0xab, 0x59, 0xe9, 0xaf, 0xbf,
-	0x9a, 0x95, 0x7e, 0xf7, 0xd5, 0x6c, 0xdf, 0x7f, 0x7e, 0x35, 0x2b, 0xfd, 0xe4, 0xb7, 0xb3, 0x7d,
-	0xbf, 0xfe, 0xed, 0xac, 0xf4, 0x78, 0xf1, 0x14, 0x17, 0x10, 0xb5, 0x1a, 0xdb, 0xdb, 0x83, 0xdc,
-	0x4e, 0xae, 0xfd, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xd4, 0x9f, 0xb9, 0xc7, 0x3d, 0x00,
+	// 5377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0x5d, 0x6c, 0x1b, 0xd9,
+	0x75, 0xd6, 0x90, 0x14, 0x7f, 0x8e, 0x24, 0x92, 0xba, 0xfa, 0x1b, 0xc9, 0xb6, 0x24, 0x73, 0x6d,
+	0xaf, 0xec, 0x58, 0xd2, 0x9a, 0x5e, 0xa7, 0x59, 0xbb, 0x1b, 0x87, 0xa4, 0xa4, 0x58, 0x5a, 0xdb,
+	0xab, 0x8c, 0x65, 0x6f, 0x62, 0xaf, 0x77, 0x32, 0x9a, 0xb9, 0xa2, 0x67, 0x45, 0xce, 0x30, 0x77,
+	0x86, 0xb2, 0x98, 0x8d, 0xd1, 0x6d, 0xd0, 0x1f, 0x34, 0x40, 0x8b, 0x60, 0x81, 0x02, 0x41, 0x1e,
+	0x9a, 0x3c, 0x15, 0x45, 0x1e, 0xfa, 0x58, 0xf4, 0xa1, 0x40, 0x52, 0xa0, 0x05, 0x82, 0x00, 0x05,
+	0xf2, 0x54, 0xb4, 0x7d, 0x48, 0xd1, 0xcd, 0x4b, 0x90, 0xa7, 0x3e, 0x16, 0x02, 0x5a, 0x14, 0xf7,
+	0xce, 0xbd, 0x33, 0x43, 0xce, 0x50, 0xa2, 0x62, 0x2f, 0x9a, 0x3e, 0x69, 0x74, 0xef, 0x39, 0xdf,
+	0xfd, 0x3b, 0xe7, 0xdc, 0x73, 0xce, 0x3d, 0x84, 0x52, 0xc3, 0x26, 0xda, 0x73, 0xcd, 0x5a, 0x76,
+	0x5c, 0x4d, 0xdf, 0x5f, 0xd5, 0x5a, 0xe6, 0x2a, 0xb6, 0x0c, 0xd5, 0xc0, 0x07, 0xa6, 0x8e, 0x57,
+	0x5a, 0xc4, 0x76, 0x6d, 0x94, 0x77, 0x5d, 0x6b, 0x85, 0xd3, 0xad, 0x1c, 0x5c, 0x9f, 0xab, 0xd4,
+	0x4d, 0xf7, 0x59, 0x7b, 0x77, 0x45, 0xb7, 0x9b, 0xab, 0xd8, 0x3a, 0xb0, 0x3b, 0x2d, 0x62, 0x1f,
+	0x76, 0x56, 0x19, 0xb1, 0xbe, 0x5c, 0xc7, 0xd6, 0xf2, 0x81, 0xd6, 0x30, 0x0d, 0xcd, 0xc5, 0xab,
+	0x91, 0x0f, 0x0f, 0x72, 0x6e, 0x39, 0x04, 0x51, 0xb7, 0xeb, 0xb6, 0xc7, 0xbc, 0xdb, 0xde, 0x63,
+	0xff, 0xb1, 0x7f, 0xd8, 0x17, 0x27, 0xaf, 0x85, 0xc8, 0x77, 0x9e, 0xe1, 0x9d, 0x67, 0xa6, 0x55,
+	0x77, 0x36, 0x2d, 0xa3, 0xed, 0xb8, 0xc4, 0xc4, 0x4e, 0x78, 0xe8, 0xba, 0xbd, 0xfc, 0xa1, 0x63,
+	0x5b, 0xab, 0x9a, 0x65, 0xd9, 0xae, 0xe6, 0x9a, 0xb6, 0xe5, 0x70, 0x90, 0xf9, 0xba, 0x6d, 0xd7,
+	0x1b, 0x38, 0x18, 0xca, 0x68, 0x13, 0x46, 0xc0, 0xfb, 0x17, 0x7b, 0xfb, 0xf7, 0x4c, 0xdc, 0x30,
+	0xd4, 0xa6, 0xe6, 0xec, 0x73, 0x8a, 0xb3, 0xbd, 0x14, 0x8e, 0x4b, 0xda, 0xba, 0xcb, 0x7b, 0x17,
+	0x7a, 0x7b, 0x5d, 0xb3, 0x89, 0x1d, 0x57, 0x6b, 0xb6, 0xfa, 0x4d, 0xe0, 0x39, 0xd1, 0x5a, 0x2d,
+	0x4c, 0xc4, 0x04, 0x5f, 0x8b, 0x9e, 0x85, 0x69, 0x60, 0xcb, 0x35, 0xf7, 0xcc, 0x80, 0xe8, 0x6c,
+	0x94, 0x68, 0x1f, 0x77, 0x44, 0xef, 0x42, 0xb4, 0x57, 0x1c, 0x1c, 0x5f, 0x64, 0x94, 0xa0, 0x89,
+	0x1d, 0x47, 0xab, 0xe3, 0x63, 0x20, 0x5a, 0xa6, 0xee, 0xb6, 0x09, 0x3e, 0x0e, 0xc2, 0xd5, 0x0c,
+	0xcd, 0xd5, 0x3c, 0x8a, 0xd2, 0xef, 0xa7, 0x20, 0xf3, 0x00, 0x3b, 0x8e, 0x69, 0x5b, 0xe8, 0x31,
+	0x64, 0x0d, 0x7c, 0xa0, 0x6a, 0x86, 0x41, 0xe4, 0xc4, 0xa2, 0xb4, 0x34, 0x5a, 0xbd, 0xfd, 0xd3,
+	0x5f, 0x2c, 0x0c, 0xfd, 0xdb, 0x2f, 0x16, 0x7e, 0xa7, 0x6e, 0xaf, 0xb8, 0xcf, 0xb0, 0xcb, 0x0e,
+	0x73, 0xc5, 0xc2, 0xee, 0x73, 0x9b, 0xec, 0xaf, 0x76, 0x83, 0x1f, 0x5c, 0x5f, 0x6d, 0xed, 0xd7,
+	0x57, 0xdd, 0x4e, 0x0b, 0x3b, 0x2b, 0x6b, 0xf8, 0xa0, 0x62, 0x18, 0x44, 0xc9, 0x18, 0xde, 0x07,
+	0xaa, 0x40, 0x8a, 0xae, 0x5d, 0x4e, 0x2e, 0x4a, 0x4b, 0x23, 0xe5, 0x33, 0x2b, 0xdd, 0x72, 0xba,
+	0xc2, 0xa7, 0xf0, 0x0e, 0xee, 0x38, 0xd5, 0xe2, 0x51, 0x75, 0xf8, 0x3b, 0x52, 0xa2, 0x28, 0xd1,
+	0xc1, 0x7f, 0xfe, 0x8b, 0x05, 0x49, 0x61, 0xac, 0xe8, 0x3c, 0x8c, 0x35, 0x34, 0xc7, 0x55, 0xf7,
+	0x54, 0xdd, 0x72, 0xd5, 0x76, 0x4b, 0x4e, 0x2d, 0x4a, 0x4b, 0x63, 0x0a, 0xd0, 0xc6, 0x8d, 0x9a,
+	0xe5, 0x3e, 0x6c, 0xa1, 0x25, 0x18, 0x67, 0x24, 0x16, 0x27, 0x32, 0xec, 0xe7, 0x96, 0x3c, 0xcc,
+	0xc8, 0x18, 0xef, 0x7d, 0x4a, 0xb7, 0x66, 0x3f, 0xb7, 0x7c, 0x4a, 0x2d, 0x4c, 0x99, 0x0e, 0x28,
+	0x2b, 0x3e, 0xe5, 0x0a, 0x4c, 0x32, 0x4a, 0xdd, 0xb6, 0xf6, 0xc2, 0xc4, 0x19, 0x46, 0x5c, 0xa4,
+	0x7d, 0x35, 0xdb, 0xda, 0xf3, 0xe9, 0x6b, 0x00, 0x8e, 0xab, 0x11, 0x17, 0x1b, 0xaa, 0xe6, 0xca,
+	0x59, 0xb6, 0xde, 0xb9, 0x15, 0x4f, 0x9e, 0x56, 0x84, 0x3c, 0xad, 0xec, 0x08, 0x81, 0xab, 0x66,
+	0xe9, 0x32, 0xbf, 0xfb, 0xef, 0x0b, 0x92, 0x92, 0xe3, 0x7c, 0x15, 0x17, 0x61, 0x38, 0xfb, 0x8d,
+	0x36, 0x6e, 0x53, 0x8c, 0x56, 0xab, 0x61, 0xea, 0x4c, 0xf8, 0xd9, 0xb8, 0x0d, 0xd3, 0xda, 0x77,
+	0xe4, 0xdc, 0x62, 0x72, 0x69, 0xa4, 0xfc, 0x5a, 0xef, 0x36, 0x56, 0x02, 0xe2, 0x35, 0x4e, 0xab,
+	0xcc, 0x79, 0x40, 0x31, 0x5d, 0xce, 0x56, 0x2a, 0x2b, 0x15, 0x13, 0xa5, 0xcb, 0x90, 0xab, 0xda,
+	0x76, 0xe3, 0x91, 0xd6, 0x68, 0x63, 0x34, 0x09, 0xc3, 0x07, 0xf4, 0x43, 0x96, 0x16, 0xa5, 0xa5,
+	0xac, 0xe2, 0xfd, 0x73, 0x33, 0xfd, 0xeb, 0x1f, 0xcd, 0x26, 0x64, 0xa9, 0xf4, 0xab, 0x02, 0x8c,
+	0xdd, 0xab, 0xd4, 0xb6, 0x35, 0xa2, 0x35, 0xb1, 0x8b, 0x89, 0x83, 0x66, 0x21, 0xdb, 0xd4, 0x0e,
+	0x55, 0x6c, 0x92, 0x16, 0x63, 0x49, 0x28, 0x99, 0xa6, 0x76, 0xb8, 0x6e, 0x92, 0x16, 0x7a, 0x04,
+	0x13, 0x9a, 0x41, 0x54, 0x2a, 0x6d, 0x2a, 0xd1, 0x5c, 0xac, 0x9a, 0x96, 0x81, 0x0f, 0xd9, 0xb1,
+	0xe5, 0xcb, 0xe7, 0x7a, 0xe7, 0xbe, 0xa6, 0xb9, 0x9a, 0xa2, 0xb9, 0x78, 0x93, 0x12, 0x55, 0xb3,
+	0x47, 0xd5, 0xe1, 0x6f, 0x53, 0x21, 0x50, 0x8a, 0x9a, 0x41, 0xba, 0xfa, 0xd0, 0x9b, 0x80, 0x28,
+	0xae, 0x7b, 0xa8, 0xb6, 0xec, 0xe7, 0x98, 0x70, 0x58, 0x76, 0xcc, 0xd5, 0xcc, 0x51, 0x35, 0x75,
+	0x25, 0x21, 0x17, 0x94, 0x82, 0x66, 0x90, 0x9d, 0xc3, 0x6d, 0x4a, 0xe0, 0x71, 0x5d, 0x86, 0x51,
+	0xca, 0x65, 0xed, 0xaa, 0x2e, 0xd1, 0x2c, 0x47, 0x4e, 0x77, 0xd3, 0x83, 0x66, 0x90, 0xfb, 0xbb,
+	0x3b, 0xb4, 0x0b, 0x5d, 0x82, 0x31, 0x4a, 0xaa, 0xe9, 0xfb, 0x6a, 0xc3, 0x6c, 0x9a, 0xae, 0x77,
+	0xd6, 0xd5, 0x84, 0x2c, 0x29, 0x23, 0x9a, 0x41, 0x2a, 0xfa, 0xfe, 0x5d, 0xda, 0x1c, 0xa6, 0x33,
+	0x70, 0x43, 0xeb, 0xc8, 0xd9, 0x5e, 0xba, 0x35, 0xda, 0x8c, 0xbe, 0x08, 0x39, 0x72, 0x78, 0x8d,
+	0xd3, 0xe4, 0xd8, 0xf2, 0x67, 0x7a, 0x97, 0xaf, 0x1c, 0x32, 0xda, 0xd0, 0xc2, 0xb3, 0xe4, 0xf0,
+	0x9a, 0xc7, 0xff, 0x35, 0x98, 0x64, 0xfc, 0xfe, 0x46, 0xda, 0x7b, 0x7b, 0x0e, 0x76, 0x65, 0x60,
+	0x50, 0xf3, 0xfd, 0x76, 0xf2, 0x5d, 0x46, 0x15, 0x42, 0x1c, 0xa7, 0x88, 0x5d, 0x9d, 0xf4, 0x8c,
+	0xc8, 0x61, 0x39, 0x72, 0x46, 0x23, 0xa7, 0x3c, 0x23, 0x72, 0x58, 0xee, 0x3e, 0xa3, 0x15, 0x18,
+	0xa3, 0xb8, 0x7b, 0x04, 0x7f, 0xa3, 0x8d, 0x2d, 0xbd, 0x23, 0x8f, 0x2e, 0x4a, 0x4b, 0xa9, 0x6a,
+	0xee, 0xa8, 0x9a, 0x2e, 0xa7, 0x96, 0x7e, 0xf8, 0xa7, 0x69, 0x65, 0x94, 0x1c, 0x96, 0x37, 0x44,
+	0x37, 0x7a, 0x00, 0x79, 0x2a, 0x46, 0x46, 0xdb, 0xed, 0xa8, 0x7a, 0x47, 0x6f, 0x60, 0x79, 0x8c,
+	0x4d, 0x21, 0x2a, 0xe2, 0xf5, 0x3a, 0xc1, 0x75, 0xcd, 0xc5, 0xc6, 0x5a, 0xdb, 0xed, 0xd4, 0x28,
+	0x69, 0x68, 0x22, 0xa3, 0x4d, 0xed, 0xd0, 0x6f, 0x47, 0x06, 0xcc, 0x10, 0xfc, 0xa1, 0x6d, 0x5a,
+	0x2a, 0xb5, 0xef, 0x6a, 0x0b, 0x13, 0xd3, 0x36, 0x4c, 0xdd, 0x74, 0x3b, 0x72, 0x9e, 0xa1, 0x97,
+	0x22, 0xa7, 0xc0, 0xc8, 0xa9, 0x72, 0xae, 0x1f, 0xb6, 0x6c, 0x0b, 0x5b, 0xe1, 0xed, 0x9b, 0x22,
+	0x7e, 0xef, 0x76, 0x00, 0x85, 0xea, 0x20, 0xf3, 0x51, 0x74, 0xbb, 0x6d, 0xb9, 0x5d, 0xc3, 0x14,
+	0xe2, 0x17, 0xe1, 0x0d, 0x53, 0xa3, 0xe4, 0x31, 0xe3, 0x4c, 0x93, 0xa0, 0x3b, 0x3c, 0xd0, 0x2d,
+	0x98, 0x68, 0x99, 0x56, 0x5d, 0x75, 0x1a, 0xb6, 0x1b, 0xda, 0xd9, 0x22, 0xdb, 0xd9, 0x91, 0xa3,
+	0x6a, 0xb6, 0x9c, 0x96, 0x87, 0xd8, 0xde, 0x8e, 0x53, 0xba, 0x07, 0x0d, 0xdb, 0x0d, 0x36, 0xf8,
+	0x09, 0xcc, 0x06, 0xcc, 0xbd, 0xc7, 0x3d, 0x3e, 0xc8, 0x71, 0x53, 0xb1, 0x9e, 0x12, 0xc0, 0xdd,
+	0xa7, 0xfd, 0x79, 0x28, 0xee, 0x62, 0x4d, 0xb7, 0xad, 0xd0, 0xb4, 0x50, 0x74, 0x5a, 0x05, 0x8f,
+	0x28, 0x98, 0xd4, 0x3b, 0x90, 0xd5, 0x9f, 0x69, 0x96, 0x85, 0x1b, 0x8e, 0x3c, 0xc1, 0x4c, 0xda,
+	0xc5, 0xde, 0x39, 0x74, 0x59, 0x9b, 0x95, 0x9a, 0x47, 0xcd, 0x36, 0xeb, 0x13, 0x29, 0x91, 0x95,
+	0x14, 0x1f, 0x00, 0xad, 0xc3, 0x78, 0xbb, 0x45, 0xed, 0x9a, 0x6a, 0x3c, 0xc7, 0x8d, 0x06, 0x3b,
+	0x73, 0x79, 0x92, 0xd9, 0xdf, 0xd9, 0x5e, 0x54, 0xdf, 0xde, 0x29, 0x05, 0x8f, 0x67, 0x8d, 0xb2,
+	0xd0, 0x93, 0x45, 0x9b, 0x30, 0x21, 0xec, 0x6c, 0x18, 0x68, 0xea, 0x24, 0xa0, 0x71, 0xc1, 0x15,
+	0x40, 0x3d, 0x85, 0xe9, 0x2e, 0x3b, 0xa2, 0x62, 0x7e, 0xd8, 0xf2, 0x34, 0x43, 0x5b, 0x8a, 0x08,
+	0xf7, 0x9a, 0x22, 0x8c, 0x8b, 0x90, 0x0b, 0x0f, 0x7c, 0x22, 0x64, 0x76, 0x44, 0x4f, 0x18, 0x9e,
+	0x99, 0x96, 0x00, 0x7e, 0xe6, 0x38, 0x78, 0x66, 0x53, 0x62, 0xe1, 0xbb, 0x7a, 0x50, 0x1d, 0x16,
+	0xfa, 0x4a, 0x8c, 0xea, 0xdd, 0x11, 0x32, 0x1b, 0xa7, 0x74, 0xac, 0xdc, 0x78, 0x23, 0xcc, 0xc5,
+	0x0a, 0x0e, 0xeb, 0x43, 0x37, 0x60, 0x98, 0x30, 0xd3, 0x38, 0xcb, 0xe0, 0x16, 0xa2, 0xda, 0xd2,
+	0xd0, 0x3a, 0x81, 0x10, 0x28, 0x1e, 0xf5, 0xdc, 0xcf, 0x13, 0x90, 0xe1, 0xf2, 0x40, 0x05, 0x90,
+	0x9f, 0x7d, 0x20, 0x80, 0x52, 0x8c, 0x00, 0x7a, 0x44, 0x81, 0x00, 0x7e, 0x01, 0x90, 0x7f, 0xd8,
+	0x01, 0x67, 0xa2, 0xd7, 0x56, 0xf9, 0x67, 0x1b, 0x70, 0x3e, 0x82, 0x89, 0xa6, 0x69, 0x45, 0x34,
+	0x29, 0x79, 0x4a, 0xc3, 0xd9, 0x34, 0xad, 0x6e, 0x55, 0xa2, 0xb8, 0xda, 0x61, 0x04, 0xf7, 0xb4,
+	0x97, 0x26, 0xb5, 0x83, 0x5d, 0xb8, 0xaf, 0xc1, 0x18, 0xb6, 0xb4, 0xdd, 0x06, 0x56, 0xbd, 0x3d,
+	0x60, 0xf7, 0x65, 0x56, 0x19, 0xf5, 0x1a, 0x1f, 0xb2, 0xb6, 0xad, 0x54, 0x36, 0x51, 0x4c, 0x6e,
+	0xa5, 0xb2, 0xc9, 0x62, 0xaa, 0xf4, 0x47, 0x69, 0x28, 0xae, 0x5b, 0xc6, 0x1a, 0x0b, 0x2f, 0x1e,
+	0x61, 0xc2, 0x5c, 0xc4, 0x77, 0x21, 0x69, 0x1a, 0x0e, 0xdb, 0xda, 0x91, 0xf2, 0xe7, 0x7a, 0x67,
+	0xd3, 0x4b, 0xbe, 0x19, 0xb8, 0xc4, 0x31, 0x5e, 0x1d, 0x45, 0x42, 0xf7, 0xa0, 0xc0, 0x01, 0xd4,
+	0x03, 0x8f, 0x89, 0xed, 0x7e, 0xbe, 0x3c, 0x17, 0x63, 0x08, 0x38, 0x6c, 0x68, 0x9d, 0x79, 0x4e,
+	0x20, 0xe6, 0xb7, 0x03, 0x13, 0x02, 0xae, 0xf5, 0xac, 0xe3, 0x43, 0x26, 0xe3, 0x21, 0xb7, 0xef,
+	0x7c, 0x2d, 0x0a, 0x39, 0xce, 0x09, 0xb6, 0x9f, 0x75, 0x04, 0xea, 0x75, 0x18, 0xf7, 0x85, 0x43,
+	0x6d, 0x35, 0x34, 0x4b, 0x35, 0x0d, 0x76, 0x22, 0x39, 0xe6, 0x3f, 0x90, 0x84, 0xfc, 0x25, 0xa5,
+	0xe0, 0x53, 0x6c, 0x37, 0x34, 0x6b, 0xd3, 0x40, 0x8b, 0x90, 0x6e, 0x3d, 0xb3, 0x5d, 0xdb, 0x91,
+	0x87, 0x17, 0x93, 0x4b, 0x39, 0x61, 0xb2, 0x8a, 0xa0, 0xf0, 0x76, 0xb4, 0x04, 0x45, 0xa7, 0xdd,
+	0x6a, 0xd9, 0xc4, 0x75, 0x54, 0xbd, 0xa1, 0x39, 0x8e, 0xba, 0xcb, 0xbc, 0x92, 0xac, 0x92, 0x17,
+	0xed, 0x35, 0xda, 0x5c, 0x8d, 0xa1, 0xd4, 0xe5, 0x4c, 0x0c, 0x65, 0x0d, 0xdd, 0x83, 0x49, 0x03,
+	0xef, 0x69, 0xed, 0x86, 0xab, 0x36, 0x35, 0x5d, 0x75, 0xb0, 0xeb, 0x52, 0x9f, 0x5d, 0xce, 0xc6,
+	0xfb, 0xdd, 0xf7, 0x2a, 0xb5, 0x07, 0x9c, 0x44, 0x41, 0x9c, 0xf1, 0x9e, 0xa6, 0x8b, 0x36, 0x2a,
+	0x35, 0x54, 0xca, 0x03, 0xd5, 0xa0, 0xde, 0x4b, 0x4a, 0x19, 0x6d, 0x9a, 0x21, 0x2b, 0x4e, 0x89,
+	0xb4, 0xc3, 0x10, 0x11, 0x70, 0x22, 0xed, 0xb0, 0x8b, 0xc8, 0x5f, 0x02, 0xbd, 0xdd, 0x98, 0x8b,
+	0x91, 0x55, 0x46, 0x45, 0xe3, 0x96, 0x6d, 0x5a, 0xe8, 0x2a, 0x20, 0x82, 0x1d, 0xcc, 0x49, 0x54,
+	0xcb, 0xb6, 0x74, 0xec, 0x30, 0xd7, 0x21, 0xab, 0x14, 0xbd, 0x1e, 0x4a, 0x77, 0x9f, 0xb5, 0x23,
+	0x0c, 0x62, 0xca, 0xea, 0x9e, 0x4d, 0x9a, 0x9a, 0x4b, 0xad, 0x83, 0x3c, 0x16, 0x6f, 0xfb, 0xee,
+	0x79, 0xa1, 0xd3, 0xb6, 0xd6, 0x69, 0xd8, 0x9a, 0xb1, 0xe1, 0xd3, 0x57, 0x47, 0xc3, 0x82, 0xa9,
+	0x8c, 0x73, 0xc4, 0x80, 0xa0, 0xf4, 0xb3, 0x49, 0x18, 0x09, 0xed, 0x13, 0xfa, 0x32, 0x14, 0xf8,
+	0x69, 0xb1, 0x9b, 0xc1, 0x6e, 0xbb, 0x5c, 0x1f, 0x66, 0x23, 0x5e, 0xfe, 0x1a, 0x0f, 0x5b, 0xab,
+	0xa9, 0xef, 0x51, 0x07, 0x7f, 0x8c, 0xf1, 0x55, 0x77, 0x3c, 0x2e, 0xf4, 0x1e, 0x4c, 0x05, 0x06,
+	0x36, 0xec, 0x35, 0x24, 0x18, 0x5c, 0xc4, 0x6b, 0xd8, 0xe6, 0x26, 0xd4, 0xf3, 0x09, 0xb8, 0xe5,
+	0x6e, 0x75, 0x35, 0x7a, 0x8e, 0xc2, 0xfb, 0xc7, 0xdd, 0xf5, 0xc9, 0x81, 0x6d, 0x76, 0x9f, 0xcb,
+	0xfe, 0x7e, 0xbc, 0x1b, 0x92, 0x62, 0xb8, 0x11, 0x67, 0xd4, 0x97, 0x00, 0x7e, 0x4b, 0x46, 0x3d,
+	0x93, 0xcd, 0x18, 0xe7, 0x61, 0x76, 0x20, 0xb0, 0x88, 0x3f, 0xe1, 0x1f, 0x8d, 0xee, 0x1f, 0xcd,
+	0xf0, 0x69, 0x8e, 0xa6, 0x26, 0x8e, 0xe6, 0xad, 0xb0, 0xc7, 0x9e, 0x66, 0x10, 0x67, 0xfb, 0x78,
+	0xec, 0xde, 0x54, 0x02, 0x67, 0x7d, 0xa7, 0x8f, 0xb3, 0x9e, 0x89, 0x3f, 0xd4, 0x6e, 0x7f, 0x9c,
+	0x6f, 0x52, 0xd4, 0x4f, 0xff, 0x4a, 0xbc, 0x9f, 0x9e, 0x1d, 0xf8, 0x30, 0xa3, 0x2e, 0x7a, 0xad,
+	0xd7, 0x45, 0xcf, 0x0d, 0xb4, 0xe9, 0xdd, 0x7e, 0xfb, 0x06, 0xcc, 0xed, 0x69, 0xba, 0x6b, 0x93,
+	0x8e, 0xda, 0x62, 0x0a, 0xea, 0xe3, 0x99, 0xd8, 0x91, 0x61, 0x31, 0xb9, 0x94, 0xf2, 0x2d, 0xdf,
+	0xd7, 0x15, 0x99, 0xd3, 0x6e, 0x33, 0xd2, 0x8d, 0x80, 0x12, 0xdd, 0x8f, 0xf8, 0xff, 0x23, 0x7d,
+	0x7c, 0x98, 0xa8, 0xff, 0xcf, 0xe7, 0xd5, 0xe5, 0xfa, 0xbf, 0x03, 0x53, 0xbe, 0xb9, 0xb9, 0x5e,
+	0x56, 0x77, 0x4d, 0x9e, 0x37, 0x90, 0x47, 0xb9, 0x3c, 0xf4, 0xf7, 0xe3, 0x04, 0xdf, 0xf5, 0x72,
+	0xd5, 0x64, 0x89, 0x05, 0x54, 0x86, 0x4c, 0xdb, 0xc1, 0xaa, 0x66, 0x10, 0x79, 0xec, 0x24, 0xf6,
+	0x74, 0xdb, 0xc1, 0x15, 0x83, 0xa0, 0x9b, 0x40, 0x23, 0x4a, 0xb5, 0xa9, 0x91, 0xba, 0x69, 0xc9,
+	0x79, 0x6e, 0x7e, 0x7b, 0xa5, 0x70, 0xa3, 0x61, 0x6b, 0xfc, 0xd0, 0x73, 0x9a, 0x41, 0xee, 0x31,
+	0x6a, 0x74, 0x0b, 0x46, 0xb9, 0x19, 0xf4, 0xe6, 0x5c, 0x38, 0x69, 0x50, 0xf0, 0xc8, 0xd9, 0x64,
+	0xdf, 0x83, 0x19, 0xc7, 0xd5, 0xdc, 0xb6, 0x13, 0x0d, 0x7a, 0x8a, 0x83, 0xe9, 0xc2, 0x94, 0xc7,
+	0xdf, 0x1b, 0xe7, 0x3c, 0x02, 0x99, 0x03, 0x47, 0xe3, 0x9c, 0x71, 0xae, 0x22, 0xbd, 0xc8, 0x0f,
+	0x37, 0x2d, 0xf7, 0x7a, 0xd9, 0x9b, 0xe4, 0xb4, 0xc7, 0x1d, 0x09, 0x6b, 0xee, 0xc0, 0xb8, 0x81,
+	0x1d, 0x93, 0x60, 0x43, 0x0d, 0x74, 0x0e, 0x0d, 0xa0, 0x73, 0x05, 0xce, 0xa6, 0x08, 0xd5, 0xd3,
+	0xe1, 0x6c, 0x17, 0x52, 0xaf, 0x0a, 0x4e, 0x0c, 0xae, 0x82, 0x72, 0x08, 0xbb, 0x5b, 0x13, 0xbf,
+	0x0e, 0x67, 0x82, 0x41, 0xa2, 0x1a, 0x39, 0x39, 0xb0, 0x46, 0xce, 0xf8, 0x43, 0xf4, 0x28, 0xa6,
+	0x02, 0x53, 0xe1, 0x11, 0x02, 0x05, 0x9d, 0x1a, 0x48, 0x41, 0x27, 0x02, 0xdc, 0x40, 0x4f, 0x9f,
+	0xc2, 0xb4, 0xc0, 0xec, 0xd1, 0xb3, 0xe9, 0x53, 0xea, 0x99, 0x80, 0xbf, 0x17, 0x56, 0xb7, 0x06,
+	0xcc, 0x0b, 0xf8, 0x3e, 0x11, 0xcf, 0xcc, 0x29, 0x23, 0x9e, 0x39, 0x8e, 0x57, 0x89, 0x09, 0x7c,
+	0x62, 0x46, 0xeb, 0x09, 0x80, 0xe4, 0x53, 0x06, 0x40, 0xdd, 0xa3, 0x75, 0x11, 0xa0, 0x7d, 0x38,
+	0x2f, 0x46, 0xeb, 0x7f, 0xab, 0x9e, 0x19, 0xf8, 0xd8, 0x85, 0x88, 0x6e, 0xc7, 0x5e, 0xae, 0x4f,
+	0xe1, 0x4c, 0x74, 0xb0, 0x40, 0x02, 0xce, 0x0e, 0x24, 0x01, 0x72, 0xcf, 0x10, 0x81, 0x18, 0x7c,
+	0x15, 0x44, 0x9f, 0x1a, 0xb9, 0x73, 0xcf, 0x0d, 0x84, 0x2d, 0xc4, 0xa8, 0xda, 0x73, 0xf5, 0x6e,
+	0x42, 0x31, 0x2c, 0x60, 0x2c, 0x1f, 0x38, 0x1f, 0x1f, 0xcf, 0x79, 0x31, 0xc2, 0xfa, 0xa6, 0xb2,
+	0xed, 0x41, 0xe6, 0x03, 0x89, 0x62, 0x79, 0xc3, 0xa7, 0x70, 0x46, 0x38, 0x58, 0xba, 0x9f, 0xf3,
+	0x54, 0x4d, 0xcb, 0xc5, 0xe4, 0x40, 0x6b, 0xc8, 0x0b, 0x83, 0x59, 0xb1, 0x19, 0xcf, 0xd9, 0xaa,
+	0x89, 0x6c, 0xe7, 0x26, 0xe7, 0x0f, 0xc2, 0xcd, 0xc5, 0xd3, 0x84, 0x9b, 0x68, 0x0d, 0xc6, 0x7c,
+	0xad, 0x64, 0xec, 0xe7, 0x07, 0x63, 0x1f, 0x15, 0xea, 0x48, 0xdb, 0x4b, 0xff, 0x90, 0x81, 0xe9,
+	0x07, 0x98, 0x1c, 0x98, 0x56, 0xbd, 0x87, 0x10, 0x6d, 0x42, 0xde, 0xc1, 0xba, 0x6d, 0x19, 0x2a,
+	0x4f, 0x69, 0xc8, 0x52, 0xbc, 0x50, 0x31, 0xc6, 0x07, 0x8c, 0x94, 0xc7, 0xbf, 0xca, 0x98, 0x13,
+	0xfe, 0x17, 0xdd, 0x82, 0x29, 0xee, 0xc7, 0x0a, 0x2c, 0x2e, 0xa6, 0x89, 0x70, 0xd2, 0x33, 0xa9,
+	0x4c, 0x70, 0x2a, 0xce, 0x27, 0x22, 0xd0, 0x82, 0xae, 0x19, 0x5d, 0xe6, 0x3d, 0xd9, 0x2f, 0x8d,
+	0xd5, 0xd0, 0x3a, 0xb5, 0xca, 0x5a, 0xc8, 0x9a, 0x87, 0x63, 0x33, 0x5d, 0x33, 0x42, 0x3d, 0xe8,
+	0x05, 0xcc, 0x88, 0x18, 0xdd, 0x26, 0xcf, 0x35, 0x62, 0x50, 0xf9, 0x26, 0xed, 0x06, 0x76, 0xe4,
+	0x14, 0xcb, 0xfd, 0xdc, 0x8a, 0xbe, 0x0a, 0xc4, 0x6d, 0xd4, 0x8a, 0x17, 0xac, 0x6e, 0xf8, 0x20,
+	0x4a, 0xbb, 0x81, 0x7d, 0x27, 0xa3, 0xa8, 0x4c, 0xb5, 0x63, 0xfa, 0x1d, 0xe4, 0xc2, 0x24, 0x0b,
+	0x2a, 0x98, 0xc4, 0xd2, 0x67, 0x04, 0xb3, 0xc1, 0xe2, 0x85, 0x61, 0x36, 0xf6, 0x17, 0x06, 0x1c,
+	0x9b, 0x86, 0x1f, 0x8a, 0x87, 0xb0, 0xc1, 0x00, 0x42, 0x03, 0xa3, 0x0f, 0x7b, 0x3b, 0x9d, 0xb9,
+	0xbf, 0x93, 0x60, 0x32, 0x6e, 0xbe, 0xe8, 0xcb, 0x90, 0xf3, 0x5e, 0xee, 0x68, 0x2c, 0x29, 0xb1,
+	0x58, 0xf2, 0xca, 0x51, 0xf5, 0x75, 0x72, 0x51, 0xbe, 0x50, 0x3e, 0xff, 0xc1, 0x13, 0x6d, 0xf9,
+	0x9b, 0x6f, 0x2c, 0xbf, 0xf5, 0x74, 0xe9, 0xf6, 0xcd, 0x27, 0xcb, 0x4f, 0x6f, 0x8b, 0x7f, 0x2f,
+	0x7f, 0x54, 0xbe, 0xfa, 0xe2, 0xc2, 0xb7, 0x3e, 0xb8, 0xa0, 0x64, 0x3d, 0xe6, 0x4d, 0x03, 0xdd,
+	0x84, 0x34, 0x33, 0xb5, 0x8e, 0x9c, 0x38, 0x46, 0x5c, 0xf8, 0xe8, 0xcc, 0x8e, 0x3a, 0x0a, 0xe7,
+	0x40, 0x0b, 0x30, 0xca, 0xde, 0x36, 0x9e, 0x73, 0x47, 0x23, 0xc9, 0xde, 0x34, 0x72, 0xb4, 0xed,
+	0x3d, 0xea, 0x4c, 0xcc, 0xfd, 0x79, 0x02, 0xc6, 0x23, 0x4b, 0x46, 0xf7, 0x21, 0xad, 0xe9, 0x54,
+	0xd5, 0xd8, 0xc4, 0xf3, 0xe5, 0xab, 0xb1, 0x43, 0x46, 0xf8, 0x2a, 0x8c, 0x27, 0x24, 0x21, 0x1c,
+	0x05, 0x7d, 0x15, 0xb2, 0xec, 0x68, 0x70, 0xdb, 0xe4, 0x0f, 0x4f, 0x6f, 0xf3, 0x87, 0xa7, 0x1b,
+	0xa7, 0x7d, 0x78, 0x5a, 0x7f, 0xb8, 0xf9, 0xf9, 0x37, 0x95, 0x0c, 0x85, 0x5b, 0x6f, 0x9b, 0xe8,
+	0x11, 0xd0, 0x17, 0x28, 0x06, 0x9c, 0x7c, 0x15, 0xc0, 0x69, 0x03, 0x1f, 0xac, 0xb7, 0xcd, 0xd2,
+	0x3f, 0x27, 0x61, 0x8a, 0x4a, 0x08, 0x36, 0x7a, 0x04, 0x04, 0x55, 0x21, 0xad, 0x35, 0x9e, 0x6b,
+	0x1d, 0x91, 0x24, 0x59, 0x8a, 0xdd, 0x1b, 0x3f, 0x53, 0x52, 0x61, 0xb4, 0xf7, 0x6c, 0x03, 0xdf,
+	0x19, 0x52, 0x38, 0x27, 0x5a, 0x87, 0x8c, 0xd1, 0xb1, 0xb4, 0xa6, 0xa9, 0xf3, 0x33, 0xbd, 0x7c,
+	0x3c, 0xc8, 0x9a, 0x47, 0xcc, 0x51, 0x04, 0x2f, 0xda, 0x85, 0xa9, 0xe0, 0x81, 0x98, 0xbe, 0x5f,
+	0xb9, 0xc4, 0x6e, 0x34, 0xb0, 0xc1, 0x43, 0xc0, 0xab, 0xc7, 0x83, 0xd6, 0x7c, 0x7a, 0x8e, 0x3b,
+	0x81, 0xa3, 0x5d, 0xe8, 0x3c, 0x64, 0x76, 0x35, 0x7d, 0xdf, 0xde, 0xdb, 0x93, 0x53, 0x61, 0xdb,
+	0x72, 0x5b, 0x11, 0xed, 0x31, 0x76, 0x6d, 0xf8, 0x37, 0xb5, 0x6b, 0x0f, 0x60, 0xdc, 0xf1, 0xf4,
+	0x52, 0x0d, 0x94, 0x27, 0xcd, 0x94, 0xe7, 0xf5, 0xa3, 0xea, 0x05, 0x52, 0x92, 0x2f, 0x94, 0xe7,
+	0x8f, 0x57, 0x1e, 0xa5, 0xc0, 0x11, 0xd6, 0xb8, 0x02, 0x55, 0x47, 0x20, 0xd5, 0xb4, 0x0d, 0x8c,
+	0x92, 0xff, 0x55, 0x95, 0x4a, 0x7f, 0x21, 0x41, 0x21, 0x7a, 0xa4, 0x19, 0xce, 0xc3, 0xcf, 0xf4,
+	0xd2, 0x60, 0xc6, 0x82, 0x9e, 0x05, 0x67, 0x44, 0xb7, 0x21, 0xed, 0x30, 0x79, 0xe1, 0x27, 0x7a,
+	0x31, 0x0e, 0x22, 0x22, 0x4d, 0x54, 0x26, 0x3c, 0xb6, 0x6a, 0xda, 0x9b, 0x65, 0xe9, 0x2f, 0x67,
+	0x20, 0x4b, 0xb3, 0x11, 0xae, 0xe6, 0x62, 0xf4, 0x18, 0x90, 0xde, 0x26, 0x04, 0x53, 0x6f, 0xdc,
+	0x67, 0xe2, 0x93, 0x3c, 0x77, 0x6c, 0x26, 0xbd, 0x37, 0xed, 0xc1, 0x61, 0x42, 0xab, 0x7e, 0x0c,
+	0x88, 0xdf, 0x5c, 0x61, 0xec, 0xc4, 0x6f, 0x80, 0x2d, 0xbc, 0x91, 0xf0, 0x8e, 0x8e, 0x0a, 0xa9,
+	0xa4, 0x97, 0x34, 0xbf, 0x5f, 0xa6, 0x7a, 0x51, 0xbd, 0xec, 0x57, 0x60, 0x2f, 0x46, 0x3c, 0x26,
+	0xd6, 0x1c, 0x97, 0x39, 0x4c, 0xbd, 0x44, 0xe6, 0xf0, 0x29, 0xcc, 0xf9, 0xcf, 0xbc, 0x26, 0x69,
+	0x62, 0x23, 0xf0, 0x3c, 0x34, 0x91, 0x45, 0x38, 0xee, 0x19, 0x37, 0xc5, 0x9e, 0x70, 0x67, 0xc4,
+	0x73, 0x30, 0x83, 0x10, 0xae, 0x47, 0xc5, 0x45, 0x37, 0x40, 0x66, 0xf0, 0xd4, 0x1a, 0xf1, 0x28,
+	0xca, 0x7f, 0xc7, 0xf6, 0x9e, 0x9d, 0x27, 0x68, 0xff, 0x1a, 0x3e, 0x78, 0xc0, 0x7a, 0xf9, 0x83,
+	0x76, 0xdf, 0x14, 0x51, 0xe6, 0x25, 0x53, 0x44, 0x18, 0xce, 0xb6, 0xb0, 0xc5, 0xae, 0xe0, 0xb8,
+	0x17, 0x66, 0x39, 0x1b, 0x8f, 0x1f, 0xfb, 0xc0, 0xcc, 0x81, 0x62, 0xfa, 0xd0, 0x3a, 0x14, 0xf9,
+	0x3b, 0x36, 0xc1, 0x4e, 0xcb, 0xb6, 0x1c, 0x2c, 0xde, 0xae, 0xe3, 0x4e, 0xa9, 0x66, 0x37, 0x9b,
+	0x9a, 0x65, 0x28, 0x05, 0x8f, 0x47, 0x11, 0x2c, 0x14, 0x46, 0xcc, 0x96, 0x5f, 0xdf, 0x5e, 0x6e,
+	0xe1, 0x04, 0x18, 0xce, 0xc3, 0x2f, 0x21, 0x07, 0x7d, 0x05, 0x10, 0x9f, 0x0d, 0xbb, 0x6e, 0x34,
+	0x5d, 0xc7, 0x2d, 0x57, 0x1e, 0x89, 0x5f, 0xaa, 0x50, 0x32, 0x76, 0xe5, 0x57, 0x18, 0xa9, 0xc2,
+	0x17, 0x13, 0xb4, 0xa0, 0x47, 0x30, 0x29, 0x66, 0x16, 0xf6, 0x2e, 0x78, 0x9a, 0xe1, 0xc2, 0xb1,
+	0xa0, 0x7c, 0x5e, 0x0a, 0xe2, 0x08, 0xa1, 0x36, 0xf4, 0x06, 0xcd, 0x22, 0xa9, 0xcf, 0x4d, 0xcb,
+	0xb0, 0x9f, 0x3b, 0xaa, 0x76, 0xa0, 0x99, 0x0d, 0x9a, 0xa7, 0x67, 0xf9, 0x87, 0xac, 0x82, 0xc8,
+	0xe1, 0x7b, 0x5e, 0x57, 0x45, 0xf4, 0xa0, 0x35, 0xc8, 0x13, 0xac, 0x63, 0x26, 0x52, 0x5e, 0x91,
+	0x40, 0x7e, 0x31, 0x19, 0xa7, 0xab, 0x9e, 0x3b, 0xc2, 0xf3, 0xa1, 0xca, 0x98, 0xc7, 0xe4, 0x35,
+	0x3a, 0x68, 0x0b, 0x8a, 0x1c, 0x25, 0x28, 0x36, 0x28, 0x2c, 0x26, 0xe3, 0x1c, 0x5d, 0x71, 0xc8,
+	0x02, 0xa9, 0xe0, 0x31, 0x8a, 0x66, 0x07, 0x35, 0xa0, 0xc4, 0x64, 0x9e, 0xdf, 0xae, 0xaa, 0x69,
+	0x99, 0xae, 0xa9, 0xb9, 0x3d, 0xaa, 0x55, 0x1c, 0x50, 0xb5, 0xe6, 0x59, 0x01, 0x87, 0x07, 0xb5,
+	0x29, 0x90, 0x42, 0x1a, 0x46, 0x60, 0x9e, 0xe0, 0x0f, 0xb1, 0xee, 0xf2, 0xa8, 0xb0, 0x27, 0x42,
+	0xc3, 0x8e, 0x3c, 0xbe, 0x98, 0x3c, 0xf9, 0x0d, 0xa5, 0x70, 0x54, 0x1d, 0xfd, 0x44, 0xca, 0x15,
+	0x0b, 0x25, 0x6e, 0x28, 0xe6, 0x04, 0x6a, 0xa5, 0xa7, 0x0e, 0x01, 0x3b, 0x68, 0x1b, 0xce, 0x75,
+	0x8d, 0xd9, 0x5d, 0x92, 0x80, 0x1d, 0x19, 0x2d, 0x26, 0x97, 0xc6, 0xaa, 0xf9, 0xa3, 0xea, 0xc8,
+	0x27, 0x52, 0xb6, 0x58, 0x28, 0x79, 0xb5, 0x06, 0xb3, 0x21, 0xc8, 0x70, 0x8d, 0x02, 0x76, 0x50,
+	0x05, 0x26, 0x7d, 0xc4, 0x70, 0x26, 0x6d, 0x82, 0x65, 0xd2, 0x38, 0x50, 0x49, 0xbc, 0x4b, 0x4d,
+	0x08, 0xda, 0x70, 0x2a, 0x6d, 0x0b, 0x8a, 0x9e, 0xa9, 0x09, 0x6d, 0xf2, 0xe4, 0x80, 0x9b, 0x9c,
+	0x67, 0x46, 0x28, 0xd8, 0x54, 0x1b, 0xfc, 0xb9, 0x86, 0x36, 0x94, 0x68, 0x56, 0x1d, 0x3b, 0xf2,
+	0x14, 0x93, 0x8b, 0x37, 0xfb, 0xca, 0xb8, 0xc2, 0x39, 0xc5, 0xae, 0x29, 0x8c, 0x6d, 0xdd, 0x72,
+	0x49, 0x87, 0xbd, 0x71, 0xc7, 0x74, 0xfa, 0x76, 0x92, 0xee, 0x26, 0x75, 0x17, 0xea, 0x38, 0xb0,
+	0x93, 0xd3, 0x81, 0x9d, 0xac, 0x18, 0xa4, 0xc6, 0x7a, 0xb9, 0x9d, 0x7c, 0x0a, 0xd3, 0x81, 0x81,
+	0xa0, 0x99, 0x00, 0xdf, 0x90, 0x79, 0x79, 0x87, 0xd7, 0x8f, 0x73, 0x8a, 0x7d, 0x4b, 0x86, 0xbf,
+	0xa1, 0x4c, 0xfa, 0x36, 0xa3, 0xa1, 0x75, 0x44, 0xc7, 0xdc, 0x7f, 0x4b, 0x30, 0x12, 0xd6, 0xce,
+	0x87, 0xe0, 0xbf, 0x08, 0x06, 0x4f, 0x2c, 0x69, 0xbe, 0xc7, 0xbd, 0xe2, 0x75, 0x57, 0xbc, 0x1c,
+	0xf4, 0xdc, 0x8b, 0x45, 0x01, 0x21, 0xfa, 0xd1, 0xef, 0x42, 0x96, 0x1c, 0xf2, 0x04, 0x58, 0x66,
+	0xd0, 0x32, 0x91, 0x0c, 0xf1, 0x9a, 0xd0, 0x2a, 0x64, 0xf4, 0x3d, 0xb5, 0x61, 0x3a, 0xa2, 0xea,
+	0x68, 0x3a, 0x72, 0x9f, 0x6e, 0xdc, 0x35, 0x1d, 0x57, 0x49, 0xeb, 0x7b, 0xf4, 0xaf, 0x57, 0xfd,
+	0x13, 0x7e, 0xf3, 0xdb, 0x4a, 0x65, 0x53, 0xc5, 0xe1, 0xad, 0x54, 0x76, 0xb8, 0x98, 0xde, 0x4a,
+	0x65, 0x73, 0x45, 0xd8, 0x4a, 0x65, 0xa1, 0x38, 0x32, 0xf7, 0x83, 0x24, 0x40, 0xc8, 0xe8, 0xbd,
+	0x06, 0x99, 0x96, 0xf7, 0x8e, 0xc2, 0x7c, 0x8d, 0x51, 0xf6, 0x54, 0xfa, 0xcd, 0x54, 0x71, 0x5c,
+	0x3e, 0xaf, 0x88, 0x1e, 0xb4, 0x06, 0x19, 0x61, 0x0c, 0x13, 0x83, 0x1b, 0xc3, 0x6a, 0x8a, 0xed,
+	0x8d, 0x60, 0x45, 0x6f, 0x0f, 0x5e, 0x37, 0xd6, 0xbd, 0xbb, 0x8c, 0x0d, 0xdd, 0x80, 0x82, 0x6e,
+	0x13, 0x2a, 0x12, 0xec, 0x7a, 0x33, 0x0d, 0x2f, 0xd6, 0xcc, 0x51, 0xe2, 0xdc, 0x27, 0x52, 0xba,
+	0x44, 0x1f, 0xef, 0x0c, 0x25, 0x1f, 0x22, 0xda, 0x34, 0x9c, 0xae, 0x4a, 0xb8, 0xe1, 0x57, 0x5c,
+	0x09, 0xb7, 0x03, 0x69, 0x0b, 0xbb, 0xc2, 0x71, 0x7d, 0x89, 0x88, 0xe4, 0x3e, 0x76, 0x37, 0xd7,
+	0x94, 0x61, 0x0b, 0xbb, 0x9b, 0xc6, 0xdc, 0x8f, 0x25, 0x18, 0xeb, 0x52, 0xa5, 0x7e, 0x0f, 0xd4,
+	0xd2, 0x67, 0xf4, 0x40, 0x9d, 0x78, 0xc9, 0x07, 0xea, 0xb9, 0xc7, 0x90, 0xef, 0xb1, 0x05, 0x77,
+	0x20, 0xcd, 0x2d, 0x8d, 0xb4, 0x98, 0x8c, 0x73, 0xbb, 0x7d, 0x01, 0xea, 0x62, 0x0c, 0x15, 0x87,
+	0x70, 0xfe, 0x39, 0x02, 0x67, 0x8e, 0x31, 0x46, 0xa8, 0x08, 0xc9, 0x7d, 0xcc, 0x0b, 0x06, 0x14,
+	0xfa, 0x89, 0xde, 0x16, 0x55, 0x70, 0x89, 0x78, 0xf3, 0x11, 0x3f, 0xb2, 0x23, 0xca, 0xe5, 0x12,
+	0x5f, 0x90, 0x4a, 0x3f, 0x93, 0xe0, 0x4c, 0x10, 0xe6, 0xb5, 0xdd, 0x67, 0xd8, 0x72, 0xb9, 0x7b,
+	0x54, 0xa3, 0xe1, 0xc6, 0x72, 0xb8, 0xd0, 0x2e, 0x57, 0x9d, 0x39, 0xaa, 0x4e, 0x12, 0x54, 0x2e,
+	0x7e, 0xf0, 0xa4, 0xb2, 0xfc, 0x98, 0x86, 0x2b, 0x1f, 0x5d, 0xbb, 0x7a, 0xbd, 0xfc, 0xe2, 0x02,
+	0x87, 0x44, 0xb7, 0x01, 0x58, 0x61, 0xae, 0xba, 0x47, 0xec, 0xa6, 0x9c, 0x18, 0xd0, 0x9e, 0xe7,
+	0x18, 0xcf, 0x06, 0xb1, 0x9b, 0xe8, 0x16, 0x64, 0x3d, 0x00, 0xd7, 0x96, 0x93, 0x03, 0xb2, 0x67,
+	0x18, 0xc7, 0x8e, 0x5d, 0xfa, 0xfb, 0x73, 0x90, 0xf3, 0x17, 0x83, 0xee, 0x84, 0xab, 0x00, 0x2e,
+	0xf4, 0xad, 0x02, 0x18, 0xe0, 0xf9, 0xbf, 0x06, 0xa0, 0x13, 0xac, 0xf1, 0x62, 0xc9, 0xc4, 0x69,
+	0x8a, 0x25, 0x39, 0x5f, 0xc5, 0xa5, 0x20, 0xed, 0x96, 0x21, 0x40, 0x92, 0xa7, 0x01, 0xe1, 0x7c,
+	0x15, 0x17, 0x9d, 0x81, 0x94, 0xa5, 0x35, 0x71, 0xf7, 0xb3, 0x7e, 0x59, 0x61, 0x8d, 0xe8, 0x0a,
+	0x8c, 0x18, 0xd8, 0xd1, 0x89, 0xd9, 0x62, 0x59, 0x8f, 0x61, 0x46, 0x43, 0xc5, 0x8c, 0x24, 0xe5,
+	0x9f, 0x17, 0x94, 0x70, 0x27, 0xfa, 0x58, 0x02, 0xd0, 0x5c, 0x97, 0x98, 0xbb, 0x6d, 0x17, 0xd3,
+	0x5b, 0x21, 0x19, 0x17, 0xc0, 0xfb, 0x9b, 0xb4, 0x52, 0xf1, 0x69, 0x99, 0x30, 0x56, 0x6f, 0x1c,
+	0x55, 0xcb, 0xdf, 0x97, 0x56, 0x8b, 0x50, 0x1a, 0x28, 0x9e, 0xbd, 0x42, 0xe7, 0xf0, 0x53, 0x49,
+	0x09, 0x8d, 0x89, 0xee, 0xc2, 0x08, 0x0f, 0x89, 0x98, 0xc5, 0xcb, 0x9c, 0xba, 0x5a, 0x43, 0x81,
+	0x03, 0xd1, 0x46, 0x6d, 0x28, 0x62, 0x51, 0xac, 0x8e, 0xd5, 0x16, 0xb1, 0xf7, 0xcc, 0x06, 0x8b,
+	0xba, 0xb3, 0xdd, 0xe5, 0x0f, 0x45, 0x4e, 0xb2, 0xed, 0x51, 0x6c, 0x1a, 0xe8, 0x1f, 0x25, 0x98,
+	0x16, 0x9e, 0x1f, 0xed, 0xc4, 0x84, 0xd9, 0x53, 0xec, 0x38, 0xec, 0xa1, 0x31, 0x57, 0xfd, 0x33,
+	0xe9, 0xa8, 0xfa, 0x1d, 0x89, 0xfc, 0xb1, 0x54, 0xfe, 0x03, 0xe9, 0x83, 0xa5, 0xdb, 0x37, 0xe9,
+	0xf2, 0xb4, 0xe5, 0x6f, 0x72, 0xf1, 0xff, 0x56, 0xe8, 0x3b, 0xf8, 0x7c, 0x7f, 0xf9, 0xe9, 0x95,
+	0x50, 0xc7, 0xe5, 0xf7, 0x57, 0x2e, 0x5f, 0xa1, 0x7c, 0x95, 0xe5, 0xc7, 0x7c, 0x57, 0xbe, 0x15,
+	0xfa, 0x0e, 0x3e, 0x19, 0x5f, 0xd0, 0x71, 0x79, 0xe9, 0xf6, 0xcd, 0x9b, 0x4f, 0xb8, 0x96, 0xdd,
+	0x78, 0x71, 0xf9, 0x36, 0xcb, 0xa9, 0x4d, 0xf2, 0xe9, 0xb2, 0x50, 0x9c, 0x54, 0xbc, 0xc9, 0xa2,
+	0x0a, 0xc8, 0x3d, 0xcb, 0xd8, 0xc7, 0xfb, 0x6a, 0x43, 0xdb, 0xc5, 0x0d, 0x79, 0x35, 0x2c, 0x08,
+	0x1f, 0x17, 0x95, 0xa9, 0x2e, 0x84, 0x77, 0xf0, 0xfe, 0x5d, 0x4a, 0x86, 0xfe, 0x49, 0x82, 0xb9,
+	0x70, 0x94, 0xd5, 0xb3, 0x1d, 0xf0, 0xdb, 0xb9, 0x1d, 0x72, 0x68, 0xca, 0xdd, 0x5b, 0xb2, 0x09,
+	0x67, 0x63, 0x96, 0x13, 0x6c, 0xcb, 0x1b, 0x3d, 0xdb, 0x32, 0x1b, 0x41, 0xf2, 0xb7, 0xe6, 0x16,
+	0x4c, 0xc5, 0x40, 0x99, 0x86, 0x7c, 0x2d, 0x2c, 0x5f, 0x86, 0x32, 0x11, 0x81, 0xd8, 0x34, 0xd0,
+	0x8f, 0x25, 0x98, 0x60, 0x51, 0x57, 0xcf, 0x86, 0x8e, 0xfc, 0x76, 0x6e, 0xe8, 0x38, 0x9d, 0x6b,
+	0xf7, 0x4e, 0xba, 0x90, 0x6b, 0xd8, 0xde, 0xaa, 0x68, 0x9d, 0x4b, 0x32, 0x2e, 0x61, 0x18, 0x98,
+	0x8a, 0xbb, 0x82, 0xd4, 0xb3, 0x14, 0x57, 0x8f, 0xaa, 0x97, 0xbf, 0x2f, 0x5d, 0x1a, 0xcc, 0x4e,
+	0x28, 0xc1, 0x40, 0xe8, 0x1a, 0x64, 0xf8, 0xef, 0x04, 0xe4, 0x32, 0xb3, 0x0d, 0x33, 0xd1, 0x3c,
+	0x02, 0xeb, 0x56, 0x04, 0x5d, 0x6c, 0xad, 0xd2, 0xd8, 0xc0, 0xb5, 0x4a, 0xf9, 0x3e, 0xb5, 0x4a,
+	0x91, 0x0c, 0x4e, 0xe1, 0xd5, 0xd7, 0x7e, 0x15, 0x3f, 0x83, 0xda, 0xaf, 0xf1, 0x13, 0x6a, 0xbf,
+	0x22, 0x65, 0x53, 0x68, 0x90, 0xb2, 0xa9, 0x89, 0x41, 0xca, 0xa6, 0x26, 0x07, 0x2e, 0x9b, 0x9a,
+	0xea, 0x53, 0x36, 0x75, 0x03, 0x72, 0xc4, 0xb6, 0x5d, 0x95, 0xf9, 0xd5, 0xde, 0xeb, 0xaf, 0x1c,
+	0x09, 0x33, 0x6c, 0xdb, 0xa5, 0x4e, 0xb5, 0x92, 0x25, 0xfc, 0x0b, 0x6d, 0xfb, 0x7e, 0xeb, 0x0c,
+	0xf3, 0x5b, 0xdf, 0x7a, 0x59, 0x9f, 0x15, 0x7d, 0x11, 0x46, 0xbb, 0x6a, 0xd4, 0xe4, 0x93, 0x6b,
+	0xd4, 0x46, 0x9a, 0xa1, 0xe2, 0xb4, 0x1b, 0x90, 0x63, 0xfc, 0xae, 0xe6, 0x62, 0x79, 0x36, 0x7e,
+	0x21, 0xc2, 0x51, 0x53, 0xb2, 0x94, 0x93, 0x7e, 0xa1, 0x35, 0x18, 0x17, 0xb1, 0x62, 0xc0, 0x7e,
+	0xf5, 0x04, 0x76, 0x91, 0x4b, 0xba, 0x27, 0x50, 0xae, 0xd1, 0xa4, 0x30, 0x0b, 0x3e, 0xe4, 0xb9,
+	0x78, 0x1d, 0xe2, 0xb1, 0x89, 0x22, 0xe8, 0xd0, 0x97, 0x40, 0xa0, 0xa8, 0x82, 0xf5, 0xcc, 0xf1,
+	0xac, 0x79, 0x4e, 0xcf, 0xff, 0x47, 0x17, 0x20, 0xef, 0x67, 0x11, 0xd9, 0x29, 0xb3, 0x07, 0xe1,
+	0x31, 0x65, 0x94, 0xe7, 0x0e, 0xd9, 0x09, 0xa3, 0x4b, 0x50, 0x68, 0x3b, 0xd8, 0x08, 0xa8, 0x1c,
+	0xf9, 0x1c, 0xcd, 0x43, 0x28, 0x63, 0xb4, 0x59, 0x90, 0xd1, 0x9f, 0x39, 0x14, 0x18, 0x5a, 0x20,
+	0x34, 0xf2, 0x7c, 0xf0, 0x0b, 0x18, 0x5f, 0x62, 0xd0, 0x45, 0x4e, 0x47, 0x3e, 0xe4, 0x95, 0x1f,
+	0x6f, 0xc8, 0x0b, 0xc1, 0xb0, 0xca, 0x87, 0xac, 0xa2, 0xe3, 0x8d, 0x28, 0xd9, 0x35, 0x79, 0x31,
+	0x42, 0x76, 0x0d, 0x7d, 0x00, 0x67, 0x7a, 0x33, 0xa1, 0x34, 0x71, 0x64, 0x1e, 0x78, 0xee, 0xdb,
+	0xf9, 0xd3, 0x64, 0x5a, 0xfd, 0x74, 0xa9, 0xc2, 0x11, 0x2a, 0x2e, 0x5a, 0x87, 0x11, 0x2f, 0x07,
+	0xe3, 0x1d, 0x6c, 0xa9, 0x8f, 0xfa, 0x53, 0x12, 0x76, 0x92, 0x21, 0xf5, 0x87, 0x96, 0xdf, 0x8a,
+	0x9e, 0x00, 0xda, 0x65, 0x05, 0x80, 0x1d, 0x9a, 0x77, 0xd5, 0xb1, 0xe5, 0x6a, 0x75, 0x2c, 0xbf,
+	0x76, 0x62, 0x1d, 0x0f, 0x4b, 0x20, 0x01, 0x9c, 0x1b, 0x1a, 0xfa, 0xf8, 0xf6, 0xf2, 0xd0, 0xd0,
+	0xd0, 0x90, 0x32, 0xce, 0x71, 0xb6, 0x7d, 0x18, 0xf4, 0x3a, 0x14, 0xfc, 0xfc, 0x01, 0xaf, 0x10,
+	0xba, 0xb0, 0x28, 0x2d, 0x0d, 0x2b, 0x79, 0xd1, 0xcc, 0x2b, 0x81, 0x4e, 0xfa, 0x1d, 0xd0, 0xd2,
+	0x2b, 0xf9, 0x1d, 0x10, 0xba, 0x03, 0x10, 0xaa, 0xa0, 0xbc, 0x7c, 0xba, 0x0a, 0x4a, 0x25, 0xc4,
+	0x8b, 0xbe, 0x02, 0xf9, 0x16, 0xb1, 0x0f, 0x4c, 0x2a, 0xae, 0xde, 0x45, 0x7e, 0xe5, 0xd4, 0x6f,
+	0x9b, 0x63, 0x21, 0x84, 0x4d, 0x83, 0xe9, 0xab, 0x68, 0x60, 0x2f, 0x3f, 0x9a, 0xab, 0xc9, 0x9f,
+	0xe3, 0x8a, 0xd3, 0x7b, 0x10, 0x0f, 0xd8, 0xcf, 0xfc, 0x94, 0x62, 0x98, 0x83, 0xc6, 0x68, 0xe8,
+	0x2c, 0xe4, 0x9a, 0xed, 0x06, 0x8d, 0xc0, 0x1c, 0x57, 0x5e, 0x66, 0xa6, 0x31, 0x68, 0x40, 0x75,
+	0x98, 0xd5, 0x1b, 0x9a, 0xd9, 0x54, 0xb5, 0xae, 0x40, 0x4d, 0xd5, 0x6d, 0x03, 0xcb, 0x2b, 0x27,
+	0xf8, 0xcf, 0xd1, 0xe0, 0x8e, 0x15, 0x1f, 0x98, 0xcd, 0x68, 0x07, 0x5a, 0x81, 0x09, 0x67, 0xdf,
+	0x6c, 0xa9, 0x3c, 0x4b, 0xa2, 0xea, 0xa4, 0xd3, 0x72, 0x6d, 0xf9, 0x3a, 0x9b, 0xd0, 0x38, 0xed,
+	0xe2, 0xfb, 0x5b, 0x63, 0x1d, 0xe8, 0x09, 0x9c, 0x8d, 0xa1, 0x57, 0xed, 0x03, 0x4c, 0x88, 0x69,
+	0x60, 0xf9, 0xcd, 0x3e, 0xea, 0x12, 0xd4, 0x86, 0xcd, 0x46, 0x40, 0xdf, 0xe5, 0xcc, 0xa8, 0x06,
+	0xa3, 0xf4, 0x05, 0xf6, 0x40, 0x84, 0x4e, 0x37, 0x06, 0xd4, 0xbd, 0x11, 0x9f, 0xab, 0xe2, 0xce,
+	0xbd, 0x0d, 0x85, 0x9e, 0x10, 0x26, 0x1c, 0x4f, 0xe7, 0xbc, 0x78, 0x7a, 0x32, 0x1c, 0x4f, 0xe7,
+	0x42, 0x61, 0xf2, 0xdc, 0x23, 0xc8, 0x77, 0xbb, 0x35, 0x31, 0xdc, 0x2b, 0xdd, 0xd1, 0x78, 0xc4,
+	0x4a, 0x0b, 0x80, 0x10, 0xee, 0x56, 0x2a, 0x7b, 0xb1, 0x78, 0x69, 0x2b, 0x95, 0xbd, 0x54, 0x7c,
+	0x7d, 0x2b, 0x95, 0x7d, 0xbd, 0xb8, 0x54, 0xba, 0x03, 0xe0, 0x1f, 0x99, 0x83, 0x6e, 0xc2, 0x48,
+	0xf0, 0x38, 0x2a, 0x32, 0x0c, 0xb3, 0x7d, 0xcf, 0x58, 0x01, 0xff, 0xf5, 0xd3, 0x29, 0xfd, 0x1e,
+	0x8c, 0xf1, 0xb4, 0xce, 0x36, 0xc1, 0x7b, 0x26, 0xcd, 0x89, 0x04, 0xf9, 0x22, 0x2f, 0x23, 0x76,
+	0xeb, 0x95, 0xe4, 0x8a, 0xa6, 0x21, 0xdd, 0xc0, 0x56, 0xdd, 0x7d, 0xe6, 0x15, 0x6e, 0x28, 0xfc,
+	0xbf, 0x92, 0x01, 0xd3, 0x35, 0x16, 0xfe, 0x06, 0xf3, 0xe3, 0xf9, 0xb2, 0x2d, 0x80, 0x60, 0x59,
+	0x7e, 0x5d, 0x72, 0xbf, 0x55, 0xc5, 0x84, 0xe5, 0x39, 0x7f, 0x9d, 0xa5, 0x1f, 0x48, 0x30, 0xfd,
+	0x90, 0x05, 0xc8, 0x9f, 0xe5, 0x30, 0xe8, 0x2d, 0x80, 0xe0, 0xe7, 0xbb, 0x7d, 0x73, 0x00, 0x1b,
+	0x94, 0xe4, 0x9e, 0xe6, 0xec, 0x2b, 0xb9, 0x3d, 0xf1, 0x59, 0xfa, 0x6b, 0x09, 0x26, 0xbe, 0x8c,
+	0xdd, 0xc8, 0xf4, 0xde, 0x87, 0x7c, 0x30, 0x3d, 0xf5, 0xe5, 0x73, 0x15, 0xa3, 0x38, 0xa0, 0x73,
+	0x5e, 0x66, 0xc2, 0xff, 0x2a, 0xc1, 0xc5, 0xf0, 0x84, 0x43, 0xc3, 0x6e, 0xd8, 0x64, 0xfd, 0xe1,
+	0xa6, 0x23, 0x96, 0x10, 0xae, 0x89, 0x90, 0x3e, 0xab, 0x9a, 0x88, 0xc4, 0xab, 0xac, 0x89, 0x38,
+	0x4a, 0xc0, 0x14, 0xcd, 0x2b, 0x07, 0x4a, 0x26, 0xd6, 0xf2, 0x2e, 0x14, 0xc2, 0xd7, 0x57, 0x70,
+	0x1e, 0x97, 0x8e, 0xb9, 0xb8, 0xc2, 0xe9, 0x88, 0xbc, 0x16, 0x6e, 0x7f, 0x99, 0x13, 0x40, 0x3f,
+	0x94, 0x60, 0xd8, 0x26, 0x06, 0x26, 0x2c, 0x51, 0x94, 0xab, 0xfe, 0x89, 0x74, 0x54, 0xfd, 0x43,
+	0x89, 0x7c, 0x5b, 0x52, 0x86, 0x94, 0xa0, 0x22, 0x47, 0x81, 0xe5, 0xe0, 0xdb, 0x3f, 0x08, 0x25,
+	0xb7, 0xec, 0x7f, 0x8a, 0x3d, 0x54, 0xb2, 0xcb, 0xe2, 0x8b, 0xe5, 0x8a, 0x94, 0xe1, 0x65, 0xf6,
+	0x27, 0x9c, 0x13, 0x52, 0x46, 0x97, 0xc3, 0xff, 0x85, 0x52, 0x5e, 0xca, 0xc8, 0x72, 0xe8, 0x1f,
+	0x6f, 0x62, 0x68, 0x1e, 0x86, 0xbd, 0x9f, 0x9d, 0x7a, 0x15, 0x15, 0xd4, 0x3f, 0xb9, 0x92, 0x94,
+	0x7f, 0x95, 0x51, 0xbc, 0x66, 0x84, 0x20, 0xd5, 0xa2, 0xce, 0x88, 0xf7, 0xc3, 0x66, 0xf6, 0x5d,
+	0xfa, 0x9e, 0x04, 0x13, 0x0f, 0x62, 0x34, 0x61, 0xe3, 0x74, 0x8a, 0xda, 0x9d, 0x43, 0x7f, 0x35,
+	0x4a, 0xfa, 0x37, 0x12, 0xcd, 0xbe, 0x3a, 0xd8, 0xad, 0x58, 0xc6, 0xff, 0x2b, 0x65, 0xfd, 0x89,
+	0x04, 0xe3, 0xfe, 0x98, 0x3b, 0xb8, 0xd9, 0x6a, 0x50, 0xc7, 0xf0, 0xff, 0x7e, 0x47, 0xd1, 0x12,
+	0x8c, 0x34, 0xb5, 0x16, 0x2b, 0x0c, 0xa0, 0x37, 0x65, 0xb2, 0x3b, 0x55, 0x02, 0xbc, 0xef, 0x1d,
+	0xdc, 0x29, 0xfd, 0xad, 0x04, 0x33, 0x91, 0x25, 0x78, 0xae, 0x9b, 0x9f, 0xf1, 0x94, 0xba, 0xd9,
+	0x63, 0x33, 0x9e, 0x89, 0x70, 0x46, 0xe7, 0xa7, 0x52, 0x77, 0xc6, 0x73, 0x07, 0x0a, 0x2c, 0x2b,
+	0x88, 0x0f, 0x5d, 0x6c, 0x39, 0x2c, 0x95, 0x91, 0x64, 0x8f, 0x2c, 0x9f, 0x3b, 0xaa, 0x2e, 0x7d,
+	0x22, 0x5d, 0x2c, 0x1a, 0xb2, 0x54, 0x5a, 0x20, 0xe7, 0xca, 0x67, 0x68, 0x1a, 0xe6, 0xfd, 0x15,
+	0xe1, 0xf1, 0x7d, 0x74, 0xed, 0xea, 0xb5, 0xcf, 0xbf, 0xb8, 0xfc, 0xd1, 0xb5, 0xab, 0x34, 0xcf,
+	0x9d, 0xa7, 0x18, 0xeb, 0x3e, 0x44, 0xe9, 0x7f, 0x24, 0x90, 0xfb, 0x4c, 0xdd, 0x41, 0x2f, 0x20,
+	0xe3, 0x39, 0x9d, 0xe2, 0xe6, 0xbe, 0xd1, 0xf7, 0x04, 0x7a, 0x58, 0x57, 0xf8, 0xdf, 0xdf, 0x24,
+	0x85, 0x22, 0xc6, 0x9c, 0xd3, 0x61, 0x34, 0x0c, 0x13, 0xe3, 0xb2, 0x9c, 0xf4, 0x80, 0xd0, 0x67,
+	0x7a, 0xe1, 0x07, 0x84, 0x8f, 0x60, 0xa1, 0x66, 0x5b, 0x07, 0x98, 0xb8, 0x11, 0x62, 0xa1, 0x3a,
+	0x6b, 0x90, 0xf3, 0xa6, 0x14, 0x14, 0x11, 0x0e, 0x5c, 0x07, 0x95, 0xf5, 0x38, 0x37, 0x0d, 0x6a,
+	0x4f, 0x98, 0x4f, 0xcd, 0x6e, 0x03, 0x85, 0x7d, 0x5f, 0x79, 0x08, 0x10, 0x84, 0x48, 0x68, 0x1c,
+	0xc6, 0xb6, 0xdf, 0x7d, 0x6f, 0x5d, 0x51, 0x1f, 0xde, 0x7f, 0xe7, 0xfe, 0xbb, 0xef, 0xdd, 0x2f,
+	0x0e, 0x05, 0x4d, 0xd5, 0xca, 0xce, 0xce, 0xba, 0xf2, 0xb5, 0xa2, 0x84, 0x10, 0xe4, 0xbd, 0xa6,
+	0xf5, 0xaf, 0xee, 0xac, 0x2b, 0xf7, 0x2b, 0x77, 0x8b, 0x89, 0xb9, 0xb1, 0x5f, 0xff, 0x68, 0x36,
+	0x27, 0x4b, 0x57, 0x86, 0x59, 0x4f, 0xf5, 0xde, 0xbf, 0xfc, 0xc7, 0xfc, 0xd0, 0xc7, 0x9f, 0xce,
+	0x4b, 0x7f, 0xf5, 0xe9, 0xbc, 0xf4, 0xab, 0x4f, 0xe7, 0x87, 0xfe, 0xf3, 0xd3, 0x79, 0xe9, 0xbb,
+	0xbf, 0x9c, 0x1f, 0xfa, 0xc9, 0x2f, 0xe7, 0xa5, 0xc7, 0xab, 0xa7, 0xb8, 0x80, 0x5c, 0xab, 0xb5,
+	0xbb, 0x9b, 0x66, 0x7a, 0x72, 0xfd, 0x7f, 0x07, 0x00, 0x98, 0x8b, 0xc4, 0xd2, 0x1b, 0x45, 0x00,
 	0x00,
 }
 
@@ -3072,6 +3511,9 @@ func (this *MACParameters) Equal(that interface{}) bool {
 	if !this.PingSlotDataRateIndexValue.Equal(that1.PingSlotDataRateIndexValue) {
 		return false
 	}
+	if !this.Relay.Equal(that1.Relay) {
+		return false
+	}
 	return true
 }
 func (this *MACParameters_Channel) Equal(that interface{}) bool {
@@ -3313,16 +3755,22 @@ func (this *MACSettings) Equal(that interface{}) bool {
 	} else if that1.ClassBCDownlinkInterval != nil {
 		return false
 	}
+	if !this.Relay.Equal(that1.Relay) {
+		return false
+	}
+	if !this.DesiredRelay.Equal(that1.DesiredRelay) {
+		return false
+	}
 	return true
 }
-func (this *MACState) Equal(that interface{}) bool {
+func (this *ServingRelayParameters) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACState)
+	that1, ok := that.(*ServingRelayParameters)
 	if !ok {
-		that2, ok := that.(MACState)
+		that2, ok := that.(ServingRelayParameters)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3334,36 +3782,331 @@ func (this *MACState) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.CurrentParameters.Equal(&that1.CurrentParameters) {
+	if !this.SecondChannel.Equal(that1.SecondChannel) {
 		return false
 	}
-	if !this.DesiredParameters.Equal(&that1.DesiredParameters) {
+	if this.DefaultChannelIndex != that1.DefaultChannelIndex {
 		return false
 	}
-	if this.DeviceClass != that1.DeviceClass {
+	if this.CadPeriodicity != that1.CadPeriodicity {
 		return false
 	}
-	if this.LorawanVersion != that1.LorawanVersion {
+	if len(this.UplinkForwardingRules) != len(that1.UplinkForwardingRules) {
 		return false
 	}
-	if that1.LastConfirmedDownlinkAt == nil {
-		if this.LastConfirmedDownlinkAt != nil {
+	for i := range this.UplinkForwardingRules {
+		if !this.UplinkForwardingRules[i].Equal(that1.UplinkForwardingRules[i]) {
 			return false
 		}
-	} else if !this.LastConfirmedDownlinkAt.Equal(*that1.LastConfirmedDownlinkAt) {
-		return false
-	}
-	if this.LastDevStatusFCntUp != that1.LastDevStatusFCntUp {
-		return false
 	}
-	if !this.PingSlotPeriodicity.Equal(that1.PingSlotPeriodicity) {
+	if len(this.JoinRequestFilters) != len(that1.JoinRequestFilters) {
 		return false
 	}
-	if !this.PendingApplicationDownlink.Equal(that1.PendingApplicationDownlink) {
-		return false
+	for i := range this.JoinRequestFilters {
+		if !this.JoinRequestFilters[i].Equal(that1.JoinRequestFilters[i]) {
+			return false
+		}
 	}
-	if len(this.QueuedResponses) != len(that1.QueuedResponses) {
-		return false
+	return true
+}
+func (this *ServingRelayParameters_UplinkForwardingRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServingRelayParameters_UplinkForwardingRule)
+	if !ok {
+		that2, ok := that.(ServingRelayParameters_UplinkForwardingRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DeviceId != that1.DeviceId {
+		return false
+	}
+	if !this.Limits.Equal(that1.Limits) {
+		return false
+	}
+	if this.LastWFCnt != that1.LastWFCnt {
+		return false
+	}
+	return true
+}
+func (this *ServingRelayParameters_JoinRequestFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServingRelayParameters_JoinRequestFilter)
+	if !ok {
+		that2, ok := that.(ServingRelayParameters_JoinRequestFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	if !this.JoinEui.Equal(that1.JoinEui) {
+		return false
+	}
+	if !this.DevEui.Equal(that1.DevEui) {
+		return false
+	}
+	return true
+}
+func (this *ServedRelayParameters) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServedRelayParameters)
+	if !ok {
+		that2, ok := that.(ServedRelayParameters)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.Mode == nil {
+		if this.Mode != nil {
+			return false
+		}
+	} else if this.Mode == nil {
+		return false
+	} else if !this.Mode.Equal(that1.Mode) {
+		return false
+	}
+	if this.Backoff != that1.Backoff {
+		return false
+	}
+	if !this.SecondChannel.Equal(that1.SecondChannel) {
+		return false
+	}
+	if this.ServingDeviceId != that1.ServingDeviceId {
+		return false
+	}
+	return true
+}
+func (this *ServedRelayParameters_Always) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServedRelayParameters_Always)
+	if !ok {
+		that2, ok := that.(ServedRelayParameters_Always)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Always.Equal(that1.Always) {
+		return false
+	}
+	return true
+}
+func (this *ServedRelayParameters_Dynamic) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServedRelayParameters_Dynamic)
+	if !ok {
+		that2, ok := that.(ServedRelayParameters_Dynamic)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Dynamic.Equal(that1.Dynamic) {
+		return false
+	}
+	return true
+}
+func (this *ServedRelayParameters_EndDeviceControlled) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ServedRelayParameters_EndDeviceControlled)
+	if !ok {
+		that2, ok := that.(ServedRelayParameters_EndDeviceControlled)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceControlled.Equal(that1.EndDeviceControlled) {
+		return false
+	}
+	return true
+}
+func (this *RelayParameters) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RelayParameters)
+	if !ok {
+		that2, ok := that.(RelayParameters)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.Mode == nil {
+		if this.Mode != nil {
+			return false
+		}
+	} else if this.Mode == nil {
+		return false
+	} else if !this.Mode.Equal(that1.Mode) {
+		return false
+	}
+	return true
+}
+func (this *RelayParameters_Serving) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RelayParameters_Serving)
+	if !ok {
+		that2, ok := that.(RelayParameters_Serving)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Serving.Equal(that1.Serving) {
+		return false
+	}
+	return true
+}
+func (this *RelayParameters_Served) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RelayParameters_Served)
+	if !ok {
+		that2, ok := that.(RelayParameters_Served)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Served.Equal(that1.Served) {
+		return false
+	}
+	return true
+}
+func (this *MACState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MACState)
+	if !ok {
+		that2, ok := that.(MACState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.CurrentParameters.Equal(&that1.CurrentParameters) {
+		return false
+	}
+	if !this.DesiredParameters.Equal(&that1.DesiredParameters) {
+		return false
+	}
+	if this.DeviceClass != that1.DeviceClass {
+		return false
+	}
+	if this.LorawanVersion != that1.LorawanVersion {
+		return false
+	}
+	if that1.LastConfirmedDownlinkAt == nil {
+		if this.LastConfirmedDownlinkAt != nil {
+			return false
+		}
+	} else if !this.LastConfirmedDownlinkAt.Equal(*that1.LastConfirmedDownlinkAt) {
+		return false
+	}
+	if this.LastDevStatusFCntUp != that1.LastDevStatusFCntUp {
+		return false
+	}
+	if !this.PingSlotPeriodicity.Equal(that1.PingSlotPeriodicity) {
+		return false
+	}
+	if !this.PendingApplicationDownlink.Equal(that1.PendingApplicationDownlink) {
+		return false
+	}
+	if len(this.QueuedResponses) != len(that1.QueuedResponses) {
+		return false
 	}
 	for i := range this.QueuedResponses {
 		if !this.QueuedResponses[i].Equal(that1.QueuedResponses[i]) {
@@ -3452,6 +4195,9 @@ func (this *MACState) Equal(that interface{}) bool {
 	if this.LastAdrChangeFCntUp != that1.LastAdrChangeFCntUp {
 		return false
 	}
+	if !this.PendingRelayDownlink.Equal(that1.PendingRelayDownlink) {
+		return false
+	}
 	return true
 }
 func (this *MACState_JoinRequest) Equal(that interface{}) bool {
@@ -4265,6 +5011,7 @@ func (this *MACParameters) String() string {
 		`AdrAckLimitExponent:` + strings.Replace(fmt.Sprintf("%v", this.AdrAckLimitExponent), "ADRAckLimitExponentValue", "ADRAckLimitExponentValue", 1) + `,`,
 		`AdrAckDelayExponent:` + strings.Replace(fmt.Sprintf("%v", this.AdrAckDelayExponent), "ADRAckDelayExponentValue", "ADRAckDelayExponentValue", 1) + `,`,
 		`PingSlotDataRateIndexValue:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotDataRateIndexValue), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
+		`Relay:` + strings.Replace(this.Relay.String(), "RelayParameters", "RelayParameters", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4340,6 +5087,129 @@ func (this *MACSettings) String() string {
 		`DesiredBeaconFrequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredBeaconFrequency), "FrequencyValue", "FrequencyValue", 1) + `,`,
 		`DesiredMaxEirp:` + strings.Replace(fmt.Sprintf("%v", this.DesiredMaxEirp), "DeviceEIRPValue", "DeviceEIRPValue", 1) + `,`,
 		`ClassBCDownlinkInterval:` + strings.Replace(fmt.Sprintf("%v", this.ClassBCDownlinkInterval), "Duration", "types.Duration", 1) + `,`,
+		`Relay:` + strings.Replace(this.Relay.String(), "RelayParameters", "RelayParameters", 1) + `,`,
+		`DesiredRelay:` + strings.Replace(this.DesiredRelay.String(), "RelayParameters", "RelayParameters", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServingRelayParameters) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForUplinkForwardingRules := "[]*ServingRelayParameters_UplinkForwardingRule{"
+	for _, f := range this.UplinkForwardingRules {
+		repeatedStringForUplinkForwardingRules += strings.Replace(fmt.Sprintf("%v", f), "ServingRelayParameters_UplinkForwardingRule", "ServingRelayParameters_UplinkForwardingRule", 1) + ","
+	}
+	repeatedStringForUplinkForwardingRules += "}"
+	repeatedStringForJoinRequestFilters := "[]*ServingRelayParameters_JoinRequestFilter{"
+	for _, f := range this.JoinRequestFilters {
+		repeatedStringForJoinRequestFilters += strings.Replace(fmt.Sprintf("%v", f), "ServingRelayParameters_JoinRequestFilter", "ServingRelayParameters_JoinRequestFilter", 1) + ","
+	}
+	repeatedStringForJoinRequestFilters += "}"
+	s := strings.Join([]string{`&ServingRelayParameters{`,
+		`SecondChannel:` + strings.Replace(fmt.Sprintf("%v", this.SecondChannel), "RelaySecondChannel", "RelaySecondChannel", 1) + `,`,
+		`DefaultChannelIndex:` + fmt.Sprintf("%v", this.DefaultChannelIndex) + `,`,
+		`CadPeriodicity:` + fmt.Sprintf("%v", this.CadPeriodicity) + `,`,
+		`UplinkForwardingRules:` + repeatedStringForUplinkForwardingRules + `,`,
+		`JoinRequestFilters:` + repeatedStringForJoinRequestFilters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServingRelayParameters_UplinkForwardingRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServingRelayParameters_UplinkForwardingRule{`,
+		`DeviceId:` + fmt.Sprintf("%v", this.DeviceId) + `,`,
+		`Limits:` + strings.Replace(fmt.Sprintf("%v", this.Limits), "RelayForwardLimits", "RelayForwardLimits", 1) + `,`,
+		`LastWFCnt:` + fmt.Sprintf("%v", this.LastWFCnt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServingRelayParameters_JoinRequestFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServingRelayParameters_JoinRequestFilter{`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`JoinEui:` + fmt.Sprintf("%v", this.JoinEui) + `,`,
+		`DevEui:` + fmt.Sprintf("%v", this.DevEui) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServedRelayParameters) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServedRelayParameters{`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`Backoff:` + fmt.Sprintf("%v", this.Backoff) + `,`,
+		`SecondChannel:` + strings.Replace(fmt.Sprintf("%v", this.SecondChannel), "RelaySecondChannel", "RelaySecondChannel", 1) + `,`,
+		`ServingDeviceId:` + fmt.Sprintf("%v", this.ServingDeviceId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServedRelayParameters_Always) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServedRelayParameters_Always{`,
+		`Always:` + strings.Replace(fmt.Sprintf("%v", this.Always), "RelayEndDeviceAlwaysMode", "RelayEndDeviceAlwaysMode", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServedRelayParameters_Dynamic) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServedRelayParameters_Dynamic{`,
+		`Dynamic:` + strings.Replace(fmt.Sprintf("%v", this.Dynamic), "RelayEndDeviceDynamicMode", "RelayEndDeviceDynamicMode", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServedRelayParameters_EndDeviceControlled) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServedRelayParameters_EndDeviceControlled{`,
+		`EndDeviceControlled:` + strings.Replace(fmt.Sprintf("%v", this.EndDeviceControlled), "RelayEndDeviceControlledMode", "RelayEndDeviceControlledMode", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayParameters) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayParameters{`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayParameters_Serving) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayParameters_Serving{`,
+		`Serving:` + strings.Replace(fmt.Sprintf("%v", this.Serving), "ServingRelayParameters", "ServingRelayParameters", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayParameters_Served) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayParameters_Served{`,
+		`Served:` + strings.Replace(fmt.Sprintf("%v", this.Served), "ServedRelayParameters", "ServedRelayParameters", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4401,6 +5271,7 @@ func (this *MACState) String() string {
 		`LastDownlinkAt:` + strings.Replace(fmt.Sprintf("%v", this.LastDownlinkAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`RejectedDataRateRanges:` + mapStringForRejectedDataRateRanges + `,`,
 		`LastAdrChangeFCntUp:` + fmt.Sprintf("%v", this.LastAdrChangeFCntUp) + `,`,
+		`PendingRelayDownlink:` + strings.Replace(fmt.Sprintf("%v", this.PendingRelayDownlink), "RelayForwardDownlinkReq", "RelayForwardDownlinkReq", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -69,6 +69,29 @@ var MACParametersFieldPathsNested = []string{
 	"ping_slot_frequency",
 	"rejoin_count_periodicity",
 	"rejoin_time_periodicity",
+	"relay",
+	"relay.mode",
+	"relay.mode.served",
+	"relay.mode.served.backoff",
+	"relay.mode.served.mode",
+	"relay.mode.served.mode.always",
+	"relay.mode.served.mode.dynamic",
+	"relay.mode.served.mode.dynamic.smart_enable_level",
+	"relay.mode.served.mode.end_device_controlled",
+	"relay.mode.served.second_channel",
+	"relay.mode.served.second_channel.ack_offset",
+	"relay.mode.served.second_channel.data_rate_index",
+	"relay.mode.served.second_channel.frequency",
+	"relay.mode.served.serving_device_id",
+	"relay.mode.serving",
+	"relay.mode.serving.cad_periodicity",
+	"relay.mode.serving.default_channel_index",
+	"relay.mode.serving.join_request_filters",
+	"relay.mode.serving.second_channel",
+	"relay.mode.serving.second_channel.ack_offset",
+	"relay.mode.serving.second_channel.data_rate_index",
+	"relay.mode.serving.second_channel.frequency",
+	"relay.mode.serving.uplink_forwarding_rules",
 	"rx1_data_rate_offset",
 	"rx1_delay",
 	"rx2_data_rate_index",
@@ -95,6 +118,7 @@ var MACParametersFieldPathsTopLevel = []string{
 	"ping_slot_frequency",
 	"rejoin_count_periodicity",
 	"rejoin_time_periodicity",
+	"relay",
 	"rx1_data_rate_offset",
 	"rx1_delay",
 	"rx2_data_rate_index",
@@ -128,6 +152,29 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"default_mac_settings.desired_ping_slot_data_rate_index.value",
 	"default_mac_settings.desired_ping_slot_frequency",
 	"default_mac_settings.desired_ping_slot_frequency.value",
+	"default_mac_settings.desired_relay",
+	"default_mac_settings.desired_relay.mode",
+	"default_mac_settings.desired_relay.mode.served",
+	"default_mac_settings.desired_relay.mode.served.backoff",
+	"default_mac_settings.desired_relay.mode.served.mode",
+	"default_mac_settings.desired_relay.mode.served.mode.always",
+	"default_mac_settings.desired_relay.mode.served.mode.dynamic",
+	"default_mac_settings.desired_relay.mode.served.mode.dynamic.smart_enable_level",
+	"default_mac_settings.desired_relay.mode.served.mode.end_device_controlled",
+	"default_mac_settings.desired_relay.mode.served.second_channel",
+	"default_mac_settings.desired_relay.mode.served.second_channel.ack_offset",
+	"default_mac_settings.desired_relay.mode.served.second_channel.data_rate_index",
+	"default_mac_settings.desired_relay.mode.served.second_channel.frequency",
+	"default_mac_settings.desired_relay.mode.served.serving_device_id",
+	"default_mac_settings.desired_relay.mode.serving",
+	"default_mac_settings.desired_relay.mode.serving.cad_periodicity",
+	"default_mac_settings.desired_relay.mode.serving.default_channel_index",
+	"default_mac_settings.desired_relay.mode.serving.join_request_filters",
+	"default_mac_settings.desired_relay.mode.serving.second_channel",
+	"default_mac_settings.desired_relay.mode.serving.second_channel.ack_offset",
+	"default_mac_settings.desired_relay.mode.serving.second_channel.data_rate_index",
+	"default_mac_settings.desired_relay.mode.serving.second_channel.frequency",
+	"default_mac_settings.desired_relay.mode.serving.uplink_forwarding_rules",
 	"default_mac_settings.desired_rx1_data_rate_offset",
 	"default_mac_settings.desired_rx1_data_rate_offset.value",
 	"default_mac_settings.desired_rx1_delay",
@@ -145,6 +192,29 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"default_mac_settings.ping_slot_frequency.value",
 	"default_mac_settings.ping_slot_periodicity",
 	"default_mac_settings.ping_slot_periodicity.value",
+	"default_mac_settings.relay",
+	"default_mac_settings.relay.mode",
+	"default_mac_settings.relay.mode.served",
+	"default_mac_settings.relay.mode.served.backoff",
+	"default_mac_settings.relay.mode.served.mode",
+	"default_mac_settings.relay.mode.served.mode.always",
+	"default_mac_settings.relay.mode.served.mode.dynamic",
+	"default_mac_settings.relay.mode.served.mode.dynamic.smart_enable_level",
+	"default_mac_settings.relay.mode.served.mode.end_device_controlled",
+	"default_mac_settings.relay.mode.served.second_channel",
+	"default_mac_settings.relay.mode.served.second_channel.ack_offset",
+	"default_mac_settings.relay.mode.served.second_channel.data_rate_index",
+	"default_mac_settings.relay.mode.served.second_channel.frequency",
+	"default_mac_settings.relay.mode.served.serving_device_id",
+	"default_mac_settings.relay.mode.serving",
+	"default_mac_settings.relay.mode.serving.cad_periodicity",
+	"default_mac_settings.relay.mode.serving.default_channel_index",
+	"default_mac_settings.relay.mode.serving.join_request_filters",
+	"default_mac_settings.relay.mode.serving.second_channel",
+	"default_mac_settings.relay.mode.serving.second_channel.ack_offset",
+	"default_mac_settings.relay.mode.serving.second_channel.data_rate_index",
+	"default_mac_settings.relay.mode.serving.second_channel.frequency",
+	"default_mac_settings.relay.mode.serving.uplink_forwarding_rules",
 	"default_mac_settings.resets_f_cnt",
 	"default_mac_settings.resets_f_cnt.value",
 	"default_mac_settings.rx1_data_rate_offset",
@@ -215,6 +285,29 @@ var MACSettingsFieldPathsNested = []string{
 	"desired_ping_slot_data_rate_index.value",
 	"desired_ping_slot_frequency",
 	"desired_ping_slot_frequency.value",
+	"desired_relay",
+	"desired_relay.mode",
+	"desired_relay.mode.served",
+	"desired_relay.mode.served.backoff",
+	"desired_relay.mode.served.mode",
+	"desired_relay.mode.served.mode.always",
+	"desired_relay.mode.served.mode.dynamic",
+	"desired_relay.mode.served.mode.dynamic.smart_enable_level",
+	"desired_relay.mode.served.mode.end_device_controlled",
+	"desired_relay.mode.served.second_channel",
+	"desired_relay.mode.served.second_channel.ack_offset",
+	"desired_relay.mode.served.second_channel.data_rate_index",
+	"desired_relay.mode.served.second_channel.frequency",
+	"desired_relay.mode.served.serving_device_id",
+	"desired_relay.mode.serving",
+	"desired_relay.mode.serving.cad_periodicity",
+	"desired_relay.mode.serving.default_channel_index",
+	"desired_relay.mode.serving.join_request_filters",
+	"desired_relay.mode.serving.second_channel",
+	"desired_relay.mode.serving.second_channel.ack_offset",
+	"desired_relay.mode.serving.second_channel.data_rate_index",
+	"desired_relay.mode.serving.second_channel.frequency",
+	"desired_relay.mode.serving.uplink_forwarding_rules",
 	"desired_rx1_data_rate_offset",
 	"desired_rx1_data_rate_offset.value",
 	"desired_rx1_delay",
@@ -232,6 +325,29 @@ var MACSettingsFieldPathsNested = []string{
 	"ping_slot_frequency.value",
 	"ping_slot_periodicity",
 	"ping_slot_periodicity.value",
+	"relay",
+	"relay.mode",
+	"relay.mode.served",
+	"relay.mode.served.backoff",
+	"relay.mode.served.mode",
+	"relay.mode.served.mode.always",
+	"relay.mode.served.mode.dynamic",
+	"relay.mode.served.mode.dynamic.smart_enable_level",
+	"relay.mode.served.mode.end_device_controlled",
+	"relay.mode.served.second_channel",
+	"relay.mode.served.second_channel.ack_offset",
+	"relay.mode.served.second_channel.data_rate_index",
+	"relay.mode.served.second_channel.frequency",
+	"relay.mode.served.serving_device_id",
+	"relay.mode.serving",
+	"relay.mode.serving.cad_periodicity",
+	"relay.mode.serving.default_channel_index",
+	"relay.mode.serving.join_request_filters",
+	"relay.mode.serving.second_channel",
+	"relay.mode.serving.second_channel.ack_offset",
+	"relay.mode.serving.second_channel.data_rate_index",
+	"relay.mode.serving.second_channel.frequency",
+	"relay.mode.serving.uplink_forwarding_rules",
 	"resets_f_cnt",
 	"resets_f_cnt.value",
 	"rx1_data_rate_offset",
@@ -263,6 +379,7 @@ var MACSettingsFieldPathsTopLevel = []string{
 	"desired_max_eirp",
 	"desired_ping_slot_data_rate_index",
 	"desired_ping_slot_frequency",
+	"desired_relay",
 	"desired_rx1_data_rate_offset",
 	"desired_rx1_delay",
 	"desired_rx2_data_rate_index",
@@ -272,6 +389,7 @@ var MACSettingsFieldPathsTopLevel = []string{
 	"ping_slot_data_rate_index",
 	"ping_slot_frequency",
 	"ping_slot_periodicity",
+	"relay",
 	"resets_f_cnt",
 	"rx1_data_rate_offset",
 	"rx1_delay",
@@ -282,6 +400,72 @@ var MACSettingsFieldPathsTopLevel = []string{
 	"supports_32_bit_f_cnt",
 	"use_adr",
 }
+var ServingRelayParametersFieldPathsNested = []string{
+	"cad_periodicity",
+	"default_channel_index",
+	"join_request_filters",
+	"second_channel",
+	"second_channel.ack_offset",
+	"second_channel.data_rate_index",
+	"second_channel.frequency",
+	"uplink_forwarding_rules",
+}
+
+var ServingRelayParametersFieldPathsTopLevel = []string{
+	"cad_periodicity",
+	"default_channel_index",
+	"join_request_filters",
+	"second_channel",
+	"uplink_forwarding_rules",
+}
+var ServedRelayParametersFieldPathsNested = []string{
+	"backoff",
+	"mode",
+	"mode.always",
+	"mode.dynamic",
+	"mode.dynamic.smart_enable_level",
+	"mode.end_device_controlled",
+	"second_channel",
+	"second_channel.ack_offset",
+	"second_channel.data_rate_index",
+	"second_channel.frequency",
+	"serving_device_id",
+}
+
+var ServedRelayParametersFieldPathsTopLevel = []string{
+	"backoff",
+	"mode",
+	"second_channel",
+	"serving_device_id",
+}
+var RelayParametersFieldPathsNested = []string{
+	"mode",
+	"mode.served",
+	"mode.served.backoff",
+	"mode.served.mode",
+	"mode.served.mode.always",
+	"mode.served.mode.dynamic",
+	"mode.served.mode.dynamic.smart_enable_level",
+	"mode.served.mode.end_device_controlled",
+	"mode.served.second_channel",
+	"mode.served.second_channel.ack_offset",
+	"mode.served.second_channel.data_rate_index",
+	"mode.served.second_channel.frequency",
+	"mode.served.serving_device_id",
+	"mode.serving",
+	"mode.serving.cad_periodicity",
+	"mode.serving.default_channel_index",
+	"mode.serving.join_request_filters",
+	"mode.serving.second_channel",
+	"mode.serving.second_channel.ack_offset",
+	"mode.serving.second_channel.data_rate_index",
+	"mode.serving.second_channel.frequency",
+	"mode.serving.uplink_forwarding_rules",
+}
+
+var RelayParametersFieldPathsTopLevel = []string{
+	"mode",
+}
 var MACStateFieldPathsNested = []string{
 	"current_parameters",
 	"current_parameters.adr_ack_delay",
@@ -305,6 +489,29 @@ var MACStateFieldPathsNested = []string{
 	"current_parameters.ping_slot_frequency",
 	"current_parameters.rejoin_count_periodicity",
 	"current_parameters.rejoin_time_periodicity",
+	"current_parameters.relay",
+	"current_parameters.relay.mode",
+	"current_parameters.relay.mode.served",
+	"current_parameters.relay.mode.served.backoff",
+	"current_parameters.relay.mode.served.mode",
+	"current_parameters.relay.mode.served.mode.always",
+	"current_parameters.relay.mode.served.mode.dynamic",
+	"current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"current_parameters.relay.mode.served.mode.end_device_controlled",
+	"current_parameters.relay.mode.served.second_channel",
+	"current_parameters.relay.mode.served.second_channel.ack_offset",
+	"current_parameters.relay.mode.served.second_channel.data_rate_index",
+	"current_parameters.relay.mode.served.second_channel.frequency",
+	"current_parameters.relay.mode.served.serving_device_id",
+	"current_parameters.relay.mode.serving",
+	"current_parameters.relay.mode.serving.cad_periodicity",
+	"current_parameters.relay.mode.serving.default_channel_index",
+	"current_parameters.relay.mode.serving.join_request_filters",
+	"current_parameters.relay.mode.serving.second_channel",
+	"current_parameters.relay.mode.serving.second_channel.ack_offset",
+	"current_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"current_parameters.relay.mode.serving.second_channel.frequency",
+	"current_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"current_parameters.rx1_data_rate_offset",
 	"current_parameters.rx1_delay",
 	"current_parameters.rx2_data_rate_index",
@@ -333,6 +540,29 @@ var MACStateFieldPathsNested = []string{
 	"desired_parameters.ping_slot_frequency",
 	"desired_parameters.rejoin_count_periodicity",
 	"desired_parameters.rejoin_time_periodicity",
+	"desired_parameters.relay",
+	"desired_parameters.relay.mode",
+	"desired_parameters.relay.mode.served",
+	"desired_parameters.relay.mode.served.backoff",
+	"desired_parameters.relay.mode.served.mode",
+	"desired_parameters.relay.mode.served.mode.always",
+	"desired_parameters.relay.mode.served.mode.dynamic",
+	"desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"desired_parameters.relay.mode.served.mode.end_device_controlled",
+	"desired_parameters.relay.mode.served.second_channel",
+	"desired_parameters.relay.mode.served.second_channel.ack_offset",
+	"desired_parameters.relay.mode.served.second_channel.data_rate_index",
+	"desired_parameters.relay.mode.served.second_channel.frequency",
+	"desired_parameters.relay.mode.served.serving_device_id",
+	"desired_parameters.relay.mode.serving",
+	"desired_parameters.relay.mode.serving.cad_periodicity",
+	"desired_parameters.relay.mode.serving.default_channel_index",
+	"desired_parameters.relay.mode.serving.join_request_filters",
+	"desired_parameters.relay.mode.serving.second_channel",
+	"desired_parameters.relay.mode.serving.second_channel.ack_offset",
+	"desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"desired_parameters.relay.mode.serving.second_channel.frequency",
+	"desired_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"desired_parameters.rx1_data_rate_offset",
 	"desired_parameters.rx1_delay",
 	"desired_parameters.rx2_data_rate_index",
@@ -369,6 +599,8 @@ var MACStateFieldPathsNested = []string{
 	"pending_join_request.downlink_settings.rx1_dr_offset",
 	"pending_join_request.downlink_settings.rx2_dr",
 	"pending_join_request.rx_delay",
+	"pending_relay_downlink",
+	"pending_relay_downlink.raw_payload",
 	"pending_requests",
 	"ping_slot_periodicity",
 	"ping_slot_periodicity.value",
@@ -427,6 +659,7 @@ var MACStateFieldPathsTopLevel = []string{
 	"lorawan_version",
 	"pending_application_downlink",
 	"pending_join_request",
+	"pending_relay_downlink",
 	"pending_requests",
 	"ping_slot_periodicity",
 	"queued_join_accept",
@@ -507,6 +740,29 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_settings.desired_ping_slot_data_rate_index.value",
 	"mac_settings.desired_ping_slot_frequency",
 	"mac_settings.desired_ping_slot_frequency.value",
+	"mac_settings.desired_relay",
+	"mac_settings.desired_relay.mode",
+	"mac_settings.desired_relay.mode.served",
+	"mac_settings.desired_relay.mode.served.backoff",
+	"mac_settings.desired_relay.mode.served.mode",
+	"mac_settings.desired_relay.mode.served.mode.always",
+	"mac_settings.desired_relay.mode.served.mode.dynamic",
+	"mac_settings.desired_relay.mode.served.mode.dynamic.smart_enable_level",
+	"mac_settings.desired_relay.mode.served.mode.end_device_controlled",
+	"mac_settings.desired_relay.mode.served.second_channel",
+	"mac_settings.desired_relay.mode.served.second_channel.ack_offset",
+	"mac_settings.desired_relay.mode.served.second_channel.data_rate_index",
+	"mac_settings.desired_relay.mode.served.second_channel.frequency",
+	"mac_settings.desired_relay.mode.served.serving_device_id",
+	"mac_settings.desired_relay.mode.serving",
+	"mac_settings.desired_relay.mode.serving.cad_periodicity",
+	"mac_settings.desired_relay.mode.serving.default_channel_index",
+	"mac_settings.desired_relay.mode.serving.join_request_filters",
+	"mac_settings.desired_relay.mode.serving.second_channel",
+	"mac_settings.desired_relay.mode.serving.second_channel.ack_offset",
+	"mac_settings.desired_relay.mode.serving.second_channel.data_rate_index",
+	"mac_settings.desired_relay.mode.serving.second_channel.frequency",
+	"mac_settings.desired_relay.mode.serving.uplink_forwarding_rules",
 	"mac_settings.desired_rx1_data_rate_offset",
 	"mac_settings.desired_rx1_data_rate_offset.value",
 	"mac_settings.desired_rx1_delay",
@@ -524,6 +780,29 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_settings.ping_slot_frequency.value",
 	"mac_settings.ping_slot_periodicity",
 	"mac_settings.ping_slot_periodicity.value",
+	"mac_settings.relay",
+	"mac_settings.relay.mode",
+	"mac_settings.relay.mode.served",
+	"mac_settings.relay.mode.served.backoff",
+	"mac_settings.relay.mode.served.mode",
+	"mac_settings.relay.mode.served.mode.always",
+	"mac_settings.relay.mode.served.mode.dynamic",
+	"mac_settings.relay.mode.served.mode.dynamic.smart_enable_level",
+	"mac_settings.relay.mode.served.mode.end_device_controlled",
+	"mac_settings.relay.mode.served.second_channel",
+	"mac_settings.relay.mode.served.second_channel.ack_offset",
+	"mac_settings.relay.mode.served.second_channel.data_rate_index",
+	"mac_settings.relay.mode.served.second_channel.frequency",
+	"mac_settings.relay.mode.served.serving_device_id",
+	"mac_settings.relay.mode.serving",
+	"mac_settings.relay.mode.serving.cad_periodicity",
+	"mac_settings.relay.mode.serving.default_channel_index",
+	"mac_settings.relay.mode.serving.join_request_filters",
+	"mac_settings.relay.mode.serving.second_channel",
+	"mac_settings.relay.mode.serving.second_channel.ack_offset",
+	"mac_settings.relay.mode.serving.second_channel.data_rate_index",
+	"mac_settings.relay.mode.serving.second_channel.frequency",
+	"mac_settings.relay.mode.serving.uplink_forwarding_rules",
 	"mac_settings.resets_f_cnt",
 	"mac_settings.resets_f_cnt.value",
 	"mac_settings.rx1_data_rate_offset",
@@ -563,6 +842,29 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.current_parameters.ping_slot_frequency",
 	"mac_state.current_parameters.rejoin_count_periodicity",
 	"mac_state.current_parameters.rejoin_time_periodicity",
+	"mac_state.current_parameters.relay",
+	"mac_state.current_parameters.relay.mode",
+	"mac_state.current_parameters.relay.mode.served",
+	"mac_state.current_parameters.relay.mode.served.backoff",
+	"mac_state.current_parameters.relay.mode.served.mode",
+	"mac_state.current_parameters.relay.mode.served.mode.always",
+	"mac_state.current_parameters.relay.mode.served.mode.dynamic",
+	"mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+	"mac_state.current_parameters.relay.mode.served.second_channel",
+	"mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+	"mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+	"mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+	"mac_state.current_parameters.relay.mode.served.serving_device_id",
+	"mac_state.current_parameters.relay.mode.serving",
+	"mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+	"mac_state.current_parameters.relay.mode.serving.default_channel_index",
+	"mac_state.current_parameters.relay.mode.serving.join_request_filters",
+	"mac_state.current_parameters.relay.mode.serving.second_channel",
+	"mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+	"mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+	"mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"mac_state.current_parameters.rx1_data_rate_offset",
 	"mac_state.current_parameters.rx1_delay",
 	"mac_state.current_parameters.rx2_data_rate_index",
@@ -591,6 +893,29 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.desired_parameters.ping_slot_frequency",
 	"mac_state.desired_parameters.rejoin_count_periodicity",
 	"mac_state.desired_parameters.rejoin_time_periodicity",
+	"mac_state.desired_parameters.relay",
+	"mac_state.desired_parameters.relay.mode",
+	"mac_state.desired_parameters.relay.mode.served",
+	"mac_state.desired_parameters.relay.mode.served.backoff",
+	"mac_state.desired_parameters.relay.mode.served.mode",
+	"mac_state.desired_parameters.relay.mode.served.mode.always",
+	"mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+	"mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+	"mac_state.desired_parameters.relay.mode.served.second_channel",
+	"mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+	"mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+	"mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+	"mac_state.desired_parameters.relay.mode.served.serving_device_id",
+	"mac_state.desired_parameters.relay.mode.serving",
+	"mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+	"mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+	"mac_state.desired_parameters.relay.mode.serving.join_request_filters",
+	"mac_state.desired_parameters.relay.mode.serving.second_channel",
+	"mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+	"mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+	"mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"mac_state.desired_parameters.rx1_data_rate_offset",
 	"mac_state.desired_parameters.rx1_delay",
 	"mac_state.desired_parameters.rx2_data_rate_index",
@@ -627,6 +952,8 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"mac_state.pending_join_request.rx_delay",
+	"mac_state.pending_relay_downlink",
+	"mac_state.pending_relay_downlink.raw_payload",
 	"mac_state.pending_requests",
 	"mac_state.ping_slot_periodicity",
 	"mac_state.ping_slot_periodicity.value",
@@ -701,6 +1028,29 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.current_parameters.ping_slot_frequency",
 	"pending_mac_state.current_parameters.rejoin_count_periodicity",
 	"pending_mac_state.current_parameters.rejoin_time_periodicity",
+	"pending_mac_state.current_parameters.relay",
+	"pending_mac_state.current_parameters.relay.mode",
+	"pending_mac_state.current_parameters.relay.mode.served",
+	"pending_mac_state.current_parameters.relay.mode.served.backoff",
+	"pending_mac_state.current_parameters.relay.mode.served.mode",
+	"pending_mac_state.current_parameters.relay.mode.served.mode.always",
+	"pending_mac_state.current_parameters.relay.mode.served.mode.dynamic",
+	"pending_mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"pending_mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+	"pending_mac_state.current_parameters.relay.mode.served.second_channel",
+	"pending_mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+	"pending_mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+	"pending_mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+	"pending_mac_state.current_parameters.relay.mode.served.serving_device_id",
+	"pending_mac_state.current_parameters.relay.mode.serving",
+	"pending_mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+	"pending_mac_state.current_parameters.relay.mode.serving.default_channel_index",
+	"pending_mac_state.current_parameters.relay.mode.serving.join_request_filters",
+	"pending_mac_state.current_parameters.relay.mode.serving.second_channel",
+	"pending_mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+	"pending_mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"pending_mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+	"pending_mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"pending_mac_state.current_parameters.rx1_data_rate_offset",
 	"pending_mac_state.current_parameters.rx1_delay",
 	"pending_mac_state.current_parameters.rx2_data_rate_index",
@@ -729,6 +1079,29 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.desired_parameters.ping_slot_frequency",
 	"pending_mac_state.desired_parameters.rejoin_count_periodicity",
 	"pending_mac_state.desired_parameters.rejoin_time_periodicity",
+	"pending_mac_state.desired_parameters.relay",
+	"pending_mac_state.desired_parameters.relay.mode",
+	"pending_mac_state.desired_parameters.relay.mode.served",
+	"pending_mac_state.desired_parameters.relay.mode.served.backoff",
+	"pending_mac_state.desired_parameters.relay.mode.served.mode",
+	"pending_mac_state.desired_parameters.relay.mode.served.mode.always",
+	"pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+	"pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"pending_mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+	"pending_mac_state.desired_parameters.relay.mode.served.second_channel",
+	"pending_mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+	"pending_mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+	"pending_mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+	"pending_mac_state.desired_parameters.relay.mode.served.serving_device_id",
+	"pending_mac_state.desired_parameters.relay.mode.serving",
+	"pending_mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+	"pending_mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+	"pending_mac_state.desired_parameters.relay.mode.serving.join_request_filters",
+	"pending_mac_state.desired_parameters.relay.mode.serving.second_channel",
+	"pending_mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+	"pending_mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"pending_mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+	"pending_mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"pending_mac_state.desired_parameters.rx1_data_rate_offset",
 	"pending_mac_state.desired_parameters.rx1_delay",
 	"pending_mac_state.desired_parameters.rx2_data_rate_index",
@@ -765,6 +1138,8 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"pending_mac_state.pending_join_request.rx_delay",
+	"pending_mac_state.pending_relay_downlink",
+	"pending_mac_state.pending_relay_downlink.raw_payload",
 	"pending_mac_state.pending_requests",
 	"pending_mac_state.ping_slot_periodicity",
 	"pending_mac_state.ping_slot_periodicity.value",
@@ -1023,6 +1398,29 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_ping_slot_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_frequency",
 	"end_device.mac_settings.desired_ping_slot_frequency.value",
+	"end_device.mac_settings.desired_relay",
+	"end_device.mac_settings.desired_relay.mode",
+	"end_device.mac_settings.desired_relay.mode.served",
+	"end_device.mac_settings.desired_relay.mode.served.backoff",
+	"end_device.mac_settings.desired_relay.mode.served.mode",
+	"end_device.mac_settings.desired_relay.mode.served.mode.always",
+	"end_device.mac_settings.desired_relay.mode.served.mode.dynamic",
+	"end_device.mac_settings.desired_relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_settings.desired_relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.mode.served.serving_device_id",
+	"end_device.mac_settings.desired_relay.mode.serving",
+	"end_device.mac_settings.desired_relay.mode.serving.cad_periodicity",
+	"end_device.mac_settings.desired_relay.mode.serving.default_channel_index",
+	"end_device.mac_settings.desired_relay.mode.serving.join_request_filters",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_settings.desired_rx1_data_rate_offset",
 	"end_device.mac_settings.desired_rx1_data_rate_offset.value",
 	"end_device.mac_settings.desired_rx1_delay",
@@ -1040,6 +1438,29 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.ping_slot_frequency.value",
 	"end_device.mac_settings.ping_slot_periodicity",
 	"end_device.mac_settings.ping_slot_periodicity.value",
+	"end_device.mac_settings.relay",
+	"end_device.mac_settings.relay.mode",
+	"end_device.mac_settings.relay.mode.served",
+	"end_device.mac_settings.relay.mode.served.backoff",
+	"end_device.mac_settings.relay.mode.served.mode",
+	"end_device.mac_settings.relay.mode.served.mode.always",
+	"end_device.mac_settings.relay.mode.served.mode.dynamic",
+	"end_device.mac_settings.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_settings.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_settings.relay.mode.served.second_channel",
+	"end_device.mac_settings.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_settings.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_settings.relay.mode.served.second_channel.frequency",
+	"end_device.mac_settings.relay.mode.served.serving_device_id",
+	"end_device.mac_settings.relay.mode.serving",
+	"end_device.mac_settings.relay.mode.serving.cad_periodicity",
+	"end_device.mac_settings.relay.mode.serving.default_channel_index",
+	"end_device.mac_settings.relay.mode.serving.join_request_filters",
+	"end_device.mac_settings.relay.mode.serving.second_channel",
+	"end_device.mac_settings.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_settings.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_settings.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_settings.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_settings.resets_f_cnt",
 	"end_device.mac_settings.resets_f_cnt.value",
 	"end_device.mac_settings.rx1_data_rate_offset",
@@ -1079,6 +1500,29 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.current_parameters.ping_slot_frequency",
 	"end_device.mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.current_parameters.relay",
+	"end_device.mac_state.current_parameters.relay.mode",
+	"end_device.mac_state.current_parameters.relay.mode.served",
+	"end_device.mac_state.current_parameters.relay.mode.served.backoff",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.always",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.dynamic",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.mode.served.serving_device_id",
+	"end_device.mac_state.current_parameters.relay.mode.serving",
+	"end_device.mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.mac_state.current_parameters.relay.mode.serving.default_channel_index",
+	"end_device.mac_state.current_parameters.relay.mode.serving.join_request_filters",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.current_parameters.rx1_delay",
 	"end_device.mac_state.current_parameters.rx2_data_rate_index",
@@ -1107,6 +1551,29 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.desired_parameters.relay",
+	"end_device.mac_state.desired_parameters.relay.mode",
+	"end_device.mac_state.desired_parameters.relay.mode.served",
+	"end_device.mac_state.desired_parameters.relay.mode.served.backoff",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.always",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.mode.served.serving_device_id",
+	"end_device.mac_state.desired_parameters.relay.mode.serving",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.join_request_filters",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.desired_parameters.rx1_delay",
 	"end_device.mac_state.desired_parameters.rx2_data_rate_index",
@@ -1143,6 +1610,8 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.mac_state.pending_join_request.rx_delay",
+	"end_device.mac_state.pending_relay_downlink",
+	"end_device.mac_state.pending_relay_downlink.raw_payload",
 	"end_device.mac_state.pending_requests",
 	"end_device.mac_state.ping_slot_periodicity",
 	"end_device.mac_state.ping_slot_periodicity.value",
@@ -1217,6 +1686,29 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.current_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay",
+	"end_device.pending_mac_state.current_parameters.relay.mode",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.backoff",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.always",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.dynamic",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.serving_device_id",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.default_channel_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.join_request_filters",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.current_parameters.rx1_delay",
 	"end_device.pending_mac_state.current_parameters.rx2_data_rate_index",
@@ -1245,6 +1737,29 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay",
+	"end_device.pending_mac_state.desired_parameters.relay.mode",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.backoff",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.always",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.serving_device_id",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.join_request_filters",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.desired_parameters.rx1_delay",
 	"end_device.pending_mac_state.desired_parameters.rx2_data_rate_index",
@@ -1281,6 +1796,8 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.pending_mac_state.pending_join_request.rx_delay",
+	"end_device.pending_mac_state.pending_relay_downlink",
+	"end_device.pending_mac_state.pending_relay_downlink.raw_payload",
 	"end_device.pending_mac_state.pending_requests",
 	"end_device.pending_mac_state.ping_slot_periodicity",
 	"end_device.pending_mac_state.ping_slot_periodicity.value",
@@ -1474,6 +1991,29 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_ping_slot_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_frequency",
 	"end_device.mac_settings.desired_ping_slot_frequency.value",
+	"end_device.mac_settings.desired_relay",
+	"end_device.mac_settings.desired_relay.mode",
+	"end_device.mac_settings.desired_relay.mode.served",
+	"end_device.mac_settings.desired_relay.mode.served.backoff",
+	"end_device.mac_settings.desired_relay.mode.served.mode",
+	"end_device.mac_settings.desired_relay.mode.served.mode.always",
+	"end_device.mac_settings.desired_relay.mode.served.mode.dynamic",
+	"end_device.mac_settings.desired_relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_settings.desired_relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.mode.served.serving_device_id",
+	"end_device.mac_settings.desired_relay.mode.serving",
+	"end_device.mac_settings.desired_relay.mode.serving.cad_periodicity",
+	"end_device.mac_settings.desired_relay.mode.serving.default_channel_index",
+	"end_device.mac_settings.desired_relay.mode.serving.join_request_filters",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_settings.desired_rx1_data_rate_offset",
 	"end_device.mac_settings.desired_rx1_data_rate_offset.value",
 	"end_device.mac_settings.desired_rx1_delay",
@@ -1491,6 +2031,29 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.ping_slot_frequency.value",
 	"end_device.mac_settings.ping_slot_periodicity",
 	"end_device.mac_settings.ping_slot_periodicity.value",
+	"end_device.mac_settings.relay",
+	"end_device.mac_settings.relay.mode",
+	"end_device.mac_settings.relay.mode.served",
+	"end_device.mac_settings.relay.mode.served.backoff",
+	"end_device.mac_settings.relay.mode.served.mode",
+	"end_device.mac_settings.relay.mode.served.mode.always",
+	"end_device.mac_settings.relay.mode.served.mode.dynamic",
+	"end_device.mac_settings.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_settings.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_settings.relay.mode.served.second_channel",
+	"end_device.mac_settings.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_settings.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_settings.relay.mode.served.second_channel.frequency",
+	"end_device.mac_settings.relay.mode.served.serving_device_id",
+	"end_device.mac_settings.relay.mode.serving",
+	"end_device.mac_settings.relay.mode.serving.cad_periodicity",
+	"end_device.mac_settings.relay.mode.serving.default_channel_index",
+	"end_device.mac_settings.relay.mode.serving.join_request_filters",
+	"end_device.mac_settings.relay.mode.serving.second_channel",
+	"end_device.mac_settings.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_settings.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_settings.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_settings.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_settings.resets_f_cnt",
 	"end_device.mac_settings.resets_f_cnt.value",
 	"end_device.mac_settings.rx1_data_rate_offset",
@@ -1530,6 +2093,29 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.current_parameters.ping_slot_frequency",
 	"end_device.mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.current_parameters.relay",
+	"end_device.mac_state.current_parameters.relay.mode",
+	"end_device.mac_state.current_parameters.relay.mode.served",
+	"end_device.mac_state.current_parameters.relay.mode.served.backoff",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.always",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.dynamic",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.mode.served.serving_device_id",
+	"end_device.mac_state.current_parameters.relay.mode.serving",
+	"end_device.mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.mac_state.current_parameters.relay.mode.serving.default_channel_index",
+	"end_device.mac_state.current_parameters.relay.mode.serving.join_request_filters",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.current_parameters.rx1_delay",
 	"end_device.mac_state.current_parameters.rx2_data_rate_index",
@@ -1558,6 +2144,29 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.desired_parameters.relay",
+	"end_device.mac_state.desired_parameters.relay.mode",
+	"end_device.mac_state.desired_parameters.relay.mode.served",
+	"end_device.mac_state.desired_parameters.relay.mode.served.backoff",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.always",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.mode.served.serving_device_id",
+	"end_device.mac_state.desired_parameters.relay.mode.serving",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.join_request_filters",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.desired_parameters.rx1_delay",
 	"end_device.mac_state.desired_parameters.rx2_data_rate_index",
@@ -1594,6 +2203,8 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.mac_state.pending_join_request.rx_delay",
+	"end_device.mac_state.pending_relay_downlink",
+	"end_device.mac_state.pending_relay_downlink.raw_payload",
 	"end_device.mac_state.pending_requests",
 	"end_device.mac_state.ping_slot_periodicity",
 	"end_device.mac_state.ping_slot_periodicity.value",
@@ -1668,6 +2279,29 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.current_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay",
+	"end_device.pending_mac_state.current_parameters.relay.mode",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.backoff",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.always",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.dynamic",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.serving_device_id",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.default_channel_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.join_request_filters",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.current_parameters.rx1_delay",
 	"end_device.pending_mac_state.current_parameters.rx2_data_rate_index",
@@ -1696,6 +2330,29 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay",
+	"end_device.pending_mac_state.desired_parameters.relay.mode",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.backoff",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.always",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.serving_device_id",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.join_request_filters",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.desired_parameters.rx1_delay",
 	"end_device.pending_mac_state.desired_parameters.rx2_data_rate_index",
@@ -1732,6 +2389,8 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.pending_mac_state.pending_join_request.rx_delay",
+	"end_device.pending_mac_state.pending_relay_downlink",
+	"end_device.pending_mac_state.pending_relay_downlink.raw_payload",
 	"end_device.pending_mac_state.pending_requests",
 	"end_device.pending_mac_state.ping_slot_periodicity",
 	"end_device.pending_mac_state.ping_slot_periodicity.value",
@@ -1967,6 +2626,29 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_ping_slot_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_frequency",
 	"end_device.mac_settings.desired_ping_slot_frequency.value",
+	"end_device.mac_settings.desired_relay",
+	"end_device.mac_settings.desired_relay.mode",
+	"end_device.mac_settings.desired_relay.mode.served",
+	"end_device.mac_settings.desired_relay.mode.served.backoff",
+	"end_device.mac_settings.desired_relay.mode.served.mode",
+	"end_device.mac_settings.desired_relay.mode.served.mode.always",
+	"end_device.mac_settings.desired_relay.mode.served.mode.dynamic",
+	"end_device.mac_settings.desired_relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_settings.desired_relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.mode.served.serving_device_id",
+	"end_device.mac_settings.desired_relay.mode.serving",
+	"end_device.mac_settings.desired_relay.mode.serving.cad_periodicity",
+	"end_device.mac_settings.desired_relay.mode.serving.default_channel_index",
+	"end_device.mac_settings.desired_relay.mode.serving.join_request_filters",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_settings.desired_rx1_data_rate_offset",
 	"end_device.mac_settings.desired_rx1_data_rate_offset.value",
 	"end_device.mac_settings.desired_rx1_delay",
@@ -1984,6 +2666,29 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.ping_slot_frequency.value",
 	"end_device.mac_settings.ping_slot_periodicity",
 	"end_device.mac_settings.ping_slot_periodicity.value",
+	"end_device.mac_settings.relay",
+	"end_device.mac_settings.relay.mode",
+	"end_device.mac_settings.relay.mode.served",
+	"end_device.mac_settings.relay.mode.served.backoff",
+	"end_device.mac_settings.relay.mode.served.mode",
+	"end_device.mac_settings.relay.mode.served.mode.always",
+	"end_device.mac_settings.relay.mode.served.mode.dynamic",
+	"end_device.mac_settings.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_settings.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_settings.relay.mode.served.second_channel",
+	"end_device.mac_settings.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_settings.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_settings.relay.mode.served.second_channel.frequency",
+	"end_device.mac_settings.relay.mode.served.serving_device_id",
+	"end_device.mac_settings.relay.mode.serving",
+	"end_device.mac_settings.relay.mode.serving.cad_periodicity",
+	"end_device.mac_settings.relay.mode.serving.default_channel_index",
+	"end_device.mac_settings.relay.mode.serving.join_request_filters",
+	"end_device.mac_settings.relay.mode.serving.second_channel",
+	"end_device.mac_settings.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_settings.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_settings.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_settings.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_settings.resets_f_cnt",
 	"end_device.mac_settings.resets_f_cnt.value",
 	"end_device.mac_settings.rx1_data_rate_offset",
@@ -2023,6 +2728,29 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.current_parameters.ping_slot_frequency",
 	"end_device.mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.current_parameters.relay",
+	"end_device.mac_state.current_parameters.relay.mode",
+	"end_device.mac_state.current_parameters.relay.mode.served",
+	"end_device.mac_state.current_parameters.relay.mode.served.backoff",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.always",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.dynamic",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.mode.served.serving_device_id",
+	"end_device.mac_state.current_parameters.relay.mode.serving",
+	"end_device.mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.mac_state.current_parameters.relay.mode.serving.default_channel_index",
+	"end_device.mac_state.current_parameters.relay.mode.serving.join_request_filters",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.current_parameters.rx1_delay",
 	"end_device.mac_state.current_parameters.rx2_data_rate_index",
@@ -2051,6 +2779,29 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.desired_parameters.relay",
+	"end_device.mac_state.desired_parameters.relay.mode",
+	"end_device.mac_state.desired_parameters.relay.mode.served",
+	"end_device.mac_state.desired_parameters.relay.mode.served.backoff",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.always",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.mode.served.serving_device_id",
+	"end_device.mac_state.desired_parameters.relay.mode.serving",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.join_request_filters",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.desired_parameters.rx1_delay",
 	"end_device.mac_state.desired_parameters.rx2_data_rate_index",
@@ -2087,6 +2838,8 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.mac_state.pending_join_request.rx_delay",
+	"end_device.mac_state.pending_relay_downlink",
+	"end_device.mac_state.pending_relay_downlink.raw_payload",
 	"end_device.mac_state.pending_requests",
 	"end_device.mac_state.ping_slot_periodicity",
 	"end_device.mac_state.ping_slot_periodicity.value",
@@ -2161,6 +2914,29 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.current_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay",
+	"end_device.pending_mac_state.current_parameters.relay.mode",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.backoff",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.always",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.dynamic",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.serving_device_id",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.default_channel_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.join_request_filters",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.current_parameters.rx1_delay",
 	"end_device.pending_mac_state.current_parameters.rx2_data_rate_index",
@@ -2189,6 +2965,29 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay",
+	"end_device.pending_mac_state.desired_parameters.relay.mode",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.backoff",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.always",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.serving_device_id",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.join_request_filters",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.desired_parameters.rx1_delay",
 	"end_device.pending_mac_state.desired_parameters.rx2_data_rate_index",
@@ -2225,6 +3024,8 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.pending_mac_state.pending_join_request.rx_delay",
+	"end_device.pending_mac_state.pending_relay_downlink",
+	"end_device.pending_mac_state.pending_relay_downlink.raw_payload",
 	"end_device.pending_mac_state.pending_requests",
 	"end_device.pending_mac_state.ping_slot_periodicity",
 	"end_device.pending_mac_state.ping_slot_periodicity.value",
@@ -2435,6 +3236,29 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_settings.desired_ping_slot_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_frequency",
 	"end_device.mac_settings.desired_ping_slot_frequency.value",
+	"end_device.mac_settings.desired_relay",
+	"end_device.mac_settings.desired_relay.mode",
+	"end_device.mac_settings.desired_relay.mode.served",
+	"end_device.mac_settings.desired_relay.mode.served.backoff",
+	"end_device.mac_settings.desired_relay.mode.served.mode",
+	"end_device.mac_settings.desired_relay.mode.served.mode.always",
+	"end_device.mac_settings.desired_relay.mode.served.mode.dynamic",
+	"end_device.mac_settings.desired_relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_settings.desired_relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.mode.served.serving_device_id",
+	"end_device.mac_settings.desired_relay.mode.serving",
+	"end_device.mac_settings.desired_relay.mode.serving.cad_periodicity",
+	"end_device.mac_settings.desired_relay.mode.serving.default_channel_index",
+	"end_device.mac_settings.desired_relay.mode.serving.join_request_filters",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_settings.desired_rx1_data_rate_offset",
 	"end_device.mac_settings.desired_rx1_data_rate_offset.value",
 	"end_device.mac_settings.desired_rx1_delay",
@@ -2452,6 +3276,29 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_settings.ping_slot_frequency.value",
 	"end_device.mac_settings.ping_slot_periodicity",
 	"end_device.mac_settings.ping_slot_periodicity.value",
+	"end_device.mac_settings.relay",
+	"end_device.mac_settings.relay.mode",
+	"end_device.mac_settings.relay.mode.served",
+	"end_device.mac_settings.relay.mode.served.backoff",
+	"end_device.mac_settings.relay.mode.served.mode",
+	"end_device.mac_settings.relay.mode.served.mode.always",
+	"end_device.mac_settings.relay.mode.served.mode.dynamic",
+	"end_device.mac_settings.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_settings.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_settings.relay.mode.served.second_channel",
+	"end_device.mac_settings.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_settings.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_settings.relay.mode.served.second_channel.frequency",
+	"end_device.mac_settings.relay.mode.served.serving_device_id",
+	"end_device.mac_settings.relay.mode.serving",
+	"end_device.mac_settings.relay.mode.serving.cad_periodicity",
+	"end_device.mac_settings.relay.mode.serving.default_channel_index",
+	"end_device.mac_settings.relay.mode.serving.join_request_filters",
+	"end_device.mac_settings.relay.mode.serving.second_channel",
+	"end_device.mac_settings.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_settings.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_settings.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_settings.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_settings.resets_f_cnt",
 	"end_device.mac_settings.resets_f_cnt.value",
 	"end_device.mac_settings.rx1_data_rate_offset",
@@ -2491,6 +3338,29 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.current_parameters.ping_slot_frequency",
 	"end_device.mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.current_parameters.relay",
+	"end_device.mac_state.current_parameters.relay.mode",
+	"end_device.mac_state.current_parameters.relay.mode.served",
+	"end_device.mac_state.current_parameters.relay.mode.served.backoff",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.always",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.dynamic",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.mode.served.serving_device_id",
+	"end_device.mac_state.current_parameters.relay.mode.serving",
+	"end_device.mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.mac_state.current_parameters.relay.mode.serving.default_channel_index",
+	"end_device.mac_state.current_parameters.relay.mode.serving.join_request_filters",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.current_parameters.rx1_delay",
 	"end_device.mac_state.current_parameters.rx2_data_rate_index",
@@ -2519,6 +3389,29 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.desired_parameters.relay",
+	"end_device.mac_state.desired_parameters.relay.mode",
+	"end_device.mac_state.desired_parameters.relay.mode.served",
+	"end_device.mac_state.desired_parameters.relay.mode.served.backoff",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.always",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.mode.served.serving_device_id",
+	"end_device.mac_state.desired_parameters.relay.mode.serving",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.join_request_filters",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.desired_parameters.rx1_delay",
 	"end_device.mac_state.desired_parameters.rx2_data_rate_index",
@@ -2555,6 +3448,8 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.mac_state.pending_join_request.rx_delay",
+	"end_device.mac_state.pending_relay_downlink",
+	"end_device.mac_state.pending_relay_downlink.raw_payload",
 	"end_device.mac_state.pending_requests",
 	"end_device.mac_state.ping_slot_periodicity",
 	"end_device.mac_state.ping_slot_periodicity.value",
@@ -2629,6 +3524,29 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.current_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay",
+	"end_device.pending_mac_state.current_parameters.relay.mode",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.backoff",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.always",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.dynamic",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.serving_device_id",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.default_channel_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.join_request_filters",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.current_parameters.rx1_delay",
 	"end_device.pending_mac_state.current_parameters.rx2_data_rate_index",
@@ -2657,6 +3575,29 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay",
+	"end_device.pending_mac_state.desired_parameters.relay.mode",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.backoff",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.always",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.serving_device_id",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.join_request_filters",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.desired_parameters.rx1_delay",
 	"end_device.pending_mac_state.desired_parameters.rx2_data_rate_index",
@@ -2693,6 +3634,8 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.pending_mac_state.pending_join_request.rx_delay",
+	"end_device.pending_mac_state.pending_relay_downlink",
+	"end_device.pending_mac_state.pending_relay_downlink.raw_payload",
 	"end_device.pending_mac_state.pending_requests",
 	"end_device.pending_mac_state.ping_slot_periodicity",
 	"end_device.pending_mac_state.ping_slot_periodicity.value",
@@ -2874,6 +3817,30 @@ var MACParameters_ChannelFieldPathsTopLevel = []string{
 	"min_data_rate_index",
 	"uplink_frequency",
 }
+var ServingRelayParameters_UplinkForwardingRuleFieldPathsNested = []string{
+	"device_id",
+	"last_w_f_cnt",
+	"limits",
+	"limits.bucket_size",
+	"limits.reload_rate",
+}
+
+var ServingRelayParameters_UplinkForwardingRuleFieldPathsTopLevel = []string{
+	"device_id",
+	"last_w_f_cnt",
+	"limits",
+}
+var ServingRelayParameters_JoinRequestFilterFieldPathsNested = []string{
+	"action",
+	"dev_eui",
+	"join_eui",
+}
+
+var ServingRelayParameters_JoinRequestFilterFieldPathsTopLevel = []string{
+	"action",
+	"dev_eui",
+	"join_eui",
+}
 var MACState_JoinRequestFieldPathsNested = []string{
 	"cf_list",
 	"cf_list.ch_masks",
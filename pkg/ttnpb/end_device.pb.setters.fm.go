@@ -424,6 +424,31 @@ func (dst *MACParameters) SetFields(src *MACParameters, paths ...string) error {
 					dst.PingSlotDataRateIndexValue = nil
 				}
 			}
+		case "relay":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayParameters
+				if (src == nil || src.Relay == nil) && dst.Relay == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Relay
+				}
+				if dst.Relay != nil {
+					newDst = dst.Relay
+				} else {
+					newDst = &RelayParameters{}
+					dst.Relay = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Relay = src.Relay
+				} else {
+					dst.Relay = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1244,6 +1269,404 @@ func (dst *MACSettings) SetFields(src *MACSettings, paths ...string) error {
 			} else {
 				dst.ClassBCDownlinkInterval = nil
 			}
+		case "relay":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayParameters
+				if (src == nil || src.Relay == nil) && dst.Relay == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Relay
+				}
+				if dst.Relay != nil {
+					newDst = dst.Relay
+				} else {
+					newDst = &RelayParameters{}
+					dst.Relay = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Relay = src.Relay
+				} else {
+					dst.Relay = nil
+				}
+			}
+		case "desired_relay":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayParameters
+				if (src == nil || src.DesiredRelay == nil) && dst.DesiredRelay == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.DesiredRelay
+				}
+				if dst.DesiredRelay != nil {
+					newDst = dst.DesiredRelay
+				} else {
+					newDst = &RelayParameters{}
+					dst.DesiredRelay = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DesiredRelay = src.DesiredRelay
+				} else {
+					dst.DesiredRelay = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ServingRelayParameters) SetFields(src *ServingRelayParameters, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "second_channel":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelaySecondChannel
+				if (src == nil || src.SecondChannel == nil) && dst.SecondChannel == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SecondChannel
+				}
+				if dst.SecondChannel != nil {
+					newDst = dst.SecondChannel
+				} else {
+					newDst = &RelaySecondChannel{}
+					dst.SecondChannel = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SecondChannel = src.SecondChannel
+				} else {
+					dst.SecondChannel = nil
+				}
+			}
+		case "default_channel_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'default_channel_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DefaultChannelIndex = src.DefaultChannelIndex
+			} else {
+				var zero uint32
+				dst.DefaultChannelIndex = zero
+			}
+		case "cad_periodicity":
+			if len(subs) > 0 {
+				return fmt.Errorf("'cad_periodicity' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CadPeriodicity = src.CadPeriodicity
+			} else {
+				var zero RelayCADPeriodicity
+				dst.CadPeriodicity = zero
+			}
+		case "uplink_forwarding_rules":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_forwarding_rules' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkForwardingRules = src.UplinkForwardingRules
+			} else {
+				dst.UplinkForwardingRules = nil
+			}
+		case "join_request_filters":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_request_filters' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinRequestFilters = src.JoinRequestFilters
+			} else {
+				dst.JoinRequestFilters = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ServedRelayParameters) SetFields(src *ServedRelayParameters, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "backoff":
+			if len(subs) > 0 {
+				return fmt.Errorf("'backoff' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Backoff = src.Backoff
+			} else {
+				var zero uint32
+				dst.Backoff = zero
+			}
+		case "second_channel":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelaySecondChannel
+				if (src == nil || src.SecondChannel == nil) && dst.SecondChannel == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SecondChannel
+				}
+				if dst.SecondChannel != nil {
+					newDst = dst.SecondChannel
+				} else {
+					newDst = &RelaySecondChannel{}
+					dst.SecondChannel = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SecondChannel = src.SecondChannel
+				} else {
+					dst.SecondChannel = nil
+				}
+			}
+		case "serving_device_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'serving_device_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ServingDeviceId = src.ServingDeviceId
+			} else {
+				var zero string
+				dst.ServingDeviceId = zero
+			}
+
+		case "mode":
+			if len(subs) == 0 && src == nil {
+				dst.Mode = nil
+				continue
+			} else if len(subs) == 0 {
+				dst.Mode = src.Mode
+				continue
+			}
+
+			subPathMap := _processPaths(subs)
+			if len(subPathMap) > 1 {
+				return fmt.Errorf("more than one field specified for oneof field '%s'", name)
+			}
+			for oneofName, oneofSubs := range subPathMap {
+				switch oneofName {
+				case "always":
+					_, srcOk := src.Mode.(*ServedRelayParameters_Always)
+					if !srcOk && src.Mode != nil {
+						return fmt.Errorf("attempt to set oneof 'always', while different oneof is set in source")
+					}
+					_, dstOk := dst.Mode.(*ServedRelayParameters_Always)
+					if !dstOk && dst.Mode != nil {
+						return fmt.Errorf("attempt to set oneof 'always', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *RelayEndDeviceAlwaysMode
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Mode.(*ServedRelayParameters_Always).Always
+						}
+						if dstOk {
+							newDst = dst.Mode.(*ServedRelayParameters_Always).Always
+						} else {
+							newDst = &RelayEndDeviceAlwaysMode{}
+							dst.Mode = &ServedRelayParameters_Always{Always: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Mode = src.Mode
+						} else {
+							dst.Mode = nil
+						}
+					}
+				case "dynamic":
+					_, srcOk := src.Mode.(*ServedRelayParameters_Dynamic)
+					if !srcOk && src.Mode != nil {
+						return fmt.Errorf("attempt to set oneof 'dynamic', while different oneof is set in source")
+					}
+					_, dstOk := dst.Mode.(*ServedRelayParameters_Dynamic)
+					if !dstOk && dst.Mode != nil {
+						return fmt.Errorf("attempt to set oneof 'dynamic', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *RelayEndDeviceDynamicMode
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Mode.(*ServedRelayParameters_Dynamic).Dynamic
+						}
+						if dstOk {
+							newDst = dst.Mode.(*ServedRelayParameters_Dynamic).Dynamic
+						} else {
+							newDst = &RelayEndDeviceDynamicMode{}
+							dst.Mode = &ServedRelayParameters_Dynamic{Dynamic: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Mode = src.Mode
+						} else {
+							dst.Mode = nil
+						}
+					}
+				case "end_device_controlled":
+					_, srcOk := src.Mode.(*ServedRelayParameters_EndDeviceControlled)
+					if !srcOk && src.Mode != nil {
+						return fmt.Errorf("attempt to set oneof 'end_device_controlled', while different oneof is set in source")
+					}
+					_, dstOk := dst.Mode.(*ServedRelayParameters_EndDeviceControlled)
+					if !dstOk && dst.Mode != nil {
+						return fmt.Errorf("attempt to set oneof 'end_device_controlled', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *RelayEndDeviceControlledMode
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Mode.(*ServedRelayParameters_EndDeviceControlled).EndDeviceControlled
+						}
+						if dstOk {
+							newDst = dst.Mode.(*ServedRelayParameters_EndDeviceControlled).EndDeviceControlled
+						} else {
+							newDst = &RelayEndDeviceControlledMode{}
+							dst.Mode = &ServedRelayParameters_EndDeviceControlled{EndDeviceControlled: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Mode = src.Mode
+						} else {
+							dst.Mode = nil
+						}
+					}
+
+				default:
+					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RelayParameters) SetFields(src *RelayParameters, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+
+		case "mode":
+			if len(subs) == 0 && src == nil {
+				dst.Mode = nil
+				continue
+			} else if len(subs) == 0 {
+				dst.Mode = src.Mode
+				continue
+			}
+
+			subPathMap := _processPaths(subs)
+			if len(subPathMap) > 1 {
+				return fmt.Errorf("more than one field specified for oneof field '%s'", name)
+			}
+			for oneofName, oneofSubs := range subPathMap {
+				switch oneofName {
+				case "serving":
+					_, srcOk := src.Mode.(*RelayParameters_Serving)
+					if !srcOk && src.Mode != nil {
+						return fmt.Errorf("attempt to set oneof 'serving', while different oneof is set in source")
+					}
+					_, dstOk := dst.Mode.(*RelayParameters_Serving)
+					if !dstOk && dst.Mode != nil {
+						return fmt.Errorf("attempt to set oneof 'serving', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *ServingRelayParameters
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Mode.(*RelayParameters_Serving).Serving
+						}
+						if dstOk {
+							newDst = dst.Mode.(*RelayParameters_Serving).Serving
+						} else {
+							newDst = &ServingRelayParameters{}
+							dst.Mode = &RelayParameters_Serving{Serving: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Mode = src.Mode
+						} else {
+							dst.Mode = nil
+						}
+					}
+				case "served":
+					_, srcOk := src.Mode.(*RelayParameters_Served)
+					if !srcOk && src.Mode != nil {
+						return fmt.Errorf("attempt to set oneof 'served', while different oneof is set in source")
+					}
+					_, dstOk := dst.Mode.(*RelayParameters_Served)
+					if !dstOk && dst.Mode != nil {
+						return fmt.Errorf("attempt to set oneof 'served', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *ServedRelayParameters
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Mode.(*RelayParameters_Served).Served
+						}
+						if dstOk {
+							newDst = dst.Mode.(*RelayParameters_Served).Served
+						} else {
+							newDst = &ServedRelayParameters{}
+							dst.Mode = &RelayParameters_Served{Served: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Mode = src.Mode
+						} else {
+							dst.Mode = nil
+						}
+					}
+
+				default:
+					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1540,6 +1963,31 @@ func (dst *MACState) SetFields(src *MACState, paths ...string) error {
 				var zero uint32
 				dst.LastAdrChangeFCntUp = zero
 			}
+		case "pending_relay_downlink":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayForwardDownlinkReq
+				if (src == nil || src.PendingRelayDownlink == nil) && dst.PendingRelayDownlink == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.PendingRelayDownlink
+				}
+				if dst.PendingRelayDownlink != nil {
+					newDst = dst.PendingRelayDownlink
+				} else {
+					newDst = &RelayForwardDownlinkReq{}
+					dst.PendingRelayDownlink = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.PendingRelayDownlink = src.PendingRelayDownlink
+				} else {
+					dst.PendingRelayDownlink = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -2782,6 +3230,103 @@ func (dst *MACParameters_Channel) SetFields(src *MACParameters_Channel, paths ..
 	return nil
 }
 
+func (dst *ServingRelayParameters_UplinkForwardingRule) SetFields(src *ServingRelayParameters_UplinkForwardingRule, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "device_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceId = src.DeviceId
+			} else {
+				var zero string
+				dst.DeviceId = zero
+			}
+		case "limits":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayForwardLimits
+				if (src == nil || src.Limits == nil) && dst.Limits == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Limits
+				}
+				if dst.Limits != nil {
+					newDst = dst.Limits
+				} else {
+					newDst = &RelayForwardLimits{}
+					dst.Limits = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Limits = src.Limits
+				} else {
+					dst.Limits = nil
+				}
+			}
+		case "last_w_f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_w_f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastWFCnt = src.LastWFCnt
+			} else {
+				var zero uint32
+				dst.LastWFCnt = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ServingRelayParameters_JoinRequestFilter) SetFields(src *ServingRelayParameters_JoinRequestFilter, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "action":
+			if len(subs) > 0 {
+				return fmt.Errorf("'action' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Action = src.Action
+			} else {
+				var zero RelayJoinRequestFilterAction
+				dst.Action = zero
+			}
+		case "join_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinEui = src.JoinEui
+			} else {
+				var zero go_thethings_network_lorawan_stack_v3_pkg_types.EUI64
+				dst.JoinEui = zero
+			}
+		case "dev_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevEui = src.DevEui
+			} else {
+				var zero go_thethings_network_lorawan_stack_v3_pkg_types.EUI64
+				dst.DevEui = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *MACState_JoinRequest) SetFields(src *MACState_JoinRequest, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
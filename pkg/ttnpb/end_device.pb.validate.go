@@ -461,6 +461,18 @@ func (m *MACParameters) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "relay":
+
+			if v, ok := interface{}(m.GetRelay()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACParametersValidationError{
+						field:  "relay",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return MACParametersValidationError{
 				field:  name,
@@ -1060,6 +1072,30 @@ func (m *MACSettings) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "relay":
+
+			if v, ok := interface{}(m.GetRelay()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACSettingsValidationError{
+						field:  "relay",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "desired_relay":
+
+			if v, ok := interface{}(m.GetDesiredRelay()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACSettingsValidationError{
+						field:  "desired_relay",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return MACSettingsValidationError{
 				field:  name,
@@ -1124,6 +1160,474 @@ var _ interface {
 	ErrorName() string
 } = MACSettingsValidationError{}
 
+// ValidateFields checks the field values on ServingRelayParameters with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ServingRelayParameters) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ServingRelayParametersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "second_channel":
+
+			if v, ok := interface{}(m.GetSecondChannel()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ServingRelayParametersValidationError{
+						field:  "second_channel",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "default_channel_index":
+
+			if m.GetDefaultChannelIndex() > 3 {
+				return ServingRelayParametersValidationError{
+					field:  "default_channel_index",
+					reason: "value must be less than or equal to 3",
+				}
+			}
+
+		case "cad_periodicity":
+
+			if _, ok := RelayCADPeriodicity_name[int32(m.GetCadPeriodicity())]; !ok {
+				return ServingRelayParametersValidationError{
+					field:  "cad_periodicity",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "uplink_forwarding_rules":
+
+			if len(m.GetUplinkForwardingRules()) > 16 {
+				return ServingRelayParametersValidationError{
+					field:  "uplink_forwarding_rules",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetUplinkForwardingRules() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ServingRelayParametersValidationError{
+							field:  fmt.Sprintf("uplink_forwarding_rules[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "join_request_filters":
+
+			if len(m.GetJoinRequestFilters()) > 16 {
+				return ServingRelayParametersValidationError{
+					field:  "join_request_filters",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetJoinRequestFilters() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ServingRelayParametersValidationError{
+							field:  fmt.Sprintf("join_request_filters[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ServingRelayParametersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ServingRelayParametersValidationError is the validation error returned by
+// ServingRelayParameters.ValidateFields if the designated constraints aren't met.
+type ServingRelayParametersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServingRelayParametersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServingRelayParametersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServingRelayParametersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServingRelayParametersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServingRelayParametersValidationError) ErrorName() string {
+	return "ServingRelayParametersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ServingRelayParametersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServingRelayParameters.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServingRelayParametersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServingRelayParametersValidationError{}
+
+// ValidateFields checks the field values on ServedRelayParameters with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ServedRelayParameters) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ServedRelayParametersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "mode":
+			if m.Mode == nil {
+				return ServedRelayParametersValidationError{
+					field:  "mode",
+					reason: "value is required",
+				}
+			}
+			if len(subs) == 0 {
+				subs = []string{
+					"always", "dynamic", "end_device_controlled",
+				}
+			}
+			for name, subs := range _processPaths(subs) {
+				_ = subs
+				switch name {
+				case "always":
+					w, ok := m.Mode.(*ServedRelayParameters_Always)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetAlways()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ServedRelayParametersValidationError{
+								field:  "always",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "dynamic":
+					w, ok := m.Mode.(*ServedRelayParameters_Dynamic)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetDynamic()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ServedRelayParametersValidationError{
+								field:  "dynamic",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "end_device_controlled":
+					w, ok := m.Mode.(*ServedRelayParameters_EndDeviceControlled)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetEndDeviceControlled()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ServedRelayParametersValidationError{
+								field:  "end_device_controlled",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				}
+			}
+		case "backoff":
+
+			if m.GetBackoff() > 63 {
+				return ServedRelayParametersValidationError{
+					field:  "backoff",
+					reason: "value must be less than or equal to 63",
+				}
+			}
+
+		case "second_channel":
+
+			if v, ok := interface{}(m.GetSecondChannel()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ServedRelayParametersValidationError{
+						field:  "second_channel",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "serving_device_id":
+
+			if utf8.RuneCountInString(m.GetServingDeviceId()) > 36 {
+				return ServedRelayParametersValidationError{
+					field:  "serving_device_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_ServedRelayParameters_ServingDeviceId_Pattern.MatchString(m.GetServingDeviceId()) {
+				return ServedRelayParametersValidationError{
+					field:  "serving_device_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		default:
+			return ServedRelayParametersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ServedRelayParametersValidationError is the validation error returned by
+// ServedRelayParameters.ValidateFields if the designated constraints aren't met.
+type ServedRelayParametersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServedRelayParametersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServedRelayParametersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServedRelayParametersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServedRelayParametersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServedRelayParametersValidationError) ErrorName() string {
+	return "ServedRelayParametersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ServedRelayParametersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServedRelayParameters.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServedRelayParametersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServedRelayParametersValidationError{}
+
+var _ServedRelayParameters_ServingDeviceId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on RelayParameters with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RelayParameters) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RelayParametersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "mode":
+			if len(subs) == 0 {
+				subs = []string{
+					"serving", "served",
+				}
+			}
+			for name, subs := range _processPaths(subs) {
+				_ = subs
+				switch name {
+				case "serving":
+					w, ok := m.Mode.(*RelayParameters_Serving)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetServing()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return RelayParametersValidationError{
+								field:  "serving",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "served":
+					w, ok := m.Mode.(*RelayParameters_Served)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetServed()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return RelayParametersValidationError{
+								field:  "served",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				}
+			}
+		default:
+			return RelayParametersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RelayParametersValidationError is the validation error returned by
+// RelayParameters.ValidateFields if the designated constraints aren't met.
+type RelayParametersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelayParametersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelayParametersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelayParametersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelayParametersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelayParametersValidationError) ErrorName() string {
+	return "RelayParametersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelayParametersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelayParameters.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelayParametersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelayParametersValidationError{}
+
 // ValidateFields checks the field values on MACState with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
 // is returned.
@@ -1414,6 +1918,18 @@ func (m *MACState) ValidateFields(paths ...string) error {
 
 		case "last_adr_change_f_cnt_up":
 			// no validation rules for LastAdrChangeFCntUp
+		case "pending_relay_downlink":
+
+			if v, ok := interface{}(m.GetPendingRelayDownlink()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACStateValidationError{
+						field:  "pending_relay_downlink",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return MACStateValidationError{
 				field:  name,
@@ -3652,6 +4168,215 @@ var _ interface {
 	ErrorName() string
 } = MACParameters_ChannelValidationError{}
 
+// ValidateFields checks the field values on
+// ServingRelayParameters_UplinkForwardingRule with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *ServingRelayParameters_UplinkForwardingRule) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ServingRelayParameters_UplinkForwardingRuleFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "device_id":
+
+			if utf8.RuneCountInString(m.GetDeviceId()) > 36 {
+				return ServingRelayParameters_UplinkForwardingRuleValidationError{
+					field:  "device_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_ServingRelayParameters_UplinkForwardingRule_DeviceId_Pattern.MatchString(m.GetDeviceId()) {
+				return ServingRelayParameters_UplinkForwardingRuleValidationError{
+					field:  "device_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$|^$\"",
+				}
+			}
+
+		case "limits":
+
+			if v, ok := interface{}(m.GetLimits()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ServingRelayParameters_UplinkForwardingRuleValidationError{
+						field:  "limits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_w_f_cnt":
+			// no validation rules for LastWFCnt
+		default:
+			return ServingRelayParameters_UplinkForwardingRuleValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// error returned by
+// ServingRelayParameters_UplinkForwardingRule.ValidateFields if the
+// designated constraints aren't met.
+type ServingRelayParameters_UplinkForwardingRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServingRelayParameters_UplinkForwardingRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServingRelayParameters_UplinkForwardingRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServingRelayParameters_UplinkForwardingRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServingRelayParameters_UplinkForwardingRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServingRelayParameters_UplinkForwardingRuleValidationError) ErrorName() string {
+	return "ServingRelayParameters_UplinkForwardingRuleValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ServingRelayParameters_UplinkForwardingRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServingRelayParameters_UplinkForwardingRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServingRelayParameters_UplinkForwardingRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServingRelayParameters_UplinkForwardingRuleValidationError{}
+
+var _ServingRelayParameters_UplinkForwardingRule_DeviceId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$|^$")
+
+// ValidateFields checks the field values on
+// ServingRelayParameters_JoinRequestFilter with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *ServingRelayParameters_JoinRequestFilter) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ServingRelayParameters_JoinRequestFilterFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "action":
+
+			if _, ok := RelayJoinRequestFilterAction_name[int32(m.GetAction())]; !ok {
+				return ServingRelayParameters_JoinRequestFilterValidationError{
+					field:  "action",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "join_eui":
+			// no validation rules for JoinEui
+		case "dev_eui":
+			// no validation rules for DevEui
+		default:
+			return ServingRelayParameters_JoinRequestFilterValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ServingRelayParameters_JoinRequestFilterValidationError is the validation
+// error returned by ServingRelayParameters_JoinRequestFilter.ValidateFields
+// if the designated constraints aren't met.
+type ServingRelayParameters_JoinRequestFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServingRelayParameters_JoinRequestFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServingRelayParameters_JoinRequestFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServingRelayParameters_JoinRequestFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServingRelayParameters_JoinRequestFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServingRelayParameters_JoinRequestFilterValidationError) ErrorName() string {
+	return "ServingRelayParameters_JoinRequestFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ServingRelayParameters_JoinRequestFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServingRelayParameters_JoinRequestFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServingRelayParameters_JoinRequestFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServingRelayParameters_JoinRequestFilterValidationError{}
+
 // ValidateFields checks the field values on MACState_JoinRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -355,6 +355,11 @@ func (x *MACParameters) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		s.WriteObjectField("ping_slot_data_rate_index_value")
 		x.PingSlotDataRateIndexValue.MarshalProtoJSON(s.WithField("ping_slot_data_rate_index_value"))
 	}
+	if x.Relay != nil || s.HasField("relay") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("relay")
+		x.Relay.MarshalProtoJSON(s.WithField("relay"))
+	}
 	s.WriteObjectEnd()
 }
 
@@ -459,6 +464,11 @@ func (x *MACParameters) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 				x.PingSlotDataRateIndexValue = &DataRateIndexValue{}
 				x.PingSlotDataRateIndexValue.UnmarshalProtoJSON(s.WithField("ping_slot_data_rate_index_value", false))
 			}
+		case "relay":
+			if !s.ReadNil() {
+				x.Relay = &RelayParameters{}
+				x.Relay.UnmarshalProtoJSON(s.WithField("relay", true))
+			}
 		}
 	})
 }
@@ -780,6 +790,16 @@ func (x *MACSettings) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 			s.WriteDuration(*x.ClassBCDownlinkInterval)
 		}
 	}
+	if x.Relay != nil || s.HasField("relay") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("relay")
+		x.Relay.MarshalProtoJSON(s.WithField("relay"))
+	}
+	if x.DesiredRelay != nil || s.HasField("desired_relay") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("desired_relay")
+		x.DesiredRelay.MarshalProtoJSON(s.WithField("desired_relay"))
+	}
 	s.WriteObjectEnd()
 }
 
@@ -979,6 +999,355 @@ func (x *MACSettings) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 				return
 			}
 			x.ClassBCDownlinkInterval = v
+		case "relay":
+			if !s.ReadNil() {
+				x.Relay = &RelayParameters{}
+				x.Relay.UnmarshalProtoJSON(s.WithField("relay", true))
+			}
+		case "desired_relay", "desiredRelay":
+			if !s.ReadNil() {
+				x.DesiredRelay = &RelayParameters{}
+				x.DesiredRelay.UnmarshalProtoJSON(s.WithField("desired_relay", true))
+			}
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the ServingRelayParameters_UplinkForwardingRule message to JSON.
+func (x *ServingRelayParameters_UplinkForwardingRule) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.DeviceId != "" || s.HasField("device_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("device_id")
+		s.WriteString(x.DeviceId)
+	}
+	if x.Limits != nil || s.HasField("limits") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("limits")
+		x.Limits.MarshalProtoJSON(s.WithField("limits"))
+	}
+	if x.LastWFCnt != 0 || s.HasField("last_w_f_cnt") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("last_w_f_cnt")
+		s.WriteUint32(x.LastWFCnt)
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the ServingRelayParameters_UplinkForwardingRule message from JSON.
+func (x *ServingRelayParameters_UplinkForwardingRule) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "device_id", "deviceId":
+			s.AddField("device_id")
+			x.DeviceId = s.ReadString()
+		case "limits":
+			if !s.ReadNil() {
+				x.Limits = &RelayForwardLimits{}
+				x.Limits.UnmarshalProtoJSON(s.WithField("limits", true))
+			}
+		case "last_w_f_cnt", "lastWFCnt":
+			s.AddField("last_w_f_cnt")
+			x.LastWFCnt = s.ReadUint32()
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the ServingRelayParameters_JoinRequestFilter message to JSON.
+func (x *ServingRelayParameters_JoinRequestFilter) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Action != 0 || s.HasField("action") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("action")
+		x.Action.MarshalProtoJSON(s)
+	}
+	if len(x.JoinEui) > 0 || s.HasField("join_eui") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("join_eui")
+		x.JoinEui.MarshalProtoJSON(s.WithField("join_eui"))
+	}
+	if len(x.DevEui) > 0 || s.HasField("dev_eui") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("dev_eui")
+		x.DevEui.MarshalProtoJSON(s.WithField("dev_eui"))
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the ServingRelayParameters_JoinRequestFilter message from JSON.
+func (x *ServingRelayParameters_JoinRequestFilter) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "action":
+			s.AddField("action")
+			x.Action.UnmarshalProtoJSON(s)
+		case "join_eui", "joinEui":
+			s.AddField("join_eui")
+			x.JoinEui.UnmarshalProtoJSON(s.WithField("join_eui", false))
+		case "dev_eui", "devEui":
+			s.AddField("dev_eui")
+			x.DevEui.UnmarshalProtoJSON(s.WithField("dev_eui", false))
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the ServingRelayParameters message to JSON.
+func (x *ServingRelayParameters) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.SecondChannel != nil || s.HasField("second_channel") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("second_channel")
+		x.SecondChannel.MarshalProtoJSON(s.WithField("second_channel"))
+	}
+	if x.DefaultChannelIndex != 0 || s.HasField("default_channel_index") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("default_channel_index")
+		s.WriteUint32(x.DefaultChannelIndex)
+	}
+	if x.CadPeriodicity != 0 || s.HasField("cad_periodicity") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("cad_periodicity")
+		x.CadPeriodicity.MarshalProtoJSON(s)
+	}
+	if len(x.UplinkForwardingRules) > 0 || s.HasField("uplink_forwarding_rules") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("uplink_forwarding_rules")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.UplinkForwardingRules {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("uplink_forwarding_rules"))
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.JoinRequestFilters) > 0 || s.HasField("join_request_filters") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("join_request_filters")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.JoinRequestFilters {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("join_request_filters"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the ServingRelayParameters message from JSON.
+func (x *ServingRelayParameters) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "second_channel", "secondChannel":
+			if !s.ReadNil() {
+				x.SecondChannel = &RelaySecondChannel{}
+				x.SecondChannel.UnmarshalProtoJSON(s.WithField("second_channel", true))
+			}
+		case "default_channel_index", "defaultChannelIndex":
+			s.AddField("default_channel_index")
+			x.DefaultChannelIndex = s.ReadUint32()
+		case "cad_periodicity", "cadPeriodicity":
+			s.AddField("cad_periodicity")
+			x.CadPeriodicity.UnmarshalProtoJSON(s)
+		case "uplink_forwarding_rules", "uplinkForwardingRules":
+			s.AddField("uplink_forwarding_rules")
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.UplinkForwardingRules = append(x.UplinkForwardingRules, nil)
+					return
+				}
+				v := &ServingRelayParameters_UplinkForwardingRule{}
+				v.UnmarshalProtoJSON(s.WithField("uplink_forwarding_rules", false))
+				if s.Err() != nil {
+					return
+				}
+				x.UplinkForwardingRules = append(x.UplinkForwardingRules, v)
+			})
+		case "join_request_filters", "joinRequestFilters":
+			s.AddField("join_request_filters")
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.JoinRequestFilters = append(x.JoinRequestFilters, nil)
+					return
+				}
+				v := &ServingRelayParameters_JoinRequestFilter{}
+				v.UnmarshalProtoJSON(s.WithField("join_request_filters", false))
+				if s.Err() != nil {
+					return
+				}
+				x.JoinRequestFilters = append(x.JoinRequestFilters, v)
+			})
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the ServedRelayParameters message to JSON.
+func (x *ServedRelayParameters) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Mode != nil {
+		switch ov := x.Mode.(type) {
+		case *ServedRelayParameters_Always:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("always")
+			// NOTE: RelayEndDeviceAlwaysMode does not seem to implement MarshalProtoJSON.
+			gogo.MarshalMessage(s, ov.Always)
+		case *ServedRelayParameters_Dynamic:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("dynamic")
+			ov.Dynamic.MarshalProtoJSON(s.WithField("dynamic"))
+		case *ServedRelayParameters_EndDeviceControlled:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("end_device_controlled")
+			// NOTE: RelayEndDeviceControlledMode does not seem to implement MarshalProtoJSON.
+			gogo.MarshalMessage(s, ov.EndDeviceControlled)
+		}
+	}
+	if x.Backoff != 0 || s.HasField("backoff") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("backoff")
+		s.WriteUint32(x.Backoff)
+	}
+	if x.SecondChannel != nil || s.HasField("second_channel") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("second_channel")
+		x.SecondChannel.MarshalProtoJSON(s.WithField("second_channel"))
+	}
+	if x.ServingDeviceId != "" || s.HasField("serving_device_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("serving_device_id")
+		s.WriteString(x.ServingDeviceId)
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the ServedRelayParameters message from JSON.
+func (x *ServedRelayParameters) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "always":
+			s.AddField("always")
+			ov := &ServedRelayParameters_Always{}
+			// NOTE: RelayEndDeviceAlwaysMode does not seem to implement UnmarshalProtoJSON.
+			var v RelayEndDeviceAlwaysMode
+			gogo.UnmarshalMessage(s, &v)
+			ov.Always = &v
+			x.Mode = ov
+		case "dynamic":
+			ov := &ServedRelayParameters_Dynamic{}
+			if !s.ReadNil() {
+				ov.Dynamic = &RelayEndDeviceDynamicMode{}
+				ov.Dynamic.UnmarshalProtoJSON(s.WithField("dynamic", true))
+			}
+			x.Mode = ov
+		case "end_device_controlled", "endDeviceControlled":
+			s.AddField("end_device_controlled")
+			ov := &ServedRelayParameters_EndDeviceControlled{}
+			// NOTE: RelayEndDeviceControlledMode does not seem to implement UnmarshalProtoJSON.
+			var v RelayEndDeviceControlledMode
+			gogo.UnmarshalMessage(s, &v)
+			ov.EndDeviceControlled = &v
+			x.Mode = ov
+		case "backoff":
+			s.AddField("backoff")
+			x.Backoff = s.ReadUint32()
+		case "second_channel", "secondChannel":
+			if !s.ReadNil() {
+				x.SecondChannel = &RelaySecondChannel{}
+				x.SecondChannel.UnmarshalProtoJSON(s.WithField("second_channel", true))
+			}
+		case "serving_device_id", "servingDeviceId":
+			s.AddField("serving_device_id")
+			x.ServingDeviceId = s.ReadString()
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the RelayParameters message to JSON.
+func (x *RelayParameters) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Mode != nil {
+		switch ov := x.Mode.(type) {
+		case *RelayParameters_Serving:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("serving")
+			ov.Serving.MarshalProtoJSON(s.WithField("serving"))
+		case *RelayParameters_Served:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("served")
+			ov.Served.MarshalProtoJSON(s.WithField("served"))
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the RelayParameters message from JSON.
+func (x *RelayParameters) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "serving":
+			ov := &RelayParameters_Serving{}
+			if !s.ReadNil() {
+				ov.Serving = &ServingRelayParameters{}
+				ov.Serving.UnmarshalProtoJSON(s.WithField("serving", true))
+			}
+			x.Mode = ov
+		case "served":
+			ov := &RelayParameters_Served{}
+			if !s.ReadNil() {
+				ov.Served = &ServedRelayParameters{}
+				ov.Served.UnmarshalProtoJSON(s.WithField("served", true))
+			}
+			x.Mode = ov
 		}
 	})
 }
@@ -1367,6 +1736,12 @@ func (x *MACState) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		s.WriteObjectField("last_adr_change_f_cnt_up")
 		s.WriteUint32(x.LastAdrChangeFCntUp)
 	}
+	if x.PendingRelayDownlink != nil || s.HasField("pending_relay_downlink") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("pending_relay_downlink")
+		// NOTE: RelayForwardDownlinkReq does not seem to implement MarshalProtoJSON.
+		gogo.MarshalMessage(s, x.PendingRelayDownlink)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -1521,6 +1896,12 @@ func (x *MACState) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 		case "last_adr_change_f_cnt_up", "lastAdrChangeFCntUp":
 			s.AddField("last_adr_change_f_cnt_up")
 			x.LastAdrChangeFCntUp = s.ReadUint32()
+		case "pending_relay_downlink", "pendingRelayDownlink":
+			s.AddField("pending_relay_downlink")
+			// NOTE: RelayForwardDownlinkReq does not seem to implement UnmarshalProtoJSON.
+			var v RelayForwardDownlinkReq
+			gogo.UnmarshalMessage(s, &v)
+			x.PendingRelayDownlink = &v
 		}
 	})
 }
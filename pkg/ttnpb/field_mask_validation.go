@@ -109,6 +109,29 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"mac_settings.desired_ping_slot_data_rate_index.value",
 	"mac_settings.desired_ping_slot_frequency",
 	"mac_settings.desired_ping_slot_frequency.value",
+	"mac_settings.desired_relay",
+	"mac_settings.desired_relay.mode",
+	"mac_settings.desired_relay.mode.served",
+	"mac_settings.desired_relay.mode.served.backoff",
+	"mac_settings.desired_relay.mode.served.mode",
+	"mac_settings.desired_relay.mode.served.mode.always",
+	"mac_settings.desired_relay.mode.served.mode.dynamic",
+	"mac_settings.desired_relay.mode.served.mode.dynamic.smart_enable_level",
+	"mac_settings.desired_relay.mode.served.mode.end_device_controlled",
+	"mac_settings.desired_relay.mode.served.second_channel",
+	"mac_settings.desired_relay.mode.served.second_channel.ack_offset",
+	"mac_settings.desired_relay.mode.served.second_channel.data_rate_index",
+	"mac_settings.desired_relay.mode.served.second_channel.frequency",
+	"mac_settings.desired_relay.mode.served.serving_device_id",
+	"mac_settings.desired_relay.mode.serving",
+	"mac_settings.desired_relay.mode.serving.cad_periodicity",
+	"mac_settings.desired_relay.mode.serving.default_channel_index",
+	"mac_settings.desired_relay.mode.serving.join_request_filters",
+	"mac_settings.desired_relay.mode.serving.second_channel",
+	"mac_settings.desired_relay.mode.serving.second_channel.ack_offset",
+	"mac_settings.desired_relay.mode.serving.second_channel.data_rate_index",
+	"mac_settings.desired_relay.mode.serving.second_channel.frequency",
+	"mac_settings.desired_relay.mode.serving.uplink_forwarding_rules",
 	"mac_settings.desired_rx1_data_rate_offset",
 	"mac_settings.desired_rx1_data_rate_offset.value",
 	"mac_settings.desired_rx1_delay",
@@ -126,6 +149,29 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"mac_settings.ping_slot_frequency.value",
 	"mac_settings.ping_slot_periodicity",
 	"mac_settings.ping_slot_periodicity.value",
+	"mac_settings.relay",
+	"mac_settings.relay.mode",
+	"mac_settings.relay.mode.served",
+	"mac_settings.relay.mode.served.backoff",
+	"mac_settings.relay.mode.served.mode",
+	"mac_settings.relay.mode.served.mode.always",
+	"mac_settings.relay.mode.served.mode.dynamic",
+	"mac_settings.relay.mode.served.mode.dynamic.smart_enable_level",
+	"mac_settings.relay.mode.served.mode.end_device_controlled",
+	"mac_settings.relay.mode.served.second_channel",
+	"mac_settings.relay.mode.served.second_channel.ack_offset",
+	"mac_settings.relay.mode.served.second_channel.data_rate_index",
+	"mac_settings.relay.mode.served.second_channel.frequency",
+	"mac_settings.relay.mode.served.serving_device_id",
+	"mac_settings.relay.mode.serving",
+	"mac_settings.relay.mode.serving.cad_periodicity",
+	"mac_settings.relay.mode.serving.default_channel_index",
+	"mac_settings.relay.mode.serving.join_request_filters",
+	"mac_settings.relay.mode.serving.second_channel",
+	"mac_settings.relay.mode.serving.second_channel.ack_offset",
+	"mac_settings.relay.mode.serving.second_channel.data_rate_index",
+	"mac_settings.relay.mode.serving.second_channel.frequency",
+	"mac_settings.relay.mode.serving.uplink_forwarding_rules",
 	"mac_settings.resets_f_cnt",
 	"mac_settings.resets_f_cnt.value",
 	"mac_settings.rx1_data_rate_offset",
@@ -162,6 +208,29 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"mac_state.current_parameters.ping_slot_frequency",
 	"mac_state.current_parameters.rejoin_count_periodicity",
 	"mac_state.current_parameters.rejoin_time_periodicity",
+	"mac_state.current_parameters.relay",
+	"mac_state.current_parameters.relay.mode",
+	"mac_state.current_parameters.relay.mode.served",
+	"mac_state.current_parameters.relay.mode.served.backoff",
+	"mac_state.current_parameters.relay.mode.served.mode",
+	"mac_state.current_parameters.relay.mode.served.mode.always",
+	"mac_state.current_parameters.relay.mode.served.mode.dynamic",
+	"mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+	"mac_state.current_parameters.relay.mode.served.second_channel",
+	"mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+	"mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+	"mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+	"mac_state.current_parameters.relay.mode.served.serving_device_id",
+	"mac_state.current_parameters.relay.mode.serving",
+	"mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+	"mac_state.current_parameters.relay.mode.serving.default_channel_index",
+	"mac_state.current_parameters.relay.mode.serving.join_request_filters",
+	"mac_state.current_parameters.relay.mode.serving.second_channel",
+	"mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+	"mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+	"mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"mac_state.current_parameters.rx1_data_rate_offset",
 	"mac_state.current_parameters.rx1_delay",
 	"mac_state.current_parameters.rx2_data_rate_index",
@@ -187,6 +256,29 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"mac_state.desired_parameters.ping_slot_frequency",
 	"mac_state.desired_parameters.rejoin_count_periodicity",
 	"mac_state.desired_parameters.rejoin_time_periodicity",
+	"mac_state.desired_parameters.relay",
+	"mac_state.desired_parameters.relay.mode",
+	"mac_state.desired_parameters.relay.mode.served",
+	"mac_state.desired_parameters.relay.mode.served.backoff",
+	"mac_state.desired_parameters.relay.mode.served.mode",
+	"mac_state.desired_parameters.relay.mode.served.mode.always",
+	"mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+	"mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+	"mac_state.desired_parameters.relay.mode.served.second_channel",
+	"mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+	"mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+	"mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+	"mac_state.desired_parameters.relay.mode.served.serving_device_id",
+	"mac_state.desired_parameters.relay.mode.serving",
+	"mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+	"mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+	"mac_state.desired_parameters.relay.mode.serving.join_request_filters",
+	"mac_state.desired_parameters.relay.mode.serving.second_channel",
+	"mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+	"mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+	"mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"mac_state.desired_parameters.rx1_data_rate_offset",
 	"mac_state.desired_parameters.rx1_delay",
 	"mac_state.desired_parameters.rx2_data_rate_index",
@@ -211,6 +303,8 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"mac_state.pending_application_downlink.frm_payload",
 	"mac_state.pending_application_downlink.priority",
 	"mac_state.pending_application_downlink.session_key_id",
+	"mac_state.pending_relay_downlink",
+	"mac_state.pending_relay_downlink.raw_payload",
 	"mac_state.pending_requests",
 	"mac_state.ping_slot_periodicity",
 	"mac_state.ping_slot_periodicity.value",
@@ -245,6 +339,29 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"pending_mac_state.current_parameters.ping_slot_frequency",
 	"pending_mac_state.current_parameters.rejoin_count_periodicity",
 	"pending_mac_state.current_parameters.rejoin_time_periodicity",
+	"pending_mac_state.current_parameters.relay",
+	"pending_mac_state.current_parameters.relay.mode",
+	"pending_mac_state.current_parameters.relay.mode.served",
+	"pending_mac_state.current_parameters.relay.mode.served.backoff",
+	"pending_mac_state.current_parameters.relay.mode.served.mode",
+	"pending_mac_state.current_parameters.relay.mode.served.mode.always",
+	"pending_mac_state.current_parameters.relay.mode.served.mode.dynamic",
+	"pending_mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"pending_mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+	"pending_mac_state.current_parameters.relay.mode.served.second_channel",
+	"pending_mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+	"pending_mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+	"pending_mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+	"pending_mac_state.current_parameters.relay.mode.served.serving_device_id",
+	"pending_mac_state.current_parameters.relay.mode.serving",
+	"pending_mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+	"pending_mac_state.current_parameters.relay.mode.serving.default_channel_index",
+	"pending_mac_state.current_parameters.relay.mode.serving.join_request_filters",
+	"pending_mac_state.current_parameters.relay.mode.serving.second_channel",
+	"pending_mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+	"pending_mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"pending_mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+	"pending_mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"pending_mac_state.current_parameters.rx1_data_rate_offset",
 	"pending_mac_state.current_parameters.rx1_delay",
 	"pending_mac_state.current_parameters.rx2_data_rate_index",
@@ -270,6 +387,29 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"pending_mac_state.desired_parameters.ping_slot_frequency",
 	"pending_mac_state.desired_parameters.rejoin_count_periodicity",
 	"pending_mac_state.desired_parameters.rejoin_time_periodicity",
+	"pending_mac_state.desired_parameters.relay",
+	"pending_mac_state.desired_parameters.relay.mode",
+	"pending_mac_state.desired_parameters.relay.mode.served",
+	"pending_mac_state.desired_parameters.relay.mode.served.backoff",
+	"pending_mac_state.desired_parameters.relay.mode.served.mode",
+	"pending_mac_state.desired_parameters.relay.mode.served.mode.always",
+	"pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+	"pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"pending_mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+	"pending_mac_state.desired_parameters.relay.mode.served.second_channel",
+	"pending_mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+	"pending_mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+	"pending_mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+	"pending_mac_state.desired_parameters.relay.mode.served.serving_device_id",
+	"pending_mac_state.desired_parameters.relay.mode.serving",
+	"pending_mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+	"pending_mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+	"pending_mac_state.desired_parameters.relay.mode.serving.join_request_filters",
+	"pending_mac_state.desired_parameters.relay.mode.serving.second_channel",
+	"pending_mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+	"pending_mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"pending_mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+	"pending_mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"pending_mac_state.desired_parameters.rx1_data_rate_offset",
 	"pending_mac_state.desired_parameters.rx1_delay",
 	"pending_mac_state.desired_parameters.rx2_data_rate_index",
@@ -293,6 +433,8 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"pending_mac_state.pending_join_request.rx_delay",
+	"pending_mac_state.pending_relay_downlink",
+	"pending_mac_state.pending_relay_downlink.raw_payload",
 	"pending_mac_state.ping_slot_periodicity",
 	"pending_mac_state.ping_slot_periodicity.value",
 	"pending_mac_state.queued_join_accept",
@@ -523,8 +665,10 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"resets_join_nonces",
 			"root_keys",
 			"root_keys.app_key",
+			"root_keys.app_key.kek_label",
 			"root_keys.app_key.key",
 			"root_keys.nwk_key",
+			"root_keys.nwk_key.kek_label",
 			"root_keys.nwk_key.key",
 			"root_keys.root_key_id",
 			"used_dev_nonces",
@@ -558,8 +702,10 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"resets_join_nonces",
 			"root_keys",
 			"root_keys.app_key",
+			"root_keys.app_key.kek_label",
 			"root_keys.app_key.key",
 			"root_keys.nwk_key",
+			"root_keys.nwk_key.kek_label",
 			"root_keys.nwk_key.key",
 			"root_keys.root_key_id",
 			"used_dev_nonces",
@@ -603,6 +749,29 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"mac_settings.desired_ping_slot_data_rate_index.value",
 			"mac_settings.desired_ping_slot_frequency",
 			"mac_settings.desired_ping_slot_frequency.value",
+			"mac_settings.desired_relay",
+			"mac_settings.desired_relay.mode",
+			"mac_settings.desired_relay.mode.served",
+			"mac_settings.desired_relay.mode.served.backoff",
+			"mac_settings.desired_relay.mode.served.mode",
+			"mac_settings.desired_relay.mode.served.mode.always",
+			"mac_settings.desired_relay.mode.served.mode.dynamic",
+			"mac_settings.desired_relay.mode.served.mode.dynamic.smart_enable_level",
+			"mac_settings.desired_relay.mode.served.mode.end_device_controlled",
+			"mac_settings.desired_relay.mode.served.second_channel",
+			"mac_settings.desired_relay.mode.served.second_channel.ack_offset",
+			"mac_settings.desired_relay.mode.served.second_channel.data_rate_index",
+			"mac_settings.desired_relay.mode.served.second_channel.frequency",
+			"mac_settings.desired_relay.mode.served.serving_device_id",
+			"mac_settings.desired_relay.mode.serving",
+			"mac_settings.desired_relay.mode.serving.cad_periodicity",
+			"mac_settings.desired_relay.mode.serving.default_channel_index",
+			"mac_settings.desired_relay.mode.serving.join_request_filters",
+			"mac_settings.desired_relay.mode.serving.second_channel",
+			"mac_settings.desired_relay.mode.serving.second_channel.ack_offset",
+			"mac_settings.desired_relay.mode.serving.second_channel.data_rate_index",
+			"mac_settings.desired_relay.mode.serving.second_channel.frequency",
+			"mac_settings.desired_relay.mode.serving.uplink_forwarding_rules",
 			"mac_settings.desired_rx1_data_rate_offset",
 			"mac_settings.desired_rx1_data_rate_offset.value",
 			"mac_settings.desired_rx1_delay",
@@ -620,6 +789,29 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"mac_settings.ping_slot_frequency.value",
 			"mac_settings.ping_slot_periodicity",
 			"mac_settings.ping_slot_periodicity.value",
+			"mac_settings.relay",
+			"mac_settings.relay.mode",
+			"mac_settings.relay.mode.served",
+			"mac_settings.relay.mode.served.backoff",
+			"mac_settings.relay.mode.served.mode",
+			"mac_settings.relay.mode.served.mode.always",
+			"mac_settings.relay.mode.served.mode.dynamic",
+			"mac_settings.relay.mode.served.mode.dynamic.smart_enable_level",
+			"mac_settings.relay.mode.served.mode.end_device_controlled",
+			"mac_settings.relay.mode.served.second_channel",
+			"mac_settings.relay.mode.served.second_channel.ack_offset",
+			"mac_settings.relay.mode.served.second_channel.data_rate_index",
+			"mac_settings.relay.mode.served.second_channel.frequency",
+			"mac_settings.relay.mode.served.serving_device_id",
+			"mac_settings.relay.mode.serving",
+			"mac_settings.relay.mode.serving.cad_periodicity",
+			"mac_settings.relay.mode.serving.default_channel_index",
+			"mac_settings.relay.mode.serving.join_request_filters",
+			"mac_settings.relay.mode.serving.second_channel",
+			"mac_settings.relay.mode.serving.second_channel.ack_offset",
+			"mac_settings.relay.mode.serving.second_channel.data_rate_index",
+			"mac_settings.relay.mode.serving.second_channel.frequency",
+			"mac_settings.relay.mode.serving.uplink_forwarding_rules",
 			"mac_settings.resets_f_cnt",
 			"mac_settings.resets_f_cnt.value",
 			"mac_settings.rx1_data_rate_offset",
@@ -656,6 +848,29 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"mac_state.current_parameters.ping_slot_frequency",
 			"mac_state.current_parameters.rejoin_count_periodicity",
 			"mac_state.current_parameters.rejoin_time_periodicity",
+			"mac_state.current_parameters.relay",
+			"mac_state.current_parameters.relay.mode",
+			"mac_state.current_parameters.relay.mode.served",
+			"mac_state.current_parameters.relay.mode.served.backoff",
+			"mac_state.current_parameters.relay.mode.served.mode",
+			"mac_state.current_parameters.relay.mode.served.mode.always",
+			"mac_state.current_parameters.relay.mode.served.mode.dynamic",
+			"mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+			"mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+			"mac_state.current_parameters.relay.mode.served.second_channel",
+			"mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+			"mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+			"mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+			"mac_state.current_parameters.relay.mode.served.serving_device_id",
+			"mac_state.current_parameters.relay.mode.serving",
+			"mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+			"mac_state.current_parameters.relay.mode.serving.default_channel_index",
+			"mac_state.current_parameters.relay.mode.serving.join_request_filters",
+			"mac_state.current_parameters.relay.mode.serving.second_channel",
+			"mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+			"mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+			"mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+			"mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
 			"mac_state.current_parameters.rx1_data_rate_offset",
 			"mac_state.current_parameters.rx1_delay",
 			"mac_state.current_parameters.rx2_data_rate_index",
@@ -681,6 +896,29 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"mac_state.desired_parameters.ping_slot_frequency",
 			"mac_state.desired_parameters.rejoin_count_periodicity",
 			"mac_state.desired_parameters.rejoin_time_periodicity",
+			"mac_state.desired_parameters.relay",
+			"mac_state.desired_parameters.relay.mode",
+			"mac_state.desired_parameters.relay.mode.served",
+			"mac_state.desired_parameters.relay.mode.served.backoff",
+			"mac_state.desired_parameters.relay.mode.served.mode",
+			"mac_state.desired_parameters.relay.mode.served.mode.always",
+			"mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+			"mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+			"mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+			"mac_state.desired_parameters.relay.mode.served.second_channel",
+			"mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+			"mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+			"mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+			"mac_state.desired_parameters.relay.mode.served.serving_device_id",
+			"mac_state.desired_parameters.relay.mode.serving",
+			"mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+			"mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+			"mac_state.desired_parameters.relay.mode.serving.join_request_filters",
+			"mac_state.desired_parameters.relay.mode.serving.second_channel",
+			"mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+			"mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+			"mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+			"mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
 			"mac_state.desired_parameters.rx1_data_rate_offset",
 			"mac_state.desired_parameters.rx1_delay",
 			"mac_state.desired_parameters.rx2_data_rate_index",
@@ -705,6 +943,8 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"mac_state.pending_application_downlink.frm_payload",
 			"mac_state.pending_application_downlink.priority",
 			"mac_state.pending_application_downlink.session_key_id",
+			"mac_state.pending_relay_downlink",
+			"mac_state.pending_relay_downlink.raw_payload",
 			"mac_state.pending_requests",
 			"mac_state.ping_slot_periodicity",
 			"mac_state.ping_slot_periodicity.value",
@@ -739,6 +979,29 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"pending_mac_state.current_parameters.ping_slot_frequency",
 			"pending_mac_state.current_parameters.rejoin_count_periodicity",
 			"pending_mac_state.current_parameters.rejoin_time_periodicity",
+			"pending_mac_state.current_parameters.relay",
+			"pending_mac_state.current_parameters.relay.mode",
+			"pending_mac_state.current_parameters.relay.mode.served",
+			"pending_mac_state.current_parameters.relay.mode.served.backoff",
+			"pending_mac_state.current_parameters.relay.mode.served.mode",
+			"pending_mac_state.current_parameters.relay.mode.served.mode.always",
+			"pending_mac_state.current_parameters.relay.mode.served.mode.dynamic",
+			"pending_mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+			"pending_mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+			"pending_mac_state.current_parameters.relay.mode.served.second_channel",
+			"pending_mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+			"pending_mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+			"pending_mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+			"pending_mac_state.current_parameters.relay.mode.served.serving_device_id",
+			"pending_mac_state.current_parameters.relay.mode.serving",
+			"pending_mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+			"pending_mac_state.current_parameters.relay.mode.serving.default_channel_index",
+			"pending_mac_state.current_parameters.relay.mode.serving.join_request_filters",
+			"pending_mac_state.current_parameters.relay.mode.serving.second_channel",
+			"pending_mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+			"pending_mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+			"pending_mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+			"pending_mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
 			"pending_mac_state.current_parameters.rx1_data_rate_offset",
 			"pending_mac_state.current_parameters.rx1_delay",
 			"pending_mac_state.current_parameters.rx2_data_rate_index",
@@ -764,6 +1027,29 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"pending_mac_state.desired_parameters.ping_slot_frequency",
 			"pending_mac_state.desired_parameters.rejoin_count_periodicity",
 			"pending_mac_state.desired_parameters.rejoin_time_periodicity",
+			"pending_mac_state.desired_parameters.relay",
+			"pending_mac_state.desired_parameters.relay.mode",
+			"pending_mac_state.desired_parameters.relay.mode.served",
+			"pending_mac_state.desired_parameters.relay.mode.served.backoff",
+			"pending_mac_state.desired_parameters.relay.mode.served.mode",
+			"pending_mac_state.desired_parameters.relay.mode.served.mode.always",
+			"pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+			"pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+			"pending_mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+			"pending_mac_state.desired_parameters.relay.mode.served.second_channel",
+			"pending_mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+			"pending_mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+			"pending_mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+			"pending_mac_state.desired_parameters.relay.mode.served.serving_device_id",
+			"pending_mac_state.desired_parameters.relay.mode.serving",
+			"pending_mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+			"pending_mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+			"pending_mac_state.desired_parameters.relay.mode.serving.join_request_filters",
+			"pending_mac_state.desired_parameters.relay.mode.serving.second_channel",
+			"pending_mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+			"pending_mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+			"pending_mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+			"pending_mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
 			"pending_mac_state.desired_parameters.rx1_data_rate_offset",
 			"pending_mac_state.desired_parameters.rx1_delay",
 			"pending_mac_state.desired_parameters.rx2_data_rate_index",
@@ -787,6 +1073,8 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 			"pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
 			"pending_mac_state.pending_join_request.rx_delay",
+			"pending_mac_state.pending_relay_downlink",
+			"pending_mac_state.pending_relay_downlink.raw_payload",
 			"pending_mac_state.ping_slot_periodicity",
 			"pending_mac_state.ping_slot_periodicity.value",
 			"pending_mac_state.queued_join_accept",
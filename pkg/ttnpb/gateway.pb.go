@@ -1137,6 +1137,7 @@ type CreateGatewayAPIKeyRequest struct {
 	Name                 string              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Rights               []Right             `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
 	ExpiresAt            *time.Time          `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	Restrictions         *APIKeyRestrictions `protobuf:"bytes,5,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
 }
@@ -1192,6 +1193,13 @@ func (m *CreateGatewayAPIKeyRequest) GetExpiresAt() *time.Time {
 	return nil
 }
 
+func (m *CreateGatewayAPIKeyRequest) GetRestrictions() *APIKeyRestrictions {
+	if m != nil {
+		return m.Restrictions
+	}
+	return nil
+}
+
 type UpdateGatewayAPIKeyRequest struct {
 	GatewayIds *GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
 	ApiKey     *APIKey             `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
@@ -1859,190 +1867,191 @@ func init() {
 }
 
 var fileDescriptor_1df6bae1ac946b39 = []byte{
-	// 2924 bytes of a gzipped FileDescriptorProto
+	// 2939 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
-	0xf5, 0xf7, 0x92, 0x92, 0x48, 0x0d, 0x25, 0x8a, 0x9a, 0xc8, 0xf2, 0x8a, 0xb6, 0x25, 0xfd, 0x19,
-	0x25, 0x91, 0xf4, 0x37, 0xc9, 0x86, 0x8e, 0x83, 0x5a, 0xf9, 0x50, 0x48, 0x5a, 0x76, 0x14, 0xcb,
-	0x96, 0xba, 0x92, 0x1a, 0xc0, 0x5f, 0x8b, 0xe1, 0xee, 0x90, 0xdc, 0x68, 0xb9, 0xbb, 0x99, 0x9d,
-	0x95, 0xc4, 0x7c, 0x35, 0x28, 0x5a, 0xa0, 0xe8, 0xa1, 0x48, 0xd3, 0x02, 0x2d, 0x02, 0x34, 0x87,
-	0xa2, 0x05, 0x8a, 0x9c, 0x8a, 0x1e, 0x7b, 0xea, 0xa1, 0x87, 0x02, 0x05, 0x8a, 0x00, 0x45, 0x81,
-	0xa2, 0x40, 0x5b, 0xc4, 0x39, 0x34, 0xc8, 0xa9, 0x67, 0x9d, 0x8a, 0x99, 0x9d, 0x5d, 0x2e, 0x29,
-	0x53, 0x91, 0x12, 0xbb, 0xe8, 0x89, 0x3b, 0x33, 0xbf, 0xf7, 0xe6, 0xbd, 0x37, 0x6f, 0xde, 0xbc,
-	0xf7, 0x08, 0x66, 0x4c, 0x9b, 0xa0, 0x3d, 0x64, 0xe5, 0x5d, 0x8a, 0xb4, 0x9d, 0x22, 0x72, 0x8c,
-	0x62, 0x03, 0x51, 0xbc, 0x87, 0xda, 0x05, 0x87, 0xd8, 0xd4, 0x86, 0x69, 0x4a, 0xad, 0x82, 0x00,
-	0x15, 0x76, 0x2f, 0x66, 0xcb, 0x0d, 0x83, 0x36, 0xbd, 0x5a, 0x41, 0xb3, 0x5b, 0x45, 0x6c, 0xed,
-	0xda, 0x6d, 0x87, 0xd8, 0xfb, 0xed, 0x22, 0x07, 0x6b, 0xf9, 0x06, 0xb6, 0xf2, 0xbb, 0xc8, 0x34,
-	0x74, 0x44, 0x71, 0xf1, 0xd0, 0x87, 0xcf, 0x32, 0x9b, 0x8f, 0xb0, 0x68, 0xd8, 0x0d, 0xdb, 0x27,
-	0xae, 0x79, 0x75, 0x3e, 0xe2, 0x03, 0xfe, 0x25, 0xe0, 0xd5, 0x08, 0x7c, 0xab, 0x89, 0xb7, 0x9a,
-	0x86, 0xd5, 0x70, 0x57, 0x2d, 0xdd, 0x73, 0x29, 0x31, 0xb0, 0x1b, 0xdd, 0xba, 0x61, 0xe7, 0x5f,
-	0x73, 0x6d, 0xab, 0x88, 0x2c, 0xcb, 0xa6, 0x88, 0x1a, 0xb6, 0xe5, 0x0a, 0x26, 0xd3, 0x0d, 0xdb,
-	0x6e, 0x98, 0xb8, 0xb3, 0x95, 0xee, 0x11, 0x0e, 0x10, 0xeb, 0xb3, 0xbd, 0xeb, 0x75, 0x03, 0x9b,
-	0xba, 0xda, 0x42, 0xee, 0x8e, 0x40, 0x9c, 0xeb, 0x45, 0xb8, 0x94, 0x78, 0x1a, 0x15, 0xab, 0x33,
-	0xbd, 0xab, 0xd4, 0x68, 0x61, 0x97, 0xa2, 0x96, 0x23, 0x00, 0x73, 0x87, 0x0d, 0xad, 0xd9, 0x16,
-	0x45, 0x1a, 0x55, 0x0d, 0xab, 0x1e, 0xe8, 0x7a, 0xfe, 0x30, 0x0a, 0x5b, 0x5e, 0x2b, 0xd0, 0xe2,
-	0xf1, 0xc3, 0xcb, 0x86, 0x8e, 0x2d, 0x6a, 0xd4, 0x0d, 0x4c, 0x02, 0xd0, 0xec, 0x61, 0x50, 0x0b,
-	0x53, 0xa4, 0x23, 0x8a, 0x02, 0x63, 0x1c, 0x46, 0x10, 0xa3, 0xd1, 0xa4, 0x01, 0x87, 0x07, 0x38,
-	0x85, 0x8b, 0x35, 0x82, 0x03, 0x40, 0xee, 0x16, 0x18, 0xb9, 0xe6, 0x7b, 0x49, 0x85, 0x20, 0x4b,
-	0x87, 0x69, 0x10, 0x33, 0x74, 0x59, 0x9a, 0x95, 0xe6, 0x87, 0x95, 0x98, 0xa1, 0x43, 0x08, 0x06,
-	0x2c, 0xd4, 0xc2, 0x72, 0x8c, 0xcf, 0xf0, 0x6f, 0x98, 0x01, 0x71, 0x8f, 0x98, 0x72, 0x9c, 0x4f,
-	0xb1, 0x4f, 0x38, 0x01, 0x06, 0x4d, 0xbb, 0x61, 0xbb, 0xf2, 0xc0, 0x6c, 0x7c, 0x7e, 0x58, 0xf1,
-	0x07, 0xb9, 0x5f, 0x4a, 0x21, 0xf3, 0x1b, 0xb6, 0x8e, 0x4d, 0xb8, 0x02, 0x92, 0x35, 0xb6, 0x8b,
-	0x1a, 0x6c, 0x51, 0x59, 0x3c, 0xa8, 0x3c, 0x45, 0x9e, 0x90, 0xe7, 0x4a, 0xd3, 0xf7, 0x6e, 0xa3,
-	0xfc, 0x1b, 0x5f, 0xcb, 0x5f, 0xbe, 0x3b, 0xbf, 0xbc, 0x74, 0x3b, 0x7f, 0x77, 0x39, 0x18, 0x2e,
-	0xbc, 0x59, 0xba, 0xf0, 0xf6, 0xdc, 0xc7, 0x92, 0xa4, 0x24, 0x38, 0xed, 0xaa, 0x0e, 0x97, 0xb8,
-	0x8c, 0xb1, 0x13, 0x33, 0x88, 0xea, 0x13, 0xef, 0xe8, 0x93, 0xfb, 0x61, 0x0c, 0x4c, 0x09, 0x39,
-	0xbf, 0x89, 0x89, 0x6b, 0xd8, 0xd6, 0x6a, 0xe7, 0x28, 0x1e, 0x96, 0xd0, 0x2b, 0x20, 0xd9, 0x62,
-	0x46, 0x50, 0xbf, 0x94, 0xe8, 0x09, 0x4e, 0xbb, 0xaa, 0xc3, 0x12, 0xc8, 0x34, 0x11, 0xd1, 0xf7,
-	0x10, 0xc1, 0xea, 0xae, 0x2f, 0xac, 0xaf, 0x4b, 0x25, 0x71, 0x50, 0x19, 0x20, 0x31, 0x79, 0x56,
-	0x19, 0x0b, 0x00, 0x42, 0x19, 0x46, 0x53, 0x37, 0x48, 0xab, 0x8b, 0x66, 0xa0, 0x87, 0x26, 0x00,
-	0x08, 0x9a, 0xdc, 0xfd, 0x58, 0x78, 0x76, 0x0a, 0xd2, 0x0d, 0x1b, 0x4e, 0x82, 0x21, 0x6c, 0xa1,
-	0x9a, 0x89, 0xb9, 0x11, 0x92, 0x8a, 0x18, 0xc1, 0xb3, 0x60, 0x58, 0x6b, 0x1a, 0x8e, 0x4a, 0xdb,
-	0x4e, 0xe0, 0x25, 0x49, 0x36, 0xb1, 0xd5, 0x76, 0x30, 0x3c, 0x07, 0x86, 0xeb, 0x04, 0xbf, 0xee,
-	0x61, 0x4b, 0x6b, 0x73, 0x31, 0x07, 0x94, 0xce, 0x04, 0x9c, 0x01, 0x29, 0xe2, 0xba, 0x86, 0x6a,
-	0xd7, 0xeb, 0x2e, 0xa6, 0x5c, 0xa4, 0x98, 0x02, 0xd8, 0xd4, 0x3a, 0x9f, 0x81, 0xaf, 0x82, 0x0c,
-	0xdd, 0x57, 0x35, 0xdb, 0xaa, 0x1b, 0x0d, 0x71, 0xc9, 0xe5, 0xc1, 0x59, 0x69, 0x3e, 0x55, 0xba,
-	0x50, 0xe8, 0x0e, 0x66, 0x85, 0xa8, 0xac, 0x85, 0xad, 0xfd, 0x6a, 0x94, 0x46, 0x19, 0xa3, 0xdd,
-	0x13, 0xd9, 0xef, 0x48, 0x60, 0xac, 0x07, 0x04, 0x1f, 0x07, 0xa3, 0x2d, 0xc3, 0x52, 0x3b, 0xf2,
-	0x4a, 0x5c, 0xde, 0x91, 0x96, 0x61, 0x5d, 0x0d, 0x45, 0x66, 0x20, 0xb4, 0x1f, 0x01, 0xc5, 0x04,
-	0x08, 0xed, 0x77, 0x40, 0x4f, 0x81, 0x31, 0xcb, 0xa6, 0x5a, 0x53, 0xed, 0xd5, 0x3d, 0xcd, 0xa7,
-	0x43, 0x60, 0xee, 0x4f, 0x12, 0x98, 0x16, 0x82, 0x57, 0x4d, 0x64, 0xb4, 0xca, 0x1e, 0x6d, 0x32,
-	0xc7, 0xd3, 0xb8, 0x44, 0x55, 0x5b, 0xc7, 0xb0, 0x00, 0x86, 0xfc, 0x0b, 0xcb, 0xc5, 0x49, 0x95,
-	0x26, 0x7b, 0x15, 0xdf, 0xe4, 0xab, 0x8a, 0x40, 0xc1, 0x65, 0x00, 0x78, 0x8c, 0x56, 0xeb, 0xc4,
-	0x6e, 0x71, 0xe9, 0x52, 0xa5, 0x6c, 0xc1, 0x0f, 0x69, 0x85, 0x20, 0xa4, 0x15, 0xb6, 0x82, 0x90,
-	0x56, 0x19, 0x78, 0xef, 0x9f, 0x33, 0x92, 0x32, 0xcc, 0x69, 0xae, 0x12, 0xbb, 0x05, 0x9f, 0x03,
-	0x49, 0x9f, 0x01, 0xb5, 0xb9, 0xd4, 0xc7, 0x21, 0x4f, 0x70, 0x8a, 0x2d, 0x3b, 0xf7, 0xe7, 0x0c,
-	0x48, 0x08, 0x85, 0xe0, 0x8b, 0x20, 0x6e, 0xe8, 0xae, 0x10, 0x3b, 0xd7, 0xe7, 0xbc, 0x22, 0x17,
-	0xad, 0x92, 0x3c, 0xa8, 0x0c, 0x7e, 0x5f, 0x8a, 0x65, 0x24, 0x85, 0x11, 0x32, 0x4d, 0x34, 0x82,
-	0x11, 0xc5, 0xba, 0x8a, 0xe8, 0xf1, 0x35, 0x11, 0x34, 0x65, 0x6e, 0x0a, 0xcf, 0xd1, 0x03, 0x06,
-	0xc7, 0xd5, 0x65, 0x58, 0xd0, 0xf8, 0x0c, 0x74, 0x6c, 0x62, 0xc1, 0x20, 0x7b, 0x5c, 0x06, 0x82,
-	0xa6, 0x4c, 0xe1, 0x59, 0x11, 0x6c, 0xba, 0x2e, 0x5b, 0x49, 0x44, 0xd1, 0x45, 0x90, 0xd2, 0xb1,
-	0xab, 0x11, 0xc3, 0x09, 0xfd, 0x7a, 0x98, 0xdb, 0x80, 0xc4, 0xe5, 0x8f, 0xc7, 0x94, 0xe8, 0x22,
-	0x7c, 0x07, 0x00, 0x44, 0x29, 0x31, 0x6a, 0x1e, 0xc5, 0xae, 0x3c, 0x34, 0x1b, 0x9f, 0x4f, 0x95,
-	0x9e, 0xea, 0x63, 0xd2, 0x42, 0x39, 0x44, 0xae, 0x58, 0x94, 0xb4, 0x2b, 0x97, 0x0e, 0x2a, 0xa5,
-	0x0f, 0xa4, 0x62, 0x06, 0xe4, 0xe6, 0x48, 0xee, 0x8b, 0xc3, 0xcd, 0x22, 0x13, 0xe0, 0x0f, 0x92,
-	0x12, 0xd9, 0x11, 0xbe, 0x0c, 0x46, 0xa2, 0x4f, 0x9c, 0x9c, 0xe0, 0x12, 0x9c, 0xed, 0x95, 0xa0,
-	0xea, 0x63, 0x56, 0xad, 0xba, 0xcd, 0x35, 0x79, 0x5f, 0x8a, 0x65, 0x80, 0x92, 0xd2, 0x3a, 0xd3,
-	0xf0, 0x15, 0x90, 0x12, 0x21, 0x48, 0x65, 0xde, 0x91, 0xe4, 0x46, 0x5d, 0xe8, 0xa3, 0xca, 0xe1,
-	0x68, 0xac, 0x80, 0xdd, 0x60, 0xce, 0x85, 0x7f, 0x91, 0xc0, 0xa4, 0x48, 0x71, 0x54, 0x17, 0x93,
-	0x5d, 0x4c, 0x54, 0xa4, 0xeb, 0x04, 0xbb, 0xae, 0x3c, 0xcc, 0xad, 0xf9, 0xa1, 0x74, 0x50, 0xf9,
-	0x40, 0x22, 0x3f, 0x91, 0x4a, 0x3f, 0x96, 0xee, 0xcd, 0x33, 0x35, 0xef, 0xbe, 0x59, 0xba, 0x70,
-	0xe9, 0xed, 0xa5, 0x62, 0x71, 0x61, 0x79, 0x7e, 0x79, 0x89, 0xe9, 0x8f, 0xf2, 0x6f, 0x94, 0xf3,
-	0xb7, 0x98, 0xfa, 0x6f, 0x45, 0xbe, 0x3b, 0x9f, 0x77, 0xf2, 0x77, 0x17, 0x23, 0x0b, 0x0b, 0x77,
-	0x0a, 0x0b, 0x8b, 0x8c, 0xae, 0x9c, 0xbf, 0x25, 0xcc, 0xf6, 0x56, 0xe4, 0xbb, 0xf3, 0xc9, 0xe9,
-	0x3a, 0x0b, 0x0b, 0xf3, 0xcb, 0x4b, 0x4b, 0xb7, 0xd9, 0xd7, 0x9b, 0x4f, 0x5f, 0xb8, 0xf4, 0xf6,
-	0xc2, 0xf2, 0xdc, 0x5b, 0xf7, 0xe6, 0x94, 0x09, 0x21, 0xfe, 0x26, 0x97, 0xbe, 0xec, 0x0b, 0xcf,
-	0xe2, 0x22, 0xf2, 0xa8, 0xad, 0xfa, 0x9e, 0x28, 0x03, 0x1e, 0x6f, 0x01, 0x9b, 0xda, 0xe6, 0x33,
-	0xb0, 0x08, 0xd2, 0xfe, 0x9a, 0xaa, 0x35, 0x91, 0x65, 0x61, 0x53, 0x4e, 0x45, 0xbd, 0xe7, 0x5d,
-	0x49, 0x19, 0xf5, 0xd7, 0xab, 0xfe, 0x32, 0xbc, 0x08, 0xc6, 0xc3, 0x58, 0xa4, 0x3a, 0x26, 0x62,
-	0xc6, 0x97, 0x47, 0xa2, 0x5e, 0xf9, 0x92, 0x32, 0x16, 0x22, 0x36, 0x4c, 0x64, 0xad, 0xea, 0xf0,
-	0x79, 0x00, 0x0f, 0x11, 0xb9, 0xf2, 0x04, 0x7b, 0xe1, 0x2b, 0xe9, 0x83, 0x4a, 0xea, 0x7d, 0x29,
-	0x99, 0x49, 0xe6, 0x7c, 0xe2, 0x4c, 0x0f, 0xb1, 0x0b, 0xaf, 0x80, 0x24, 0xb2, 0x28, 0xb6, 0x2c,
-	0xe4, 0xca, 0xa3, 0xdc, 0x5d, 0xa6, 0xfb, 0x9c, 0x72, 0xd9, 0x87, 0x85, 0x1e, 0x93, 0x54, 0x42,
-	0x4a, 0x16, 0x6f, 0x5d, 0x8a, 0xa8, 0xe7, 0xaa, 0x8e, 0x57, 0x33, 0x0d, 0x4d, 0x4e, 0x73, 0x63,
-	0x8c, 0xf8, 0x93, 0x1b, 0x7c, 0x8e, 0xc5, 0x5b, 0xd3, 0xf6, 0x63, 0x66, 0x00, 0x1b, 0xe3, 0xb0,
-	0x74, 0x30, 0x2d, 0x80, 0xcf, 0x80, 0x49, 0x57, 0x6b, 0x62, 0xdd, 0x33, 0xb1, 0xaa, 0xdb, 0x7b,
-	0x96, 0x69, 0x58, 0x3b, 0xaa, 0xc9, 0x6c, 0x9c, 0xe1, 0xf8, 0x89, 0x60, 0xf5, 0x8a, 0x58, 0x5c,
-	0x63, 0xd6, 0xbe, 0x00, 0x20, 0xb6, 0xea, 0x36, 0xd1, 0xb0, 0xaa, 0x7b, 0xb4, 0xad, 0x6a, 0x6d,
-	0xcd, 0xc4, 0xf2, 0x38, 0xa7, 0xc8, 0x88, 0x95, 0x2b, 0x1e, 0x6d, 0x57, 0xd9, 0x3c, 0x7c, 0x0d,
-	0xc8, 0x21, 0x6b, 0x07, 0xd1, 0x26, 0x7b, 0xbe, 0x5c, 0x4a, 0x90, 0x61, 0x51, 0x19, 0xce, 0x4a,
-	0xf3, 0xe9, 0xd2, 0x93, 0xbd, 0x76, 0x08, 0x76, 0xdb, 0x40, 0xb4, 0x59, 0x0d, 0xd1, 0xdc, 0x1e,
-	0xdf, 0xe6, 0xf1, 0x70, 0x52, 0x7f, 0x20, 0x02, 0x6e, 0x47, 0xf4, 0x41, 0x56, 0x9b, 0x25, 0xaa,
-	0xaa, 0x8e, 0x4d, 0xd4, 0x96, 0x1f, 0xe3, 0xf7, 0x6a, 0xea, 0x50, 0xb0, 0xba, 0x22, 0x5e, 0xbb,
-	0xca, 0xc0, 0x4f, 0x59, 0xac, 0x0a, 0x15, 0x2e, 0xfb, 0xd4, 0x57, 0x18, 0x31, 0x7c, 0x01, 0x9c,
-	0x15, 0xee, 0x15, 0x9a, 0x95, 0xbd, 0x26, 0xaa, 0x6f, 0x74, 0xf9, 0x34, 0xd7, 0x5c, 0xf6, 0x21,
-	0x6b, 0x02, 0xc1, 0xde, 0x8e, 0x4d, 0xbe, 0x0e, 0x9f, 0x07, 0x69, 0xb3, 0xe6, 0xaa, 0xa6, 0xe5,
-	0xaa, 0xe2, 0xe9, 0x9a, 0x3c, 0xf2, 0xe9, 0x1a, 0x31, 0x6b, 0xee, 0x9a, 0xe5, 0xfa, 0x23, 0xf8,
-	0x1a, 0x98, 0xd2, 0xd8, 0x5b, 0xa8, 0xa2, 0xae, 0xc7, 0x50, 0xd5, 0x6c, 0x1d, 0xcb, 0x67, 0x38,
-	0xa3, 0x42, 0x1f, 0x47, 0xea, 0xf3, 0x86, 0x2a, 0x67, 0xb4, 0x3e, 0x8f, 0xeb, 0x45, 0x30, 0x46,
-	0x11, 0x69, 0x60, 0xaa, 0x6a, 0x9e, 0xe3, 0xaa, 0x1e, 0x31, 0x64, 0x99, 0x5f, 0x8a, 0xd4, 0x41,
-	0x25, 0x49, 0x86, 0xbe, 0x27, 0x49, 0x2c, 0xf7, 0x1a, 0xf5, 0x31, 0x55, 0xcf, 0x71, 0xb7, 0x89,
-	0x01, 0x5f, 0xec, 0x26, 0xda, 0xc1, 0x6d, 0x79, 0xea, 0x48, 0xfd, 0x22, 0xf4, 0xd7, 0x71, 0x1b,
-	0xbe, 0x0c, 0x66, 0xd9, 0x5d, 0x31, 0x08, 0x8e, 0xaa, 0x88, 0x75, 0xe6, 0x28, 0x16, 0xd6, 0xf8,
-	0x63, 0x70, 0x96, 0x9b, 0x78, 0x5a, 0xe0, 0xca, 0x51, 0x58, 0x35, 0x44, 0xc1, 0x67, 0xc1, 0x90,
-	0x49, 0xea, 0x4d, 0xd7, 0x95, 0xcf, 0x71, 0x01, 0xfa, 0x5d, 0xb0, 0xc2, 0x9a, 0x72, 0xf5, 0xe5,
-	0xcd, 0x4d, 0x45, 0xa0, 0xe1, 0x35, 0x30, 0xab, 0x1b, 0x2e, 0xcb, 0xde, 0x54, 0x07, 0x69, 0x3b,
-	0x98, 0xaa, 0x35, 0x62, 0xef, 0x60, 0xa2, 0xd6, 0x6d, 0xb2, 0x87, 0x88, 0x6e, 0x58, 0x0d, 0xf9,
-	0x3c, 0x97, 0xe0, 0xbc, 0xc0, 0x6d, 0x70, 0x58, 0x85, 0xa3, 0xae, 0x86, 0xa0, 0xec, 0x0b, 0x60,
-	0xac, 0xe7, 0xb1, 0x61, 0xb5, 0x01, 0xb3, 0x88, 0x5f, 0x40, 0xb0, 0x4f, 0x56, 0x1b, 0xec, 0x22,
-	0xd3, 0x0b, 0x92, 0x43, 0x7f, 0xb0, 0x14, 0xfb, 0xba, 0x94, 0x7d, 0x12, 0x0c, 0xf9, 0x92, 0xb1,
-	0x3c, 0xd1, 0xf5, 0x1c, 0xc7, 0x26, 0x14, 0xeb, 0x22, 0xbf, 0xec, 0x4c, 0xe4, 0x96, 0x41, 0x52,
-	0x68, 0xe2, 0xc2, 0x8b, 0x20, 0x29, 0x62, 0x26, 0x4b, 0x2d, 0x58, 0x58, 0x39, 0xd3, 0x2f, 0x15,
-	0x0c, 0x81, 0xb9, 0x9f, 0x49, 0x60, 0xfc, 0x1a, 0xa6, 0xc1, 0x02, 0x8b, 0x54, 0x2e, 0x85, 0x37,
-	0x40, 0x2a, 0x78, 0x3d, 0xbe, 0x6c, 0xa2, 0x02, 0x1a, 0xc1, 0xaa, 0x0b, 0x2f, 0x03, 0xd0, 0xa9,
-	0x34, 0xfb, 0xe6, 0x2b, 0x57, 0x19, 0xe4, 0x06, 0x72, 0x77, 0x94, 0xe1, 0x7a, 0xf0, 0x99, 0x7b,
-	0x1d, 0xe4, 0x3a, 0xe2, 0x45, 0x76, 0xba, 0x6a, 0x93, 0x95, 0xed, 0xd5, 0x40, 0xde, 0xeb, 0x20,
-	0x8e, 0x3d, 0x83, 0xcb, 0x39, 0x52, 0xb9, 0xfc, 0xb7, 0x7f, 0xcc, 0x5c, 0x6a, 0xd8, 0x05, 0xda,
-	0xc4, 0x94, 0x97, 0xd1, 0x05, 0x0b, 0xd3, 0x3d, 0x9b, 0xec, 0x14, 0xbb, 0x2b, 0xbe, 0xdd, 0x8b,
-	0x45, 0x67, 0xa7, 0x51, 0x64, 0xd9, 0xb9, 0x5b, 0x58, 0xd9, 0x5e, 0x7d, 0xf6, 0x19, 0x85, 0x71,
-	0xc9, 0x7d, 0x1e, 0x03, 0x8f, 0xad, 0x19, 0x6e, 0xb0, 0xa9, 0x1b, 0x6c, 0xf2, 0x0d, 0xf6, 0xd2,
-	0x9b, 0x26, 0xaa, 0xd9, 0x04, 0x51, 0x9b, 0x08, 0xab, 0xe4, 0x7b, 0xad, 0xb2, 0x4e, 0x1a, 0xc8,
-	0x32, 0xde, 0xe0, 0x57, 0x69, 0x9d, 0x6c, 0xbb, 0x98, 0x44, 0x1f, 0xe9, 0x2e, 0x16, 0x5f, 0xc1,
-	0x30, 0x70, 0x0f, 0x0c, 0xda, 0x44, 0xc7, 0x44, 0x94, 0x38, 0xe8, 0xa0, 0x72, 0x8f, 0xdc, 0x51,
-	0x4e, 0x85, 0x76, 0x57, 0x0d, 0x5d, 0x49, 0xe5, 0xa3, 0x83, 0xe0, 0x1b, 0x7b, 0x86, 0x32, 0x92,
-	0x8f, 0x8e, 0x78, 0xd6, 0xa5, 0x0c, 0xe6, 0xf9, 0x4f, 0x24, 0xb5, 0x54, 0x52, 0xf9, 0xc8, 0xc0,
-	0xdf, 0x0f, 0x4e, 0x83, 0x41, 0xd3, 0x68, 0x19, 0x7e, 0x51, 0x32, 0xca, 0x4f, 0x7c, 0x31, 0x2e,
-	0x7f, 0x96, 0x50, 0xfc, 0x69, 0x56, 0x46, 0x3a, 0xa8, 0x81, 0x79, 0xd6, 0x36, 0xaa, 0xf0, 0x6f,
-	0x28, 0x83, 0x84, 0x48, 0xfd, 0xe4, 0x21, 0xee, 0xc2, 0xc1, 0x30, 0xf7, 0x6b, 0x09, 0x4c, 0x54,
-	0xf9, 0x1e, 0x3d, 0x2e, 0xf8, 0x1c, 0x48, 0x08, 0x11, 0x85, 0xa1, 0xfb, 0x39, 0x73, 0xc4, 0xe7,
-	0x02, 0x0a, 0x78, 0xbb, 0xe7, 0xa8, 0x62, 0x5f, 0xe2, 0xa8, 0x22, 0x7c, 0xbb, 0x98, 0xe5, 0x7e,
-	0x20, 0x81, 0x09, 0x3f, 0xdb, 0x78, 0x98, 0x22, 0x7f, 0x85, 0x3b, 0xf2, 0xa1, 0x04, 0xa6, 0x22,
-	0x0e, 0x5b, 0xde, 0x58, 0xbd, 0x8e, 0x3b, 0x6e, 0xfb, 0x90, 0xef, 0x72, 0x78, 0xfc, 0xb1, 0xa3,
-	0x8f, 0x3f, 0xde, 0x39, 0xfe, 0xdc, 0xb7, 0xc0, 0x99, 0xce, 0x25, 0xf6, 0xc5, 0x7b, 0x44, 0xd2,
-	0x9d, 0x06, 0x43, 0x3b, 0xb8, 0x1d, 0x36, 0x12, 0x94, 0xc1, 0x1d, 0xdc, 0x5e, 0xd5, 0x73, 0x3f,
-	0x8a, 0x81, 0x6c, 0x97, 0x97, 0x3d, 0x52, 0x21, 0xce, 0x46, 0x1b, 0x43, 0xbd, 0xb5, 0xcd, 0x4b,
-	0x60, 0xc8, 0x6f, 0x43, 0xc9, 0xf1, 0xd9, 0xf8, 0x7c, 0xba, 0x74, 0xba, 0x77, 0x1b, 0x85, 0xad,
-	0x56, 0xc6, 0x0f, 0x2a, 0xe9, 0xf7, 0xa5, 0x54, 0x52, 0x92, 0xa5, 0x9c, 0x48, 0x75, 0x04, 0x1d,
-	0xbc, 0x06, 0x00, 0xde, 0x77, 0x0c, 0x82, 0x5d, 0x56, 0x7b, 0x0d, 0x7c, 0x61, 0xed, 0x35, 0x72,
-	0x50, 0x19, 0xfc, 0x8d, 0x14, 0x7b, 0x49, 0xf2, 0x6b, 0x30, 0x41, 0x5b, 0xa6, 0xb9, 0x4f, 0x24,
-	0x90, 0xed, 0x72, 0xe4, 0x47, 0x6a, 0x95, 0xcb, 0x20, 0x81, 0x1c, 0x83, 0x27, 0x05, 0xb1, 0x07,
-	0x27, 0x05, 0xfe, 0xf6, 0x11, 0xf2, 0x21, 0xe4, 0x18, 0x2c, 0x2f, 0xe8, 0xbe, 0x1b, 0xf1, 0x93,
-	0xdc, 0x8d, 0x5f, 0x48, 0x60, 0x26, 0x72, 0x37, 0xaa, 0x91, 0x8b, 0xfc, 0xbf, 0x74, 0x43, 0xfe,
-	0x28, 0x81, 0xf3, 0x9d, 0x2b, 0x12, 0x95, 0xf2, 0x11, 0x09, 0xf9, 0x48, 0x23, 0xe4, 0x6f, 0x25,
-	0x70, 0x7e, 0xf3, 0xbf, 0xa9, 0xcd, 0x2b, 0x0f, 0xd4, 0xe6, 0xdc, 0xe1, 0x22, 0xbc, 0x83, 0xe9,
-	0x2b, 0xfc, 0x67, 0x31, 0x90, 0xee, 0x2e, 0xbf, 0xd8, 0x89, 0x35, 0x90, 0x61, 0x71, 0x31, 0x63,
-	0x0a, 0xff, 0x86, 0xcf, 0x80, 0x64, 0x50, 0x02, 0x88, 0xed, 0xe4, 0xde, 0xed, 0x82, 0x02, 0x40,
-	0x09, 0x91, 0xf0, 0xbb, 0x52, 0x57, 0xbb, 0x22, 0xce, 0xd3, 0xb4, 0xc2, 0xd1, 0xd5, 0xdf, 0xa3,
-	0xe8, 0x5a, 0xac, 0x80, 0x61, 0xc7, 0x44, 0x1a, 0x6e, 0x61, 0xcb, 0x0f, 0x21, 0xe9, 0xbe, 0x4d,
-	0x13, 0x21, 0xc5, 0x46, 0x00, 0x57, 0x3a, 0x94, 0x5f, 0x31, 0xcb, 0xcd, 0xfd, 0x7c, 0x10, 0x8c,
-	0x8a, 0x5d, 0xc2, 0x02, 0x69, 0x80, 0x15, 0x5b, 0xc2, 0x21, 0x8e, 0x11, 0xd5, 0x92, 0x7e, 0x54,
-	0xe3, 0x54, 0xf0, 0x05, 0x30, 0x5c, 0xb3, 0x6d, 0xaa, 0x72, 0x16, 0xc7, 0x6d, 0x8b, 0x25, 0x19,
-	0x09, 0x9b, 0x84, 0xef, 0x80, 0xa4, 0x68, 0xa1, 0x04, 0x27, 0xf3, 0xff, 0x7d, 0x6c, 0xe2, 0x4b,
-	0x5b, 0x10, 0x4d, 0x98, 0x43, 0xc7, 0xf2, 0x04, 0x79, 0x5c, 0x9e, 0x2b, 0xcd, 0x74, 0x1d, 0x8b,
-	0x7a, 0xf8, 0x5c, 0xfc, 0xfe, 0x72, 0xb8, 0x27, 0x5c, 0x07, 0xe3, 0xa2, 0xba, 0x0f, 0xab, 0x4b,
-	0xff, 0x6f, 0x83, 0x23, 0x7c, 0x2b, 0xd2, 0x1a, 0xc8, 0x08, 0xe2, 0x60, 0x89, 0x45, 0xa2, 0x98,
-	0xe1, 0xc8, 0x83, 0x5d, 0x6d, 0x09, 0x90, 0x1b, 0x20, 0x31, 0x47, 0x52, 0x62, 0x86, 0x03, 0x3d,
-	0x90, 0x68, 0x61, 0x4a, 0x0c, 0x2d, 0x68, 0x9c, 0x2d, 0x1e, 0xad, 0xef, 0x0d, 0x1f, 0xec, 0xab,
-	0x5b, 0x3c, 0xa8, 0x5c, 0xf8, 0x40, 0x5a, 0x38, 0xb6, 0xba, 0x4a, 0xb0, 0x17, 0x2b, 0x54, 0x90,
-	0xbe, 0x8b, 0x2c, 0x0d, 0xeb, 0xb2, 0x26, 0x12, 0xa5, 0xde, 0x53, 0xda, 0xe4, 0xff, 0x3b, 0x29,
-	0x21, 0x30, 0xfb, 0x1c, 0x18, 0xed, 0x32, 0xf7, 0x89, 0xca, 0xa9, 0x25, 0x30, 0x12, 0x95, 0xfd,
-	0x8b, 0x68, 0x63, 0x51, 0x27, 0xfd, 0x57, 0x12, 0x4c, 0x86, 0x91, 0x2c, 0x28, 0x30, 0x99, 0x41,
-	0x5c, 0x58, 0xe5, 0xbd, 0x3f, 0x36, 0xe5, 0xf7, 0x41, 0xa5, 0x63, 0xba, 0x5c, 0x2a, 0xa4, 0x2a,
-	0x53, 0x98, 0x05, 0x49, 0xff, 0xcf, 0x3d, 0xdb, 0x0c, 0xfe, 0x24, 0x08, 0xc6, 0xf0, 0x55, 0x70,
-	0xc6, 0x44, 0x2e, 0x15, 0xed, 0x05, 0x95, 0x60, 0x0d, 0x1b, 0xbb, 0x27, 0x6b, 0xda, 0x4e, 0x30,
-	0x06, 0xfe, 0xf9, 0x29, 0x82, 0xbc, 0x4c, 0xe1, 0x8b, 0x20, 0x15, 0x61, 0x2c, 0x92, 0x88, 0xf3,
-	0x47, 0x9e, 0xbe, 0x02, 0x3a, 0x9c, 0x42, 0xc1, 0x3c, 0x87, 0x77, 0x73, 0xa2, 0x82, 0x0d, 0x9e,
-	0x44, 0xb0, 0x6d, 0x4e, 0x1f, 0x11, 0xec, 0xff, 0xc0, 0x88, 0xe0, 0xa9, 0xd9, 0x9e, 0x45, 0x79,
-	0xb9, 0x30, 0xa0, 0xa4, 0xfc, 0xb9, 0x2a, 0x9b, 0x82, 0xb7, 0xc1, 0x14, 0xdf, 0x3b, 0xec, 0x25,
-	0x45, 0x77, 0x4f, 0x1c, 0x73, 0xf7, 0x49, 0xc6, 0x22, 0xe8, 0x2e, 0x45, 0xf6, 0x7f, 0x02, 0xa4,
-	0x43, 0xbe, 0xbe, 0x04, 0x49, 0x2e, 0xc1, 0x68, 0x30, 0xeb, 0xcb, 0xa0, 0x82, 0x0c, 0xb1, 0x3d,
-	0x4b, 0x57, 0x29, 0x31, 0x1c, 0x1e, 0x6f, 0xfc, 0xc6, 0x6a, 0xaa, 0x74, 0xa9, 0x5f, 0x07, 0xa6,
-	0xdb, 0x77, 0x0a, 0x0a, 0x23, 0xdf, 0x22, 0x86, 0xc3, 0x25, 0x53, 0xd2, 0xa4, 0x6b, 0x0c, 0xaf,
-	0xb3, 0xb2, 0xbf, 0xa6, 0xd6, 0x90, 0xa5, 0xbb, 0x32, 0x38, 0xf2, 0x99, 0xe8, 0xe5, 0xbc, 0xe9,
-	0xd5, 0x2a, 0xc8, 0xd2, 0x95, 0xa4, 0xeb, 0x7f, 0xb8, 0xd9, 0xbf, 0x4b, 0x20, 0xdd, 0xbd, 0x1f,
-	0xbc, 0x0c, 0xe2, 0x2d, 0xf1, 0xa2, 0x1d, 0xd9, 0x0c, 0x63, 0x61, 0xf6, 0x23, 0x16, 0x66, 0x79,
-	0x53, 0x8c, 0xd1, 0x70, 0x52, 0xb4, 0x2f, 0xe2, 0xeb, 0x09, 0x48, 0xd1, 0x3e, 0x5c, 0x06, 0x43,
-	0x2d, 0xac, 0x1b, 0xc8, 0x12, 0xee, 0x7b, 0x6c, 0x6a, 0x41, 0xc6, 0xae, 0xa9, 0x7f, 0x2a, 0xbc,
-	0xf8, 0x54, 0xfc, 0x41, 0xf6, 0xf7, 0x12, 0x48, 0x08, 0xad, 0x1f, 0xe2, 0x7f, 0x55, 0xcf, 0x83,
-	0x6c, 0xe8, 0x0a, 0x1e, 0x35, 0x4c, 0x91, 0x06, 0xa9, 0x7e, 0x72, 0x17, 0xe7, 0x71, 0x22, 0x6c,
-	0x68, 0x6e, 0x77, 0x00, 0x6b, 0x3c, 0xcb, 0x7b, 0x1a, 0x4c, 0x3c, 0x88, 0x5a, 0xfc, 0x95, 0xf7,
-	0xd8, 0x03, 0xe8, 0x16, 0xef, 0x80, 0x33, 0x7d, 0xde, 0x5c, 0x78, 0x1a, 0x8c, 0x6f, 0xac, 0x95,
-	0xab, 0x2b, 0x37, 0x56, 0x6e, 0x6e, 0xa9, 0xdb, 0x37, 0xaf, 0xdf, 0x5c, 0x7f, 0xf5, 0x66, 0xe6,
-	0x14, 0x04, 0x60, 0x68, 0xf5, 0xe6, 0x95, 0xf5, 0x75, 0x25, 0x23, 0xc1, 0x14, 0x48, 0xac, 0x6f,
-	0x6f, 0xf1, 0x41, 0x2c, 0x3b, 0xfe, 0xf9, 0x47, 0x53, 0xa3, 0xb2, 0xb4, 0x38, 0x1c, 0x52, 0x55,
-	0x6e, 0xfc, 0xf5, 0x93, 0xe9, 0x53, 0xef, 0xde, 0x9f, 0x96, 0x7e, 0x75, 0x7f, 0x5a, 0xfa, 0xec,
-	0xfe, 0xf4, 0xa9, 0x7f, 0xdf, 0x9f, 0x96, 0xde, 0xfb, 0x74, 0xfa, 0xd4, 0xef, 0x3e, 0x9d, 0x96,
-	0x6e, 0x15, 0x4f, 0xd0, 0x30, 0xa1, 0x96, 0x53, 0xab, 0x0d, 0xf1, 0x23, 0xbb, 0xf8, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xcb, 0xbf, 0x2a, 0xa2, 0x5c, 0x21, 0x00, 0x00,
+	0xf5, 0xf7, 0x92, 0xfa, 0xa0, 0x1e, 0x25, 0x8a, 0x9e, 0xc8, 0xf2, 0x9a, 0xb6, 0x65, 0xfd, 0x19,
+	0x27, 0x96, 0xf4, 0x37, 0xc9, 0x86, 0x8e, 0x83, 0x5a, 0xf9, 0x50, 0x48, 0x5a, 0x76, 0x14, 0x7f,
+	0xc8, 0x5d, 0x49, 0x0d, 0xe0, 0xaf, 0xc5, 0x70, 0x77, 0x48, 0x6d, 0xb4, 0xdc, 0x65, 0x66, 0x67,
+	0x65, 0x31, 0x5f, 0x0d, 0x8a, 0x16, 0x28, 0x7a, 0x28, 0xd2, 0xa0, 0x40, 0x8b, 0x00, 0xcd, 0xa1,
+	0x68, 0x81, 0x22, 0xa7, 0xa2, 0xc7, 0x9e, 0x7a, 0xe8, 0xa1, 0x40, 0x81, 0x22, 0x40, 0x51, 0xa0,
+	0x68, 0xd1, 0x16, 0x71, 0x0e, 0x0d, 0x72, 0xea, 0x59, 0xa7, 0x62, 0x66, 0x67, 0x97, 0x4b, 0xea,
+	0x23, 0x52, 0x12, 0x17, 0x3d, 0x71, 0x67, 0xe6, 0xf7, 0xde, 0xbc, 0xf7, 0xe6, 0xcd, 0x9b, 0xf7,
+	0x1e, 0xe1, 0x8c, 0xed, 0x52, 0xfc, 0x00, 0x3b, 0x05, 0x8f, 0x61, 0x63, 0xa3, 0x84, 0xdb, 0x56,
+	0xa9, 0x89, 0x19, 0x79, 0x80, 0x3b, 0xc5, 0x36, 0x75, 0x99, 0x8b, 0x32, 0x8c, 0x39, 0x45, 0x09,
+	0x2a, 0x6e, 0x5e, 0xc8, 0x55, 0x9a, 0x16, 0x5b, 0xf7, 0xeb, 0x45, 0xc3, 0x6d, 0x95, 0x88, 0xb3,
+	0xe9, 0x76, 0xda, 0xd4, 0xdd, 0xea, 0x94, 0x04, 0xd8, 0x28, 0x34, 0x89, 0x53, 0xd8, 0xc4, 0xb6,
+	0x65, 0x62, 0x46, 0x4a, 0x3b, 0x3e, 0x02, 0x96, 0xb9, 0x42, 0x8c, 0x45, 0xd3, 0x6d, 0xba, 0x01,
+	0x71, 0xdd, 0x6f, 0x88, 0x91, 0x18, 0x88, 0x2f, 0x09, 0xaf, 0xc5, 0xe0, 0xab, 0xeb, 0x64, 0x75,
+	0xdd, 0x72, 0x9a, 0xde, 0x92, 0x63, 0xfa, 0x1e, 0xa3, 0x16, 0xf1, 0xe2, 0x5b, 0x37, 0xdd, 0xc2,
+	0xab, 0x9e, 0xeb, 0x94, 0xb0, 0xe3, 0xb8, 0x0c, 0x33, 0xcb, 0x75, 0x3c, 0xc9, 0x64, 0xaa, 0xe9,
+	0xba, 0x4d, 0x9b, 0x74, 0xb7, 0x32, 0x7d, 0x2a, 0x00, 0x72, 0x7d, 0xba, 0x7f, 0xbd, 0x61, 0x11,
+	0xdb, 0xd4, 0x5b, 0xd8, 0xdb, 0x90, 0x88, 0x53, 0xfd, 0x08, 0x8f, 0x51, 0xdf, 0x60, 0x72, 0xf5,
+	0x4c, 0xff, 0x2a, 0xb3, 0x5a, 0xc4, 0x63, 0xb8, 0xd5, 0x96, 0x80, 0xb3, 0x3b, 0x0d, 0x6d, 0xb8,
+	0x0e, 0xc3, 0x06, 0xd3, 0x2d, 0xa7, 0x11, 0xea, 0x7a, 0x7a, 0x27, 0x8a, 0x38, 0x7e, 0x2b, 0xd4,
+	0xe2, 0xf1, 0x9d, 0xcb, 0x96, 0x49, 0x1c, 0x66, 0x35, 0x2c, 0x42, 0x43, 0xd0, 0xf4, 0x4e, 0x50,
+	0x8b, 0x30, 0x6c, 0x62, 0x86, 0x43, 0x63, 0xec, 0x44, 0x50, 0xab, 0xb9, 0xce, 0x42, 0x0e, 0xbb,
+	0x38, 0x85, 0x47, 0x0c, 0x4a, 0x42, 0x40, 0xfe, 0x36, 0x8c, 0x5e, 0x0d, 0xbc, 0xa4, 0x4a, 0xb1,
+	0x63, 0xa2, 0x0c, 0x24, 0x2c, 0x53, 0x55, 0xa6, 0x95, 0x99, 0x11, 0x2d, 0x61, 0x99, 0x08, 0xc1,
+	0x80, 0x83, 0x5b, 0x44, 0x4d, 0x88, 0x19, 0xf1, 0x8d, 0xb2, 0x90, 0xf4, 0xa9, 0xad, 0x26, 0xc5,
+	0x14, 0xff, 0x44, 0x13, 0x30, 0x68, 0xbb, 0x4d, 0xd7, 0x53, 0x07, 0xa6, 0x93, 0x33, 0x23, 0x5a,
+	0x30, 0xc8, 0xff, 0x42, 0x89, 0x98, 0xdf, 0x70, 0x4d, 0x62, 0xa3, 0x45, 0x48, 0xd5, 0xf9, 0x2e,
+	0x7a, 0xb8, 0x45, 0x75, 0x6e, 0xbb, 0x7a, 0x8e, 0x3e, 0xa1, 0x9e, 0x2d, 0x4f, 0xdd, 0xbf, 0x83,
+	0x0b, 0xaf, 0x7f, 0xad, 0x70, 0xe9, 0xde, 0xcc, 0xc2, 0xfc, 0x9d, 0xc2, 0xbd, 0x85, 0x70, 0x38,
+	0xfb, 0x46, 0xf9, 0xfc, 0x5b, 0x67, 0x3f, 0x52, 0x14, 0x6d, 0x58, 0xd0, 0x2e, 0x99, 0x68, 0x5e,
+	0xc8, 0x98, 0x38, 0x34, 0x83, 0xb8, 0x3e, 0xc9, 0xae, 0x3e, 0xf9, 0x1f, 0x26, 0xe0, 0x84, 0x94,
+	0xf3, 0x9b, 0x84, 0x7a, 0x96, 0xeb, 0x2c, 0x75, 0x8f, 0xe2, 0xab, 0x12, 0x7a, 0x11, 0x52, 0x2d,
+	0x6e, 0x04, 0xfd, 0x0b, 0x89, 0x3e, 0x2c, 0x68, 0x97, 0x4c, 0x54, 0x86, 0xec, 0x3a, 0xa6, 0xe6,
+	0x03, 0x4c, 0x89, 0xbe, 0x19, 0x08, 0x1b, 0xe8, 0x52, 0x1d, 0xde, 0xae, 0x0e, 0xd0, 0x84, 0x3a,
+	0xad, 0x8d, 0x87, 0x00, 0xa9, 0x0c, 0xa7, 0x69, 0x58, 0xb4, 0xd5, 0x43, 0x33, 0xd0, 0x47, 0x13,
+	0x02, 0x24, 0x4d, 0xfe, 0x61, 0x22, 0x3a, 0x3b, 0x0d, 0x9b, 0x96, 0x8b, 0x26, 0x61, 0x88, 0x38,
+	0xb8, 0x6e, 0x13, 0x61, 0x84, 0x94, 0x26, 0x47, 0xe8, 0x24, 0x8c, 0x18, 0xeb, 0x56, 0x5b, 0x67,
+	0x9d, 0x76, 0xe8, 0x25, 0x29, 0x3e, 0xb1, 0xda, 0x69, 0x13, 0x74, 0x0a, 0x46, 0x1a, 0x94, 0xbc,
+	0xe6, 0x13, 0xc7, 0xe8, 0x08, 0x31, 0x07, 0xb4, 0xee, 0x04, 0x3a, 0x03, 0x69, 0xea, 0x79, 0x96,
+	0xee, 0x36, 0x1a, 0x1e, 0x61, 0x42, 0xa4, 0x84, 0x06, 0x7c, 0x6a, 0x59, 0xcc, 0xa0, 0x57, 0x20,
+	0xcb, 0xb6, 0x74, 0xc3, 0x75, 0x1a, 0x56, 0x53, 0x5e, 0x72, 0x75, 0x70, 0x5a, 0x99, 0x49, 0x97,
+	0xcf, 0x17, 0x7b, 0x83, 0x59, 0x31, 0x2e, 0x6b, 0x71, 0x75, 0xab, 0x16, 0xa7, 0xd1, 0xc6, 0x59,
+	0xef, 0x44, 0xee, 0x3b, 0x0a, 0x8c, 0xf7, 0x81, 0xd0, 0xe3, 0x30, 0xd6, 0xb2, 0x1c, 0xbd, 0x2b,
+	0xaf, 0x22, 0xe4, 0x1d, 0x6d, 0x59, 0xce, 0x95, 0x48, 0x64, 0x0e, 0xc2, 0x5b, 0x31, 0x50, 0x42,
+	0x82, 0xf0, 0x56, 0x17, 0x74, 0x0e, 0xc6, 0x1d, 0x97, 0x19, 0xeb, 0x7a, 0xbf, 0xee, 0x19, 0x31,
+	0x1d, 0x01, 0xf3, 0x7f, 0x54, 0x60, 0x4a, 0x0a, 0x5e, 0xb3, 0xb1, 0xd5, 0xaa, 0xf8, 0x6c, 0x9d,
+	0x3b, 0x9e, 0x21, 0x24, 0xaa, 0xb9, 0x26, 0x41, 0x45, 0x18, 0x0a, 0x2e, 0xac, 0x10, 0x27, 0x5d,
+	0x9e, 0xec, 0x57, 0x7c, 0x45, 0xac, 0x6a, 0x12, 0x85, 0x16, 0x00, 0x44, 0x8c, 0xd6, 0x1b, 0xd4,
+	0x6d, 0x09, 0xe9, 0xd2, 0xe5, 0x5c, 0x31, 0x08, 0x69, 0xc5, 0x30, 0xa4, 0x15, 0x57, 0xc3, 0x90,
+	0x56, 0x1d, 0x78, 0xf7, 0x9f, 0x67, 0x14, 0x6d, 0x44, 0xd0, 0x5c, 0xa1, 0x6e, 0x0b, 0x3d, 0x0b,
+	0xa9, 0x80, 0x01, 0x73, 0xd5, 0xe4, 0x01, 0xc9, 0x87, 0x05, 0xc5, 0xaa, 0x9b, 0xff, 0x53, 0x16,
+	0x86, 0xa5, 0x42, 0xe8, 0x05, 0x48, 0x5a, 0xa6, 0x27, 0xc5, 0xce, 0xef, 0x71, 0x5e, 0xb1, 0x8b,
+	0x56, 0x4d, 0x6d, 0x57, 0x07, 0xbf, 0xaf, 0x24, 0xb2, 0x8a, 0xc6, 0x09, 0xb9, 0x26, 0x06, 0x25,
+	0x98, 0x11, 0x53, 0xc7, 0xec, 0xe0, 0x9a, 0x48, 0x9a, 0x8a, 0x30, 0x85, 0xdf, 0x36, 0x43, 0x06,
+	0x07, 0xd5, 0x65, 0x44, 0xd2, 0x04, 0x0c, 0x4c, 0x62, 0x13, 0xc9, 0x20, 0x77, 0x50, 0x06, 0x92,
+	0xa6, 0xc2, 0xd0, 0x49, 0x19, 0x6c, 0x7a, 0x2e, 0x5b, 0x59, 0x46, 0xd1, 0x39, 0x48, 0x9b, 0xc4,
+	0x33, 0xa8, 0xd5, 0x8e, 0xfc, 0x7a, 0x44, 0xd8, 0x80, 0x26, 0xd5, 0x8f, 0xc6, 0xb5, 0xf8, 0x22,
+	0x7a, 0x1b, 0x00, 0x33, 0x46, 0xad, 0xba, 0xcf, 0x88, 0xa7, 0x0e, 0x4d, 0x27, 0x67, 0xd2, 0xe5,
+	0x73, 0x7b, 0x98, 0xb4, 0x58, 0x89, 0x90, 0x8b, 0x0e, 0xa3, 0x9d, 0xea, 0xc5, 0xed, 0x6a, 0xf9,
+	0x7d, 0xa5, 0x94, 0x85, 0xfc, 0x59, 0x9a, 0xff, 0xfc, 0x70, 0x33, 0xc7, 0x05, 0xf8, 0xbd, 0xa2,
+	0xc5, 0x76, 0x44, 0x2f, 0xc1, 0x68, 0xfc, 0x89, 0x53, 0x87, 0x85, 0x04, 0x27, 0xfb, 0x25, 0xa8,
+	0x05, 0x98, 0x25, 0xa7, 0xe1, 0x0a, 0x4d, 0xde, 0x53, 0x12, 0x59, 0xd0, 0xd2, 0x46, 0x77, 0x1a,
+	0xbd, 0x0c, 0x69, 0x19, 0x82, 0x74, 0xee, 0x1d, 0x29, 0x61, 0xd4, 0xd9, 0x3d, 0x54, 0xd9, 0x19,
+	0x8d, 0x35, 0xd8, 0x0c, 0xe7, 0x3c, 0xf4, 0x67, 0x05, 0x26, 0x65, 0x8a, 0xa3, 0x7b, 0x84, 0x6e,
+	0x12, 0xaa, 0x63, 0xd3, 0xa4, 0xc4, 0xf3, 0xd4, 0x11, 0x61, 0xcd, 0x0f, 0x94, 0xed, 0xea, 0xfb,
+	0x0a, 0xfd, 0xb1, 0x52, 0xfe, 0x91, 0x72, 0x7f, 0x86, 0xab, 0x79, 0xef, 0x8d, 0xf2, 0xf9, 0x8b,
+	0x6f, 0xcd, 0x97, 0x4a, 0xb3, 0x0b, 0x33, 0x0b, 0xf3, 0x5c, 0x7f, 0x5c, 0x78, 0xbd, 0x52, 0xb8,
+	0xcd, 0xd5, 0x7f, 0x33, 0xf6, 0xdd, 0xfd, 0xbc, 0x5b, 0xb8, 0x37, 0x17, 0x5b, 0x98, 0xbd, 0x5b,
+	0x9c, 0x9d, 0xe3, 0x74, 0x95, 0xc2, 0x6d, 0x69, 0xb6, 0x37, 0x63, 0xdf, 0xdd, 0x4f, 0x41, 0xd7,
+	0x5d, 0x98, 0x9d, 0x59, 0x98, 0x9f, 0xbf, 0xc3, 0xbf, 0xde, 0x78, 0xea, 0xfc, 0xc5, 0xb7, 0x66,
+	0x17, 0xce, 0xbe, 0x79, 0xff, 0xac, 0x36, 0x21, 0xc5, 0x5f, 0x11, 0xd2, 0x57, 0x02, 0xe1, 0x79,
+	0x5c, 0xc4, 0x3e, 0x73, 0xf5, 0xc0, 0x13, 0x55, 0x10, 0xf1, 0x16, 0xf8, 0xd4, 0x9a, 0x98, 0x41,
+	0x25, 0xc8, 0x04, 0x6b, 0xba, 0xb1, 0x8e, 0x1d, 0x87, 0xd8, 0x6a, 0x3a, 0xee, 0x3d, 0xef, 0x28,
+	0xda, 0x58, 0xb0, 0x5e, 0x0b, 0x96, 0xd1, 0x05, 0x38, 0x1a, 0xc5, 0x22, 0xbd, 0x6d, 0x63, 0x6e,
+	0x7c, 0x75, 0x34, 0xee, 0x95, 0x2f, 0x6a, 0xe3, 0x11, 0xe2, 0x96, 0x8d, 0x9d, 0x25, 0x13, 0x3d,
+	0x07, 0x68, 0x07, 0x91, 0xa7, 0x4e, 0xf0, 0x17, 0xbe, 0x9a, 0xd9, 0xae, 0xa6, 0xdf, 0x53, 0x52,
+	0xd9, 0x54, 0x3e, 0x20, 0xce, 0xf6, 0x11, 0x7b, 0xe8, 0x32, 0xa4, 0xb0, 0xc3, 0x88, 0xe3, 0x60,
+	0x4f, 0x1d, 0x13, 0xee, 0x32, 0xb5, 0xc7, 0x29, 0x57, 0x02, 0x58, 0xe4, 0x31, 0x29, 0x2d, 0xa2,
+	0xe4, 0xf1, 0xd6, 0x63, 0x98, 0xf9, 0x9e, 0xde, 0xf6, 0xeb, 0xb6, 0x65, 0xa8, 0x19, 0x61, 0x8c,
+	0xd1, 0x60, 0xf2, 0x96, 0x98, 0xe3, 0xf1, 0xd6, 0x76, 0x83, 0x98, 0x19, 0xc2, 0xc6, 0x05, 0x2c,
+	0x13, 0x4e, 0x4b, 0xe0, 0xd3, 0x30, 0xe9, 0x19, 0xeb, 0xc4, 0xf4, 0x6d, 0xa2, 0x9b, 0xee, 0x03,
+	0xc7, 0xb6, 0x9c, 0x0d, 0xdd, 0xe6, 0x36, 0xce, 0x0a, 0xfc, 0x44, 0xb8, 0x7a, 0x59, 0x2e, 0x5e,
+	0xe7, 0xd6, 0x3e, 0x0f, 0x88, 0x38, 0x0d, 0x97, 0x1a, 0x44, 0x37, 0x7d, 0xd6, 0xd1, 0x8d, 0x8e,
+	0x61, 0x13, 0xf5, 0xa8, 0xa0, 0xc8, 0xca, 0x95, 0xcb, 0x3e, 0xeb, 0xd4, 0xf8, 0x3c, 0x7a, 0x15,
+	0xd4, 0x88, 0x75, 0x1b, 0xb3, 0x75, 0xfe, 0x7c, 0x79, 0x8c, 0x62, 0xcb, 0x61, 0x2a, 0x9a, 0x56,
+	0x66, 0x32, 0xe5, 0x27, 0xfb, 0xed, 0x10, 0xee, 0x76, 0x0b, 0xb3, 0xf5, 0x5a, 0x84, 0x16, 0xf6,
+	0xf8, 0xb6, 0x88, 0x87, 0x93, 0xe6, 0xae, 0x08, 0xb4, 0x16, 0xd3, 0x07, 0x3b, 0x1d, 0x9e, 0xa8,
+	0xea, 0x26, 0xb1, 0x71, 0x47, 0x7d, 0x4c, 0xdc, 0xab, 0x13, 0x3b, 0x82, 0xd5, 0x65, 0xf9, 0xda,
+	0x55, 0x07, 0x7e, 0xc2, 0x63, 0x55, 0xa4, 0x70, 0x25, 0xa0, 0xbe, 0xcc, 0x89, 0xd1, 0xf3, 0x70,
+	0x52, 0xba, 0x57, 0x64, 0x56, 0xfe, 0x9a, 0xe8, 0x81, 0xd1, 0xd5, 0x63, 0x42, 0x73, 0x35, 0x80,
+	0x5c, 0x97, 0x08, 0xfe, 0x76, 0xac, 0x88, 0x75, 0xf4, 0x1c, 0x64, 0xec, 0xba, 0xa7, 0xdb, 0x8e,
+	0xa7, 0xcb, 0xa7, 0x6b, 0x72, 0xdf, 0xa7, 0x6b, 0xd4, 0xae, 0x7b, 0xd7, 0x1d, 0x2f, 0x18, 0xa1,
+	0x57, 0xe1, 0x84, 0xc1, 0xdf, 0x42, 0x1d, 0xf7, 0x3c, 0x86, 0xba, 0xe1, 0x9a, 0x44, 0x3d, 0x2e,
+	0x18, 0x15, 0xf7, 0x70, 0xa4, 0x3d, 0xde, 0x50, 0xed, 0xb8, 0xb1, 0xfb, 0x02, 0xba, 0x00, 0xe3,
+	0x0c, 0xd3, 0x26, 0x61, 0xba, 0xe1, 0xb7, 0x3d, 0xdd, 0xa7, 0x96, 0xaa, 0x8a, 0x4b, 0x91, 0xde,
+	0xae, 0xa6, 0xe8, 0xd0, 0xf7, 0x14, 0x85, 0xe7, 0x5e, 0x63, 0x01, 0xa6, 0xe6, 0xb7, 0xbd, 0x35,
+	0x6a, 0xa1, 0x17, 0x7a, 0x89, 0x36, 0x48, 0x47, 0x3d, 0xb1, 0xaf, 0x7e, 0x31, 0xfa, 0x6b, 0xa4,
+	0x83, 0x5e, 0x82, 0x69, 0x7e, 0x57, 0x2c, 0x4a, 0xe2, 0x2a, 0x12, 0x93, 0x3b, 0x8a, 0x43, 0x0c,
+	0xf1, 0x18, 0x9c, 0x14, 0x26, 0x9e, 0x92, 0xb8, 0x4a, 0x1c, 0x56, 0x8b, 0x50, 0xe8, 0x19, 0x18,
+	0xb2, 0x69, 0x63, 0xdd, 0xf3, 0xd4, 0x53, 0xd3, 0xca, 0x3e, 0x17, 0xac, 0x78, 0x5d, 0xbb, 0xf2,
+	0xd2, 0xca, 0x8a, 0x26, 0xd1, 0xe8, 0x2a, 0x4c, 0x9b, 0x96, 0xc7, 0xb3, 0x37, 0xbd, 0x8d, 0x8d,
+	0x0d, 0xc2, 0xf4, 0x3a, 0x75, 0x37, 0x08, 0xd5, 0x1b, 0x2e, 0x7d, 0x80, 0xa9, 0x69, 0x39, 0x4d,
+	0xf5, 0xb4, 0x90, 0xe0, 0xb4, 0xc4, 0xdd, 0x12, 0xb0, 0xaa, 0x40, 0x5d, 0x89, 0x40, 0xb9, 0xe7,
+	0x61, 0xbc, 0xef, 0xb1, 0xe1, 0xb5, 0x01, 0xb7, 0x48, 0x50, 0x40, 0xf0, 0x4f, 0x5e, 0x1b, 0x6c,
+	0x62, 0xdb, 0x0f, 0x93, 0xc3, 0x60, 0x30, 0x9f, 0xf8, 0xba, 0x92, 0x7b, 0x12, 0x86, 0x02, 0xc9,
+	0x78, 0x9e, 0xe8, 0xf9, 0xed, 0xb6, 0x4b, 0x19, 0x31, 0x65, 0x7e, 0xd9, 0x9d, 0xc8, 0x2f, 0x40,
+	0x4a, 0x6a, 0xe2, 0xa1, 0x0b, 0x90, 0x92, 0x31, 0x93, 0xa7, 0x16, 0x3c, 0xac, 0x1c, 0xdf, 0x2b,
+	0x15, 0x8c, 0x80, 0xf9, 0x9f, 0x2a, 0x70, 0xf4, 0x2a, 0x61, 0xe1, 0x02, 0x8f, 0x54, 0x1e, 0x43,
+	0x37, 0x20, 0x1d, 0xbe, 0x1e, 0x5f, 0x34, 0x51, 0x81, 0x66, 0xb8, 0xea, 0xa1, 0x4b, 0x00, 0xdd,
+	0x4a, 0x73, 0xcf, 0x7c, 0xe5, 0x0a, 0x87, 0xdc, 0xc0, 0xde, 0x86, 0x36, 0xd2, 0x08, 0x3f, 0xf3,
+	0xaf, 0x41, 0xbe, 0x2b, 0x5e, 0x6c, 0xa7, 0x2b, 0x2e, 0x5d, 0x5c, 0x5b, 0x0a, 0xe5, 0xbd, 0x06,
+	0x49, 0xe2, 0x5b, 0x42, 0xce, 0xd1, 0xea, 0xa5, 0xbf, 0xfe, 0xe3, 0xcc, 0xc5, 0xa6, 0x5b, 0x64,
+	0xeb, 0x84, 0x89, 0x32, 0xba, 0xe8, 0x10, 0xf6, 0xc0, 0xa5, 0x1b, 0xa5, 0xde, 0x8a, 0x6f, 0xf3,
+	0x42, 0xa9, 0xbd, 0xd1, 0x2c, 0xf1, 0xec, 0xdc, 0x2b, 0x2e, 0xae, 0x2d, 0x3d, 0xf3, 0xb4, 0xc6,
+	0xb9, 0xe4, 0x3f, 0x4b, 0xc0, 0x63, 0xd7, 0x2d, 0x2f, 0xdc, 0xd4, 0x0b, 0x37, 0xf9, 0x06, 0x7f,
+	0xe9, 0x6d, 0x1b, 0xd7, 0x5d, 0x8a, 0x99, 0x4b, 0xa5, 0x55, 0x0a, 0xfd, 0x56, 0x59, 0xa6, 0x4d,
+	0xec, 0x58, 0xaf, 0x8b, 0xab, 0xb4, 0x4c, 0xd7, 0x3c, 0x42, 0xe3, 0x8f, 0x74, 0x0f, 0x8b, 0x2f,
+	0x61, 0x18, 0xf4, 0x00, 0x06, 0x5d, 0x6a, 0x12, 0x2a, 0x4b, 0x1c, 0xbc, 0x5d, 0xbd, 0x4f, 0xef,
+	0x6a, 0x47, 0x22, 0xbb, 0xeb, 0x96, 0xa9, 0xa5, 0x0b, 0xf1, 0x41, 0xf8, 0x4d, 0x7c, 0x4b, 0x1b,
+	0x2d, 0xc4, 0x47, 0x22, 0xeb, 0xd2, 0x06, 0x0b, 0xe2, 0x27, 0x96, 0x5a, 0x6a, 0xe9, 0x42, 0x6c,
+	0x10, 0xec, 0x87, 0xa6, 0x60, 0xd0, 0xb6, 0x5a, 0x56, 0x50, 0x94, 0x8c, 0x89, 0x13, 0x9f, 0x4b,
+	0xaa, 0x9f, 0x0e, 0x6b, 0xc1, 0x34, 0x2f, 0x23, 0xdb, 0xb8, 0x49, 0x44, 0xd6, 0x36, 0xa6, 0x89,
+	0x6f, 0xa4, 0xc2, 0xb0, 0x4c, 0xfd, 0xd4, 0x21, 0xe1, 0xc2, 0xe1, 0x30, 0xff, 0x2b, 0x05, 0x26,
+	0x6a, 0x62, 0x8f, 0x3e, 0x17, 0x7c, 0x16, 0x86, 0xa5, 0x88, 0xd2, 0xd0, 0x7b, 0x39, 0x73, 0xcc,
+	0xe7, 0x42, 0x0a, 0x74, 0xa7, 0xef, 0xa8, 0x12, 0x5f, 0xe0, 0xa8, 0x62, 0x7c, 0x7b, 0x98, 0xe5,
+	0x7f, 0xa0, 0xc0, 0x44, 0x90, 0x6d, 0x7c, 0x95, 0x22, 0x7f, 0x89, 0x3b, 0xf2, 0x81, 0x02, 0x27,
+	0x62, 0x0e, 0x5b, 0xb9, 0xb5, 0x74, 0x8d, 0x74, 0xbc, 0x47, 0x74, 0x97, 0xa3, 0xe3, 0x4f, 0xec,
+	0x7f, 0xfc, 0xc9, 0xee, 0xf1, 0xe7, 0xbf, 0x05, 0xc7, 0xaf, 0x92, 0x5e, 0xf1, 0x1e, 0x91, 0x74,
+	0xc7, 0x60, 0x68, 0x83, 0x74, 0xa2, 0x46, 0x82, 0x36, 0xb8, 0x41, 0x3a, 0x4b, 0x66, 0xfe, 0x6f,
+	0x09, 0xc8, 0xf5, 0x78, 0xd9, 0x23, 0x15, 0xe2, 0x64, 0xbc, 0x31, 0xd4, 0x5f, 0xdb, 0xbc, 0x08,
+	0x43, 0x41, 0x1b, 0x4a, 0x4d, 0x4e, 0x27, 0x67, 0x32, 0xe5, 0x63, 0xfd, 0xdb, 0x68, 0x7c, 0xb5,
+	0x7a, 0x74, 0xbb, 0x9a, 0x79, 0x4f, 0x49, 0xa7, 0x14, 0x55, 0xc9, 0xcb, 0x54, 0x47, 0xd2, 0xa1,
+	0xab, 0x00, 0x64, 0xab, 0x6d, 0x51, 0xe2, 0xe9, 0x38, 0xb8, 0x85, 0xfb, 0xd7, 0x5e, 0xa3, 0xdb,
+	0xd5, 0xc1, 0x5f, 0x2b, 0x89, 0x17, 0x95, 0xa0, 0x06, 0x93, 0xb4, 0x15, 0x86, 0xae, 0xc0, 0x28,
+	0x25, 0xbc, 0xbf, 0x28, 0xde, 0x4c, 0x4f, 0x1d, 0xdc, 0x5d, 0xef, 0xd0, 0x56, 0x5d, 0xa4, 0xd6,
+	0x43, 0x97, 0xff, 0x58, 0x81, 0x5c, 0xcf, 0x85, 0x78, 0xa4, 0xd6, 0xbd, 0x04, 0xc3, 0xb8, 0x6d,
+	0x89, 0xe4, 0x22, 0xb1, 0x7b, 0x72, 0x11, 0x6c, 0x1f, 0x23, 0x1f, 0xc2, 0x6d, 0xeb, 0x1a, 0xe9,
+	0xbf, 0x63, 0xc9, 0xc3, 0xdc, 0xb1, 0x9f, 0x2b, 0x70, 0x26, 0x76, 0xc7, 0x6a, 0xb1, 0x80, 0xf0,
+	0xbf, 0x74, 0xd3, 0xfe, 0xa0, 0xc0, 0xe9, 0xab, 0x64, 0x37, 0x29, 0x1f, 0x91, 0x90, 0x8f, 0x34,
+	0xd2, 0xfe, 0x46, 0x81, 0xd3, 0x2b, 0xff, 0x4d, 0x6d, 0x5e, 0xde, 0x55, 0x9b, 0x53, 0x3b, 0x8b,
+	0xf9, 0x2e, 0x66, 0x4f, 0xe1, 0x3f, 0x4d, 0x40, 0xa6, 0xb7, 0x8c, 0xe3, 0x27, 0xd6, 0xc4, 0x96,
+	0x23, 0xc4, 0x4c, 0x68, 0xe2, 0x1b, 0x3d, 0x0d, 0xa9, 0xb0, 0x94, 0x90, 0xdb, 0xa9, 0xfd, 0xdb,
+	0x85, 0x85, 0x84, 0x16, 0x21, 0xd1, 0x77, 0x95, 0x9e, 0xb6, 0x47, 0x72, 0x3a, 0xb9, 0x4f, 0xf2,
+	0x2f, 0xb7, 0x7f, 0x14, 0xdd, 0x8f, 0x45, 0x18, 0x69, 0xdb, 0xd8, 0x20, 0x2d, 0xe2, 0x04, 0xa1,
+	0x28, 0x53, 0x3e, 0xb7, 0xbf, 0x14, 0xb7, 0x42, 0xb8, 0xd6, 0xa5, 0xfc, 0x92, 0xd9, 0x72, 0xfe,
+	0x67, 0x83, 0x30, 0x26, 0x77, 0x89, 0x0a, 0xad, 0x01, 0x5e, 0xb4, 0xa9, 0xca, 0x1e, 0x77, 0x7c,
+	0x47, 0x74, 0x4c, 0x05, 0xd1, 0x51, 0x50, 0xa1, 0xe7, 0x61, 0xa4, 0xee, 0xba, 0x4c, 0x17, 0x2c,
+	0x0e, 0xda, 0x5e, 0x4b, 0x71, 0x12, 0x3e, 0x89, 0xde, 0x86, 0x94, 0x6c, 0xc5, 0x84, 0x27, 0xf3,
+	0xff, 0x7b, 0xd8, 0x24, 0x90, 0xb6, 0x28, 0x9b, 0x39, 0x3b, 0x8e, 0xe5, 0x09, 0xfa, 0xb8, 0x7a,
+	0xb6, 0x7c, 0xa6, 0xe7, 0x58, 0xf4, 0x9d, 0xe7, 0x12, 0xf4, 0xa9, 0xa3, 0x3d, 0xd1, 0x32, 0x1c,
+	0x95, 0x5d, 0x82, 0xa8, 0x4a, 0x0d, 0xfe, 0x7e, 0xd8, 0xc7, 0xb7, 0x62, 0x2d, 0x86, 0xac, 0x24,
+	0x0e, 0x97, 0x78, 0x24, 0x4a, 0x58, 0x6d, 0x75, 0xb0, 0xa7, 0xbd, 0x01, 0xbc, 0xbd, 0xd1, 0xe6,
+	0xff, 0x1c, 0xb4, 0x91, 0x0f, 0xc3, 0x2d, 0xc2, 0xdf, 0x83, 0xb0, 0x01, 0x37, 0xb7, 0xbf, 0xbe,
+	0x37, 0x02, 0x70, 0xa0, 0x6e, 0x69, 0xbb, 0x7a, 0xfe, 0x7d, 0x65, 0xf6, 0xc0, 0xea, 0x6a, 0xe1,
+	0x5e, 0xbc, 0xe0, 0xc1, 0xe6, 0x26, 0x76, 0x0c, 0x62, 0xaa, 0x86, 0x4c, 0xb8, 0xfa, 0x4f, 0x69,
+	0x45, 0xfc, 0x7f, 0xa5, 0x45, 0xc0, 0xdc, 0xb3, 0x30, 0xd6, 0x63, 0xee, 0x43, 0x95, 0x65, 0xf3,
+	0x30, 0x1a, 0x97, 0xfd, 0xf3, 0x68, 0x13, 0x71, 0x27, 0xfd, 0x57, 0x0a, 0x26, 0xa3, 0x48, 0x16,
+	0x16, 0xaa, 0xdc, 0x20, 0x1e, 0xaa, 0x89, 0x1e, 0x22, 0x9f, 0x0a, 0xfa, 0xa9, 0xca, 0x01, 0x5d,
+	0x2e, 0x1d, 0x51, 0x55, 0x18, 0xca, 0x41, 0x4a, 0x00, 0x0d, 0xd7, 0x0e, 0xff, 0x6c, 0x08, 0xc7,
+	0xe8, 0x15, 0x38, 0x6e, 0x63, 0x8f, 0xc9, 0x36, 0x85, 0x4e, 0x89, 0x41, 0xac, 0xcd, 0xc3, 0x35,
+	0x7f, 0x27, 0x38, 0x83, 0xe0, 0xfc, 0x34, 0x49, 0x5e, 0x61, 0xe8, 0x05, 0x48, 0xc7, 0x18, 0xcb,
+	0x64, 0xe4, 0xf4, 0xbe, 0xa7, 0xaf, 0x41, 0x97, 0x53, 0x24, 0x98, 0xdf, 0x16, 0x5d, 0xa1, 0xb8,
+	0x60, 0x83, 0x87, 0x11, 0x6c, 0x4d, 0xd0, 0xc7, 0x04, 0xfb, 0x3f, 0x18, 0x95, 0x3c, 0x0d, 0xd7,
+	0x77, 0x98, 0x28, 0x3b, 0x06, 0xb4, 0x74, 0x30, 0x57, 0xe3, 0x53, 0xe8, 0x0e, 0x9c, 0x10, 0x7b,
+	0x47, 0x3d, 0xa9, 0xf8, 0xee, 0xc3, 0x07, 0xdc, 0x7d, 0x92, 0xb3, 0x08, 0xbb, 0x54, 0xb1, 0xfd,
+	0x9f, 0x80, 0x4c, 0xc4, 0x37, 0x90, 0x20, 0x25, 0x24, 0x18, 0x0b, 0x67, 0x03, 0x19, 0x74, 0xc8,
+	0x52, 0xd7, 0x77, 0x4c, 0x9d, 0x51, 0xfe, 0x47, 0x11, 0x67, 0x2e, 0x1a, 0xb4, 0xe9, 0xf2, 0xc5,
+	0xbd, 0x3a, 0x39, 0xbd, 0xbe, 0x53, 0xd4, 0x38, 0xf9, 0x2a, 0xb5, 0xda, 0x42, 0x32, 0x2d, 0x43,
+	0x7b, 0xc6, 0xe8, 0x1a, 0x6f, 0x1f, 0xd4, 0xf5, 0x3a, 0x76, 0x4c, 0x4f, 0x85, 0x7d, 0x9f, 0x89,
+	0x7e, 0xce, 0x2b, 0x7e, 0xbd, 0x8a, 0x1d, 0x53, 0x4b, 0x79, 0xc1, 0x87, 0x97, 0xfb, 0xbb, 0x02,
+	0x99, 0xde, 0xfd, 0xd0, 0x25, 0x48, 0xb6, 0xe4, 0x8b, 0xb6, 0x6f, 0x53, 0x8d, 0x87, 0xd9, 0x0f,
+	0x79, 0x98, 0x15, 0xcd, 0x35, 0x4e, 0x23, 0x48, 0xf1, 0x96, 0x9a, 0x38, 0x2c, 0x29, 0xde, 0x42,
+	0x0b, 0x30, 0xd4, 0x22, 0xa6, 0x85, 0x1d, 0x35, 0x79, 0x38, 0x6a, 0x49, 0xc6, 0xaf, 0x69, 0x70,
+	0x2a, 0xa2, 0x88, 0xd5, 0x82, 0x41, 0xee, 0x77, 0x0a, 0x0c, 0x4b, 0xad, 0xbf, 0xc2, 0xff, 0xbc,
+	0x9e, 0x83, 0x5c, 0xe4, 0x0a, 0x3e, 0xb3, 0x6c, 0x99, 0x06, 0xe9, 0x41, 0x72, 0x97, 0x14, 0x71,
+	0x22, 0x6a, 0x8c, 0xae, 0x75, 0x01, 0xd7, 0xf9, 0x3a, 0x7a, 0x0a, 0x26, 0x76, 0xa3, 0x96, 0x7f,
+	0x09, 0x3e, 0xb6, 0x0b, 0xdd, 0xdc, 0x5d, 0x38, 0xbe, 0xc7, 0x9b, 0x8b, 0x8e, 0xc1, 0xd1, 0x5b,
+	0xd7, 0x2b, 0xb5, 0xc5, 0x1b, 0x8b, 0x37, 0x57, 0xf5, 0xb5, 0x9b, 0xd7, 0x6e, 0x2e, 0xbf, 0x72,
+	0x33, 0x7b, 0x04, 0x01, 0x0c, 0x2d, 0xdd, 0xbc, 0xbc, 0xbc, 0xac, 0x65, 0x15, 0x94, 0x86, 0xe1,
+	0xe5, 0xb5, 0x55, 0x31, 0x48, 0xe4, 0x8e, 0x7e, 0xf6, 0xe1, 0x89, 0x31, 0x55, 0x99, 0x1b, 0x89,
+	0xa8, 0xaa, 0x37, 0xfe, 0xf2, 0xf1, 0xd4, 0x91, 0x77, 0x1e, 0x4e, 0x29, 0xbf, 0x7c, 0x38, 0xa5,
+	0x7c, 0xfa, 0x70, 0xea, 0xc8, 0xbf, 0x1f, 0x4e, 0x29, 0xef, 0x7e, 0x32, 0x75, 0xe4, 0xb7, 0x9f,
+	0x4c, 0x29, 0xb7, 0x4b, 0x87, 0x68, 0xbc, 0x30, 0xa7, 0x5d, 0xaf, 0x0f, 0x89, 0x23, 0xbb, 0xf0,
+	0x9f, 0x01, 0x00, 0xd9, 0xe0, 0x9e, 0x9e, 0xa4, 0x21, 0x00, 0x00,
 }
 
 func (x GatewayAntennaPlacement) String() string {
@@ -2701,6 +2710,9 @@ func (this *CreateGatewayAPIKeyRequest) Equal(that interface{}) bool {
 	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
 		return false
 	}
+	if !this.Restrictions.Equal(that1.Restrictions) {
+		return false
+	}
 	return true
 }
 func (this *UpdateGatewayAPIKeyRequest) Equal(that interface{}) bool {
@@ -3330,6 +3342,7 @@ func (this *CreateGatewayAPIKeyRequest) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
 		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Restrictions:` + strings.Replace(fmt.Sprintf("%v", this.Restrictions), "APIKeyRestrictions", "APIKeyRestrictions", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -341,6 +341,11 @@ var CreateGatewayAPIKeyRequestFieldPathsNested = []string{
 	"gateway_ids.eui",
 	"gateway_ids.gateway_id",
 	"name",
+	"restrictions",
+	"restrictions.end_device_attributes",
+	"restrictions.end_device_ids",
+	"restrictions.methods",
+	"restrictions.source_cidrs",
 	"rights",
 }
 
@@ -348,6 +353,7 @@ var CreateGatewayAPIKeyRequestFieldPathsTopLevel = []string{
 	"expires_at",
 	"gateway_ids",
 	"name",
+	"restrictions",
 	"rights",
 }
 var UpdateGatewayAPIKeyRequestFieldPathsNested = []string{
@@ -357,6 +363,11 @@ var UpdateGatewayAPIKeyRequestFieldPathsNested = []string{
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
+	"api_key.restrictions",
+	"api_key.restrictions.end_device_attributes",
+	"api_key.restrictions.end_device_ids",
+	"api_key.restrictions.methods",
+	"api_key.restrictions.source_cidrs",
 	"api_key.rights",
 	"api_key.updated_at",
 	"field_mask",
@@ -1977,6 +1977,18 @@ func (m *CreateGatewayAPIKeyRequest) ValidateFields(paths ...string) error {
 
 			}
 
+		case "restrictions":
+
+			if v, ok := interface{}(m.GetRestrictions()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateGatewayAPIKeyRequestValidationError{
+						field:  "restrictions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return CreateGatewayAPIKeyRequestValidationError{
 				field:  name,
@@ -571,6 +571,12 @@ func (x *CreateGatewayAPIKeyRequest) MarshalProtoJSON(s *jsonplugin.MarshalState
 			s.WriteTime(*x.ExpiresAt)
 		}
 	}
+	if x.Restrictions != nil || s.HasField("restrictions") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("restrictions")
+		// NOTE: APIKeyRestrictions does not seem to implement MarshalProtoJSON.
+		gogo.MarshalMessage(s, x.Restrictions)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -606,6 +612,12 @@ func (x *CreateGatewayAPIKeyRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalS
 				return
 			}
 			x.ExpiresAt = v
+		case "restrictions":
+			s.AddField("restrictions")
+			// NOTE: APIKeyRestrictions does not seem to implement UnmarshalProtoJSON.
+			var v APIKeyRestrictions
+			gogo.UnmarshalMessage(s, &v)
+			x.Restrictions = &v
 		}
 	})
 }
@@ -86,6 +86,13 @@ func init() {
 	defineEnum(CID_BEACON_TIMING, "beacon timing")
 	defineEnum(CID_BEACON_FREQ, "beacon frequency")
 	defineEnum(CID_DEVICE_MODE, "device mode")
+	defineEnum(CID_RELAY_CONF, "relay configuration")
+	defineEnum(CID_RELAY_END_DEVICE_CONF, "relay end device configuration")
+	defineEnum(CID_RELAY_FILTER_LIST, "relay join-request filter list")
+	defineEnum(CID_RELAY_UPDATE_UPLINK_LIST, "relay update uplink list")
+	defineEnum(CID_RELAY_CTRL_UPLINK_LIST, "relay control uplink list")
+	defineEnum(CID_RELAY_CONFIGURE_FWD_LIMIT, "relay forward limits")
+	defineEnum(CID_RELAY_NOTIFY_NEW_END_DEVICE, "relay new end device notification")
 
 	defineEnum(SOURCE_UNKNOWN, "unknown location source")
 	defineEnum(SOURCE_GPS, "determined by GPS")
@@ -116,6 +123,7 @@ func init() {
 	defineEnum(RIGHT_USER_CLIENTS_CREATE, "create an OAuth client under the user account")
 	defineEnum(RIGHT_USER_ORGANIZATIONS_LIST, "list organizations the user is a member of")
 	defineEnum(RIGHT_USER_ORGANIZATIONS_CREATE, "create an organization under the user account")
+	defineEnum(RIGHT_USER_AUDIT_LOG, "view the audit log of the user")
 	defineEnum(RIGHT_USER_ALL, "all user rights")
 
 	defineEnum(RIGHT_APPLICATION_INFO, "view application information")
@@ -132,6 +140,7 @@ func init() {
 	defineEnum(RIGHT_APPLICATION_TRAFFIC_UP_WRITE, "write uplink application traffic")
 	defineEnum(RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE, "write downlink application traffic")
 	defineEnum(RIGHT_APPLICATION_LINK, "link as Application to a Network Server for traffic exchange, i.e. read uplink and write downlink")
+	defineEnum(RIGHT_APPLICATION_AUDIT_LOG, "view the audit log of the application and its devices")
 	defineEnum(RIGHT_APPLICATION_ALL, "all application rights")
 
 	defineEnum(RIGHT_CLIENT_ALL, "all OAuth client rights")
@@ -148,6 +157,7 @@ func init() {
 	defineEnum(RIGHT_GATEWAY_LOCATION_READ, "view gateway location")
 	defineEnum(RIGHT_GATEWAY_WRITE_SECRETS, "store secrets for a gateway")
 	defineEnum(RIGHT_GATEWAY_READ_SECRETS, "retrieve secrets associated with a gateway")
+	defineEnum(RIGHT_GATEWAY_AUDIT_LOG, "view the audit log of the gateway")
 	defineEnum(RIGHT_GATEWAY_ALL, "all gateway rights")
 
 	defineEnum(RIGHT_ORGANIZATION_INFO, "view organization information")
@@ -162,6 +172,7 @@ func init() {
 	defineEnum(RIGHT_ORGANIZATION_CLIENTS_LIST, "list the OAuth clients the organization is a collaborator of")
 	defineEnum(RIGHT_ORGANIZATION_CLIENTS_CREATE, "create an OAuth client under the organization")
 	defineEnum(RIGHT_ORGANIZATION_ADD_AS_COLLABORATOR, "add the organization as a collaborator on an existing entity")
+	defineEnum(RIGHT_ORGANIZATION_AUDIT_LOG, "view the audit log of the organization")
 	defineEnum(RIGHT_ORGANIZATION_ALL, "all organization rights")
 
 	defineEnum(RIGHT_SEND_INVITES, "send user invites")
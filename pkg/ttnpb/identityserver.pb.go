@@ -6,9 +6,11 @@ package ttnpb
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/TheThingsIndustries/protoc-gen-go-json/annotations"
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -17,6 +19,7 @@ import (
 	status "google.golang.org/grpc/status"
 	math "math"
 	reflect "reflect"
+	strconv "strconv"
 	strings "strings"
 	time "time"
 )
@@ -34,6 +37,30 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type NotificationStatus int32
+
+const (
+	NotificationStatus_NOTIFICATION_STATUS_UNREAD   NotificationStatus = 0
+	NotificationStatus_NOTIFICATION_STATUS_READ     NotificationStatus = 1
+	NotificationStatus_NOTIFICATION_STATUS_ARCHIVED NotificationStatus = 2
+)
+
+var NotificationStatus_name = map[int32]string{
+	0: "NOTIFICATION_STATUS_UNREAD",
+	1: "NOTIFICATION_STATUS_READ",
+	2: "NOTIFICATION_STATUS_ARCHIVED",
+}
+
+var NotificationStatus_value = map[string]int32{
+	"NOTIFICATION_STATUS_UNREAD":   0,
+	"NOTIFICATION_STATUS_READ":     1,
+	"NOTIFICATION_STATUS_ARCHIVED": 2,
+}
+
+func (NotificationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a1c7e02f6181562c, []int{0}
+}
+
 type AuthInfoResponse struct {
 	// Types that are valid to be assigned to AccessMethod:
 	//	*AuthInfoResponse_ApiKey
@@ -707,268 +734,1178 @@ func (m *GetIsConfigurationResponse) GetConfiguration() *IsConfiguration {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*AuthInfoResponse)(nil), "ttn.lorawan.v3.AuthInfoResponse")
-	golang_proto.RegisterType((*AuthInfoResponse)(nil), "ttn.lorawan.v3.AuthInfoResponse")
-	proto.RegisterType((*AuthInfoResponse_APIKeyAccess)(nil), "ttn.lorawan.v3.AuthInfoResponse.APIKeyAccess")
-	golang_proto.RegisterType((*AuthInfoResponse_APIKeyAccess)(nil), "ttn.lorawan.v3.AuthInfoResponse.APIKeyAccess")
-	proto.RegisterType((*GetIsConfigurationRequest)(nil), "ttn.lorawan.v3.GetIsConfigurationRequest")
-	golang_proto.RegisterType((*GetIsConfigurationRequest)(nil), "ttn.lorawan.v3.GetIsConfigurationRequest")
-	proto.RegisterType((*IsConfiguration)(nil), "ttn.lorawan.v3.IsConfiguration")
-	golang_proto.RegisterType((*IsConfiguration)(nil), "ttn.lorawan.v3.IsConfiguration")
-	proto.RegisterType((*IsConfiguration_UserRegistration)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration")
-	golang_proto.RegisterType((*IsConfiguration_UserRegistration)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration")
-	proto.RegisterType((*IsConfiguration_UserRegistration_Invitation)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration.Invitation")
-	golang_proto.RegisterType((*IsConfiguration_UserRegistration_Invitation)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration.Invitation")
-	proto.RegisterType((*IsConfiguration_UserRegistration_ContactInfoValidation)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration.ContactInfoValidation")
-	golang_proto.RegisterType((*IsConfiguration_UserRegistration_ContactInfoValidation)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration.ContactInfoValidation")
-	proto.RegisterType((*IsConfiguration_UserRegistration_AdminApproval)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration.AdminApproval")
-	golang_proto.RegisterType((*IsConfiguration_UserRegistration_AdminApproval)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration.AdminApproval")
-	proto.RegisterType((*IsConfiguration_UserRegistration_PasswordRequirements)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration.PasswordRequirements")
-	golang_proto.RegisterType((*IsConfiguration_UserRegistration_PasswordRequirements)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration.PasswordRequirements")
-	proto.RegisterType((*IsConfiguration_ProfilePicture)(nil), "ttn.lorawan.v3.IsConfiguration.ProfilePicture")
-	golang_proto.RegisterType((*IsConfiguration_ProfilePicture)(nil), "ttn.lorawan.v3.IsConfiguration.ProfilePicture")
-	proto.RegisterType((*IsConfiguration_EndDevicePicture)(nil), "ttn.lorawan.v3.IsConfiguration.EndDevicePicture")
-	golang_proto.RegisterType((*IsConfiguration_EndDevicePicture)(nil), "ttn.lorawan.v3.IsConfiguration.EndDevicePicture")
-	proto.RegisterType((*IsConfiguration_UserRights)(nil), "ttn.lorawan.v3.IsConfiguration.UserRights")
-	golang_proto.RegisterType((*IsConfiguration_UserRights)(nil), "ttn.lorawan.v3.IsConfiguration.UserRights")
-	proto.RegisterType((*GetIsConfigurationResponse)(nil), "ttn.lorawan.v3.GetIsConfigurationResponse")
-	golang_proto.RegisterType((*GetIsConfigurationResponse)(nil), "ttn.lorawan.v3.GetIsConfigurationResponse")
+// AuditEntry is an entry in the audit log of changes in the Identity Server.
+type AuditEntry struct {
+	Id        string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	// The name of the event that triggered the entry, such as application.update.
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// The type of the changed entity.
+	EntityType string `protobuf:"bytes,4,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	// The unique ID of the changed entity.
+	EntityId string `protobuf:"bytes,5,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// The other entities of the change, such as collaborators, as type:id.
+	RelatedEntities []string `protobuf:"bytes,6,rep,name=related_entities,json=relatedEntities,proto3" json:"related_entities,omitempty"`
+	// The field mask paths of the change.
+	Paths []string `protobuf:"bytes,7,rep,name=paths,proto3" json:"paths,omitempty"`
+	// The type of the account or entity that made the change.
+	ActorType string `protobuf:"bytes,8,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
+	// The unique ID of the account or entity that made the change.
+	ActorId       string `protobuf:"bytes,9,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	AuthType      string `protobuf:"bytes,10,opt,name=auth_type,json=authType,proto3" json:"auth_type,omitempty"`
+	AuthTokenType string `protobuf:"bytes,11,opt,name=auth_token_type,json=authTokenType,proto3" json:"auth_token_type,omitempty"`
+	AuthTokenId   string `protobuf:"bytes,12,opt,name=auth_token_id,json=authTokenId,proto3" json:"auth_token_id,omitempty"`
+	// The IP address of the client that made the change.
+	SourceIp string `protobuf:"bytes,13,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	// The user agent of the client that made the change.
+	UserAgent            string   `protobuf:"bytes,14,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("lorawan-stack/api/identityserver.proto", fileDescriptor_a1c7e02f6181562c)
+func (m *AuditEntry) Reset()      { *m = AuditEntry{} }
+func (*AuditEntry) ProtoMessage() {}
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1c7e02f6181562c, []int{4}
 }
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/identityserver.proto", fileDescriptor_a1c7e02f6181562c)
+func (m *AuditEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEntry.Unmarshal(m, b)
+}
+func (m *AuditEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEntry.Marshal(b, m, deterministic)
+}
+func (m *AuditEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEntry.Merge(m, src)
+}
+func (m *AuditEntry) XXX_Size() int {
+	return xxx_messageInfo_AuditEntry.Size(m)
+}
+func (m *AuditEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEntry.DiscardUnknown(m)
 }
 
-var fileDescriptor_a1c7e02f6181562c = []byte{
-	// 1255 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xba, 0xbf, 0xdc, 0x97, 0x38, 0x71, 0xa7, 0x3f, 0x48, 0xb6, 0xc5, 0x0d, 0x41, 0x42,
-	0xa5, 0x52, 0xd6, 0x28, 0x91, 0x90, 0x50, 0x29, 0xc2, 0x4e, 0x43, 0x62, 0x85, 0xd2, 0x68, 0xdb,
-	0x14, 0x04, 0x82, 0xd5, 0x64, 0x77, 0xb2, 0x1e, 0x65, 0x3d, 0xb3, 0xdd, 0x99, 0x75, 0xea, 0x1e,
-	0x10, 0xaa, 0x38, 0xc1, 0x05, 0xc1, 0x05, 0xfe, 0x03, 0x8e, 0xfc, 0x09, 0x1c, 0xb9, 0x71, 0xe0,
-	0xc2, 0x05, 0x10, 0x29, 0x07, 0x8e, 0x5c, 0xb8, 0xc0, 0x05, 0xed, 0xcc, 0xd8, 0xb1, 0xd7, 0x69,
-	0xe2, 0x54, 0xdc, 0x76, 0xe6, 0xbd, 0xef, 0x9b, 0x37, 0xdf, 0x7b, 0xfb, 0xe6, 0xc1, 0x4b, 0x11,
-	0x4f, 0xf0, 0x2e, 0x66, 0x0b, 0x42, 0x62, 0x7f, 0xa7, 0x86, 0x63, 0x5a, 0xa3, 0x01, 0x61, 0x92,
-	0xca, 0xae, 0x20, 0x49, 0x87, 0x24, 0x4e, 0x9c, 0x70, 0xc9, 0xd1, 0x94, 0x94, 0xcc, 0x31, 0xbe,
-	0x4e, 0x67, 0xc9, 0xae, 0x87, 0x54, 0xb6, 0xd2, 0x2d, 0xc7, 0xe7, 0xed, 0x1a, 0x61, 0x1d, 0xde,
-	0x8d, 0x13, 0xfe, 0xb0, 0x5b, 0x53, 0xce, 0xfe, 0x42, 0x48, 0xd8, 0x42, 0x07, 0x47, 0x34, 0xc0,
-	0x92, 0xd4, 0x46, 0x3e, 0x34, 0xa5, 0xbd, 0x30, 0x40, 0x11, 0xf2, 0x90, 0x6b, 0xf0, 0x56, 0xba,
-	0xad, 0x56, 0x6a, 0xa1, 0xbe, 0x8c, 0xfb, 0x95, 0x90, 0xf3, 0x30, 0x22, 0x2a, 0x44, 0xcc, 0x18,
-	0x97, 0x58, 0x52, 0xce, 0x84, 0xb1, 0x56, 0x8d, 0xb5, 0xcf, 0x11, 0xa4, 0x89, 0x72, 0x30, 0xf6,
-	0xcb, 0x79, 0x3b, 0x69, 0xc7, 0xb2, 0xfb, 0x34, 0xf0, 0x6e, 0x82, 0xe3, 0x98, 0x24, 0x3d, 0xf2,
-	0x17, 0x9f, 0x26, 0xd2, 0x36, 0xdd, 0x77, 0xba, 0x32, 0xea, 0x94, 0x8a, 0x9e, 0x7e, 0xf6, 0xf3,
-	0xa3, 0x56, 0x8e, 0x53, 0xd9, 0xea, 0x45, 0x30, 0x6a, 0x4e, 0x68, 0xd8, 0x92, 0x86, 0x7c, 0xfe,
-	0xdf, 0x13, 0x50, 0xa9, 0xa7, 0xb2, 0xd5, 0x64, 0xdb, 0xdc, 0x25, 0x22, 0xe6, 0x4c, 0x10, 0xb4,
-	0x06, 0x67, 0x70, 0x4c, 0xbd, 0x1d, 0xd2, 0x9d, 0xb1, 0xe6, 0xac, 0x6b, 0x13, 0x8b, 0x0b, 0xce,
-	0x70, 0x96, 0x9c, 0x3c, 0xc4, 0xa9, 0x6f, 0x34, 0xd7, 0x49, 0xb7, 0xee, 0xfb, 0x44, 0x88, 0xb5,
-	0x82, 0x7b, 0x1a, 0xc7, 0x74, 0x9d, 0x74, 0xd1, 0x06, 0x20, 0x15, 0x8d, 0x87, 0x95, 0xc5, 0x93,
-	0x7c, 0x87, 0xb0, 0x99, 0xa2, 0x22, 0x9d, 0xcb, 0x93, 0xde, 0xc9, 0x58, 0x35, 0xc5, 0xbd, 0xcc,
-	0x6f, 0xad, 0xe0, 0x56, 0x14, 0x7a, 0x60, 0x0f, 0xbd, 0x09, 0x93, 0xd9, 0xed, 0x3d, 0x41, 0x84,
-	0xa0, 0x9c, 0xcd, 0x9c, 0x52, 0x5c, 0x97, 0xf3, 0x5c, 0x9b, 0x82, 0x24, 0x77, 0xb5, 0xcb, 0x5a,
-	0xc1, 0x9d, 0x48, 0xf7, 0x97, 0xa8, 0x0e, 0x95, 0x94, 0xd1, 0x0e, 0x49, 0x04, 0x8e, 0x3c, 0x2d,
-	0xc6, 0xcc, 0x09, 0xc5, 0x72, 0x29, 0xcf, 0xe2, 0x2a, 0xab, 0x3b, 0xdd, 0xf7, 0xd7, 0x1b, 0x68,
-	0x16, 0x4a, 0x54, 0x78, 0x38, 0x68, 0x53, 0x36, 0x73, 0x72, 0xce, 0xba, 0x56, 0x72, 0xcf, 0x50,
-	0x51, 0xcf, 0x96, 0xf6, 0x37, 0x16, 0x4c, 0x0e, 0x8a, 0x81, 0x5e, 0xcb, 0x8b, 0x39, 0x72, 0x8a,
-	0x76, 0x6f, 0x94, 0xfe, 0x69, 0x9c, 0xfa, 0xcc, 0x2a, 0x56, 0xac, 0xbe, 0x7a, 0xef, 0x00, 0xe8,
-	0x3f, 0xc6, 0xa3, 0x81, 0x30, 0xaa, 0xbd, 0x90, 0x47, 0xaf, 0x28, 0x8f, 0xe6, 0x7e, 0xd9, 0x34,
-	0x26, 0x7b, 0x44, 0x3f, 0xfc, 0x7a, 0xb5, 0xe0, 0x9e, 0x25, 0xc6, 0x41, 0x34, 0xa6, 0xa1, 0x6c,
-	0xf2, 0xd0, 0x26, 0xb2, 0xc5, 0x83, 0xf9, 0xcb, 0x30, 0xbb, 0x4a, 0x64, 0x53, 0x2c, 0x73, 0xb6,
-	0x4d, 0x43, 0x53, 0xd8, 0x2e, 0x79, 0x90, 0x12, 0x21, 0xe7, 0xff, 0x9e, 0x86, 0xe9, 0x9c, 0x09,
-	0x7d, 0x08, 0xe7, 0x94, 0xfa, 0x09, 0x09, 0xa9, 0x90, 0x7a, 0xd3, 0x88, 0xf7, 0x4a, 0x3e, 0xb0,
-	0x1c, 0x56, 0xa5, 0xc4, 0x1d, 0xc0, 0xb9, 0x95, 0x34, 0xb7, 0x83, 0xde, 0x85, 0xe9, 0x38, 0xe1,
-	0xdb, 0x34, 0x22, 0x5e, 0x4c, 0x7d, 0x99, 0x26, 0x44, 0xc9, 0x3b, 0xb1, 0xe8, 0x1c, 0x45, 0xbe,
-	0xa1, 0x61, 0x1b, 0x1a, 0xe5, 0x4e, 0xc5, 0x43, 0x6b, 0xf4, 0x11, 0x20, 0xc2, 0x02, 0x2f, 0x20,
-	0x1d, 0xea, 0xef, 0x73, 0x9f, 0x1a, 0x2f, 0xf0, 0x15, 0x16, 0xdc, 0x52, 0xc0, 0x1e, 0x7b, 0x85,
-	0xe4, 0x76, 0xd0, 0x3a, 0x4c, 0x68, 0x5d, 0x74, 0x39, 0x9d, 0x56, 0xc4, 0xd7, 0xc7, 0x52, 0x44,
-	0x97, 0x18, 0xa4, 0xfd, 0x6f, 0xfb, 0x97, 0x12, 0x54, 0xf2, 0x62, 0xa1, 0x0f, 0x00, 0x28, 0xeb,
-	0x50, 0xdd, 0x9c, 0x4c, 0x25, 0xdd, 0x38, 0xae, 0xe4, 0x4e, 0xb3, 0x4f, 0xe1, 0x0e, 0xd0, 0xa1,
-	0x8f, 0xe1, 0x39, 0x9f, 0x33, 0x89, 0x7d, 0xe9, 0x51, 0xb6, 0xcd, 0x3d, 0xd3, 0x50, 0xb3, 0x93,
-	0x74, 0xd5, 0xbd, 0x75, 0xec, 0x93, 0x96, 0x35, 0x5f, 0xd6, 0x24, 0xee, 0xf7, 0xd9, 0xdc, 0x8b,
-	0xfe, 0x41, 0xdb, 0x88, 0xc0, 0x94, 0xfa, 0x99, 0x3c, 0x1c, 0xc7, 0x09, 0xef, 0xe0, 0xc8, 0xd4,
-	0xd4, 0x1b, 0xc7, 0x3e, 0x56, 0xfd, 0x84, 0x75, 0xc3, 0xe2, 0x96, 0xf1, 0xe0, 0x12, 0x3d, 0x82,
-	0x8b, 0x31, 0x16, 0x62, 0x97, 0x27, 0x81, 0x97, 0x90, 0x07, 0x29, 0x4d, 0x48, 0x9b, 0x30, 0x29,
-	0x4c, 0x91, 0xad, 0x1c, 0xfb, 0xb4, 0x0d, 0xc3, 0xe6, 0x0e, 0x90, 0xb9, 0x17, 0xe2, 0x03, 0x76,
-	0xd1, 0x0c, 0x9c, 0x21, 0x0c, 0x6f, 0x45, 0x24, 0x50, 0x65, 0x57, 0x72, 0x7b, 0x4b, 0xfb, 0xb1,
-	0x05, 0xb0, 0x9f, 0x17, 0xf4, 0x2a, 0x94, 0x4c, 0x6c, 0x81, 0x49, 0xb3, 0xed, 0xe8, 0x67, 0xc4,
-	0xe9, 0x3d, 0x23, 0x4e, 0x83, 0xf3, 0xe8, 0x3e, 0x8e, 0x52, 0xe2, 0xf6, 0x7d, 0xd1, 0xeb, 0x70,
-	0x56, 0x75, 0x57, 0x4f, 0xca, 0xc8, 0x64, 0x6d, 0x76, 0x04, 0x78, 0xcb, 0x5c, 0xa5, 0x71, 0xf2,
-	0xeb, 0xdf, 0xae, 0x5a, 0x6e, 0x49, 0x21, 0xee, 0xc9, 0xc8, 0xbe, 0x03, 0x17, 0x0f, 0xcc, 0xd8,
-	0xb3, 0x86, 0x63, 0xaf, 0x42, 0x79, 0x28, 0x17, 0xcf, 0x4c, 0xf4, 0x63, 0x11, 0x2e, 0x1c, 0xa4,
-	0x33, 0xba, 0x01, 0x90, 0x95, 0x4c, 0x44, 0x58, 0x28, 0x5b, 0x86, 0xf2, 0xca, 0x08, 0xe5, 0x66,
-	0x93, 0xc9, 0xa5, 0x45, 0x4d, 0x7a, 0xb6, 0x4d, 0xd9, 0xdb, 0xca, 0x5d, 0x81, 0xf1, 0xc3, 0x1e,
-	0xb8, 0x38, 0x16, 0x18, 0x3f, 0x34, 0xe0, 0x3a, 0x94, 0xb3, 0x93, 0xd3, 0xec, 0x29, 0xf7, 0xb1,
-	0x20, 0xa6, 0x5a, 0x0f, 0xc7, 0x4f, 0xb6, 0x29, 0xdb, 0xec, 0x21, 0x7a, 0xc1, 0x07, 0x34, 0xa4,
-	0xfd, 0xfa, 0x3b, 0x3a, 0xf8, 0x5b, 0xca, 0x1d, 0xdd, 0x84, 0x89, 0x0c, 0x2c, 0x62, 0xe2, 0x53,
-	0x1c, 0x99, 0x36, 0x76, 0x38, 0x3a, 0x3b, 0xed, 0xae, 0xf6, 0xb7, 0xbf, 0xb4, 0x60, 0x6a, 0xb8,
-	0x5f, 0xa2, 0x3a, 0x4c, 0x05, 0x54, 0x64, 0xf5, 0xe8, 0xa5, 0x71, 0xc4, 0xf1, 0x38, 0x29, 0x2a,
-	0x1b, 0xc4, 0xa6, 0x02, 0xa0, 0x9b, 0xea, 0x61, 0xf6, 0xc2, 0x04, 0x77, 0xb0, 0xc4, 0x89, 0xd1,
-	0xf4, 0x30, 0x82, 0xac, 0x65, 0xae, 0x1a, 0x77, 0x7b, 0x13, 0x2a, 0xf9, 0x3e, 0xfb, 0x3f, 0x44,
-	0x65, 0x7f, 0x57, 0x04, 0xd8, 0x6f, 0xb3, 0x68, 0x1d, 0xce, 0xfb, 0x09, 0xc1, 0x92, 0x64, 0x9d,
-	0x26, 0xa2, 0xbe, 0x1e, 0xf5, 0xc6, 0xa0, 0x45, 0x1a, 0x56, 0x1f, 0x40, 0x65, 0xe1, 0x19, 0x32,
-	0x3f, 0xa2, 0xaa, 0x8f, 0x1c, 0x7d, 0xe7, 0xb2, 0x46, 0x2c, 0x6b, 0x00, 0x5a, 0x86, 0x69, 0x43,
-	0x11, 0x62, 0x49, 0x76, 0x71, 0xb7, 0x37, 0x8a, 0x1c, 0xc6, 0x61, 0x4e, 0x5d, 0x35, 0x08, 0x74,
-	0x1b, 0x2e, 0x18, 0x12, 0x9e, 0x84, 0x98, 0xd1, 0x47, 0xe6, 0x56, 0x27, 0x8f, 0x64, 0x32, 0x62,
-	0xdc, 0x19, 0x84, 0xcd, 0xfb, 0x60, 0x1f, 0x34, 0x14, 0x98, 0xd9, 0x70, 0x05, 0xca, 0xfe, 0xa0,
-	0xc1, 0x68, 0x77, 0xf5, 0x88, 0xde, 0xe9, 0x0e, 0xa3, 0x16, 0x5b, 0x30, 0xa9, 0x07, 0x17, 0x33,
-	0x25, 0xbd, 0x07, 0xa5, 0xde, 0x4c, 0x89, 0x2e, 0x8d, 0x44, 0xbc, 0x92, 0xcd, 0xd4, 0xf6, 0xdc,
-	0x51, 0x53, 0xe8, 0x3c, 0x7a, 0xfc, 0xd3, 0x1f, 0x5f, 0x15, 0x27, 0x11, 0xd4, 0xd4, 0xd0, 0x99,
-	0xbd, 0x65, 0x8b, 0x9f, 0x5b, 0x50, 0x6c, 0x0a, 0xf4, 0xa9, 0x05, 0x95, 0x55, 0x22, 0x87, 0xc7,
-	0x99, 0x97, 0xf3, 0x8c, 0x4f, 0x9d, 0x86, 0xec, 0xeb, 0xe3, 0xb8, 0x9a, 0x30, 0x66, 0x55, 0x18,
-	0xe7, 0xd1, 0xb9, 0x1a, 0x15, 0xb5, 0xa1, 0x7b, 0x37, 0x6e, 0xff, 0xfc, 0x7b, 0xb5, 0xf0, 0xc9,
-	0x5e, 0xd5, 0xfa, 0x76, 0xaf, 0x6a, 0xfd, 0xb9, 0x57, 0x2d, 0xfc, 0xb5, 0x57, 0xb5, 0xbe, 0x78,
-	0x52, 0x2d, 0x7c, 0xff, 0xa4, 0x6a, 0xbd, 0x5f, 0x0b, 0xb9, 0x23, 0x5b, 0x44, 0xb6, 0x28, 0x0b,
-	0x85, 0xc3, 0x88, 0xdc, 0xe5, 0xc9, 0x4e, 0x6d, 0x78, 0x84, 0xef, 0x2c, 0xd5, 0xe2, 0x9d, 0xb0,
-	0x26, 0x25, 0x8b, 0xb7, 0xb6, 0x4e, 0x2b, 0x89, 0x96, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xd9,
-	0x73, 0xa8, 0x81, 0x6e, 0x0d, 0x00, 0x00,
+var xxx_messageInfo_AuditEntry proto.InternalMessageInfo
+
+func (m *AuditEntry) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func (this *AuthInfoResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *AuditEntry) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
+	return nil
+}
 
-	that1, ok := that.(*AuthInfoResponse)
-	if !ok {
-		that2, ok := that.(AuthInfoResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *AuditEntry) GetAction() string {
+	if m != nil {
+		return m.Action
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *AuditEntry) GetEntityType() string {
+	if m != nil {
+		return m.EntityType
 	}
-	if that1.AccessMethod == nil {
-		if this.AccessMethod != nil {
-			return false
-		}
-	} else if this.AccessMethod == nil {
-		return false
-	} else if !this.AccessMethod.Equal(that1.AccessMethod) {
-		return false
+	return ""
+}
+
+func (m *AuditEntry) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
 	}
-	if !this.UniversalRights.Equal(that1.UniversalRights) {
-		return false
+	return ""
+}
+
+func (m *AuditEntry) GetRelatedEntities() []string {
+	if m != nil {
+		return m.RelatedEntities
 	}
-	if this.IsAdmin != that1.IsAdmin {
-		return false
+	return nil
+}
+
+func (m *AuditEntry) GetPaths() []string {
+	if m != nil {
+		return m.Paths
 	}
-	return true
+	return nil
 }
-func (this *AuthInfoResponse_ApiKey) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *AuditEntry) GetActorType() string {
+	if m != nil {
+		return m.ActorType
 	}
+	return ""
+}
 
-	that1, ok := that.(*AuthInfoResponse_ApiKey)
-	if !ok {
-		that2, ok := that.(AuthInfoResponse_ApiKey)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *AuditEntry) GetActorId() string {
+	if m != nil {
+		return m.ActorId
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *AuditEntry) GetAuthType() string {
+	if m != nil {
+		return m.AuthType
 	}
-	if !this.ApiKey.Equal(that1.ApiKey) {
-		return false
+	return ""
+}
+
+func (m *AuditEntry) GetAuthTokenType() string {
+	if m != nil {
+		return m.AuthTokenType
 	}
-	return true
+	return ""
 }
-func (this *AuthInfoResponse_OauthAccessToken) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *AuditEntry) GetAuthTokenId() string {
+	if m != nil {
+		return m.AuthTokenId
 	}
+	return ""
+}
 
-	that1, ok := that.(*AuthInfoResponse_OauthAccessToken)
-	if !ok {
-		that2, ok := that.(AuthInfoResponse_OauthAccessToken)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *AuditEntry) GetSourceIp() string {
+	if m != nil {
+		return m.SourceIp
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *AuditEntry) GetUserAgent() string {
+	if m != nil {
+		return m.UserAgent
 	}
-	if !this.OauthAccessToken.Equal(that1.OauthAccessToken) {
-		return false
+	return ""
+}
+
+type AuditEntries struct {
+	Entries              []*AuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AuditEntries) Reset()      { *m = AuditEntries{} }
+func (*AuditEntries) ProtoMessage() {}
+func (*AuditEntries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1c7e02f6181562c, []int{5}
+}
+func (m *AuditEntries) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEntries.Unmarshal(m, b)
+}
+func (m *AuditEntries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEntries.Marshal(b, m, deterministic)
+}
+func (m *AuditEntries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEntries.Merge(m, src)
+}
+func (m *AuditEntries) XXX_Size() int {
+	return xxx_messageInfo_AuditEntries.Size(m)
+}
+func (m *AuditEntries) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEntries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEntries proto.InternalMessageInfo
+
+func (m *AuditEntries) GetEntries() []*AuditEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return true
+	return nil
 }
-func (this *AuthInfoResponse_UserSession) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+type ListAuditEntriesRequest struct {
+	// Only list the entries of this entity. Only admins can list the entries of all entities.
+	EntityIds *EntityIdentifiers `protobuf:"bytes,1,opt,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
+	// Only list the entries that were created at or after this time.
+	After *time.Time `protobuf:"bytes,2,opt,name=after,proto3,stdtime" json:"after,omitempty"`
+	// Only list the entries that were created before this time.
+	Before *time.Time `protobuf:"bytes,3,opt,name=before,proto3,stdtime" json:"before,omitempty"`
+	// Limit the number of results per page.
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Page number for pagination. 0 is interpreted as 1.
+	Page                 uint32   `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAuditEntriesRequest) Reset()      { *m = ListAuditEntriesRequest{} }
+func (*ListAuditEntriesRequest) ProtoMessage() {}
+func (*ListAuditEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1c7e02f6181562c, []int{6}
+}
+func (m *ListAuditEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEntriesRequest.Unmarshal(m, b)
+}
+func (m *ListAuditEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEntriesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAuditEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEntriesRequest.Merge(m, src)
+}
+func (m *ListAuditEntriesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEntriesRequest.Size(m)
+}
+func (m *ListAuditEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEntriesRequest proto.InternalMessageInfo
+
+func (m *ListAuditEntriesRequest) GetEntityIds() *EntityIdentifiers {
+	if m != nil {
+		return m.EntityIds
 	}
+	return nil
+}
 
-	that1, ok := that.(*AuthInfoResponse_UserSession)
-	if !ok {
-		that2, ok := that.(AuthInfoResponse_UserSession)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ListAuditEntriesRequest) GetAfter() *time.Time {
+	if m != nil {
+		return m.After
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *ListAuditEntriesRequest) GetBefore() *time.Time {
+	if m != nil {
+		return m.Before
 	}
-	if !this.UserSession.Equal(that1.UserSession) {
-		return false
+	return nil
+}
+
+func (m *ListAuditEntriesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	return true
+	return 0
 }
-func (this *AuthInfoResponse_APIKeyAccess) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ListAuditEntriesRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+// Notification is a notification in the inbox of a user.
+type Notification struct {
+	Id        string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	// The type of the notification, such as api_key_created.
+	NotificationType string `protobuf:"bytes,3,opt,name=notification_type,json=notificationType,proto3" json:"notification_type,omitempty"`
+	// The type of the entity that the notification is about.
+	EntityType string `protobuf:"bytes,4,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	// The ID of the entity that the notification is about.
+	EntityId             string             `protobuf:"bytes,5,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Subject              string             `protobuf:"bytes,6,opt,name=subject,proto3" json:"subject,omitempty"`
+	Body                 string             `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	Status               NotificationStatus `protobuf:"varint,8,opt,name=status,proto3,enum=ttn.lorawan.v3.NotificationStatus" json:"status,omitempty"`
+	StatusUpdatedAt      *time.Time         `protobuf:"bytes,9,opt,name=status_updated_at,json=statusUpdatedAt,proto3,stdtime" json:"status_updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Notification) Reset()      { *m = Notification{} }
+func (*Notification) ProtoMessage() {}
+func (*Notification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1c7e02f6181562c, []int{7}
+}
+func (m *Notification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Notification.Unmarshal(m, b)
+}
+func (m *Notification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Notification.Marshal(b, m, deterministic)
+}
+func (m *Notification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notification.Merge(m, src)
+}
+func (m *Notification) XXX_Size() int {
+	return xxx_messageInfo_Notification.Size(m)
+}
+func (m *Notification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notification proto.InternalMessageInfo
+
+func (m *Notification) GetId() string {
+	if m != nil {
+		return m.Id
 	}
+	return ""
+}
 
-	that1, ok := that.(*AuthInfoResponse_APIKeyAccess)
-	if !ok {
-		that2, ok := that.(AuthInfoResponse_APIKeyAccess)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Notification) GetCreatedAt() *time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *Notification) GetNotificationType() string {
+	if m != nil {
+		return m.NotificationType
 	}
-	if !this.ApiKey.Equal(that1.ApiKey) {
-		return false
+	return ""
+}
+
+func (m *Notification) GetEntityType() string {
+	if m != nil {
+		return m.EntityType
 	}
-	if !this.EntityIds.Equal(&that1.EntityIds) {
-		return false
+	return ""
+}
+
+func (m *Notification) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
 	}
-	return true
+	return ""
 }
-func (this *GetIsConfigurationRequest) Equal(that interface{}) bool {
+
+func (m *Notification) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *Notification) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *Notification) GetStatus() NotificationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return NotificationStatus_NOTIFICATION_STATUS_UNREAD
+}
+
+func (m *Notification) GetStatusUpdatedAt() *time.Time {
+	if m != nil {
+		return m.StatusUpdatedAt
+	}
+	return nil
+}
+
+type Notifications struct {
+	Notifications        []*Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Notifications) Reset()      { *m = Notifications{} }
+func (*Notifications) ProtoMessage() {}
+func (*Notifications) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1c7e02f6181562c, []int{8}
+}
+func (m *Notifications) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Notifications.Unmarshal(m, b)
+}
+func (m *Notifications) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Notifications.Marshal(b, m, deterministic)
+}
+func (m *Notifications) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notifications.Merge(m, src)
+}
+func (m *Notifications) XXX_Size() int {
+	return xxx_messageInfo_Notifications.Size(m)
+}
+func (m *Notifications) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notifications.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notifications proto.InternalMessageInfo
+
+func (m *Notifications) GetNotifications() []*Notification {
+	if m != nil {
+		return m.Notifications
+	}
+	return nil
+}
+
+type ListNotificationsRequest struct {
+	// The user that received the notifications.
+	ReceiverIds *UserIdentifiers `protobuf:"bytes,1,opt,name=receiver_ids,json=receiverIds,proto3" json:"receiver_ids,omitempty"`
+	// Only list notifications with these statuses. All notifications are listed if no statuses are given.
+	Status []NotificationStatus `protobuf:"varint,2,rep,packed,name=status,proto3,enum=ttn.lorawan.v3.NotificationStatus" json:"status,omitempty"`
+	// Limit the number of results per page.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Page number for pagination. 0 is interpreted as 1.
+	Page                 uint32   `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListNotificationsRequest) Reset()      { *m = ListNotificationsRequest{} }
+func (*ListNotificationsRequest) ProtoMessage() {}
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1c7e02f6181562c, []int{9}
+}
+func (m *ListNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNotificationsRequest.Unmarshal(m, b)
+}
+func (m *ListNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNotificationsRequest.Merge(m, src)
+}
+func (m *ListNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListNotificationsRequest.Size(m)
+}
+func (m *ListNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNotificationsRequest proto.InternalMessageInfo
+
+func (m *ListNotificationsRequest) GetReceiverIds() *UserIdentifiers {
+	if m != nil {
+		return m.ReceiverIds
+	}
+	return nil
+}
+
+func (m *ListNotificationsRequest) GetStatus() []NotificationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListNotificationsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListNotificationsRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+type UpdateNotificationStatusRequest struct {
+	// The user that received the notifications.
+	ReceiverIds          *UserIdentifiers   `protobuf:"bytes,1,opt,name=receiver_ids,json=receiverIds,proto3" json:"receiver_ids,omitempty"`
+	Ids                  []string           `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+	Status               NotificationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=ttn.lorawan.v3.NotificationStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *UpdateNotificationStatusRequest) Reset()      { *m = UpdateNotificationStatusRequest{} }
+func (*UpdateNotificationStatusRequest) ProtoMessage() {}
+func (*UpdateNotificationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1c7e02f6181562c, []int{10}
+}
+func (m *UpdateNotificationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateNotificationStatusRequest.Unmarshal(m, b)
+}
+func (m *UpdateNotificationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateNotificationStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateNotificationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateNotificationStatusRequest.Merge(m, src)
+}
+func (m *UpdateNotificationStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateNotificationStatusRequest.Size(m)
+}
+func (m *UpdateNotificationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateNotificationStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateNotificationStatusRequest proto.InternalMessageInfo
+
+func (m *UpdateNotificationStatusRequest) GetReceiverIds() *UserIdentifiers {
+	if m != nil {
+		return m.ReceiverIds
+	}
+	return nil
+}
+
+func (m *UpdateNotificationStatusRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *UpdateNotificationStatusRequest) GetStatus() NotificationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return NotificationStatus_NOTIFICATION_STATUS_UNREAD
+}
+
+type StreamNotificationsRequest struct {
+	// The user that receives the notifications.
+	ReceiverIds          *UserIdentifiers `protobuf:"bytes,1,opt,name=receiver_ids,json=receiverIds,proto3" json:"receiver_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *StreamNotificationsRequest) Reset()      { *m = StreamNotificationsRequest{} }
+func (*StreamNotificationsRequest) ProtoMessage() {}
+func (*StreamNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1c7e02f6181562c, []int{11}
+}
+func (m *StreamNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamNotificationsRequest.Unmarshal(m, b)
+}
+func (m *StreamNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamNotificationsRequest.Merge(m, src)
+}
+func (m *StreamNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamNotificationsRequest.Size(m)
+}
+func (m *StreamNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamNotificationsRequest proto.InternalMessageInfo
+
+func (m *StreamNotificationsRequest) GetReceiverIds() *UserIdentifiers {
+	if m != nil {
+		return m.ReceiverIds
+	}
+	return nil
+}
+
+// NotificationEmailPreferences are the preferences of a user for receiving notifications by email.
+type NotificationEmailPreferences struct {
+	// Whether notifications are sent by email, by notification type.
+	// Notification types that are not in the preferences are sent by email.
+	Email                map[string]bool `protobuf:"bytes,1,rep,name=email,proto3" json:"email,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *NotificationEmailPreferences) Reset()      { *m = NotificationEmailPreferences{} }
+func (*NotificationEmailPreferences) ProtoMessage() {}
+func (*NotificationEmailPreferences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1c7e02f6181562c, []int{12}
+}
+func (m *NotificationEmailPreferences) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotificationEmailPreferences.Unmarshal(m, b)
+}
+func (m *NotificationEmailPreferences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotificationEmailPreferences.Marshal(b, m, deterministic)
+}
+func (m *NotificationEmailPreferences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationEmailPreferences.Merge(m, src)
+}
+func (m *NotificationEmailPreferences) XXX_Size() int {
+	return xxx_messageInfo_NotificationEmailPreferences.Size(m)
+}
+func (m *NotificationEmailPreferences) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationEmailPreferences.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationEmailPreferences proto.InternalMessageInfo
+
+func (m *NotificationEmailPreferences) GetEmail() map[string]bool {
+	if m != nil {
+		return m.Email
+	}
+	return nil
+}
+
+type SetNotificationEmailPreferencesRequest struct {
+	UserIds              *UserIdentifiers              `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Preferences          *NotificationEmailPreferences `protobuf:"bytes,2,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *SetNotificationEmailPreferencesRequest) Reset() {
+	*m = SetNotificationEmailPreferencesRequest{}
+}
+func (*SetNotificationEmailPreferencesRequest) ProtoMessage() {}
+func (*SetNotificationEmailPreferencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1c7e02f6181562c, []int{13}
+}
+func (m *SetNotificationEmailPreferencesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetNotificationEmailPreferencesRequest.Unmarshal(m, b)
+}
+func (m *SetNotificationEmailPreferencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetNotificationEmailPreferencesRequest.Marshal(b, m, deterministic)
+}
+func (m *SetNotificationEmailPreferencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetNotificationEmailPreferencesRequest.Merge(m, src)
+}
+func (m *SetNotificationEmailPreferencesRequest) XXX_Size() int {
+	return xxx_messageInfo_SetNotificationEmailPreferencesRequest.Size(m)
+}
+func (m *SetNotificationEmailPreferencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetNotificationEmailPreferencesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetNotificationEmailPreferencesRequest proto.InternalMessageInfo
+
+func (m *SetNotificationEmailPreferencesRequest) GetUserIds() *UserIdentifiers {
+	if m != nil {
+		return m.UserIds
+	}
+	return nil
+}
+
+func (m *SetNotificationEmailPreferencesRequest) GetPreferences() *NotificationEmailPreferences {
+	if m != nil {
+		return m.Preferences
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.NotificationStatus", NotificationStatus_name, NotificationStatus_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.NotificationStatus", NotificationStatus_name, NotificationStatus_value)
+	proto.RegisterType((*AuthInfoResponse)(nil), "ttn.lorawan.v3.AuthInfoResponse")
+	golang_proto.RegisterType((*AuthInfoResponse)(nil), "ttn.lorawan.v3.AuthInfoResponse")
+	proto.RegisterType((*AuthInfoResponse_APIKeyAccess)(nil), "ttn.lorawan.v3.AuthInfoResponse.APIKeyAccess")
+	golang_proto.RegisterType((*AuthInfoResponse_APIKeyAccess)(nil), "ttn.lorawan.v3.AuthInfoResponse.APIKeyAccess")
+	proto.RegisterType((*GetIsConfigurationRequest)(nil), "ttn.lorawan.v3.GetIsConfigurationRequest")
+	golang_proto.RegisterType((*GetIsConfigurationRequest)(nil), "ttn.lorawan.v3.GetIsConfigurationRequest")
+	proto.RegisterType((*IsConfiguration)(nil), "ttn.lorawan.v3.IsConfiguration")
+	golang_proto.RegisterType((*IsConfiguration)(nil), "ttn.lorawan.v3.IsConfiguration")
+	proto.RegisterType((*IsConfiguration_UserRegistration)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration")
+	golang_proto.RegisterType((*IsConfiguration_UserRegistration)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration")
+	proto.RegisterType((*IsConfiguration_UserRegistration_Invitation)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration.Invitation")
+	golang_proto.RegisterType((*IsConfiguration_UserRegistration_Invitation)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration.Invitation")
+	proto.RegisterType((*IsConfiguration_UserRegistration_ContactInfoValidation)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration.ContactInfoValidation")
+	golang_proto.RegisterType((*IsConfiguration_UserRegistration_ContactInfoValidation)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration.ContactInfoValidation")
+	proto.RegisterType((*IsConfiguration_UserRegistration_AdminApproval)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration.AdminApproval")
+	golang_proto.RegisterType((*IsConfiguration_UserRegistration_AdminApproval)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration.AdminApproval")
+	proto.RegisterType((*IsConfiguration_UserRegistration_PasswordRequirements)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration.PasswordRequirements")
+	golang_proto.RegisterType((*IsConfiguration_UserRegistration_PasswordRequirements)(nil), "ttn.lorawan.v3.IsConfiguration.UserRegistration.PasswordRequirements")
+	proto.RegisterType((*IsConfiguration_ProfilePicture)(nil), "ttn.lorawan.v3.IsConfiguration.ProfilePicture")
+	golang_proto.RegisterType((*IsConfiguration_ProfilePicture)(nil), "ttn.lorawan.v3.IsConfiguration.ProfilePicture")
+	proto.RegisterType((*IsConfiguration_EndDevicePicture)(nil), "ttn.lorawan.v3.IsConfiguration.EndDevicePicture")
+	golang_proto.RegisterType((*IsConfiguration_EndDevicePicture)(nil), "ttn.lorawan.v3.IsConfiguration.EndDevicePicture")
+	proto.RegisterType((*IsConfiguration_UserRights)(nil), "ttn.lorawan.v3.IsConfiguration.UserRights")
+	golang_proto.RegisterType((*IsConfiguration_UserRights)(nil), "ttn.lorawan.v3.IsConfiguration.UserRights")
+	proto.RegisterType((*GetIsConfigurationResponse)(nil), "ttn.lorawan.v3.GetIsConfigurationResponse")
+	golang_proto.RegisterType((*GetIsConfigurationResponse)(nil), "ttn.lorawan.v3.GetIsConfigurationResponse")
+	proto.RegisterType((*AuditEntry)(nil), "ttn.lorawan.v3.AuditEntry")
+	golang_proto.RegisterType((*AuditEntry)(nil), "ttn.lorawan.v3.AuditEntry")
+	proto.RegisterType((*AuditEntries)(nil), "ttn.lorawan.v3.AuditEntries")
+	golang_proto.RegisterType((*AuditEntries)(nil), "ttn.lorawan.v3.AuditEntries")
+	proto.RegisterType((*ListAuditEntriesRequest)(nil), "ttn.lorawan.v3.ListAuditEntriesRequest")
+	golang_proto.RegisterType((*ListAuditEntriesRequest)(nil), "ttn.lorawan.v3.ListAuditEntriesRequest")
+	proto.RegisterType((*Notification)(nil), "ttn.lorawan.v3.Notification")
+	golang_proto.RegisterType((*Notification)(nil), "ttn.lorawan.v3.Notification")
+	proto.RegisterType((*Notifications)(nil), "ttn.lorawan.v3.Notifications")
+	golang_proto.RegisterType((*Notifications)(nil), "ttn.lorawan.v3.Notifications")
+	proto.RegisterType((*ListNotificationsRequest)(nil), "ttn.lorawan.v3.ListNotificationsRequest")
+	golang_proto.RegisterType((*ListNotificationsRequest)(nil), "ttn.lorawan.v3.ListNotificationsRequest")
+	proto.RegisterType((*UpdateNotificationStatusRequest)(nil), "ttn.lorawan.v3.UpdateNotificationStatusRequest")
+	golang_proto.RegisterType((*UpdateNotificationStatusRequest)(nil), "ttn.lorawan.v3.UpdateNotificationStatusRequest")
+	proto.RegisterType((*StreamNotificationsRequest)(nil), "ttn.lorawan.v3.StreamNotificationsRequest")
+	golang_proto.RegisterType((*StreamNotificationsRequest)(nil), "ttn.lorawan.v3.StreamNotificationsRequest")
+	proto.RegisterType((*NotificationEmailPreferences)(nil), "ttn.lorawan.v3.NotificationEmailPreferences")
+	golang_proto.RegisterType((*NotificationEmailPreferences)(nil), "ttn.lorawan.v3.NotificationEmailPreferences")
+	proto.RegisterMapType((map[string]bool)(nil), "ttn.lorawan.v3.NotificationEmailPreferences.EmailEntry")
+	golang_proto.RegisterMapType((map[string]bool)(nil), "ttn.lorawan.v3.NotificationEmailPreferences.EmailEntry")
+	proto.RegisterType((*SetNotificationEmailPreferencesRequest)(nil), "ttn.lorawan.v3.SetNotificationEmailPreferencesRequest")
+	golang_proto.RegisterType((*SetNotificationEmailPreferencesRequest)(nil), "ttn.lorawan.v3.SetNotificationEmailPreferencesRequest")
+}
+
+func init() {
+	proto.RegisterFile("lorawan-stack/api/identityserver.proto", fileDescriptor_a1c7e02f6181562c)
+}
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/identityserver.proto", fileDescriptor_a1c7e02f6181562c)
+}
+
+var fileDescriptor_a1c7e02f6181562c = []byte{
+	// 2374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xd7, 0x92, 0xfa, 0x41, 0x3e, 0x89, 0x12, 0x3d, 0x72, 0x12, 0x6a, 0x2d, 0x4b, 0xfa, 0xf2,
+	0x0b, 0xa4, 0x8e, 0x12, 0x91, 0x81, 0x94, 0xba, 0xae, 0xd3, 0xa4, 0x21, 0x25, 0x45, 0x26, 0xec,
+	0xd8, 0xc2, 0x4a, 0x72, 0x03, 0x15, 0x2d, 0x31, 0xda, 0x1d, 0x51, 0x63, 0x93, 0xbb, 0x9b, 0x9d,
+	0x59, 0xc9, 0x4c, 0xa1, 0xa2, 0x35, 0x7a, 0x6a, 0x0f, 0x75, 0xdd, 0x4b, 0x9a, 0x7f, 0x20, 0x45,
+	0x4f, 0xbd, 0xf5, 0x50, 0xa0, 0xe8, 0x31, 0xb7, 0x1e, 0xd2, 0x43, 0x2f, 0x6d, 0x51, 0xa7, 0x40,
+	0x8d, 0x9e, 0x7a, 0xe9, 0xc5, 0xbd, 0x14, 0xf3, 0x63, 0xc9, 0xe5, 0x0f, 0x49, 0x94, 0x9b, 0xdc,
+	0x66, 0xde, 0x7b, 0x9f, 0xcf, 0xbc, 0x7d, 0xf3, 0xe6, 0xcd, 0x1b, 0x12, 0x5e, 0xae, 0x7b, 0x01,
+	0x3e, 0xc2, 0xee, 0x12, 0xe3, 0xd8, 0xbe, 0x5f, 0xc4, 0x3e, 0x2d, 0x52, 0x87, 0xb8, 0x9c, 0xf2,
+	0x26, 0x23, 0xc1, 0x21, 0x09, 0x0a, 0x7e, 0xe0, 0x71, 0x0f, 0x4d, 0x72, 0xee, 0x16, 0xb4, 0x6d,
+	0xe1, 0x70, 0xc5, 0x5c, 0xad, 0x51, 0x7e, 0x10, 0xee, 0x15, 0x6c, 0xaf, 0x51, 0xdc, 0x3e, 0x20,
+	0xdb, 0x07, 0xd4, 0xad, 0xb1, 0x8a, 0xeb, 0x84, 0x8c, 0x07, 0x94, 0xb0, 0xa2, 0x44, 0xd9, 0x4b,
+	0x35, 0xe2, 0x2e, 0xd5, 0xbc, 0xa5, 0x7b, 0xcc, 0x73, 0x8b, 0xd8, 0x75, 0x3d, 0x8e, 0x39, 0xf5,
+	0x5c, 0xa6, 0x48, 0xcd, 0x52, 0x8c, 0x84, 0xb8, 0x87, 0x5e, 0xd3, 0x0f, 0xbc, 0x07, 0xcd, 0x38,
+	0xf6, 0x10, 0xd7, 0xa9, 0x83, 0x39, 0x29, 0xf6, 0x0c, 0x34, 0xc5, 0x52, 0x8c, 0xa2, 0xe6, 0xd5,
+	0x3c, 0x05, 0xde, 0x0b, 0xf7, 0xe5, 0x4c, 0x4e, 0xe4, 0x48, 0x9b, 0xcf, 0xd6, 0x3c, 0xaf, 0x56,
+	0x27, 0xf2, 0x3b, 0x7b, 0xfd, 0x99, 0xd3, 0xda, 0x16, 0x87, 0x13, 0x06, 0xd2, 0x40, 0xeb, 0x2f,
+	0x75, 0xeb, 0x49, 0xc3, 0xe7, 0x4d, 0xad, 0x9c, 0xef, 0x56, 0x72, 0xda, 0x20, 0x8c, 0xe3, 0x86,
+	0x7f, 0x12, 0xfb, 0x51, 0x80, 0x7d, 0x9f, 0x04, 0xd1, 0xea, 0xff, 0x7f, 0xd2, 0x56, 0xec, 0xd3,
+	0xb6, 0xd1, 0x6c, 0xaf, 0x51, 0xc8, 0xa2, 0x5d, 0x32, 0x2f, 0xf7, 0x6a, 0x3d, 0x1c, 0xf2, 0x83,
+	0xc8, 0x83, 0x5e, 0x75, 0x40, 0x6b, 0x07, 0x5c, 0x93, 0xe7, 0xff, 0x93, 0x84, 0x6c, 0x29, 0xe4,
+	0x07, 0x15, 0x77, 0xdf, 0xb3, 0x08, 0xf3, 0x3d, 0x97, 0x11, 0x74, 0x03, 0xc6, 0xb0, 0x4f, 0xab,
+	0xf7, 0x49, 0x33, 0x67, 0x2c, 0x18, 0x57, 0xc6, 0x97, 0x97, 0x0a, 0x9d, 0xb9, 0x50, 0xe8, 0x86,
+	0x14, 0x4a, 0x9b, 0x95, 0x9b, 0xa4, 0x59, 0xb2, 0x6d, 0xc2, 0xd8, 0x8d, 0x21, 0x6b, 0x14, 0xfb,
+	0xf4, 0x26, 0x69, 0xa2, 0x4d, 0x40, 0xd2, 0x9b, 0x2a, 0x96, 0x9a, 0x2a, 0xf7, 0xee, 0x13, 0x37,
+	0x97, 0x90, 0xa4, 0x0b, 0xdd, 0xa4, 0x77, 0x04, 0xab, 0xa2, 0xd8, 0x16, 0x76, 0x37, 0x86, 0xac,
+	0xac, 0x87, 0x3b, 0x65, 0xe8, 0x1d, 0x98, 0x10, 0x5f, 0x5f, 0x65, 0x84, 0x31, 0xea, 0xb9, 0xb9,
+	0x11, 0xc9, 0x75, 0xa9, 0x9b, 0x6b, 0x87, 0x91, 0x60, 0x4b, 0x99, 0xdc, 0x18, 0xb2, 0xc6, 0xc3,
+	0xf6, 0x14, 0x95, 0x20, 0x1b, 0xba, 0xf4, 0x90, 0x04, 0x0c, 0xd7, 0xab, 0x2a, 0x18, 0xb9, 0xa4,
+	0x64, 0x79, 0xb1, 0x9b, 0xc5, 0x92, 0x5a, 0x6b, 0xaa, 0x65, 0xaf, 0x04, 0x68, 0x06, 0x52, 0x94,
+	0x55, 0xb1, 0xd3, 0xa0, 0x6e, 0x6e, 0x78, 0xc1, 0xb8, 0x92, 0xb2, 0xc6, 0x28, 0x2b, 0x89, 0xa9,
+	0xf9, 0x0b, 0x03, 0x26, 0xe2, 0xc1, 0x40, 0x5f, 0xef, 0x0e, 0x66, 0xcf, 0x2a, 0xca, 0xbc, 0x9c,
+	0x7a, 0x56, 0x1e, 0xf9, 0xb1, 0x91, 0xc8, 0x1a, 0xad, 0xe8, 0xdd, 0x06, 0x50, 0xe7, 0xb2, 0x4a,
+	0x1d, 0xa6, 0xa3, 0xf6, 0x7f, 0xdd, 0xe8, 0x75, 0x69, 0x51, 0x69, 0xa7, 0x4d, 0x79, 0x22, 0x22,
+	0xfa, 0xf4, 0x2f, 0xf3, 0x43, 0x56, 0x9a, 0x68, 0x03, 0x56, 0x9e, 0x82, 0x8c, 0xde, 0x87, 0x06,
+	0xe1, 0x07, 0x9e, 0x93, 0xbf, 0x04, 0x33, 0x1b, 0x84, 0x57, 0xd8, 0xaa, 0xe7, 0xee, 0xd3, 0x9a,
+	0xce, 0x7c, 0x8b, 0x7c, 0x10, 0x12, 0xc6, 0xf3, 0xff, 0x9e, 0x82, 0xa9, 0x2e, 0x15, 0xfa, 0x0e,
+	0x5c, 0x90, 0xd1, 0x0f, 0x48, 0x8d, 0x32, 0xae, 0x84, 0x3a, 0x78, 0xaf, 0x77, 0x3b, 0xd6, 0x85,
+	0x95, 0x5b, 0x62, 0xc5, 0x70, 0x56, 0x36, 0xec, 0x92, 0xa0, 0x6f, 0xc1, 0x94, 0x1f, 0x78, 0xfb,
+	0xb4, 0x4e, 0xaa, 0x3e, 0xb5, 0x79, 0x18, 0x10, 0x19, 0xde, 0xf1, 0xe5, 0xc2, 0x59, 0xe4, 0x9b,
+	0x0a, 0xb6, 0xa9, 0x50, 0xd6, 0xa4, 0xdf, 0x31, 0x47, 0xdf, 0x05, 0x44, 0x5c, 0xa7, 0xea, 0x90,
+	0x43, 0x6a, 0xb7, 0xb9, 0x47, 0x06, 0x73, 0x7c, 0xdd, 0x75, 0xd6, 0x24, 0x30, 0x62, 0xcf, 0x92,
+	0x2e, 0x09, 0xba, 0x09, 0xe3, 0x2a, 0x2e, 0x2a, 0x9d, 0x46, 0x25, 0xf1, 0xe2, 0x40, 0x11, 0x51,
+	0x29, 0x06, 0x61, 0x6b, 0x6c, 0xfe, 0x39, 0x05, 0xd9, 0xee, 0x60, 0xa1, 0x6f, 0x03, 0x50, 0xf7,
+	0x90, 0xaa, 0xea, 0xa5, 0x33, 0xe9, 0xcd, 0xf3, 0x86, 0xbc, 0x50, 0x69, 0x51, 0x58, 0x31, 0x3a,
+	0xf4, 0x7d, 0x78, 0xc9, 0xf6, 0x5c, 0x8e, 0x6d, 0x5e, 0xa5, 0xee, 0xbe, 0x57, 0xd5, 0x15, 0x57,
+	0xac, 0xa4, 0xb2, 0xee, 0xdd, 0x73, 0xaf, 0xb4, 0xaa, 0xf8, 0x44, 0x91, 0xb8, 0xdb, 0x62, 0xb3,
+	0x5e, 0xb0, 0xfb, 0x89, 0x11, 0x81, 0x49, 0x79, 0x98, 0xaa, 0xd8, 0xf7, 0x03, 0xef, 0x10, 0xd7,
+	0x75, 0x4e, 0xbd, 0x7d, 0xee, 0x65, 0xe5, 0x21, 0x2c, 0x69, 0x16, 0x2b, 0x83, 0xe3, 0x53, 0xf4,
+	0x21, 0xbc, 0xe0, 0x63, 0xc6, 0x8e, 0xbc, 0xc0, 0xa9, 0x06, 0xe4, 0x83, 0x90, 0x06, 0xa4, 0x41,
+	0x5c, 0xce, 0x74, 0x92, 0xad, 0x9f, 0x7b, 0xb5, 0x4d, 0xcd, 0x66, 0xc5, 0xc8, 0xac, 0x8b, 0x7e,
+	0x1f, 0x29, 0xca, 0xc1, 0x18, 0x71, 0xf1, 0x5e, 0x9d, 0x38, 0x32, 0xed, 0x52, 0x56, 0x34, 0x35,
+	0x1f, 0x1a, 0x00, 0xed, 0x7d, 0x41, 0x57, 0x21, 0xa5, 0x7d, 0x73, 0xf4, 0x36, 0x9b, 0x05, 0x75,
+	0x8d, 0x14, 0xa2, 0x6b, 0xa4, 0x50, 0xf6, 0xbc, 0xfa, 0x5d, 0x5c, 0x0f, 0x89, 0xd5, 0xb2, 0x45,
+	0xdf, 0x80, 0xb4, 0xac, 0xae, 0x55, 0xce, 0xeb, 0x7a, 0xd7, 0x66, 0x7a, 0x80, 0x6b, 0xfa, 0x53,
+	0xca, 0xc3, 0x1f, 0xfd, 0x75, 0xde, 0xb0, 0x52, 0x12, 0xb1, 0xcd, 0xeb, 0xe6, 0x1d, 0x78, 0xa1,
+	0xef, 0x8e, 0x3d, 0xaf, 0x3b, 0xe6, 0x06, 0x64, 0x3a, 0xf6, 0xe2, 0xb9, 0x89, 0xfe, 0x90, 0x80,
+	0x8b, 0xfd, 0xe2, 0x8c, 0xde, 0x04, 0x10, 0x29, 0x53, 0x27, 0x6e, 0x8d, 0x1f, 0x68, 0xca, 0xd9,
+	0x1e, 0xca, 0x9d, 0x8a, 0xcb, 0x57, 0x96, 0x15, 0x69, 0xba, 0x41, 0xdd, 0x5b, 0xd2, 0x5c, 0x82,
+	0xf1, 0x83, 0x08, 0x9c, 0x18, 0x08, 0x8c, 0x1f, 0x68, 0x70, 0x09, 0x32, 0x62, 0xe5, 0x50, 0x5c,
+	0xe5, 0x36, 0x66, 0x24, 0x97, 0x1c, 0x00, 0x3f, 0xd1, 0xa0, 0xee, 0x4e, 0x84, 0x88, 0x9c, 0x77,
+	0x68, 0x8d, 0xb6, 0xf2, 0xef, 0x6c, 0xe7, 0xd7, 0xa4, 0x39, 0x7a, 0x0b, 0xc6, 0x05, 0x98, 0xf9,
+	0xc4, 0xa6, 0xb8, 0x9e, 0x1b, 0x19, 0x00, 0x2d, 0x56, 0xdb, 0x52, 0xf6, 0xe6, 0x63, 0x03, 0x26,
+	0x3b, 0xeb, 0x25, 0x2a, 0xc1, 0xa4, 0x43, 0x99, 0xc8, 0xc7, 0x6a, 0xe8, 0xd7, 0x3d, 0x3c, 0xc8,
+	0x16, 0x65, 0x34, 0x62, 0x47, 0x02, 0xd0, 0x5b, 0xf2, 0x62, 0xae, 0xd6, 0x02, 0x7c, 0x88, 0x39,
+	0x0e, 0x72, 0x89, 0x33, 0x09, 0x44, 0xc9, 0xdc, 0xd0, 0xe6, 0xe6, 0x0e, 0x64, 0xbb, 0xeb, 0xec,
+	0x17, 0xe0, 0x95, 0xf9, 0xeb, 0x04, 0x40, 0xbb, 0xcc, 0xa2, 0x9b, 0x30, 0x6d, 0x07, 0x04, 0x73,
+	0x22, 0x2a, 0x4d, 0x9d, 0xda, 0xaa, 0x17, 0x1c, 0x80, 0x16, 0x29, 0x58, 0x29, 0x86, 0x12, 0xee,
+	0x69, 0x32, 0xbb, 0x4e, 0x65, 0x1d, 0x39, 0xfb, 0x9b, 0x33, 0x0a, 0xb1, 0xaa, 0x00, 0x68, 0x15,
+	0xa6, 0x34, 0x45, 0x0d, 0x73, 0x72, 0x84, 0x9b, 0x51, 0x2b, 0x72, 0x1a, 0x87, 0x5e, 0x75, 0x43,
+	0x23, 0xd0, 0x7b, 0x70, 0x51, 0x93, 0x78, 0x41, 0x0d, 0xbb, 0xf4, 0x43, 0xfd, 0x55, 0xc3, 0x67,
+	0x32, 0xe9, 0x60, 0xdc, 0x89, 0xc3, 0xf2, 0x36, 0x98, 0xfd, 0x9a, 0x02, 0xdd, 0x1b, 0xae, 0x43,
+	0xc6, 0x8e, 0x2b, 0x74, 0xec, 0xe6, 0xcf, 0xa8, 0x9d, 0x56, 0x27, 0x2a, 0xff, 0x59, 0x12, 0xa0,
+	0x14, 0x3a, 0x94, 0xaf, 0xbb, 0x3c, 0x68, 0xa2, 0x49, 0x48, 0x50, 0xb5, 0xbb, 0x69, 0x2b, 0x41,
+	0x1d, 0xf4, 0x4d, 0x00, 0xe5, 0x9a, 0x53, 0xc5, 0xfc, 0xc4, 0xb0, 0x6e, 0x47, 0xed, 0x76, 0x79,
+	0xf8, 0x91, 0x28, 0x67, 0x69, 0x8d, 0x29, 0x71, 0xf4, 0x22, 0x8c, 0x62, 0xbb, 0xd5, 0x9d, 0xa4,
+	0x2d, 0x3d, 0x43, 0xf3, 0x30, 0xae, 0x5b, 0x2a, 0xde, 0xf4, 0x55, 0x77, 0x91, 0xb6, 0x74, 0x97,
+	0xb5, 0xdd, 0xf4, 0x09, 0xba, 0x04, 0xe9, 0x56, 0xcf, 0x25, 0x4f, 0x56, 0xda, 0x4a, 0x45, 0x1d,
+	0x14, 0x7a, 0x05, 0xb2, 0x01, 0xa9, 0x4b, 0xb7, 0xa4, 0x8c, 0x12, 0x71, 0xd7, 0x27, 0xaf, 0xa4,
+	0xad, 0x29, 0x2d, 0x5f, 0xd7, 0x62, 0x74, 0x11, 0x46, 0x7c, 0xcc, 0x0f, 0x58, 0x6e, 0x4c, 0xea,
+	0xd5, 0x04, 0x5d, 0x06, 0xc0, 0x36, 0xf7, 0x02, 0xb5, 0x7a, 0x4a, 0xd2, 0xa7, 0xa5, 0x44, 0x2e,
+	0x3e, 0x03, 0x29, 0xa5, 0xa6, 0x4e, 0x2e, 0x2d, 0x95, 0x63, 0x72, 0x5e, 0x71, 0x84, 0x5f, 0xb2,
+	0x91, 0x96, 0x40, 0x50, 0x7e, 0x09, 0x81, 0xc4, 0xbd, 0x0c, 0x53, 0x4a, 0xa9, 0x2e, 0x00, 0x61,
+	0x32, 0x2e, 0x4d, 0x32, 0xd2, 0x44, 0x16, 0x79, 0x61, 0x97, 0x87, 0x4c, 0xcc, 0x8e, 0x3a, 0xb9,
+	0x09, 0x69, 0x35, 0xde, 0xb2, 0x52, 0x0b, 0x31, 0x2f, 0x0c, 0x6c, 0x52, 0xa5, 0x7e, 0x2e, 0xa3,
+	0x16, 0x52, 0x82, 0x8a, 0x2f, 0xfc, 0x97, 0x7d, 0x0e, 0xae, 0x11, 0x97, 0xe7, 0x26, 0x95, 0xff,
+	0x42, 0x52, 0x12, 0x82, 0xfc, 0x1a, 0x4c, 0xb4, 0x36, 0x55, 0x04, 0xe1, 0x0d, 0x71, 0xe9, 0xc9,
+	0x61, 0xce, 0x58, 0x48, 0xca, 0x3d, 0xec, 0x79, 0x48, 0x44, 0x39, 0x60, 0x45, 0xa6, 0xf9, 0x1f,
+	0x26, 0xe0, 0xa5, 0x5b, 0x94, 0xf1, 0x38, 0x95, 0x6e, 0x4a, 0xd1, 0x3b, 0x1d, 0x2d, 0xb1, 0x31,
+	0x60, 0x4b, 0x1c, 0x6b, 0x82, 0xd1, 0x55, 0x18, 0xc1, 0xfb, 0x9c, 0x04, 0x03, 0x67, 0x95, 0x32,
+	0x47, 0xd7, 0x60, 0x74, 0x8f, 0xec, 0x7b, 0x01, 0xc9, 0x25, 0x07, 0x04, 0x6a, 0x7b, 0x34, 0x07,
+	0x23, 0x75, 0xda, 0xa0, 0x5c, 0x66, 0x5b, 0x46, 0xf6, 0xf9, 0x8b, 0xc9, 0xdc, 0xd3, 0x31, 0x4b,
+	0x89, 0x11, 0x82, 0x61, 0x1f, 0xd7, 0x54, 0x3b, 0x9a, 0xb1, 0xe4, 0x38, 0xff, 0x30, 0x09, 0x13,
+	0xb7, 0x3d, 0xe1, 0xbe, 0xaa, 0x36, 0x5f, 0xfc, 0x09, 0x79, 0x15, 0x2e, 0xb8, 0xb1, 0x05, 0x54,
+	0xd6, 0xa8, 0xc3, 0x92, 0x8d, 0x2b, 0x64, 0xe2, 0xfc, 0x6f, 0xc7, 0x26, 0x07, 0x63, 0x2c, 0xdc,
+	0xbb, 0x47, 0x6c, 0x2e, 0x3b, 0xe3, 0xb4, 0x15, 0x4d, 0xc5, 0xa7, 0xef, 0x79, 0x4e, 0x33, 0x37,
+	0x26, 0xc5, 0x72, 0x8c, 0xae, 0xc3, 0x28, 0xe3, 0x98, 0x87, 0x4c, 0x9e, 0x8f, 0xc9, 0xe5, 0x7c,
+	0xf7, 0xf6, 0xc6, 0xe3, 0xb2, 0x25, 0x2d, 0x2d, 0x8d, 0x40, 0xb7, 0xe0, 0x82, 0x1a, 0x55, 0x43,
+	0xdf, 0x89, 0x82, 0x93, 0x1e, 0x30, 0x38, 0x53, 0x0a, 0xba, 0xa3, 0x90, 0x25, 0x9e, 0xdf, 0x82,
+	0x4c, 0x7c, 0x2d, 0x86, 0xca, 0x90, 0x89, 0x87, 0x26, 0xca, 0xea, 0xd9, 0xd3, 0x3c, 0xb4, 0x3a,
+	0x21, 0xf9, 0x7f, 0x18, 0x90, 0x13, 0xd9, 0xdd, 0xc1, 0x1c, 0xa5, 0xf7, 0x2d, 0x98, 0x08, 0x88,
+	0x4d, 0xc4, 0x6b, 0x33, 0x96, 0xe0, 0xf3, 0xfd, 0x5e, 0xb7, 0xf1, 0x17, 0x5f, 0xfb, 0xe9, 0x38,
+	0x1e, 0xc1, 0x45, 0xaa, 0x57, 0x5a, 0x91, 0x4c, 0x2c, 0x24, 0x07, 0x8b, 0x64, 0x39, 0xf3, 0xac,
+	0x0c, 0x8f, 0x8d, 0xb1, 0xfc, 0xc8, 0x43, 0xf5, 0x14, 0xd5, 0x81, 0x6d, 0xe5, 0x70, 0xf2, 0xf4,
+	0x1c, 0x1e, 0x8e, 0xe5, 0xf0, 0x1f, 0x0d, 0x98, 0x57, 0xc1, 0xec, 0xb3, 0x63, 0x5f, 0xca, 0x07,
+	0x5f, 0x86, 0xa4, 0x7a, 0x29, 0x27, 0xaf, 0xa4, 0xcb, 0xe3, 0xcf, 0xca, 0xa9, 0xc7, 0xc6, 0x48,
+	0xca, 0xc8, 0x3e, 0x1d, 0xb3, 0x84, 0x1c, 0xad, 0xb5, 0xe2, 0x91, 0x1c, 0x34, 0xb3, 0xe4, 0x4a,
+	0x1d, 0xa1, 0xc8, 0xdf, 0x03, 0x73, 0x8b, 0x07, 0x04, 0x37, 0xbe, 0xfc, 0x1d, 0xcc, 0x7f, 0x62,
+	0xc0, 0x6c, 0x7c, 0x99, 0xf5, 0x06, 0xa6, 0xf5, 0xcd, 0x80, 0xec, 0x93, 0x80, 0xb8, 0x36, 0x11,
+	0x77, 0xff, 0x08, 0x11, 0x32, 0x9d, 0x89, 0x5f, 0x3b, 0xed, 0x8b, 0xba, 0xc1, 0x05, 0x29, 0x50,
+	0xc5, 0x57, 0xb1, 0x98, 0xd7, 0x00, 0xda, 0x42, 0x94, 0x85, 0x64, 0xf4, 0xb3, 0x45, 0xda, 0x12,
+	0x43, 0x71, 0xab, 0x1d, 0x8a, 0xce, 0x41, 0x16, 0x9c, 0x94, 0xa5, 0x26, 0xd7, 0x13, 0xd7, 0x8c,
+	0xfc, 0xa7, 0x06, 0xbc, 0xbc, 0x45, 0xf8, 0x69, 0xeb, 0x45, 0x21, 0x5a, 0x83, 0x54, 0xc8, 0x9e,
+	0x37, 0x3c, 0x63, 0x21, 0x53, 0x7b, 0xfd, 0x3e, 0x8c, 0xfb, 0x6d, 0x6e, 0x5d, 0x01, 0x5f, 0x3b,
+	0xcf, 0xf7, 0xc7, 0x83, 0x1e, 0xa3, 0x5a, 0xfc, 0x99, 0x01, 0xa8, 0x37, 0x13, 0xd0, 0x1c, 0x98,
+	0xb7, 0xef, 0x6c, 0x57, 0xde, 0xad, 0xac, 0x96, 0xb6, 0x2b, 0x77, 0x6e, 0x57, 0xb7, 0xb6, 0x4b,
+	0xdb, 0x3b, 0x5b, 0xd5, 0x9d, 0xdb, 0xd6, 0x7a, 0x69, 0x2d, 0x3b, 0x84, 0x66, 0x21, 0xd7, 0x4f,
+	0x2f, 0xb5, 0x06, 0x5a, 0x80, 0xd9, 0x7e, 0xda, 0x92, 0xb5, 0x7a, 0xa3, 0x72, 0x77, 0x7d, 0x2d,
+	0x9b, 0x30, 0x2f, 0xfd, 0xf3, 0x57, 0x33, 0x2f, 0xe5, 0x8c, 0xc5, 0xe9, 0x3e, 0x76, 0xcb, 0x07,
+	0x30, 0xa1, 0x6e, 0x35, 0xfd, 0xab, 0xd2, 0xfb, 0x90, 0x8a, 0x7e, 0x83, 0x43, 0x2f, 0xf6, 0x54,
+	0xb6, 0x75, 0xf1, 0x23, 0xa5, 0xb9, 0x70, 0xd6, 0xaf, 0x76, 0x79, 0xf4, 0xf0, 0xb3, 0xbf, 0xff,
+	0x3c, 0x31, 0x81, 0xa0, 0x28, 0xdb, 0x02, 0xf1, 0xf6, 0x5f, 0xfe, 0x89, 0x01, 0x89, 0x0a, 0x43,
+	0x3f, 0x32, 0x20, 0xbb, 0x41, 0x78, 0xe7, 0xcf, 0x3f, 0xaf, 0x74, 0x33, 0x9e, 0xf8, 0xeb, 0x91,
+	0xb9, 0x38, 0x88, 0xa9, 0x76, 0x63, 0x46, 0xba, 0x31, 0x8d, 0x2e, 0x14, 0x29, 0x2b, 0x76, 0xf4,
+	0x89, 0xcb, 0x9f, 0x0c, 0x8b, 0x0f, 0x75, 0x28, 0xbf, 0xe5, 0xd5, 0xd0, 0xc7, 0xc3, 0x90, 0xed,
+	0x6e, 0x0c, 0xd0, 0x57, 0xba, 0x17, 0x3a, 0xa1, 0x75, 0x30, 0x67, 0x4f, 0xec, 0x3d, 0x44, 0xd3,
+	0xf1, 0xbb, 0xa4, 0x74, 0xe2, 0x37, 0x49, 0x94, 0x16, 0x5e, 0x60, 0xa1, 0xdb, 0x5d, 0x43, 0xe5,
+	0xd6, 0xa4, 0x18, 0x7f, 0x31, 0x14, 0xbf, 0xd7, 0xee, 0x42, 0x0a, 0x31, 0x45, 0x9f, 0xf9, 0xf1,
+	0x6e, 0x13, 0x1d, 0x0d, 0xc0, 0xa2, 0x7f, 0x9b, 0x1a, 0x80, 0xb0, 0xa8, 0x4c, 0x4f, 0x84, 0xb7,
+	0x86, 0xc7, 0xbb, 0x5f, 0x45, 0x2b, 0xed, 0xa5, 0xf5, 0x2b, 0xa5, 0x03, 0xa6, 0x64, 0x9d, 0xc3,
+	0xe3, 0xdd, 0x6b, 0xe8, 0x6a, 0x1b, 0x16, 0xbd, 0x4c, 0x3a, 0x70, 0x5a, 0xd8, 0x35, 0x3e, 0xde,
+	0xdd, 0x40, 0xeb, 0x6d, 0x64, 0xc7, 0x73, 0xa4, 0x03, 0x1e, 0xd7, 0xf4, 0x13, 0x1c, 0xef, 0x16,
+	0xd1, 0x52, 0x9b, 0x48, 0x9c, 0xf9, 0x4e, 0x82, 0xa8, 0x80, 0x44, 0x83, 0xe3, 0xe5, 0x8f, 0x47,
+	0x61, 0xba, 0xe3, 0xd4, 0x92, 0x40, 0x84, 0x41, 0x24, 0xf2, 0xb0, 0x48, 0x09, 0x74, 0xa5, 0x5f,
+	0xa2, 0xf4, 0xab, 0xe1, 0xe6, 0xe5, 0xd3, 0xaa, 0x08, 0xcb, 0xaf, 0xc8, 0x4c, 0x59, 0x42, 0xaf,
+	0x46, 0x7e, 0xc5, 0x0b, 0x7e, 0xcb, 0xa1, 0x62, 0xc7, 0xb5, 0x8f, 0x7e, 0x6a, 0xc0, 0x84, 0xba,
+	0x0c, 0x75, 0x39, 0x29, 0xf6, 0xd4, 0xbc, 0xd3, 0xaf, 0x4a, 0xf3, 0x84, 0x63, 0x9e, 0xbf, 0x2a,
+	0xdd, 0x79, 0x7d, 0xf9, 0x3c, 0xee, 0x5c, 0x37, 0x16, 0xd1, 0x23, 0x03, 0x46, 0xd5, 0x45, 0x86,
+	0x7a, 0x0e, 0xeb, 0xc9, 0x17, 0x9c, 0x79, 0x6a, 0xb3, 0x93, 0xbf, 0x2e, 0x9d, 0x79, 0x03, 0x2d,
+	0x9f, 0xc3, 0x99, 0x22, 0x93, 0xab, 0xbd, 0x6e, 0xa0, 0x8f, 0x0c, 0x98, 0xde, 0x20, 0xbc, 0xe7,
+	0x96, 0x3b, 0xeb, 0x7e, 0x30, 0xcf, 0x55, 0xf7, 0xf3, 0xcb, 0xd2, 0xc9, 0xd7, 0xd0, 0x62, 0xe4,
+	0xe4, 0x09, 0x7e, 0xc5, 0x2e, 0x05, 0xf4, 0x5b, 0x03, 0xa6, 0xb7, 0xfa, 0xb8, 0x76, 0xb5, 0x27,
+	0x74, 0x03, 0x5d, 0x82, 0xe7, 0xf4, 0xf8, 0x6d, 0xe9, 0xf1, 0x35, 0x73, 0xa5, 0xcb, 0x63, 0x56,
+	0x38, 0xdb, 0xf5, 0xeb, 0xc6, 0x62, 0xf9, 0xbd, 0x3f, 0xfd, 0x6d, 0x6e, 0xe8, 0x07, 0x4f, 0xe6,
+	0x8c, 0x5f, 0x3e, 0x99, 0x33, 0x9e, 0x3e, 0x99, 0x1b, 0xfa, 0xd7, 0x93, 0x39, 0xe3, 0xd1, 0xe7,
+	0x73, 0x43, 0xbf, 0xff, 0x7c, 0xce, 0xd8, 0x2d, 0xd6, 0xbc, 0x02, 0x3f, 0x20, 0x5c, 0xfe, 0xa3,
+	0x57, 0x70, 0x09, 0x3f, 0xf2, 0x82, 0xfb, 0xc5, 0xce, 0x7f, 0x8e, 0x0e, 0x57, 0x8a, 0xfe, 0xfd,
+	0x5a, 0x91, 0x73, 0xd7, 0xdf, 0xdb, 0x1b, 0x95, 0x29, 0xb8, 0xf2, 0xdf, 0x01, 0x00, 0xc5, 0x40,
+	0xa1, 0xba, 0x4b, 0x1c, 0x00, 0x00,
+}
+
+func (x NotificationStatus) String() string {
+	s, ok := NotificationStatus_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *AuthInfoResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AuthInfoResponse)
+	if !ok {
+		that2, ok := that.(AuthInfoResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.AccessMethod == nil {
+		if this.AccessMethod != nil {
+			return false
+		}
+	} else if this.AccessMethod == nil {
+		return false
+	} else if !this.AccessMethod.Equal(that1.AccessMethod) {
+		return false
+	}
+	if !this.UniversalRights.Equal(that1.UniversalRights) {
+		return false
+	}
+	if this.IsAdmin != that1.IsAdmin {
+		return false
+	}
+	return true
+}
+func (this *AuthInfoResponse_ApiKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AuthInfoResponse_ApiKey)
+	if !ok {
+		that2, ok := that.(AuthInfoResponse_ApiKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApiKey.Equal(that1.ApiKey) {
+		return false
+	}
+	return true
+}
+func (this *AuthInfoResponse_OauthAccessToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AuthInfoResponse_OauthAccessToken)
+	if !ok {
+		that2, ok := that.(AuthInfoResponse_OauthAccessToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.OauthAccessToken.Equal(that1.OauthAccessToken) {
+		return false
+	}
+	return true
+}
+func (this *AuthInfoResponse_UserSession) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AuthInfoResponse_UserSession)
+	if !ok {
+		that2, ok := that.(AuthInfoResponse_UserSession)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UserSession.Equal(that1.UserSession) {
+		return false
+	}
+	return true
+}
+func (this *AuthInfoResponse_APIKeyAccess) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AuthInfoResponse_APIKeyAccess)
+	if !ok {
+		that2, ok := that.(AuthInfoResponse_APIKeyAccess)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApiKey.Equal(that1.ApiKey) {
+		return false
+	}
+	if !this.EntityIds.Equal(&that1.EntityIds) {
+		return false
+	}
+	return true
+}
+func (this *GetIsConfigurationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetIsConfigurationRequest)
+	if !ok {
+		that2, ok := that.(GetIsConfigurationRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *IsConfiguration) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IsConfiguration)
+	if !ok {
+		that2, ok := that.(IsConfiguration)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UserRegistration.Equal(that1.UserRegistration) {
+		return false
+	}
+	if !this.ProfilePicture.Equal(that1.ProfilePicture) {
+		return false
+	}
+	if !this.EndDevicePicture.Equal(that1.EndDevicePicture) {
+		return false
+	}
+	if !this.UserRights.Equal(that1.UserRights) {
+		return false
+	}
+	return true
+}
+func (this *IsConfiguration_UserRegistration) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IsConfiguration_UserRegistration)
+	if !ok {
+		that2, ok := that.(IsConfiguration_UserRegistration)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Invitation.Equal(that1.Invitation) {
+		return false
+	}
+	if !this.ContactInfoValidation.Equal(that1.ContactInfoValidation) {
+		return false
+	}
+	if !this.AdminApproval.Equal(that1.AdminApproval) {
+		return false
+	}
+	if !this.PasswordRequirements.Equal(that1.PasswordRequirements) {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	return true
+}
+func (this *IsConfiguration_UserRegistration_Invitation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IsConfiguration_UserRegistration_Invitation)
+	if !ok {
+		that2, ok := that.(IsConfiguration_UserRegistration_Invitation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Required.Equal(that1.Required) {
+		return false
+	}
+	if this.TokenTtl != nil && that1.TokenTtl != nil {
+		if *this.TokenTtl != *that1.TokenTtl {
+			return false
+		}
+	} else if this.TokenTtl != nil {
+		return false
+	} else if that1.TokenTtl != nil {
+		return false
+	}
+	return true
+}
+func (this *IsConfiguration_UserRegistration_ContactInfoValidation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IsConfiguration_UserRegistration_ContactInfoValidation)
+	if !ok {
+		that2, ok := that.(IsConfiguration_UserRegistration_ContactInfoValidation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Required.Equal(that1.Required) {
+		return false
+	}
+	return true
+}
+func (this *IsConfiguration_UserRegistration_AdminApproval) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IsConfiguration_UserRegistration_AdminApproval)
+	if !ok {
+		that2, ok := that.(IsConfiguration_UserRegistration_AdminApproval)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Required.Equal(that1.Required) {
+		return false
+	}
+	return true
+}
+func (this *IsConfiguration_UserRegistration_PasswordRequirements) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetIsConfigurationRequest)
+	that1, ok := that.(*IsConfiguration_UserRegistration_PasswordRequirements)
 	if !ok {
-		that2, ok := that.(GetIsConfigurationRequest)
+		that2, ok := that.(IsConfiguration_UserRegistration_PasswordRequirements)
 		if ok {
 			that1 = &that2
 		} else {
@@ -980,16 +1917,31 @@ func (this *GetIsConfigurationRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !this.MinLength.Equal(that1.MinLength) {
+		return false
+	}
+	if !this.MaxLength.Equal(that1.MaxLength) {
+		return false
+	}
+	if !this.MinUppercase.Equal(that1.MinUppercase) {
+		return false
+	}
+	if !this.MinDigits.Equal(that1.MinDigits) {
+		return false
+	}
+	if !this.MinSpecial.Equal(that1.MinSpecial) {
+		return false
+	}
 	return true
 }
-func (this *IsConfiguration) Equal(that interface{}) bool {
+func (this *IsConfiguration_ProfilePicture) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*IsConfiguration)
+	that1, ok := that.(*IsConfiguration_ProfilePicture)
 	if !ok {
-		that2, ok := that.(IsConfiguration)
+		that2, ok := that.(IsConfiguration_ProfilePicture)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1001,28 +1953,46 @@ func (this *IsConfiguration) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.UserRegistration.Equal(that1.UserRegistration) {
+	if !this.DisableUpload.Equal(that1.DisableUpload) {
 		return false
 	}
-	if !this.ProfilePicture.Equal(that1.ProfilePicture) {
+	if !this.UseGravatar.Equal(that1.UseGravatar) {
 		return false
 	}
-	if !this.EndDevicePicture.Equal(that1.EndDevicePicture) {
+	return true
+}
+func (this *IsConfiguration_EndDevicePicture) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IsConfiguration_EndDevicePicture)
+	if !ok {
+		that2, ok := that.(IsConfiguration_EndDevicePicture)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if !this.UserRights.Equal(that1.UserRights) {
+	if !this.DisableUpload.Equal(that1.DisableUpload) {
 		return false
 	}
 	return true
 }
-func (this *IsConfiguration_UserRegistration) Equal(that interface{}) bool {
+func (this *IsConfiguration_UserRights) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*IsConfiguration_UserRegistration)
+	that1, ok := that.(*IsConfiguration_UserRights)
 	if !ok {
-		that2, ok := that.(IsConfiguration_UserRegistration)
+		that2, ok := that.(IsConfiguration_UserRights)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1034,31 +2004,52 @@ func (this *IsConfiguration_UserRegistration) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Invitation.Equal(that1.Invitation) {
+	if !this.CreateApplications.Equal(that1.CreateApplications) {
 		return false
 	}
-	if !this.ContactInfoValidation.Equal(that1.ContactInfoValidation) {
+	if !this.CreateClients.Equal(that1.CreateClients) {
 		return false
 	}
-	if !this.AdminApproval.Equal(that1.AdminApproval) {
+	if !this.CreateGateways.Equal(that1.CreateGateways) {
 		return false
 	}
-	if !this.PasswordRequirements.Equal(that1.PasswordRequirements) {
+	if !this.CreateOrganizations.Equal(that1.CreateOrganizations) {
 		return false
 	}
-	if this.Enabled != that1.Enabled {
+	return true
+}
+func (this *GetIsConfigurationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetIsConfigurationResponse)
+	if !ok {
+		that2, ok := that.(GetIsConfigurationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Configuration.Equal(that1.Configuration) {
 		return false
 	}
 	return true
 }
-func (this *IsConfiguration_UserRegistration_Invitation) Equal(that interface{}) bool {
+func (this *AuditEntry) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*IsConfiguration_UserRegistration_Invitation)
+	that1, ok := that.(*AuditEntry)
 	if !ok {
-		that2, ok := that.(IsConfiguration_UserRegistration_Invitation)
+		that2, ok := that.(AuditEntry)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1070,28 +2061,72 @@ func (this *IsConfiguration_UserRegistration_Invitation) Equal(that interface{})
 	} else if this == nil {
 		return false
 	}
-	if !this.Required.Equal(that1.Required) {
+	if this.Id != that1.Id {
 		return false
 	}
-	if this.TokenTtl != nil && that1.TokenTtl != nil {
-		if *this.TokenTtl != *that1.TokenTtl {
+	if that1.CreatedAt == nil {
+		if this.CreatedAt != nil {
 			return false
 		}
-	} else if this.TokenTtl != nil {
+	} else if !this.CreatedAt.Equal(*that1.CreatedAt) {
 		return false
-	} else if that1.TokenTtl != nil {
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	if this.EntityType != that1.EntityType {
+		return false
+	}
+	if this.EntityId != that1.EntityId {
+		return false
+	}
+	if len(this.RelatedEntities) != len(that1.RelatedEntities) {
+		return false
+	}
+	for i := range this.RelatedEntities {
+		if this.RelatedEntities[i] != that1.RelatedEntities[i] {
+			return false
+		}
+	}
+	if len(this.Paths) != len(that1.Paths) {
+		return false
+	}
+	for i := range this.Paths {
+		if this.Paths[i] != that1.Paths[i] {
+			return false
+		}
+	}
+	if this.ActorType != that1.ActorType {
+		return false
+	}
+	if this.ActorId != that1.ActorId {
+		return false
+	}
+	if this.AuthType != that1.AuthType {
+		return false
+	}
+	if this.AuthTokenType != that1.AuthTokenType {
+		return false
+	}
+	if this.AuthTokenId != that1.AuthTokenId {
+		return false
+	}
+	if this.SourceIp != that1.SourceIp {
+		return false
+	}
+	if this.UserAgent != that1.UserAgent {
 		return false
 	}
 	return true
 }
-func (this *IsConfiguration_UserRegistration_ContactInfoValidation) Equal(that interface{}) bool {
+func (this *AuditEntries) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*IsConfiguration_UserRegistration_ContactInfoValidation)
+	that1, ok := that.(*AuditEntries)
 	if !ok {
-		that2, ok := that.(IsConfiguration_UserRegistration_ContactInfoValidation)
+		that2, ok := that.(AuditEntries)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1103,19 +2138,24 @@ func (this *IsConfiguration_UserRegistration_ContactInfoValidation) Equal(that i
 	} else if this == nil {
 		return false
 	}
-	if !this.Required.Equal(that1.Required) {
+	if len(this.Entries) != len(that1.Entries) {
 		return false
 	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(that1.Entries[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *IsConfiguration_UserRegistration_AdminApproval) Equal(that interface{}) bool {
+func (this *ListAuditEntriesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*IsConfiguration_UserRegistration_AdminApproval)
+	that1, ok := that.(*ListAuditEntriesRequest)
 	if !ok {
-		that2, ok := that.(IsConfiguration_UserRegistration_AdminApproval)
+		that2, ok := that.(ListAuditEntriesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1127,19 +2167,39 @@ func (this *IsConfiguration_UserRegistration_AdminApproval) Equal(that interface
 	} else if this == nil {
 		return false
 	}
-	if !this.Required.Equal(that1.Required) {
+	if !this.EntityIds.Equal(that1.EntityIds) {
+		return false
+	}
+	if that1.After == nil {
+		if this.After != nil {
+			return false
+		}
+	} else if !this.After.Equal(*that1.After) {
+		return false
+	}
+	if that1.Before == nil {
+		if this.Before != nil {
+			return false
+		}
+	} else if !this.Before.Equal(*that1.Before) {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.Page != that1.Page {
 		return false
 	}
 	return true
 }
-func (this *IsConfiguration_UserRegistration_PasswordRequirements) Equal(that interface{}) bool {
+func (this *Notification) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*IsConfiguration_UserRegistration_PasswordRequirements)
+	that1, ok := that.(*Notification)
 	if !ok {
-		that2, ok := that.(IsConfiguration_UserRegistration_PasswordRequirements)
+		that2, ok := that.(Notification)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1151,31 +2211,51 @@ func (this *IsConfiguration_UserRegistration_PasswordRequirements) Equal(that in
 	} else if this == nil {
 		return false
 	}
-	if !this.MinLength.Equal(that1.MinLength) {
+	if this.Id != that1.Id {
 		return false
 	}
-	if !this.MaxLength.Equal(that1.MaxLength) {
+	if that1.CreatedAt == nil {
+		if this.CreatedAt != nil {
+			return false
+		}
+	} else if !this.CreatedAt.Equal(*that1.CreatedAt) {
 		return false
 	}
-	if !this.MinUppercase.Equal(that1.MinUppercase) {
+	if this.NotificationType != that1.NotificationType {
 		return false
 	}
-	if !this.MinDigits.Equal(that1.MinDigits) {
+	if this.EntityType != that1.EntityType {
 		return false
 	}
-	if !this.MinSpecial.Equal(that1.MinSpecial) {
+	if this.EntityId != that1.EntityId {
+		return false
+	}
+	if this.Subject != that1.Subject {
+		return false
+	}
+	if this.Body != that1.Body {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if that1.StatusUpdatedAt == nil {
+		if this.StatusUpdatedAt != nil {
+			return false
+		}
+	} else if !this.StatusUpdatedAt.Equal(*that1.StatusUpdatedAt) {
 		return false
 	}
 	return true
 }
-func (this *IsConfiguration_ProfilePicture) Equal(that interface{}) bool {
+func (this *Notifications) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*IsConfiguration_ProfilePicture)
+	that1, ok := that.(*Notifications)
 	if !ok {
-		that2, ok := that.(IsConfiguration_ProfilePicture)
+		that2, ok := that.(Notifications)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1187,22 +2267,24 @@ func (this *IsConfiguration_ProfilePicture) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DisableUpload.Equal(that1.DisableUpload) {
+	if len(this.Notifications) != len(that1.Notifications) {
 		return false
 	}
-	if !this.UseGravatar.Equal(that1.UseGravatar) {
-		return false
+	for i := range this.Notifications {
+		if !this.Notifications[i].Equal(that1.Notifications[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *IsConfiguration_EndDevicePicture) Equal(that interface{}) bool {
+func (this *ListNotificationsRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*IsConfiguration_EndDevicePicture)
+	that1, ok := that.(*ListNotificationsRequest)
 	if !ok {
-		that2, ok := that.(IsConfiguration_EndDevicePicture)
+		that2, ok := that.(ListNotificationsRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1214,19 +2296,33 @@ func (this *IsConfiguration_EndDevicePicture) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DisableUpload.Equal(that1.DisableUpload) {
+	if !this.ReceiverIds.Equal(that1.ReceiverIds) {
+		return false
+	}
+	if len(this.Status) != len(that1.Status) {
+		return false
+	}
+	for i := range this.Status {
+		if this.Status[i] != that1.Status[i] {
+			return false
+		}
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.Page != that1.Page {
 		return false
 	}
 	return true
 }
-func (this *IsConfiguration_UserRights) Equal(that interface{}) bool {
+func (this *UpdateNotificationStatusRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*IsConfiguration_UserRights)
+	that1, ok := that.(*UpdateNotificationStatusRequest)
 	if !ok {
-		that2, ok := that.(IsConfiguration_UserRights)
+		that2, ok := that.(UpdateNotificationStatusRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1238,28 +2334,83 @@ func (this *IsConfiguration_UserRights) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.CreateApplications.Equal(that1.CreateApplications) {
+	if !this.ReceiverIds.Equal(that1.ReceiverIds) {
 		return false
 	}
-	if !this.CreateClients.Equal(that1.CreateClients) {
+	if len(this.Ids) != len(that1.Ids) {
 		return false
 	}
-	if !this.CreateGateways.Equal(that1.CreateGateways) {
+	for i := range this.Ids {
+		if this.Ids[i] != that1.Ids[i] {
+			return false
+		}
+	}
+	if this.Status != that1.Status {
 		return false
 	}
-	if !this.CreateOrganizations.Equal(that1.CreateOrganizations) {
+	return true
+}
+func (this *StreamNotificationsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StreamNotificationsRequest)
+	if !ok {
+		that2, ok := that.(StreamNotificationsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ReceiverIds.Equal(that1.ReceiverIds) {
 		return false
 	}
 	return true
 }
-func (this *GetIsConfigurationResponse) Equal(that interface{}) bool {
+func (this *NotificationEmailPreferences) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetIsConfigurationResponse)
+	that1, ok := that.(*NotificationEmailPreferences)
 	if !ok {
-		that2, ok := that.(GetIsConfigurationResponse)
+		that2, ok := that.(NotificationEmailPreferences)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Email) != len(that1.Email) {
+		return false
+	}
+	for i := range this.Email {
+		if this.Email[i] != that1.Email[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *SetNotificationEmailPreferencesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetNotificationEmailPreferencesRequest)
+	if !ok {
+		that2, ok := that.(SetNotificationEmailPreferencesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1271,7 +2422,10 @@ func (this *GetIsConfigurationResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Configuration.Equal(that1.Configuration) {
+	if !this.UserIds.Equal(that1.UserIds) {
+		return false
+	}
+	if !this.Preferences.Equal(that1.Preferences) {
 		return false
 	}
 	return true
@@ -1435,6 +2589,336 @@ var _Is_serviceDesc = grpc.ServiceDesc{
 	Metadata: "lorawan-stack/api/identityserver.proto",
 }
 
+// AuditLogClient is the client API for AuditLog service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AuditLogClient interface {
+	// List the entries in the audit log, newest first.
+	// Listing the entries of an entity requires the audit log right on the entity.
+	ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*AuditEntries, error)
+}
+
+type auditLogClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAuditLogClient(cc *grpc.ClientConn) AuditLogClient {
+	return &auditLogClient{cc}
+}
+
+func (c *auditLogClient) ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*AuditEntries, error) {
+	out := new(AuditEntries)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AuditLog/ListAuditEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditLogServer is the server API for AuditLog service.
+type AuditLogServer interface {
+	// List the entries in the audit log, newest first.
+	// Listing the entries of an entity requires the audit log right on the entity.
+	ListAuditEntries(context.Context, *ListAuditEntriesRequest) (*AuditEntries, error)
+}
+
+// UnimplementedAuditLogServer can be embedded to have forward compatible implementations.
+type UnimplementedAuditLogServer struct {
+}
+
+func (*UnimplementedAuditLogServer) ListAuditEntries(ctx context.Context, req *ListAuditEntriesRequest) (*AuditEntries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEntries not implemented")
+}
+
+func RegisterAuditLogServer(s *grpc.Server, srv AuditLogServer) {
+	s.RegisterService(&_AuditLog_serviceDesc, srv)
+}
+
+func _AuditLog_ListAuditEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditLogServer).ListAuditEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AuditLog/ListAuditEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditLogServer).ListAuditEntries(ctx, req.(*ListAuditEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AuditLog_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.AuditLog",
+	HandlerType: (*AuditLogServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAuditEntries",
+			Handler:    _AuditLog_ListAuditEntries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/identityserver.proto",
+}
+
+// NotificationServiceClient is the client API for NotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NotificationServiceClient interface {
+	// List the notifications in the inbox of the user, newest first.
+	List(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*Notifications, error)
+	// Update the status of notifications in the inbox of the user.
+	UpdateStatus(ctx context.Context, in *UpdateNotificationStatusRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Stream the new notifications in the inbox of the user.
+	Stream(ctx context.Context, in *StreamNotificationsRequest, opts ...grpc.CallOption) (NotificationService_StreamClient, error)
+	// Get the preferences of the user for receiving notifications by email.
+	GetEmailPreferences(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*NotificationEmailPreferences, error)
+	// Set the preferences of the user for receiving notifications by email.
+	SetEmailPreferences(ctx context.Context, in *SetNotificationEmailPreferencesRequest, opts ...grpc.CallOption) (*NotificationEmailPreferences, error)
+}
+
+type notificationServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNotificationServiceClient(cc *grpc.ClientConn) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) List(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*Notifications, error) {
+	out := new(Notifications)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NotificationService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) UpdateStatus(ctx context.Context, in *UpdateNotificationStatusRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NotificationService/UpdateStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) Stream(ctx context.Context, in *StreamNotificationsRequest, opts ...grpc.CallOption) (NotificationService_StreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NotificationService_serviceDesc.Streams[0], "/ttn.lorawan.v3.NotificationService/Stream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &notificationServiceStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NotificationService_StreamClient interface {
+	Recv() (*Notification, error)
+	grpc.ClientStream
+}
+
+type notificationServiceStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *notificationServiceStreamClient) Recv() (*Notification, error) {
+	m := new(Notification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *notificationServiceClient) GetEmailPreferences(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*NotificationEmailPreferences, error) {
+	out := new(NotificationEmailPreferences)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NotificationService/GetEmailPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SetEmailPreferences(ctx context.Context, in *SetNotificationEmailPreferencesRequest, opts ...grpc.CallOption) (*NotificationEmailPreferences, error) {
+	out := new(NotificationEmailPreferences)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NotificationService/SetEmailPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationServiceServer is the server API for NotificationService service.
+type NotificationServiceServer interface {
+	// List the notifications in the inbox of the user, newest first.
+	List(context.Context, *ListNotificationsRequest) (*Notifications, error)
+	// Update the status of notifications in the inbox of the user.
+	UpdateStatus(context.Context, *UpdateNotificationStatusRequest) (*types.Empty, error)
+	// Stream the new notifications in the inbox of the user.
+	Stream(*StreamNotificationsRequest, NotificationService_StreamServer) error
+	// Get the preferences of the user for receiving notifications by email.
+	GetEmailPreferences(context.Context, *UserIdentifiers) (*NotificationEmailPreferences, error)
+	// Set the preferences of the user for receiving notifications by email.
+	SetEmailPreferences(context.Context, *SetNotificationEmailPreferencesRequest) (*NotificationEmailPreferences, error)
+}
+
+// UnimplementedNotificationServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedNotificationServiceServer struct {
+}
+
+func (*UnimplementedNotificationServiceServer) List(ctx context.Context, req *ListNotificationsRequest) (*Notifications, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedNotificationServiceServer) UpdateStatus(ctx context.Context, req *UpdateNotificationStatusRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStatus not implemented")
+}
+func (*UnimplementedNotificationServiceServer) Stream(req *StreamNotificationsRequest, srv NotificationService_StreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (*UnimplementedNotificationServiceServer) GetEmailPreferences(ctx context.Context, req *UserIdentifiers) (*NotificationEmailPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmailPreferences not implemented")
+}
+func (*UnimplementedNotificationServiceServer) SetEmailPreferences(ctx context.Context, req *SetNotificationEmailPreferencesRequest) (*NotificationEmailPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEmailPreferences not implemented")
+}
+
+func RegisterNotificationServiceServer(s *grpc.Server, srv NotificationServiceServer) {
+	s.RegisterService(&_NotificationService_serviceDesc, srv)
+}
+
+func _NotificationService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NotificationService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).List(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_UpdateStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).UpdateStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NotificationService/UpdateStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).UpdateStatus(ctx, req.(*UpdateNotificationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotificationServiceServer).Stream(m, &notificationServiceStreamServer{stream})
+}
+
+type NotificationService_StreamServer interface {
+	Send(*Notification) error
+	grpc.ServerStream
+}
+
+type notificationServiceStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *notificationServiceStreamServer) Send(m *Notification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NotificationService_GetEmailPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetEmailPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NotificationService/GetEmailPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetEmailPreferences(ctx, req.(*UserIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SetEmailPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotificationEmailPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SetEmailPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NotificationService/SetEmailPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SetEmailPreferences(ctx, req.(*SetNotificationEmailPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NotificationService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _NotificationService_List_Handler,
+		},
+		{
+			MethodName: "UpdateStatus",
+			Handler:    _NotificationService_UpdateStatus_Handler,
+		},
+		{
+			MethodName: "GetEmailPreferences",
+			Handler:    _NotificationService_GetEmailPreferences_Handler,
+		},
+		{
+			MethodName: "SetEmailPreferences",
+			Handler:    _NotificationService_SetEmailPreferences_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Stream",
+			Handler:       _NotificationService_Stream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "lorawan-stack/api/identityserver.proto",
+}
+
 func (this *AuthInfoResponse) String() string {
 	if this == nil {
 		return "nil"
@@ -1613,6 +3097,157 @@ func (this *GetIsConfigurationResponse) String() string {
 	}, "")
 	return s
 }
+func (this *AuditEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuditEntry{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`EntityType:` + fmt.Sprintf("%v", this.EntityType) + `,`,
+		`EntityId:` + fmt.Sprintf("%v", this.EntityId) + `,`,
+		`RelatedEntities:` + fmt.Sprintf("%v", this.RelatedEntities) + `,`,
+		`Paths:` + fmt.Sprintf("%v", this.Paths) + `,`,
+		`ActorType:` + fmt.Sprintf("%v", this.ActorType) + `,`,
+		`ActorId:` + fmt.Sprintf("%v", this.ActorId) + `,`,
+		`AuthType:` + fmt.Sprintf("%v", this.AuthType) + `,`,
+		`AuthTokenType:` + fmt.Sprintf("%v", this.AuthTokenType) + `,`,
+		`AuthTokenId:` + fmt.Sprintf("%v", this.AuthTokenId) + `,`,
+		`SourceIp:` + fmt.Sprintf("%v", this.SourceIp) + `,`,
+		`UserAgent:` + fmt.Sprintf("%v", this.UserAgent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuditEntries) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEntries := "[]*AuditEntry{"
+	for _, f := range this.Entries {
+		repeatedStringForEntries += strings.Replace(f.String(), "AuditEntry", "AuditEntry", 1) + ","
+	}
+	repeatedStringForEntries += "}"
+	s := strings.Join([]string{`&AuditEntries{`,
+		`Entries:` + repeatedStringForEntries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListAuditEntriesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListAuditEntriesRequest{`,
+		`EntityIds:` + strings.Replace(fmt.Sprintf("%v", this.EntityIds), "EntityIdentifiers", "EntityIdentifiers", 1) + `,`,
+		`After:` + strings.Replace(fmt.Sprintf("%v", this.After), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Before:` + strings.Replace(fmt.Sprintf("%v", this.Before), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Notification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Notification{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`NotificationType:` + fmt.Sprintf("%v", this.NotificationType) + `,`,
+		`EntityType:` + fmt.Sprintf("%v", this.EntityType) + `,`,
+		`EntityId:` + fmt.Sprintf("%v", this.EntityId) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`StatusUpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.StatusUpdatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Notifications) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNotifications := "[]*Notification{"
+	for _, f := range this.Notifications {
+		repeatedStringForNotifications += strings.Replace(f.String(), "Notification", "Notification", 1) + ","
+	}
+	repeatedStringForNotifications += "}"
+	s := strings.Join([]string{`&Notifications{`,
+		`Notifications:` + repeatedStringForNotifications + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListNotificationsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListNotificationsRequest{`,
+		`ReceiverIds:` + strings.Replace(fmt.Sprintf("%v", this.ReceiverIds), "UserIdentifiers", "UserIdentifiers", 1) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateNotificationStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateNotificationStatusRequest{`,
+		`ReceiverIds:` + strings.Replace(fmt.Sprintf("%v", this.ReceiverIds), "UserIdentifiers", "UserIdentifiers", 1) + `,`,
+		`Ids:` + fmt.Sprintf("%v", this.Ids) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamNotificationsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamNotificationsRequest{`,
+		`ReceiverIds:` + strings.Replace(fmt.Sprintf("%v", this.ReceiverIds), "UserIdentifiers", "UserIdentifiers", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NotificationEmailPreferences) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForEmail := make([]string, 0, len(this.Email))
+	for k := range this.Email {
+		keysForEmail = append(keysForEmail, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForEmail)
+	mapStringForEmail := "map[string]bool{"
+	for _, k := range keysForEmail {
+		mapStringForEmail += fmt.Sprintf("%v: %v,", k, this.Email[k])
+	}
+	mapStringForEmail += "}"
+	s := strings.Join([]string{`&NotificationEmailPreferences{`,
+		`Email:` + mapStringForEmail + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetNotificationEmailPreferencesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetNotificationEmailPreferencesRequest{`,
+		`UserIds:` + strings.Replace(fmt.Sprintf("%v", this.UserIds), "UserIdentifiers", "UserIdentifiers", 1) + `,`,
+		`Preferences:` + strings.Replace(this.Preferences.String(), "NotificationEmailPreferences", "NotificationEmailPreferences", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringIdentityserver(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -11,6 +11,11 @@ var AuthInfoResponseFieldPathsNested = []string{
 	"access_method.api_key.api_key.id",
 	"access_method.api_key.api_key.key",
 	"access_method.api_key.api_key.name",
+	"access_method.api_key.api_key.restrictions",
+	"access_method.api_key.api_key.restrictions.end_device_attributes",
+	"access_method.api_key.api_key.restrictions.end_device_ids",
+	"access_method.api_key.api_key.restrictions.methods",
+	"access_method.api_key.api_key.restrictions.source_cidrs",
 	"access_method.api_key.api_key.rights",
 	"access_method.api_key.api_key.updated_at",
 	"access_method.api_key.entity_ids",
@@ -134,6 +139,167 @@ var GetIsConfigurationResponseFieldPathsNested = []string{
 var GetIsConfigurationResponseFieldPathsTopLevel = []string{
 	"configuration",
 }
+var AuditEntryFieldPathsNested = []string{
+	"action",
+	"actor_id",
+	"actor_type",
+	"auth_token_id",
+	"auth_token_type",
+	"auth_type",
+	"created_at",
+	"entity_id",
+	"entity_type",
+	"id",
+	"paths",
+	"related_entities",
+	"source_ip",
+	"user_agent",
+}
+
+var AuditEntryFieldPathsTopLevel = []string{
+	"action",
+	"actor_id",
+	"actor_type",
+	"auth_token_id",
+	"auth_token_type",
+	"auth_type",
+	"created_at",
+	"entity_id",
+	"entity_type",
+	"id",
+	"paths",
+	"related_entities",
+	"source_ip",
+	"user_agent",
+}
+var AuditEntriesFieldPathsNested = []string{
+	"entries",
+}
+
+var AuditEntriesFieldPathsTopLevel = []string{
+	"entries",
+}
+var ListAuditEntriesRequestFieldPathsNested = []string{
+	"after",
+	"before",
+	"entity_ids",
+	"entity_ids.ids",
+	"entity_ids.ids.application_ids",
+	"entity_ids.ids.application_ids.application_id",
+	"entity_ids.ids.client_ids",
+	"entity_ids.ids.client_ids.client_id",
+	"entity_ids.ids.device_ids",
+	"entity_ids.ids.device_ids.application_ids",
+	"entity_ids.ids.device_ids.application_ids.application_id",
+	"entity_ids.ids.device_ids.dev_addr",
+	"entity_ids.ids.device_ids.dev_eui",
+	"entity_ids.ids.device_ids.device_id",
+	"entity_ids.ids.device_ids.join_eui",
+	"entity_ids.ids.gateway_ids",
+	"entity_ids.ids.gateway_ids.eui",
+	"entity_ids.ids.gateway_ids.gateway_id",
+	"entity_ids.ids.organization_ids",
+	"entity_ids.ids.organization_ids.organization_id",
+	"entity_ids.ids.user_ids",
+	"entity_ids.ids.user_ids.email",
+	"entity_ids.ids.user_ids.user_id",
+	"limit",
+	"page",
+}
+
+var ListAuditEntriesRequestFieldPathsTopLevel = []string{
+	"after",
+	"before",
+	"entity_ids",
+	"limit",
+	"page",
+}
+var NotificationFieldPathsNested = []string{
+	"body",
+	"created_at",
+	"entity_id",
+	"entity_type",
+	"id",
+	"notification_type",
+	"status",
+	"status_updated_at",
+	"subject",
+}
+
+var NotificationFieldPathsTopLevel = []string{
+	"body",
+	"created_at",
+	"entity_id",
+	"entity_type",
+	"id",
+	"notification_type",
+	"status",
+	"status_updated_at",
+	"subject",
+}
+var NotificationsFieldPathsNested = []string{
+	"notifications",
+}
+
+var NotificationsFieldPathsTopLevel = []string{
+	"notifications",
+}
+var ListNotificationsRequestFieldPathsNested = []string{
+	"limit",
+	"page",
+	"receiver_ids",
+	"receiver_ids.email",
+	"receiver_ids.user_id",
+	"status",
+}
+
+var ListNotificationsRequestFieldPathsTopLevel = []string{
+	"limit",
+	"page",
+	"receiver_ids",
+	"status",
+}
+var UpdateNotificationStatusRequestFieldPathsNested = []string{
+	"ids",
+	"receiver_ids",
+	"receiver_ids.email",
+	"receiver_ids.user_id",
+	"status",
+}
+
+var UpdateNotificationStatusRequestFieldPathsTopLevel = []string{
+	"ids",
+	"receiver_ids",
+	"status",
+}
+var StreamNotificationsRequestFieldPathsNested = []string{
+	"receiver_ids",
+	"receiver_ids.email",
+	"receiver_ids.user_id",
+}
+
+var StreamNotificationsRequestFieldPathsTopLevel = []string{
+	"receiver_ids",
+}
+var NotificationEmailPreferencesFieldPathsNested = []string{
+	"email",
+}
+
+var NotificationEmailPreferencesFieldPathsTopLevel = []string{
+	"email",
+}
+var SetNotificationEmailPreferencesRequestFieldPathsNested = []string{
+	"preferences",
+	"preferences.email",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+}
+
+var SetNotificationEmailPreferencesRequestFieldPathsTopLevel = []string{
+	"preferences",
+	"user_ids",
+}
 var AuthInfoResponse_APIKeyAccessFieldPathsNested = []string{
 	"api_key",
 	"api_key.created_at",
@@ -141,6 +307,11 @@ var AuthInfoResponse_APIKeyAccessFieldPathsNested = []string{
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
+	"api_key.restrictions",
+	"api_key.restrictions.end_device_attributes",
+	"api_key.restrictions.end_device_ids",
+	"api_key.restrictions.methods",
+	"api_key.restrictions.source_cidrs",
 	"api_key.rights",
 	"api_key.updated_at",
 	"entity_ids",
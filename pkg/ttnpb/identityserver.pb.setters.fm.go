@@ -327,6 +327,604 @@ func (dst *GetIsConfigurationResponse) SetFields(src *GetIsConfigurationResponse
 	return nil
 }
 
+func (dst *AuditEntry) SetFields(src *AuditEntry, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Id = src.Id
+			} else {
+				var zero string
+				dst.Id = zero
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				dst.CreatedAt = nil
+			}
+		case "action":
+			if len(subs) > 0 {
+				return fmt.Errorf("'action' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Action = src.Action
+			} else {
+				var zero string
+				dst.Action = zero
+			}
+		case "entity_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'entity_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EntityType = src.EntityType
+			} else {
+				var zero string
+				dst.EntityType = zero
+			}
+		case "entity_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'entity_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EntityId = src.EntityId
+			} else {
+				var zero string
+				dst.EntityId = zero
+			}
+		case "related_entities":
+			if len(subs) > 0 {
+				return fmt.Errorf("'related_entities' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RelatedEntities = src.RelatedEntities
+			} else {
+				dst.RelatedEntities = nil
+			}
+		case "paths":
+			if len(subs) > 0 {
+				return fmt.Errorf("'paths' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Paths = src.Paths
+			} else {
+				dst.Paths = nil
+			}
+		case "actor_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'actor_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ActorType = src.ActorType
+			} else {
+				var zero string
+				dst.ActorType = zero
+			}
+		case "actor_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'actor_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ActorId = src.ActorId
+			} else {
+				var zero string
+				dst.ActorId = zero
+			}
+		case "auth_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'auth_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AuthType = src.AuthType
+			} else {
+				var zero string
+				dst.AuthType = zero
+			}
+		case "auth_token_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'auth_token_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AuthTokenType = src.AuthTokenType
+			} else {
+				var zero string
+				dst.AuthTokenType = zero
+			}
+		case "auth_token_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'auth_token_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AuthTokenId = src.AuthTokenId
+			} else {
+				var zero string
+				dst.AuthTokenId = zero
+			}
+		case "source_ip":
+			if len(subs) > 0 {
+				return fmt.Errorf("'source_ip' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SourceIp = src.SourceIp
+			} else {
+				var zero string
+				dst.SourceIp = zero
+			}
+		case "user_agent":
+			if len(subs) > 0 {
+				return fmt.Errorf("'user_agent' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UserAgent = src.UserAgent
+			} else {
+				var zero string
+				dst.UserAgent = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *AuditEntries) SetFields(src *AuditEntries, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "entries":
+			if len(subs) > 0 {
+				return fmt.Errorf("'entries' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Entries = src.Entries
+			} else {
+				dst.Entries = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListAuditEntriesRequest) SetFields(src *ListAuditEntriesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "entity_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EntityIdentifiers
+				if (src == nil || src.EntityIds == nil) && dst.EntityIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EntityIds
+				}
+				if dst.EntityIds != nil {
+					newDst = dst.EntityIds
+				} else {
+					newDst = &EntityIdentifiers{}
+					dst.EntityIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EntityIds = src.EntityIds
+				} else {
+					dst.EntityIds = nil
+				}
+			}
+		case "after":
+			if len(subs) > 0 {
+				return fmt.Errorf("'after' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.After = src.After
+			} else {
+				dst.After = nil
+			}
+		case "before":
+			if len(subs) > 0 {
+				return fmt.Errorf("'before' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Before = src.Before
+			} else {
+				dst.Before = nil
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *Notification) SetFields(src *Notification, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Id = src.Id
+			} else {
+				var zero string
+				dst.Id = zero
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				dst.CreatedAt = nil
+			}
+		case "notification_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'notification_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NotificationType = src.NotificationType
+			} else {
+				var zero string
+				dst.NotificationType = zero
+			}
+		case "entity_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'entity_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EntityType = src.EntityType
+			} else {
+				var zero string
+				dst.EntityType = zero
+			}
+		case "entity_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'entity_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EntityId = src.EntityId
+			} else {
+				var zero string
+				dst.EntityId = zero
+			}
+		case "subject":
+			if len(subs) > 0 {
+				return fmt.Errorf("'subject' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Subject = src.Subject
+			} else {
+				var zero string
+				dst.Subject = zero
+			}
+		case "body":
+			if len(subs) > 0 {
+				return fmt.Errorf("'body' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Body = src.Body
+			} else {
+				var zero string
+				dst.Body = zero
+			}
+		case "status":
+			if len(subs) > 0 {
+				return fmt.Errorf("'status' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Status = src.Status
+			} else {
+				var zero NotificationStatus
+				dst.Status = zero
+			}
+		case "status_updated_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'status_updated_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StatusUpdatedAt = src.StatusUpdatedAt
+			} else {
+				dst.StatusUpdatedAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *Notifications) SetFields(src *Notifications, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "notifications":
+			if len(subs) > 0 {
+				return fmt.Errorf("'notifications' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Notifications = src.Notifications
+			} else {
+				dst.Notifications = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListNotificationsRequest) SetFields(src *ListNotificationsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "receiver_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if (src == nil || src.ReceiverIds == nil) && dst.ReceiverIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ReceiverIds
+				}
+				if dst.ReceiverIds != nil {
+					newDst = dst.ReceiverIds
+				} else {
+					newDst = &UserIdentifiers{}
+					dst.ReceiverIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ReceiverIds = src.ReceiverIds
+				} else {
+					dst.ReceiverIds = nil
+				}
+			}
+		case "status":
+			if len(subs) > 0 {
+				return fmt.Errorf("'status' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Status = src.Status
+			} else {
+				dst.Status = nil
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *UpdateNotificationStatusRequest) SetFields(src *UpdateNotificationStatusRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "receiver_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if (src == nil || src.ReceiverIds == nil) && dst.ReceiverIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ReceiverIds
+				}
+				if dst.ReceiverIds != nil {
+					newDst = dst.ReceiverIds
+				} else {
+					newDst = &UserIdentifiers{}
+					dst.ReceiverIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ReceiverIds = src.ReceiverIds
+				} else {
+					dst.ReceiverIds = nil
+				}
+			}
+		case "ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Ids = src.Ids
+			} else {
+				dst.Ids = nil
+			}
+		case "status":
+			if len(subs) > 0 {
+				return fmt.Errorf("'status' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Status = src.Status
+			} else {
+				var zero NotificationStatus
+				dst.Status = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *StreamNotificationsRequest) SetFields(src *StreamNotificationsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "receiver_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if (src == nil || src.ReceiverIds == nil) && dst.ReceiverIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ReceiverIds
+				}
+				if dst.ReceiverIds != nil {
+					newDst = dst.ReceiverIds
+				} else {
+					newDst = &UserIdentifiers{}
+					dst.ReceiverIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ReceiverIds = src.ReceiverIds
+				} else {
+					dst.ReceiverIds = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *NotificationEmailPreferences) SetFields(src *NotificationEmailPreferences, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "email":
+			if len(subs) > 0 {
+				return fmt.Errorf("'email' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Email = src.Email
+			} else {
+				dst.Email = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SetNotificationEmailPreferencesRequest) SetFields(src *SetNotificationEmailPreferencesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "user_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if (src == nil || src.UserIds == nil) && dst.UserIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.UserIds
+				}
+				if dst.UserIds != nil {
+					newDst = dst.UserIds
+				} else {
+					newDst = &UserIdentifiers{}
+					dst.UserIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIds = src.UserIds
+				} else {
+					dst.UserIds = nil
+				}
+			}
+		case "preferences":
+			if len(subs) > 0 {
+				var newDst, newSrc *NotificationEmailPreferences
+				if (src == nil || src.Preferences == nil) && dst.Preferences == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Preferences
+				}
+				if dst.Preferences != nil {
+					newDst = dst.Preferences
+				} else {
+					newDst = &NotificationEmailPreferences{}
+					dst.Preferences = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Preferences = src.Preferences
+				} else {
+					dst.Preferences = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *AuthInfoResponse_APIKeyAccess) SetFields(src *AuthInfoResponse_APIKeyAccess, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -475,6 +475,352 @@ var _ interface {
 	ErrorName() string
 } = GetIsConfigurationResponseValidationError{}
 
+// ValidateFields checks the field values on AuditEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *AuditEntry) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = AuditEntryFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "id":
+			// no validation rules for Id
+		case "created_at":
+
+			if v, ok := interface{}(m.GetCreatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return AuditEntryValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "action":
+			// no validation rules for Action
+		case "entity_type":
+			// no validation rules for EntityType
+		case "entity_id":
+			// no validation rules for EntityId
+		case "related_entities":
+			// no validation rules for RelatedEntities
+		case "paths":
+			// no validation rules for Paths
+		case "actor_type":
+			// no validation rules for ActorType
+		case "actor_id":
+			// no validation rules for ActorId
+		case "auth_type":
+			// no validation rules for AuthType
+		case "auth_token_type":
+			// no validation rules for AuthTokenType
+		case "auth_token_id":
+			// no validation rules for AuthTokenId
+		case "source_ip":
+			// no validation rules for SourceIp
+		case "user_agent":
+			// no validation rules for UserAgent
+		default:
+			return AuditEntryValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// AuditEntryValidationError is the validation error returned by
+// AuditEntry.ValidateFields if the designated constraints aren't met.
+type AuditEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEntryValidationError) ErrorName() string {
+	return "AuditEntryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuditEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEntryValidationError{}
+
+// ValidateFields checks the field values on AuditEntries with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *AuditEntries) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = AuditEntriesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "entries":
+
+			for idx, item := range m.GetEntries() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return AuditEntriesValidationError{
+							field:  fmt.Sprintf("entries[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return AuditEntriesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// AuditEntriesValidationError is the validation error returned by
+// AuditEntries.ValidateFields if the designated constraints aren't met.
+type AuditEntriesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEntriesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEntriesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEntriesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEntriesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEntriesValidationError) ErrorName() string {
+	return "AuditEntriesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuditEntriesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEntries.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEntriesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEntriesValidationError{}
+
+// ValidateFields checks the field values on ListAuditEntriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListAuditEntriesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListAuditEntriesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "entity_ids":
+
+			if v, ok := interface{}(m.GetEntityIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListAuditEntriesRequestValidationError{
+						field:  "entity_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "after":
+
+			if v, ok := interface{}(m.GetAfter()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListAuditEntriesRequestValidationError{
+						field:  "after",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "before":
+
+			if v, ok := interface{}(m.GetBefore()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListAuditEntriesRequestValidationError{
+						field:  "before",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return ListAuditEntriesRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		default:
+			return ListAuditEntriesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListAuditEntriesRequestValidationError is the validation error returned by
+// ListAuditEntriesRequest.ValidateFields if the designated constraints aren't
+// met.
+type ListAuditEntriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEntriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEntriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEntriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEntriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEntriesRequestValidationError) ErrorName() string {
+	return "ListAuditEntriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEntriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEntriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEntriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEntriesRequestValidationError{}
+
 // ValidateFields checks the field values on AuthInfoResponse_APIKeyAccess with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
@@ -1510,3 +1856,771 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = IsConfiguration_UserRegistration_PasswordRequirementsValidationError{}
+
+// ValidateFields checks the field values on Notification with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *Notification) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = NotificationFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "id":
+			// no validation rules for Id
+		case "created_at":
+
+			if v, ok := interface{}(m.GetCreatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return NotificationValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "notification_type":
+			// no validation rules for NotificationType
+		case "entity_type":
+			// no validation rules for EntityType
+		case "entity_id":
+			// no validation rules for EntityId
+		case "subject":
+			// no validation rules for Subject
+		case "body":
+			// no validation rules for Body
+		case "status":
+			// no validation rules for Status
+		case "status_updated_at":
+
+			if v, ok := interface{}(m.GetStatusUpdatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return NotificationValidationError{
+						field:  "status_updated_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return NotificationValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// NotificationValidationError is the validation error returned by
+// Notification.ValidateFields if the designated constraints aren't met.
+type NotificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationValidationError) ErrorName() string {
+	return "NotificationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationValidationError{}
+
+// ValidateFields checks the field values on Notifications with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *Notifications) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = NotificationsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "notifications":
+
+			for idx, item := range m.GetNotifications() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return NotificationsValidationError{
+							field:  fmt.Sprintf("notifications[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return NotificationsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// NotificationsValidationError is the validation error returned by
+// Notifications.ValidateFields if the designated constraints aren't met.
+type NotificationsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationsValidationError) ErrorName() string {
+	return "NotificationsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotifications.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationsValidationError{}
+
+// ValidateFields checks the field values on ListNotificationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListNotificationsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListNotificationsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "receiver_ids":
+
+			if m.GetReceiverIds() == nil {
+				return ListNotificationsRequestValidationError{
+					field:  "receiver_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetReceiverIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListNotificationsRequestValidationError{
+						field:  "receiver_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "status":
+
+			for idx, item := range m.GetStatus() {
+				_, _ = idx, item
+
+				if _, ok := NotificationStatus_name[int32(item)]; !ok {
+					return ListNotificationsRequestValidationError{
+						field:  fmt.Sprintf("status[%v]", idx),
+						reason: "value must be one of the defined enum values",
+					}
+				}
+
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return ListNotificationsRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		default:
+			return ListNotificationsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListNotificationsRequestValidationError is the validation error returned by
+// ListNotificationsRequest.ValidateFields if the designated constraints aren't
+// met.
+type ListNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationsRequestValidationError) ErrorName() string {
+	return "ListNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationsRequestValidationError{}
+
+// ValidateFields checks the field values on UpdateNotificationStatusRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *UpdateNotificationStatusRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = UpdateNotificationStatusRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "receiver_ids":
+
+			if m.GetReceiverIds() == nil {
+				return UpdateNotificationStatusRequestValidationError{
+					field:  "receiver_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetReceiverIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UpdateNotificationStatusRequestValidationError{
+						field:  "receiver_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "ids":
+
+			if l := len(m.GetIds()); l < 1 || l > 1000 {
+				return UpdateNotificationStatusRequestValidationError{
+					field:  "ids",
+					reason: "value must contain between 1 and 1000 items, inclusive",
+				}
+			}
+
+		case "status":
+
+			if _, ok := NotificationStatus_name[int32(m.GetStatus())]; !ok {
+				return UpdateNotificationStatusRequestValidationError{
+					field:  "status",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return UpdateNotificationStatusRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateNotificationStatusRequestValidationError is the validation error
+// returned by UpdateNotificationStatusRequest.ValidateFields if the designated
+// constraints aren't met.
+type UpdateNotificationStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNotificationStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNotificationStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNotificationStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNotificationStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNotificationStatusRequestValidationError) ErrorName() string {
+	return "UpdateNotificationStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNotificationStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNotificationStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNotificationStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNotificationStatusRequestValidationError{}
+
+// ValidateFields checks the field values on StreamNotificationsRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *StreamNotificationsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = StreamNotificationsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "receiver_ids":
+
+			if m.GetReceiverIds() == nil {
+				return StreamNotificationsRequestValidationError{
+					field:  "receiver_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetReceiverIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return StreamNotificationsRequestValidationError{
+						field:  "receiver_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return StreamNotificationsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// StreamNotificationsRequestValidationError is the validation error returned
+// by StreamNotificationsRequest.ValidateFields if the designated constraints
+// aren't met.
+type StreamNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamNotificationsRequestValidationError) ErrorName() string {
+	return "StreamNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamNotificationsRequestValidationError{}
+
+// ValidateFields checks the field values on NotificationEmailPreferences with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *NotificationEmailPreferences) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = NotificationEmailPreferencesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "email":
+			// no validation rules for Email
+		default:
+			return NotificationEmailPreferencesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// NotificationEmailPreferencesValidationError is the validation error returned
+// by NotificationEmailPreferences.ValidateFields if the designated constraints
+// aren't met.
+type NotificationEmailPreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationEmailPreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationEmailPreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationEmailPreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationEmailPreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationEmailPreferencesValidationError) ErrorName() string {
+	return "NotificationEmailPreferencesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationEmailPreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationEmailPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationEmailPreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationEmailPreferencesValidationError{}
+
+// ValidateFields checks the field values on
+// SetNotificationEmailPreferencesRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is
+// returned.
+func (m *SetNotificationEmailPreferencesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetNotificationEmailPreferencesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "user_ids":
+
+			if m.GetUserIds() == nil {
+				return SetNotificationEmailPreferencesRequestValidationError{
+					field:  "user_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetUserIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetNotificationEmailPreferencesRequestValidationError{
+						field:  "user_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "preferences":
+
+			if m.GetPreferences() == nil {
+				return SetNotificationEmailPreferencesRequestValidationError{
+					field:  "preferences",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetPreferences()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetNotificationEmailPreferencesRequestValidationError{
+						field:  "preferences",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return SetNotificationEmailPreferencesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetNotificationEmailPreferencesRequestValidationError is the validation
+// error returned by SetNotificationEmailPreferencesRequest.ValidateFields if
+// the designated constraints aren't met.
+type SetNotificationEmailPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetNotificationEmailPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetNotificationEmailPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetNotificationEmailPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetNotificationEmailPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetNotificationEmailPreferencesRequestValidationError) ErrorName() string {
+	return "SetNotificationEmailPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetNotificationEmailPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetNotificationEmailPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetNotificationEmailPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetNotificationEmailPreferencesRequestValidationError{}
@@ -11,6 +11,28 @@ import (
 	jsonplugin "github.com/TheThingsIndustries/protoc-gen-go-json/jsonplugin"
 )
 
+// MarshalProtoJSON marshals the NotificationStatus to JSON.
+func (x NotificationStatus) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), NotificationStatus_name)
+}
+
+// NotificationStatus_customvalue contains custom string values that extend NotificationStatus_value.
+var NotificationStatus_customvalue = map[string]int32{
+	"UNREAD":   0,
+	"READ":     1,
+	"ARCHIVED": 2,
+}
+
+// UnmarshalProtoJSON unmarshals the NotificationStatus from JSON.
+func (x *NotificationStatus) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(NotificationStatus_value, NotificationStatus_customvalue)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read NotificationStatus enum: %v", err)
+		return
+	}
+	*x = NotificationStatus(v)
+}
+
 // MarshalProtoJSON marshals the AuthInfoResponse_APIKeyAccess message to JSON.
 func (x *AuthInfoResponse_APIKeyAccess) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 	if x == nil {
@@ -137,3 +159,286 @@ func (x *AuthInfoResponse) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 		}
 	})
 }
+
+// MarshalProtoJSON marshals the Notification message to JSON.
+func (x *Notification) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Id != "" || s.HasField("id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("id")
+		s.WriteString(x.Id)
+	}
+	if x.CreatedAt != nil || s.HasField("created_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("created_at")
+		if x.CreatedAt == nil {
+			s.WriteNil()
+		} else {
+			s.WriteTime(*x.CreatedAt)
+		}
+	}
+	if x.NotificationType != "" || s.HasField("notification_type") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("notification_type")
+		s.WriteString(x.NotificationType)
+	}
+	if x.EntityType != "" || s.HasField("entity_type") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("entity_type")
+		s.WriteString(x.EntityType)
+	}
+	if x.EntityId != "" || s.HasField("entity_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("entity_id")
+		s.WriteString(x.EntityId)
+	}
+	if x.Subject != "" || s.HasField("subject") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("subject")
+		s.WriteString(x.Subject)
+	}
+	if x.Body != "" || s.HasField("body") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("body")
+		s.WriteString(x.Body)
+	}
+	if x.Status != 0 || s.HasField("status") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("status")
+		x.Status.MarshalProtoJSON(s)
+	}
+	if x.StatusUpdatedAt != nil || s.HasField("status_updated_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("status_updated_at")
+		if x.StatusUpdatedAt == nil {
+			s.WriteNil()
+		} else {
+			s.WriteTime(*x.StatusUpdatedAt)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the Notification message from JSON.
+func (x *Notification) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "id":
+			s.AddField("id")
+			x.Id = s.ReadString()
+		case "created_at", "createdAt":
+			s.AddField("created_at")
+			v := s.ReadTime()
+			if s.Err() != nil {
+				return
+			}
+			x.CreatedAt = v
+		case "notification_type", "notificationType":
+			s.AddField("notification_type")
+			x.NotificationType = s.ReadString()
+		case "entity_type", "entityType":
+			s.AddField("entity_type")
+			x.EntityType = s.ReadString()
+		case "entity_id", "entityId":
+			s.AddField("entity_id")
+			x.EntityId = s.ReadString()
+		case "subject":
+			s.AddField("subject")
+			x.Subject = s.ReadString()
+		case "body":
+			s.AddField("body")
+			x.Body = s.ReadString()
+		case "status":
+			s.AddField("status")
+			x.Status.UnmarshalProtoJSON(s)
+		case "status_updated_at", "statusUpdatedAt":
+			s.AddField("status_updated_at")
+			v := s.ReadTime()
+			if s.Err() != nil {
+				return
+			}
+			x.StatusUpdatedAt = v
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the Notifications message to JSON.
+func (x *Notifications) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Notifications) > 0 || s.HasField("notifications") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("notifications")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Notifications {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("notifications"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the Notifications message from JSON.
+func (x *Notifications) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "notifications":
+			s.AddField("notifications")
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Notifications = append(x.Notifications, nil)
+					return
+				}
+				v := &Notification{}
+				v.UnmarshalProtoJSON(s.WithField("notifications", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Notifications = append(x.Notifications, v)
+			})
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the ListNotificationsRequest message to JSON.
+func (x *ListNotificationsRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ReceiverIds != nil || s.HasField("receiver_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("receiver_ids")
+		// NOTE: UserIdentifiers does not seem to implement MarshalProtoJSON.
+		gogo.MarshalMessage(s, x.ReceiverIds)
+	}
+	if len(x.Status) > 0 || s.HasField("status") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("status")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Status {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s)
+		}
+		s.WriteArrayEnd()
+	}
+	if x.Limit != 0 || s.HasField("limit") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("limit")
+		s.WriteUint32(x.Limit)
+	}
+	if x.Page != 0 || s.HasField("page") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("page")
+		s.WriteUint32(x.Page)
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the ListNotificationsRequest message from JSON.
+func (x *ListNotificationsRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "receiver_ids", "receiverIds":
+			s.AddField("receiver_ids")
+			// NOTE: UserIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v UserIdentifiers
+			gogo.UnmarshalMessage(s, &v)
+			x.ReceiverIds = &v
+		case "status":
+			s.AddField("status")
+			s.ReadArray(func() {
+				var v NotificationStatus
+				v.UnmarshalProtoJSON(s)
+				x.Status = append(x.Status, v)
+			})
+		case "limit":
+			s.AddField("limit")
+			x.Limit = s.ReadUint32()
+		case "page":
+			s.AddField("page")
+			x.Page = s.ReadUint32()
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the UpdateNotificationStatusRequest message to JSON.
+func (x *UpdateNotificationStatusRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ReceiverIds != nil || s.HasField("receiver_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("receiver_ids")
+		// NOTE: UserIdentifiers does not seem to implement MarshalProtoJSON.
+		gogo.MarshalMessage(s, x.ReceiverIds)
+	}
+	if len(x.Ids) > 0 || s.HasField("ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("ids")
+		s.WriteStringArray(x.Ids)
+	}
+	if x.Status != 0 || s.HasField("status") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("status")
+		x.Status.MarshalProtoJSON(s)
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the UpdateNotificationStatusRequest message from JSON.
+func (x *UpdateNotificationStatusRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "receiver_ids", "receiverIds":
+			s.AddField("receiver_ids")
+			// NOTE: UserIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v UserIdentifiers
+			gogo.UnmarshalMessage(s, &v)
+			x.ReceiverIds = &v
+		case "ids":
+			s.AddField("ids")
+			x.Ids = s.ReadStringArray()
+		case "status":
+			s.AddField("status")
+			x.Status.UnmarshalProtoJSON(s)
+		}
+	})
+}
@@ -938,6 +938,249 @@ func (m *JoinEUIPrefixes) GetPrefixes() []*JoinEUIPrefix {
 	return nil
 }
 
+type JoinEUIRange struct {
+	// The JoinEUI prefix of the range.
+	Prefix *JoinEUIPrefix `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// The application that the range is allocated to.
+	// A range is allocated to either an application or an organization.
+	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,2,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	// The organization that the range is allocated to.
+	// The applications on which the organization is a collaborator can use the range.
+	OrganizationIds *OrganizationIdentifiers `protobuf:"bytes,3,opt,name=organization_ids,json=organizationIds,proto3" json:"organization_ids,omitempty"`
+	// The NetIDs that end devices in the range may join. An empty list allows all NetIDs.
+	NetIds []go_thethings_network_lorawan_stack_v3_pkg_types.NetID `protobuf:"bytes,4,rep,name=net_ids,json=netIds,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.NetID" json:"net_ids"`
+	// The addresses of the Network Servers that may handle the end devices in the range.
+	// An empty list allows all Network Servers.
+	NetworkServerAddresses []string `protobuf:"bytes,5,rep,name=network_server_addresses,json=networkServerAddresses,proto3" json:"network_server_addresses,omitempty"`
+	// The AS-IDs of the Application Servers that may handle the end devices in the range.
+	// An empty list allows all Application Servers.
+	ApplicationServerIds []string `protobuf:"bytes,6,rep,name=application_server_ids,json=applicationServerIds,proto3" json:"application_server_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JoinEUIRange) Reset()      { *m = JoinEUIRange{} }
+func (*JoinEUIRange) ProtoMessage() {}
+func (*JoinEUIRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b695d5f526759a7, []int{15}
+}
+func (m *JoinEUIRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JoinEUIRange.Unmarshal(m, b)
+}
+func (m *JoinEUIRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JoinEUIRange.Marshal(b, m, deterministic)
+}
+func (m *JoinEUIRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinEUIRange.Merge(m, src)
+}
+func (m *JoinEUIRange) XXX_Size() int {
+	return xxx_messageInfo_JoinEUIRange.Size(m)
+}
+func (m *JoinEUIRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinEUIRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinEUIRange proto.InternalMessageInfo
+
+func (m *JoinEUIRange) GetPrefix() *JoinEUIPrefix {
+	if m != nil {
+		return m.Prefix
+	}
+	return nil
+}
+
+func (m *JoinEUIRange) GetApplicationIds() *ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIds
+	}
+	return nil
+}
+
+func (m *JoinEUIRange) GetOrganizationIds() *OrganizationIdentifiers {
+	if m != nil {
+		return m.OrganizationIds
+	}
+	return nil
+}
+
+func (m *JoinEUIRange) GetNetworkServerAddresses() []string {
+	if m != nil {
+		return m.NetworkServerAddresses
+	}
+	return nil
+}
+
+func (m *JoinEUIRange) GetApplicationServerIds() []string {
+	if m != nil {
+		return m.ApplicationServerIds
+	}
+	return nil
+}
+
+type JoinEUIRanges struct {
+	Ranges               []*JoinEUIRange `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *JoinEUIRanges) Reset()      { *m = JoinEUIRanges{} }
+func (*JoinEUIRanges) ProtoMessage() {}
+func (*JoinEUIRanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b695d5f526759a7, []int{16}
+}
+func (m *JoinEUIRanges) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JoinEUIRanges.Unmarshal(m, b)
+}
+func (m *JoinEUIRanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JoinEUIRanges.Marshal(b, m, deterministic)
+}
+func (m *JoinEUIRanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinEUIRanges.Merge(m, src)
+}
+func (m *JoinEUIRanges) XXX_Size() int {
+	return xxx_messageInfo_JoinEUIRanges.Size(m)
+}
+func (m *JoinEUIRanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinEUIRanges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinEUIRanges proto.InternalMessageInfo
+
+func (m *JoinEUIRanges) GetRanges() []*JoinEUIRange {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
+type GetJoinEUIRangeRequest struct {
+	// The JoinEUI of the prefix of the range, in hexadecimal notation.
+	JoinEui string `protobuf:"bytes,1,opt,name=join_eui,json=joinEui,proto3" json:"join_eui,omitempty"`
+	// The length of the prefix of the range, in bits.
+	Length               uint32   `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetJoinEUIRangeRequest) Reset()      { *m = GetJoinEUIRangeRequest{} }
+func (*GetJoinEUIRangeRequest) ProtoMessage() {}
+func (*GetJoinEUIRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b695d5f526759a7, []int{17}
+}
+func (m *GetJoinEUIRangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJoinEUIRangeRequest.Unmarshal(m, b)
+}
+func (m *GetJoinEUIRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJoinEUIRangeRequest.Marshal(b, m, deterministic)
+}
+func (m *GetJoinEUIRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJoinEUIRangeRequest.Merge(m, src)
+}
+func (m *GetJoinEUIRangeRequest) XXX_Size() int {
+	return xxx_messageInfo_GetJoinEUIRangeRequest.Size(m)
+}
+func (m *GetJoinEUIRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJoinEUIRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJoinEUIRangeRequest proto.InternalMessageInfo
+
+func (m *GetJoinEUIRangeRequest) GetJoinEui() string {
+	if m != nil {
+		return m.JoinEui
+	}
+	return ""
+}
+
+func (m *GetJoinEUIRangeRequest) GetLength() uint32 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+type SetJoinEUIRangeRequest struct {
+	JoinEuiRange         *JoinEUIRange `protobuf:"bytes,1,opt,name=join_eui_range,json=joinEuiRange,proto3" json:"join_eui_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SetJoinEUIRangeRequest) Reset()      { *m = SetJoinEUIRangeRequest{} }
+func (*SetJoinEUIRangeRequest) ProtoMessage() {}
+func (*SetJoinEUIRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b695d5f526759a7, []int{18}
+}
+func (m *SetJoinEUIRangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetJoinEUIRangeRequest.Unmarshal(m, b)
+}
+func (m *SetJoinEUIRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetJoinEUIRangeRequest.Marshal(b, m, deterministic)
+}
+func (m *SetJoinEUIRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetJoinEUIRangeRequest.Merge(m, src)
+}
+func (m *SetJoinEUIRangeRequest) XXX_Size() int {
+	return xxx_messageInfo_SetJoinEUIRangeRequest.Size(m)
+}
+func (m *SetJoinEUIRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetJoinEUIRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetJoinEUIRangeRequest proto.InternalMessageInfo
+
+func (m *SetJoinEUIRangeRequest) GetJoinEuiRange() *JoinEUIRange {
+	if m != nil {
+		return m.JoinEuiRange
+	}
+	return nil
+}
+
+type DeleteJoinEUIRangeRequest struct {
+	// The JoinEUI of the prefix of the range, in hexadecimal notation.
+	JoinEui string `protobuf:"bytes,1,opt,name=join_eui,json=joinEui,proto3" json:"join_eui,omitempty"`
+	// The length of the prefix of the range, in bits.
+	Length               uint32   `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteJoinEUIRangeRequest) Reset()      { *m = DeleteJoinEUIRangeRequest{} }
+func (*DeleteJoinEUIRangeRequest) ProtoMessage() {}
+func (*DeleteJoinEUIRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b695d5f526759a7, []int{19}
+}
+func (m *DeleteJoinEUIRangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteJoinEUIRangeRequest.Unmarshal(m, b)
+}
+func (m *DeleteJoinEUIRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteJoinEUIRangeRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteJoinEUIRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteJoinEUIRangeRequest.Merge(m, src)
+}
+func (m *DeleteJoinEUIRangeRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteJoinEUIRangeRequest.Size(m)
+}
+func (m *DeleteJoinEUIRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteJoinEUIRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteJoinEUIRangeRequest proto.InternalMessageInfo
+
+func (m *DeleteJoinEUIRangeRequest) GetJoinEui() string {
+	if m != nil {
+		return m.JoinEui
+	}
+	return ""
+}
+
+func (m *DeleteJoinEUIRangeRequest) GetLength() uint32 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SessionKeyRequest)(nil), "ttn.lorawan.v3.SessionKeyRequest")
 	golang_proto.RegisterType((*SessionKeyRequest)(nil), "ttn.lorawan.v3.SessionKeyRequest")
@@ -975,6 +1218,16 @@ func init() {
 	golang_proto.RegisterType((*JoinEUIPrefix)(nil), "ttn.lorawan.v3.JoinEUIPrefix")
 	proto.RegisterType((*JoinEUIPrefixes)(nil), "ttn.lorawan.v3.JoinEUIPrefixes")
 	golang_proto.RegisterType((*JoinEUIPrefixes)(nil), "ttn.lorawan.v3.JoinEUIPrefixes")
+	proto.RegisterType((*JoinEUIRange)(nil), "ttn.lorawan.v3.JoinEUIRange")
+	golang_proto.RegisterType((*JoinEUIRange)(nil), "ttn.lorawan.v3.JoinEUIRange")
+	proto.RegisterType((*JoinEUIRanges)(nil), "ttn.lorawan.v3.JoinEUIRanges")
+	golang_proto.RegisterType((*JoinEUIRanges)(nil), "ttn.lorawan.v3.JoinEUIRanges")
+	proto.RegisterType((*GetJoinEUIRangeRequest)(nil), "ttn.lorawan.v3.GetJoinEUIRangeRequest")
+	golang_proto.RegisterType((*GetJoinEUIRangeRequest)(nil), "ttn.lorawan.v3.GetJoinEUIRangeRequest")
+	proto.RegisterType((*SetJoinEUIRangeRequest)(nil), "ttn.lorawan.v3.SetJoinEUIRangeRequest")
+	golang_proto.RegisterType((*SetJoinEUIRangeRequest)(nil), "ttn.lorawan.v3.SetJoinEUIRangeRequest")
+	proto.RegisterType((*DeleteJoinEUIRangeRequest)(nil), "ttn.lorawan.v3.DeleteJoinEUIRangeRequest")
+	golang_proto.RegisterType((*DeleteJoinEUIRangeRequest)(nil), "ttn.lorawan.v3.DeleteJoinEUIRangeRequest")
 }
 
 func init() {
@@ -985,128 +1238,155 @@ func init() {
 }
 
 var fileDescriptor_1b695d5f526759a7 = []byte{
-	// 1932 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6c, 0x23, 0x57,
-	0x19, 0xcf, 0xd8, 0xce, 0x1f, 0x7f, 0xd9, 0x38, 0xd9, 0xb7, 0xfd, 0x63, 0xbc, 0xbb, 0x49, 0x3a,
-	0x4d, 0xd9, 0x25, 0xad, 0xed, 0xca, 0x0b, 0xb4, 0x9b, 0x02, 0x5b, 0x3b, 0x71, 0x13, 0x27, 0x9b,
-	0x28, 0x1d, 0xef, 0x56, 0xdd, 0x2d, 0xbb, 0xc3, 0xc4, 0xf3, 0xec, 0x4c, 0xc6, 0x79, 0x33, 0xcc,
-	0x7b, 0x71, 0xea, 0x96, 0x95, 0x4a, 0x0f, 0x80, 0x90, 0x90, 0x90, 0xa0, 0x77, 0x24, 0x0e, 0x70,
-	0xe0, 0x02, 0x54, 0xe2, 0x06, 0x1c, 0x38, 0x20, 0x71, 0xe4, 0x82, 0x40, 0xaa, 0xc4, 0x96, 0x03,
-	0x12, 0x07, 0xb8, 0xd0, 0xc3, 0x1e, 0x10, 0x9a, 0x37, 0xcf, 0xe3, 0xf1, 0xd8, 0xf1, 0xda, 0x9b,
-	0xa4, 0xdb, 0xdb, 0x9b, 0x79, 0xdf, 0xfb, 0xbd, 0xef, 0xfb, 0x7d, 0x7f, 0xe6, 0xfb, 0x06, 0xe4,
-	0xba, 0xe5, 0x68, 0x87, 0x1a, 0x49, 0x53, 0xa6, 0x55, 0xcc, 0xac, 0x66, 0x1b, 0xd9, 0x3d, 0xcb,
-	0x20, 0x14, 0x3b, 0x0d, 0xec, 0x64, 0x6c, 0xc7, 0x62, 0x16, 0x4a, 0x30, 0x46, 0x32, 0x42, 0x2e,
-	0xd3, 0xb8, 0x92, 0xca, 0xd7, 0x0c, 0xb6, 0x7b, 0xb0, 0x93, 0xa9, 0x58, 0xfb, 0x59, 0x4c, 0x1a,
-	0x56, 0xd3, 0x76, 0xac, 0xb7, 0x9b, 0x59, 0x2e, 0x5c, 0x49, 0xd7, 0x30, 0x49, 0x37, 0xb4, 0xba,
-	0xa1, 0x6b, 0x0c, 0x67, 0xbb, 0x16, 0x1e, 0x64, 0x2a, 0x1d, 0x80, 0xa8, 0x59, 0x35, 0xcb, 0x3b,
-	0xbc, 0x73, 0x50, 0xe5, 0x4f, 0xfc, 0x81, 0xaf, 0x84, 0xf8, 0x85, 0x9a, 0x65, 0xd5, 0xea, 0x98,
-	0xab, 0xa7, 0x11, 0x62, 0x31, 0x8d, 0x19, 0x16, 0xa1, 0x62, 0xf7, 0xbc, 0xd8, 0xf5, 0x31, 0xf0,
-	0xbe, 0xcd, 0x9a, 0x62, 0x73, 0x3e, 0xbc, 0x59, 0x35, 0x70, 0x5d, 0x57, 0xf7, 0x35, 0x6a, 0x86,
-	0xc0, 0x7d, 0x09, 0xca, 0x9c, 0x83, 0x0a, 0x13, 0xbb, 0x3d, 0x08, 0xc2, 0x44, 0x57, 0x75, 0xdc,
-	0x30, 0x2a, 0x2d, 0x6b, 0x9e, 0xed, 0x96, 0x31, 0x74, 0x4c, 0x98, 0x51, 0x35, 0xb0, 0xd3, 0xd2,
-	0xf2, 0x42, 0x6f, 0xa6, 0x8f, 0xde, 0x35, 0x71, 0xb3, 0x75, 0x76, 0xae, 0x7b, 0xb7, 0xe5, 0x0f,
-	0x2e, 0x20, 0xff, 0x57, 0x82, 0xb3, 0x65, 0x4c, 0xa9, 0x61, 0x91, 0x0d, 0xdc, 0x54, 0xf0, 0x37,
-	0x0f, 0x30, 0x65, 0x28, 0x03, 0x09, 0xea, 0xbd, 0x54, 0x4d, 0xdc, 0x54, 0x0d, 0x3d, 0x29, 0xcd,
-	0x4b, 0x97, 0xcf, 0x14, 0x26, 0x1e, 0x14, 0x46, 0xdf, 0x89, 0x26, 0xdf, 0x9b, 0x51, 0xce, 0x50,
-	0xff, 0x50, 0x49, 0x47, 0x6f, 0xc0, 0xb8, 0x8e, 0x1b, 0x2a, 0x3e, 0x30, 0x92, 0x11, 0x2e, 0xf8,
-	0xd5, 0x3f, 0x7e, 0x34, 0x37, 0xf2, 0xd7, 0x8f, 0xe6, 0xbe, 0x54, 0xb3, 0x32, 0x6c, 0x17, 0xb3,
-	0x5d, 0x83, 0xd4, 0x68, 0x86, 0x60, 0x76, 0x68, 0x39, 0x66, 0xb6, 0x53, 0xa9, 0xc6, 0x95, 0xac,
-	0x6d, 0xd6, 0xb2, 0xac, 0x69, 0x63, 0x9a, 0x29, 0xde, 0x2c, 0x7d, 0xf9, 0x8b, 0xca, 0x98, 0x8e,
-	0x1b, 0xc5, 0x03, 0x03, 0xbd, 0x09, 0x13, 0xae, 0xa9, 0x1c, 0x38, 0x7a, 0x12, 0xc0, 0xe3, 0x2e,
-	0x5c, 0xf1, 0xc0, 0x90, 0x3f, 0x91, 0x60, 0x66, 0xeb, 0xd0, 0x2c, 0x6f, 0xe0, 0x26, 0x55, 0x30,
-	0xb5, 0x2d, 0x42, 0x31, 0xda, 0x80, 0xe9, 0xaa, 0x4a, 0x0e, 0x4d, 0x95, 0xaa, 0x06, 0x61, 0xae,
-	0xe9, 0xdc, 0xee, 0xc9, 0xdc, 0xf9, 0x4c, 0x67, 0x24, 0x67, 0x36, 0x70, 0xb3, 0x48, 0x1a, 0xb8,
-	0x6e, 0xd9, 0x98, 0x93, 0xf2, 0x7d, 0x29, 0x32, 0x23, 0x29, 0x93, 0x55, 0x17, 0xb2, 0x44, 0xd8,
-	0x06, 0x6e, 0xba, 0x60, 0x34, 0x04, 0x16, 0x19, 0x0a, 0x8c, 0x06, 0xc0, 0xd6, 0x60, 0xca, 0x83,
-	0xc2, 0xa4, 0xc2, 0xa1, 0xa2, 0xc3, 0x40, 0x01, 0x39, 0x34, 0xcb, 0x45, 0x52, 0xd9, 0xc0, 0x4d,
-	0xf9, 0x06, 0x4c, 0xe7, 0x6d, 0xbb, 0xcc, 0x9d, 0x2d, 0xcc, 0xce, 0x43, 0x5c, 0xb3, 0x6d, 0x95,
-	0x0e, 0x6f, 0xf0, 0xb8, 0xe6, 0x41, 0xc9, 0xff, 0x8a, 0xc0, 0xf9, 0x65, 0xa7, 0x69, 0x33, 0xab,
-	0x8c, 0x1d, 0x37, 0xc0, 0xb7, 0xb5, 0x66, 0xdd, 0xd2, 0xf4, 0x56, 0x40, 0xbd, 0x0a, 0x51, 0x43,
-	0xa7, 0x02, 0x7c, 0x21, 0x0c, 0x5e, 0x24, 0xfa, 0x0a, 0x4f, 0x8b, 0x52, 0x3b, 0xf8, 0x03, 0xb7,
-	0xb8, 0x47, 0xd1, 0x26, 0x4c, 0x8b, 0x13, 0x6a, 0x03, 0x3b, 0x6e, 0xe8, 0x71, 0x3a, 0x13, 0xb9,
-	0x54, 0x18, 0x6d, 0x33, 0xbf, 0xfc, 0x86, 0x27, 0xc1, 0x31, 0xde, 0xe7, 0x18, 0x09, 0x21, 0x20,
-	0x76, 0x90, 0x0c, 0xe3, 0xb6, 0xa7, 0xa2, 0x08, 0xac, 0x56, 0x68, 0x47, 0x94, 0xd6, 0x06, 0x7a,
-	0x1d, 0x12, 0xb6, 0x63, 0x35, 0x0c, 0xf7, 0x00, 0x76, 0xdc, 0x2c, 0x88, 0xcd, 0x4b, 0x97, 0xe3,
-	0x85, 0xc5, 0x07, 0x85, 0x4b, 0xce, 0x73, 0xc9, 0x85, 0xdc, 0x33, 0x77, 0xdf, 0xd2, 0xd2, 0xef,
-	0xbc, 0x98, 0xbe, 0x7a, 0xe7, 0xf2, 0xb5, 0xa5, 0xb7, 0xd2, 0x77, 0xae, 0xb5, 0x1e, 0xbf, 0xf0,
-	0x6e, 0xee, 0x85, 0x7b, 0x0b, 0xdf, 0xba, 0xbb, 0xa0, 0x4c, 0x05, 0x10, 0x4a, 0x3a, 0x5a, 0x81,
-	0xb3, 0xfe, 0x0b, 0x83, 0xd4, 0x54, 0x5d, 0x63, 0x5a, 0x72, 0x94, 0xb3, 0xf2, 0x74, 0xc6, 0x2b,
-	0x27, 0x99, 0x56, 0x39, 0xc9, 0x94, 0x79, 0x39, 0x51, 0x66, 0x82, 0x27, 0x56, 0x34, 0xa6, 0xc9,
-	0x2f, 0xc3, 0x85, 0xde, 0x64, 0x0b, 0x87, 0x26, 0xdb, 0xc6, 0xf1, 0xbc, 0xf5, 0x4d, 0x92, 0x7f,
-	0x11, 0x81, 0x27, 0xd6, 0x2d, 0x83, 0xe4, 0x2b, 0x15, 0x6c, 0xb3, 0xcd, 0xd2, 0x72, 0xcb, 0x41,
-	0x77, 0x61, 0x5a, 0xc8, 0xa8, 0x8e, 0xf7, 0x4a, 0x38, 0xeb, 0xf9, 0x30, 0xbd, 0x7d, 0xdc, 0x1c,
-	0xf0, 0x59, 0xc2, 0xee, 0x0c, 0x80, 0x9b, 0x70, 0x96, 0x67, 0xb2, 0x00, 0x57, 0xdd, 0xa4, 0x14,
-	0x0e, 0x9c, 0x0b, 0xdf, 0xe0, 0x2a, 0x28, 0xce, 0xdd, 0x68, 0x8a, 0x78, 0xf3, 0xbc, 0x38, 0xbd,
-	0xd7, 0xb9, 0x85, 0xee, 0x40, 0xdc, 0x2d, 0x3c, 0xc4, 0x22, 0x15, 0x2c, 0x1c, 0xf9, 0xaa, 0xa8,
-	0x10, 0x2f, 0x0f, 0x5b, 0x21, 0x56, 0x70, 0x63, 0xcb, 0xc5, 0x51, 0x26, 0x74, 0xb1, 0x92, 0xff,
-	0x16, 0x83, 0xe4, 0x0a, 0x76, 0x8c, 0x06, 0x6e, 0xd7, 0x48, 0xfa, 0x99, 0x8d, 0xe9, 0x6f, 0x00,
-	0x70, 0x8e, 0x83, 0x6c, 0xe4, 0x05, 0x1b, 0x57, 0x87, 0x65, 0xc3, 0x75, 0x82, 0x47, 0x47, 0x7c,
-	0xaf, 0xb5, 0xec, 0xa4, 0x3b, 0x76, 0xd2, 0x74, 0xa3, 0x1b, 0x30, 0x46, 0x30, 0x73, 0x13, 0x6d,
-	0xf4, 0x78, 0xc5, 0x7e, 0x0b, 0xb3, 0xd2, 0x8a, 0x32, 0x4a, 0x30, 0x2b, 0xf5, 0x4a, 0xe3, 0xb1,
-	0x53, 0x49, 0xe3, 0xf1, 0x61, 0xd3, 0xf8, 0xdf, 0x12, 0xa0, 0x55, 0xcc, 0x14, 0xcb, 0x62, 0x27,
-	0x1b, 0x57, 0xdd, 0x16, 0x47, 0x4e, 0xc5, 0xe2, 0xe8, 0xb0, 0x16, 0xff, 0x6f, 0x1c, 0x52, 0xdb,
-	0xad, 0x97, 0xbe, 0x25, 0xbe, 0xe5, 0xb7, 0x60, 0x5a, 0xb3, 0xed, 0xba, 0x51, 0xe1, 0x5d, 0x9a,
-	0xda, 0x66, 0xe1, 0xf3, 0x61, 0x16, 0xf2, 0x6d, 0xb1, 0xde, 0x3c, 0x24, 0xb4, 0xa0, 0x04, 0x45,
-	0x5b, 0x47, 0x50, 0x72, 0xe9, 0x41, 0x61, 0xc1, 0x91, 0x93, 0x0b, 0xb9, 0xd9, 0xfe, 0x94, 0x84,
-	0xf9, 0x78, 0xfe, 0x28, 0x3e, 0xce, 0x74, 0x9b, 0x8d, 0xb6, 0x21, 0x56, 0x37, 0x28, 0xe3, 0x19,
-	0x33, 0x99, 0x5b, 0x0a, 0x1b, 0x73, 0x34, 0x23, 0x99, 0x80, 0x71, 0xd7, 0x0d, 0xca, 0xd6, 0x46,
-	0x14, 0x8e, 0x84, 0xca, 0x30, 0xea, 0x68, 0xa4, 0x86, 0xc5, 0xb7, 0xe3, 0x95, 0x47, 0x83, 0x54,
-	0x5c, 0x88, 0xb5, 0x11, 0xc5, 0xc3, 0x72, 0xb3, 0xbb, 0xea, 0x58, 0xfb, 0x9e, 0x2d, 0x63, 0x1c,
-	0xf8, 0x6b, 0x8f, 0x06, 0xfc, 0x9a, 0x63, 0xed, 0xbb, 0x96, 0xaf, 0x8d, 0x28, 0x13, 0x55, 0xb1,
-	0x4e, 0xfd, 0x5a, 0x82, 0xe9, 0x90, 0x3d, 0xe8, 0x46, 0xa0, 0xc1, 0xf3, 0x5a, 0xcc, 0xab, 0xc7,
-	0x6f, 0xee, 0xd0, 0x3a, 0x24, 0xda, 0xad, 0x36, 0x0f, 0xa3, 0xc8, 0x7c, 0x74, 0xd0, 0x64, 0x52,
-	0xce, 0xe0, 0xf6, 0x5b, 0x9a, 0xfa, 0x93, 0x04, 0x33, 0x61, 0xca, 0x4e, 0x49, 0x6d, 0x0d, 0xa6,
-	0x28, 0xd3, 0x1c, 0xa6, 0x9e, 0x68, 0x2f, 0x3d, 0xc9, 0x31, 0x57, 0x78, 0x43, 0x9d, 0x32, 0xe1,
-	0x5c, 0x0f, 0x37, 0x9d, 0x8e, 0x3d, 0x4b, 0x91, 0xa4, 0x54, 0x98, 0x82, 0xc9, 0xb6, 0x2b, 0xa8,
-	0xfc, 0x83, 0x08, 0x5c, 0x0c, 0xe4, 0x6e, 0xbe, 0xc2, 0x8c, 0x06, 0x5f, 0x95, 0x31, 0x63, 0xee,
-	0x35, 0xe8, 0x39, 0x88, 0x9b, 0xd8, 0x54, 0xeb, 0xda, 0x0e, 0xae, 0x73, 0x5d, 0xe2, 0x3c, 0xab,
-	0x1d, 0x3e, 0x75, 0x4c, 0x98, 0xd8, 0xbc, 0xee, 0xee, 0xa0, 0x34, 0x44, 0x4d, 0x6c, 0x0e, 0xd0,
-	0x51, 0x2b, 0xae, 0x1c, 0xba, 0x05, 0x93, 0xbb, 0xd6, 0x3e, 0x56, 0xc5, 0xe7, 0x25, 0xfa, 0xe8,
-	0x36, 0x7a, 0x9f, 0x96, 0xb8, 0x8b, 0xb6, 0xc5, 0x3f, 0x2f, 0xaf, 0xc0, 0x93, 0xc1, 0xa2, 0xe5,
-	0x0d, 0xc0, 0xed, 0x66, 0x71, 0xfc, 0x41, 0x21, 0xe6, 0x44, 0x92, 0xba, 0x72, 0x2e, 0x20, 0x55,
-	0xe6, 0x42, 0x25, 0x5d, 0xfe, 0x9d, 0x04, 0x97, 0x56, 0x31, 0xeb, 0x4b, 0xc9, 0xa7, 0x50, 0x1d,
-	0xaf, 0x02, 0xb4, 0xa7, 0x5b, 0x41, 0x6a, 0xaa, 0xab, 0xac, 0xbf, 0xe6, 0x8a, 0x6c, 0x6a, 0xd4,
-	0x54, 0xe2, 0xd5, 0xd6, 0x52, 0xfe, 0x71, 0x04, 0x2e, 0x95, 0x1f, 0xbf, 0x05, 0x65, 0x98, 0xa0,
-	0xe2, 0x36, 0xa1, 0x7f, 0xba, 0x0f, 0x66, 0xb7, 0x8a, 0x01, 0x68, 0x1f, 0x28, 0x44, 0x4b, 0x74,
-	0x18, 0x5a, 0xbe, 0x2b, 0xc1, 0xe2, 0x0a, 0xae, 0x63, 0x86, 0x1f, 0x33, 0x33, 0xf2, 0xb7, 0x25,
-	0x98, 0x72, 0x9b, 0xb9, 0xe2, 0xcd, 0xd2, 0xb6, 0x83, 0xab, 0xc6, 0xdb, 0x1d, 0x53, 0xb5, 0x74,
-	0x92, 0x53, 0x35, 0x7a, 0x0a, 0xc6, 0xea, 0x98, 0xd4, 0xd8, 0x2e, 0xf7, 0xc1, 0x94, 0x22, 0x9e,
-	0xe4, 0xeb, 0x30, 0xdd, 0xa1, 0x02, 0x76, 0xb9, 0x9d, 0xb0, 0xc5, 0x3a, 0x29, 0xf1, 0xea, 0x7c,
-	0xb1, 0xd7, 0x1c, 0xe0, 0x1f, 0x51, 0x7c, 0xf1, 0xdc, 0x4f, 0x25, 0x88, 0x6d, 0xd1, 0x75, 0x8a,
-	0x56, 0x01, 0xd6, 0x34, 0xa2, 0xd7, 0xb1, 0x2b, 0x89, 0xce, 0xf7, 0x99, 0x23, 0x52, 0x17, 0x7a,
-	0x6f, 0x8a, 0x81, 0x49, 0x81, 0xc9, 0x55, 0xcc, 0x5a, 0xff, 0x03, 0xd0, 0x33, 0x61, 0xe1, 0xae,
-	0x3f, 0x24, 0xa9, 0xf9, 0xb0, 0x48, 0xf8, 0x67, 0x42, 0xee, 0x4d, 0x88, 0xe5, 0x5d, 0x25, 0xb7,
-	0x01, 0xbc, 0x0c, 0x77, 0xb7, 0x07, 0x81, 0x9e, 0xeb, 0xe1, 0xf2, 0xe0, 0xbc, 0x9e, 0xbb, 0x05,
-	0xa3, 0x79, 0xdb, 0x3e, 0x15, 0xe8, 0x4f, 0x62, 0xf0, 0xc4, 0x96, 0xe7, 0xf6, 0x8e, 0x39, 0x0f,
-	0x99, 0x90, 0x08, 0xd0, 0xb9, 0x59, 0x5a, 0x46, 0xc3, 0x0c, 0x86, 0xa9, 0x17, 0x06, 0x13, 0x16,
-	0xee, 0xa8, 0x78, 0x11, 0xeb, 0x0f, 0xa9, 0x68, 0xa1, 0x97, 0xf7, 0xc2, 0x33, 0xec, 0x90, 0x97,
-	0x10, 0x38, 0x5b, 0x24, 0x15, 0x57, 0xa2, 0x0d, 0x76, 0x9a, 0x46, 0xd9, 0x70, 0x4e, 0xdc, 0xa7,
-	0xe0, 0xbd, 0x4f, 0xe5, 0xc6, 0xaf, 0x43, 0xc2, 0x1b, 0x5e, 0xfd, 0xc0, 0xbe, 0x1c, 0x3e, 0x7f,
-	0xd4, 0x70, 0xfb, 0xf0, 0xf8, 0x46, 0xd7, 0x21, 0xee, 0xe5, 0x8c, 0x1b, 0x7b, 0x72, 0x58, 0xbc,
-	0x7b, 0xae, 0x49, 0xf5, 0xfb, 0x4a, 0xe7, 0xfe, 0x20, 0x41, 0x32, 0x50, 0xda, 0x3a, 0x83, 0xef,
-	0x36, 0x4c, 0x79, 0x8a, 0xb6, 0x42, 0x7d, 0x70, 0x3b, 0x1e, 0x16, 0xf1, 0xc2, 0x8c, 0xbc, 0x6d,
-	0x9f, 0x88, 0x19, 0x1f, 0x8c, 0xc1, 0xb9, 0x75, 0xea, 0xb7, 0x95, 0x0a, 0xae, 0x19, 0x94, 0x39,
-	0x4d, 0xf4, 0x2b, 0x09, 0xa2, 0xab, 0x98, 0xa1, 0x67, 0x7b, 0x5c, 0x10, 0x90, 0xf6, 0x6e, 0xf8,
-	0xdc, 0x91, 0x6d, 0xaa, 0x6c, 0xbe, 0xff, 0xe7, 0x7f, 0xfc, 0x28, 0x82, 0x51, 0x25, 0xbb, 0x47,
-	0xb3, 0x81, 0x42, 0x4f, 0xb3, 0xef, 0x76, 0x76, 0xbc, 0x99, 0xd0, 0xe7, 0x24, 0xf4, 0x7c, 0x2f,
-	0x2b, 0x3a, 0xb2, 0xae, 0x73, 0xfe, 0xf2, 0x1e, 0xfa, 0x4e, 0x04, 0xa2, 0xe5, 0x5e, 0x4a, 0x97,
-	0x87, 0x53, 0xfa, 0xb7, 0x12, 0xd7, 0xfa, 0x37, 0x52, 0xaa, 0xaf, 0xda, 0x99, 0x47, 0x54, 0x3b,
-	0xd3, 0xa9, 0xf6, 0x92, 0xb4, 0x78, 0x7b, 0x53, 0x5e, 0x3b, 0xa9, 0x9b, 0x96, 0xa4, 0x45, 0xf4,
-	0x33, 0x09, 0xe2, 0xfe, 0xe0, 0x83, 0x16, 0x07, 0x9f, 0x89, 0xfa, 0xb1, 0xf2, 0x3a, 0x27, 0x65,
-	0x2d, 0xb5, 0xdc, 0xad, 0xe9, 0xc3, 0x54, 0xf3, 0x07, 0xcc, 0x74, 0x5b, 0xc9, 0xef, 0x45, 0xa4,
-	0x17, 0x25, 0xf4, 0x81, 0x04, 0x63, 0x5e, 0xdb, 0x81, 0x06, 0x1a, 0x76, 0x52, 0x4f, 0x75, 0xb5,
-	0x33, 0xc5, 0x7d, 0x9b, 0x35, 0xe5, 0x4d, 0xae, 0xdd, 0xea, 0x62, 0x71, 0x78, 0xed, 0x7c, 0x17,
-	0xb5, 0x7d, 0x92, 0xfb, 0x30, 0x06, 0x0b, 0xfd, 0x1a, 0x21, 0x3f, 0x51, 0x7e, 0x29, 0x12, 0xe5,
-	0xa5, 0x1e, 0x89, 0x32, 0x48, 0x27, 0x95, 0x1a, 0xae, 0xed, 0x93, 0x0b, 0xdc, 0xce, 0xaf, 0xa0,
-	0xa5, 0xe1, 0xed, 0xf4, 0xdb, 0xc4, 0x0f, 0x25, 0x2f, 0x4f, 0x5e, 0xea, 0x91, 0x27, 0xa7, 0xa1,
-	0x73, 0x91, 0xeb, 0x7c, 0x4d, 0x3e, 0x86, 0xce, 0x6e, 0x54, 0xff, 0xa4, 0x1d, 0x2b, 0x4b, 0xdd,
-	0xf5, 0x74, 0xd0, 0xd6, 0xf5, 0xc8, 0x08, 0x12, 0xcc, 0x2e, 0x1e, 0x43, 0xcb, 0x9c, 0x03, 0x91,
-	0x75, 0x8a, 0xea, 0xfc, 0x37, 0x59, 0xb8, 0x81, 0x3c, 0xe2, 0xde, 0xd4, 0x5c, 0xdf, 0x36, 0x12,
-	0x53, 0xf9, 0x22, 0x57, 0xec, 0x69, 0xf4, 0xa4, 0xab, 0x58, 0xab, 0x11, 0x56, 0x5b, 0xdd, 0x65,
-	0x61, 0xf3, 0x2f, 0x7f, 0x9f, 0x1d, 0x79, 0xef, 0xfe, 0xac, 0xf4, 0xf3, 0xfb, 0xb3, 0xd2, 0x3f,
-	0xef, 0xcf, 0x8e, 0xfc, 0xe7, 0xfe, 0xac, 0xf4, 0xc3, 0x8f, 0x67, 0x47, 0x7e, 0xff, 0xf1, 0xac,
-	0x74, 0x3b, 0x3b, 0x44, 0x87, 0xcc, 0x88, 0xbd, 0xb3, 0x33, 0xc6, 0xd5, 0xbb, 0xf2, 0xff, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x51, 0xdd, 0x65, 0x37, 0x30, 0x1d, 0x00, 0x00,
+	// 2362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0x14, 0x25, 0x3e, 0x49, 0x94, 0x3c, 0x8a, 0x15, 0x86, 0xb6, 0x25, 0x79, 0xa3,
+	0x58, 0x12, 0x63, 0x92, 0x09, 0x9d, 0x3f, 0x2b, 0x6d, 0x65, 0xd2, 0xa2, 0x25, 0x4a, 0x96, 0xe2,
+	0x2c, 0xed, 0x20, 0x96, 0x7f, 0xd8, 0x35, 0x39, 0xa4, 0xd7, 0xa4, 0x76, 0xb7, 0x3b, 0x23, 0x3a,
+	0xb4, 0x2c, 0x20, 0x09, 0x8a, 0xa6, 0x08, 0xd0, 0xa2, 0x70, 0x9b, 0x7b, 0x81, 0x1e, 0xda, 0x43,
+	0x2f, 0x6d, 0x03, 0xf4, 0xd6, 0xf6, 0xd0, 0x43, 0x81, 0xa2, 0xa7, 0x5e, 0x8a, 0x16, 0x0d, 0x50,
+	0xa7, 0x87, 0x02, 0x3d, 0xb4, 0x97, 0xe6, 0xa0, 0x43, 0x51, 0xec, 0xec, 0x90, 0x5c, 0x2e, 0x97,
+	0xb4, 0x68, 0x4b, 0x4e, 0x6f, 0xc3, 0x9d, 0x37, 0xdf, 0xfb, 0x99, 0xf7, 0xde, 0xbc, 0xf7, 0x08,
+	0x62, 0x45, 0x33, 0xe4, 0xbb, 0xb2, 0x1a, 0x25, 0x54, 0xce, 0x97, 0xe3, 0xb2, 0xae, 0xc4, 0xef,
+	0x68, 0x8a, 0x4a, 0xb0, 0x51, 0xc5, 0x46, 0x4c, 0x37, 0x34, 0xaa, 0xa1, 0x20, 0xa5, 0x6a, 0x8c,
+	0xd3, 0xc5, 0xaa, 0x67, 0xc2, 0xc9, 0x92, 0x42, 0x6f, 0x6f, 0xdf, 0x8a, 0xe5, 0xb5, 0xad, 0x38,
+	0x56, 0xab, 0x5a, 0x4d, 0x37, 0xb4, 0xf7, 0x6a, 0x71, 0x46, 0x9c, 0x8f, 0x96, 0xb0, 0x1a, 0xad,
+	0xca, 0x15, 0xa5, 0x20, 0x53, 0x1c, 0x6f, 0x5b, 0x58, 0x90, 0xe1, 0xa8, 0x0d, 0xa2, 0xa4, 0x95,
+	0x34, 0xeb, 0xf0, 0xad, 0xed, 0x22, 0xfb, 0xc5, 0x7e, 0xb0, 0x15, 0x27, 0x3f, 0x5e, 0xd2, 0xb4,
+	0x52, 0x05, 0x33, 0xf1, 0x64, 0x55, 0xd5, 0xa8, 0x4c, 0x15, 0x4d, 0x25, 0x7c, 0xf7, 0x18, 0xdf,
+	0x6d, 0x60, 0xe0, 0x2d, 0x9d, 0xd6, 0xf8, 0xe6, 0xb4, 0x73, 0xb3, 0xa8, 0xe0, 0x4a, 0x21, 0xb7,
+	0x25, 0x93, 0xb2, 0x03, 0xbc, 0x41, 0x41, 0xa8, 0xb1, 0x9d, 0xa7, 0x7c, 0xd7, 0xc5, 0x40, 0x58,
+	0x2d, 0xe4, 0x0a, 0xb8, 0xaa, 0xe4, 0xeb, 0xda, 0x3c, 0xdf, 0x4e, 0xa3, 0x14, 0xb0, 0x4a, 0x95,
+	0xa2, 0x82, 0x8d, 0xba, 0x94, 0xc7, 0xdd, 0x2d, 0xdd, 0x79, 0xb7, 0x8c, 0x6b, 0xf5, 0xb3, 0x53,
+	0xed, 0xbb, 0xf5, 0xfb, 0x60, 0x04, 0xe2, 0x7f, 0x04, 0x38, 0x92, 0xc5, 0x84, 0x28, 0x9a, 0xba,
+	0x86, 0x6b, 0x12, 0xfe, 0xc6, 0x36, 0x26, 0x14, 0xc5, 0x20, 0x48, 0xac, 0x8f, 0xb9, 0x32, 0xae,
+	0xe5, 0x94, 0x42, 0x48, 0x98, 0x16, 0xe6, 0x86, 0x53, 0x83, 0x7b, 0xa9, 0xfe, 0x7b, 0xde, 0xd0,
+	0xfb, 0x63, 0xd2, 0x30, 0x69, 0x1c, 0xca, 0x14, 0xd0, 0x3b, 0x30, 0x50, 0xc0, 0xd5, 0x1c, 0xde,
+	0x56, 0x42, 0x1e, 0x46, 0xf8, 0xd5, 0xdf, 0x7d, 0x36, 0xd5, 0xf7, 0xe7, 0xcf, 0xa6, 0x5e, 0x2d,
+	0x69, 0x31, 0x7a, 0x1b, 0xd3, 0xdb, 0x8a, 0x5a, 0x22, 0x31, 0x15, 0xd3, 0xbb, 0x9a, 0x51, 0x8e,
+	0xb7, 0x0a, 0x55, 0x3d, 0x13, 0xd7, 0xcb, 0xa5, 0x38, 0xad, 0xe9, 0x98, 0xc4, 0xd2, 0x57, 0x32,
+	0xaf, 0xbd, 0x22, 0xf9, 0x0b, 0xb8, 0x9a, 0xde, 0x56, 0xd0, 0xbb, 0x30, 0x68, 0xaa, 0xca, 0x80,
+	0xbd, 0x07, 0x01, 0x3c, 0x60, 0xc2, 0xa5, 0xb7, 0x15, 0xf1, 0x0b, 0x01, 0xc6, 0x36, 0xee, 0x96,
+	0xb3, 0x6b, 0xb8, 0x46, 0x24, 0x4c, 0x74, 0x4d, 0x25, 0x18, 0xad, 0xc1, 0x68, 0x31, 0xa7, 0xde,
+	0x2d, 0xe7, 0x48, 0x4e, 0x51, 0xa9, 0xa9, 0x3a, 0xd3, 0x7b, 0x28, 0x71, 0x2c, 0xd6, 0xea, 0xc9,
+	0xb1, 0x35, 0x5c, 0x4b, 0xab, 0x55, 0x5c, 0xd1, 0x74, 0xcc, 0x8c, 0xf2, 0xb1, 0xe0, 0x19, 0x13,
+	0xa4, 0xa1, 0xa2, 0x09, 0x99, 0x51, 0xe9, 0x1a, 0xae, 0x99, 0x60, 0xc4, 0x01, 0xe6, 0xe9, 0x09,
+	0x8c, 0xd8, 0xc0, 0x56, 0x60, 0xc4, 0x82, 0xc2, 0x6a, 0x9e, 0x41, 0x79, 0x7b, 0x81, 0x02, 0xf5,
+	0x6e, 0x39, 0x9b, 0x56, 0xf3, 0x6b, 0xb8, 0x26, 0x5e, 0x86, 0xd1, 0xa4, 0xae, 0x67, 0xd9, 0x65,
+	0x73, 0xb5, 0x93, 0x10, 0x90, 0x75, 0x3d, 0x47, 0x7a, 0x57, 0x78, 0x40, 0xb6, 0xa0, 0xc4, 0x7f,
+	0x7a, 0xe0, 0xd8, 0x79, 0xa3, 0xa6, 0x53, 0x2d, 0x8b, 0x0d, 0xd3, 0xc1, 0x2f, 0xc9, 0xb5, 0x8a,
+	0x26, 0x17, 0xea, 0x0e, 0x75, 0x0e, 0xbc, 0x4a, 0x81, 0x70, 0xf0, 0x19, 0x27, 0x78, 0x5a, 0x2d,
+	0x2c, 0xb1, 0xb0, 0xc8, 0x34, 0x9d, 0xdf, 0xc6, 0xc5, 0x3c, 0x8a, 0xd6, 0x61, 0x94, 0x9f, 0xc8,
+	0x55, 0xb1, 0x61, 0xba, 0x1e, 0x33, 0x67, 0x30, 0x11, 0x76, 0xa2, 0xad, 0x27, 0xcf, 0xbf, 0x63,
+	0x51, 0x30, 0x8c, 0x0f, 0x19, 0x46, 0x90, 0x13, 0xf0, 0x1d, 0x24, 0xc2, 0x80, 0x6e, 0x89, 0xc8,
+	0x1d, 0xab, 0xee, 0xda, 0x1e, 0xa9, 0xbe, 0x81, 0xde, 0x86, 0xa0, 0x6e, 0x68, 0x55, 0xc5, 0x3c,
+	0x80, 0x0d, 0x33, 0x0a, 0x7c, 0xd3, 0xc2, 0x5c, 0x20, 0x15, 0xd9, 0x4b, 0xcd, 0x1a, 0x2f, 0x84,
+	0x66, 0x12, 0x27, 0x6f, 0x5e, 0x93, 0xa3, 0xf7, 0x5e, 0x8a, 0x9e, 0xbd, 0x31, 0xb7, 0xb8, 0x70,
+	0x2d, 0x7a, 0x63, 0xb1, 0xfe, 0x73, 0x7e, 0x27, 0x71, 0x7a, 0x77, 0xe6, 0xfe, 0xcd, 0x19, 0x69,
+	0xc4, 0x86, 0x90, 0x29, 0xa0, 0x25, 0x38, 0xd2, 0xf8, 0xa0, 0xa8, 0xa5, 0x5c, 0x41, 0xa6, 0x72,
+	0xa8, 0x9f, 0x59, 0xe5, 0xd9, 0x98, 0x95, 0x4e, 0x62, 0xf5, 0x74, 0x12, 0xcb, 0xb2, 0x74, 0x22,
+	0x8d, 0xd9, 0x4f, 0x2c, 0xc9, 0x54, 0x16, 0xdf, 0x80, 0xe3, 0xee, 0xc6, 0xe6, 0x17, 0x1a, 0x6a,
+	0x2a, 0xc7, 0xe2, 0xb6, 0xa1, 0x92, 0xf8, 0x53, 0x0f, 0x3c, 0xb3, 0xaa, 0x29, 0x6a, 0x32, 0x9f,
+	0xc7, 0x3a, 0x5d, 0xcf, 0x9c, 0xaf, 0x5f, 0xd0, 0x4d, 0x18, 0xe5, 0x34, 0x39, 0xc3, 0xfa, 0xc4,
+	0x2f, 0xeb, 0x45, 0xa7, 0x79, 0xbb, 0x5c, 0xb3, 0xed, 0xce, 0x82, 0x7a, 0xab, 0x03, 0x5c, 0x81,
+	0x23, 0x2c, 0x92, 0x39, 0x78, 0xce, 0x0c, 0x4a, 0x7e, 0x81, 0x53, 0x4e, 0x0e, 0xa6, 0x80, 0xfc,
+	0xdc, 0xe5, 0x9a, 0x8e, 0x6d, 0xb7, 0x38, 0x7a, 0xa7, 0x75, 0x0b, 0xdd, 0x80, 0x80, 0x99, 0x78,
+	0x54, 0x4d, 0xcd, 0x63, 0x7e, 0x91, 0xe7, 0x78, 0x86, 0x78, 0xa3, 0xd7, 0x0c, 0xb1, 0x84, 0xab,
+	0x1b, 0x26, 0x8e, 0x34, 0x58, 0xe0, 0x2b, 0xf1, 0x2f, 0x3e, 0x08, 0x2d, 0x61, 0x43, 0xa9, 0xe2,
+	0x66, 0x8e, 0x24, 0xff, 0xb7, 0x3e, 0xfd, 0x75, 0x00, 0x66, 0x63, 0xbb, 0x35, 0x92, 0xdc, 0x1a,
+	0x67, 0x7b, 0xb5, 0x86, 0x79, 0x09, 0x96, 0x39, 0x02, 0x77, 0xea, 0xcb, 0x56, 0x73, 0xfb, 0x0e,
+	0xda, 0xdc, 0xe8, 0x32, 0xf8, 0x55, 0x4c, 0xcd, 0x40, 0xeb, 0x7f, 0xb2, 0x64, 0xbf, 0x81, 0x69,
+	0x66, 0x49, 0xea, 0x57, 0x31, 0xcd, 0xb8, 0x85, 0xb1, 0xff, 0x50, 0xc2, 0x78, 0xa0, 0xd7, 0x30,
+	0xfe, 0x97, 0x00, 0x68, 0x19, 0x53, 0x49, 0xd3, 0xe8, 0xc1, 0xfa, 0x55, 0xbb, 0xc6, 0x9e, 0x43,
+	0xd1, 0xd8, 0xdb, 0xab, 0xc6, 0xff, 0x1d, 0x80, 0xf0, 0xa5, 0xfa, 0xc7, 0x86, 0x26, 0x0d, 0xcd,
+	0xaf, 0xc2, 0xa8, 0xac, 0xeb, 0x15, 0x25, 0xcf, 0xaa, 0xb4, 0x5c, 0xd3, 0x0a, 0xa7, 0x9c, 0x56,
+	0x48, 0x36, 0xc9, 0xdc, 0xed, 0x10, 0x94, 0xed, 0x14, 0x04, 0x6d, 0x74, 0x30, 0xc9, 0xec, 0x5e,
+	0x6a, 0xc6, 0x10, 0x43, 0x33, 0x89, 0xc9, 0xee, 0x26, 0x71, 0xda, 0xe3, 0xc5, 0x4e, 0xf6, 0x18,
+	0x6e, 0x57, 0x1b, 0x5d, 0x02, 0x5f, 0x45, 0x21, 0x94, 0x45, 0xcc, 0x50, 0x62, 0xc1, 0xa9, 0x4c,
+	0x67, 0x8b, 0xc4, 0x6c, 0xca, 0x5d, 0x54, 0x08, 0x5d, 0xe9, 0x93, 0x18, 0x12, 0xca, 0x42, 0xbf,
+	0x21, 0xab, 0x25, 0xcc, 0xdf, 0x8e, 0x37, 0x1f, 0x0f, 0x52, 0x32, 0x21, 0x56, 0xfa, 0x24, 0x0b,
+	0xcb, 0x8c, 0xee, 0xa2, 0xa1, 0x6d, 0x59, 0xba, 0xf8, 0x19, 0xf0, 0xd7, 0x1e, 0x0f, 0xf8, 0x82,
+	0xa1, 0x6d, 0x99, 0x9a, 0xaf, 0xf4, 0x49, 0x83, 0x45, 0xbe, 0x0e, 0xff, 0x42, 0x80, 0x51, 0x87,
+	0x3e, 0xe8, 0xb2, 0xad, 0xc0, 0xb3, 0x4a, 0xcc, 0xb3, 0x4f, 0x5e, 0xdc, 0xa1, 0x55, 0x08, 0x36,
+	0x4b, 0x6d, 0xe6, 0x46, 0x9e, 0x69, 0xef, 0x7e, 0x83, 0x49, 0x1a, 0xc6, 0xcd, 0xaf, 0x24, 0xfc,
+	0x7b, 0x01, 0xc6, 0x9c, 0x26, 0x3b, 0x24, 0xb1, 0x65, 0x18, 0x21, 0x54, 0x36, 0x68, 0xee, 0x40,
+	0x6b, 0xe9, 0x21, 0x86, 0xb9, 0xc4, 0x0a, 0xea, 0x70, 0x19, 0xc6, 0x5d, 0xae, 0xe9, 0x70, 0xf4,
+	0x59, 0xf0, 0x84, 0x84, 0xd4, 0x08, 0x0c, 0x35, 0xaf, 0x82, 0x88, 0xdf, 0xf1, 0xc0, 0x09, 0x5b,
+	0xec, 0x26, 0xf3, 0x54, 0xa9, 0xb2, 0x55, 0x16, 0x53, 0x6a, 0xb2, 0x41, 0x2f, 0x40, 0xa0, 0x8c,
+	0xcb, 0xb9, 0x8a, 0x7c, 0x0b, 0x57, 0x98, 0x2c, 0x01, 0x16, 0xd5, 0x06, 0xeb, 0x3a, 0x06, 0xcb,
+	0xb8, 0x7c, 0xd1, 0xdc, 0x41, 0x51, 0xf0, 0x96, 0x71, 0x79, 0x1f, 0x15, 0xb5, 0x64, 0xd2, 0xa1,
+	0xab, 0x30, 0x74, 0x5b, 0xdb, 0xc2, 0x39, 0xfe, 0xbc, 0x78, 0x1f, 0x5f, 0x47, 0xeb, 0x69, 0x09,
+	0x98, 0x68, 0x1b, 0xec, 0x79, 0x79, 0x13, 0x8e, 0xda, 0x93, 0x96, 0xd5, 0x00, 0x37, 0x8b, 0xc5,
+	0x81, 0xbd, 0x94, 0xcf, 0xf0, 0x84, 0x0a, 0xd2, 0xb8, 0x8d, 0x2a, 0xcb, 0x88, 0x32, 0x05, 0xf1,
+	0xd7, 0x02, 0xcc, 0x2e, 0x63, 0xda, 0xd5, 0x24, 0x4f, 0x21, 0x3b, 0x9e, 0x05, 0x68, 0x76, 0xb7,
+	0xdc, 0xa8, 0xe1, 0xb6, 0xb4, 0x7e, 0xc1, 0x24, 0x59, 0x97, 0x49, 0x59, 0x0a, 0x14, 0xeb, 0x4b,
+	0xf1, 0x07, 0x1e, 0x98, 0xcd, 0x7e, 0xf9, 0x1a, 0x64, 0x61, 0x90, 0x70, 0x6e, 0x5c, 0xfe, 0x68,
+	0x17, 0xcc, 0x76, 0x11, 0x6d, 0xd0, 0x0d, 0x20, 0x87, 0x59, 0xbc, 0xbd, 0x98, 0xe5, 0x23, 0x01,
+	0x22, 0x4b, 0xb8, 0x82, 0x29, 0xfe, 0x92, 0x2d, 0x23, 0x7e, 0x20, 0xc0, 0x88, 0x59, 0xcc, 0xa5,
+	0xaf, 0x64, 0x2e, 0x19, 0xb8, 0xa8, 0xbc, 0xd7, 0xd2, 0x55, 0x0b, 0x07, 0xd9, 0x55, 0xa3, 0x09,
+	0xf0, 0x57, 0xb0, 0x5a, 0xa2, 0xb7, 0xd9, 0x1d, 0x8c, 0x48, 0xfc, 0x97, 0x78, 0x11, 0x46, 0x5b,
+	0x44, 0xc0, 0xa6, 0x6d, 0x07, 0x75, 0xbe, 0x0e, 0x09, 0x2c, 0x3b, 0x9f, 0x70, 0xeb, 0x03, 0x1a,
+	0x47, 0xa4, 0x06, 0xb9, 0xf8, 0xd0, 0x07, 0xc3, 0x7c, 0xcf, 0x4a, 0xc7, 0x8b, 0xe0, 0xb7, 0x36,
+	0xb9, 0xd1, 0xba, 0x23, 0xd9, 0x6c, 0xc5, 0x8f, 0xa1, 0xb7, 0xda, 0xcd, 0xef, 0xe9, 0xc5, 0xfc,
+	0x6d, 0xee, 0x28, 0xc1, 0x98, 0x66, 0x94, 0x64, 0x55, 0xb9, 0xd7, 0x44, 0xb4, 0xfc, 0x67, 0xd6,
+	0x89, 0xf8, 0x96, 0x8d, 0xce, 0x0e, 0x39, 0xaa, 0xb5, 0x6c, 0x10, 0x73, 0xc8, 0x62, 0xa5, 0x2f,
+	0x12, 0xf2, 0x4d, 0x7b, 0x9f, 0xbc, 0x3c, 0xf6, 0xb3, 0xf2, 0x98, 0xa0, 0x3f, 0x08, 0x10, 0xe2,
+	0x87, 0xea, 0xd9, 0x4b, 0x2e, 0x14, 0x0c, 0x4c, 0x08, 0x26, 0xa1, 0xfe, 0x69, 0xef, 0x5c, 0x20,
+	0xf5, 0x40, 0xd8, 0x4b, 0x7d, 0x57, 0x78, 0x20, 0x7c, 0x2c, 0x88, 0x1f, 0x09, 0xc6, 0x37, 0x85,
+	0xc4, 0x07, 0xc2, 0xcd, 0xb9, 0xc5, 0x05, 0xb3, 0x52, 0x92, 0xa3, 0xf7, 0x92, 0xd1, 0x4d, 0xb3,
+	0x50, 0xba, 0x6f, 0x5b, 0x37, 0x97, 0xd7, 0xa3, 0x37, 0x22, 0xb6, 0x8d, 0xf9, 0xeb, 0xb1, 0xf9,
+	0x88, 0x79, 0x2e, 0x19, 0xdd, 0xe4, 0x05, 0xd6, 0x7d, 0xdb, 0xba, 0xb9, 0x64, 0xe7, 0x9a, 0x1b,
+	0xf3, 0x73, 0x8b, 0x0b, 0x0b, 0xd7, 0xcc, 0xd5, 0xce, 0xcb, 0xa7, 0x5f, 0xdd, 0x9d, 0x5f, 0x9c,
+	0x91, 0x26, 0xb8, 0xd0, 0x56, 0x32, 0x4d, 0xd6, 0x45, 0x46, 0x4b, 0x30, 0xe1, 0x9a, 0x90, 0x49,
+	0xc8, 0xcf, 0x94, 0x09, 0xee, 0xa5, 0x86, 0x1e, 0x08, 0x83, 0xa2, 0xdf, 0xf0, 0x8d, 0x09, 0xa1,
+	0x82, 0xf4, 0x8c, 0x4b, 0x62, 0x26, 0x62, 0x1a, 0x46, 0xec, 0x3e, 0x46, 0xd0, 0x2b, 0xe0, 0x67,
+	0x65, 0x52, 0xdd, 0x5d, 0x8f, 0x77, 0x70, 0x32, 0x46, 0x2e, 0x71, 0x5a, 0x51, 0x87, 0x89, 0x65,
+	0x4c, 0x5b, 0xb6, 0x78, 0xc8, 0x9f, 0x71, 0x44, 0x61, 0x20, 0x15, 0xda, 0x4b, 0x1d, 0x35, 0xc6,
+	0x13, 0x47, 0x6e, 0x9a, 0x7a, 0x26, 0xa3, 0x17, 0xe4, 0x68, 0xf1, 0xc6, 0xce, 0xcb, 0xaf, 0xed,
+	0xce, 0x34, 0x03, 0xec, 0x64, 0x6b, 0x80, 0xa5, 0x02, 0x7b, 0x29, 0x7f, 0xc4, 0x17, 0x3a, 0x37,
+	0x27, 0x34, 0x62, 0xad, 0x08, 0x13, 0x59, 0x77, 0x8e, 0x17, 0x21, 0x58, 0xe7, 0x98, 0xb3, 0xaa,
+	0x47, 0x2b, 0x5c, 0xba, 0x6a, 0x62, 0x8b, 0x96, 0x61, 0x2e, 0x05, 0xfb, 0x2e, 0x12, 0x78, 0xce,
+	0x4a, 0x70, 0x4f, 0x51, 0xb9, 0xc4, 0x8f, 0x04, 0xf0, 0x6d, 0x90, 0x55, 0x82, 0x96, 0x01, 0x56,
+	0x64, 0xb5, 0x50, 0x61, 0xdc, 0xd1, 0xb1, 0x2e, 0x23, 0x84, 0xf0, 0x71, 0xf7, 0x4d, 0x3e, 0x2b,
+	0x91, 0x60, 0x68, 0x19, 0xd3, 0xfa, 0x28, 0x10, 0x9d, 0x74, 0x12, 0xb7, 0x0d, 0x47, 0xc3, 0xd3,
+	0x4e, 0x12, 0xe7, 0x1c, 0x31, 0xf1, 0x2e, 0xf8, 0x92, 0xa6, 0x90, 0x97, 0x00, 0xac, 0xc7, 0xdd,
+	0xdc, 0xde, 0x0f, 0xf4, 0x94, 0x4b, 0xba, 0xb1, 0x8f, 0xea, 0x12, 0x57, 0xa1, 0x3f, 0xa9, 0xeb,
+	0x87, 0x02, 0xfd, 0x85, 0x0f, 0x9e, 0xd9, 0xb0, 0x22, 0xaa, 0x65, 0xc4, 0x83, 0xca, 0x10, 0xb4,
+	0x99, 0x73, 0x3d, 0x73, 0x1e, 0xf5, 0x32, 0x13, 0x0a, 0x9f, 0xde, 0x1f, 0x31, 0xbf, 0x8e, 0x3c,
+	0x8c, 0xb4, 0xcc, 0xa7, 0xd0, 0x8c, 0xdb, 0xed, 0x39, 0xc7, 0x57, 0x3d, 0x32, 0x51, 0xe1, 0x48,
+	0x5a, 0xcd, 0x9b, 0x14, 0x4d, 0xb0, 0xc3, 0x54, 0x4a, 0x87, 0x71, 0xce, 0x4f, 0xc2, 0x77, 0x9e,
+	0x0a, 0xc7, 0xeb, 0x10, 0xb4, 0xe6, 0x56, 0x0d, 0xc7, 0x9e, 0x73, 0x9e, 0xef, 0x34, 0xd7, 0x7a,
+	0xb4, 0x7f, 0xa3, 0x8b, 0x10, 0xb0, 0x62, 0xc6, 0xf4, 0x3d, 0xd1, 0x49, 0xde, 0x3e, 0xd2, 0x08,
+	0x77, 0x2b, 0xd0, 0x13, 0xbf, 0x15, 0x20, 0x64, 0x7b, 0x56, 0x5b, 0x9d, 0x6f, 0x13, 0x46, 0x2c,
+	0x41, 0xeb, 0xae, 0xbe, 0x7f, 0x3d, 0x1e, 0xe5, 0xf1, 0x5c, 0x8d, 0xa4, 0xae, 0x1f, 0x88, 0x1a,
+	0x9f, 0xf8, 0x61, 0x7c, 0x95, 0x34, 0x3a, 0x4a, 0x09, 0x97, 0x14, 0x42, 0x8d, 0x1a, 0xfa, 0xb9,
+	0x00, 0xde, 0x65, 0x4c, 0xd1, 0xf3, 0x2e, 0x0c, 0x6c, 0xd4, 0x16, 0x87, 0xe7, 0x3a, 0x76, 0xa8,
+	0x62, 0xf9, 0xc3, 0x3f, 0xfe, 0xfd, 0xfb, 0x1e, 0x8c, 0xf2, 0xf1, 0x3b, 0x24, 0x6e, 0x7b, 0xb2,
+	0x48, 0x7c, 0xa7, 0xb5, 0xd9, 0x8d, 0x39, 0x4a, 0x19, 0xc7, 0xef, 0xdd, 0xb8, 0x45, 0xda, 0x7e,
+	0xae, 0xb1, 0xdc, 0x45, 0xdf, 0xf2, 0x80, 0x37, 0xeb, 0x26, 0x74, 0xb6, 0x37, 0xa1, 0x7f, 0x25,
+	0x30, 0xa9, 0x7f, 0x29, 0x84, 0xbb, 0x8a, 0x1d, 0x7b, 0x4c, 0xb1, 0x63, 0xad, 0x62, 0x2f, 0x08,
+	0x91, 0xcd, 0x75, 0x71, 0xe5, 0xa0, 0x38, 0x2d, 0x08, 0x11, 0xf4, 0x63, 0x01, 0x02, 0x8d, 0x99,
+	0x07, 0x8a, 0xec, 0x7f, 0x1c, 0xd2, 0xcd, 0x2a, 0x6f, 0x33, 0xa3, 0xac, 0x84, 0xcf, 0xb7, 0x4b,
+	0xfa, 0x28, 0xd1, 0x1a, 0xb3, 0xa5, 0x68, 0x53, 0xc8, 0x6f, 0x7b, 0x84, 0x97, 0x04, 0xf4, 0x89,
+	0x00, 0x7e, 0xeb, 0x41, 0x46, 0xfb, 0x9a, 0x73, 0x84, 0x27, 0xda, 0x3a, 0x99, 0xb4, 0xf9, 0xf7,
+	0xa7, 0xb8, 0xce, 0xa4, 0x5b, 0x8e, 0xa4, 0x7b, 0x97, 0xae, 0x71, 0x45, 0xcd, 0x3b, 0x49, 0x7c,
+	0xea, 0x83, 0x99, 0x6e, 0x3d, 0x50, 0x23, 0x50, 0x7e, 0xc6, 0x03, 0xe5, 0x75, 0x97, 0x40, 0xd9,
+	0x4f, 0x13, 0x15, 0xee, 0xad, 0xe3, 0x13, 0x53, 0x4c, 0xcf, 0xaf, 0xa0, 0x85, 0xde, 0xf5, 0x6c,
+	0x74, 0x88, 0x9f, 0x0a, 0x56, 0x9c, 0xbc, 0xee, 0x12, 0x27, 0x87, 0x21, 0x73, 0x9a, 0xc9, 0xbc,
+	0x28, 0x3e, 0x81, 0xcc, 0xa6, 0x57, 0xff, 0xb0, 0xe9, 0x2b, 0x0b, 0xed, 0xf9, 0x74, 0xbf, 0x5d,
+	0x6b, 0x47, 0x0f, 0xe2, 0x96, 0x8d, 0x3c, 0x81, 0x94, 0x09, 0x03, 0x3c, 0xab, 0x04, 0x55, 0xd8,
+	0x84, 0xdc, 0xd9, 0x3b, 0x76, 0xe0, 0x1b, 0x9e, 0xea, 0xda, 0xf7, 0x61, 0x22, 0x9e, 0x60, 0x82,
+	0x3d, 0x8b, 0x8e, 0x9a, 0x82, 0x35, 0x6a, 0xe1, 0x7a, 0x63, 0x99, 0xf8, 0xab, 0x17, 0x26, 0x56,
+	0x49, 0x6b, 0x3d, 0xcb, 0x9d, 0x73, 0x13, 0x7c, 0x6c, 0x60, 0xd9, 0x89, 0xf5, 0x89, 0x6e, 0x35,
+	0x34, 0x11, 0x8f, 0x31, 0xc6, 0x47, 0xd1, 0x78, 0x0b, 0x63, 0xab, 0x47, 0x40, 0xf7, 0x2d, 0xbf,
+	0x3f, 0xe5, 0xe2, 0xf7, 0x2e, 0xb5, 0x75, 0xb8, 0x6b, 0xb9, 0x2e, 0xc6, 0x19, 0xa7, 0x79, 0x34,
+	0xeb, 0xc2, 0x29, 0xbe, 0x53, 0xff, 0xb0, 0x1b, 0xdf, 0xb1, 0x2a, 0xea, 0x5d, 0x54, 0xb6, 0x3c,
+	0xf8, 0x94, 0x8b, 0x07, 0xf7, 0xce, 0x7d, 0x92, 0x71, 0x0f, 0x85, 0xdd, 0xf4, 0x34, 0x1d, 0xef,
+	0x7e, 0xc3, 0xef, 0xe6, 0xdd, 0xfd, 0xce, 0x8d, 0x65, 0x27, 0x37, 0xe3, 0xaa, 0x46, 0xf6, 0xab,
+	0x6a, 0x6a, 0xfd, 0x4f, 0x7f, 0x9b, 0xec, 0x7b, 0xff, 0xe1, 0xa4, 0xf0, 0x93, 0x87, 0x93, 0xc2,
+	0x3f, 0x1e, 0x4e, 0xf6, 0xfd, 0xfb, 0xe1, 0xa4, 0xf0, 0xbd, 0xcf, 0x27, 0xfb, 0x7e, 0xf3, 0xf9,
+	0xa4, 0xb0, 0x19, 0xef, 0xa1, 0x8d, 0xa6, 0xaa, 0x7e, 0xeb, 0x96, 0x9f, 0xc9, 0x73, 0xe6, 0x7f,
+	0x03, 0x00, 0x3c, 0x38, 0xe1, 0xc5, 0x0b, 0x23, 0x00, 0x00,
 }
 
 func (this *SessionKeyRequest) Equal(that interface{}) bool {
@@ -1735,6 +2015,167 @@ func (this *JoinEUIPrefixes) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *JoinEUIRange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JoinEUIRange)
+	if !ok {
+		that2, ok := that.(JoinEUIRange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Prefix.Equal(that1.Prefix) {
+		return false
+	}
+	if !this.ApplicationIds.Equal(that1.ApplicationIds) {
+		return false
+	}
+	if !this.OrganizationIds.Equal(that1.OrganizationIds) {
+		return false
+	}
+	if len(this.NetIds) != len(that1.NetIds) {
+		return false
+	}
+	for i := range this.NetIds {
+		if !this.NetIds[i].Equal(that1.NetIds[i]) {
+			return false
+		}
+	}
+	if len(this.NetworkServerAddresses) != len(that1.NetworkServerAddresses) {
+		return false
+	}
+	for i := range this.NetworkServerAddresses {
+		if this.NetworkServerAddresses[i] != that1.NetworkServerAddresses[i] {
+			return false
+		}
+	}
+	if len(this.ApplicationServerIds) != len(that1.ApplicationServerIds) {
+		return false
+	}
+	for i := range this.ApplicationServerIds {
+		if this.ApplicationServerIds[i] != that1.ApplicationServerIds[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *JoinEUIRanges) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JoinEUIRanges)
+	if !ok {
+		that2, ok := that.(JoinEUIRanges)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Ranges) != len(that1.Ranges) {
+		return false
+	}
+	for i := range this.Ranges {
+		if !this.Ranges[i].Equal(that1.Ranges[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GetJoinEUIRangeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetJoinEUIRangeRequest)
+	if !ok {
+		that2, ok := that.(GetJoinEUIRangeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.JoinEui != that1.JoinEui {
+		return false
+	}
+	if this.Length != that1.Length {
+		return false
+	}
+	return true
+}
+func (this *SetJoinEUIRangeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetJoinEUIRangeRequest)
+	if !ok {
+		that2, ok := that.(SetJoinEUIRangeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.JoinEuiRange.Equal(that1.JoinEuiRange) {
+		return false
+	}
+	return true
+}
+func (this *DeleteJoinEUIRangeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteJoinEUIRangeRequest)
+	if !ok {
+		that2, ok := that.(DeleteJoinEUIRangeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.JoinEui != that1.JoinEui {
+		return false
+	}
+	if this.Length != that1.Length {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -2827,6 +3268,196 @@ var _Js_serviceDesc = grpc.ServiceDesc{
 	Metadata: "lorawan-stack/api/joinserver.proto",
 }
 
+// JsJoinEUIRangeRegistryClient is the client API for JsJoinEUIRangeRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type JsJoinEUIRangeRegistryClient interface {
+	// List returns all JoinEUI ranges.
+	List(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*JoinEUIRanges, error)
+	// Get returns the JoinEUI range with the given prefix.
+	Get(ctx context.Context, in *GetJoinEUIRangeRequest, opts ...grpc.CallOption) (*JoinEUIRange, error)
+	// Set creates or replaces the JoinEUI range with the prefix of the range.
+	// JoinEUI ranges may not overlap with other JoinEUI ranges.
+	Set(ctx context.Context, in *SetJoinEUIRangeRequest, opts ...grpc.CallOption) (*JoinEUIRange, error)
+	// Delete deletes the JoinEUI range with the given prefix.
+	Delete(ctx context.Context, in *DeleteJoinEUIRangeRequest, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type jsJoinEUIRangeRegistryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewJsJoinEUIRangeRegistryClient(cc *grpc.ClientConn) JsJoinEUIRangeRegistryClient {
+	return &jsJoinEUIRangeRegistryClient{cc}
+}
+
+func (c *jsJoinEUIRangeRegistryClient) List(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*JoinEUIRanges, error) {
+	out := new(JoinEUIRanges)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.JsJoinEUIRangeRegistry/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jsJoinEUIRangeRegistryClient) Get(ctx context.Context, in *GetJoinEUIRangeRequest, opts ...grpc.CallOption) (*JoinEUIRange, error) {
+	out := new(JoinEUIRange)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.JsJoinEUIRangeRegistry/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jsJoinEUIRangeRegistryClient) Set(ctx context.Context, in *SetJoinEUIRangeRequest, opts ...grpc.CallOption) (*JoinEUIRange, error) {
+	out := new(JoinEUIRange)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.JsJoinEUIRangeRegistry/Set", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jsJoinEUIRangeRegistryClient) Delete(ctx context.Context, in *DeleteJoinEUIRangeRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.JsJoinEUIRangeRegistry/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JsJoinEUIRangeRegistryServer is the server API for JsJoinEUIRangeRegistry service.
+type JsJoinEUIRangeRegistryServer interface {
+	// List returns all JoinEUI ranges.
+	List(context.Context, *types.Empty) (*JoinEUIRanges, error)
+	// Get returns the JoinEUI range with the given prefix.
+	Get(context.Context, *GetJoinEUIRangeRequest) (*JoinEUIRange, error)
+	// Set creates or replaces the JoinEUI range with the prefix of the range.
+	// JoinEUI ranges may not overlap with other JoinEUI ranges.
+	Set(context.Context, *SetJoinEUIRangeRequest) (*JoinEUIRange, error)
+	// Delete deletes the JoinEUI range with the given prefix.
+	Delete(context.Context, *DeleteJoinEUIRangeRequest) (*types.Empty, error)
+}
+
+// UnimplementedJsJoinEUIRangeRegistryServer can be embedded to have forward compatible implementations.
+type UnimplementedJsJoinEUIRangeRegistryServer struct {
+}
+
+func (*UnimplementedJsJoinEUIRangeRegistryServer) List(ctx context.Context, req *types.Empty) (*JoinEUIRanges, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedJsJoinEUIRangeRegistryServer) Get(ctx context.Context, req *GetJoinEUIRangeRequest) (*JoinEUIRange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedJsJoinEUIRangeRegistryServer) Set(ctx context.Context, req *SetJoinEUIRangeRequest) (*JoinEUIRange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (*UnimplementedJsJoinEUIRangeRegistryServer) Delete(ctx context.Context, req *DeleteJoinEUIRangeRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+
+func RegisterJsJoinEUIRangeRegistryServer(s *grpc.Server, srv JsJoinEUIRangeRegistryServer) {
+	s.RegisterService(&_JsJoinEUIRangeRegistry_serviceDesc, srv)
+}
+
+func _JsJoinEUIRangeRegistry_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsJoinEUIRangeRegistryServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.JsJoinEUIRangeRegistry/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsJoinEUIRangeRegistryServer).List(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JsJoinEUIRangeRegistry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJoinEUIRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsJoinEUIRangeRegistryServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.JsJoinEUIRangeRegistry/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsJoinEUIRangeRegistryServer).Get(ctx, req.(*GetJoinEUIRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JsJoinEUIRangeRegistry_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetJoinEUIRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsJoinEUIRangeRegistryServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.JsJoinEUIRangeRegistry/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsJoinEUIRangeRegistryServer).Set(ctx, req.(*SetJoinEUIRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JsJoinEUIRangeRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJoinEUIRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsJoinEUIRangeRegistryServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.JsJoinEUIRangeRegistry/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsJoinEUIRangeRegistryServer).Delete(ctx, req.(*DeleteJoinEUIRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _JsJoinEUIRangeRegistry_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.JsJoinEUIRangeRegistry",
+	HandlerType: (*JsJoinEUIRangeRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _JsJoinEUIRangeRegistry_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _JsJoinEUIRangeRegistry_Get_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _JsJoinEUIRangeRegistry_Set_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _JsJoinEUIRangeRegistry_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/joinserver.proto",
+}
+
 func (this *SessionKeyRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -3077,6 +3708,68 @@ func (this *JoinEUIPrefixes) String() string {
 	}, "")
 	return s
 }
+func (this *JoinEUIRange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JoinEUIRange{`,
+		`Prefix:` + strings.Replace(this.Prefix.String(), "JoinEUIPrefix", "JoinEUIPrefix", 1) + `,`,
+		`ApplicationIds:` + strings.Replace(fmt.Sprintf("%v", this.ApplicationIds), "ApplicationIdentifiers", "ApplicationIdentifiers", 1) + `,`,
+		`OrganizationIds:` + strings.Replace(fmt.Sprintf("%v", this.OrganizationIds), "OrganizationIdentifiers", "OrganizationIdentifiers", 1) + `,`,
+		`NetIds:` + fmt.Sprintf("%v", this.NetIds) + `,`,
+		`NetworkServerAddresses:` + fmt.Sprintf("%v", this.NetworkServerAddresses) + `,`,
+		`ApplicationServerIds:` + fmt.Sprintf("%v", this.ApplicationServerIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JoinEUIRanges) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRanges := "[]*JoinEUIRange{"
+	for _, f := range this.Ranges {
+		repeatedStringForRanges += strings.Replace(f.String(), "JoinEUIRange", "JoinEUIRange", 1) + ","
+	}
+	repeatedStringForRanges += "}"
+	s := strings.Join([]string{`&JoinEUIRanges{`,
+		`Ranges:` + repeatedStringForRanges + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetJoinEUIRangeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetJoinEUIRangeRequest{`,
+		`JoinEui:` + fmt.Sprintf("%v", this.JoinEui) + `,`,
+		`Length:` + fmt.Sprintf("%v", this.Length) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetJoinEUIRangeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetJoinEUIRangeRequest{`,
+		`JoinEuiRange:` + strings.Replace(this.JoinEuiRange.String(), "JoinEUIRange", "JoinEUIRange", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteJoinEUIRangeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteJoinEUIRangeRequest{`,
+		`JoinEui:` + fmt.Sprintf("%v", this.JoinEui) + `,`,
+		`Length:` + fmt.Sprintf("%v", this.Length) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringJoinserver(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
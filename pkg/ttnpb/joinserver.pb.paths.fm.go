@@ -226,6 +226,69 @@ var JoinEUIPrefixesFieldPathsNested = []string{
 var JoinEUIPrefixesFieldPathsTopLevel = []string{
 	"prefixes",
 }
+var JoinEUIRangeFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"application_server_ids",
+	"net_ids",
+	"network_server_addresses",
+	"organization_ids",
+	"organization_ids.organization_id",
+	"prefix",
+	"prefix.join_eui",
+	"prefix.length",
+}
+
+var JoinEUIRangeFieldPathsTopLevel = []string{
+	"application_ids",
+	"application_server_ids",
+	"net_ids",
+	"network_server_addresses",
+	"organization_ids",
+	"prefix",
+}
+var JoinEUIRangesFieldPathsNested = []string{
+	"ranges",
+}
+
+var JoinEUIRangesFieldPathsTopLevel = []string{
+	"ranges",
+}
+var GetJoinEUIRangeRequestFieldPathsNested = []string{
+	"join_eui",
+	"length",
+}
+
+var GetJoinEUIRangeRequestFieldPathsTopLevel = []string{
+	"join_eui",
+	"length",
+}
+var SetJoinEUIRangeRequestFieldPathsNested = []string{
+	"join_eui_range",
+	"join_eui_range.application_ids",
+	"join_eui_range.application_ids.application_id",
+	"join_eui_range.application_server_ids",
+	"join_eui_range.net_ids",
+	"join_eui_range.network_server_addresses",
+	"join_eui_range.organization_ids",
+	"join_eui_range.organization_ids.organization_id",
+	"join_eui_range.prefix",
+	"join_eui_range.prefix.join_eui",
+	"join_eui_range.prefix.length",
+}
+
+var SetJoinEUIRangeRequestFieldPathsTopLevel = []string{
+	"join_eui_range",
+}
+var DeleteJoinEUIRangeRequestFieldPathsNested = []string{
+	"join_eui",
+	"length",
+}
+
+var DeleteJoinEUIRangeRequestFieldPathsTopLevel = []string{
+	"join_eui",
+	"length",
+}
 var ProvisionEndDevicesRequest_IdentifiersListFieldPathsNested = []string{
 	"end_device_ids",
 	"join_eui",
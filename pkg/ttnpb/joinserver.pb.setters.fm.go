@@ -911,6 +911,237 @@ func (dst *JoinEUIPrefixes) SetFields(src *JoinEUIPrefixes, paths ...string) err
 	return nil
 }
 
+func (dst *JoinEUIRange) SetFields(src *JoinEUIRange, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "prefix":
+			if len(subs) > 0 {
+				var newDst, newSrc *JoinEUIPrefix
+				if (src == nil || src.Prefix == nil) && dst.Prefix == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Prefix
+				}
+				if dst.Prefix != nil {
+					newDst = dst.Prefix
+				} else {
+					newDst = &JoinEUIPrefix{}
+					dst.Prefix = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Prefix = src.Prefix
+				} else {
+					dst.Prefix = nil
+				}
+			}
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "organization_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *OrganizationIdentifiers
+				if (src == nil || src.OrganizationIds == nil) && dst.OrganizationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.OrganizationIds
+				}
+				if dst.OrganizationIds != nil {
+					newDst = dst.OrganizationIds
+				} else {
+					newDst = &OrganizationIdentifiers{}
+					dst.OrganizationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.OrganizationIds = src.OrganizationIds
+				} else {
+					dst.OrganizationIds = nil
+				}
+			}
+		case "net_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'net_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NetIds = src.NetIds
+			} else {
+				dst.NetIds = nil
+			}
+		case "network_server_addresses":
+			if len(subs) > 0 {
+				return fmt.Errorf("'network_server_addresses' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NetworkServerAddresses = src.NetworkServerAddresses
+			} else {
+				dst.NetworkServerAddresses = nil
+			}
+		case "application_server_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'application_server_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ApplicationServerIds = src.ApplicationServerIds
+			} else {
+				dst.ApplicationServerIds = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *JoinEUIRanges) SetFields(src *JoinEUIRanges, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ranges":
+			if len(subs) > 0 {
+				return fmt.Errorf("'ranges' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Ranges = src.Ranges
+			} else {
+				dst.Ranges = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetJoinEUIRangeRequest) SetFields(src *GetJoinEUIRangeRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "join_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinEui = src.JoinEui
+			} else {
+				var zero string
+				dst.JoinEui = zero
+			}
+		case "length":
+			if len(subs) > 0 {
+				return fmt.Errorf("'length' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Length = src.Length
+			} else {
+				var zero uint32
+				dst.Length = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SetJoinEUIRangeRequest) SetFields(src *SetJoinEUIRangeRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "join_eui_range":
+			if len(subs) > 0 {
+				var newDst, newSrc *JoinEUIRange
+				if (src == nil || src.JoinEuiRange == nil) && dst.JoinEuiRange == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.JoinEuiRange
+				}
+				if dst.JoinEuiRange != nil {
+					newDst = dst.JoinEuiRange
+				} else {
+					newDst = &JoinEUIRange{}
+					dst.JoinEuiRange = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.JoinEuiRange = src.JoinEuiRange
+				} else {
+					dst.JoinEuiRange = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DeleteJoinEUIRangeRequest) SetFields(src *DeleteJoinEUIRangeRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "join_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinEui = src.JoinEui
+			} else {
+				var zero string
+				dst.JoinEui = zero
+			}
+		case "length":
+			if len(subs) > 0 {
+				return fmt.Errorf("'length' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Length = src.Length
+			} else {
+				var zero uint32
+				dst.Length = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ProvisionEndDevicesRequest_IdentifiersList) SetFields(src *ProvisionEndDevicesRequest_IdentifiersList, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -1802,6 +1802,565 @@ var _ interface {
 	ErrorName() string
 } = JoinEUIPrefixesValidationError{}
 
+// ValidateFields checks the field values on JoinEUIRange with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *JoinEUIRange) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = JoinEUIRangeFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "prefix":
+
+			if m.GetPrefix() == nil {
+				return JoinEUIRangeValidationError{
+					field:  "prefix",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetPrefix()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return JoinEUIRangeValidationError{
+						field:  "prefix",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "application_ids":
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return JoinEUIRangeValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "organization_ids":
+
+			if v, ok := interface{}(m.GetOrganizationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return JoinEUIRangeValidationError{
+						field:  "organization_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "net_ids":
+			// no validation rules for NetIds
+		case "network_server_addresses":
+
+			for idx, item := range m.GetNetworkServerAddresses() {
+				_, _ = idx, item
+
+				if !_JoinEUIRange_NetworkServerAddresses_Pattern.MatchString(item) {
+					return JoinEUIRangeValidationError{
+						field:  fmt.Sprintf("network_server_addresses[%v]", idx),
+						reason: "value does not match regex pattern \"^(?:(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9\\\\-]*[a-zA-Z0-9])\\\\.)*(?:[A-Za-z0-9]|[A-Za-z0-9][A-Za-z0-9\\\\-]*[A-Za-z0-9])(?::[0-9]{1,5})?$\"",
+					}
+				}
+
+			}
+
+		case "application_server_ids":
+
+			for idx, item := range m.GetApplicationServerIds() {
+				_, _ = idx, item
+
+				if l := utf8.RuneCountInString(item); l < 1 || l > 100 {
+					return JoinEUIRangeValidationError{
+						field:  fmt.Sprintf("application_server_ids[%v]", idx),
+						reason: "value length must be between 1 and 100 runes, inclusive",
+					}
+				}
+
+			}
+
+		default:
+			return JoinEUIRangeValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// JoinEUIRangeValidationError is the validation error returned by
+// JoinEUIRange.ValidateFields if the designated constraints aren't met.
+type JoinEUIRangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JoinEUIRangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JoinEUIRangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JoinEUIRangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JoinEUIRangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JoinEUIRangeValidationError) ErrorName() string {
+	return "JoinEUIRangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JoinEUIRangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJoinEUIRange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JoinEUIRangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JoinEUIRangeValidationError{}
+
+var _JoinEUIRange_NetworkServerAddresses_Pattern = regexp.MustCompile("^(?:(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)*(?:[A-Za-z0-9]|[A-Za-z0-9][A-Za-z0-9\\-]*[A-Za-z0-9])(?::[0-9]{1,5})?$")
+
+// ValidateFields checks the field values on JoinEUIRanges with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *JoinEUIRanges) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = JoinEUIRangesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ranges":
+
+			for idx, item := range m.GetRanges() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return JoinEUIRangesValidationError{
+							field:  fmt.Sprintf("ranges[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return JoinEUIRangesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// JoinEUIRangesValidationError is the validation error returned by
+// JoinEUIRanges.ValidateFields if the designated constraints aren't met.
+type JoinEUIRangesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JoinEUIRangesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JoinEUIRangesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JoinEUIRangesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JoinEUIRangesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JoinEUIRangesValidationError) ErrorName() string {
+	return "JoinEUIRangesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JoinEUIRangesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJoinEUIRanges.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JoinEUIRangesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JoinEUIRangesValidationError{}
+
+// ValidateFields checks the field values on GetJoinEUIRangeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetJoinEUIRangeRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetJoinEUIRangeRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "join_eui":
+
+			if !_GetJoinEUIRangeRequest_JoinEui_Pattern.MatchString(m.GetJoinEui()) {
+				return GetJoinEUIRangeRequestValidationError{
+					field:  "join_eui",
+					reason: "value does not match regex pattern \"^[0-9A-Fa-f]{16}$\"",
+				}
+			}
+
+		case "length":
+
+			if val := m.GetLength(); val < 1 || val > 64 {
+				return GetJoinEUIRangeRequestValidationError{
+					field:  "length",
+					reason: "value must be inside range [1, 64]",
+				}
+			}
+
+		default:
+			return GetJoinEUIRangeRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetJoinEUIRangeRequestValidationError is the validation error returned by
+// GetJoinEUIRangeRequest.ValidateFields if the designated constraints aren't
+// met.
+type GetJoinEUIRangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJoinEUIRangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJoinEUIRangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJoinEUIRangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJoinEUIRangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJoinEUIRangeRequestValidationError) ErrorName() string {
+	return "GetJoinEUIRangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJoinEUIRangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJoinEUIRangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJoinEUIRangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJoinEUIRangeRequestValidationError{}
+
+var _GetJoinEUIRangeRequest_JoinEui_Pattern = regexp.MustCompile("^[0-9A-Fa-f]{16}$")
+
+// ValidateFields checks the field values on SetJoinEUIRangeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetJoinEUIRangeRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetJoinEUIRangeRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "join_eui_range":
+
+			if m.GetJoinEuiRange() == nil {
+				return SetJoinEUIRangeRequestValidationError{
+					field:  "join_eui_range",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetJoinEuiRange()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetJoinEUIRangeRequestValidationError{
+						field:  "join_eui_range",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return SetJoinEUIRangeRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetJoinEUIRangeRequestValidationError is the validation error returned by
+// SetJoinEUIRangeRequest.ValidateFields if the designated constraints aren't
+// met.
+type SetJoinEUIRangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetJoinEUIRangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetJoinEUIRangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetJoinEUIRangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetJoinEUIRangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetJoinEUIRangeRequestValidationError) ErrorName() string {
+	return "SetJoinEUIRangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetJoinEUIRangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetJoinEUIRangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetJoinEUIRangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetJoinEUIRangeRequestValidationError{}
+
+// ValidateFields checks the field values on DeleteJoinEUIRangeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteJoinEUIRangeRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DeleteJoinEUIRangeRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "join_eui":
+
+			if !_DeleteJoinEUIRangeRequest_JoinEui_Pattern.MatchString(m.GetJoinEui()) {
+				return DeleteJoinEUIRangeRequestValidationError{
+					field:  "join_eui",
+					reason: "value does not match regex pattern \"^[0-9A-Fa-f]{16}$\"",
+				}
+			}
+
+		case "length":
+
+			if val := m.GetLength(); val < 1 || val > 64 {
+				return DeleteJoinEUIRangeRequestValidationError{
+					field:  "length",
+					reason: "value must be inside range [1, 64]",
+				}
+			}
+
+		default:
+			return DeleteJoinEUIRangeRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteJoinEUIRangeRequestValidationError is the validation error returned by
+// DeleteJoinEUIRangeRequest.ValidateFields if the designated constraints
+// aren't met.
+type DeleteJoinEUIRangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJoinEUIRangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJoinEUIRangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJoinEUIRangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJoinEUIRangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJoinEUIRangeRequestValidationError) ErrorName() string {
+	return "DeleteJoinEUIRangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteJoinEUIRangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJoinEUIRangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJoinEUIRangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJoinEUIRangeRequestValidationError{}
+
+var _DeleteJoinEUIRangeRequest_JoinEui_Pattern = regexp.MustCompile("^[0-9A-Fa-f]{16}$")
+
 // ValidateFields checks the field values on
 // ProvisionEndDevicesRequest_IdentifiersList with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
@@ -60,3 +60,18 @@ func (req *SetApplicationActivationSettingsRequest) ValidateContext(context.Cont
 func (req *DeleteApplicationActivationSettingsRequest) ValidateContext(context.Context) error {
 	return req.ValidateFields()
 }
+
+// ValidateContext wraps the generated validator with (optionally context-based) custom checks.
+func (req *GetJoinEUIRangeRequest) ValidateContext(context.Context) error {
+	return req.ValidateFields()
+}
+
+// ValidateContext wraps the generated validator with (optionally context-based) custom checks.
+func (req *SetJoinEUIRangeRequest) ValidateContext(context.Context) error {
+	return req.ValidateFields()
+}
+
+// ValidateContext wraps the generated validator with (optionally context-based) custom checks.
+func (req *DeleteJoinEUIRangeRequest) ValidateContext(context.Context) error {
+	return req.ValidateFields()
+}
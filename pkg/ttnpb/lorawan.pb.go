@@ -414,27 +414,34 @@ func (TxSchedulePriority) EnumDescriptor() ([]byte, []int) {
 type MACCommandIdentifier int32
 
 const (
-	CID_RFU_0              MACCommandIdentifier = 0
-	CID_RESET              MACCommandIdentifier = 1
-	CID_LINK_CHECK         MACCommandIdentifier = 2
-	CID_LINK_ADR           MACCommandIdentifier = 3
-	CID_DUTY_CYCLE         MACCommandIdentifier = 4
-	CID_RX_PARAM_SETUP     MACCommandIdentifier = 5
-	CID_DEV_STATUS         MACCommandIdentifier = 6
-	CID_NEW_CHANNEL        MACCommandIdentifier = 7
-	CID_RX_TIMING_SETUP    MACCommandIdentifier = 8
-	CID_TX_PARAM_SETUP     MACCommandIdentifier = 9
-	CID_DL_CHANNEL         MACCommandIdentifier = 10
-	CID_REKEY              MACCommandIdentifier = 11
-	CID_ADR_PARAM_SETUP    MACCommandIdentifier = 12
-	CID_DEVICE_TIME        MACCommandIdentifier = 13
-	CID_FORCE_REJOIN       MACCommandIdentifier = 14
-	CID_REJOIN_PARAM_SETUP MACCommandIdentifier = 15
-	CID_PING_SLOT_INFO     MACCommandIdentifier = 16
-	CID_PING_SLOT_CHANNEL  MACCommandIdentifier = 17
-	CID_BEACON_TIMING      MACCommandIdentifier = 18
-	CID_BEACON_FREQ        MACCommandIdentifier = 19
-	CID_DEVICE_MODE        MACCommandIdentifier = 32
+	CID_RFU_0                       MACCommandIdentifier = 0
+	CID_RESET                       MACCommandIdentifier = 1
+	CID_LINK_CHECK                  MACCommandIdentifier = 2
+	CID_LINK_ADR                    MACCommandIdentifier = 3
+	CID_DUTY_CYCLE                  MACCommandIdentifier = 4
+	CID_RX_PARAM_SETUP              MACCommandIdentifier = 5
+	CID_DEV_STATUS                  MACCommandIdentifier = 6
+	CID_NEW_CHANNEL                 MACCommandIdentifier = 7
+	CID_RX_TIMING_SETUP             MACCommandIdentifier = 8
+	CID_TX_PARAM_SETUP              MACCommandIdentifier = 9
+	CID_DL_CHANNEL                  MACCommandIdentifier = 10
+	CID_REKEY                       MACCommandIdentifier = 11
+	CID_ADR_PARAM_SETUP             MACCommandIdentifier = 12
+	CID_DEVICE_TIME                 MACCommandIdentifier = 13
+	CID_FORCE_REJOIN                MACCommandIdentifier = 14
+	CID_REJOIN_PARAM_SETUP          MACCommandIdentifier = 15
+	CID_PING_SLOT_INFO              MACCommandIdentifier = 16
+	CID_PING_SLOT_CHANNEL           MACCommandIdentifier = 17
+	CID_BEACON_TIMING               MACCommandIdentifier = 18
+	CID_BEACON_FREQ                 MACCommandIdentifier = 19
+	CID_DEVICE_MODE                 MACCommandIdentifier = 32
+	CID_RELAY_CONF                  MACCommandIdentifier = 64
+	CID_RELAY_END_DEVICE_CONF       MACCommandIdentifier = 65
+	CID_RELAY_FILTER_LIST           MACCommandIdentifier = 66
+	CID_RELAY_UPDATE_UPLINK_LIST    MACCommandIdentifier = 67
+	CID_RELAY_CTRL_UPLINK_LIST      MACCommandIdentifier = 68
+	CID_RELAY_CONFIGURE_FWD_LIMIT   MACCommandIdentifier = 69
+	CID_RELAY_NOTIFY_NEW_END_DEVICE MACCommandIdentifier = 70
 )
 
 var MACCommandIdentifier_name = map[int32]string{
@@ -459,30 +466,44 @@ var MACCommandIdentifier_name = map[int32]string{
 	18: "CID_BEACON_TIMING",
 	19: "CID_BEACON_FREQ",
 	32: "CID_DEVICE_MODE",
+	64: "CID_RELAY_CONF",
+	65: "CID_RELAY_END_DEVICE_CONF",
+	66: "CID_RELAY_FILTER_LIST",
+	67: "CID_RELAY_UPDATE_UPLINK_LIST",
+	68: "CID_RELAY_CTRL_UPLINK_LIST",
+	69: "CID_RELAY_CONFIGURE_FWD_LIMIT",
+	70: "CID_RELAY_NOTIFY_NEW_END_DEVICE",
 }
 
 var MACCommandIdentifier_value = map[string]int32{
-	"CID_RFU_0":              0,
-	"CID_RESET":              1,
-	"CID_LINK_CHECK":         2,
-	"CID_LINK_ADR":           3,
-	"CID_DUTY_CYCLE":         4,
-	"CID_RX_PARAM_SETUP":     5,
-	"CID_DEV_STATUS":         6,
-	"CID_NEW_CHANNEL":        7,
-	"CID_RX_TIMING_SETUP":    8,
-	"CID_TX_PARAM_SETUP":     9,
-	"CID_DL_CHANNEL":         10,
-	"CID_REKEY":              11,
-	"CID_ADR_PARAM_SETUP":    12,
-	"CID_DEVICE_TIME":        13,
-	"CID_FORCE_REJOIN":       14,
-	"CID_REJOIN_PARAM_SETUP": 15,
-	"CID_PING_SLOT_INFO":     16,
-	"CID_PING_SLOT_CHANNEL":  17,
-	"CID_BEACON_TIMING":      18,
-	"CID_BEACON_FREQ":        19,
-	"CID_DEVICE_MODE":        32,
+	"CID_RFU_0":                       0,
+	"CID_RESET":                       1,
+	"CID_LINK_CHECK":                  2,
+	"CID_LINK_ADR":                    3,
+	"CID_DUTY_CYCLE":                  4,
+	"CID_RX_PARAM_SETUP":              5,
+	"CID_DEV_STATUS":                  6,
+	"CID_NEW_CHANNEL":                 7,
+	"CID_RX_TIMING_SETUP":             8,
+	"CID_TX_PARAM_SETUP":              9,
+	"CID_DL_CHANNEL":                  10,
+	"CID_REKEY":                       11,
+	"CID_ADR_PARAM_SETUP":             12,
+	"CID_DEVICE_TIME":                 13,
+	"CID_FORCE_REJOIN":                14,
+	"CID_REJOIN_PARAM_SETUP":          15,
+	"CID_PING_SLOT_INFO":              16,
+	"CID_PING_SLOT_CHANNEL":           17,
+	"CID_BEACON_TIMING":               18,
+	"CID_BEACON_FREQ":                 19,
+	"CID_DEVICE_MODE":                 32,
+	"CID_RELAY_CONF":                  64,
+	"CID_RELAY_END_DEVICE_CONF":       65,
+	"CID_RELAY_FILTER_LIST":           66,
+	"CID_RELAY_UPDATE_UPLINK_LIST":    67,
+	"CID_RELAY_CTRL_UPLINK_LIST":      68,
+	"CID_RELAY_CONFIGURE_FWD_LIMIT":   69,
+	"CID_RELAY_NOTIFY_NEW_END_DEVICE": 70,
 }
 
 func (MACCommandIdentifier) EnumDescriptor() ([]byte, []int) {
@@ -1071,6 +1092,171 @@ func (Minor) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2084d1d5a227b67e, []int{21}
 }
 
+type RelayCADPeriodicity int32
+
+const (
+	RELAY_CAD_PERIODICITY_1_SECOND         RelayCADPeriodicity = 0
+	RELAY_CAD_PERIODICITY_500_MILLISECONDS RelayCADPeriodicity = 1
+	RELAY_CAD_PERIODICITY_250_MILLISECONDS RelayCADPeriodicity = 2
+	RELAY_CAD_PERIODICITY_100_MILLISECONDS RelayCADPeriodicity = 3
+	RELAY_CAD_PERIODICITY_50_MILLISECONDS  RelayCADPeriodicity = 4
+	RELAY_CAD_PERIODICITY_20_MILLISECONDS  RelayCADPeriodicity = 5
+)
+
+var RelayCADPeriodicity_name = map[int32]string{
+	0: "RELAY_CAD_PERIODICITY_1_SECOND",
+	1: "RELAY_CAD_PERIODICITY_500_MILLISECONDS",
+	2: "RELAY_CAD_PERIODICITY_250_MILLISECONDS",
+	3: "RELAY_CAD_PERIODICITY_100_MILLISECONDS",
+	4: "RELAY_CAD_PERIODICITY_50_MILLISECONDS",
+	5: "RELAY_CAD_PERIODICITY_20_MILLISECONDS",
+}
+
+var RelayCADPeriodicity_value = map[string]int32{
+	"RELAY_CAD_PERIODICITY_1_SECOND":         0,
+	"RELAY_CAD_PERIODICITY_500_MILLISECONDS": 1,
+	"RELAY_CAD_PERIODICITY_250_MILLISECONDS": 2,
+	"RELAY_CAD_PERIODICITY_100_MILLISECONDS": 3,
+	"RELAY_CAD_PERIODICITY_50_MILLISECONDS":  4,
+	"RELAY_CAD_PERIODICITY_20_MILLISECONDS":  5,
+}
+
+func (RelayCADPeriodicity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{22}
+}
+
+type RelaySecondChAckOffset int32
+
+const (
+	RELAY_SECOND_CH_ACK_OFFSET_0    RelaySecondChAckOffset = 0
+	RELAY_SECOND_CH_ACK_OFFSET_200  RelaySecondChAckOffset = 1
+	RELAY_SECOND_CH_ACK_OFFSET_400  RelaySecondChAckOffset = 2
+	RELAY_SECOND_CH_ACK_OFFSET_800  RelaySecondChAckOffset = 3
+	RELAY_SECOND_CH_ACK_OFFSET_1600 RelaySecondChAckOffset = 4
+	RELAY_SECOND_CH_ACK_OFFSET_3200 RelaySecondChAckOffset = 5
+)
+
+var RelaySecondChAckOffset_name = map[int32]string{
+	0: "RELAY_SECOND_CH_ACK_OFFSET_0",
+	1: "RELAY_SECOND_CH_ACK_OFFSET_200",
+	2: "RELAY_SECOND_CH_ACK_OFFSET_400",
+	3: "RELAY_SECOND_CH_ACK_OFFSET_800",
+	4: "RELAY_SECOND_CH_ACK_OFFSET_1600",
+	5: "RELAY_SECOND_CH_ACK_OFFSET_3200",
+}
+
+var RelaySecondChAckOffset_value = map[string]int32{
+	"RELAY_SECOND_CH_ACK_OFFSET_0":    0,
+	"RELAY_SECOND_CH_ACK_OFFSET_200":  1,
+	"RELAY_SECOND_CH_ACK_OFFSET_400":  2,
+	"RELAY_SECOND_CH_ACK_OFFSET_800":  3,
+	"RELAY_SECOND_CH_ACK_OFFSET_1600": 4,
+	"RELAY_SECOND_CH_ACK_OFFSET_3200": 5,
+}
+
+func (RelaySecondChAckOffset) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{23}
+}
+
+type RelaySmartEnableLevel int32
+
+const (
+	RELAY_SMART_ENABLE_LEVEL_8  RelaySmartEnableLevel = 0
+	RELAY_SMART_ENABLE_LEVEL_16 RelaySmartEnableLevel = 1
+	RELAY_SMART_ENABLE_LEVEL_32 RelaySmartEnableLevel = 2
+	RELAY_SMART_ENABLE_LEVEL_64 RelaySmartEnableLevel = 3
+)
+
+var RelaySmartEnableLevel_name = map[int32]string{
+	0: "RELAY_SMART_ENABLE_LEVEL_8",
+	1: "RELAY_SMART_ENABLE_LEVEL_16",
+	2: "RELAY_SMART_ENABLE_LEVEL_32",
+	3: "RELAY_SMART_ENABLE_LEVEL_64",
+}
+
+var RelaySmartEnableLevel_value = map[string]int32{
+	"RELAY_SMART_ENABLE_LEVEL_8":  0,
+	"RELAY_SMART_ENABLE_LEVEL_16": 1,
+	"RELAY_SMART_ENABLE_LEVEL_32": 2,
+	"RELAY_SMART_ENABLE_LEVEL_64": 3,
+}
+
+func (RelaySmartEnableLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{24}
+}
+
+type RelayLimitBucketSize int32
+
+const (
+	RELAY_LIMIT_BUCKET_SIZE_1  RelayLimitBucketSize = 0
+	RELAY_LIMIT_BUCKET_SIZE_2  RelayLimitBucketSize = 1
+	RELAY_LIMIT_BUCKET_SIZE_4  RelayLimitBucketSize = 2
+	RELAY_LIMIT_BUCKET_SIZE_12 RelayLimitBucketSize = 3
+)
+
+var RelayLimitBucketSize_name = map[int32]string{
+	0: "RELAY_LIMIT_BUCKET_SIZE_1",
+	1: "RELAY_LIMIT_BUCKET_SIZE_2",
+	2: "RELAY_LIMIT_BUCKET_SIZE_4",
+	3: "RELAY_LIMIT_BUCKET_SIZE_12",
+}
+
+var RelayLimitBucketSize_value = map[string]int32{
+	"RELAY_LIMIT_BUCKET_SIZE_1":  0,
+	"RELAY_LIMIT_BUCKET_SIZE_2":  1,
+	"RELAY_LIMIT_BUCKET_SIZE_4":  2,
+	"RELAY_LIMIT_BUCKET_SIZE_12": 3,
+}
+
+func (RelayLimitBucketSize) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{25}
+}
+
+type RelayJoinRequestFilterAction int32
+
+const (
+	RELAY_JOIN_REQUEST_FILTER_ACTION_NO_RULE RelayJoinRequestFilterAction = 0
+	RELAY_JOIN_REQUEST_FILTER_ACTION_FORWARD RelayJoinRequestFilterAction = 1
+	RELAY_JOIN_REQUEST_FILTER_ACTION_FILTER  RelayJoinRequestFilterAction = 2
+)
+
+var RelayJoinRequestFilterAction_name = map[int32]string{
+	0: "RELAY_JOIN_REQUEST_FILTER_ACTION_NO_RULE",
+	1: "RELAY_JOIN_REQUEST_FILTER_ACTION_FORWARD",
+	2: "RELAY_JOIN_REQUEST_FILTER_ACTION_FILTER",
+}
+
+var RelayJoinRequestFilterAction_value = map[string]int32{
+	"RELAY_JOIN_REQUEST_FILTER_ACTION_NO_RULE": 0,
+	"RELAY_JOIN_REQUEST_FILTER_ACTION_FORWARD": 1,
+	"RELAY_JOIN_REQUEST_FILTER_ACTION_FILTER":  2,
+}
+
+func (RelayJoinRequestFilterAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{26}
+}
+
+type RelayCtrlUplinkListAction int32
+
+const (
+	RELAY_CTRL_UPLINK_LIST_ACTION_READ_W_F_CNT              RelayCtrlUplinkListAction = 0
+	RELAY_CTRL_UPLINK_LIST_ACTION_REMOVE_TRUSTED_END_DEVICE RelayCtrlUplinkListAction = 1
+)
+
+var RelayCtrlUplinkListAction_name = map[int32]string{
+	0: "RELAY_CTRL_UPLINK_LIST_ACTION_READ_W_F_CNT",
+	1: "RELAY_CTRL_UPLINK_LIST_ACTION_REMOVE_TRUSTED_END_DEVICE",
+}
+
+var RelayCtrlUplinkListAction_value = map[string]int32{
+	"RELAY_CTRL_UPLINK_LIST_ACTION_READ_W_F_CNT":              0,
+	"RELAY_CTRL_UPLINK_LIST_ACTION_REMOVE_TRUSTED_END_DEVICE": 1,
+}
+
+func (RelayCtrlUplinkListAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{27}
+}
+
 // Message represents a LoRaWAN message
 type Message struct {
 	MHDR `protobuf:"bytes,1,opt,name=m_hdr,json=mHdr,proto3,embedded=m_hdr" json:"m_hdr"`
@@ -2397,6 +2583,16 @@ type MACCommand struct {
 	//	*MACCommand_BeaconFreqAns_
 	//	*MACCommand_DeviceModeInd_
 	//	*MACCommand_DeviceModeConf_
+	//	*MACCommand_RelayConfReq_
+	//	*MACCommand_RelayConfAns_
+	//	*MACCommand_RelayEndDeviceConfReq_
+	//	*MACCommand_RelayEndDeviceConfAns_
+	//	*MACCommand_RelayFilterListReq_
+	//	*MACCommand_RelayFilterListAns_
+	//	*MACCommand_RelayUpdateUplinkListReq_
+	//	*MACCommand_RelayCtrlUplinkListReq_
+	//	*MACCommand_RelayCtrlUplinkListAns_
+	//	*MACCommand_RelayNotifyNewEndDeviceReq_
 	Payload              isMACCommand_Payload `protobuf_oneof:"payload"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -2523,38 +2719,78 @@ type MACCommand_DeviceModeInd_ struct {
 type MACCommand_DeviceModeConf_ struct {
 	DeviceModeConf *MACCommand_DeviceModeConf `protobuf:"bytes,32,opt,name=device_mode_conf,json=deviceModeConf,proto3,oneof" json:"device_mode_conf,omitempty"`
 }
-
-func (*MACCommand_RawPayload) isMACCommand_Payload()           {}
-func (*MACCommand_ResetInd_) isMACCommand_Payload()            {}
-func (*MACCommand_ResetConf_) isMACCommand_Payload()           {}
-func (*MACCommand_LinkCheckAns_) isMACCommand_Payload()        {}
-func (*MACCommand_LinkAdrReq) isMACCommand_Payload()           {}
-func (*MACCommand_LinkAdrAns) isMACCommand_Payload()           {}
-func (*MACCommand_DutyCycleReq_) isMACCommand_Payload()        {}
-func (*MACCommand_RxParamSetupReq_) isMACCommand_Payload()     {}
-func (*MACCommand_RxParamSetupAns_) isMACCommand_Payload()     {}
-func (*MACCommand_DevStatusAns_) isMACCommand_Payload()        {}
-func (*MACCommand_NewChannelReq_) isMACCommand_Payload()       {}
-func (*MACCommand_NewChannelAns_) isMACCommand_Payload()       {}
-func (*MACCommand_DlChannelReq) isMACCommand_Payload()         {}
-func (*MACCommand_DlChannelAns) isMACCommand_Payload()         {}
-func (*MACCommand_RxTimingSetupReq_) isMACCommand_Payload()    {}
-func (*MACCommand_TxParamSetupReq_) isMACCommand_Payload()     {}
-func (*MACCommand_RekeyInd_) isMACCommand_Payload()            {}
-func (*MACCommand_RekeyConf_) isMACCommand_Payload()           {}
-func (*MACCommand_AdrParamSetupReq) isMACCommand_Payload()     {}
-func (*MACCommand_DeviceTimeAns_) isMACCommand_Payload()       {}
-func (*MACCommand_ForceRejoinReq_) isMACCommand_Payload()      {}
-func (*MACCommand_RejoinParamSetupReq_) isMACCommand_Payload() {}
-func (*MACCommand_RejoinParamSetupAns_) isMACCommand_Payload() {}
-func (*MACCommand_PingSlotInfoReq_) isMACCommand_Payload()     {}
-func (*MACCommand_PingSlotChannelReq_) isMACCommand_Payload()  {}
-func (*MACCommand_PingSlotChannelAns_) isMACCommand_Payload()  {}
-func (*MACCommand_BeaconTimingAns_) isMACCommand_Payload()     {}
-func (*MACCommand_BeaconFreqReq_) isMACCommand_Payload()       {}
-func (*MACCommand_BeaconFreqAns_) isMACCommand_Payload()       {}
-func (*MACCommand_DeviceModeInd_) isMACCommand_Payload()       {}
-func (*MACCommand_DeviceModeConf_) isMACCommand_Payload()      {}
+type MACCommand_RelayConfReq_ struct {
+	RelayConfReq *MACCommand_RelayConfReq `protobuf:"bytes,33,opt,name=relay_conf_req,json=relayConfReq,proto3,oneof" json:"relay_conf_req,omitempty"`
+}
+type MACCommand_RelayConfAns_ struct {
+	RelayConfAns *MACCommand_RelayConfAns `protobuf:"bytes,34,opt,name=relay_conf_ans,json=relayConfAns,proto3,oneof" json:"relay_conf_ans,omitempty"`
+}
+type MACCommand_RelayEndDeviceConfReq_ struct {
+	RelayEndDeviceConfReq *MACCommand_RelayEndDeviceConfReq `protobuf:"bytes,35,opt,name=relay_end_device_conf_req,json=relayEndDeviceConfReq,proto3,oneof" json:"relay_end_device_conf_req,omitempty"`
+}
+type MACCommand_RelayEndDeviceConfAns_ struct {
+	RelayEndDeviceConfAns *MACCommand_RelayEndDeviceConfAns `protobuf:"bytes,36,opt,name=relay_end_device_conf_ans,json=relayEndDeviceConfAns,proto3,oneof" json:"relay_end_device_conf_ans,omitempty"`
+}
+type MACCommand_RelayFilterListReq_ struct {
+	RelayFilterListReq *MACCommand_RelayFilterListReq `protobuf:"bytes,37,opt,name=relay_filter_list_req,json=relayFilterListReq,proto3,oneof" json:"relay_filter_list_req,omitempty"`
+}
+type MACCommand_RelayFilterListAns_ struct {
+	RelayFilterListAns *MACCommand_RelayFilterListAns `protobuf:"bytes,38,opt,name=relay_filter_list_ans,json=relayFilterListAns,proto3,oneof" json:"relay_filter_list_ans,omitempty"`
+}
+type MACCommand_RelayUpdateUplinkListReq_ struct {
+	RelayUpdateUplinkListReq *MACCommand_RelayUpdateUplinkListReq `protobuf:"bytes,39,opt,name=relay_update_uplink_list_req,json=relayUpdateUplinkListReq,proto3,oneof" json:"relay_update_uplink_list_req,omitempty"`
+}
+type MACCommand_RelayCtrlUplinkListReq_ struct {
+	RelayCtrlUplinkListReq *MACCommand_RelayCtrlUplinkListReq `protobuf:"bytes,40,opt,name=relay_ctrl_uplink_list_req,json=relayCtrlUplinkListReq,proto3,oneof" json:"relay_ctrl_uplink_list_req,omitempty"`
+}
+type MACCommand_RelayCtrlUplinkListAns_ struct {
+	RelayCtrlUplinkListAns *MACCommand_RelayCtrlUplinkListAns `protobuf:"bytes,41,opt,name=relay_ctrl_uplink_list_ans,json=relayCtrlUplinkListAns,proto3,oneof" json:"relay_ctrl_uplink_list_ans,omitempty"`
+}
+type MACCommand_RelayNotifyNewEndDeviceReq_ struct {
+	RelayNotifyNewEndDeviceReq *MACCommand_RelayNotifyNewEndDeviceReq `protobuf:"bytes,42,opt,name=relay_notify_new_end_device_req,json=relayNotifyNewEndDeviceReq,proto3,oneof" json:"relay_notify_new_end_device_req,omitempty"`
+}
+
+func (*MACCommand_RawPayload) isMACCommand_Payload()                  {}
+func (*MACCommand_ResetInd_) isMACCommand_Payload()                   {}
+func (*MACCommand_ResetConf_) isMACCommand_Payload()                  {}
+func (*MACCommand_LinkCheckAns_) isMACCommand_Payload()               {}
+func (*MACCommand_LinkAdrReq) isMACCommand_Payload()                  {}
+func (*MACCommand_LinkAdrAns) isMACCommand_Payload()                  {}
+func (*MACCommand_DutyCycleReq_) isMACCommand_Payload()               {}
+func (*MACCommand_RxParamSetupReq_) isMACCommand_Payload()            {}
+func (*MACCommand_RxParamSetupAns_) isMACCommand_Payload()            {}
+func (*MACCommand_DevStatusAns_) isMACCommand_Payload()               {}
+func (*MACCommand_NewChannelReq_) isMACCommand_Payload()              {}
+func (*MACCommand_NewChannelAns_) isMACCommand_Payload()              {}
+func (*MACCommand_DlChannelReq) isMACCommand_Payload()                {}
+func (*MACCommand_DlChannelAns) isMACCommand_Payload()                {}
+func (*MACCommand_RxTimingSetupReq_) isMACCommand_Payload()           {}
+func (*MACCommand_TxParamSetupReq_) isMACCommand_Payload()            {}
+func (*MACCommand_RekeyInd_) isMACCommand_Payload()                   {}
+func (*MACCommand_RekeyConf_) isMACCommand_Payload()                  {}
+func (*MACCommand_AdrParamSetupReq) isMACCommand_Payload()            {}
+func (*MACCommand_DeviceTimeAns_) isMACCommand_Payload()              {}
+func (*MACCommand_ForceRejoinReq_) isMACCommand_Payload()             {}
+func (*MACCommand_RejoinParamSetupReq_) isMACCommand_Payload()        {}
+func (*MACCommand_RejoinParamSetupAns_) isMACCommand_Payload()        {}
+func (*MACCommand_PingSlotInfoReq_) isMACCommand_Payload()            {}
+func (*MACCommand_PingSlotChannelReq_) isMACCommand_Payload()         {}
+func (*MACCommand_PingSlotChannelAns_) isMACCommand_Payload()         {}
+func (*MACCommand_BeaconTimingAns_) isMACCommand_Payload()            {}
+func (*MACCommand_BeaconFreqReq_) isMACCommand_Payload()              {}
+func (*MACCommand_BeaconFreqAns_) isMACCommand_Payload()              {}
+func (*MACCommand_DeviceModeInd_) isMACCommand_Payload()              {}
+func (*MACCommand_DeviceModeConf_) isMACCommand_Payload()             {}
+func (*MACCommand_RelayConfReq_) isMACCommand_Payload()               {}
+func (*MACCommand_RelayConfAns_) isMACCommand_Payload()               {}
+func (*MACCommand_RelayEndDeviceConfReq_) isMACCommand_Payload()      {}
+func (*MACCommand_RelayEndDeviceConfAns_) isMACCommand_Payload()      {}
+func (*MACCommand_RelayFilterListReq_) isMACCommand_Payload()         {}
+func (*MACCommand_RelayFilterListAns_) isMACCommand_Payload()         {}
+func (*MACCommand_RelayUpdateUplinkListReq_) isMACCommand_Payload()   {}
+func (*MACCommand_RelayCtrlUplinkListReq_) isMACCommand_Payload()     {}
+func (*MACCommand_RelayCtrlUplinkListAns_) isMACCommand_Payload()     {}
+func (*MACCommand_RelayNotifyNewEndDeviceReq_) isMACCommand_Payload() {}
 
 func (m *MACCommand) GetPayload() isMACCommand_Payload {
 	if m != nil {
@@ -2787,6 +3023,76 @@ func (m *MACCommand) GetDeviceModeConf() *MACCommand_DeviceModeConf {
 	return nil
 }
 
+func (m *MACCommand) GetRelayConfReq() *MACCommand_RelayConfReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayConfReq_); ok {
+		return x.RelayConfReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayConfAns() *MACCommand_RelayConfAns {
+	if x, ok := m.GetPayload().(*MACCommand_RelayConfAns_); ok {
+		return x.RelayConfAns
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayEndDeviceConfReq() *MACCommand_RelayEndDeviceConfReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayEndDeviceConfReq_); ok {
+		return x.RelayEndDeviceConfReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayEndDeviceConfAns() *MACCommand_RelayEndDeviceConfAns {
+	if x, ok := m.GetPayload().(*MACCommand_RelayEndDeviceConfAns_); ok {
+		return x.RelayEndDeviceConfAns
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayFilterListReq() *MACCommand_RelayFilterListReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayFilterListReq_); ok {
+		return x.RelayFilterListReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayFilterListAns() *MACCommand_RelayFilterListAns {
+	if x, ok := m.GetPayload().(*MACCommand_RelayFilterListAns_); ok {
+		return x.RelayFilterListAns
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayUpdateUplinkListReq() *MACCommand_RelayUpdateUplinkListReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayUpdateUplinkListReq_); ok {
+		return x.RelayUpdateUplinkListReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayCtrlUplinkListReq() *MACCommand_RelayCtrlUplinkListReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayCtrlUplinkListReq_); ok {
+		return x.RelayCtrlUplinkListReq
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayCtrlUplinkListAns() *MACCommand_RelayCtrlUplinkListAns {
+	if x, ok := m.GetPayload().(*MACCommand_RelayCtrlUplinkListAns_); ok {
+		return x.RelayCtrlUplinkListAns
+	}
+	return nil
+}
+
+func (m *MACCommand) GetRelayNotifyNewEndDeviceReq() *MACCommand_RelayNotifyNewEndDeviceReq {
+	if x, ok := m.GetPayload().(*MACCommand_RelayNotifyNewEndDeviceReq_); ok {
+		return x.RelayNotifyNewEndDeviceReq
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MACCommand) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -2821,6 +3127,16 @@ func (*MACCommand) XXX_OneofWrappers() []interface{} {
 		(*MACCommand_BeaconFreqAns_)(nil),
 		(*MACCommand_DeviceModeInd_)(nil),
 		(*MACCommand_DeviceModeConf_)(nil),
+		(*MACCommand_RelayConfReq_)(nil),
+		(*MACCommand_RelayConfAns_)(nil),
+		(*MACCommand_RelayEndDeviceConfReq_)(nil),
+		(*MACCommand_RelayEndDeviceConfAns_)(nil),
+		(*MACCommand_RelayFilterListReq_)(nil),
+		(*MACCommand_RelayFilterListAns_)(nil),
+		(*MACCommand_RelayUpdateUplinkListReq_)(nil),
+		(*MACCommand_RelayCtrlUplinkListReq_)(nil),
+		(*MACCommand_RelayCtrlUplinkListAns_)(nil),
+		(*MACCommand_RelayNotifyNewEndDeviceReq_)(nil),
 	}
 }
 
@@ -4141,1001 +4457,2990 @@ func (m *MACCommand_DeviceModeConf) GetClass() Class {
 	return CLASS_A
 }
 
-type FrequencyValue struct {
-	Value                uint64   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type MACCommand_RelayConfReq struct {
+	// Configuration of the relay. If unset, the relay is disabled.
+	Configuration        *MACCommand_RelayConfReq_Configuration `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
 }
 
-func (m *FrequencyValue) Reset()      { *m = FrequencyValue{} }
-func (*FrequencyValue) ProtoMessage() {}
-func (*FrequencyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{20}
+func (m *MACCommand_RelayConfReq) Reset()      { *m = MACCommand_RelayConfReq{} }
+func (*MACCommand_RelayConfReq) ProtoMessage() {}
+func (*MACCommand_RelayConfReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 30}
 }
-func (m *FrequencyValue) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FrequencyValue.Unmarshal(m, b)
+func (m *MACCommand_RelayConfReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MACCommand_RelayConfReq.Unmarshal(m, b)
 }
-func (m *FrequencyValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FrequencyValue.Marshal(b, m, deterministic)
+func (m *MACCommand_RelayConfReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MACCommand_RelayConfReq.Marshal(b, m, deterministic)
 }
-func (m *FrequencyValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FrequencyValue.Merge(m, src)
+func (m *MACCommand_RelayConfReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayConfReq.Merge(m, src)
 }
-func (m *FrequencyValue) XXX_Size() int {
-	return xxx_messageInfo_FrequencyValue.Size(m)
+func (m *MACCommand_RelayConfReq) XXX_Size() int {
+	return xxx_messageInfo_MACCommand_RelayConfReq.Size(m)
 }
-func (m *FrequencyValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_FrequencyValue.DiscardUnknown(m)
+func (m *MACCommand_RelayConfReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayConfReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FrequencyValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayConfReq proto.InternalMessageInfo
 
-func (m *FrequencyValue) GetValue() uint64 {
+func (m *MACCommand_RelayConfReq) GetConfiguration() *MACCommand_RelayConfReq_Configuration {
 	if m != nil {
-		return m.Value
+		return m.Configuration
 	}
-	return 0
+	return nil
 }
 
-type DataRateOffsetValue struct {
-	Value                DataRateOffset `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.DataRateOffset" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type MACCommand_RelayConfReq_Configuration struct {
+	SecondChannel        *RelaySecondChannel `protobuf:"bytes,1,opt,name=second_channel,json=secondChannel,proto3" json:"second_channel,omitempty"`
+	DefaultChannelIndex  uint32              `protobuf:"varint,2,opt,name=default_channel_index,json=defaultChannelIndex,proto3" json:"default_channel_index,omitempty"`
+	CadPeriodicity       RelayCADPeriodicity `protobuf:"varint,3,opt,name=cad_periodicity,json=cadPeriodicity,proto3,enum=ttn.lorawan.v3.RelayCADPeriodicity" json:"cad_periodicity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *DataRateOffsetValue) Reset()      { *m = DataRateOffsetValue{} }
-func (*DataRateOffsetValue) ProtoMessage() {}
-func (*DataRateOffsetValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{21}
+func (m *MACCommand_RelayConfReq_Configuration) Reset()      { *m = MACCommand_RelayConfReq_Configuration{} }
+func (*MACCommand_RelayConfReq_Configuration) ProtoMessage() {}
+func (*MACCommand_RelayConfReq_Configuration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 30, 0}
 }
-func (m *DataRateOffsetValue) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DataRateOffsetValue.Unmarshal(m, b)
+func (m *MACCommand_RelayConfReq_Configuration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MACCommand_RelayConfReq_Configuration.Unmarshal(m, b)
 }
-func (m *DataRateOffsetValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DataRateOffsetValue.Marshal(b, m, deterministic)
+func (m *MACCommand_RelayConfReq_Configuration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MACCommand_RelayConfReq_Configuration.Marshal(b, m, deterministic)
 }
-func (m *DataRateOffsetValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataRateOffsetValue.Merge(m, src)
+func (m *MACCommand_RelayConfReq_Configuration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayConfReq_Configuration.Merge(m, src)
 }
-func (m *DataRateOffsetValue) XXX_Size() int {
-	return xxx_messageInfo_DataRateOffsetValue.Size(m)
+func (m *MACCommand_RelayConfReq_Configuration) XXX_Size() int {
+	return xxx_messageInfo_MACCommand_RelayConfReq_Configuration.Size(m)
 }
-func (m *DataRateOffsetValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataRateOffsetValue.DiscardUnknown(m)
+func (m *MACCommand_RelayConfReq_Configuration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayConfReq_Configuration.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataRateOffsetValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayConfReq_Configuration proto.InternalMessageInfo
 
-func (m *DataRateOffsetValue) GetValue() DataRateOffset {
+func (m *MACCommand_RelayConfReq_Configuration) GetSecondChannel() *RelaySecondChannel {
 	if m != nil {
-		return m.Value
+		return m.SecondChannel
 	}
-	return DataRateOffset_DATA_RATE_OFFSET_0
+	return nil
 }
 
-type DataRateIndexValue struct {
-	Value                DataRateIndex `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *MACCommand_RelayConfReq_Configuration) GetDefaultChannelIndex() uint32 {
+	if m != nil {
+		return m.DefaultChannelIndex
+	}
+	return 0
 }
 
-func (m *DataRateIndexValue) Reset()      { *m = DataRateIndexValue{} }
-func (*DataRateIndexValue) ProtoMessage() {}
-func (*DataRateIndexValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{22}
+func (m *MACCommand_RelayConfReq_Configuration) GetCadPeriodicity() RelayCADPeriodicity {
+	if m != nil {
+		return m.CadPeriodicity
+	}
+	return RELAY_CAD_PERIODICITY_1_SECOND
 }
-func (m *DataRateIndexValue) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DataRateIndexValue.Unmarshal(m, b)
+
+type MACCommand_RelayConfAns struct {
+	SecondChannelFrequencyAck     bool     `protobuf:"varint,1,opt,name=second_channel_frequency_ack,json=secondChannelFrequencyAck,proto3" json:"second_channel_frequency_ack,omitempty"`
+	SecondChannelAckOffsetAck     bool     `protobuf:"varint,2,opt,name=second_channel_ack_offset_ack,json=secondChannelAckOffsetAck,proto3" json:"second_channel_ack_offset_ack,omitempty"`
+	SecondChannelDataRateIndexAck bool     `protobuf:"varint,3,opt,name=second_channel_data_rate_index_ack,json=secondChannelDataRateIndexAck,proto3" json:"second_channel_data_rate_index_ack,omitempty"`
+	SecondChannelIndexAck         bool     `protobuf:"varint,4,opt,name=second_channel_index_ack,json=secondChannelIndexAck,proto3" json:"second_channel_index_ack,omitempty"`
+	DefaultChannelIndexAck        bool     `protobuf:"varint,5,opt,name=default_channel_index_ack,json=defaultChannelIndexAck,proto3" json:"default_channel_index_ack,omitempty"`
+	CadPeriodicityAck             bool     `protobuf:"varint,6,opt,name=cad_periodicity_ack,json=cadPeriodicityAck,proto3" json:"cad_periodicity_ack,omitempty"`
+	XXX_NoUnkeyedLiteral          struct{} `json:"-"`
+	XXX_sizecache                 int32    `json:"-"`
 }
-func (m *DataRateIndexValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DataRateIndexValue.Marshal(b, m, deterministic)
+
+func (m *MACCommand_RelayConfAns) Reset()      { *m = MACCommand_RelayConfAns{} }
+func (*MACCommand_RelayConfAns) ProtoMessage() {}
+func (*MACCommand_RelayConfAns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 31}
 }
-func (m *DataRateIndexValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataRateIndexValue.Merge(m, src)
+func (m *MACCommand_RelayConfAns) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MACCommand_RelayConfAns.Unmarshal(m, b)
 }
-func (m *DataRateIndexValue) XXX_Size() int {
-	return xxx_messageInfo_DataRateIndexValue.Size(m)
+func (m *MACCommand_RelayConfAns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MACCommand_RelayConfAns.Marshal(b, m, deterministic)
 }
-func (m *DataRateIndexValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataRateIndexValue.DiscardUnknown(m)
+func (m *MACCommand_RelayConfAns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayConfAns.Merge(m, src)
+}
+func (m *MACCommand_RelayConfAns) XXX_Size() int {
+	return xxx_messageInfo_MACCommand_RelayConfAns.Size(m)
+}
+func (m *MACCommand_RelayConfAns) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayConfAns.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataRateIndexValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayConfAns proto.InternalMessageInfo
 
-func (m *DataRateIndexValue) GetValue() DataRateIndex {
+func (m *MACCommand_RelayConfAns) GetSecondChannelFrequencyAck() bool {
 	if m != nil {
-		return m.Value
+		return m.SecondChannelFrequencyAck
 	}
-	return DATA_RATE_0
+	return false
 }
 
-type PingSlotPeriodValue struct {
-	Value                PingSlotPeriod `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.PingSlotPeriod" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *MACCommand_RelayConfAns) GetSecondChannelAckOffsetAck() bool {
+	if m != nil {
+		return m.SecondChannelAckOffsetAck
+	}
+	return false
 }
 
-func (m *PingSlotPeriodValue) Reset()      { *m = PingSlotPeriodValue{} }
-func (*PingSlotPeriodValue) ProtoMessage() {}
-func (*PingSlotPeriodValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{23}
+func (m *MACCommand_RelayConfAns) GetSecondChannelDataRateIndexAck() bool {
+	if m != nil {
+		return m.SecondChannelDataRateIndexAck
+	}
+	return false
 }
-func (m *PingSlotPeriodValue) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PingSlotPeriodValue.Unmarshal(m, b)
+
+func (m *MACCommand_RelayConfAns) GetSecondChannelIndexAck() bool {
+	if m != nil {
+		return m.SecondChannelIndexAck
+	}
+	return false
 }
-func (m *PingSlotPeriodValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PingSlotPeriodValue.Marshal(b, m, deterministic)
+
+func (m *MACCommand_RelayConfAns) GetDefaultChannelIndexAck() bool {
+	if m != nil {
+		return m.DefaultChannelIndexAck
+	}
+	return false
 }
-func (m *PingSlotPeriodValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PingSlotPeriodValue.Merge(m, src)
+
+func (m *MACCommand_RelayConfAns) GetCadPeriodicityAck() bool {
+	if m != nil {
+		return m.CadPeriodicityAck
+	}
+	return false
 }
-func (m *PingSlotPeriodValue) XXX_Size() int {
-	return xxx_messageInfo_PingSlotPeriodValue.Size(m)
+
+type MACCommand_RelayEndDeviceConfReq struct {
+	// Relay configuration of the end device. If unset, relay mode is disabled on the end device.
+	Configuration        *MACCommand_RelayEndDeviceConfReq_Configuration `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                        `json:"-"`
+	XXX_sizecache        int32                                           `json:"-"`
 }
-func (m *PingSlotPeriodValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_PingSlotPeriodValue.DiscardUnknown(m)
+
+func (m *MACCommand_RelayEndDeviceConfReq) Reset()      { *m = MACCommand_RelayEndDeviceConfReq{} }
+func (*MACCommand_RelayEndDeviceConfReq) ProtoMessage() {}
+func (*MACCommand_RelayEndDeviceConfReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 32}
+}
+func (m *MACCommand_RelayEndDeviceConfReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MACCommand_RelayEndDeviceConfReq.Unmarshal(m, b)
+}
+func (m *MACCommand_RelayEndDeviceConfReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MACCommand_RelayEndDeviceConfReq.Marshal(b, m, deterministic)
+}
+func (m *MACCommand_RelayEndDeviceConfReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayEndDeviceConfReq.Merge(m, src)
+}
+func (m *MACCommand_RelayEndDeviceConfReq) XXX_Size() int {
+	return xxx_messageInfo_MACCommand_RelayEndDeviceConfReq.Size(m)
+}
+func (m *MACCommand_RelayEndDeviceConfReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayEndDeviceConfReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PingSlotPeriodValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayEndDeviceConfReq proto.InternalMessageInfo
 
-func (m *PingSlotPeriodValue) GetValue() PingSlotPeriod {
+func (m *MACCommand_RelayEndDeviceConfReq) GetConfiguration() *MACCommand_RelayEndDeviceConfReq_Configuration {
 	if m != nil {
-		return m.Value
+		return m.Configuration
 	}
-	return PING_EVERY_1S
+	return nil
 }
 
-type AggregatedDutyCycleValue struct {
-	Value                AggregatedDutyCycle `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.AggregatedDutyCycle" json:"value,omitempty"`
+type MACCommand_RelayEndDeviceConfReq_Configuration struct {
+	// Types that are valid to be assigned to Mode:
+	//	*MACCommand_RelayEndDeviceConfReq_Configuration_Always
+	//	*MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic
+	//	*MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled
+	Mode isMACCommand_RelayEndDeviceConfReq_Configuration_Mode `protobuf_oneof:"mode"`
+	// Number of uplinks the end device sends without acknowledgment from the relay before it sends uplinks directly.
+	Backoff              uint32              `protobuf:"varint,4,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	SecondChannel        *RelaySecondChannel `protobuf:"bytes,5,opt,name=second_channel,json=secondChannel,proto3" json:"second_channel,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *AggregatedDutyCycleValue) Reset()      { *m = AggregatedDutyCycleValue{} }
-func (*AggregatedDutyCycleValue) ProtoMessage() {}
-func (*AggregatedDutyCycleValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{24}
+func (m *MACCommand_RelayEndDeviceConfReq_Configuration) Reset() {
+	*m = MACCommand_RelayEndDeviceConfReq_Configuration{}
 }
-func (m *AggregatedDutyCycleValue) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AggregatedDutyCycleValue.Unmarshal(m, b)
+func (*MACCommand_RelayEndDeviceConfReq_Configuration) ProtoMessage() {}
+func (*MACCommand_RelayEndDeviceConfReq_Configuration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 32, 0}
 }
-func (m *AggregatedDutyCycleValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AggregatedDutyCycleValue.Marshal(b, m, deterministic)
+func (m *MACCommand_RelayEndDeviceConfReq_Configuration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MACCommand_RelayEndDeviceConfReq_Configuration.Unmarshal(m, b)
 }
-func (m *AggregatedDutyCycleValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AggregatedDutyCycleValue.Merge(m, src)
+func (m *MACCommand_RelayEndDeviceConfReq_Configuration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MACCommand_RelayEndDeviceConfReq_Configuration.Marshal(b, m, deterministic)
 }
-func (m *AggregatedDutyCycleValue) XXX_Size() int {
-	return xxx_messageInfo_AggregatedDutyCycleValue.Size(m)
+func (m *MACCommand_RelayEndDeviceConfReq_Configuration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayEndDeviceConfReq_Configuration.Merge(m, src)
 }
-func (m *AggregatedDutyCycleValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_AggregatedDutyCycleValue.DiscardUnknown(m)
+func (m *MACCommand_RelayEndDeviceConfReq_Configuration) XXX_Size() int {
+	return xxx_messageInfo_MACCommand_RelayEndDeviceConfReq_Configuration.Size(m)
+}
+func (m *MACCommand_RelayEndDeviceConfReq_Configuration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayEndDeviceConfReq_Configuration.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AggregatedDutyCycleValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayEndDeviceConfReq_Configuration proto.InternalMessageInfo
 
-func (m *AggregatedDutyCycleValue) GetValue() AggregatedDutyCycle {
-	if m != nil {
-		return m.Value
+type isMACCommand_RelayEndDeviceConfReq_Configuration_Mode interface {
+	isMACCommand_RelayEndDeviceConfReq_Configuration_Mode()
+	Equal(interface{}) bool
+}
+
+type MACCommand_RelayEndDeviceConfReq_Configuration_Always struct {
+	Always *RelayEndDeviceAlwaysMode `protobuf:"bytes,1,opt,name=always,proto3,oneof" json:"always,omitempty"`
+}
+type MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic struct {
+	Dynamic *RelayEndDeviceDynamicMode `protobuf:"bytes,2,opt,name=dynamic,proto3,oneof" json:"dynamic,omitempty"`
+}
+type MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled struct {
+	EndDeviceControlled *RelayEndDeviceControlledMode `protobuf:"bytes,3,opt,name=end_device_controlled,json=endDeviceControlled,proto3,oneof" json:"end_device_controlled,omitempty"`
+}
+
+func (*MACCommand_RelayEndDeviceConfReq_Configuration_Always) isMACCommand_RelayEndDeviceConfReq_Configuration_Mode() {
+}
+func (*MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic) isMACCommand_RelayEndDeviceConfReq_Configuration_Mode() {
+}
+func (*MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled) isMACCommand_RelayEndDeviceConfReq_Configuration_Mode() {
+}
+
+func (m *MACCommand_RelayEndDeviceConfReq_Configuration) GetMode() isMACCommand_RelayEndDeviceConfReq_Configuration_Mode {
+	if m != nil {
+		return m.Mode
 	}
-	return DUTY_CYCLE_1
+	return nil
 }
 
-type RxDelayValue struct {
-	Value                RxDelay  `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.RxDelay" json:"value,omitempty"`
+func (m *MACCommand_RelayEndDeviceConfReq_Configuration) GetAlways() *RelayEndDeviceAlwaysMode {
+	if x, ok := m.GetMode().(*MACCommand_RelayEndDeviceConfReq_Configuration_Always); ok {
+		return x.Always
+	}
+	return nil
+}
+
+func (m *MACCommand_RelayEndDeviceConfReq_Configuration) GetDynamic() *RelayEndDeviceDynamicMode {
+	if x, ok := m.GetMode().(*MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic); ok {
+		return x.Dynamic
+	}
+	return nil
+}
+
+func (m *MACCommand_RelayEndDeviceConfReq_Configuration) GetEndDeviceControlled() *RelayEndDeviceControlledMode {
+	if x, ok := m.GetMode().(*MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled); ok {
+		return x.EndDeviceControlled
+	}
+	return nil
+}
+
+func (m *MACCommand_RelayEndDeviceConfReq_Configuration) GetBackoff() uint32 {
+	if m != nil {
+		return m.Backoff
+	}
+	return 0
+}
+
+func (m *MACCommand_RelayEndDeviceConfReq_Configuration) GetSecondChannel() *RelaySecondChannel {
+	if m != nil {
+		return m.SecondChannel
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*MACCommand_RelayEndDeviceConfReq_Configuration) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*MACCommand_RelayEndDeviceConfReq_Configuration_Always)(nil),
+		(*MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic)(nil),
+		(*MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled)(nil),
+	}
+}
+
+type MACCommand_RelayEndDeviceConfAns struct {
+	SecondChannelFrequencyAck     bool     `protobuf:"varint,1,opt,name=second_channel_frequency_ack,json=secondChannelFrequencyAck,proto3" json:"second_channel_frequency_ack,omitempty"`
+	SecondChannelDataRateIndexAck bool     `protobuf:"varint,2,opt,name=second_channel_data_rate_index_ack,json=secondChannelDataRateIndexAck,proto3" json:"second_channel_data_rate_index_ack,omitempty"`
+	SecondChannelIndexAck         bool     `protobuf:"varint,3,opt,name=second_channel_index_ack,json=secondChannelIndexAck,proto3" json:"second_channel_index_ack,omitempty"`
+	BackoffAck                    bool     `protobuf:"varint,4,opt,name=backoff_ack,json=backoffAck,proto3" json:"backoff_ack,omitempty"`
+	XXX_NoUnkeyedLiteral          struct{} `json:"-"`
+	XXX_sizecache                 int32    `json:"-"`
+}
+
+func (m *MACCommand_RelayEndDeviceConfAns) Reset()      { *m = MACCommand_RelayEndDeviceConfAns{} }
+func (*MACCommand_RelayEndDeviceConfAns) ProtoMessage() {}
+func (*MACCommand_RelayEndDeviceConfAns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 33}
+}
+func (m *MACCommand_RelayEndDeviceConfAns) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MACCommand_RelayEndDeviceConfAns.Unmarshal(m, b)
+}
+func (m *MACCommand_RelayEndDeviceConfAns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MACCommand_RelayEndDeviceConfAns.Marshal(b, m, deterministic)
+}
+func (m *MACCommand_RelayEndDeviceConfAns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayEndDeviceConfAns.Merge(m, src)
+}
+func (m *MACCommand_RelayEndDeviceConfAns) XXX_Size() int {
+	return xxx_messageInfo_MACCommand_RelayEndDeviceConfAns.Size(m)
+}
+func (m *MACCommand_RelayEndDeviceConfAns) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayEndDeviceConfAns.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MACCommand_RelayEndDeviceConfAns proto.InternalMessageInfo
+
+func (m *MACCommand_RelayEndDeviceConfAns) GetSecondChannelFrequencyAck() bool {
+	if m != nil {
+		return m.SecondChannelFrequencyAck
+	}
+	return false
+}
+
+func (m *MACCommand_RelayEndDeviceConfAns) GetSecondChannelDataRateIndexAck() bool {
+	if m != nil {
+		return m.SecondChannelDataRateIndexAck
+	}
+	return false
+}
+
+func (m *MACCommand_RelayEndDeviceConfAns) GetSecondChannelIndexAck() bool {
+	if m != nil {
+		return m.SecondChannelIndexAck
+	}
+	return false
+}
+
+func (m *MACCommand_RelayEndDeviceConfAns) GetBackoffAck() bool {
+	if m != nil {
+		return m.BackoffAck
+	}
+	return false
+}
+
+type MACCommand_RelayFilterListReq struct {
+	RuleIndex            uint32                                                `protobuf:"varint,1,opt,name=rule_index,json=ruleIndex,proto3" json:"rule_index,omitempty"`
+	Action               RelayJoinRequestFilterAction                          `protobuf:"varint,2,opt,name=action,proto3,enum=ttn.lorawan.v3.RelayJoinRequestFilterAction" json:"action,omitempty"`
+	JoinEui              go_thethings_network_lorawan_stack_v3_pkg_types.EUI64 `protobuf:"bytes,3,opt,name=join_eui,json=joinEui,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.EUI64" json:"join_eui"`
+	DevEui               go_thethings_network_lorawan_stack_v3_pkg_types.EUI64 `protobuf:"bytes,4,opt,name=dev_eui,json=devEui,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.EUI64" json:"dev_eui"`
+	XXX_NoUnkeyedLiteral struct{}                                              `json:"-"`
+	XXX_sizecache        int32                                                 `json:"-"`
+}
+
+func (m *MACCommand_RelayFilterListReq) Reset()      { *m = MACCommand_RelayFilterListReq{} }
+func (*MACCommand_RelayFilterListReq) ProtoMessage() {}
+func (*MACCommand_RelayFilterListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 34}
+}
+func (m *MACCommand_RelayFilterListReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MACCommand_RelayFilterListReq.Unmarshal(m, b)
+}
+func (m *MACCommand_RelayFilterListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MACCommand_RelayFilterListReq.Marshal(b, m, deterministic)
+}
+func (m *MACCommand_RelayFilterListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayFilterListReq.Merge(m, src)
+}
+func (m *MACCommand_RelayFilterListReq) XXX_Size() int {
+	return xxx_messageInfo_MACCommand_RelayFilterListReq.Size(m)
+}
+func (m *MACCommand_RelayFilterListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayFilterListReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MACCommand_RelayFilterListReq proto.InternalMessageInfo
+
+func (m *MACCommand_RelayFilterListReq) GetRuleIndex() uint32 {
+	if m != nil {
+		return m.RuleIndex
+	}
+	return 0
+}
+
+func (m *MACCommand_RelayFilterListReq) GetAction() RelayJoinRequestFilterAction {
+	if m != nil {
+		return m.Action
+	}
+	return RELAY_JOIN_REQUEST_FILTER_ACTION_NO_RULE
+}
+
+type MACCommand_RelayFilterListAns struct {
+	ActionAck            bool     `protobuf:"varint,1,opt,name=action_ack,json=actionAck,proto3" json:"action_ack,omitempty"`
+	RuleIndexAck         bool     `protobuf:"varint,2,opt,name=rule_index_ack,json=ruleIndexAck,proto3" json:"rule_index_ack,omitempty"`
+	CombinedRulesAck     bool     `protobuf:"varint,3,opt,name=combined_rules_ack,json=combinedRulesAck,proto3" json:"combined_rules_ack,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RxDelayValue) Reset()      { *m = RxDelayValue{} }
-func (*RxDelayValue) ProtoMessage() {}
-func (*RxDelayValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{25}
+func (m *MACCommand_RelayFilterListAns) Reset()      { *m = MACCommand_RelayFilterListAns{} }
+func (*MACCommand_RelayFilterListAns) ProtoMessage() {}
+func (*MACCommand_RelayFilterListAns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 35}
 }
-func (m *RxDelayValue) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RxDelayValue.Unmarshal(m, b)
+func (m *MACCommand_RelayFilterListAns) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MACCommand_RelayFilterListAns.Unmarshal(m, b)
 }
-func (m *RxDelayValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RxDelayValue.Marshal(b, m, deterministic)
+func (m *MACCommand_RelayFilterListAns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MACCommand_RelayFilterListAns.Marshal(b, m, deterministic)
 }
-func (m *RxDelayValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RxDelayValue.Merge(m, src)
+func (m *MACCommand_RelayFilterListAns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayFilterListAns.Merge(m, src)
 }
-func (m *RxDelayValue) XXX_Size() int {
-	return xxx_messageInfo_RxDelayValue.Size(m)
+func (m *MACCommand_RelayFilterListAns) XXX_Size() int {
+	return xxx_messageInfo_MACCommand_RelayFilterListAns.Size(m)
 }
-func (m *RxDelayValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_RxDelayValue.DiscardUnknown(m)
+func (m *MACCommand_RelayFilterListAns) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayFilterListAns.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RxDelayValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayFilterListAns proto.InternalMessageInfo
 
-func (m *RxDelayValue) GetValue() RxDelay {
+func (m *MACCommand_RelayFilterListAns) GetActionAck() bool {
 	if m != nil {
-		return m.Value
+		return m.ActionAck
 	}
-	return RX_DELAY_0
+	return false
 }
 
-type ADRAckLimitExponentValue struct {
-	Value                ADRAckLimitExponent `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.ADRAckLimitExponent" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *MACCommand_RelayFilterListAns) GetRuleIndexAck() bool {
+	if m != nil {
+		return m.RuleIndexAck
+	}
+	return false
 }
 
-func (m *ADRAckLimitExponentValue) Reset()      { *m = ADRAckLimitExponentValue{} }
-func (*ADRAckLimitExponentValue) ProtoMessage() {}
-func (*ADRAckLimitExponentValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{26}
+func (m *MACCommand_RelayFilterListAns) GetCombinedRulesAck() bool {
+	if m != nil {
+		return m.CombinedRulesAck
+	}
+	return false
 }
-func (m *ADRAckLimitExponentValue) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ADRAckLimitExponentValue.Unmarshal(m, b)
+
+type MACCommand_RelayUpdateUplinkListReq struct {
+	RuleIndex     uint32                                                  `protobuf:"varint,1,opt,name=rule_index,json=ruleIndex,proto3" json:"rule_index,omitempty"`
+	ForwardLimits *RelayForwardLimits                                     `protobuf:"bytes,2,opt,name=forward_limits,json=forwardLimits,proto3" json:"forward_limits,omitempty"`
+	DevAddr       go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr `protobuf:"bytes,3,opt,name=dev_addr,json=devAddr,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.DevAddr" json:"dev_addr"`
+	// Wake-on-radio frame counter of the served end device.
+	WFCnt                uint32                                                    `protobuf:"varint,4,opt,name=w_f_cnt,json=wFCnt,proto3" json:"w_f_cnt,omitempty"`
+	RootWorSKey          go_thethings_network_lorawan_stack_v3_pkg_types.AES128Key `protobuf:"bytes,5,opt,name=root_wor_s_key,json=rootWorSKey,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.AES128Key" json:"root_wor_s_key"`
+	XXX_NoUnkeyedLiteral struct{}                                                  `json:"-"`
+	XXX_sizecache        int32                                                     `json:"-"`
 }
-func (m *ADRAckLimitExponentValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ADRAckLimitExponentValue.Marshal(b, m, deterministic)
+
+func (m *MACCommand_RelayUpdateUplinkListReq) Reset()      { *m = MACCommand_RelayUpdateUplinkListReq{} }
+func (*MACCommand_RelayUpdateUplinkListReq) ProtoMessage() {}
+func (*MACCommand_RelayUpdateUplinkListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 36}
 }
-func (m *ADRAckLimitExponentValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ADRAckLimitExponentValue.Merge(m, src)
+func (m *MACCommand_RelayUpdateUplinkListReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MACCommand_RelayUpdateUplinkListReq.Unmarshal(m, b)
 }
-func (m *ADRAckLimitExponentValue) XXX_Size() int {
-	return xxx_messageInfo_ADRAckLimitExponentValue.Size(m)
+func (m *MACCommand_RelayUpdateUplinkListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MACCommand_RelayUpdateUplinkListReq.Marshal(b, m, deterministic)
 }
-func (m *ADRAckLimitExponentValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_ADRAckLimitExponentValue.DiscardUnknown(m)
+func (m *MACCommand_RelayUpdateUplinkListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayUpdateUplinkListReq.Merge(m, src)
+}
+func (m *MACCommand_RelayUpdateUplinkListReq) XXX_Size() int {
+	return xxx_messageInfo_MACCommand_RelayUpdateUplinkListReq.Size(m)
+}
+func (m *MACCommand_RelayUpdateUplinkListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayUpdateUplinkListReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ADRAckLimitExponentValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayUpdateUplinkListReq proto.InternalMessageInfo
 
-func (m *ADRAckLimitExponentValue) GetValue() ADRAckLimitExponent {
+func (m *MACCommand_RelayUpdateUplinkListReq) GetRuleIndex() uint32 {
 	if m != nil {
-		return m.Value
+		return m.RuleIndex
 	}
-	return ADR_ACK_LIMIT_1
+	return 0
 }
 
-type ADRAckDelayExponentValue struct {
-	Value                ADRAckDelayExponent `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.ADRAckDelayExponent" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *MACCommand_RelayUpdateUplinkListReq) GetForwardLimits() *RelayForwardLimits {
+	if m != nil {
+		return m.ForwardLimits
+	}
+	return nil
 }
 
-func (m *ADRAckDelayExponentValue) Reset()      { *m = ADRAckDelayExponentValue{} }
-func (*ADRAckDelayExponentValue) ProtoMessage() {}
-func (*ADRAckDelayExponentValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{27}
+func (m *MACCommand_RelayUpdateUplinkListReq) GetWFCnt() uint32 {
+	if m != nil {
+		return m.WFCnt
+	}
+	return 0
 }
-func (m *ADRAckDelayExponentValue) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ADRAckDelayExponentValue.Unmarshal(m, b)
+
+type MACCommand_RelayCtrlUplinkListReq struct {
+	RuleIndex            uint32                    `protobuf:"varint,1,opt,name=rule_index,json=ruleIndex,proto3" json:"rule_index,omitempty"`
+	Action               RelayCtrlUplinkListAction `protobuf:"varint,2,opt,name=action,proto3,enum=ttn.lorawan.v3.RelayCtrlUplinkListAction" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
-func (m *ADRAckDelayExponentValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ADRAckDelayExponentValue.Marshal(b, m, deterministic)
+
+func (m *MACCommand_RelayCtrlUplinkListReq) Reset()      { *m = MACCommand_RelayCtrlUplinkListReq{} }
+func (*MACCommand_RelayCtrlUplinkListReq) ProtoMessage() {}
+func (*MACCommand_RelayCtrlUplinkListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 37}
 }
-func (m *ADRAckDelayExponentValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ADRAckDelayExponentValue.Merge(m, src)
+func (m *MACCommand_RelayCtrlUplinkListReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MACCommand_RelayCtrlUplinkListReq.Unmarshal(m, b)
 }
-func (m *ADRAckDelayExponentValue) XXX_Size() int {
-	return xxx_messageInfo_ADRAckDelayExponentValue.Size(m)
+func (m *MACCommand_RelayCtrlUplinkListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MACCommand_RelayCtrlUplinkListReq.Marshal(b, m, deterministic)
 }
-func (m *ADRAckDelayExponentValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_ADRAckDelayExponentValue.DiscardUnknown(m)
+func (m *MACCommand_RelayCtrlUplinkListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayCtrlUplinkListReq.Merge(m, src)
+}
+func (m *MACCommand_RelayCtrlUplinkListReq) XXX_Size() int {
+	return xxx_messageInfo_MACCommand_RelayCtrlUplinkListReq.Size(m)
+}
+func (m *MACCommand_RelayCtrlUplinkListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayCtrlUplinkListReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ADRAckDelayExponentValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayCtrlUplinkListReq proto.InternalMessageInfo
 
-func (m *ADRAckDelayExponentValue) GetValue() ADRAckDelayExponent {
+func (m *MACCommand_RelayCtrlUplinkListReq) GetRuleIndex() uint32 {
 	if m != nil {
-		return m.Value
+		return m.RuleIndex
 	}
-	return ADR_ACK_DELAY_1
+	return 0
 }
 
-type DeviceEIRPValue struct {
-	Value                DeviceEIRP `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.DeviceEIRP" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *MACCommand_RelayCtrlUplinkListReq) GetAction() RelayCtrlUplinkListAction {
+	if m != nil {
+		return m.Action
+	}
+	return RELAY_CTRL_UPLINK_LIST_ACTION_READ_W_F_CNT
 }
 
-func (m *DeviceEIRPValue) Reset()      { *m = DeviceEIRPValue{} }
-func (*DeviceEIRPValue) ProtoMessage() {}
-func (*DeviceEIRPValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{28}
+type MACCommand_RelayCtrlUplinkListAns struct {
+	RuleIndexAck bool `protobuf:"varint,1,opt,name=rule_index_ack,json=ruleIndexAck,proto3" json:"rule_index_ack,omitempty"`
+	// Wake-on-radio frame counter of the served end device.
+	WFCnt                uint32   `protobuf:"varint,2,opt,name=w_f_cnt,json=wFCnt,proto3" json:"w_f_cnt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *DeviceEIRPValue) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeviceEIRPValue.Unmarshal(m, b)
+
+func (m *MACCommand_RelayCtrlUplinkListAns) Reset()      { *m = MACCommand_RelayCtrlUplinkListAns{} }
+func (*MACCommand_RelayCtrlUplinkListAns) ProtoMessage() {}
+func (*MACCommand_RelayCtrlUplinkListAns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 38}
 }
-func (m *DeviceEIRPValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeviceEIRPValue.Marshal(b, m, deterministic)
+func (m *MACCommand_RelayCtrlUplinkListAns) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MACCommand_RelayCtrlUplinkListAns.Unmarshal(m, b)
 }
-func (m *DeviceEIRPValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeviceEIRPValue.Merge(m, src)
+func (m *MACCommand_RelayCtrlUplinkListAns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MACCommand_RelayCtrlUplinkListAns.Marshal(b, m, deterministic)
 }
-func (m *DeviceEIRPValue) XXX_Size() int {
-	return xxx_messageInfo_DeviceEIRPValue.Size(m)
+func (m *MACCommand_RelayCtrlUplinkListAns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayCtrlUplinkListAns.Merge(m, src)
 }
-func (m *DeviceEIRPValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeviceEIRPValue.DiscardUnknown(m)
+func (m *MACCommand_RelayCtrlUplinkListAns) XXX_Size() int {
+	return xxx_messageInfo_MACCommand_RelayCtrlUplinkListAns.Size(m)
+}
+func (m *MACCommand_RelayCtrlUplinkListAns) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayCtrlUplinkListAns.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeviceEIRPValue proto.InternalMessageInfo
+var xxx_messageInfo_MACCommand_RelayCtrlUplinkListAns proto.InternalMessageInfo
 
-func (m *DeviceEIRPValue) GetValue() DeviceEIRP {
+func (m *MACCommand_RelayCtrlUplinkListAns) GetRuleIndexAck() bool {
 	if m != nil {
-		return m.Value
+		return m.RuleIndexAck
 	}
-	return DEVICE_EIRP_8
+	return false
 }
 
-func init() {
-	proto.RegisterEnum("ttn.lorawan.v3.MType", MType_name, MType_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.MType", MType_name, MType_value)
-	proto.RegisterEnum("ttn.lorawan.v3.Major", Major_name, Major_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.Major", Major_name, Major_value)
-	proto.RegisterEnum("ttn.lorawan.v3.MACVersion", MACVersion_name, MACVersion_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.MACVersion", MACVersion_name, MACVersion_value)
-	proto.RegisterEnum("ttn.lorawan.v3.PHYVersion", PHYVersion_name, PHYVersion_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.PHYVersion", PHYVersion_name, PHYVersion_value)
-	proto.RegisterEnum("ttn.lorawan.v3.DataRateIndex", DataRateIndex_name, DataRateIndex_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.DataRateIndex", DataRateIndex_name, DataRateIndex_value)
-	proto.RegisterEnum("ttn.lorawan.v3.DataRateOffset", DataRateOffset_name, DataRateOffset_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.DataRateOffset", DataRateOffset_name, DataRateOffset_value)
-	proto.RegisterEnum("ttn.lorawan.v3.JoinRequestType", JoinRequestType_name, JoinRequestType_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.JoinRequestType", JoinRequestType_name, JoinRequestType_value)
-	proto.RegisterEnum("ttn.lorawan.v3.RejoinRequestType", RejoinRequestType_name, RejoinRequestType_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinRequestType", RejoinRequestType_name, RejoinRequestType_value)
-	proto.RegisterEnum("ttn.lorawan.v3.CFListType", CFListType_name, CFListType_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.CFListType", CFListType_name, CFListType_value)
-	proto.RegisterEnum("ttn.lorawan.v3.Class", Class_name, Class_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.Class", Class_name, Class_value)
-	proto.RegisterEnum("ttn.lorawan.v3.TxSchedulePriority", TxSchedulePriority_name, TxSchedulePriority_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.TxSchedulePriority", TxSchedulePriority_name, TxSchedulePriority_value)
-	proto.RegisterEnum("ttn.lorawan.v3.MACCommandIdentifier", MACCommandIdentifier_name, MACCommandIdentifier_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.MACCommandIdentifier", MACCommandIdentifier_name, MACCommandIdentifier_value)
-	proto.RegisterEnum("ttn.lorawan.v3.AggregatedDutyCycle", AggregatedDutyCycle_name, AggregatedDutyCycle_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.AggregatedDutyCycle", AggregatedDutyCycle_name, AggregatedDutyCycle_value)
-	proto.RegisterEnum("ttn.lorawan.v3.PingSlotPeriod", PingSlotPeriod_name, PingSlotPeriod_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.PingSlotPeriod", PingSlotPeriod_name, PingSlotPeriod_value)
-	proto.RegisterEnum("ttn.lorawan.v3.RejoinCountExponent", RejoinCountExponent_name, RejoinCountExponent_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinCountExponent", RejoinCountExponent_name, RejoinCountExponent_value)
-	proto.RegisterEnum("ttn.lorawan.v3.RejoinTimeExponent", RejoinTimeExponent_name, RejoinTimeExponent_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinTimeExponent", RejoinTimeExponent_name, RejoinTimeExponent_value)
-	proto.RegisterEnum("ttn.lorawan.v3.RejoinPeriodExponent", RejoinPeriodExponent_name, RejoinPeriodExponent_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinPeriodExponent", RejoinPeriodExponent_name, RejoinPeriodExponent_value)
-	proto.RegisterEnum("ttn.lorawan.v3.DeviceEIRP", DeviceEIRP_name, DeviceEIRP_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.DeviceEIRP", DeviceEIRP_name, DeviceEIRP_value)
-	proto.RegisterEnum("ttn.lorawan.v3.ADRAckLimitExponent", ADRAckLimitExponent_name, ADRAckLimitExponent_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.ADRAckLimitExponent", ADRAckLimitExponent_name, ADRAckLimitExponent_value)
-	proto.RegisterEnum("ttn.lorawan.v3.ADRAckDelayExponent", ADRAckDelayExponent_name, ADRAckDelayExponent_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.ADRAckDelayExponent", ADRAckDelayExponent_name, ADRAckDelayExponent_value)
-	proto.RegisterEnum("ttn.lorawan.v3.RxDelay", RxDelay_name, RxDelay_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.RxDelay", RxDelay_name, RxDelay_value)
-	proto.RegisterEnum("ttn.lorawan.v3.Minor", Minor_name, Minor_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.Minor", Minor_name, Minor_value)
-	proto.RegisterType((*Message)(nil), "ttn.lorawan.v3.Message")
-	golang_proto.RegisterType((*Message)(nil), "ttn.lorawan.v3.Message")
-	proto.RegisterType((*MHDR)(nil), "ttn.lorawan.v3.MHDR")
-	golang_proto.RegisterType((*MHDR)(nil), "ttn.lorawan.v3.MHDR")
-	proto.RegisterType((*MACPayload)(nil), "ttn.lorawan.v3.MACPayload")
-	golang_proto.RegisterType((*MACPayload)(nil), "ttn.lorawan.v3.MACPayload")
-	proto.RegisterType((*FHDR)(nil), "ttn.lorawan.v3.FHDR")
-	golang_proto.RegisterType((*FHDR)(nil), "ttn.lorawan.v3.FHDR")
-	proto.RegisterType((*FCtrl)(nil), "ttn.lorawan.v3.FCtrl")
-	golang_proto.RegisterType((*FCtrl)(nil), "ttn.lorawan.v3.FCtrl")
-	proto.RegisterType((*JoinRequestPayload)(nil), "ttn.lorawan.v3.JoinRequestPayload")
-	golang_proto.RegisterType((*JoinRequestPayload)(nil), "ttn.lorawan.v3.JoinRequestPayload")
-	proto.RegisterType((*RejoinRequestPayload)(nil), "ttn.lorawan.v3.RejoinRequestPayload")
-	golang_proto.RegisterType((*RejoinRequestPayload)(nil), "ttn.lorawan.v3.RejoinRequestPayload")
-	proto.RegisterType((*JoinAcceptPayload)(nil), "ttn.lorawan.v3.JoinAcceptPayload")
-	golang_proto.RegisterType((*JoinAcceptPayload)(nil), "ttn.lorawan.v3.JoinAcceptPayload")
-	proto.RegisterType((*DLSettings)(nil), "ttn.lorawan.v3.DLSettings")
-	golang_proto.RegisterType((*DLSettings)(nil), "ttn.lorawan.v3.DLSettings")
-	proto.RegisterType((*CFList)(nil), "ttn.lorawan.v3.CFList")
-	golang_proto.RegisterType((*CFList)(nil), "ttn.lorawan.v3.CFList")
-	proto.RegisterType((*LoRaDataRate)(nil), "ttn.lorawan.v3.LoRaDataRate")
-	golang_proto.RegisterType((*LoRaDataRate)(nil), "ttn.lorawan.v3.LoRaDataRate")
-	proto.RegisterType((*FSKDataRate)(nil), "ttn.lorawan.v3.FSKDataRate")
-	golang_proto.RegisterType((*FSKDataRate)(nil), "ttn.lorawan.v3.FSKDataRate")
-	proto.RegisterType((*LRFHSSDataRate)(nil), "ttn.lorawan.v3.LRFHSSDataRate")
-	golang_proto.RegisterType((*LRFHSSDataRate)(nil), "ttn.lorawan.v3.LRFHSSDataRate")
-	proto.RegisterType((*DataRate)(nil), "ttn.lorawan.v3.DataRate")
-	golang_proto.RegisterType((*DataRate)(nil), "ttn.lorawan.v3.DataRate")
-	proto.RegisterType((*TxSettings)(nil), "ttn.lorawan.v3.TxSettings")
-	golang_proto.RegisterType((*TxSettings)(nil), "ttn.lorawan.v3.TxSettings")
-	proto.RegisterType((*TxSettings_Downlink)(nil), "ttn.lorawan.v3.TxSettings.Downlink")
-	golang_proto.RegisterType((*TxSettings_Downlink)(nil), "ttn.lorawan.v3.TxSettings.Downlink")
-	proto.RegisterType((*GatewayAntennaIdentifiers)(nil), "ttn.lorawan.v3.GatewayAntennaIdentifiers")
-	golang_proto.RegisterType((*GatewayAntennaIdentifiers)(nil), "ttn.lorawan.v3.GatewayAntennaIdentifiers")
-	proto.RegisterType((*UplinkToken)(nil), "ttn.lorawan.v3.UplinkToken")
-	golang_proto.RegisterType((*UplinkToken)(nil), "ttn.lorawan.v3.UplinkToken")
-	proto.RegisterType((*DownlinkPath)(nil), "ttn.lorawan.v3.DownlinkPath")
-	golang_proto.RegisterType((*DownlinkPath)(nil), "ttn.lorawan.v3.DownlinkPath")
-	proto.RegisterType((*TxRequest)(nil), "ttn.lorawan.v3.TxRequest")
-	golang_proto.RegisterType((*TxRequest)(nil), "ttn.lorawan.v3.TxRequest")
-	proto.RegisterType((*MACCommand)(nil), "ttn.lorawan.v3.MACCommand")
-	golang_proto.RegisterType((*MACCommand)(nil), "ttn.lorawan.v3.MACCommand")
-	proto.RegisterType((*MACCommand_ResetInd)(nil), "ttn.lorawan.v3.MACCommand.ResetInd")
-	golang_proto.RegisterType((*MACCommand_ResetInd)(nil), "ttn.lorawan.v3.MACCommand.ResetInd")
-	proto.RegisterType((*MACCommand_ResetConf)(nil), "ttn.lorawan.v3.MACCommand.ResetConf")
-	golang_proto.RegisterType((*MACCommand_ResetConf)(nil), "ttn.lorawan.v3.MACCommand.ResetConf")
-	proto.RegisterType((*MACCommand_LinkCheckAns)(nil), "ttn.lorawan.v3.MACCommand.LinkCheckAns")
-	golang_proto.RegisterType((*MACCommand_LinkCheckAns)(nil), "ttn.lorawan.v3.MACCommand.LinkCheckAns")
-	proto.RegisterType((*MACCommand_LinkADRReq)(nil), "ttn.lorawan.v3.MACCommand.LinkADRReq")
-	golang_proto.RegisterType((*MACCommand_LinkADRReq)(nil), "ttn.lorawan.v3.MACCommand.LinkADRReq")
-	proto.RegisterType((*MACCommand_LinkADRAns)(nil), "ttn.lorawan.v3.MACCommand.LinkADRAns")
-	golang_proto.RegisterType((*MACCommand_LinkADRAns)(nil), "ttn.lorawan.v3.MACCommand.LinkADRAns")
-	proto.RegisterType((*MACCommand_DutyCycleReq)(nil), "ttn.lorawan.v3.MACCommand.DutyCycleReq")
-	golang_proto.RegisterType((*MACCommand_DutyCycleReq)(nil), "ttn.lorawan.v3.MACCommand.DutyCycleReq")
-	proto.RegisterType((*MACCommand_RxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupReq")
-	golang_proto.RegisterType((*MACCommand_RxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupReq")
-	proto.RegisterType((*MACCommand_RxParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupAns")
-	golang_proto.RegisterType((*MACCommand_RxParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupAns")
-	proto.RegisterType((*MACCommand_DevStatusAns)(nil), "ttn.lorawan.v3.MACCommand.DevStatusAns")
-	golang_proto.RegisterType((*MACCommand_DevStatusAns)(nil), "ttn.lorawan.v3.MACCommand.DevStatusAns")
-	proto.RegisterType((*MACCommand_NewChannelReq)(nil), "ttn.lorawan.v3.MACCommand.NewChannelReq")
-	golang_proto.RegisterType((*MACCommand_NewChannelReq)(nil), "ttn.lorawan.v3.MACCommand.NewChannelReq")
-	proto.RegisterType((*MACCommand_NewChannelAns)(nil), "ttn.lorawan.v3.MACCommand.NewChannelAns")
-	golang_proto.RegisterType((*MACCommand_NewChannelAns)(nil), "ttn.lorawan.v3.MACCommand.NewChannelAns")
-	proto.RegisterType((*MACCommand_DLChannelReq)(nil), "ttn.lorawan.v3.MACCommand.DLChannelReq")
-	golang_proto.RegisterType((*MACCommand_DLChannelReq)(nil), "ttn.lorawan.v3.MACCommand.DLChannelReq")
-	proto.RegisterType((*MACCommand_DLChannelAns)(nil), "ttn.lorawan.v3.MACCommand.DLChannelAns")
-	golang_proto.RegisterType((*MACCommand_DLChannelAns)(nil), "ttn.lorawan.v3.MACCommand.DLChannelAns")
-	proto.RegisterType((*MACCommand_RxTimingSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxTimingSetupReq")
-	golang_proto.RegisterType((*MACCommand_RxTimingSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxTimingSetupReq")
-	proto.RegisterType((*MACCommand_TxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.TxParamSetupReq")
-	golang_proto.RegisterType((*MACCommand_TxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.TxParamSetupReq")
-	proto.RegisterType((*MACCommand_RekeyInd)(nil), "ttn.lorawan.v3.MACCommand.RekeyInd")
-	golang_proto.RegisterType((*MACCommand_RekeyInd)(nil), "ttn.lorawan.v3.MACCommand.RekeyInd")
-	proto.RegisterType((*MACCommand_RekeyConf)(nil), "ttn.lorawan.v3.MACCommand.RekeyConf")
-	golang_proto.RegisterType((*MACCommand_RekeyConf)(nil), "ttn.lorawan.v3.MACCommand.RekeyConf")
-	proto.RegisterType((*MACCommand_ADRParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.ADRParamSetupReq")
-	golang_proto.RegisterType((*MACCommand_ADRParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.ADRParamSetupReq")
-	proto.RegisterType((*MACCommand_DeviceTimeAns)(nil), "ttn.lorawan.v3.MACCommand.DeviceTimeAns")
-	golang_proto.RegisterType((*MACCommand_DeviceTimeAns)(nil), "ttn.lorawan.v3.MACCommand.DeviceTimeAns")
-	proto.RegisterType((*MACCommand_ForceRejoinReq)(nil), "ttn.lorawan.v3.MACCommand.ForceRejoinReq")
-	golang_proto.RegisterType((*MACCommand_ForceRejoinReq)(nil), "ttn.lorawan.v3.MACCommand.ForceRejoinReq")
-	proto.RegisterType((*MACCommand_RejoinParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupReq")
-	golang_proto.RegisterType((*MACCommand_RejoinParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupReq")
-	proto.RegisterType((*MACCommand_RejoinParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupAns")
-	golang_proto.RegisterType((*MACCommand_RejoinParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupAns")
-	proto.RegisterType((*MACCommand_PingSlotInfoReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotInfoReq")
-	golang_proto.RegisterType((*MACCommand_PingSlotInfoReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotInfoReq")
-	proto.RegisterType((*MACCommand_PingSlotChannelReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelReq")
-	golang_proto.RegisterType((*MACCommand_PingSlotChannelReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelReq")
-	proto.RegisterType((*MACCommand_PingSlotChannelAns)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelAns")
-	golang_proto.RegisterType((*MACCommand_PingSlotChannelAns)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelAns")
-	proto.RegisterType((*MACCommand_BeaconTimingAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconTimingAns")
-	golang_proto.RegisterType((*MACCommand_BeaconTimingAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconTimingAns")
-	proto.RegisterType((*MACCommand_BeaconFreqReq)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqReq")
-	golang_proto.RegisterType((*MACCommand_BeaconFreqReq)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqReq")
-	proto.RegisterType((*MACCommand_BeaconFreqAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqAns")
-	golang_proto.RegisterType((*MACCommand_BeaconFreqAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqAns")
-	proto.RegisterType((*MACCommand_DeviceModeInd)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeInd")
-	golang_proto.RegisterType((*MACCommand_DeviceModeInd)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeInd")
-	proto.RegisterType((*MACCommand_DeviceModeConf)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeConf")
-	golang_proto.RegisterType((*MACCommand_DeviceModeConf)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeConf")
-	proto.RegisterType((*FrequencyValue)(nil), "ttn.lorawan.v3.FrequencyValue")
-	golang_proto.RegisterType((*FrequencyValue)(nil), "ttn.lorawan.v3.FrequencyValue")
-	proto.RegisterType((*DataRateOffsetValue)(nil), "ttn.lorawan.v3.DataRateOffsetValue")
-	golang_proto.RegisterType((*DataRateOffsetValue)(nil), "ttn.lorawan.v3.DataRateOffsetValue")
-	proto.RegisterType((*DataRateIndexValue)(nil), "ttn.lorawan.v3.DataRateIndexValue")
-	golang_proto.RegisterType((*DataRateIndexValue)(nil), "ttn.lorawan.v3.DataRateIndexValue")
-	proto.RegisterType((*PingSlotPeriodValue)(nil), "ttn.lorawan.v3.PingSlotPeriodValue")
-	golang_proto.RegisterType((*PingSlotPeriodValue)(nil), "ttn.lorawan.v3.PingSlotPeriodValue")
-	proto.RegisterType((*AggregatedDutyCycleValue)(nil), "ttn.lorawan.v3.AggregatedDutyCycleValue")
-	golang_proto.RegisterType((*AggregatedDutyCycleValue)(nil), "ttn.lorawan.v3.AggregatedDutyCycleValue")
-	proto.RegisterType((*RxDelayValue)(nil), "ttn.lorawan.v3.RxDelayValue")
-	golang_proto.RegisterType((*RxDelayValue)(nil), "ttn.lorawan.v3.RxDelayValue")
-	proto.RegisterType((*ADRAckLimitExponentValue)(nil), "ttn.lorawan.v3.ADRAckLimitExponentValue")
-	golang_proto.RegisterType((*ADRAckLimitExponentValue)(nil), "ttn.lorawan.v3.ADRAckLimitExponentValue")
-	proto.RegisterType((*ADRAckDelayExponentValue)(nil), "ttn.lorawan.v3.ADRAckDelayExponentValue")
-	golang_proto.RegisterType((*ADRAckDelayExponentValue)(nil), "ttn.lorawan.v3.ADRAckDelayExponentValue")
-	proto.RegisterType((*DeviceEIRPValue)(nil), "ttn.lorawan.v3.DeviceEIRPValue")
-	golang_proto.RegisterType((*DeviceEIRPValue)(nil), "ttn.lorawan.v3.DeviceEIRPValue")
+func (m *MACCommand_RelayCtrlUplinkListAns) GetWFCnt() uint32 {
+	if m != nil {
+		return m.WFCnt
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("lorawan-stack/api/lorawan.proto", fileDescriptor_2084d1d5a227b67e) }
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/lorawan.proto", fileDescriptor_2084d1d5a227b67e)
+type MACCommand_RelayNotifyNewEndDeviceReq struct {
+	DevAddr go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr `protobuf:"bytes,1,opt,name=dev_addr,json=devAddr,proto3,customtype=go.thethings.network/lorawan-stack/v3/pkg/types.DevAddr" json:"dev_addr"`
+	// Signal-to-noise ratio (dB) of the uplink received by the relay.
+	Snr int32 `protobuf:"varint,2,opt,name=snr,proto3" json:"snr,omitempty"`
+	// Received signal strength indicator (dBm) of the uplink received by the relay.
+	Rssi                 int32    `protobuf:"varint,3,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-var fileDescriptor_2084d1d5a227b67e = []byte{
-	// 5880 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7b, 0x4b, 0x70, 0x1b, 0x49,
-	0x72, 0x36, 0x1b, 0x0f, 0x02, 0x4c, 0xbc, 0x9a, 0x45, 0x8d, 0x04, 0x62, 0x66, 0x29, 0x2d, 0x67,
-	0xfe, 0x7f, 0x35, 0x9c, 0x10, 0x45, 0x80, 0x0f, 0x51, 0xf3, 0xef, 0x63, 0x00, 0x10, 0x1c, 0x42,
-	0xe2, 0x6b, 0x1b, 0x90, 0x34, 0xda, 0xc7, 0xdf, 0x7f, 0x13, 0xdd, 0x20, 0x21, 0x02, 0xdd, 0x98,
-	0x46, 0x93, 0x22, 0xf7, 0xbf, 0xd8, 0xbb, 0x3e, 0x38, 0xd6, 0x61, 0x7b, 0x62, 0x6f, 0x3e, 0xf8,
-	0x11, 0x61, 0x3b, 0xd6, 0x96, 0x7d, 0x70, 0xac, 0x4f, 0x8e, 0xf0, 0x61, 0x23, 0x1c, 0x0e, 0x3b,
-	0x1c, 0x3e, 0xf8, 0x68, 0xef, 0x61, 0x6d, 0xcf, 0xfa, 0xb0, 0x41, 0x47, 0x38, 0x7c, 0xf0, 0xc1,
-	0xa1, 0x83, 0xc7, 0x91, 0x55, 0xd5, 0xe8, 0xea, 0x6e, 0x50, 0x24, 0x77, 0xe5, 0xb9, 0x0c, 0xeb,
-	0xab, 0xaa, 0x2f, 0xb3, 0x32, 0xb3, 0xb2, 0xb2, 0xaa, 0x21, 0xb8, 0xd9, 0xb5, 0x6c, 0xed, 0xb9,
-	0x66, 0xde, 0x19, 0x38, 0x5a, 0xeb, 0xf0, 0xae, 0xd6, 0xef, 0xdc, 0xe5, 0xc8, 0x7c, 0xdf, 0xb6,
-	0x1c, 0x8b, 0x64, 0x1d, 0xc7, 0x9c, 0x77, 0xa1, 0xe3, 0xc5, 0x42, 0x79, 0xbf, 0xe3, 0x1c, 0x1c,
-	0xed, 0xcd, 0xb7, 0xac, 0xde, 0x5d, 0xc3, 0x3c, 0xb6, 0x4e, 0xfb, 0xb6, 0x75, 0x72, 0x7a, 0x97,
-	0x0e, 0x6e, 0xdd, 0xd9, 0x37, 0xcc, 0x3b, 0xc7, 0x5a, 0xb7, 0xa3, 0x6b, 0x8e, 0x71, 0x37, 0xf4,
-	0x07, 0xa3, 0x2c, 0xdc, 0x11, 0x28, 0xf6, 0xad, 0x7d, 0x8b, 0x4d, 0xde, 0x3b, 0x6a, 0xd3, 0x16,
-	0x6d, 0xd0, 0xbf, 0xf8, 0xf0, 0xaa, 0x30, 0xbc, 0x79, 0x60, 0x34, 0x0f, 0x3a, 0xe6, 0xfe, 0xa0,
-	0x6e, 0xea, 0x47, 0x03, 0xc7, 0xee, 0x18, 0x03, 0x51, 0xf4, 0xbe, 0x75, 0xe7, 0xd9, 0xc0, 0x32,
-	0xef, 0x6a, 0xa6, 0x69, 0x39, 0x9a, 0xd3, 0xb1, 0xcc, 0x01, 0x27, 0x79, 0x6b, 0xdf, 0xb2, 0xf6,
-	0xbb, 0x86, 0x27, 0x6a, 0xe0, 0xd8, 0x47, 0x2d, 0x87, 0xf7, 0xde, 0x0c, 0xf6, 0x3a, 0x9d, 0x9e,
-	0x31, 0x70, 0xb4, 0x5e, 0x9f, 0x0f, 0x78, 0x3b, 0x6c, 0xa6, 0x8e, 0x6e, 0x98, 0x4e, 0xa7, 0xdd,
-	0x31, 0x6c, 0x2e, 0x63, 0xf6, 0x2f, 0xa2, 0x90, 0xd8, 0x32, 0x06, 0x03, 0x6d, 0xdf, 0x20, 0xff,
-	0x07, 0xe2, 0x3d, 0xf5, 0x40, 0xb7, 0xf3, 0xd2, 0x2d, 0xe9, 0x76, 0xaa, 0x74, 0x6d, 0xde, 0x6f,
-	0xc6, 0xf9, 0xad, 0x8d, 0x35, 0xa5, 0x22, 0xbf, 0xac, 0xc4, 0xbf, 0x2b, 0x45, 0x64, 0xe9, 0xaf,
-	0x7f, 0x7c, 0x73, 0xec, 0xef, 0x7e, 0x7c, 0x53, 0x52, 0x62, 0xbd, 0x0d, 0xdd, 0x26, 0x6f, 0x42,
-	0xb4, 0xd7, 0x69, 0xe5, 0x23, 0xb7, 0xa4, 0xdb, 0xe9, 0xca, 0xc4, 0xcb, 0xca, 0xf8, 0xb7, 0x62,
-	0xf2, 0x58, 0x3e, 0xa6, 0x20, 0x4a, 0xbe, 0x04, 0xa9, 0x9e, 0xd6, 0x52, 0xfb, 0xda, 0x69, 0xd7,
-	0xd2, 0xf4, 0x7c, 0x94, 0xf2, 0x17, 0x42, 0xfc, 0xe5, 0xea, 0x2e, 0x1b, 0xb1, 0x31, 0xa6, 0x40,
-	0x4f, 0x6b, 0xf1, 0x16, 0x79, 0x0c, 0xd7, 0x9e, 0x59, 0x1d, 0x53, 0xb5, 0x8d, 0x8f, 0x8f, 0x8c,
-	0x81, 0x33, 0xe4, 0x89, 0x51, 0x9e, 0xd9, 0x20, 0xcf, 0x03, 0xab, 0x63, 0x2a, 0x6c, 0xa8, 0xc7,
-	0x47, 0x9e, 0x85, 0x50, 0xd2, 0x80, 0x29, 0xca, 0xab, 0xb5, 0x5a, 0x46, 0xdf, 0xa3, 0x8d, 0x53,
-	0xda, 0xcf, 0x8f, 0xa2, 0x2d, 0xd3, 0x91, 0x1e, 0xeb, 0xe4, 0xb3, 0x20, 0x48, 0xbe, 0x01, 0xd7,
-	0x6d, 0x63, 0xa4, 0xba, 0xe3, 0x94, 0xf7, 0x9d, 0x20, 0xaf, 0x62, 0x3c, 0x1b, 0xa5, 0xf0, 0x35,
-	0x7b, 0x04, 0x5e, 0xc9, 0x42, 0xc2, 0x15, 0x14, 0xfd, 0xcf, 0x8a, 0xf4, 0x20, 0x96, 0x4c, 0xc8,
-	0xc9, 0xd9, 0x23, 0x88, 0xa1, 0x73, 0xc8, 0x0a, 0x8c, 0xf7, 0x54, 0xe7, 0xb4, 0x6f, 0x50, 0x17,
-	0x66, 0x4b, 0x6f, 0x84, 0x4c, 0xdc, 0x3c, 0xed, 0x1b, 0x95, 0xe4, 0xcb, 0x4a, 0xfc, 0xdb, 0xe8,
-	0x43, 0x25, 0xde, 0x43, 0x80, 0x2c, 0x43, 0xbc, 0xa7, 0x3d, 0xb3, 0x6c, 0xea, 0xbe, 0x51, 0xd3,
-	0xb0, 0xd3, 0x37, 0x0d, 0x81, 0xd9, 0x7f, 0x95, 0x00, 0x3c, 0xa7, 0x61, 0xfc, 0xb4, 0x5f, 0x15,
-	0x3f, 0xeb, 0xe7, 0xc4, 0x4f, 0x1b, 0xe3, 0xe7, 0x26, 0x8c, 0xb7, 0xd5, 0xbe, 0x65, 0x3b, 0x54,
-	0x87, 0x0c, 0x15, 0x36, 0x17, 0xcd, 0x7f, 0x26, 0x29, 0xf1, 0xf6, 0xae, 0x65, 0x3b, 0xe4, 0x26,
-	0xa4, 0xda, 0x76, 0xcf, 0x17, 0x43, 0x69, 0x05, 0xda, 0x76, 0xcf, 0x15, 0xff, 0x01, 0xe4, 0x74,
-	0xa3, 0x65, 0xe9, 0x86, 0x1e, 0x08, 0x90, 0x1b, 0xf3, 0x6c, 0xab, 0xcc, 0xbb, 0x5b, 0x65, 0xbe,
-	0x41, 0x37, 0x92, 0x92, 0xe5, 0xe3, 0x5d, 0x86, 0xb7, 0x00, 0xda, 0x47, 0xdd, 0xae, 0xda, 0x56,
-	0x5b, 0xa6, 0x43, 0xc3, 0x20, 0xa3, 0x24, 0x11, 0x59, 0xaf, 0x9a, 0xce, 0xec, 0xa7, 0x12, 0xc4,
-	0x70, 0x09, 0xe4, 0x6b, 0x90, 0xd4, 0x8d, 0x63, 0x55, 0xd3, 0xf9, 0x52, 0xd3, 0x95, 0xaf, 0xe0,
-	0x62, 0x7e, 0xf4, 0xe3, 0x9b, 0xf7, 0xf6, 0xad, 0x79, 0xe7, 0xc0, 0x70, 0xe8, 0x76, 0x9f, 0x37,
-	0x0d, 0xe7, 0xb9, 0x65, 0x1f, 0xde, 0xf5, 0xef, 0xc3, 0xe3, 0xc5, 0xbb, 0xfd, 0xc3, 0xfd, 0xbb,
-	0xe8, 0xa5, 0xc1, 0xfc, 0x9a, 0x71, 0x5c, 0xd6, 0x75, 0x5b, 0x49, 0xe8, 0xec, 0x0f, 0xf2, 0x65,
-	0x34, 0x43, 0xcb, 0xb1, 0xbb, 0xd4, 0x0c, 0xa9, 0xb0, 0x2b, 0xd6, 0xab, 0x8e, 0xdd, 0x1d, 0x61,
-	0xc5, 0x78, 0x1b, 0x3b, 0xc8, 0x0c, 0xfa, 0x00, 0xb5, 0x8f, 0x52, 0x2b, 0xe2, 0x46, 0x9c, 0x8b,
-	0xe5, 0x3f, 0xfb, 0x2c, 0xaa, 0xc4, 0xda, 0x55, 0xd3, 0x21, 0x33, 0xc8, 0x6f, 0xf5, 0x9d, 0x01,
-	0xb5, 0x4d, 0xba, 0x92, 0x78, 0x59, 0x89, 0x7d, 0x2b, 0x92, 0xcf, 0x29, 0xf1, 0xf6, 0x4e, 0xdf,
-	0x19, 0xcc, 0xfe, 0xa2, 0x04, 0x71, 0x2a, 0x82, 0xc8, 0x10, 0xd5, 0xf8, 0x02, 0x93, 0x0a, 0xfe,
-	0x49, 0x66, 0x20, 0xa5, 0xe9, 0xb6, 0xaa, 0xb5, 0x0e, 0x31, 0xb4, 0xa9, 0x82, 0x49, 0x65, 0x42,
-	0xd3, 0xed, 0x72, 0xeb, 0x50, 0x31, 0x3e, 0xa6, 0x33, 0x5a, 0x87, 0x54, 0x32, 0xce, 0x68, 0x1d,
-	0x92, 0x37, 0x61, 0xa2, 0xad, 0xf6, 0x0d, 0x53, 0xef, 0x98, 0xfb, 0x54, 0x60, 0x52, 0x49, 0xb6,
-	0x77, 0x59, 0x9b, 0xdc, 0x80, 0x44, 0xab, 0xab, 0x0d, 0x06, 0xea, 0x1e, 0x35, 0x75, 0x52, 0x19,
-	0xa7, 0xcd, 0xca, 0xec, 0xef, 0x47, 0x80, 0x84, 0xf7, 0x30, 0xf9, 0x08, 0x92, 0x74, 0x5b, 0x19,
-	0x47, 0x1d, 0x6e, 0xf6, 0x2f, 0x71, 0xb3, 0x2f, 0x5f, 0xd5, 0xec, 0xb5, 0x47, 0xf5, 0x95, 0x25,
-	0x25, 0x81, 0x74, 0xb5, 0xa3, 0x0e, 0x79, 0x0c, 0x68, 0x7f, 0x4a, 0x1c, 0x79, 0x1d, 0xc4, 0xe3,
-	0xba, 0x71, 0x8c, 0xbc, 0xdf, 0x84, 0x09, 0xe4, 0x35, 0x2d, 0xb3, 0x65, 0xb0, 0x80, 0xad, 0x7c,
-	0xc0, 0x99, 0x57, 0x7f, 0x86, 0x48, 0xd9, 0x46, 0x1e, 0x05, 0x63, 0x8f, 0xfe, 0x35, 0xfb, 0x2b,
-	0x51, 0xb8, 0x36, 0x2a, 0x79, 0x90, 0x4d, 0x48, 0xf1, 0x14, 0x24, 0xe4, 0x82, 0xcf, 0xbf, 0x32,
-	0xef, 0x04, 0xf2, 0x02, 0xb0, 0xf9, 0x34, 0x39, 0x34, 0x61, 0xdc, 0x34, 0x1c, 0xb5, 0xa3, 0xff,
-	0xbc, 0xc6, 0xd9, 0x36, 0x9c, 0xfa, 0x9a, 0x12, 0x37, 0x0d, 0xa7, 0xee, 0xf7, 0x66, 0xf4, 0x7f,
-	0xca, 0x9b, 0xb1, 0xd7, 0xe9, 0xcd, 0xcf, 0x01, 0xb7, 0x8a, 0x90, 0x1d, 0x26, 0x18, 0x82, 0xe9,
-	0xe1, 0x3b, 0x31, 0x98, 0x0c, 0x1d, 0x11, 0xe4, 0x2d, 0x98, 0x30, 0xcc, 0x96, 0x7d, 0xda, 0x77,
-	0x0c, 0x9d, 0x45, 0xad, 0xe2, 0x01, 0xe4, 0xff, 0x01, 0x50, 0x42, 0x16, 0x21, 0xcc, 0xbc, 0x65,
-	0xae, 0xed, 0xfd, 0xab, 0x6a, 0x8b, 0xc2, 0x59, 0x88, 0x4c, 0x3c, 0x73, 0xff, 0x14, 0x9c, 0x17,
-	0x7d, 0x8d, 0xce, 0x13, 0x33, 0x60, 0xec, 0x35, 0x67, 0xc0, 0x2d, 0x48, 0xe9, 0x5d, 0x75, 0x60,
-	0x38, 0x0e, 0x52, 0xf0, 0xc3, 0x38, 0x54, 0x2b, 0xac, 0x6d, 0x36, 0xf8, 0x88, 0x11, 0xb9, 0x10,
-	0xf4, 0xae, 0xdb, 0x4b, 0xbe, 0x08, 0x49, 0xfb, 0x44, 0xd5, 0x8d, 0xae, 0x76, 0x4a, 0x0f, 0xe0,
-	0x6c, 0xe9, 0x46, 0x68, 0x23, 0x9c, 0xac, 0x61, 0xb7, 0x10, 0xfe, 0x09, 0x9b, 0x41, 0xe4, 0x2e,
-	0x24, 0x5a, 0x6d, 0xb5, 0xdb, 0x19, 0x38, 0xf9, 0x04, 0x55, 0xe4, 0x7a, 0x70, 0x72, 0x75, 0x7d,
-	0xb3, 0x33, 0x70, 0x94, 0xf1, 0x56, 0x1b, 0xff, 0x3f, 0xfb, 0xa7, 0x12, 0x80, 0xa7, 0x1b, 0xd9,
-	0x84, 0x8c, 0x7d, 0x52, 0x54, 0x75, 0x5b, 0xb5, 0xda, 0xed, 0x81, 0xe1, 0xf0, 0xbd, 0x38, 0x13,
-	0x5a, 0x8e, 0xe6, 0x68, 0x8a, 0xe6, 0x18, 0x3b, 0x74, 0x94, 0xa0, 0x49, 0xca, 0x3e, 0x29, 0xae,
-	0xd9, 0x0c, 0xc6, 0xc3, 0xc1, 0x3e, 0x29, 0xa9, 0xba, 0x7b, 0x4e, 0x7f, 0xee, 0x3c, 0x9a, 0xba,
-	0xa9, 0x1b, 0x27, 0xe2, 0x79, 0x6d, 0x9f, 0x94, 0xd6, 0x6c, 0xcc, 0xb8, 0x56, 0xdf, 0x51, 0x4d,
-	0x63, 0x9f, 0x27, 0xe9, 0x71, 0xab, 0xef, 0x6c, 0x1b, 0xfb, 0xb3, 0x1f, 0xc3, 0x38, 0x5b, 0x07,
-	0x59, 0x85, 0x98, 0x90, 0x33, 0x0a, 0xa3, 0x57, 0x1b, 0x48, 0x16, 0x74, 0x06, 0x21, 0x10, 0x6b,
-	0xb3, 0x63, 0x21, 0x7a, 0x3b, 0xa3, 0xd0, 0xbf, 0xc9, 0x34, 0x24, 0x5b, 0x07, 0x6a, 0x4f, 0x1b,
-	0x1c, 0x0e, 0xf2, 0xd1, 0x5b, 0xd1, 0xdb, 0x49, 0x25, 0xd1, 0x3a, 0xd8, 0xc2, 0xe6, 0xec, 0x13,
-	0x48, 0x6f, 0x5a, 0x8a, 0xe6, 0x6a, 0x8c, 0x1b, 0x65, 0x4f, 0x33, 0xf5, 0xe7, 0x1d, 0xdd, 0x39,
-	0xa0, 0xd2, 0x33, 0x8a, 0x07, 0x90, 0x77, 0x41, 0x1e, 0xf4, 0x6d, 0x43, 0xc3, 0x83, 0x43, 0x6d,
-	0x6b, 0x2d, 0x87, 0xd7, 0x2a, 0x19, 0x25, 0x37, 0xc4, 0xd7, 0x29, 0x3c, 0x7b, 0x1b, 0x52, 0xeb,
-	0x8d, 0x87, 0x43, 0xde, 0x69, 0x48, 0xee, 0x75, 0x1c, 0xd5, 0xd6, 0x1c, 0x83, 0xd3, 0x26, 0xf6,
-	0x3a, 0x0e, 0x76, 0xcd, 0x7e, 0x4f, 0x82, 0xec, 0xa6, 0xb2, 0xbe, 0xd1, 0x68, 0x0c, 0x47, 0x7f,
-	0x01, 0x72, 0x3d, 0x4b, 0x3f, 0xea, 0xd2, 0x3a, 0xdc, 0xcb, 0x9e, 0x19, 0x25, 0xeb, 0xc1, 0x34,
-	0x29, 0xae, 0xc0, 0x0d, 0xab, 0x6f, 0xd8, 0x1a, 0xba, 0x59, 0x6d, 0x1d, 0x68, 0xa6, 0x69, 0x74,
-	0x55, 0xa6, 0x3c, 0xd3, 0xeb, 0x8d, 0x61, 0x77, 0x95, 0xf5, 0x3e, 0xa1, 0x0b, 0xb9, 0x09, 0xa9,
-	0x96, 0x45, 0x57, 0x41, 0x35, 0x42, 0x37, 0x4c, 0x28, 0xc0, 0x20, 0xaa, 0xd4, 0x9f, 0x4b, 0x90,
-	0x1c, 0xaa, 0x53, 0x82, 0x18, 0x1a, 0x9f, 0x17, 0x54, 0x6f, 0x05, 0xbd, 0x21, 0x1a, 0x70, 0x63,
-	0x4c, 0xa1, 0x63, 0xc9, 0x5d, 0x88, 0xb6, 0x07, 0x87, 0xbc, 0x7c, 0x78, 0x33, 0x54, 0x3e, 0x78,
-	0xa6, 0xd9, 0x18, 0x53, 0x70, 0x24, 0x59, 0x85, 0xf1, 0xae, 0xdd, 0x3e, 0x18, 0x0c, 0x78, 0x5d,
-	0x1e, 0x0a, 0x4e, 0xbf, 0x8d, 0x36, 0xc6, 0x14, 0x3e, 0xbe, 0x32, 0x09, 0xe0, 0x99, 0x85, 0xd6,
-	0xa3, 0xb3, 0x7f, 0x15, 0x05, 0x68, 0x9e, 0x0c, 0xe3, 0xbf, 0x0a, 0x13, 0xba, 0xe6, 0x68, 0x9e,
-	0xf9, 0x53, 0xa5, 0xfc, 0x79, 0x41, 0x5b, 0x49, 0x8b, 0x1b, 0x59, 0x49, 0xea, 0xae, 0x15, 0x2e,
-	0xb2, 0x19, 0xc6, 0x4e, 0x9b, 0x16, 0xdb, 0x66, 0xeb, 0x94, 0xe6, 0xa3, 0x98, 0xe2, 0x01, 0x98,
-	0xb7, 0x0d, 0x53, 0xdb, 0xeb, 0x1a, 0x6a, 0xcb, 0x6e, 0xf1, 0x52, 0x63, 0x82, 0x21, 0x55, 0xbb,
-	0x85, 0x93, 0x87, 0x37, 0x27, 0x9a, 0x21, 0x32, 0x8a, 0x07, 0x90, 0x25, 0x88, 0x61, 0x83, 0xef,
-	0xfe, 0x42, 0xa8, 0x92, 0x6c, 0xba, 0x23, 0x2b, 0xb1, 0x4f, 0xfe, 0x11, 0x8b, 0x59, 0x1c, 0x4d,
-	0xbe, 0x02, 0x49, 0xdd, 0x7a, 0x6e, 0x76, 0x3b, 0xe6, 0x61, 0x3e, 0x49, 0x67, 0xbe, 0x1d, 0x5c,
-	0xb5, 0x67, 0xa4, 0xf9, 0x35, 0x3e, 0x54, 0x19, 0x4e, 0x2a, 0xfc, 0x7f, 0x48, 0xba, 0x28, 0x79,
-	0x1b, 0x32, 0x9a, 0xe9, 0x18, 0xa6, 0xa9, 0xa9, 0x1d, 0xdc, 0xd7, 0x3c, 0x22, 0xd3, 0x1c, 0xa4,
-	0x7b, 0x1d, 0xc3, 0xdc, 0x39, 0x51, 0xfb, 0xd6, 0x73, 0x83, 0x6d, 0x8c, 0x88, 0x92, 0x70, 0x4e,
-	0x76, 0xb1, 0x49, 0xee, 0xc2, 0x54, 0xc7, 0x3c, 0x36, 0x6c, 0x47, 0xed, 0x5b, 0x5d, 0xcd, 0xee,
-	0x7c, 0x8b, 0xba, 0x8b, 0x67, 0x00, 0xc2, 0xba, 0x76, 0x85, 0x9e, 0x07, 0xb1, 0x64, 0x44, 0x8e,
-	0xce, 0xfe, 0xba, 0x04, 0xd3, 0x1f, 0x6a, 0x8e, 0xf1, 0x5c, 0x3b, 0x2d, 0x73, 0x49, 0xde, 0xed,
-	0x11, 0xb3, 0xf4, 0x3e, 0xeb, 0x54, 0x3b, 0xfa, 0x80, 0xbb, 0x36, 0x74, 0x13, 0xe3, 0xf3, 0x85,
-	0x89, 0x34, 0x6d, 0x7c, 0x97, 0xd5, 0x18, 0xfb, 0x6e, 0xef, 0x20, 0xbc, 0xc6, 0x48, 0x78, 0x8d,
-	0xb3, 0xdf, 0x8f, 0x40, 0xea, 0x51, 0x1f, 0x6d, 0xd2, 0xb4, 0x0e, 0x0d, 0x93, 0xd4, 0x20, 0xea,
-	0xc9, 0x7e, 0xf7, 0x1c, 0xd9, 0x61, 0xdd, 0x05, 0x15, 0x70, 0xbe, 0x3f, 0x00, 0x22, 0xc1, 0x00,
-	0x28, 0x43, 0x6a, 0x60, 0xd8, 0xc7, 0x86, 0xad, 0xd2, 0x38, 0x88, 0x5e, 0x32, 0x0e, 0x80, 0x4d,
-	0x42, 0x98, 0xbc, 0x07, 0x93, 0x2d, 0x3c, 0x8c, 0x4d, 0xc7, 0xd6, 0x1c, 0x8b, 0x13, 0x61, 0x98,
-	0x46, 0x15, 0x59, 0xec, 0xa0, 0x83, 0xab, 0x90, 0x76, 0x0d, 0x4b, 0xc7, 0xc5, 0x2f, 0x29, 0xd0,
-	0x75, 0x07, 0xe2, 0xb3, 0xdf, 0x91, 0x20, 0xed, 0xc6, 0xcf, 0xae, 0xe6, 0x1c, 0x90, 0xb7, 0x21,
-	0x7d, 0x44, 0x2d, 0xa7, 0x3a, 0x68, 0x3a, 0x56, 0x89, 0x6c, 0x8c, 0x29, 0xa9, 0x23, 0xc1, 0x9e,
-	0x65, 0x88, 0xb7, 0x3b, 0x27, 0x86, 0xce, 0x73, 0xc7, 0xe5, 0x2d, 0xba, 0x31, 0xa6, 0xb0, 0x99,
-	0x95, 0x14, 0xc4, 0xfa, 0x28, 0x8f, 0xe6, 0x82, 0x4f, 0xe2, 0x30, 0xd1, 0x3c, 0xe1, 0x05, 0x26,
-	0x79, 0x0f, 0xe2, 0xb4, 0xbe, 0x3f, 0xef, 0x6a, 0x5a, 0xc5, 0x4e, 0x85, 0x8d, 0x21, 0x55, 0xc8,
-	0xba, 0x7b, 0x41, 0x45, 0xc2, 0x01, 0x3d, 0x56, 0x46, 0xa4, 0x40, 0x71, 0x95, 0x4a, 0x46, 0x17,
-	0x5a, 0x03, 0xf2, 0x65, 0x98, 0xa0, 0x87, 0x2f, 0x3d, 0xfb, 0xa3, 0x97, 0x3d, 0xfb, 0x93, 0x78,
-	0xe2, 0xd2, 0xc3, 0xff, 0x8b, 0xfc, 0xf0, 0x1e, 0x26, 0xb0, 0xf4, 0xab, 0x13, 0x18, 0x3b, 0xac,
-	0xdd, 0xac, 0xf5, 0x36, 0x9b, 0xed, 0x25, 0xa6, 0x38, 0x4d, 0x4c, 0x69, 0xfb, 0xa4, 0xb8, 0x3e,
-	0xcc, 0x4d, 0x54, 0x44, 0x49, 0x10, 0x91, 0xb9, 0x58, 0x44, 0xc9, 0x2f, 0xa2, 0x24, 0x88, 0x48,
-	0xb8, 0x22, 0x4a, 0x9e, 0x88, 0x0d, 0x48, 0xf6, 0xed, 0x8e, 0x65, 0x77, 0x9c, 0x53, 0x9a, 0x8b,
-	0xb2, 0xe1, 0x6d, 0xda, 0x3c, 0x69, 0xb4, 0x0e, 0x0c, 0xfd, 0xa8, 0x6b, 0xec, 0xf2, 0x91, 0xa2,
-	0x3d, 0xdc, 0xd9, 0xa4, 0x06, 0x19, 0x6d, 0x6f, 0x60, 0x75, 0x8f, 0x1c, 0x83, 0xc5, 0xe6, 0xc4,
-	0x25, 0x63, 0x33, 0xed, 0x4e, 0xa3, 0x11, 0xbe, 0x08, 0x93, 0x43, 0x8d, 0xd5, 0x7e, 0x57, 0x33,
-	0xb1, 0x3a, 0x05, 0x4c, 0xea, 0xf4, 0x36, 0x6a, 0x47, 0xf2, 0x1f, 0x28, 0xb9, 0xe1, 0x88, 0xdd,
-	0xae, 0x66, 0xd6, 0x75, 0xb2, 0x08, 0x49, 0x4d, 0x3f, 0xd6, 0xcc, 0x96, 0xa1, 0xe7, 0x5b, 0xaf,
-	0xbe, 0xd5, 0x0f, 0x07, 0x3e, 0x88, 0x25, 0x63, 0x72, 0xfc, 0x41, 0x2c, 0x39, 0x2e, 0x27, 0x1e,
-	0xc4, 0x92, 0x29, 0x39, 0x3d, 0xfb, 0x37, 0x8b, 0xf4, 0xc5, 0xa2, 0x6a, 0xf5, 0x7a, 0x9a, 0xa9,
-	0x93, 0x0a, 0x44, 0x5b, 0x1d, 0x9d, 0x47, 0xe4, 0x3b, 0x23, 0xde, 0xa3, 0xf8, 0x40, 0x2f, 0xd6,
-	0x2b, 0xf0, 0xb2, 0x92, 0xf8, 0xb6, 0x14, 0x93, 0xa5, 0x5b, 0x63, 0x0a, 0x4e, 0x26, 0x9f, 0x87,
-	0x94, 0xad, 0x3d, 0x1f, 0x3e, 0x39, 0x44, 0xf8, 0xce, 0x02, 0x5b, 0x7b, 0xee, 0x5e, 0x02, 0x2a,
-	0x30, 0x61, 0x1b, 0x03, 0x2c, 0xc3, 0x4d, 0xf7, 0xf1, 0xeb, 0xed, 0xf3, 0x85, 0xcd, 0x2b, 0x38,
-	0xb6, 0x6e, 0xea, 0x1b, 0x63, 0x4a, 0xd2, 0xe6, 0x7f, 0x93, 0x1a, 0xde, 0x3e, 0x90, 0xa3, 0x65,
-	0x99, 0x6d, 0xfe, 0xb0, 0xf1, 0xce, 0x45, 0x24, 0x55, 0xcb, 0x6c, 0x6f, 0x8c, 0x29, 0x4c, 0x3a,
-	0x36, 0xc8, 0x0e, 0x64, 0xe9, 0xa6, 0x6a, 0x1d, 0x18, 0xad, 0x43, 0x55, 0x33, 0xdd, 0x02, 0xfb,
-	0x0b, 0xaf, 0xa0, 0xda, 0xec, 0x98, 0x87, 0x55, 0x1c, 0x5f, 0x36, 0x71, 0xab, 0xa7, 0xbb, 0x42,
-	0x9b, 0xd4, 0x81, 0xb6, 0x55, 0x4d, 0xb7, 0xe9, 0xab, 0x00, 0x7b, 0xe4, 0xfa, 0x5f, 0x17, 0xd0,
-	0x95, 0xd7, 0x14, 0xc5, 0xf8, 0x18, 0xcd, 0x84, 0x93, 0xcb, 0xba, 0xad, 0x18, 0x1f, 0xfb, 0xa8,
-	0x50, 0xb3, 0xc4, 0x65, 0xa9, 0x98, 0x5e, 0x2e, 0x15, 0x6a, 0xb5, 0x03, 0x59, 0xfd, 0xc8, 0x39,
-	0x55, 0x5b, 0xa7, 0xad, 0xae, 0x41, 0xf5, 0x4a, 0x5e, 0xb8, 0xcc, 0xb5, 0x23, 0xe7, 0xb4, 0x8a,
-	0xe3, 0x99, 0x66, 0x69, 0x5d, 0x68, 0x93, 0xa7, 0x40, 0xec, 0x13, 0xb5, 0xaf, 0xd9, 0x5a, 0x0f,
-	0xef, 0x26, 0x47, 0x7d, 0x4a, 0xca, 0x36, 0xc0, 0xdc, 0xab, 0xdc, 0x70, 0xb2, 0x8b, 0x73, 0x1a,
-	0x38, 0x85, 0xf1, 0xe6, 0x6c, 0x3f, 0x34, 0x82, 0x1a, 0x17, 0x0f, 0x57, 0xa2, 0x66, 0x16, 0xf0,
-	0x51, 0xbb, 0x66, 0x30, 0x8e, 0xd5, 0x81, 0xa3, 0x39, 0x47, 0x03, 0x4a, 0x9b, 0xba, 0xd8, 0x0c,
-	0xc6, 0x71, 0x83, 0x8e, 0xe7, 0xde, 0xd6, 0x85, 0x36, 0x51, 0x20, 0x67, 0x1a, 0xcf, 0x87, 0x05,
-	0x2f, 0xda, 0x80, 0x25, 0xc5, 0xdb, 0xaf, 0x60, 0xdc, 0x36, 0x9e, 0xf3, 0x1a, 0x98, 0x59, 0x20,
-	0x63, 0x8a, 0x40, 0x90, 0x13, 0xb5, 0xcc, 0x5c, 0x81, 0x93, 0xa9, 0x29, 0x70, 0xba, 0x0b, 0xef,
-	0xfa, 0xd4, 0xcc, 0x5e, 0xbc, 0xf0, 0x4d, 0x9f, 0x96, 0x69, 0xbd, 0x2b, 0x28, 0xe9, 0x27, 0x44,
-	0x1d, 0x73, 0x97, 0x27, 0x74, 0x2d, 0xd9, 0x15, 0x34, 0xfc, 0x06, 0x4c, 0xd9, 0x27, 0x98, 0x46,
-	0xb1, 0xae, 0xf5, 0x22, 0x4a, 0xa6, 0xac, 0xef, 0xbd, 0xd2, 0xed, 0x4d, 0x3a, 0x49, 0x08, 0x29,
-	0xd9, 0x0e, 0x60, 0x18, 0x53, 0x4e, 0x38, 0x5c, 0x27, 0x2f, 0x8c, 0xa9, 0x66, 0x38, 0x5c, 0x9d,
-	0x40, 0xb8, 0xd2, 0x64, 0x76, 0x68, 0x9c, 0xd2, 0x64, 0x46, 0x2e, 0x91, 0xcc, 0x0e, 0x8d, 0xd3,
-	0x61, 0x32, 0x63, 0x7f, 0xb3, 0x64, 0x86, 0x1c, 0x34, 0x99, 0x4d, 0x5d, 0x22, 0x99, 0x1d, 0x1a,
-	0xa7, 0x5e, 0x32, 0xe3, 0x0d, 0xb4, 0x21, 0xe6, 0x8a, 0xe0, 0x32, 0xaf, 0x5d, 0x68, 0xc3, 0xf2,
-	0x9a, 0x12, 0x5c, 0xa7, 0xac, 0xe9, 0xb6, 0x7f, 0xa1, 0x0a, 0xe4, 0x74, 0xe3, 0xb8, 0xd3, 0x62,
-	0x87, 0x1d, 0xf5, 0xf9, 0x1b, 0x17, 0xc6, 0xe5, 0x1a, 0x9d, 0x81, 0xe7, 0x1c, 0x8f, 0x4b, 0x5d,
-	0x04, 0xc8, 0x23, 0x90, 0xdb, 0x96, 0xdd, 0xc2, 0x94, 0xe4, 0x7e, 0x22, 0xc8, 0x5f, 0x1f, 0x5d,
-	0x6d, 0x09, 0xa4, 0xeb, 0x38, 0x65, 0xf8, 0x5c, 0xb7, 0x31, 0xa6, 0x64, 0xdb, 0x3e, 0x84, 0x18,
-	0xc3, 0x6f, 0x0e, 0x41, 0x5b, 0xdc, 0xa0, 0xe4, 0xf3, 0xaf, 0xb4, 0x2d, 0x4e, 0x0c, 0x9a, 0x63,
-	0xca, 0x0e, 0xc3, 0xe7, 0x88, 0x41, 0xc3, 0xe4, 0xaf, 0x2c, 0x86, 0x99, 0x27, 0x24, 0x06, 0x8d,
-	0xf4, 0x14, 0x48, 0x9f, 0xee, 0x8a, 0xae, 0x85, 0x47, 0x66, 0xdb, 0xa2, 0x2b, 0x99, 0xbe, 0x30,
-	0x78, 0x77, 0x71, 0x07, 0x74, 0x2d, 0xa7, 0x6e, 0xb6, 0x2d, 0x1e, 0xbc, 0x7d, 0x3f, 0x44, 0xf6,
-	0xe0, 0x0d, 0x8f, 0x5a, 0x4c, 0x0f, 0x05, 0xca, 0x7e, 0xe7, 0x12, 0xec, 0xbe, 0x24, 0x41, 0xfa,
-	0x21, 0x74, 0xb4, 0x0c, 0x34, 0xd2, 0x9b, 0x57, 0x95, 0xc1, 0x6c, 0x14, 0x94, 0x81, 0x26, 0xfa,
-	0x08, 0x26, 0xf7, 0x0c, 0xad, 0x65, 0x99, 0x6e, 0x06, 0x41, 0xfe, 0xb7, 0x2e, 0xb4, 0x50, 0x85,
-	0xce, 0x61, 0xb9, 0x82, 0x1f, 0x19, 0x7b, 0x7e, 0x08, 0xa3, 0x9e, 0x33, 0x63, 0x09, 0x46, 0x6d,
-	0xf3, 0xb9, 0x0b, 0xa3, 0x9e, 0xf1, 0x62, 0xcd, 0xc9, 0x33, 0xfc, 0x9e, 0x08, 0x04, 0x39, 0x51,
-	0xd7, 0x99, 0x2b, 0x70, 0xf2, 0x9d, 0xb4, 0x27, 0x02, 0xc2, 0xee, 0xec, 0x59, 0xba, 0x41, 0x93,
-	0xd1, 0xcd, 0x4b, 0xee, 0xce, 0x2d, 0x4b, 0x37, 0x58, 0x46, 0xe2, 0xbb, 0x93, 0x03, 0xb8, 0x3b,
-	0x45, 0x4e, 0x9a, 0x9c, 0x6e, 0x5d, 0xb8, 0x3b, 0x3d, 0x52, 0x9e, 0xa1, 0xb2, 0xba, 0x0f, 0x29,
-	0x28, 0x90, 0x74, 0x4b, 0x3a, 0xb2, 0x0e, 0x99, 0x5e, 0xc7, 0xb4, 0x6c, 0xf5, 0xd8, 0xb0, 0x07,
-	0x78, 0x0d, 0x3f, 0xef, 0x43, 0x1d, 0x0e, 0xf2, 0x8a, 0xcd, 0xbc, 0xa4, 0xa4, 0xe9, 0xbc, 0xc7,
-	0x6c, 0x5a, 0xa1, 0x01, 0x13, 0xc3, 0x0a, 0xef, 0xb5, 0x91, 0xaa, 0x90, 0x16, 0x6b, 0x3d, 0x72,
-	0x0b, 0xc6, 0x7b, 0x9a, 0xbd, 0xdf, 0x61, 0x84, 0xc3, 0x6f, 0x72, 0xff, 0x25, 0x29, 0x1c, 0x27,
-	0x77, 0x20, 0xe3, 0xde, 0x56, 0x5b, 0xd6, 0x91, 0x19, 0xfe, 0x78, 0xe7, 0x5e, 0x66, 0xab, 0xd8,
-	0x5b, 0xf8, 0xad, 0x08, 0x80, 0x57, 0xfe, 0x91, 0x1d, 0xc8, 0x0d, 0x6f, 0x3e, 0xc2, 0xdb, 0xc6,
-	0x15, 0x1e, 0x36, 0x33, 0xba, 0xd8, 0x41, 0xee, 0x40, 0xd6, 0x7d, 0x05, 0x11, 0xdf, 0x11, 0xe8,
-	0xbd, 0x62, 0x2e, 0x92, 0xcf, 0x29, 0x69, 0xfe, 0x28, 0xc2, 0x86, 0xbf, 0x07, 0x69, 0x77, 0x7f,
-	0xf6, 0xb4, 0xc1, 0x21, 0x7b, 0xa2, 0xa4, 0xec, 0xdf, 0x93, 0x22, 0xb2, 0xac, 0xa4, 0x78, 0xef,
-	0x96, 0x36, 0x38, 0x24, 0xf7, 0xe1, 0x9a, 0x38, 0x18, 0xa3, 0xc3, 0xb1, 0xad, 0x2e, 0xfb, 0x10,
-	0xe0, 0x4a, 0x48, 0x28, 0x44, 0x98, 0x53, 0x65, 0x43, 0xc8, 0x2c, 0x24, 0xcd, 0x3d, 0xd5, 0xb1,
-	0x31, 0xf0, 0xc7, 0xfd, 0x0a, 0x25, 0xcc, 0xbd, 0x26, 0xe2, 0xec, 0xae, 0x52, 0xf8, 0x9e, 0x34,
-	0x34, 0x10, 0x3a, 0xe0, 0x36, 0xc8, 0x3e, 0x99, 0x5a, 0xeb, 0x90, 0x7f, 0x90, 0xcb, 0x0a, 0x62,
-	0xca, 0xad, 0x43, 0x72, 0x07, 0xa6, 0x02, 0xa6, 0xa4, 0x83, 0xd9, 0x37, 0x3a, 0xd9, 0x67, 0x25,
-	0x1c, 0xfe, 0x1e, 0xab, 0x0f, 0x3c, 0x43, 0xa9, 0xde, 0x97, 0xbb, 0x9c, 0x68, 0xa3, 0x72, 0xeb,
-	0xb0, 0xd0, 0x82, 0xb4, 0x58, 0x1b, 0x93, 0x06, 0x64, 0x7b, 0xda, 0x89, 0xea, 0x15, 0xd8, 0xdc,
-	0x6b, 0xa1, 0x32, 0xa0, 0xbc, 0xbf, 0x6f, 0x1b, 0x18, 0x00, 0xfa, 0x70, 0xbe, 0xe0, 0xbb, 0x74,
-	0x4f, 0x3b, 0x19, 0xe2, 0x85, 0xff, 0x90, 0x20, 0x17, 0x28, 0x96, 0xc9, 0x63, 0xac, 0x91, 0x84,
-	0xdb, 0xf1, 0xcf, 0x16, 0x23, 0xb2, 0x70, 0x65, 0x66, 0x7e, 0x7f, 0x0a, 0xd7, 0x7c, 0x17, 0x7b,
-	0xf7, 0x71, 0x3e, 0x72, 0xc5, 0xc7, 0xf9, 0x49, 0xe1, 0xbe, 0xcf, 0x9f, 0xe8, 0xe7, 0x83, 0x57,
-	0x72, 0xb4, 0x69, 0x8c, 0x7e, 0x87, 0x2d, 0xc5, 0x6e, 0xff, 0xce, 0xaf, 0x8e, 0xfb, 0x6f, 0xe7,
-	0x85, 0x3f, 0x0a, 0x2c, 0x1b, 0xbd, 0xbe, 0x04, 0x37, 0x46, 0x2c, 0x5b, 0x70, 0xfe, 0x54, 0x70,
-	0x45, 0xe8, 0xd2, 0x15, 0xc8, 0x8f, 0x5a, 0x94, 0x10, 0x06, 0xd7, 0x42, 0xea, 0xe2, 0xbc, 0x39,
-	0x98, 0xf4, 0x69, 0x2c, 0x46, 0x82, 0xa8, 0x2a, 0x46, 0xc2, 0xff, 0x85, 0xb4, 0x78, 0x3d, 0x20,
-	0xb3, 0x90, 0xd8, 0xd3, 0x1c, 0xc7, 0xb0, 0x4f, 0xfd, 0x19, 0xe2, 0x33, 0x49, 0x71, 0x3b, 0xc8,
-	0xdc, 0x30, 0x89, 0xa0, 0x16, 0xf1, 0x0a, 0x79, 0x59, 0xc9, 0x15, 0x32, 0xf9, 0x9b, 0xb7, 0xff,
-	0xe9, 0x33, 0xfe, 0xdf, 0x30, 0x9d, 0x14, 0x7e, 0x23, 0x02, 0x19, 0xdf, 0x6d, 0x01, 0x13, 0x8c,
-	0xbb, 0x03, 0x84, 0xc7, 0x4f, 0x31, 0xc1, 0xf0, 0x6e, 0xe6, 0xd9, 0x77, 0xc5, 0x97, 0xe0, 0x08,
-	0x35, 0x7d, 0xea, 0x65, 0x25, 0x59, 0x1a, 0xcf, 0x8f, 0x51, 0xe3, 0x0b, 0xcf, 0xc2, 0x8f, 0x61,
-	0xaa, 0xd7, 0x31, 0x43, 0xc1, 0x15, 0xbd, 0x62, 0x70, 0xf5, 0x3a, 0xa6, 0x3f, 0xb8, 0x90, 0x17,
-	0x77, 0x47, 0x80, 0x37, 0x76, 0x55, 0x5e, 0xed, 0xc4, 0xd7, 0x57, 0xf8, 0x48, 0x34, 0x0d, 0x1a,
-	0xff, 0x6d, 0xc8, 0xf8, 0x9d, 0xc6, 0x82, 0x23, 0xdd, 0x16, 0x3c, 0x46, 0x66, 0x21, 0xe3, 0x69,
-	0xe2, 0x85, 0x42, 0xca, 0xcd, 0x08, 0xe8, 0xd5, 0x36, 0xa4, 0xc5, 0xbb, 0xcf, 0x55, 0x6d, 0xfe,
-	0x85, 0xb0, 0xcd, 0x85, 0x70, 0xf7, 0xfa, 0xf0, 0x78, 0x11, 0xaf, 0x44, 0x18, 0x79, 0x3e, 0x39,
-	0xc2, 0x22, 0x72, 0xa2, 0x04, 0x5c, 0x47, 0x68, 0xb1, 0x91, 0xf0, 0x62, 0x0b, 0x0f, 0x41, 0x0e,
-	0xde, 0x8e, 0xc8, 0x3d, 0x88, 0xb3, 0x07, 0x40, 0xe9, 0xb2, 0x0f, 0x80, 0x6c, 0x7c, 0xe1, 0xcf,
-	0x24, 0xc8, 0x05, 0xae, 0x43, 0xe4, 0x01, 0xcb, 0x7c, 0x46, 0xc7, 0xee, 0xfb, 0x72, 0x51, 0xf8,
-	0xf3, 0x24, 0xad, 0x00, 0x6a, 0x75, 0x65, 0x37, 0x90, 0xf0, 0x6a, 0x1d, 0xbb, 0xcf, 0xcc, 0x36,
-	0x07, 0x93, 0xfc, 0x49, 0x56, 0x7f, 0x6e, 0x74, 0xbb, 0xec, 0x45, 0x8d, 0xad, 0x2a, 0xc7, 0x3a,
-	0xd6, 0x10, 0xa7, 0x4f, 0x66, 0xf3, 0x30, 0x35, 0x7c, 0x0e, 0x15, 0x46, 0xb3, 0x5d, 0x3a, 0xe9,
-	0x76, 0x0d, 0xc7, 0x17, 0x76, 0xb1, 0xe2, 0xe0, 0x77, 0xad, 0xb5, 0x2b, 0x15, 0x07, 0xa2, 0xb6,
-	0x62, 0x69, 0xf0, 0x55, 0xac, 0x37, 0xdc, 0x7b, 0xd7, 0xeb, 0xa1, 0xfc, 0x91, 0x04, 0x72, 0xf0,
-	0x22, 0x46, 0xf6, 0xe0, 0xba, 0xfb, 0x1b, 0x93, 0x6e, 0xa7, 0xd7, 0x71, 0x54, 0xe3, 0xa4, 0x6f,
-	0x99, 0x86, 0xe9, 0x9c, 0x7b, 0xc6, 0xac, 0x29, 0xe5, 0xd6, 0xe1, 0x26, 0x8e, 0xad, 0xf1, 0xa1,
-	0x82, 0xc4, 0x29, 0xf6, 0xeb, 0x14, 0x5f, 0xb7, 0x28, 0x83, 0xba, 0xda, 0x93, 0x11, 0x79, 0x95,
-	0x0c, 0x1a, 0x27, 0xe7, 0xcb, 0xf0, 0x75, 0x17, 0xbe, 0x0a, 0x19, 0xdf, 0x55, 0x90, 0x7c, 0x70,
-	0xe9, 0x0f, 0x49, 0xf2, 0xcb, 0x4a, 0xfc, 0x07, 0x52, 0x24, 0x49, 0x3f, 0x83, 0x79, 0x1f, 0x95,
-	0x0a, 0x3f, 0x88, 0x40, 0xd6, 0x7f, 0x13, 0x7c, 0xcd, 0x3f, 0xf4, 0x18, 0x51, 0x8e, 0x45, 0x7e,
-	0xae, 0x72, 0xec, 0x36, 0xa4, 0x70, 0xbb, 0xd8, 0x06, 0xfd, 0xd1, 0x23, 0xff, 0x49, 0xd2, 0xb0,
-	0x52, 0x82, 0x9e, 0x76, 0xa2, 0xb0, 0x2e, 0xf2, 0x04, 0x72, 0x7d, 0xc3, 0xee, 0x58, 0xba, 0xe7,
-	0x8b, 0xd8, 0xe8, 0x47, 0x59, 0x7e, 0x8f, 0xa4, 0x83, 0x47, 0x38, 0x23, 0xdb, 0xf7, 0xf5, 0x14,
-	0xfe, 0x56, 0x82, 0xa9, 0x11, 0x37, 0x5c, 0xf2, 0x75, 0x20, 0xa8, 0x1a, 0x2d, 0x5a, 0x2f, 0x8c,
-	0x31, 0x46, 0x40, 0x4b, 0xd8, 0x11, 0x22, 0x31, 0x55, 0xfb, 0xfa, 0xf0, 0x76, 0x86, 0xe4, 0xf4,
-	0xd9, 0x20, 0x10, 0x5b, 0xb3, 0xa3, 0xb9, 0xd1, 0xfb, 0x23, 0xa8, 0x73, 0x3d, 0xed, 0x44, 0xec,
-	0x2a, 0x6c, 0x84, 0x57, 0x83, 0xc1, 0x55, 0x84, 0x37, 0x42, 0x02, 0x85, 0x6c, 0x4a, 0x02, 0x34,
-	0x98, 0x2b, 0x1b, 0x90, 0x0b, 0xdc, 0x97, 0xc9, 0x07, 0x30, 0xce, 0xac, 0x77, 0xde, 0xaf, 0x14,
-	0xdc, 0x09, 0xcc, 0xfa, 0x82, 0x9e, 0x7c, 0x5e, 0xe1, 0x13, 0x09, 0x48, 0xf8, 0x9e, 0xec, 0x3f,
-	0x95, 0xa5, 0x57, 0x9e, 0xca, 0xaf, 0x3b, 0x06, 0x0b, 0x07, 0x21, 0x8d, 0x2e, 0x7d, 0x76, 0x5e,
-	0xad, 0xa6, 0x2e, 0x68, 0x90, 0x0b, 0xdc, 0xaf, 0xc9, 0x4d, 0xf1, 0xf0, 0xf1, 0xfd, 0x1a, 0x8f,
-	0xe1, 0xe1, 0xa3, 0x36, 0xf2, 0xaa, 0xa3, 0xb6, 0xf0, 0x3e, 0x64, 0x7c, 0x57, 0xed, 0x2b, 0x58,
-	0xb6, 0xb0, 0x24, 0xce, 0xbd, 0xac, 0x0d, 0x0a, 0xeb, 0x6e, 0x1e, 0x73, 0xef, 0xc8, 0xcb, 0x97,
-	0xf9, 0x8c, 0x27, 0x9e, 0xa6, 0x74, 0x74, 0xe1, 0x43, 0xc8, 0xfa, 0xef, 0xc9, 0x3f, 0x23, 0x51,
-	0x65, 0x02, 0x12, 0xfc, 0x53, 0xcb, 0xec, 0x7d, 0xc8, 0x0e, 0xab, 0xd3, 0xc7, 0x5a, 0xf7, 0xc8,
-	0x40, 0x7b, 0x1f, 0xe3, 0x1f, 0xdc, 0x14, 0x42, 0x19, 0xc2, 0xf0, 0xf7, 0xc7, 0xcf, 0x5e, 0x4c,
-	0x47, 0xf2, 0xd2, 0xec, 0x37, 0x61, 0xca, 0x5f, 0x09, 0xb3, 0xf9, 0x5f, 0x16, 0xe7, 0x5f, 0xe5,
-	0x26, 0x10, 0xa0, 0xff, 0x3a, 0x10, 0x5f, 0x78, 0x32, 0xf6, 0x2f, 0xf9, 0xd9, 0x2f, 0xff, 0xeb,
-	0x9d, 0x90, 0xee, 0xfe, 0x8d, 0x78, 0x39, 0xdd, 0xcf, 0xdd, 0xbc, 0x01, 0xfa, 0x7d, 0xc8, 0x8f,
-	0xb8, 0xb7, 0x31, 0x19, 0x55, 0xbf, 0x8c, 0x2b, 0x5e, 0xf8, 0x02, 0x82, 0x76, 0x20, 0xcd, 0x8b,
-	0x2f, 0x46, 0x7e, 0xcf, 0x4f, 0x7e, 0x99, 0x4a, 0x2d, 0xac, 0x79, 0xb8, 0x1a, 0xb8, 0xa4, 0xe6,
-	0xaf, 0x2c, 0x23, 0xce, 0x13, 0xe4, 0x3b, 0xf3, 0xaf, 0x22, 0xe8, 0xbc, 0x5a, 0x22, 0x20, 0xe8,
-	0x11, 0xe4, 0xbc, 0x4a, 0x92, 0xf1, 0xbf, 0xef, 0xe7, 0xbf, 0x5c, 0xe5, 0xe9, 0xa7, 0x9d, 0xfb,
-	0xbe, 0x04, 0x71, 0xfa, 0x4b, 0x70, 0x22, 0x43, 0xfa, 0xc1, 0x4e, 0x7d, 0x5b, 0x55, 0x6a, 0x5f,
-	0x7d, 0x54, 0x6b, 0x34, 0xe5, 0x31, 0x92, 0x83, 0x14, 0x45, 0xca, 0xd5, 0x6a, 0x6d, 0xb7, 0x29,
-	0x4b, 0x84, 0x40, 0xf6, 0xd1, 0x76, 0x75, 0x67, 0x7b, 0xbd, 0xae, 0x6c, 0xd5, 0xd6, 0xd4, 0x47,
-	0xbb, 0x72, 0x84, 0x5c, 0x03, 0x59, 0xc4, 0xd6, 0x76, 0x9e, 0x6c, 0xcb, 0x51, 0x24, 0xf3, 0x8d,
-	0x8b, 0xe1, 0xdc, 0xc0, 0xa8, 0x38, 0x62, 0x4a, 0xcd, 0x27, 0x74, 0x1c, 0x85, 0xee, 0x2a, 0x3b,
-	0xbb, 0x4a, 0xbd, 0xd6, 0x2c, 0x2b, 0x4f, 0xe5, 0x44, 0xc1, 0xd5, 0xf4, 0x36, 0xc4, 0xe9, 0x6f,
-	0xcf, 0x49, 0x16, 0x60, 0x73, 0x47, 0x29, 0x3f, 0x29, 0x6f, 0xab, 0x4a, 0x51, 0x1e, 0x2b, 0xe4,
-	0xce, 0x5e, 0x4c, 0xa7, 0xf2, 0xd2, 0x5c, 0x82, 0xa3, 0x73, 0xbf, 0xc6, 0x7e, 0x8b, 0xce, 0x8b,
-	0x4b, 0x64, 0xdc, 0x2a, 0x57, 0xd5, 0x47, 0xdb, 0x0f, 0xb7, 0x51, 0xec, 0x18, 0x49, 0x43, 0x12,
-	0x81, 0xc7, 0x45, 0x75, 0x41, 0x96, 0x90, 0xce, 0x6d, 0xa9, 0x45, 0x39, 0xe2, 0x6b, 0x97, 0xe4,
-	0xa8, 0x30, 0xba, 0x28, 0xc7, 0x7c, 0xbd, 0x8b, 0x72, 0xdc, 0xd7, 0x5e, 0x92, 0xc7, 0x0b, 0x53,
-	0x67, 0x2f, 0xa6, 0x13, 0x79, 0x69, 0x2e, 0xba, 0x55, 0xae, 0xfe, 0xf2, 0xef, 0xce, 0x8c, 0xfd,
-	0xc9, 0xef, 0xcd, 0x8c, 0xcd, 0xfd, 0x52, 0x0c, 0x60, 0x77, 0xe3, 0xa9, 0xab, 0xd0, 0x0c, 0xa4,
-	0x76, 0x37, 0x9e, 0x7a, 0x0a, 0x15, 0x32, 0x67, 0x2f, 0xa6, 0x27, 0x20, 0x71, 0x64, 0x1e, 0x9a,
-	0xd6, 0x73, 0x93, 0xcc, 0x01, 0x34, 0x1b, 0x0b, 0x0b, 0x45, 0xae, 0x61, 0xa1, 0x70, 0xf6, 0x62,
-	0xfa, 0x3a, 0x24, 0x71, 0x0e, 0x22, 0x24, 0x5a, 0x9c, 0x5f, 0x20, 0xf1, 0xe2, 0xfc, 0xc2, 0xfc,
-	0x02, 0x79, 0x17, 0xd2, 0xde, 0x58, 0xd4, 0xbf, 0x70, 0xe3, 0xec, 0xc5, 0xf4, 0x14, 0x95, 0xc7,
-	0x31, 0x36, 0xb4, 0x48, 0x56, 0x21, 0xad, 0xec, 0x0e, 0x87, 0x96, 0xe4, 0x68, 0xe1, 0x7f, 0x9f,
-	0xbd, 0x98, 0x9e, 0x05, 0x79, 0x38, 0xb4, 0xa4, 0x2a, 0xb5, 0xc7, 0x6a, 0x99, 0x4d, 0x28, 0x91,
-	0x04, 0xfd, 0xdf, 0x1d, 0x8d, 0xdc, 0x03, 0x22, 0xce, 0xa4, 0xa3, 0x2a, 0x72, 0xac, 0x70, 0xf3,
-	0xec, 0xc5, 0xf4, 0x9b, 0xa1, 0xf9, 0x15, 0x77, 0xe2, 0x1e, 0x79, 0x1f, 0xe4, 0xe1, 0xc4, 0x22,
-	0x23, 0x97, 0xe3, 0x85, 0x77, 0xce, 0x5e, 0x4c, 0xdf, 0x82, 0x2c, 0x9f, 0x56, 0xf4, 0x84, 0x16,
-	0xef, 0x68, 0x38, 0xb7, 0x38, 0xbf, 0x70, 0x47, 0x1b, 0x31, 0xb7, 0x22, 0x8f, 0x8f, 0x9c, 0x5b,
-	0x61, 0x73, 0xf7, 0xdc, 0xb9, 0x7b, 0x01, 0x85, 0x17, 0xb9, 0xe4, 0x44, 0x48, 0x61, 0xde, 0xc3,
-	0x14, 0x5e, 0xbc, 0xa3, 0x61, 0xdc, 0xe2, 0xc4, 0x12, 0xeb, 0x5e, 0x90, 0x93, 0x01, 0xa4, 0x28,
-	0x4f, 0x04, 0x90, 0x92, 0x0c, 0x01, 0x64, 0x51, 0x4e, 0x79, 0x61, 0xb0, 0xbb, 0xf1, 0x74, 0x18,
-	0x06, 0x3f, 0x8c, 0x40, 0xc6, 0xff, 0x40, 0x90, 0x83, 0xd4, 0x5a, 0xb9, 0x59, 0x56, 0x95, 0x72,
-	0xb3, 0xa6, 0x2e, 0xb0, 0x2d, 0xe7, 0x01, 0x45, 0x59, 0xf2, 0x03, 0x25, 0x39, 0xe2, 0x07, 0x16,
-	0xe5, 0xa8, 0x1f, 0x58, 0x92, 0x63, 0x7e, 0x60, 0x59, 0x8e, 0xfb, 0x81, 0x15, 0xb6, 0xc7, 0x3c,
-	0xe0, 0x9e, 0x9c, 0xf0, 0x03, 0xab, 0x72, 0xd2, 0x0f, 0xdc, 0x67, 0x8b, 0x16, 0x14, 0x5b, 0x60,
-	0x8b, 0x16, 0x90, 0xa2, 0x9c, 0x0a, 0x20, 0x25, 0x39, 0x1d, 0x40, 0x16, 0xe5, 0x4c, 0x00, 0x59,
-	0x92, 0xb3, 0x01, 0x64, 0x59, 0xce, 0x15, 0xa6, 0xcf, 0x5e, 0x4c, 0x67, 0x64, 0x69, 0x6e, 0x62,
-	0x88, 0x0f, 0x4d, 0xf8, 0x6f, 0x12, 0x64, 0x03, 0xcf, 0x6c, 0xd7, 0x81, 0x78, 0xf3, 0x77, 0xd6,
-	0xd7, 0x1b, 0xb5, 0x26, 0x35, 0xe5, 0x28, 0x1c, 0x2d, 0x3a, 0x0a, 0x47, 0xc3, 0x8e, 0xc2, 0xd1,
-	0xbe, 0xa3, 0x70, 0x34, 0xf3, 0x28, 0x1c, 0xad, 0x3d, 0x0a, 0x47, 0xa3, 0x8f, 0xc2, 0xef, 0xc9,
-	0x89, 0xc2, 0x5b, 0x67, 0x2f, 0xa6, 0xaf, 0xc9, 0xd2, 0x9c, 0x1c, 0xec, 0xa5, 0x0b, 0xfe, 0x26,
-	0xe4, 0x1e, 0xf8, 0x6f, 0x6c, 0x42, 0xd6, 0xac, 0xee, 0x6c, 0x37, 0x6b, 0x1f, 0x61, 0xaa, 0xf6,
-	0xb0, 0x46, 0xad, 0xd1, 0xa8, 0xef, 0x6c, 0xb3, 0xd0, 0xe1, 0xd8, 0xc3, 0xda, 0xd3, 0x86, 0x1c,
-	0x21, 0x13, 0x10, 0xc3, 0xa6, 0xfc, 0x99, 0x34, 0x4c, 0xaa, 0x5f, 0x81, 0xc9, 0xd0, 0x95, 0x90,
-	0xa4, 0x20, 0xe1, 0x31, 0xa7, 0x20, 0xe1, 0x51, 0x26, 0x21, 0xc6, 0xb8, 0x86, 0x04, 0xab, 0x00,
-	0xde, 0x0f, 0x81, 0x51, 0xe4, 0x3a, 0x4d, 0xe5, 0xdb, 0xd5, 0x7a, 0xad, 0x21, 0x8f, 0x91, 0x49,
-	0xc8, 0x54, 0x37, 0xca, 0xdb, 0xdb, 0xb5, 0x4d, 0x75, 0xab, 0xdc, 0x78, 0xd8, 0x90, 0x3d, 0xd1,
-	0x65, 0x88, 0xd3, 0xba, 0x8e, 0x8a, 0xdb, 0x2c, 0x37, 0x1a, 0x6a, 0x99, 0x89, 0x63, 0x8d, 0x8a,
-	0x2c, 0x79, 0x8d, 0xaa, 0x1c, 0x29, 0x4c, 0x62, 0x92, 0xcc, 0x4b, 0x73, 0x71, 0x0a, 0x61, 0x44,
-	0xcc, 0x9d, 0x00, 0x09, 0xff, 0x5e, 0x89, 0x00, 0x8c, 0x6f, 0xee, 0x3c, 0x61, 0x47, 0x58, 0x02,
-	0xa2, 0x9b, 0x3b, 0x4f, 0x64, 0x09, 0xa3, 0xac, 0x52, 0xdb, 0xdc, 0x79, 0xa2, 0x6e, 0xef, 0x28,
-	0x5b, 0xe5, 0x4d, 0x39, 0x82, 0xc3, 0xf8, 0xdf, 0xf4, 0xb8, 0x2a, 0x57, 0x76, 0x1e, 0xd7, 0xdc,
-	0xde, 0x18, 0xae, 0x74, 0xa3, 0xfe, 0xe1, 0x86, 0x1c, 0x47, 0x25, 0xf0, 0x2f, 0x7a, 0x3a, 0x0d,
-	0x95, 0xff, 0x69, 0x14, 0xae, 0x8d, 0xfa, 0x4d, 0x10, 0xc9, 0xc0, 0x44, 0xb5, 0xbe, 0xa6, 0x2a,
-	0xeb, 0x8f, 0x68, 0x10, 0xba, 0xcd, 0x5a, 0xa3, 0xc6, 0x0f, 0x50, 0x6c, 0x6e, 0xd6, 0xb7, 0x1f,
-	0xaa, 0xd5, 0x8d, 0x5a, 0xf5, 0xa1, 0x1c, 0xa1, 0x47, 0xa5, 0x8b, 0x95, 0xd7, 0x14, 0x39, 0xea,
-	0x8e, 0x5a, 0x7b, 0xd4, 0x7c, 0xaa, 0x56, 0x9f, 0x56, 0x37, 0x6b, 0x2c, 0xda, 0x28, 0xd1, 0x47,
-	0xea, 0x6e, 0x59, 0x29, 0x6f, 0xa9, 0x8d, 0x5a, 0xf3, 0xd1, 0x2e, 0x3b, 0x42, 0xe9, 0xd8, 0xda,
-	0x63, 0xb5, 0xd1, 0x2c, 0x37, 0x1f, 0x35, 0xe4, 0x71, 0x32, 0x05, 0x39, 0xc4, 0xb6, 0x6b, 0x4f,
-	0x54, 0x6e, 0x7c, 0x39, 0x41, 0x6e, 0xc0, 0x14, 0x27, 0x68, 0xd6, 0xb7, 0xea, 0xdb, 0x1f, 0x72,
-	0x86, 0xa4, 0xcb, 0xdc, 0xf4, 0x33, 0x4f, 0x0c, 0x99, 0x37, 0x87, 0x24, 0xe0, 0x2d, 0xe7, 0x61,
-	0xed, 0xa9, 0x9c, 0x72, 0x39, 0xcb, 0x6b, 0x8a, 0x6f, 0x6e, 0xda, 0xd5, 0x60, 0xad, 0xf6, 0xb8,
-	0x5e, 0xad, 0xa1, 0xc0, 0x9a, 0x9c, 0xc1, 0x4a, 0x01, 0xc1, 0xf5, 0x1d, 0xa5, 0x5a, 0x53, 0x59,
-	0x64, 0xca, 0x59, 0x52, 0x80, 0xeb, 0x8c, 0x92, 0x46, 0xaa, 0x48, 0x93, 0x73, 0x55, 0xdb, 0xa5,
-	0xea, 0x6e, 0xee, 0x34, 0xd5, 0xfa, 0xf6, 0xfa, 0x8e, 0x2c, 0x93, 0x69, 0x78, 0xc3, 0x8f, 0xbb,
-	0x1a, 0x4e, 0x92, 0x37, 0x60, 0x12, 0xbb, 0x2a, 0xb5, 0x72, 0x75, 0x67, 0x9b, 0x2f, 0x55, 0x26,
-	0xae, 0x42, 0x1c, 0xc6, 0x18, 0x95, 0xa7, 0x02, 0x5a, 0x6e, 0xed, 0xac, 0xd5, 0xe4, 0x5b, 0xb4,
-	0x9a, 0xa0, 0x99, 0xbb, 0x5a, 0x5f, 0xa3, 0x41, 0x76, 0x16, 0x81, 0xa9, 0x11, 0xc5, 0x2c, 0xcd,
-	0x5b, 0x43, 0x0f, 0xa9, 0x45, 0x79, 0x2c, 0x80, 0x94, 0x58, 0xd4, 0x09, 0xc8, 0x12, 0xf3, 0xb6,
-	0x80, 0xac, 0xca, 0x51, 0xdc, 0x22, 0x22, 0xcf, 0x8a, 0x1c, 0x0b, 0x40, 0x8b, 0x25, 0x39, 0x1e,
-	0x80, 0x56, 0x96, 0xe4, 0x71, 0x74, 0x90, 0x38, 0xb1, 0xb4, 0x2a, 0x27, 0x02, 0x58, 0x69, 0x79,
-	0x45, 0x4e, 0x06, 0xb0, 0xe5, 0x62, 0x49, 0x9e, 0xc0, 0xa5, 0x8b, 0x73, 0x17, 0x4a, 0x4b, 0x32,
-	0x04, 0xc0, 0xd2, 0xc2, 0xd2, 0xaa, 0x9c, 0x0a, 0x80, 0x4b, 0x0b, 0xf7, 0x57, 0x98, 0x7f, 0xc5,
-	0x55, 0x14, 0xef, 0x97, 0x98, 0x7f, 0x7d, 0x0b, 0x59, 0x5c, 0xc5, 0xf4, 0xee, 0x47, 0x17, 0x4b,
-	0xf7, 0x56, 0x56, 0xe5, 0x5c, 0xe1, 0xfa, 0xd9, 0x8b, 0xe9, 0x6c, 0x5e, 0x9a, 0x03, 0xaf, 0x8f,
-	0x1a, 0xfb, 0x2f, 0x25, 0xc8, 0xfa, 0x6f, 0x27, 0xb8, 0x72, 0xea, 0xe8, 0xda, 0xe3, 0x9a, 0xf2,
-	0x54, 0x2d, 0xf2, 0xac, 0x22, 0x40, 0xa5, 0x86, 0x2c, 0x05, 0xa0, 0x25, 0x4c, 0x77, 0x7e, 0x68,
-	0xb5, 0xc1, 0x76, 0x96, 0xc8, 0xb5, 0xd2, 0x60, 0x85, 0xa9, 0x80, 0x2d, 0x96, 0x1a, 0x6c, 0x57,
-	0x09, 0xd8, 0xca, 0x12, 0xdf, 0x55, 0xe2, 0xdc, 0xd2, 0x6a, 0x43, 0x4e, 0x78, 0xeb, 0xf0, 0xba,
-	0xe8, 0x3a, 0x7e, 0x3b, 0xea, 0xbe, 0xce, 0xf8, 0x9f, 0x83, 0xa6, 0x20, 0x37, 0xcc, 0xdd, 0x8f,
-	0xb6, 0x9b, 0xe8, 0xee, 0xb1, 0x10, 0xb8, 0x88, 0xa1, 0x13, 0x04, 0x57, 0x96, 0x58, 0xb1, 0xed,
-	0x9f, 0x5e, 0xc2, 0x08, 0x0a, 0xa2, 0xe8, 0xf6, 0x58, 0x08, 0x45, 0xc7, 0xc7, 0x71, 0x7f, 0xf8,
-	0x19, 0xd0, 0xf5, 0xe3, 0x21, 0x98, 0x3a, 0x3f, 0x11, 0x82, 0xa9, 0xfb, 0x93, 0x21, 0x98, 0x06,
-	0xc0, 0x04, 0x6e, 0xd7, 0xc0, 0xe2, 0x30, 0x04, 0x20, 0x84, 0xb3, 0x20, 0x48, 0x85, 0xf0, 0x95,
-	0xe5, 0xe5, 0x45, 0x8c, 0xae, 0x1b, 0x30, 0xe5, 0xe7, 0x59, 0x2c, 0x2e, 0xdc, 0xc3, 0x08, 0x0b,
-	0x76, 0x94, 0x56, 0x4a, 0xc5, 0x25, 0x0c, 0xb2, 0x60, 0xc7, 0x72, 0x69, 0xa9, 0xb4, 0x8a, 0x71,
-	0x96, 0x3f, 0x7b, 0x31, 0x2d, 0xe7, 0xa5, 0xb9, 0xb4, 0xd8, 0x4d, 0x3d, 0xf4, 0x93, 0x08, 0x90,
-	0xf0, 0x83, 0x1b, 0x06, 0x0d, 0x1f, 0x8a, 0x59, 0x8b, 0xe6, 0xf0, 0x00, 0x54, 0x64, 0xd1, 0x26,
-	0x42, 0x25, 0x16, 0x6d, 0x22, 0xb4, 0xc8, 0x76, 0xb6, 0x08, 0x2d, 0xb1, 0x9d, 0x2d, 0x42, 0xcb,
-	0x6c, 0x67, 0x8b, 0x10, 0x96, 0x0a, 0x01, 0x08, 0x2b, 0xb4, 0x00, 0x84, 0x35, 0x5a, 0x00, 0xba,
-	0xcf, 0x72, 0xb6, 0x4f, 0x55, 0xac, 0xd3, 0x82, 0x18, 0x56, 0x6a, 0x41, 0x0c, 0x6b, 0xb5, 0x20,
-	0x86, 0xd5, 0x5a, 0x10, 0x43, 0x5b, 0x07, 0x31, 0xac, 0xd8, 0xf0, 0xce, 0x91, 0xcb, 0x4b, 0x73,
-	0x29, 0xa1, 0x87, 0x5a, 0xf9, 0x1f, 0x24, 0xf7, 0xdf, 0x25, 0xfa, 0x9f, 0x69, 0x85, 0xf0, 0xde,
-	0xad, 0x29, 0xf5, 0x9d, 0x35, 0x6a, 0xe9, 0x10, 0x58, 0xf4, 0x6d, 0x04, 0x0e, 0xa2, 0xb5, 0x43,
-	0x20, 0xda, 0x3b, 0x04, 0xa2, 0xc5, 0x43, 0x20, 0xda, 0x3c, 0x04, 0xae, 0xb0, 0x0d, 0xee, 0x07,
-	0xb1, 0x3a, 0xc3, 0x5a, 0x74, 0x32, 0x2f, 0xcd, 0x65, 0x7c, 0x5d, 0x74, 0x6d, 0xff, 0x12, 0x01,
-	0xf0, 0xae, 0xd8, 0x34, 0x43, 0xb3, 0x93, 0x04, 0x9b, 0xea, 0x2a, 0xab, 0xca, 0x44, 0xa8, 0xb8,
-	0xc0, 0x4a, 0x00, 0x1f, 0x86, 0xab, 0x09, 0x62, 0x8b, 0x2c, 0x55, 0xf9, 0xb0, 0x25, 0x96, 0xaa,
-	0x7c, 0xd8, 0x0a, 0x4b, 0x55, 0x3e, 0x6c, 0x95, 0x9f, 0x0c, 0x02, 0x56, 0x5a, 0xe0, 0x27, 0x83,
-	0x88, 0x15, 0xf9, 0xc9, 0x20, 0x62, 0x4b, 0x2c, 0x84, 0x7c, 0xd8, 0x0a, 0x0b, 0x21, 0x1f, 0x76,
-	0x8f, 0x85, 0x90, 0x0f, 0xbb, 0xcf, 0x42, 0x48, 0xc4, 0x16, 0x17, 0x58, 0x08, 0xf9, 0xb0, 0x45,
-	0x16, 0x42, 0x3e, 0x6c, 0x45, 0x0c, 0x21, 0xa1, 0x87, 0x9a, 0xf9, 0x37, 0xa3, 0x30, 0x35, 0xe2,
-	0x49, 0x06, 0xdd, 0x85, 0x85, 0x47, 0xb9, 0xfa, 0x50, 0xdd, 0xac, 0x6f, 0xd5, 0x9b, 0xf4, 0x08,
-	0x0e, 0x81, 0x3c, 0x95, 0xfa, 0xc1, 0x25, 0x16, 0x41, 0x7e, 0x90, 0x67, 0xd2, 0x00, 0x27, 0xcf,
-	0xa4, 0x7e, 0x94, 0x9e, 0xc8, 0x21, 0x74, 0x85, 0x27, 0xd2, 0x00, 0x43, 0x89, 0x27, 0xd2, 0x80,
-	0x5e, 0xcb, 0x3c, 0x91, 0xfa, 0x61, 0x76, 0x3a, 0x5f, 0x07, 0x12, 0x20, 0x61, 0x07, 0x74, 0x08,
-	0xe7, 0x67, 0x74, 0x08, 0xe7, 0xc7, 0x74, 0x08, 0xe7, 0x27, 0xf5, 0x0d, 0x6a, 0x51, 0xdf, 0x32,
-	0xd9, 0x61, 0x1d, 0xea, 0x70, 0xcf, 0xeb, 0xe1, 0x36, 0xf0, 0x75, 0x07, 0xfc, 0xe3, 0x7b, 0xc9,
-	0x12, 0x0d, 0xbc, 0x56, 0xdb, 0x2c, 0x3f, 0x0d, 0xfa, 0x87, 0x81, 0x01, 0xff, 0x30, 0x30, 0xe0,
-	0x1f, 0x06, 0x06, 0xfc, 0xc3, 0x39, 0x03, 0xfe, 0x61, 0x68, 0xd0, 0x3f, 0x0c, 0x0d, 0xfa, 0x87,
-	0x33, 0x04, 0xfd, 0xc3, 0xf5, 0x0a, 0xfa, 0x87, 0xc1, 0x21, 0xff, 0x70, 0x92, 0x90, 0x7f, 0x38,
-	0x4b, 0xc8, 0x3f, 0x7c, 0x81, 0x21, 0xff, 0xf0, 0x35, 0x86, 0xfc, 0xe3, 0x2e, 0x33, 0xe4, 0x1f,
-	0x77, 0xa5, 0xe7, 0xf8, 0x87, 0x76, 0x53, 0xff, 0xfc, 0x61, 0x04, 0x12, 0xfc, 0xbd, 0x94, 0x64,
-	0x01, 0x94, 0x8f, 0xf8, 0x54, 0x4c, 0xb8, 0x62, 0xbb, 0xc8, 0xde, 0xc2, 0x86, 0xed, 0x12, 0x7b,
-	0x0b, 0x1b, 0xb6, 0x31, 0x29, 0x89, 0xed, 0x25, 0xf6, 0x1a, 0x36, 0x6c, 0x2f, 0xb3, 0xd7, 0xb0,
-	0x61, 0x1b, 0x53, 0xaa, 0xd8, 0xc6, 0x53, 0x4c, 0x6c, 0xe3, 0x11, 0x26, 0xb6, 0xf1, 0xfc, 0xc2,
-	0x2b, 0xeb, 0x50, 0x1f, 0x3c, 0xbc, 0x7c, 0x00, 0x9e, 0x5c, 0x3e, 0x00, 0x8f, 0x2d, 0x1f, 0x80,
-	0x67, 0x96, 0x0f, 0x40, 0xa3, 0xf9, 0x80, 0x65, 0x5e, 0x14, 0xa4, 0x65, 0x69, 0x2e, 0xe9, 0xc2,
-	0xc3, 0xe7, 0x85, 0x3f, 0x8e, 0x40, 0x9c, 0x7e, 0xa9, 0xa6, 0x8f, 0x86, 0xf5, 0xed, 0x1d, 0x65,
-	0x78, 0x93, 0x4b, 0x41, 0x82, 0x01, 0xfc, 0x55, 0xc6, 0xeb, 0xe5, 0xaf, 0x32, 0x1e, 0xc0, 0x5f,
-	0x65, 0x3c, 0x80, 0xbf, 0xca, 0x78, 0x00, 0x7f, 0x95, 0xf1, 0x00, 0xfe, 0x2a, 0xe3, 0x01, 0xfc,
-	0x55, 0xc6, 0x03, 0xf8, 0xab, 0x8c, 0x07, 0xf0, 0x57, 0x19, 0x0f, 0x70, 0x5f, 0x65, 0x04, 0x84,
-	0xbf, 0xca, 0x08, 0x08, 0x7f, 0x95, 0x11, 0x10, 0xfe, 0x2a, 0x23, 0x20, 0xfc, 0x55, 0x46, 0x40,
-	0xd0, 0x6a, 0xc3, 0xfb, 0x37, 0xc5, 0xd1, 0x64, 0x95, 0xad, 0xbf, 0xff, 0xe7, 0x99, 0xb1, 0x5f,
-	0xf8, 0x74, 0x46, 0xfa, 0x83, 0x4f, 0x67, 0xa4, 0x9f, 0x7e, 0x3a, 0x33, 0xf6, 0xef, 0x9f, 0xce,
-	0x48, 0x9f, 0xfc, 0x64, 0x66, 0xec, 0x87, 0x3f, 0x99, 0x91, 0xbe, 0x76, 0xf7, 0x0a, 0xff, 0xfe,
-	0xdb, 0x31, 0xfb, 0x7b, 0x7b, 0xe3, 0xf4, 0x0b, 0xf8, 0xe2, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff,
-	0xb2, 0xcf, 0x7a, 0xe7, 0xd5, 0x47, 0x00, 0x00,
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) Reset()      { *m = MACCommand_RelayNotifyNewEndDeviceReq{} }
+func (*MACCommand_RelayNotifyNewEndDeviceReq) ProtoMessage() {}
+func (*MACCommand_RelayNotifyNewEndDeviceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 39}
+}
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MACCommand_RelayNotifyNewEndDeviceReq.Unmarshal(m, b)
+}
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MACCommand_RelayNotifyNewEndDeviceReq.Marshal(b, m, deterministic)
+}
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACCommand_RelayNotifyNewEndDeviceReq.Merge(m, src)
+}
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) XXX_Size() int {
+	return xxx_messageInfo_MACCommand_RelayNotifyNewEndDeviceReq.Size(m)
+}
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACCommand_RelayNotifyNewEndDeviceReq.DiscardUnknown(m)
 }
 
-func (x MType) String() string {
-	s, ok := MType_name[int32(x)]
-	if ok {
-		return s
+var xxx_messageInfo_MACCommand_RelayNotifyNewEndDeviceReq proto.InternalMessageInfo
+
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) GetSnr() int32 {
+	if m != nil {
+		return m.Snr
 	}
-	return strconv.Itoa(int(x))
+	return 0
 }
-func (x Major) String() string {
-	s, ok := Major_name[int32(x)]
-	if ok {
-		return s
+
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) GetRssi() int32 {
+	if m != nil {
+		return m.Rssi
 	}
-	return strconv.Itoa(int(x))
+	return 0
 }
-func (x JoinRequestType) String() string {
-	s, ok := JoinRequestType_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+
+type RelaySecondChannel struct {
+	AckOffset     RelaySecondChAckOffset `protobuf:"varint,1,opt,name=ack_offset,json=ackOffset,proto3,enum=ttn.lorawan.v3.RelaySecondChAckOffset" json:"ack_offset,omitempty"`
+	DataRateIndex DataRateIndex          `protobuf:"varint,2,opt,name=data_rate_index,json=dataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"data_rate_index,omitempty"`
+	// Frequency (Hz).
+	Frequency            uint64   `protobuf:"varint,3,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (x RejoinRequestType) String() string {
-	s, ok := RejoinRequestType_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+
+func (m *RelaySecondChannel) Reset()      { *m = RelaySecondChannel{} }
+func (*RelaySecondChannel) ProtoMessage() {}
+func (*RelaySecondChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{20}
 }
-func (x CFListType) String() string {
-	s, ok := CFListType_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *RelaySecondChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelaySecondChannel.Unmarshal(m, b)
 }
-func (x Class) String() string {
-	s, ok := Class_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *RelaySecondChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelaySecondChannel.Marshal(b, m, deterministic)
 }
-func (x TxSchedulePriority) String() string {
-	s, ok := TxSchedulePriority_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *RelaySecondChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelaySecondChannel.Merge(m, src)
 }
-func (x MACCommandIdentifier) String() string {
-	s, ok := MACCommandIdentifier_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *RelaySecondChannel) XXX_Size() int {
+	return xxx_messageInfo_RelaySecondChannel.Size(m)
 }
-func (x AggregatedDutyCycle) String() string {
-	s, ok := AggregatedDutyCycle_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *RelaySecondChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelaySecondChannel.DiscardUnknown(m)
 }
-func (x PingSlotPeriod) String() string {
-	s, ok := PingSlotPeriod_name[int32(x)]
-	if ok {
-		return s
+
+var xxx_messageInfo_RelaySecondChannel proto.InternalMessageInfo
+
+func (m *RelaySecondChannel) GetAckOffset() RelaySecondChAckOffset {
+	if m != nil {
+		return m.AckOffset
 	}
-	return strconv.Itoa(int(x))
+	return RELAY_SECOND_CH_ACK_OFFSET_0
 }
-func (x RejoinCountExponent) String() string {
-	s, ok := RejoinCountExponent_name[int32(x)]
-	if ok {
-		return s
+
+func (m *RelaySecondChannel) GetDataRateIndex() DataRateIndex {
+	if m != nil {
+		return m.DataRateIndex
 	}
-	return strconv.Itoa(int(x))
+	return DATA_RATE_0
 }
-func (x RejoinTimeExponent) String() string {
-	s, ok := RejoinTimeExponent_name[int32(x)]
-	if ok {
-		return s
+
+func (m *RelaySecondChannel) GetFrequency() uint64 {
+	if m != nil {
+		return m.Frequency
 	}
-	return strconv.Itoa(int(x))
+	return 0
 }
-func (x RejoinPeriodExponent) String() string {
-	s, ok := RejoinPeriodExponent_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+
+type RelayEndDeviceAlwaysMode struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (x DeviceEIRP) String() string {
-	s, ok := DeviceEIRP_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+
+func (m *RelayEndDeviceAlwaysMode) Reset()      { *m = RelayEndDeviceAlwaysMode{} }
+func (*RelayEndDeviceAlwaysMode) ProtoMessage() {}
+func (*RelayEndDeviceAlwaysMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{21}
 }
-func (x ADRAckLimitExponent) String() string {
-	s, ok := ADRAckLimitExponent_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *RelayEndDeviceAlwaysMode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelayEndDeviceAlwaysMode.Unmarshal(m, b)
 }
-func (x ADRAckDelayExponent) String() string {
-	s, ok := ADRAckDelayExponent_name[int32(x)]
-	if ok {
-		return s
-	}
-	return strconv.Itoa(int(x))
+func (m *RelayEndDeviceAlwaysMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelayEndDeviceAlwaysMode.Marshal(b, m, deterministic)
 }
-func (x Minor) String() string {
-	s, ok := Minor_name[int32(x)]
-	if ok {
-		return s
+func (m *RelayEndDeviceAlwaysMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayEndDeviceAlwaysMode.Merge(m, src)
+}
+func (m *RelayEndDeviceAlwaysMode) XXX_Size() int {
+	return xxx_messageInfo_RelayEndDeviceAlwaysMode.Size(m)
+}
+func (m *RelayEndDeviceAlwaysMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayEndDeviceAlwaysMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayEndDeviceAlwaysMode proto.InternalMessageInfo
+
+type RelayEndDeviceDynamicMode struct {
+	SmartEnableLevel     RelaySmartEnableLevel `protobuf:"varint,1,opt,name=smart_enable_level,json=smartEnableLevel,proto3,enum=ttn.lorawan.v3.RelaySmartEnableLevel" json:"smart_enable_level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RelayEndDeviceDynamicMode) Reset()      { *m = RelayEndDeviceDynamicMode{} }
+func (*RelayEndDeviceDynamicMode) ProtoMessage() {}
+func (*RelayEndDeviceDynamicMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{22}
+}
+func (m *RelayEndDeviceDynamicMode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelayEndDeviceDynamicMode.Unmarshal(m, b)
+}
+func (m *RelayEndDeviceDynamicMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelayEndDeviceDynamicMode.Marshal(b, m, deterministic)
+}
+func (m *RelayEndDeviceDynamicMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayEndDeviceDynamicMode.Merge(m, src)
+}
+func (m *RelayEndDeviceDynamicMode) XXX_Size() int {
+	return xxx_messageInfo_RelayEndDeviceDynamicMode.Size(m)
+}
+func (m *RelayEndDeviceDynamicMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayEndDeviceDynamicMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayEndDeviceDynamicMode proto.InternalMessageInfo
+
+func (m *RelayEndDeviceDynamicMode) GetSmartEnableLevel() RelaySmartEnableLevel {
+	if m != nil {
+		return m.SmartEnableLevel
+	}
+	return RELAY_SMART_ENABLE_LEVEL_8
+}
+
+type RelayEndDeviceControlledMode struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RelayEndDeviceControlledMode) Reset()      { *m = RelayEndDeviceControlledMode{} }
+func (*RelayEndDeviceControlledMode) ProtoMessage() {}
+func (*RelayEndDeviceControlledMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{23}
+}
+func (m *RelayEndDeviceControlledMode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelayEndDeviceControlledMode.Unmarshal(m, b)
+}
+func (m *RelayEndDeviceControlledMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelayEndDeviceControlledMode.Marshal(b, m, deterministic)
+}
+func (m *RelayEndDeviceControlledMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayEndDeviceControlledMode.Merge(m, src)
+}
+func (m *RelayEndDeviceControlledMode) XXX_Size() int {
+	return xxx_messageInfo_RelayEndDeviceControlledMode.Size(m)
+}
+func (m *RelayEndDeviceControlledMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayEndDeviceControlledMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayEndDeviceControlledMode proto.InternalMessageInfo
+
+type RelayForwardLimits struct {
+	BucketSize RelayLimitBucketSize `protobuf:"varint,1,opt,name=bucket_size,json=bucketSize,proto3,enum=ttn.lorawan.v3.RelayLimitBucketSize" json:"bucket_size,omitempty"`
+	// Number of uplinks per hour the relay may forward.
+	ReloadRate           uint32   `protobuf:"varint,2,opt,name=reload_rate,json=reloadRate,proto3" json:"reload_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RelayForwardLimits) Reset()      { *m = RelayForwardLimits{} }
+func (*RelayForwardLimits) ProtoMessage() {}
+func (*RelayForwardLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{24}
+}
+func (m *RelayForwardLimits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelayForwardLimits.Unmarshal(m, b)
+}
+func (m *RelayForwardLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelayForwardLimits.Marshal(b, m, deterministic)
+}
+func (m *RelayForwardLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayForwardLimits.Merge(m, src)
+}
+func (m *RelayForwardLimits) XXX_Size() int {
+	return xxx_messageInfo_RelayForwardLimits.Size(m)
+}
+func (m *RelayForwardLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayForwardLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayForwardLimits proto.InternalMessageInfo
+
+func (m *RelayForwardLimits) GetBucketSize() RelayLimitBucketSize {
+	if m != nil {
+		return m.BucketSize
+	}
+	return RELAY_LIMIT_BUCKET_SIZE_1
+}
+
+func (m *RelayForwardLimits) GetReloadRate() uint32 {
+	if m != nil {
+		return m.ReloadRate
+	}
+	return 0
+}
+
+// Downlink of a served end device, which is forwarded by the relay.
+type RelayForwardDownlinkReq struct {
+	RawPayload           []byte   `protobuf:"bytes,1,opt,name=raw_payload,json=rawPayload,proto3" json:"raw_payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RelayForwardDownlinkReq) Reset()      { *m = RelayForwardDownlinkReq{} }
+func (*RelayForwardDownlinkReq) ProtoMessage() {}
+func (*RelayForwardDownlinkReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{25}
+}
+func (m *RelayForwardDownlinkReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelayForwardDownlinkReq.Unmarshal(m, b)
+}
+func (m *RelayForwardDownlinkReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelayForwardDownlinkReq.Marshal(b, m, deterministic)
+}
+func (m *RelayForwardDownlinkReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayForwardDownlinkReq.Merge(m, src)
+}
+func (m *RelayForwardDownlinkReq) XXX_Size() int {
+	return xxx_messageInfo_RelayForwardDownlinkReq.Size(m)
+}
+func (m *RelayForwardDownlinkReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayForwardDownlinkReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayForwardDownlinkReq proto.InternalMessageInfo
+
+func (m *RelayForwardDownlinkReq) GetRawPayload() []byte {
+	if m != nil {
+		return m.RawPayload
+	}
+	return nil
+}
+
+type FrequencyValue struct {
+	Value                uint64   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FrequencyValue) Reset()      { *m = FrequencyValue{} }
+func (*FrequencyValue) ProtoMessage() {}
+func (*FrequencyValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{26}
+}
+func (m *FrequencyValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FrequencyValue.Unmarshal(m, b)
+}
+func (m *FrequencyValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FrequencyValue.Marshal(b, m, deterministic)
+}
+func (m *FrequencyValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrequencyValue.Merge(m, src)
+}
+func (m *FrequencyValue) XXX_Size() int {
+	return xxx_messageInfo_FrequencyValue.Size(m)
+}
+func (m *FrequencyValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrequencyValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrequencyValue proto.InternalMessageInfo
+
+func (m *FrequencyValue) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type DataRateOffsetValue struct {
+	Value                DataRateOffset `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.DataRateOffset" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DataRateOffsetValue) Reset()      { *m = DataRateOffsetValue{} }
+func (*DataRateOffsetValue) ProtoMessage() {}
+func (*DataRateOffsetValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{27}
+}
+func (m *DataRateOffsetValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DataRateOffsetValue.Unmarshal(m, b)
+}
+func (m *DataRateOffsetValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DataRateOffsetValue.Marshal(b, m, deterministic)
+}
+func (m *DataRateOffsetValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataRateOffsetValue.Merge(m, src)
+}
+func (m *DataRateOffsetValue) XXX_Size() int {
+	return xxx_messageInfo_DataRateOffsetValue.Size(m)
+}
+func (m *DataRateOffsetValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataRateOffsetValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataRateOffsetValue proto.InternalMessageInfo
+
+func (m *DataRateOffsetValue) GetValue() DataRateOffset {
+	if m != nil {
+		return m.Value
+	}
+	return DataRateOffset_DATA_RATE_OFFSET_0
+}
+
+type DataRateIndexValue struct {
+	Value                DataRateIndex `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DataRateIndexValue) Reset()      { *m = DataRateIndexValue{} }
+func (*DataRateIndexValue) ProtoMessage() {}
+func (*DataRateIndexValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{28}
+}
+func (m *DataRateIndexValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DataRateIndexValue.Unmarshal(m, b)
+}
+func (m *DataRateIndexValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DataRateIndexValue.Marshal(b, m, deterministic)
+}
+func (m *DataRateIndexValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataRateIndexValue.Merge(m, src)
+}
+func (m *DataRateIndexValue) XXX_Size() int {
+	return xxx_messageInfo_DataRateIndexValue.Size(m)
+}
+func (m *DataRateIndexValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataRateIndexValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataRateIndexValue proto.InternalMessageInfo
+
+func (m *DataRateIndexValue) GetValue() DataRateIndex {
+	if m != nil {
+		return m.Value
+	}
+	return DATA_RATE_0
+}
+
+type PingSlotPeriodValue struct {
+	Value                PingSlotPeriod `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.PingSlotPeriod" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PingSlotPeriodValue) Reset()      { *m = PingSlotPeriodValue{} }
+func (*PingSlotPeriodValue) ProtoMessage() {}
+func (*PingSlotPeriodValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{29}
+}
+func (m *PingSlotPeriodValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PingSlotPeriodValue.Unmarshal(m, b)
+}
+func (m *PingSlotPeriodValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PingSlotPeriodValue.Marshal(b, m, deterministic)
+}
+func (m *PingSlotPeriodValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PingSlotPeriodValue.Merge(m, src)
+}
+func (m *PingSlotPeriodValue) XXX_Size() int {
+	return xxx_messageInfo_PingSlotPeriodValue.Size(m)
+}
+func (m *PingSlotPeriodValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_PingSlotPeriodValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PingSlotPeriodValue proto.InternalMessageInfo
+
+func (m *PingSlotPeriodValue) GetValue() PingSlotPeriod {
+	if m != nil {
+		return m.Value
+	}
+	return PING_EVERY_1S
+}
+
+type AggregatedDutyCycleValue struct {
+	Value                AggregatedDutyCycle `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.AggregatedDutyCycle" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *AggregatedDutyCycleValue) Reset()      { *m = AggregatedDutyCycleValue{} }
+func (*AggregatedDutyCycleValue) ProtoMessage() {}
+func (*AggregatedDutyCycleValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{30}
+}
+func (m *AggregatedDutyCycleValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AggregatedDutyCycleValue.Unmarshal(m, b)
+}
+func (m *AggregatedDutyCycleValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AggregatedDutyCycleValue.Marshal(b, m, deterministic)
+}
+func (m *AggregatedDutyCycleValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedDutyCycleValue.Merge(m, src)
+}
+func (m *AggregatedDutyCycleValue) XXX_Size() int {
+	return xxx_messageInfo_AggregatedDutyCycleValue.Size(m)
+}
+func (m *AggregatedDutyCycleValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedDutyCycleValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedDutyCycleValue proto.InternalMessageInfo
+
+func (m *AggregatedDutyCycleValue) GetValue() AggregatedDutyCycle {
+	if m != nil {
+		return m.Value
+	}
+	return DUTY_CYCLE_1
+}
+
+type RxDelayValue struct {
+	Value                RxDelay  `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.RxDelay" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RxDelayValue) Reset()      { *m = RxDelayValue{} }
+func (*RxDelayValue) ProtoMessage() {}
+func (*RxDelayValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{31}
+}
+func (m *RxDelayValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RxDelayValue.Unmarshal(m, b)
+}
+func (m *RxDelayValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RxDelayValue.Marshal(b, m, deterministic)
+}
+func (m *RxDelayValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RxDelayValue.Merge(m, src)
+}
+func (m *RxDelayValue) XXX_Size() int {
+	return xxx_messageInfo_RxDelayValue.Size(m)
+}
+func (m *RxDelayValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_RxDelayValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RxDelayValue proto.InternalMessageInfo
+
+func (m *RxDelayValue) GetValue() RxDelay {
+	if m != nil {
+		return m.Value
+	}
+	return RX_DELAY_0
+}
+
+type ADRAckLimitExponentValue struct {
+	Value                ADRAckLimitExponent `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.ADRAckLimitExponent" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ADRAckLimitExponentValue) Reset()      { *m = ADRAckLimitExponentValue{} }
+func (*ADRAckLimitExponentValue) ProtoMessage() {}
+func (*ADRAckLimitExponentValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{32}
+}
+func (m *ADRAckLimitExponentValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ADRAckLimitExponentValue.Unmarshal(m, b)
+}
+func (m *ADRAckLimitExponentValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ADRAckLimitExponentValue.Marshal(b, m, deterministic)
+}
+func (m *ADRAckLimitExponentValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ADRAckLimitExponentValue.Merge(m, src)
+}
+func (m *ADRAckLimitExponentValue) XXX_Size() int {
+	return xxx_messageInfo_ADRAckLimitExponentValue.Size(m)
+}
+func (m *ADRAckLimitExponentValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ADRAckLimitExponentValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ADRAckLimitExponentValue proto.InternalMessageInfo
+
+func (m *ADRAckLimitExponentValue) GetValue() ADRAckLimitExponent {
+	if m != nil {
+		return m.Value
+	}
+	return ADR_ACK_LIMIT_1
+}
+
+type ADRAckDelayExponentValue struct {
+	Value                ADRAckDelayExponent `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.ADRAckDelayExponent" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ADRAckDelayExponentValue) Reset()      { *m = ADRAckDelayExponentValue{} }
+func (*ADRAckDelayExponentValue) ProtoMessage() {}
+func (*ADRAckDelayExponentValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{33}
+}
+func (m *ADRAckDelayExponentValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ADRAckDelayExponentValue.Unmarshal(m, b)
+}
+func (m *ADRAckDelayExponentValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ADRAckDelayExponentValue.Marshal(b, m, deterministic)
+}
+func (m *ADRAckDelayExponentValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ADRAckDelayExponentValue.Merge(m, src)
+}
+func (m *ADRAckDelayExponentValue) XXX_Size() int {
+	return xxx_messageInfo_ADRAckDelayExponentValue.Size(m)
+}
+func (m *ADRAckDelayExponentValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ADRAckDelayExponentValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ADRAckDelayExponentValue proto.InternalMessageInfo
+
+func (m *ADRAckDelayExponentValue) GetValue() ADRAckDelayExponent {
+	if m != nil {
+		return m.Value
+	}
+	return ADR_ACK_DELAY_1
+}
+
+type DeviceEIRPValue struct {
+	Value                DeviceEIRP `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.DeviceEIRP" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *DeviceEIRPValue) Reset()      { *m = DeviceEIRPValue{} }
+func (*DeviceEIRPValue) ProtoMessage() {}
+func (*DeviceEIRPValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{34}
+}
+func (m *DeviceEIRPValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceEIRPValue.Unmarshal(m, b)
+}
+func (m *DeviceEIRPValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceEIRPValue.Marshal(b, m, deterministic)
+}
+func (m *DeviceEIRPValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceEIRPValue.Merge(m, src)
+}
+func (m *DeviceEIRPValue) XXX_Size() int {
+	return xxx_messageInfo_DeviceEIRPValue.Size(m)
+}
+func (m *DeviceEIRPValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceEIRPValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceEIRPValue proto.InternalMessageInfo
+
+func (m *DeviceEIRPValue) GetValue() DeviceEIRP {
+	if m != nil {
+		return m.Value
+	}
+	return DEVICE_EIRP_8
+}
+
+func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.MType", MType_name, MType_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.MType", MType_name, MType_value)
+	proto.RegisterEnum("ttn.lorawan.v3.Major", Major_name, Major_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.Major", Major_name, Major_value)
+	proto.RegisterEnum("ttn.lorawan.v3.MACVersion", MACVersion_name, MACVersion_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.MACVersion", MACVersion_name, MACVersion_value)
+	proto.RegisterEnum("ttn.lorawan.v3.PHYVersion", PHYVersion_name, PHYVersion_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.PHYVersion", PHYVersion_name, PHYVersion_value)
+	proto.RegisterEnum("ttn.lorawan.v3.DataRateIndex", DataRateIndex_name, DataRateIndex_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.DataRateIndex", DataRateIndex_name, DataRateIndex_value)
+	proto.RegisterEnum("ttn.lorawan.v3.DataRateOffset", DataRateOffset_name, DataRateOffset_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.DataRateOffset", DataRateOffset_name, DataRateOffset_value)
+	proto.RegisterEnum("ttn.lorawan.v3.JoinRequestType", JoinRequestType_name, JoinRequestType_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.JoinRequestType", JoinRequestType_name, JoinRequestType_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RejoinRequestType", RejoinRequestType_name, RejoinRequestType_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinRequestType", RejoinRequestType_name, RejoinRequestType_value)
+	proto.RegisterEnum("ttn.lorawan.v3.CFListType", CFListType_name, CFListType_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.CFListType", CFListType_name, CFListType_value)
+	proto.RegisterEnum("ttn.lorawan.v3.Class", Class_name, Class_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.Class", Class_name, Class_value)
+	proto.RegisterEnum("ttn.lorawan.v3.TxSchedulePriority", TxSchedulePriority_name, TxSchedulePriority_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.TxSchedulePriority", TxSchedulePriority_name, TxSchedulePriority_value)
+	proto.RegisterEnum("ttn.lorawan.v3.MACCommandIdentifier", MACCommandIdentifier_name, MACCommandIdentifier_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.MACCommandIdentifier", MACCommandIdentifier_name, MACCommandIdentifier_value)
+	proto.RegisterEnum("ttn.lorawan.v3.AggregatedDutyCycle", AggregatedDutyCycle_name, AggregatedDutyCycle_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.AggregatedDutyCycle", AggregatedDutyCycle_name, AggregatedDutyCycle_value)
+	proto.RegisterEnum("ttn.lorawan.v3.PingSlotPeriod", PingSlotPeriod_name, PingSlotPeriod_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.PingSlotPeriod", PingSlotPeriod_name, PingSlotPeriod_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RejoinCountExponent", RejoinCountExponent_name, RejoinCountExponent_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinCountExponent", RejoinCountExponent_name, RejoinCountExponent_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RejoinTimeExponent", RejoinTimeExponent_name, RejoinTimeExponent_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinTimeExponent", RejoinTimeExponent_name, RejoinTimeExponent_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RejoinPeriodExponent", RejoinPeriodExponent_name, RejoinPeriodExponent_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RejoinPeriodExponent", RejoinPeriodExponent_name, RejoinPeriodExponent_value)
+	proto.RegisterEnum("ttn.lorawan.v3.DeviceEIRP", DeviceEIRP_name, DeviceEIRP_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.DeviceEIRP", DeviceEIRP_name, DeviceEIRP_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ADRAckLimitExponent", ADRAckLimitExponent_name, ADRAckLimitExponent_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ADRAckLimitExponent", ADRAckLimitExponent_name, ADRAckLimitExponent_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ADRAckDelayExponent", ADRAckDelayExponent_name, ADRAckDelayExponent_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ADRAckDelayExponent", ADRAckDelayExponent_name, ADRAckDelayExponent_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RxDelay", RxDelay_name, RxDelay_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RxDelay", RxDelay_name, RxDelay_value)
+	proto.RegisterEnum("ttn.lorawan.v3.Minor", Minor_name, Minor_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.Minor", Minor_name, Minor_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RelayCADPeriodicity", RelayCADPeriodicity_name, RelayCADPeriodicity_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RelayCADPeriodicity", RelayCADPeriodicity_name, RelayCADPeriodicity_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RelaySecondChAckOffset", RelaySecondChAckOffset_name, RelaySecondChAckOffset_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RelaySecondChAckOffset", RelaySecondChAckOffset_name, RelaySecondChAckOffset_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RelaySmartEnableLevel", RelaySmartEnableLevel_name, RelaySmartEnableLevel_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RelaySmartEnableLevel", RelaySmartEnableLevel_name, RelaySmartEnableLevel_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RelayLimitBucketSize", RelayLimitBucketSize_name, RelayLimitBucketSize_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RelayLimitBucketSize", RelayLimitBucketSize_name, RelayLimitBucketSize_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RelayJoinRequestFilterAction", RelayJoinRequestFilterAction_name, RelayJoinRequestFilterAction_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RelayJoinRequestFilterAction", RelayJoinRequestFilterAction_name, RelayJoinRequestFilterAction_value)
+	proto.RegisterEnum("ttn.lorawan.v3.RelayCtrlUplinkListAction", RelayCtrlUplinkListAction_name, RelayCtrlUplinkListAction_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.RelayCtrlUplinkListAction", RelayCtrlUplinkListAction_name, RelayCtrlUplinkListAction_value)
+	proto.RegisterType((*Message)(nil), "ttn.lorawan.v3.Message")
+	golang_proto.RegisterType((*Message)(nil), "ttn.lorawan.v3.Message")
+	proto.RegisterType((*MHDR)(nil), "ttn.lorawan.v3.MHDR")
+	golang_proto.RegisterType((*MHDR)(nil), "ttn.lorawan.v3.MHDR")
+	proto.RegisterType((*MACPayload)(nil), "ttn.lorawan.v3.MACPayload")
+	golang_proto.RegisterType((*MACPayload)(nil), "ttn.lorawan.v3.MACPayload")
+	proto.RegisterType((*FHDR)(nil), "ttn.lorawan.v3.FHDR")
+	golang_proto.RegisterType((*FHDR)(nil), "ttn.lorawan.v3.FHDR")
+	proto.RegisterType((*FCtrl)(nil), "ttn.lorawan.v3.FCtrl")
+	golang_proto.RegisterType((*FCtrl)(nil), "ttn.lorawan.v3.FCtrl")
+	proto.RegisterType((*JoinRequestPayload)(nil), "ttn.lorawan.v3.JoinRequestPayload")
+	golang_proto.RegisterType((*JoinRequestPayload)(nil), "ttn.lorawan.v3.JoinRequestPayload")
+	proto.RegisterType((*RejoinRequestPayload)(nil), "ttn.lorawan.v3.RejoinRequestPayload")
+	golang_proto.RegisterType((*RejoinRequestPayload)(nil), "ttn.lorawan.v3.RejoinRequestPayload")
+	proto.RegisterType((*JoinAcceptPayload)(nil), "ttn.lorawan.v3.JoinAcceptPayload")
+	golang_proto.RegisterType((*JoinAcceptPayload)(nil), "ttn.lorawan.v3.JoinAcceptPayload")
+	proto.RegisterType((*DLSettings)(nil), "ttn.lorawan.v3.DLSettings")
+	golang_proto.RegisterType((*DLSettings)(nil), "ttn.lorawan.v3.DLSettings")
+	proto.RegisterType((*CFList)(nil), "ttn.lorawan.v3.CFList")
+	golang_proto.RegisterType((*CFList)(nil), "ttn.lorawan.v3.CFList")
+	proto.RegisterType((*LoRaDataRate)(nil), "ttn.lorawan.v3.LoRaDataRate")
+	golang_proto.RegisterType((*LoRaDataRate)(nil), "ttn.lorawan.v3.LoRaDataRate")
+	proto.RegisterType((*FSKDataRate)(nil), "ttn.lorawan.v3.FSKDataRate")
+	golang_proto.RegisterType((*FSKDataRate)(nil), "ttn.lorawan.v3.FSKDataRate")
+	proto.RegisterType((*LRFHSSDataRate)(nil), "ttn.lorawan.v3.LRFHSSDataRate")
+	golang_proto.RegisterType((*LRFHSSDataRate)(nil), "ttn.lorawan.v3.LRFHSSDataRate")
+	proto.RegisterType((*DataRate)(nil), "ttn.lorawan.v3.DataRate")
+	golang_proto.RegisterType((*DataRate)(nil), "ttn.lorawan.v3.DataRate")
+	proto.RegisterType((*TxSettings)(nil), "ttn.lorawan.v3.TxSettings")
+	golang_proto.RegisterType((*TxSettings)(nil), "ttn.lorawan.v3.TxSettings")
+	proto.RegisterType((*TxSettings_Downlink)(nil), "ttn.lorawan.v3.TxSettings.Downlink")
+	golang_proto.RegisterType((*TxSettings_Downlink)(nil), "ttn.lorawan.v3.TxSettings.Downlink")
+	proto.RegisterType((*GatewayAntennaIdentifiers)(nil), "ttn.lorawan.v3.GatewayAntennaIdentifiers")
+	golang_proto.RegisterType((*GatewayAntennaIdentifiers)(nil), "ttn.lorawan.v3.GatewayAntennaIdentifiers")
+	proto.RegisterType((*UplinkToken)(nil), "ttn.lorawan.v3.UplinkToken")
+	golang_proto.RegisterType((*UplinkToken)(nil), "ttn.lorawan.v3.UplinkToken")
+	proto.RegisterType((*DownlinkPath)(nil), "ttn.lorawan.v3.DownlinkPath")
+	golang_proto.RegisterType((*DownlinkPath)(nil), "ttn.lorawan.v3.DownlinkPath")
+	proto.RegisterType((*TxRequest)(nil), "ttn.lorawan.v3.TxRequest")
+	golang_proto.RegisterType((*TxRequest)(nil), "ttn.lorawan.v3.TxRequest")
+	proto.RegisterType((*MACCommand)(nil), "ttn.lorawan.v3.MACCommand")
+	golang_proto.RegisterType((*MACCommand)(nil), "ttn.lorawan.v3.MACCommand")
+	proto.RegisterType((*MACCommand_ResetInd)(nil), "ttn.lorawan.v3.MACCommand.ResetInd")
+	golang_proto.RegisterType((*MACCommand_ResetInd)(nil), "ttn.lorawan.v3.MACCommand.ResetInd")
+	proto.RegisterType((*MACCommand_ResetConf)(nil), "ttn.lorawan.v3.MACCommand.ResetConf")
+	golang_proto.RegisterType((*MACCommand_ResetConf)(nil), "ttn.lorawan.v3.MACCommand.ResetConf")
+	proto.RegisterType((*MACCommand_LinkCheckAns)(nil), "ttn.lorawan.v3.MACCommand.LinkCheckAns")
+	golang_proto.RegisterType((*MACCommand_LinkCheckAns)(nil), "ttn.lorawan.v3.MACCommand.LinkCheckAns")
+	proto.RegisterType((*MACCommand_LinkADRReq)(nil), "ttn.lorawan.v3.MACCommand.LinkADRReq")
+	golang_proto.RegisterType((*MACCommand_LinkADRReq)(nil), "ttn.lorawan.v3.MACCommand.LinkADRReq")
+	proto.RegisterType((*MACCommand_LinkADRAns)(nil), "ttn.lorawan.v3.MACCommand.LinkADRAns")
+	golang_proto.RegisterType((*MACCommand_LinkADRAns)(nil), "ttn.lorawan.v3.MACCommand.LinkADRAns")
+	proto.RegisterType((*MACCommand_DutyCycleReq)(nil), "ttn.lorawan.v3.MACCommand.DutyCycleReq")
+	golang_proto.RegisterType((*MACCommand_DutyCycleReq)(nil), "ttn.lorawan.v3.MACCommand.DutyCycleReq")
+	proto.RegisterType((*MACCommand_RxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupReq")
+	golang_proto.RegisterType((*MACCommand_RxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupReq")
+	proto.RegisterType((*MACCommand_RxParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupAns")
+	golang_proto.RegisterType((*MACCommand_RxParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RxParamSetupAns")
+	proto.RegisterType((*MACCommand_DevStatusAns)(nil), "ttn.lorawan.v3.MACCommand.DevStatusAns")
+	golang_proto.RegisterType((*MACCommand_DevStatusAns)(nil), "ttn.lorawan.v3.MACCommand.DevStatusAns")
+	proto.RegisterType((*MACCommand_NewChannelReq)(nil), "ttn.lorawan.v3.MACCommand.NewChannelReq")
+	golang_proto.RegisterType((*MACCommand_NewChannelReq)(nil), "ttn.lorawan.v3.MACCommand.NewChannelReq")
+	proto.RegisterType((*MACCommand_NewChannelAns)(nil), "ttn.lorawan.v3.MACCommand.NewChannelAns")
+	golang_proto.RegisterType((*MACCommand_NewChannelAns)(nil), "ttn.lorawan.v3.MACCommand.NewChannelAns")
+	proto.RegisterType((*MACCommand_DLChannelReq)(nil), "ttn.lorawan.v3.MACCommand.DLChannelReq")
+	golang_proto.RegisterType((*MACCommand_DLChannelReq)(nil), "ttn.lorawan.v3.MACCommand.DLChannelReq")
+	proto.RegisterType((*MACCommand_DLChannelAns)(nil), "ttn.lorawan.v3.MACCommand.DLChannelAns")
+	golang_proto.RegisterType((*MACCommand_DLChannelAns)(nil), "ttn.lorawan.v3.MACCommand.DLChannelAns")
+	proto.RegisterType((*MACCommand_RxTimingSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxTimingSetupReq")
+	golang_proto.RegisterType((*MACCommand_RxTimingSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RxTimingSetupReq")
+	proto.RegisterType((*MACCommand_TxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.TxParamSetupReq")
+	golang_proto.RegisterType((*MACCommand_TxParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.TxParamSetupReq")
+	proto.RegisterType((*MACCommand_RekeyInd)(nil), "ttn.lorawan.v3.MACCommand.RekeyInd")
+	golang_proto.RegisterType((*MACCommand_RekeyInd)(nil), "ttn.lorawan.v3.MACCommand.RekeyInd")
+	proto.RegisterType((*MACCommand_RekeyConf)(nil), "ttn.lorawan.v3.MACCommand.RekeyConf")
+	golang_proto.RegisterType((*MACCommand_RekeyConf)(nil), "ttn.lorawan.v3.MACCommand.RekeyConf")
+	proto.RegisterType((*MACCommand_ADRParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.ADRParamSetupReq")
+	golang_proto.RegisterType((*MACCommand_ADRParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.ADRParamSetupReq")
+	proto.RegisterType((*MACCommand_DeviceTimeAns)(nil), "ttn.lorawan.v3.MACCommand.DeviceTimeAns")
+	golang_proto.RegisterType((*MACCommand_DeviceTimeAns)(nil), "ttn.lorawan.v3.MACCommand.DeviceTimeAns")
+	proto.RegisterType((*MACCommand_ForceRejoinReq)(nil), "ttn.lorawan.v3.MACCommand.ForceRejoinReq")
+	golang_proto.RegisterType((*MACCommand_ForceRejoinReq)(nil), "ttn.lorawan.v3.MACCommand.ForceRejoinReq")
+	proto.RegisterType((*MACCommand_RejoinParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupReq")
+	golang_proto.RegisterType((*MACCommand_RejoinParamSetupReq)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupReq")
+	proto.RegisterType((*MACCommand_RejoinParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupAns")
+	golang_proto.RegisterType((*MACCommand_RejoinParamSetupAns)(nil), "ttn.lorawan.v3.MACCommand.RejoinParamSetupAns")
+	proto.RegisterType((*MACCommand_PingSlotInfoReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotInfoReq")
+	golang_proto.RegisterType((*MACCommand_PingSlotInfoReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotInfoReq")
+	proto.RegisterType((*MACCommand_PingSlotChannelReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelReq")
+	golang_proto.RegisterType((*MACCommand_PingSlotChannelReq)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelReq")
+	proto.RegisterType((*MACCommand_PingSlotChannelAns)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelAns")
+	golang_proto.RegisterType((*MACCommand_PingSlotChannelAns)(nil), "ttn.lorawan.v3.MACCommand.PingSlotChannelAns")
+	proto.RegisterType((*MACCommand_BeaconTimingAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconTimingAns")
+	golang_proto.RegisterType((*MACCommand_BeaconTimingAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconTimingAns")
+	proto.RegisterType((*MACCommand_BeaconFreqReq)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqReq")
+	golang_proto.RegisterType((*MACCommand_BeaconFreqReq)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqReq")
+	proto.RegisterType((*MACCommand_BeaconFreqAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqAns")
+	golang_proto.RegisterType((*MACCommand_BeaconFreqAns)(nil), "ttn.lorawan.v3.MACCommand.BeaconFreqAns")
+	proto.RegisterType((*MACCommand_DeviceModeInd)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeInd")
+	golang_proto.RegisterType((*MACCommand_DeviceModeInd)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeInd")
+	proto.RegisterType((*MACCommand_DeviceModeConf)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeConf")
+	golang_proto.RegisterType((*MACCommand_DeviceModeConf)(nil), "ttn.lorawan.v3.MACCommand.DeviceModeConf")
+	proto.RegisterType((*MACCommand_RelayConfReq)(nil), "ttn.lorawan.v3.MACCommand.RelayConfReq")
+	golang_proto.RegisterType((*MACCommand_RelayConfReq)(nil), "ttn.lorawan.v3.MACCommand.RelayConfReq")
+	proto.RegisterType((*MACCommand_RelayConfReq_Configuration)(nil), "ttn.lorawan.v3.MACCommand.RelayConfReq.Configuration")
+	golang_proto.RegisterType((*MACCommand_RelayConfReq_Configuration)(nil), "ttn.lorawan.v3.MACCommand.RelayConfReq.Configuration")
+	proto.RegisterType((*MACCommand_RelayConfAns)(nil), "ttn.lorawan.v3.MACCommand.RelayConfAns")
+	golang_proto.RegisterType((*MACCommand_RelayConfAns)(nil), "ttn.lorawan.v3.MACCommand.RelayConfAns")
+	proto.RegisterType((*MACCommand_RelayEndDeviceConfReq)(nil), "ttn.lorawan.v3.MACCommand.RelayEndDeviceConfReq")
+	golang_proto.RegisterType((*MACCommand_RelayEndDeviceConfReq)(nil), "ttn.lorawan.v3.MACCommand.RelayEndDeviceConfReq")
+	proto.RegisterType((*MACCommand_RelayEndDeviceConfReq_Configuration)(nil), "ttn.lorawan.v3.MACCommand.RelayEndDeviceConfReq.Configuration")
+	golang_proto.RegisterType((*MACCommand_RelayEndDeviceConfReq_Configuration)(nil), "ttn.lorawan.v3.MACCommand.RelayEndDeviceConfReq.Configuration")
+	proto.RegisterType((*MACCommand_RelayEndDeviceConfAns)(nil), "ttn.lorawan.v3.MACCommand.RelayEndDeviceConfAns")
+	golang_proto.RegisterType((*MACCommand_RelayEndDeviceConfAns)(nil), "ttn.lorawan.v3.MACCommand.RelayEndDeviceConfAns")
+	proto.RegisterType((*MACCommand_RelayFilterListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayFilterListReq")
+	golang_proto.RegisterType((*MACCommand_RelayFilterListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayFilterListReq")
+	proto.RegisterType((*MACCommand_RelayFilterListAns)(nil), "ttn.lorawan.v3.MACCommand.RelayFilterListAns")
+	golang_proto.RegisterType((*MACCommand_RelayFilterListAns)(nil), "ttn.lorawan.v3.MACCommand.RelayFilterListAns")
+	proto.RegisterType((*MACCommand_RelayUpdateUplinkListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayUpdateUplinkListReq")
+	golang_proto.RegisterType((*MACCommand_RelayUpdateUplinkListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayUpdateUplinkListReq")
+	proto.RegisterType((*MACCommand_RelayCtrlUplinkListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayCtrlUplinkListReq")
+	golang_proto.RegisterType((*MACCommand_RelayCtrlUplinkListReq)(nil), "ttn.lorawan.v3.MACCommand.RelayCtrlUplinkListReq")
+	proto.RegisterType((*MACCommand_RelayCtrlUplinkListAns)(nil), "ttn.lorawan.v3.MACCommand.RelayCtrlUplinkListAns")
+	golang_proto.RegisterType((*MACCommand_RelayCtrlUplinkListAns)(nil), "ttn.lorawan.v3.MACCommand.RelayCtrlUplinkListAns")
+	proto.RegisterType((*MACCommand_RelayNotifyNewEndDeviceReq)(nil), "ttn.lorawan.v3.MACCommand.RelayNotifyNewEndDeviceReq")
+	golang_proto.RegisterType((*MACCommand_RelayNotifyNewEndDeviceReq)(nil), "ttn.lorawan.v3.MACCommand.RelayNotifyNewEndDeviceReq")
+	proto.RegisterType((*RelaySecondChannel)(nil), "ttn.lorawan.v3.RelaySecondChannel")
+	golang_proto.RegisterType((*RelaySecondChannel)(nil), "ttn.lorawan.v3.RelaySecondChannel")
+	proto.RegisterType((*RelayEndDeviceAlwaysMode)(nil), "ttn.lorawan.v3.RelayEndDeviceAlwaysMode")
+	golang_proto.RegisterType((*RelayEndDeviceAlwaysMode)(nil), "ttn.lorawan.v3.RelayEndDeviceAlwaysMode")
+	proto.RegisterType((*RelayEndDeviceDynamicMode)(nil), "ttn.lorawan.v3.RelayEndDeviceDynamicMode")
+	golang_proto.RegisterType((*RelayEndDeviceDynamicMode)(nil), "ttn.lorawan.v3.RelayEndDeviceDynamicMode")
+	proto.RegisterType((*RelayEndDeviceControlledMode)(nil), "ttn.lorawan.v3.RelayEndDeviceControlledMode")
+	golang_proto.RegisterType((*RelayEndDeviceControlledMode)(nil), "ttn.lorawan.v3.RelayEndDeviceControlledMode")
+	proto.RegisterType((*RelayForwardLimits)(nil), "ttn.lorawan.v3.RelayForwardLimits")
+	golang_proto.RegisterType((*RelayForwardLimits)(nil), "ttn.lorawan.v3.RelayForwardLimits")
+	proto.RegisterType((*RelayForwardDownlinkReq)(nil), "ttn.lorawan.v3.RelayForwardDownlinkReq")
+	golang_proto.RegisterType((*RelayForwardDownlinkReq)(nil), "ttn.lorawan.v3.RelayForwardDownlinkReq")
+	proto.RegisterType((*FrequencyValue)(nil), "ttn.lorawan.v3.FrequencyValue")
+	golang_proto.RegisterType((*FrequencyValue)(nil), "ttn.lorawan.v3.FrequencyValue")
+	proto.RegisterType((*DataRateOffsetValue)(nil), "ttn.lorawan.v3.DataRateOffsetValue")
+	golang_proto.RegisterType((*DataRateOffsetValue)(nil), "ttn.lorawan.v3.DataRateOffsetValue")
+	proto.RegisterType((*DataRateIndexValue)(nil), "ttn.lorawan.v3.DataRateIndexValue")
+	golang_proto.RegisterType((*DataRateIndexValue)(nil), "ttn.lorawan.v3.DataRateIndexValue")
+	proto.RegisterType((*PingSlotPeriodValue)(nil), "ttn.lorawan.v3.PingSlotPeriodValue")
+	golang_proto.RegisterType((*PingSlotPeriodValue)(nil), "ttn.lorawan.v3.PingSlotPeriodValue")
+	proto.RegisterType((*AggregatedDutyCycleValue)(nil), "ttn.lorawan.v3.AggregatedDutyCycleValue")
+	golang_proto.RegisterType((*AggregatedDutyCycleValue)(nil), "ttn.lorawan.v3.AggregatedDutyCycleValue")
+	proto.RegisterType((*RxDelayValue)(nil), "ttn.lorawan.v3.RxDelayValue")
+	golang_proto.RegisterType((*RxDelayValue)(nil), "ttn.lorawan.v3.RxDelayValue")
+	proto.RegisterType((*ADRAckLimitExponentValue)(nil), "ttn.lorawan.v3.ADRAckLimitExponentValue")
+	golang_proto.RegisterType((*ADRAckLimitExponentValue)(nil), "ttn.lorawan.v3.ADRAckLimitExponentValue")
+	proto.RegisterType((*ADRAckDelayExponentValue)(nil), "ttn.lorawan.v3.ADRAckDelayExponentValue")
+	golang_proto.RegisterType((*ADRAckDelayExponentValue)(nil), "ttn.lorawan.v3.ADRAckDelayExponentValue")
+	proto.RegisterType((*DeviceEIRPValue)(nil), "ttn.lorawan.v3.DeviceEIRPValue")
+	golang_proto.RegisterType((*DeviceEIRPValue)(nil), "ttn.lorawan.v3.DeviceEIRPValue")
+}
+
+func init() { proto.RegisterFile("lorawan-stack/api/lorawan.proto", fileDescriptor_2084d1d5a227b67e) }
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/lorawan.proto", fileDescriptor_2084d1d5a227b67e)
+}
+
+var fileDescriptor_2084d1d5a227b67e = []byte{
+	// 7428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7c, 0x4b, 0x6c, 0x23, 0x49,
+	0x9a, 0x9e, 0x92, 0x0f, 0x91, 0xfa, 0x49, 0x4a, 0xa1, 0x50, 0x3d, 0x28, 0x76, 0x95, 0xaa, 0x5a,
+	0xd5, 0xd3, 0x5d, 0xad, 0x9e, 0x52, 0x89, 0x94, 0x4a, 0xa5, 0x9a, 0xd9, 0x99, 0x6e, 0xbe, 0xd4,
+	0x62, 0x95, 0x44, 0x69, 0x92, 0x54, 0x55, 0xd7, 0xcc, 0x8e, 0xd3, 0x29, 0x32, 0xa9, 0x62, 0x8b,
+	0x4a, 0xb2, 0x93, 0xa9, 0x92, 0xd4, 0xf6, 0x61, 0xbd, 0x6b, 0xc0, 0x86, 0x8d, 0xf5, 0x0e, 0xe6,
+	0x64, 0x1f, 0xfc, 0x00, 0x6c, 0x63, 0xed, 0xb2, 0x0f, 0xc6, 0x2e, 0x60, 0xd8, 0x80, 0x61, 0x8c,
+	0x61, 0x18, 0xf6, 0xc1, 0x80, 0x7d, 0x31, 0x60, 0x2f, 0xe0, 0x59, 0xbb, 0xc7, 0x07, 0xa3, 0x6c,
+	0xf8, 0x7d, 0xf0, 0x36, 0xe0, 0x6d, 0xe3, 0x8f, 0x88, 0x64, 0x46, 0x66, 0x92, 0x7a, 0x74, 0xd7,
+	0x1c, 0xb6, 0x2f, 0xa5, 0xfc, 0xe2, 0x7f, 0xc5, 0x1f, 0x7f, 0xfc, 0x11, 0xf1, 0x47, 0xb0, 0xe1,
+	0x56, 0xa7, 0x6b, 0xe9, 0xc7, 0xba, 0x79, 0xaf, 0x6f, 0xeb, 0x8d, 0x83, 0xfb, 0x7a, 0xaf, 0x7d,
+	0x5f, 0x20, 0x8b, 0x3d, 0xab, 0x6b, 0x77, 0xe9, 0xa4, 0x6d, 0x9b, 0x8b, 0x0e, 0xf4, 0x72, 0x39,
+	0x93, 0xdf, 0x6f, 0xdb, 0x2f, 0x8e, 0xf6, 0x16, 0x1b, 0xdd, 0xc3, 0xfb, 0x86, 0xf9, 0xb2, 0x7b,
+	0xda, 0xb3, 0xba, 0x27, 0xa7, 0xf7, 0x19, 0x71, 0xe3, 0xde, 0xbe, 0x61, 0xde, 0x7b, 0xa9, 0x77,
+	0xda, 0x4d, 0xdd, 0x36, 0xee, 0x07, 0xfe, 0xe0, 0x22, 0x33, 0xf7, 0x24, 0x11, 0xfb, 0xdd, 0xfd,
+	0x2e, 0x67, 0xde, 0x3b, 0x6a, 0xb1, 0x2f, 0xf6, 0xc1, 0xfe, 0x12, 0xe4, 0x45, 0x89, 0xbc, 0xfe,
+	0xc2, 0xa8, 0xbf, 0x68, 0x9b, 0xfb, 0xfd, 0x8a, 0xd9, 0x3c, 0xea, 0xdb, 0x56, 0xdb, 0xe8, 0xcb,
+	0xaa, 0xf7, 0xbb, 0xf7, 0x3e, 0xed, 0x77, 0xcd, 0xfb, 0xba, 0x69, 0x76, 0x6d, 0xdd, 0x6e, 0x77,
+	0xcd, 0xbe, 0x10, 0x72, 0x63, 0xbf, 0xdb, 0xdd, 0xef, 0x18, 0xae, 0xaa, 0xbe, 0x6d, 0x1d, 0x35,
+	0x6c, 0xd1, 0x7a, 0xcb, 0xdf, 0x6a, 0xb7, 0x0f, 0x8d, 0xbe, 0xad, 0x1f, 0xf6, 0x04, 0xc1, 0x9d,
+	0xa0, 0x9b, 0xda, 0x4d, 0xc3, 0xb4, 0xdb, 0xad, 0xb6, 0x61, 0x09, 0x1d, 0xf3, 0xff, 0x34, 0x0c,
+	0xb1, 0x2d, 0xa3, 0xdf, 0xd7, 0xf7, 0x0d, 0xfa, 0x5d, 0x88, 0x1e, 0x6a, 0x2f, 0x9a, 0x56, 0x5a,
+	0xb9, 0xad, 0xdc, 0x4d, 0xe4, 0xae, 0x2c, 0x7a, 0xdd, 0xb8, 0xb8, 0xb5, 0x51, 0x52, 0x0b, 0xe4,
+	0xcb, 0x42, 0xf4, 0xcf, 0x29, 0x21, 0xa2, 0xfc, 0x8b, 0x9f, 0xdf, 0x1a, 0xfb, 0xd7, 0x3f, 0xbf,
+	0xa5, 0xa8, 0x91, 0xc3, 0x8d, 0xa6, 0x45, 0xdf, 0x82, 0xf0, 0x61, 0xbb, 0x91, 0x0e, 0xdd, 0x56,
+	0xee, 0x26, 0x0b, 0x13, 0x5f, 0x16, 0xc6, 0x3f, 0x8f, 0x90, 0xb1, 0x74, 0x44, 0x45, 0x94, 0x7e,
+	0x0f, 0x12, 0x87, 0x7a, 0x43, 0xeb, 0xe9, 0xa7, 0x9d, 0xae, 0xde, 0x4c, 0x87, 0x99, 0xfc, 0x4c,
+	0x40, 0x7e, 0xbe, 0xb8, 0xc3, 0x29, 0x36, 0xc6, 0x54, 0x38, 0xd4, 0x1b, 0xe2, 0x8b, 0x3e, 0x85,
+	0x2b, 0x9f, 0x76, 0xdb, 0xa6, 0x66, 0x19, 0x9f, 0x1d, 0x19, 0x7d, 0x7b, 0x20, 0x27, 0xc2, 0xe4,
+	0xcc, 0xfb, 0xe5, 0x3c, 0xee, 0xb6, 0x4d, 0x95, 0x93, 0xba, 0xf2, 0xe8, 0xa7, 0x01, 0x94, 0xd6,
+	0x60, 0x86, 0xc9, 0xd5, 0x1b, 0x0d, 0xa3, 0xe7, 0x8a, 0x8d, 0x32, 0xb1, 0x6f, 0x0f, 0x13, 0x9b,
+	0x67, 0x94, 0xae, 0xd4, 0xe9, 0x4f, 0xfd, 0x20, 0xfd, 0x55, 0xb8, 0x66, 0x19, 0x43, 0xcd, 0x1d,
+	0x67, 0x72, 0xdf, 0xf1, 0xcb, 0x55, 0x8d, 0x4f, 0x87, 0x19, 0x7c, 0xc5, 0x1a, 0x82, 0x17, 0x26,
+	0x21, 0xe6, 0x28, 0x0a, 0xff, 0xdf, 0x82, 0xf2, 0x38, 0x12, 0x8f, 0x91, 0xf8, 0xfc, 0x11, 0x44,
+	0x70, 0x70, 0xe8, 0x2a, 0x8c, 0x1f, 0x6a, 0xf6, 0x69, 0xcf, 0x60, 0x43, 0x38, 0x99, 0xbb, 0x1a,
+	0x70, 0x71, 0xfd, 0xb4, 0x67, 0x14, 0xe2, 0x5f, 0x16, 0xa2, 0xbf, 0x8e, 0x63, 0xa8, 0x46, 0x0f,
+	0x11, 0xa0, 0x0f, 0x20, 0x7a, 0xa8, 0x7f, 0xda, 0xb5, 0xd2, 0xa1, 0x11, 0x6c, 0xd8, 0xe8, 0x61,
+	0x43, 0x60, 0xfe, 0xbf, 0x28, 0x00, 0xee, 0xa0, 0x61, 0xfc, 0xb4, 0xce, 0x8a, 0x9f, 0xf5, 0x11,
+	0xf1, 0xd3, 0xc2, 0xf8, 0xb9, 0x05, 0xe3, 0x2d, 0xad, 0xd7, 0xb5, 0x6c, 0x66, 0x43, 0x8a, 0x29,
+	0x5b, 0x08, 0xa7, 0xbf, 0x52, 0xd4, 0x68, 0x6b, 0xa7, 0x6b, 0xd9, 0xf4, 0x16, 0x24, 0x5a, 0xd6,
+	0xa1, 0x27, 0x86, 0x92, 0x2a, 0xb4, 0xac, 0x43, 0x47, 0xfd, 0x47, 0x30, 0xd5, 0x34, 0x1a, 0xdd,
+	0xa6, 0xd1, 0xf4, 0x05, 0xc8, 0xf5, 0x45, 0x3e, 0x55, 0x16, 0x9d, 0xa9, 0xb2, 0x58, 0x63, 0x13,
+	0x49, 0x9d, 0x14, 0xf4, 0x8e, 0x84, 0x1b, 0x00, 0xad, 0xa3, 0x4e, 0x47, 0x6b, 0x69, 0x0d, 0xd3,
+	0x66, 0x61, 0x90, 0x52, 0xe3, 0x88, 0xac, 0x17, 0x4d, 0x7b, 0xfe, 0x0b, 0x05, 0x22, 0xd8, 0x05,
+	0xfa, 0x43, 0x88, 0x37, 0x8d, 0x97, 0x9a, 0xde, 0x14, 0x5d, 0x4d, 0x16, 0x3e, 0xc4, 0xce, 0xfc,
+	0xde, 0xcf, 0x6f, 0x3d, 0xdc, 0xef, 0x2e, 0xda, 0x2f, 0x0c, 0x9b, 0x4d, 0xf7, 0x45, 0xd3, 0xb0,
+	0x8f, 0xbb, 0xd6, 0xc1, 0x7d, 0xef, 0x3c, 0x7c, 0xb9, 0x7c, 0xbf, 0x77, 0xb0, 0x7f, 0x1f, 0x47,
+	0xa9, 0xbf, 0x58, 0x32, 0x5e, 0xe6, 0x9b, 0x4d, 0x4b, 0x8d, 0x35, 0xf9, 0x1f, 0xf4, 0xfb, 0xe8,
+	0x86, 0x86, 0x6d, 0x75, 0x98, 0x1b, 0x12, 0xc1, 0xa1, 0x58, 0x2f, 0xda, 0x56, 0x67, 0x88, 0x17,
+	0xa3, 0x2d, 0x6c, 0xa0, 0x73, 0x10, 0xe5, 0xd6, 0x87, 0x99, 0x17, 0x71, 0x22, 0x2e, 0x44, 0xd2,
+	0x5f, 0x7d, 0x15, 0x56, 0x23, 0xad, 0xa2, 0x69, 0xd3, 0x39, 0x94, 0xdf, 0xed, 0xd9, 0x7d, 0xe6,
+	0x9b, 0x64, 0x21, 0xf6, 0x65, 0x21, 0xf2, 0x79, 0x28, 0x3d, 0xa5, 0x46, 0x5b, 0xdb, 0x3d, 0xbb,
+	0x3f, 0xff, 0xa7, 0x14, 0x88, 0x32, 0x15, 0x94, 0x40, 0x58, 0x17, 0x1d, 0x8c, 0xab, 0xf8, 0x27,
+	0x9d, 0x83, 0x84, 0xde, 0xb4, 0x34, 0xbd, 0x71, 0x80, 0xa1, 0xcd, 0x0c, 0x8c, 0xab, 0x13, 0x7a,
+	0xd3, 0xca, 0x37, 0x0e, 0x54, 0xe3, 0x33, 0xc6, 0xd1, 0x38, 0x48, 0x87, 0x05, 0x47, 0xe3, 0x80,
+	0xbe, 0x05, 0x13, 0x2d, 0xad, 0x67, 0x98, 0xcd, 0xb6, 0xb9, 0xcf, 0x14, 0xc6, 0xd5, 0x78, 0x6b,
+	0x87, 0x7f, 0xd3, 0xeb, 0x10, 0x6b, 0x74, 0xf4, 0x7e, 0x5f, 0xdb, 0x63, 0xae, 0x8e, 0xab, 0xe3,
+	0xec, 0xb3, 0x30, 0xff, 0x37, 0x43, 0x40, 0x83, 0x73, 0x98, 0x7e, 0x02, 0x71, 0x36, 0xad, 0x8c,
+	0xa3, 0xb6, 0x70, 0xfb, 0xf7, 0x84, 0xdb, 0x1f, 0x5c, 0xd6, 0xed, 0xe5, 0xdd, 0xca, 0xea, 0x8a,
+	0x1a, 0x43, 0x71, 0xe5, 0xa3, 0x36, 0x7d, 0x0a, 0xe8, 0x7f, 0x26, 0x38, 0xf4, 0x26, 0x04, 0x8f,
+	0x37, 0x8d, 0x97, 0x28, 0xf7, 0xc7, 0x30, 0x81, 0x72, 0xcd, 0xae, 0xd9, 0x30, 0x78, 0xc0, 0x16,
+	0x3e, 0x12, 0x92, 0xd7, 0xbe, 0x46, 0xa4, 0x54, 0x51, 0x8e, 0x1a, 0x6f, 0x8a, 0xbf, 0xe6, 0xff,
+	0x7c, 0x18, 0xae, 0x0c, 0x4b, 0x1e, 0x74, 0x13, 0x12, 0x22, 0x05, 0x49, 0xb9, 0xe0, 0xed, 0x33,
+	0xf3, 0x8e, 0x2f, 0x2f, 0x00, 0xe7, 0x47, 0x94, 0xd6, 0x61, 0xdc, 0x34, 0x6c, 0xad, 0xdd, 0xfc,
+	0xa6, 0xce, 0xa9, 0x1a, 0x76, 0xa5, 0xa4, 0x46, 0x4d, 0xc3, 0xae, 0x78, 0x47, 0x33, 0xfc, 0xcb,
+	0x1a, 0xcd, 0xc8, 0x9b, 0x1c, 0xcd, 0x9b, 0x20, 0xbc, 0x22, 0x65, 0x87, 0x09, 0x8e, 0x60, 0x7a,
+	0xf8, 0x8d, 0x08, 0x4c, 0x07, 0x96, 0x08, 0x7a, 0x03, 0x26, 0x0c, 0xb3, 0x61, 0x9d, 0xf6, 0x6c,
+	0xa3, 0xc9, 0xa3, 0x56, 0x75, 0x01, 0xfa, 0xc7, 0x01, 0x98, 0x40, 0x1e, 0x21, 0xdc, 0xbd, 0x79,
+	0x61, 0xed, 0xa3, 0xcb, 0x5a, 0x8b, 0xca, 0x79, 0x88, 0x4c, 0x7c, 0xea, 0xfc, 0x29, 0x0d, 0x5e,
+	0xf8, 0x0d, 0x0e, 0x9e, 0x9c, 0x01, 0x23, 0x6f, 0x38, 0x03, 0x6e, 0x41, 0xa2, 0xd9, 0xd1, 0xfa,
+	0x86, 0x6d, 0xa3, 0x88, 0x74, 0x74, 0xf8, 0x5e, 0xa1, 0xb4, 0x59, 0x13, 0x14, 0x43, 0x72, 0x21,
+	0x34, 0x3b, 0x4e, 0x2b, 0xfd, 0x15, 0x88, 0x5b, 0x27, 0x5a, 0xd3, 0xe8, 0xe8, 0xa7, 0x6c, 0x01,
+	0x9e, 0xcc, 0x5d, 0x0f, 0x4c, 0x84, 0x93, 0x12, 0x36, 0x4b, 0xe1, 0x1f, 0xb3, 0x38, 0x44, 0xef,
+	0x43, 0xac, 0xd1, 0xd2, 0x3a, 0xed, 0xbe, 0x9d, 0x8e, 0x31, 0x43, 0xae, 0xf9, 0x99, 0x8b, 0xeb,
+	0x9b, 0xed, 0xbe, 0xad, 0x8e, 0x37, 0x5a, 0xf8, 0xef, 0xfc, 0xef, 0x2a, 0x00, 0xae, 0x6d, 0x74,
+	0x13, 0x52, 0xd6, 0x49, 0x56, 0x6b, 0x5a, 0x5a, 0xb7, 0xd5, 0xea, 0x1b, 0xb6, 0x98, 0x8b, 0x73,
+	0x81, 0xee, 0xe8, 0xb6, 0xae, 0xea, 0xb6, 0xb1, 0xcd, 0xa8, 0x24, 0x4b, 0x12, 0xd6, 0x49, 0xb6,
+	0x64, 0x71, 0x18, 0x17, 0x07, 0xeb, 0x24, 0xa7, 0x35, 0x9d, 0x75, 0xfa, 0xe6, 0x28, 0x31, 0x15,
+	0xb3, 0x69, 0x9c, 0xc8, 0xeb, 0xb5, 0x75, 0x92, 0x2b, 0x59, 0x98, 0x71, 0xbb, 0x3d, 0x5b, 0x33,
+	0x8d, 0x7d, 0x91, 0xa4, 0xc7, 0xbb, 0x3d, 0xbb, 0x6a, 0xec, 0xcf, 0x7f, 0x06, 0xe3, 0xbc, 0x1f,
+	0x74, 0x0d, 0x22, 0x52, 0xce, 0xc8, 0x0c, 0xef, 0xad, 0x2f, 0x59, 0x30, 0x0e, 0x4a, 0x21, 0xd2,
+	0xe2, 0xcb, 0x42, 0xf8, 0x6e, 0x4a, 0x65, 0x7f, 0xd3, 0x59, 0x88, 0x37, 0x5e, 0x68, 0x87, 0x7a,
+	0xff, 0xa0, 0x9f, 0x0e, 0xdf, 0x0e, 0xdf, 0x8d, 0xab, 0xb1, 0xc6, 0x8b, 0x2d, 0xfc, 0x9c, 0x7f,
+	0x06, 0xc9, 0xcd, 0xae, 0xaa, 0x3b, 0x16, 0xe3, 0x44, 0xd9, 0xd3, 0xcd, 0xe6, 0x71, 0xbb, 0x69,
+	0xbf, 0x60, 0xda, 0x53, 0xaa, 0x0b, 0xd0, 0xf7, 0x81, 0xf4, 0x7b, 0x96, 0xa1, 0xe3, 0xc2, 0xa1,
+	0xb5, 0xf4, 0x86, 0x2d, 0xf6, 0x2a, 0x29, 0x75, 0x6a, 0x80, 0xaf, 0x33, 0x78, 0xfe, 0x2e, 0x24,
+	0xd6, 0x6b, 0x4f, 0x06, 0x72, 0x67, 0x21, 0xbe, 0xd7, 0xb6, 0x35, 0x4b, 0xb7, 0x0d, 0x21, 0x36,
+	0xb6, 0xd7, 0xb6, 0xb1, 0x69, 0xfe, 0xa7, 0x0a, 0x4c, 0x6e, 0xaa, 0xeb, 0x1b, 0xb5, 0xda, 0x80,
+	0xfa, 0x3d, 0x98, 0x3a, 0xec, 0x36, 0x8f, 0x3a, 0x6c, 0x1f, 0xee, 0x66, 0xcf, 0x94, 0x3a, 0xe9,
+	0xc2, 0x2c, 0x29, 0xae, 0xc2, 0xf5, 0x6e, 0xcf, 0xb0, 0x74, 0x1c, 0x66, 0xad, 0xf1, 0x42, 0x37,
+	0x4d, 0xa3, 0xa3, 0x71, 0xe3, 0xb9, 0x5d, 0x57, 0x07, 0xcd, 0x45, 0xde, 0xfa, 0x8c, 0x75, 0xe4,
+	0x16, 0x24, 0x1a, 0x5d, 0xd6, 0x0b, 0x66, 0x11, 0x0e, 0xc3, 0x84, 0x0a, 0x1c, 0x62, 0x46, 0xfd,
+	0x23, 0x05, 0xe2, 0x03, 0x73, 0x72, 0x10, 0x41, 0xe7, 0x8b, 0x0d, 0xd5, 0x0d, 0xff, 0x68, 0xc8,
+	0x0e, 0xdc, 0x18, 0x53, 0x19, 0x2d, 0xbd, 0x0f, 0xe1, 0x56, 0xff, 0x40, 0x6c, 0x1f, 0xde, 0x0a,
+	0x6c, 0x1f, 0x5c, 0xd7, 0x6c, 0x8c, 0xa9, 0x48, 0x49, 0xd7, 0x60, 0xbc, 0x63, 0xb5, 0x5e, 0xf4,
+	0xfb, 0x62, 0x5f, 0x1e, 0x08, 0x4e, 0xaf, 0x8f, 0x36, 0xc6, 0x54, 0x41, 0x5f, 0x98, 0x06, 0x70,
+	0xdd, 0xc2, 0xf6, 0xa3, 0xf3, 0xff, 0x3c, 0x0c, 0x50, 0x3f, 0x19, 0xc4, 0x7f, 0x11, 0x26, 0x9a,
+	0xba, 0xad, 0xbb, 0xee, 0x4f, 0xe4, 0xd2, 0xa3, 0x82, 0xb6, 0x90, 0x94, 0x27, 0xb2, 0x1a, 0x6f,
+	0x3a, 0x5e, 0x38, 0xcf, 0x67, 0x18, 0x3b, 0x2d, 0xb6, 0xd9, 0x36, 0x1b, 0xa7, 0x2c, 0x1f, 0x45,
+	0x54, 0x17, 0xc0, 0xbc, 0x6d, 0x98, 0xfa, 0x5e, 0xc7, 0xd0, 0x1a, 0x56, 0x43, 0x6c, 0x35, 0x26,
+	0x38, 0x52, 0xb4, 0x1a, 0xc8, 0x3c, 0x38, 0x39, 0xb1, 0x0c, 0x91, 0x52, 0x5d, 0x80, 0xae, 0x40,
+	0x04, 0x3f, 0xc4, 0xec, 0xcf, 0x04, 0x76, 0x92, 0x75, 0x87, 0xb2, 0x10, 0xf9, 0xc9, 0xef, 0xe3,
+	0x66, 0x16, 0xa9, 0xe9, 0x87, 0x10, 0x6f, 0x76, 0x8f, 0xcd, 0x4e, 0xdb, 0x3c, 0x48, 0xc7, 0x19,
+	0xe7, 0x1d, 0x7f, 0xaf, 0x5d, 0x27, 0x2d, 0x96, 0x04, 0xa9, 0x3a, 0x60, 0xca, 0xfc, 0x09, 0x88,
+	0x3b, 0x28, 0xbd, 0x03, 0x29, 0xdd, 0xb4, 0x0d, 0xd3, 0xd4, 0xb5, 0x36, 0xce, 0x6b, 0x11, 0x91,
+	0x49, 0x01, 0xb2, 0xb9, 0x8e, 0x61, 0x6e, 0x9f, 0x68, 0xbd, 0xee, 0xb1, 0xc1, 0x27, 0x46, 0x48,
+	0x8d, 0xd9, 0x27, 0x3b, 0xf8, 0x49, 0xef, 0xc3, 0x4c, 0xdb, 0x7c, 0x69, 0x58, 0xb6, 0xd6, 0xeb,
+	0x76, 0x74, 0xab, 0xfd, 0x39, 0x1b, 0x2e, 0x91, 0x01, 0x28, 0x6f, 0xda, 0x91, 0x5a, 0x1e, 0x47,
+	0xe2, 0x21, 0x12, 0x9e, 0xff, 0x2d, 0x05, 0x66, 0x3f, 0xd6, 0x6d, 0xe3, 0x58, 0x3f, 0xcd, 0x0b,
+	0x4d, 0xee, 0xe9, 0x11, 0xb3, 0xf4, 0x3e, 0x6f, 0xd4, 0xda, 0xcd, 0x7e, 0x5a, 0x19, 0x7e, 0x12,
+	0x13, 0xfc, 0x12, 0x63, 0x21, 0xee, 0x0c, 0xb2, 0x0a, 0xfb, 0x4e, 0x6b, 0x3f, 0xd8, 0xc7, 0x50,
+	0xb0, 0x8f, 0xf3, 0xbf, 0x1d, 0x82, 0xc4, 0x6e, 0x0f, 0x7d, 0x52, 0xef, 0x1e, 0x18, 0x26, 0x2d,
+	0x43, 0xd8, 0xd5, 0xfd, 0xfe, 0x08, 0xdd, 0x41, 0xdb, 0x25, 0x13, 0x90, 0xdf, 0x1b, 0x00, 0x21,
+	0x7f, 0x00, 0xe4, 0x21, 0xd1, 0x37, 0xac, 0x97, 0x86, 0xa5, 0xb1, 0x38, 0x08, 0x5f, 0x30, 0x0e,
+	0x80, 0x33, 0x21, 0x4c, 0x3f, 0x80, 0xe9, 0x06, 0x2e, 0xc6, 0xa6, 0x6d, 0xe9, 0x76, 0x57, 0x08,
+	0xc2, 0x30, 0x0d, 0xab, 0x44, 0x6e, 0x60, 0xc4, 0x45, 0x48, 0x3a, 0x8e, 0x65, 0x74, 0xd1, 0x0b,
+	0x2a, 0x74, 0x86, 0x03, 0xf1, 0xf9, 0xdf, 0x50, 0x20, 0xe9, 0xc4, 0xcf, 0x8e, 0x6e, 0xbf, 0xa0,
+	0x77, 0x20, 0x79, 0xc4, 0x3c, 0xa7, 0xd9, 0xe8, 0x3a, 0xbe, 0x13, 0xd9, 0x18, 0x53, 0x13, 0x47,
+	0x92, 0x3f, 0xf3, 0x10, 0x6d, 0xb5, 0x4f, 0x8c, 0x66, 0x3a, 0x74, 0x49, 0x8f, 0x6e, 0x8c, 0xa9,
+	0x9c, 0xb3, 0x90, 0x80, 0x48, 0x0f, 0xf5, 0xb1, 0x5c, 0xf0, 0x93, 0x28, 0x4c, 0xd4, 0x4f, 0xc4,
+	0x06, 0x93, 0x7e, 0x00, 0x51, 0xb6, 0xbf, 0x1f, 0x75, 0x34, 0x2d, 0x62, 0xa3, 0xca, 0x69, 0x68,
+	0x11, 0x26, 0x9d, 0xb9, 0xa0, 0xa1, 0xc0, 0x3e, 0x5b, 0x56, 0x86, 0xa4, 0x40, 0xb9, 0x97, 0x6a,
+	0xaa, 0x29, 0x7d, 0xf5, 0xe9, 0xf7, 0x61, 0x82, 0x2d, 0xbe, 0x6c, 0xed, 0x0f, 0x5f, 0x74, 0xed,
+	0x8f, 0xe3, 0x8a, 0x8b, 0x18, 0xfd, 0x15, 0xb1, 0x78, 0x0f, 0x12, 0x58, 0xf2, 0xec, 0x04, 0xc6,
+	0x17, 0x6b, 0xf1, 0x41, 0xef, 0x70, 0x6e, 0x37, 0x31, 0x45, 0x59, 0x62, 0x4a, 0x5a, 0x27, 0xd9,
+	0x75, 0x07, 0xe3, 0x2a, 0x72, 0x92, 0x8a, 0xd4, 0xf9, 0x2a, 0x72, 0x5e, 0x15, 0x39, 0x49, 0x45,
+	0xcc, 0x51, 0x91, 0x73, 0x55, 0x6c, 0x40, 0xbc, 0x67, 0xb5, 0xbb, 0x56, 0xdb, 0x3e, 0x65, 0xb9,
+	0x68, 0x32, 0x38, 0x4d, 0xeb, 0x27, 0xb5, 0xc6, 0x0b, 0xa3, 0x79, 0xd4, 0x31, 0x76, 0x04, 0xa5,
+	0xec, 0x0f, 0x87, 0x9b, 0x96, 0x21, 0xa5, 0xef, 0xf5, 0xbb, 0x9d, 0x23, 0xdb, 0xe0, 0xb1, 0x39,
+	0x71, 0xc1, 0xd8, 0x4c, 0x3a, 0x6c, 0xd8, 0x40, 0x97, 0x61, 0x7a, 0x60, 0xb1, 0xd6, 0xeb, 0xe8,
+	0x26, 0xee, 0x4e, 0x01, 0x93, 0x3a, 0x3b, 0x8d, 0x5a, 0xa1, 0xf4, 0x47, 0xea, 0xd4, 0x80, 0x62,
+	0xa7, 0xa3, 0x9b, 0x95, 0x26, 0x5d, 0x86, 0xb8, 0xde, 0x7c, 0xa9, 0x9b, 0x0d, 0xa3, 0x99, 0x6e,
+	0x9c, 0x7d, 0xaa, 0x1f, 0x10, 0x3e, 0x8e, 0xc4, 0x23, 0x24, 0xfa, 0x38, 0x12, 0x1f, 0x27, 0xb1,
+	0xc7, 0x91, 0x78, 0x82, 0x24, 0xe7, 0xff, 0xc9, 0x0f, 0x58, 0xc5, 0xa2, 0xd8, 0x3d, 0x3c, 0xd4,
+	0xcd, 0x26, 0x2d, 0x40, 0xb8, 0xd1, 0x6e, 0x8a, 0x88, 0x7c, 0x67, 0x48, 0x3d, 0x4a, 0x10, 0xba,
+	0xb1, 0x5e, 0x80, 0x2f, 0x0b, 0xb1, 0x5f, 0x57, 0x22, 0x44, 0xb9, 0x3d, 0xa6, 0x22, 0x33, 0x7d,
+	0x1b, 0x12, 0x96, 0x7e, 0x3c, 0x28, 0x39, 0x84, 0xc4, 0xcc, 0x02, 0x4b, 0x3f, 0x76, 0x0e, 0x01,
+	0x05, 0x98, 0xb0, 0x8c, 0x3e, 0x6e, 0xc3, 0x4d, 0xa7, 0xf8, 0x75, 0x67, 0xb4, 0xb2, 0x45, 0x15,
+	0x69, 0x2b, 0x26, 0x16, 0x81, 0xe2, 0x96, 0xf8, 0x9b, 0x96, 0x01, 0xb8, 0x8c, 0x46, 0xd7, 0x6c,
+	0x89, 0xc2, 0xc6, 0x3b, 0xe7, 0x09, 0x29, 0x76, 0xcd, 0xd6, 0xc6, 0x98, 0x3a, 0x61, 0x39, 0x1f,
+	0x74, 0x1b, 0x26, 0xd9, 0xa4, 0x6a, 0xbc, 0x30, 0x1a, 0x07, 0x9a, 0x6e, 0x3a, 0x1b, 0xec, 0xf7,
+	0xce, 0x10, 0xb5, 0xd9, 0x36, 0x0f, 0x8a, 0x48, 0x9f, 0x37, 0x71, 0xaa, 0x27, 0x3b, 0xd2, 0x37,
+	0xad, 0x00, 0xfb, 0xd6, 0xb0, 0x32, 0x80, 0xdb, 0x3f, 0x5e, 0xe4, 0xfa, 0xd6, 0x39, 0xe2, 0xf2,
+	0x25, 0x55, 0x35, 0x3e, 0x43, 0x37, 0x21, 0x73, 0xbe, 0x69, 0x61, 0xfd, 0x40, 0x16, 0x85, 0x96,
+	0xc5, 0x2e, 0x2a, 0x8a, 0xdb, 0xe5, 0x88, 0x42, 0xab, 0xb6, 0x61, 0xb2, 0x79, 0x64, 0x9f, 0x6a,
+	0x8d, 0xd3, 0x46, 0xc7, 0x60, 0x76, 0xc5, 0xcf, 0xed, 0x66, 0xe9, 0xc8, 0x3e, 0x2d, 0x22, 0x3d,
+	0xb7, 0x2c, 0xd9, 0x94, 0xbe, 0xe9, 0x73, 0xa0, 0xd6, 0x89, 0xd6, 0xd3, 0x2d, 0xfd, 0x10, 0xcf,
+	0x26, 0x47, 0x3d, 0x26, 0x94, 0x4f, 0x80, 0x85, 0xb3, 0x86, 0xe1, 0x64, 0x07, 0x79, 0x6a, 0xc8,
+	0xc2, 0xe5, 0x4e, 0x59, 0x5e, 0x68, 0x88, 0x68, 0xec, 0x3c, 0x5c, 0x4a, 0x34, 0xf7, 0x80, 0x47,
+	0xb4, 0xe3, 0x06, 0xe3, 0xa5, 0xd6, 0xb7, 0x75, 0xfb, 0xa8, 0xcf, 0xc4, 0x26, 0xce, 0x77, 0x83,
+	0xf1, 0xb2, 0xc6, 0xe8, 0xc5, 0x68, 0x37, 0xa5, 0x6f, 0xaa, 0xc2, 0x94, 0x69, 0x1c, 0x0f, 0x36,
+	0xbc, 0xe8, 0x03, 0x9e, 0x14, 0xef, 0x9e, 0x21, 0xb1, 0x6a, 0x1c, 0x8b, 0x3d, 0x30, 0xf7, 0x40,
+	0xca, 0x94, 0x01, 0xbf, 0x4c, 0xb4, 0x32, 0x75, 0x09, 0x99, 0xdc, 0x4c, 0x49, 0xa6, 0xd3, 0xf1,
+	0x8e, 0xc7, 0xcc, 0xc9, 0xf3, 0x3b, 0xbe, 0xe9, 0xb1, 0x32, 0xd9, 0xec, 0x48, 0x46, 0x7a, 0x05,
+	0xa2, 0x8d, 0x53, 0x17, 0x17, 0xe8, 0x78, 0xb2, 0x23, 0x59, 0xf8, 0xab, 0x30, 0x63, 0x9d, 0x60,
+	0x1a, 0xc5, 0x7d, 0xad, 0x1b, 0x51, 0x84, 0x49, 0xfd, 0xe0, 0xcc, 0x61, 0xaf, 0x33, 0x26, 0x29,
+	0xa4, 0x88, 0xe5, 0xc3, 0x30, 0xa6, 0xec, 0x60, 0xb8, 0x4e, 0x9f, 0x1b, 0x53, 0xf5, 0x60, 0xb8,
+	0xda, 0x5e, 0x88, 0x27, 0xb3, 0x03, 0xe3, 0x94, 0x25, 0x33, 0x7a, 0x81, 0x64, 0x76, 0x60, 0x9c,
+	0x0e, 0x92, 0x19, 0xff, 0x9b, 0x27, 0x33, 0x94, 0xc1, 0x92, 0xd9, 0xcc, 0x05, 0x92, 0xd9, 0x81,
+	0x71, 0xea, 0x26, 0x33, 0xf1, 0x81, 0x3e, 0xc4, 0x5c, 0xe1, 0xef, 0xe6, 0x95, 0x73, 0x7d, 0x98,
+	0x2f, 0xa9, 0xfe, 0x7e, 0x12, 0xbd, 0x69, 0x79, 0x3b, 0xaa, 0x62, 0x3d, 0xf9, 0x65, 0xbb, 0xc1,
+	0x17, 0x3b, 0x36, 0xe6, 0x57, 0xcf, 0x8d, 0xcb, 0x12, 0xe3, 0xc0, 0x75, 0x4e, 0xc4, 0x65, 0x53,
+	0x06, 0xe8, 0x2e, 0x90, 0x56, 0xd7, 0x6a, 0x60, 0x4a, 0x72, 0xae, 0x08, 0xd2, 0xd7, 0x86, 0xef,
+	0xb6, 0x24, 0xa1, 0xeb, 0xc8, 0x32, 0x28, 0xd7, 0x6d, 0x8c, 0xa9, 0x93, 0x2d, 0x0f, 0x42, 0x8d,
+	0xc1, 0x9d, 0x83, 0xdf, 0x17, 0xd7, 0x99, 0xf0, 0xc5, 0x33, 0x7d, 0x8b, 0x8c, 0x7e, 0x77, 0xcc,
+	0x58, 0x41, 0x78, 0x84, 0x1a, 0x74, 0x4c, 0xfa, 0xd2, 0x6a, 0xb8, 0x7b, 0x02, 0x6a, 0xd0, 0x49,
+	0xcf, 0x81, 0xf6, 0xd8, 0xac, 0xe8, 0x74, 0x71, 0xc9, 0x6c, 0x75, 0x59, 0x4f, 0x66, 0xcf, 0x0d,
+	0xde, 0x1d, 0x9c, 0x01, 0x9d, 0xae, 0x5d, 0x31, 0x5b, 0x5d, 0x11, 0xbc, 0x3d, 0x2f, 0x44, 0xf7,
+	0xe0, 0xaa, 0x2b, 0x5a, 0x4e, 0x0f, 0x19, 0x26, 0xfd, 0xde, 0x05, 0xa4, 0x7b, 0x92, 0x04, 0xed,
+	0x05, 0xd0, 0xe1, 0x3a, 0xd0, 0x49, 0x6f, 0x5d, 0x56, 0x07, 0xf7, 0x91, 0x5f, 0x07, 0xba, 0xe8,
+	0x13, 0x98, 0xde, 0x33, 0xf4, 0x06, 0xd6, 0x28, 0x78, 0x06, 0x41, 0xf9, 0x37, 0xce, 0xf5, 0x50,
+	0x81, 0xf1, 0xf0, 0x5c, 0x21, 0x96, 0x8c, 0x3d, 0x2f, 0x84, 0x51, 0x2f, 0x24, 0xe3, 0x16, 0x8c,
+	0xf9, 0xe6, 0xe6, 0xb9, 0x51, 0xcf, 0xe5, 0xe2, 0x9e, 0x53, 0x64, 0xf8, 0x3d, 0x19, 0xf0, 0xcb,
+	0x44, 0x5b, 0xe7, 0x2e, 0x21, 0x53, 0xcc, 0xa4, 0x3d, 0x19, 0x90, 0x66, 0xe7, 0x61, 0xb7, 0x69,
+	0xb0, 0x64, 0x74, 0xeb, 0x82, 0xb3, 0x73, 0xab, 0xdb, 0x34, 0x78, 0x46, 0x4a, 0x35, 0x65, 0x00,
+	0x67, 0xa7, 0x2c, 0x93, 0x25, 0xa7, 0xdb, 0xe7, 0xce, 0x4e, 0x57, 0xa8, 0xc8, 0x50, 0x93, 0x4d,
+	0x0f, 0x82, 0x6b, 0x87, 0x85, 0x07, 0x0a, 0x26, 0x90, 0x79, 0xf4, 0xed, 0x73, 0xd7, 0x0e, 0x15,
+	0x19, 0x90, 0x5b, 0x2c, 0x46, 0x96, 0xf4, 0xed, 0x13, 0x88, 0xee, 0x9c, 0xbf, 0xb8, 0x40, 0xb1,
+	0x18, 0x59, 0xd2, 0x37, 0xed, 0xc0, 0x2c, 0x17, 0x68, 0x98, 0x4d, 0x4d, 0xb8, 0x60, 0x60, 0xec,
+	0x1d, 0x26, 0x7b, 0xe9, 0x3c, 0xd9, 0x65, 0xb3, 0xc9, 0x3d, 0xe1, 0x5a, 0x7d, 0xd5, 0x1a, 0xd6,
+	0x30, 0x5a, 0x1b, 0xf6, 0xe4, 0x9d, 0xaf, 0xa1, 0x8d, 0x77, 0x69, 0x88, 0x36, 0xec, 0xdb, 0x1e,
+	0xf0, 0x06, 0xad, 0xd5, 0xee, 0xd8, 0x86, 0xc5, 0x8a, 0xb9, 0xac, 0x5f, 0xdf, 0x3a, 0x77, 0x3a,
+	0x32, 0x4d, 0xeb, 0x8c, 0x8d, 0x15, 0x79, 0xf9, 0x94, 0xb7, 0x02, 0xe8, 0x70, 0x1d, 0xd8, 0x9b,
+	0x77, 0x2f, 0xab, 0x43, 0x4c, 0x79, 0x2b, 0x80, 0xd2, 0x23, 0xb8, 0xc1, 0x75, 0x1c, 0xf5, 0xf0,
+	0x5d, 0x82, 0x26, 0xce, 0xf3, 0x83, 0xee, 0xbc, 0xc7, 0x54, 0x2d, 0x9f, 0xa7, 0x6a, 0x97, 0x71,
+	0xf3, 0x32, 0x8a, 0xdb, 0xa9, 0xb4, 0x35, 0xa2, 0x8d, 0x76, 0x21, 0x23, 0x62, 0xcd, 0xb6, 0x3a,
+	0x01, 0xa5, 0x77, 0x99, 0xd2, 0xec, 0xb9, 0x71, 0x67, 0x5b, 0x1d, 0xbf, 0xca, 0x6b, 0xd6, 0xd0,
+	0x96, 0x33, 0x14, 0xa2, 0x43, 0xdf, 0xff, 0x3a, 0x0a, 0xb9, 0x53, 0x87, 0x29, 0x44, 0xc7, 0xfe,
+	0x49, 0xb8, 0xc5, 0x15, 0x9a, 0x5d, 0xbb, 0xdd, 0x3a, 0xd5, 0x70, 0x33, 0x2a, 0x45, 0x26, 0x76,
+	0x73, 0x81, 0x69, 0x7d, 0x70, 0x9e, 0xd6, 0x2a, 0x13, 0x50, 0x35, 0x8e, 0x07, 0x41, 0xc8, 0xbb,
+	0x9a, 0xb1, 0x46, 0xb6, 0x66, 0x54, 0x88, 0x3b, 0xe7, 0x3d, 0xba, 0x0e, 0xa9, 0xc3, 0xb6, 0xd9,
+	0xb5, 0xb4, 0x97, 0x86, 0xd5, 0xc7, 0x1a, 0xdd, 0xa8, 0x5b, 0x7c, 0x24, 0x72, 0x4f, 0xa2, 0x69,
+	0x45, 0x4d, 0x32, 0xbe, 0xa7, 0x9c, 0x2d, 0x53, 0x83, 0x89, 0xc1, 0xf1, 0xef, 0x8d, 0x09, 0xd5,
+	0x20, 0x29, 0x1f, 0x04, 0xe9, 0x6d, 0x18, 0x3f, 0xd4, 0xad, 0xfd, 0x36, 0x17, 0x38, 0xb8, 0xb0,
+	0xff, 0x43, 0x45, 0x15, 0x38, 0xbd, 0x07, 0x29, 0xa7, 0x94, 0xd5, 0xe8, 0x1e, 0x99, 0xc1, 0x9b,
+	0x7d, 0xa7, 0xd2, 0x55, 0xc4, 0xd6, 0xcc, 0x5f, 0x09, 0x01, 0xb8, 0x67, 0x43, 0xba, 0x0d, 0x53,
+	0x83, 0xb2, 0x88, 0x54, 0xf8, 0xbc, 0xc4, 0xad, 0x47, 0xaa, 0x29, 0x37, 0xd0, 0x7b, 0x30, 0xe9,
+	0x94, 0x48, 0xe5, 0x22, 0x23, 0x2b, 0x3a, 0x2c, 0xe0, 0x15, 0x78, 0x52, 0x54, 0x4c, 0x39, 0xf9,
+	0x07, 0x90, 0x74, 0x16, 0x6f, 0xbc, 0xc0, 0xe0, 0xf7, 0x17, 0x4c, 0xfa, 0x4f, 0x95, 0x10, 0x21,
+	0x6a, 0x42, 0xb4, 0xe2, 0x75, 0x06, 0x7d, 0x04, 0x57, 0x64, 0x62, 0x4c, 0x67, 0xb6, 0xd5, 0xed,
+	0xa4, 0xa3, 0xb2, 0x86, 0x98, 0x4a, 0x25, 0x9e, 0x22, 0x27, 0xa1, 0xf3, 0x10, 0x37, 0xf7, 0x34,
+	0xdb, 0xc2, 0xe8, 0x1e, 0xf7, 0x1a, 0x14, 0x33, 0xf7, 0xea, 0x88, 0xf3, 0x42, 0x46, 0xe6, 0xa7,
+	0xca, 0xc0, 0x41, 0x38, 0x00, 0x77, 0x81, 0x78, 0x74, 0xe2, 0xdd, 0x3b, 0xbf, 0xad, 0x9f, 0x94,
+	0xd4, 0xe4, 0x1b, 0x07, 0xf4, 0x1e, 0xcc, 0xf8, 0x5c, 0xc9, 0x88, 0xf9, 0x05, 0x3e, 0xf1, 0x78,
+	0x09, 0xc9, 0x3f, 0xe0, 0x87, 0x07, 0xd7, 0x51, 0x9a, 0x7b, 0xad, 0x3f, 0x25, 0xfb, 0x28, 0xdf,
+	0x38, 0xc8, 0x34, 0x20, 0x29, 0x1f, 0x9c, 0x69, 0x0d, 0x26, 0x0f, 0xf5, 0x13, 0xcd, 0x3d, 0x7d,
+	0x8b, 0x51, 0x0b, 0x9c, 0x11, 0xf2, 0xfb, 0xfb, 0x96, 0x81, 0x01, 0xd0, 0x1c, 0xf0, 0x4b, 0x63,
+	0x97, 0x3c, 0xd4, 0x4f, 0x06, 0x78, 0xe6, 0xff, 0x28, 0x30, 0xe5, 0x3b, 0x49, 0xd3, 0xa7, 0x30,
+	0xe3, 0x29, 0x9d, 0x7d, 0xbd, 0x18, 0x21, 0x52, 0x3d, 0x8d, 0x8f, 0xfb, 0x73, 0xb8, 0xe2, 0xa9,
+	0xfa, 0x39, 0x37, 0x77, 0xa1, 0x4b, 0xde, 0xdc, 0x4d, 0x4b, 0xc5, 0x40, 0xde, 0x48, 0x17, 0xfd,
+	0xf5, 0x3a, 0xf4, 0x69, 0x84, 0x3d, 0xd2, 0xc8, 0x45, 0xee, 0xfe, 0xb5, 0xdf, 0x1c, 0xf7, 0x96,
+	0xee, 0x32, 0x7f, 0xc7, 0xd7, 0x6d, 0x1c, 0xf5, 0x15, 0xb8, 0x3e, 0xa4, 0xdb, 0xd2, 0xe0, 0xcf,
+	0xf8, 0x7b, 0x84, 0x43, 0xba, 0x0a, 0xe9, 0x61, 0x9d, 0x92, 0xc2, 0xe0, 0x4a, 0xc0, 0x5c, 0xe4,
+	0x5b, 0x80, 0x69, 0x8f, 0xc5, 0x72, 0x24, 0xc8, 0xa6, 0x62, 0x24, 0xfc, 0x31, 0x48, 0xca, 0xb5,
+	0x03, 0x3a, 0x0f, 0xb1, 0x3d, 0xdd, 0xb6, 0x0d, 0xeb, 0xd4, 0x9b, 0x21, 0xbe, 0x52, 0x54, 0xa7,
+	0x81, 0x2e, 0x0c, 0x92, 0x08, 0x5a, 0x11, 0x2d, 0xd0, 0x2f, 0x0b, 0x53, 0x99, 0x54, 0xfa, 0xd6,
+	0xdd, 0xff, 0xf0, 0x95, 0xf8, 0x6f, 0x90, 0x4e, 0x32, 0x7f, 0x29, 0x04, 0x29, 0x4f, 0x29, 0x01,
+	0x13, 0x8c, 0x33, 0x03, 0xa4, 0x9b, 0x11, 0x39, 0xc1, 0x88, 0x66, 0x3e, 0xb2, 0xef, 0xcb, 0xd7,
+	0x44, 0x21, 0xe6, 0xfa, 0xc4, 0x97, 0x85, 0x78, 0x6e, 0x3c, 0x3d, 0xc6, 0x9c, 0xef, 0xb6, 0x62,
+	0x70, 0x1d, 0xb6, 0xcd, 0x40, 0x70, 0x85, 0x2f, 0x19, 0x5c, 0x87, 0x6d, 0xd3, 0xd3, 0xc6, 0xe4,
+	0xea, 0x27, 0x01, 0xb9, 0x91, 0xcb, 0xca, 0xd5, 0x4f, 0x3c, 0x6d, 0x99, 0x4f, 0x64, 0xd7, 0xa0,
+	0xf3, 0xef, 0x40, 0xca, 0x3b, 0x68, 0x3c, 0x38, 0x92, 0x2d, 0x69, 0xc4, 0xe8, 0x3c, 0xa4, 0x5c,
+	0x4b, 0xdc, 0x50, 0x48, 0x38, 0x19, 0x01, 0x47, 0xb5, 0x05, 0xc9, 0xd2, 0xe6, 0xd7, 0xf7, 0xf9,
+	0x7b, 0x41, 0x9f, 0x4b, 0xe1, 0xee, 0xb6, 0x65, 0x34, 0x49, 0x0f, 0x76, 0x60, 0x01, 0xa6, 0x3d,
+	0x7a, 0xa4, 0x4e, 0x4c, 0xc9, 0x1a, 0xb0, 0x1f, 0x81, 0xce, 0x86, 0x82, 0x9d, 0xcd, 0x3c, 0x01,
+	0xe2, 0x2f, 0x9d, 0xd0, 0x87, 0x10, 0xe5, 0xb7, 0x03, 0xca, 0x45, 0x6f, 0x07, 0x38, 0x7d, 0xe6,
+	0x1f, 0x2a, 0x30, 0xe5, 0xab, 0x95, 0xd0, 0xc7, 0x3c, 0xf3, 0x19, 0x6d, 0xab, 0xe7, 0xc9, 0x45,
+	0xc1, 0xb7, 0x0b, 0x6c, 0xf1, 0x2f, 0x57, 0xd4, 0x1d, 0x5f, 0xc2, 0x2b, 0xb7, 0xad, 0x1e, 0x77,
+	0xdb, 0x02, 0x4c, 0x8b, 0x9d, 0x4f, 0xf3, 0xd8, 0xe8, 0x74, 0x78, 0xb9, 0x9d, 0xf7, 0x6a, 0x8a,
+	0x37, 0x94, 0x10, 0x67, 0xf5, 0xf4, 0x45, 0x98, 0x19, 0xdc, 0x95, 0x48, 0xd4, 0x7c, 0x96, 0x4e,
+	0x3b, 0x4d, 0x03, 0xfa, 0xcc, 0x0e, 0xee, 0x38, 0x44, 0x21, 0xa6, 0x74, 0xa9, 0xcd, 0x81, 0x6c,
+	0xad, 0xbc, 0x35, 0xf8, 0x01, 0xee, 0x37, 0x9c, 0xa2, 0xcc, 0x9b, 0x11, 0xf9, 0x7b, 0x0a, 0x10,
+	0x7f, 0x95, 0x86, 0xee, 0xc1, 0x35, 0xe7, 0x01, 0x5a, 0xa7, 0x7d, 0xd8, 0xb6, 0x35, 0xe3, 0xa4,
+	0xd7, 0x35, 0x0d, 0xd3, 0x1e, 0xb9, 0xc6, 0x94, 0xd4, 0x7c, 0xe3, 0x60, 0x13, 0x69, 0xcb, 0x82,
+	0x54, 0xd2, 0x38, 0xc3, 0x9f, 0xae, 0x79, 0x9a, 0x65, 0x1d, 0x4d, 0x7e, 0x48, 0x71, 0x74, 0x84,
+	0xce, 0xd2, 0xc1, 0xe2, 0x64, 0xb4, 0x0e, 0x4f, 0x73, 0xe6, 0x07, 0x90, 0xf2, 0xd4, 0x89, 0xe8,
+	0x47, 0x17, 0xbe, 0x65, 0xc6, 0xc7, 0x2e, 0xbf, 0xa3, 0x84, 0xe2, 0xec, 0x8e, 0xdc, 0xbd, 0x71,
+	0xce, 0xfc, 0x4e, 0x08, 0x26, 0xbd, 0x65, 0xa2, 0x37, 0xfc, 0x0a, 0x6c, 0xc8, 0x76, 0x2c, 0xf4,
+	0x8d, 0xb6, 0x63, 0x77, 0xf1, 0x4d, 0xf0, 0x89, 0x66, 0x19, 0xec, 0x45, 0x74, 0x3a, 0x2c, 0x6f,
+	0x7d, 0x62, 0xf8, 0xfc, 0xf7, 0x44, 0xe5, 0x4d, 0xf4, 0x19, 0x4c, 0xf5, 0x0c, 0xab, 0xdd, 0x6d,
+	0xba, 0x63, 0x11, 0x19, 0x7e, 0x63, 0x23, 0x8a, 0x4c, 0x8c, 0x78, 0xc8, 0x60, 0x4c, 0xf6, 0x3c,
+	0x2d, 0x99, 0x7f, 0xa9, 0xc0, 0xcc, 0x90, 0xf2, 0x17, 0xfd, 0x11, 0x50, 0x34, 0x8d, 0x6d, 0x5a,
+	0xcf, 0x8d, 0x31, 0x2e, 0x80, 0x6d, 0x61, 0x87, 0xa8, 0xc4, 0x54, 0xed, 0x69, 0xc3, 0xd2, 0x0d,
+	0x0a, 0x67, 0x35, 0x45, 0x5f, 0x6c, 0xcd, 0x0f, 0x97, 0x8d, 0xa3, 0x3f, 0x44, 0xf4, 0xd4, 0xa1,
+	0x7e, 0x22, 0x37, 0x65, 0x36, 0x82, 0xbd, 0xc1, 0xe0, 0xca, 0xc2, 0xd5, 0x80, 0x42, 0x29, 0x9b,
+	0x52, 0x9f, 0x18, 0xcc, 0x95, 0x35, 0x98, 0xf2, 0x15, 0xd3, 0xe8, 0x47, 0x30, 0xce, 0xbd, 0x37,
+	0xea, 0x09, 0x93, 0xc3, 0xc0, 0xbd, 0x2f, 0xd9, 0x29, 0xf8, 0x32, 0x3f, 0x51, 0x80, 0x06, 0x8b,
+	0x68, 0xde, 0x55, 0x59, 0x39, 0x73, 0x55, 0x7e, 0xd3, 0x31, 0x98, 0x79, 0x11, 0xb0, 0xe8, 0xc2,
+	0x6b, 0xe7, 0xe5, 0xf6, 0xd4, 0x19, 0x1d, 0xa6, 0x7c, 0xc5, 0x37, 0x7a, 0x4b, 0x5e, 0x7c, 0x3c,
+	0x4f, 0x75, 0x39, 0x1e, 0x5c, 0x6a, 0x43, 0x67, 0x2d, 0xb5, 0x99, 0xef, 0x40, 0xca, 0x53, 0x87,
+	0xbb, 0x84, 0x67, 0x33, 0x2b, 0x32, 0xef, 0x45, 0x7d, 0x90, 0x59, 0x77, 0xf2, 0x98, 0x53, 0x40,
+	0x7b, 0x70, 0x91, 0x3b, 0x7e, 0x79, 0x35, 0x65, 0xd4, 0x99, 0x8f, 0x61, 0xd2, 0x5b, 0x44, 0xfb,
+	0xba, 0x82, 0xfe, 0x7d, 0x08, 0x92, 0x72, 0xe5, 0x8c, 0xfe, 0x08, 0x52, 0x58, 0x59, 0x6a, 0xef,
+	0x1f, 0x59, 0xfc, 0xd5, 0x8b, 0x72, 0xb1, 0x93, 0xbc, 0xe0, 0x5f, 0x2c, 0xca, 0xcc, 0xaa, 0x57,
+	0x56, 0xe6, 0xbf, 0x2b, 0x90, 0xf2, 0x10, 0xd0, 0x0a, 0x4c, 0xf6, 0x8d, 0x46, 0xd7, 0x6c, 0x3a,
+	0x75, 0xdf, 0x51, 0x0f, 0x63, 0x98, 0x92, 0x1a, 0x23, 0x75, 0xa6, 0x42, 0xaa, 0x2f, 0x7f, 0xd2,
+	0xef, 0xc2, 0xd5, 0xa6, 0xd1, 0xd2, 0x8f, 0x3a, 0xb6, 0x36, 0x2c, 0x08, 0x44, 0xa2, 0x0c, 0xab,
+	0x33, 0x82, 0xaa, 0x28, 0xef, 0xba, 0x9e, 0xc2, 0x54, 0x43, 0x6f, 0x6a, 0x7c, 0xe2, 0xb5, 0x1b,
+	0x78, 0xf5, 0x1f, 0x1e, 0x95, 0xbd, 0xb0, 0xb7, 0xf9, 0xd2, 0x8e, 0x4b, 0x2a, 0x27, 0xcc, 0x86,
+	0xde, 0x94, 0x5a, 0x32, 0xbf, 0x16, 0x96, 0xfc, 0x8b, 0x61, 0xf2, 0x21, 0xdc, 0xf0, 0x76, 0x58,
+	0x1b, 0x16, 0x35, 0xb3, 0x9e, 0xae, 0xc9, 0x87, 0x06, 0xfa, 0x11, 0xdc, 0xf4, 0x09, 0xc0, 0x95,
+	0x37, 0x70, 0x3a, 0xf1, 0x4a, 0xc8, 0x37, 0x0e, 0xdc, 0x23, 0x4a, 0x05, 0xe6, 0x7d, 0x12, 0x86,
+	0xcd, 0x4b, 0xbe, 0x1b, 0xba, 0xe9, 0x11, 0x13, 0x38, 0x25, 0x3d, 0x84, 0xb4, 0x4f, 0x94, 0x2b,
+	0x80, 0xbf, 0x5e, 0xbf, 0xea, 0x11, 0x30, 0x60, 0x7c, 0x04, 0xb3, 0x43, 0x07, 0x8b, 0x71, 0xf2,
+	0x17, 0x67, 0xd7, 0x86, 0x8c, 0x13, 0xb2, 0x2e, 0xc2, 0x8c, 0x6f, 0xa8, 0x18, 0xd3, 0x38, 0xdf,
+	0xbd, 0x79, 0xfd, 0x8f, 0x73, 0xee, 0x0f, 0xc2, 0x70, 0x75, 0x68, 0xbd, 0x95, 0x36, 0x87, 0xc7,
+	0xfa, 0xf7, 0x2f, 0x5b, 0xb8, 0x3d, 0x3b, 0xe8, 0xff, 0x20, 0xe4, 0x0f, 0xfa, 0x02, 0x8c, 0xeb,
+	0x9d, 0x63, 0xfd, 0xd4, 0x79, 0x89, 0x75, 0x77, 0x68, 0x8c, 0x0d, 0xb4, 0xe4, 0x19, 0x2d, 0xce,
+	0x74, 0x7c, 0x49, 0xc8, 0x39, 0x69, 0x19, 0x62, 0xcd, 0x53, 0x53, 0x77, 0x7e, 0x41, 0x34, 0xa4,
+	0xe0, 0xee, 0x15, 0x52, 0xe2, 0xc4, 0x42, 0x8a, 0xc3, 0x8b, 0x75, 0x58, 0x6f, 0x4d, 0x19, 0x2b,
+	0x2c, 0x1d, 0xc3, 0x79, 0x74, 0xf1, 0xed, 0xb3, 0x85, 0x16, 0x07, 0xf4, 0x42, 0xee, 0x8c, 0x11,
+	0x6c, 0xa2, 0x6f, 0xe3, 0xb1, 0xb6, 0x71, 0xd0, 0x6d, 0xf1, 0x57, 0x18, 0x83, 0xa9, 0xf8, 0xa1,
+	0xea, 0xe0, 0x43, 0xd2, 0x40, 0xf4, 0x6b, 0xa6, 0x01, 0x7c, 0x50, 0x85, 0x77, 0x11, 0xec, 0x41,
+	0x55, 0xe6, 0xff, 0x29, 0xc3, 0xc6, 0xfe, 0x8d, 0xcc, 0xc3, 0x8b, 0xcd, 0xa2, 0xd0, 0x37, 0x9d,
+	0x45, 0xe1, 0xb3, 0x66, 0xd1, 0x2d, 0x48, 0x08, 0x0f, 0x4a, 0x33, 0x0e, 0x04, 0x84, 0xb1, 0xff,
+	0x8f, 0x43, 0x40, 0x83, 0x35, 0x79, 0xfa, 0x2e, 0x80, 0x75, 0xd4, 0x31, 0x3c, 0xe7, 0xd1, 0x41,
+	0x0d, 0x6d, 0x02, 0x9b, 0x78, 0x56, 0xac, 0xc2, 0xb8, 0xde, 0x60, 0x33, 0x83, 0xef, 0x1a, 0x86,
+	0x87, 0x83, 0xf4, 0xcb, 0x13, 0xae, 0x26, 0xcf, 0x78, 0xe4, 0x0d, 0x0d, 0x97, 0xf2, 0x47, 0xef,
+	0x27, 0x0c, 0x99, 0x3f, 0xa3, 0x04, 0x1c, 0x88, 0xd1, 0x73, 0x13, 0x80, 0x77, 0x49, 0x8a, 0x95,
+	0x09, 0x8e, 0xe0, 0xb8, 0xbc, 0x03, 0x93, 0xae, 0x7f, 0xe5, 0xf3, 0xf5, 0xc0, 0xb5, 0x48, 0xf5,
+	0x6d, 0xa0, 0x8d, 0xee, 0xe1, 0x5e, 0xdb, 0x34, 0x9a, 0x1a, 0x36, 0xf4, 0xa5, 0x01, 0x27, 0x4e,
+	0x8b, 0x8a, 0x0d, 0x38, 0x94, 0xff, 0x3b, 0x04, 0xe9, 0x51, 0xf7, 0x11, 0x17, 0x1e, 0xd0, 0x1a,
+	0xe0, 0x45, 0xf8, 0xb1, 0x6e, 0x35, 0xf9, 0x79, 0xb0, 0x9f, 0x0e, 0x9d, 0x31, 0xcf, 0xd6, 0x39,
+	0x29, 0x3b, 0xee, 0xc9, 0x8f, 0x40, 0x53, 0x2d, 0xb9, 0xc1, 0xf3, 0xdb, 0x86, 0xf0, 0x1b, 0xfe,
+	0x6d, 0xc3, 0x35, 0x88, 0x1d, 0x8b, 0x5f, 0x97, 0xb1, 0xdc, 0xa1, 0x46, 0x8f, 0xf1, 0xa7, 0x65,
+	0xb4, 0x05, 0x93, 0x56, 0xb7, 0x6b, 0x6b, 0xc7, 0x5d, 0x4b, 0xeb, 0x6b, 0x07, 0x06, 0x7f, 0x2c,
+	0xf8, 0x0d, 0x7e, 0x0b, 0x92, 0x2f, 0xd7, 0xb2, 0xb9, 0xb5, 0x27, 0xc6, 0xa9, 0x9a, 0x40, 0xc1,
+	0xcf, 0xba, 0x56, 0xed, 0x89, 0x71, 0x9a, 0xf9, 0x4d, 0x05, 0xae, 0x0d, 0xbf, 0x90, 0xb9, 0xb0,
+	0xcf, 0x9f, 0xf8, 0x26, 0xd1, 0xf0, 0x44, 0xed, 0x95, 0x3f, 0x6a, 0x06, 0x65, 0x9e, 0x0e, 0x35,
+	0x07, 0x43, 0x32, 0x18, 0x73, 0xca, 0x90, 0x98, 0x93, 0xfc, 0x19, 0x92, 0xfc, 0x99, 0xf9, 0x57,
+	0x0a, 0x64, 0x46, 0xdf, 0xc8, 0xfc, 0x52, 0x7f, 0xc0, 0xf7, 0x0e, 0x84, 0xfb, 0xa6, 0xe5, 0x2d,
+	0x67, 0x26, 0xee, 0xfe, 0x57, 0xb7, 0x9c, 0x89, 0xcd, 0xf4, 0x3e, 0x44, 0xac, 0x7e, 0x9f, 0xa7,
+	0x8d, 0x68, 0xe1, 0xad, 0x2f, 0x0b, 0xe9, 0xcc, 0xb5, 0xf4, 0xff, 0x1c, 0x10, 0xdd, 0xfd, 0x5f,
+	0x7f, 0x38, 0xa0, 0x67, 0x84, 0x85, 0x09, 0x88, 0x89, 0x17, 0x86, 0xf3, 0xbf, 0xef, 0x4c, 0x62,
+	0xcf, 0xc2, 0x41, 0xb7, 0x01, 0xdc, 0xad, 0x93, 0xd8, 0x37, 0xbf, 0x7b, 0xe6, 0x82, 0x33, 0xd8,
+	0x46, 0x49, 0x23, 0x33, 0xa1, 0x3b, 0xe0, 0x9b, 0x3f, 0xf1, 0x7b, 0x6a, 0x81, 0xe1, 0xd1, 0xb5,
+	0xc0, 0xf9, 0x8c, 0xc8, 0x0d, 0x43, 0xf6, 0x0c, 0xf3, 0x9f, 0xc3, 0xec, 0xc8, 0xad, 0x00, 0xfd,
+	0x31, 0xd0, 0xfe, 0xa1, 0x6e, 0xd9, 0x9a, 0x78, 0xf0, 0xdf, 0x31, 0x5e, 0x8a, 0x3d, 0xf8, 0x64,
+	0xf0, 0x1d, 0x21, 0xf7, 0x05, 0x92, 0x97, 0x19, 0xf5, 0x26, 0x12, 0xcb, 0x47, 0xf7, 0xbe, 0xaf,
+	0x6d, 0x7e, 0x0e, 0x6e, 0x9c, 0xb5, 0x63, 0x98, 0xff, 0x2d, 0x67, 0x64, 0x3c, 0xa9, 0x86, 0x6e,
+	0x43, 0x62, 0xef, 0xa8, 0x71, 0x60, 0xd8, 0x5a, 0xbf, 0xfd, 0xb9, 0x31, 0xea, 0xb5, 0x29, 0x63,
+	0x64, 0x1c, 0x05, 0x46, 0x5c, 0x6b, 0x7f, 0xee, 0xa9, 0xc5, 0xec, 0x0d, 0x50, 0x2c, 0x9d, 0x58,
+	0x06, 0xc6, 0x02, 0x7f, 0x33, 0x1c, 0xf2, 0x6e, 0x43, 0x80, 0xb7, 0xb1, 0x5f, 0x93, 0x14, 0xe1,
+	0xba, 0x6c, 0xd0, 0xe0, 0x97, 0x06, 0xc6, 0x67, 0xf4, 0xae, 0xf7, 0xdd, 0xaa, 0x22, 0xfd, 0x1c,
+	0x94, 0x28, 0xf2, 0xf3, 0xd5, 0xf9, 0x47, 0x30, 0x39, 0xd8, 0x2b, 0x3c, 0xd5, 0x3b, 0x47, 0xf8,
+	0x8b, 0x8c, 0xe8, 0x4b, 0xfc, 0x23, 0xad, 0xf8, 0x47, 0x91, 0xe3, 0xdf, 0x19, 0x7f, 0xfd, 0x6a,
+	0x36, 0x94, 0x56, 0xe6, 0x7f, 0x0c, 0x33, 0xde, 0x4b, 0x05, 0xce, 0xff, 0x7d, 0x99, 0xff, 0x32,
+	0x97, 0x2a, 0x3e, 0xf1, 0x3f, 0x02, 0xea, 0x89, 0x3d, 0x2e, 0xfd, 0x7b, 0x5e, 0xe9, 0x17, 0xff,
+	0x95, 0x54, 0xc0, 0x76, 0x6f, 0x4d, 0xe3, 0x62, 0xb6, 0x8f, 0xac, 0x83, 0xf8, 0xc4, 0xef, 0x43,
+	0x7a, 0xc8, 0x15, 0x18, 0xd7, 0x51, 0xf4, 0xea, 0xb8, 0xe4, 0xdd, 0x99, 0x4f, 0xd1, 0x36, 0x24,
+	0x45, 0x1d, 0x9b, 0x0b, 0x7f, 0xe8, 0x15, 0x7e, 0x91, 0xa2, 0x77, 0xd0, 0xf2, 0x60, 0x61, 0xf5,
+	0x82, 0x96, 0x9f, 0x59, 0x91, 0x1d, 0xa5, 0xc8, 0x53, 0x3e, 0xbd, 0x8c, 0xa2, 0x51, 0x65, 0x59,
+	0x9f, 0xa2, 0x5d, 0x98, 0x72, 0x8b, 0xf2, 0x5c, 0xfe, 0x77, 0xbc, 0xf2, 0x2f, 0x56, 0xc4, 0xf7,
+	0x8a, 0x5d, 0xf8, 0x6d, 0x05, 0xa2, 0xec, 0x17, 0xf7, 0x94, 0x40, 0xf2, 0xf1, 0x76, 0xa5, 0xaa,
+	0xa9, 0xe5, 0x1f, 0xec, 0x96, 0x6b, 0x75, 0x32, 0x46, 0xa7, 0x20, 0xc1, 0x90, 0x7c, 0xb1, 0x58,
+	0xde, 0xa9, 0x13, 0x85, 0x52, 0x98, 0xdc, 0xad, 0x16, 0xb7, 0xab, 0xeb, 0x15, 0x75, 0xab, 0x5c,
+	0xd2, 0x76, 0x77, 0x48, 0x88, 0x5e, 0x01, 0x22, 0x63, 0xa5, 0xed, 0x67, 0x55, 0x12, 0x46, 0x61,
+	0x1e, 0xba, 0x08, 0xf2, 0xfa, 0xa8, 0xa2, 0x88, 0xa9, 0x65, 0x8f, 0xd2, 0x71, 0x54, 0xba, 0xa3,
+	0x6e, 0xef, 0xa8, 0x95, 0x72, 0x3d, 0xaf, 0x3e, 0x27, 0xb1, 0x8c, 0x63, 0xe9, 0x5d, 0x88, 0xb2,
+	0xdf, 0xf8, 0xd3, 0x49, 0x80, 0xcd, 0x6d, 0x35, 0xff, 0x2c, 0x5f, 0xd5, 0xd4, 0x2c, 0x19, 0xcb,
+	0x4c, 0xbd, 0x7e, 0x35, 0x9b, 0x48, 0x2b, 0x0b, 0x31, 0x81, 0x2e, 0xfc, 0x05, 0xfe, 0x9b, 0x7f,
+	0x51, 0xa7, 0x47, 0x89, 0x5b, 0xf9, 0xa2, 0xb6, 0x5b, 0x7d, 0x52, 0x45, 0xb5, 0x63, 0x34, 0x09,
+	0x71, 0x04, 0x9e, 0x66, 0xb5, 0x25, 0xa2, 0xa0, 0x38, 0xe7, 0x4b, 0xcb, 0x92, 0x90, 0xe7, 0x3b,
+	0x47, 0xc2, 0x12, 0x75, 0x96, 0x44, 0x3c, 0xad, 0xcb, 0x24, 0xea, 0xf9, 0x5e, 0x21, 0xe3, 0x99,
+	0x99, 0xd7, 0xaf, 0x66, 0x63, 0x69, 0x65, 0x21, 0xbc, 0x95, 0x2f, 0xfe, 0xd9, 0xbf, 0x3e, 0x37,
+	0xf6, 0xf7, 0xfe, 0xc6, 0xdc, 0xd8, 0xc2, 0x9f, 0x8e, 0x00, 0xec, 0x6c, 0x3c, 0x77, 0x0c, 0x9a,
+	0x83, 0xc4, 0xce, 0xc6, 0x73, 0xd7, 0xa0, 0x4c, 0xea, 0xf5, 0xab, 0xd9, 0x09, 0x88, 0x1d, 0x99,
+	0x07, 0x66, 0xf7, 0xd8, 0xa4, 0x0b, 0x00, 0xf5, 0xda, 0xd2, 0x52, 0x56, 0x58, 0x98, 0xc9, 0xbc,
+	0x7e, 0x35, 0x7b, 0x0d, 0xe2, 0xc8, 0x83, 0x08, 0x0d, 0x67, 0x17, 0x97, 0x68, 0x34, 0xbb, 0xb8,
+	0xb4, 0xb8, 0x44, 0xdf, 0x87, 0xa4, 0x4b, 0x8b, 0xf6, 0x67, 0xae, 0xbf, 0x7e, 0x35, 0x3b, 0xc3,
+	0xf4, 0x09, 0x8c, 0x93, 0x66, 0xe9, 0x1a, 0x24, 0xd5, 0x9d, 0x01, 0x69, 0x8e, 0x84, 0x33, 0xef,
+	0xbe, 0x7e, 0x35, 0x3b, 0x0f, 0x64, 0x40, 0x9a, 0xd3, 0xd4, 0xf2, 0x53, 0x2d, 0xcf, 0x19, 0x72,
+	0x34, 0xc6, 0xfe, 0xb9, 0xa7, 0xd3, 0x87, 0x40, 0x65, 0x4e, 0x46, 0x55, 0x20, 0x91, 0xcc, 0xad,
+	0xd7, 0xaf, 0x66, 0xdf, 0x0a, 0xf0, 0x17, 0x1c, 0xc6, 0x3d, 0xfa, 0x1d, 0x20, 0x03, 0xc6, 0x2c,
+	0x17, 0x4e, 0xa2, 0x99, 0x77, 0x5e, 0xbf, 0x9a, 0xbd, 0x0d, 0x93, 0x82, 0x2d, 0xeb, 0x2a, 0xcd,
+	0xde, 0xd3, 0x91, 0x37, 0xbb, 0xb8, 0x74, 0x4f, 0x1f, 0xc2, 0x5b, 0x20, 0xe3, 0x43, 0x79, 0x0b,
+	0x9c, 0x77, 0xcf, 0xe1, 0xdd, 0xf3, 0x19, 0xbc, 0x2c, 0x34, 0xc7, 0x02, 0x06, 0x8b, 0x16, 0x6e,
+	0xf0, 0xf2, 0x3d, 0x1d, 0xe3, 0x16, 0x19, 0x73, 0xbc, 0x79, 0x89, 0xc4, 0x7d, 0x48, 0x96, 0x4c,
+	0xf8, 0x90, 0x1c, 0x01, 0x1f, 0xb2, 0x4c, 0x12, 0x6e, 0x18, 0xec, 0x6c, 0x3c, 0x1f, 0x84, 0xc1,
+	0xcf, 0x42, 0x90, 0xf2, 0xde, 0xb5, 0x4e, 0x41, 0xa2, 0x94, 0xaf, 0xe7, 0x35, 0x35, 0x5f, 0x2f,
+	0x6b, 0x4b, 0x64, 0xcc, 0x0b, 0x64, 0x89, 0xe2, 0x05, 0x72, 0x24, 0xe4, 0x05, 0x96, 0x49, 0xd8,
+	0x0b, 0xac, 0x90, 0x88, 0x17, 0x78, 0x40, 0xa2, 0x5e, 0x60, 0x95, 0x8c, 0x7b, 0x81, 0x87, 0x24,
+	0xe6, 0x05, 0xd6, 0x48, 0xdc, 0x0b, 0x3c, 0xe2, 0x9d, 0x96, 0x0c, 0x5b, 0x22, 0xe0, 0x43, 0xb2,
+	0x24, 0xe1, 0x43, 0x72, 0x24, 0xe9, 0x43, 0x96, 0x49, 0xca, 0x87, 0xac, 0x90, 0x49, 0x1f, 0xf2,
+	0x80, 0x4c, 0x65, 0x66, 0x5f, 0xbf, 0x9a, 0x4d, 0x11, 0x65, 0x61, 0x62, 0x80, 0x0f, 0x5c, 0xf8,
+	0xdf, 0x14, 0x98, 0xf4, 0xbd, 0x58, 0xb8, 0x06, 0xd4, 0xe5, 0xdf, 0x5e, 0x5f, 0xaf, 0x95, 0xeb,
+	0xcc, 0x95, 0xc3, 0x70, 0xf4, 0xe8, 0x30, 0x1c, 0x1d, 0x3b, 0x0c, 0x47, 0xff, 0x0e, 0xc3, 0xd1,
+	0xcd, 0xc3, 0x70, 0xf4, 0xf6, 0x30, 0x1c, 0x9d, 0x3e, 0x0c, 0x7f, 0x48, 0x62, 0x99, 0x1b, 0xaf,
+	0x5f, 0xcd, 0x5e, 0x21, 0xca, 0x02, 0xf1, 0xb7, 0xb2, 0x0e, 0xff, 0x18, 0xa6, 0x1e, 0x7b, 0x2f,
+	0xbf, 0xa4, 0xac, 0x59, 0xdc, 0xae, 0xd6, 0xcb, 0x9f, 0x60, 0xaa, 0x76, 0xb1, 0x5a, 0xb9, 0x56,
+	0xab, 0x6c, 0x57, 0x79, 0xe8, 0x08, 0xec, 0x49, 0xf9, 0x79, 0x8d, 0x84, 0xe8, 0x04, 0x44, 0xf0,
+	0x93, 0x7c, 0xa5, 0x0c, 0x92, 0xea, 0x87, 0x30, 0x1d, 0xb8, 0x5d, 0xa3, 0x09, 0x88, 0xb9, 0x92,
+	0x13, 0x10, 0x73, 0x45, 0xc6, 0x21, 0xc2, 0x65, 0x0d, 0x04, 0xac, 0x01, 0xb8, 0x3f, 0xb8, 0x46,
+	0x95, 0xeb, 0x2c, 0x95, 0x57, 0x8b, 0x95, 0x72, 0x8d, 0x8c, 0xd1, 0x69, 0x48, 0x15, 0x37, 0xf2,
+	0xd5, 0x6a, 0x79, 0x53, 0xdb, 0xca, 0xd7, 0x9e, 0xd4, 0x88, 0xab, 0x3a, 0x0f, 0x51, 0x56, 0x22,
+	0x67, 0xea, 0x36, 0xf3, 0xb5, 0x9a, 0x96, 0x27, 0x63, 0xee, 0x47, 0x81, 0x28, 0xee, 0x47, 0x91,
+	0x84, 0x32, 0xd3, 0x98, 0x24, 0xd3, 0xca, 0x42, 0x94, 0x41, 0x18, 0x11, 0x0b, 0x27, 0x40, 0x83,
+	0xbf, 0x0b, 0xa3, 0x00, 0xe3, 0x9b, 0xdb, 0xcf, 0xf8, 0x12, 0x16, 0x83, 0xf0, 0xe6, 0xf6, 0x33,
+	0xa2, 0x60, 0x94, 0x15, 0xca, 0x9b, 0xdb, 0xcf, 0xb4, 0xea, 0xb6, 0xba, 0x95, 0xdf, 0x24, 0x21,
+	0x24, 0x13, 0x7f, 0xb3, 0xe5, 0x2a, 0x5f, 0xd8, 0x7e, 0x5a, 0x76, 0x5a, 0x23, 0xd8, 0xd3, 0x8d,
+	0xca, 0xc7, 0x1b, 0x24, 0x8a, 0x46, 0xe0, 0x5f, 0x6c, 0x75, 0x1a, 0x18, 0xff, 0xb3, 0x28, 0x5c,
+	0x19, 0xf6, 0xdb, 0x2b, 0x9a, 0x82, 0x89, 0x62, 0xa5, 0xa4, 0xa9, 0xeb, 0xbb, 0x2c, 0x08, 0x9d,
+	0xcf, 0x72, 0xad, 0x2c, 0x16, 0x50, 0xfc, 0xdc, 0xac, 0x54, 0x9f, 0x68, 0xc5, 0x8d, 0x72, 0xf1,
+	0x09, 0x09, 0xb1, 0xa5, 0xd2, 0xc1, 0xf2, 0x25, 0x95, 0x84, 0x1d, 0xaa, 0xd2, 0x6e, 0xfd, 0xb9,
+	0x56, 0x7c, 0x5e, 0xdc, 0x2c, 0xf3, 0x68, 0x63, 0x82, 0x3e, 0xd1, 0x76, 0xf2, 0x6a, 0x7e, 0x4b,
+	0xab, 0x95, 0xeb, 0xbb, 0x3b, 0x7c, 0x09, 0x65, 0xb4, 0xe5, 0xa7, 0x5a, 0xad, 0x9e, 0xaf, 0xef,
+	0xd6, 0xc8, 0x38, 0x9d, 0x81, 0x29, 0xc4, 0xaa, 0xe5, 0x67, 0x9a, 0x70, 0x3e, 0x89, 0xd1, 0xeb,
+	0x30, 0x23, 0x04, 0xd4, 0x2b, 0x5b, 0x95, 0xea, 0xc7, 0x42, 0x42, 0xdc, 0x91, 0x5c, 0xf7, 0x4a,
+	0x9e, 0x18, 0x48, 0xde, 0x1c, 0x08, 0x01, 0xb7, 0x3b, 0x4f, 0xca, 0xcf, 0x49, 0xc2, 0x91, 0x99,
+	0x2f, 0xa9, 0x1e, 0xde, 0xa4, 0x63, 0x41, 0xa9, 0xfc, 0xb4, 0x52, 0x2c, 0xa3, 0xc2, 0x32, 0x49,
+	0xe1, 0x4e, 0x01, 0xc1, 0xf5, 0x6d, 0xb5, 0x58, 0xd6, 0x78, 0x64, 0x92, 0x49, 0x9a, 0x81, 0x6b,
+	0x5c, 0x24, 0x7e, 0x7b, 0xc4, 0x4c, 0x39, 0xa6, 0xed, 0x30, 0x73, 0x37, 0xb7, 0xeb, 0x5a, 0xa5,
+	0xba, 0xbe, 0x4d, 0x08, 0x9d, 0x85, 0xab, 0x5e, 0xdc, 0xb1, 0x70, 0x9a, 0x5e, 0x85, 0x69, 0x6c,
+	0x2a, 0x94, 0xf3, 0xc5, 0xed, 0xaa, 0xe8, 0x2a, 0xa1, 0x8e, 0x41, 0x02, 0xc6, 0x18, 0x25, 0x33,
+	0x3e, 0x2b, 0xb7, 0xb6, 0x4b, 0x65, 0x72, 0xdb, 0xe9, 0xb6, 0x5a, 0xde, 0xcc, 0x3f, 0xc7, 0x09,
+	0xb6, 0x4e, 0x3e, 0xa2, 0x37, 0x61, 0xd6, 0xc5, 0xca, 0xd5, 0x01, 0x0b, 0x6b, 0xce, 0x3b, 0xe6,
+	0xf0, 0xe6, 0xf5, 0xca, 0x66, 0xbd, 0xac, 0x6a, 0x9b, 0x95, 0x5a, 0x9d, 0x14, 0xe8, 0x6d, 0xb8,
+	0xe1, 0x36, 0xed, 0xee, 0x94, 0x70, 0x76, 0xef, 0xee, 0xb0, 0xb1, 0x66, 0x14, 0x45, 0x3a, 0x07,
+	0x19, 0x49, 0x5f, 0x5d, 0xdd, 0xf4, 0xb4, 0x97, 0xe8, 0xdb, 0x70, 0xd3, 0x6b, 0x4f, 0xe5, 0xe3,
+	0x5d, 0xb5, 0xac, 0xad, 0x3f, 0xc3, 0x90, 0xd9, 0xaa, 0xd4, 0x49, 0x99, 0xde, 0x81, 0x5b, 0x2e,
+	0x49, 0x75, 0xbb, 0x5e, 0x59, 0x7f, 0xce, 0x06, 0xdf, 0xb5, 0x94, 0xac, 0x67, 0xa6, 0x9c, 0x15,
+	0xa9, 0x58, 0x29, 0xb1, 0xc9, 0xf3, 0x3a, 0x04, 0x33, 0x43, 0x36, 0xe9, 0x2c, 0x1f, 0x0f, 0x22,
+	0x4f, 0xcb, 0x92, 0x31, 0x1f, 0x92, 0x23, 0x8a, 0x0f, 0x59, 0x21, 0x21, 0x1f, 0xb2, 0x46, 0xc2,
+	0x38, 0xf5, 0x65, 0x39, 0xab, 0x24, 0xe2, 0x83, 0x96, 0x73, 0x24, 0xea, 0x83, 0x56, 0x57, 0xc8,
+	0x38, 0x8e, 0x80, 0xcc, 0x98, 0x5b, 0x23, 0x31, 0x1f, 0x96, 0x7b, 0xb0, 0x4a, 0xe2, 0x3e, 0xec,
+	0x41, 0x36, 0x47, 0x26, 0x70, 0x48, 0x65, 0xde, 0xa5, 0xdc, 0x0a, 0x01, 0x1f, 0x98, 0x5b, 0x5a,
+	0x59, 0x23, 0x09, 0x1f, 0xb8, 0xb2, 0xf4, 0x68, 0x95, 0x24, 0x7d, 0xe0, 0x5a, 0xf6, 0x51, 0x8e,
+	0xc7, 0xad, 0xa7, 0x23, 0xcb, 0x6b, 0xb8, 0x6c, 0x79, 0xd1, 0xe5, 0xdc, 0xc3, 0xd5, 0x35, 0x32,
+	0x95, 0xb9, 0xf6, 0xfa, 0xd5, 0xec, 0x64, 0x5a, 0x59, 0x00, 0xb7, 0x8d, 0x39, 0xfb, 0x9f, 0x29,
+	0x30, 0xe9, 0x3d, 0x75, 0x61, 0xcf, 0x59, 0x00, 0x97, 0x9f, 0x96, 0xd5, 0xe7, 0x5a, 0x56, 0x64,
+	0x4b, 0x09, 0xca, 0xd5, 0x88, 0xe2, 0x83, 0x56, 0x30, 0x8d, 0x7b, 0xa1, 0xb5, 0x1a, 0xcf, 0x18,
+	0xb2, 0xac, 0xd5, 0x1a, 0x89, 0xf8, 0xb0, 0xe5, 0x5c, 0x8d, 0x44, 0x7d, 0xd8, 0xea, 0x8a, 0xc8,
+	0x16, 0x32, 0x6f, 0x6e, 0xad, 0x46, 0x62, 0x6e, 0x3f, 0xdc, 0x26, 0xd6, 0x8f, 0xbf, 0x1a, 0x76,
+	0x2e, 0xf0, 0xbd, 0x2f, 0x06, 0x66, 0x60, 0x6a, 0xb0, 0x26, 0xed, 0x56, 0xeb, 0x38, 0xdc, 0x63,
+	0x01, 0x70, 0x19, 0x43, 0xc7, 0x0f, 0xae, 0xae, 0xf0, 0x43, 0x84, 0x97, 0x3d, 0x87, 0x11, 0xe4,
+	0x47, 0x71, 0xd8, 0x23, 0x01, 0x14, 0x07, 0x3e, 0x8a, 0xf3, 0xde, 0x2b, 0x01, 0x87, 0x7e, 0x3c,
+	0x00, 0xb3, 0xc1, 0x8f, 0x05, 0x60, 0x36, 0xfc, 0xf1, 0x00, 0xcc, 0x02, 0x60, 0x02, 0xd3, 0x90,
+	0xaf, 0x73, 0x18, 0x02, 0x10, 0xc0, 0x79, 0x10, 0x24, 0x02, 0xf8, 0xea, 0x83, 0x07, 0xcb, 0x18,
+	0x5d, 0xd7, 0x61, 0xc6, 0x2b, 0x67, 0x39, 0xbb, 0xf4, 0x10, 0x23, 0xcc, 0xdf, 0x90, 0x5b, 0xcd,
+	0x65, 0x57, 0x30, 0xc8, 0xfc, 0x0d, 0x0f, 0x72, 0x2b, 0xb9, 0x35, 0x8c, 0xb3, 0xf4, 0xeb, 0x57,
+	0xb3, 0x24, 0xad, 0x2c, 0x24, 0xe5, 0x66, 0x36, 0x42, 0xbf, 0x60, 0x17, 0x10, 0xfe, 0x37, 0x19,
+	0x18, 0x34, 0x82, 0x14, 0xb3, 0x31, 0x5b, 0x9b, 0x7c, 0x50, 0x96, 0x28, 0x7e, 0x28, 0x47, 0x42,
+	0x7e, 0x68, 0x99, 0x84, 0xfd, 0xd0, 0x0a, 0x89, 0xf8, 0xa1, 0x07, 0x24, 0xea, 0x87, 0x70, 0x0b,
+	0xe4, 0x83, 0x70, 0xe7, 0xe9, 0x83, 0x70, 0xef, 0xe9, 0x83, 0x1e, 0xf1, 0xb5, 0xc8, 0x63, 0x2a,
+	0xee, 0x3f, 0xfd, 0x18, 0xee, 0x40, 0xfd, 0x18, 0xee, 0x41, 0xfd, 0x18, 0xee, 0x42, 0xfd, 0x18,
+	0xfa, 0xda, 0x8f, 0xe1, 0x4e, 0x14, 0xcf, 0x52, 0x53, 0x69, 0x65, 0x21, 0x21, 0xb5, 0x30, 0x2f,
+	0xff, 0x3b, 0xc5, 0xf9, 0xff, 0x5a, 0x79, 0x5f, 0xf2, 0x48, 0xe1, 0xbd, 0x53, 0x56, 0x2b, 0xdb,
+	0x25, 0xe6, 0xe9, 0x00, 0x98, 0x25, 0x4a, 0x10, 0x44, 0x6f, 0x07, 0x40, 0xf4, 0x77, 0x00, 0x44,
+	0x8f, 0x07, 0x40, 0xf4, 0x79, 0x00, 0x5c, 0x25, 0xe3, 0x41, 0x10, 0x77, 0x9d, 0xb8, 0xc7, 0x9e,
+	0x4e, 0x2b, 0x0b, 0x29, 0x4f, 0x13, 0xeb, 0xdb, 0x7f, 0x0a, 0x01, 0xb8, 0xa5, 0x03, 0x96, 0xa1,
+	0xf9, 0x72, 0x87, 0x9f, 0xda, 0x1a, 0xdf, 0x6d, 0xca, 0x50, 0x76, 0x89, 0x28, 0x01, 0x0c, 0x7b,
+	0xe3, 0xc7, 0x96, 0x49, 0x38, 0x80, 0xad, 0x90, 0x48, 0x00, 0x5b, 0x25, 0xd1, 0x00, 0xb6, 0x46,
+	0xc6, 0xfd, 0x58, 0x6e, 0x89, 0xc4, 0x02, 0x58, 0x96, 0xc4, 0x03, 0xd8, 0x0a, 0x99, 0x08, 0x60,
+	0xab, 0x04, 0x02, 0xd8, 0x43, 0x92, 0x08, 0x60, 0x8f, 0x48, 0xd2, 0x8f, 0x2d, 0x2f, 0x91, 0x54,
+	0x00, 0x5b, 0x26, 0x93, 0x01, 0x6c, 0x55, 0x0e, 0x21, 0xa9, 0x85, 0xb9, 0xf9, 0x2f, 0x87, 0x61,
+	0x66, 0x48, 0xa9, 0x09, 0x87, 0x0b, 0x37, 0x54, 0xf9, 0xe2, 0x13, 0xbe, 0xc0, 0xb3, 0x25, 0x38,
+	0x00, 0x8a, 0x54, 0xea, 0x05, 0x57, 0x48, 0x28, 0x08, 0x8a, 0x4c, 0xea, 0x93, 0x29, 0x32, 0xa9,
+	0x17, 0x65, 0x2b, 0x72, 0x00, 0x5d, 0x15, 0x89, 0xd4, 0x27, 0x21, 0x27, 0x12, 0xa9, 0xcf, 0xae,
+	0x07, 0x22, 0x91, 0x7a, 0x61, 0xbe, 0x3a, 0x5f, 0x03, 0xea, 0x13, 0xc2, 0x17, 0xe8, 0x00, 0x2e,
+	0xd6, 0xe8, 0x00, 0x2e, 0x96, 0xe9, 0x00, 0x2e, 0x56, 0xea, 0xeb, 0x30, 0xe3, 0xc5, 0x9d, 0xc5,
+	0x3a, 0xd0, 0xe0, 0xac, 0xd7, 0x83, 0x69, 0xe0, 0x69, 0xf6, 0x8d, 0x8f, 0xa7, 0x42, 0x27, 0x3b,
+	0xb8, 0xc4, 0x76, 0x5c, 0xbe, 0xf1, 0xe1, 0xa0, 0x6f, 0x7c, 0x38, 0xe8, 0x1b, 0x1f, 0x0e, 0xfa,
+	0xc6, 0x47, 0xc8, 0xf4, 0x8d, 0x0f, 0x47, 0xfd, 0xe3, 0xc3, 0x51, 0xff, 0xf8, 0x08, 0x09, 0xfe,
+	0xf1, 0x11, 0x76, 0xf9, 0xc7, 0x87, 0xc3, 0x81, 0xf1, 0x11, 0x42, 0x02, 0xe3, 0x23, 0xa4, 0x04,
+	0xc6, 0x47, 0x74, 0x30, 0x30, 0x3e, 0xa2, 0x8f, 0x81, 0xf1, 0x71, 0xba, 0x19, 0x18, 0x1f, 0xa7,
+	0xa7, 0x23, 0xc6, 0x87, 0x35, 0xb3, 0xf1, 0xf9, 0xdb, 0x21, 0x88, 0x89, 0x3a, 0x30, 0x56, 0xe1,
+	0xd4, 0x4f, 0x04, 0x2b, 0x26, 0x5c, 0xf9, 0x3b, 0x4b, 0x14, 0xcf, 0x77, 0x8e, 0x84, 0x3c, 0xdf,
+	0x98, 0x94, 0xe4, 0xef, 0x15, 0x12, 0xf1, 0x7c, 0x3f, 0x20, 0x51, 0xcf, 0x37, 0xa6, 0x54, 0xf9,
+	0x1b, 0x57, 0x31, 0xf9, 0x1b, 0x97, 0x30, 0xf9, 0x1b, 0xd7, 0x2f, 0x3c, 0x8a, 0x0f, 0xec, 0xc1,
+	0xc5, 0xcb, 0x03, 0xe0, 0xca, 0xe5, 0x01, 0x70, 0xd9, 0xf2, 0x00, 0xb8, 0x66, 0x79, 0x00, 0x74,
+	0x9a, 0x07, 0x78, 0x20, 0x36, 0x05, 0x49, 0xa2, 0x2c, 0xc4, 0xd5, 0x4f, 0x5c, 0x3f, 0xb1, 0x2a,
+	0xc2, 0xdf, 0x0d, 0x41, 0x94, 0x3d, 0x66, 0x46, 0xa6, 0xad, 0x4a, 0x75, 0x5b, 0x1d, 0x9c, 0x50,
+	0x13, 0x10, 0xe3, 0x80, 0xa8, 0x36, 0xb9, 0xad, 0xa2, 0xda, 0xe4, 0x02, 0xa2, 0xda, 0xe4, 0x02,
+	0xa2, 0xda, 0xe4, 0x02, 0xa2, 0xda, 0xe4, 0x02, 0xa2, 0xda, 0xe4, 0x02, 0xa2, 0xda, 0xe4, 0x02,
+	0xa2, 0xda, 0xe4, 0x02, 0xa2, 0xda, 0xe4, 0x02, 0x4e, 0xb5, 0x49, 0x42, 0x44, 0xb5, 0x49, 0x42,
+	0x44, 0xb5, 0x49, 0x42, 0x44, 0xb5, 0x49, 0x42, 0x44, 0xb5, 0x49, 0x42, 0xd0, 0x6b, 0x83, 0xba,
+	0x02, 0xc3, 0x59, 0x68, 0xfd, 0xfd, 0x10, 0xcc, 0xf0, 0x4b, 0x5f, 0xcf, 0xab, 0x33, 0x3a, 0x0f,
+	0x73, 0xe2, 0x1c, 0x96, 0x2f, 0x89, 0x15, 0xb3, 0x52, 0xac, 0xd4, 0x9f, 0x6b, 0x59, 0xad, 0x56,
+	0x2e, 0x6e, 0x57, 0x4b, 0x64, 0x8c, 0x2e, 0xc0, 0xbb, 0xc3, 0x69, 0x1e, 0x2c, 0x2d, 0x69, 0x5b,
+	0x95, 0xcd, 0xcd, 0x0a, 0x27, 0xc5, 0xcd, 0xfd, 0x48, 0xda, 0xdc, 0x03, 0x1f, 0x6d, 0x68, 0x34,
+	0x6d, 0xd6, 0x2f, 0x37, 0x4c, 0xdf, 0x87, 0x6f, 0x8d, 0xb2, 0xc1, 0x4b, 0x1a, 0x19, 0x4d, 0x9a,
+	0xf3, 0x91, 0x46, 0x33, 0x6f, 0xbf, 0x7e, 0x35, 0x3b, 0x9b, 0x56, 0x16, 0xae, 0x0e, 0x65, 0x60,
+	0x8e, 0xfb, 0x8b, 0x21, 0xb8, 0x36, 0xfc, 0xfe, 0x16, 0x4f, 0xc2, 0x9c, 0x8f, 0x0b, 0xd4, 0x8a,
+	0x1b, 0x6c, 0x3e, 0x4b, 0x05, 0xbb, 0x81, 0x77, 0x87, 0x52, 0xe4, 0x96, 0x70, 0x97, 0x71, 0x36,
+	0xcd, 0xca, 0xd2, 0x12, 0x09, 0x9d, 0x43, 0xb3, 0xb6, 0xb4, 0x44, 0xc2, 0x78, 0x64, 0x3e, 0x83,
+	0x26, 0xbb, 0xba, 0xb4, 0x44, 0x22, 0xe7, 0x10, 0x2d, 0xa3, 0x45, 0x51, 0x56, 0x43, 0x9f, 0x4b,
+	0x2b, 0x0b, 0x99, 0xd1, 0xa4, 0xcc, 0x35, 0x3f, 0x73, 0x1e, 0x46, 0xf9, 0xaf, 0x73, 0xb1, 0x02,
+	0x20, 0x78, 0xb7, 0xf2, 0x6a, 0x5d, 0x2b, 0x57, 0xf3, 0x85, 0xcd, 0xb2, 0xb6, 0x59, 0x7e, 0x5a,
+	0xde, 0x64, 0xbb, 0xad, 0x5b, 0xf0, 0xd6, 0xc8, 0xf6, 0xec, 0x2a, 0x51, 0xce, 0x24, 0x58, 0xc6,
+	0x59, 0x7c, 0x16, 0xc1, 0xea, 0x0a, 0x09, 0xb3, 0xaa, 0xfa, 0x8d, 0xb4, 0xb2, 0x90, 0x1e, 0x45,
+	0xc6, 0xba, 0xf0, 0x0f, 0xd8, 0xa6, 0x37, 0x78, 0x05, 0x8c, 0xf5, 0x11, 0xce, 0xc8, 0x17, 0xd7,
+	0xc2, 0x6e, 0xf1, 0x49, 0xb9, 0xae, 0xd5, 0x2a, 0x3f, 0xe4, 0xf5, 0x83, 0x33, 0x9a, 0x71, 0x99,
+	0x3c, 0xa3, 0x19, 0x17, 0xcc, 0x81, 0x7b, 0x86, 0x08, 0xc7, 0x4b, 0x8c, 0x3b, 0x58, 0xd3, 0x4f,
+	0x2b, 0x0b, 0xd7, 0x47, 0x50, 0x31, 0xd3, 0xff, 0x8d, 0x22, 0xee, 0xc5, 0x47, 0x3c, 0x9d, 0xa2,
+	0xdf, 0x86, 0xbb, 0x9c, 0x5f, 0xbe, 0x8e, 0x72, 0x8a, 0x39, 0xf9, 0x62, 0xbd, 0xb2, 0x5d, 0xd5,
+	0xaa, 0xdb, 0x9a, 0xba, 0xbb, 0x59, 0x26, 0x63, 0x17, 0xa2, 0x5e, 0xdf, 0x56, 0x9f, 0xe5, 0xd5,
+	0x12, 0x51, 0xe8, 0x07, 0xf0, 0xde, 0xf9, 0xd4, 0xec, 0x8b, 0x84, 0x32, 0xdf, 0x7e, 0xfd, 0x6a,
+	0xf6, 0x9d, 0xb4, 0xb2, 0x70, 0xfb, 0x3c, 0x16, 0xd6, 0xaf, 0xdf, 0x55, 0x60, 0x76, 0xd8, 0xf3,
+	0x14, 0xde, 0xa9, 0x45, 0x58, 0x18, 0x5e, 0x57, 0x72, 0xb4, 0xaa, 0xe5, 0x7c, 0x49, 0x7b, 0xa6,
+	0xad, 0x6b, 0xc5, 0x2a, 0x56, 0x4b, 0xbf, 0x0b, 0x0f, 0xcf, 0xa3, 0xdf, 0xc2, 0x2a, 0x69, 0x5d,
+	0xdd, 0xad, 0xd5, 0xcb, 0x25, 0xb9, 0xc0, 0xa4, 0x64, 0xde, 0x7f, 0xfd, 0x6a, 0xf6, 0xed, 0xb4,
+	0xb2, 0x70, 0xf3, 0x4c, 0x11, 0x68, 0x75, 0x61, 0xeb, 0xdf, 0xfe, 0xc7, 0xb9, 0xb1, 0x5f, 0xfb,
+	0x62, 0x4e, 0xf9, 0x5b, 0x5f, 0xcc, 0x29, 0xff, 0xf9, 0x8b, 0xb9, 0xb1, 0xff, 0xf1, 0xc5, 0x9c,
+	0xf2, 0x93, 0x5f, 0xcc, 0x8d, 0xfd, 0xec, 0x17, 0x73, 0xca, 0x0f, 0xef, 0x5f, 0xe2, 0x81, 0x8b,
+	0x6d, 0xf6, 0xf6, 0xf6, 0xc6, 0xd9, 0x8f, 0x50, 0x96, 0xff, 0xff, 0x00, 0xdf, 0xd7, 0x16, 0x73,
+	0x75, 0x5f, 0x00, 0x00,
+}
+
+func (x MType) String() string {
+	s, ok := MType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x Major) String() string {
+	s, ok := Major_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x JoinRequestType) String() string {
+	s, ok := JoinRequestType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RejoinRequestType) String() string {
+	s, ok := RejoinRequestType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x CFListType) String() string {
+	s, ok := CFListType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x Class) String() string {
+	s, ok := Class_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x TxSchedulePriority) String() string {
+	s, ok := TxSchedulePriority_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x MACCommandIdentifier) String() string {
+	s, ok := MACCommandIdentifier_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x AggregatedDutyCycle) String() string {
+	s, ok := AggregatedDutyCycle_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x PingSlotPeriod) String() string {
+	s, ok := PingSlotPeriod_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RejoinCountExponent) String() string {
+	s, ok := RejoinCountExponent_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RejoinTimeExponent) String() string {
+	s, ok := RejoinTimeExponent_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RejoinPeriodExponent) String() string {
+	s, ok := RejoinPeriodExponent_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x DeviceEIRP) String() string {
+	s, ok := DeviceEIRP_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x ADRAckLimitExponent) String() string {
+	s, ok := ADRAckLimitExponent_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x ADRAckDelayExponent) String() string {
+	s, ok := ADRAckDelayExponent_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x Minor) String() string {
+	s, ok := Minor_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RelayCADPeriodicity) String() string {
+	s, ok := RelayCADPeriodicity_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RelaySecondChAckOffset) String() string {
+	s, ok := RelaySecondChAckOffset_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RelaySmartEnableLevel) String() string {
+	s, ok := RelaySmartEnableLevel_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RelayLimitBucketSize) String() string {
+	s, ok := RelayLimitBucketSize_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RelayJoinRequestFilterAction) String() string {
+	s, ok := RelayJoinRequestFilterAction_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x RelayCtrlUplinkListAction) String() string {
+	s, ok := RelayCtrlUplinkListAction_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *Message) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message)
+	if !ok {
+		that2, ok := that.(Message)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MHDR.Equal(&that1.MHDR) {
+		return false
+	}
+	if !bytes.Equal(this.Mic, that1.Mic) {
+		return false
+	}
+	if that1.Payload == nil {
+		if this.Payload != nil {
+			return false
+		}
+	} else if this.Payload == nil {
+		return false
+	} else if !this.Payload.Equal(that1.Payload) {
+		return false
+	}
+	return true
+}
+func (this *Message_MacPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_MacPayload)
+	if !ok {
+		that2, ok := that.(Message_MacPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MacPayload.Equal(that1.MacPayload) {
+		return false
+	}
+	return true
+}
+func (this *Message_JoinRequestPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_JoinRequestPayload)
+	if !ok {
+		that2, ok := that.(Message_JoinRequestPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.JoinRequestPayload.Equal(that1.JoinRequestPayload) {
+		return false
+	}
+	return true
+}
+func (this *Message_JoinAcceptPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_JoinAcceptPayload)
+	if !ok {
+		that2, ok := that.(Message_JoinAcceptPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.JoinAcceptPayload.Equal(that1.JoinAcceptPayload) {
+		return false
+	}
+	return true
+}
+func (this *Message_RejoinRequestPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message_RejoinRequestPayload)
+	if !ok {
+		that2, ok := that.(Message_RejoinRequestPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RejoinRequestPayload.Equal(that1.RejoinRequestPayload) {
+		return false
+	}
+	return true
+}
+func (this *MHDR) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MHDR)
+	if !ok {
+		that2, ok := that.(MHDR)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MType != that1.MType {
+		return false
+	}
+	if this.Major != that1.Major {
+		return false
+	}
+	return true
+}
+func (this *MACPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MACPayload)
+	if !ok {
+		that2, ok := that.(MACPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.FHDR.Equal(&that1.FHDR) {
+		return false
+	}
+	if this.FPort != that1.FPort {
+		return false
+	}
+	if !bytes.Equal(this.FrmPayload, that1.FrmPayload) {
+		return false
+	}
+	if !this.DecodedPayload.Equal(that1.DecodedPayload) {
+		return false
+	}
+	if this.FullFCnt != that1.FullFCnt {
+		return false
+	}
+	return true
+}
+func (this *FHDR) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FHDR)
+	if !ok {
+		that2, ok := that.(FHDR)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DevAddr.Equal(that1.DevAddr) {
+		return false
+	}
+	if !this.FCtrl.Equal(&that1.FCtrl) {
+		return false
+	}
+	if this.FCnt != that1.FCnt {
+		return false
+	}
+	if !bytes.Equal(this.FOpts, that1.FOpts) {
+		return false
+	}
+	return true
+}
+func (this *FCtrl) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FCtrl)
+	if !ok {
+		that2, ok := that.(FCtrl)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Adr != that1.Adr {
+		return false
+	}
+	if this.AdrAckReq != that1.AdrAckReq {
+		return false
+	}
+	if this.Ack != that1.Ack {
+		return false
+	}
+	if this.FPending != that1.FPending {
+		return false
+	}
+	if this.ClassB != that1.ClassB {
+		return false
+	}
+	return true
+}
+func (this *JoinRequestPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JoinRequestPayload)
+	if !ok {
+		that2, ok := that.(JoinRequestPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.JoinEui.Equal(that1.JoinEui) {
+		return false
+	}
+	if !this.DevEui.Equal(that1.DevEui) {
+		return false
+	}
+	if !this.DevNonce.Equal(that1.DevNonce) {
+		return false
+	}
+	return true
+}
+func (this *RejoinRequestPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RejoinRequestPayload)
+	if !ok {
+		that2, ok := that.(RejoinRequestPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RejoinType != that1.RejoinType {
+		return false
+	}
+	if !this.NetId.Equal(that1.NetId) {
+		return false
+	}
+	if !this.JoinEui.Equal(that1.JoinEui) {
+		return false
+	}
+	if !this.DevEui.Equal(that1.DevEui) {
+		return false
+	}
+	if this.RejoinCnt != that1.RejoinCnt {
+		return false
+	}
+	return true
+}
+func (this *JoinAcceptPayload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JoinAcceptPayload)
+	if !ok {
+		that2, ok := that.(JoinAcceptPayload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Encrypted, that1.Encrypted) {
+		return false
+	}
+	if !this.JoinNonce.Equal(that1.JoinNonce) {
+		return false
+	}
+	if !this.NetId.Equal(that1.NetId) {
+		return false
+	}
+	if !this.DevAddr.Equal(that1.DevAddr) {
+		return false
+	}
+	if !this.DLSettings.Equal(&that1.DLSettings) {
+		return false
+	}
+	if this.RxDelay != that1.RxDelay {
+		return false
+	}
+	if !this.CfList.Equal(that1.CfList) {
+		return false
+	}
+	return true
+}
+func (this *DLSettings) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DLSettings)
+	if !ok {
+		that2, ok := that.(DLSettings)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Rx1DrOffset != that1.Rx1DrOffset {
+		return false
+	}
+	if this.Rx2Dr != that1.Rx2Dr {
+		return false
+	}
+	if this.OptNeg != that1.OptNeg {
+		return false
+	}
+	return true
+}
+func (this *CFList) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CFList)
+	if !ok {
+		that2, ok := that.(CFList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if len(this.Freq) != len(that1.Freq) {
+		return false
+	}
+	for i := range this.Freq {
+		if this.Freq[i] != that1.Freq[i] {
+			return false
+		}
+	}
+	if len(this.ChMasks) != len(that1.ChMasks) {
+		return false
+	}
+	for i := range this.ChMasks {
+		if this.ChMasks[i] != that1.ChMasks[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *LoRaDataRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LoRaDataRate)
+	if !ok {
+		that2, ok := that.(LoRaDataRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Bandwidth != that1.Bandwidth {
+		return false
+	}
+	if this.SpreadingFactor != that1.SpreadingFactor {
+		return false
+	}
+	return true
+}
+func (this *FSKDataRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FSKDataRate)
+	if !ok {
+		that2, ok := that.(FSKDataRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BitRate != that1.BitRate {
+		return false
+	}
+	return true
+}
+func (this *LRFHSSDataRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LRFHSSDataRate)
+	if !ok {
+		that2, ok := that.(LRFHSSDataRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ModulationType != that1.ModulationType {
+		return false
+	}
+	if this.OperatingChannelWidth != that1.OperatingChannelWidth {
+		return false
+	}
+	if this.CodingRate != that1.CodingRate {
+		return false
+	}
+	return true
+}
+func (this *DataRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataRate)
+	if !ok {
+		that2, ok := that.(DataRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.Modulation == nil {
+		if this.Modulation != nil {
+			return false
+		}
+	} else if this.Modulation == nil {
+		return false
+	} else if !this.Modulation.Equal(that1.Modulation) {
+		return false
+	}
+	return true
+}
+func (this *DataRate_Lora) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataRate_Lora)
+	if !ok {
+		that2, ok := that.(DataRate_Lora)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Lora.Equal(that1.Lora) {
+		return false
+	}
+	return true
+}
+func (this *DataRate_Fsk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataRate_Fsk)
+	if !ok {
+		that2, ok := that.(DataRate_Fsk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Fsk.Equal(that1.Fsk) {
+		return false
+	}
+	return true
+}
+func (this *DataRate_Lrfhss) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataRate_Lrfhss)
+	if !ok {
+		that2, ok := that.(DataRate_Lrfhss)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Lrfhss.Equal(that1.Lrfhss) {
+		return false
+	}
+	return true
+}
+func (this *TxSettings) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxSettings)
+	if !ok {
+		that2, ok := that.(TxSettings)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DataRate.Equal(&that1.DataRate) {
+		return false
+	}
+	if this.CodingRate != that1.CodingRate {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
+		return false
+	}
+	if this.EnableCrc != that1.EnableCrc {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if that1.Time == nil {
+		if this.Time != nil {
+			return false
+		}
+	} else if !this.Time.Equal(*that1.Time) {
+		return false
+	}
+	if !this.Downlink.Equal(that1.Downlink) {
+		return false
+	}
+	return true
+}
+func (this *TxSettings_Downlink) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxSettings_Downlink)
+	if !ok {
+		that2, ok := that.(TxSettings_Downlink)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AntennaIndex != that1.AntennaIndex {
+		return false
+	}
+	if this.TxPower != that1.TxPower {
+		return false
+	}
+	if this.InvertPolarization != that1.InvertPolarization {
+		return false
+	}
+	return true
+}
+func (this *GatewayAntennaIdentifiers) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayAntennaIdentifiers)
+	if !ok {
+		that2, ok := that.(GatewayAntennaIdentifiers)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIds.Equal(that1.GatewayIds) {
+		return false
+	}
+	if this.AntennaIndex != that1.AntennaIndex {
+		return false
+	}
+	return true
+}
+func (this *UplinkToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UplinkToken)
+	if !ok {
+		that2, ok := that.(UplinkToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Ids.Equal(that1.Ids) {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if that1.ServerTime == nil {
+		if this.ServerTime != nil {
+			return false
+		}
+	} else if !this.ServerTime.Equal(*that1.ServerTime) {
+		return false
+	}
+	if this.ConcentratorTime != that1.ConcentratorTime {
+		return false
+	}
+	if that1.GatewayTime == nil {
+		if this.GatewayTime != nil {
+			return false
+		}
+	} else if !this.GatewayTime.Equal(*that1.GatewayTime) {
+		return false
+	}
+	return true
+}
+func (this *DownlinkPath) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DownlinkPath)
+	if !ok {
+		that2, ok := that.(DownlinkPath)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.Path == nil {
+		if this.Path != nil {
+			return false
+		}
+	} else if this.Path == nil {
+		return false
+	} else if !this.Path.Equal(that1.Path) {
+		return false
+	}
+	return true
+}
+func (this *DownlinkPath_UplinkToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DownlinkPath_UplinkToken)
+	if !ok {
+		that2, ok := that.(DownlinkPath_UplinkToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.UplinkToken, that1.UplinkToken) {
+		return false
+	}
+	return true
+}
+func (this *DownlinkPath_Fixed) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DownlinkPath_Fixed)
+	if !ok {
+		that2, ok := that.(DownlinkPath_Fixed)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Fixed.Equal(that1.Fixed) {
+		return false
+	}
+	return true
+}
+func (this *TxRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxRequest)
+	if !ok {
+		that2, ok := that.(TxRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Class != that1.Class {
+		return false
+	}
+	if len(this.DownlinkPaths) != len(that1.DownlinkPaths) {
+		return false
+	}
+	for i := range this.DownlinkPaths {
+		if !this.DownlinkPaths[i].Equal(that1.DownlinkPaths[i]) {
+			return false
+		}
+	}
+	if this.Rx1Delay != that1.Rx1Delay {
+		return false
+	}
+	if !this.Rx1DataRate.Equal(that1.Rx1DataRate) {
+		return false
+	}
+	if this.Rx1Frequency != that1.Rx1Frequency {
+		return false
+	}
+	if !this.Rx2DataRate.Equal(that1.Rx2DataRate) {
+		return false
+	}
+	if this.Rx2Frequency != that1.Rx2Frequency {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if that1.AbsoluteTime == nil {
+		if this.AbsoluteTime != nil {
+			return false
+		}
+	} else if !this.AbsoluteTime.Equal(*that1.AbsoluteTime) {
+		return false
 	}
-	return strconv.Itoa(int(x))
+	if this.FrequencyPlanId != that1.FrequencyPlanId {
+		return false
+	}
+	if !this.Advanced.Equal(that1.Advanced) {
+		return false
+	}
+	return true
 }
-func (this *Message) Equal(that interface{}) bool {
+func (this *MACCommand) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Message)
+	that1, ok := that.(*MACCommand)
 	if !ok {
-		that2, ok := that.(Message)
+		that2, ok := that.(MACCommand)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5147,10 +7452,7 @@ func (this *Message) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.MHDR.Equal(&that1.MHDR) {
-		return false
-	}
-	if !bytes.Equal(this.Mic, that1.Mic) {
+	if this.Cid != that1.Cid {
 		return false
 	}
 	if that1.Payload == nil {
@@ -5164,14 +7466,14 @@ func (this *Message) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Message_MacPayload) Equal(that interface{}) bool {
+func (this *MACCommand_RawPayload) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Message_MacPayload)
+	that1, ok := that.(*MACCommand_RawPayload)
 	if !ok {
-		that2, ok := that.(Message_MacPayload)
+		that2, ok := that.(MACCommand_RawPayload)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5183,19 +7485,19 @@ func (this *Message_MacPayload) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.MacPayload.Equal(that1.MacPayload) {
+	if !bytes.Equal(this.RawPayload, that1.RawPayload) {
 		return false
 	}
 	return true
 }
-func (this *Message_JoinRequestPayload) Equal(that interface{}) bool {
+func (this *MACCommand_ResetInd_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Message_JoinRequestPayload)
+	that1, ok := that.(*MACCommand_ResetInd_)
 	if !ok {
-		that2, ok := that.(Message_JoinRequestPayload)
+		that2, ok := that.(MACCommand_ResetInd_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5207,19 +7509,19 @@ func (this *Message_JoinRequestPayload) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.JoinRequestPayload.Equal(that1.JoinRequestPayload) {
+	if !this.ResetInd.Equal(that1.ResetInd) {
 		return false
 	}
 	return true
 }
-func (this *Message_JoinAcceptPayload) Equal(that interface{}) bool {
+func (this *MACCommand_ResetConf_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Message_JoinAcceptPayload)
+	that1, ok := that.(*MACCommand_ResetConf_)
 	if !ok {
-		that2, ok := that.(Message_JoinAcceptPayload)
+		that2, ok := that.(MACCommand_ResetConf_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5231,19 +7533,19 @@ func (this *Message_JoinAcceptPayload) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.JoinAcceptPayload.Equal(that1.JoinAcceptPayload) {
+	if !this.ResetConf.Equal(that1.ResetConf) {
 		return false
 	}
 	return true
 }
-func (this *Message_RejoinRequestPayload) Equal(that interface{}) bool {
+func (this *MACCommand_LinkCheckAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Message_RejoinRequestPayload)
+	that1, ok := that.(*MACCommand_LinkCheckAns_)
 	if !ok {
-		that2, ok := that.(Message_RejoinRequestPayload)
+		that2, ok := that.(MACCommand_LinkCheckAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5255,19 +7557,19 @@ func (this *Message_RejoinRequestPayload) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RejoinRequestPayload.Equal(that1.RejoinRequestPayload) {
+	if !this.LinkCheckAns.Equal(that1.LinkCheckAns) {
 		return false
 	}
 	return true
 }
-func (this *MHDR) Equal(that interface{}) bool {
+func (this *MACCommand_LinkAdrReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MHDR)
+	that1, ok := that.(*MACCommand_LinkAdrReq)
 	if !ok {
-		that2, ok := that.(MHDR)
+		that2, ok := that.(MACCommand_LinkAdrReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5279,22 +7581,19 @@ func (this *MHDR) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MType != that1.MType {
-		return false
-	}
-	if this.Major != that1.Major {
+	if !this.LinkAdrReq.Equal(that1.LinkAdrReq) {
 		return false
 	}
 	return true
 }
-func (this *MACPayload) Equal(that interface{}) bool {
+func (this *MACCommand_LinkAdrAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACPayload)
+	that1, ok := that.(*MACCommand_LinkAdrAns)
 	if !ok {
-		that2, ok := that.(MACPayload)
+		that2, ok := that.(MACCommand_LinkAdrAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5306,31 +7605,19 @@ func (this *MACPayload) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.FHDR.Equal(&that1.FHDR) {
-		return false
-	}
-	if this.FPort != that1.FPort {
-		return false
-	}
-	if !bytes.Equal(this.FrmPayload, that1.FrmPayload) {
-		return false
-	}
-	if !this.DecodedPayload.Equal(that1.DecodedPayload) {
-		return false
-	}
-	if this.FullFCnt != that1.FullFCnt {
+	if !this.LinkAdrAns.Equal(that1.LinkAdrAns) {
 		return false
 	}
 	return true
 }
-func (this *FHDR) Equal(that interface{}) bool {
+func (this *MACCommand_DutyCycleReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*FHDR)
+	that1, ok := that.(*MACCommand_DutyCycleReq_)
 	if !ok {
-		that2, ok := that.(FHDR)
+		that2, ok := that.(MACCommand_DutyCycleReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5342,28 +7629,19 @@ func (this *FHDR) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DevAddr.Equal(that1.DevAddr) {
-		return false
-	}
-	if !this.FCtrl.Equal(&that1.FCtrl) {
-		return false
-	}
-	if this.FCnt != that1.FCnt {
-		return false
-	}
-	if !bytes.Equal(this.FOpts, that1.FOpts) {
+	if !this.DutyCycleReq.Equal(that1.DutyCycleReq) {
 		return false
 	}
 	return true
 }
-func (this *FCtrl) Equal(that interface{}) bool {
+func (this *MACCommand_RxParamSetupReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*FCtrl)
+	that1, ok := that.(*MACCommand_RxParamSetupReq_)
 	if !ok {
-		that2, ok := that.(FCtrl)
+		that2, ok := that.(MACCommand_RxParamSetupReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5375,31 +7653,19 @@ func (this *FCtrl) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Adr != that1.Adr {
-		return false
-	}
-	if this.AdrAckReq != that1.AdrAckReq {
-		return false
-	}
-	if this.Ack != that1.Ack {
-		return false
-	}
-	if this.FPending != that1.FPending {
-		return false
-	}
-	if this.ClassB != that1.ClassB {
+	if !this.RxParamSetupReq.Equal(that1.RxParamSetupReq) {
 		return false
 	}
 	return true
 }
-func (this *JoinRequestPayload) Equal(that interface{}) bool {
+func (this *MACCommand_RxParamSetupAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*JoinRequestPayload)
+	that1, ok := that.(*MACCommand_RxParamSetupAns_)
 	if !ok {
-		that2, ok := that.(JoinRequestPayload)
+		that2, ok := that.(MACCommand_RxParamSetupAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5411,25 +7677,19 @@ func (this *JoinRequestPayload) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.JoinEui.Equal(that1.JoinEui) {
-		return false
-	}
-	if !this.DevEui.Equal(that1.DevEui) {
-		return false
-	}
-	if !this.DevNonce.Equal(that1.DevNonce) {
+	if !this.RxParamSetupAns.Equal(that1.RxParamSetupAns) {
 		return false
 	}
 	return true
 }
-func (this *RejoinRequestPayload) Equal(that interface{}) bool {
+func (this *MACCommand_DevStatusAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RejoinRequestPayload)
+	that1, ok := that.(*MACCommand_DevStatusAns_)
 	if !ok {
-		that2, ok := that.(RejoinRequestPayload)
+		that2, ok := that.(MACCommand_DevStatusAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5441,31 +7701,19 @@ func (this *RejoinRequestPayload) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.RejoinType != that1.RejoinType {
-		return false
-	}
-	if !this.NetId.Equal(that1.NetId) {
-		return false
-	}
-	if !this.JoinEui.Equal(that1.JoinEui) {
-		return false
-	}
-	if !this.DevEui.Equal(that1.DevEui) {
-		return false
-	}
-	if this.RejoinCnt != that1.RejoinCnt {
+	if !this.DevStatusAns.Equal(that1.DevStatusAns) {
 		return false
 	}
 	return true
 }
-func (this *JoinAcceptPayload) Equal(that interface{}) bool {
+func (this *MACCommand_NewChannelReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*JoinAcceptPayload)
+	that1, ok := that.(*MACCommand_NewChannelReq_)
 	if !ok {
-		that2, ok := that.(JoinAcceptPayload)
+		that2, ok := that.(MACCommand_NewChannelReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5477,37 +7725,43 @@ func (this *JoinAcceptPayload) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Encrypted, that1.Encrypted) {
-		return false
-	}
-	if !this.JoinNonce.Equal(that1.JoinNonce) {
-		return false
-	}
-	if !this.NetId.Equal(that1.NetId) {
+	if !this.NewChannelReq.Equal(that1.NewChannelReq) {
 		return false
 	}
-	if !this.DevAddr.Equal(that1.DevAddr) {
-		return false
+	return true
+}
+func (this *MACCommand_NewChannelAns_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if !this.DLSettings.Equal(&that1.DLSettings) {
-		return false
+
+	that1, ok := that.(*MACCommand_NewChannelAns_)
+	if !ok {
+		that2, ok := that.(MACCommand_NewChannelAns_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.RxDelay != that1.RxDelay {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if !this.CfList.Equal(that1.CfList) {
+	if !this.NewChannelAns.Equal(that1.NewChannelAns) {
 		return false
 	}
 	return true
 }
-func (this *DLSettings) Equal(that interface{}) bool {
+func (this *MACCommand_DlChannelReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DLSettings)
+	that1, ok := that.(*MACCommand_DlChannelReq)
 	if !ok {
-		that2, ok := that.(DLSettings)
+		that2, ok := that.(MACCommand_DlChannelReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5519,25 +7773,19 @@ func (this *DLSettings) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Rx1DrOffset != that1.Rx1DrOffset {
-		return false
-	}
-	if this.Rx2Dr != that1.Rx2Dr {
-		return false
-	}
-	if this.OptNeg != that1.OptNeg {
+	if !this.DlChannelReq.Equal(that1.DlChannelReq) {
 		return false
 	}
 	return true
 }
-func (this *CFList) Equal(that interface{}) bool {
+func (this *MACCommand_DlChannelAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CFList)
+	that1, ok := that.(*MACCommand_DlChannelAns)
 	if !ok {
-		that2, ok := that.(CFList)
+		that2, ok := that.(MACCommand_DlChannelAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5549,35 +7797,43 @@ func (this *CFList) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Type != that1.Type {
+	if !this.DlChannelAns.Equal(that1.DlChannelAns) {
 		return false
 	}
-	if len(this.Freq) != len(that1.Freq) {
-		return false
+	return true
+}
+func (this *MACCommand_RxTimingSetupReq_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	for i := range this.Freq {
-		if this.Freq[i] != that1.Freq[i] {
+
+	that1, ok := that.(*MACCommand_RxTimingSetupReq_)
+	if !ok {
+		that2, ok := that.(MACCommand_RxTimingSetupReq_)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if len(this.ChMasks) != len(that1.ChMasks) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	for i := range this.ChMasks {
-		if this.ChMasks[i] != that1.ChMasks[i] {
-			return false
-		}
+	if !this.RxTimingSetupReq.Equal(that1.RxTimingSetupReq) {
+		return false
 	}
 	return true
 }
-func (this *LoRaDataRate) Equal(that interface{}) bool {
+func (this *MACCommand_TxParamSetupReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*LoRaDataRate)
+	that1, ok := that.(*MACCommand_TxParamSetupReq_)
 	if !ok {
-		that2, ok := that.(LoRaDataRate)
+		that2, ok := that.(MACCommand_TxParamSetupReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5589,22 +7845,19 @@ func (this *LoRaDataRate) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Bandwidth != that1.Bandwidth {
-		return false
-	}
-	if this.SpreadingFactor != that1.SpreadingFactor {
+	if !this.TxParamSetupReq.Equal(that1.TxParamSetupReq) {
 		return false
 	}
 	return true
 }
-func (this *FSKDataRate) Equal(that interface{}) bool {
+func (this *MACCommand_RekeyInd_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*FSKDataRate)
+	that1, ok := that.(*MACCommand_RekeyInd_)
 	if !ok {
-		that2, ok := that.(FSKDataRate)
+		that2, ok := that.(MACCommand_RekeyInd_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5616,19 +7869,19 @@ func (this *FSKDataRate) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.BitRate != that1.BitRate {
+	if !this.RekeyInd.Equal(that1.RekeyInd) {
 		return false
 	}
 	return true
 }
-func (this *LRFHSSDataRate) Equal(that interface{}) bool {
+func (this *MACCommand_RekeyConf_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*LRFHSSDataRate)
+	that1, ok := that.(*MACCommand_RekeyConf_)
 	if !ok {
-		that2, ok := that.(LRFHSSDataRate)
+		that2, ok := that.(MACCommand_RekeyConf_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5640,25 +7893,19 @@ func (this *LRFHSSDataRate) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ModulationType != that1.ModulationType {
-		return false
-	}
-	if this.OperatingChannelWidth != that1.OperatingChannelWidth {
-		return false
-	}
-	if this.CodingRate != that1.CodingRate {
+	if !this.RekeyConf.Equal(that1.RekeyConf) {
 		return false
 	}
 	return true
 }
-func (this *DataRate) Equal(that interface{}) bool {
+func (this *MACCommand_AdrParamSetupReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataRate)
+	that1, ok := that.(*MACCommand_AdrParamSetupReq)
 	if !ok {
-		that2, ok := that.(DataRate)
+		that2, ok := that.(MACCommand_AdrParamSetupReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5670,25 +7917,19 @@ func (this *DataRate) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.Modulation == nil {
-		if this.Modulation != nil {
-			return false
-		}
-	} else if this.Modulation == nil {
-		return false
-	} else if !this.Modulation.Equal(that1.Modulation) {
+	if !this.AdrParamSetupReq.Equal(that1.AdrParamSetupReq) {
 		return false
 	}
 	return true
 }
-func (this *DataRate_Lora) Equal(that interface{}) bool {
+func (this *MACCommand_DeviceTimeAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataRate_Lora)
+	that1, ok := that.(*MACCommand_DeviceTimeAns_)
 	if !ok {
-		that2, ok := that.(DataRate_Lora)
+		that2, ok := that.(MACCommand_DeviceTimeAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5700,19 +7941,19 @@ func (this *DataRate_Lora) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Lora.Equal(that1.Lora) {
+	if !this.DeviceTimeAns.Equal(that1.DeviceTimeAns) {
 		return false
 	}
 	return true
 }
-func (this *DataRate_Fsk) Equal(that interface{}) bool {
+func (this *MACCommand_ForceRejoinReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataRate_Fsk)
+	that1, ok := that.(*MACCommand_ForceRejoinReq_)
 	if !ok {
-		that2, ok := that.(DataRate_Fsk)
+		that2, ok := that.(MACCommand_ForceRejoinReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5724,19 +7965,19 @@ func (this *DataRate_Fsk) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Fsk.Equal(that1.Fsk) {
+	if !this.ForceRejoinReq.Equal(that1.ForceRejoinReq) {
 		return false
 	}
 	return true
 }
-func (this *DataRate_Lrfhss) Equal(that interface{}) bool {
+func (this *MACCommand_RejoinParamSetupReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DataRate_Lrfhss)
+	that1, ok := that.(*MACCommand_RejoinParamSetupReq_)
 	if !ok {
-		that2, ok := that.(DataRate_Lrfhss)
+		that2, ok := that.(MACCommand_RejoinParamSetupReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5748,19 +7989,19 @@ func (this *DataRate_Lrfhss) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Lrfhss.Equal(that1.Lrfhss) {
+	if !this.RejoinParamSetupReq.Equal(that1.RejoinParamSetupReq) {
 		return false
 	}
 	return true
 }
-func (this *TxSettings) Equal(that interface{}) bool {
+func (this *MACCommand_RejoinParamSetupAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*TxSettings)
+	that1, ok := that.(*MACCommand_RejoinParamSetupAns_)
 	if !ok {
-		that2, ok := that.(TxSettings)
+		that2, ok := that.(MACCommand_RejoinParamSetupAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5772,41 +8013,19 @@ func (this *TxSettings) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DataRate.Equal(&that1.DataRate) {
-		return false
-	}
-	if this.CodingRate != that1.CodingRate {
-		return false
-	}
-	if this.Frequency != that1.Frequency {
-		return false
-	}
-	if this.EnableCrc != that1.EnableCrc {
-		return false
-	}
-	if this.Timestamp != that1.Timestamp {
-		return false
-	}
-	if that1.Time == nil {
-		if this.Time != nil {
-			return false
-		}
-	} else if !this.Time.Equal(*that1.Time) {
-		return false
-	}
-	if !this.Downlink.Equal(that1.Downlink) {
+	if !this.RejoinParamSetupAns.Equal(that1.RejoinParamSetupAns) {
 		return false
 	}
 	return true
 }
-func (this *TxSettings_Downlink) Equal(that interface{}) bool {
+func (this *MACCommand_PingSlotInfoReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*TxSettings_Downlink)
+	that1, ok := that.(*MACCommand_PingSlotInfoReq_)
 	if !ok {
-		that2, ok := that.(TxSettings_Downlink)
+		that2, ok := that.(MACCommand_PingSlotInfoReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5818,25 +8037,19 @@ func (this *TxSettings_Downlink) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.AntennaIndex != that1.AntennaIndex {
-		return false
-	}
-	if this.TxPower != that1.TxPower {
-		return false
-	}
-	if this.InvertPolarization != that1.InvertPolarization {
+	if !this.PingSlotInfoReq.Equal(that1.PingSlotInfoReq) {
 		return false
 	}
 	return true
 }
-func (this *GatewayAntennaIdentifiers) Equal(that interface{}) bool {
+func (this *MACCommand_PingSlotChannelReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GatewayAntennaIdentifiers)
+	that1, ok := that.(*MACCommand_PingSlotChannelReq_)
 	if !ok {
-		that2, ok := that.(GatewayAntennaIdentifiers)
+		that2, ok := that.(MACCommand_PingSlotChannelReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5848,22 +8061,19 @@ func (this *GatewayAntennaIdentifiers) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.GatewayIds.Equal(that1.GatewayIds) {
-		return false
-	}
-	if this.AntennaIndex != that1.AntennaIndex {
+	if !this.PingSlotChannelReq.Equal(that1.PingSlotChannelReq) {
 		return false
 	}
 	return true
 }
-func (this *UplinkToken) Equal(that interface{}) bool {
+func (this *MACCommand_PingSlotChannelAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UplinkToken)
+	that1, ok := that.(*MACCommand_PingSlotChannelAns_)
 	if !ok {
-		that2, ok := that.(UplinkToken)
+		that2, ok := that.(MACCommand_PingSlotChannelAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5875,39 +8085,19 @@ func (this *UplinkToken) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Ids.Equal(that1.Ids) {
-		return false
-	}
-	if this.Timestamp != that1.Timestamp {
-		return false
-	}
-	if that1.ServerTime == nil {
-		if this.ServerTime != nil {
-			return false
-		}
-	} else if !this.ServerTime.Equal(*that1.ServerTime) {
-		return false
-	}
-	if this.ConcentratorTime != that1.ConcentratorTime {
-		return false
-	}
-	if that1.GatewayTime == nil {
-		if this.GatewayTime != nil {
-			return false
-		}
-	} else if !this.GatewayTime.Equal(*that1.GatewayTime) {
+	if !this.PingSlotChannelAns.Equal(that1.PingSlotChannelAns) {
 		return false
 	}
 	return true
 }
-func (this *DownlinkPath) Equal(that interface{}) bool {
+func (this *MACCommand_BeaconTimingAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DownlinkPath)
+	that1, ok := that.(*MACCommand_BeaconTimingAns_)
 	if !ok {
-		that2, ok := that.(DownlinkPath)
+		that2, ok := that.(MACCommand_BeaconTimingAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5919,25 +8109,19 @@ func (this *DownlinkPath) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if that1.Path == nil {
-		if this.Path != nil {
-			return false
-		}
-	} else if this.Path == nil {
-		return false
-	} else if !this.Path.Equal(that1.Path) {
+	if !this.BeaconTimingAns.Equal(that1.BeaconTimingAns) {
 		return false
 	}
 	return true
 }
-func (this *DownlinkPath_UplinkToken) Equal(that interface{}) bool {
+func (this *MACCommand_BeaconFreqReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DownlinkPath_UplinkToken)
+	that1, ok := that.(*MACCommand_BeaconFreqReq_)
 	if !ok {
-		that2, ok := that.(DownlinkPath_UplinkToken)
+		that2, ok := that.(MACCommand_BeaconFreqReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5949,19 +8133,19 @@ func (this *DownlinkPath_UplinkToken) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.UplinkToken, that1.UplinkToken) {
+	if !this.BeaconFreqReq.Equal(that1.BeaconFreqReq) {
 		return false
 	}
 	return true
 }
-func (this *DownlinkPath_Fixed) Equal(that interface{}) bool {
+func (this *MACCommand_BeaconFreqAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DownlinkPath_Fixed)
+	that1, ok := that.(*MACCommand_BeaconFreqAns_)
 	if !ok {
-		that2, ok := that.(DownlinkPath_Fixed)
+		that2, ok := that.(MACCommand_BeaconFreqAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5973,19 +8157,19 @@ func (this *DownlinkPath_Fixed) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Fixed.Equal(that1.Fixed) {
+	if !this.BeaconFreqAns.Equal(that1.BeaconFreqAns) {
 		return false
 	}
 	return true
 }
-func (this *TxRequest) Equal(that interface{}) bool {
+func (this *MACCommand_DeviceModeInd_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*TxRequest)
+	that1, ok := that.(*MACCommand_DeviceModeInd_)
 	if !ok {
-		that2, ok := that.(TxRequest)
+		that2, ok := that.(MACCommand_DeviceModeInd_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5997,58 +8181,19 @@ func (this *TxRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Class != that1.Class {
-		return false
-	}
-	if len(this.DownlinkPaths) != len(that1.DownlinkPaths) {
-		return false
-	}
-	for i := range this.DownlinkPaths {
-		if !this.DownlinkPaths[i].Equal(that1.DownlinkPaths[i]) {
-			return false
-		}
-	}
-	if this.Rx1Delay != that1.Rx1Delay {
-		return false
-	}
-	if !this.Rx1DataRate.Equal(that1.Rx1DataRate) {
-		return false
-	}
-	if this.Rx1Frequency != that1.Rx1Frequency {
-		return false
-	}
-	if !this.Rx2DataRate.Equal(that1.Rx2DataRate) {
-		return false
-	}
-	if this.Rx2Frequency != that1.Rx2Frequency {
-		return false
-	}
-	if this.Priority != that1.Priority {
-		return false
-	}
-	if that1.AbsoluteTime == nil {
-		if this.AbsoluteTime != nil {
-			return false
-		}
-	} else if !this.AbsoluteTime.Equal(*that1.AbsoluteTime) {
-		return false
-	}
-	if this.FrequencyPlanId != that1.FrequencyPlanId {
-		return false
-	}
-	if !this.Advanced.Equal(that1.Advanced) {
+	if !this.DeviceModeInd.Equal(that1.DeviceModeInd) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand) Equal(that interface{}) bool {
+func (this *MACCommand_DeviceModeConf_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand)
+	that1, ok := that.(*MACCommand_DeviceModeConf_)
 	if !ok {
-		that2, ok := that.(MACCommand)
+		that2, ok := that.(MACCommand_DeviceModeConf_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6060,28 +8205,19 @@ func (this *MACCommand) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Cid != that1.Cid {
-		return false
-	}
-	if that1.Payload == nil {
-		if this.Payload != nil {
-			return false
-		}
-	} else if this.Payload == nil {
-		return false
-	} else if !this.Payload.Equal(that1.Payload) {
+	if !this.DeviceModeConf.Equal(that1.DeviceModeConf) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RawPayload) Equal(that interface{}) bool {
+func (this *MACCommand_RelayConfReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RawPayload)
+	that1, ok := that.(*MACCommand_RelayConfReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_RawPayload)
+		that2, ok := that.(MACCommand_RelayConfReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6093,19 +8229,19 @@ func (this *MACCommand_RawPayload) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.RawPayload, that1.RawPayload) {
+	if !this.RelayConfReq.Equal(that1.RelayConfReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ResetInd_) Equal(that interface{}) bool {
+func (this *MACCommand_RelayConfAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ResetInd_)
+	that1, ok := that.(*MACCommand_RelayConfAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_ResetInd_)
+		that2, ok := that.(MACCommand_RelayConfAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6117,19 +8253,19 @@ func (this *MACCommand_ResetInd_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ResetInd.Equal(that1.ResetInd) {
+	if !this.RelayConfAns.Equal(that1.RelayConfAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ResetConf_) Equal(that interface{}) bool {
+func (this *MACCommand_RelayEndDeviceConfReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ResetConf_)
+	that1, ok := that.(*MACCommand_RelayEndDeviceConfReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_ResetConf_)
+		that2, ok := that.(MACCommand_RelayEndDeviceConfReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6141,19 +8277,19 @@ func (this *MACCommand_ResetConf_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ResetConf.Equal(that1.ResetConf) {
+	if !this.RelayEndDeviceConfReq.Equal(that1.RelayEndDeviceConfReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkCheckAns_) Equal(that interface{}) bool {
+func (this *MACCommand_RelayEndDeviceConfAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkCheckAns_)
+	that1, ok := that.(*MACCommand_RelayEndDeviceConfAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkCheckAns_)
+		that2, ok := that.(MACCommand_RelayEndDeviceConfAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6165,19 +8301,19 @@ func (this *MACCommand_LinkCheckAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.LinkCheckAns.Equal(that1.LinkCheckAns) {
+	if !this.RelayEndDeviceConfAns.Equal(that1.RelayEndDeviceConfAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkAdrReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayFilterListReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkAdrReq)
+	that1, ok := that.(*MACCommand_RelayFilterListReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkAdrReq)
+		that2, ok := that.(MACCommand_RelayFilterListReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6189,19 +8325,19 @@ func (this *MACCommand_LinkAdrReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.LinkAdrReq.Equal(that1.LinkAdrReq) {
+	if !this.RelayFilterListReq.Equal(that1.RelayFilterListReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkAdrAns) Equal(that interface{}) bool {
+func (this *MACCommand_RelayFilterListAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkAdrAns)
+	that1, ok := that.(*MACCommand_RelayFilterListAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkAdrAns)
+		that2, ok := that.(MACCommand_RelayFilterListAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6213,19 +8349,19 @@ func (this *MACCommand_LinkAdrAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.LinkAdrAns.Equal(that1.LinkAdrAns) {
+	if !this.RelayFilterListAns.Equal(that1.RelayFilterListAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DutyCycleReq_) Equal(that interface{}) bool {
+func (this *MACCommand_RelayUpdateUplinkListReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DutyCycleReq_)
+	that1, ok := that.(*MACCommand_RelayUpdateUplinkListReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_DutyCycleReq_)
+		that2, ok := that.(MACCommand_RelayUpdateUplinkListReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6237,19 +8373,19 @@ func (this *MACCommand_DutyCycleReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DutyCycleReq.Equal(that1.DutyCycleReq) {
+	if !this.RelayUpdateUplinkListReq.Equal(that1.RelayUpdateUplinkListReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RxParamSetupReq_) Equal(that interface{}) bool {
+func (this *MACCommand_RelayCtrlUplinkListReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxParamSetupReq_)
+	that1, ok := that.(*MACCommand_RelayCtrlUplinkListReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_RxParamSetupReq_)
+		that2, ok := that.(MACCommand_RelayCtrlUplinkListReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6261,19 +8397,19 @@ func (this *MACCommand_RxParamSetupReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RxParamSetupReq.Equal(that1.RxParamSetupReq) {
+	if !this.RelayCtrlUplinkListReq.Equal(that1.RelayCtrlUplinkListReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RxParamSetupAns_) Equal(that interface{}) bool {
+func (this *MACCommand_RelayCtrlUplinkListAns_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxParamSetupAns_)
+	that1, ok := that.(*MACCommand_RelayCtrlUplinkListAns_)
 	if !ok {
-		that2, ok := that.(MACCommand_RxParamSetupAns_)
+		that2, ok := that.(MACCommand_RelayCtrlUplinkListAns_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6285,19 +8421,19 @@ func (this *MACCommand_RxParamSetupAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RxParamSetupAns.Equal(that1.RxParamSetupAns) {
+	if !this.RelayCtrlUplinkListAns.Equal(that1.RelayCtrlUplinkListAns) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DevStatusAns_) Equal(that interface{}) bool {
+func (this *MACCommand_RelayNotifyNewEndDeviceReq_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DevStatusAns_)
+	that1, ok := that.(*MACCommand_RelayNotifyNewEndDeviceReq_)
 	if !ok {
-		that2, ok := that.(MACCommand_DevStatusAns_)
+		that2, ok := that.(MACCommand_RelayNotifyNewEndDeviceReq_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6309,19 +8445,19 @@ func (this *MACCommand_DevStatusAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DevStatusAns.Equal(that1.DevStatusAns) {
+	if !this.RelayNotifyNewEndDeviceReq.Equal(that1.RelayNotifyNewEndDeviceReq) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_NewChannelReq_) Equal(that interface{}) bool {
+func (this *MACCommand_ResetInd) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_NewChannelReq_)
+	that1, ok := that.(*MACCommand_ResetInd)
 	if !ok {
-		that2, ok := that.(MACCommand_NewChannelReq_)
+		that2, ok := that.(MACCommand_ResetInd)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6333,19 +8469,19 @@ func (this *MACCommand_NewChannelReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.NewChannelReq.Equal(that1.NewChannelReq) {
+	if this.MinorVersion != that1.MinorVersion {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_NewChannelAns_) Equal(that interface{}) bool {
+func (this *MACCommand_ResetConf) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_NewChannelAns_)
+	that1, ok := that.(*MACCommand_ResetConf)
 	if !ok {
-		that2, ok := that.(MACCommand_NewChannelAns_)
+		that2, ok := that.(MACCommand_ResetConf)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6357,19 +8493,19 @@ func (this *MACCommand_NewChannelAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.NewChannelAns.Equal(that1.NewChannelAns) {
+	if this.MinorVersion != that1.MinorVersion {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DlChannelReq) Equal(that interface{}) bool {
+func (this *MACCommand_LinkCheckAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DlChannelReq)
+	that1, ok := that.(*MACCommand_LinkCheckAns)
 	if !ok {
-		that2, ok := that.(MACCommand_DlChannelReq)
+		that2, ok := that.(MACCommand_LinkCheckAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6381,19 +8517,22 @@ func (this *MACCommand_DlChannelReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DlChannelReq.Equal(that1.DlChannelReq) {
+	if this.Margin != that1.Margin {
+		return false
+	}
+	if this.GatewayCount != that1.GatewayCount {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DlChannelAns) Equal(that interface{}) bool {
+func (this *MACCommand_LinkADRReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DlChannelAns)
+	that1, ok := that.(*MACCommand_LinkADRReq)
 	if !ok {
-		that2, ok := that.(MACCommand_DlChannelAns)
+		that2, ok := that.(MACCommand_LinkADRReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6405,19 +8544,36 @@ func (this *MACCommand_DlChannelAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DlChannelAns.Equal(that1.DlChannelAns) {
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	if this.TxPowerIndex != that1.TxPowerIndex {
+		return false
+	}
+	if len(this.ChannelMask) != len(that1.ChannelMask) {
+		return false
+	}
+	for i := range this.ChannelMask {
+		if this.ChannelMask[i] != that1.ChannelMask[i] {
+			return false
+		}
+	}
+	if this.ChannelMaskControl != that1.ChannelMaskControl {
+		return false
+	}
+	if this.NbTrans != that1.NbTrans {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RxTimingSetupReq_) Equal(that interface{}) bool {
+func (this *MACCommand_LinkADRAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxTimingSetupReq_)
+	that1, ok := that.(*MACCommand_LinkADRAns)
 	if !ok {
-		that2, ok := that.(MACCommand_RxTimingSetupReq_)
+		that2, ok := that.(MACCommand_LinkADRAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6429,19 +8585,25 @@ func (this *MACCommand_RxTimingSetupReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RxTimingSetupReq.Equal(that1.RxTimingSetupReq) {
+	if this.ChannelMaskAck != that1.ChannelMaskAck {
+		return false
+	}
+	if this.DataRateIndexAck != that1.DataRateIndexAck {
+		return false
+	}
+	if this.TxPowerIndexAck != that1.TxPowerIndexAck {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_TxParamSetupReq_) Equal(that interface{}) bool {
+func (this *MACCommand_DutyCycleReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_TxParamSetupReq_)
+	that1, ok := that.(*MACCommand_DutyCycleReq)
 	if !ok {
-		that2, ok := that.(MACCommand_TxParamSetupReq_)
+		that2, ok := that.(MACCommand_DutyCycleReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6453,19 +8615,19 @@ func (this *MACCommand_TxParamSetupReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.TxParamSetupReq.Equal(that1.TxParamSetupReq) {
+	if this.MaxDutyCycle != that1.MaxDutyCycle {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RekeyInd_) Equal(that interface{}) bool {
+func (this *MACCommand_RxParamSetupReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RekeyInd_)
+	that1, ok := that.(*MACCommand_RxParamSetupReq)
 	if !ok {
-		that2, ok := that.(MACCommand_RekeyInd_)
+		that2, ok := that.(MACCommand_RxParamSetupReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6477,19 +8639,25 @@ func (this *MACCommand_RekeyInd_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RekeyInd.Equal(that1.RekeyInd) {
+	if this.Rx2DataRateIndex != that1.Rx2DataRateIndex {
+		return false
+	}
+	if this.Rx1DataRateOffset != that1.Rx1DataRateOffset {
+		return false
+	}
+	if this.Rx2Frequency != that1.Rx2Frequency {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RekeyConf_) Equal(that interface{}) bool {
+func (this *MACCommand_RxParamSetupAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RekeyConf_)
+	that1, ok := that.(*MACCommand_RxParamSetupAns)
 	if !ok {
-		that2, ok := that.(MACCommand_RekeyConf_)
+		that2, ok := that.(MACCommand_RxParamSetupAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6501,19 +8669,25 @@ func (this *MACCommand_RekeyConf_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RekeyConf.Equal(that1.RekeyConf) {
+	if this.Rx2DataRateIndexAck != that1.Rx2DataRateIndexAck {
+		return false
+	}
+	if this.Rx1DataRateOffsetAck != that1.Rx1DataRateOffsetAck {
+		return false
+	}
+	if this.Rx2FrequencyAck != that1.Rx2FrequencyAck {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_AdrParamSetupReq) Equal(that interface{}) bool {
+func (this *MACCommand_DevStatusAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_AdrParamSetupReq)
+	that1, ok := that.(*MACCommand_DevStatusAns)
 	if !ok {
-		that2, ok := that.(MACCommand_AdrParamSetupReq)
+		that2, ok := that.(MACCommand_DevStatusAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6525,19 +8699,22 @@ func (this *MACCommand_AdrParamSetupReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.AdrParamSetupReq.Equal(that1.AdrParamSetupReq) {
+	if this.Battery != that1.Battery {
+		return false
+	}
+	if this.Margin != that1.Margin {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DeviceTimeAns_) Equal(that interface{}) bool {
+func (this *MACCommand_NewChannelReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceTimeAns_)
+	that1, ok := that.(*MACCommand_NewChannelReq)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceTimeAns_)
+		that2, ok := that.(MACCommand_NewChannelReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6549,19 +8726,28 @@ func (this *MACCommand_DeviceTimeAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DeviceTimeAns.Equal(that1.DeviceTimeAns) {
+	if this.ChannelIndex != that1.ChannelIndex {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
+		return false
+	}
+	if this.MinDataRateIndex != that1.MinDataRateIndex {
+		return false
+	}
+	if this.MaxDataRateIndex != that1.MaxDataRateIndex {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ForceRejoinReq_) Equal(that interface{}) bool {
+func (this *MACCommand_NewChannelAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ForceRejoinReq_)
+	that1, ok := that.(*MACCommand_NewChannelAns)
 	if !ok {
-		that2, ok := that.(MACCommand_ForceRejoinReq_)
+		that2, ok := that.(MACCommand_NewChannelAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6573,19 +8759,22 @@ func (this *MACCommand_ForceRejoinReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ForceRejoinReq.Equal(that1.ForceRejoinReq) {
+	if this.FrequencyAck != that1.FrequencyAck {
+		return false
+	}
+	if this.DataRateAck != that1.DataRateAck {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RejoinParamSetupReq_) Equal(that interface{}) bool {
+func (this *MACCommand_DLChannelReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RejoinParamSetupReq_)
+	that1, ok := that.(*MACCommand_DLChannelReq)
 	if !ok {
-		that2, ok := that.(MACCommand_RejoinParamSetupReq_)
+		that2, ok := that.(MACCommand_DLChannelReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6597,19 +8786,22 @@ func (this *MACCommand_RejoinParamSetupReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RejoinParamSetupReq.Equal(that1.RejoinParamSetupReq) {
+	if this.ChannelIndex != that1.ChannelIndex {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RejoinParamSetupAns_) Equal(that interface{}) bool {
+func (this *MACCommand_DLChannelAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RejoinParamSetupAns_)
+	that1, ok := that.(*MACCommand_DLChannelAns)
 	if !ok {
-		that2, ok := that.(MACCommand_RejoinParamSetupAns_)
+		that2, ok := that.(MACCommand_DLChannelAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6621,19 +8813,22 @@ func (this *MACCommand_RejoinParamSetupAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RejoinParamSetupAns.Equal(that1.RejoinParamSetupAns) {
+	if this.ChannelIndexAck != that1.ChannelIndexAck {
+		return false
+	}
+	if this.FrequencyAck != that1.FrequencyAck {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotInfoReq_) Equal(that interface{}) bool {
+func (this *MACCommand_RxTimingSetupReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotInfoReq_)
+	that1, ok := that.(*MACCommand_RxTimingSetupReq)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotInfoReq_)
+		that2, ok := that.(MACCommand_RxTimingSetupReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6645,19 +8840,19 @@ func (this *MACCommand_PingSlotInfoReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.PingSlotInfoReq.Equal(that1.PingSlotInfoReq) {
+	if this.Delay != that1.Delay {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotChannelReq_) Equal(that interface{}) bool {
+func (this *MACCommand_TxParamSetupReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotChannelReq_)
+	that1, ok := that.(*MACCommand_TxParamSetupReq)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotChannelReq_)
+		that2, ok := that.(MACCommand_TxParamSetupReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6669,19 +8864,25 @@ func (this *MACCommand_PingSlotChannelReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.PingSlotChannelReq.Equal(that1.PingSlotChannelReq) {
+	if this.MaxEirpIndex != that1.MaxEirpIndex {
+		return false
+	}
+	if this.UplinkDwellTime != that1.UplinkDwellTime {
+		return false
+	}
+	if this.DownlinkDwellTime != that1.DownlinkDwellTime {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotChannelAns_) Equal(that interface{}) bool {
+func (this *MACCommand_RekeyInd) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotChannelAns_)
+	that1, ok := that.(*MACCommand_RekeyInd)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotChannelAns_)
+		that2, ok := that.(MACCommand_RekeyInd)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6693,19 +8894,19 @@ func (this *MACCommand_PingSlotChannelAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.PingSlotChannelAns.Equal(that1.PingSlotChannelAns) {
+	if this.MinorVersion != that1.MinorVersion {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_BeaconTimingAns_) Equal(that interface{}) bool {
+func (this *MACCommand_RekeyConf) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconTimingAns_)
+	that1, ok := that.(*MACCommand_RekeyConf)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconTimingAns_)
+		that2, ok := that.(MACCommand_RekeyConf)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6717,19 +8918,19 @@ func (this *MACCommand_BeaconTimingAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.BeaconTimingAns.Equal(that1.BeaconTimingAns) {
+	if this.MinorVersion != that1.MinorVersion {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_BeaconFreqReq_) Equal(that interface{}) bool {
+func (this *MACCommand_ADRParamSetupReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconFreqReq_)
+	that1, ok := that.(*MACCommand_ADRParamSetupReq)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconFreqReq_)
+		that2, ok := that.(MACCommand_ADRParamSetupReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6741,19 +8942,22 @@ func (this *MACCommand_BeaconFreqReq_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.BeaconFreqReq.Equal(that1.BeaconFreqReq) {
+	if this.AdrAckLimitExponent != that1.AdrAckLimitExponent {
+		return false
+	}
+	if this.AdrAckDelayExponent != that1.AdrAckDelayExponent {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_BeaconFreqAns_) Equal(that interface{}) bool {
+func (this *MACCommand_DeviceTimeAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconFreqAns_)
+	that1, ok := that.(*MACCommand_DeviceTimeAns)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconFreqAns_)
+		that2, ok := that.(MACCommand_DeviceTimeAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6765,19 +8969,19 @@ func (this *MACCommand_BeaconFreqAns_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.BeaconFreqAns.Equal(that1.BeaconFreqAns) {
+	if !this.Time.Equal(that1.Time) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DeviceModeInd_) Equal(that interface{}) bool {
+func (this *MACCommand_ForceRejoinReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceModeInd_)
+	that1, ok := that.(*MACCommand_ForceRejoinReq)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceModeInd_)
+		that2, ok := that.(MACCommand_ForceRejoinReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6789,19 +8993,28 @@ func (this *MACCommand_DeviceModeInd_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DeviceModeInd.Equal(that1.DeviceModeInd) {
+	if this.RejoinType != that1.RejoinType {
+		return false
+	}
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	if this.MaxRetries != that1.MaxRetries {
+		return false
+	}
+	if this.PeriodExponent != that1.PeriodExponent {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DeviceModeConf_) Equal(that interface{}) bool {
+func (this *MACCommand_RejoinParamSetupReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceModeConf_)
+	that1, ok := that.(*MACCommand_RejoinParamSetupReq)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceModeConf_)
+		that2, ok := that.(MACCommand_RejoinParamSetupReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6813,19 +9026,22 @@ func (this *MACCommand_DeviceModeConf_) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.DeviceModeConf.Equal(that1.DeviceModeConf) {
+	if this.MaxCountExponent != that1.MaxCountExponent {
+		return false
+	}
+	if this.MaxTimeExponent != that1.MaxTimeExponent {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ResetInd) Equal(that interface{}) bool {
+func (this *MACCommand_RejoinParamSetupAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ResetInd)
+	that1, ok := that.(*MACCommand_RejoinParamSetupAns)
 	if !ok {
-		that2, ok := that.(MACCommand_ResetInd)
+		that2, ok := that.(MACCommand_RejoinParamSetupAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6837,19 +9053,19 @@ func (this *MACCommand_ResetInd) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MinorVersion != that1.MinorVersion {
+	if this.MaxTimeExponentAck != that1.MaxTimeExponentAck {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ResetConf) Equal(that interface{}) bool {
+func (this *MACCommand_PingSlotInfoReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ResetConf)
+	that1, ok := that.(*MACCommand_PingSlotInfoReq)
 	if !ok {
-		that2, ok := that.(MACCommand_ResetConf)
+		that2, ok := that.(MACCommand_PingSlotInfoReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6861,19 +9077,19 @@ func (this *MACCommand_ResetConf) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MinorVersion != that1.MinorVersion {
+	if this.Period != that1.Period {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkCheckAns) Equal(that interface{}) bool {
+func (this *MACCommand_PingSlotChannelReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkCheckAns)
+	that1, ok := that.(*MACCommand_PingSlotChannelReq)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkCheckAns)
+		that2, ok := that.(MACCommand_PingSlotChannelReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6885,22 +9101,22 @@ func (this *MACCommand_LinkCheckAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Margin != that1.Margin {
+	if this.Frequency != that1.Frequency {
 		return false
 	}
-	if this.GatewayCount != that1.GatewayCount {
+	if this.DataRateIndex != that1.DataRateIndex {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkADRReq) Equal(that interface{}) bool {
+func (this *MACCommand_PingSlotChannelAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkADRReq)
+	that1, ok := that.(*MACCommand_PingSlotChannelAns)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkADRReq)
+		that2, ok := that.(MACCommand_PingSlotChannelAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6912,36 +9128,22 @@ func (this *MACCommand_LinkADRReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.DataRateIndex != that1.DataRateIndex {
-		return false
-	}
-	if this.TxPowerIndex != that1.TxPowerIndex {
-		return false
-	}
-	if len(this.ChannelMask) != len(that1.ChannelMask) {
-		return false
-	}
-	for i := range this.ChannelMask {
-		if this.ChannelMask[i] != that1.ChannelMask[i] {
-			return false
-		}
-	}
-	if this.ChannelMaskControl != that1.ChannelMaskControl {
+	if this.FrequencyAck != that1.FrequencyAck {
 		return false
 	}
-	if this.NbTrans != that1.NbTrans {
+	if this.DataRateIndexAck != that1.DataRateIndexAck {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_LinkADRAns) Equal(that interface{}) bool {
+func (this *MACCommand_BeaconTimingAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_LinkADRAns)
+	that1, ok := that.(*MACCommand_BeaconTimingAns)
 	if !ok {
-		that2, ok := that.(MACCommand_LinkADRAns)
+		that2, ok := that.(MACCommand_BeaconTimingAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6953,25 +9155,22 @@ func (this *MACCommand_LinkADRAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ChannelMaskAck != that1.ChannelMaskAck {
-		return false
-	}
-	if this.DataRateIndexAck != that1.DataRateIndexAck {
+	if this.Delay != that1.Delay {
 		return false
 	}
-	if this.TxPowerIndexAck != that1.TxPowerIndexAck {
+	if this.ChannelIndex != that1.ChannelIndex {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DutyCycleReq) Equal(that interface{}) bool {
+func (this *MACCommand_BeaconFreqReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DutyCycleReq)
+	that1, ok := that.(*MACCommand_BeaconFreqReq)
 	if !ok {
-		that2, ok := that.(MACCommand_DutyCycleReq)
+		that2, ok := that.(MACCommand_BeaconFreqReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6983,19 +9182,19 @@ func (this *MACCommand_DutyCycleReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MaxDutyCycle != that1.MaxDutyCycle {
+	if this.Frequency != that1.Frequency {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RxParamSetupReq) Equal(that interface{}) bool {
+func (this *MACCommand_BeaconFreqAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxParamSetupReq)
+	that1, ok := that.(*MACCommand_BeaconFreqAns)
 	if !ok {
-		that2, ok := that.(MACCommand_RxParamSetupReq)
+		that2, ok := that.(MACCommand_BeaconFreqAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7007,25 +9206,19 @@ func (this *MACCommand_RxParamSetupReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Rx2DataRateIndex != that1.Rx2DataRateIndex {
-		return false
-	}
-	if this.Rx1DataRateOffset != that1.Rx1DataRateOffset {
-		return false
-	}
-	if this.Rx2Frequency != that1.Rx2Frequency {
+	if this.FrequencyAck != that1.FrequencyAck {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RxParamSetupAns) Equal(that interface{}) bool {
+func (this *MACCommand_DeviceModeInd) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxParamSetupAns)
+	that1, ok := that.(*MACCommand_DeviceModeInd)
 	if !ok {
-		that2, ok := that.(MACCommand_RxParamSetupAns)
+		that2, ok := that.(MACCommand_DeviceModeInd)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7037,25 +9230,19 @@ func (this *MACCommand_RxParamSetupAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Rx2DataRateIndexAck != that1.Rx2DataRateIndexAck {
-		return false
-	}
-	if this.Rx1DataRateOffsetAck != that1.Rx1DataRateOffsetAck {
-		return false
-	}
-	if this.Rx2FrequencyAck != that1.Rx2FrequencyAck {
+	if this.Class != that1.Class {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DevStatusAns) Equal(that interface{}) bool {
+func (this *MACCommand_DeviceModeConf) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DevStatusAns)
+	that1, ok := that.(*MACCommand_DeviceModeConf)
 	if !ok {
-		that2, ok := that.(MACCommand_DevStatusAns)
+		that2, ok := that.(MACCommand_DeviceModeConf)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7067,22 +9254,19 @@ func (this *MACCommand_DevStatusAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Battery != that1.Battery {
-		return false
-	}
-	if this.Margin != that1.Margin {
+	if this.Class != that1.Class {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_NewChannelReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayConfReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_NewChannelReq)
+	that1, ok := that.(*MACCommand_RelayConfReq)
 	if !ok {
-		that2, ok := that.(MACCommand_NewChannelReq)
+		that2, ok := that.(MACCommand_RelayConfReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7094,28 +9278,19 @@ func (this *MACCommand_NewChannelReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ChannelIndex != that1.ChannelIndex {
-		return false
-	}
-	if this.Frequency != that1.Frequency {
-		return false
-	}
-	if this.MinDataRateIndex != that1.MinDataRateIndex {
-		return false
-	}
-	if this.MaxDataRateIndex != that1.MaxDataRateIndex {
+	if !this.Configuration.Equal(that1.Configuration) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_NewChannelAns) Equal(that interface{}) bool {
+func (this *MACCommand_RelayConfReq_Configuration) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_NewChannelAns)
+	that1, ok := that.(*MACCommand_RelayConfReq_Configuration)
 	if !ok {
-		that2, ok := that.(MACCommand_NewChannelAns)
+		that2, ok := that.(MACCommand_RelayConfReq_Configuration)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7127,22 +9302,25 @@ func (this *MACCommand_NewChannelAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.FrequencyAck != that1.FrequencyAck {
+	if !this.SecondChannel.Equal(that1.SecondChannel) {
 		return false
 	}
-	if this.DataRateAck != that1.DataRateAck {
+	if this.DefaultChannelIndex != that1.DefaultChannelIndex {
+		return false
+	}
+	if this.CadPeriodicity != that1.CadPeriodicity {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DLChannelReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayConfAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DLChannelReq)
+	that1, ok := that.(*MACCommand_RelayConfAns)
 	if !ok {
-		that2, ok := that.(MACCommand_DLChannelReq)
+		that2, ok := that.(MACCommand_RelayConfAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7154,22 +9332,34 @@ func (this *MACCommand_DLChannelReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ChannelIndex != that1.ChannelIndex {
+	if this.SecondChannelFrequencyAck != that1.SecondChannelFrequencyAck {
 		return false
 	}
-	if this.Frequency != that1.Frequency {
+	if this.SecondChannelAckOffsetAck != that1.SecondChannelAckOffsetAck {
+		return false
+	}
+	if this.SecondChannelDataRateIndexAck != that1.SecondChannelDataRateIndexAck {
+		return false
+	}
+	if this.SecondChannelIndexAck != that1.SecondChannelIndexAck {
+		return false
+	}
+	if this.DefaultChannelIndexAck != that1.DefaultChannelIndexAck {
+		return false
+	}
+	if this.CadPeriodicityAck != that1.CadPeriodicityAck {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DLChannelAns) Equal(that interface{}) bool {
+func (this *MACCommand_RelayEndDeviceConfReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DLChannelAns)
+	that1, ok := that.(*MACCommand_RelayEndDeviceConfReq)
 	if !ok {
-		that2, ok := that.(MACCommand_DLChannelAns)
+		that2, ok := that.(MACCommand_RelayEndDeviceConfReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7181,22 +9371,19 @@ func (this *MACCommand_DLChannelAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ChannelIndexAck != that1.ChannelIndexAck {
-		return false
-	}
-	if this.FrequencyAck != that1.FrequencyAck {
+	if !this.Configuration.Equal(that1.Configuration) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RxTimingSetupReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayEndDeviceConfReq_Configuration) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RxTimingSetupReq)
+	that1, ok := that.(*MACCommand_RelayEndDeviceConfReq_Configuration)
 	if !ok {
-		that2, ok := that.(MACCommand_RxTimingSetupReq)
+		that2, ok := that.(MACCommand_RelayEndDeviceConfReq_Configuration)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7208,19 +9395,31 @@ func (this *MACCommand_RxTimingSetupReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Delay != that1.Delay {
+	if that1.Mode == nil {
+		if this.Mode != nil {
+			return false
+		}
+	} else if this.Mode == nil {
+		return false
+	} else if !this.Mode.Equal(that1.Mode) {
+		return false
+	}
+	if this.Backoff != that1.Backoff {
+		return false
+	}
+	if !this.SecondChannel.Equal(that1.SecondChannel) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_TxParamSetupReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayEndDeviceConfReq_Configuration_Always) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_TxParamSetupReq)
+	that1, ok := that.(*MACCommand_RelayEndDeviceConfReq_Configuration_Always)
 	if !ok {
-		that2, ok := that.(MACCommand_TxParamSetupReq)
+		that2, ok := that.(MACCommand_RelayEndDeviceConfReq_Configuration_Always)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7232,25 +9431,19 @@ func (this *MACCommand_TxParamSetupReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MaxEirpIndex != that1.MaxEirpIndex {
-		return false
-	}
-	if this.UplinkDwellTime != that1.UplinkDwellTime {
-		return false
-	}
-	if this.DownlinkDwellTime != that1.DownlinkDwellTime {
+	if !this.Always.Equal(that1.Always) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RekeyInd) Equal(that interface{}) bool {
+func (this *MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RekeyInd)
+	that1, ok := that.(*MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic)
 	if !ok {
-		that2, ok := that.(MACCommand_RekeyInd)
+		that2, ok := that.(MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7262,19 +9455,19 @@ func (this *MACCommand_RekeyInd) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MinorVersion != that1.MinorVersion {
+	if !this.Dynamic.Equal(that1.Dynamic) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RekeyConf) Equal(that interface{}) bool {
+func (this *MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RekeyConf)
+	that1, ok := that.(*MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled)
 	if !ok {
-		that2, ok := that.(MACCommand_RekeyConf)
+		that2, ok := that.(MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7286,19 +9479,19 @@ func (this *MACCommand_RekeyConf) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MinorVersion != that1.MinorVersion {
+	if !this.EndDeviceControlled.Equal(that1.EndDeviceControlled) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ADRParamSetupReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayEndDeviceConfAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ADRParamSetupReq)
+	that1, ok := that.(*MACCommand_RelayEndDeviceConfAns)
 	if !ok {
-		that2, ok := that.(MACCommand_ADRParamSetupReq)
+		that2, ok := that.(MACCommand_RelayEndDeviceConfAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7310,22 +9503,28 @@ func (this *MACCommand_ADRParamSetupReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.AdrAckLimitExponent != that1.AdrAckLimitExponent {
+	if this.SecondChannelFrequencyAck != that1.SecondChannelFrequencyAck {
 		return false
 	}
-	if this.AdrAckDelayExponent != that1.AdrAckDelayExponent {
+	if this.SecondChannelDataRateIndexAck != that1.SecondChannelDataRateIndexAck {
+		return false
+	}
+	if this.SecondChannelIndexAck != that1.SecondChannelIndexAck {
+		return false
+	}
+	if this.BackoffAck != that1.BackoffAck {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DeviceTimeAns) Equal(that interface{}) bool {
+func (this *MACCommand_RelayFilterListReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceTimeAns)
+	that1, ok := that.(*MACCommand_RelayFilterListReq)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceTimeAns)
+		that2, ok := that.(MACCommand_RelayFilterListReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7337,19 +9536,28 @@ func (this *MACCommand_DeviceTimeAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Time.Equal(that1.Time) {
+	if this.RuleIndex != that1.RuleIndex {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	if !this.JoinEui.Equal(that1.JoinEui) {
+		return false
+	}
+	if !this.DevEui.Equal(that1.DevEui) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_ForceRejoinReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayFilterListAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_ForceRejoinReq)
+	that1, ok := that.(*MACCommand_RelayFilterListAns)
 	if !ok {
-		that2, ok := that.(MACCommand_ForceRejoinReq)
+		that2, ok := that.(MACCommand_RelayFilterListAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7361,28 +9569,25 @@ func (this *MACCommand_ForceRejoinReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.RejoinType != that1.RejoinType {
-		return false
-	}
-	if this.DataRateIndex != that1.DataRateIndex {
+	if this.ActionAck != that1.ActionAck {
 		return false
 	}
-	if this.MaxRetries != that1.MaxRetries {
+	if this.RuleIndexAck != that1.RuleIndexAck {
 		return false
 	}
-	if this.PeriodExponent != that1.PeriodExponent {
+	if this.CombinedRulesAck != that1.CombinedRulesAck {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RejoinParamSetupReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayUpdateUplinkListReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RejoinParamSetupReq)
+	that1, ok := that.(*MACCommand_RelayUpdateUplinkListReq)
 	if !ok {
-		that2, ok := that.(MACCommand_RejoinParamSetupReq)
+		that2, ok := that.(MACCommand_RelayUpdateUplinkListReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7394,22 +9599,31 @@ func (this *MACCommand_RejoinParamSetupReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MaxCountExponent != that1.MaxCountExponent {
+	if this.RuleIndex != that1.RuleIndex {
 		return false
 	}
-	if this.MaxTimeExponent != that1.MaxTimeExponent {
+	if !this.ForwardLimits.Equal(that1.ForwardLimits) {
+		return false
+	}
+	if !this.DevAddr.Equal(that1.DevAddr) {
+		return false
+	}
+	if this.WFCnt != that1.WFCnt {
+		return false
+	}
+	if !this.RootWorSKey.Equal(that1.RootWorSKey) {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_RejoinParamSetupAns) Equal(that interface{}) bool {
+func (this *MACCommand_RelayCtrlUplinkListReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_RejoinParamSetupAns)
+	that1, ok := that.(*MACCommand_RelayCtrlUplinkListReq)
 	if !ok {
-		that2, ok := that.(MACCommand_RejoinParamSetupAns)
+		that2, ok := that.(MACCommand_RelayCtrlUplinkListReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7421,19 +9635,22 @@ func (this *MACCommand_RejoinParamSetupAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.MaxTimeExponentAck != that1.MaxTimeExponentAck {
+	if this.RuleIndex != that1.RuleIndex {
+		return false
+	}
+	if this.Action != that1.Action {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotInfoReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayCtrlUplinkListAns) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotInfoReq)
+	that1, ok := that.(*MACCommand_RelayCtrlUplinkListAns)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotInfoReq)
+		that2, ok := that.(MACCommand_RelayCtrlUplinkListAns)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7445,19 +9662,22 @@ func (this *MACCommand_PingSlotInfoReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Period != that1.Period {
+	if this.RuleIndexAck != that1.RuleIndexAck {
+		return false
+	}
+	if this.WFCnt != that1.WFCnt {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotChannelReq) Equal(that interface{}) bool {
+func (this *MACCommand_RelayNotifyNewEndDeviceReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotChannelReq)
+	that1, ok := that.(*MACCommand_RelayNotifyNewEndDeviceReq)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotChannelReq)
+		that2, ok := that.(MACCommand_RelayNotifyNewEndDeviceReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7469,22 +9689,25 @@ func (this *MACCommand_PingSlotChannelReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Frequency != that1.Frequency {
+	if !this.DevAddr.Equal(that1.DevAddr) {
 		return false
 	}
-	if this.DataRateIndex != that1.DataRateIndex {
+	if this.Snr != that1.Snr {
+		return false
+	}
+	if this.Rssi != that1.Rssi {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_PingSlotChannelAns) Equal(that interface{}) bool {
+func (this *RelaySecondChannel) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_PingSlotChannelAns)
+	that1, ok := that.(*RelaySecondChannel)
 	if !ok {
-		that2, ok := that.(MACCommand_PingSlotChannelAns)
+		that2, ok := that.(RelaySecondChannel)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7496,22 +9719,25 @@ func (this *MACCommand_PingSlotChannelAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.FrequencyAck != that1.FrequencyAck {
+	if this.AckOffset != that1.AckOffset {
+		return false
+	}
+	if this.DataRateIndex != that1.DataRateIndex {
 		return false
 	}
-	if this.DataRateIndexAck != that1.DataRateIndexAck {
+	if this.Frequency != that1.Frequency {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_BeaconTimingAns) Equal(that interface{}) bool {
+func (this *RelayEndDeviceAlwaysMode) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconTimingAns)
+	that1, ok := that.(*RelayEndDeviceAlwaysMode)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconTimingAns)
+		that2, ok := that.(RelayEndDeviceAlwaysMode)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7523,22 +9749,16 @@ func (this *MACCommand_BeaconTimingAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Delay != that1.Delay {
-		return false
-	}
-	if this.ChannelIndex != that1.ChannelIndex {
-		return false
-	}
 	return true
 }
-func (this *MACCommand_BeaconFreqReq) Equal(that interface{}) bool {
+func (this *RelayEndDeviceDynamicMode) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconFreqReq)
+	that1, ok := that.(*RelayEndDeviceDynamicMode)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconFreqReq)
+		that2, ok := that.(RelayEndDeviceDynamicMode)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7550,19 +9770,19 @@ func (this *MACCommand_BeaconFreqReq) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Frequency != that1.Frequency {
+	if this.SmartEnableLevel != that1.SmartEnableLevel {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_BeaconFreqAns) Equal(that interface{}) bool {
+func (this *RelayEndDeviceControlledMode) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_BeaconFreqAns)
+	that1, ok := that.(*RelayEndDeviceControlledMode)
 	if !ok {
-		that2, ok := that.(MACCommand_BeaconFreqAns)
+		that2, ok := that.(RelayEndDeviceControlledMode)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7574,19 +9794,16 @@ func (this *MACCommand_BeaconFreqAns) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.FrequencyAck != that1.FrequencyAck {
-		return false
-	}
 	return true
 }
-func (this *MACCommand_DeviceModeInd) Equal(that interface{}) bool {
+func (this *RelayForwardLimits) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceModeInd)
+	that1, ok := that.(*RelayForwardLimits)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceModeInd)
+		that2, ok := that.(RelayForwardLimits)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7598,19 +9815,22 @@ func (this *MACCommand_DeviceModeInd) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Class != that1.Class {
+	if this.BucketSize != that1.BucketSize {
+		return false
+	}
+	if this.ReloadRate != that1.ReloadRate {
 		return false
 	}
 	return true
 }
-func (this *MACCommand_DeviceModeConf) Equal(that interface{}) bool {
+func (this *RelayForwardDownlinkReq) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MACCommand_DeviceModeConf)
+	that1, ok := that.(*RelayForwardDownlinkReq)
 	if !ok {
-		that2, ok := that.(MACCommand_DeviceModeConf)
+		that2, ok := that.(RelayForwardDownlinkReq)
 		if ok {
 			that1 = &that2
 		} else {
@@ -7622,7 +9842,7 @@ func (this *MACCommand_DeviceModeConf) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Class != that1.Class {
+	if !bytes.Equal(this.RawPayload, that1.RawPayload) {
 		return false
 	}
 	return true
@@ -8515,6 +10735,106 @@ func (this *MACCommand_DeviceModeConf_) String() string {
 	}, "")
 	return s
 }
+func (this *MACCommand_RelayConfReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfReq_{`,
+		`RelayConfReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayConfReq), "MACCommand_RelayConfReq", "MACCommand_RelayConfReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayConfAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfAns_{`,
+		`RelayConfAns:` + strings.Replace(fmt.Sprintf("%v", this.RelayConfAns), "MACCommand_RelayConfAns", "MACCommand_RelayConfAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayEndDeviceConfReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayEndDeviceConfReq_{`,
+		`RelayEndDeviceConfReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayEndDeviceConfReq), "MACCommand_RelayEndDeviceConfReq", "MACCommand_RelayEndDeviceConfReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayEndDeviceConfAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayEndDeviceConfAns_{`,
+		`RelayEndDeviceConfAns:` + strings.Replace(fmt.Sprintf("%v", this.RelayEndDeviceConfAns), "MACCommand_RelayEndDeviceConfAns", "MACCommand_RelayEndDeviceConfAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayFilterListReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayFilterListReq_{`,
+		`RelayFilterListReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayFilterListReq), "MACCommand_RelayFilterListReq", "MACCommand_RelayFilterListReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayFilterListAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayFilterListAns_{`,
+		`RelayFilterListAns:` + strings.Replace(fmt.Sprintf("%v", this.RelayFilterListAns), "MACCommand_RelayFilterListAns", "MACCommand_RelayFilterListAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayUpdateUplinkListReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayUpdateUplinkListReq_{`,
+		`RelayUpdateUplinkListReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayUpdateUplinkListReq), "MACCommand_RelayUpdateUplinkListReq", "MACCommand_RelayUpdateUplinkListReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayCtrlUplinkListReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayCtrlUplinkListReq_{`,
+		`RelayCtrlUplinkListReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayCtrlUplinkListReq), "MACCommand_RelayCtrlUplinkListReq", "MACCommand_RelayCtrlUplinkListReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayCtrlUplinkListAns_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayCtrlUplinkListAns_{`,
+		`RelayCtrlUplinkListAns:` + strings.Replace(fmt.Sprintf("%v", this.RelayCtrlUplinkListAns), "MACCommand_RelayCtrlUplinkListAns", "MACCommand_RelayCtrlUplinkListAns", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayNotifyNewEndDeviceReq_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayNotifyNewEndDeviceReq_{`,
+		`RelayNotifyNewEndDeviceReq:` + strings.Replace(fmt.Sprintf("%v", this.RelayNotifyNewEndDeviceReq), "MACCommand_RelayNotifyNewEndDeviceReq", "MACCommand_RelayNotifyNewEndDeviceReq", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MACCommand_ResetInd) String() string {
 	if this == nil {
 		return "nil"
@@ -8843,6 +11163,242 @@ func (this *MACCommand_DeviceModeConf) String() string {
 	}, "")
 	return s
 }
+func (this *MACCommand_RelayConfReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfReq{`,
+		`Configuration:` + strings.Replace(fmt.Sprintf("%v", this.Configuration), "MACCommand_RelayConfReq_Configuration", "MACCommand_RelayConfReq_Configuration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayConfReq_Configuration) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfReq_Configuration{`,
+		`SecondChannel:` + strings.Replace(this.SecondChannel.String(), "RelaySecondChannel", "RelaySecondChannel", 1) + `,`,
+		`DefaultChannelIndex:` + fmt.Sprintf("%v", this.DefaultChannelIndex) + `,`,
+		`CadPeriodicity:` + fmt.Sprintf("%v", this.CadPeriodicity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayConfAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayConfAns{`,
+		`SecondChannelFrequencyAck:` + fmt.Sprintf("%v", this.SecondChannelFrequencyAck) + `,`,
+		`SecondChannelAckOffsetAck:` + fmt.Sprintf("%v", this.SecondChannelAckOffsetAck) + `,`,
+		`SecondChannelDataRateIndexAck:` + fmt.Sprintf("%v", this.SecondChannelDataRateIndexAck) + `,`,
+		`SecondChannelIndexAck:` + fmt.Sprintf("%v", this.SecondChannelIndexAck) + `,`,
+		`DefaultChannelIndexAck:` + fmt.Sprintf("%v", this.DefaultChannelIndexAck) + `,`,
+		`CadPeriodicityAck:` + fmt.Sprintf("%v", this.CadPeriodicityAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayEndDeviceConfReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayEndDeviceConfReq{`,
+		`Configuration:` + strings.Replace(fmt.Sprintf("%v", this.Configuration), "MACCommand_RelayEndDeviceConfReq_Configuration", "MACCommand_RelayEndDeviceConfReq_Configuration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayEndDeviceConfReq_Configuration) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayEndDeviceConfReq_Configuration{`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`Backoff:` + fmt.Sprintf("%v", this.Backoff) + `,`,
+		`SecondChannel:` + strings.Replace(this.SecondChannel.String(), "RelaySecondChannel", "RelaySecondChannel", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayEndDeviceConfReq_Configuration_Always) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayEndDeviceConfReq_Configuration_Always{`,
+		`Always:` + strings.Replace(fmt.Sprintf("%v", this.Always), "RelayEndDeviceAlwaysMode", "RelayEndDeviceAlwaysMode", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic{`,
+		`Dynamic:` + strings.Replace(fmt.Sprintf("%v", this.Dynamic), "RelayEndDeviceDynamicMode", "RelayEndDeviceDynamicMode", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled{`,
+		`EndDeviceControlled:` + strings.Replace(fmt.Sprintf("%v", this.EndDeviceControlled), "RelayEndDeviceControlledMode", "RelayEndDeviceControlledMode", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayEndDeviceConfAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayEndDeviceConfAns{`,
+		`SecondChannelFrequencyAck:` + fmt.Sprintf("%v", this.SecondChannelFrequencyAck) + `,`,
+		`SecondChannelDataRateIndexAck:` + fmt.Sprintf("%v", this.SecondChannelDataRateIndexAck) + `,`,
+		`SecondChannelIndexAck:` + fmt.Sprintf("%v", this.SecondChannelIndexAck) + `,`,
+		`BackoffAck:` + fmt.Sprintf("%v", this.BackoffAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayFilterListReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayFilterListReq{`,
+		`RuleIndex:` + fmt.Sprintf("%v", this.RuleIndex) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`JoinEui:` + fmt.Sprintf("%v", this.JoinEui) + `,`,
+		`DevEui:` + fmt.Sprintf("%v", this.DevEui) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayFilterListAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayFilterListAns{`,
+		`ActionAck:` + fmt.Sprintf("%v", this.ActionAck) + `,`,
+		`RuleIndexAck:` + fmt.Sprintf("%v", this.RuleIndexAck) + `,`,
+		`CombinedRulesAck:` + fmt.Sprintf("%v", this.CombinedRulesAck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayUpdateUplinkListReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayUpdateUplinkListReq{`,
+		`RuleIndex:` + fmt.Sprintf("%v", this.RuleIndex) + `,`,
+		`ForwardLimits:` + strings.Replace(this.ForwardLimits.String(), "RelayForwardLimits", "RelayForwardLimits", 1) + `,`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`WFCnt:` + fmt.Sprintf("%v", this.WFCnt) + `,`,
+		`RootWorSKey:` + fmt.Sprintf("%v", this.RootWorSKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayCtrlUplinkListReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayCtrlUplinkListReq{`,
+		`RuleIndex:` + fmt.Sprintf("%v", this.RuleIndex) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayCtrlUplinkListAns) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayCtrlUplinkListAns{`,
+		`RuleIndexAck:` + fmt.Sprintf("%v", this.RuleIndexAck) + `,`,
+		`WFCnt:` + fmt.Sprintf("%v", this.WFCnt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACCommand_RelayNotifyNewEndDeviceReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACCommand_RelayNotifyNewEndDeviceReq{`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`Snr:` + fmt.Sprintf("%v", this.Snr) + `,`,
+		`Rssi:` + fmt.Sprintf("%v", this.Rssi) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelaySecondChannel) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelaySecondChannel{`,
+		`AckOffset:` + fmt.Sprintf("%v", this.AckOffset) + `,`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayEndDeviceAlwaysMode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayEndDeviceAlwaysMode{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayEndDeviceDynamicMode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayEndDeviceDynamicMode{`,
+		`SmartEnableLevel:` + fmt.Sprintf("%v", this.SmartEnableLevel) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayEndDeviceControlledMode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayEndDeviceControlledMode{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayForwardLimits) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayForwardLimits{`,
+		`BucketSize:` + fmt.Sprintf("%v", this.BucketSize) + `,`,
+		`ReloadRate:` + fmt.Sprintf("%v", this.ReloadRate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayForwardDownlinkReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayForwardDownlinkReq{`,
+		`RawPayload:` + fmt.Sprintf("%v", this.RawPayload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *FrequencyValue) String() string {
 	if this == nil {
 		return "nil"
@@ -432,6 +432,65 @@ var MACCommandFieldPathsNested = []string{
 	"payload.rekey_conf.minor_version",
 	"payload.rekey_ind",
 	"payload.rekey_ind.minor_version",
+	"payload.relay_conf_ans",
+	"payload.relay_conf_ans.cad_periodicity_ack",
+	"payload.relay_conf_ans.default_channel_index_ack",
+	"payload.relay_conf_ans.second_channel_ack_offset_ack",
+	"payload.relay_conf_ans.second_channel_data_rate_index_ack",
+	"payload.relay_conf_ans.second_channel_frequency_ack",
+	"payload.relay_conf_ans.second_channel_index_ack",
+	"payload.relay_conf_req",
+	"payload.relay_conf_req.configuration",
+	"payload.relay_conf_req.configuration.cad_periodicity",
+	"payload.relay_conf_req.configuration.default_channel_index",
+	"payload.relay_conf_req.configuration.second_channel",
+	"payload.relay_conf_req.configuration.second_channel.ack_offset",
+	"payload.relay_conf_req.configuration.second_channel.data_rate_index",
+	"payload.relay_conf_req.configuration.second_channel.frequency",
+	"payload.relay_ctrl_uplink_list_ans",
+	"payload.relay_ctrl_uplink_list_ans.rule_index_ack",
+	"payload.relay_ctrl_uplink_list_ans.w_f_cnt",
+	"payload.relay_ctrl_uplink_list_req",
+	"payload.relay_ctrl_uplink_list_req.action",
+	"payload.relay_ctrl_uplink_list_req.rule_index",
+	"payload.relay_end_device_conf_ans",
+	"payload.relay_end_device_conf_ans.backoff_ack",
+	"payload.relay_end_device_conf_ans.second_channel_data_rate_index_ack",
+	"payload.relay_end_device_conf_ans.second_channel_frequency_ack",
+	"payload.relay_end_device_conf_ans.second_channel_index_ack",
+	"payload.relay_end_device_conf_req",
+	"payload.relay_end_device_conf_req.configuration",
+	"payload.relay_end_device_conf_req.configuration.backoff",
+	"payload.relay_end_device_conf_req.configuration.mode",
+	"payload.relay_end_device_conf_req.configuration.mode.always",
+	"payload.relay_end_device_conf_req.configuration.mode.dynamic",
+	"payload.relay_end_device_conf_req.configuration.mode.dynamic.smart_enable_level",
+	"payload.relay_end_device_conf_req.configuration.mode.end_device_controlled",
+	"payload.relay_end_device_conf_req.configuration.second_channel",
+	"payload.relay_end_device_conf_req.configuration.second_channel.ack_offset",
+	"payload.relay_end_device_conf_req.configuration.second_channel.data_rate_index",
+	"payload.relay_end_device_conf_req.configuration.second_channel.frequency",
+	"payload.relay_filter_list_ans",
+	"payload.relay_filter_list_ans.action_ack",
+	"payload.relay_filter_list_ans.combined_rules_ack",
+	"payload.relay_filter_list_ans.rule_index_ack",
+	"payload.relay_filter_list_req",
+	"payload.relay_filter_list_req.action",
+	"payload.relay_filter_list_req.dev_eui",
+	"payload.relay_filter_list_req.join_eui",
+	"payload.relay_filter_list_req.rule_index",
+	"payload.relay_notify_new_end_device_req",
+	"payload.relay_notify_new_end_device_req.dev_addr",
+	"payload.relay_notify_new_end_device_req.rssi",
+	"payload.relay_notify_new_end_device_req.snr",
+	"payload.relay_update_uplink_list_req",
+	"payload.relay_update_uplink_list_req.dev_addr",
+	"payload.relay_update_uplink_list_req.forward_limits",
+	"payload.relay_update_uplink_list_req.forward_limits.bucket_size",
+	"payload.relay_update_uplink_list_req.forward_limits.reload_rate",
+	"payload.relay_update_uplink_list_req.root_wor_s_key",
+	"payload.relay_update_uplink_list_req.rule_index",
+	"payload.relay_update_uplink_list_req.w_f_cnt",
 	"payload.reset_conf",
 	"payload.reset_conf.minor_version",
 	"payload.reset_ind",
@@ -456,6 +515,44 @@ var MACCommandFieldPathsTopLevel = []string{
 	"cid",
 	"payload",
 }
+var RelaySecondChannelFieldPathsNested = []string{
+	"ack_offset",
+	"data_rate_index",
+	"frequency",
+}
+
+var RelaySecondChannelFieldPathsTopLevel = []string{
+	"ack_offset",
+	"data_rate_index",
+	"frequency",
+}
+var RelayEndDeviceAlwaysModeFieldPathsNested []string
+var RelayEndDeviceAlwaysModeFieldPathsTopLevel []string
+var RelayEndDeviceDynamicModeFieldPathsNested = []string{
+	"smart_enable_level",
+}
+
+var RelayEndDeviceDynamicModeFieldPathsTopLevel = []string{
+	"smart_enable_level",
+}
+var RelayEndDeviceControlledModeFieldPathsNested []string
+var RelayEndDeviceControlledModeFieldPathsTopLevel []string
+var RelayForwardLimitsFieldPathsNested = []string{
+	"bucket_size",
+	"reload_rate",
+}
+
+var RelayForwardLimitsFieldPathsTopLevel = []string{
+	"bucket_size",
+	"reload_rate",
+}
+var RelayForwardDownlinkReqFieldPathsNested = []string{
+	"raw_payload",
+}
+
+var RelayForwardDownlinkReqFieldPathsTopLevel = []string{
+	"raw_payload",
+}
 var FrequencyValueFieldPathsNested = []string{
 	"value",
 }
@@ -796,3 +893,165 @@ var MACCommand_DeviceModeConfFieldPathsNested = []string{
 var MACCommand_DeviceModeConfFieldPathsTopLevel = []string{
 	"class",
 }
+var MACCommand_RelayConfReqFieldPathsNested = []string{
+	"configuration",
+	"configuration.cad_periodicity",
+	"configuration.default_channel_index",
+	"configuration.second_channel",
+	"configuration.second_channel.ack_offset",
+	"configuration.second_channel.data_rate_index",
+	"configuration.second_channel.frequency",
+}
+
+var MACCommand_RelayConfReqFieldPathsTopLevel = []string{
+	"configuration",
+}
+var MACCommand_RelayConfAnsFieldPathsNested = []string{
+	"cad_periodicity_ack",
+	"default_channel_index_ack",
+	"second_channel_ack_offset_ack",
+	"second_channel_data_rate_index_ack",
+	"second_channel_frequency_ack",
+	"second_channel_index_ack",
+}
+
+var MACCommand_RelayConfAnsFieldPathsTopLevel = []string{
+	"cad_periodicity_ack",
+	"default_channel_index_ack",
+	"second_channel_ack_offset_ack",
+	"second_channel_data_rate_index_ack",
+	"second_channel_frequency_ack",
+	"second_channel_index_ack",
+}
+var MACCommand_RelayEndDeviceConfReqFieldPathsNested = []string{
+	"configuration",
+	"configuration.backoff",
+	"configuration.mode",
+	"configuration.mode.always",
+	"configuration.mode.dynamic",
+	"configuration.mode.dynamic.smart_enable_level",
+	"configuration.mode.end_device_controlled",
+	"configuration.second_channel",
+	"configuration.second_channel.ack_offset",
+	"configuration.second_channel.data_rate_index",
+	"configuration.second_channel.frequency",
+}
+
+var MACCommand_RelayEndDeviceConfReqFieldPathsTopLevel = []string{
+	"configuration",
+}
+var MACCommand_RelayEndDeviceConfAnsFieldPathsNested = []string{
+	"backoff_ack",
+	"second_channel_data_rate_index_ack",
+	"second_channel_frequency_ack",
+	"second_channel_index_ack",
+}
+
+var MACCommand_RelayEndDeviceConfAnsFieldPathsTopLevel = []string{
+	"backoff_ack",
+	"second_channel_data_rate_index_ack",
+	"second_channel_frequency_ack",
+	"second_channel_index_ack",
+}
+var MACCommand_RelayFilterListReqFieldPathsNested = []string{
+	"action",
+	"dev_eui",
+	"join_eui",
+	"rule_index",
+}
+
+var MACCommand_RelayFilterListReqFieldPathsTopLevel = []string{
+	"action",
+	"dev_eui",
+	"join_eui",
+	"rule_index",
+}
+var MACCommand_RelayFilterListAnsFieldPathsNested = []string{
+	"action_ack",
+	"combined_rules_ack",
+	"rule_index_ack",
+}
+
+var MACCommand_RelayFilterListAnsFieldPathsTopLevel = []string{
+	"action_ack",
+	"combined_rules_ack",
+	"rule_index_ack",
+}
+var MACCommand_RelayUpdateUplinkListReqFieldPathsNested = []string{
+	"dev_addr",
+	"forward_limits",
+	"forward_limits.bucket_size",
+	"forward_limits.reload_rate",
+	"root_wor_s_key",
+	"rule_index",
+	"w_f_cnt",
+}
+
+var MACCommand_RelayUpdateUplinkListReqFieldPathsTopLevel = []string{
+	"dev_addr",
+	"forward_limits",
+	"root_wor_s_key",
+	"rule_index",
+	"w_f_cnt",
+}
+var MACCommand_RelayCtrlUplinkListReqFieldPathsNested = []string{
+	"action",
+	"rule_index",
+}
+
+var MACCommand_RelayCtrlUplinkListReqFieldPathsTopLevel = []string{
+	"action",
+	"rule_index",
+}
+var MACCommand_RelayCtrlUplinkListAnsFieldPathsNested = []string{
+	"rule_index_ack",
+	"w_f_cnt",
+}
+
+var MACCommand_RelayCtrlUplinkListAnsFieldPathsTopLevel = []string{
+	"rule_index_ack",
+	"w_f_cnt",
+}
+var MACCommand_RelayNotifyNewEndDeviceReqFieldPathsNested = []string{
+	"dev_addr",
+	"rssi",
+	"snr",
+}
+
+var MACCommand_RelayNotifyNewEndDeviceReqFieldPathsTopLevel = []string{
+	"dev_addr",
+	"rssi",
+	"snr",
+}
+var MACCommand_RelayConfReq_ConfigurationFieldPathsNested = []string{
+	"cad_periodicity",
+	"default_channel_index",
+	"second_channel",
+	"second_channel.ack_offset",
+	"second_channel.data_rate_index",
+	"second_channel.frequency",
+}
+
+var MACCommand_RelayConfReq_ConfigurationFieldPathsTopLevel = []string{
+	"cad_periodicity",
+	"default_channel_index",
+	"second_channel",
+}
+var MACCommand_RelayEndDeviceConfReq_ConfigurationFieldPathsNested = []string{
+	"backoff",
+	"mode",
+	"mode.always",
+	"mode.dynamic",
+	"mode.dynamic.smart_enable_level",
+	"mode.end_device_controlled",
+	"second_channel",
+	"second_channel.ack_offset",
+	"second_channel.data_rate_index",
+	"second_channel.frequency",
+}
+
+var MACCommand_RelayEndDeviceConfReq_ConfigurationFieldPathsTopLevel = []string{
+	"backoff",
+	"mode",
+	"second_channel",
+}
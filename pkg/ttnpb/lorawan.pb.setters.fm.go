@@ -2414,6 +2414,336 @@ func (dst *MACCommand) SetFields(src *MACCommand, paths ...string) error {
 							dst.Payload = nil
 						}
 					}
+				case "relay_conf_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayConfReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_conf_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayConfReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_conf_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayConfReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayConfReq_).RelayConfReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayConfReq_).RelayConfReq
+						} else {
+							newDst = &MACCommand_RelayConfReq{}
+							dst.Payload = &MACCommand_RelayConfReq_{RelayConfReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_conf_ans":
+					_, srcOk := src.Payload.(*MACCommand_RelayConfAns_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_conf_ans', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayConfAns_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_conf_ans', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayConfAns
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayConfAns_).RelayConfAns
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayConfAns_).RelayConfAns
+						} else {
+							newDst = &MACCommand_RelayConfAns{}
+							dst.Payload = &MACCommand_RelayConfAns_{RelayConfAns: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_end_device_conf_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayEndDeviceConfReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_end_device_conf_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayEndDeviceConfReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_end_device_conf_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayEndDeviceConfReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayEndDeviceConfReq_).RelayEndDeviceConfReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayEndDeviceConfReq_).RelayEndDeviceConfReq
+						} else {
+							newDst = &MACCommand_RelayEndDeviceConfReq{}
+							dst.Payload = &MACCommand_RelayEndDeviceConfReq_{RelayEndDeviceConfReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_end_device_conf_ans":
+					_, srcOk := src.Payload.(*MACCommand_RelayEndDeviceConfAns_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_end_device_conf_ans', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayEndDeviceConfAns_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_end_device_conf_ans', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayEndDeviceConfAns
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayEndDeviceConfAns_).RelayEndDeviceConfAns
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayEndDeviceConfAns_).RelayEndDeviceConfAns
+						} else {
+							newDst = &MACCommand_RelayEndDeviceConfAns{}
+							dst.Payload = &MACCommand_RelayEndDeviceConfAns_{RelayEndDeviceConfAns: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_filter_list_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayFilterListReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_filter_list_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayFilterListReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_filter_list_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayFilterListReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayFilterListReq_).RelayFilterListReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayFilterListReq_).RelayFilterListReq
+						} else {
+							newDst = &MACCommand_RelayFilterListReq{}
+							dst.Payload = &MACCommand_RelayFilterListReq_{RelayFilterListReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_filter_list_ans":
+					_, srcOk := src.Payload.(*MACCommand_RelayFilterListAns_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_filter_list_ans', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayFilterListAns_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_filter_list_ans', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayFilterListAns
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayFilterListAns_).RelayFilterListAns
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayFilterListAns_).RelayFilterListAns
+						} else {
+							newDst = &MACCommand_RelayFilterListAns{}
+							dst.Payload = &MACCommand_RelayFilterListAns_{RelayFilterListAns: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_update_uplink_list_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayUpdateUplinkListReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_update_uplink_list_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayUpdateUplinkListReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_update_uplink_list_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayUpdateUplinkListReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayUpdateUplinkListReq_).RelayUpdateUplinkListReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayUpdateUplinkListReq_).RelayUpdateUplinkListReq
+						} else {
+							newDst = &MACCommand_RelayUpdateUplinkListReq{}
+							dst.Payload = &MACCommand_RelayUpdateUplinkListReq_{RelayUpdateUplinkListReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_ctrl_uplink_list_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayCtrlUplinkListReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_ctrl_uplink_list_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayCtrlUplinkListReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_ctrl_uplink_list_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayCtrlUplinkListReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayCtrlUplinkListReq_).RelayCtrlUplinkListReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayCtrlUplinkListReq_).RelayCtrlUplinkListReq
+						} else {
+							newDst = &MACCommand_RelayCtrlUplinkListReq{}
+							dst.Payload = &MACCommand_RelayCtrlUplinkListReq_{RelayCtrlUplinkListReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_ctrl_uplink_list_ans":
+					_, srcOk := src.Payload.(*MACCommand_RelayCtrlUplinkListAns_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_ctrl_uplink_list_ans', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayCtrlUplinkListAns_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_ctrl_uplink_list_ans', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayCtrlUplinkListAns
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayCtrlUplinkListAns_).RelayCtrlUplinkListAns
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayCtrlUplinkListAns_).RelayCtrlUplinkListAns
+						} else {
+							newDst = &MACCommand_RelayCtrlUplinkListAns{}
+							dst.Payload = &MACCommand_RelayCtrlUplinkListAns_{RelayCtrlUplinkListAns: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
+				case "relay_notify_new_end_device_req":
+					_, srcOk := src.Payload.(*MACCommand_RelayNotifyNewEndDeviceReq_)
+					if !srcOk && src.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_notify_new_end_device_req', while different oneof is set in source")
+					}
+					_, dstOk := dst.Payload.(*MACCommand_RelayNotifyNewEndDeviceReq_)
+					if !dstOk && dst.Payload != nil {
+						return fmt.Errorf("attempt to set oneof 'relay_notify_new_end_device_req', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *MACCommand_RelayNotifyNewEndDeviceReq
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Payload.(*MACCommand_RelayNotifyNewEndDeviceReq_).RelayNotifyNewEndDeviceReq
+						}
+						if dstOk {
+							newDst = dst.Payload.(*MACCommand_RelayNotifyNewEndDeviceReq_).RelayNotifyNewEndDeviceReq
+						} else {
+							newDst = &MACCommand_RelayNotifyNewEndDeviceReq{}
+							dst.Payload = &MACCommand_RelayNotifyNewEndDeviceReq_{RelayNotifyNewEndDeviceReq: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Payload = src.Payload
+						} else {
+							dst.Payload = nil
+						}
+					}
 
 				default:
 					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
@@ -2427,18 +2757,555 @@ func (dst *MACCommand) SetFields(src *MACCommand, paths ...string) error {
 	return nil
 }
 
-func (dst *FrequencyValue) SetFields(src *FrequencyValue, paths ...string) error {
+func (dst *RelaySecondChannel) SetFields(src *RelaySecondChannel, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ack_offset":
+			if len(subs) > 0 {
+				return fmt.Errorf("'ack_offset' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AckOffset = src.AckOffset
+			} else {
+				var zero RelaySecondChAckOffset
+				dst.AckOffset = zero
+			}
+		case "data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndex = src.DataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.DataRateIndex = zero
+			}
+		case "frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Frequency = src.Frequency
+			} else {
+				var zero uint64
+				dst.Frequency = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RelayEndDeviceAlwaysMode) SetFields(src *RelayEndDeviceAlwaysMode, paths ...string) error {
+	if len(paths) != 0 {
+		return fmt.Errorf("message RelayEndDeviceAlwaysMode has no fields, but paths %s were specified", paths)
+	}
+	if src != nil {
+		*dst = *src
+	}
+	return nil
+}
+
+func (dst *RelayEndDeviceDynamicMode) SetFields(src *RelayEndDeviceDynamicMode, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "smart_enable_level":
+			if len(subs) > 0 {
+				return fmt.Errorf("'smart_enable_level' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SmartEnableLevel = src.SmartEnableLevel
+			} else {
+				var zero RelaySmartEnableLevel
+				dst.SmartEnableLevel = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RelayEndDeviceControlledMode) SetFields(src *RelayEndDeviceControlledMode, paths ...string) error {
+	if len(paths) != 0 {
+		return fmt.Errorf("message RelayEndDeviceControlledMode has no fields, but paths %s were specified", paths)
+	}
+	if src != nil {
+		*dst = *src
+	}
+	return nil
+}
+
+func (dst *RelayForwardLimits) SetFields(src *RelayForwardLimits, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "bucket_size":
+			if len(subs) > 0 {
+				return fmt.Errorf("'bucket_size' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.BucketSize = src.BucketSize
+			} else {
+				var zero RelayLimitBucketSize
+				dst.BucketSize = zero
+			}
+		case "reload_rate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reload_rate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ReloadRate = src.ReloadRate
+			} else {
+				var zero uint32
+				dst.ReloadRate = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RelayForwardDownlinkReq) SetFields(src *RelayForwardDownlinkReq, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "raw_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'raw_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RawPayload = src.RawPayload
+			} else {
+				dst.RawPayload = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *FrequencyValue) SetFields(src *FrequencyValue, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero uint64
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DataRateOffsetValue) SetFields(src *DataRateOffsetValue, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero DataRateOffset
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DataRateIndexValue) SetFields(src *DataRateIndexValue, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero DataRateIndex
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *PingSlotPeriodValue) SetFields(src *PingSlotPeriodValue, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero PingSlotPeriod
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *AggregatedDutyCycleValue) SetFields(src *AggregatedDutyCycleValue, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero AggregatedDutyCycle
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RxDelayValue) SetFields(src *RxDelayValue, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero RxDelay
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ADRAckLimitExponentValue) SetFields(src *ADRAckLimitExponentValue, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero ADRAckLimitExponent
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ADRAckDelayExponentValue) SetFields(src *ADRAckDelayExponentValue, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero ADRAckDelayExponent
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DeviceEIRPValue) SetFields(src *DeviceEIRPValue, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero DeviceEIRP
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *TxSettings_Downlink) SetFields(src *TxSettings_Downlink, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "antenna_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'antenna_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AntennaIndex = src.AntennaIndex
+			} else {
+				var zero uint32
+				dst.AntennaIndex = zero
+			}
+		case "tx_power":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_power' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxPower = src.TxPower
+			} else {
+				var zero float32
+				dst.TxPower = zero
+			}
+		case "invert_polarization":
+			if len(subs) > 0 {
+				return fmt.Errorf("'invert_polarization' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.InvertPolarization = src.InvertPolarization
+			} else {
+				var zero bool
+				dst.InvertPolarization = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_ResetInd) SetFields(src *MACCommand_ResetInd, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "minor_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'minor_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinorVersion = src.MinorVersion
+			} else {
+				var zero Minor
+				dst.MinorVersion = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_ResetConf) SetFields(src *MACCommand_ResetConf, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "minor_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'minor_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinorVersion = src.MinorVersion
+			} else {
+				var zero Minor
+				dst.MinorVersion = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_LinkCheckAns) SetFields(src *MACCommand_LinkCheckAns, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "margin":
+			if len(subs) > 0 {
+				return fmt.Errorf("'margin' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Margin = src.Margin
+			} else {
+				var zero uint32
+				dst.Margin = zero
+			}
+		case "gateway_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'gateway_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.GatewayCount = src.GatewayCount
+			} else {
+				var zero uint32
+				dst.GatewayCount = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_LinkADRReq) SetFields(src *MACCommand_LinkADRReq, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndex = src.DataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.DataRateIndex = zero
+			}
+		case "tx_power_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_power_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxPowerIndex = src.TxPowerIndex
+			} else {
+				var zero uint32
+				dst.TxPowerIndex = zero
+			}
+		case "channel_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channel_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ChannelMask = src.ChannelMask
+			} else {
+				dst.ChannelMask = nil
+			}
+		case "channel_mask_control":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channel_mask_control' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ChannelMaskControl = src.ChannelMaskControl
+			} else {
+				var zero uint32
+				dst.ChannelMaskControl = zero
+			}
+		case "nb_trans":
+			if len(subs) > 0 {
+				return fmt.Errorf("'nb_trans' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NbTrans = src.NbTrans
+			} else {
+				var zero uint32
+				dst.NbTrans = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_LinkADRAns) SetFields(src *MACCommand_LinkADRAns, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "channel_mask_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channel_mask_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ChannelMaskAck = src.ChannelMaskAck
+			} else {
+				var zero bool
+				dst.ChannelMaskAck = zero
+			}
+		case "data_rate_index_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndexAck = src.DataRateIndexAck
+			} else {
+				var zero bool
+				dst.DataRateIndexAck = zero
+			}
+		case "tx_power_index_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_power_index_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxPowerIndexAck = src.TxPowerIndexAck
+			} else {
+				var zero bool
+				dst.TxPowerIndexAck = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_DutyCycleReq) SetFields(src *MACCommand_DutyCycleReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "value":
+		case "max_duty_cycle":
 			if len(subs) > 0 {
-				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'max_duty_cycle' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Value = src.Value
+				dst.MaxDutyCycle = src.MaxDutyCycle
 			} else {
-				var zero uint64
-				dst.Value = zero
+				var zero AggregatedDutyCycle
+				dst.MaxDutyCycle = zero
 			}
 
 		default:
@@ -2448,18 +3315,38 @@ func (dst *FrequencyValue) SetFields(src *FrequencyValue, paths ...string) error
 	return nil
 }
 
-func (dst *DataRateOffsetValue) SetFields(src *DataRateOffsetValue, paths ...string) error {
+func (dst *MACCommand_RxParamSetupReq) SetFields(src *MACCommand_RxParamSetupReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "value":
+		case "rx2_data_rate_index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'rx2_data_rate_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Value = src.Value
+				dst.Rx2DataRateIndex = src.Rx2DataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.Rx2DataRateIndex = zero
+			}
+		case "rx1_data_rate_offset":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rx1_data_rate_offset' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rx1DataRateOffset = src.Rx1DataRateOffset
 			} else {
 				var zero DataRateOffset
-				dst.Value = zero
+				dst.Rx1DataRateOffset = zero
+			}
+		case "rx2_frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rx2_frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rx2Frequency = src.Rx2Frequency
+			} else {
+				var zero uint64
+				dst.Rx2Frequency = zero
 			}
 
 		default:
@@ -2469,18 +3356,38 @@ func (dst *DataRateOffsetValue) SetFields(src *DataRateOffsetValue, paths ...str
 	return nil
 }
 
-func (dst *DataRateIndexValue) SetFields(src *DataRateIndexValue, paths ...string) error {
+func (dst *MACCommand_RxParamSetupAns) SetFields(src *MACCommand_RxParamSetupAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "value":
+		case "rx2_data_rate_index_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'rx2_data_rate_index_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Value = src.Value
+				dst.Rx2DataRateIndexAck = src.Rx2DataRateIndexAck
 			} else {
-				var zero DataRateIndex
-				dst.Value = zero
+				var zero bool
+				dst.Rx2DataRateIndexAck = zero
+			}
+		case "rx1_data_rate_offset_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rx1_data_rate_offset_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rx1DataRateOffsetAck = src.Rx1DataRateOffsetAck
+			} else {
+				var zero bool
+				dst.Rx1DataRateOffsetAck = zero
+			}
+		case "rx2_frequency_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rx2_frequency_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rx2FrequencyAck = src.Rx2FrequencyAck
+			} else {
+				var zero bool
+				dst.Rx2FrequencyAck = zero
 			}
 
 		default:
@@ -2490,18 +3397,28 @@ func (dst *DataRateIndexValue) SetFields(src *DataRateIndexValue, paths ...strin
 	return nil
 }
 
-func (dst *PingSlotPeriodValue) SetFields(src *PingSlotPeriodValue, paths ...string) error {
+func (dst *MACCommand_DevStatusAns) SetFields(src *MACCommand_DevStatusAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "value":
+		case "battery":
 			if len(subs) > 0 {
-				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'battery' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Value = src.Value
+				dst.Battery = src.Battery
 			} else {
-				var zero PingSlotPeriod
-				dst.Value = zero
+				var zero uint32
+				dst.Battery = zero
+			}
+		case "margin":
+			if len(subs) > 0 {
+				return fmt.Errorf("'margin' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Margin = src.Margin
+			} else {
+				var zero int32
+				dst.Margin = zero
 			}
 
 		default:
@@ -2511,18 +3428,48 @@ func (dst *PingSlotPeriodValue) SetFields(src *PingSlotPeriodValue, paths ...str
 	return nil
 }
 
-func (dst *AggregatedDutyCycleValue) SetFields(src *AggregatedDutyCycleValue, paths ...string) error {
+func (dst *MACCommand_NewChannelReq) SetFields(src *MACCommand_NewChannelReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "value":
+		case "channel_index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'channel_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Value = src.Value
+				dst.ChannelIndex = src.ChannelIndex
 			} else {
-				var zero AggregatedDutyCycle
-				dst.Value = zero
+				var zero uint32
+				dst.ChannelIndex = zero
+			}
+		case "frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Frequency = src.Frequency
+			} else {
+				var zero uint64
+				dst.Frequency = zero
+			}
+		case "min_data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinDataRateIndex = src.MinDataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.MinDataRateIndex = zero
+			}
+		case "max_data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxDataRateIndex = src.MaxDataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.MaxDataRateIndex = zero
 			}
 
 		default:
@@ -2532,18 +3479,28 @@ func (dst *AggregatedDutyCycleValue) SetFields(src *AggregatedDutyCycleValue, pa
 	return nil
 }
 
-func (dst *RxDelayValue) SetFields(src *RxDelayValue, paths ...string) error {
+func (dst *MACCommand_NewChannelAns) SetFields(src *MACCommand_NewChannelAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "value":
+		case "frequency_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'frequency_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Value = src.Value
+				dst.FrequencyAck = src.FrequencyAck
 			} else {
-				var zero RxDelay
-				dst.Value = zero
+				var zero bool
+				dst.FrequencyAck = zero
+			}
+		case "data_rate_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateAck = src.DataRateAck
+			} else {
+				var zero bool
+				dst.DataRateAck = zero
 			}
 
 		default:
@@ -2553,18 +3510,28 @@ func (dst *RxDelayValue) SetFields(src *RxDelayValue, paths ...string) error {
 	return nil
 }
 
-func (dst *ADRAckLimitExponentValue) SetFields(src *ADRAckLimitExponentValue, paths ...string) error {
+func (dst *MACCommand_DLChannelReq) SetFields(src *MACCommand_DLChannelReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "value":
+		case "channel_index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'channel_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Value = src.Value
+				dst.ChannelIndex = src.ChannelIndex
 			} else {
-				var zero ADRAckLimitExponent
-				dst.Value = zero
+				var zero uint32
+				dst.ChannelIndex = zero
+			}
+		case "frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Frequency = src.Frequency
+			} else {
+				var zero uint64
+				dst.Frequency = zero
 			}
 
 		default:
@@ -2574,18 +3541,28 @@ func (dst *ADRAckLimitExponentValue) SetFields(src *ADRAckLimitExponentValue, pa
 	return nil
 }
 
-func (dst *ADRAckDelayExponentValue) SetFields(src *ADRAckDelayExponentValue, paths ...string) error {
+func (dst *MACCommand_DLChannelAns) SetFields(src *MACCommand_DLChannelAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "value":
+		case "channel_index_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'channel_index_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Value = src.Value
+				dst.ChannelIndexAck = src.ChannelIndexAck
 			} else {
-				var zero ADRAckDelayExponent
-				dst.Value = zero
+				var zero bool
+				dst.ChannelIndexAck = zero
+			}
+		case "frequency_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FrequencyAck = src.FrequencyAck
+			} else {
+				var zero bool
+				dst.FrequencyAck = zero
 			}
 
 		default:
@@ -2595,18 +3572,18 @@ func (dst *ADRAckDelayExponentValue) SetFields(src *ADRAckDelayExponentValue, pa
 	return nil
 }
 
-func (dst *DeviceEIRPValue) SetFields(src *DeviceEIRPValue, paths ...string) error {
+func (dst *MACCommand_RxTimingSetupReq) SetFields(src *MACCommand_RxTimingSetupReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "value":
+		case "delay":
 			if len(subs) > 0 {
-				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'delay' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Value = src.Value
+				dst.Delay = src.Delay
 			} else {
-				var zero DeviceEIRP
-				dst.Value = zero
+				var zero RxDelay
+				dst.Delay = zero
 			}
 
 		default:
@@ -2616,38 +3593,38 @@ func (dst *DeviceEIRPValue) SetFields(src *DeviceEIRPValue, paths ...string) err
 	return nil
 }
 
-func (dst *TxSettings_Downlink) SetFields(src *TxSettings_Downlink, paths ...string) error {
+func (dst *MACCommand_TxParamSetupReq) SetFields(src *MACCommand_TxParamSetupReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "antenna_index":
+		case "max_eirp_index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'antenna_index' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'max_eirp_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.AntennaIndex = src.AntennaIndex
+				dst.MaxEirpIndex = src.MaxEirpIndex
 			} else {
-				var zero uint32
-				dst.AntennaIndex = zero
+				var zero DeviceEIRP
+				dst.MaxEirpIndex = zero
 			}
-		case "tx_power":
+		case "uplink_dwell_time":
 			if len(subs) > 0 {
-				return fmt.Errorf("'tx_power' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'uplink_dwell_time' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.TxPower = src.TxPower
+				dst.UplinkDwellTime = src.UplinkDwellTime
 			} else {
-				var zero float32
-				dst.TxPower = zero
+				var zero bool
+				dst.UplinkDwellTime = zero
 			}
-		case "invert_polarization":
+		case "downlink_dwell_time":
 			if len(subs) > 0 {
-				return fmt.Errorf("'invert_polarization' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'downlink_dwell_time' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.InvertPolarization = src.InvertPolarization
+				dst.DownlinkDwellTime = src.DownlinkDwellTime
 			} else {
 				var zero bool
-				dst.InvertPolarization = zero
+				dst.DownlinkDwellTime = zero
 			}
 
 		default:
@@ -2657,7 +3634,7 @@ func (dst *TxSettings_Downlink) SetFields(src *TxSettings_Downlink, paths ...str
 	return nil
 }
 
-func (dst *MACCommand_ResetInd) SetFields(src *MACCommand_ResetInd, paths ...string) error {
+func (dst *MACCommand_RekeyInd) SetFields(src *MACCommand_RekeyInd, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
 		case "minor_version":
@@ -2678,7 +3655,7 @@ func (dst *MACCommand_ResetInd) SetFields(src *MACCommand_ResetInd, paths ...str
 	return nil
 }
 
-func (dst *MACCommand_ResetConf) SetFields(src *MACCommand_ResetConf, paths ...string) error {
+func (dst *MACCommand_RekeyConf) SetFields(src *MACCommand_RekeyConf, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
 		case "minor_version":
@@ -2699,28 +3676,28 @@ func (dst *MACCommand_ResetConf) SetFields(src *MACCommand_ResetConf, paths ...s
 	return nil
 }
 
-func (dst *MACCommand_LinkCheckAns) SetFields(src *MACCommand_LinkCheckAns, paths ...string) error {
+func (dst *MACCommand_ADRParamSetupReq) SetFields(src *MACCommand_ADRParamSetupReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "margin":
+		case "adr_ack_limit_exponent":
 			if len(subs) > 0 {
-				return fmt.Errorf("'margin' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'adr_ack_limit_exponent' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Margin = src.Margin
+				dst.AdrAckLimitExponent = src.AdrAckLimitExponent
 			} else {
-				var zero uint32
-				dst.Margin = zero
+				var zero ADRAckLimitExponent
+				dst.AdrAckLimitExponent = zero
 			}
-		case "gateway_count":
+		case "adr_ack_delay_exponent":
 			if len(subs) > 0 {
-				return fmt.Errorf("'gateway_count' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'adr_ack_delay_exponent' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.GatewayCount = src.GatewayCount
+				dst.AdrAckDelayExponent = src.AdrAckDelayExponent
 			} else {
-				var zero uint32
-				dst.GatewayCount = zero
+				var zero ADRAckDelayExponent
+				dst.AdrAckDelayExponent = zero
 			}
 
 		default:
@@ -2730,57 +3707,18 @@ func (dst *MACCommand_LinkCheckAns) SetFields(src *MACCommand_LinkCheckAns, path
 	return nil
 }
 
-func (dst *MACCommand_LinkADRReq) SetFields(src *MACCommand_LinkADRReq, paths ...string) error {
+func (dst *MACCommand_DeviceTimeAns) SetFields(src *MACCommand_DeviceTimeAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "data_rate_index":
-			if len(subs) > 0 {
-				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.DataRateIndex = src.DataRateIndex
-			} else {
-				var zero DataRateIndex
-				dst.DataRateIndex = zero
-			}
-		case "tx_power_index":
-			if len(subs) > 0 {
-				return fmt.Errorf("'tx_power_index' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.TxPowerIndex = src.TxPowerIndex
-			} else {
-				var zero uint32
-				dst.TxPowerIndex = zero
-			}
-		case "channel_mask":
-			if len(subs) > 0 {
-				return fmt.Errorf("'channel_mask' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.ChannelMask = src.ChannelMask
-			} else {
-				dst.ChannelMask = nil
-			}
-		case "channel_mask_control":
-			if len(subs) > 0 {
-				return fmt.Errorf("'channel_mask_control' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.ChannelMaskControl = src.ChannelMaskControl
-			} else {
-				var zero uint32
-				dst.ChannelMaskControl = zero
-			}
-		case "nb_trans":
+		case "time":
 			if len(subs) > 0 {
-				return fmt.Errorf("'nb_trans' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.NbTrans = src.NbTrans
+				dst.Time = src.Time
 			} else {
-				var zero uint32
-				dst.NbTrans = zero
+				var zero time.Time
+				dst.Time = zero
 			}
 
 		default:
@@ -2790,59 +3728,48 @@ func (dst *MACCommand_LinkADRReq) SetFields(src *MACCommand_LinkADRReq, paths ..
 	return nil
 }
 
-func (dst *MACCommand_LinkADRAns) SetFields(src *MACCommand_LinkADRAns, paths ...string) error {
+func (dst *MACCommand_ForceRejoinReq) SetFields(src *MACCommand_ForceRejoinReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "channel_mask_ack":
+		case "rejoin_type":
 			if len(subs) > 0 {
-				return fmt.Errorf("'channel_mask_ack' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'rejoin_type' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.ChannelMaskAck = src.ChannelMaskAck
+				dst.RejoinType = src.RejoinType
 			} else {
-				var zero bool
-				dst.ChannelMaskAck = zero
+				var zero RejoinRequestType
+				dst.RejoinType = zero
 			}
-		case "data_rate_index_ack":
+		case "data_rate_index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'data_rate_index_ack' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.DataRateIndexAck = src.DataRateIndexAck
+				dst.DataRateIndex = src.DataRateIndex
 			} else {
-				var zero bool
-				dst.DataRateIndexAck = zero
+				var zero DataRateIndex
+				dst.DataRateIndex = zero
 			}
-		case "tx_power_index_ack":
+		case "max_retries":
 			if len(subs) > 0 {
-				return fmt.Errorf("'tx_power_index_ack' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'max_retries' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.TxPowerIndexAck = src.TxPowerIndexAck
+				dst.MaxRetries = src.MaxRetries
 			} else {
-				var zero bool
-				dst.TxPowerIndexAck = zero
+				var zero uint32
+				dst.MaxRetries = zero
 			}
-
-		default:
-			return fmt.Errorf("invalid field: '%s'", name)
-		}
-	}
-	return nil
-}
-
-func (dst *MACCommand_DutyCycleReq) SetFields(src *MACCommand_DutyCycleReq, paths ...string) error {
-	for name, subs := range _processPaths(paths) {
-		switch name {
-		case "max_duty_cycle":
+		case "period_exponent":
 			if len(subs) > 0 {
-				return fmt.Errorf("'max_duty_cycle' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'period_exponent' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.MaxDutyCycle = src.MaxDutyCycle
+				dst.PeriodExponent = src.PeriodExponent
 			} else {
-				var zero AggregatedDutyCycle
-				dst.MaxDutyCycle = zero
+				var zero RejoinPeriodExponent
+				dst.PeriodExponent = zero
 			}
 
 		default:
@@ -2852,38 +3779,28 @@ func (dst *MACCommand_DutyCycleReq) SetFields(src *MACCommand_DutyCycleReq, path
 	return nil
 }
 
-func (dst *MACCommand_RxParamSetupReq) SetFields(src *MACCommand_RxParamSetupReq, paths ...string) error {
+func (dst *MACCommand_RejoinParamSetupReq) SetFields(src *MACCommand_RejoinParamSetupReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "rx2_data_rate_index":
-			if len(subs) > 0 {
-				return fmt.Errorf("'rx2_data_rate_index' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.Rx2DataRateIndex = src.Rx2DataRateIndex
-			} else {
-				var zero DataRateIndex
-				dst.Rx2DataRateIndex = zero
-			}
-		case "rx1_data_rate_offset":
+		case "max_count_exponent":
 			if len(subs) > 0 {
-				return fmt.Errorf("'rx1_data_rate_offset' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'max_count_exponent' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Rx1DataRateOffset = src.Rx1DataRateOffset
+				dst.MaxCountExponent = src.MaxCountExponent
 			} else {
-				var zero DataRateOffset
-				dst.Rx1DataRateOffset = zero
+				var zero RejoinCountExponent
+				dst.MaxCountExponent = zero
 			}
-		case "rx2_frequency":
+		case "max_time_exponent":
 			if len(subs) > 0 {
-				return fmt.Errorf("'rx2_frequency' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'max_time_exponent' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Rx2Frequency = src.Rx2Frequency
+				dst.MaxTimeExponent = src.MaxTimeExponent
 			} else {
-				var zero uint64
-				dst.Rx2Frequency = zero
+				var zero RejoinTimeExponent
+				dst.MaxTimeExponent = zero
 			}
 
 		default:
@@ -2893,38 +3810,18 @@ func (dst *MACCommand_RxParamSetupReq) SetFields(src *MACCommand_RxParamSetupReq
 	return nil
 }
 
-func (dst *MACCommand_RxParamSetupAns) SetFields(src *MACCommand_RxParamSetupAns, paths ...string) error {
+func (dst *MACCommand_RejoinParamSetupAns) SetFields(src *MACCommand_RejoinParamSetupAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "rx2_data_rate_index_ack":
-			if len(subs) > 0 {
-				return fmt.Errorf("'rx2_data_rate_index_ack' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.Rx2DataRateIndexAck = src.Rx2DataRateIndexAck
-			} else {
-				var zero bool
-				dst.Rx2DataRateIndexAck = zero
-			}
-		case "rx1_data_rate_offset_ack":
-			if len(subs) > 0 {
-				return fmt.Errorf("'rx1_data_rate_offset_ack' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.Rx1DataRateOffsetAck = src.Rx1DataRateOffsetAck
-			} else {
-				var zero bool
-				dst.Rx1DataRateOffsetAck = zero
-			}
-		case "rx2_frequency_ack":
+		case "max_time_exponent_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'rx2_frequency_ack' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'max_time_exponent_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Rx2FrequencyAck = src.Rx2FrequencyAck
+				dst.MaxTimeExponentAck = src.MaxTimeExponentAck
 			} else {
 				var zero bool
-				dst.Rx2FrequencyAck = zero
+				dst.MaxTimeExponentAck = zero
 			}
 
 		default:
@@ -2934,50 +3831,30 @@ func (dst *MACCommand_RxParamSetupAns) SetFields(src *MACCommand_RxParamSetupAns
 	return nil
 }
 
-func (dst *MACCommand_DevStatusAns) SetFields(src *MACCommand_DevStatusAns, paths ...string) error {
+func (dst *MACCommand_PingSlotInfoReq) SetFields(src *MACCommand_PingSlotInfoReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "battery":
-			if len(subs) > 0 {
-				return fmt.Errorf("'battery' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.Battery = src.Battery
-			} else {
-				var zero uint32
-				dst.Battery = zero
-			}
-		case "margin":
+		case "period":
 			if len(subs) > 0 {
-				return fmt.Errorf("'margin' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'period' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Margin = src.Margin
+				dst.Period = src.Period
 			} else {
-				var zero int32
-				dst.Margin = zero
+				var zero PingSlotPeriod
+				dst.Period = zero
 			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
-		}
-	}
-	return nil
-}
-
-func (dst *MACCommand_NewChannelReq) SetFields(src *MACCommand_NewChannelReq, paths ...string) error {
-	for name, subs := range _processPaths(paths) {
-		switch name {
-		case "channel_index":
-			if len(subs) > 0 {
-				return fmt.Errorf("'channel_index' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.ChannelIndex = src.ChannelIndex
-			} else {
-				var zero uint32
-				dst.ChannelIndex = zero
-			}
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_PingSlotChannelReq) SetFields(src *MACCommand_PingSlotChannelReq, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
 		case "frequency":
 			if len(subs) > 0 {
 				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
@@ -2988,25 +3865,15 @@ func (dst *MACCommand_NewChannelReq) SetFields(src *MACCommand_NewChannelReq, pa
 				var zero uint64
 				dst.Frequency = zero
 			}
-		case "min_data_rate_index":
-			if len(subs) > 0 {
-				return fmt.Errorf("'min_data_rate_index' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.MinDataRateIndex = src.MinDataRateIndex
-			} else {
-				var zero DataRateIndex
-				dst.MinDataRateIndex = zero
-			}
-		case "max_data_rate_index":
+		case "data_rate_index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'max_data_rate_index' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.MaxDataRateIndex = src.MaxDataRateIndex
+				dst.DataRateIndex = src.DataRateIndex
 			} else {
 				var zero DataRateIndex
-				dst.MaxDataRateIndex = zero
+				dst.DataRateIndex = zero
 			}
 
 		default:
@@ -3016,7 +3883,7 @@ func (dst *MACCommand_NewChannelReq) SetFields(src *MACCommand_NewChannelReq, pa
 	return nil
 }
 
-func (dst *MACCommand_NewChannelAns) SetFields(src *MACCommand_NewChannelAns, paths ...string) error {
+func (dst *MACCommand_PingSlotChannelAns) SetFields(src *MACCommand_PingSlotChannelAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
 		case "frequency_ack":
@@ -3029,15 +3896,15 @@ func (dst *MACCommand_NewChannelAns) SetFields(src *MACCommand_NewChannelAns, pa
 				var zero bool
 				dst.FrequencyAck = zero
 			}
-		case "data_rate_ack":
+		case "data_rate_index_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'data_rate_ack' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'data_rate_index_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.DataRateAck = src.DataRateAck
+				dst.DataRateIndexAck = src.DataRateIndexAck
 			} else {
 				var zero bool
-				dst.DataRateAck = zero
+				dst.DataRateIndexAck = zero
 			}
 
 		default:
@@ -3047,9 +3914,19 @@ func (dst *MACCommand_NewChannelAns) SetFields(src *MACCommand_NewChannelAns, pa
 	return nil
 }
 
-func (dst *MACCommand_DLChannelReq) SetFields(src *MACCommand_DLChannelReq, paths ...string) error {
+func (dst *MACCommand_BeaconTimingAns) SetFields(src *MACCommand_BeaconTimingAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
+		case "delay":
+			if len(subs) > 0 {
+				return fmt.Errorf("'delay' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Delay = src.Delay
+			} else {
+				var zero uint32
+				dst.Delay = zero
+			}
 		case "channel_index":
 			if len(subs) > 0 {
 				return fmt.Errorf("'channel_index' has no subfields, but %s were specified", subs)
@@ -3060,6 +3937,17 @@ func (dst *MACCommand_DLChannelReq) SetFields(src *MACCommand_DLChannelReq, path
 				var zero uint32
 				dst.ChannelIndex = zero
 			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACCommand_BeaconFreqReq) SetFields(src *MACCommand_BeaconFreqReq, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
 		case "frequency":
 			if len(subs) > 0 {
 				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
@@ -3078,19 +3966,9 @@ func (dst *MACCommand_DLChannelReq) SetFields(src *MACCommand_DLChannelReq, path
 	return nil
 }
 
-func (dst *MACCommand_DLChannelAns) SetFields(src *MACCommand_DLChannelAns, paths ...string) error {
+func (dst *MACCommand_BeaconFreqAns) SetFields(src *MACCommand_BeaconFreqAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "channel_index_ack":
-			if len(subs) > 0 {
-				return fmt.Errorf("'channel_index_ack' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.ChannelIndexAck = src.ChannelIndexAck
-			} else {
-				var zero bool
-				dst.ChannelIndexAck = zero
-			}
 		case "frequency_ack":
 			if len(subs) > 0 {
 				return fmt.Errorf("'frequency_ack' has no subfields, but %s were specified", subs)
@@ -3109,18 +3987,18 @@ func (dst *MACCommand_DLChannelAns) SetFields(src *MACCommand_DLChannelAns, path
 	return nil
 }
 
-func (dst *MACCommand_RxTimingSetupReq) SetFields(src *MACCommand_RxTimingSetupReq, paths ...string) error {
+func (dst *MACCommand_DeviceModeInd) SetFields(src *MACCommand_DeviceModeInd, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "delay":
+		case "class":
 			if len(subs) > 0 {
-				return fmt.Errorf("'delay' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'class' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Delay = src.Delay
+				dst.Class = src.Class
 			} else {
-				var zero RxDelay
-				dst.Delay = zero
+				var zero Class
+				dst.Class = zero
 			}
 
 		default:
@@ -3130,38 +4008,18 @@ func (dst *MACCommand_RxTimingSetupReq) SetFields(src *MACCommand_RxTimingSetupR
 	return nil
 }
 
-func (dst *MACCommand_TxParamSetupReq) SetFields(src *MACCommand_TxParamSetupReq, paths ...string) error {
+func (dst *MACCommand_DeviceModeConf) SetFields(src *MACCommand_DeviceModeConf, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "max_eirp_index":
-			if len(subs) > 0 {
-				return fmt.Errorf("'max_eirp_index' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.MaxEirpIndex = src.MaxEirpIndex
-			} else {
-				var zero DeviceEIRP
-				dst.MaxEirpIndex = zero
-			}
-		case "uplink_dwell_time":
-			if len(subs) > 0 {
-				return fmt.Errorf("'uplink_dwell_time' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.UplinkDwellTime = src.UplinkDwellTime
-			} else {
-				var zero bool
-				dst.UplinkDwellTime = zero
-			}
-		case "downlink_dwell_time":
+		case "class":
 			if len(subs) > 0 {
-				return fmt.Errorf("'downlink_dwell_time' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'class' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.DownlinkDwellTime = src.DownlinkDwellTime
+				dst.Class = src.Class
 			} else {
-				var zero bool
-				dst.DownlinkDwellTime = zero
+				var zero Class
+				dst.Class = zero
 			}
 
 		default:
@@ -3171,18 +4029,33 @@ func (dst *MACCommand_TxParamSetupReq) SetFields(src *MACCommand_TxParamSetupReq
 	return nil
 }
 
-func (dst *MACCommand_RekeyInd) SetFields(src *MACCommand_RekeyInd, paths ...string) error {
+func (dst *MACCommand_RelayConfReq) SetFields(src *MACCommand_RelayConfReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "minor_version":
+		case "configuration":
 			if len(subs) > 0 {
-				return fmt.Errorf("'minor_version' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.MinorVersion = src.MinorVersion
+				var newDst, newSrc *MACCommand_RelayConfReq_Configuration
+				if (src == nil || src.Configuration == nil) && dst.Configuration == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Configuration
+				}
+				if dst.Configuration != nil {
+					newDst = dst.Configuration
+				} else {
+					newDst = &MACCommand_RelayConfReq_Configuration{}
+					dst.Configuration = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
 			} else {
-				var zero Minor
-				dst.MinorVersion = zero
+				if src != nil {
+					dst.Configuration = src.Configuration
+				} else {
+					dst.Configuration = nil
+				}
 			}
 
 		default:
@@ -3192,49 +4065,68 @@ func (dst *MACCommand_RekeyInd) SetFields(src *MACCommand_RekeyInd, paths ...str
 	return nil
 }
 
-func (dst *MACCommand_RekeyConf) SetFields(src *MACCommand_RekeyConf, paths ...string) error {
+func (dst *MACCommand_RelayConfAns) SetFields(src *MACCommand_RelayConfAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "minor_version":
+		case "second_channel_frequency_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'minor_version' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'second_channel_frequency_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.MinorVersion = src.MinorVersion
+				dst.SecondChannelFrequencyAck = src.SecondChannelFrequencyAck
 			} else {
-				var zero Minor
-				dst.MinorVersion = zero
+				var zero bool
+				dst.SecondChannelFrequencyAck = zero
 			}
-
-		default:
-			return fmt.Errorf("invalid field: '%s'", name)
-		}
-	}
-	return nil
-}
-
-func (dst *MACCommand_ADRParamSetupReq) SetFields(src *MACCommand_ADRParamSetupReq, paths ...string) error {
-	for name, subs := range _processPaths(paths) {
-		switch name {
-		case "adr_ack_limit_exponent":
+		case "second_channel_ack_offset_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'adr_ack_limit_exponent' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'second_channel_ack_offset_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.AdrAckLimitExponent = src.AdrAckLimitExponent
+				dst.SecondChannelAckOffsetAck = src.SecondChannelAckOffsetAck
 			} else {
-				var zero ADRAckLimitExponent
-				dst.AdrAckLimitExponent = zero
+				var zero bool
+				dst.SecondChannelAckOffsetAck = zero
 			}
-		case "adr_ack_delay_exponent":
+		case "second_channel_data_rate_index_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'adr_ack_delay_exponent' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'second_channel_data_rate_index_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.AdrAckDelayExponent = src.AdrAckDelayExponent
+				dst.SecondChannelDataRateIndexAck = src.SecondChannelDataRateIndexAck
 			} else {
-				var zero ADRAckDelayExponent
-				dst.AdrAckDelayExponent = zero
+				var zero bool
+				dst.SecondChannelDataRateIndexAck = zero
+			}
+		case "second_channel_index_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'second_channel_index_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SecondChannelIndexAck = src.SecondChannelIndexAck
+			} else {
+				var zero bool
+				dst.SecondChannelIndexAck = zero
+			}
+		case "default_channel_index_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'default_channel_index_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DefaultChannelIndexAck = src.DefaultChannelIndexAck
+			} else {
+				var zero bool
+				dst.DefaultChannelIndexAck = zero
+			}
+		case "cad_periodicity_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'cad_periodicity_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CadPeriodicityAck = src.CadPeriodicityAck
+			} else {
+				var zero bool
+				dst.CadPeriodicityAck = zero
 			}
 
 		default:
@@ -3244,18 +4136,33 @@ func (dst *MACCommand_ADRParamSetupReq) SetFields(src *MACCommand_ADRParamSetupR
 	return nil
 }
 
-func (dst *MACCommand_DeviceTimeAns) SetFields(src *MACCommand_DeviceTimeAns, paths ...string) error {
+func (dst *MACCommand_RelayEndDeviceConfReq) SetFields(src *MACCommand_RelayEndDeviceConfReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "time":
+		case "configuration":
 			if len(subs) > 0 {
-				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
-			}
-			if src != nil {
-				dst.Time = src.Time
+				var newDst, newSrc *MACCommand_RelayEndDeviceConfReq_Configuration
+				if (src == nil || src.Configuration == nil) && dst.Configuration == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Configuration
+				}
+				if dst.Configuration != nil {
+					newDst = dst.Configuration
+				} else {
+					newDst = &MACCommand_RelayEndDeviceConfReq_Configuration{}
+					dst.Configuration = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
 			} else {
-				var zero time.Time
-				dst.Time = zero
+				if src != nil {
+					dst.Configuration = src.Configuration
+				} else {
+					dst.Configuration = nil
+				}
 			}
 
 		default:
@@ -3265,48 +4172,48 @@ func (dst *MACCommand_DeviceTimeAns) SetFields(src *MACCommand_DeviceTimeAns, pa
 	return nil
 }
 
-func (dst *MACCommand_ForceRejoinReq) SetFields(src *MACCommand_ForceRejoinReq, paths ...string) error {
+func (dst *MACCommand_RelayEndDeviceConfAns) SetFields(src *MACCommand_RelayEndDeviceConfAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "rejoin_type":
+		case "second_channel_frequency_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'rejoin_type' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'second_channel_frequency_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.RejoinType = src.RejoinType
+				dst.SecondChannelFrequencyAck = src.SecondChannelFrequencyAck
 			} else {
-				var zero RejoinRequestType
-				dst.RejoinType = zero
+				var zero bool
+				dst.SecondChannelFrequencyAck = zero
 			}
-		case "data_rate_index":
+		case "second_channel_data_rate_index_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'second_channel_data_rate_index_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.DataRateIndex = src.DataRateIndex
+				dst.SecondChannelDataRateIndexAck = src.SecondChannelDataRateIndexAck
 			} else {
-				var zero DataRateIndex
-				dst.DataRateIndex = zero
+				var zero bool
+				dst.SecondChannelDataRateIndexAck = zero
 			}
-		case "max_retries":
+		case "second_channel_index_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'max_retries' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'second_channel_index_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.MaxRetries = src.MaxRetries
+				dst.SecondChannelIndexAck = src.SecondChannelIndexAck
 			} else {
-				var zero uint32
-				dst.MaxRetries = zero
+				var zero bool
+				dst.SecondChannelIndexAck = zero
 			}
-		case "period_exponent":
+		case "backoff_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'period_exponent' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'backoff_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.PeriodExponent = src.PeriodExponent
+				dst.BackoffAck = src.BackoffAck
 			} else {
-				var zero RejoinPeriodExponent
-				dst.PeriodExponent = zero
+				var zero bool
+				dst.BackoffAck = zero
 			}
 
 		default:
@@ -3316,28 +4223,48 @@ func (dst *MACCommand_ForceRejoinReq) SetFields(src *MACCommand_ForceRejoinReq,
 	return nil
 }
 
-func (dst *MACCommand_RejoinParamSetupReq) SetFields(src *MACCommand_RejoinParamSetupReq, paths ...string) error {
+func (dst *MACCommand_RelayFilterListReq) SetFields(src *MACCommand_RelayFilterListReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "max_count_exponent":
+		case "rule_index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'max_count_exponent' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'rule_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.MaxCountExponent = src.MaxCountExponent
+				dst.RuleIndex = src.RuleIndex
 			} else {
-				var zero RejoinCountExponent
-				dst.MaxCountExponent = zero
+				var zero uint32
+				dst.RuleIndex = zero
 			}
-		case "max_time_exponent":
+		case "action":
 			if len(subs) > 0 {
-				return fmt.Errorf("'max_time_exponent' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'action' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.MaxTimeExponent = src.MaxTimeExponent
+				dst.Action = src.Action
+			} else {
+				var zero RelayJoinRequestFilterAction
+				dst.Action = zero
+			}
+		case "join_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinEui = src.JoinEui
+			} else {
+				var zero go_thethings_network_lorawan_stack_v3_pkg_types.EUI64
+				dst.JoinEui = zero
+			}
+		case "dev_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevEui = src.DevEui
 			} else {
-				var zero RejoinTimeExponent
-				dst.MaxTimeExponent = zero
+				var zero go_thethings_network_lorawan_stack_v3_pkg_types.EUI64
+				dst.DevEui = zero
 			}
 
 		default:
@@ -3347,39 +4274,38 @@ func (dst *MACCommand_RejoinParamSetupReq) SetFields(src *MACCommand_RejoinParam
 	return nil
 }
 
-func (dst *MACCommand_RejoinParamSetupAns) SetFields(src *MACCommand_RejoinParamSetupAns, paths ...string) error {
+func (dst *MACCommand_RelayFilterListAns) SetFields(src *MACCommand_RelayFilterListAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "max_time_exponent_ack":
+		case "action_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'max_time_exponent_ack' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'action_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.MaxTimeExponentAck = src.MaxTimeExponentAck
+				dst.ActionAck = src.ActionAck
 			} else {
 				var zero bool
-				dst.MaxTimeExponentAck = zero
+				dst.ActionAck = zero
 			}
-
-		default:
-			return fmt.Errorf("invalid field: '%s'", name)
-		}
-	}
-	return nil
-}
-
-func (dst *MACCommand_PingSlotInfoReq) SetFields(src *MACCommand_PingSlotInfoReq, paths ...string) error {
-	for name, subs := range _processPaths(paths) {
-		switch name {
-		case "period":
+		case "rule_index_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'period' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'rule_index_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Period = src.Period
+				dst.RuleIndexAck = src.RuleIndexAck
 			} else {
-				var zero PingSlotPeriod
-				dst.Period = zero
+				var zero bool
+				dst.RuleIndexAck = zero
+			}
+		case "combined_rules_ack":
+			if len(subs) > 0 {
+				return fmt.Errorf("'combined_rules_ack' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CombinedRulesAck = src.CombinedRulesAck
+			} else {
+				var zero bool
+				dst.CombinedRulesAck = zero
 			}
 
 		default:
@@ -3389,59 +4315,73 @@ func (dst *MACCommand_PingSlotInfoReq) SetFields(src *MACCommand_PingSlotInfoReq
 	return nil
 }
 
-func (dst *MACCommand_PingSlotChannelReq) SetFields(src *MACCommand_PingSlotChannelReq, paths ...string) error {
+func (dst *MACCommand_RelayUpdateUplinkListReq) SetFields(src *MACCommand_RelayUpdateUplinkListReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "frequency":
+		case "rule_index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'rule_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Frequency = src.Frequency
+				dst.RuleIndex = src.RuleIndex
 			} else {
-				var zero uint64
-				dst.Frequency = zero
+				var zero uint32
+				dst.RuleIndex = zero
 			}
-		case "data_rate_index":
+		case "forward_limits":
 			if len(subs) > 0 {
-				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+				var newDst, newSrc *RelayForwardLimits
+				if (src == nil || src.ForwardLimits == nil) && dst.ForwardLimits == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ForwardLimits
+				}
+				if dst.ForwardLimits != nil {
+					newDst = dst.ForwardLimits
+				} else {
+					newDst = &RelayForwardLimits{}
+					dst.ForwardLimits = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ForwardLimits = src.ForwardLimits
+				} else {
+					dst.ForwardLimits = nil
+				}
+			}
+		case "dev_addr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_addr' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.DataRateIndex = src.DataRateIndex
+				dst.DevAddr = src.DevAddr
 			} else {
-				var zero DataRateIndex
-				dst.DataRateIndex = zero
+				var zero go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr
+				dst.DevAddr = zero
 			}
-
-		default:
-			return fmt.Errorf("invalid field: '%s'", name)
-		}
-	}
-	return nil
-}
-
-func (dst *MACCommand_PingSlotChannelAns) SetFields(src *MACCommand_PingSlotChannelAns, paths ...string) error {
-	for name, subs := range _processPaths(paths) {
-		switch name {
-		case "frequency_ack":
+		case "w_f_cnt":
 			if len(subs) > 0 {
-				return fmt.Errorf("'frequency_ack' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'w_f_cnt' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.FrequencyAck = src.FrequencyAck
+				dst.WFCnt = src.WFCnt
 			} else {
-				var zero bool
-				dst.FrequencyAck = zero
+				var zero uint32
+				dst.WFCnt = zero
 			}
-		case "data_rate_index_ack":
+		case "root_wor_s_key":
 			if len(subs) > 0 {
-				return fmt.Errorf("'data_rate_index_ack' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'root_wor_s_key' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.DataRateIndexAck = src.DataRateIndexAck
+				dst.RootWorSKey = src.RootWorSKey
 			} else {
-				var zero bool
-				dst.DataRateIndexAck = zero
+				var zero go_thethings_network_lorawan_stack_v3_pkg_types.AES128Key
+				dst.RootWorSKey = zero
 			}
 
 		default:
@@ -3451,28 +4391,28 @@ func (dst *MACCommand_PingSlotChannelAns) SetFields(src *MACCommand_PingSlotChan
 	return nil
 }
 
-func (dst *MACCommand_BeaconTimingAns) SetFields(src *MACCommand_BeaconTimingAns, paths ...string) error {
+func (dst *MACCommand_RelayCtrlUplinkListReq) SetFields(src *MACCommand_RelayCtrlUplinkListReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "delay":
+		case "rule_index":
 			if len(subs) > 0 {
-				return fmt.Errorf("'delay' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'rule_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Delay = src.Delay
+				dst.RuleIndex = src.RuleIndex
 			} else {
 				var zero uint32
-				dst.Delay = zero
+				dst.RuleIndex = zero
 			}
-		case "channel_index":
+		case "action":
 			if len(subs) > 0 {
-				return fmt.Errorf("'channel_index' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'action' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.ChannelIndex = src.ChannelIndex
+				dst.Action = src.Action
 			} else {
-				var zero uint32
-				dst.ChannelIndex = zero
+				var zero RelayCtrlUplinkListAction
+				dst.Action = zero
 			}
 
 		default:
@@ -3482,18 +4422,28 @@ func (dst *MACCommand_BeaconTimingAns) SetFields(src *MACCommand_BeaconTimingAns
 	return nil
 }
 
-func (dst *MACCommand_BeaconFreqReq) SetFields(src *MACCommand_BeaconFreqReq, paths ...string) error {
+func (dst *MACCommand_RelayCtrlUplinkListAns) SetFields(src *MACCommand_RelayCtrlUplinkListAns, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "frequency":
+		case "rule_index_ack":
 			if len(subs) > 0 {
-				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'rule_index_ack' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Frequency = src.Frequency
+				dst.RuleIndexAck = src.RuleIndexAck
 			} else {
-				var zero uint64
-				dst.Frequency = zero
+				var zero bool
+				dst.RuleIndexAck = zero
+			}
+		case "w_f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'w_f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WFCnt = src.WFCnt
+			} else {
+				var zero uint32
+				dst.WFCnt = zero
 			}
 
 		default:
@@ -3503,18 +4453,38 @@ func (dst *MACCommand_BeaconFreqReq) SetFields(src *MACCommand_BeaconFreqReq, pa
 	return nil
 }
 
-func (dst *MACCommand_BeaconFreqAns) SetFields(src *MACCommand_BeaconFreqAns, paths ...string) error {
+func (dst *MACCommand_RelayNotifyNewEndDeviceReq) SetFields(src *MACCommand_RelayNotifyNewEndDeviceReq, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "frequency_ack":
+		case "dev_addr":
 			if len(subs) > 0 {
-				return fmt.Errorf("'frequency_ack' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'dev_addr' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.FrequencyAck = src.FrequencyAck
+				dst.DevAddr = src.DevAddr
 			} else {
-				var zero bool
-				dst.FrequencyAck = zero
+				var zero go_thethings_network_lorawan_stack_v3_pkg_types.DevAddr
+				dst.DevAddr = zero
+			}
+		case "snr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'snr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Snr = src.Snr
+			} else {
+				var zero int32
+				dst.Snr = zero
+			}
+		case "rssi":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rssi' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rssi = src.Rssi
+			} else {
+				var zero int32
+				dst.Rssi = zero
 			}
 
 		default:
@@ -3524,18 +4494,53 @@ func (dst *MACCommand_BeaconFreqAns) SetFields(src *MACCommand_BeaconFreqAns, pa
 	return nil
 }
 
-func (dst *MACCommand_DeviceModeInd) SetFields(src *MACCommand_DeviceModeInd, paths ...string) error {
+func (dst *MACCommand_RelayConfReq_Configuration) SetFields(src *MACCommand_RelayConfReq_Configuration, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "class":
+		case "second_channel":
 			if len(subs) > 0 {
-				return fmt.Errorf("'class' has no subfields, but %s were specified", subs)
+				var newDst, newSrc *RelaySecondChannel
+				if (src == nil || src.SecondChannel == nil) && dst.SecondChannel == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SecondChannel
+				}
+				if dst.SecondChannel != nil {
+					newDst = dst.SecondChannel
+				} else {
+					newDst = &RelaySecondChannel{}
+					dst.SecondChannel = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SecondChannel = src.SecondChannel
+				} else {
+					dst.SecondChannel = nil
+				}
+			}
+		case "default_channel_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'default_channel_index' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Class = src.Class
+				dst.DefaultChannelIndex = src.DefaultChannelIndex
 			} else {
-				var zero Class
-				dst.Class = zero
+				var zero uint32
+				dst.DefaultChannelIndex = zero
+			}
+		case "cad_periodicity":
+			if len(subs) > 0 {
+				return fmt.Errorf("'cad_periodicity' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CadPeriodicity = src.CadPeriodicity
+			} else {
+				var zero RelayCADPeriodicity
+				dst.CadPeriodicity = zero
 			}
 
 		default:
@@ -3545,18 +4550,163 @@ func (dst *MACCommand_DeviceModeInd) SetFields(src *MACCommand_DeviceModeInd, pa
 	return nil
 }
 
-func (dst *MACCommand_DeviceModeConf) SetFields(src *MACCommand_DeviceModeConf, paths ...string) error {
+func (dst *MACCommand_RelayEndDeviceConfReq_Configuration) SetFields(src *MACCommand_RelayEndDeviceConfReq_Configuration, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
-		case "class":
+		case "backoff":
 			if len(subs) > 0 {
-				return fmt.Errorf("'class' has no subfields, but %s were specified", subs)
+				return fmt.Errorf("'backoff' has no subfields, but %s were specified", subs)
 			}
 			if src != nil {
-				dst.Class = src.Class
+				dst.Backoff = src.Backoff
 			} else {
-				var zero Class
-				dst.Class = zero
+				var zero uint32
+				dst.Backoff = zero
+			}
+		case "second_channel":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelaySecondChannel
+				if (src == nil || src.SecondChannel == nil) && dst.SecondChannel == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SecondChannel
+				}
+				if dst.SecondChannel != nil {
+					newDst = dst.SecondChannel
+				} else {
+					newDst = &RelaySecondChannel{}
+					dst.SecondChannel = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SecondChannel = src.SecondChannel
+				} else {
+					dst.SecondChannel = nil
+				}
+			}
+
+		case "mode":
+			if len(subs) == 0 && src == nil {
+				dst.Mode = nil
+				continue
+			} else if len(subs) == 0 {
+				dst.Mode = src.Mode
+				continue
+			}
+
+			subPathMap := _processPaths(subs)
+			if len(subPathMap) > 1 {
+				return fmt.Errorf("more than one field specified for oneof field '%s'", name)
+			}
+			for oneofName, oneofSubs := range subPathMap {
+				switch oneofName {
+				case "always":
+					_, srcOk := src.Mode.(*MACCommand_RelayEndDeviceConfReq_Configuration_Always)
+					if !srcOk && src.Mode != nil {
+						return fmt.Errorf("attempt to set oneof 'always', while different oneof is set in source")
+					}
+					_, dstOk := dst.Mode.(*MACCommand_RelayEndDeviceConfReq_Configuration_Always)
+					if !dstOk && dst.Mode != nil {
+						return fmt.Errorf("attempt to set oneof 'always', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *RelayEndDeviceAlwaysMode
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Mode.(*MACCommand_RelayEndDeviceConfReq_Configuration_Always).Always
+						}
+						if dstOk {
+							newDst = dst.Mode.(*MACCommand_RelayEndDeviceConfReq_Configuration_Always).Always
+						} else {
+							newDst = &RelayEndDeviceAlwaysMode{}
+							dst.Mode = &MACCommand_RelayEndDeviceConfReq_Configuration_Always{Always: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Mode = src.Mode
+						} else {
+							dst.Mode = nil
+						}
+					}
+				case "dynamic":
+					_, srcOk := src.Mode.(*MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic)
+					if !srcOk && src.Mode != nil {
+						return fmt.Errorf("attempt to set oneof 'dynamic', while different oneof is set in source")
+					}
+					_, dstOk := dst.Mode.(*MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic)
+					if !dstOk && dst.Mode != nil {
+						return fmt.Errorf("attempt to set oneof 'dynamic', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *RelayEndDeviceDynamicMode
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Mode.(*MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic).Dynamic
+						}
+						if dstOk {
+							newDst = dst.Mode.(*MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic).Dynamic
+						} else {
+							newDst = &RelayEndDeviceDynamicMode{}
+							dst.Mode = &MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic{Dynamic: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Mode = src.Mode
+						} else {
+							dst.Mode = nil
+						}
+					}
+				case "end_device_controlled":
+					_, srcOk := src.Mode.(*MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled)
+					if !srcOk && src.Mode != nil {
+						return fmt.Errorf("attempt to set oneof 'end_device_controlled', while different oneof is set in source")
+					}
+					_, dstOk := dst.Mode.(*MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled)
+					if !dstOk && dst.Mode != nil {
+						return fmt.Errorf("attempt to set oneof 'end_device_controlled', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *RelayEndDeviceControlledMode
+						if !srcOk && !dstOk {
+							continue
+						}
+						if srcOk {
+							newSrc = src.Mode.(*MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled).EndDeviceControlled
+						}
+						if dstOk {
+							newDst = dst.Mode.(*MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled).EndDeviceControlled
+						} else {
+							newDst = &RelayEndDeviceControlledMode{}
+							dst.Mode = &MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled{EndDeviceControlled: newDst}
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Mode = src.Mode
+						} else {
+							dst.Mode = nil
+						}
+					}
+
+				default:
+					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
+				}
 			}
 
 		default:
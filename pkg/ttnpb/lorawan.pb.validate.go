@@ -2199,7 +2199,7 @@ func (m *MACCommand) ValidateFields(paths ...string) error {
 		case "payload":
 			if len(subs) == 0 {
 				subs = []string{
-					"raw_payload", "reset_ind", "reset_conf", "link_check_ans", "link_adr_req", "link_adr_ans", "duty_cycle_req", "rx_param_setup_req", "rx_param_setup_ans", "dev_status_ans", "new_channel_req", "new_channel_ans", "dl_channel_req", "dl_channel_ans", "rx_timing_setup_req", "tx_param_setup_req", "rekey_ind", "rekey_conf", "adr_param_setup_req", "device_time_ans", "force_rejoin_req", "rejoin_param_setup_req", "rejoin_param_setup_ans", "ping_slot_info_req", "ping_slot_channel_req", "ping_slot_channel_ans", "beacon_timing_ans", "beacon_freq_req", "beacon_freq_ans", "device_mode_ind", "device_mode_conf",
+					"raw_payload", "reset_ind", "reset_conf", "link_check_ans", "link_adr_req", "link_adr_ans", "duty_cycle_req", "rx_param_setup_req", "rx_param_setup_ans", "dev_status_ans", "new_channel_req", "new_channel_ans", "dl_channel_req", "dl_channel_ans", "rx_timing_setup_req", "tx_param_setup_req", "rekey_ind", "rekey_conf", "adr_param_setup_req", "device_time_ans", "force_rejoin_req", "rejoin_param_setup_req", "rejoin_param_setup_ans", "ping_slot_info_req", "ping_slot_channel_req", "ping_slot_channel_ans", "beacon_timing_ans", "beacon_freq_req", "beacon_freq_ans", "device_mode_ind", "device_mode_conf", "relay_conf_req", "relay_conf_ans", "relay_end_device_conf_req", "relay_end_device_conf_ans", "relay_filter_list_req", "relay_filter_list_ans", "relay_update_uplink_list_req", "relay_ctrl_uplink_list_req", "relay_ctrl_uplink_list_ans", "relay_notify_new_end_device_req",
 				}
 			}
 			for name, subs := range _processPaths(subs) {
@@ -2691,6 +2691,166 @@ func (m *MACCommand) ValidateFields(paths ...string) error {
 						}
 					}
 
+				case "relay_conf_req":
+					w, ok := m.Payload.(*MACCommand_RelayConfReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayConfReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_conf_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_conf_ans":
+					w, ok := m.Payload.(*MACCommand_RelayConfAns_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayConfAns()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_conf_ans",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_end_device_conf_req":
+					w, ok := m.Payload.(*MACCommand_RelayEndDeviceConfReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayEndDeviceConfReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_end_device_conf_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_end_device_conf_ans":
+					w, ok := m.Payload.(*MACCommand_RelayEndDeviceConfAns_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayEndDeviceConfAns()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_end_device_conf_ans",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_filter_list_req":
+					w, ok := m.Payload.(*MACCommand_RelayFilterListReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayFilterListReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_filter_list_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_filter_list_ans":
+					w, ok := m.Payload.(*MACCommand_RelayFilterListAns_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayFilterListAns()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_filter_list_ans",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_update_uplink_list_req":
+					w, ok := m.Payload.(*MACCommand_RelayUpdateUplinkListReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayUpdateUplinkListReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_update_uplink_list_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_ctrl_uplink_list_req":
+					w, ok := m.Payload.(*MACCommand_RelayCtrlUplinkListReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayCtrlUplinkListReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_ctrl_uplink_list_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_ctrl_uplink_list_ans":
+					w, ok := m.Payload.(*MACCommand_RelayCtrlUplinkListAns_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayCtrlUplinkListAns()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_ctrl_uplink_list_ans",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "relay_notify_new_end_device_req":
+					w, ok := m.Payload.(*MACCommand_RelayNotifyNewEndDeviceReq_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRelayNotifyNewEndDeviceReq()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommandValidationError{
+								field:  "relay_notify_new_end_device_req",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
 				}
 			}
 		default:
@@ -2761,32 +2921,50 @@ var _MACCommand_Cid_NotInLookup = map[MACCommandIdentifier]struct{}{
 	0: {},
 }
 
-// ValidateFields checks the field values on FrequencyValue with the rules
+// ValidateFields checks the field values on RelaySecondChannel with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *FrequencyValue) ValidateFields(paths ...string) error {
+func (m *RelaySecondChannel) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = FrequencyValueFieldPathsNested
+		paths = RelaySecondChannelFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "value":
+		case "ack_offset":
 
-			if m.GetValue() < 100000 {
-				return FrequencyValueValidationError{
-					field:  "value",
+			if _, ok := RelaySecondChAckOffset_name[int32(m.GetAckOffset())]; !ok {
+				return RelaySecondChannelValidationError{
+					field:  "ack_offset",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
+				return RelaySecondChannelValidationError{
+					field:  "data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "frequency":
+
+			if m.GetFrequency() < 100000 {
+				return RelaySecondChannelValidationError{
+					field:  "frequency",
 					reason: "value must be greater than or equal to 100000",
 				}
 			}
 
 		default:
-			return FrequencyValueValidationError{
+			return RelaySecondChannelValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -2795,9 +2973,9 @@ func (m *FrequencyValue) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// FrequencyValueValidationError is the validation error returned by
-// FrequencyValue.ValidateFields if the designated constraints aren't met.
-type FrequencyValueValidationError struct {
+// RelaySecondChannelValidationError is the validation error returned by
+// RelaySecondChannel.ValidateFields if the designated constraints aren't met.
+type RelaySecondChannelValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2805,22 +2983,24 @@ type FrequencyValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e FrequencyValueValidationError) Field() string { return e.field }
+func (e RelaySecondChannelValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FrequencyValueValidationError) Reason() string { return e.reason }
+func (e RelaySecondChannelValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FrequencyValueValidationError) Cause() error { return e.cause }
+func (e RelaySecondChannelValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FrequencyValueValidationError) Key() bool { return e.key }
+func (e RelaySecondChannelValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FrequencyValueValidationError) ErrorName() string { return "FrequencyValueValidationError" }
+func (e RelaySecondChannelValidationError) ErrorName() string {
+	return "RelaySecondChannelValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e FrequencyValueValidationError) Error() string {
+func (e RelaySecondChannelValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2832,14 +3012,14 @@ func (e FrequencyValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFrequencyValue.%s: %s%s",
+		"invalid %sRelaySecondChannel.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FrequencyValueValidationError{}
+var _ error = RelaySecondChannelValidationError{}
 
 var _ interface {
 	Field() string
@@ -2847,34 +3027,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FrequencyValueValidationError{}
+} = RelaySecondChannelValidationError{}
 
-// ValidateFields checks the field values on DataRateOffsetValue with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateFields checks the field values on RelayEndDeviceAlwaysMode with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DataRateOffsetValue) ValidateFields(paths ...string) error {
+func (m *RelayEndDeviceAlwaysMode) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = DataRateOffsetValueFieldPathsNested
+		paths = RelayEndDeviceAlwaysModeFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "value":
-
-			if _, ok := DataRateOffset_name[int32(m.GetValue())]; !ok {
-				return DataRateOffsetValueValidationError{
-					field:  "value",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
 		default:
-			return DataRateOffsetValueValidationError{
+			return RelayEndDeviceAlwaysModeValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -2883,9 +3054,10 @@ func (m *DataRateOffsetValue) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// DataRateOffsetValueValidationError is the validation error returned by
-// DataRateOffsetValue.ValidateFields if the designated constraints aren't met.
-type DataRateOffsetValueValidationError struct {
+// RelayEndDeviceAlwaysModeValidationError is the validation error returned by
+// RelayEndDeviceAlwaysMode.ValidateFields if the designated constraints
+// aren't met.
+type RelayEndDeviceAlwaysModeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2893,24 +3065,24 @@ type DataRateOffsetValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataRateOffsetValueValidationError) Field() string { return e.field }
+func (e RelayEndDeviceAlwaysModeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataRateOffsetValueValidationError) Reason() string { return e.reason }
+func (e RelayEndDeviceAlwaysModeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataRateOffsetValueValidationError) Cause() error { return e.cause }
+func (e RelayEndDeviceAlwaysModeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataRateOffsetValueValidationError) Key() bool { return e.key }
+func (e RelayEndDeviceAlwaysModeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataRateOffsetValueValidationError) ErrorName() string {
-	return "DataRateOffsetValueValidationError"
+func (e RelayEndDeviceAlwaysModeValidationError) ErrorName() string {
+	return "RelayEndDeviceAlwaysModeValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DataRateOffsetValueValidationError) Error() string {
+func (e RelayEndDeviceAlwaysModeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2922,14 +3094,14 @@ func (e DataRateOffsetValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataRateOffsetValue.%s: %s%s",
+		"invalid %sRelayEndDeviceAlwaysMode.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataRateOffsetValueValidationError{}
+var _ error = RelayEndDeviceAlwaysModeValidationError{}
 
 var _ interface {
 	Field() string
@@ -2937,34 +3109,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataRateOffsetValueValidationError{}
+} = RelayEndDeviceAlwaysModeValidationError{}
 
-// ValidateFields checks the field values on DataRateIndexValue with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateFields checks the field values on RelayEndDeviceDynamicMode with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DataRateIndexValue) ValidateFields(paths ...string) error {
+func (m *RelayEndDeviceDynamicMode) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = DataRateIndexValueFieldPathsNested
+		paths = RelayEndDeviceDynamicModeFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "value":
+		case "smart_enable_level":
 
-			if _, ok := DataRateIndex_name[int32(m.GetValue())]; !ok {
-				return DataRateIndexValueValidationError{
-					field:  "value",
+			if _, ok := RelaySmartEnableLevel_name[int32(m.GetSmartEnableLevel())]; !ok {
+				return RelayEndDeviceDynamicModeValidationError{
+					field:  "smart_enable_level",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return DataRateIndexValueValidationError{
+			return RelayEndDeviceDynamicModeValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -2973,9 +3145,10 @@ func (m *DataRateIndexValue) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// DataRateIndexValueValidationError is the validation error returned by
-// DataRateIndexValue.ValidateFields if the designated constraints aren't met.
-type DataRateIndexValueValidationError struct {
+// RelayEndDeviceDynamicModeValidationError is the validation error returned by
+// RelayEndDeviceDynamicMode.ValidateFields if the designated constraints
+// aren't met.
+type RelayEndDeviceDynamicModeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2983,24 +3156,24 @@ type DataRateIndexValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e DataRateIndexValueValidationError) Field() string { return e.field }
+func (e RelayEndDeviceDynamicModeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DataRateIndexValueValidationError) Reason() string { return e.reason }
+func (e RelayEndDeviceDynamicModeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DataRateIndexValueValidationError) Cause() error { return e.cause }
+func (e RelayEndDeviceDynamicModeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DataRateIndexValueValidationError) Key() bool { return e.key }
+func (e RelayEndDeviceDynamicModeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DataRateIndexValueValidationError) ErrorName() string {
-	return "DataRateIndexValueValidationError"
+func (e RelayEndDeviceDynamicModeValidationError) ErrorName() string {
+	return "RelayEndDeviceDynamicModeValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DataRateIndexValueValidationError) Error() string {
+func (e RelayEndDeviceDynamicModeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3012,14 +3185,14 @@ func (e DataRateIndexValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDataRateIndexValue.%s: %s%s",
+		"invalid %sRelayEndDeviceDynamicMode.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DataRateIndexValueValidationError{}
+var _ error = RelayEndDeviceDynamicModeValidationError{}
 
 var _ interface {
 	Field() string
@@ -3027,34 +3200,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DataRateIndexValueValidationError{}
+} = RelayEndDeviceDynamicModeValidationError{}
 
-// ValidateFields checks the field values on PingSlotPeriodValue with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *PingSlotPeriodValue) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on RelayEndDeviceControlledMode with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *RelayEndDeviceControlledMode) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = PingSlotPeriodValueFieldPathsNested
+		paths = RelayEndDeviceControlledModeFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "value":
-
-			if _, ok := PingSlotPeriod_name[int32(m.GetValue())]; !ok {
-				return PingSlotPeriodValueValidationError{
-					field:  "value",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
 		default:
-			return PingSlotPeriodValueValidationError{
+			return RelayEndDeviceControlledModeValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3063,9 +3227,10 @@ func (m *PingSlotPeriodValue) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// PingSlotPeriodValueValidationError is the validation error returned by
-// PingSlotPeriodValue.ValidateFields if the designated constraints aren't met.
-type PingSlotPeriodValueValidationError struct {
+// RelayEndDeviceControlledModeValidationError is the validation error returned
+// by RelayEndDeviceControlledMode.ValidateFields if the designated
+// constraints aren't met.
+type RelayEndDeviceControlledModeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3073,24 +3238,24 @@ type PingSlotPeriodValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e PingSlotPeriodValueValidationError) Field() string { return e.field }
+func (e RelayEndDeviceControlledModeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PingSlotPeriodValueValidationError) Reason() string { return e.reason }
+func (e RelayEndDeviceControlledModeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PingSlotPeriodValueValidationError) Cause() error { return e.cause }
+func (e RelayEndDeviceControlledModeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PingSlotPeriodValueValidationError) Key() bool { return e.key }
+func (e RelayEndDeviceControlledModeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PingSlotPeriodValueValidationError) ErrorName() string {
-	return "PingSlotPeriodValueValidationError"
+func (e RelayEndDeviceControlledModeValidationError) ErrorName() string {
+	return "RelayEndDeviceControlledModeValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PingSlotPeriodValueValidationError) Error() string {
+func (e RelayEndDeviceControlledModeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3102,14 +3267,14 @@ func (e PingSlotPeriodValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPingSlotPeriodValue.%s: %s%s",
+		"invalid %sRelayEndDeviceControlledMode.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PingSlotPeriodValueValidationError{}
+var _ error = RelayEndDeviceControlledModeValidationError{}
 
 var _ interface {
 	Field() string
@@ -3117,34 +3282,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PingSlotPeriodValueValidationError{}
+} = RelayEndDeviceControlledModeValidationError{}
 
-// ValidateFields checks the field values on AggregatedDutyCycleValue with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateFields checks the field values on RelayForwardLimits with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *AggregatedDutyCycleValue) ValidateFields(paths ...string) error {
+func (m *RelayForwardLimits) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = AggregatedDutyCycleValueFieldPathsNested
+		paths = RelayForwardLimitsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "value":
+		case "bucket_size":
 
-			if _, ok := AggregatedDutyCycle_name[int32(m.GetValue())]; !ok {
-				return AggregatedDutyCycleValueValidationError{
-					field:  "value",
+			if _, ok := RelayLimitBucketSize_name[int32(m.GetBucketSize())]; !ok {
+				return RelayForwardLimitsValidationError{
+					field:  "bucket_size",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
+		case "reload_rate":
+
+			if m.GetReloadRate() > 63 {
+				return RelayForwardLimitsValidationError{
+					field:  "reload_rate",
+					reason: "value must be less than or equal to 63",
+				}
+			}
+
 		default:
-			return AggregatedDutyCycleValueValidationError{
+			return RelayForwardLimitsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3153,10 +3327,9 @@ func (m *AggregatedDutyCycleValue) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// AggregatedDutyCycleValueValidationError is the validation error returned by
-// AggregatedDutyCycleValue.ValidateFields if the designated constraints
-// aren't met.
-type AggregatedDutyCycleValueValidationError struct {
+// RelayForwardLimitsValidationError is the validation error returned by
+// RelayForwardLimits.ValidateFields if the designated constraints aren't met.
+type RelayForwardLimitsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3164,24 +3337,24 @@ type AggregatedDutyCycleValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e AggregatedDutyCycleValueValidationError) Field() string { return e.field }
+func (e RelayForwardLimitsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AggregatedDutyCycleValueValidationError) Reason() string { return e.reason }
+func (e RelayForwardLimitsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AggregatedDutyCycleValueValidationError) Cause() error { return e.cause }
+func (e RelayForwardLimitsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AggregatedDutyCycleValueValidationError) Key() bool { return e.key }
+func (e RelayForwardLimitsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AggregatedDutyCycleValueValidationError) ErrorName() string {
-	return "AggregatedDutyCycleValueValidationError"
+func (e RelayForwardLimitsValidationError) ErrorName() string {
+	return "RelayForwardLimitsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AggregatedDutyCycleValueValidationError) Error() string {
+func (e RelayForwardLimitsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3193,14 +3366,14 @@ func (e AggregatedDutyCycleValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAggregatedDutyCycleValue.%s: %s%s",
+		"invalid %sRelayForwardLimits.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AggregatedDutyCycleValueValidationError{}
+var _ error = RelayForwardLimitsValidationError{}
 
 var _ interface {
 	Field() string
@@ -3208,34 +3381,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AggregatedDutyCycleValueValidationError{}
+} = RelayForwardLimitsValidationError{}
 
-// ValidateFields checks the field values on RxDelayValue with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateFields checks the field values on RelayForwardDownlinkReq with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *RxDelayValue) ValidateFields(paths ...string) error {
+func (m *RelayForwardDownlinkReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = RxDelayValueFieldPathsNested
+		paths = RelayForwardDownlinkReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "value":
+		case "raw_payload":
 
-			if _, ok := RxDelay_name[int32(m.GetValue())]; !ok {
-				return RxDelayValueValidationError{
-					field:  "value",
-					reason: "value must be one of the defined enum values",
+			if len(m.GetRawPayload()) < 1 {
+				return RelayForwardDownlinkReqValidationError{
+					field:  "raw_payload",
+					reason: "value length must be at least 1 bytes",
 				}
 			}
 
 		default:
-			return RxDelayValueValidationError{
+			return RelayForwardDownlinkReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3244,9 +3417,9 @@ func (m *RxDelayValue) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// RxDelayValueValidationError is the validation error returned by
-// RxDelayValue.ValidateFields if the designated constraints aren't met.
-type RxDelayValueValidationError struct {
+// RelayForwardDownlinkReqValidationError is the validation error returned by
+// RelayForwardDownlinkReq.ValidateFields if the designated constraints aren't met.
+type RelayForwardDownlinkReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3254,22 +3427,24 @@ type RxDelayValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e RxDelayValueValidationError) Field() string { return e.field }
+func (e RelayForwardDownlinkReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RxDelayValueValidationError) Reason() string { return e.reason }
+func (e RelayForwardDownlinkReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RxDelayValueValidationError) Cause() error { return e.cause }
+func (e RelayForwardDownlinkReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RxDelayValueValidationError) Key() bool { return e.key }
+func (e RelayForwardDownlinkReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RxDelayValueValidationError) ErrorName() string { return "RxDelayValueValidationError" }
+func (e RelayForwardDownlinkReqValidationError) ErrorName() string {
+	return "RelayForwardDownlinkReqValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RxDelayValueValidationError) Error() string {
+func (e RelayForwardDownlinkReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3281,14 +3456,14 @@ func (e RxDelayValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRxDelayValue.%s: %s%s",
+		"invalid %sRelayForwardDownlinkReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RxDelayValueValidationError{}
+var _ error = RelayForwardDownlinkReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -3296,18 +3471,18 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RxDelayValueValidationError{}
+} = RelayForwardDownlinkReqValidationError{}
 
-// ValidateFields checks the field values on ADRAckLimitExponentValue with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateFields checks the field values on FrequencyValue with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ADRAckLimitExponentValue) ValidateFields(paths ...string) error {
+func (m *FrequencyValue) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = ADRAckLimitExponentValueFieldPathsNested
+		paths = FrequencyValueFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
@@ -3315,15 +3490,15 @@ func (m *ADRAckLimitExponentValue) ValidateFields(paths ...string) error {
 		switch name {
 		case "value":
 
-			if _, ok := ADRAckLimitExponent_name[int32(m.GetValue())]; !ok {
-				return ADRAckLimitExponentValueValidationError{
+			if m.GetValue() < 100000 {
+				return FrequencyValueValidationError{
 					field:  "value",
-					reason: "value must be one of the defined enum values",
+					reason: "value must be greater than or equal to 100000",
 				}
 			}
 
 		default:
-			return ADRAckLimitExponentValueValidationError{
+			return FrequencyValueValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3332,10 +3507,9 @@ func (m *ADRAckLimitExponentValue) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// ADRAckLimitExponentValueValidationError is the validation error returned by
-// ADRAckLimitExponentValue.ValidateFields if the designated constraints
-// aren't met.
-type ADRAckLimitExponentValueValidationError struct {
+// FrequencyValueValidationError is the validation error returned by
+// FrequencyValue.ValidateFields if the designated constraints aren't met.
+type FrequencyValueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3343,24 +3517,22 @@ type ADRAckLimitExponentValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e ADRAckLimitExponentValueValidationError) Field() string { return e.field }
+func (e FrequencyValueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ADRAckLimitExponentValueValidationError) Reason() string { return e.reason }
+func (e FrequencyValueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ADRAckLimitExponentValueValidationError) Cause() error { return e.cause }
+func (e FrequencyValueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ADRAckLimitExponentValueValidationError) Key() bool { return e.key }
+func (e FrequencyValueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ADRAckLimitExponentValueValidationError) ErrorName() string {
-	return "ADRAckLimitExponentValueValidationError"
-}
+func (e FrequencyValueValidationError) ErrorName() string { return "FrequencyValueValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ADRAckLimitExponentValueValidationError) Error() string {
+func (e FrequencyValueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3372,14 +3544,14 @@ func (e ADRAckLimitExponentValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sADRAckLimitExponentValue.%s: %s%s",
+		"invalid %sFrequencyValue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ADRAckLimitExponentValueValidationError{}
+var _ error = FrequencyValueValidationError{}
 
 var _ interface {
 	Field() string
@@ -3387,18 +3559,18 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ADRAckLimitExponentValueValidationError{}
+} = FrequencyValueValidationError{}
 
-// ValidateFields checks the field values on ADRAckDelayExponentValue with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateFields checks the field values on DataRateOffsetValue with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ADRAckDelayExponentValue) ValidateFields(paths ...string) error {
+func (m *DataRateOffsetValue) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = ADRAckDelayExponentValueFieldPathsNested
+		paths = DataRateOffsetValueFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
@@ -3406,15 +3578,15 @@ func (m *ADRAckDelayExponentValue) ValidateFields(paths ...string) error {
 		switch name {
 		case "value":
 
-			if _, ok := ADRAckDelayExponent_name[int32(m.GetValue())]; !ok {
-				return ADRAckDelayExponentValueValidationError{
+			if _, ok := DataRateOffset_name[int32(m.GetValue())]; !ok {
+				return DataRateOffsetValueValidationError{
 					field:  "value",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return ADRAckDelayExponentValueValidationError{
+			return DataRateOffsetValueValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3423,10 +3595,9 @@ func (m *ADRAckDelayExponentValue) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// ADRAckDelayExponentValueValidationError is the validation error returned by
-// ADRAckDelayExponentValue.ValidateFields if the designated constraints
-// aren't met.
-type ADRAckDelayExponentValueValidationError struct {
+// DataRateOffsetValueValidationError is the validation error returned by
+// DataRateOffsetValue.ValidateFields if the designated constraints aren't met.
+type DataRateOffsetValueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3434,24 +3605,24 @@ type ADRAckDelayExponentValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e ADRAckDelayExponentValueValidationError) Field() string { return e.field }
+func (e DataRateOffsetValueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ADRAckDelayExponentValueValidationError) Reason() string { return e.reason }
+func (e DataRateOffsetValueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ADRAckDelayExponentValueValidationError) Cause() error { return e.cause }
+func (e DataRateOffsetValueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ADRAckDelayExponentValueValidationError) Key() bool { return e.key }
+func (e DataRateOffsetValueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ADRAckDelayExponentValueValidationError) ErrorName() string {
-	return "ADRAckDelayExponentValueValidationError"
+func (e DataRateOffsetValueValidationError) ErrorName() string {
+	return "DataRateOffsetValueValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ADRAckDelayExponentValueValidationError) Error() string {
+func (e DataRateOffsetValueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3463,14 +3634,14 @@ func (e ADRAckDelayExponentValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sADRAckDelayExponentValue.%s: %s%s",
+		"invalid %sDataRateOffsetValue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ADRAckDelayExponentValueValidationError{}
+var _ error = DataRateOffsetValueValidationError{}
 
 var _ interface {
 	Field() string
@@ -3478,18 +3649,18 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ADRAckDelayExponentValueValidationError{}
+} = DataRateOffsetValueValidationError{}
 
-// ValidateFields checks the field values on DeviceEIRPValue with the rules
+// ValidateFields checks the field values on DataRateIndexValue with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DeviceEIRPValue) ValidateFields(paths ...string) error {
+func (m *DataRateIndexValue) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = DeviceEIRPValueFieldPathsNested
+		paths = DataRateIndexValueFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
@@ -3497,15 +3668,15 @@ func (m *DeviceEIRPValue) ValidateFields(paths ...string) error {
 		switch name {
 		case "value":
 
-			if _, ok := DeviceEIRP_name[int32(m.GetValue())]; !ok {
-				return DeviceEIRPValueValidationError{
+			if _, ok := DataRateIndex_name[int32(m.GetValue())]; !ok {
+				return DataRateIndexValueValidationError{
 					field:  "value",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return DeviceEIRPValueValidationError{
+			return DataRateIndexValueValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3514,9 +3685,9 @@ func (m *DeviceEIRPValue) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// DeviceEIRPValueValidationError is the validation error returned by
-// DeviceEIRPValue.ValidateFields if the designated constraints aren't met.
-type DeviceEIRPValueValidationError struct {
+// DataRateIndexValueValidationError is the validation error returned by
+// DataRateIndexValue.ValidateFields if the designated constraints aren't met.
+type DataRateIndexValueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3524,22 +3695,24 @@ type DeviceEIRPValueValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeviceEIRPValueValidationError) Field() string { return e.field }
+func (e DataRateIndexValueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeviceEIRPValueValidationError) Reason() string { return e.reason }
+func (e DataRateIndexValueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeviceEIRPValueValidationError) Cause() error { return e.cause }
+func (e DataRateIndexValueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeviceEIRPValueValidationError) Key() bool { return e.key }
+func (e DataRateIndexValueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeviceEIRPValueValidationError) ErrorName() string { return "DeviceEIRPValueValidationError" }
+func (e DataRateIndexValueValidationError) ErrorName() string {
+	return "DataRateIndexValueValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DeviceEIRPValueValidationError) Error() string {
+func (e DataRateIndexValueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3551,14 +3724,14 @@ func (e DeviceEIRPValueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeviceEIRPValue.%s: %s%s",
+		"invalid %sDataRateIndexValue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeviceEIRPValueValidationError{}
+var _ error = DataRateIndexValueValidationError{}
 
 var _ interface {
 	Field() string
@@ -3566,31 +3739,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeviceEIRPValueValidationError{}
+} = DataRateIndexValueValidationError{}
 
-// ValidateFields checks the field values on TxSettings_Downlink with the rules
+// ValidateFields checks the field values on PingSlotPeriodValue with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *TxSettings_Downlink) ValidateFields(paths ...string) error {
+func (m *PingSlotPeriodValue) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = TxSettings_DownlinkFieldPathsNested
+		paths = PingSlotPeriodValueFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "antenna_index":
-			// no validation rules for AntennaIndex
-		case "tx_power":
-			// no validation rules for TxPower
-		case "invert_polarization":
-			// no validation rules for InvertPolarization
+		case "value":
+
+			if _, ok := PingSlotPeriod_name[int32(m.GetValue())]; !ok {
+				return PingSlotPeriodValueValidationError{
+					field:  "value",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
 		default:
-			return TxSettings_DownlinkValidationError{
+			return PingSlotPeriodValueValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3599,9 +3775,9 @@ func (m *TxSettings_Downlink) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// TxSettings_DownlinkValidationError is the validation error returned by
-// TxSettings_Downlink.ValidateFields if the designated constraints aren't met.
-type TxSettings_DownlinkValidationError struct {
+// PingSlotPeriodValueValidationError is the validation error returned by
+// PingSlotPeriodValue.ValidateFields if the designated constraints aren't met.
+type PingSlotPeriodValueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3609,24 +3785,24 @@ type TxSettings_DownlinkValidationError struct {
 }
 
 // Field function returns field value.
-func (e TxSettings_DownlinkValidationError) Field() string { return e.field }
+func (e PingSlotPeriodValueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TxSettings_DownlinkValidationError) Reason() string { return e.reason }
+func (e PingSlotPeriodValueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TxSettings_DownlinkValidationError) Cause() error { return e.cause }
+func (e PingSlotPeriodValueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TxSettings_DownlinkValidationError) Key() bool { return e.key }
+func (e PingSlotPeriodValueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TxSettings_DownlinkValidationError) ErrorName() string {
-	return "TxSettings_DownlinkValidationError"
+func (e PingSlotPeriodValueValidationError) ErrorName() string {
+	return "PingSlotPeriodValueValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TxSettings_DownlinkValidationError) Error() string {
+func (e PingSlotPeriodValueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3638,14 +3814,14 @@ func (e TxSettings_DownlinkValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTxSettings_Downlink.%s: %s%s",
+		"invalid %sPingSlotPeriodValue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TxSettings_DownlinkValidationError{}
+var _ error = PingSlotPeriodValueValidationError{}
 
 var _ interface {
 	Field() string
@@ -3653,41 +3829,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TxSettings_DownlinkValidationError{}
+} = PingSlotPeriodValueValidationError{}
 
-// ValidateFields checks the field values on MACCommand_ResetInd with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateFields checks the field values on AggregatedDutyCycleValue with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_ResetInd) ValidateFields(paths ...string) error {
+func (m *AggregatedDutyCycleValue) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_ResetIndFieldPathsNested
+		paths = AggregatedDutyCycleValueFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "minor_version":
-
-			if _, ok := _MACCommand_ResetInd_MinorVersion_InLookup[m.GetMinorVersion()]; !ok {
-				return MACCommand_ResetIndValidationError{
-					field:  "minor_version",
-					reason: "value must be in list [1]",
-				}
-			}
+		case "value":
 
-			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
-				return MACCommand_ResetIndValidationError{
-					field:  "minor_version",
+			if _, ok := AggregatedDutyCycle_name[int32(m.GetValue())]; !ok {
+				return AggregatedDutyCycleValueValidationError{
+					field:  "value",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_ResetIndValidationError{
+			return AggregatedDutyCycleValueValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3696,9 +3865,10 @@ func (m *MACCommand_ResetInd) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_ResetIndValidationError is the validation error returned by
-// MACCommand_ResetInd.ValidateFields if the designated constraints aren't met.
-type MACCommand_ResetIndValidationError struct {
+// AggregatedDutyCycleValueValidationError is the validation error returned by
+// AggregatedDutyCycleValue.ValidateFields if the designated constraints
+// aren't met.
+type AggregatedDutyCycleValueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3706,24 +3876,24 @@ type MACCommand_ResetIndValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_ResetIndValidationError) Field() string { return e.field }
+func (e AggregatedDutyCycleValueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_ResetIndValidationError) Reason() string { return e.reason }
+func (e AggregatedDutyCycleValueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_ResetIndValidationError) Cause() error { return e.cause }
+func (e AggregatedDutyCycleValueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_ResetIndValidationError) Key() bool { return e.key }
+func (e AggregatedDutyCycleValueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_ResetIndValidationError) ErrorName() string {
-	return "MACCommand_ResetIndValidationError"
+func (e AggregatedDutyCycleValueValidationError) ErrorName() string {
+	return "AggregatedDutyCycleValueValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_ResetIndValidationError) Error() string {
+func (e AggregatedDutyCycleValueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3735,14 +3905,14 @@ func (e MACCommand_ResetIndValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_ResetInd.%s: %s%s",
+		"invalid %sAggregatedDutyCycleValue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_ResetIndValidationError{}
+var _ error = AggregatedDutyCycleValueValidationError{}
 
 var _ interface {
 	Field() string
@@ -3750,45 +3920,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_ResetIndValidationError{}
-
-var _MACCommand_ResetInd_MinorVersion_InLookup = map[Minor]struct{}{
-	1: {},
-}
+} = AggregatedDutyCycleValueValidationError{}
 
-// ValidateFields checks the field values on MACCommand_ResetConf with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateFields checks the field values on RxDelayValue with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_ResetConf) ValidateFields(paths ...string) error {
+func (m *RxDelayValue) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_ResetConfFieldPathsNested
+		paths = RxDelayValueFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "minor_version":
-
-			if _, ok := _MACCommand_ResetConf_MinorVersion_InLookup[m.GetMinorVersion()]; !ok {
-				return MACCommand_ResetConfValidationError{
-					field:  "minor_version",
-					reason: "value must be in list [1]",
-				}
-			}
+		case "value":
 
-			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
-				return MACCommand_ResetConfValidationError{
-					field:  "minor_version",
+			if _, ok := RxDelay_name[int32(m.GetValue())]; !ok {
+				return RxDelayValueValidationError{
+					field:  "value",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_ResetConfValidationError{
+			return RxDelayValueValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3797,9 +3956,9 @@ func (m *MACCommand_ResetConf) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_ResetConfValidationError is the validation error returned by
-// MACCommand_ResetConf.ValidateFields if the designated constraints aren't met.
-type MACCommand_ResetConfValidationError struct {
+// RxDelayValueValidationError is the validation error returned by
+// RxDelayValue.ValidateFields if the designated constraints aren't met.
+type RxDelayValueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3807,24 +3966,22 @@ type MACCommand_ResetConfValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_ResetConfValidationError) Field() string { return e.field }
+func (e RxDelayValueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_ResetConfValidationError) Reason() string { return e.reason }
+func (e RxDelayValueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_ResetConfValidationError) Cause() error { return e.cause }
+func (e RxDelayValueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_ResetConfValidationError) Key() bool { return e.key }
+func (e RxDelayValueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_ResetConfValidationError) ErrorName() string {
-	return "MACCommand_ResetConfValidationError"
-}
+func (e RxDelayValueValidationError) ErrorName() string { return "RxDelayValueValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_ResetConfValidationError) Error() string {
+func (e RxDelayValueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3836,14 +3993,14 @@ func (e MACCommand_ResetConfValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_ResetConf.%s: %s%s",
+		"invalid %sRxDelayValue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_ResetConfValidationError{}
+var _ error = RxDelayValueValidationError{}
 
 var _ interface {
 	Field() string
@@ -3851,47 +4008,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_ResetConfValidationError{}
-
-var _MACCommand_ResetConf_MinorVersion_InLookup = map[Minor]struct{}{
-	1: {},
-}
+} = RxDelayValueValidationError{}
 
-// ValidateFields checks the field values on MACCommand_LinkCheckAns with the
+// ValidateFields checks the field values on ADRAckLimitExponentValue with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_LinkCheckAns) ValidateFields(paths ...string) error {
+func (m *ADRAckLimitExponentValue) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_LinkCheckAnsFieldPathsNested
+		paths = ADRAckLimitExponentValueFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "margin":
-
-			if m.GetMargin() > 254 {
-				return MACCommand_LinkCheckAnsValidationError{
-					field:  "margin",
-					reason: "value must be less than or equal to 254",
-				}
-			}
-
-		case "gateway_count":
+		case "value":
 
-			if m.GetGatewayCount() > 255 {
-				return MACCommand_LinkCheckAnsValidationError{
-					field:  "gateway_count",
-					reason: "value must be less than or equal to 255",
+			if _, ok := ADRAckLimitExponent_name[int32(m.GetValue())]; !ok {
+				return ADRAckLimitExponentValueValidationError{
+					field:  "value",
+					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_LinkCheckAnsValidationError{
+			return ADRAckLimitExponentValueValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -3900,9 +4044,10 @@ func (m *MACCommand_LinkCheckAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_LinkCheckAnsValidationError is the validation error returned by
-// MACCommand_LinkCheckAns.ValidateFields if the designated constraints aren't met.
-type MACCommand_LinkCheckAnsValidationError struct {
+// ADRAckLimitExponentValueValidationError is the validation error returned by
+// ADRAckLimitExponentValue.ValidateFields if the designated constraints
+// aren't met.
+type ADRAckLimitExponentValueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3910,24 +4055,24 @@ type MACCommand_LinkCheckAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_LinkCheckAnsValidationError) Field() string { return e.field }
+func (e ADRAckLimitExponentValueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_LinkCheckAnsValidationError) Reason() string { return e.reason }
+func (e ADRAckLimitExponentValueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_LinkCheckAnsValidationError) Cause() error { return e.cause }
+func (e ADRAckLimitExponentValueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_LinkCheckAnsValidationError) Key() bool { return e.key }
+func (e ADRAckLimitExponentValueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_LinkCheckAnsValidationError) ErrorName() string {
-	return "MACCommand_LinkCheckAnsValidationError"
+func (e ADRAckLimitExponentValueValidationError) ErrorName() string {
+	return "ADRAckLimitExponentValueValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_LinkCheckAnsValidationError) Error() string {
+func (e ADRAckLimitExponentValueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3939,14 +4084,14 @@ func (e MACCommand_LinkCheckAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_LinkCheckAns.%s: %s%s",
+		"invalid %sADRAckLimitExponentValue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_LinkCheckAnsValidationError{}
+var _ error = ADRAckLimitExponentValueValidationError{}
 
 var _ interface {
 	Field() string
@@ -3954,70 +4099,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_LinkCheckAnsValidationError{}
+} = ADRAckLimitExponentValueValidationError{}
 
-// ValidateFields checks the field values on MACCommand_LinkADRReq with the
+// ValidateFields checks the field values on ADRAckDelayExponentValue with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_LinkADRReq) ValidateFields(paths ...string) error {
+func (m *ADRAckDelayExponentValue) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_LinkADRReqFieldPathsNested
+		paths = ADRAckDelayExponentValueFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "data_rate_index":
+		case "value":
 
-			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
-				return MACCommand_LinkADRReqValidationError{
-					field:  "data_rate_index",
+			if _, ok := ADRAckDelayExponent_name[int32(m.GetValue())]; !ok {
+				return ADRAckDelayExponentValueValidationError{
+					field:  "value",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
-		case "tx_power_index":
-
-			if m.GetTxPowerIndex() > 15 {
-				return MACCommand_LinkADRReqValidationError{
-					field:  "tx_power_index",
-					reason: "value must be less than or equal to 15",
-				}
-			}
-
-		case "channel_mask":
-
-			if len(m.GetChannelMask()) > 16 {
-				return MACCommand_LinkADRReqValidationError{
-					field:  "channel_mask",
-					reason: "value must contain no more than 16 item(s)",
-				}
-			}
-
-		case "channel_mask_control":
-
-			if m.GetChannelMaskControl() > 7 {
-				return MACCommand_LinkADRReqValidationError{
-					field:  "channel_mask_control",
-					reason: "value must be less than or equal to 7",
-				}
-			}
-
-		case "nb_trans":
-
-			if m.GetNbTrans() > 15 {
-				return MACCommand_LinkADRReqValidationError{
-					field:  "nb_trans",
-					reason: "value must be less than or equal to 15",
-				}
-			}
-
 		default:
-			return MACCommand_LinkADRReqValidationError{
+			return ADRAckDelayExponentValueValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4026,9 +4135,10 @@ func (m *MACCommand_LinkADRReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_LinkADRReqValidationError is the validation error returned by
-// MACCommand_LinkADRReq.ValidateFields if the designated constraints aren't met.
-type MACCommand_LinkADRReqValidationError struct {
+// ADRAckDelayExponentValueValidationError is the validation error returned by
+// ADRAckDelayExponentValue.ValidateFields if the designated constraints
+// aren't met.
+type ADRAckDelayExponentValueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4036,24 +4146,24 @@ type MACCommand_LinkADRReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_LinkADRReqValidationError) Field() string { return e.field }
+func (e ADRAckDelayExponentValueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_LinkADRReqValidationError) Reason() string { return e.reason }
+func (e ADRAckDelayExponentValueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_LinkADRReqValidationError) Cause() error { return e.cause }
+func (e ADRAckDelayExponentValueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_LinkADRReqValidationError) Key() bool { return e.key }
+func (e ADRAckDelayExponentValueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_LinkADRReqValidationError) ErrorName() string {
-	return "MACCommand_LinkADRReqValidationError"
+func (e ADRAckDelayExponentValueValidationError) ErrorName() string {
+	return "ADRAckDelayExponentValueValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_LinkADRReqValidationError) Error() string {
+func (e ADRAckDelayExponentValueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4065,14 +4175,14 @@ func (e MACCommand_LinkADRReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_LinkADRReq.%s: %s%s",
+		"invalid %sADRAckDelayExponentValue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_LinkADRReqValidationError{}
+var _ error = ADRAckDelayExponentValueValidationError{}
 
 var _ interface {
 	Field() string
@@ -4080,31 +4190,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_LinkADRReqValidationError{}
+} = ADRAckDelayExponentValueValidationError{}
 
-// ValidateFields checks the field values on MACCommand_LinkADRAns with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateFields checks the field values on DeviceEIRPValue with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_LinkADRAns) ValidateFields(paths ...string) error {
+func (m *DeviceEIRPValue) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_LinkADRAnsFieldPathsNested
+		paths = DeviceEIRPValueFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "channel_mask_ack":
-			// no validation rules for ChannelMaskAck
-		case "data_rate_index_ack":
-			// no validation rules for DataRateIndexAck
-		case "tx_power_index_ack":
-			// no validation rules for TxPowerIndexAck
+		case "value":
+
+			if _, ok := DeviceEIRP_name[int32(m.GetValue())]; !ok {
+				return DeviceEIRPValueValidationError{
+					field:  "value",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
 		default:
-			return MACCommand_LinkADRAnsValidationError{
+			return DeviceEIRPValueValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4113,9 +4226,9 @@ func (m *MACCommand_LinkADRAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_LinkADRAnsValidationError is the validation error returned by
-// MACCommand_LinkADRAns.ValidateFields if the designated constraints aren't met.
-type MACCommand_LinkADRAnsValidationError struct {
+// DeviceEIRPValueValidationError is the validation error returned by
+// DeviceEIRPValue.ValidateFields if the designated constraints aren't met.
+type DeviceEIRPValueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4123,24 +4236,22 @@ type MACCommand_LinkADRAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_LinkADRAnsValidationError) Field() string { return e.field }
+func (e DeviceEIRPValueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_LinkADRAnsValidationError) Reason() string { return e.reason }
+func (e DeviceEIRPValueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_LinkADRAnsValidationError) Cause() error { return e.cause }
+func (e DeviceEIRPValueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_LinkADRAnsValidationError) Key() bool { return e.key }
+func (e DeviceEIRPValueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_LinkADRAnsValidationError) ErrorName() string {
-	return "MACCommand_LinkADRAnsValidationError"
-}
+func (e DeviceEIRPValueValidationError) ErrorName() string { return "DeviceEIRPValueValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_LinkADRAnsValidationError) Error() string {
+func (e DeviceEIRPValueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4152,14 +4263,14 @@ func (e MACCommand_LinkADRAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_LinkADRAns.%s: %s%s",
+		"invalid %sDeviceEIRPValue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_LinkADRAnsValidationError{}
+var _ error = DeviceEIRPValueValidationError{}
 
 var _ interface {
 	Field() string
@@ -4167,34 +4278,1685 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_LinkADRAnsValidationError{}
+} = DeviceEIRPValueValidationError{}
 
-// ValidateFields checks the field values on MACCommand_DutyCycleReq with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateFields checks the field values on TxSettings_Downlink with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_DutyCycleReq) ValidateFields(paths ...string) error {
+func (m *TxSettings_Downlink) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_DutyCycleReqFieldPathsNested
+		paths = TxSettings_DownlinkFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "max_duty_cycle":
+		case "antenna_index":
+			// no validation rules for AntennaIndex
+		case "tx_power":
+			// no validation rules for TxPower
+		case "invert_polarization":
+			// no validation rules for InvertPolarization
+		default:
+			return TxSettings_DownlinkValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// TxSettings_DownlinkValidationError is the validation error returned by
+// TxSettings_Downlink.ValidateFields if the designated constraints aren't met.
+type TxSettings_DownlinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxSettings_DownlinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxSettings_DownlinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxSettings_DownlinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxSettings_DownlinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxSettings_DownlinkValidationError) ErrorName() string {
+	return "TxSettings_DownlinkValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TxSettings_DownlinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxSettings_Downlink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxSettings_DownlinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxSettings_DownlinkValidationError{}
+
+// ValidateFields checks the field values on MACCommand_ResetInd with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_ResetInd) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_ResetIndFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "minor_version":
+
+			if _, ok := _MACCommand_ResetInd_MinorVersion_InLookup[m.GetMinorVersion()]; !ok {
+				return MACCommand_ResetIndValidationError{
+					field:  "minor_version",
+					reason: "value must be in list [1]",
+				}
+			}
+
+			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
+				return MACCommand_ResetIndValidationError{
+					field:  "minor_version",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return MACCommand_ResetIndValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_ResetIndValidationError is the validation error returned by
+// MACCommand_ResetInd.ValidateFields if the designated constraints aren't met.
+type MACCommand_ResetIndValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_ResetIndValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_ResetIndValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_ResetIndValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_ResetIndValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_ResetIndValidationError) ErrorName() string {
+	return "MACCommand_ResetIndValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_ResetIndValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_ResetInd.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_ResetIndValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_ResetIndValidationError{}
+
+var _MACCommand_ResetInd_MinorVersion_InLookup = map[Minor]struct{}{
+	1: {},
+}
+
+// ValidateFields checks the field values on MACCommand_ResetConf with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_ResetConf) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_ResetConfFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "minor_version":
+
+			if _, ok := _MACCommand_ResetConf_MinorVersion_InLookup[m.GetMinorVersion()]; !ok {
+				return MACCommand_ResetConfValidationError{
+					field:  "minor_version",
+					reason: "value must be in list [1]",
+				}
+			}
+
+			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
+				return MACCommand_ResetConfValidationError{
+					field:  "minor_version",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return MACCommand_ResetConfValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_ResetConfValidationError is the validation error returned by
+// MACCommand_ResetConf.ValidateFields if the designated constraints aren't met.
+type MACCommand_ResetConfValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_ResetConfValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_ResetConfValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_ResetConfValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_ResetConfValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_ResetConfValidationError) ErrorName() string {
+	return "MACCommand_ResetConfValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_ResetConfValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_ResetConf.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_ResetConfValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_ResetConfValidationError{}
+
+var _MACCommand_ResetConf_MinorVersion_InLookup = map[Minor]struct{}{
+	1: {},
+}
+
+// ValidateFields checks the field values on MACCommand_LinkCheckAns with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_LinkCheckAns) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_LinkCheckAnsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "margin":
+
+			if m.GetMargin() > 254 {
+				return MACCommand_LinkCheckAnsValidationError{
+					field:  "margin",
+					reason: "value must be less than or equal to 254",
+				}
+			}
+
+		case "gateway_count":
+
+			if m.GetGatewayCount() > 255 {
+				return MACCommand_LinkCheckAnsValidationError{
+					field:  "gateway_count",
+					reason: "value must be less than or equal to 255",
+				}
+			}
+
+		default:
+			return MACCommand_LinkCheckAnsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_LinkCheckAnsValidationError is the validation error returned by
+// MACCommand_LinkCheckAns.ValidateFields if the designated constraints aren't met.
+type MACCommand_LinkCheckAnsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_LinkCheckAnsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_LinkCheckAnsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_LinkCheckAnsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_LinkCheckAnsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_LinkCheckAnsValidationError) ErrorName() string {
+	return "MACCommand_LinkCheckAnsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_LinkCheckAnsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_LinkCheckAns.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_LinkCheckAnsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_LinkCheckAnsValidationError{}
+
+// ValidateFields checks the field values on MACCommand_LinkADRReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_LinkADRReq) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_LinkADRReqFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
+				return MACCommand_LinkADRReqValidationError{
+					field:  "data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "tx_power_index":
+
+			if m.GetTxPowerIndex() > 15 {
+				return MACCommand_LinkADRReqValidationError{
+					field:  "tx_power_index",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		case "channel_mask":
+
+			if len(m.GetChannelMask()) > 16 {
+				return MACCommand_LinkADRReqValidationError{
+					field:  "channel_mask",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+		case "channel_mask_control":
+
+			if m.GetChannelMaskControl() > 7 {
+				return MACCommand_LinkADRReqValidationError{
+					field:  "channel_mask_control",
+					reason: "value must be less than or equal to 7",
+				}
+			}
+
+		case "nb_trans":
+
+			if m.GetNbTrans() > 15 {
+				return MACCommand_LinkADRReqValidationError{
+					field:  "nb_trans",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		default:
+			return MACCommand_LinkADRReqValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_LinkADRReqValidationError is the validation error returned by
+// MACCommand_LinkADRReq.ValidateFields if the designated constraints aren't met.
+type MACCommand_LinkADRReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_LinkADRReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_LinkADRReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_LinkADRReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_LinkADRReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_LinkADRReqValidationError) ErrorName() string {
+	return "MACCommand_LinkADRReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_LinkADRReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_LinkADRReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_LinkADRReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_LinkADRReqValidationError{}
+
+// ValidateFields checks the field values on MACCommand_LinkADRAns with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_LinkADRAns) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_LinkADRAnsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "channel_mask_ack":
+			// no validation rules for ChannelMaskAck
+		case "data_rate_index_ack":
+			// no validation rules for DataRateIndexAck
+		case "tx_power_index_ack":
+			// no validation rules for TxPowerIndexAck
+		default:
+			return MACCommand_LinkADRAnsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_LinkADRAnsValidationError is the validation error returned by
+// MACCommand_LinkADRAns.ValidateFields if the designated constraints aren't met.
+type MACCommand_LinkADRAnsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_LinkADRAnsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_LinkADRAnsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_LinkADRAnsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_LinkADRAnsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_LinkADRAnsValidationError) ErrorName() string {
+	return "MACCommand_LinkADRAnsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_LinkADRAnsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_LinkADRAns.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_LinkADRAnsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_LinkADRAnsValidationError{}
+
+// ValidateFields checks the field values on MACCommand_DutyCycleReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_DutyCycleReq) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_DutyCycleReqFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "max_duty_cycle":
+
+			if _, ok := AggregatedDutyCycle_name[int32(m.GetMaxDutyCycle())]; !ok {
+				return MACCommand_DutyCycleReqValidationError{
+					field:  "max_duty_cycle",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return MACCommand_DutyCycleReqValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_DutyCycleReqValidationError is the validation error returned by
+// MACCommand_DutyCycleReq.ValidateFields if the designated constraints aren't met.
+type MACCommand_DutyCycleReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_DutyCycleReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_DutyCycleReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_DutyCycleReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_DutyCycleReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_DutyCycleReqValidationError) ErrorName() string {
+	return "MACCommand_DutyCycleReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_DutyCycleReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_DutyCycleReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_DutyCycleReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_DutyCycleReqValidationError{}
+
+// ValidateFields checks the field values on MACCommand_RxParamSetupReq with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_RxParamSetupReq) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RxParamSetupReqFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "rx2_data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetRx2DataRateIndex())]; !ok {
+				return MACCommand_RxParamSetupReqValidationError{
+					field:  "rx2_data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "rx1_data_rate_offset":
+
+			if _, ok := DataRateOffset_name[int32(m.GetRx1DataRateOffset())]; !ok {
+				return MACCommand_RxParamSetupReqValidationError{
+					field:  "rx1_data_rate_offset",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "rx2_frequency":
+
+			if m.GetRx2Frequency() < 100000 {
+				return MACCommand_RxParamSetupReqValidationError{
+					field:  "rx2_frequency",
+					reason: "value must be greater than or equal to 100000",
+				}
+			}
+
+		default:
+			return MACCommand_RxParamSetupReqValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_RxParamSetupReqValidationError is the validation error returned
+// by MACCommand_RxParamSetupReq.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_RxParamSetupReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_RxParamSetupReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_RxParamSetupReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_RxParamSetupReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_RxParamSetupReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_RxParamSetupReqValidationError) ErrorName() string {
+	return "MACCommand_RxParamSetupReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_RxParamSetupReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_RxParamSetupReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_RxParamSetupReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_RxParamSetupReqValidationError{}
+
+// ValidateFields checks the field values on MACCommand_RxParamSetupAns with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_RxParamSetupAns) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RxParamSetupAnsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "rx2_data_rate_index_ack":
+			// no validation rules for Rx2DataRateIndexAck
+		case "rx1_data_rate_offset_ack":
+			// no validation rules for Rx1DataRateOffsetAck
+		case "rx2_frequency_ack":
+			// no validation rules for Rx2FrequencyAck
+		default:
+			return MACCommand_RxParamSetupAnsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_RxParamSetupAnsValidationError is the validation error returned
+// by MACCommand_RxParamSetupAns.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_RxParamSetupAnsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_RxParamSetupAnsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_RxParamSetupAnsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_RxParamSetupAnsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_RxParamSetupAnsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_RxParamSetupAnsValidationError) ErrorName() string {
+	return "MACCommand_RxParamSetupAnsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_RxParamSetupAnsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_RxParamSetupAns.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_RxParamSetupAnsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_RxParamSetupAnsValidationError{}
+
+// ValidateFields checks the field values on MACCommand_DevStatusAns with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_DevStatusAns) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_DevStatusAnsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "battery":
+
+			if m.GetBattery() > 255 {
+				return MACCommand_DevStatusAnsValidationError{
+					field:  "battery",
+					reason: "value must be less than or equal to 255",
+				}
+			}
+
+		case "margin":
+
+			if val := m.GetMargin(); val < -32 || val > 31 {
+				return MACCommand_DevStatusAnsValidationError{
+					field:  "margin",
+					reason: "value must be inside range [-32, 31]",
+				}
+			}
+
+		default:
+			return MACCommand_DevStatusAnsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_DevStatusAnsValidationError is the validation error returned by
+// MACCommand_DevStatusAns.ValidateFields if the designated constraints aren't met.
+type MACCommand_DevStatusAnsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_DevStatusAnsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_DevStatusAnsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_DevStatusAnsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_DevStatusAnsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_DevStatusAnsValidationError) ErrorName() string {
+	return "MACCommand_DevStatusAnsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_DevStatusAnsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_DevStatusAns.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_DevStatusAnsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_DevStatusAnsValidationError{}
+
+// ValidateFields checks the field values on MACCommand_NewChannelReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_NewChannelReq) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_NewChannelReqFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "channel_index":
+
+			if m.GetChannelIndex() > 255 {
+				return MACCommand_NewChannelReqValidationError{
+					field:  "channel_index",
+					reason: "value must be less than or equal to 255",
+				}
+			}
+
+		case "frequency":
+
+			if val := m.GetFrequency(); val > 0 && val < 100000 {
+				return MACCommand_NewChannelReqValidationError{
+					field:  "frequency",
+					reason: "value must be outside range (0, 100000)",
+				}
+			}
+
+		case "min_data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetMinDataRateIndex())]; !ok {
+				return MACCommand_NewChannelReqValidationError{
+					field:  "min_data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "max_data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetMaxDataRateIndex())]; !ok {
+				return MACCommand_NewChannelReqValidationError{
+					field:  "max_data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return MACCommand_NewChannelReqValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_NewChannelReqValidationError is the validation error returned by
+// MACCommand_NewChannelReq.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_NewChannelReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_NewChannelReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_NewChannelReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_NewChannelReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_NewChannelReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_NewChannelReqValidationError) ErrorName() string {
+	return "MACCommand_NewChannelReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_NewChannelReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_NewChannelReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_NewChannelReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_NewChannelReqValidationError{}
+
+// ValidateFields checks the field values on MACCommand_NewChannelAns with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_NewChannelAns) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_NewChannelAnsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "frequency_ack":
+			// no validation rules for FrequencyAck
+		case "data_rate_ack":
+			// no validation rules for DataRateAck
+		default:
+			return MACCommand_NewChannelAnsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_NewChannelAnsValidationError is the validation error returned by
+// MACCommand_NewChannelAns.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_NewChannelAnsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_NewChannelAnsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_NewChannelAnsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_NewChannelAnsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_NewChannelAnsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_NewChannelAnsValidationError) ErrorName() string {
+	return "MACCommand_NewChannelAnsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_NewChannelAnsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_NewChannelAns.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_NewChannelAnsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_NewChannelAnsValidationError{}
+
+// ValidateFields checks the field values on MACCommand_DLChannelReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_DLChannelReq) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_DLChannelReqFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "channel_index":
+
+			if m.GetChannelIndex() > 255 {
+				return MACCommand_DLChannelReqValidationError{
+					field:  "channel_index",
+					reason: "value must be less than or equal to 255",
+				}
+			}
+
+		case "frequency":
+
+			if m.GetFrequency() < 100000 {
+				return MACCommand_DLChannelReqValidationError{
+					field:  "frequency",
+					reason: "value must be greater than or equal to 100000",
+				}
+			}
+
+		default:
+			return MACCommand_DLChannelReqValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_DLChannelReqValidationError is the validation error returned by
+// MACCommand_DLChannelReq.ValidateFields if the designated constraints aren't met.
+type MACCommand_DLChannelReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_DLChannelReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_DLChannelReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_DLChannelReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_DLChannelReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_DLChannelReqValidationError) ErrorName() string {
+	return "MACCommand_DLChannelReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_DLChannelReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_DLChannelReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_DLChannelReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_DLChannelReqValidationError{}
+
+// ValidateFields checks the field values on MACCommand_DLChannelAns with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_DLChannelAns) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_DLChannelAnsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "channel_index_ack":
+			// no validation rules for ChannelIndexAck
+		case "frequency_ack":
+			// no validation rules for FrequencyAck
+		default:
+			return MACCommand_DLChannelAnsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_DLChannelAnsValidationError is the validation error returned by
+// MACCommand_DLChannelAns.ValidateFields if the designated constraints aren't met.
+type MACCommand_DLChannelAnsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_DLChannelAnsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_DLChannelAnsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_DLChannelAnsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_DLChannelAnsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_DLChannelAnsValidationError) ErrorName() string {
+	return "MACCommand_DLChannelAnsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_DLChannelAnsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_DLChannelAns.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_DLChannelAnsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_DLChannelAnsValidationError{}
+
+// ValidateFields checks the field values on MACCommand_RxTimingSetupReq with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_RxTimingSetupReq) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RxTimingSetupReqFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "delay":
+
+			if _, ok := RxDelay_name[int32(m.GetDelay())]; !ok {
+				return MACCommand_RxTimingSetupReqValidationError{
+					field:  "delay",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return MACCommand_RxTimingSetupReqValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_RxTimingSetupReqValidationError is the validation error returned
+// by MACCommand_RxTimingSetupReq.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_RxTimingSetupReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_RxTimingSetupReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_RxTimingSetupReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_RxTimingSetupReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_RxTimingSetupReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_RxTimingSetupReqValidationError) ErrorName() string {
+	return "MACCommand_RxTimingSetupReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_RxTimingSetupReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_RxTimingSetupReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_RxTimingSetupReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_RxTimingSetupReqValidationError{}
+
+// ValidateFields checks the field values on MACCommand_TxParamSetupReq with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_TxParamSetupReq) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_TxParamSetupReqFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "max_eirp_index":
+
+			if _, ok := DeviceEIRP_name[int32(m.GetMaxEirpIndex())]; !ok {
+				return MACCommand_TxParamSetupReqValidationError{
+					field:  "max_eirp_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "uplink_dwell_time":
+			// no validation rules for UplinkDwellTime
+		case "downlink_dwell_time":
+			// no validation rules for DownlinkDwellTime
+		default:
+			return MACCommand_TxParamSetupReqValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_TxParamSetupReqValidationError is the validation error returned
+// by MACCommand_TxParamSetupReq.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_TxParamSetupReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_TxParamSetupReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_TxParamSetupReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_TxParamSetupReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_TxParamSetupReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_TxParamSetupReqValidationError) ErrorName() string {
+	return "MACCommand_TxParamSetupReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_TxParamSetupReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_TxParamSetupReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_TxParamSetupReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_TxParamSetupReqValidationError{}
+
+// ValidateFields checks the field values on MACCommand_RekeyInd with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_RekeyInd) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RekeyIndFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "minor_version":
+
+			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
+				return MACCommand_RekeyIndValidationError{
+					field:  "minor_version",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return MACCommand_RekeyIndValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_RekeyIndValidationError is the validation error returned by
+// MACCommand_RekeyInd.ValidateFields if the designated constraints aren't met.
+type MACCommand_RekeyIndValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_RekeyIndValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_RekeyIndValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_RekeyIndValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_RekeyIndValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_RekeyIndValidationError) ErrorName() string {
+	return "MACCommand_RekeyIndValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_RekeyIndValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_RekeyInd.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_RekeyIndValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_RekeyIndValidationError{}
 
-			if _, ok := AggregatedDutyCycle_name[int32(m.GetMaxDutyCycle())]; !ok {
-				return MACCommand_DutyCycleReqValidationError{
-					field:  "max_duty_cycle",
+// ValidateFields checks the field values on MACCommand_RekeyConf with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_RekeyConf) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_RekeyConfFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "minor_version":
+
+			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
+				return MACCommand_RekeyConfValidationError{
+					field:  "minor_version",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_DutyCycleReqValidationError{
+			return MACCommand_RekeyConfValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4203,9 +5965,9 @@ func (m *MACCommand_DutyCycleReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_DutyCycleReqValidationError is the validation error returned by
-// MACCommand_DutyCycleReq.ValidateFields if the designated constraints aren't met.
-type MACCommand_DutyCycleReqValidationError struct {
+// MACCommand_RekeyConfValidationError is the validation error returned by
+// MACCommand_RekeyConf.ValidateFields if the designated constraints aren't met.
+type MACCommand_RekeyConfValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4213,24 +5975,24 @@ type MACCommand_DutyCycleReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_DutyCycleReqValidationError) Field() string { return e.field }
+func (e MACCommand_RekeyConfValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_DutyCycleReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_RekeyConfValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_DutyCycleReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_RekeyConfValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_DutyCycleReqValidationError) Key() bool { return e.key }
+func (e MACCommand_RekeyConfValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_DutyCycleReqValidationError) ErrorName() string {
-	return "MACCommand_DutyCycleReqValidationError"
+func (e MACCommand_RekeyConfValidationError) ErrorName() string {
+	return "MACCommand_RekeyConfValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_DutyCycleReqValidationError) Error() string {
+func (e MACCommand_RekeyConfValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4242,14 +6004,14 @@ func (e MACCommand_DutyCycleReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_DutyCycleReq.%s: %s%s",
+		"invalid %sMACCommand_RekeyConf.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_DutyCycleReqValidationError{}
+var _ error = MACCommand_RekeyConfValidationError{}
 
 var _ interface {
 	Field() string
@@ -4257,52 +6019,127 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_DutyCycleReqValidationError{}
+} = MACCommand_RekeyConfValidationError{}
 
-// ValidateFields checks the field values on MACCommand_RxParamSetupReq with
+// ValidateFields checks the field values on MACCommand_ADRParamSetupReq with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
-func (m *MACCommand_RxParamSetupReq) ValidateFields(paths ...string) error {
+func (m *MACCommand_ADRParamSetupReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_RxParamSetupReqFieldPathsNested
+		paths = MACCommand_ADRParamSetupReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "rx2_data_rate_index":
+		case "adr_ack_limit_exponent":
 
-			if _, ok := DataRateIndex_name[int32(m.GetRx2DataRateIndex())]; !ok {
-				return MACCommand_RxParamSetupReqValidationError{
-					field:  "rx2_data_rate_index",
+			if _, ok := ADRAckLimitExponent_name[int32(m.GetAdrAckLimitExponent())]; !ok {
+				return MACCommand_ADRParamSetupReqValidationError{
+					field:  "adr_ack_limit_exponent",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
-		case "rx1_data_rate_offset":
+		case "adr_ack_delay_exponent":
 
-			if _, ok := DataRateOffset_name[int32(m.GetRx1DataRateOffset())]; !ok {
-				return MACCommand_RxParamSetupReqValidationError{
-					field:  "rx1_data_rate_offset",
+			if _, ok := ADRAckDelayExponent_name[int32(m.GetAdrAckDelayExponent())]; !ok {
+				return MACCommand_ADRParamSetupReqValidationError{
+					field:  "adr_ack_delay_exponent",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
-		case "rx2_frequency":
-
-			if m.GetRx2Frequency() < 100000 {
-				return MACCommand_RxParamSetupReqValidationError{
-					field:  "rx2_frequency",
-					reason: "value must be greater than or equal to 100000",
-				}
+		default:
+			return MACCommand_ADRParamSetupReqValidationError{
+				field:  name,
+				reason: "invalid field path",
 			}
+		}
+	}
+	return nil
+}
+
+// MACCommand_ADRParamSetupReqValidationError is the validation error returned
+// by MACCommand_ADRParamSetupReq.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_ADRParamSetupReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACCommand_ADRParamSetupReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACCommand_ADRParamSetupReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACCommand_ADRParamSetupReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACCommand_ADRParamSetupReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACCommand_ADRParamSetupReqValidationError) ErrorName() string {
+	return "MACCommand_ADRParamSetupReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACCommand_ADRParamSetupReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACCommand_ADRParamSetupReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACCommand_ADRParamSetupReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACCommand_ADRParamSetupReqValidationError{}
+
+// ValidateFields checks the field values on MACCommand_DeviceTimeAns with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_DeviceTimeAns) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACCommand_DeviceTimeAnsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "time":
 
 		default:
-			return MACCommand_RxParamSetupReqValidationError{
+			return MACCommand_DeviceTimeAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4311,10 +6148,10 @@ func (m *MACCommand_RxParamSetupReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_RxParamSetupReqValidationError is the validation error returned
-// by MACCommand_RxParamSetupReq.ValidateFields if the designated constraints
+// MACCommand_DeviceTimeAnsValidationError is the validation error returned by
+// MACCommand_DeviceTimeAns.ValidateFields if the designated constraints
 // aren't met.
-type MACCommand_RxParamSetupReqValidationError struct {
+type MACCommand_DeviceTimeAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4322,24 +6159,24 @@ type MACCommand_RxParamSetupReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_RxParamSetupReqValidationError) Field() string { return e.field }
+func (e MACCommand_DeviceTimeAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_RxParamSetupReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_DeviceTimeAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_RxParamSetupReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_DeviceTimeAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_RxParamSetupReqValidationError) Key() bool { return e.key }
+func (e MACCommand_DeviceTimeAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_RxParamSetupReqValidationError) ErrorName() string {
-	return "MACCommand_RxParamSetupReqValidationError"
+func (e MACCommand_DeviceTimeAnsValidationError) ErrorName() string {
+	return "MACCommand_DeviceTimeAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_RxParamSetupReqValidationError) Error() string {
+func (e MACCommand_DeviceTimeAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4351,14 +6188,14 @@ func (e MACCommand_RxParamSetupReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_RxParamSetupReq.%s: %s%s",
+		"invalid %sMACCommand_DeviceTimeAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_RxParamSetupReqValidationError{}
+var _ error = MACCommand_DeviceTimeAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -4366,31 +6203,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_RxParamSetupReqValidationError{}
+} = MACCommand_DeviceTimeAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_RxParamSetupAns with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, an error is returned.
-func (m *MACCommand_RxParamSetupAns) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_ForceRejoinReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_ForceRejoinReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_RxParamSetupAnsFieldPathsNested
+		paths = MACCommand_ForceRejoinReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "rx2_data_rate_index_ack":
-			// no validation rules for Rx2DataRateIndexAck
-		case "rx1_data_rate_offset_ack":
-			// no validation rules for Rx1DataRateOffsetAck
-		case "rx2_frequency_ack":
-			// no validation rules for Rx2FrequencyAck
+		case "rejoin_type":
+
+			if _, ok := RejoinRequestType_name[int32(m.GetRejoinType())]; !ok {
+				return MACCommand_ForceRejoinReqValidationError{
+					field:  "rejoin_type",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
+				return MACCommand_ForceRejoinReqValidationError{
+					field:  "data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "max_retries":
+
+			if m.GetMaxRetries() > 7 {
+				return MACCommand_ForceRejoinReqValidationError{
+					field:  "max_retries",
+					reason: "value must be less than or equal to 7",
+				}
+			}
+
+		case "period_exponent":
+
+			if _, ok := RejoinPeriodExponent_name[int32(m.GetPeriodExponent())]; !ok {
+				return MACCommand_ForceRejoinReqValidationError{
+					field:  "period_exponent",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
 		default:
-			return MACCommand_RxParamSetupAnsValidationError{
+			return MACCommand_ForceRejoinReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4399,10 +6266,10 @@ func (m *MACCommand_RxParamSetupAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_RxParamSetupAnsValidationError is the validation error returned
-// by MACCommand_RxParamSetupAns.ValidateFields if the designated constraints
+// MACCommand_ForceRejoinReqValidationError is the validation error returned by
+// MACCommand_ForceRejoinReq.ValidateFields if the designated constraints
 // aren't met.
-type MACCommand_RxParamSetupAnsValidationError struct {
+type MACCommand_ForceRejoinReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4410,24 +6277,24 @@ type MACCommand_RxParamSetupAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_RxParamSetupAnsValidationError) Field() string { return e.field }
+func (e MACCommand_ForceRejoinReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_RxParamSetupAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_ForceRejoinReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_RxParamSetupAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_ForceRejoinReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_RxParamSetupAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_ForceRejoinReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_RxParamSetupAnsValidationError) ErrorName() string {
-	return "MACCommand_RxParamSetupAnsValidationError"
+func (e MACCommand_ForceRejoinReqValidationError) ErrorName() string {
+	return "MACCommand_ForceRejoinReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_RxParamSetupAnsValidationError) Error() string {
+func (e MACCommand_ForceRejoinReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4439,14 +6306,14 @@ func (e MACCommand_RxParamSetupAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_RxParamSetupAns.%s: %s%s",
+		"invalid %sMACCommand_ForceRejoinReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_RxParamSetupAnsValidationError{}
+var _ error = MACCommand_ForceRejoinReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4454,43 +6321,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_RxParamSetupAnsValidationError{}
+} = MACCommand_ForceRejoinReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_DevStatusAns with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_DevStatusAns) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_RejoinParamSetupReq
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *MACCommand_RejoinParamSetupReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_DevStatusAnsFieldPathsNested
+		paths = MACCommand_RejoinParamSetupReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "battery":
+		case "max_count_exponent":
 
-			if m.GetBattery() > 255 {
-				return MACCommand_DevStatusAnsValidationError{
-					field:  "battery",
-					reason: "value must be less than or equal to 255",
+			if _, ok := RejoinCountExponent_name[int32(m.GetMaxCountExponent())]; !ok {
+				return MACCommand_RejoinParamSetupReqValidationError{
+					field:  "max_count_exponent",
+					reason: "value must be one of the defined enum values",
 				}
 			}
 
-		case "margin":
+		case "max_time_exponent":
 
-			if val := m.GetMargin(); val < -32 || val > 31 {
-				return MACCommand_DevStatusAnsValidationError{
-					field:  "margin",
-					reason: "value must be inside range [-32, 31]",
+			if _, ok := RejoinTimeExponent_name[int32(m.GetMaxTimeExponent())]; !ok {
+				return MACCommand_RejoinParamSetupReqValidationError{
+					field:  "max_time_exponent",
+					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_DevStatusAnsValidationError{
+			return MACCommand_RejoinParamSetupReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4499,9 +6366,10 @@ func (m *MACCommand_DevStatusAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_DevStatusAnsValidationError is the validation error returned by
-// MACCommand_DevStatusAns.ValidateFields if the designated constraints aren't met.
-type MACCommand_DevStatusAnsValidationError struct {
+// MACCommand_RejoinParamSetupReqValidationError is the validation error
+// returned by MACCommand_RejoinParamSetupReq.ValidateFields if the designated
+// constraints aren't met.
+type MACCommand_RejoinParamSetupReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4509,24 +6377,24 @@ type MACCommand_DevStatusAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_DevStatusAnsValidationError) Field() string { return e.field }
+func (e MACCommand_RejoinParamSetupReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_DevStatusAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_RejoinParamSetupReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_DevStatusAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_RejoinParamSetupReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_DevStatusAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_RejoinParamSetupReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_DevStatusAnsValidationError) ErrorName() string {
-	return "MACCommand_DevStatusAnsValidationError"
+func (e MACCommand_RejoinParamSetupReqValidationError) ErrorName() string {
+	return "MACCommand_RejoinParamSetupReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_DevStatusAnsValidationError) Error() string {
+func (e MACCommand_RejoinParamSetupReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4538,14 +6406,14 @@ func (e MACCommand_DevStatusAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_DevStatusAns.%s: %s%s",
+		"invalid %sMACCommand_RejoinParamSetupReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_DevStatusAnsValidationError{}
+var _ error = MACCommand_RejoinParamSetupReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4553,61 +6421,27 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_DevStatusAnsValidationError{}
+} = MACCommand_RejoinParamSetupReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_NewChannelReq with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_NewChannelReq) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_RejoinParamSetupAns
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *MACCommand_RejoinParamSetupAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_NewChannelReqFieldPathsNested
+		paths = MACCommand_RejoinParamSetupAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "channel_index":
-
-			if m.GetChannelIndex() > 255 {
-				return MACCommand_NewChannelReqValidationError{
-					field:  "channel_index",
-					reason: "value must be less than or equal to 255",
-				}
-			}
-
-		case "frequency":
-
-			if val := m.GetFrequency(); val > 0 && val < 100000 {
-				return MACCommand_NewChannelReqValidationError{
-					field:  "frequency",
-					reason: "value must be outside range (0, 100000)",
-				}
-			}
-
-		case "min_data_rate_index":
-
-			if _, ok := DataRateIndex_name[int32(m.GetMinDataRateIndex())]; !ok {
-				return MACCommand_NewChannelReqValidationError{
-					field:  "min_data_rate_index",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
-		case "max_data_rate_index":
-
-			if _, ok := DataRateIndex_name[int32(m.GetMaxDataRateIndex())]; !ok {
-				return MACCommand_NewChannelReqValidationError{
-					field:  "max_data_rate_index",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
+		case "max_time_exponent_ack":
+			// no validation rules for MaxTimeExponentAck
 		default:
-			return MACCommand_NewChannelReqValidationError{
+			return MACCommand_RejoinParamSetupAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4616,10 +6450,10 @@ func (m *MACCommand_NewChannelReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_NewChannelReqValidationError is the validation error returned by
-// MACCommand_NewChannelReq.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_NewChannelReqValidationError struct {
+// MACCommand_RejoinParamSetupAnsValidationError is the validation error
+// returned by MACCommand_RejoinParamSetupAns.ValidateFields if the designated
+// constraints aren't met.
+type MACCommand_RejoinParamSetupAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4627,24 +6461,24 @@ type MACCommand_NewChannelReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_NewChannelReqValidationError) Field() string { return e.field }
+func (e MACCommand_RejoinParamSetupAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_NewChannelReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_RejoinParamSetupAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_NewChannelReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_RejoinParamSetupAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_NewChannelReqValidationError) Key() bool { return e.key }
+func (e MACCommand_RejoinParamSetupAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_NewChannelReqValidationError) ErrorName() string {
-	return "MACCommand_NewChannelReqValidationError"
+func (e MACCommand_RejoinParamSetupAnsValidationError) ErrorName() string {
+	return "MACCommand_RejoinParamSetupAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_NewChannelReqValidationError) Error() string {
+func (e MACCommand_RejoinParamSetupAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4656,14 +6490,14 @@ func (e MACCommand_NewChannelReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_NewChannelReq.%s: %s%s",
+		"invalid %sMACCommand_RejoinParamSetupAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_NewChannelReqValidationError{}
+var _ error = MACCommand_RejoinParamSetupAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -4671,29 +6505,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_NewChannelReqValidationError{}
+} = MACCommand_RejoinParamSetupAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_NewChannelAns with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_NewChannelAns) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_PingSlotInfoReq with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_PingSlotInfoReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_NewChannelAnsFieldPathsNested
+		paths = MACCommand_PingSlotInfoReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "frequency_ack":
-			// no validation rules for FrequencyAck
-		case "data_rate_ack":
-			// no validation rules for DataRateAck
+		case "period":
+
+			if _, ok := PingSlotPeriod_name[int32(m.GetPeriod())]; !ok {
+				return MACCommand_PingSlotInfoReqValidationError{
+					field:  "period",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
 		default:
-			return MACCommand_NewChannelAnsValidationError{
+			return MACCommand_PingSlotInfoReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4702,10 +6541,10 @@ func (m *MACCommand_NewChannelAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_NewChannelAnsValidationError is the validation error returned by
-// MACCommand_NewChannelAns.ValidateFields if the designated constraints
+// MACCommand_PingSlotInfoReqValidationError is the validation error returned
+// by MACCommand_PingSlotInfoReq.ValidateFields if the designated constraints
 // aren't met.
-type MACCommand_NewChannelAnsValidationError struct {
+type MACCommand_PingSlotInfoReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4713,24 +6552,24 @@ type MACCommand_NewChannelAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_NewChannelAnsValidationError) Field() string { return e.field }
+func (e MACCommand_PingSlotInfoReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_NewChannelAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_PingSlotInfoReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_NewChannelAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_PingSlotInfoReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_NewChannelAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_PingSlotInfoReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_NewChannelAnsValidationError) ErrorName() string {
-	return "MACCommand_NewChannelAnsValidationError"
+func (e MACCommand_PingSlotInfoReqValidationError) ErrorName() string {
+	return "MACCommand_PingSlotInfoReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_NewChannelAnsValidationError) Error() string {
+func (e MACCommand_PingSlotInfoReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4742,14 +6581,14 @@ func (e MACCommand_NewChannelAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_NewChannelAns.%s: %s%s",
+		"invalid %sMACCommand_PingSlotInfoReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_NewChannelAnsValidationError{}
+var _ error = MACCommand_PingSlotInfoReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4757,43 +6596,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_NewChannelAnsValidationError{}
+} = MACCommand_PingSlotInfoReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_DLChannelReq with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_DLChannelReq) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_PingSlotChannelReq with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_PingSlotChannelReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_DLChannelReqFieldPathsNested
+		paths = MACCommand_PingSlotChannelReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "channel_index":
+		case "frequency":
 
-			if m.GetChannelIndex() > 255 {
-				return MACCommand_DLChannelReqValidationError{
-					field:  "channel_index",
-					reason: "value must be less than or equal to 255",
+			if val := m.GetFrequency(); val > 0 && val < 100000 {
+				return MACCommand_PingSlotChannelReqValidationError{
+					field:  "frequency",
+					reason: "value must be outside range (0, 100000)",
 				}
 			}
 
-		case "frequency":
+		case "data_rate_index":
 
-			if m.GetFrequency() < 100000 {
-				return MACCommand_DLChannelReqValidationError{
-					field:  "frequency",
-					reason: "value must be greater than or equal to 100000",
+			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
+				return MACCommand_PingSlotChannelReqValidationError{
+					field:  "data_rate_index",
+					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_DLChannelReqValidationError{
+			return MACCommand_PingSlotChannelReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4802,9 +6641,10 @@ func (m *MACCommand_DLChannelReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_DLChannelReqValidationError is the validation error returned by
-// MACCommand_DLChannelReq.ValidateFields if the designated constraints aren't met.
-type MACCommand_DLChannelReqValidationError struct {
+// MACCommand_PingSlotChannelReqValidationError is the validation error
+// returned by MACCommand_PingSlotChannelReq.ValidateFields if the designated
+// constraints aren't met.
+type MACCommand_PingSlotChannelReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4812,24 +6652,24 @@ type MACCommand_DLChannelReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_DLChannelReqValidationError) Field() string { return e.field }
+func (e MACCommand_PingSlotChannelReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_DLChannelReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_PingSlotChannelReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_DLChannelReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_PingSlotChannelReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_DLChannelReqValidationError) Key() bool { return e.key }
+func (e MACCommand_PingSlotChannelReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_DLChannelReqValidationError) ErrorName() string {
-	return "MACCommand_DLChannelReqValidationError"
+func (e MACCommand_PingSlotChannelReqValidationError) ErrorName() string {
+	return "MACCommand_PingSlotChannelReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_DLChannelReqValidationError) Error() string {
+func (e MACCommand_PingSlotChannelReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4841,14 +6681,14 @@ func (e MACCommand_DLChannelReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_DLChannelReq.%s: %s%s",
+		"invalid %sMACCommand_PingSlotChannelReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_DLChannelReqValidationError{}
+var _ error = MACCommand_PingSlotChannelReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -4856,29 +6696,29 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_DLChannelReqValidationError{}
+} = MACCommand_PingSlotChannelReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_DLChannelAns with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_DLChannelAns) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_PingSlotChannelAns with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACCommand_PingSlotChannelAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_DLChannelAnsFieldPathsNested
+		paths = MACCommand_PingSlotChannelAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "channel_index_ack":
-			// no validation rules for ChannelIndexAck
 		case "frequency_ack":
 			// no validation rules for FrequencyAck
+		case "data_rate_index_ack":
+			// no validation rules for DataRateIndexAck
 		default:
-			return MACCommand_DLChannelAnsValidationError{
+			return MACCommand_PingSlotChannelAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4887,9 +6727,10 @@ func (m *MACCommand_DLChannelAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_DLChannelAnsValidationError is the validation error returned by
-// MACCommand_DLChannelAns.ValidateFields if the designated constraints aren't met.
-type MACCommand_DLChannelAnsValidationError struct {
+// MACCommand_PingSlotChannelAnsValidationError is the validation error
+// returned by MACCommand_PingSlotChannelAns.ValidateFields if the designated
+// constraints aren't met.
+type MACCommand_PingSlotChannelAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4897,24 +6738,24 @@ type MACCommand_DLChannelAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_DLChannelAnsValidationError) Field() string { return e.field }
+func (e MACCommand_PingSlotChannelAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_DLChannelAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_PingSlotChannelAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_DLChannelAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_PingSlotChannelAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_DLChannelAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_PingSlotChannelAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_DLChannelAnsValidationError) ErrorName() string {
-	return "MACCommand_DLChannelAnsValidationError"
+func (e MACCommand_PingSlotChannelAnsValidationError) ErrorName() string {
+	return "MACCommand_PingSlotChannelAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_DLChannelAnsValidationError) Error() string {
+func (e MACCommand_PingSlotChannelAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4926,14 +6767,14 @@ func (e MACCommand_DLChannelAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_DLChannelAns.%s: %s%s",
+		"invalid %sMACCommand_PingSlotChannelAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_DLChannelAnsValidationError{}
+var _ error = MACCommand_PingSlotChannelAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -4941,18 +6782,18 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_DLChannelAnsValidationError{}
+} = MACCommand_PingSlotChannelAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_RxTimingSetupReq with
+// ValidateFields checks the field values on MACCommand_BeaconTimingAns with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
-func (m *MACCommand_RxTimingSetupReq) ValidateFields(paths ...string) error {
+func (m *MACCommand_BeaconTimingAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_RxTimingSetupReqFieldPathsNested
+		paths = MACCommand_BeaconTimingAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
@@ -4960,15 +6801,24 @@ func (m *MACCommand_RxTimingSetupReq) ValidateFields(paths ...string) error {
 		switch name {
 		case "delay":
 
-			if _, ok := RxDelay_name[int32(m.GetDelay())]; !ok {
-				return MACCommand_RxTimingSetupReqValidationError{
+			if m.GetDelay() > 65535 {
+				return MACCommand_BeaconTimingAnsValidationError{
 					field:  "delay",
-					reason: "value must be one of the defined enum values",
+					reason: "value must be less than or equal to 65535",
+				}
+			}
+
+		case "channel_index":
+
+			if m.GetChannelIndex() > 255 {
+				return MACCommand_BeaconTimingAnsValidationError{
+					field:  "channel_index",
+					reason: "value must be less than or equal to 255",
 				}
 			}
 
 		default:
-			return MACCommand_RxTimingSetupReqValidationError{
+			return MACCommand_BeaconTimingAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -4977,10 +6827,10 @@ func (m *MACCommand_RxTimingSetupReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_RxTimingSetupReqValidationError is the validation error returned
-// by MACCommand_RxTimingSetupReq.ValidateFields if the designated constraints
+// MACCommand_BeaconTimingAnsValidationError is the validation error returned
+// by MACCommand_BeaconTimingAns.ValidateFields if the designated constraints
 // aren't met.
-type MACCommand_RxTimingSetupReqValidationError struct {
+type MACCommand_BeaconTimingAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4988,24 +6838,24 @@ type MACCommand_RxTimingSetupReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_RxTimingSetupReqValidationError) Field() string { return e.field }
+func (e MACCommand_BeaconTimingAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_RxTimingSetupReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_BeaconTimingAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_RxTimingSetupReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_BeaconTimingAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_RxTimingSetupReqValidationError) Key() bool { return e.key }
+func (e MACCommand_BeaconTimingAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_RxTimingSetupReqValidationError) ErrorName() string {
-	return "MACCommand_RxTimingSetupReqValidationError"
+func (e MACCommand_BeaconTimingAnsValidationError) ErrorName() string {
+	return "MACCommand_BeaconTimingAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_RxTimingSetupReqValidationError) Error() string {
+func (e MACCommand_BeaconTimingAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5017,14 +6867,14 @@ func (e MACCommand_RxTimingSetupReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_RxTimingSetupReq.%s: %s%s",
+		"invalid %sMACCommand_BeaconTimingAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_RxTimingSetupReqValidationError{}
+var _ error = MACCommand_BeaconTimingAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -5032,38 +6882,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_RxTimingSetupReqValidationError{}
+} = MACCommand_BeaconTimingAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_TxParamSetupReq with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, an error is returned.
-func (m *MACCommand_TxParamSetupReq) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_BeaconFreqReq with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_BeaconFreqReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_TxParamSetupReqFieldPathsNested
+		paths = MACCommand_BeaconFreqReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "max_eirp_index":
+		case "frequency":
 
-			if _, ok := DeviceEIRP_name[int32(m.GetMaxEirpIndex())]; !ok {
-				return MACCommand_TxParamSetupReqValidationError{
-					field:  "max_eirp_index",
-					reason: "value must be one of the defined enum values",
+			if val := m.GetFrequency(); val > 0 && val < 100000 {
+				return MACCommand_BeaconFreqReqValidationError{
+					field:  "frequency",
+					reason: "value must be outside range (0, 100000)",
 				}
 			}
 
-		case "uplink_dwell_time":
-			// no validation rules for UplinkDwellTime
-		case "downlink_dwell_time":
-			// no validation rules for DownlinkDwellTime
 		default:
-			return MACCommand_TxParamSetupReqValidationError{
+			return MACCommand_BeaconFreqReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5072,10 +6918,10 @@ func (m *MACCommand_TxParamSetupReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_TxParamSetupReqValidationError is the validation error returned
-// by MACCommand_TxParamSetupReq.ValidateFields if the designated constraints
+// MACCommand_BeaconFreqReqValidationError is the validation error returned by
+// MACCommand_BeaconFreqReq.ValidateFields if the designated constraints
 // aren't met.
-type MACCommand_TxParamSetupReqValidationError struct {
+type MACCommand_BeaconFreqReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5083,24 +6929,24 @@ type MACCommand_TxParamSetupReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_TxParamSetupReqValidationError) Field() string { return e.field }
+func (e MACCommand_BeaconFreqReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_TxParamSetupReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_BeaconFreqReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_TxParamSetupReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_BeaconFreqReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_TxParamSetupReqValidationError) Key() bool { return e.key }
+func (e MACCommand_BeaconFreqReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_TxParamSetupReqValidationError) ErrorName() string {
-	return "MACCommand_TxParamSetupReqValidationError"
+func (e MACCommand_BeaconFreqReqValidationError) ErrorName() string {
+	return "MACCommand_BeaconFreqReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_TxParamSetupReqValidationError) Error() string {
+func (e MACCommand_BeaconFreqReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5112,14 +6958,14 @@ func (e MACCommand_TxParamSetupReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_TxParamSetupReq.%s: %s%s",
+		"invalid %sMACCommand_BeaconFreqReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_TxParamSetupReqValidationError{}
+var _ error = MACCommand_BeaconFreqReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5127,34 +6973,27 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_TxParamSetupReqValidationError{}
+} = MACCommand_BeaconFreqReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_RekeyInd with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateFields checks the field values on MACCommand_BeaconFreqAns with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_RekeyInd) ValidateFields(paths ...string) error {
+func (m *MACCommand_BeaconFreqAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_RekeyIndFieldPathsNested
+		paths = MACCommand_BeaconFreqAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "minor_version":
-
-			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
-				return MACCommand_RekeyIndValidationError{
-					field:  "minor_version",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
+		case "frequency_ack":
+			// no validation rules for FrequencyAck
 		default:
-			return MACCommand_RekeyIndValidationError{
+			return MACCommand_BeaconFreqAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5163,9 +7002,10 @@ func (m *MACCommand_RekeyInd) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_RekeyIndValidationError is the validation error returned by
-// MACCommand_RekeyInd.ValidateFields if the designated constraints aren't met.
-type MACCommand_RekeyIndValidationError struct {
+// MACCommand_BeaconFreqAnsValidationError is the validation error returned by
+// MACCommand_BeaconFreqAns.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_BeaconFreqAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5173,24 +7013,24 @@ type MACCommand_RekeyIndValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_RekeyIndValidationError) Field() string { return e.field }
+func (e MACCommand_BeaconFreqAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_RekeyIndValidationError) Reason() string { return e.reason }
+func (e MACCommand_BeaconFreqAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_RekeyIndValidationError) Cause() error { return e.cause }
+func (e MACCommand_BeaconFreqAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_RekeyIndValidationError) Key() bool { return e.key }
+func (e MACCommand_BeaconFreqAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_RekeyIndValidationError) ErrorName() string {
-	return "MACCommand_RekeyIndValidationError"
+func (e MACCommand_BeaconFreqAnsValidationError) ErrorName() string {
+	return "MACCommand_BeaconFreqAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_RekeyIndValidationError) Error() string {
+func (e MACCommand_BeaconFreqAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5202,14 +7042,14 @@ func (e MACCommand_RekeyIndValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_RekeyInd.%s: %s%s",
+		"invalid %sMACCommand_BeaconFreqAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_RekeyIndValidationError{}
+var _ error = MACCommand_BeaconFreqAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -5217,34 +7057,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_RekeyIndValidationError{}
+} = MACCommand_BeaconFreqAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_RekeyConf with the
+// ValidateFields checks the field values on MACCommand_DeviceModeInd with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_RekeyConf) ValidateFields(paths ...string) error {
+func (m *MACCommand_DeviceModeInd) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_RekeyConfFieldPathsNested
+		paths = MACCommand_DeviceModeIndFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "minor_version":
+		case "class":
 
-			if _, ok := Minor_name[int32(m.GetMinorVersion())]; !ok {
-				return MACCommand_RekeyConfValidationError{
-					field:  "minor_version",
+			if _, ok := Class_name[int32(m.GetClass())]; !ok {
+				return MACCommand_DeviceModeIndValidationError{
+					field:  "class",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_RekeyConfValidationError{
+			return MACCommand_DeviceModeIndValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5253,9 +7093,10 @@ func (m *MACCommand_RekeyConf) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_RekeyConfValidationError is the validation error returned by
-// MACCommand_RekeyConf.ValidateFields if the designated constraints aren't met.
-type MACCommand_RekeyConfValidationError struct {
+// MACCommand_DeviceModeIndValidationError is the validation error returned by
+// MACCommand_DeviceModeInd.ValidateFields if the designated constraints
+// aren't met.
+type MACCommand_DeviceModeIndValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5263,24 +7104,24 @@ type MACCommand_RekeyConfValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_RekeyConfValidationError) Field() string { return e.field }
+func (e MACCommand_DeviceModeIndValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_RekeyConfValidationError) Reason() string { return e.reason }
+func (e MACCommand_DeviceModeIndValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_RekeyConfValidationError) Cause() error { return e.cause }
+func (e MACCommand_DeviceModeIndValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_RekeyConfValidationError) Key() bool { return e.key }
+func (e MACCommand_DeviceModeIndValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_RekeyConfValidationError) ErrorName() string {
-	return "MACCommand_RekeyConfValidationError"
+func (e MACCommand_DeviceModeIndValidationError) ErrorName() string {
+	return "MACCommand_DeviceModeIndValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_RekeyConfValidationError) Error() string {
+func (e MACCommand_DeviceModeIndValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5292,14 +7133,14 @@ func (e MACCommand_RekeyConfValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_RekeyConf.%s: %s%s",
+		"invalid %sMACCommand_DeviceModeInd.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_RekeyConfValidationError{}
+var _ error = MACCommand_DeviceModeIndValidationError{}
 
 var _ interface {
 	Field() string
@@ -5307,43 +7148,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_RekeyConfValidationError{}
+} = MACCommand_DeviceModeIndValidationError{}
 
-// ValidateFields checks the field values on MACCommand_ADRParamSetupReq with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, an error is returned.
-func (m *MACCommand_ADRParamSetupReq) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_DeviceModeConf with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACCommand_DeviceModeConf) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_ADRParamSetupReqFieldPathsNested
+		paths = MACCommand_DeviceModeConfFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "adr_ack_limit_exponent":
-
-			if _, ok := ADRAckLimitExponent_name[int32(m.GetAdrAckLimitExponent())]; !ok {
-				return MACCommand_ADRParamSetupReqValidationError{
-					field:  "adr_ack_limit_exponent",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
-		case "adr_ack_delay_exponent":
+		case "class":
 
-			if _, ok := ADRAckDelayExponent_name[int32(m.GetAdrAckDelayExponent())]; !ok {
-				return MACCommand_ADRParamSetupReqValidationError{
-					field:  "adr_ack_delay_exponent",
+			if _, ok := Class_name[int32(m.GetClass())]; !ok {
+				return MACCommand_DeviceModeConfValidationError{
+					field:  "class",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_ADRParamSetupReqValidationError{
+			return MACCommand_DeviceModeConfValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5352,10 +7184,10 @@ func (m *MACCommand_ADRParamSetupReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_ADRParamSetupReqValidationError is the validation error returned
-// by MACCommand_ADRParamSetupReq.ValidateFields if the designated constraints
+// MACCommand_DeviceModeConfValidationError is the validation error returned by
+// MACCommand_DeviceModeConf.ValidateFields if the designated constraints
 // aren't met.
-type MACCommand_ADRParamSetupReqValidationError struct {
+type MACCommand_DeviceModeConfValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5363,24 +7195,24 @@ type MACCommand_ADRParamSetupReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_ADRParamSetupReqValidationError) Field() string { return e.field }
+func (e MACCommand_DeviceModeConfValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_ADRParamSetupReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_DeviceModeConfValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_ADRParamSetupReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_DeviceModeConfValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_ADRParamSetupReqValidationError) Key() bool { return e.key }
+func (e MACCommand_DeviceModeConfValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_ADRParamSetupReqValidationError) ErrorName() string {
-	return "MACCommand_ADRParamSetupReqValidationError"
+func (e MACCommand_DeviceModeConfValidationError) ErrorName() string {
+	return "MACCommand_DeviceModeConfValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_ADRParamSetupReqValidationError) Error() string {
+func (e MACCommand_DeviceModeConfValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5392,14 +7224,14 @@ func (e MACCommand_ADRParamSetupReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_ADRParamSetupReq.%s: %s%s",
+		"invalid %sMACCommand_DeviceModeConf.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_ADRParamSetupReqValidationError{}
+var _ error = MACCommand_DeviceModeConfValidationError{}
 
 var _ interface {
 	Field() string
@@ -5407,27 +7239,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_ADRParamSetupReqValidationError{}
+} = MACCommand_DeviceModeConfValidationError{}
 
-// ValidateFields checks the field values on MACCommand_DeviceTimeAns with the
+// ValidateFields checks the field values on MACCommand_RelayConfReq with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_DeviceTimeAns) ValidateFields(paths ...string) error {
+func (m *MACCommand_RelayConfReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_DeviceTimeAnsFieldPathsNested
+		paths = MACCommand_RelayConfReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "time":
+		case "configuration":
+
+			if v, ok := interface{}(m.GetConfiguration()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayConfReqValidationError{
+						field:  "configuration",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
 
 		default:
-			return MACCommand_DeviceTimeAnsValidationError{
+			return MACCommand_RelayConfReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5436,10 +7278,9 @@ func (m *MACCommand_DeviceTimeAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_DeviceTimeAnsValidationError is the validation error returned by
-// MACCommand_DeviceTimeAns.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_DeviceTimeAnsValidationError struct {
+// MACCommand_RelayConfReqValidationError is the validation error returned by
+// MACCommand_RelayConfReq.ValidateFields if the designated constraints aren't met.
+type MACCommand_RelayConfReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5447,24 +7288,24 @@ type MACCommand_DeviceTimeAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_DeviceTimeAnsValidationError) Field() string { return e.field }
+func (e MACCommand_RelayConfReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_DeviceTimeAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayConfReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_DeviceTimeAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayConfReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_DeviceTimeAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayConfReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_DeviceTimeAnsValidationError) ErrorName() string {
-	return "MACCommand_DeviceTimeAnsValidationError"
+func (e MACCommand_RelayConfReqValidationError) ErrorName() string {
+	return "MACCommand_RelayConfReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_DeviceTimeAnsValidationError) Error() string {
+func (e MACCommand_RelayConfReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5476,14 +7317,14 @@ func (e MACCommand_DeviceTimeAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_DeviceTimeAns.%s: %s%s",
+		"invalid %sMACCommand_RelayConfReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_DeviceTimeAnsValidationError{}
+var _ error = MACCommand_RelayConfReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5491,61 +7332,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_DeviceTimeAnsValidationError{}
+} = MACCommand_RelayConfReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_ForceRejoinReq with the
+// ValidateFields checks the field values on MACCommand_RelayConfAns with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *MACCommand_ForceRejoinReq) ValidateFields(paths ...string) error {
+func (m *MACCommand_RelayConfAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_ForceRejoinReqFieldPathsNested
+		paths = MACCommand_RelayConfAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "rejoin_type":
-
-			if _, ok := RejoinRequestType_name[int32(m.GetRejoinType())]; !ok {
-				return MACCommand_ForceRejoinReqValidationError{
-					field:  "rejoin_type",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
-		case "data_rate_index":
-
-			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
-				return MACCommand_ForceRejoinReqValidationError{
-					field:  "data_rate_index",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
-		case "max_retries":
-
-			if m.GetMaxRetries() > 7 {
-				return MACCommand_ForceRejoinReqValidationError{
-					field:  "max_retries",
-					reason: "value must be less than or equal to 7",
-				}
-			}
-
-		case "period_exponent":
-
-			if _, ok := RejoinPeriodExponent_name[int32(m.GetPeriodExponent())]; !ok {
-				return MACCommand_ForceRejoinReqValidationError{
-					field:  "period_exponent",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
+		case "second_channel_frequency_ack":
+			// no validation rules for SecondChannelFrequencyAck
+		case "second_channel_ack_offset_ack":
+			// no validation rules for SecondChannelAckOffsetAck
+		case "second_channel_data_rate_index_ack":
+			// no validation rules for SecondChannelDataRateIndexAck
+		case "second_channel_index_ack":
+			// no validation rules for SecondChannelIndexAck
+		case "default_channel_index_ack":
+			// no validation rules for DefaultChannelIndexAck
+		case "cad_periodicity_ack":
+			// no validation rules for CadPeriodicityAck
 		default:
-			return MACCommand_ForceRejoinReqValidationError{
+			return MACCommand_RelayConfAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5554,10 +7371,9 @@ func (m *MACCommand_ForceRejoinReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_ForceRejoinReqValidationError is the validation error returned by
-// MACCommand_ForceRejoinReq.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_ForceRejoinReqValidationError struct {
+// MACCommand_RelayConfAnsValidationError is the validation error returned by
+// MACCommand_RelayConfAns.ValidateFields if the designated constraints aren't met.
+type MACCommand_RelayConfAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5565,24 +7381,24 @@ type MACCommand_ForceRejoinReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_ForceRejoinReqValidationError) Field() string { return e.field }
+func (e MACCommand_RelayConfAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_ForceRejoinReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayConfAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_ForceRejoinReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayConfAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_ForceRejoinReqValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayConfAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_ForceRejoinReqValidationError) ErrorName() string {
-	return "MACCommand_ForceRejoinReqValidationError"
+func (e MACCommand_RelayConfAnsValidationError) ErrorName() string {
+	return "MACCommand_RelayConfAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_ForceRejoinReqValidationError) Error() string {
+func (e MACCommand_RelayConfAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5594,14 +7410,14 @@ func (e MACCommand_ForceRejoinReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_ForceRejoinReq.%s: %s%s",
+		"invalid %sMACCommand_RelayConfAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_ForceRejoinReqValidationError{}
+var _ error = MACCommand_RelayConfAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -5609,43 +7425,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_ForceRejoinReqValidationError{}
+} = MACCommand_RelayConfAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_RejoinParamSetupReq
+// ValidateFields checks the field values on MACCommand_RelayEndDeviceConfReq
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, an error is returned.
-func (m *MACCommand_RejoinParamSetupReq) ValidateFields(paths ...string) error {
+func (m *MACCommand_RelayEndDeviceConfReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_RejoinParamSetupReqFieldPathsNested
+		paths = MACCommand_RelayEndDeviceConfReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "max_count_exponent":
-
-			if _, ok := RejoinCountExponent_name[int32(m.GetMaxCountExponent())]; !ok {
-				return MACCommand_RejoinParamSetupReqValidationError{
-					field:  "max_count_exponent",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
-		case "max_time_exponent":
+		case "configuration":
 
-			if _, ok := RejoinTimeExponent_name[int32(m.GetMaxTimeExponent())]; !ok {
-				return MACCommand_RejoinParamSetupReqValidationError{
-					field:  "max_time_exponent",
-					reason: "value must be one of the defined enum values",
+			if v, ok := interface{}(m.GetConfiguration()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayEndDeviceConfReqValidationError{
+						field:  "configuration",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
 				}
 			}
 
 		default:
-			return MACCommand_RejoinParamSetupReqValidationError{
+			return MACCommand_RelayEndDeviceConfReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5654,10 +7464,10 @@ func (m *MACCommand_RejoinParamSetupReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_RejoinParamSetupReqValidationError is the validation error
-// returned by MACCommand_RejoinParamSetupReq.ValidateFields if the designated
-// constraints aren't met.
-type MACCommand_RejoinParamSetupReqValidationError struct {
+// MACCommand_RelayEndDeviceConfReqValidationError is the validation error
+// returned by MACCommand_RelayEndDeviceConfReq.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayEndDeviceConfReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5665,24 +7475,24 @@ type MACCommand_RejoinParamSetupReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_RejoinParamSetupReqValidationError) Field() string { return e.field }
+func (e MACCommand_RelayEndDeviceConfReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_RejoinParamSetupReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayEndDeviceConfReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_RejoinParamSetupReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayEndDeviceConfReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_RejoinParamSetupReqValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayEndDeviceConfReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_RejoinParamSetupReqValidationError) ErrorName() string {
-	return "MACCommand_RejoinParamSetupReqValidationError"
+func (e MACCommand_RelayEndDeviceConfReqValidationError) ErrorName() string {
+	return "MACCommand_RelayEndDeviceConfReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_RejoinParamSetupReqValidationError) Error() string {
+func (e MACCommand_RelayEndDeviceConfReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5694,14 +7504,14 @@ func (e MACCommand_RejoinParamSetupReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_RejoinParamSetupReq.%s: %s%s",
+		"invalid %sMACCommand_RelayEndDeviceConfReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_RejoinParamSetupReqValidationError{}
+var _ error = MACCommand_RelayEndDeviceConfReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5709,27 +7519,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_RejoinParamSetupReqValidationError{}
+} = MACCommand_RelayEndDeviceConfReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_RejoinParamSetupAns
+// ValidateFields checks the field values on MACCommand_RelayEndDeviceConfAns
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, an error is returned.
-func (m *MACCommand_RejoinParamSetupAns) ValidateFields(paths ...string) error {
+func (m *MACCommand_RelayEndDeviceConfAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_RejoinParamSetupAnsFieldPathsNested
+		paths = MACCommand_RelayEndDeviceConfAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "max_time_exponent_ack":
-			// no validation rules for MaxTimeExponentAck
+		case "second_channel_frequency_ack":
+			// no validation rules for SecondChannelFrequencyAck
+		case "second_channel_data_rate_index_ack":
+			// no validation rules for SecondChannelDataRateIndexAck
+		case "second_channel_index_ack":
+			// no validation rules for SecondChannelIndexAck
+		case "backoff_ack":
+			// no validation rules for BackoffAck
 		default:
-			return MACCommand_RejoinParamSetupAnsValidationError{
+			return MACCommand_RelayEndDeviceConfAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5738,10 +7554,10 @@ func (m *MACCommand_RejoinParamSetupAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_RejoinParamSetupAnsValidationError is the validation error
-// returned by MACCommand_RejoinParamSetupAns.ValidateFields if the designated
-// constraints aren't met.
-type MACCommand_RejoinParamSetupAnsValidationError struct {
+// MACCommand_RelayEndDeviceConfAnsValidationError is the validation error
+// returned by MACCommand_RelayEndDeviceConfAns.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayEndDeviceConfAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5749,24 +7565,24 @@ type MACCommand_RejoinParamSetupAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_RejoinParamSetupAnsValidationError) Field() string { return e.field }
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_RejoinParamSetupAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_RejoinParamSetupAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_RejoinParamSetupAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_RejoinParamSetupAnsValidationError) ErrorName() string {
-	return "MACCommand_RejoinParamSetupAnsValidationError"
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) ErrorName() string {
+	return "MACCommand_RelayEndDeviceConfAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_RejoinParamSetupAnsValidationError) Error() string {
+func (e MACCommand_RelayEndDeviceConfAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5778,14 +7594,14 @@ func (e MACCommand_RejoinParamSetupAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_RejoinParamSetupAns.%s: %s%s",
+		"invalid %sMACCommand_RelayEndDeviceConfAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_RejoinParamSetupAnsValidationError{}
+var _ error = MACCommand_RelayEndDeviceConfAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -5793,34 +7609,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_RejoinParamSetupAnsValidationError{}
+} = MACCommand_RelayEndDeviceConfAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_PingSlotInfoReq with
+// ValidateFields checks the field values on MACCommand_RelayFilterListReq with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
-func (m *MACCommand_PingSlotInfoReq) ValidateFields(paths ...string) error {
+func (m *MACCommand_RelayFilterListReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_PingSlotInfoReqFieldPathsNested
+		paths = MACCommand_RelayFilterListReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "period":
+		case "rule_index":
 
-			if _, ok := PingSlotPeriod_name[int32(m.GetPeriod())]; !ok {
-				return MACCommand_PingSlotInfoReqValidationError{
-					field:  "period",
+			if m.GetRuleIndex() > 15 {
+				return MACCommand_RelayFilterListReqValidationError{
+					field:  "rule_index",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		case "action":
+
+			if _, ok := RelayJoinRequestFilterAction_name[int32(m.GetAction())]; !ok {
+				return MACCommand_RelayFilterListReqValidationError{
+					field:  "action",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
+		case "join_eui":
+			// no validation rules for JoinEui
+		case "dev_eui":
+			// no validation rules for DevEui
 		default:
-			return MACCommand_PingSlotInfoReqValidationError{
+			return MACCommand_RelayFilterListReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5829,10 +7658,10 @@ func (m *MACCommand_PingSlotInfoReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_PingSlotInfoReqValidationError is the validation error returned
-// by MACCommand_PingSlotInfoReq.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_PingSlotInfoReqValidationError struct {
+// MACCommand_RelayFilterListReqValidationError is the validation error
+// returned by MACCommand_RelayFilterListReq.ValidateFields if the designated
+// constraints aren't met.
+type MACCommand_RelayFilterListReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5840,24 +7669,24 @@ type MACCommand_PingSlotInfoReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_PingSlotInfoReqValidationError) Field() string { return e.field }
+func (e MACCommand_RelayFilterListReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_PingSlotInfoReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayFilterListReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_PingSlotInfoReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayFilterListReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_PingSlotInfoReqValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayFilterListReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_PingSlotInfoReqValidationError) ErrorName() string {
-	return "MACCommand_PingSlotInfoReqValidationError"
+func (e MACCommand_RelayFilterListReqValidationError) ErrorName() string {
+	return "MACCommand_RelayFilterListReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_PingSlotInfoReqValidationError) Error() string {
+func (e MACCommand_RelayFilterListReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5869,14 +7698,14 @@ func (e MACCommand_PingSlotInfoReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_PingSlotInfoReq.%s: %s%s",
+		"invalid %sMACCommand_RelayFilterListReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_PingSlotInfoReqValidationError{}
+var _ error = MACCommand_RelayFilterListReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -5884,43 +7713,31 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_PingSlotInfoReqValidationError{}
+} = MACCommand_RelayFilterListReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_PingSlotChannelReq with
+// ValidateFields checks the field values on MACCommand_RelayFilterListAns with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
-func (m *MACCommand_PingSlotChannelReq) ValidateFields(paths ...string) error {
+func (m *MACCommand_RelayFilterListAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_PingSlotChannelReqFieldPathsNested
+		paths = MACCommand_RelayFilterListAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "frequency":
-
-			if val := m.GetFrequency(); val > 0 && val < 100000 {
-				return MACCommand_PingSlotChannelReqValidationError{
-					field:  "frequency",
-					reason: "value must be outside range (0, 100000)",
-				}
-			}
-
-		case "data_rate_index":
-
-			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
-				return MACCommand_PingSlotChannelReqValidationError{
-					field:  "data_rate_index",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
+		case "action_ack":
+			// no validation rules for ActionAck
+		case "rule_index_ack":
+			// no validation rules for RuleIndexAck
+		case "combined_rules_ack":
+			// no validation rules for CombinedRulesAck
 		default:
-			return MACCommand_PingSlotChannelReqValidationError{
+			return MACCommand_RelayFilterListAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -5929,10 +7746,10 @@ func (m *MACCommand_PingSlotChannelReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_PingSlotChannelReqValidationError is the validation error
-// returned by MACCommand_PingSlotChannelReq.ValidateFields if the designated
+// MACCommand_RelayFilterListAnsValidationError is the validation error
+// returned by MACCommand_RelayFilterListAns.ValidateFields if the designated
 // constraints aren't met.
-type MACCommand_PingSlotChannelReqValidationError struct {
+type MACCommand_RelayFilterListAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5940,24 +7757,24 @@ type MACCommand_PingSlotChannelReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_PingSlotChannelReqValidationError) Field() string { return e.field }
+func (e MACCommand_RelayFilterListAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_PingSlotChannelReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayFilterListAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_PingSlotChannelReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayFilterListAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_PingSlotChannelReqValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayFilterListAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_PingSlotChannelReqValidationError) ErrorName() string {
-	return "MACCommand_PingSlotChannelReqValidationError"
+func (e MACCommand_RelayFilterListAnsValidationError) ErrorName() string {
+	return "MACCommand_RelayFilterListAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_PingSlotChannelReqValidationError) Error() string {
+func (e MACCommand_RelayFilterListAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5969,44 +7786,74 @@ func (e MACCommand_PingSlotChannelReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_PingSlotChannelReq.%s: %s%s",
+		"invalid %sMACCommand_RelayFilterListAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_PingSlotChannelReqValidationError{}
+var _ error = MACCommand_RelayFilterListAnsValidationError{}
 
 var _ interface {
 	Field() string
 	Reason() string
 	Key() bool
-	Cause() error
-	ErrorName() string
-} = MACCommand_PingSlotChannelReqValidationError{}
-
-// ValidateFields checks the field values on MACCommand_PingSlotChannelAns with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, an error is returned.
-func (m *MACCommand_PingSlotChannelAns) ValidateFields(paths ...string) error {
+	Cause() error
+	ErrorName() string
+} = MACCommand_RelayFilterListAnsValidationError{}
+
+// ValidateFields checks the field values on
+// MACCommand_RelayUpdateUplinkListReq with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *MACCommand_RelayUpdateUplinkListReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_PingSlotChannelAnsFieldPathsNested
+		paths = MACCommand_RelayUpdateUplinkListReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "frequency_ack":
-			// no validation rules for FrequencyAck
-		case "data_rate_index_ack":
-			// no validation rules for DataRateIndexAck
+		case "rule_index":
+
+			if m.GetRuleIndex() > 15 {
+				return MACCommand_RelayUpdateUplinkListReqValidationError{
+					field:  "rule_index",
+					reason: "value must be less than or equal to 15",
+				}
+			}
+
+		case "forward_limits":
+
+			if m.GetForwardLimits() == nil {
+				return MACCommand_RelayUpdateUplinkListReqValidationError{
+					field:  "forward_limits",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetForwardLimits()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayUpdateUplinkListReqValidationError{
+						field:  "forward_limits",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "dev_addr":
+			// no validation rules for DevAddr
+		case "w_f_cnt":
+			// no validation rules for WFCnt
+		case "root_wor_s_key":
+			// no validation rules for RootWorSKey
 		default:
-			return MACCommand_PingSlotChannelAnsValidationError{
+			return MACCommand_RelayUpdateUplinkListReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -6015,10 +7862,10 @@ func (m *MACCommand_PingSlotChannelAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_PingSlotChannelAnsValidationError is the validation error
-// returned by MACCommand_PingSlotChannelAns.ValidateFields if the designated
-// constraints aren't met.
-type MACCommand_PingSlotChannelAnsValidationError struct {
+// MACCommand_RelayUpdateUplinkListReqValidationError is the validation error
+// returned by MACCommand_RelayUpdateUplinkListReq.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayUpdateUplinkListReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6026,24 +7873,24 @@ type MACCommand_PingSlotChannelAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_PingSlotChannelAnsValidationError) Field() string { return e.field }
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_PingSlotChannelAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_PingSlotChannelAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_PingSlotChannelAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_PingSlotChannelAnsValidationError) ErrorName() string {
-	return "MACCommand_PingSlotChannelAnsValidationError"
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) ErrorName() string {
+	return "MACCommand_RelayUpdateUplinkListReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_PingSlotChannelAnsValidationError) Error() string {
+func (e MACCommand_RelayUpdateUplinkListReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6055,14 +7902,14 @@ func (e MACCommand_PingSlotChannelAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_PingSlotChannelAns.%s: %s%s",
+		"invalid %sMACCommand_RelayUpdateUplinkListReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_PingSlotChannelAnsValidationError{}
+var _ error = MACCommand_RelayUpdateUplinkListReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -6070,43 +7917,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_PingSlotChannelAnsValidationError{}
+} = MACCommand_RelayUpdateUplinkListReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_BeaconTimingAns with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, an error is returned.
-func (m *MACCommand_BeaconTimingAns) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_RelayCtrlUplinkListReq
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *MACCommand_RelayCtrlUplinkListReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_BeaconTimingAnsFieldPathsNested
+		paths = MACCommand_RelayCtrlUplinkListReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "delay":
+		case "rule_index":
 
-			if m.GetDelay() > 65535 {
-				return MACCommand_BeaconTimingAnsValidationError{
-					field:  "delay",
-					reason: "value must be less than or equal to 65535",
+			if m.GetRuleIndex() > 15 {
+				return MACCommand_RelayCtrlUplinkListReqValidationError{
+					field:  "rule_index",
+					reason: "value must be less than or equal to 15",
 				}
 			}
 
-		case "channel_index":
+		case "action":
 
-			if m.GetChannelIndex() > 255 {
-				return MACCommand_BeaconTimingAnsValidationError{
-					field:  "channel_index",
-					reason: "value must be less than or equal to 255",
+			if _, ok := RelayCtrlUplinkListAction_name[int32(m.GetAction())]; !ok {
+				return MACCommand_RelayCtrlUplinkListReqValidationError{
+					field:  "action",
+					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_BeaconTimingAnsValidationError{
+			return MACCommand_RelayCtrlUplinkListReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -6115,10 +7962,10 @@ func (m *MACCommand_BeaconTimingAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_BeaconTimingAnsValidationError is the validation error returned
-// by MACCommand_BeaconTimingAns.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_BeaconTimingAnsValidationError struct {
+// MACCommand_RelayCtrlUplinkListReqValidationError is the validation error
+// returned by MACCommand_RelayCtrlUplinkListReq.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayCtrlUplinkListReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6126,24 +7973,24 @@ type MACCommand_BeaconTimingAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_BeaconTimingAnsValidationError) Field() string { return e.field }
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_BeaconTimingAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_BeaconTimingAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_BeaconTimingAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_BeaconTimingAnsValidationError) ErrorName() string {
-	return "MACCommand_BeaconTimingAnsValidationError"
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) ErrorName() string {
+	return "MACCommand_RelayCtrlUplinkListReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_BeaconTimingAnsValidationError) Error() string {
+func (e MACCommand_RelayCtrlUplinkListReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6155,14 +8002,14 @@ func (e MACCommand_BeaconTimingAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_BeaconTimingAns.%s: %s%s",
+		"invalid %sMACCommand_RelayCtrlUplinkListReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_BeaconTimingAnsValidationError{}
+var _ error = MACCommand_RelayCtrlUplinkListReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -6170,34 +8017,29 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_BeaconTimingAnsValidationError{}
+} = MACCommand_RelayCtrlUplinkListReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_BeaconFreqReq with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_BeaconFreqReq) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on MACCommand_RelayCtrlUplinkListAns
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *MACCommand_RelayCtrlUplinkListAns) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_BeaconFreqReqFieldPathsNested
+		paths = MACCommand_RelayCtrlUplinkListAnsFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "frequency":
-
-			if val := m.GetFrequency(); val > 0 && val < 100000 {
-				return MACCommand_BeaconFreqReqValidationError{
-					field:  "frequency",
-					reason: "value must be outside range (0, 100000)",
-				}
-			}
-
+		case "rule_index_ack":
+			// no validation rules for RuleIndexAck
+		case "w_f_cnt":
+			// no validation rules for WFCnt
 		default:
-			return MACCommand_BeaconFreqReqValidationError{
+			return MACCommand_RelayCtrlUplinkListAnsValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -6206,10 +8048,10 @@ func (m *MACCommand_BeaconFreqReq) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_BeaconFreqReqValidationError is the validation error returned by
-// MACCommand_BeaconFreqReq.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_BeaconFreqReqValidationError struct {
+// MACCommand_RelayCtrlUplinkListAnsValidationError is the validation error
+// returned by MACCommand_RelayCtrlUplinkListAns.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayCtrlUplinkListAnsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6217,24 +8059,24 @@ type MACCommand_BeaconFreqReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_BeaconFreqReqValidationError) Field() string { return e.field }
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_BeaconFreqReqValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_BeaconFreqReqValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_BeaconFreqReqValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_BeaconFreqReqValidationError) ErrorName() string {
-	return "MACCommand_BeaconFreqReqValidationError"
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) ErrorName() string {
+	return "MACCommand_RelayCtrlUplinkListAnsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_BeaconFreqReqValidationError) Error() string {
+func (e MACCommand_RelayCtrlUplinkListAnsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6246,14 +8088,14 @@ func (e MACCommand_BeaconFreqReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_BeaconFreqReq.%s: %s%s",
+		"invalid %sMACCommand_RelayCtrlUplinkListAns.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_BeaconFreqReqValidationError{}
+var _ error = MACCommand_RelayCtrlUplinkListAnsValidationError{}
 
 var _ interface {
 	Field() string
@@ -6261,27 +8103,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_BeaconFreqReqValidationError{}
+} = MACCommand_RelayCtrlUplinkListAnsValidationError{}
 
-// ValidateFields checks the field values on MACCommand_BeaconFreqAns with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_BeaconFreqAns) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on
+// MACCommand_RelayNotifyNewEndDeviceReq with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *MACCommand_RelayNotifyNewEndDeviceReq) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_BeaconFreqAnsFieldPathsNested
+		paths = MACCommand_RelayNotifyNewEndDeviceReqFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "frequency_ack":
-			// no validation rules for FrequencyAck
+		case "dev_addr":
+			// no validation rules for DevAddr
+		case "snr":
+
+			if val := m.GetSnr(); val < -20 || val > 11 {
+				return MACCommand_RelayNotifyNewEndDeviceReqValidationError{
+					field:  "snr",
+					reason: "value must be inside range [-20, 11]",
+				}
+			}
+
+		case "rssi":
+
+			if val := m.GetRssi(); val < -142 || val > -15 {
+				return MACCommand_RelayNotifyNewEndDeviceReqValidationError{
+					field:  "rssi",
+					reason: "value must be inside range [-142, -15]",
+				}
+			}
+
 		default:
-			return MACCommand_BeaconFreqAnsValidationError{
+			return MACCommand_RelayNotifyNewEndDeviceReqValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -6290,10 +8150,10 @@ func (m *MACCommand_BeaconFreqAns) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_BeaconFreqAnsValidationError is the validation error returned by
-// MACCommand_BeaconFreqAns.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_BeaconFreqAnsValidationError struct {
+// MACCommand_RelayNotifyNewEndDeviceReqValidationError is the validation error
+// returned by MACCommand_RelayNotifyNewEndDeviceReq.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayNotifyNewEndDeviceReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6301,24 +8161,24 @@ type MACCommand_BeaconFreqAnsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_BeaconFreqAnsValidationError) Field() string { return e.field }
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_BeaconFreqAnsValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_BeaconFreqAnsValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_BeaconFreqAnsValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_BeaconFreqAnsValidationError) ErrorName() string {
-	return "MACCommand_BeaconFreqAnsValidationError"
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) ErrorName() string {
+	return "MACCommand_RelayNotifyNewEndDeviceReqValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_BeaconFreqAnsValidationError) Error() string {
+func (e MACCommand_RelayNotifyNewEndDeviceReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6330,14 +8190,14 @@ func (e MACCommand_BeaconFreqAnsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_BeaconFreqAns.%s: %s%s",
+		"invalid %sMACCommand_RelayNotifyNewEndDeviceReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_BeaconFreqAnsValidationError{}
+var _ error = MACCommand_RelayNotifyNewEndDeviceReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -6345,34 +8205,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_BeaconFreqAnsValidationError{}
+} = MACCommand_RelayNotifyNewEndDeviceReqValidationError{}
 
-// ValidateFields checks the field values on MACCommand_DeviceModeInd with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_DeviceModeInd) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on
+// MACCommand_RelayConfReq_Configuration with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *MACCommand_RelayConfReq_Configuration) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_DeviceModeIndFieldPathsNested
+		paths = MACCommand_RelayConfReq_ConfigurationFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "class":
+		case "second_channel":
 
-			if _, ok := Class_name[int32(m.GetClass())]; !ok {
-				return MACCommand_DeviceModeIndValidationError{
-					field:  "class",
+			if v, ok := interface{}(m.GetSecondChannel()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayConfReq_ConfigurationValidationError{
+						field:  "second_channel",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "default_channel_index":
+
+			if m.GetDefaultChannelIndex() > 3 {
+				return MACCommand_RelayConfReq_ConfigurationValidationError{
+					field:  "default_channel_index",
+					reason: "value must be less than or equal to 3",
+				}
+			}
+
+		case "cad_periodicity":
+
+			if _, ok := RelayCADPeriodicity_name[int32(m.GetCadPeriodicity())]; !ok {
+				return MACCommand_RelayConfReq_ConfigurationValidationError{
+					field:  "cad_periodicity",
 					reason: "value must be one of the defined enum values",
 				}
 			}
 
 		default:
-			return MACCommand_DeviceModeIndValidationError{
+			return MACCommand_RelayConfReq_ConfigurationValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -6381,10 +8262,10 @@ func (m *MACCommand_DeviceModeInd) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_DeviceModeIndValidationError is the validation error returned by
-// MACCommand_DeviceModeInd.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_DeviceModeIndValidationError struct {
+// MACCommand_RelayConfReq_ConfigurationValidationError is the validation error
+// returned by MACCommand_RelayConfReq_Configuration.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayConfReq_ConfigurationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6392,24 +8273,24 @@ type MACCommand_DeviceModeIndValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_DeviceModeIndValidationError) Field() string { return e.field }
+func (e MACCommand_RelayConfReq_ConfigurationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_DeviceModeIndValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayConfReq_ConfigurationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MACCommand_DeviceModeIndValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayConfReq_ConfigurationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_DeviceModeIndValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayConfReq_ConfigurationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_DeviceModeIndValidationError) ErrorName() string {
-	return "MACCommand_DeviceModeIndValidationError"
+func (e MACCommand_RelayConfReq_ConfigurationValidationError) ErrorName() string {
+	return "MACCommand_RelayConfReq_ConfigurationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_DeviceModeIndValidationError) Error() string {
+func (e MACCommand_RelayConfReq_ConfigurationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6421,14 +8302,14 @@ func (e MACCommand_DeviceModeIndValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_DeviceModeInd.%s: %s%s",
+		"invalid %sMACCommand_RelayConfReq_Configuration.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_DeviceModeIndValidationError{}
+var _ error = MACCommand_RelayConfReq_ConfigurationValidationError{}
 
 var _ interface {
 	Field() string
@@ -6436,34 +8317,112 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_DeviceModeIndValidationError{}
+} = MACCommand_RelayConfReq_ConfigurationValidationError{}
 
-// ValidateFields checks the field values on MACCommand_DeviceModeConf with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MACCommand_DeviceModeConf) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on
+// MACCommand_RelayEndDeviceConfReq_Configuration with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *MACCommand_RelayEndDeviceConfReq_Configuration) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = MACCommand_DeviceModeConfFieldPathsNested
+		paths = MACCommand_RelayEndDeviceConfReq_ConfigurationFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "class":
+		case "mode":
+			if m.Mode == nil {
+				return MACCommand_RelayEndDeviceConfReq_ConfigurationValidationError{
+					field:  "mode",
+					reason: "value is required",
+				}
+			}
+			if len(subs) == 0 {
+				subs = []string{
+					"always", "dynamic", "end_device_controlled",
+				}
+			}
+			for name, subs := range _processPaths(subs) {
+				_ = subs
+				switch name {
+				case "always":
+					w, ok := m.Mode.(*MACCommand_RelayEndDeviceConfReq_Configuration_Always)
+					if !ok || w == nil {
+						continue
+					}
 
-			if _, ok := Class_name[int32(m.GetClass())]; !ok {
-				return MACCommand_DeviceModeConfValidationError{
-					field:  "class",
-					reason: "value must be one of the defined enum values",
+					if v, ok := interface{}(m.GetAlways()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommand_RelayEndDeviceConfReq_ConfigurationValidationError{
+								field:  "always",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "dynamic":
+					w, ok := m.Mode.(*MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetDynamic()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommand_RelayEndDeviceConfReq_ConfigurationValidationError{
+								field:  "dynamic",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "end_device_controlled":
+					w, ok := m.Mode.(*MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetEndDeviceControlled()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return MACCommand_RelayEndDeviceConfReq_ConfigurationValidationError{
+								field:  "end_device_controlled",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				}
+			}
+		case "backoff":
+
+			if m.GetBackoff() > 63 {
+				return MACCommand_RelayEndDeviceConfReq_ConfigurationValidationError{
+					field:  "backoff",
+					reason: "value must be less than or equal to 63",
+				}
+			}
+
+		case "second_channel":
+
+			if v, ok := interface{}(m.GetSecondChannel()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACCommand_RelayEndDeviceConfReq_ConfigurationValidationError{
+						field:  "second_channel",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
 				}
 			}
 
 		default:
-			return MACCommand_DeviceModeConfValidationError{
+			return MACCommand_RelayEndDeviceConfReq_ConfigurationValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -6472,10 +8431,10 @@ func (m *MACCommand_DeviceModeConf) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// MACCommand_DeviceModeConfValidationError is the validation error returned by
-// MACCommand_DeviceModeConf.ValidateFields if the designated constraints
-// aren't met.
-type MACCommand_DeviceModeConfValidationError struct {
+// validation error returned by
+// MACCommand_RelayEndDeviceConfReq_Configuration.ValidateFields if the
+// designated constraints aren't met.
+type MACCommand_RelayEndDeviceConfReq_ConfigurationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6483,24 +8442,26 @@ type MACCommand_DeviceModeConfValidationError struct {
 }
 
 // Field function returns field value.
-func (e MACCommand_DeviceModeConfValidationError) Field() string { return e.field }
+func (e MACCommand_RelayEndDeviceConfReq_ConfigurationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MACCommand_DeviceModeConfValidationError) Reason() string { return e.reason }
+func (e MACCommand_RelayEndDeviceConfReq_ConfigurationValidationError) Reason() string {
+	return e.reason
+}
 
 // Cause function returns cause value.
-func (e MACCommand_DeviceModeConfValidationError) Cause() error { return e.cause }
+func (e MACCommand_RelayEndDeviceConfReq_ConfigurationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MACCommand_DeviceModeConfValidationError) Key() bool { return e.key }
+func (e MACCommand_RelayEndDeviceConfReq_ConfigurationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MACCommand_DeviceModeConfValidationError) ErrorName() string {
-	return "MACCommand_DeviceModeConfValidationError"
+func (e MACCommand_RelayEndDeviceConfReq_ConfigurationValidationError) ErrorName() string {
+	return "MACCommand_RelayEndDeviceConfReq_ConfigurationValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MACCommand_DeviceModeConfValidationError) Error() string {
+func (e MACCommand_RelayEndDeviceConfReq_ConfigurationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6512,14 +8473,14 @@ func (e MACCommand_DeviceModeConfValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMACCommand_DeviceModeConf.%s: %s%s",
+		"invalid %sMACCommand_RelayEndDeviceConfReq_Configuration.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MACCommand_DeviceModeConfValidationError{}
+var _ error = MACCommand_RelayEndDeviceConfReq_ConfigurationValidationError{}
 
 var _ interface {
 	Field() string
@@ -6527,4 +8488,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MACCommand_DeviceModeConfValidationError{}
+} = MACCommand_RelayEndDeviceConfReq_ConfigurationValidationError{}
@@ -274,27 +274,34 @@ func (x MACCommandIdentifier) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 
 // MACCommandIdentifier_customvalue contains custom string values that extend MACCommandIdentifier_value.
 var MACCommandIdentifier_customvalue = map[string]int32{
-	"RFU_0":              0,
-	"RESET":              1,
-	"LINK_CHECK":         2,
-	"LINK_ADR":           3,
-	"DUTY_CYCLE":         4,
-	"RX_PARAM_SETUP":     5,
-	"DEV_STATUS":         6,
-	"NEW_CHANNEL":        7,
-	"RX_TIMING_SETUP":    8,
-	"TX_PARAM_SETUP":     9,
-	"DL_CHANNEL":         10,
-	"REKEY":              11,
-	"ADR_PARAM_SETUP":    12,
-	"DEVICE_TIME":        13,
-	"FORCE_REJOIN":       14,
-	"REJOIN_PARAM_SETUP": 15,
-	"PING_SLOT_INFO":     16,
-	"PING_SLOT_CHANNEL":  17,
-	"BEACON_TIMING":      18,
-	"BEACON_FREQ":        19,
-	"DEVICE_MODE":        32,
+	"RFU_0":                       0,
+	"RESET":                       1,
+	"LINK_CHECK":                  2,
+	"LINK_ADR":                    3,
+	"DUTY_CYCLE":                  4,
+	"RX_PARAM_SETUP":              5,
+	"DEV_STATUS":                  6,
+	"NEW_CHANNEL":                 7,
+	"RX_TIMING_SETUP":             8,
+	"TX_PARAM_SETUP":              9,
+	"DL_CHANNEL":                  10,
+	"REKEY":                       11,
+	"ADR_PARAM_SETUP":             12,
+	"DEVICE_TIME":                 13,
+	"FORCE_REJOIN":                14,
+	"REJOIN_PARAM_SETUP":          15,
+	"PING_SLOT_INFO":              16,
+	"PING_SLOT_CHANNEL":           17,
+	"BEACON_TIMING":               18,
+	"BEACON_FREQ":                 19,
+	"DEVICE_MODE":                 32,
+	"RELAY_CONF":                  64,
+	"RELAY_END_DEVICE_CONF":       65,
+	"RELAY_FILTER_LIST":           66,
+	"RELAY_UPDATE_UPLINK_LIST":    67,
+	"RELAY_CTRL_UPLINK_LIST":      68,
+	"RELAY_CONFIGURE_FWD_LIMIT":   69,
+	"RELAY_NOTIFY_NEW_END_DEVICE": 70,
 }
 
 // UnmarshalProtoJSON unmarshals the MACCommandIdentifier from JSON.
@@ -641,6 +648,145 @@ func (x *Minor) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 	*x = Minor(v)
 }
 
+// MarshalProtoJSON marshals the RelayCADPeriodicity to JSON.
+func (x RelayCADPeriodicity) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), RelayCADPeriodicity_name)
+}
+
+// RelayCADPeriodicity_customvalue contains custom string values that extend RelayCADPeriodicity_value.
+var RelayCADPeriodicity_customvalue = map[string]int32{
+	"1_SECOND":         0,
+	"500_MILLISECONDS": 1,
+	"250_MILLISECONDS": 2,
+	"100_MILLISECONDS": 3,
+	"50_MILLISECONDS":  4,
+	"20_MILLISECONDS":  5,
+}
+
+// UnmarshalProtoJSON unmarshals the RelayCADPeriodicity from JSON.
+func (x *RelayCADPeriodicity) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(RelayCADPeriodicity_value, RelayCADPeriodicity_customvalue)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read RelayCADPeriodicity enum: %v", err)
+		return
+	}
+	*x = RelayCADPeriodicity(v)
+}
+
+// MarshalProtoJSON marshals the RelaySecondChAckOffset to JSON.
+func (x RelaySecondChAckOffset) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), RelaySecondChAckOffset_name)
+}
+
+// RelaySecondChAckOffset_customvalue contains custom string values that extend RelaySecondChAckOffset_value.
+var RelaySecondChAckOffset_customvalue = map[string]int32{
+	"0":    0,
+	"200":  1,
+	"400":  2,
+	"800":  3,
+	"1600": 4,
+	"3200": 5,
+}
+
+// UnmarshalProtoJSON unmarshals the RelaySecondChAckOffset from JSON.
+func (x *RelaySecondChAckOffset) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(RelaySecondChAckOffset_value, RelaySecondChAckOffset_customvalue)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read RelaySecondChAckOffset enum: %v", err)
+		return
+	}
+	*x = RelaySecondChAckOffset(v)
+}
+
+// MarshalProtoJSON marshals the RelaySmartEnableLevel to JSON.
+func (x RelaySmartEnableLevel) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), RelaySmartEnableLevel_name)
+}
+
+// RelaySmartEnableLevel_customvalue contains custom string values that extend RelaySmartEnableLevel_value.
+var RelaySmartEnableLevel_customvalue = map[string]int32{
+	"8":  0,
+	"16": 1,
+	"32": 2,
+	"64": 3,
+}
+
+// UnmarshalProtoJSON unmarshals the RelaySmartEnableLevel from JSON.
+func (x *RelaySmartEnableLevel) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(RelaySmartEnableLevel_value, RelaySmartEnableLevel_customvalue)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read RelaySmartEnableLevel enum: %v", err)
+		return
+	}
+	*x = RelaySmartEnableLevel(v)
+}
+
+// MarshalProtoJSON marshals the RelayLimitBucketSize to JSON.
+func (x RelayLimitBucketSize) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), RelayLimitBucketSize_name)
+}
+
+// RelayLimitBucketSize_customvalue contains custom string values that extend RelayLimitBucketSize_value.
+var RelayLimitBucketSize_customvalue = map[string]int32{
+	"1":  0,
+	"2":  1,
+	"4":  2,
+	"12": 3,
+}
+
+// UnmarshalProtoJSON unmarshals the RelayLimitBucketSize from JSON.
+func (x *RelayLimitBucketSize) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(RelayLimitBucketSize_value, RelayLimitBucketSize_customvalue)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read RelayLimitBucketSize enum: %v", err)
+		return
+	}
+	*x = RelayLimitBucketSize(v)
+}
+
+// MarshalProtoJSON marshals the RelayJoinRequestFilterAction to JSON.
+func (x RelayJoinRequestFilterAction) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), RelayJoinRequestFilterAction_name)
+}
+
+// RelayJoinRequestFilterAction_customvalue contains custom string values that extend RelayJoinRequestFilterAction_value.
+var RelayJoinRequestFilterAction_customvalue = map[string]int32{
+	"NO_RULE": 0,
+	"FORWARD": 1,
+	"FILTER":  2,
+}
+
+// UnmarshalProtoJSON unmarshals the RelayJoinRequestFilterAction from JSON.
+func (x *RelayJoinRequestFilterAction) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(RelayJoinRequestFilterAction_value, RelayJoinRequestFilterAction_customvalue)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read RelayJoinRequestFilterAction enum: %v", err)
+		return
+	}
+	*x = RelayJoinRequestFilterAction(v)
+}
+
+// MarshalProtoJSON marshals the RelayCtrlUplinkListAction to JSON.
+func (x RelayCtrlUplinkListAction) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), RelayCtrlUplinkListAction_name)
+}
+
+// RelayCtrlUplinkListAction_customvalue contains custom string values that extend RelayCtrlUplinkListAction_value.
+var RelayCtrlUplinkListAction_customvalue = map[string]int32{
+	"READ_W_F_CNT":              0,
+	"REMOVE_TRUSTED_END_DEVICE": 1,
+}
+
+// UnmarshalProtoJSON unmarshals the RelayCtrlUplinkListAction from JSON.
+func (x *RelayCtrlUplinkListAction) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(RelayCtrlUplinkListAction_value, RelayCtrlUplinkListAction_customvalue)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read RelayCtrlUplinkListAction enum: %v", err)
+		return
+	}
+	*x = RelayCtrlUplinkListAction(v)
+}
+
 // MarshalProtoJSON marshals the Message message to JSON.
 func (x *Message) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 	if x == nil {
@@ -1845,6 +1991,369 @@ func (x *MACCommand_DeviceModeConf) UnmarshalProtoJSON(s *jsonplugin.UnmarshalSt
 	})
 }
 
+// MarshalProtoJSON marshals the MACCommand_RelayConfReq_Configuration message to JSON.
+func (x *MACCommand_RelayConfReq_Configuration) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.SecondChannel != nil || s.HasField("second_channel") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("second_channel")
+		x.SecondChannel.MarshalProtoJSON(s.WithField("second_channel"))
+	}
+	if x.DefaultChannelIndex != 0 || s.HasField("default_channel_index") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("default_channel_index")
+		s.WriteUint32(x.DefaultChannelIndex)
+	}
+	if x.CadPeriodicity != 0 || s.HasField("cad_periodicity") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("cad_periodicity")
+		x.CadPeriodicity.MarshalProtoJSON(s)
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the MACCommand_RelayConfReq_Configuration message from JSON.
+func (x *MACCommand_RelayConfReq_Configuration) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "second_channel", "secondChannel":
+			if !s.ReadNil() {
+				x.SecondChannel = &RelaySecondChannel{}
+				x.SecondChannel.UnmarshalProtoJSON(s.WithField("second_channel", true))
+			}
+		case "default_channel_index", "defaultChannelIndex":
+			s.AddField("default_channel_index")
+			x.DefaultChannelIndex = s.ReadUint32()
+		case "cad_periodicity", "cadPeriodicity":
+			s.AddField("cad_periodicity")
+			x.CadPeriodicity.UnmarshalProtoJSON(s)
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the MACCommand_RelayConfReq message to JSON.
+func (x *MACCommand_RelayConfReq) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Configuration != nil || s.HasField("configuration") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("configuration")
+		x.Configuration.MarshalProtoJSON(s.WithField("configuration"))
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the MACCommand_RelayConfReq message from JSON.
+func (x *MACCommand_RelayConfReq) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "configuration":
+			if !s.ReadNil() {
+				x.Configuration = &MACCommand_RelayConfReq_Configuration{}
+				x.Configuration.UnmarshalProtoJSON(s.WithField("configuration", true))
+			}
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the MACCommand_RelayEndDeviceConfReq_Configuration message to JSON.
+func (x *MACCommand_RelayEndDeviceConfReq_Configuration) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Mode != nil {
+		switch ov := x.Mode.(type) {
+		case *MACCommand_RelayEndDeviceConfReq_Configuration_Always:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("always")
+			// NOTE: RelayEndDeviceAlwaysMode does not seem to implement MarshalProtoJSON.
+			gogo.MarshalMessage(s, ov.Always)
+		case *MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("dynamic")
+			ov.Dynamic.MarshalProtoJSON(s.WithField("dynamic"))
+		case *MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("end_device_controlled")
+			// NOTE: RelayEndDeviceControlledMode does not seem to implement MarshalProtoJSON.
+			gogo.MarshalMessage(s, ov.EndDeviceControlled)
+		}
+	}
+	if x.Backoff != 0 || s.HasField("backoff") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("backoff")
+		s.WriteUint32(x.Backoff)
+	}
+	if x.SecondChannel != nil || s.HasField("second_channel") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("second_channel")
+		x.SecondChannel.MarshalProtoJSON(s.WithField("second_channel"))
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the MACCommand_RelayEndDeviceConfReq_Configuration message from JSON.
+func (x *MACCommand_RelayEndDeviceConfReq_Configuration) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "always":
+			s.AddField("always")
+			ov := &MACCommand_RelayEndDeviceConfReq_Configuration_Always{}
+			// NOTE: RelayEndDeviceAlwaysMode does not seem to implement UnmarshalProtoJSON.
+			var v RelayEndDeviceAlwaysMode
+			gogo.UnmarshalMessage(s, &v)
+			ov.Always = &v
+			x.Mode = ov
+		case "dynamic":
+			ov := &MACCommand_RelayEndDeviceConfReq_Configuration_Dynamic{}
+			if !s.ReadNil() {
+				ov.Dynamic = &RelayEndDeviceDynamicMode{}
+				ov.Dynamic.UnmarshalProtoJSON(s.WithField("dynamic", true))
+			}
+			x.Mode = ov
+		case "end_device_controlled", "endDeviceControlled":
+			s.AddField("end_device_controlled")
+			ov := &MACCommand_RelayEndDeviceConfReq_Configuration_EndDeviceControlled{}
+			// NOTE: RelayEndDeviceControlledMode does not seem to implement UnmarshalProtoJSON.
+			var v RelayEndDeviceControlledMode
+			gogo.UnmarshalMessage(s, &v)
+			ov.EndDeviceControlled = &v
+			x.Mode = ov
+		case "backoff":
+			s.AddField("backoff")
+			x.Backoff = s.ReadUint32()
+		case "second_channel", "secondChannel":
+			if !s.ReadNil() {
+				x.SecondChannel = &RelaySecondChannel{}
+				x.SecondChannel.UnmarshalProtoJSON(s.WithField("second_channel", true))
+			}
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the MACCommand_RelayEndDeviceConfReq message to JSON.
+func (x *MACCommand_RelayEndDeviceConfReq) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Configuration != nil || s.HasField("configuration") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("configuration")
+		x.Configuration.MarshalProtoJSON(s.WithField("configuration"))
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the MACCommand_RelayEndDeviceConfReq message from JSON.
+func (x *MACCommand_RelayEndDeviceConfReq) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "configuration":
+			if !s.ReadNil() {
+				x.Configuration = &MACCommand_RelayEndDeviceConfReq_Configuration{}
+				x.Configuration.UnmarshalProtoJSON(s.WithField("configuration", true))
+			}
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the MACCommand_RelayFilterListReq message to JSON.
+func (x *MACCommand_RelayFilterListReq) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.RuleIndex != 0 || s.HasField("rule_index") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rule_index")
+		s.WriteUint32(x.RuleIndex)
+	}
+	if x.Action != 0 || s.HasField("action") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("action")
+		x.Action.MarshalProtoJSON(s)
+	}
+	if len(x.JoinEui) > 0 || s.HasField("join_eui") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("join_eui")
+		x.JoinEui.MarshalProtoJSON(s.WithField("join_eui"))
+	}
+	if len(x.DevEui) > 0 || s.HasField("dev_eui") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("dev_eui")
+		x.DevEui.MarshalProtoJSON(s.WithField("dev_eui"))
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the MACCommand_RelayFilterListReq message from JSON.
+func (x *MACCommand_RelayFilterListReq) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "rule_index", "ruleIndex":
+			s.AddField("rule_index")
+			x.RuleIndex = s.ReadUint32()
+		case "action":
+			s.AddField("action")
+			x.Action.UnmarshalProtoJSON(s)
+		case "join_eui", "joinEui":
+			s.AddField("join_eui")
+			x.JoinEui.UnmarshalProtoJSON(s.WithField("join_eui", false))
+		case "dev_eui", "devEui":
+			s.AddField("dev_eui")
+			x.DevEui.UnmarshalProtoJSON(s.WithField("dev_eui", false))
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the MACCommand_RelayUpdateUplinkListReq message to JSON.
+func (x *MACCommand_RelayUpdateUplinkListReq) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.RuleIndex != 0 || s.HasField("rule_index") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rule_index")
+		s.WriteUint32(x.RuleIndex)
+	}
+	if x.ForwardLimits != nil || s.HasField("forward_limits") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("forward_limits")
+		x.ForwardLimits.MarshalProtoJSON(s.WithField("forward_limits"))
+	}
+	if len(x.DevAddr) > 0 || s.HasField("dev_addr") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("dev_addr")
+		x.DevAddr.MarshalProtoJSON(s.WithField("dev_addr"))
+	}
+	if x.WFCnt != 0 || s.HasField("w_f_cnt") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("w_f_cnt")
+		s.WriteUint32(x.WFCnt)
+	}
+	if len(x.RootWorSKey) > 0 || s.HasField("root_wor_s_key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("root_wor_s_key")
+		x.RootWorSKey.MarshalProtoJSON(s.WithField("root_wor_s_key"))
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the MACCommand_RelayUpdateUplinkListReq message from JSON.
+func (x *MACCommand_RelayUpdateUplinkListReq) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "rule_index", "ruleIndex":
+			s.AddField("rule_index")
+			x.RuleIndex = s.ReadUint32()
+		case "forward_limits", "forwardLimits":
+			if !s.ReadNil() {
+				x.ForwardLimits = &RelayForwardLimits{}
+				x.ForwardLimits.UnmarshalProtoJSON(s.WithField("forward_limits", true))
+			}
+		case "dev_addr", "devAddr":
+			s.AddField("dev_addr")
+			x.DevAddr.UnmarshalProtoJSON(s.WithField("dev_addr", false))
+		case "w_f_cnt", "wFCnt":
+			s.AddField("w_f_cnt")
+			x.WFCnt = s.ReadUint32()
+		case "root_wor_s_key", "rootWorSKey":
+			s.AddField("root_wor_s_key")
+			x.RootWorSKey.UnmarshalProtoJSON(s.WithField("root_wor_s_key", false))
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the MACCommand_RelayCtrlUplinkListReq message to JSON.
+func (x *MACCommand_RelayCtrlUplinkListReq) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.RuleIndex != 0 || s.HasField("rule_index") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rule_index")
+		s.WriteUint32(x.RuleIndex)
+	}
+	if x.Action != 0 || s.HasField("action") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("action")
+		x.Action.MarshalProtoJSON(s)
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the MACCommand_RelayCtrlUplinkListReq message from JSON.
+func (x *MACCommand_RelayCtrlUplinkListReq) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "rule_index", "ruleIndex":
+			s.AddField("rule_index")
+			x.RuleIndex = s.ReadUint32()
+		case "action":
+			s.AddField("action")
+			x.Action.UnmarshalProtoJSON(s)
+		}
+	})
+}
+
 // MarshalProtoJSON marshals the MACCommand message to JSON.
 func (x *MACCommand) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 	if x == nil {
@@ -1997,6 +2506,51 @@ func (x *MACCommand) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 			s.WriteMoreIf(&wroteField)
 			s.WriteObjectField("device_mode_conf")
 			ov.DeviceModeConf.MarshalProtoJSON(s.WithField("device_mode_conf"))
+		case *MACCommand_RelayConfReq_:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("relay_conf_req")
+			ov.RelayConfReq.MarshalProtoJSON(s.WithField("relay_conf_req"))
+		case *MACCommand_RelayConfAns_:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("relay_conf_ans")
+			// NOTE: MACCommand_RelayConfAns does not seem to implement MarshalProtoJSON.
+			gogo.MarshalMessage(s, ov.RelayConfAns)
+		case *MACCommand_RelayEndDeviceConfReq_:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("relay_end_device_conf_req")
+			ov.RelayEndDeviceConfReq.MarshalProtoJSON(s.WithField("relay_end_device_conf_req"))
+		case *MACCommand_RelayEndDeviceConfAns_:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("relay_end_device_conf_ans")
+			// NOTE: MACCommand_RelayEndDeviceConfAns does not seem to implement MarshalProtoJSON.
+			gogo.MarshalMessage(s, ov.RelayEndDeviceConfAns)
+		case *MACCommand_RelayFilterListReq_:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("relay_filter_list_req")
+			ov.RelayFilterListReq.MarshalProtoJSON(s.WithField("relay_filter_list_req"))
+		case *MACCommand_RelayFilterListAns_:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("relay_filter_list_ans")
+			// NOTE: MACCommand_RelayFilterListAns does not seem to implement MarshalProtoJSON.
+			gogo.MarshalMessage(s, ov.RelayFilterListAns)
+		case *MACCommand_RelayUpdateUplinkListReq_:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("relay_update_uplink_list_req")
+			ov.RelayUpdateUplinkListReq.MarshalProtoJSON(s.WithField("relay_update_uplink_list_req"))
+		case *MACCommand_RelayCtrlUplinkListReq_:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("relay_ctrl_uplink_list_req")
+			ov.RelayCtrlUplinkListReq.MarshalProtoJSON(s.WithField("relay_ctrl_uplink_list_req"))
+		case *MACCommand_RelayCtrlUplinkListAns_:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("relay_ctrl_uplink_list_ans")
+			// NOTE: MACCommand_RelayCtrlUplinkListAns does not seem to implement MarshalProtoJSON.
+			gogo.MarshalMessage(s, ov.RelayCtrlUplinkListAns)
+		case *MACCommand_RelayNotifyNewEndDeviceReq_:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("relay_notify_new_end_device_req")
+			// NOTE: MACCommand_RelayNotifyNewEndDeviceReq does not seem to implement MarshalProtoJSON.
+			gogo.MarshalMessage(s, ov.RelayNotifyNewEndDeviceReq)
 		}
 	}
 	s.WriteObjectEnd()
@@ -2242,6 +2796,201 @@ func (x *MACCommand) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 				ov.DeviceModeConf.UnmarshalProtoJSON(s.WithField("device_mode_conf", true))
 			}
 			x.Payload = ov
+		case "relay_conf_req", "relayConfReq":
+			ov := &MACCommand_RelayConfReq_{}
+			if !s.ReadNil() {
+				ov.RelayConfReq = &MACCommand_RelayConfReq{}
+				ov.RelayConfReq.UnmarshalProtoJSON(s.WithField("relay_conf_req", true))
+			}
+			x.Payload = ov
+		case "relay_conf_ans", "relayConfAns":
+			s.AddField("relay_conf_ans")
+			ov := &MACCommand_RelayConfAns_{}
+			// NOTE: MACCommand_RelayConfAns does not seem to implement UnmarshalProtoJSON.
+			var v MACCommand_RelayConfAns
+			gogo.UnmarshalMessage(s, &v)
+			ov.RelayConfAns = &v
+			x.Payload = ov
+		case "relay_end_device_conf_req", "relayEndDeviceConfReq":
+			ov := &MACCommand_RelayEndDeviceConfReq_{}
+			if !s.ReadNil() {
+				ov.RelayEndDeviceConfReq = &MACCommand_RelayEndDeviceConfReq{}
+				ov.RelayEndDeviceConfReq.UnmarshalProtoJSON(s.WithField("relay_end_device_conf_req", true))
+			}
+			x.Payload = ov
+		case "relay_end_device_conf_ans", "relayEndDeviceConfAns":
+			s.AddField("relay_end_device_conf_ans")
+			ov := &MACCommand_RelayEndDeviceConfAns_{}
+			// NOTE: MACCommand_RelayEndDeviceConfAns does not seem to implement UnmarshalProtoJSON.
+			var v MACCommand_RelayEndDeviceConfAns
+			gogo.UnmarshalMessage(s, &v)
+			ov.RelayEndDeviceConfAns = &v
+			x.Payload = ov
+		case "relay_filter_list_req", "relayFilterListReq":
+			ov := &MACCommand_RelayFilterListReq_{}
+			if !s.ReadNil() {
+				ov.RelayFilterListReq = &MACCommand_RelayFilterListReq{}
+				ov.RelayFilterListReq.UnmarshalProtoJSON(s.WithField("relay_filter_list_req", true))
+			}
+			x.Payload = ov
+		case "relay_filter_list_ans", "relayFilterListAns":
+			s.AddField("relay_filter_list_ans")
+			ov := &MACCommand_RelayFilterListAns_{}
+			// NOTE: MACCommand_RelayFilterListAns does not seem to implement UnmarshalProtoJSON.
+			var v MACCommand_RelayFilterListAns
+			gogo.UnmarshalMessage(s, &v)
+			ov.RelayFilterListAns = &v
+			x.Payload = ov
+		case "relay_update_uplink_list_req", "relayUpdateUplinkListReq":
+			ov := &MACCommand_RelayUpdateUplinkListReq_{}
+			if !s.ReadNil() {
+				ov.RelayUpdateUplinkListReq = &MACCommand_RelayUpdateUplinkListReq{}
+				ov.RelayUpdateUplinkListReq.UnmarshalProtoJSON(s.WithField("relay_update_uplink_list_req", true))
+			}
+			x.Payload = ov
+		case "relay_ctrl_uplink_list_req", "relayCtrlUplinkListReq":
+			ov := &MACCommand_RelayCtrlUplinkListReq_{}
+			if !s.ReadNil() {
+				ov.RelayCtrlUplinkListReq = &MACCommand_RelayCtrlUplinkListReq{}
+				ov.RelayCtrlUplinkListReq.UnmarshalProtoJSON(s.WithField("relay_ctrl_uplink_list_req", true))
+			}
+			x.Payload = ov
+		case "relay_ctrl_uplink_list_ans", "relayCtrlUplinkListAns":
+			s.AddField("relay_ctrl_uplink_list_ans")
+			ov := &MACCommand_RelayCtrlUplinkListAns_{}
+			// NOTE: MACCommand_RelayCtrlUplinkListAns does not seem to implement UnmarshalProtoJSON.
+			var v MACCommand_RelayCtrlUplinkListAns
+			gogo.UnmarshalMessage(s, &v)
+			ov.RelayCtrlUplinkListAns = &v
+			x.Payload = ov
+		case "relay_notify_new_end_device_req", "relayNotifyNewEndDeviceReq":
+			s.AddField("relay_notify_new_end_device_req")
+			ov := &MACCommand_RelayNotifyNewEndDeviceReq_{}
+			// NOTE: MACCommand_RelayNotifyNewEndDeviceReq does not seem to implement UnmarshalProtoJSON.
+			var v MACCommand_RelayNotifyNewEndDeviceReq
+			gogo.UnmarshalMessage(s, &v)
+			ov.RelayNotifyNewEndDeviceReq = &v
+			x.Payload = ov
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the RelaySecondChannel message to JSON.
+func (x *RelaySecondChannel) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.AckOffset != 0 || s.HasField("ack_offset") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("ack_offset")
+		x.AckOffset.MarshalProtoJSON(s)
+	}
+	if x.DataRateIndex != 0 || s.HasField("data_rate_index") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("data_rate_index")
+		x.DataRateIndex.MarshalProtoJSON(s)
+	}
+	if x.Frequency != 0 || s.HasField("frequency") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("frequency")
+		s.WriteUint64(x.Frequency)
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the RelaySecondChannel message from JSON.
+func (x *RelaySecondChannel) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "ack_offset", "ackOffset":
+			s.AddField("ack_offset")
+			x.AckOffset.UnmarshalProtoJSON(s)
+		case "data_rate_index", "dataRateIndex":
+			s.AddField("data_rate_index")
+			x.DataRateIndex.UnmarshalProtoJSON(s)
+		case "frequency":
+			s.AddField("frequency")
+			x.Frequency = s.ReadUint64()
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the RelayEndDeviceDynamicMode message to JSON.
+func (x *RelayEndDeviceDynamicMode) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.SmartEnableLevel != 0 || s.HasField("smart_enable_level") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("smart_enable_level")
+		x.SmartEnableLevel.MarshalProtoJSON(s)
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the RelayEndDeviceDynamicMode message from JSON.
+func (x *RelayEndDeviceDynamicMode) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "smart_enable_level", "smartEnableLevel":
+			s.AddField("smart_enable_level")
+			x.SmartEnableLevel.UnmarshalProtoJSON(s)
+		}
+	})
+}
+
+// MarshalProtoJSON marshals the RelayForwardLimits message to JSON.
+func (x *RelayForwardLimits) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.BucketSize != 0 || s.HasField("bucket_size") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("bucket_size")
+		x.BucketSize.MarshalProtoJSON(s)
+	}
+	if x.ReloadRate != 0 || s.HasField("reload_rate") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("reload_rate")
+		s.WriteUint32(x.ReloadRate)
+	}
+	s.WriteObjectEnd()
+}
+
+// UnmarshalProtoJSON unmarshals the RelayForwardLimits message from JSON.
+func (x *RelayForwardLimits) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "bucket_size", "bucketSize":
+			s.AddField("bucket_size")
+			x.BucketSize.UnmarshalProtoJSON(s)
+		case "reload_rate", "reloadRate":
+			s.AddField("reload_rate")
+			x.ReloadRate = s.ReadUint32()
 		}
 	})
 }
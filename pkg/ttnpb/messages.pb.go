@@ -1373,6 +1373,74 @@ func (*ApplicationUp) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// ApplicationRoutingRule is a routing rule of a webhook or Pub/Sub integration.
+// All the conditions that are set must match in order for the rule to match.
+type ApplicationRoutingRule struct {
+	// Shell pattern that the end device ID must match, i.e. `alarm-*`.
+	DeviceIdPattern string `protobuf:"bytes,1,opt,name=device_id_pattern,json=deviceIdPattern,proto3" json:"device_id_pattern,omitempty"`
+	// Attributes that the end device must have. An empty value matches any value of the attribute.
+	DeviceAttributes map[string]string `protobuf:"bytes,2,rep,name=device_attributes,json=deviceAttributes,proto3" json:"device_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// FPorts of which one must match. The condition only matches uplink and downlink messages.
+	FPorts []uint32 `protobuf:"varint,3,rep,packed,name=f_ports,json=fPorts,proto3" json:"f_ports,omitempty"`
+	// JavaScript expression evaluated on the decoded payload of uplink messages.
+	// The decoded payload is available as `payload` and the FPort as `f_port`, i.e. `payload.temperature > 30`.
+	// The condition only matches uplink messages.
+	Expression           string   `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationRoutingRule) Reset()      { *m = ApplicationRoutingRule{} }
+func (*ApplicationRoutingRule) ProtoMessage() {}
+func (*ApplicationRoutingRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbc6bff5780bdc9d, []int{15}
+}
+func (m *ApplicationRoutingRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationRoutingRule.Unmarshal(m, b)
+}
+func (m *ApplicationRoutingRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationRoutingRule.Marshal(b, m, deterministic)
+}
+func (m *ApplicationRoutingRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationRoutingRule.Merge(m, src)
+}
+func (m *ApplicationRoutingRule) XXX_Size() int {
+	return xxx_messageInfo_ApplicationRoutingRule.Size(m)
+}
+func (m *ApplicationRoutingRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationRoutingRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationRoutingRule proto.InternalMessageInfo
+
+func (m *ApplicationRoutingRule) GetDeviceIdPattern() string {
+	if m != nil {
+		return m.DeviceIdPattern
+	}
+	return ""
+}
+
+func (m *ApplicationRoutingRule) GetDeviceAttributes() map[string]string {
+	if m != nil {
+		return m.DeviceAttributes
+	}
+	return nil
+}
+
+func (m *ApplicationRoutingRule) GetFPorts() []uint32 {
+	if m != nil {
+		return m.FPorts
+	}
+	return nil
+}
+
+func (m *ApplicationRoutingRule) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
+
 type MessagePayloadFormatters struct {
 	// Payload formatter for uplink messages, must be set together with its parameter.
 	UpFormatter PayloadFormatter `protobuf:"varint,1,opt,name=up_formatter,json=upFormatter,proto3,enum=ttn.lorawan.v3.PayloadFormatter" json:"up_formatter,omitempty"`
@@ -1389,7 +1457,7 @@ type MessagePayloadFormatters struct {
 func (m *MessagePayloadFormatters) Reset()      { *m = MessagePayloadFormatters{} }
 func (*MessagePayloadFormatters) ProtoMessage() {}
 func (*MessagePayloadFormatters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{15}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{16}
 }
 func (m *MessagePayloadFormatters) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MessagePayloadFormatters.Unmarshal(m, b)
@@ -1447,7 +1515,7 @@ type DownlinkQueueRequest struct {
 func (m *DownlinkQueueRequest) Reset()      { *m = DownlinkQueueRequest{} }
 func (*DownlinkQueueRequest) ProtoMessage() {}
 func (*DownlinkQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{16}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{17}
 }
 func (m *DownlinkQueueRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownlinkQueueRequest.Unmarshal(m, b)
@@ -1515,6 +1583,10 @@ func init() {
 	golang_proto.RegisterType((*ApplicationServiceData)(nil), "ttn.lorawan.v3.ApplicationServiceData")
 	proto.RegisterType((*ApplicationUp)(nil), "ttn.lorawan.v3.ApplicationUp")
 	golang_proto.RegisterType((*ApplicationUp)(nil), "ttn.lorawan.v3.ApplicationUp")
+	proto.RegisterType((*ApplicationRoutingRule)(nil), "ttn.lorawan.v3.ApplicationRoutingRule")
+	golang_proto.RegisterType((*ApplicationRoutingRule)(nil), "ttn.lorawan.v3.ApplicationRoutingRule")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationRoutingRule.DeviceAttributesEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationRoutingRule.DeviceAttributesEntry")
 	proto.RegisterType((*MessagePayloadFormatters)(nil), "ttn.lorawan.v3.MessagePayloadFormatters")
 	golang_proto.RegisterType((*MessagePayloadFormatters)(nil), "ttn.lorawan.v3.MessagePayloadFormatters")
 	proto.RegisterType((*DownlinkQueueRequest)(nil), "ttn.lorawan.v3.DownlinkQueueRequest")
@@ -1527,164 +1599,171 @@ func init() {
 }
 
 var fileDescriptor_bbc6bff5780bdc9d = []byte{
-	// 2503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x8c, 0x23, 0x47,
-	0x15, 0x76, 0xfb, 0xdf, 0xcf, 0x3f, 0xd3, 0x5b, 0x99, 0x6c, 0x7a, 0x87, 0xcd, 0xcc, 0xe0, 0x6c,
-	0xc8, 0x64, 0xc3, 0x78, 0xc2, 0xac, 0xa2, 0x84, 0x8d, 0xa2, 0xc4, 0xf6, 0x78, 0x77, 0x3c, 0x3f,
-	0xf6, 0x6c, 0xd9, 0xbb, 0xc9, 0x12, 0x42, 0xab, 0xc7, 0x5d, 0xe3, 0xed, 0x8c, 0xdd, 0xdd, 0xe9,
-	0x2e, 0x7b, 0x66, 0x82, 0x90, 0x22, 0x6e, 0x44, 0x42, 0x8a, 0x72, 0x09, 0x42, 0x48, 0x20, 0x21,
-	0x21, 0x14, 0x2e, 0xdc, 0xe1, 0x10, 0xc1, 0x25, 0xe2, 0xc4, 0x11, 0x71, 0x08, 0xb0, 0xb9, 0xa0,
-	0x5c, 0xe0, 0x84, 0xd0, 0x5c, 0x82, 0xba, 0xba, 0xda, 0xee, 0x6e, 0x7b, 0x67, 0x3d, 0x09, 0x48,
-	0xdc, 0xba, 0xab, 0xde, 0xfb, 0xea, 0xd5, 0x7b, 0xaf, 0xbe, 0xf7, 0xaa, 0x60, 0xb9, 0x67, 0x58,
-	0xca, 0x91, 0xa2, 0xaf, 0xda, 0x54, 0xe9, 0x1c, 0xae, 0x29, 0xa6, 0xb6, 0xd6, 0x27, 0xb6, 0xad,
-	0x74, 0x89, 0x5d, 0x32, 0x2d, 0x83, 0x1a, 0xa8, 0x40, 0xa9, 0x5e, 0xe2, 0x52, 0xa5, 0xe1, 0xb5,
-	0x85, 0x72, 0x57, 0xa3, 0xf7, 0x06, 0xfb, 0xa5, 0x8e, 0xd1, 0x5f, 0x23, 0xfa, 0xd0, 0x38, 0x31,
-	0x2d, 0xe3, 0xf8, 0x64, 0x8d, 0x09, 0x77, 0x56, 0xbb, 0x44, 0x5f, 0x1d, 0x2a, 0x3d, 0x4d, 0x55,
-	0x28, 0x59, 0x9b, 0xf8, 0x70, 0x21, 0x17, 0x56, 0x7d, 0x10, 0x5d, 0xa3, 0x6b, 0xb8, 0xca, 0xfb,
-	0x83, 0x03, 0xf6, 0xc7, 0x7e, 0xd8, 0x17, 0x17, 0xaf, 0xfa, 0xc4, 0xdb, 0xf7, 0x48, 0xfb, 0x9e,
-	0xa6, 0x77, 0xed, 0xba, 0xae, 0x0e, 0x6c, 0x6a, 0x69, 0xc4, 0xf6, 0x2f, 0xdd, 0x35, 0x56, 0xdf,
-	0xb4, 0x0d, 0x7d, 0x4d, 0xd1, 0x75, 0x83, 0x2a, 0x54, 0x33, 0x74, 0xbe, 0x8d, 0x85, 0xcb, 0x5d,
-	0xc3, 0xe8, 0xf6, 0xc8, 0x78, 0x29, 0x9b, 0x5a, 0x83, 0x0e, 0xe5, 0xb3, 0x4b, 0xe1, 0x59, 0xaa,
-	0xf5, 0x89, 0x4d, 0x95, 0xbe, 0xc9, 0x05, 0x16, 0xc3, 0x02, 0xea, 0xc0, 0x62, 0xf8, 0x7c, 0xfe,
-	0xf1, 0x49, 0x3f, 0x12, 0xcb, 0x32, 0x2c, 0x3e, 0xfd, 0xc4, 0xe4, 0xb4, 0xa6, 0x12, 0x9d, 0x6a,
-	0x07, 0x1a, 0xb1, 0x46, 0x26, 0x4e, 0x0a, 0x1d, 0x92, 0x13, 0x6f, 0x76, 0x69, 0x72, 0xd6, 0x8b,
-	0x8a, 0x2b, 0x30, 0x35, 0x94, 0x54, 0x51, 0x15, 0xaa, 0xb8, 0x12, 0xc5, 0x7f, 0xc5, 0x20, 0x7f,
-	0xdb, 0xec, 0x69, 0xfa, 0xe1, 0xae, 0x1b, 0x63, 0xb4, 0x04, 0x59, 0x4b, 0x39, 0x92, 0x4d, 0xe5,
-	0xa4, 0x67, 0x28, 0xaa, 0x24, 0x2c, 0x0b, 0x2b, 0x39, 0x0c, 0x96, 0x72, 0xb4, 0xe7, 0x8e, 0xa0,
-	0x6f, 0x40, 0xca, 0x9b, 0x8c, 0x2e, 0x0b, 0x2b, 0xd9, 0xf5, 0xc7, 0x4a, 0xc1, 0x7c, 0x28, 0x71,
-	0x28, 0xec, 0xc9, 0xa1, 0x0d, 0x48, 0xdb, 0x84, 0x52, 0x27, 0x48, 0x52, 0x9c, 0xe9, 0x2c, 0x84,
-	0x75, 0xda, 0xc7, 0x2d, 0x2e, 0x51, 0xc9, 0x9d, 0x56, 0x12, 0xef, 0x0a, 0x51, 0x51, 0xf8, 0xf8,
-	0x93, 0xa5, 0x08, 0x1e, 0x69, 0xa2, 0x17, 0x21, 0x6b, 0x1d, 0xcb, 0xde, 0x06, 0xa4, 0xc4, 0x72,
-	0x6c, 0x1a, 0x10, 0x3e, 0xde, 0xe5, 0x12, 0x18, 0xac, 0xd1, 0x37, 0xaa, 0x41, 0xd6, 0x22, 0x1d,
-	0xa2, 0x0d, 0x89, 0x2a, 0x2b, 0x54, 0x4a, 0x72, 0x2b, 0xdc, 0x18, 0x96, 0xbc, 0x18, 0x96, 0xda,
-	0x5e, 0x90, 0x2b, 0x69, 0x67, 0xf5, 0xf7, 0xfe, 0xb2, 0x24, 0x60, 0xf0, 0x14, 0xcb, 0x14, 0x3d,
-	0x07, 0x73, 0x1d, 0xc3, 0xb2, 0x48, 0x8f, 0x45, 0x5a, 0xd6, 0x54, 0x5b, 0x4a, 0x2d, 0xc7, 0x56,
-	0x32, 0x8e, 0xd1, 0x99, 0xf7, 0x85, 0x64, 0x31, 0x6e, 0x45, 0x25, 0x15, 0x17, 0x7c, 0x42, 0x75,
-	0xd5, 0x46, 0xd7, 0x61, 0x5e, 0x25, 0x43, 0xad, 0x43, 0xe4, 0xce, 0x3d, 0x45, 0xd7, 0x49, 0x4f,
-	0xd6, 0x74, 0x95, 0x1c, 0x4b, 0x99, 0x65, 0x61, 0x25, 0x5f, 0x49, 0x9f, 0x56, 0x12, 0x57, 0x63,
-	0xd2, 0xe7, 0x02, 0x46, 0xae, 0x54, 0xd5, 0x15, 0xaa, 0x3b, 0x32, 0xa8, 0x01, 0x62, 0xc7, 0xd0,
-	0xed, 0x41, 0xdf, 0xb1, 0x5c, 0xb3, 0x9c, 0x34, 0x94, 0x80, 0x99, 0x7f, 0x69, 0xc2, 0xfc, 0x0d,
-	0x9e, 0x82, 0xcc, 0x7a, 0xe1, 0x47, 0x8e, 0xf5, 0x73, 0x9e, 0x72, 0xd9, 0xd5, 0xdd, 0x8a, 0xa7,
-	0xd3, 0x62, 0xa6, 0xf8, 0x93, 0x18, 0xcc, 0x6d, 0x18, 0x47, 0xfa, 0xff, 0x3a, 0xf4, 0x5b, 0x50,
-	0x20, 0xba, 0x2a, 0xf3, 0xdd, 0x3b, 0xfe, 0x8a, 0x31, 0xcd, 0x2b, 0x61, 0xcd, 0x9a, 0xae, 0x6e,
-	0x30, 0xa1, 0xfa, 0xf8, 0x14, 0xe0, 0x1c, 0x19, 0x8f, 0xda, 0xe8, 0x39, 0x48, 0x59, 0xe4, 0xad,
-	0x01, 0xb1, 0x29, 0xcf, 0xa2, 0x4b, 0x93, 0x59, 0x84, 0x5d, 0x81, 0xcd, 0x08, 0xf6, 0x64, 0xd1,
-	0x75, 0xc8, 0xd8, 0x9d, 0x7b, 0x44, 0x1d, 0xf4, 0x88, 0x2a, 0x25, 0x1e, 0x96, 0x7e, 0x9b, 0x11,
-	0x3c, 0x16, 0x9f, 0x16, 0xef, 0xe4, 0x0c, 0xf1, 0x2e, 0x41, 0xc1, 0x26, 0xb6, 0xed, 0xa8, 0x1c,
-	0x92, 0x13, 0x59, 0x53, 0xa5, 0x94, 0xe3, 0x4c, 0x16, 0xe9, 0xb7, 0x63, 0xd2, 0x3b, 0x22, 0xce,
-	0xf1, 0xf9, 0x6d, 0x72, 0x52, 0x57, 0x2b, 0x73, 0xe3, 0x03, 0x82, 0x62, 0xff, 0xae, 0x08, 0xc5,
-	0x1f, 0xc4, 0x40, 0x6c, 0x1f, 0x97, 0x3b, 0x87, 0xba, 0x71, 0xd4, 0x23, 0x6a, 0xb7, 0x4f, 0xf4,
-	0xa9, 0xc9, 0x27, 0xcc, 0x60, 0x4c, 0x1d, 0x92, 0x16, 0xb1, 0x07, 0x3d, 0xca, 0x82, 0x56, 0x58,
-	0x7f, 0x6a, 0x72, 0xf3, 0xc1, 0x85, 0x4a, 0x98, 0x89, 0x33, 0x6b, 0xbf, 0xef, 0x1c, 0x44, 0xcc,
-	0x01, 0xd0, 0x16, 0x88, 0x2a, 0x4f, 0x1a, 0x99, 0x17, 0x05, 0x1e, 0xcf, 0xa5, 0x30, 0x68, 0x28,
-	0xb9, 0xf0, 0x9c, 0x1a, 0x1c, 0x28, 0xfe, 0x42, 0x80, 0xa4, 0xbb, 0x10, 0xca, 0x42, 0xaa, 0x75,
-	0xbb, 0x5a, 0xad, 0xb5, 0x5a, 0x62, 0x04, 0x5d, 0x80, 0xfc, 0xed, 0xc6, 0x76, 0xa3, 0xf9, 0x6a,
-	0x43, 0xae, 0x61, 0xdc, 0xc4, 0xa2, 0x80, 0x72, 0x90, 0x6e, 0x37, 0x9b, 0xf2, 0x4e, 0xb9, 0x5d,
-	0x13, 0xa3, 0x28, 0x0f, 0x19, 0xe7, 0xaf, 0x56, 0xc6, 0x3b, 0x77, 0xc5, 0x18, 0x9a, 0x07, 0xb1,
-	0xda, 0xdc, 0xd9, 0xa9, 0xb7, 0xea, 0xcd, 0x86, 0xbc, 0x57, 0xae, 0x6e, 0xd7, 0xda, 0x62, 0x3c,
-	0x38, 0x5a, 0xa9, 0x95, 0xab, 0xcd, 0x86, 0x98, 0x70, 0x16, 0x6a, 0xbf, 0x26, 0xdf, 0xc0, 0xb5,
-	0x5b, 0x62, 0x92, 0xa1, 0xbe, 0x26, 0xef, 0x35, 0x5f, 0xad, 0x61, 0x31, 0x85, 0x44, 0xc8, 0xdd,
-	0xdc, 0x6b, 0xc9, 0xb7, 0x1b, 0x3b, 0xcd, 0xea, 0x76, 0x6d, 0x43, 0x4c, 0x2f, 0x24, 0x3f, 0xfb,
-	0xf0, 0x52, 0x54, 0x12, 0x8a, 0x1f, 0x08, 0xf0, 0xd8, 0x4d, 0x85, 0x92, 0x23, 0xe5, 0x64, 0x22,
-	0x24, 0x55, 0xc8, 0x76, 0xdd, 0x29, 0x1e, 0x0e, 0xc7, 0x17, 0xc5, 0xb0, 0x2f, 0xb8, 0xb6, 0x3f,
-	0xb3, 0xa1, 0xeb, 0x8d, 0xd9, 0xe8, 0x79, 0x48, 0xd2, 0x63, 0x59, 0xe9, 0x1c, 0xf2, 0x53, 0xb5,
-	0xfc, 0xb0, 0x00, 0xe1, 0x04, 0x75, 0x46, 0x8a, 0x43, 0x98, 0xe7, 0xd0, 0x41, 0x0e, 0xaf, 0x41,
-	0xca, 0x8b, 0x8e, 0x6b, 0xd1, 0xe3, 0x61, 0xc4, 0x80, 0xfc, 0x98, 0x71, 0xff, 0xf8, 0xc9, 0x92,
-	0x80, 0x3d, 0x5d, 0xf4, 0x18, 0xa4, 0xf6, 0x15, 0x5d, 0x75, 0xd2, 0xd7, 0x31, 0x2c, 0x83, 0x93,
-	0xce, 0x6f, 0x5d, 0x2d, 0xfe, 0x23, 0x05, 0x17, 0xca, 0xa6, 0xd9, 0xd3, 0x3a, 0x2c, 0xc9, 0x5c,
-	0xb0, 0x29, 0x49, 0x2f, 0x9c, 0x95, 0xf4, 0xa8, 0x08, 0xc9, 0x03, 0xd9, 0x34, 0x2c, 0x37, 0x2f,
-	0xf3, 0x95, 0xec, 0x69, 0x25, 0x7d, 0x35, 0x29, 0x7d, 0x2e, 0xbc, 0xf0, 0x57, 0x01, 0x27, 0x0e,
-	0xf6, 0x0c, 0x8b, 0xa2, 0x47, 0x20, 0x71, 0x20, 0x77, 0x74, 0xca, 0xb2, 0x2c, 0x8f, 0xe3, 0x07,
-	0x55, 0x9d, 0x3a, 0x3c, 0x75, 0x60, 0xf5, 0x47, 0x3c, 0x15, 0x77, 0x79, 0xea, 0xc0, 0xea, 0x7b,
-	0x3c, 0xf5, 0x0a, 0xcc, 0xa9, 0xa4, 0x63, 0xa8, 0x44, 0x1d, 0x09, 0x25, 0x38, 0x5f, 0x85, 0x19,
-	0xb3, 0xc5, 0x6a, 0x3e, 0x2e, 0x70, 0x79, 0x0f, 0xe1, 0x05, 0x90, 0x42, 0x08, 0xf2, 0x91, 0x62,
-	0xe9, 0xac, 0x82, 0xe5, 0x9c, 0x33, 0x87, 0x2f, 0x06, 0x35, 0x5e, 0xe5, 0xb3, 0xe1, 0x2a, 0x95,
-	0x3c, 0x57, 0x95, 0xf2, 0x17, 0xca, 0xd4, 0x17, 0x2e, 0x94, 0xa1, 0x5a, 0x97, 0xfe, 0x82, 0xb5,
-	0xee, 0x79, 0xc8, 0x28, 0xa6, 0x29, 0xdb, 0x4e, 0x34, 0x59, 0xa5, 0xca, 0xae, 0x7f, 0x25, 0x6c,
-	0xcd, 0x36, 0x39, 0xa9, 0xe9, 0x43, 0xd2, 0x33, 0x4c, 0x82, 0x53, 0x8a, 0x69, 0xb6, 0xb6, 0xc9,
-	0x09, 0x5a, 0x81, 0x0b, 0x3d, 0xc5, 0xa6, 0xb2, 0x22, 0xb3, 0xd8, 0xc9, 0xce, 0xc9, 0x67, 0x25,
-	0x2b, 0x8f, 0xf3, 0xce, 0x44, 0xf9, 0x46, 0x55, 0xa7, 0x0e, 0x3f, 0xa0, 0xcb, 0x90, 0xe9, 0x18,
-	0xfa, 0x81, 0x66, 0xf5, 0x89, 0x2a, 0x65, 0x97, 0x85, 0x95, 0x34, 0x1e, 0x0f, 0x4c, 0xad, 0x7c,
-	0xf9, 0x2f, 0x5e, 0xf9, 0x50, 0x03, 0x32, 0x3d, 0xc3, 0x4d, 0x59, 0x5b, 0x2a, 0xb0, 0xc0, 0x3c,
-	0x1b, 0xde, 0xd0, 0x44, 0x5a, 0x97, 0x76, 0x3c, 0x95, 0x9a, 0x4e, 0xad, 0x13, 0x3c, 0x86, 0x40,
-	0x3b, 0x90, 0x1d, 0x12, 0xcb, 0xf6, 0xb8, 0x78, 0x8e, 0x99, 0xf6, 0xcc, 0x03, 0x0b, 0xdb, 0x1d,
-	0x57, 0x36, 0xc0, 0x02, 0x43, 0x6f, 0xcc, 0x76, 0xa8, 0x44, 0x27, 0xf4, 0xc8, 0xb0, 0x0e, 0x19,
-	0x9a, 0x38, 0x9d, 0x4a, 0x1a, 0xae, 0x48, 0x00, 0x44, 0xf7, 0xc6, 0xec, 0x85, 0x3b, 0x50, 0x08,
-	0xda, 0x8b, 0x44, 0x88, 0x39, 0xf1, 0x13, 0xd8, 0x01, 0x76, 0x3e, 0x51, 0x09, 0x12, 0x43, 0xa5,
-	0x37, 0x20, 0x9c, 0x6d, 0xa4, 0xf0, 0x12, 0x1e, 0x00, 0x76, 0xc5, 0xae, 0x47, 0x5f, 0x10, 0x8a,
-	0xbf, 0x8f, 0xc2, 0x23, 0x3e, 0xd7, 0x78, 0x22, 0x48, 0x82, 0x94, 0x4d, 0x2c, 0x67, 0x77, 0x7c,
-	0x05, 0xef, 0x17, 0xdd, 0x80, 0xb4, 0xe7, 0xa9, 0x87, 0x2d, 0x54, 0x11, 0xfd, 0x89, 0xcc, 0x38,
-	0x68, 0xa4, 0x8b, 0xde, 0x15, 0x00, 0x14, 0x4a, 0x2d, 0x6d, 0x7f, 0x40, 0x89, 0xd3, 0x3d, 0x38,
-	0x61, 0xbb, 0x76, 0x46, 0xd8, 0x3c, 0xd4, 0x52, 0x79, 0xa4, 0xc5, 0x3c, 0x51, 0x79, 0xee, 0xb4,
-	0xb2, 0xfe, 0x63, 0x61, 0x4d, 0x84, 0xe2, 0x15, 0xab, 0x28, 0x5d, 0x59, 0x5f, 0xfc, 0xce, 0xeb,
-	0xca, 0xea, 0xdb, 0xcf, 0xae, 0x7e, 0xf3, 0x8d, 0x95, 0x97, 0xaf, 0xbf, 0xbe, 0xfa, 0xc6, 0xcb,
-	0xde, 0xef, 0xd3, 0xdf, 0x5d, 0xff, 0xfa, 0xf7, 0xae, 0x5c, 0x4d, 0x58, 0x31, 0xe9, 0x63, 0x01,
-	0xfb, 0x56, 0x5f, 0x78, 0x09, 0xe6, 0x42, 0xa8, 0x53, 0xfc, 0x3b, 0xef, 0xf7, 0x6f, 0xc6, 0xef,
-	0xc5, 0x3f, 0x44, 0xe1, 0x51, 0x9f, 0xa5, 0x5b, 0x86, 0xa6, 0x97, 0x3b, 0x1d, 0x62, 0xd2, 0x73,
-	0x73, 0x67, 0xe0, 0x6c, 0x46, 0xcf, 0x71, 0x36, 0x5f, 0x83, 0x47, 0x35, 0xdd, 0xbb, 0x7c, 0xa9,
-	0xb2, 0x57, 0x94, 0x3d, 0xc7, 0x3e, 0x71, 0x86, 0x63, 0xbd, 0x8a, 0x8e, 0xe7, 0x7d, 0x08, 0xde,
-	0xa0, 0x8d, 0x9e, 0x82, 0x39, 0x93, 0xe8, 0xaa, 0xa6, 0x77, 0x65, 0x6e, 0x2a, 0x63, 0xe6, 0x34,
-	0x2e, 0xf0, 0xe1, 0x96, 0x3b, 0xfa, 0x5f, 0xa2, 0xa7, 0xe2, 0x4f, 0x13, 0x81, 0x94, 0xf4, 0x0c,
-	0x39, 0xb7, 0x2b, 0xaf, 0x84, 0xca, 0x50, 0xfe, 0xb4, 0x02, 0x57, 0xd3, 0xd2, 0xe7, 0xc2, 0xca,
-	0xff, 0x7b, 0x21, 0x82, 0x33, 0x0b, 0x51, 0x80, 0x5b, 0x93, 0x61, 0x6e, 0xbd, 0x09, 0x99, 0x4e,
-	0x4f, 0xb1, 0x6d, 0x79, 0x5f, 0xee, 0xf0, 0x52, 0xf3, 0xcc, 0x0c, 0xb1, 0x2f, 0x55, 0x1d, 0xa5,
-	0x4a, 0x15, 0xa7, 0x3a, 0xee, 0x07, 0xda, 0x84, 0xb4, 0x69, 0x69, 0x86, 0xa5, 0xd1, 0x13, 0x16,
-	0xca, 0xc2, 0x24, 0x67, 0xb5, 0x8f, 0x5b, 0xbc, 0xa1, 0xde, 0xe3, 0x92, 0xbe, 0xd6, 0x72, 0xa4,
-	0x3d, 0xad, 0xbd, 0xcd, 0x3c, 0xbc, 0xbd, 0x5d, 0xf8, 0x40, 0x80, 0x14, 0xb7, 0x0a, 0xd5, 0x20,
-	0xcd, 0xfb, 0x2a, 0xf7, 0x5e, 0x96, 0x5d, 0x7f, 0xfa, 0x01, 0xbd, 0x58, 0x59, 0xa7, 0x44, 0xd7,
-	0x15, 0x3f, 0x8f, 0x8e, 0x54, 0x51, 0x0d, 0xf2, 0xca, 0xbe, 0x6d, 0xf4, 0x06, 0x94, 0xc8, 0xac,
-	0xea, 0x3c, 0x3c, 0x47, 0xe3, 0x2c, 0x3f, 0x73, 0x9e, 0x9a, 0x33, 0x51, 0xbc, 0x0b, 0xf3, 0x53,
-	0x5c, 0x68, 0xa3, 0x32, 0x64, 0xc6, 0xe7, 0x4e, 0x98, 0xfd, 0xdc, 0x8d, 0xb5, 0x8a, 0xbf, 0x16,
-	0xe0, 0xd2, 0x14, 0x91, 0x1b, 0x8a, 0xe6, 0xdc, 0x5a, 0x6e, 0x41, 0xda, 0x13, 0xe5, 0x0d, 0xe0,
-	0x2c, 0xf8, 0xd3, 0x68, 0xd8, 0x83, 0x41, 0xaf, 0x40, 0x82, 0xbd, 0x5e, 0x70, 0xb2, 0xb9, 0x3c,
-	0x51, 0xe5, 0x9c, 0xc9, 0x0d, 0x42, 0x15, 0xad, 0x17, 0x6e, 0x4c, 0x5c, 0xc5, 0xe2, 0x6f, 0x04,
-	0x58, 0xf2, 0xad, 0x5a, 0x9f, 0xc6, 0x21, 0x5f, 0xde, 0x33, 0xe8, 0x49, 0x98, 0x63, 0xcd, 0x87,
-	0xaf, 0xf5, 0x60, 0xe7, 0x1a, 0xe7, 0x9c, 0xe1, 0x51, 0xe7, 0x31, 0xc9, 0x12, 0xb1, 0xb3, 0x58,
-	0xa2, 0xf8, 0xab, 0x18, 0x14, 0xbd, 0xe5, 0x6e, 0x0d, 0xc8, 0x80, 0x34, 0x4d, 0xe2, 0x76, 0x1c,
-	0xfe, 0x9d, 0xa3, 0x3b, 0x90, 0x56, 0xc9, 0x50, 0x56, 0x54, 0xd5, 0xe2, 0xb4, 0xf3, 0xe2, 0x9f,
-	0x3f, 0x59, 0x7a, 0xbe, 0x6b, 0x94, 0xe8, 0x3d, 0x42, 0xd9, 0x33, 0x55, 0x89, 0x97, 0xeb, 0xb5,
-	0xe0, 0xe3, 0xcc, 0xf0, 0xda, 0x9a, 0x79, 0xd8, 0x5d, 0xa3, 0x27, 0x26, 0xb1, 0x4b, 0x1b, 0x64,
-	0x58, 0x56, 0x55, 0x0b, 0xa7, 0x54, 0xf7, 0x63, 0x8a, 0xb9, 0xd1, 0x33, 0x49, 0xed, 0x09, 0x28,
-	0xf4, 0x35, 0xdd, 0xef, 0x04, 0x97, 0xb7, 0xb2, 0x7d, 0x4d, 0x1f, 0xf9, 0x80, 0x80, 0xe8, 0x31,
-	0xf6, 0xc8, 0xe8, 0xf8, 0x97, 0x37, 0xda, 0xe3, 0x7b, 0xfe, 0x8f, 0x5e, 0x82, 0x8b, 0xa1, 0xc2,
-	0xe0, 0xed, 0x21, 0x11, 0xda, 0xc3, 0x23, 0xc1, 0x4a, 0xe1, 0x6e, 0x65, 0x7d, 0xac, 0x1e, 0xda,
-	0x52, 0x92, 0x6d, 0x09, 0xf1, 0xd9, 0xdd, 0xf1, 0xce, 0x8a, 0x32, 0x5c, 0xf4, 0xa5, 0x49, 0xcb,
-	0x6d, 0x49, 0x36, 0x9c, 0x0e, 0xfb, 0xc1, 0x0d, 0xcb, 0x33, 0x10, 0x67, 0x1d, 0x7b, 0xf4, 0x6c,
-	0x82, 0x66, 0x42, 0xc5, 0xdf, 0xa6, 0x21, 0x1f, 0x68, 0x15, 0xd1, 0xb7, 0x27, 0x1e, 0x3a, 0x84,
-	0xd9, 0x1f, 0x3a, 0xa6, 0x1c, 0xbd, 0xf0, 0xd3, 0xc7, 0x04, 0x37, 0x46, 0x67, 0xb8, 0xfa, 0x97,
-	0x83, 0xa5, 0x36, 0x37, 0x23, 0x8d, 0xf9, 0x6f, 0x01, 0x5b, 0x50, 0x18, 0x98, 0x53, 0x2e, 0xfc,
-	0x5f, 0x7d, 0x68, 0xe7, 0xbc, 0x19, 0xc1, 0xf9, 0x41, 0xe0, 0x5e, 0xba, 0x09, 0xd9, 0x37, 0x0d,
-	0x4d, 0x97, 0x15, 0xd6, 0xf4, 0xf0, 0x47, 0x9c, 0x27, 0xcf, 0x00, 0x1a, 0x77, 0x48, 0x9b, 0x11,
-	0x0c, 0x6f, 0x8e, 0xfb, 0xa5, 0x4d, 0xc8, 0x8d, 0x1e, 0x22, 0x9c, 0x8b, 0x73, 0x62, 0x66, 0x96,
-	0xdb, 0x8c, 0xe0, 0xac, 0xa7, 0x5a, 0xee, 0x1c, 0xa2, 0x2d, 0xc8, 0x8f, 0x90, 0x74, 0x07, 0x2a,
-	0x79, 0x1e, 0xa8, 0x91, 0x15, 0x0d, 0x25, 0x84, 0x65, 0x13, 0x9d, 0xf2, 0xc2, 0x7a, 0x5e, 0xac,
-	0x16, 0xd1, 0x29, 0x6a, 0xc3, 0xe8, 0xc5, 0x44, 0x3e, 0x60, 0xb4, 0xce, 0xab, 0xd0, 0xd3, 0x33,
-	0xa0, 0xb9, 0x75, 0x60, 0x33, 0x82, 0x0b, 0x6a, 0xb0, 0x32, 0x34, 0x7c, 0xa8, 0x6f, 0x39, 0x34,
-	0xa6, 0xf2, 0x9b, 0xdd, 0x8c, 0x36, 0x8e, 0xf0, 0x18, 0x07, 0xaa, 0xc8, 0x80, 0x85, 0x20, 0x9e,
-	0xec, 0xeb, 0x0d, 0xf9, 0x33, 0xe5, 0xda, 0x19, 0xd0, 0xd3, 0xaa, 0xc0, 0x66, 0x04, 0x4b, 0x81,
-	0x65, 0x7c, 0x42, 0xce, 0x06, 0xbc, 0xab, 0x81, 0x6c, 0x1b, 0xbd, 0x21, 0xbf, 0x37, 0x9e, 0xbd,
-	0x01, 0xef, 0x4a, 0xe0, 0x6c, 0xc0, 0xd3, 0x6e, 0x31, 0x65, 0xb4, 0x0d, 0x39, 0x4e, 0x00, 0x32,
-	0x3b, 0xfd, 0xee, 0xfd, 0xf2, 0x6b, 0x67, 0x80, 0xf9, 0xd8, 0xc4, 0xc9, 0x25, 0xdb, 0x47, 0x2e,
-	0x97, 0x21, 0x63, 0x6b, 0xfd, 0x41, 0x8f, 0x6d, 0xbe, 0xe0, 0xb6, 0x5c, 0xa3, 0x81, 0x4a, 0x06,
-	0xa2, 0x03, 0xd3, 0x7d, 0xde, 0xfb, 0x5d, 0x14, 0x24, 0x7e, 0x28, 0x78, 0xdb, 0x76, 0xc3, 0xb0,
-	0xfa, 0x0a, 0xa5, 0xc4, 0xb2, 0xd1, 0x2e, 0xe4, 0x06, 0xa6, 0x7c, 0xe0, 0x0d, 0x30, 0x1e, 0x29,
-	0x4c, 0x3e, 0x0a, 0x85, 0x15, 0x7d, 0x3d, 0x55, 0x76, 0x60, 0x8e, 0x86, 0xd1, 0xcb, 0x70, 0xd1,
-	0x0f, 0x27, 0x9b, 0x8a, 0xa5, 0xf4, 0x89, 0x03, 0xcc, 0xee, 0x27, 0x95, 0xcc, 0x69, 0x25, 0x69,
-	0xc5, 0xa5, 0x77, 0x3e, 0x8a, 0xe2, 0x79, 0x9f, 0xde, 0x9e, 0x27, 0x86, 0x6e, 0x01, 0x8b, 0xba,
-	0xcf, 0xa2, 0xd8, 0xb9, 0x2d, 0x62, 0xe7, 0x62, 0x6c, 0x53, 0x15, 0xa4, 0x20, 0xa4, 0xcf, 0xaa,
-	0x78, 0xd8, 0xaa, 0x8b, 0x01, 0xdd, 0x91, 0x5d, 0x4e, 0x43, 0x31, 0x1f, 0x28, 0xc9, 0xfc, 0xed,
-	0x17, 0xb5, 0xbf, 0x14, 0x15, 0xa7, 0x1f, 0x40, 0xc1, 0xbb, 0xfe, 0xde, 0x24, 0x3a, 0x73, 0x6f,
-	0x52, 0x81, 0xd3, 0x4a, 0xea, 0x7d, 0x21, 0x2e, 0xfe, 0xec, 0x87, 0x49, 0x5f, 0x9f, 0x72, 0xf5,
-	0xe7, 0x02, 0x88, 0x61, 0x87, 0x21, 0x04, 0x85, 0x1b, 0x4d, 0xbc, 0x5b, 0x6e, 0xb7, 0x6b, 0x58,
-	0x6e, 0x34, 0x1b, 0x35, 0x31, 0x82, 0x24, 0x98, 0x1f, 0x8f, 0xe1, 0xda, 0x5e, 0xb3, 0x55, 0x6f,
-	0x37, 0xf1, 0x5d, 0x51, 0x40, 0x0b, 0x70, 0x71, 0x3c, 0x73, 0x13, 0xef, 0x55, 0xe5, 0x56, 0x0d,
-	0xdf, 0xa9, 0x57, 0x6b, 0x62, 0x34, 0xa8, 0xb5, 0x55, 0xbe, 0x53, 0x6e, 0x55, 0x71, 0x7d, 0xaf,
-	0x2d, 0xc6, 0x82, 0x33, 0xd5, 0xf2, 0xdd, 0x5a, 0xa3, 0x51, 0xdb, 0xd9, 0xdb, 0x13, 0xe3, 0x0b,
-	0x17, 0x3e, 0xfb, 0xf0, 0x52, 0x5e, 0x12, 0xae, 0x66, 0x46, 0xf3, 0x95, 0xdd, 0x3f, 0xfd, 0x6d,
-	0x31, 0xf2, 0xce, 0xfd, 0x45, 0xe1, 0x97, 0xf7, 0x17, 0x85, 0xbf, 0xdf, 0x5f, 0x8c, 0xfc, 0xf3,
-	0xfe, 0xa2, 0xf0, 0xde, 0xa7, 0x8b, 0x91, 0x8f, 0x3e, 0x5d, 0x14, 0xbe, 0xb5, 0x76, 0x8e, 0x16,
-	0x81, 0xea, 0xe6, 0xfe, 0x7e, 0x92, 0x95, 0x9c, 0x6b, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x51,
-	0xbb, 0x9d, 0x98, 0x64, 0x1c, 0x00, 0x00,
+	// 2616 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x8c, 0xdb, 0xc6,
+	0xf5, 0x17, 0xf5, 0xad, 0xa7, 0x8f, 0xa5, 0x27, 0x1b, 0x87, 0xde, 0x7f, 0xb2, 0xbb, 0x7f, 0xc5,
+	0x69, 0xd6, 0x4e, 0x57, 0x4a, 0xd7, 0x08, 0x92, 0x3a, 0x0d, 0x12, 0x49, 0x2b, 0x7b, 0xb5, 0x1f,
+	0x92, 0x3c, 0x92, 0x9d, 0xb8, 0x69, 0x4a, 0x70, 0xc5, 0x59, 0x99, 0x59, 0x89, 0x64, 0x48, 0x4a,
+	0xbb, 0x4a, 0x51, 0xc0, 0xe8, 0xad, 0x01, 0x0a, 0x04, 0xb9, 0xa4, 0x28, 0x0a, 0x34, 0x40, 0x81,
+	0xa2, 0x48, 0x2f, 0xbd, 0xb7, 0x87, 0xa0, 0xbd, 0x04, 0x3d, 0xf5, 0x58, 0xf4, 0x90, 0xb6, 0xce,
+	0xa5, 0xc8, 0xa5, 0x3d, 0x15, 0xc5, 0x5e, 0x5c, 0x70, 0x38, 0x94, 0x48, 0x4a, 0x96, 0x77, 0x9d,
+	0x14, 0xe8, 0x8d, 0x9c, 0x79, 0xef, 0x37, 0x6f, 0xde, 0x7b, 0xf3, 0x7b, 0x6f, 0x06, 0x56, 0x7b,
+	0x9a, 0x21, 0x1d, 0x49, 0xea, 0xba, 0x69, 0x49, 0x9d, 0xc3, 0xa2, 0xa4, 0x2b, 0xc5, 0x3e, 0x31,
+	0x4d, 0xa9, 0x4b, 0xcc, 0x82, 0x6e, 0x68, 0x96, 0x86, 0x72, 0x96, 0xa5, 0x16, 0x98, 0x54, 0x61,
+	0x78, 0x65, 0xa9, 0xd4, 0x55, 0xac, 0x3b, 0x83, 0xfd, 0x42, 0x47, 0xeb, 0x17, 0x89, 0x3a, 0xd4,
+	0x46, 0xba, 0xa1, 0x1d, 0x8f, 0x8a, 0x54, 0xb8, 0xb3, 0xde, 0x25, 0xea, 0xfa, 0x50, 0xea, 0x29,
+	0xb2, 0x64, 0x91, 0xe2, 0xd4, 0x87, 0x03, 0xb9, 0xb4, 0xee, 0x81, 0xe8, 0x6a, 0x5d, 0xcd, 0x51,
+	0xde, 0x1f, 0x1c, 0xd0, 0x3f, 0xfa, 0x43, 0xbf, 0x98, 0x78, 0xc5, 0x23, 0xde, 0xbe, 0x43, 0xda,
+	0x77, 0x14, 0xb5, 0x6b, 0xd6, 0x54, 0x79, 0x60, 0x5a, 0x86, 0x42, 0x4c, 0xef, 0xd2, 0x5d, 0x6d,
+	0xfd, 0x6d, 0x53, 0x53, 0x8b, 0x92, 0xaa, 0x6a, 0x96, 0x64, 0x29, 0x9a, 0xca, 0xb6, 0xb1, 0xf4,
+	0x64, 0x57, 0xd3, 0xba, 0x3d, 0x32, 0x59, 0xca, 0xb4, 0x8c, 0x41, 0xc7, 0x62, 0xb3, 0x2b, 0xc1,
+	0x59, 0x4b, 0xe9, 0x13, 0xd3, 0x92, 0xfa, 0x3a, 0x13, 0x58, 0x0e, 0x0a, 0xc8, 0x03, 0x83, 0xe2,
+	0xb3, 0xf9, 0xa7, 0xa6, 0xfd, 0x48, 0x0c, 0x43, 0x33, 0xd8, 0xf4, 0xd3, 0xd3, 0xd3, 0x8a, 0x4c,
+	0x54, 0x4b, 0x39, 0x50, 0x88, 0x31, 0x36, 0x71, 0x5a, 0xe8, 0x90, 0x8c, 0xdc, 0xd9, 0x95, 0xe9,
+	0x59, 0x37, 0x2a, 0x8e, 0xc0, 0xcc, 0x50, 0x5a, 0x92, 0x2c, 0x59, 0x92, 0x23, 0x91, 0xff, 0x57,
+	0x04, 0xb2, 0x37, 0xf5, 0x9e, 0xa2, 0x1e, 0xee, 0x39, 0x31, 0x46, 0x2b, 0x90, 0x36, 0xa4, 0x23,
+	0x51, 0x97, 0x46, 0x3d, 0x4d, 0x92, 0x05, 0x6e, 0x95, 0x5b, 0xcb, 0x60, 0x30, 0xa4, 0xa3, 0xa6,
+	0x33, 0x82, 0xbe, 0x01, 0x09, 0x77, 0x32, 0xbc, 0xca, 0xad, 0xa5, 0x37, 0x9e, 0x28, 0xf8, 0xf3,
+	0xa1, 0xc0, 0xa0, 0xb0, 0x2b, 0x87, 0x36, 0x21, 0x69, 0x12, 0xcb, 0xb2, 0x83, 0x24, 0x44, 0xa9,
+	0xce, 0x52, 0x50, 0xa7, 0x7d, 0xdc, 0x62, 0x12, 0xe5, 0xcc, 0x49, 0x39, 0xf6, 0x1e, 0x17, 0xe6,
+	0xb9, 0x4f, 0x3f, 0x5b, 0x09, 0xe1, 0xb1, 0x26, 0x7a, 0x19, 0xd2, 0xc6, 0xb1, 0xe8, 0x6e, 0x40,
+	0x88, 0xad, 0x46, 0x66, 0x01, 0xe1, 0xe3, 0x3d, 0x26, 0x81, 0xc1, 0x18, 0x7f, 0xa3, 0x2a, 0xa4,
+	0x0d, 0xd2, 0x21, 0xca, 0x90, 0xc8, 0xa2, 0x64, 0x09, 0x71, 0x66, 0x85, 0x13, 0xc3, 0x82, 0x1b,
+	0xc3, 0x42, 0xdb, 0x0d, 0x72, 0x39, 0x69, 0xaf, 0xfe, 0xfe, 0x5f, 0x56, 0x38, 0x0c, 0xae, 0x62,
+	0xc9, 0x42, 0x2f, 0xc0, 0x42, 0x47, 0x33, 0x0c, 0xd2, 0xa3, 0x91, 0x16, 0x15, 0xd9, 0x14, 0x12,
+	0xab, 0x91, 0xb5, 0x94, 0x6d, 0x74, 0xea, 0x03, 0x2e, 0x9e, 0x8f, 0x1a, 0x61, 0x41, 0xc6, 0x39,
+	0x8f, 0x50, 0x4d, 0x36, 0xd1, 0x55, 0x58, 0x94, 0xc9, 0x50, 0xe9, 0x10, 0xb1, 0x73, 0x47, 0x52,
+	0x55, 0xd2, 0x13, 0x15, 0x55, 0x26, 0xc7, 0x42, 0x6a, 0x95, 0x5b, 0xcb, 0x96, 0x93, 0x27, 0xe5,
+	0xd8, 0xe5, 0x88, 0x70, 0x9f, 0xc3, 0xc8, 0x91, 0xaa, 0x38, 0x42, 0x35, 0x5b, 0x06, 0xd5, 0x81,
+	0xef, 0x68, 0xaa, 0x39, 0xe8, 0xdb, 0x96, 0x2b, 0x86, 0x9d, 0x86, 0x02, 0x50, 0xf3, 0x2f, 0x4c,
+	0x99, 0xbf, 0xc9, 0x52, 0x90, 0x5a, 0xcf, 0xfd, 0xd8, 0xb6, 0x7e, 0xc1, 0x55, 0x2e, 0x39, 0xba,
+	0xdb, 0xd1, 0x64, 0x92, 0x4f, 0xe5, 0x7f, 0x1a, 0x81, 0x85, 0x4d, 0xed, 0x48, 0xfd, 0x6f, 0x87,
+	0x7e, 0x1b, 0x72, 0x44, 0x95, 0x45, 0xb6, 0x7b, 0xdb, 0x5f, 0x11, 0xaa, 0x79, 0x31, 0xa8, 0x59,
+	0x55, 0xe5, 0x4d, 0x2a, 0x54, 0x9b, 0x9c, 0x02, 0x9c, 0x21, 0x93, 0x51, 0x13, 0xbd, 0x00, 0x09,
+	0x83, 0xbc, 0x33, 0x20, 0xa6, 0xc5, 0xb2, 0xe8, 0xc2, 0x74, 0x16, 0x61, 0x47, 0x60, 0x2b, 0x84,
+	0x5d, 0x59, 0x74, 0x15, 0x52, 0x66, 0xe7, 0x0e, 0x91, 0x07, 0x3d, 0x22, 0x0b, 0xb1, 0x87, 0xa5,
+	0xdf, 0x56, 0x08, 0x4f, 0xc4, 0x67, 0xc5, 0x3b, 0x7e, 0x8a, 0x78, 0x17, 0x20, 0x67, 0x12, 0xd3,
+	0xb4, 0x55, 0x0e, 0xc9, 0x48, 0x54, 0x64, 0x21, 0x61, 0x3b, 0x93, 0x46, 0xfa, 0xdd, 0x88, 0x70,
+	0x97, 0xc7, 0x19, 0x36, 0xbf, 0x43, 0x46, 0x35, 0xb9, 0xbc, 0x30, 0x39, 0x20, 0x28, 0xf2, 0xef,
+	0x32, 0x97, 0xff, 0x61, 0x04, 0xf8, 0xf6, 0x71, 0xa9, 0x73, 0xa8, 0x6a, 0x47, 0x3d, 0x22, 0x77,
+	0xfb, 0x44, 0x9d, 0x99, 0x7c, 0xdc, 0x29, 0x8c, 0xa9, 0x41, 0xdc, 0x20, 0xe6, 0xa0, 0x67, 0xd1,
+	0xa0, 0xe5, 0x36, 0x9e, 0x9d, 0xde, 0xbc, 0x7f, 0xa1, 0x02, 0xa6, 0xe2, 0xd4, 0xda, 0x1f, 0xd8,
+	0x07, 0x11, 0x33, 0x00, 0xb4, 0x0d, 0xbc, 0xcc, 0x92, 0x46, 0x64, 0x45, 0x81, 0xc5, 0x73, 0x25,
+	0x08, 0x1a, 0x48, 0x2e, 0xbc, 0x20, 0xfb, 0x07, 0xf2, 0xbf, 0xe0, 0x20, 0xee, 0x2c, 0x84, 0xd2,
+	0x90, 0x68, 0xdd, 0xac, 0x54, 0xaa, 0xad, 0x16, 0x1f, 0x42, 0xe7, 0x20, 0x7b, 0xb3, 0xbe, 0x53,
+	0x6f, 0xbc, 0x5e, 0x17, 0xab, 0x18, 0x37, 0x30, 0xcf, 0xa1, 0x0c, 0x24, 0xdb, 0x8d, 0x86, 0xb8,
+	0x5b, 0x6a, 0x57, 0xf9, 0x30, 0xca, 0x42, 0xca, 0xfe, 0xab, 0x96, 0xf0, 0xee, 0x6d, 0x3e, 0x82,
+	0x16, 0x81, 0xaf, 0x34, 0x76, 0x77, 0x6b, 0xad, 0x5a, 0xa3, 0x2e, 0x36, 0x4b, 0x95, 0x9d, 0x6a,
+	0x9b, 0x8f, 0xfa, 0x47, 0xcb, 0xd5, 0x52, 0xa5, 0x51, 0xe7, 0x63, 0xf6, 0x42, 0xed, 0x37, 0xc4,
+	0x6b, 0xb8, 0x7a, 0x83, 0x8f, 0x53, 0xd4, 0x37, 0xc4, 0x66, 0xe3, 0xf5, 0x2a, 0xe6, 0x13, 0x88,
+	0x87, 0xcc, 0xf5, 0x66, 0x4b, 0xbc, 0x59, 0xdf, 0x6d, 0x54, 0x76, 0xaa, 0x9b, 0x7c, 0x72, 0x29,
+	0xfe, 0xc5, 0xc7, 0x17, 0xc2, 0x02, 0x97, 0xff, 0x90, 0x83, 0x27, 0xae, 0x4b, 0x16, 0x39, 0x92,
+	0x46, 0x53, 0x21, 0xa9, 0x40, 0xba, 0xeb, 0x4c, 0xb1, 0x70, 0xd8, 0xbe, 0xc8, 0x07, 0x7d, 0xc1,
+	0xb4, 0xbd, 0x99, 0x0d, 0x5d, 0x77, 0xcc, 0x44, 0x2f, 0x42, 0xdc, 0x3a, 0x16, 0xa5, 0xce, 0x21,
+	0x3b, 0x55, 0xab, 0x0f, 0x0b, 0x10, 0x8e, 0x59, 0xf6, 0x48, 0x7e, 0x08, 0x8b, 0x0c, 0xda, 0xcf,
+	0xe1, 0x55, 0x48, 0xb8, 0xd1, 0x71, 0x2c, 0x7a, 0x2a, 0x88, 0xe8, 0x93, 0x9f, 0x30, 0xee, 0x1f,
+	0x3f, 0x5b, 0xe1, 0xb0, 0xab, 0x8b, 0x9e, 0x80, 0xc4, 0xbe, 0xa4, 0xca, 0x76, 0xfa, 0xda, 0x86,
+	0xa5, 0x70, 0xdc, 0xfe, 0xad, 0xc9, 0xf9, 0x7f, 0x24, 0xe0, 0x5c, 0x49, 0xd7, 0x7b, 0x4a, 0x87,
+	0x26, 0x99, 0x03, 0x36, 0x23, 0xe9, 0xb9, 0x79, 0x49, 0x8f, 0xf2, 0x10, 0x3f, 0x10, 0x75, 0xcd,
+	0x70, 0xf2, 0x32, 0x5b, 0x4e, 0x9f, 0x94, 0x93, 0x97, 0xe3, 0xc2, 0x7d, 0xee, 0xa5, 0xbf, 0x72,
+	0x38, 0x76, 0xd0, 0xd4, 0x0c, 0x0b, 0x3d, 0x06, 0xb1, 0x03, 0xb1, 0xa3, 0x5a, 0x34, 0xcb, 0xb2,
+	0x38, 0x7a, 0x50, 0x51, 0x2d, 0x9b, 0xa7, 0x0e, 0x8c, 0xfe, 0x98, 0xa7, 0xa2, 0x0e, 0x4f, 0x1d,
+	0x18, 0x7d, 0x97, 0xa7, 0x5e, 0x83, 0x05, 0x99, 0x74, 0x34, 0x99, 0xc8, 0x63, 0xa1, 0x18, 0xe3,
+	0xab, 0x20, 0x63, 0xb6, 0x68, 0xcd, 0xc7, 0x39, 0x26, 0xef, 0x22, 0xbc, 0x04, 0x42, 0x00, 0x41,
+	0x3c, 0x92, 0x0c, 0x95, 0x56, 0xb0, 0x8c, 0x7d, 0xe6, 0xf0, 0x79, 0xbf, 0xc6, 0xeb, 0x6c, 0x36,
+	0x58, 0xa5, 0xe2, 0x67, 0xaa, 0x52, 0xde, 0x42, 0x99, 0x78, 0xe4, 0x42, 0x19, 0xa8, 0x75, 0xc9,
+	0x47, 0xac, 0x75, 0x2f, 0x42, 0x4a, 0xd2, 0x75, 0xd1, 0xb4, 0xa3, 0x49, 0x2b, 0x55, 0x7a, 0xe3,
+	0xff, 0x82, 0xd6, 0xec, 0x90, 0x51, 0x55, 0x1d, 0x92, 0x9e, 0xa6, 0x13, 0x9c, 0x90, 0x74, 0xbd,
+	0xb5, 0x43, 0x46, 0x68, 0x0d, 0xce, 0xf5, 0x24, 0xd3, 0x12, 0x25, 0x91, 0xc6, 0x4e, 0xb4, 0x4f,
+	0x3e, 0x2d, 0x59, 0x59, 0x9c, 0xb5, 0x27, 0x4a, 0xd7, 0x2a, 0xaa, 0x65, 0xf3, 0x03, 0x7a, 0x12,
+	0x52, 0x1d, 0x4d, 0x3d, 0x50, 0x8c, 0x3e, 0x91, 0x85, 0xf4, 0x2a, 0xb7, 0x96, 0xc4, 0x93, 0x81,
+	0x99, 0x95, 0x2f, 0xfb, 0xe8, 0x95, 0x0f, 0xd5, 0x21, 0xd5, 0xd3, 0x9c, 0x94, 0x35, 0x85, 0x1c,
+	0x0d, 0xcc, 0xf3, 0xc1, 0x0d, 0x4d, 0xa5, 0x75, 0x61, 0xd7, 0x55, 0xa9, 0xaa, 0x96, 0x31, 0xc2,
+	0x13, 0x08, 0xb4, 0x0b, 0xe9, 0x21, 0x31, 0x4c, 0x97, 0x8b, 0x17, 0xa8, 0x69, 0xcf, 0x3d, 0xb0,
+	0xb0, 0xdd, 0x72, 0x64, 0x7d, 0x2c, 0x30, 0x74, 0xc7, 0x4c, 0x9b, 0x4a, 0x54, 0x62, 0x1d, 0x69,
+	0xc6, 0x21, 0x45, 0xe3, 0x67, 0x53, 0x49, 0xdd, 0x11, 0xf1, 0x81, 0xa8, 0xee, 0x98, 0xb9, 0x74,
+	0x0b, 0x72, 0x7e, 0x7b, 0x11, 0x0f, 0x11, 0x3b, 0x7e, 0x1c, 0x3d, 0xc0, 0xf6, 0x27, 0x2a, 0x40,
+	0x6c, 0x28, 0xf5, 0x06, 0x84, 0xb1, 0x8d, 0x10, 0x5c, 0xc2, 0x05, 0xc0, 0x8e, 0xd8, 0xd5, 0xf0,
+	0x4b, 0x5c, 0xfe, 0xf7, 0x61, 0x78, 0xcc, 0xe3, 0x1a, 0x57, 0x04, 0x09, 0x90, 0x30, 0x89, 0x61,
+	0xef, 0x8e, 0xad, 0xe0, 0xfe, 0xa2, 0x6b, 0x90, 0x74, 0x3d, 0xf5, 0xb0, 0x85, 0xca, 0xbc, 0x37,
+	0x91, 0x29, 0x07, 0x8d, 0x75, 0xd1, 0x7b, 0x1c, 0x80, 0x64, 0x59, 0x86, 0xb2, 0x3f, 0xb0, 0x88,
+	0xdd, 0x3d, 0xd8, 0x61, 0xbb, 0x32, 0x27, 0x6c, 0x2e, 0x6a, 0xa1, 0x34, 0xd6, 0xa2, 0x9e, 0x28,
+	0xbf, 0x70, 0x52, 0xde, 0xf8, 0x09, 0x57, 0xe4, 0x21, 0x7f, 0xd1, 0xc8, 0x0b, 0x17, 0x37, 0x96,
+	0xbf, 0xfb, 0xa6, 0xb4, 0xfe, 0xee, 0xf3, 0xeb, 0xdf, 0x7c, 0x6b, 0xed, 0xd5, 0xab, 0x6f, 0xae,
+	0xbf, 0xf5, 0xaa, 0xfb, 0x7b, 0xe9, 0x7b, 0x1b, 0x5f, 0xff, 0xfe, 0xc5, 0xcb, 0x31, 0x23, 0x22,
+	0x7c, 0xca, 0x61, 0xcf, 0xea, 0x4b, 0xaf, 0xc0, 0x42, 0x00, 0x75, 0x86, 0x7f, 0x17, 0xbd, 0xfe,
+	0x4d, 0x79, 0xbd, 0xf8, 0x87, 0x30, 0x3c, 0xee, 0xb1, 0x74, 0x5b, 0x53, 0xd4, 0x52, 0xa7, 0x43,
+	0x74, 0xeb, 0xcc, 0xdc, 0xe9, 0x3b, 0x9b, 0xe1, 0x33, 0x9c, 0xcd, 0x37, 0xe0, 0x71, 0x45, 0x75,
+	0x2f, 0x5f, 0xb2, 0xe8, 0x16, 0x65, 0xd7, 0xb1, 0x4f, 0xcf, 0x71, 0xac, 0x5b, 0xd1, 0xf1, 0xa2,
+	0x07, 0xc1, 0x1d, 0x34, 0xd1, 0xb3, 0xb0, 0xa0, 0x13, 0x55, 0x56, 0xd4, 0xae, 0xc8, 0x4c, 0xa5,
+	0xcc, 0x9c, 0xc4, 0x39, 0x36, 0xdc, 0x72, 0x46, 0xbf, 0x22, 0x7a, 0xca, 0xff, 0x2c, 0xe6, 0x4b,
+	0x49, 0xd7, 0x90, 0x33, 0xbb, 0xf2, 0x62, 0xa0, 0x0c, 0x65, 0x4f, 0xca, 0x70, 0x39, 0x29, 0xdc,
+	0xe7, 0xd6, 0xfe, 0xd7, 0x0b, 0x11, 0xcc, 0x2d, 0x44, 0x3e, 0x6e, 0x8d, 0x07, 0xb9, 0xf5, 0x3a,
+	0xa4, 0x3a, 0x3d, 0xc9, 0x34, 0xc5, 0x7d, 0xb1, 0x23, 0x24, 0x66, 0x33, 0xd7, 0x0c, 0xef, 0x16,
+	0x2a, 0xb6, 0x52, 0xb9, 0x82, 0x13, 0x1d, 0xe7, 0x03, 0x6d, 0x41, 0x52, 0x37, 0x14, 0xcd, 0x50,
+	0xac, 0x11, 0x0d, 0x65, 0x6e, 0x9a, 0xb3, 0xda, 0xc7, 0x2d, 0xd6, 0x50, 0x37, 0x99, 0xa4, 0xa7,
+	0xb5, 0x1c, 0x6b, 0xcf, 0x6a, 0x6f, 0x53, 0x0f, 0x6f, 0x6f, 0x97, 0x3e, 0xe4, 0x20, 0xc1, 0xac,
+	0x42, 0x55, 0x48, 0xb2, 0xbe, 0xca, 0xb9, 0x97, 0xa5, 0x37, 0x2e, 0x3d, 0xa0, 0x17, 0x2b, 0xa9,
+	0x16, 0x51, 0x55, 0xc9, 0xcb, 0xa3, 0x63, 0x55, 0x54, 0x85, 0xac, 0xb4, 0x6f, 0x6a, 0xbd, 0x81,
+	0x45, 0x44, 0x5a, 0x75, 0x1e, 0x9e, 0xa3, 0x51, 0x9a, 0x9f, 0x19, 0x57, 0xcd, 0x9e, 0xc8, 0xdf,
+	0x86, 0xc5, 0x19, 0x2e, 0x34, 0x51, 0x09, 0x52, 0x93, 0x73, 0xc7, 0x9d, 0xfe, 0xdc, 0x4d, 0xb4,
+	0xf2, 0xbf, 0xe6, 0xe0, 0xc2, 0x0c, 0x91, 0x6b, 0x92, 0x62, 0xdf, 0x5a, 0x6e, 0x40, 0xd2, 0x15,
+	0x65, 0x0d, 0xe0, 0x69, 0xf0, 0x67, 0xd1, 0xb0, 0x0b, 0x83, 0x5e, 0x83, 0x18, 0x7d, 0xbd, 0x60,
+	0x64, 0xf3, 0xe4, 0x54, 0x95, 0xb3, 0x27, 0x37, 0x89, 0x25, 0x29, 0xbd, 0x60, 0x63, 0xe2, 0x28,
+	0xe6, 0x7f, 0xc3, 0xc1, 0x8a, 0x67, 0xd5, 0xda, 0x2c, 0x0e, 0xf9, 0xf2, 0x9e, 0x41, 0xcf, 0xc0,
+	0x02, 0x6d, 0x3e, 0x3c, 0xad, 0x07, 0x3d, 0xd7, 0x38, 0x63, 0x0f, 0x8f, 0x3b, 0x8f, 0x69, 0x96,
+	0x88, 0xcc, 0x63, 0x89, 0xfc, 0xaf, 0x22, 0x90, 0x77, 0x97, 0xbb, 0x31, 0x20, 0x03, 0xd2, 0xd0,
+	0x89, 0xd3, 0x71, 0x78, 0x77, 0x8e, 0x6e, 0x41, 0x52, 0x26, 0x43, 0x51, 0x92, 0x65, 0x83, 0xd1,
+	0xce, 0xcb, 0x7f, 0xfe, 0x6c, 0xe5, 0xc5, 0xae, 0x56, 0xb0, 0xee, 0x10, 0x8b, 0x3e, 0x53, 0x15,
+	0x58, 0xb9, 0x2e, 0xfa, 0x1f, 0x67, 0x86, 0x57, 0x8a, 0xfa, 0x61, 0xb7, 0x68, 0x8d, 0x74, 0x62,
+	0x16, 0x36, 0xc9, 0xb0, 0x24, 0xcb, 0x06, 0x4e, 0xc8, 0xce, 0xc7, 0x0c, 0x73, 0xc3, 0x73, 0x49,
+	0xed, 0x69, 0xc8, 0xf5, 0x15, 0xd5, 0xeb, 0x04, 0x87, 0xb7, 0xd2, 0x7d, 0x45, 0x1d, 0xfb, 0x80,
+	0x00, 0xef, 0x32, 0xf6, 0xd8, 0xe8, 0xe8, 0x97, 0x37, 0xda, 0xe5, 0x7b, 0xf6, 0x8f, 0x5e, 0x81,
+	0xf3, 0x81, 0xc2, 0xe0, 0xee, 0x21, 0x16, 0xd8, 0xc3, 0x63, 0xfe, 0x4a, 0xe1, 0x6c, 0x65, 0x63,
+	0xa2, 0x1e, 0xd8, 0x52, 0x9c, 0x6e, 0x09, 0xb1, 0xd9, 0xbd, 0xc9, 0xce, 0xf2, 0x22, 0x9c, 0xf7,
+	0xa4, 0x49, 0xcb, 0x69, 0x49, 0x36, 0xed, 0x0e, 0xfb, 0xc1, 0x0d, 0xcb, 0x73, 0x10, 0xa5, 0x1d,
+	0x7b, 0x78, 0x3e, 0x41, 0x53, 0xa1, 0xfc, 0x6f, 0x93, 0x90, 0xf5, 0xb5, 0x8a, 0xe8, 0x3b, 0x53,
+	0x0f, 0x1d, 0xdc, 0xe9, 0x1f, 0x3a, 0x66, 0x1c, 0xbd, 0xe0, 0xd3, 0xc7, 0x14, 0x37, 0x86, 0x4f,
+	0x71, 0xf5, 0x2f, 0xf9, 0x4b, 0x6d, 0xe6, 0x94, 0x34, 0xe6, 0xbd, 0x05, 0x6c, 0x43, 0x6e, 0xa0,
+	0xcf, 0xb8, 0xf0, 0xff, 0xff, 0x43, 0x3b, 0xe7, 0xad, 0x10, 0xce, 0x0e, 0x7c, 0xf7, 0xd2, 0x2d,
+	0x48, 0xbf, 0xad, 0x29, 0xaa, 0x28, 0xd1, 0xa6, 0x87, 0x3d, 0xe2, 0x3c, 0x33, 0x07, 0x68, 0xd2,
+	0x21, 0x6d, 0x85, 0x30, 0xbc, 0x3d, 0xfe, 0x43, 0x5b, 0x90, 0x19, 0x3f, 0x44, 0xd8, 0x17, 0xe7,
+	0xd8, 0xa9, 0x59, 0x6e, 0x2b, 0x84, 0xd3, 0xae, 0x6a, 0xa9, 0x73, 0x88, 0xb6, 0x21, 0x3b, 0x46,
+	0x52, 0x6d, 0xa8, 0xf8, 0x59, 0xa0, 0xc6, 0x56, 0xd4, 0xa5, 0x00, 0x96, 0x49, 0x54, 0x4b, 0x48,
+	0x3c, 0x12, 0x56, 0x8b, 0xa8, 0x16, 0x6a, 0xc3, 0xf8, 0xc5, 0x44, 0x3c, 0xa0, 0xb4, 0xce, 0xaa,
+	0xd0, 0xa5, 0x53, 0xa0, 0x39, 0x75, 0x60, 0x2b, 0x84, 0x73, 0xb2, 0x6f, 0x04, 0xd5, 0x3d, 0xa8,
+	0xef, 0xd8, 0x34, 0x26, 0x0b, 0xa9, 0xb3, 0xd8, 0x98, 0x93, 0xbd, 0x1c, 0x28, 0x23, 0x0d, 0x96,
+	0xfc, 0x78, 0xa2, 0xa7, 0x37, 0x64, 0xcf, 0x94, 0xc5, 0x39, 0xd0, 0xb3, 0xaa, 0xc0, 0x56, 0x08,
+	0x0b, 0xbe, 0x65, 0x3c, 0x42, 0xf6, 0x06, 0xdc, 0xab, 0x81, 0x68, 0x6a, 0xbd, 0x21, 0xbb, 0x37,
+	0xce, 0xdf, 0x80, 0x7b, 0x25, 0xb0, 0x37, 0xe0, 0x6a, 0xb7, 0xa8, 0x32, 0xda, 0x81, 0x0c, 0x23,
+	0x00, 0x91, 0x9e, 0x7e, 0xe7, 0x7e, 0xf9, 0xb5, 0x39, 0x60, 0x1e, 0x36, 0xb1, 0x73, 0xc9, 0x9c,
+	0xfc, 0xda, 0x2d, 0x97, 0xa9, 0xf4, 0x07, 0x3d, 0xba, 0xf9, 0x9c, 0xd3, 0x72, 0x8d, 0x07, 0xca,
+	0x29, 0x08, 0x0f, 0x74, 0xe7, 0x79, 0xef, 0xbd, 0x88, 0x8f, 0xa0, 0xb0, 0x36, 0xb0, 0x6f, 0xee,
+	0x78, 0xd0, 0x23, 0xe8, 0x0a, 0x9c, 0x1b, 0x73, 0x88, 0xa8, 0x4b, 0x96, 0x45, 0x0c, 0xd5, 0xa1,
+	0xaa, 0x72, 0xe2, 0xa4, 0xec, 0x1c, 0xf3, 0x05, 0x99, 0x11, 0x43, 0xd3, 0x99, 0x47, 0x1f, 0x71,
+	0x63, 0x2d, 0xcf, 0x5d, 0x29, 0x4c, 0x0b, 0xe8, 0xb7, 0xe6, 0xec, 0xc5, 0xb3, 0x70, 0xc1, 0x21,
+	0x9b, 0xaf, 0xe8, 0xd2, 0xc4, 0xcb, 0x01, 0x34, 0x74, 0x09, 0x12, 0x4e, 0x9b, 0xed, 0x5c, 0x35,
+	0xb2, 0x36, 0xe5, 0x65, 0x3f, 0xe0, 0x80, 0xbf, 0xcf, 0xe5, 0xd9, 0xeb, 0x77, 0x9c, 0xb6, 0xda,
+	0x26, 0x5a, 0x03, 0x20, 0xc7, 0xba, 0xe1, 0xb9, 0x44, 0xa4, 0x68, 0x91, 0x30, 0x22, 0xc2, 0xdd,
+	0x55, 0xec, 0x99, 0x5b, 0xaa, 0xc0, 0xe3, 0x33, 0xcd, 0x3e, 0xd3, 0xad, 0xec, 0x77, 0x61, 0x10,
+	0x18, 0x43, 0xb1, 0x1e, 0xfa, 0x9a, 0x66, 0xf4, 0xa9, 0x67, 0x4d, 0xb4, 0x07, 0x99, 0x81, 0x2e,
+	0x1e, 0xb8, 0x03, 0x14, 0x31, 0x37, 0xfd, 0x42, 0x17, 0x54, 0xf4, 0x34, 0xb8, 0xe9, 0x81, 0x3e,
+	0x1e, 0x46, 0xaf, 0xc2, 0x79, 0x2f, 0x9c, 0xa8, 0x4b, 0x86, 0xd4, 0x27, 0x36, 0x30, 0x35, 0xab,
+	0x9c, 0x3a, 0x29, 0xc7, 0x8d, 0xa8, 0x70, 0xf7, 0x93, 0x30, 0x5e, 0xf4, 0xe8, 0x35, 0x5d, 0x31,
+	0x74, 0x03, 0xe8, 0x11, 0xf4, 0x58, 0x14, 0x39, 0xb3, 0x45, 0x94, 0xa4, 0x26, 0x36, 0x55, 0x40,
+	0xf0, 0x43, 0x7a, 0xac, 0x8a, 0x06, 0xad, 0x3a, 0xef, 0xd3, 0x1d, 0xdb, 0x65, 0x77, 0x77, 0x8b,
+	0xbe, 0xfe, 0x88, 0x3d, 0xc4, 0xa3, 0xf6, 0x97, 0xaa, 0x8b, 0xc9, 0x07, 0xd4, 0xc3, 0x3d, 0x6f,
+	0xa3, 0x18, 0x3e, 0x75, 0xa3, 0x58, 0x86, 0x93, 0x72, 0xe2, 0x03, 0x2e, 0xca, 0x7f, 0xf4, 0xa3,
+	0xb8, 0xa7, 0x69, 0xbc, 0xfc, 0x73, 0x0e, 0xf8, 0xa0, 0xc3, 0x10, 0x82, 0xdc, 0xb5, 0x06, 0xde,
+	0x2b, 0xb5, 0xdb, 0x55, 0x2c, 0xd6, 0x1b, 0xf5, 0x2a, 0x1f, 0x42, 0x02, 0x2c, 0x4e, 0xc6, 0x70,
+	0xb5, 0xd9, 0x68, 0xd5, 0xda, 0x0d, 0x7c, 0x9b, 0xe7, 0xd0, 0x12, 0x9c, 0x9f, 0xcc, 0x5c, 0xc7,
+	0xcd, 0x8a, 0xd8, 0xaa, 0xe2, 0x5b, 0xb5, 0x8a, 0xfd, 0x62, 0xed, 0xd3, 0xda, 0x2e, 0xdd, 0x2a,
+	0xb5, 0x2a, 0xb8, 0xd6, 0x6c, 0xf3, 0x11, 0xff, 0x4c, 0xa5, 0x74, 0xbb, 0x5a, 0xaf, 0x57, 0x77,
+	0x9b, 0x4d, 0x3e, 0xba, 0x74, 0xee, 0x8b, 0x8f, 0x2f, 0x64, 0x05, 0xee, 0x72, 0x6a, 0x3c, 0x5f,
+	0xde, 0xfb, 0xd3, 0xdf, 0x96, 0x43, 0x77, 0xef, 0x2d, 0x73, 0xbf, 0xbc, 0xb7, 0xcc, 0xfd, 0xfd,
+	0xde, 0x72, 0xe8, 0x9f, 0xf7, 0x96, 0xb9, 0xf7, 0x3f, 0x5f, 0x0e, 0x7d, 0xf2, 0xf9, 0x32, 0xf7,
+	0xed, 0xe2, 0x19, 0xfa, 0x35, 0x4b, 0xd5, 0xf7, 0xf7, 0xe3, 0xb4, 0xfe, 0x5f, 0xf9, 0xcf, 0x00,
+	0x02, 0xf7, 0x31, 0x8f, 0xf1, 0x1d, 0x00, 0x00,
 }
 
 func (x PayloadFormatter) String() string {
@@ -2668,6 +2747,49 @@ func (this *ApplicationUp_ServiceData) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ApplicationRoutingRule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationRoutingRule)
+	if !ok {
+		that2, ok := that.(ApplicationRoutingRule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DeviceIdPattern != that1.DeviceIdPattern {
+		return false
+	}
+	if len(this.DeviceAttributes) != len(that1.DeviceAttributes) {
+		return false
+	}
+	for i := range this.DeviceAttributes {
+		if this.DeviceAttributes[i] != that1.DeviceAttributes[i] {
+			return false
+		}
+	}
+	if len(this.FPorts) != len(that1.FPorts) {
+		return false
+	}
+	for i := range this.FPorts {
+		if this.FPorts[i] != that1.FPorts[i] {
+			return false
+		}
+	}
+	if this.Expression != that1.Expression {
+		return false
+	}
+	return true
+}
 func (this *MessagePayloadFormatters) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -3123,6 +3245,29 @@ func (this *ApplicationUp_ServiceData) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationRoutingRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForDeviceAttributes := make([]string, 0, len(this.DeviceAttributes))
+	for k := range this.DeviceAttributes {
+		keysForDeviceAttributes = append(keysForDeviceAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForDeviceAttributes)
+	mapStringForDeviceAttributes := "map[string]string{"
+	for _, k := range keysForDeviceAttributes {
+		mapStringForDeviceAttributes += fmt.Sprintf("%v: %v,", k, this.DeviceAttributes[k])
+	}
+	mapStringForDeviceAttributes += "}"
+	s := strings.Join([]string{`&ApplicationRoutingRule{`,
+		`DeviceIdPattern:` + fmt.Sprintf("%v", this.DeviceIdPattern) + `,`,
+		`DeviceAttributes:` + mapStringForDeviceAttributes + `,`,
+		`FPorts:` + fmt.Sprintf("%v", this.FPorts) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MessagePayloadFormatters) String() string {
 	if this == nil {
 		return "nil"
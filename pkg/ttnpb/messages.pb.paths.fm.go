@@ -912,6 +912,19 @@ var ApplicationUpFieldPathsTopLevel = []string{
 	"simulated",
 	"up",
 }
+var ApplicationRoutingRuleFieldPathsNested = []string{
+	"device_attributes",
+	"device_id_pattern",
+	"expression",
+	"f_ports",
+}
+
+var ApplicationRoutingRuleFieldPathsTopLevel = []string{
+	"device_attributes",
+	"device_id_pattern",
+	"expression",
+	"f_ports",
+}
 var MessagePayloadFormattersFieldPathsNested = []string{
 	"down_formatter",
 	"down_formatter_parameter",
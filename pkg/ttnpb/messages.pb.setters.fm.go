@@ -1525,6 +1525,55 @@ func (dst *ApplicationUp) SetFields(src *ApplicationUp, paths ...string) error {
 	return nil
 }
 
+func (dst *ApplicationRoutingRule) SetFields(src *ApplicationRoutingRule, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "device_id_pattern":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_id_pattern' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceIdPattern = src.DeviceIdPattern
+			} else {
+				var zero string
+				dst.DeviceIdPattern = zero
+			}
+		case "device_attributes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_attributes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceAttributes = src.DeviceAttributes
+			} else {
+				dst.DeviceAttributes = nil
+			}
+		case "f_ports":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_ports' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FPorts = src.FPorts
+			} else {
+				dst.FPorts = nil
+			}
+		case "expression":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expression' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Expression = src.Expression
+			} else {
+				var zero string
+				dst.Expression = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *MessagePayloadFormatters) SetFields(src *MessagePayloadFormatters, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
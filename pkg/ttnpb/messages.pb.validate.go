@@ -2185,6 +2185,163 @@ var _ interface {
 	ErrorName() string
 } = ApplicationUpValidationError{}
 
+// ValidateFields checks the field values on ApplicationRoutingRule with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationRoutingRule) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationRoutingRuleFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "device_id_pattern":
+
+			if utf8.RuneCountInString(m.GetDeviceIdPattern()) > 100 {
+				return ApplicationRoutingRuleValidationError{
+					field:  "device_id_pattern",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		case "device_attributes":
+
+			if len(m.GetDeviceAttributes()) > 10 {
+				return ApplicationRoutingRuleValidationError{
+					field:  "device_attributes",
+					reason: "value must contain no more than 10 pair(s)",
+				}
+			}
+
+			for key, val := range m.GetDeviceAttributes() {
+				_ = val
+
+				if utf8.RuneCountInString(key) > 36 {
+					return ApplicationRoutingRuleValidationError{
+						field:  fmt.Sprintf("device_attributes[%v]", key),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_ApplicationRoutingRule_DeviceAttributes_Pattern.MatchString(key) {
+					return ApplicationRoutingRuleValidationError{
+						field:  fmt.Sprintf("device_attributes[%v]", key),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+				if utf8.RuneCountInString(val) > 200 {
+					return ApplicationRoutingRuleValidationError{
+						field:  fmt.Sprintf("device_attributes[%v]", key),
+						reason: "value length must be at most 200 runes",
+					}
+				}
+
+			}
+
+		case "f_ports":
+
+			if len(m.GetFPorts()) > 255 {
+				return ApplicationRoutingRuleValidationError{
+					field:  "f_ports",
+					reason: "value must contain no more than 255 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetFPorts() {
+				_, _ = idx, item
+
+				if item > 255 {
+					return ApplicationRoutingRuleValidationError{
+						field:  fmt.Sprintf("f_ports[%v]", idx),
+						reason: "value must be less than or equal to 255",
+					}
+				}
+
+			}
+
+		case "expression":
+
+			if utf8.RuneCountInString(m.GetExpression()) > 4096 {
+				return ApplicationRoutingRuleValidationError{
+					field:  "expression",
+					reason: "value length must be at most 4096 runes",
+				}
+			}
+
+		default:
+			return ApplicationRoutingRuleValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationRoutingRuleValidationError is the validation error returned by
+// ApplicationRoutingRule.ValidateFields if the designated constraints aren't met.
+type ApplicationRoutingRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationRoutingRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationRoutingRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationRoutingRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationRoutingRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationRoutingRuleValidationError) ErrorName() string {
+	return "ApplicationRoutingRuleValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationRoutingRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationRoutingRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationRoutingRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationRoutingRuleValidationError{}
+
+var _ApplicationRoutingRule_DeviceAttributes_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
 // ValidateFields checks the field values on MessagePayloadFormatters with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
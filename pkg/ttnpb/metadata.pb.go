@@ -126,9 +126,11 @@ type RxMetadata struct {
 	// Advanced metadata fields
 	// - can be used for advanced information or experimental features that are not yet formally defined in the API
 	// - field names are written in snake_case
-	Advanced             *types.Struct `protobuf:"bytes,99,opt,name=advanced,proto3" json:"advanced,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Advanced *types.Struct `protobuf:"bytes,99,opt,name=advanced,proto3" json:"advanced,omitempty"`
+	// Relay that forwarded the uplink of the end device; set by the Network Server.
+	Relay                *RelayMetadata `protobuf:"bytes,21,opt,name=relay,proto3" json:"relay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *RxMetadata) Reset()      { *m = RxMetadata{} }
@@ -301,6 +303,59 @@ func (m *RxMetadata) GetAdvanced() *types.Struct {
 	return nil
 }
 
+func (m *RxMetadata) GetRelay() *RelayMetadata {
+	if m != nil {
+		return m.Relay
+	}
+	return nil
+}
+
+type RelayMetadata struct {
+	// Identifier of the relay end device, in the application of the end device.
+	DeviceId string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// Index of the wake-on-radio channel the relay received the uplink on.
+	WorChannel           uint32   `protobuf:"varint,2,opt,name=wor_channel,json=worChannel,proto3" json:"wor_channel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RelayMetadata) Reset()      { *m = RelayMetadata{} }
+func (*RelayMetadata) ProtoMessage() {}
+func (*RelayMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1123b3e8fd87092, []int{1}
+}
+func (m *RelayMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RelayMetadata.Unmarshal(m, b)
+}
+func (m *RelayMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RelayMetadata.Marshal(b, m, deterministic)
+}
+func (m *RelayMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayMetadata.Merge(m, src)
+}
+func (m *RelayMetadata) XXX_Size() int {
+	return xxx_messageInfo_RelayMetadata.Size(m)
+}
+func (m *RelayMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayMetadata proto.InternalMessageInfo
+
+func (m *RelayMetadata) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
+func (m *RelayMetadata) GetWorChannel() uint32 {
+	if m != nil {
+		return m.WorChannel
+	}
+	return 0
+}
+
 type Location struct {
 	// The North–South position (degrees; -90 to +90), where 0 is the equator, North pole is positive, South pole is negative.
 	Latitude float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
@@ -319,7 +374,7 @@ type Location struct {
 func (m *Location) Reset()      { *m = Location{} }
 func (*Location) ProtoMessage() {}
 func (*Location) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1123b3e8fd87092, []int{1}
+	return fileDescriptor_e1123b3e8fd87092, []int{2}
 }
 func (m *Location) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Location.Unmarshal(m, b)
@@ -403,7 +458,7 @@ type PacketBrokerMetadata struct {
 func (m *PacketBrokerMetadata) Reset()      { *m = PacketBrokerMetadata{} }
 func (*PacketBrokerMetadata) ProtoMessage() {}
 func (*PacketBrokerMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1123b3e8fd87092, []int{2}
+	return fileDescriptor_e1123b3e8fd87092, []int{3}
 }
 func (m *PacketBrokerMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PacketBrokerMetadata.Unmarshal(m, b)
@@ -490,7 +545,7 @@ type PacketBrokerRouteHop struct {
 func (m *PacketBrokerRouteHop) Reset()      { *m = PacketBrokerRouteHop{} }
 func (*PacketBrokerRouteHop) ProtoMessage() {}
 func (*PacketBrokerRouteHop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1123b3e8fd87092, []int{3}
+	return fileDescriptor_e1123b3e8fd87092, []int{4}
 }
 func (m *PacketBrokerRouteHop) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PacketBrokerRouteHop.Unmarshal(m, b)
@@ -550,6 +605,8 @@ func init() {
 	golang_proto.RegisterEnum("ttn.lorawan.v3.LocationSource", LocationSource_name, LocationSource_value)
 	proto.RegisterType((*RxMetadata)(nil), "ttn.lorawan.v3.RxMetadata")
 	golang_proto.RegisterType((*RxMetadata)(nil), "ttn.lorawan.v3.RxMetadata")
+	proto.RegisterType((*RelayMetadata)(nil), "ttn.lorawan.v3.RelayMetadata")
+	golang_proto.RegisterType((*RelayMetadata)(nil), "ttn.lorawan.v3.RelayMetadata")
 	proto.RegisterType((*Location)(nil), "ttn.lorawan.v3.Location")
 	golang_proto.RegisterType((*Location)(nil), "ttn.lorawan.v3.Location")
 	proto.RegisterType((*PacketBrokerMetadata)(nil), "ttn.lorawan.v3.PacketBrokerMetadata")
@@ -564,96 +621,102 @@ func init() {
 }
 
 var fileDescriptor_e1123b3e8fd87092 = []byte{
-	// 1410 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xdb, 0xb6,
-	0x1b, 0x8e, 0x62, 0x27, 0xb5, 0xe9, 0x3f, 0x75, 0x99, 0xb4, 0x51, 0xd3, 0xd4, 0xf1, 0x2f, 0xfd,
-	0x6d, 0x73, 0x0b, 0xc4, 0x1e, 0x92, 0xb6, 0xe8, 0x80, 0x0d, 0xa8, 0x9d, 0xa4, 0x99, 0xd0, 0xc6,
-	0x0e, 0x68, 0xb7, 0xc5, 0x76, 0x11, 0x18, 0x89, 0x96, 0x55, 0xdb, 0xa4, 0x26, 0x51, 0x49, 0x7d,
-	0x2b, 0x76, 0x1a, 0x76, 0xea, 0x37, 0xd8, 0x61, 0x87, 0x0d, 0xfb, 0x14, 0x3d, 0xee, 0xbe, 0xcb,
-	0xb0, 0x43, 0x87, 0xa5, 0x97, 0x61, 0xc0, 0x80, 0x9e, 0x7b, 0xd9, 0x20, 0x8a, 0x92, 0xff, 0xa5,
-	0x28, 0x36, 0x4c, 0x27, 0xf2, 0x79, 0x9e, 0xf7, 0x21, 0xf5, 0xbe, 0x2f, 0x49, 0x50, 0xea, 0x33,
-	0x17, 0x9f, 0x60, 0xba, 0xe9, 0x71, 0x6c, 0xf4, 0xaa, 0xd8, 0xb1, 0xab, 0x03, 0xc2, 0xb1, 0x89,
-	0x39, 0xae, 0x38, 0x2e, 0xe3, 0x0c, 0xe6, 0x39, 0xa7, 0x15, 0xa9, 0xaa, 0x1c, 0x6f, 0xaf, 0xd6,
-	0x2c, 0x9b, 0x77, 0xfd, 0xa3, 0x8a, 0xc1, 0x06, 0x55, 0x42, 0x8f, 0xd9, 0xd0, 0x71, 0xd9, 0xd3,
-	0x61, 0x55, 0x88, 0x8d, 0x4d, 0x8b, 0xd0, 0xcd, 0x63, 0xdc, 0xb7, 0x4d, 0xcc, 0x49, 0x75, 0x66,
-	0x10, 0x5a, 0xae, 0x6e, 0x8e, 0x59, 0x58, 0xcc, 0x62, 0x61, 0xf0, 0x91, 0xdf, 0x11, 0x33, 0x31,
-	0x11, 0x23, 0x29, 0xdf, 0x19, 0x93, 0xb7, 0xbb, 0xa4, 0xdd, 0xb5, 0xa9, 0xe5, 0x69, 0xd4, 0xf4,
-	0x3d, 0xee, 0xda, 0xc4, 0x1b, 0x5f, 0xda, 0x62, 0x9b, 0x4f, 0x3c, 0x46, 0xab, 0x98, 0x52, 0xc6,
-	0x31, 0xb7, 0x19, 0xf5, 0xa4, 0xc9, 0x9a, 0xc5, 0x98, 0xd5, 0x27, 0xa3, 0xa5, 0x3c, 0xee, 0xfa,
-	0x06, 0x97, 0xec, 0xfa, 0x34, 0xcb, 0xed, 0x01, 0xf1, 0x38, 0x1e, 0x38, 0x52, 0x50, 0x9c, 0x16,
-	0x9c, 0xb8, 0xd8, 0x71, 0x88, 0x1b, 0xd9, 0x5f, 0x9d, 0xcd, 0x23, 0xa1, 0xfe, 0x20, 0xa2, 0xaf,
-	0xcd, 0xd2, 0xb6, 0x49, 0x28, 0xb7, 0x3b, 0x76, 0xec, 0xb1, 0xf1, 0x5d, 0x0a, 0x00, 0xf4, 0xf4,
-	0x40, 0xa6, 0x1f, 0x1e, 0x80, 0x8c, 0x85, 0x39, 0x39, 0xc1, 0x43, 0xdd, 0x36, 0x3d, 0x55, 0x29,
-	0x29, 0xe5, 0xcc, 0xd6, 0x46, 0x65, 0xb2, 0x1c, 0x95, 0xfd, 0x50, 0xa2, 0x8d, 0xdc, 0xea, 0xa9,
-	0x37, 0xf5, 0x85, 0xaf, 0x95, 0xf9, 0x82, 0x82, 0x80, 0x15, 0xb1, 0x1e, 0xd4, 0x40, 0xce, 0xc1,
-	0x46, 0x8f, 0x70, 0xfd, 0xc8, 0x65, 0x3d, 0xe2, 0xaa, 0x50, 0x18, 0xfe, 0x7f, 0xda, 0xf0, 0x50,
-	0x88, 0xea, 0x42, 0x13, 0xed, 0x05, 0x65, 0x9d, 0x31, 0x14, 0x5e, 0x03, 0x39, 0x4c, 0x39, 0xa1,
-	0x14, 0xeb, 0x36, 0x35, 0xc9, 0x53, 0x75, 0xbe, 0xa4, 0x94, 0x73, 0x28, 0x2b, 0x41, 0x2d, 0xc0,
-	0xe0, 0x4d, 0x90, 0x0c, 0x92, 0xa8, 0x26, 0xc4, 0x32, 0xab, 0x95, 0x30, 0x81, 0x95, 0x28, 0x81,
-	0x95, 0x76, 0x94, 0xe1, 0x7a, 0xf2, 0xf9, 0xaf, 0xeb, 0x0a, 0x12, 0x6a, 0xb8, 0x06, 0xd2, 0x71,
-	0xea, 0xd5, 0xa4, 0xb0, 0x1d, 0x01, 0xf0, 0x3d, 0x90, 0xef, 0xd8, 0x94, 0xe8, 0x23, 0xc9, 0x42,
-	0x49, 0x29, 0x27, 0x51, 0x2e, 0x40, 0x63, 0x43, 0x78, 0x07, 0xa8, 0x84, 0x1a, 0xee, 0xd0, 0xe1,
-	0xc4, 0xd4, 0xa7, 0x02, 0x16, 0x4b, 0x4a, 0x39, 0x8b, 0x2e, 0xc5, 0xfc, 0xbd, 0x89, 0xc8, 0x5d,
-	0xb0, 0xfe, 0xb6, 0x48, 0xbd, 0x47, 0x82, 0x42, 0xa8, 0xe7, 0x4a, 0x4a, 0x39, 0x8d, 0xae, 0x9c,
-	0x6d, 0x70, 0x9f, 0x0c, 0x35, 0x13, 0x42, 0x90, 0x74, 0x3d, 0xcf, 0x56, 0x53, 0x25, 0xa5, 0x3c,
-	0x8f, 0xc4, 0x18, 0x7e, 0x0c, 0x32, 0x9e, 0x6d, 0x51, 0xdc, 0xd7, 0x05, 0x55, 0x10, 0x59, 0xb9,
-	0x32, 0x93, 0x95, 0x7b, 0x7d, 0x86, 0xf9, 0x23, 0xdc, 0xf7, 0x09, 0x02, 0xa1, 0x1e, 0x05, 0xd1,
-	0xff, 0x03, 0x59, 0xa3, 0x8b, 0x29, 0x25, 0x32, 0x3c, 0x2d, 0x9c, 0x33, 0x12, 0x13, 0x92, 0xdb,
-	0x60, 0x25, 0xa0, 0x74, 0x8f, 0x63, 0x6a, 0x62, 0xd7, 0xd4, 0x4d, 0x72, 0x6c, 0x8b, 0x23, 0xa0,
-	0x02, 0xa1, 0xbe, 0x18, 0xd0, 0x2d, 0xc9, 0xee, 0x46, 0x24, 0x2c, 0x80, 0x84, 0x47, 0x5d, 0x35,
-	0x23, 0x34, 0xc1, 0x10, 0x5e, 0x07, 0x85, 0x8e, 0x4b, 0xbe, 0xf0, 0x09, 0x35, 0x86, 0x3a, 0xeb,
-	0x74, 0x3c, 0xc2, 0xd5, 0x6c, 0x49, 0x29, 0x27, 0xd0, 0xf9, 0x18, 0x6f, 0x0a, 0x18, 0xde, 0x04,
-	0xa9, 0x3e, 0x33, 0xc2, 0x55, 0x72, 0xe2, 0x97, 0xd4, 0xe9, 0x7e, 0x7a, 0x20, 0x79, 0x14, 0x2b,
-	0xe1, 0x13, 0xa0, 0x9a, 0xec, 0x84, 0xf6, 0x6d, 0xda, 0xd3, 0x1d, 0xcc, 0xbb, 0xba, 0xc1, 0xa8,
-	0xc7, 0x5d, 0x6c, 0x53, 0xae, 0xe6, 0x4b, 0x4a, 0x39, 0xbf, 0xf5, 0xfe, 0xb4, 0xcb, 0xae, 0xd4,
-	0x1f, 0x62, 0xde, 0xdd, 0x89, 0xd5, 0xa2, 0xd5, 0xbf, 0x14, 0xad, 0x7e, 0xc9, 0x3c, 0x53, 0x11,
-	0x64, 0xce, 0x77, 0xc4, 0x4a, 0x9c, 0xf5, 0x08, 0x55, 0xcf, 0x8b, 0xfa, 0x67, 0x42, 0xac, 0x1d,
-	0x40, 0x70, 0x13, 0xe4, 0xa2, 0xe4, 0x86, 0xed, 0x7c, 0x21, 0xe8, 0x3b, 0xe1, 0x7d, 0x23, 0xa1,
-	0xfe, 0xa5, 0xa0, 0x28, 0xf7, 0x61, 0x63, 0x5f, 0x03, 0xb9, 0x2e, 0x73, 0x1c, 0x9b, 0x5a, 0xfa,
-	0x89, 0x6d, 0xf2, 0xae, 0xba, 0x14, 0x76, 0xbf, 0x04, 0x1f, 0x07, 0x18, 0xfc, 0x00, 0x8c, 0x72,
-	0xa5, 0x9b, 0xae, 0xdd, 0xe1, 0xea, 0x72, 0x49, 0x29, 0x2f, 0xa0, 0x7c, 0x0c, 0xef, 0x06, 0x28,
-	0xdc, 0x06, 0x29, 0x6c, 0x1e, 0x63, 0x6a, 0x10, 0x53, 0x35, 0x44, 0x06, 0x57, 0x66, 0x9a, 0xa2,
-	0x25, 0xae, 0x2a, 0x14, 0x0b, 0x37, 0x5e, 0x2b, 0x20, 0x15, 0xe5, 0x35, 0x70, 0xe8, 0x63, 0x6e,
-	0x73, 0xdf, 0x24, 0xe2, 0x92, 0x50, 0xea, 0x2b, 0x6f, 0xea, 0xcb, 0x10, 0x5e, 0x9e, 0x0b, 0xbe,
-	0x67, 0x8f, 0xee, 0x5e, 0x97, 0x83, 0x17, 0x28, 0x16, 0xc2, 0x5b, 0x20, 0xdd, 0x67, 0xd4, 0x0a,
-	0xa3, 0xe6, 0x67, 0xa3, 0x3a, 0x51, 0x54, 0xe7, 0x05, 0x1a, 0x29, 0xe1, 0x2a, 0x48, 0xe1, 0xbe,
-	0x5c, 0x2b, 0x21, 0xfe, 0x27, 0x9e, 0x0b, 0xce, 0x30, 0x7c, 0x17, 0x1b, 0x43, 0x71, 0x72, 0x03,
-	0x4e, 0xce, 0xe1, 0x5d, 0xb0, 0xe8, 0x31, 0xdf, 0x35, 0x88, 0x38, 0xb0, 0xf9, 0xad, 0xe2, 0xdb,
-	0xba, 0xa4, 0x25, 0x54, 0x63, 0x75, 0x95, 0x71, 0x1b, 0x3f, 0x2d, 0x80, 0xe5, 0xb3, 0xae, 0x26,
-	0x78, 0x15, 0x80, 0x01, 0xf1, 0x3c, 0x6c, 0x91, 0xe0, 0x74, 0x2a, 0xe2, 0x74, 0xa6, 0x25, 0xa2,
-	0x99, 0xd0, 0x02, 0x85, 0x0e, 0x73, 0x4f, 0xb0, 0x6b, 0x12, 0x57, 0xa7, 0x84, 0x07, 0xa2, 0xe0,
-	0x7f, 0xb3, 0xf5, 0x4f, 0x7e, 0x7c, 0xb9, 0x3e, 0xf7, 0xcb, 0xcb, 0xf5, 0x5b, 0x16, 0xab, 0xf0,
-	0x2e, 0xe1, 0xe2, 0x59, 0xa9, 0x50, 0xc2, 0x4f, 0x98, 0xdb, 0xab, 0x4e, 0x5e, 0xd8, 0xc7, 0xdb,
-	0x55, 0xa7, 0x67, 0x55, 0xf9, 0xd0, 0x21, 0x5e, 0xa5, 0x41, 0xb8, 0xb6, 0x8b, 0xf2, 0xb1, 0x6d,
-	0x30, 0x37, 0x61, 0x05, 0x2c, 0x8d, 0x16, 0xe2, 0x84, 0x62, 0x2a, 0xd6, 0x4a, 0x88, 0x0d, 0x5d,
-	0x88, 0xa9, 0xb6, 0x60, 0x34, 0x13, 0x7e, 0x08, 0x96, 0x47, 0x7a, 0xa3, 0xef, 0x7b, 0x9c, 0xb8,
-	0x41, 0x40, 0x52, 0x04, 0xc0, 0x98, 0xdb, 0x09, 0x29, 0xcd, 0x84, 0x03, 0x70, 0x71, 0x14, 0x11,
-	0x3d, 0x0d, 0xc4, 0x0f, 0x6f, 0x83, 0x6c, 0xfd, 0xa3, 0x7f, 0xf3, 0x2f, 0x7b, 0x0f, 0xb5, 0xdb,
-	0x37, 0xd1, 0x68, 0xe7, 0xf2, 0x39, 0xd9, 0xf3, 0x6d, 0xd8, 0x18, 0xdf, 0xe0, 0xe8, 0x25, 0x12,
-	0xb7, 0x49, 0x66, 0x6b, 0xed, 0xac, 0x2e, 0xb5, 0xa9, 0x15, 0xde, 0x5d, 0x70, 0xda, 0x50, 0x33,
-	0x61, 0x1f, 0x2c, 0x75, 0xd9, 0x80, 0xe8, 0x72, 0x57, 0x51, 0x31, 0x16, 0xfe, 0x8b, 0x62, 0x14,
-	0x02, 0xe7, 0x46, 0xa8, 0x0e, 0xcb, 0xb1, 0x0d, 0x2e, 0x4d, 0xac, 0x36, 0xaa, 0xc8, 0xa2, 0x48,
-	0xf0, 0xd2, 0x58, 0x44, 0x5c, 0x93, 0x5b, 0x60, 0x65, 0x22, 0x68, 0xac, 0x2c, 0x29, 0x11, 0xb5,
-	0x3c, 0x16, 0x35, 0x2a, 0xcc, 0x1d, 0x90, 0xec, 0x32, 0xc7, 0x53, 0xcf, 0x95, 0x12, 0xef, 0x7a,
-	0x51, 0x11, 0xf3, 0x39, 0xf9, 0x94, 0x39, 0x48, 0x44, 0x6c, 0xfc, 0xa9, 0x4c, 0x76, 0x75, 0x44,
-	0xc3, 0x1a, 0xc8, 0xb8, 0xc4, 0x20, 0xf6, 0x31, 0x31, 0x75, 0xcc, 0xe5, 0xe3, 0xff, 0xee, 0x47,
-	0x14, 0x44, 0x41, 0x35, 0x0e, 0xd7, 0x41, 0xc6, 0x23, 0x54, 0xb4, 0x3d, 0x1e, 0x84, 0x87, 0x3c,
-	0x8d, 0x40, 0x08, 0x35, 0xf0, 0x80, 0x04, 0xaf, 0xa9, 0x14, 0x60, 0xd3, 0x74, 0x89, 0xe7, 0xc9,
-	0x66, 0xcd, 0x85, 0x68, 0x2d, 0x04, 0x83, 0xfb, 0x4e, 0xba, 0x4a, 0xa7, 0xb0, 0x43, 0xb3, 0x11,
-	0x18, 0x79, 0xc5, 0x22, 0x6c, 0x11, 0xca, 0x45, 0x5d, 0xd3, 0x28, 0x0e, 0xad, 0x05, 0xe0, 0x8d,
-	0x6f, 0xe6, 0x41, 0x7e, 0xf2, 0xa8, 0x43, 0x08, 0xf2, 0xad, 0xe6, 0x43, 0xb4, 0xb3, 0xa7, 0x3f,
-	0x6c, 0xdc, 0x6f, 0x34, 0x1f, 0x37, 0x0a, 0x73, 0x30, 0x0f, 0x80, 0xc4, 0xf6, 0x0f, 0x5b, 0x05,
-	0x05, 0x2e, 0x81, 0xf3, 0x72, 0x8e, 0xf6, 0xf6, 0xb5, 0x56, 0x1b, 0x7d, 0x56, 0x48, 0xc0, 0xcb,
-	0xe0, 0xa2, 0x04, 0xb5, 0x43, 0x7d, 0x7f, 0xaf, 0xf9, 0xa0, 0xb9, 0x53, 0x6b, 0x6b, 0xcd, 0x46,
-	0x21, 0x09, 0x4b, 0x60, 0x4d, 0x52, 0x8f, 0xb5, 0x7b, 0x9a, 0x8e, 0x5a, 0x2d, 0x6d, 0x42, 0xb1,
-	0x00, 0x8b, 0x60, 0x55, 0x2a, 0xea, 0xed, 0x59, 0x7e, 0x71, 0xcc, 0xe1, 0x41, 0x13, 0xd5, 0x66,
-	0x15, 0xe7, 0xa6, 0x15, 0xed, 0xdd, 0x66, 0x6d, 0x42, 0x91, 0x82, 0xeb, 0xe0, 0x8a, 0x54, 0xec,
-	0x34, 0x0f, 0xea, 0x5a, 0x63, 0x6f, 0x77, 0x42, 0x90, 0x5e, 0x85, 0x7f, 0xfc, 0x70, 0x19, 0xa8,
-	0xca, 0x8d, 0xc5, 0x50, 0xf6, 0xd5, 0xb7, 0xc5, 0xb9, 0xfa, 0xc1, 0xcf, 0xbf, 0x15, 0xe7, 0x9e,
-	0x9d, 0x16, 0x95, 0xef, 0x4f, 0x8b, 0xca, 0xef, 0xa7, 0xc5, 0xb9, 0xd7, 0xa7, 0x45, 0xe5, 0xf9,
-	0xab, 0xe2, 0xdc, 0x8b, 0x57, 0x45, 0xe5, 0xf3, 0xea, 0x3f, 0x38, 0x1e, 0x9c, 0x3a, 0x47, 0x47,
-	0x8b, 0xa2, 0x55, 0xb6, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xd4, 0xcd, 0xcd, 0x18, 0xe8, 0x0b,
-	0x00, 0x00,
+	// 1514 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x2d, 0xd9, 0x96, 0x46, 0x1f, 0xd1, 0x8e, 0xed, 0x98, 0x71, 0x1c, 0x59, 0x75, 0xdc,
+	0xae, 0x62, 0x54, 0xd2, 0xc2, 0x4e, 0x16, 0xbb, 0x45, 0x8b, 0x5d, 0xc9, 0x72, 0x5c, 0x62, 0x63,
+	0xc9, 0x18, 0x29, 0x1b, 0xb4, 0x8b, 0x2d, 0x31, 0x26, 0x47, 0x14, 0x23, 0x69, 0x86, 0x25, 0x47,
+	0x56, 0xd4, 0xa2, 0x68, 0xd0, 0x53, 0xd1, 0x53, 0xfe, 0x83, 0x1e, 0x7a, 0x29, 0xfa, 0x57, 0xe4,
+	0xd8, 0x7b, 0x2f, 0x45, 0x51, 0xa4, 0xa8, 0x73, 0x29, 0x0a, 0x14, 0xc8, 0xd9, 0x97, 0x16, 0x1c,
+	0x0e, 0xa9, 0x2f, 0x07, 0xfd, 0x40, 0x75, 0xe2, 0xfc, 0x3e, 0xde, 0x23, 0xdf, 0x7b, 0x33, 0x23,
+	0x50, 0xe8, 0x33, 0x17, 0x8f, 0x30, 0x2d, 0x79, 0x1c, 0x1b, 0xbd, 0x0a, 0x76, 0xec, 0xca, 0x80,
+	0x70, 0x6c, 0x62, 0x8e, 0xcb, 0x8e, 0xcb, 0x38, 0x83, 0x59, 0xce, 0x69, 0x59, 0xaa, 0xca, 0x97,
+	0x47, 0xdb, 0x55, 0xcb, 0xe6, 0xdd, 0xe1, 0x45, 0xd9, 0x60, 0x83, 0x0a, 0xa1, 0x97, 0x6c, 0xec,
+	0xb8, 0xec, 0xc5, 0xb8, 0x22, 0xc4, 0x46, 0xc9, 0x22, 0xb4, 0x74, 0x89, 0xfb, 0xb6, 0x89, 0x39,
+	0xa9, 0x2c, 0x3c, 0x04, 0x21, 0xb7, 0x4b, 0x53, 0x21, 0x2c, 0x66, 0xb1, 0xc0, 0x7c, 0x31, 0xec,
+	0x88, 0x95, 0x58, 0x88, 0x27, 0x29, 0x3f, 0x9e, 0x92, 0xb7, 0xbb, 0xa4, 0xdd, 0xb5, 0xa9, 0xe5,
+	0x69, 0xd4, 0x1c, 0x7a, 0xdc, 0xb5, 0x89, 0x37, 0x9d, 0xda, 0x62, 0xa5, 0xe7, 0x1e, 0xa3, 0x15,
+	0x4c, 0x29, 0xe3, 0x98, 0xdb, 0x8c, 0x7a, 0x32, 0xc8, 0x8e, 0xc5, 0x98, 0xd5, 0x27, 0x93, 0x54,
+	0x1e, 0x77, 0x87, 0x06, 0x97, 0xec, 0xee, 0x3c, 0xcb, 0xed, 0x01, 0xf1, 0x38, 0x1e, 0x38, 0x52,
+	0x90, 0x9f, 0x17, 0x8c, 0x5c, 0xec, 0x38, 0xc4, 0x0d, 0xc3, 0xdf, 0x5b, 0xac, 0x23, 0xa1, 0xc3,
+	0x41, 0x48, 0xdf, 0x5f, 0xa4, 0x6d, 0x93, 0x50, 0x6e, 0x77, 0xec, 0x28, 0xc6, 0xde, 0x9f, 0x13,
+	0x00, 0xa0, 0x17, 0x67, 0xb2, 0xfc, 0xf0, 0x0c, 0xa4, 0x2c, 0xcc, 0xc9, 0x08, 0x8f, 0x75, 0xdb,
+	0xf4, 0x54, 0xa5, 0xa0, 0x14, 0x53, 0x87, 0x7b, 0xe5, 0xd9, 0x76, 0x94, 0x4f, 0x03, 0x89, 0x36,
+	0x89, 0x56, 0x4b, 0x5c, 0xd7, 0x56, 0x7e, 0xa5, 0x2c, 0xe7, 0x14, 0x04, 0xac, 0x90, 0xf5, 0xa0,
+	0x06, 0x32, 0x0e, 0x36, 0x7a, 0x84, 0xeb, 0x17, 0x2e, 0xeb, 0x11, 0x57, 0x85, 0x22, 0xe0, 0xfe,
+	0x7c, 0xc0, 0x73, 0x21, 0xaa, 0x09, 0x4d, 0xf8, 0x2e, 0x28, 0xed, 0x4c, 0xa1, 0xf0, 0x3e, 0xc8,
+	0x60, 0xca, 0x09, 0xa5, 0x58, 0xb7, 0xa9, 0x49, 0x5e, 0xa8, 0xcb, 0x05, 0xa5, 0x98, 0x41, 0x69,
+	0x09, 0x6a, 0x3e, 0x06, 0x1f, 0x82, 0xb8, 0x5f, 0x44, 0x35, 0x26, 0xd2, 0x6c, 0x97, 0x83, 0x02,
+	0x96, 0xc3, 0x02, 0x96, 0xdb, 0x61, 0x85, 0x6b, 0xf1, 0x57, 0x7f, 0xd9, 0x55, 0x90, 0x50, 0xc3,
+	0x1d, 0x90, 0x8c, 0x4a, 0xaf, 0xc6, 0x45, 0xd8, 0x09, 0x00, 0xbf, 0x09, 0xb2, 0x1d, 0x9b, 0x12,
+	0x7d, 0x22, 0x59, 0x29, 0x28, 0xc5, 0x38, 0xca, 0xf8, 0x68, 0x14, 0x10, 0x7e, 0x02, 0x54, 0x42,
+	0x0d, 0x77, 0xec, 0x70, 0x62, 0xea, 0x73, 0x86, 0xd5, 0x82, 0x52, 0x4c, 0xa3, 0xdb, 0x11, 0xff,
+	0x78, 0xc6, 0x59, 0x07, 0xbb, 0xef, 0x73, 0xea, 0x3d, 0xe2, 0x37, 0x42, 0x5d, 0x2b, 0x28, 0xc5,
+	0x24, 0xba, 0x7b, 0x73, 0x80, 0x2f, 0xc8, 0x58, 0x33, 0x21, 0x04, 0x71, 0xd7, 0xf3, 0x6c, 0x35,
+	0x51, 0x50, 0x8a, 0xcb, 0x48, 0x3c, 0xc3, 0xef, 0x82, 0x94, 0x67, 0x5b, 0x14, 0xf7, 0x75, 0x41,
+	0xe5, 0x44, 0x55, 0xee, 0x2e, 0x54, 0xe5, 0x71, 0x9f, 0x61, 0xfe, 0x25, 0xee, 0x0f, 0x09, 0x02,
+	0x81, 0x1e, 0xf9, 0xee, 0x6f, 0x80, 0xb4, 0xd1, 0xc5, 0x94, 0x12, 0x69, 0x4f, 0x8a, 0xc8, 0x29,
+	0x89, 0x09, 0xc9, 0xc7, 0x60, 0xcb, 0xa7, 0x74, 0x8f, 0x63, 0x6a, 0x62, 0xd7, 0xd4, 0x4d, 0x72,
+	0x69, 0x8b, 0x2d, 0xa0, 0x02, 0xa1, 0xde, 0xf4, 0xe9, 0x96, 0x64, 0xeb, 0x21, 0x09, 0x73, 0x20,
+	0xe6, 0x51, 0x57, 0x4d, 0x09, 0x8d, 0xff, 0x08, 0x1f, 0x80, 0x5c, 0xc7, 0x25, 0x3f, 0x1e, 0x12,
+	0x6a, 0x8c, 0x75, 0xd6, 0xe9, 0x78, 0x84, 0xab, 0xe9, 0x82, 0x52, 0x8c, 0xa1, 0x5b, 0x11, 0xde,
+	0x14, 0x30, 0x7c, 0x08, 0x12, 0x7d, 0x66, 0x04, 0x59, 0x32, 0xe2, 0x93, 0xd4, 0xf9, 0x79, 0x7a,
+	0x22, 0x79, 0x14, 0x29, 0xe1, 0x73, 0xa0, 0x9a, 0x6c, 0x44, 0xfb, 0x36, 0xed, 0xe9, 0x0e, 0xe6,
+	0x5d, 0xdd, 0x60, 0xd4, 0xe3, 0x2e, 0xb6, 0x29, 0x57, 0xb3, 0x05, 0xa5, 0x98, 0x3d, 0xfc, 0xd6,
+	0x7c, 0x94, 0xba, 0xd4, 0x9f, 0x63, 0xde, 0x3d, 0x8e, 0xd4, 0x62, 0xd4, 0x7f, 0x21, 0x46, 0xfd,
+	0xb6, 0x79, 0xa3, 0xc2, 0xaf, 0xdc, 0xd0, 0x11, 0x99, 0x38, 0xeb, 0x11, 0xaa, 0xde, 0x12, 0xfd,
+	0x4f, 0x05, 0x58, 0xdb, 0x87, 0x60, 0x09, 0x64, 0xc2, 0xe2, 0x06, 0xe3, 0xfc, 0x81, 0x3f, 0x77,
+	0x22, 0xf6, 0x41, 0x4c, 0xfd, 0xa7, 0x82, 0xc2, 0xda, 0x07, 0x83, 0x7d, 0x1f, 0x64, 0xba, 0xcc,
+	0x71, 0x6c, 0x6a, 0xe9, 0x23, 0xdb, 0xe4, 0x5d, 0x75, 0x3d, 0x98, 0x7e, 0x09, 0x3e, 0xf3, 0x31,
+	0xf8, 0x21, 0x98, 0xd4, 0x4a, 0x37, 0x5d, 0xbb, 0xc3, 0xd5, 0x8d, 0x82, 0x52, 0x5c, 0x41, 0xd9,
+	0x08, 0xae, 0xfb, 0x28, 0x3c, 0x02, 0x09, 0x6c, 0x5e, 0x62, 0x6a, 0x10, 0x53, 0x35, 0x44, 0x05,
+	0xb7, 0x16, 0x86, 0xa2, 0x25, 0x8e, 0x2a, 0x14, 0x09, 0xe1, 0x11, 0x58, 0x71, 0x49, 0x1f, 0x8f,
+	0xd5, 0x4d, 0xe1, 0xb8, 0x37, 0x5f, 0x2d, 0xe4, 0x93, 0xd1, 0xe6, 0x0d, 0xb4, 0x7b, 0x3f, 0x07,
+	0x99, 0x19, 0x1c, 0xd6, 0x41, 0xd2, 0x9f, 0x11, 0x83, 0xf8, 0x63, 0xed, 0x1f, 0x2f, 0xc9, 0xda,
+	0x87, 0xd7, 0xb5, 0x7d, 0x77, 0x4f, 0xdd, 0x3f, 0xcc, 0xff, 0xe8, 0x2b, 0x5c, 0xfa, 0xc9, 0x47,
+	0xa5, 0x4f, 0xbf, 0x2e, 0x7e, 0xf6, 0x9d, 0xaf, 0x4a, 0x5f, 0x7f, 0x16, 0x2e, 0x1f, 0xfc, 0xf4,
+	0xf0, 0xdb, 0x3f, 0xdb, 0x47, 0x89, 0xc0, 0xa9, 0x99, 0xb0, 0x08, 0x52, 0x23, 0xe6, 0xea, 0xb2,
+	0x44, 0xc1, 0x51, 0x50, 0x5b, 0xbb, 0xae, 0xc5, 0x0f, 0x96, 0x55, 0x05, 0x81, 0x11, 0x73, 0x8f,
+	0x03, 0x6a, 0xef, 0x9d, 0x02, 0x12, 0xe1, 0x34, 0xf8, 0xdf, 0xdd, 0xc7, 0xdc, 0xe6, 0x43, 0x93,
+	0x88, 0xdc, 0x4a, 0x6d, 0xeb, 0xba, 0xb6, 0x01, 0xe1, 0x9d, 0x25, 0xff, 0xf7, 0xf2, 0xcb, 0xcf,
+	0x1f, 0xc8, 0x87, 0xd7, 0x28, 0x12, 0xc2, 0x47, 0x20, 0xd9, 0x67, 0xd4, 0x0a, 0x5c, 0xcb, 0x8b,
+	0xae, 0x4e, 0xe8, 0xea, 0xbc, 0x46, 0x13, 0x25, 0xdc, 0x06, 0x09, 0xdc, 0x97, 0xb9, 0x62, 0xa2,
+	0x0b, 0xd1, 0x5a, 0x70, 0x86, 0x31, 0x74, 0xb1, 0x31, 0x56, 0xe3, 0x92, 0x93, 0x6b, 0xf8, 0x39,
+	0x58, 0xf5, 0xd8, 0xd0, 0x35, 0x88, 0x38, 0x66, 0xb2, 0x87, 0xf9, 0xf7, 0xcd, 0x76, 0x4b, 0xa8,
+	0xa6, 0xa6, 0x51, 0xfa, 0xf6, 0xfe, 0xb0, 0x02, 0x36, 0x6e, 0x3a, 0x50, 0xe1, 0x3d, 0x00, 0x06,
+	0xc4, 0xf3, 0xb0, 0x35, 0x29, 0x3e, 0x4a, 0x4a, 0x44, 0x33, 0xa1, 0x05, 0x72, 0x1d, 0xe6, 0x8e,
+	0xb0, 0x6b, 0x12, 0x57, 0xa7, 0x84, 0xfb, 0x22, 0xff, 0x7b, 0xd3, 0xb5, 0xef, 0xfd, 0xfe, 0xcd,
+	0xee, 0xd2, 0x9f, 0xde, 0xec, 0x3e, 0xb2, 0x58, 0x99, 0x77, 0x09, 0x17, 0x97, 0x61, 0x99, 0x12,
+	0x3e, 0x62, 0x6e, 0xaf, 0x32, 0x7b, 0xcd, 0x5c, 0x1e, 0x55, 0x9c, 0x9e, 0x55, 0xe1, 0x63, 0x87,
+	0x78, 0xe5, 0x06, 0xe1, 0x5a, 0x1d, 0x65, 0xa3, 0xb0, 0xfe, 0xda, 0x84, 0x65, 0xb0, 0x3e, 0x49,
+	0xc4, 0x09, 0xc5, 0x54, 0xe4, 0x8a, 0x89, 0x17, 0xfa, 0x20, 0xa2, 0xda, 0x82, 0xd1, 0x4c, 0xf8,
+	0x11, 0xd8, 0x98, 0xe8, 0x8d, 0xfe, 0xd0, 0xe3, 0xc4, 0xf5, 0x0d, 0x71, 0x61, 0x80, 0x11, 0x77,
+	0x1c, 0x50, 0x9a, 0x09, 0x07, 0x60, 0x73, 0xe2, 0x08, 0x2f, 0x34, 0x32, 0x0c, 0xce, 0xb0, 0x74,
+	0xed, 0xd3, 0xff, 0xe5, 0x5b, 0x4e, 0x9e, 0x6a, 0x1f, 0x3f, 0x44, 0x93, 0x37, 0x97, 0x97, 0xe0,
+	0xc9, 0xd0, 0x86, 0x0d, 0xb0, 0xb1, 0x98, 0xce, 0x36, 0xc5, 0x19, 0x98, 0x3a, 0xdc, 0xb9, 0x69,
+	0x6f, 0xd9, 0xd4, 0x0a, 0x4e, 0x5c, 0x38, 0x1f, 0x50, 0x33, 0x61, 0x1f, 0xac, 0x77, 0xd9, 0x80,
+	0xe8, 0xf2, 0xad, 0xc2, 0x66, 0xac, 0xfc, 0x3f, 0x9a, 0x91, 0xf3, 0x23, 0x37, 0x02, 0x75, 0xd0,
+	0x8e, 0x23, 0x70, 0x7b, 0x26, 0xdb, 0xa4, 0x23, 0xab, 0xa2, 0xc0, 0xeb, 0x53, 0x8e, 0xa8, 0x27,
+	0x8f, 0xc0, 0xd6, 0x8c, 0x69, 0xaa, 0x2d, 0x09, 0xe1, 0xda, 0x98, 0x72, 0x4d, 0x1a, 0xf3, 0x09,
+	0x88, 0x77, 0x99, 0xe3, 0xa9, 0x6b, 0x85, 0xd8, 0xbf, 0xfb, 0x1f, 0x80, 0xd8, 0x90, 0x93, 0xef,
+	0x33, 0x07, 0x09, 0xc7, 0xde, 0x3f, 0x14, 0xb0, 0x71, 0x13, 0x0d, 0xab, 0x20, 0xe5, 0x12, 0x83,
+	0xd8, 0x97, 0xc4, 0xd4, 0x31, 0x57, 0x95, 0xff, 0xf0, 0xea, 0x07, 0xa1, 0xa9, 0xca, 0xe1, 0x2e,
+	0x48, 0x79, 0x84, 0x8a, 0xb1, 0xc7, 0x83, 0x60, 0x93, 0x27, 0x11, 0x08, 0xa0, 0x06, 0x1e, 0x10,
+	0xff, 0x3f, 0x80, 0x14, 0x60, 0xd3, 0x74, 0x89, 0xe7, 0xc9, 0x61, 0xcd, 0x04, 0x68, 0x35, 0x00,
+	0xfd, 0x53, 0x5a, 0x46, 0x95, 0x91, 0x82, 0x09, 0x4d, 0x87, 0x60, 0x18, 0x2b, 0x12, 0x61, 0x8b,
+	0x50, 0x2e, 0xfa, 0x9a, 0x44, 0x91, 0xb5, 0xea, 0x83, 0x07, 0xbf, 0x5e, 0x06, 0xd9, 0xd9, 0xad,
+	0x0e, 0x21, 0xc8, 0xb6, 0x9a, 0x4f, 0xd1, 0xf1, 0x89, 0xfe, 0xb4, 0xf1, 0x45, 0xa3, 0xf9, 0xac,
+	0x91, 0x5b, 0x82, 0x59, 0x00, 0x24, 0x76, 0x7a, 0xde, 0xca, 0x29, 0x70, 0x1d, 0xdc, 0x92, 0x6b,
+	0x74, 0x72, 0xaa, 0xb5, 0xda, 0xe8, 0x07, 0xb9, 0x18, 0xbc, 0x03, 0x36, 0x25, 0xa8, 0x9d, 0xeb,
+	0xa7, 0x27, 0xcd, 0x27, 0xcd, 0xe3, 0x6a, 0x5b, 0x6b, 0x36, 0x72, 0x71, 0x58, 0x00, 0x3b, 0x92,
+	0x7a, 0xa6, 0x3d, 0xd6, 0x74, 0xd4, 0x6a, 0x69, 0x33, 0x8a, 0x15, 0x98, 0x07, 0xdb, 0x52, 0x51,
+	0x6b, 0x2f, 0xf2, 0xab, 0x53, 0x11, 0x9e, 0x34, 0x51, 0x75, 0x51, 0xb1, 0x36, 0xaf, 0x68, 0xd7,
+	0x9b, 0xd5, 0x19, 0x45, 0x02, 0xee, 0x82, 0xbb, 0x52, 0x71, 0xdc, 0x3c, 0xab, 0x69, 0x8d, 0x93,
+	0xfa, 0x8c, 0x20, 0xb9, 0x0d, 0xff, 0xfe, 0xbb, 0x3b, 0x40, 0x55, 0x0e, 0x56, 0x03, 0xd9, 0x2f,
+	0x7f, 0x93, 0x5f, 0xaa, 0x9d, 0xfd, 0xf1, 0xaf, 0xf9, 0xa5, 0x97, 0x57, 0x79, 0xe5, 0xb7, 0x57,
+	0x79, 0xe5, 0x6f, 0x57, 0xf9, 0xa5, 0x77, 0x57, 0x79, 0xe5, 0xd5, 0xdb, 0xfc, 0xd2, 0xeb, 0xb7,
+	0x79, 0xe5, 0x87, 0x95, 0xff, 0x62, 0x7b, 0x70, 0xea, 0x5c, 0x5c, 0xac, 0x8a, 0x51, 0x39, 0xfa,
+	0xd7, 0x00, 0x16, 0xec, 0xcb, 0x13, 0x9e, 0x0c, 0x00, 0x00,
 }
 
 func (x LocationSource) String() string {
@@ -749,6 +812,36 @@ func (this *RxMetadata) Equal(that interface{}) bool {
 	if !this.Advanced.Equal(that1.Advanced) {
 		return false
 	}
+	if !this.Relay.Equal(that1.Relay) {
+		return false
+	}
+	return true
+}
+func (this *RelayMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RelayMetadata)
+	if !ok {
+		that2, ok := that.(RelayMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DeviceId != that1.DeviceId {
+		return false
+	}
+	if this.WorChannel != that1.WorChannel {
+		return false
+	}
 	return true
 }
 func (this *Location) Equal(that interface{}) bool {
@@ -913,6 +1006,18 @@ func (this *RxMetadata) String() string {
 		`HoppingWidth:` + fmt.Sprintf("%v", this.HoppingWidth) + `,`,
 		`FrequencyDrift:` + fmt.Sprintf("%v", this.FrequencyDrift) + `,`,
 		`Advanced:` + strings.Replace(fmt.Sprintf("%v", this.Advanced), "Struct", "types.Struct", 1) + `,`,
+		`Relay:` + strings.Replace(this.Relay.String(), "RelayMetadata", "RelayMetadata", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RelayMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RelayMetadata{`,
+		`DeviceId:` + fmt.Sprintf("%v", this.DeviceId) + `,`,
+		`WorChannel:` + fmt.Sprintf("%v", this.WorChannel) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -34,6 +34,9 @@ var RxMetadataFieldPathsNested = []string{
 	"packet_broker.home_network_tenant_id",
 	"packet_broker.hops",
 	"packet_broker.message_id",
+	"relay",
+	"relay.device_id",
+	"relay.wor_channel",
 	"rssi",
 	"rssi_standard_deviation",
 	"signal_rssi",
@@ -58,6 +61,7 @@ var RxMetadataFieldPathsTopLevel = []string{
 	"hopping_width",
 	"location",
 	"packet_broker",
+	"relay",
 	"rssi",
 	"rssi_standard_deviation",
 	"signal_rssi",
@@ -66,6 +70,15 @@ var RxMetadataFieldPathsTopLevel = []string{
 	"timestamp",
 	"uplink_token",
 }
+var RelayMetadataFieldPathsNested = []string{
+	"device_id",
+	"wor_channel",
+}
+
+var RelayMetadataFieldPathsTopLevel = []string{
+	"device_id",
+	"wor_channel",
+}
 var LocationFieldPathsNested = []string{
 	"accuracy",
 	"altitude",
@@ -167,6 +167,12 @@ func (x *RxMetadata) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 			gogo.MarshalStruct(s, x.Advanced)
 		}
 	}
+	if x.Relay != nil || s.HasField("relay") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("relay")
+		// NOTE: RelayMetadata does not seem to implement MarshalProtoJSON.
+		gogo.MarshalMessage(s, x.Relay)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -264,6 +270,12 @@ func (x *RxMetadata) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 				return
 			}
 			x.Advanced = v
+		case "relay":
+			s.AddField("relay")
+			// NOTE: RelayMetadata does not seem to implement UnmarshalProtoJSON.
+			var v RelayMetadata
+			gogo.UnmarshalMessage(s, &v)
+			x.Relay = &v
 		}
 	})
 }
@@ -123,62 +123,65 @@ func init() {
 }
 
 var fileDescriptor_c77e7504ad1081b8 = []byte{
-	// 879 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xb8, 0x21, 0x2d, 0xa3, 0x28, 0x25, 0xd3, 0x50, 0x82, 0x5b, 0xdc, 0xc8, 0x05, 0x51,
-	0x05, 0xb2, 0x2b, 0x39, 0x07, 0xa4, 0x72, 0xc1, 0xc6, 0xc1, 0x29, 0xc2, 0x51, 0xea, 0x84, 0x4a,
-	0xed, 0x65, 0x35, 0xd9, 0x79, 0x59, 0xaf, 0xbc, 0x9e, 0xd9, 0xce, 0x8c, 0xd7, 0x5d, 0x45, 0x91,
-	0x50, 0x0f, 0x9c, 0x2b, 0x21, 0xce, 0x5c, 0xcb, 0x85, 0x03, 0x17, 0x2e, 0x08, 0xf1, 0x0b, 0xb8,
-	0x70, 0x41, 0x1c, 0x90, 0x48, 0x39, 0x70, 0xe4, 0xdc, 0x13, 0xf2, 0xec, 0x6e, 0x12, 0xef, 0xda,
-	0x28, 0x01, 0xd4, 0xdb, 0x1b, 0xbf, 0x6f, 0xbe, 0xf7, 0xcd, 0xf7, 0xde, 0x5b, 0xe3, 0xb7, 0x02,
-	0x21, 0xe9, 0x90, 0xf2, 0x35, 0xa5, 0xa9, 0xdb, 0xb3, 0x69, 0xe8, 0xdb, 0x1c, 0xf4, 0x50, 0xc8,
-	0x9e, 0x02, 0x19, 0x81, 0xb4, 0x42, 0x29, 0xb4, 0x20, 0x0b, 0x5a, 0x73, 0x2b, 0x85, 0x5a, 0xd1,
-	0x7a, 0xb9, 0xee, 0xf9, 0xba, 0x3b, 0xd8, 0xb3, 0x5c, 0xd1, 0xb7, 0x81, 0x47, 0x22, 0x0e, 0xa5,
-	0x78, 0x14, 0xdb, 0x06, 0xec, 0xae, 0x79, 0xc0, 0xd7, 0x22, 0x1a, 0xf8, 0x8c, 0x6a, 0xb0, 0x0b,
-	0x41, 0x42, 0x59, 0x5e, 0x3b, 0x45, 0xe1, 0x09, 0x4f, 0x24, 0x97, 0xf7, 0x06, 0xfb, 0xe6, 0x64,
-	0x0e, 0x26, 0x4a, 0xe1, 0xd7, 0x3d, 0x21, 0xbc, 0x00, 0x8c, 0x42, 0xca, 0xb9, 0xd0, 0x54, 0xfb,
-	0x82, 0xab, 0x34, 0x7b, 0x2d, 0xcd, 0x1e, 0x73, 0x40, 0x3f, 0xd4, 0x71, 0x9a, 0xac, 0x16, 0xdf,
-	0x08, 0x9c, 0x39, 0x0c, 0x22, 0xdf, 0xcd, 0xd4, 0xdc, 0x2c, 0x62, 0x7c, 0x06, 0x5c, 0xfb, 0xfb,
-	0x3e, 0xc8, 0xac, 0xca, 0x4a, 0x11, 0xd4, 0x07, 0xa5, 0xa8, 0x07, 0x19, 0xe2, 0x46, 0x11, 0x91,
-	0xb9, 0x66, 0x00, 0xd5, 0x87, 0xf8, 0xb5, 0x16, 0x70, 0x90, 0x54, 0x43, 0x13, 0xa2, 0x3a, 0x63,
-	0xb2, 0x03, 0x2a, 0x14, 0x5c, 0x01, 0xb9, 0x87, 0x2f, 0x31, 0x88, 0x1c, 0xca, 0x98, 0x5c, 0x46,
-	0x2b, 0xe8, 0xd6, 0x7c, 0xe3, 0xfd, 0x5f, 0x7f, 0xbb, 0xf1, 0x9e, 0x27, 0x2c, 0xdd, 0x05, 0xdd,
-	0xf5, 0xb9, 0xa7, 0xac, 0xb4, 0x37, 0xf6, 0x78, 0x99, 0x68, 0xdd, 0x0e, 0x7b, 0x9e, 0xad, 0xe3,
-	0x10, 0x94, 0x95, 0xd1, 0x5e, 0x64, 0x49, 0x50, 0xfd, 0x1a, 0xe1, 0xeb, 0x2d, 0xd0, 0x4d, 0xd8,
-	0xa7, 0x83, 0x40, 0xb7, 0xeb, 0x1f, 0xee, 0x80, 0xd6, 0x23, 0xb6, 0x0e, 0x3c, 0x1c, 0x80, 0xd2,
-	0x64, 0x1d, 0x2f, 0xee, 0xcb, 0x51, 0xcc, 0xdd, 0xd8, 0x09, 0x03, 0xca, 0x1d, 0x9f, 0x19, 0x05,
-	0x2f, 0x37, 0x2e, 0x3e, 0x6f, 0xcc, 0xca, 0xd2, 0xf2, 0x07, 0x9d, 0xcb, 0xc7, 0x88, 0xed, 0x80,
-	0xf2, 0x3b, 0x8c, 0xec, 0xe2, 0x2b, 0xa9, 0x08, 0x27, 0xec, 0xc6, 0x4e, 0x04, 0x52, 0xf9, 0x82,
-	0x2f, 0x97, 0x56, 0xd0, 0xad, 0x85, 0x5a, 0xd9, 0x1a, 0x9f, 0x17, 0x6b, 0x7b, 0xf3, 0xfe, 0xbd,
-	0x04, 0xd1, 0xb8, 0xf4, 0xbc, 0xf1, 0xd2, 0x63, 0x54, 0x7a, 0x05, 0x75, 0x16, 0x53, 0xc0, 0x76,
-	0x37, 0x4e, 0x93, 0xb5, 0xaf, 0x4a, 0xb8, 0xb4, 0xa5, 0x48, 0x17, 0x5f, 0xce, 0xb9, 0x44, 0xae,
-	0x5a, 0x49, 0x8b, 0xad, 0xac, 0xc5, 0xd6, 0xc6, 0xa8, 0xc5, 0xe5, 0xb7, 0xf3, 0xa5, 0xa6, 0xd8,
-	0x5b, 0x5d, 0x7a, 0xfc, 0xf3, 0x1f, 0x5f, 0x94, 0x16, 0xc8, 0xbc, 0xcd, 0x95, 0x9d, 0x19, 0x4d,
-	0xbe, 0x41, 0xf8, 0xd5, 0x89, 0xe6, 0x90, 0x77, 0x8b, 0xc4, 0xd3, 0x3d, 0x2c, 0x5f, 0xcb, 0xa3,
-	0x4f, 0x61, 0xaa, 0x1f, 0x9b, 0xd2, 0x4d, 0xd2, 0x48, 0x4a, 0x1b, 0x0e, 0xa7, 0x4f, 0x5d, 0x47,
-	0xa5, 0x08, 0xfb, 0xa0, 0xd0, 0x80, 0x43, 0xfb, 0x60, 0x82, 0xbf, 0x87, 0xb5, 0x27, 0x25, 0x3c,
-	0x5b, 0x57, 0x5b, 0x8a, 0xec, 0xe2, 0xa5, 0xa6, 0x18, 0xf2, 0xc0, 0xe7, 0xbd, 0xbb, 0x03, 0x18,
-	0x40, 0x07, 0xc2, 0x80, 0xba, 0x40, 0xde, 0xcc, 0x2b, 0xc9, 0xa1, 0x12, 0xbd, 0x53, 0xec, 0x24,
-	0x77, 0xf1, 0xe2, 0x18, 0x7e, 0x7b, 0xa0, 0xba, 0xff, 0x91, 0xd2, 0xc9, 0x51, 0x7e, 0xe2, 0x2b,
-	0x5d, 0xa4, 0xdc, 0xe0, 0xac, 0x69, 0x16, 0xf2, 0xce, 0xc9, 0xda, 0x95, 0x0b, 0xa8, 0x7a, 0x18,
-	0x06, 0xbe, 0x6b, 0x56, 0x3f, 0xe3, 0x54, 0xb5, 0xa7, 0x08, 0xcf, 0xb6, 0x46, 0x96, 0x6c, 0xe0,
-	0xf9, 0x4d, 0xca, 0x59, 0x00, 0x9f, 0x86, 0xa3, 0x0c, 0x79, 0x23, 0x7f, 0x3d, 0xf9, 0xbd, 0x9d,
-	0xec, 0xec, 0x54, 0xc1, 0xf7, 0xf1, 0xd5, 0x0e, 0x84, 0x42, 0xea, 0xdd, 0x47, 0x75, 0xb7, 0xc7,
-	0xc5, 0x30, 0x00, 0xe6, 0xf5, 0x81, 0x6b, 0x52, 0x1c, 0x36, 0xaa, 0x61, 0x48, 0xe3, 0x3c, 0x70,
-	0x1a, 0x75, 0xed, 0xfb, 0x39, 0x7c, 0x65, 0x4b, 0x1d, 0xbf, 0xb5, 0x03, 0x9e, 0xaf, 0xb4, 0x8c,
-	0xc9, 0xb7, 0x08, 0x5f, 0x68, 0x81, 0x26, 0x37, 0x27, 0x0c, 0xdd, 0x29, 0x74, 0x62, 0xf4, 0xeb,
-	0x53, 0xbd, 0xab, 0xf6, 0xcc, 0xa4, 0x01, 0x71, 0x47, 0x93, 0x46, 0x4f, 0xcc, 0x52, 0xf6, 0xc1,
-	0xc9, 0x27, 0xcf, 0xf1, 0x99, 0xb2, 0x4e, 0x25, 0x27, 0x9c, 0x0f, 0xed, 0x04, 0x5a, 0xbc, 0x77,
-	0x1c, 0x1e, 0x92, 0xcf, 0x4b, 0xf8, 0xc2, 0xce, 0x24, 0xd1, 0x3b, 0xe7, 0x13, 0xfd, 0x03, 0x32,
-	0xaa, 0xbf, 0x43, 0xe5, 0x7f, 0x94, 0x6d, 0xfd, 0x4b, 0xd9, 0xd6, 0xb8, 0xec, 0xdb, 0x68, 0xf5,
-	0x41, 0xbb, 0xba, 0xf9, 0x7f, 0x55, 0xba, 0x8d, 0x56, 0xc9, 0x4f, 0x08, 0x2f, 0x75, 0x40, 0x81,
-	0xfe, 0x88, 0xba, 0x5a, 0xc8, 0x38, 0xfd, 0x4c, 0x28, 0xf2, 0x4e, 0xfe, 0xd1, 0x06, 0x55, 0xe7,
-	0xec, 0x9c, 0x6d, 0xe5, 0xc6, 0xa0, 0x6e, 0xed, 0x45, 0xb4, 0x75, 0xf4, 0xa0, 0x2f, 0x11, 0x9e,
-	0x6b, 0x42, 0x00, 0x1a, 0xce, 0xb8, 0xa8, 0x53, 0xe6, 0xbd, 0xda, 0x36, 0xc2, 0x5b, 0xab, 0x1b,
-	0x45, 0xe1, 0x67, 0x56, 0x7a, 0x22, 0xad, 0xd1, 0xfe, 0xe5, 0xf7, 0xca, 0xcc, 0x67, 0x47, 0x15,
-	0xf4, 0xf4, 0xa8, 0x82, 0xfe, 0x3c, 0xaa, 0xcc, 0xfc, 0x75, 0x54, 0x41, 0x4f, 0x9e, 0x55, 0x66,
-	0x7e, 0x7c, 0x56, 0x41, 0x0f, 0xec, 0x73, 0xfc, 0x55, 0x6a, 0x1e, 0xee, 0xed, 0xcd, 0x19, 0xb5,
-	0xeb, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x47, 0xbb, 0x1c, 0x05, 0x09, 0x00, 0x00,
+	// 921 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x31, 0x6c, 0x1c, 0x45,
+	0x14, 0xf5, 0x5c, 0x8c, 0x13, 0x46, 0xc6, 0xc1, 0x13, 0x13, 0xcc, 0x25, 0x5c, 0xac, 0x0d, 0x88,
+	0xc8, 0xe0, 0x5d, 0xe9, 0x5c, 0x20, 0x85, 0x86, 0x3b, 0xce, 0x9c, 0x83, 0x38, 0xcb, 0x39, 0x1b,
+	0x4b, 0x89, 0x90, 0x56, 0xe3, 0xdd, 0xef, 0xbd, 0xd5, 0xed, 0xcd, 0x6c, 0x66, 0xe6, 0xf6, 0xbc,
+	0xb2, 0x2c, 0xa1, 0x14, 0xd4, 0x91, 0x10, 0x35, 0x6d, 0x68, 0x28, 0x68, 0x68, 0x10, 0xa2, 0xa3,
+	0xa3, 0xa1, 0x41, 0x14, 0x48, 0x38, 0x14, 0x94, 0xd4, 0xa9, 0xd0, 0xce, 0xee, 0xd9, 0xe7, 0xdd,
+	0xbb, 0xc8, 0x86, 0x88, 0x6e, 0x66, 0xff, 0x9b, 0xf7, 0xdf, 0xbc, 0xff, 0xff, 0x2c, 0x7e, 0x33,
+	0xe0, 0x82, 0x0e, 0x28, 0x5b, 0x91, 0x8a, 0x3a, 0x5d, 0x8b, 0x86, 0xbe, 0xc5, 0x40, 0x0d, 0xb8,
+	0xe8, 0x4a, 0x10, 0x11, 0x08, 0x33, 0x14, 0x5c, 0x71, 0x32, 0xa7, 0x14, 0x33, 0x33, 0xa8, 0x19,
+	0xad, 0x96, 0x6b, 0x9e, 0xaf, 0x3a, 0xfd, 0x5d, 0xd3, 0xe1, 0x3d, 0x0b, 0x58, 0xc4, 0xe3, 0x50,
+	0xf0, 0xfd, 0xd8, 0xd2, 0x60, 0x67, 0xc5, 0x03, 0xb6, 0x12, 0xd1, 0xc0, 0x77, 0xa9, 0x02, 0xab,
+	0xb0, 0x48, 0x29, 0xcb, 0x2b, 0x23, 0x14, 0x1e, 0xf7, 0x78, 0x7a, 0x78, 0xb7, 0xbf, 0xa7, 0x77,
+	0x7a, 0xa3, 0x57, 0x19, 0xfc, 0xba, 0xc7, 0xb9, 0x17, 0x80, 0x56, 0x48, 0x19, 0xe3, 0x8a, 0x2a,
+	0x9f, 0x33, 0x99, 0x45, 0xaf, 0x65, 0xd1, 0x63, 0x0e, 0xe8, 0x85, 0x2a, 0xce, 0x82, 0x46, 0xf1,
+	0x8e, 0xc0, 0x5c, 0xdb, 0x85, 0xc8, 0x77, 0x86, 0x6a, 0x6e, 0x16, 0x31, 0xbe, 0x0b, 0x4c, 0xf9,
+	0x7b, 0x3e, 0x88, 0x61, 0x96, 0xa5, 0x22, 0xa8, 0x07, 0x52, 0x52, 0x0f, 0x86, 0x88, 0x1b, 0x45,
+	0x44, 0xf6, 0x25, 0x05, 0x18, 0x0f, 0xf0, 0xab, 0x4d, 0x60, 0x20, 0xa8, 0x82, 0x06, 0x44, 0x35,
+	0xd7, 0x15, 0x6d, 0x90, 0x21, 0x67, 0x12, 0xc8, 0x0e, 0xbe, 0xe4, 0x42, 0x64, 0x53, 0xd7, 0x15,
+	0x8b, 0x68, 0x09, 0xdd, 0x9a, 0xad, 0xbf, 0xf7, 0xdb, 0xef, 0x37, 0xde, 0xf5, 0xb8, 0xa9, 0x3a,
+	0xa0, 0x3a, 0x3e, 0xf3, 0xa4, 0x99, 0xd5, 0xc6, 0x3a, 0x9d, 0x26, 0x5a, 0xb5, 0xc2, 0xae, 0x67,
+	0xa9, 0x38, 0x04, 0x69, 0x0e, 0x69, 0x2f, 0xba, 0xe9, 0xc2, 0xf8, 0x1a, 0xe1, 0xeb, 0x4d, 0x50,
+	0x0d, 0xd8, 0xa3, 0xfd, 0x40, 0xb5, 0x6a, 0x1f, 0x6c, 0x81, 0x52, 0x09, 0x5b, 0x1b, 0x1e, 0xf4,
+	0x41, 0x2a, 0xb2, 0x8a, 0xe7, 0xf7, 0x44, 0xb2, 0x66, 0x4e, 0x6c, 0x87, 0x01, 0x65, 0xb6, 0xef,
+	0x6a, 0x05, 0x2f, 0xd6, 0x2f, 0x3e, 0xad, 0x4f, 0x8b, 0xd2, 0xe2, 0xfb, 0xed, 0xcb, 0xc7, 0x88,
+	0xcd, 0x80, 0xb2, 0x3b, 0x2e, 0xd9, 0xc6, 0x57, 0x32, 0x11, 0x76, 0xd8, 0x89, 0xed, 0x08, 0x84,
+	0xf4, 0x39, 0x5b, 0x2c, 0x2d, 0xa1, 0x5b, 0x73, 0xd5, 0xb2, 0x79, 0xba, 0x5f, 0xcc, 0xcd, 0xf5,
+	0x7b, 0x3b, 0x29, 0xa2, 0x7e, 0xe9, 0x69, 0xfd, 0x85, 0x87, 0xa8, 0xf4, 0x32, 0x6a, 0xcf, 0x67,
+	0x80, 0xcd, 0x4e, 0x9c, 0x05, 0xab, 0x5f, 0x95, 0x70, 0x69, 0x43, 0x92, 0x0e, 0xbe, 0x9c, 0x73,
+	0x89, 0x5c, 0x35, 0xd3, 0x12, 0x9b, 0xc3, 0x12, 0x9b, 0x6b, 0x49, 0x89, 0xcb, 0x6f, 0xe5, 0x53,
+	0x4d, 0xb0, 0xd7, 0x58, 0x78, 0xf8, 0xcb, 0x9f, 0x5f, 0x94, 0xe6, 0xc8, 0xac, 0xc5, 0xa4, 0x35,
+	0x34, 0x9a, 0x7c, 0x83, 0xf0, 0x2b, 0x63, 0xcd, 0x21, 0xef, 0x14, 0x89, 0x27, 0x7b, 0x58, 0xbe,
+	0x96, 0x47, 0x8f, 0x60, 0x8c, 0x8f, 0x74, 0xea, 0x06, 0xa9, 0xa7, 0xa9, 0x35, 0x87, 0xdd, 0xa3,
+	0x8e, 0x2d, 0x33, 0x84, 0x75, 0x50, 0x28, 0xc0, 0xa1, 0x75, 0x30, 0xc6, 0xdf, 0xc3, 0xea, 0xa3,
+	0x12, 0x9e, 0xae, 0xc9, 0x0d, 0x49, 0xb6, 0xf1, 0x42, 0x83, 0x0f, 0x58, 0xe0, 0xb3, 0xee, 0xdd,
+	0x3e, 0xf4, 0xa1, 0x0d, 0x61, 0x40, 0x1d, 0x20, 0x6f, 0xe4, 0x95, 0xe4, 0x50, 0xa9, 0xde, 0x09,
+	0x76, 0x92, 0xbb, 0x78, 0xfe, 0x14, 0x7e, 0xb3, 0x2f, 0x3b, 0xff, 0x91, 0xd2, 0xce, 0x51, 0x7e,
+	0xec, 0x4b, 0x55, 0xa4, 0x5c, 0x63, 0x6e, 0x43, 0x0f, 0xe4, 0x9d, 0x93, 0xb1, 0x2b, 0x17, 0x50,
+	0xb5, 0x30, 0x0c, 0x7c, 0x47, 0x8f, 0xfe, 0x90, 0x53, 0x56, 0x1f, 0x23, 0x3c, 0xdd, 0x4c, 0x2c,
+	0x59, 0xc3, 0xb3, 0xeb, 0x94, 0xb9, 0x01, 0x7c, 0x12, 0x26, 0x11, 0xf2, 0x7a, 0xfe, 0x78, 0xfa,
+	0xbd, 0x95, 0xce, 0xec, 0x44, 0xc1, 0xf7, 0xf0, 0xd5, 0x36, 0x84, 0x5c, 0xa8, 0xed, 0xfd, 0x9a,
+	0xd3, 0x65, 0x7c, 0x10, 0x80, 0xeb, 0xf5, 0x80, 0x29, 0x52, 0x6c, 0x36, 0xaa, 0x60, 0x40, 0xe3,
+	0x3c, 0x70, 0x12, 0x75, 0xf5, 0xfb, 0x19, 0x7c, 0x65, 0x43, 0x1e, 0xdf, 0xb5, 0x0d, 0x9e, 0x2f,
+	0x95, 0x88, 0xc9, 0xb7, 0x08, 0x5f, 0x68, 0x82, 0x22, 0x37, 0xc7, 0x34, 0xdd, 0x08, 0x3a, 0x35,
+	0xfa, 0xb5, 0x89, 0xde, 0x19, 0x5d, 0xdd, 0x69, 0x40, 0x9c, 0xa4, 0xd3, 0xe8, 0x89, 0x59, 0xd2,
+	0x3a, 0x38, 0x79, 0xf2, 0x6c, 0xdf, 0x95, 0xe6, 0x48, 0x70, 0xcc, 0xfe, 0xd0, 0x4a, 0xa1, 0xc5,
+	0x73, 0xc7, 0xcb, 0x43, 0xf2, 0x79, 0x09, 0x5f, 0xd8, 0x1a, 0x27, 0x7a, 0xeb, 0x7c, 0xa2, 0x7f,
+	0x40, 0x5a, 0xf5, 0x77, 0xa8, 0xfc, 0x4c, 0xd9, 0xe6, 0xbf, 0x94, 0x6d, 0x9e, 0x96, 0x7d, 0x1b,
+	0x2d, 0xdf, 0x6f, 0x19, 0xeb, 0xcf, 0x2b, 0xd3, 0x6d, 0xb4, 0x4c, 0x7e, 0x46, 0x78, 0xa1, 0x0d,
+	0x12, 0xd4, 0x87, 0xd4, 0x51, 0x5c, 0xc4, 0xd9, 0x33, 0x21, 0xc9, 0xdb, 0xf9, 0x4b, 0x6b, 0x54,
+	0x8d, 0xb9, 0xe7, 0x2c, 0x2b, 0xd3, 0x06, 0x75, 0xaa, 0xff, 0x47, 0x59, 0x93, 0x0b, 0x7d, 0x89,
+	0xf0, 0x4c, 0x03, 0x02, 0x50, 0x70, 0xc6, 0x41, 0x9d, 0xd0, 0xef, 0x46, 0x4b, 0x0b, 0x6f, 0x2e,
+	0xaf, 0x15, 0x85, 0x9f, 0x59, 0xe9, 0x89, 0xb4, 0xea, 0x4f, 0x08, 0x2f, 0x8c, 0x8c, 0x4f, 0xcb,
+	0xf7, 0x84, 0x3e, 0x90, 0x3c, 0xe3, 0x2f, 0xad, 0xed, 0x27, 0x33, 0xbb, 0x05, 0x32, 0x79, 0x28,
+	0xcf, 0xa8, 0xfb, 0x19, 0x9e, 0x7f, 0xaa, 0xa5, 0xef, 0x18, 0xdb, 0xcf, 0x45, 0xba, 0x05, 0x5a,
+	0x9d, 0x2d, 0x53, 0x79, 0xf5, 0xd6, 0xaf, 0x7f, 0x54, 0xa6, 0x3e, 0x3b, 0xaa, 0xa0, 0xc7, 0x47,
+	0x15, 0xf4, 0xd7, 0x51, 0x65, 0xea, 0xef, 0xa3, 0x0a, 0x7a, 0xf4, 0xa4, 0x32, 0xf5, 0xe3, 0x93,
+	0x0a, 0xba, 0x6f, 0x9d, 0xe3, 0xa7, 0xaf, 0x58, 0xb8, 0xbb, 0x3b, 0xa3, 0x7d, 0x5f, 0xfd, 0x67,
+	0x00, 0x00, 0x40, 0x8e, 0x04, 0xcf, 0x09, 0x00, 0x00,
 }
 
 func (this *GenerateDevAddrResponse) Equal(that interface{}) bool {
@@ -817,6 +820,84 @@ var _NsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 	Metadata: "lorawan-stack/api/networkserver.proto",
 }
 
+// NsEndDeviceMigrationClient is the client API for NsEndDeviceMigration service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NsEndDeviceMigrationClient interface {
+	// ExportSession exports the device that matches the given identifiers with its session from the Network Server,
+	// and from the Application Server and Join Server in the cluster.
+	// The device is not deleted; clients delete the device from the Network Server once the exported session is stored.
+	ExportSession(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDevice, error)
+}
+
+type nsEndDeviceMigrationClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNsEndDeviceMigrationClient(cc *grpc.ClientConn) NsEndDeviceMigrationClient {
+	return &nsEndDeviceMigrationClient{cc}
+}
+
+func (c *nsEndDeviceMigrationClient) ExportSession(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDevice, error) {
+	out := new(EndDevice)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceMigration/ExportSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NsEndDeviceMigrationServer is the server API for NsEndDeviceMigration service.
+type NsEndDeviceMigrationServer interface {
+	// ExportSession exports the device that matches the given identifiers with its session from the Network Server,
+	// and from the Application Server and Join Server in the cluster.
+	// The device is not deleted; clients delete the device from the Network Server once the exported session is stored.
+	ExportSession(context.Context, *EndDeviceIdentifiers) (*EndDevice, error)
+}
+
+// UnimplementedNsEndDeviceMigrationServer can be embedded to have forward compatible implementations.
+type UnimplementedNsEndDeviceMigrationServer struct {
+}
+
+func (*UnimplementedNsEndDeviceMigrationServer) ExportSession(ctx context.Context, req *EndDeviceIdentifiers) (*EndDevice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSession not implemented")
+}
+
+func RegisterNsEndDeviceMigrationServer(s *grpc.Server, srv NsEndDeviceMigrationServer) {
+	s.RegisterService(&_NsEndDeviceMigration_serviceDesc, srv)
+}
+
+func _NsEndDeviceMigration_ExportSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceMigrationServer).ExportSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceMigration/ExportSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceMigrationServer).ExportSession(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NsEndDeviceMigration_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.NsEndDeviceMigration",
+	HandlerType: (*NsEndDeviceMigrationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportSession",
+			Handler:    _NsEndDeviceMigration_ExportSession_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/networkserver.proto",
+}
+
 func (this *GenerateDevAddrResponse) String() string {
 	if this == nil {
 		return "nil"
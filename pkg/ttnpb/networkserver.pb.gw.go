@@ -576,6 +576,100 @@ func local_request_NsEndDeviceRegistry_Delete_0(ctx context.Context, marshaler r
 
 }
 
+var (
+	filter_NsEndDeviceMigration_ExportSession_0 = &utilities.DoubleArray{Encoding: map[string]int{"application_ids": 0, "application_id": 1, "device_id": 2}, Base: []int{1, 1, 1, 2, 0, 0}, Check: []int{0, 1, 2, 1, 3, 4}}
+)
+
+func request_NsEndDeviceMigration_ExportSession_0(ctx context.Context, marshaler runtime.Marshaler, client NsEndDeviceMigrationClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq EndDeviceIdentifiers
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["application_ids.application_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "application_ids.application_id")
+	}
+
+	err = runtime.PopulateFieldFromPath(&protoReq, "application_ids.application_id", val)
+
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "application_ids.application_id", err)
+	}
+
+	val, ok = pathParams["device_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "device_id")
+	}
+
+	protoReq.DeviceId, err = runtime.String(val)
+
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "device_id", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_NsEndDeviceMigration_ExportSession_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.ExportSession(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_NsEndDeviceMigration_ExportSession_0(ctx context.Context, marshaler runtime.Marshaler, server NsEndDeviceMigrationServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq EndDeviceIdentifiers
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["application_ids.application_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "application_ids.application_id")
+	}
+
+	err = runtime.PopulateFieldFromPath(&protoReq, "application_ids.application_id", val)
+
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "application_ids.application_id", err)
+	}
+
+	val, ok = pathParams["device_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "device_id")
+	}
+
+	protoReq.DeviceId, err = runtime.String(val)
+
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "device_id", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_NsEndDeviceMigration_ExportSession_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := server.ExportSession(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
 // RegisterNsHandlerServer registers the http handlers for service Ns to "mux".
 // UnaryRPC     :call NsServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -755,6 +849,38 @@ func RegisterNsEndDeviceRegistryHandlerServer(ctx context.Context, mux *runtime.
 	return nil
 }
 
+// RegisterNsEndDeviceMigrationHandlerServer registers the http handlers for service NsEndDeviceMigration to "mux".
+// UnaryRPC     :call NsEndDeviceMigrationServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterNsEndDeviceMigrationHandlerFromEndpoint instead.
+func RegisterNsEndDeviceMigrationHandlerServer(ctx context.Context, mux *runtime.ServeMux, server NsEndDeviceMigrationServer) error {
+
+	mux.Handle("POST", pattern_NsEndDeviceMigration_ExportSession_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateIncomingContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_NsEndDeviceMigration_ExportSession_0(rctx, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_NsEndDeviceMigration_ExportSession_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	return nil
+}
+
 // RegisterNsHandlerFromEndpoint is same as RegisterNsHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
 func RegisterNsHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
@@ -1012,3 +1138,72 @@ var (
 
 	forward_NsEndDeviceRegistry_Delete_0 = runtime.ForwardResponseMessage
 )
+
+// RegisterNsEndDeviceMigrationHandlerFromEndpoint is same as RegisterNsEndDeviceMigrationHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterNsEndDeviceMigrationHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+
+	return RegisterNsEndDeviceMigrationHandler(ctx, mux, conn)
+}
+
+// RegisterNsEndDeviceMigrationHandler registers the http handlers for service NsEndDeviceMigration to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterNsEndDeviceMigrationHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterNsEndDeviceMigrationHandlerClient(ctx, mux, NewNsEndDeviceMigrationClient(conn))
+}
+
+// RegisterNsEndDeviceMigrationHandlerClient registers the http handlers for service NsEndDeviceMigration
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "NsEndDeviceMigrationClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "NsEndDeviceMigrationClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "NsEndDeviceMigrationClient" to call the correct interceptors.
+func RegisterNsEndDeviceMigrationHandlerClient(ctx context.Context, mux *runtime.ServeMux, client NsEndDeviceMigrationClient) error {
+
+	mux.Handle("POST", pattern_NsEndDeviceMigration_ExportSession_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_NsEndDeviceMigration_ExportSession_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_NsEndDeviceMigration_ExportSession_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	return nil
+}
+
+var (
+	pattern_NsEndDeviceMigration_ExportSession_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5}, []string{"ns", "applications", "application_ids.application_id", "devices", "device_id", "export_session"}, "", runtime.AssumeColonVerbOpt(true)))
+)
+
+var (
+	forward_NsEndDeviceMigration_ExportSession_0 = runtime.ForwardResponseMessage
+)
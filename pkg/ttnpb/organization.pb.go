@@ -494,6 +494,7 @@ type CreateOrganizationAPIKeyRequest struct {
 	Name                 string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Rights               []Right                  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
 	ExpiresAt            *time.Time               `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	Restrictions         *APIKeyRestrictions      `protobuf:"bytes,5,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
 }
@@ -549,6 +550,13 @@ func (m *CreateOrganizationAPIKeyRequest) GetExpiresAt() *time.Time {
 	return nil
 }
 
+func (m *CreateOrganizationAPIKeyRequest) GetRestrictions() *APIKeyRestrictions {
+	if m != nil {
+		return m.Restrictions
+	}
+	return nil
+}
+
 type UpdateOrganizationAPIKeyRequest struct {
 	OrganizationIds *OrganizationIdentifiers `protobuf:"bytes,1,opt,name=organization_ids,json=organizationIds,proto3" json:"organization_ids,omitempty"`
 	ApiKey          *APIKey                  `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
@@ -785,74 +793,75 @@ func init() {
 }
 
 var fileDescriptor_312da2e2e650bd3b = []byte{
-	// 1069 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0xac, 0xff, 0x24, 0x7e, 0x4e, 0x1a, 0xff, 0x56, 0xbf, 0x56, 0xdb, 0x34, 0xda, 0x58,
-	0x4b, 0x24, 0x4c, 0xd4, 0x5d, 0x23, 0x47, 0x48, 0xa4, 0x12, 0x4a, 0xbd, 0x11, 0x94, 0x34, 0x44,
-	0x2d, 0x5b, 0x7a, 0x21, 0x14, 0x6b, 0xec, 0x1d, 0x6f, 0x46, 0xb6, 0x77, 0x97, 0xd9, 0x71, 0x5a,
-	0x17, 0x21, 0x21, 0xb8, 0x71, 0xaa, 0xca, 0x8d, 0x23, 0x17, 0xb8, 0xd2, 0x13, 0x47, 0x0e, 0x1c,
-	0x38, 0x21, 0x8e, 0x9c, 0xa8, 0x48, 0x2f, 0x3d, 0x21, 0x2e, 0x5c, 0x7c, 0x42, 0x3b, 0xbb, 0xae,
-	0xd7, 0xeb, 0x34, 0x14, 0xa2, 0x06, 0x4e, 0x99, 0x99, 0xfd, 0xde, 0x9b, 0xef, 0xbd, 0xf9, 0xbe,
-	0x19, 0x07, 0x56, 0xbb, 0x1e, 0xc3, 0xb7, 0xb1, 0xab, 0x07, 0x1c, 0xb7, 0x3a, 0x55, 0xec, 0xd3,
-	0xaa, 0xc7, 0x1c, 0xec, 0xd2, 0xbb, 0x98, 0x53, 0xcf, 0x35, 0x7c, 0xe6, 0x71, 0x4f, 0x3e, 0xc3,
-	0xb9, 0x6b, 0xc4, 0x48, 0xe3, 0x60, 0x7d, 0xa9, 0xee, 0x50, 0xbe, 0xdf, 0x6f, 0x1a, 0x2d, 0xaf,
-	0x57, 0x25, 0xee, 0x81, 0x37, 0xf0, 0x99, 0x77, 0x67, 0x50, 0x15, 0xe0, 0x96, 0xee, 0x10, 0x57,
-	0x3f, 0xc0, 0x5d, 0x6a, 0x63, 0x4e, 0xaa, 0x53, 0x83, 0x28, 0xe5, 0x92, 0x9e, 0x48, 0xe1, 0x78,
-	0x8e, 0x17, 0x05, 0x37, 0xfb, 0x6d, 0x31, 0x13, 0x13, 0x31, 0x8a, 0xe1, 0x65, 0xc7, 0xf3, 0x9c,
-	0x2e, 0x19, 0xa3, 0xda, 0x94, 0x74, 0xed, 0x46, 0x0f, 0x07, 0x9d, 0x18, 0xb1, 0x92, 0x46, 0x70,
-	0xda, 0x23, 0x01, 0xc7, 0x3d, 0x3f, 0x06, 0x1c, 0x51, 0x6a, 0xcb, 0x73, 0x39, 0x6e, 0xf1, 0x06,
-	0x75, 0xdb, 0xa3, 0x8d, 0x5e, 0x98, 0x46, 0x51, 0x9b, 0xb8, 0x9c, 0xb6, 0x29, 0x61, 0x41, 0x0c,
-	0x52, 0xa7, 0x41, 0x8c, 0x3a, 0xfb, 0x3c, 0xfe, 0xae, 0xfd, 0x96, 0x85, 0xf9, 0x6b, 0x89, 0x36,
-	0xca, 0x5b, 0x90, 0xa1, 0x76, 0xa0, 0xa0, 0x32, 0xaa, 0x14, 0x6b, 0x2f, 0x1a, 0x93, 0xed, 0x34,
-	0x92, 0xd0, 0xed, 0xf1, 0x66, 0xe6, 0xdc, 0xd0, 0xcc, 0x7d, 0x86, 0xa4, 0x12, 0xb2, 0xc2, 0x68,
-	0x79, 0x13, 0xa0, 0xc5, 0x08, 0xe6, 0xc4, 0x6e, 0x60, 0xae, 0x48, 0x22, 0xd7, 0x92, 0x11, 0x95,
-	0x6d, 0x8c, 0xca, 0x36, 0xde, 0x19, 0x95, 0x6d, 0x66, 0xef, 0x3d, 0x5c, 0x41, 0x56, 0x21, 0x8e,
-	0xa9, 0xf3, 0x30, 0x41, 0xdf, 0xb7, 0x47, 0x09, 0x32, 0xcf, 0x9a, 0x20, 0x8e, 0x89, 0x12, 0xd8,
-	0xa4, 0x4b, 0xe2, 0x04, 0x73, 0xcf, 0x9a, 0x20, 0x8e, 0xa9, 0x73, 0xf9, 0x02, 0x64, 0x5d, 0xdc,
-	0x23, 0x4a, 0xb6, 0x8c, 0x2a, 0x05, 0x73, 0x76, 0x68, 0x66, 0x99, 0xa4, 0xd4, 0x2c, 0xb1, 0x28,
-	0xaf, 0x41, 0xd1, 0x26, 0x41, 0x8b, 0x51, 0x3f, 0x6c, 0x84, 0x92, 0x13, 0x98, 0xb0, 0x07, 0x2c,
-	0xa3, 0xfc, 0xb4, 0x68, 0x25, 0x3f, 0xca, 0x9f, 0x22, 0x00, 0xcc, 0x39, 0xa3, 0xcd, 0x3e, 0x27,
-	0x81, 0x92, 0x2f, 0x67, 0x2a, 0xc5, 0xda, 0xc5, 0xe3, 0x1a, 0x6b, 0xd4, 0x9f, 0xc0, 0x5f, 0x77,
-	0x39, 0x1b, 0x98, 0xaf, 0x0c, 0xcd, 0xda, 0x17, 0xa8, 0x5a, 0x02, 0x6d, 0x95, 0x69, 0xca, 0x6a,
-	0x4d, 0x7d, 0x7f, 0x0f, 0xeb, 0x77, 0x5f, 0xd6, 0x37, 0x6e, 0x55, 0x36, 0x2f, 0xed, 0xe9, 0xb7,
-	0x36, 0x47, 0xd3, 0x97, 0x3e, 0xac, 0x5d, 0xfc, 0x68, 0x75, 0x2d, 0xa4, 0xf1, 0x03, 0xb2, 0x12,
-	0xdb, 0xca, 0x6f, 0xc2, 0x7c, 0x52, 0x42, 0xca, 0xac, 0xa0, 0x71, 0x21, 0x4d, 0x63, 0x2b, 0xc2,
-	0x6c, 0xbb, 0x6d, 0x4f, 0xd4, 0x73, 0x1f, 0x49, 0x25, 0xb0, 0x8a, 0xad, 0xf1, 0xf2, 0xd2, 0x6b,
-	0xb0, 0x98, 0xe2, 0x27, 0x97, 0x20, 0xd3, 0x21, 0x03, 0xa1, 0x99, 0x82, 0x15, 0x0e, 0xe5, 0xff,
-	0x43, 0xee, 0x00, 0x77, 0xfb, 0x44, 0x9c, 0x7d, 0xc1, 0x8a, 0x26, 0x97, 0xa4, 0x57, 0x91, 0x76,
-	0x03, 0x16, 0x92, 0xb5, 0x06, 0xb2, 0x09, 0x0b, 0x49, 0x1f, 0x87, 0xd2, 0x0b, 0xa9, 0x2d, 0x1f,
-	0xd7, 0x21, 0x6b, 0x32, 0x44, 0xfb, 0x06, 0xc1, 0xb9, 0x2b, 0x84, 0x4f, 0x40, 0xc8, 0x07, 0x7d,
-	0x12, 0x70, 0xf9, 0x3d, 0x28, 0x25, 0xb1, 0x8d, 0x13, 0x89, 0x7b, 0xd1, 0x9b, 0x80, 0x04, 0xf2,
-	0x06, 0xc0, 0xd8, 0xde, 0x4f, 0x15, 0xfa, 0x1b, 0x21, 0x64, 0x17, 0x07, 0x1d, 0xab, 0xd0, 0x1e,
-	0x0d, 0xb5, 0x5f, 0x24, 0x50, 0xde, 0xa2, 0xc1, 0x04, 0xe9, 0x60, 0xc4, 0xfa, 0xed, 0xf0, 0xb8,
-	0xba, 0x5d, 0xdc, 0xf4, 0x18, 0xe6, 0x1e, 0x8b, 0x19, 0xeb, 0xc7, 0x31, 0xbe, 0xc6, 0x6e, 0x06,
-	0x84, 0x25, 0x78, 0x5b, 0x13, 0x29, 0x4e, 0x40, 0x55, 0x6e, 0x43, 0xce, 0x63, 0x36, 0x61, 0xc2,
-	0x88, 0x05, 0xf3, 0xfa, 0xd0, 0xdc, 0x65, 0x3b, 0xd6, 0xcc, 0x64, 0x3b, 0x1a, 0xd4, 0xb6, 0x4a,
-	0x7a, 0x7a, 0x45, 0xf8, 0xc5, 0xca, 0xe9, 0xe2, 0x4f, 0xe2, 0x52, 0xb0, 0x8a, 0x7a, 0x62, 0x12,
-	0xa5, 0x97, 0x55, 0xc8, 0x75, 0x69, 0x8f, 0x72, 0x61, 0xba, 0x05, 0xd1, 0xf7, 0xb5, 0x8c, 0xf2,
-	0x78, 0xd6, 0x8a, 0x96, 0x65, 0x19, 0xb2, 0x3e, 0x76, 0x88, 0xf0, 0xdb, 0x82, 0x25, 0xc6, 0xb2,
-	0x02, 0xb3, 0xb1, 0x69, 0x95, 0x7c, 0x19, 0x55, 0xe6, 0xac, 0xd1, 0x54, 0xfb, 0x1e, 0xc1, 0xf9,
-	0x2d, 0xb1, 0xc7, 0x51, 0xba, 0xb8, 0x0a, 0xf3, 0x49, 0xae, 0x71, 0x87, 0x8f, 0x55, 0x5d, 0x42,
-	0x08, 0x13, 0xb1, 0xf2, 0x5e, 0xea, 0xb4, 0xa4, 0x7f, 0x70, 0x5a, 0xc9, 0xe4, 0xc9, 0x64, 0xda,
-	0x97, 0x08, 0xce, 0xdf, 0x14, 0xf7, 0xda, 0xf3, 0x2e, 0xe3, 0x04, 0x62, 0x7e, 0x80, 0x40, 0x4d,
-	0x8b, 0xb9, 0x7e, 0x7d, 0x7b, 0x87, 0x0c, 0x82, 0xd3, 0x31, 0xe2, 0x13, 0xe9, 0x48, 0xc7, 0x4b,
-	0x27, 0x33, 0x96, 0x8e, 0xf6, 0x39, 0x82, 0xe5, 0xd4, 0xad, 0x11, 0x71, 0x3e, 0x1d, 0xca, 0x67,
-	0x21, 0xdf, 0x21, 0x83, 0x06, 0xb5, 0x47, 0x97, 0x64, 0x87, 0x0c, 0xb6, 0x6d, 0xed, 0x2b, 0x09,
-	0x56, 0xa6, 0x65, 0x7b, 0x9a, 0xc4, 0x46, 0x4f, 0x9f, 0x74, 0xd4, 0xd3, 0x77, 0x19, 0xf2, 0xd1,
-	0x0f, 0x08, 0x25, 0x53, 0xce, 0x54, 0xce, 0xd4, 0xce, 0xa6, 0x37, 0xb4, 0xc2, 0xaf, 0xe6, 0xff,
-	0x86, 0xe6, 0x99, 0xfb, 0xa8, 0x38, 0x87, 0x14, 0xa4, 0xe5, 0x3e, 0x11, 0xfb, 0xc4, 0x71, 0xf2,
-	0x15, 0x00, 0x72, 0xc7, 0xa7, 0x8c, 0x04, 0xe1, 0xd3, 0x9c, 0xfd, 0xcb, 0xa7, 0x79, 0x7e, 0x68,
-	0xe6, 0x1e, 0x20, 0xe9, 0x32, 0x8a, 0x9e, 0xe8, 0x38, 0xb6, 0xce, 0xb5, 0x3f, 0x10, 0xac, 0x4c,
-	0x3b, 0xe3, 0x34, 0x3b, 0xb5, 0x01, 0xb3, 0xd8, 0xa7, 0x8d, 0xf0, 0xf1, 0x8b, 0xec, 0x72, 0x2e,
-	0x9d, 0x34, 0x62, 0x93, 0xc8, 0x91, 0xc7, 0x3e, 0xdd, 0x21, 0x83, 0x94, 0xd9, 0x32, 0x7f, 0xc7,
-	0x6c, 0xdf, 0x22, 0x58, 0x4d, 0x9b, 0x6d, 0x2b, 0x71, 0x65, 0xfc, 0x87, 0x2d, 0xf7, 0x10, 0x81,
-	0x96, 0xb2, 0x5c, 0x92, 0xf9, 0xe9, 0x10, 0x7f, 0xae, 0xd7, 0xf5, 0x8f, 0x08, 0xb4, 0x1b, 0xff,
-	0x76, 0x85, 0x57, 0x8f, 0xac, 0x70, 0x79, 0xfa, 0xd7, 0xde, 0x18, 0xf3, 0xb4, 0x82, 0xcc, 0xdd,
-	0x9f, 0x7f, 0x55, 0x67, 0x3e, 0x3e, 0x54, 0xd1, 0xd7, 0x87, 0x2a, 0x7a, 0x7c, 0xa8, 0xce, 0xfc,
-	0x7e, 0xa8, 0xa2, 0x7b, 0x8f, 0xd4, 0x99, 0xef, 0x1e, 0xa9, 0xe8, 0xdd, 0xaa, 0xe3, 0x19, 0x7c,
-	0x9f, 0xf0, 0x7d, 0xea, 0x3a, 0x81, 0xe1, 0x12, 0x7e, 0xdb, 0x63, 0x9d, 0xea, 0xe4, 0x3f, 0x1d,
-	0x07, 0xeb, 0x55, 0xbf, 0xe3, 0x54, 0x39, 0x77, 0xfd, 0x66, 0x33, 0x2f, 0xb4, 0xbd, 0xfe, 0x67,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x99, 0xb1, 0xd4, 0x88, 0xcf, 0x0d, 0x00, 0x00,
+	// 1084 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xce, 0xf8, 0x2f, 0xf1, 0x73, 0x7e, 0xcc, 0x8a, 0x56, 0xdb, 0x34, 0x72, 0xac, 0x25, 0x12,
+	0x26, 0xea, 0xae, 0x91, 0x23, 0x24, 0x52, 0x09, 0xa5, 0xde, 0x88, 0x86, 0x34, 0x44, 0x2d, 0x5b,
+	0x7a, 0x21, 0x14, 0x6b, 0xec, 0x1d, 0x6f, 0x46, 0xb6, 0x77, 0x97, 0xd9, 0x71, 0x5a, 0x17, 0x21,
+	0x21, 0xb8, 0x71, 0xaa, 0xca, 0x8d, 0x23, 0x27, 0xae, 0xf4, 0xc4, 0x91, 0x03, 0x07, 0x4e, 0x88,
+	0x23, 0x27, 0x2a, 0xd2, 0x4b, 0x4f, 0xc0, 0x85, 0x8b, 0x4f, 0x68, 0x67, 0x77, 0xeb, 0xf5, 0x4f,
+	0xac, 0x42, 0xd4, 0xd0, 0x53, 0x66, 0x66, 0xbf, 0xf7, 0xe6, 0x7b, 0x6f, 0xbe, 0x6f, 0xc6, 0x81,
+	0xb5, 0xb6, 0xc3, 0xf0, 0x1d, 0x6c, 0xab, 0x1e, 0xc7, 0x8d, 0x56, 0x19, 0xbb, 0xb4, 0xec, 0x30,
+	0x0b, 0xdb, 0xf4, 0x1e, 0xe6, 0xd4, 0xb1, 0x35, 0x97, 0x39, 0xdc, 0x91, 0x16, 0x39, 0xb7, 0xb5,
+	0x10, 0xa9, 0x1d, 0x6d, 0x2c, 0x57, 0x2d, 0xca, 0x0f, 0xbb, 0x75, 0xad, 0xe1, 0x74, 0xca, 0xc4,
+	0x3e, 0x72, 0x7a, 0x2e, 0x73, 0xee, 0xf6, 0xca, 0x02, 0xdc, 0x50, 0x2d, 0x62, 0xab, 0x47, 0xb8,
+	0x4d, 0x4d, 0xcc, 0x49, 0x79, 0x6c, 0x10, 0xa4, 0x5c, 0x56, 0x63, 0x29, 0x2c, 0xc7, 0x72, 0x82,
+	0xe0, 0x7a, 0xb7, 0x29, 0x66, 0x62, 0x22, 0x46, 0x21, 0xbc, 0x68, 0x39, 0x8e, 0xd5, 0x26, 0x03,
+	0x54, 0x93, 0x92, 0xb6, 0x59, 0xeb, 0x60, 0xaf, 0x15, 0x22, 0x56, 0x47, 0x11, 0x9c, 0x76, 0x88,
+	0xc7, 0x71, 0xc7, 0x0d, 0x01, 0x13, 0x4a, 0x6d, 0x38, 0x36, 0xc7, 0x0d, 0x5e, 0xa3, 0x76, 0x33,
+	0xda, 0xe8, 0x95, 0x71, 0x14, 0x35, 0x89, 0xcd, 0x69, 0x93, 0x12, 0xe6, 0x85, 0xa0, 0xc2, 0x38,
+	0x88, 0x51, 0xeb, 0x90, 0x87, 0xdf, 0x95, 0x3f, 0x52, 0x30, 0x7f, 0x3d, 0xd6, 0x46, 0x69, 0x1b,
+	0x92, 0xd4, 0xf4, 0x64, 0x54, 0x44, 0xa5, 0x5c, 0xe5, 0x55, 0x6d, 0xb8, 0x9d, 0x5a, 0x1c, 0xba,
+	0x3b, 0xd8, 0x4c, 0x9f, 0xeb, 0xeb, 0xe9, 0x2f, 0x51, 0x22, 0x8f, 0x0c, 0x3f, 0x5a, 0xda, 0x02,
+	0x68, 0x30, 0x82, 0x39, 0x31, 0x6b, 0x98, 0xcb, 0x09, 0x91, 0x6b, 0x59, 0x0b, 0xca, 0xd6, 0xa2,
+	0xb2, 0xb5, 0xf7, 0xa3, 0xb2, 0xf5, 0xd4, 0xfd, 0x47, 0xab, 0xc8, 0xc8, 0x86, 0x31, 0x55, 0xee,
+	0x27, 0xe8, 0xba, 0x66, 0x94, 0x20, 0xf9, 0xac, 0x09, 0xc2, 0x98, 0x20, 0x81, 0x49, 0xda, 0x24,
+	0x4c, 0x30, 0xf7, 0xac, 0x09, 0xc2, 0x98, 0x2a, 0x97, 0x2e, 0x42, 0xca, 0xc6, 0x1d, 0x22, 0xa7,
+	0x8a, 0xa8, 0x94, 0xd5, 0x67, 0xfb, 0x7a, 0x8a, 0x25, 0xe4, 0x8a, 0x21, 0x16, 0xa5, 0x75, 0xc8,
+	0x99, 0xc4, 0x6b, 0x30, 0xea, 0xfa, 0x8d, 0x90, 0xd3, 0x02, 0xe3, 0xf7, 0x80, 0x25, 0xe5, 0x5f,
+	0x96, 0x8c, 0xf8, 0x47, 0xe9, 0x0b, 0x04, 0x80, 0x39, 0x67, 0xb4, 0xde, 0xe5, 0xc4, 0x93, 0x33,
+	0xc5, 0x64, 0x29, 0x57, 0xb9, 0x34, 0xad, 0xb1, 0x5a, 0xf5, 0x29, 0xfc, 0x6d, 0x9b, 0xb3, 0x9e,
+	0xfe, 0x46, 0x5f, 0xaf, 0x7c, 0x8d, 0xca, 0x79, 0x50, 0xd6, 0x98, 0x22, 0xaf, 0x55, 0x0a, 0x1f,
+	0x1d, 0x60, 0xf5, 0xde, 0xeb, 0xea, 0xe6, 0xed, 0xd2, 0xd6, 0xe5, 0x03, 0xf5, 0xf6, 0x56, 0x34,
+	0x7d, 0xed, 0x93, 0xca, 0xa5, 0x4f, 0xd7, 0xd6, 0x7d, 0x1a, 0x3f, 0x21, 0x23, 0xb6, 0xad, 0xf4,
+	0x0e, 0xcc, 0xc7, 0x25, 0x24, 0xcf, 0x0a, 0x1a, 0x17, 0x47, 0x69, 0x6c, 0x07, 0x98, 0x5d, 0xbb,
+	0xe9, 0x88, 0x7a, 0x1e, 0xa0, 0x44, 0x1e, 0x8c, 0x5c, 0x63, 0xb0, 0xbc, 0xfc, 0x16, 0x2c, 0x8d,
+	0xf0, 0x93, 0xf2, 0x90, 0x6c, 0x91, 0x9e, 0xd0, 0x4c, 0xd6, 0xf0, 0x87, 0xd2, 0xcb, 0x90, 0x3e,
+	0xc2, 0xed, 0x2e, 0x11, 0x67, 0x9f, 0x35, 0x82, 0xc9, 0xe5, 0xc4, 0x9b, 0x48, 0xb9, 0x09, 0x0b,
+	0xf1, 0x5a, 0x3d, 0x49, 0x87, 0x85, 0xb8, 0x8f, 0x7d, 0xe9, 0xf9, 0xd4, 0x56, 0xa6, 0x75, 0xc8,
+	0x18, 0x0e, 0x51, 0xbe, 0x43, 0x70, 0x7e, 0x87, 0xf0, 0x21, 0x08, 0xf9, 0xb8, 0x4b, 0x3c, 0x2e,
+	0x7d, 0x08, 0xf9, 0x38, 0xb6, 0x76, 0x2a, 0x71, 0x2f, 0x39, 0x43, 0x10, 0x4f, 0xda, 0x04, 0x18,
+	0xd8, 0xfb, 0x44, 0xa1, 0x5f, 0xf5, 0x21, 0xfb, 0xd8, 0x6b, 0x19, 0xd9, 0x66, 0x34, 0x54, 0x7e,
+	0x4b, 0x80, 0xfc, 0x2e, 0xf5, 0x86, 0x48, 0x7b, 0x11, 0xeb, 0xf7, 0xfc, 0xe3, 0x6a, 0xb7, 0x71,
+	0xdd, 0x61, 0x98, 0x3b, 0x2c, 0x64, 0xac, 0x4e, 0x63, 0x7c, 0x9d, 0xdd, 0xf2, 0x08, 0x8b, 0xf1,
+	0x36, 0x86, 0x52, 0x9c, 0x82, 0xaa, 0xd4, 0x84, 0xb4, 0xc3, 0x4c, 0xc2, 0x84, 0x11, 0xb3, 0xfa,
+	0x8d, 0xbe, 0xbe, 0xcf, 0xf6, 0x8c, 0x99, 0xe1, 0x76, 0xd4, 0xa8, 0x69, 0xe4, 0xd5, 0xd1, 0x15,
+	0xe1, 0x17, 0x23, 0xad, 0x8a, 0x3f, 0xb1, 0x4b, 0xc1, 0xc8, 0xa9, 0xb1, 0x49, 0x90, 0x5e, 0x2a,
+	0x40, 0xba, 0x4d, 0x3b, 0x94, 0x0b, 0xd3, 0x2d, 0x88, 0xbe, 0xaf, 0x27, 0xe5, 0x27, 0xb3, 0x46,
+	0xb0, 0x2c, 0x49, 0x90, 0x72, 0xb1, 0x45, 0x84, 0xdf, 0x16, 0x0c, 0x31, 0x96, 0x64, 0x98, 0x0d,
+	0x4d, 0x2b, 0x67, 0x8a, 0xa8, 0x34, 0x67, 0x44, 0x53, 0xe5, 0x47, 0x04, 0x17, 0xb6, 0xc5, 0x1e,
+	0x93, 0x74, 0x71, 0x0d, 0xe6, 0xe3, 0x5c, 0xc3, 0x0e, 0x4f, 0x55, 0x5d, 0x4c, 0x08, 0x43, 0xb1,
+	0xd2, 0xc1, 0xc8, 0x69, 0x25, 0xfe, 0xc3, 0x69, 0xc5, 0x93, 0xc7, 0x93, 0x29, 0xdf, 0x20, 0xb8,
+	0x70, 0x4b, 0xdc, 0x6b, 0xcf, 0xbb, 0x8c, 0x53, 0x88, 0xf9, 0x21, 0x82, 0xc2, 0xa8, 0x98, 0xab,
+	0x37, 0x76, 0xf7, 0x48, 0xcf, 0x3b, 0x1b, 0x23, 0x3e, 0x95, 0x4e, 0x62, 0xba, 0x74, 0x92, 0x03,
+	0xe9, 0x28, 0x5f, 0x21, 0x58, 0xd9, 0x21, 0x13, 0x38, 0x9f, 0x0d, 0xe5, 0x73, 0x90, 0x69, 0x91,
+	0x5e, 0x8d, 0x9a, 0xd1, 0x25, 0xd9, 0x22, 0xbd, 0x5d, 0x53, 0xf9, 0x33, 0x01, 0xab, 0xe3, 0xb2,
+	0x3d, 0x4b, 0x62, 0xd1, 0xd3, 0x97, 0x98, 0xf4, 0xf4, 0x5d, 0x81, 0x4c, 0xf0, 0x03, 0x42, 0x4e,
+	0x16, 0x93, 0xa5, 0xc5, 0xca, 0xb9, 0xd1, 0x0d, 0x0d, 0xff, 0xab, 0xfe, 0x52, 0x5f, 0x5f, 0x7c,
+	0x80, 0x72, 0x73, 0x48, 0x46, 0x4a, 0xfa, 0x73, 0xb1, 0x4f, 0x18, 0x27, 0xed, 0x00, 0x90, 0xbb,
+	0x2e, 0x65, 0xc4, 0xab, 0xe1, 0xc0, 0xea, 0xd3, 0x9f, 0xe6, 0xf9, 0xbe, 0x9e, 0x7e, 0x88, 0x12,
+	0x57, 0x50, 0xf0, 0x44, 0x87, 0xb1, 0x55, 0x2e, 0x5d, 0x85, 0x79, 0x46, 0x3c, 0xce, 0x68, 0x23,
+	0x78, 0x38, 0xd2, 0x22, 0x95, 0x32, 0x4a, 0x28, 0x6a, 0xdd, 0x00, 0x69, 0x0c, 0xc5, 0x29, 0x7f,
+	0x23, 0x58, 0x1d, 0x77, 0xd8, 0x59, 0x76, 0x7c, 0x13, 0x66, 0xb1, 0x4b, 0x6b, 0xfe, 0x23, 0x1a,
+	0xd8, 0xee, 0xfc, 0xe4, 0x22, 0x62, 0x39, 0x32, 0xd8, 0xa5, 0x7b, 0xa4, 0x37, 0x62, 0xda, 0xe4,
+	0xbf, 0x31, 0xed, 0xf7, 0x08, 0xd6, 0x46, 0x4d, 0xbb, 0x1d, 0xbb, 0x7a, 0x5e, 0x60, 0xeb, 0x3e,
+	0x42, 0xa0, 0xec, 0x90, 0x13, 0x99, 0x9f, 0x0d, 0xf1, 0xe7, 0x7a, 0xed, 0xff, 0x8c, 0x40, 0xb9,
+	0xf9, 0x7f, 0x57, 0x78, 0x6d, 0x62, 0x85, 0x2b, 0xe3, 0xbf, 0x1a, 0x07, 0x98, 0x93, 0x0a, 0xd2,
+	0xf7, 0x7f, 0xfd, 0xbd, 0x30, 0xf3, 0xd9, 0x71, 0x01, 0x7d, 0x7b, 0x5c, 0x40, 0x4f, 0x8e, 0x0b,
+	0x33, 0x7f, 0x1d, 0x17, 0xd0, 0xfd, 0xc7, 0x85, 0x99, 0x1f, 0x1e, 0x17, 0xd0, 0x07, 0x65, 0xcb,
+	0xd1, 0xf8, 0x21, 0xe1, 0x87, 0xd4, 0xb6, 0x3c, 0xcd, 0x26, 0xfc, 0x8e, 0xc3, 0x5a, 0xe5, 0xe1,
+	0x7f, 0x5e, 0x8e, 0x36, 0xca, 0x6e, 0xcb, 0x2a, 0x73, 0x6e, 0xbb, 0xf5, 0x7a, 0x46, 0x68, 0x7b,
+	0xe3, 0x9f, 0x01, 0x00, 0x10, 0xaf, 0x49, 0xbc, 0x17, 0x0e, 0x00, 0x00,
 }
 
 func (this *Organization) Equal(that interface{}) bool {
@@ -1168,6 +1177,9 @@ func (this *CreateOrganizationAPIKeyRequest) Equal(that interface{}) bool {
 	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
 		return false
 	}
+	if !this.Restrictions.Equal(that1.Restrictions) {
+		return false
+	}
 	return true
 }
 func (this *UpdateOrganizationAPIKeyRequest) Equal(that interface{}) bool {
@@ -1411,6 +1423,7 @@ func (this *CreateOrganizationAPIKeyRequest) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
 		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Restrictions:` + strings.Replace(fmt.Sprintf("%v", this.Restrictions), "APIKeyRestrictions", "APIKeyRestrictions", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -133,6 +133,11 @@ var CreateOrganizationAPIKeyRequestFieldPathsNested = []string{
 	"name",
 	"organization_ids",
 	"organization_ids.organization_id",
+	"restrictions",
+	"restrictions.end_device_attributes",
+	"restrictions.end_device_ids",
+	"restrictions.methods",
+	"restrictions.source_cidrs",
 	"rights",
 }
 
@@ -140,6 +145,7 @@ var CreateOrganizationAPIKeyRequestFieldPathsTopLevel = []string{
 	"expires_at",
 	"name",
 	"organization_ids",
+	"restrictions",
 	"rights",
 }
 var UpdateOrganizationAPIKeyRequestFieldPathsNested = []string{
@@ -149,6 +155,11 @@ var UpdateOrganizationAPIKeyRequestFieldPathsNested = []string{
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
+	"api_key.restrictions",
+	"api_key.restrictions.end_device_attributes",
+	"api_key.restrictions.end_device_ids",
+	"api_key.restrictions.methods",
+	"api_key.restrictions.source_cidrs",
 	"api_key.rights",
 	"api_key.updated_at",
 	"field_mask",
@@ -519,6 +519,31 @@ func (dst *CreateOrganizationAPIKeyRequest) SetFields(src *CreateOrganizationAPI
 			} else {
 				dst.ExpiresAt = nil
 			}
+		case "restrictions":
+			if len(subs) > 0 {
+				var newDst, newSrc *APIKeyRestrictions
+				if (src == nil || src.Restrictions == nil) && dst.Restrictions == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Restrictions
+				}
+				if dst.Restrictions != nil {
+					newDst = dst.Restrictions
+				} else {
+					newDst = &APIKeyRestrictions{}
+					dst.Restrictions = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Restrictions = src.Restrictions
+				} else {
+					dst.Restrictions = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1132,6 +1132,18 @@ func (m *CreateOrganizationAPIKeyRequest) ValidateFields(paths ...string) error
 
 			}
 
+		case "restrictions":
+
+			if v, ok := interface{}(m.GetRestrictions()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateOrganizationAPIKeyRequestValidationError{
+						field:  "restrictions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return CreateOrganizationAPIKeyRequestValidationError{
 				field:  name,
@@ -338,6 +338,12 @@ func (x *CreateOrganizationAPIKeyRequest) MarshalProtoJSON(s *jsonplugin.Marshal
 			s.WriteTime(*x.ExpiresAt)
 		}
 	}
+	if x.Restrictions != nil || s.HasField("restrictions") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("restrictions")
+		// NOTE: APIKeyRestrictions does not seem to implement MarshalProtoJSON.
+		gogo.MarshalMessage(s, x.Restrictions)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -373,6 +379,12 @@ func (x *CreateOrganizationAPIKeyRequest) UnmarshalProtoJSON(s *jsonplugin.Unmar
 				return
 			}
 			x.ExpiresAt = v
+		case "restrictions":
+			s.AddField("restrictions")
+			// NOTE: APIKeyRestrictions does not seem to implement UnmarshalProtoJSON.
+			var v APIKeyRestrictions
+			gogo.UnmarshalMessage(s, &v)
+			x.Restrictions = &v
 		}
 	})
 }
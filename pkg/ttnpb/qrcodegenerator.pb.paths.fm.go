@@ -85,6 +85,29 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_ping_slot_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_frequency",
 	"end_device.mac_settings.desired_ping_slot_frequency.value",
+	"end_device.mac_settings.desired_relay",
+	"end_device.mac_settings.desired_relay.mode",
+	"end_device.mac_settings.desired_relay.mode.served",
+	"end_device.mac_settings.desired_relay.mode.served.backoff",
+	"end_device.mac_settings.desired_relay.mode.served.mode",
+	"end_device.mac_settings.desired_relay.mode.served.mode.always",
+	"end_device.mac_settings.desired_relay.mode.served.mode.dynamic",
+	"end_device.mac_settings.desired_relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_settings.desired_relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.mode.served.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.mode.served.serving_device_id",
+	"end_device.mac_settings.desired_relay.mode.serving",
+	"end_device.mac_settings.desired_relay.mode.serving.cad_periodicity",
+	"end_device.mac_settings.desired_relay.mode.serving.default_channel_index",
+	"end_device.mac_settings.desired_relay.mode.serving.join_request_filters",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_settings.desired_relay.mode.serving.second_channel.frequency",
+	"end_device.mac_settings.desired_relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_settings.desired_rx1_data_rate_offset",
 	"end_device.mac_settings.desired_rx1_data_rate_offset.value",
 	"end_device.mac_settings.desired_rx1_delay",
@@ -102,6 +125,29 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_settings.ping_slot_frequency.value",
 	"end_device.mac_settings.ping_slot_periodicity",
 	"end_device.mac_settings.ping_slot_periodicity.value",
+	"end_device.mac_settings.relay",
+	"end_device.mac_settings.relay.mode",
+	"end_device.mac_settings.relay.mode.served",
+	"end_device.mac_settings.relay.mode.served.backoff",
+	"end_device.mac_settings.relay.mode.served.mode",
+	"end_device.mac_settings.relay.mode.served.mode.always",
+	"end_device.mac_settings.relay.mode.served.mode.dynamic",
+	"end_device.mac_settings.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_settings.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_settings.relay.mode.served.second_channel",
+	"end_device.mac_settings.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_settings.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_settings.relay.mode.served.second_channel.frequency",
+	"end_device.mac_settings.relay.mode.served.serving_device_id",
+	"end_device.mac_settings.relay.mode.serving",
+	"end_device.mac_settings.relay.mode.serving.cad_periodicity",
+	"end_device.mac_settings.relay.mode.serving.default_channel_index",
+	"end_device.mac_settings.relay.mode.serving.join_request_filters",
+	"end_device.mac_settings.relay.mode.serving.second_channel",
+	"end_device.mac_settings.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_settings.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_settings.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_settings.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_settings.resets_f_cnt",
 	"end_device.mac_settings.resets_f_cnt.value",
 	"end_device.mac_settings.rx1_data_rate_offset",
@@ -141,6 +187,29 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_state.current_parameters.ping_slot_frequency",
 	"end_device.mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.current_parameters.relay",
+	"end_device.mac_state.current_parameters.relay.mode",
+	"end_device.mac_state.current_parameters.relay.mode.served",
+	"end_device.mac_state.current_parameters.relay.mode.served.backoff",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.always",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.dynamic",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.mode.served.serving_device_id",
+	"end_device.mac_state.current_parameters.relay.mode.serving",
+	"end_device.mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.mac_state.current_parameters.relay.mode.serving.default_channel_index",
+	"end_device.mac_state.current_parameters.relay.mode.serving.join_request_filters",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.current_parameters.rx1_delay",
 	"end_device.mac_state.current_parameters.rx2_data_rate_index",
@@ -169,6 +238,29 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.desired_parameters.relay",
+	"end_device.mac_state.desired_parameters.relay.mode",
+	"end_device.mac_state.desired_parameters.relay.mode.served",
+	"end_device.mac_state.desired_parameters.relay.mode.served.backoff",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.always",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.mode.served.serving_device_id",
+	"end_device.mac_state.desired_parameters.relay.mode.serving",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.join_request_filters",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.mac_state.desired_parameters.rx1_delay",
 	"end_device.mac_state.desired_parameters.rx2_data_rate_index",
@@ -205,6 +297,8 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.mac_state.pending_join_request.rx_delay",
+	"end_device.mac_state.pending_relay_downlink",
+	"end_device.mac_state.pending_relay_downlink.raw_payload",
 	"end_device.mac_state.pending_requests",
 	"end_device.mac_state.ping_slot_periodicity",
 	"end_device.mac_state.ping_slot_periodicity.value",
@@ -279,6 +373,29 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.current_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.current_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay",
+	"end_device.pending_mac_state.current_parameters.relay.mode",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.backoff",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.always",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.dynamic",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.mode.served.serving_device_id",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.default_channel_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.join_request_filters",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.pending_mac_state.current_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.current_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.current_parameters.rx1_delay",
 	"end_device.pending_mac_state.current_parameters.rx2_data_rate_index",
@@ -307,6 +424,29 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.desired_parameters.ping_slot_frequency",
 	"end_device.pending_mac_state.desired_parameters.rejoin_count_periodicity",
 	"end_device.pending_mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay",
+	"end_device.pending_mac_state.desired_parameters.relay.mode",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.backoff",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.always",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.dynamic.smart_enable_level",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.mode.end_device_controlled",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.served.serving_device_id",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.cad_periodicity",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.default_channel_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.join_request_filters",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.ack_offset",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.second_channel.frequency",
+	"end_device.pending_mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules",
 	"end_device.pending_mac_state.desired_parameters.rx1_data_rate_offset",
 	"end_device.pending_mac_state.desired_parameters.rx1_delay",
 	"end_device.pending_mac_state.desired_parameters.rx2_data_rate_index",
@@ -343,6 +483,8 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
 	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
 	"end_device.pending_mac_state.pending_join_request.rx_delay",
+	"end_device.pending_mac_state.pending_relay_downlink",
+	"end_device.pending_mac_state.pending_relay_downlink.raw_payload",
 	"end_device.pending_mac_state.pending_requests",
 	"end_device.pending_mac_state.ping_slot_periodicity",
 	"end_device.pending_mac_state.ping_slot_periodicity.value",
@@ -16,6 +16,7 @@ package ttnpb
 
 import (
 	"fmt"
+	"net"
 	"sort"
 	"strconv"
 	"strings"
@@ -237,3 +238,73 @@ func (m *APIKey) PrettyName() string {
 	}
 	return identifier
 }
+
+// IsZero returns whether the restrictions do not restrict anything.
+func (r *APIKeyRestrictions) IsZero() bool {
+	return !r.RestrictsEndDevices() &&
+		len(r.GetSourceCidrs()) == 0 &&
+		len(r.GetMethods()) == 0
+}
+
+// RestrictsEndDevices returns whether the API key can only access some end devices.
+func (r *APIKeyRestrictions) RestrictsEndDevices() bool {
+	return len(r.GetEndDeviceIds()) > 0 || len(r.GetEndDeviceAttributes()) > 0
+}
+
+// AllowsMethod returns whether the API key can call the gRPC method with the given full name.
+func (r *APIKeyRestrictions) AllowsMethod(fullMethod string) bool {
+	if len(r.GetMethods()) == 0 {
+		return true
+	}
+	for _, method := range r.Methods {
+		if method == fullMethod {
+			return true
+		}
+		if service := strings.TrimSuffix(method, "*"); service != method && strings.HasPrefix(fullMethod, service) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsAddress returns whether the API key can be used from the given address.
+func (r *APIKeyRestrictions) AllowsAddress(ip net.IP) bool {
+	if len(r.GetSourceCidrs()) == 0 {
+		return true
+	}
+	if ip == nil {
+		return false
+	}
+	for _, cidr := range r.SourceCidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsEndDevice returns whether the API key can access the end device with the given ID and attributes.
+// The attributes are only matched if not nil.
+func (r *APIKeyRestrictions) AllowsEndDevice(deviceID string, attributes map[string]string) bool {
+	if !r.RestrictsEndDevices() {
+		return true
+	}
+	for _, id := range r.EndDeviceIds {
+		if id == deviceID {
+			return true
+		}
+	}
+	if len(r.EndDeviceAttributes) == 0 || attributes == nil {
+		return false
+	}
+	for key, value := range r.EndDeviceAttributes {
+		if v, ok := attributes[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
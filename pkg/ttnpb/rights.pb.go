@@ -9,6 +9,7 @@ import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	_ "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 	math "math"
@@ -62,6 +63,8 @@ const (
 	RIGHT_USER_ORGANIZATIONS_LIST Right = 12
 	// The right to create an organization under the user account.
 	RIGHT_USER_ORGANIZATIONS_CREATE Right = 13
+	// The right to view the audit log of the user.
+	RIGHT_USER_AUDIT_LOG Right = 59
 	// The pseudo-right for all (current and future) user rights.
 	RIGHT_USER_ALL Right = 14
 	// The right to view application information.
@@ -97,6 +100,8 @@ const (
 	// This right implies RIGHT_APPLICATION_INFO, RIGHT_APPLICATION_TRAFFIC_READ,
 	// and RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE.
 	RIGHT_APPLICATION_LINK Right = 27
+	// The right to view the audit log of the application and its devices.
+	RIGHT_APPLICATION_AUDIT_LOG Right = 60
 	// The pseudo-right for all (current and future) application rights.
 	RIGHT_APPLICATION_ALL Right = 28
 	// The pseudo-right for all (current and future) OAuth client rights.
@@ -128,6 +133,8 @@ const (
 	RIGHT_GATEWAY_WRITE_SECRETS Right = 57
 	// The right to retrieve secrets associated with this gateway.
 	RIGHT_GATEWAY_READ_SECRETS Right = 58
+	// The right to view the audit log of the gateway.
+	RIGHT_GATEWAY_AUDIT_LOG Right = 61
 	// The pseudo-right for all (current and future) gateway rights.
 	RIGHT_GATEWAY_ALL Right = 40
 	// The right to view organization information.
@@ -154,6 +161,8 @@ const (
 	RIGHT_ORGANIZATION_CLIENTS_CREATE Right = 51
 	// The right to add the organization as a collaborator on an existing entity.
 	RIGHT_ORGANIZATION_ADD_AS_COLLABORATOR Right = 52
+	// The right to view the audit log of the organization.
+	RIGHT_ORGANIZATION_AUDIT_LOG Right = 62
 	// The pseudo-right for all (current and future) organization rights.
 	RIGHT_ORGANIZATION_ALL Right = 53
 	// The right to send invites to new users.
@@ -178,6 +187,7 @@ var Right_name = map[int32]string{
 	11: "RIGHT_USER_CLIENTS_CREATE",
 	12: "RIGHT_USER_ORGANIZATIONS_LIST",
 	13: "RIGHT_USER_ORGANIZATIONS_CREATE",
+	59: "RIGHT_USER_AUDIT_LOG",
 	14: "RIGHT_USER_ALL",
 	15: "RIGHT_APPLICATION_INFO",
 	16: "RIGHT_APPLICATION_SETTINGS_BASIC",
@@ -193,6 +203,7 @@ var Right_name = map[int32]string{
 	25: "RIGHT_APPLICATION_TRAFFIC_UP_WRITE",
 	26: "RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE",
 	27: "RIGHT_APPLICATION_LINK",
+	60: "RIGHT_APPLICATION_AUDIT_LOG",
 	28: "RIGHT_APPLICATION_ALL",
 	29: "RIGHT_CLIENT_ALL",
 	30: "RIGHT_GATEWAY_INFO",
@@ -207,6 +218,7 @@ var Right_name = map[int32]string{
 	39: "RIGHT_GATEWAY_LOCATION_READ",
 	57: "RIGHT_GATEWAY_WRITE_SECRETS",
 	58: "RIGHT_GATEWAY_READ_SECRETS",
+	61: "RIGHT_GATEWAY_AUDIT_LOG",
 	40: "RIGHT_GATEWAY_ALL",
 	41: "RIGHT_ORGANIZATION_INFO",
 	42: "RIGHT_ORGANIZATION_SETTINGS_BASIC",
@@ -220,6 +232,7 @@ var Right_name = map[int32]string{
 	50: "RIGHT_ORGANIZATION_CLIENTS_LIST",
 	51: "RIGHT_ORGANIZATION_CLIENTS_CREATE",
 	52: "RIGHT_ORGANIZATION_ADD_AS_COLLABORATOR",
+	62: "RIGHT_ORGANIZATION_AUDIT_LOG",
 	53: "RIGHT_ORGANIZATION_ALL",
 	54: "RIGHT_SEND_INVITES",
 	55: "RIGHT_ALL",
@@ -240,6 +253,7 @@ var Right_value = map[string]int32{
 	"RIGHT_USER_CLIENTS_CREATE":                11,
 	"RIGHT_USER_ORGANIZATIONS_LIST":            12,
 	"RIGHT_USER_ORGANIZATIONS_CREATE":          13,
+	"RIGHT_USER_AUDIT_LOG":                     59,
 	"RIGHT_USER_ALL":                           14,
 	"RIGHT_APPLICATION_INFO":                   15,
 	"RIGHT_APPLICATION_SETTINGS_BASIC":         16,
@@ -255,6 +269,7 @@ var Right_value = map[string]int32{
 	"RIGHT_APPLICATION_TRAFFIC_UP_WRITE":       25,
 	"RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE":     26,
 	"RIGHT_APPLICATION_LINK":                   27,
+	"RIGHT_APPLICATION_AUDIT_LOG":              60,
 	"RIGHT_APPLICATION_ALL":                    28,
 	"RIGHT_CLIENT_ALL":                         29,
 	"RIGHT_GATEWAY_INFO":                       30,
@@ -269,6 +284,7 @@ var Right_value = map[string]int32{
 	"RIGHT_GATEWAY_LOCATION_READ":              39,
 	"RIGHT_GATEWAY_WRITE_SECRETS":              57,
 	"RIGHT_GATEWAY_READ_SECRETS":               58,
+	"RIGHT_GATEWAY_AUDIT_LOG":                  61,
 	"RIGHT_GATEWAY_ALL":                        40,
 	"RIGHT_ORGANIZATION_INFO":                  41,
 	"RIGHT_ORGANIZATION_SETTINGS_BASIC":        42,
@@ -282,6 +298,7 @@ var Right_value = map[string]int32{
 	"RIGHT_ORGANIZATION_CLIENTS_LIST":          50,
 	"RIGHT_ORGANIZATION_CLIENTS_CREATE":        51,
 	"RIGHT_ORGANIZATION_ADD_AS_COLLABORATOR":   52,
+	"RIGHT_ORGANIZATION_AUDIT_LOG":             62,
 	"RIGHT_ORGANIZATION_ALL":                   53,
 	"RIGHT_SEND_INVITES":                       54,
 	"RIGHT_ALL":                                55,
@@ -337,12 +354,14 @@ type APIKey struct {
 	// User-defined (friendly) name for the API key.
 	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	// Rights that are granted to this API key.
-	Rights               []Right    `protobuf:"varint,4,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	CreatedAt            *time.Time `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
-	UpdatedAt            *time.Time `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
-	ExpiresAt            *time.Time `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Rights    []Right    `protobuf:"varint,4,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	CreatedAt *time.Time `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at,omitempty"`
+	UpdatedAt *time.Time `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
+	ExpiresAt *time.Time `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	// Restrictions of the requests that the API key can be used for, on top of its rights.
+	Restrictions         *APIKeyRestrictions `protobuf:"bytes,8,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *APIKey) Reset()      { *m = APIKey{} }
@@ -417,6 +436,13 @@ func (m *APIKey) GetExpiresAt() *time.Time {
 	return nil
 }
 
+func (m *APIKey) GetRestrictions() *APIKeyRestrictions {
+	if m != nil {
+		return m.Restrictions
+	}
+	return nil
+}
+
 type APIKeys struct {
 	ApiKeys              []*APIKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -577,6 +603,74 @@ func (m *Collaborators) GetCollaborators() []*Collaborator {
 	return nil
 }
 
+// APIKeyRestrictions restrict the requests that an API key can be used for, on top of its rights.
+// Empty restrictions do not restrict anything.
+type APIKeyRestrictions struct {
+	// IDs of the end devices that the API key can access.
+	EndDeviceIds []string `protobuf:"bytes,1,rep,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	// Attributes that select the end devices that the API key can access, in addition to the end devices in end_device_ids.
+	// End devices that have all these attributes can be accessed.
+	EndDeviceAttributes map[string]string `protobuf:"bytes,2,rep,name=end_device_attributes,json=endDeviceAttributes,proto3" json:"end_device_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Address ranges, in CIDR notation, that the API key can be used from.
+	SourceCidrs []string `protobuf:"bytes,3,rep,name=source_cidrs,json=sourceCidrs,proto3" json:"source_cidrs,omitempty"`
+	// Full names of the gRPC methods that the API key can call.
+	// The name of a service followed by /* allows all methods of the service.
+	Methods              []string `protobuf:"bytes,4,rep,name=methods,proto3" json:"methods,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *APIKeyRestrictions) Reset()      { *m = APIKeyRestrictions{} }
+func (*APIKeyRestrictions) ProtoMessage() {}
+func (*APIKeyRestrictions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bb69af2cf8904c5, []int{6}
+}
+func (m *APIKeyRestrictions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APIKeyRestrictions.Unmarshal(m, b)
+}
+func (m *APIKeyRestrictions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APIKeyRestrictions.Marshal(b, m, deterministic)
+}
+func (m *APIKeyRestrictions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIKeyRestrictions.Merge(m, src)
+}
+func (m *APIKeyRestrictions) XXX_Size() int {
+	return xxx_messageInfo_APIKeyRestrictions.Size(m)
+}
+func (m *APIKeyRestrictions) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIKeyRestrictions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIKeyRestrictions proto.InternalMessageInfo
+
+func (m *APIKeyRestrictions) GetEndDeviceIds() []string {
+	if m != nil {
+		return m.EndDeviceIds
+	}
+	return nil
+}
+
+func (m *APIKeyRestrictions) GetEndDeviceAttributes() map[string]string {
+	if m != nil {
+		return m.EndDeviceAttributes
+	}
+	return nil
+}
+
+func (m *APIKeyRestrictions) GetSourceCidrs() []string {
+	if m != nil {
+		return m.SourceCidrs
+	}
+	return nil
+}
+
+func (m *APIKeyRestrictions) GetMethods() []string {
+	if m != nil {
+		return m.Methods
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ttn.lorawan.v3.Right", Right_name, Right_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.Right", Right_name, Right_value)
@@ -592,6 +686,10 @@ func init() {
 	golang_proto.RegisterType((*GetCollaboratorResponse)(nil), "ttn.lorawan.v3.GetCollaboratorResponse")
 	proto.RegisterType((*Collaborators)(nil), "ttn.lorawan.v3.Collaborators")
 	golang_proto.RegisterType((*Collaborators)(nil), "ttn.lorawan.v3.Collaborators")
+	proto.RegisterType((*APIKeyRestrictions)(nil), "ttn.lorawan.v3.APIKeyRestrictions")
+	golang_proto.RegisterType((*APIKeyRestrictions)(nil), "ttn.lorawan.v3.APIKeyRestrictions")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.APIKeyRestrictions.EndDeviceAttributesEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.APIKeyRestrictions.EndDeviceAttributesEntry")
 }
 
 func init() { proto.RegisterFile("lorawan-stack/api/rights.proto", fileDescriptor_9bb69af2cf8904c5) }
@@ -600,87 +698,102 @@ func init() {
 }
 
 var fileDescriptor_9bb69af2cf8904c5 = []byte{
-	// 1277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4b, 0x6f, 0xdb, 0x46,
-	0x17, 0x35, 0x65, 0xbd, 0x3c, 0x8e, 0x9d, 0xf1, 0x24, 0x76, 0x64, 0xd9, 0xa1, 0x14, 0x39, 0x0f,
-	0x7d, 0xfe, 0x22, 0xaa, 0x91, 0xfb, 0x46, 0x80, 0x94, 0x94, 0x68, 0x85, 0x36, 0x23, 0x09, 0x24,
-	0x9d, 0x20, 0xde, 0x10, 0xb4, 0xc5, 0xd0, 0xac, 0x6d, 0x52, 0x20, 0xc7, 0x4e, 0xdc, 0x55, 0xd1,
-	0x55, 0xd1, 0x55, 0xd0, 0x7f, 0x50, 0x74, 0x53, 0x74, 0x53, 0xa0, 0xbf, 0xa0, 0xab, 0xa2, 0x3f,
-	0xa0, 0x8b, 0xae, 0x5a, 0xd4, 0xd9, 0x14, 0x5d, 0x75, 0xed, 0x55, 0x21, 0x72, 0x64, 0x3e, 0x24,
-	0xc5, 0x49, 0x77, 0xd2, 0xbd, 0xe7, 0x9e, 0xb9, 0xf7, 0xdc, 0x33, 0x03, 0x02, 0xfa, 0xc0, 0x76,
-	0xb4, 0xe7, 0x9a, 0x55, 0x71, 0xb1, 0xb6, 0xbb, 0x5f, 0xd5, 0x7a, 0x66, 0xd5, 0x31, 0x8d, 0x3d,
-	0xec, 0x32, 0x3d, 0xc7, 0xc6, 0x36, 0x9a, 0xc5, 0xd8, 0x62, 0x08, 0x86, 0x39, 0x5e, 0xcb, 0xb3,
-	0x86, 0x89, 0xf7, 0x8e, 0x76, 0x98, 0x5d, 0xfb, 0xb0, 0xaa, 0x5b, 0xc7, 0xf6, 0x49, 0xcf, 0xb1,
-	0x5f, 0x9c, 0x54, 0x3d, 0xf0, 0x6e, 0xc5, 0xd0, 0xad, 0xca, 0xb1, 0x76, 0x60, 0x76, 0x35, 0xac,
-	0x57, 0x87, 0x7e, 0xf8, 0x94, 0xf9, 0x4a, 0x88, 0xc2, 0xb0, 0x0d, 0xdb, 0x2f, 0xde, 0x39, 0x7a,
-	0xe6, 0xfd, 0xf3, 0xfe, 0x78, 0xbf, 0x08, 0xbc, 0x1e, 0x82, 0x2b, 0x7b, 0xba, 0xb2, 0x67, 0x5a,
-	0x86, 0x2b, 0x58, 0xdd, 0x23, 0x17, 0x3b, 0xa6, 0xee, 0x86, 0x8f, 0x36, 0xec, 0xca, 0xa7, 0xae,
-	0x6d, 0x55, 0x35, 0xcb, 0xb2, 0xb1, 0x86, 0x4d, 0xdb, 0x22, 0x63, 0xe4, 0x0b, 0x86, 0x6d, 0x1b,
-	0x07, 0x7a, 0x70, 0x14, 0x36, 0x0f, 0x75, 0x17, 0x6b, 0x87, 0x3d, 0x02, 0x58, 0x19, 0xd6, 0xc1,
-	0xec, 0xea, 0x16, 0x36, 0x9f, 0x99, 0xba, 0x43, 0x58, 0x4a, 0xeb, 0x20, 0x2d, 0x79, 0xe2, 0xa0,
-	0xfb, 0x20, 0xed, 0xcb, 0x94, 0xa3, 0x8a, 0x93, 0xe5, 0xd9, 0xda, 0x3c, 0x13, 0xd5, 0x89, 0xf1,
-	0x70, 0xdc, 0xcc, 0x19, 0x07, 0xbe, 0xa6, 0x32, 0xa5, 0xd4, 0x17, 0x54, 0x02, 0x52, 0x12, 0xa9,
-	0x29, 0xfd, 0x9a, 0x00, 0x69, 0xb6, 0x23, 0x6c, 0xea, 0x27, 0x68, 0x16, 0x24, 0xcc, 0x6e, 0x8e,
-	0x2a, 0x52, 0xe5, 0x29, 0x29, 0x61, 0x76, 0x11, 0x04, 0x93, 0xfb, 0xfa, 0x49, 0x2e, 0xe1, 0x05,
-	0xfa, 0x3f, 0xd1, 0x12, 0x48, 0x5a, 0xda, 0xa1, 0x9e, 0x9b, 0xec, 0x87, 0xb8, 0xcc, 0x19, 0x97,
-	0x74, 0x12, 0xb9, 0x9a, 0xe4, 0x05, 0x43, 0x7d, 0x24, 0xdf, 0xbe, 0x0f, 0xf4, 0x00, 0x80, 0x5d,
-	0x47, 0xd7, 0xb0, 0xde, 0x55, 0x35, 0x9c, 0x4b, 0x15, 0xa9, 0xf2, 0x74, 0x2d, 0xcf, 0xf8, 0x52,
-	0x31, 0x03, 0xa9, 0x18, 0x65, 0x20, 0x15, 0x97, 0x7c, 0xf9, 0x47, 0x81, 0x92, 0xa6, 0x48, 0x0d,
-	0x8b, 0xfb, 0x04, 0x47, 0xbd, 0xee, 0x80, 0x20, 0xfd, 0xa6, 0x04, 0xa4, 0x86, 0xc5, 0x68, 0x13,
-	0x00, 0xfd, 0x45, 0xcf, 0x74, 0x74, 0xb7, 0x4f, 0x90, 0xb9, 0x90, 0x00, 0x9e, 0x71, 0xa9, 0x1f,
-	0xa9, 0xc4, 0x27, 0xd4, 0x2f, 0xbf, 0x17, 0x28, 0x9f, 0x8c, 0xd4, 0xb3, 0xb8, 0x74, 0x1f, 0x64,
-	0x7c, 0x55, 0x5d, 0x74, 0x0f, 0x64, 0xb5, 0x9e, 0xa9, 0xee, 0xeb, 0x27, 0xfe, 0x86, 0xa6, 0x6b,
-	0x0b, 0x71, 0x65, 0x7c, 0xa8, 0x94, 0xd1, 0x7a, 0x66, 0xbf, 0xa4, 0xf4, 0x03, 0x05, 0x2e, 0xd5,
-	0xed, 0x83, 0x03, 0x6d, 0xc7, 0x76, 0x34, 0x6c, 0x3b, 0x48, 0x00, 0x93, 0x66, 0xd7, 0xf5, 0x76,
-	0x33, 0x5d, 0xab, 0xc4, 0xcb, 0xdb, 0x8e, 0xa1, 0x59, 0xe6, 0x67, 0x9e, 0xcb, 0xda, 0xce, 0x96,
-	0xab, 0x3b, 0x42, 0xe0, 0x17, 0x2e, 0x7b, 0xc6, 0xa5, 0xbe, 0xf2, 0xb4, 0xee, 0x73, 0x84, 0xd6,
-	0x94, 0x78, 0xfb, 0x35, 0x6d, 0x24, 0xb3, 0x93, 0x30, 0xb9, 0x91, 0xcc, 0x26, 0x61, 0x6a, 0x23,
-	0x99, 0x4d, 0xc1, 0xf4, 0x46, 0x32, 0x9b, 0x86, 0x99, 0xd2, 0x37, 0x14, 0xb8, 0xd6, 0xd4, 0x71,
-	0xb8, 0x69, 0x49, 0x77, 0x7b, 0xb6, 0xe5, 0xea, 0xe8, 0xc1, 0x7f, 0x6f, 0xde, 0x6f, 0xb9, 0xf2,
-	0x46, 0x2d, 0x5f, 0xd8, 0xa3, 0x0c, 0x66, 0xc2, 0xfd, 0xb9, 0x88, 0x03, 0x33, 0xbb, 0xe1, 0x00,
-	0x59, 0xcf, 0x72, 0x9c, 0x3e, 0x32, 0x55, 0xb4, 0x64, 0xf5, 0xe7, 0xcb, 0x20, 0xe5, 0x1d, 0x8f,
-	0xe6, 0xc0, 0x8c, 0xd7, 0x80, 0x6a, 0x5a, 0xde, 0x2b, 0x03, 0x27, 0xd0, 0x15, 0x70, 0x59, 0x12,
-	0x9a, 0x0f, 0x15, 0x75, 0x4b, 0xe6, 0x25, 0x55, 0x68, 0xad, 0xb7, 0x21, 0x85, 0xae, 0x83, 0xc5,
-	0x50, 0x50, 0xe6, 0x15, 0x45, 0x68, 0x35, 0x65, 0x95, 0x63, 0x65, 0xa1, 0x0e, 0x13, 0xa8, 0x08,
-	0x96, 0x47, 0xa5, 0xd9, 0x8e, 0xa0, 0x6e, 0xf2, 0x4f, 0x65, 0x38, 0x89, 0xe6, 0xc1, 0x5c, 0x08,
-	0xd1, 0xe0, 0x45, 0x5e, 0xe1, 0x61, 0x12, 0xdd, 0x00, 0xd7, 0x43, 0x61, 0x76, 0x4b, 0x79, 0xd8,
-	0x96, 0x84, 0x6d, 0xbe, 0xa1, 0xd6, 0x45, 0x81, 0x6f, 0x29, 0x32, 0x4c, 0xc5, 0xb8, 0xd9, 0x4e,
-	0x47, 0x14, 0xea, 0xac, 0x22, 0xb4, 0x5b, 0xb2, 0x2a, 0x0a, 0xb2, 0x02, 0xd3, 0xa8, 0x04, 0xe8,
-	0x71, 0x88, 0xba, 0xc4, 0xb3, 0x0a, 0x0f, 0x33, 0x68, 0x19, 0xe4, 0x42, 0x98, 0x26, 0xab, 0xf0,
-	0x4f, 0xd8, 0xa7, 0x84, 0x21, 0x8b, 0x68, 0x90, 0x1f, 0x95, 0x25, 0xd5, 0x53, 0x68, 0x09, 0x5c,
-	0x0b, 0xe5, 0x49, 0x6f, 0x7e, 0x31, 0x88, 0x69, 0x33, 0x48, 0x92, 0xda, 0xe9, 0xd8, 0x88, 0x6d,
-	0xa9, 0xc9, 0xb6, 0x84, 0xed, 0xf0, 0x00, 0x97, 0xd0, 0x0a, 0x28, 0x8c, 0x85, 0x10, 0x9e, 0x19,
-	0x84, 0xc0, 0x6c, 0x78, 0x4a, 0x51, 0x84, 0xb3, 0x28, 0x0f, 0x16, 0xfc, 0x58, 0x68, 0x68, 0x7f,
-	0x65, 0x97, 0xd1, 0x4d, 0x50, 0x1c, 0xce, 0xc5, 0x36, 0x07, 0xd1, 0x1d, 0xb0, 0xf2, 0x1a, 0xd4,
-	0xf9, 0x02, 0xe7, 0xd0, 0x5d, 0x50, 0x7e, 0x0d, 0xb0, 0xde, 0x16, 0x45, 0x96, 0x6b, 0x4b, 0xac,
-	0xd2, 0x96, 0x64, 0x88, 0x2e, 0xa0, 0xed, 0xb0, 0xf5, 0x4d, 0xb6, 0xc9, 0xcb, 0xf0, 0xc3, 0x60,
-	0x2f, 0x61, 0x20, 0xb1, 0xc7, 0x95, 0x60, 0xb3, 0xd1, 0xec, 0x63, 0xa1, 0xce, 0xcb, 0xaa, 0xc4,
-	0xb3, 0x0d, 0x78, 0x35, 0x10, 0x6f, 0x14, 0xe6, 0x89, 0x24, 0x28, 0x3c, 0x9c, 0x1f, 0xdd, 0x4f,
-	0x98, 0xc8, 0x1f, 0x73, 0x01, 0x95, 0xc1, 0xcd, 0x0b, 0xd8, 0x7c, 0xe4, 0xb5, 0xd1, 0xbd, 0x29,
-	0x12, 0xbb, 0xbe, 0x2e, 0xd4, 0xfd, 0xde, 0x72, 0xe8, 0x36, 0x28, 0x8d, 0xc7, 0x6c, 0x75, 0x48,
-	0x7b, 0x8b, 0xa3, 0x4f, 0x1d, 0xe0, 0x1a, 0xed, 0x27, 0x2d, 0x82, 0xcc, 0x8f, 0xde, 0xb8, 0x28,
-	0xb4, 0x36, 0xe1, 0x12, 0x5a, 0x04, 0xf3, 0xc3, 0xb9, 0xbe, 0x51, 0x96, 0xd1, 0x55, 0x00, 0xfd,
-	0x94, 0x6f, 0x4f, 0x2f, 0x7a, 0x1d, 0x2d, 0x00, 0xe4, 0x47, 0x89, 0xe3, 0x7d, 0xeb, 0xd0, 0xc1,
-	0x95, 0x1b, 0xc4, 0x63, 0xb6, 0x29, 0x04, 0xa2, 0x0f, 0x21, 0xce, 0x2d, 0x53, 0x0c, 0xa6, 0x1a,
-	0x02, 0x45, 0xed, 0x72, 0x03, 0xe5, 0xc0, 0xd5, 0x28, 0x92, 0x38, 0xa0, 0x14, 0xdc, 0xcc, 0x41,
-	0x26, 0xa2, 0xf0, 0x4a, 0xe0, 0xf2, 0x78, 0x3e, 0xa4, 0xda, 0xcd, 0xe1, 0x41, 0x3d, 0xc5, 0x6e,
-	0x05, 0x57, 0xf7, 0xbc, 0x43, 0x85, 0x55, 0xb6, 0x88, 0xb5, 0x6e, 0xa3, 0x02, 0x58, 0x8a, 0x95,
-	0xb5, 0x89, 0xaa, 0x1e, 0xe0, 0xce, 0x30, 0xc0, 0x77, 0x88, 0xcc, 0xd7, 0x25, 0x5e, 0x91, 0xe1,
-	0x47, 0xc3, 0xed, 0x7b, 0x5e, 0x1b, 0xe4, 0x3f, 0x0e, 0x9e, 0xc5, 0x41, 0xbe, 0xbf, 0x98, 0x72,
-	0xf0, 0xde, 0x84, 0xdf, 0x02, 0x7f, 0x3b, 0xff, 0x43, 0xb7, 0xc0, 0x8d, 0x11, 0xc9, 0xd8, 0x8a,
-	0x56, 0x03, 0xf5, 0x47, 0xc3, 0xce, 0xf7, 0xf4, 0xff, 0xe0, 0x72, 0x8c, 0x46, 0x3e, 0xe2, 0x1f,
-	0x71, 0xbc, 0x24, 0xc3, 0xbb, 0x81, 0x5c, 0x11, 0x20, 0xd9, 0x55, 0x65, 0xcc, 0x89, 0xc3, 0x2f,
-	0x36, 0x83, 0x56, 0xc1, 0xed, 0x8b, 0x90, 0xe4, 0xdd, 0xab, 0x06, 0x1b, 0x8e, 0x60, 0xa3, 0x2f,
-	0xf8, 0x3b, 0xc1, 0x4d, 0x1b, 0x8d, 0x22, 0x6c, 0xf7, 0x02, 0xe3, 0x46, 0x70, 0x91, 0x17, 0xbd,
-	0x36, 0x46, 0xe1, 0xd8, 0xcb, 0xbe, 0x36, 0x6e, 0x8a, 0x46, 0x43, 0x65, 0xa3, 0x16, 0x87, 0xef,
-	0x06, 0xf7, 0x36, 0x8a, 0x15, 0x45, 0xf8, 0x5e, 0xe0, 0x4e, 0x99, 0x6f, 0x35, 0x54, 0xa1, 0xf5,
-	0x58, 0x50, 0x78, 0x19, 0xbe, 0x8f, 0x66, 0xc0, 0x14, 0xb9, 0xcf, 0xa2, 0x08, 0x3f, 0xc8, 0xcf,
-	0xfd, 0xfd, 0xfd, 0xe2, 0x54, 0x8e, 0x5a, 0x4d, 0x79, 0xc1, 0x2f, 0xbf, 0xa5, 0x27, 0xb8, 0x47,
-	0xbf, 0xfd, 0x49, 0x4f, 0x7c, 0x7e, 0x4a, 0x53, 0xdf, 0x9d, 0xd2, 0xd4, 0x5f, 0xa7, 0xf4, 0xc4,
-	0x3f, 0xa7, 0x34, 0xf5, 0xf2, 0x15, 0x3d, 0xf1, 0xd3, 0x2b, 0x9a, 0xda, 0xae, 0x1a, 0x36, 0x83,
-	0xf7, 0x74, 0xec, 0x7d, 0xf1, 0x33, 0x96, 0x8e, 0x9f, 0xdb, 0xce, 0x7e, 0x35, 0xfa, 0xa1, 0x7e,
-	0xbc, 0x56, 0xed, 0xed, 0x1b, 0x55, 0x8c, 0xad, 0xde, 0xce, 0x4e, 0xda, 0xfb, 0x62, 0x5c, 0xfb,
-	0x37, 0x00, 0x00, 0xff, 0xff, 0xe1, 0x70, 0x0f, 0x6c, 0xd5, 0x0c, 0x00, 0x00,
+	// 1514 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4b, 0x6f, 0xdb, 0xc6,
+	0x16, 0x36, 0xf5, 0xd6, 0xf8, 0x91, 0xf1, 0xc4, 0x76, 0x64, 0xd9, 0x91, 0x6d, 0xd9, 0x71, 0x14,
+	0x5f, 0x4b, 0x4a, 0xe4, 0x7b, 0x6f, 0xf3, 0x6a, 0x5d, 0x52, 0xa2, 0x1d, 0xda, 0x8c, 0x64, 0x90,
+	0x74, 0x82, 0x24, 0x48, 0x09, 0x5a, 0x9a, 0xc8, 0xac, 0x6d, 0x52, 0x20, 0xc7, 0x4e, 0x9c, 0x22,
+	0x40, 0xd1, 0x55, 0xd1, 0x55, 0x90, 0x65, 0xd1, 0x4d, 0xd1, 0x4d, 0xd1, 0x4d, 0x81, 0xfe, 0x82,
+	0x2c, 0xfb, 0x13, 0xba, 0x6a, 0x51, 0x67, 0x53, 0x14, 0x5d, 0x74, 0xed, 0x55, 0x21, 0x92, 0x12,
+	0x49, 0x3d, 0xe2, 0xa4, 0x3b, 0xf2, 0x9c, 0xef, 0x7c, 0x3c, 0xf3, 0x7d, 0x67, 0x66, 0x40, 0x90,
+	0xda, 0xd7, 0x0d, 0xe5, 0xa9, 0xa2, 0x65, 0x4d, 0xa2, 0x54, 0xf7, 0xf2, 0x4a, 0x43, 0xcd, 0x1b,
+	0x6a, 0x7d, 0x97, 0x98, 0xb9, 0x86, 0xa1, 0x13, 0x1d, 0x8d, 0x10, 0xa2, 0xe5, 0x1c, 0x4c, 0xee,
+	0x68, 0x25, 0x49, 0xd7, 0x55, 0xb2, 0x7b, 0xb8, 0x93, 0xab, 0xea, 0x07, 0x79, 0xac, 0x1d, 0xe9,
+	0xc7, 0x0d, 0x43, 0x7f, 0x76, 0x9c, 0xb7, 0xc0, 0xd5, 0x6c, 0x1d, 0x6b, 0xd9, 0x23, 0x65, 0x5f,
+	0xad, 0x29, 0x04, 0xe7, 0xbb, 0x1e, 0x6c, 0xca, 0x64, 0xd6, 0x43, 0x51, 0xd7, 0xeb, 0xba, 0x5d,
+	0xbc, 0x73, 0xf8, 0xc4, 0x7a, 0xb3, 0x5e, 0xac, 0x27, 0x07, 0x5e, 0xf4, 0xc0, 0xa5, 0x5d, 0x2c,
+	0xed, 0xaa, 0x5a, 0xdd, 0xe4, 0xb4, 0xda, 0xa1, 0x49, 0x0c, 0x15, 0x9b, 0xde, 0x4f, 0xd7, 0xf5,
+	0xec, 0xa7, 0xa6, 0xae, 0xe5, 0x15, 0x4d, 0xd3, 0x89, 0x42, 0x54, 0x5d, 0x73, 0x96, 0x91, 0x9c,
+	0xa9, 0xeb, 0x7a, 0x7d, 0x1f, 0xbb, 0x9f, 0x22, 0xea, 0x01, 0x36, 0x89, 0x72, 0xd0, 0x70, 0x00,
+	0xf3, 0xdd, 0x3a, 0xa8, 0x35, 0xac, 0x11, 0xf5, 0x89, 0x8a, 0x0d, 0x87, 0x25, 0xbd, 0x06, 0x22,
+	0x82, 0x25, 0x0e, 0xba, 0x0d, 0x22, 0xb6, 0x4c, 0x09, 0x6a, 0x36, 0x98, 0x19, 0x29, 0x8c, 0xe7,
+	0xfc, 0x3a, 0xe5, 0x2c, 0x1c, 0x33, 0x7c, 0xca, 0x80, 0x57, 0x54, 0x34, 0x1d, 0xfe, 0x82, 0x0a,
+	0x40, 0x4a, 0x70, 0x6a, 0xd2, 0xdf, 0x04, 0x41, 0x84, 0xde, 0xe2, 0x36, 0xf1, 0x31, 0x1a, 0x01,
+	0x01, 0xb5, 0x96, 0xa0, 0x66, 0xa9, 0x4c, 0x5c, 0x08, 0xa8, 0x35, 0x04, 0x41, 0x70, 0x0f, 0x1f,
+	0x27, 0x02, 0x56, 0xa0, 0xf9, 0x88, 0xa6, 0x40, 0x48, 0x53, 0x0e, 0x70, 0x22, 0xd8, 0x0c, 0x31,
+	0xd1, 0x53, 0x26, 0x64, 0x04, 0x12, 0x05, 0xc1, 0x0a, 0x7a, 0xfa, 0x08, 0xbd, 0x7f, 0x1f, 0x68,
+	0x15, 0x80, 0xaa, 0x81, 0x15, 0x82, 0x6b, 0xb2, 0x42, 0x12, 0xe1, 0x59, 0x2a, 0x33, 0x58, 0x48,
+	0xe6, 0x6c, 0xa9, 0x72, 0x2d, 0xa9, 0x72, 0x52, 0x4b, 0x2a, 0x26, 0xf4, 0xf2, 0xb7, 0x19, 0x4a,
+	0x88, 0x3b, 0x35, 0x34, 0x69, 0x12, 0x1c, 0x36, 0x6a, 0x2d, 0x82, 0xc8, 0xbb, 0x12, 0x38, 0x35,
+	0x34, 0x41, 0x9b, 0x00, 0xe0, 0x67, 0x0d, 0xd5, 0xc0, 0x66, 0x93, 0x20, 0x7a, 0x26, 0x01, 0x3c,
+	0x65, 0xc2, 0x3f, 0x51, 0x81, 0x8f, 0xa9, 0x9f, 0x7f, 0x9d, 0xa1, 0x6c, 0x32, 0xa7, 0x9e, 0x26,
+	0x68, 0x0d, 0x0c, 0x19, 0xb8, 0x39, 0x15, 0x55, 0xcb, 0xfa, 0x44, 0xcc, 0xa2, 0x4b, 0x77, 0x4a,
+	0x62, 0x2b, 0x2f, 0x78, 0x90, 0x82, 0xaf, 0x2e, 0x7d, 0x1b, 0x44, 0x6d, 0x8c, 0x89, 0xae, 0x81,
+	0x98, 0xd2, 0x50, 0xe5, 0x3d, 0x7c, 0x6c, 0x3b, 0x3d, 0x58, 0x98, 0xe8, 0x43, 0x17, 0x55, 0x1a,
+	0x6a, 0xb3, 0x24, 0xfd, 0x23, 0x05, 0x86, 0x8a, 0xfa, 0xfe, 0xbe, 0xb2, 0xa3, 0x1b, 0x0a, 0xd1,
+	0x0d, 0xc4, 0x81, 0xa0, 0x5a, 0x33, 0x2d, 0x8f, 0x07, 0x0b, 0xd9, 0xce, 0xf2, 0x8a, 0x51, 0x57,
+	0x34, 0xf5, 0xb9, 0x35, 0xad, 0x15, 0x63, 0xdb, 0xc4, 0x06, 0xe7, 0xce, 0x1d, 0x13, 0x3b, 0x65,
+	0xc2, 0x5f, 0x59, 0x9e, 0x35, 0x39, 0x3c, 0x76, 0x07, 0xde, 0xdf, 0xee, 0x8d, 0x50, 0x2c, 0x08,
+	0x43, 0x1b, 0xa1, 0x58, 0x08, 0x86, 0x37, 0x42, 0xb1, 0x30, 0x8c, 0x6c, 0x84, 0x62, 0x11, 0x18,
+	0x4d, 0x7f, 0x4b, 0x81, 0x0b, 0xeb, 0x98, 0x78, 0x9b, 0x16, 0xb0, 0xd9, 0xd0, 0x35, 0x13, 0xa3,
+	0xd5, 0x7f, 0xdf, 0xbc, 0xdd, 0x72, 0xf6, 0x9d, 0x5a, 0x3e, 0xb3, 0x47, 0x11, 0x0c, 0x7b, 0xfb,
+	0x33, 0x11, 0x03, 0x86, 0xab, 0xde, 0x80, 0x63, 0xcf, 0x74, 0x27, 0xbd, 0x6f, 0x55, 0xfe, 0x92,
+	0xf4, 0x5f, 0x01, 0x80, 0xba, 0xa7, 0x01, 0x09, 0x60, 0x04, 0x6b, 0x35, 0xb9, 0x86, 0x8f, 0xd4,
+	0x2a, 0x96, 0xed, 0xe5, 0x07, 0x33, 0x71, 0x66, 0xf9, 0x94, 0xb9, 0xf2, 0x8a, 0x5a, 0x4c, 0x2f,
+	0x18, 0xe9, 0xc4, 0x42, 0x21, 0xf5, 0xc9, 0x23, 0x25, 0xfb, 0xfc, 0x6a, 0xf6, 0xc6, 0xe3, 0xcc,
+	0xea, 0xcd, 0x47, 0xd9, 0xc7, 0xab, 0xad, 0xd7, 0x2b, 0x9f, 0x15, 0x96, 0x5f, 0x2c, 0x08, 0x43,
+	0x58, 0xab, 0x95, 0x2c, 0x0a, 0xae, 0x66, 0xa2, 0x17, 0x60, 0xdc, 0xc3, 0xa9, 0x10, 0x62, 0xa8,
+	0x3b, 0x87, 0x04, 0xdb, 0xaa, 0x0c, 0x16, 0x6e, 0x9d, 0x3d, 0xa4, 0x39, 0xb6, 0xc5, 0x47, 0xb7,
+	0xab, 0x59, 0x8d, 0x18, 0xc7, 0xcc, 0xd0, 0x29, 0x13, 0xff, 0x9a, 0x8a, 0xa4, 0x43, 0x46, 0xd3,
+	0xed, 0xf3, 0xb8, 0x1b, 0x87, 0xe6, 0xc0, 0x90, 0xa9, 0x1f, 0x1a, 0x55, 0x2c, 0x57, 0xd5, 0x9a,
+	0x61, 0x26, 0x82, 0xcd, 0x05, 0x09, 0x83, 0x76, 0xac, 0xd8, 0x0c, 0xa1, 0x04, 0x88, 0x1e, 0x60,
+	0xb2, 0xab, 0xd7, 0xec, 0xb3, 0x24, 0x2e, 0xb4, 0x5e, 0x93, 0x6b, 0x20, 0xd1, 0xef, 0xdb, 0xad,
+	0xf3, 0x8a, 0x72, 0xcf, 0xab, 0x31, 0x10, 0x3e, 0x52, 0xf6, 0x0f, 0xb1, 0x73, 0x86, 0xd9, 0x2f,
+	0x37, 0x03, 0xd7, 0xa9, 0xa5, 0xd7, 0x10, 0x84, 0x2d, 0xb7, 0xd1, 0x28, 0x18, 0xb6, 0xfc, 0x96,
+	0x55, 0xcd, 0xba, 0x1c, 0xe0, 0x00, 0x3a, 0x0f, 0xce, 0x09, 0xdc, 0xfa, 0x1d, 0x49, 0xde, 0x16,
+	0x59, 0x41, 0xe6, 0xca, 0x6b, 0x15, 0x48, 0xa1, 0x8b, 0x60, 0xd2, 0x13, 0x14, 0x59, 0x49, 0xe2,
+	0xca, 0xeb, 0xa2, 0xcc, 0xd0, 0x22, 0x57, 0x84, 0x01, 0x34, 0x0b, 0xa6, 0x7b, 0xa5, 0xe9, 0x2d,
+	0x4e, 0xde, 0x64, 0x1f, 0x88, 0x30, 0x88, 0xc6, 0xc1, 0xa8, 0x07, 0x51, 0x62, 0x79, 0x56, 0x62,
+	0x61, 0x08, 0xcd, 0x81, 0x8b, 0x9e, 0x30, 0xbd, 0x2d, 0xdd, 0xa9, 0x08, 0xdc, 0x43, 0xb6, 0x24,
+	0x17, 0x79, 0x8e, 0x2d, 0x4b, 0x22, 0x0c, 0x77, 0x70, 0xd3, 0x5b, 0x5b, 0x3c, 0x57, 0xa4, 0x25,
+	0xae, 0x52, 0x16, 0x65, 0x9e, 0x13, 0x25, 0x18, 0x41, 0x69, 0x90, 0xea, 0x87, 0x28, 0x0a, 0x2c,
+	0x2d, 0xb1, 0x30, 0x8a, 0xa6, 0x41, 0xc2, 0x83, 0x59, 0xa7, 0x25, 0xf6, 0x3e, 0xfd, 0xc0, 0x61,
+	0x88, 0xa1, 0x14, 0x48, 0xf6, 0xca, 0x3a, 0xd5, 0x71, 0x34, 0x05, 0x2e, 0x78, 0xf2, 0x4e, 0x6f,
+	0x76, 0x31, 0xe8, 0xd0, 0xa6, 0x95, 0x74, 0x6a, 0x07, 0x3b, 0x96, 0x58, 0x11, 0xd6, 0xe9, 0x32,
+	0xf7, 0xd0, 0xbb, 0x80, 0x21, 0x34, 0x0f, 0x66, 0xfa, 0x42, 0x1c, 0x9e, 0x61, 0x94, 0x00, 0x63,
+	0x3e, 0xa9, 0x4a, 0x9c, 0x24, 0xf3, 0x95, 0x75, 0x78, 0x0b, 0x21, 0x30, 0xe2, 0xcd, 0xf0, 0x3c,
+	0x1c, 0x41, 0x49, 0x30, 0x61, 0xc7, 0x3c, 0x72, 0xd8, 0x66, 0x9e, 0x43, 0x0b, 0x60, 0xb6, 0x3b,
+	0xd7, 0xe1, 0x29, 0x44, 0x97, 0xc1, 0xfc, 0x5b, 0x50, 0x6d, 0x6b, 0x47, 0xd1, 0x32, 0xc8, 0xbc,
+	0x05, 0x58, 0xac, 0xf0, 0x3c, 0xcd, 0x54, 0x04, 0x5a, 0xaa, 0x08, 0x22, 0x44, 0x67, 0xd0, 0x6e,
+	0xd1, 0xc5, 0x4d, 0x7a, 0x9d, 0x15, 0xe1, 0x75, 0xd7, 0x31, 0x2f, 0xd0, 0x19, 0x9c, 0xf3, 0xae,
+	0xe7, 0xfe, 0xec, 0x3d, 0xae, 0xc8, 0x8a, 0xb2, 0xc0, 0xd2, 0x25, 0x38, 0xe6, 0xca, 0xda, 0x0b,
+	0x73, 0x5f, 0xe0, 0x24, 0x16, 0x8e, 0xf7, 0xee, 0xc7, 0x4b, 0x64, 0x2f, 0x73, 0x02, 0x65, 0xc0,
+	0xc2, 0x19, 0x6c, 0x36, 0xf2, 0x42, 0xef, 0xde, 0x24, 0x81, 0x5e, 0x5b, 0xe3, 0x8a, 0x76, 0x6f,
+	0x09, 0xb4, 0x08, 0xd2, 0xfd, 0x31, 0xdb, 0x5b, 0x4e, 0x7b, 0x93, 0xbd, 0xbf, 0xda, 0xc2, 0x95,
+	0x2a, 0xf7, 0xcb, 0x0e, 0x32, 0xd9, 0xdb, 0x71, 0x9e, 0x2b, 0x6f, 0xc2, 0x29, 0x34, 0x03, 0xa6,
+	0xba, 0x73, 0xee, 0x08, 0xdd, 0x46, 0x93, 0x60, 0xbc, 0x07, 0x80, 0xe7, 0xe1, 0x34, 0x1a, 0x03,
+	0xd0, 0x4e, 0xd9, 0x93, 0x6d, 0x45, 0x2f, 0xa2, 0x09, 0x80, 0xec, 0xa8, 0xb3, 0x59, 0xec, 0xd9,
+	0x4a, 0xb9, 0xbb, 0xb5, 0x15, 0xef, 0x98, 0xab, 0x19, 0xd7, 0x95, 0x2e, 0x44, 0x7b, 0xa6, 0x66,
+	0xdd, 0x65, 0x77, 0x81, 0xfc, 0xf3, 0x34, 0xe7, 0x6e, 0x8b, 0x16, 0xd2, 0x19, 0x91, 0xb4, 0xbb,
+	0xa9, 0x5b, 0x19, 0x9f, 0x05, 0xf3, 0xee, 0x36, 0xe8, 0xcc, 0x7b, 0x64, 0x5d, 0xe8, 0x5e, 0xa8,
+	0x25, 0xe9, 0x25, 0x77, 0xd7, 0xb7, 0x3b, 0x94, 0x68, 0x69, 0xdb, 0x99, 0xbd, 0x45, 0x57, 0xf1,
+	0x76, 0x59, 0xc5, 0x51, 0xd5, 0x02, 0x5c, 0xee, 0x06, 0xd8, 0x23, 0x24, 0xb2, 0x45, 0x81, 0x95,
+	0x44, 0x78, 0xa3, 0xbb, 0xfd, 0x66, 0x61, 0x3b, 0x7f, 0xd3, 0x3d, 0x93, 0x5a, 0x79, 0xd7, 0xcf,
+	0x0f, 0xdd, 0xe3, 0xb6, 0x9d, 0xe4, 0x79, 0x98, 0x71, 0x6b, 0xbc, 0x67, 0x8c, 0x6d, 0xdd, 0x15,
+	0x74, 0x09, 0xcc, 0xf5, 0x48, 0x76, 0xf8, 0xb7, 0xe4, 0x5a, 0xd3, 0x1b, 0xd6, 0x36, 0xf1, 0x3f,
+	0xee, 0xd6, 0xea, 0x8d, 0xbc, 0xcb, 0xde, 0x65, 0x58, 0x41, 0x84, 0xcb, 0xae, 0x96, 0x3e, 0xa0,
+	0x63, 0x64, 0xb6, 0xcf, 0x17, 0xbb, 0x6f, 0x82, 0x1c, 0x5a, 0x02, 0x8b, 0x67, 0x21, 0x9d, 0xf3,
+	0x34, 0xef, 0xda, 0xef, 0xc3, 0xfa, 0x6f, 0x86, 0xab, 0xee, 0x3e, 0xed, 0x8d, 0x72, 0xd8, 0xae,
+	0xb9, 0x53, 0xed, 0xc3, 0xf9, 0x6e, 0x8a, 0x42, 0x1f, 0x85, 0x3b, 0x6e, 0x8c, 0x95, 0x7e, 0xab,
+	0x28, 0x95, 0x64, 0xda, 0x3f, 0xff, 0xf0, 0xbf, 0xee, 0x7e, 0xf3, 0x63, 0xdb, 0xa3, 0xf0, 0x91,
+	0x7b, 0x2e, 0xf8, 0x11, 0x3c, 0x0f, 0xff, 0xe7, 0x0e, 0xb7, 0xc8, 0x96, 0x4b, 0x32, 0x57, 0xbe,
+	0xc7, 0x49, 0xac, 0x08, 0xff, 0x8f, 0x86, 0x41, 0xdc, 0x8e, 0x37, 0x61, 0x1f, 0x24, 0x47, 0xff,
+	0xfc, 0x61, 0x32, 0x9e, 0xa0, 0x96, 0xc2, 0x56, 0xf0, 0xcb, 0xef, 0x52, 0x03, 0xcc, 0xdd, 0x5f,
+	0x7e, 0x4f, 0x0d, 0x7c, 0x7e, 0x92, 0xa2, 0xbe, 0x3f, 0x49, 0x51, 0x7f, 0x9c, 0xa4, 0x06, 0xfe,
+	0x3e, 0x49, 0x51, 0x2f, 0xdf, 0xa4, 0x06, 0x5e, 0xbf, 0x49, 0x51, 0x0f, 0xf3, 0x75, 0x3d, 0x47,
+	0x76, 0x31, 0xb1, 0x7e, 0x11, 0x73, 0x1a, 0x26, 0x4f, 0x75, 0x63, 0x2f, 0xef, 0xff, 0xb3, 0x3b,
+	0x5a, 0xc9, 0x37, 0xf6, 0xea, 0x79, 0x42, 0xb4, 0xc6, 0xce, 0x4e, 0xc4, 0xfa, 0xc5, 0x58, 0xf9,
+	0x67, 0x00, 0xba, 0xae, 0xbe, 0x79, 0x06, 0x0f, 0x00, 0x00,
 }
 
 func (x Right) String() string {
@@ -776,6 +889,9 @@ func (this *APIKey) Equal(that interface{}) bool {
 	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
 		return false
 	}
+	if !this.Restrictions.Equal(that1.Restrictions) {
+		return false
+	}
 	return true
 }
 func (this *APIKeys) Equal(that interface{}) bool {
@@ -900,6 +1016,59 @@ func (this *Collaborators) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *APIKeyRestrictions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*APIKeyRestrictions)
+	if !ok {
+		that2, ok := that.(APIKeyRestrictions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.EndDeviceIds) != len(that1.EndDeviceIds) {
+		return false
+	}
+	for i := range this.EndDeviceIds {
+		if this.EndDeviceIds[i] != that1.EndDeviceIds[i] {
+			return false
+		}
+	}
+	if len(this.EndDeviceAttributes) != len(that1.EndDeviceAttributes) {
+		return false
+	}
+	for i := range this.EndDeviceAttributes {
+		if this.EndDeviceAttributes[i] != that1.EndDeviceAttributes[i] {
+			return false
+		}
+	}
+	if len(this.SourceCidrs) != len(that1.SourceCidrs) {
+		return false
+	}
+	for i := range this.SourceCidrs {
+		if this.SourceCidrs[i] != that1.SourceCidrs[i] {
+			return false
+		}
+	}
+	if len(this.Methods) != len(that1.Methods) {
+		return false
+	}
+	for i := range this.Methods {
+		if this.Methods[i] != that1.Methods[i] {
+			return false
+		}
+	}
+	return true
+}
 func (this *Rights) String() string {
 	if this == nil {
 		return "nil"
@@ -922,6 +1091,7 @@ func (this *APIKey) String() string {
 		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`UpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Restrictions:` + strings.Replace(this.Restrictions.String(), "APIKeyRestrictions", "APIKeyRestrictions", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -978,6 +1148,29 @@ func (this *Collaborators) String() string {
 	}, "")
 	return s
 }
+func (this *APIKeyRestrictions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForEndDeviceAttributes := make([]string, 0, len(this.EndDeviceAttributes))
+	for k := range this.EndDeviceAttributes {
+		keysForEndDeviceAttributes = append(keysForEndDeviceAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForEndDeviceAttributes)
+	mapStringForEndDeviceAttributes := "map[string]string{"
+	for _, k := range keysForEndDeviceAttributes {
+		mapStringForEndDeviceAttributes += fmt.Sprintf("%v: %v,", k, this.EndDeviceAttributes[k])
+	}
+	mapStringForEndDeviceAttributes += "}"
+	s := strings.Join([]string{`&APIKeyRestrictions{`,
+		`EndDeviceIds:` + fmt.Sprintf("%v", this.EndDeviceIds) + `,`,
+		`EndDeviceAttributes:` + mapStringForEndDeviceAttributes + `,`,
+		`SourceCidrs:` + fmt.Sprintf("%v", this.SourceCidrs) + `,`,
+		`Methods:` + fmt.Sprintf("%v", this.Methods) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringRights(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -15,6 +15,11 @@ var APIKeyFieldPathsNested = []string{
 	"id",
 	"key",
 	"name",
+	"restrictions",
+	"restrictions.end_device_attributes",
+	"restrictions.end_device_ids",
+	"restrictions.methods",
+	"restrictions.source_cidrs",
 	"rights",
 	"updated_at",
 }
@@ -25,6 +30,7 @@ var APIKeyFieldPathsTopLevel = []string{
 	"id",
 	"key",
 	"name",
+	"restrictions",
 	"rights",
 	"updated_at",
 }
@@ -72,3 +78,16 @@ var CollaboratorsFieldPathsNested = []string{
 var CollaboratorsFieldPathsTopLevel = []string{
 	"collaborators",
 }
+var APIKeyRestrictionsFieldPathsNested = []string{
+	"end_device_attributes",
+	"end_device_ids",
+	"methods",
+	"source_cidrs",
+}
+
+var APIKeyRestrictionsFieldPathsTopLevel = []string{
+	"end_device_attributes",
+	"end_device_ids",
+	"methods",
+	"source_cidrs",
+}
@@ -93,6 +93,31 @@ func (dst *APIKey) SetFields(src *APIKey, paths ...string) error {
 			} else {
 				dst.ExpiresAt = nil
 			}
+		case "restrictions":
+			if len(subs) > 0 {
+				var newDst, newSrc *APIKeyRestrictions
+				if (src == nil || src.Restrictions == nil) && dst.Restrictions == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Restrictions
+				}
+				if dst.Restrictions != nil {
+					newDst = dst.Restrictions
+				} else {
+					newDst = &APIKeyRestrictions{}
+					dst.Restrictions = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Restrictions = src.Restrictions
+				} else {
+					dst.Restrictions = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -230,3 +255,50 @@ func (dst *Collaborators) SetFields(src *Collaborators, paths ...string) error {
 	}
 	return nil
 }
+
+func (dst *APIKeyRestrictions) SetFields(src *APIKeyRestrictions, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'end_device_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EndDeviceIds = src.EndDeviceIds
+			} else {
+				dst.EndDeviceIds = nil
+			}
+		case "end_device_attributes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'end_device_attributes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EndDeviceAttributes = src.EndDeviceAttributes
+			} else {
+				dst.EndDeviceAttributes = nil
+			}
+		case "source_cidrs":
+			if len(subs) > 0 {
+				return fmt.Errorf("'source_cidrs' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SourceCidrs = src.SourceCidrs
+			} else {
+				dst.SourceCidrs = nil
+			}
+		case "methods":
+			if len(subs) > 0 {
+				return fmt.Errorf("'methods' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Methods = src.Methods
+			} else {
+				dst.Methods = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -31,6 +31,7 @@ var Right_customvalue = map[string]int32{
 	"USER_CLIENTS_CREATE":                11,
 	"USER_ORGANIZATIONS_LIST":            12,
 	"USER_ORGANIZATIONS_CREATE":          13,
+	"USER_AUDIT_LOG":                     59,
 	"USER_ALL":                           14,
 	"APPLICATION_INFO":                   15,
 	"APPLICATION_SETTINGS_BASIC":         16,
@@ -46,6 +47,7 @@ var Right_customvalue = map[string]int32{
 	"APPLICATION_TRAFFIC_UP_WRITE":       25,
 	"APPLICATION_TRAFFIC_DOWN_WRITE":     26,
 	"APPLICATION_LINK":                   27,
+	"APPLICATION_AUDIT_LOG":              60,
 	"APPLICATION_ALL":                    28,
 	"CLIENT_ALL":                         29,
 	"GATEWAY_INFO":                       30,
@@ -60,6 +62,7 @@ var Right_customvalue = map[string]int32{
 	"GATEWAY_LOCATION_READ":              39,
 	"GATEWAY_WRITE_SECRETS":              57,
 	"GATEWAY_READ_SECRETS":               58,
+	"GATEWAY_AUDIT_LOG":                  61,
 	"GATEWAY_ALL":                        40,
 	"ORGANIZATION_INFO":                  41,
 	"ORGANIZATION_SETTINGS_BASIC":        42,
@@ -73,6 +76,7 @@ var Right_customvalue = map[string]int32{
 	"ORGANIZATION_CLIENTS_LIST":          50,
 	"ORGANIZATION_CLIENTS_CREATE":        51,
 	"ORGANIZATION_ADD_AS_COLLABORATOR":   52,
+	"ORGANIZATION_AUDIT_LOG":             62,
 	"ORGANIZATION_ALL":                   53,
 	"SEND_INVITES":                       54,
 	"ALL":                                55,
@@ -191,6 +195,12 @@ func (x *APIKey) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 			s.WriteTime(*x.ExpiresAt)
 		}
 	}
+	if x.Restrictions != nil || s.HasField("restrictions") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("restrictions")
+		// NOTE: APIKeyRestrictions does not seem to implement MarshalProtoJSON.
+		gogo.MarshalMessage(s, x.Restrictions)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -240,6 +250,12 @@ func (x *APIKey) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 				return
 			}
 			x.ExpiresAt = v
+		case "restrictions":
+			s.AddField("restrictions")
+			// NOTE: APIKeyRestrictions does not seem to implement UnmarshalProtoJSON.
+			var v APIKeyRestrictions
+			gogo.UnmarshalMessage(s, &v)
+			x.Restrictions = &v
 		}
 	})
 }
@@ -689,12 +689,13 @@ func (m *GetUserAPIKeyRequest) GetKeyId() string {
 }
 
 type CreateUserAPIKeyRequest struct {
-	UserIds              *UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
-	Name                 string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights               []Right          `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	ExpiresAt            *time.Time       `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	UserIds              *UserIdentifiers    `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Name                 string              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights               []Right             `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	ExpiresAt            *time.Time          `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	Restrictions         *APIKeyRestrictions `protobuf:"bytes,5,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *CreateUserAPIKeyRequest) Reset()      { *m = CreateUserAPIKeyRequest{} }
@@ -748,6 +749,13 @@ func (m *CreateUserAPIKeyRequest) GetExpiresAt() *time.Time {
 	return nil
 }
 
+func (m *CreateUserAPIKeyRequest) GetRestrictions() *APIKeyRestrictions {
+	if m != nil {
+		return m.Restrictions
+	}
+	return nil
+}
+
 type UpdateUserAPIKeyRequest struct {
 	UserIds *UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
 	ApiKey  *APIKey          `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
@@ -1479,113 +1487,115 @@ func init() {
 }
 
 var fileDescriptor_5ce30de589ccb9af = []byte{
-	// 1690 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x23, 0x49,
-	0x15, 0x4f, 0xf9, 0x2b, 0xf6, 0xf3, 0x4c, 0x62, 0xd7, 0x4c, 0x26, 0x4d, 0x86, 0x78, 0xac, 0x26,
-	0xa0, 0x6c, 0x84, 0xed, 0x95, 0xa3, 0x99, 0xdd, 0xd9, 0x05, 0x4d, 0xec, 0x9d, 0x61, 0x88, 0x66,
-	0x56, 0x8a, 0x3a, 0xb3, 0x1c, 0x58, 0x2d, 0x4d, 0xc7, 0x5d, 0x76, 0x4a, 0x6e, 0x77, 0xf7, 0x56,
-	0x95, 0x93, 0x35, 0x2b, 0xd0, 0x6a, 0x0f, 0x7c, 0x1e, 0x58, 0xe6, 0xc8, 0x1f, 0x80, 0x38, 0xf3,
-	0x0f, 0xc0, 0x01, 0x24, 0x24, 0x2e, 0x5c, 0x90, 0xb8, 0x21, 0xb2, 0x42, 0x9a, 0x23, 0x57, 0x72,
-	0x42, 0x5d, 0xd5, 0xed, 0xee, 0xd8, 0x66, 0x70, 0x26, 0x09, 0xe2, 0xe4, 0xfa, 0xf8, 0xbd, 0xf7,
-	0xea, 0xbd, 0x7a, 0xef, 0xf7, 0xaa, 0x0d, 0x5f, 0x74, 0x3c, 0x66, 0x1d, 0x5b, 0x6e, 0x8d, 0x0b,
-	0xab, 0xd3, 0x6f, 0x58, 0x3e, 0x6d, 0x0c, 0x39, 0x61, 0x75, 0x9f, 0x79, 0xc2, 0xc3, 0x4b, 0x42,
-	0xb8, 0xf5, 0x10, 0x51, 0x3f, 0xda, 0x5e, 0x6b, 0xf5, 0xa8, 0x38, 0x1c, 0x1e, 0xd4, 0x3b, 0xde,
-	0xa0, 0x41, 0xdc, 0x23, 0x6f, 0xe4, 0x33, 0xef, 0xa3, 0x51, 0x43, 0x82, 0x3b, 0xb5, 0x1e, 0x71,
-	0x6b, 0x47, 0x96, 0x43, 0x6d, 0x4b, 0x90, 0xc6, 0xd4, 0x40, 0xa9, 0x5c, 0xab, 0x25, 0x54, 0xf4,
-	0xbc, 0x9e, 0xa7, 0x84, 0x0f, 0x86, 0x5d, 0x39, 0x93, 0x13, 0x39, 0x0a, 0xe1, 0xd5, 0x9e, 0xe7,
-	0xf5, 0x1c, 0x12, 0xa3, 0xba, 0x94, 0x38, 0xb6, 0x39, 0xb0, 0x78, 0x3f, 0x44, 0xdc, 0x99, 0x44,
-	0x08, 0x3a, 0x20, 0x5c, 0x58, 0x03, 0x3f, 0x04, 0x6c, 0x4c, 0xbb, 0xd8, 0xf1, 0x5c, 0x61, 0x75,
-	0x84, 0x49, 0xdd, 0x6e, 0x64, 0x68, 0x7d, 0x1a, 0x45, 0xdc, 0xe1, 0x80, 0x87, 0xdb, 0x5f, 0x9a,
-	0xde, 0xa6, 0x36, 0x71, 0x05, 0xed, 0x52, 0xc2, 0x22, 0xd0, 0x9d, 0x69, 0x90, 0x4f, 0x3b, 0x62,
-	0xc8, 0x22, 0xe7, 0x2b, 0xd3, 0x00, 0x46, 0x7b, 0x87, 0x22, 0x54, 0xa0, 0xff, 0x0b, 0x20, 0xf3,
-	0x1e, 0x27, 0x0c, 0xbf, 0x0d, 0x69, 0x6a, 0x73, 0x0d, 0x55, 0xd1, 0x66, 0xb1, 0x79, 0xa7, 0x7e,
-	0xf6, 0x1a, 0xea, 0x01, 0x64, 0x37, 0xb6, 0xde, 0xce, 0x9f, 0xb6, 0xb3, 0x3f, 0x45, 0xa9, 0x12,
-	0x32, 0x02, 0x29, 0xfc, 0x00, 0xa0, 0xc3, 0x88, 0x25, 0x88, 0x6d, 0x5a, 0x42, 0x4b, 0x49, 0x1d,
-	0x6b, 0x75, 0x15, 0xa6, 0x7a, 0x14, 0xa6, 0xfa, 0xb3, 0x28, 0x4c, 0xed, 0xcc, 0x67, 0x7f, 0xbb,
-	0x83, 0x8c, 0x42, 0x28, 0xd3, 0x12, 0x81, 0x82, 0xa1, 0x6f, 0x47, 0x0a, 0xd2, 0xf3, 0x2a, 0x08,
-	0x65, 0x94, 0x02, 0x9b, 0x38, 0x24, 0x54, 0x70, 0x63, 0x5e, 0x05, 0xa1, 0x4c, 0x4b, 0xe0, 0xdb,
-	0x90, 0x71, 0xad, 0x01, 0xd1, 0x32, 0x55, 0xb4, 0x59, 0x68, 0x2f, 0x9e, 0xb6, 0x33, 0x2c, 0xa5,
-	0x35, 0x0d, 0xb9, 0x88, 0xb7, 0xa0, 0x68, 0x13, 0xde, 0x61, 0xd4, 0x17, 0xd4, 0x73, 0xb5, 0xac,
-	0xc4, 0x04, 0x31, 0x60, 0x69, 0xed, 0xcf, 0xcb, 0x46, 0x72, 0x13, 0x7f, 0x0c, 0x60, 0x09, 0xc1,
-	0xe8, 0xc1, 0x50, 0x10, 0xae, 0xe5, 0xaa, 0xe9, 0xcd, 0x62, 0x73, 0x63, 0x56, 0x3c, 0xeb, 0xad,
-	0x31, 0xec, 0x91, 0x2b, 0xd8, 0xa8, 0x7d, 0xf7, 0xb4, 0xdd, 0xfc, 0x25, 0x6a, 0x94, 0x40, 0xdf,
-	0x60, 0xba, 0xb6, 0xd1, 0xac, 0x7c, 0xe7, 0x7d, 0xab, 0xf6, 0xbd, 0xd7, 0x6b, 0xf7, 0x3f, 0xd8,
-	0x7c, 0xf0, 0xd6, 0xfb, 0xb5, 0x0f, 0x1e, 0x44, 0xd3, 0xd7, 0x3e, 0x6e, 0x7e, 0xf5, 0xfb, 0x1b,
-	0x5b, 0x81, 0xf5, 0x3f, 0x22, 0x23, 0x61, 0x0e, 0x7f, 0x13, 0xae, 0x25, 0x33, 0x4d, 0x5b, 0x94,
-	0xe6, 0x6f, 0x4f, 0x9a, 0x7f, 0x47, 0x61, 0x76, 0xdd, 0xae, 0x27, 0xdd, 0x78, 0x8e, 0x52, 0x25,
-	0x30, 0x8a, 0x9d, 0x78, 0x19, 0xbf, 0x0d, 0x2b, 0x3e, 0xa3, 0x03, 0x8b, 0x8d, 0x4c, 0x32, 0xb0,
-	0xa8, 0x63, 0x5a, 0xb6, 0xcd, 0x08, 0xe7, 0x5a, 0x3e, 0x11, 0xa0, 0xef, 0x22, 0xe3, 0x46, 0x88,
-	0x7a, 0x14, 0x80, 0x5a, 0x0a, 0x83, 0x1d, 0xd0, 0x67, 0x0a, 0x9b, 0x51, 0x69, 0xca, 0x5b, 0x2a,
-	0xcc, 0x79, 0x4b, 0x95, 0x19, 0x26, 0xbe, 0x15, 0x29, 0x6a, 0x09, 0xbc, 0x01, 0x79, 0xdf, 0xe2,
-	0xfc, 0xd8, 0x63, 0xb6, 0x06, 0xc9, 0xab, 0x79, 0xb1, 0x68, 0x8c, 0x77, 0xf0, 0x1e, 0xdc, 0x88,
-	0xc6, 0x66, 0x22, 0xd7, 0x8a, 0x73, 0x1e, 0xa2, 0x1c, 0x09, 0xbf, 0x37, 0xce, 0xb9, 0x7b, 0xb0,
-	0xca, 0xc8, 0x87, 0x43, 0xca, 0x88, 0x39, 0xa1, 0x59, 0xbb, 0x56, 0x45, 0x9b, 0x79, 0x63, 0x25,
-	0xdc, 0xde, 0x3b, 0x23, 0x8a, 0xef, 0x42, 0x96, 0x8b, 0x00, 0x75, 0xbd, 0x8a, 0x36, 0x97, 0x9a,
-	0x2b, 0x93, 0xb7, 0xb3, 0x1f, 0x6c, 0x4a, 0x1f, 0x3e, 0x95, 0x25, 0xa6, 0xd0, 0xf8, 0x2e, 0x94,
-	0xe5, 0xc0, 0x4c, 0xa6, 0xe2, 0xcd, 0xa4, 0xbf, 0x9f, 0x20, 0xa3, 0x24, 0x21, 0x0f, 0x13, 0xf9,
-	0x78, 0x13, 0xb2, 0x96, 0x3d, 0xa0, 0xae, 0xb6, 0x24, 0xcf, 0xa4, 0x26, 0xf8, 0x0d, 0xc0, 0x82,
-	0x0c, 0x7c, 0x8f, 0x05, 0x77, 0x34, 0x8e, 0xde, 0xf2, 0x44, 0xf4, 0xca, 0x63, 0x4c, 0xe4, 0x02,
-	0xee, 0xc0, 0xfa, 0xb4, 0xa0, 0x99, 0xa8, 0xfe, 0xd2, 0x9c, 0x01, 0x5d, 0x9b, 0xd2, 0xfd, 0xce,
-	0x98, 0x0e, 0x66, 0x1b, 0x21, 0x1f, 0xf9, 0x94, 0x11, 0x1e, 0x18, 0x29, 0xbf, 0xb2, 0x91, 0x47,
-	0x4a, 0x49, 0x4b, 0xe0, 0x1d, 0x58, 0xf6, 0x99, 0xd7, 0xa5, 0x0e, 0x31, 0x43, 0xce, 0xd4, 0xb0,
-	0x54, 0xbb, 0x3a, 0x79, 0x21, 0x7b, 0x6a, 0xdb, 0x58, 0x0a, 0xf1, 0xe1, 0x7c, 0xed, 0xeb, 0xb0,
-	0x3c, 0x51, 0xc3, 0xb8, 0x04, 0xe9, 0x3e, 0x19, 0x49, 0x1a, 0x2d, 0x18, 0xc1, 0x30, 0x88, 0xff,
-	0x91, 0xe5, 0x0c, 0x89, 0xa4, 0xc5, 0x82, 0xa1, 0x26, 0x6f, 0xa5, 0xde, 0x44, 0xfa, 0x36, 0x64,
-	0x03, 0x1e, 0xe0, 0x78, 0x0b, 0xb2, 0x41, 0x0b, 0x0c, 0xd8, 0x37, 0x28, 0xd7, 0x9b, 0xb3, 0xd8,
-	0xc2, 0x50, 0x10, 0xfd, 0x17, 0x08, 0x96, 0x1e, 0x13, 0x21, 0x97, 0xc8, 0x87, 0x43, 0xc2, 0x05,
-	0x7e, 0x08, 0xf9, 0x60, 0xcf, 0x7c, 0x25, 0xfe, 0x5e, 0x1c, 0xca, 0x2d, 0x8e, 0xef, 0x03, 0xc4,
-	0x9d, 0xee, 0x3f, 0x72, 0xf8, 0x37, 0x02, 0xc8, 0xbb, 0x16, 0xef, 0x1b, 0x85, 0x6e, 0x34, 0xd4,
-	0x7f, 0x9f, 0x82, 0xd2, 0x53, 0xca, 0xe5, 0xa1, 0x78, 0x74, 0xaa, 0xb3, 0xfa, 0xd0, 0x39, 0xf4,
-	0xe1, 0x5f, 0x21, 0xc8, 0x7a, 0xcc, 0x26, 0x4c, 0xc5, 0xac, 0xfd, 0x73, 0x74, 0xda, 0xfe, 0x19,
-	0x62, 0x3f, 0x46, 0xc6, 0x82, 0x3a, 0xaf, 0x49, 0x6d, 0x23, 0x5f, 0x8b, 0x46, 0x92, 0xa3, 0x8d,
-	0x6c, 0x4d, 0xfe, 0xcc, 0x66, 0x2d, 0xe3, 0x56, 0x6d, 0xf6, 0xba, 0xaa, 0x2d, 0x23, 0x57, 0x53,
-	0xbf, 0xaa, 0x3a, 0x8c, 0x5c, 0x4d, 0xfd, 0x26, 0xba, 0x9a, 0x51, 0xac, 0x25, 0x26, 0xea, 0x78,
-	0xb8, 0x02, 0x59, 0x87, 0x0e, 0xa8, 0xea, 0x58, 0xd7, 0x65, 0x54, 0xb7, 0x64, 0xe1, 0xa8, 0x65,
-	0x8c, 0x21, 0xe3, 0x5b, 0x3d, 0xd5, 0x54, 0xae, 0x1b, 0x72, 0x8c, 0x35, 0x58, 0x0c, 0xbb, 0x8e,
-	0xec, 0x23, 0x79, 0x23, 0x9a, 0xea, 0x0c, 0xca, 0xaa, 0x04, 0x92, 0x97, 0xdb, 0x84, 0x4c, 0xe0,
-	0x67, 0x18, 0xc0, 0x99, 0xa9, 0x91, 0xb8, 0x4d, 0x89, 0xc5, 0xaf, 0x41, 0x89, 0xba, 0x47, 0x54,
-	0x58, 0x01, 0x01, 0x98, 0xc2, 0xeb, 0x13, 0x37, 0xcc, 0xbe, 0xe5, 0x78, 0xfd, 0x59, 0xb0, 0xac,
-	0x7f, 0x8a, 0xa0, 0xac, 0x68, 0xe9, 0xa2, 0x46, 0x2f, 0x90, 0x3f, 0x5d, 0xa8, 0x28, 0xc7, 0x9f,
-	0x4d, 0x56, 0xeb, 0xa5, 0xa6, 0xb8, 0xfe, 0x07, 0x04, 0x5f, 0x88, 0x9d, 0xbd, 0x12, 0x1b, 0x78,
-	0x0d, 0xd2, 0x2e, 0x39, 0x0e, 0x13, 0x37, 0x66, 0xd2, 0x60, 0x31, 0xd8, 0xf3, 0x1c, 0x5b, 0x26,
-	0xcb, 0x99, 0x3d, 0xcf, 0xb1, 0xf1, 0x16, 0x94, 0x19, 0x39, 0xf2, 0xfa, 0xc4, 0xb4, 0x1c, 0xc7,
-	0xb4, 0x3a, 0x9d, 0xa0, 0xd7, 0x66, 0x64, 0x82, 0x2c, 0xab, 0x8d, 0x96, 0xe3, 0xb4, 0xe4, 0xb2,
-	0xfe, 0x1c, 0xc1, 0xad, 0xa8, 0xde, 0x5a, 0x7b, 0xbb, 0x4f, 0xc8, 0x88, 0x5f, 0xae, 0x13, 0xe3,
-	0xbc, 0x4e, 0xbd, 0x3c, 0xaf, 0xd3, 0x71, 0x5e, 0xeb, 0x1c, 0x6e, 0x86, 0xbc, 0xa4, 0x8e, 0x74,
-	0xb9, 0x27, 0x5a, 0x81, 0x5c, 0x9f, 0x8c, 0x4c, 0x6a, 0x47, 0x34, 0xda, 0x27, 0xa3, 0x5d, 0x5b,
-	0xff, 0x51, 0x0a, 0x56, 0xe3, 0x9a, 0xb9, 0x0a, 0xc3, 0xd1, 0xbb, 0x30, 0x35, 0xeb, 0x5d, 0xb8,
-	0x03, 0x39, 0xf5, 0x9a, 0xd6, 0xd2, 0xd5, 0xf4, 0xac, 0x56, 0x6e, 0x04, 0xbb, 0xed, 0xf2, 0x69,
-	0x7b, 0xe9, 0x39, 0x2a, 0xe6, 0x91, 0x86, 0xf4, 0xb0, 0xa7, 0x87, 0x72, 0xf8, 0x31, 0x40, 0xa2,
-	0xad, 0x65, 0xfe, 0x6b, 0x5b, 0xbb, 0x76, 0xda, 0xce, 0xfe, 0x06, 0xa5, 0x76, 0x90, 0x7a, 0xbf,
-	0x92, 0xa8, 0x9b, 0xe9, 0x7f, 0x41, 0xb0, 0x1a, 0xe7, 0xf6, 0x55, 0x44, 0xe2, 0x3e, 0x2c, 0x5a,
-	0x3e, 0x35, 0x83, 0xf6, 0xa6, 0xaa, 0xfb, 0xd6, 0xa4, 0x12, 0x65, 0x35, 0x21, 0x9b, 0xb3, 0x7c,
-	0xfa, 0x84, 0x8c, 0x26, 0xb8, 0x21, 0x7d, 0x1e, 0x6e, 0xf8, 0x49, 0x1a, 0x60, 0x77, 0x4c, 0x5a,
-	0x78, 0x1d, 0xb2, 0x92, 0xc0, 0x55, 0x87, 0x8d, 0x9f, 0xa1, 0x6a, 0x35, 0x68, 0xb6, 0x49, 0xba,
-	0x53, 0x93, 0xe0, 0xe3, 0x20, 0x11, 0xe4, 0xb9, 0xbf, 0x2e, 0xc6, 0xc1, 0x9d, 0xf8, 0xbe, 0xc9,
-	0x5c, 0xf4, 0xfb, 0x26, 0x7b, 0xfe, 0xef, 0x9b, 0x16, 0x14, 0x03, 0x4e, 0xf0, 0x43, 0x0d, 0xb9,
-	0x39, 0x35, 0x40, 0x24, 0x24, 0xdf, 0x3b, 0xb1, 0x8a, 0x83, 0x91, 0xb6, 0x38, 0x57, 0x22, 0xc4,
-	0x1a, 0xda, 0x23, 0xfd, 0xa9, 0xa2, 0x9d, 0xf8, 0x3a, 0xc6, 0xb4, 0x33, 0x26, 0x0c, 0xf4, 0x72,
-	0xc2, 0x48, 0x25, 0x08, 0xe3, 0x09, 0x14, 0x13, 0x9a, 0xf0, 0xd7, 0xa0, 0x18, 0x37, 0xa7, 0xe8,
-	0x29, 0xb4, 0x36, 0x79, 0xbc, 0x58, 0xc2, 0x48, 0xc2, 0xf5, 0x7b, 0xb0, 0xb2, 0x4f, 0x5c, 0x3b,
-	0xb1, 0x1d, 0x9e, 0xec, 0xe5, 0x09, 0xa3, 0xbf, 0x09, 0xab, 0x0f, 0x65, 0xfb, 0x3d, 0xb7, 0xe4,
-	0x0f, 0x11, 0xdc, 0x0a, 0x82, 0xb5, 0x4f, 0x38, 0xa7, 0x9e, 0x9b, 0x88, 0xd9, 0x25, 0xd5, 0xdb,
-	0x57, 0x00, 0xb8, 0xd2, 0x3d, 0xa6, 0xbd, 0x88, 0x7f, 0x76, 0x8c, 0x02, 0x8f, 0xcc, 0xea, 0xff,
-	0x48, 0x41, 0x31, 0x71, 0x90, 0xff, 0xad, 0xf5, 0x89, 0xd2, 0x48, 0x5f, 0xb4, 0x34, 0x32, 0xaf,
-	0xf4, 0xe9, 0x9f, 0xa8, 0xee, 0xec, 0xf9, 0xab, 0xfb, 0xcb, 0xb0, 0x14, 0xb9, 0xca, 0x49, 0x87,
-	0x11, 0x55, 0x5e, 0x05, 0xe3, 0x7a, 0xb8, 0xba, 0x2f, 0x17, 0xf5, 0xc7, 0x70, 0x2d, 0x11, 0x66,
-	0x8e, 0xdf, 0x80, 0x7c, 0x08, 0x88, 0xb2, 0xf5, 0xf6, 0xac, 0x38, 0x87, 0x78, 0x63, 0x0c, 0xd6,
-	0xff, 0x84, 0x60, 0x35, 0x6a, 0xdf, 0x91, 0xb6, 0xcb, 0xa5, 0xea, 0x7b, 0x67, 0xdf, 0xcf, 0xd5,
-	0xd3, 0xf6, 0x3a, 0xbb, 0x6d, 0x2c, 0x5c, 0xc5, 0x7b, 0x56, 0xff, 0x6d, 0x0a, 0xe0, 0xa9, 0xd7,
-	0xa3, 0xea, 0x41, 0x79, 0x49, 0x0e, 0xfc, 0x5f, 0xfc, 0xa1, 0x74, 0xae, 0xc6, 0x3c, 0x95, 0x55,
-	0xe3, 0x56, 0x94, 0x4d, 0xb6, 0x22, 0x2c, 0x5f, 0xd6, 0xb6, 0xcc, 0xb0, 0xbc, 0x7c, 0x39, 0xdb,
-	0xfa, 0x0f, 0xa2, 0x37, 0x4c, 0x1c, 0xc6, 0xcb, 0x4d, 0x87, 0x75, 0x00, 0xde, 0xa7, 0xbe, 0xfa,
-	0xf4, 0x91, 0xd1, 0xcc, 0x1b, 0x85, 0x60, 0x45, 0xfe, 0xa3, 0xa2, 0xbf, 0x0e, 0xda, 0xb4, 0x7d,
-	0xee, 0x7b, 0x2e, 0x27, 0xb1, 0x17, 0x28, 0xe1, 0x45, 0xfb, 0xdd, 0xbf, 0xfe, 0xbd, 0xb2, 0xf0,
-	0xc9, 0x49, 0x05, 0xfd, 0xfa, 0xa4, 0x82, 0x5e, 0x9c, 0x54, 0x16, 0xfe, 0x79, 0x52, 0x41, 0x9f,
-	0x7d, 0x5e, 0x59, 0xf8, 0xdd, 0xe7, 0x15, 0xf4, 0xed, 0x46, 0xcf, 0xab, 0x8b, 0x43, 0x22, 0x0e,
-	0xa9, 0xdb, 0xe3, 0x75, 0x97, 0x88, 0x63, 0x8f, 0xf5, 0x1b, 0x67, 0xff, 0x88, 0x3c, 0xda, 0x6e,
-	0xf8, 0xfd, 0x5e, 0x43, 0x08, 0xd7, 0x3f, 0x38, 0xc8, 0xc9, 0x78, 0x6e, 0xff, 0x3b, 0x00, 0x00,
-	0xff, 0xff, 0x95, 0xc8, 0x7c, 0x62, 0x1b, 0x16, 0x00, 0x00,
+	// 1713 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x8f, 0x1b, 0x49,
+	0x15, 0x9f, 0xf2, 0xc7, 0x8c, 0xfd, 0x3c, 0x99, 0x8f, 0x4a, 0x26, 0xd3, 0x4c, 0x18, 0x67, 0xd4,
+	0x0c, 0x68, 0x76, 0x84, 0xed, 0x95, 0xa3, 0x64, 0x37, 0xbb, 0xa0, 0xc4, 0xde, 0x64, 0xc3, 0x28,
+	0x59, 0x29, 0xaa, 0x64, 0x39, 0xb0, 0x5a, 0x7a, 0x7b, 0xdc, 0x65, 0xa7, 0xe4, 0x76, 0x77, 0x6f,
+	0x55, 0x79, 0xb2, 0x66, 0x05, 0x5a, 0xed, 0x01, 0xf1, 0x71, 0x60, 0xc9, 0x91, 0x3f, 0x00, 0x71,
+	0xe6, 0x1f, 0x80, 0x03, 0x48, 0x48, 0x1c, 0xe0, 0x82, 0xc4, 0x0d, 0x91, 0x15, 0xd2, 0x1e, 0xb9,
+	0x32, 0x27, 0x54, 0x55, 0xdd, 0xee, 0x1e, 0xdb, 0x04, 0x4f, 0x32, 0x41, 0x9c, 0x5c, 0x1f, 0xbf,
+	0xf7, 0x5e, 0xbd, 0x57, 0xef, 0xfd, 0x5e, 0xb5, 0xe1, 0xcb, 0x7e, 0xc8, 0xdd, 0xc7, 0x6e, 0x50,
+	0x13, 0xd2, 0xed, 0xf4, 0x1b, 0x6e, 0xc4, 0x1a, 0x43, 0x41, 0x79, 0x3d, 0xe2, 0xa1, 0x0c, 0xf1,
+	0x8a, 0x94, 0x41, 0x3d, 0x46, 0xd4, 0x8f, 0xae, 0x6c, 0xb5, 0x7a, 0x4c, 0x3e, 0x1a, 0x1e, 0xd6,
+	0x3b, 0xe1, 0xa0, 0x41, 0x83, 0xa3, 0x70, 0x14, 0xf1, 0xf0, 0xa3, 0x51, 0x43, 0x83, 0x3b, 0xb5,
+	0x1e, 0x0d, 0x6a, 0x47, 0xae, 0xcf, 0x3c, 0x57, 0xd2, 0xc6, 0xd4, 0xc0, 0xa8, 0xdc, 0xaa, 0x65,
+	0x54, 0xf4, 0xc2, 0x5e, 0x68, 0x84, 0x0f, 0x87, 0x5d, 0x3d, 0xd3, 0x13, 0x3d, 0x8a, 0xe1, 0x3b,
+	0xbd, 0x30, 0xec, 0xf9, 0x34, 0x45, 0x75, 0x19, 0xf5, 0x3d, 0x67, 0xe0, 0x8a, 0x7e, 0x8c, 0xb8,
+	0x3c, 0x89, 0x90, 0x6c, 0x40, 0x85, 0x74, 0x07, 0x51, 0x0c, 0xd8, 0x9d, 0x76, 0xb1, 0x13, 0x06,
+	0xd2, 0xed, 0x48, 0x87, 0x05, 0xdd, 0xc4, 0xd0, 0xf6, 0x34, 0x8a, 0x06, 0xc3, 0x81, 0x88, 0xb7,
+	0xbf, 0x32, 0xbd, 0xcd, 0x3c, 0x1a, 0x48, 0xd6, 0x65, 0x94, 0x27, 0xa0, 0xcb, 0xd3, 0xa0, 0x88,
+	0x75, 0xe4, 0x90, 0x27, 0xce, 0x57, 0xa7, 0x01, 0x9c, 0xf5, 0x1e, 0xc9, 0x58, 0x81, 0xfd, 0x2f,
+	0x80, 0xc2, 0xbb, 0x82, 0x72, 0xfc, 0x26, 0xe4, 0x99, 0x27, 0x2c, 0xb4, 0x83, 0xf6, 0x2a, 0xcd,
+	0xcb, 0xf5, 0x93, 0xd7, 0x50, 0x57, 0x90, 0x83, 0xd4, 0x7a, 0xbb, 0x74, 0xdc, 0x2e, 0xfe, 0x04,
+	0xe5, 0xd6, 0x10, 0x51, 0x52, 0xf8, 0x06, 0x40, 0x87, 0x53, 0x57, 0x52, 0xcf, 0x71, 0xa5, 0x95,
+	0xd3, 0x3a, 0xb6, 0xea, 0x26, 0x4c, 0xf5, 0x24, 0x4c, 0xf5, 0x87, 0x49, 0x98, 0xda, 0x85, 0xcf,
+	0xfe, 0x76, 0x19, 0x91, 0x72, 0x2c, 0xd3, 0x92, 0x4a, 0xc1, 0x30, 0xf2, 0x12, 0x05, 0xf9, 0x79,
+	0x15, 0xc4, 0x32, 0x46, 0x81, 0x47, 0x7d, 0x1a, 0x2b, 0x38, 0x3f, 0xaf, 0x82, 0x58, 0xa6, 0x25,
+	0xf1, 0x25, 0x28, 0x04, 0xee, 0x80, 0x5a, 0x85, 0x1d, 0xb4, 0x57, 0x6e, 0x2f, 0x1d, 0xb7, 0x0b,
+	0x3c, 0x67, 0x35, 0x89, 0x5e, 0xc4, 0xfb, 0x50, 0xf1, 0xa8, 0xe8, 0x70, 0x16, 0x49, 0x16, 0x06,
+	0x56, 0x51, 0x63, 0x54, 0x0c, 0x78, 0xde, 0xfa, 0xf3, 0x2a, 0xc9, 0x6e, 0xe2, 0x8f, 0x01, 0x5c,
+	0x29, 0x39, 0x3b, 0x1c, 0x4a, 0x2a, 0xac, 0xc5, 0x9d, 0xfc, 0x5e, 0xa5, 0xb9, 0x3b, 0x2b, 0x9e,
+	0xf5, 0xd6, 0x18, 0x76, 0x3b, 0x90, 0x7c, 0xd4, 0xbe, 0x7a, 0xdc, 0x6e, 0xfe, 0x02, 0x35, 0xd6,
+	0xc0, 0xde, 0xe5, 0xb6, 0xb5, 0xdb, 0xac, 0x7e, 0xf7, 0x3d, 0xb7, 0xf6, 0xbd, 0x57, 0x6b, 0xd7,
+	0xdf, 0xdf, 0xbb, 0xf1, 0xc6, 0x7b, 0xb5, 0xf7, 0x6f, 0x24, 0xd3, 0x57, 0x3e, 0x6e, 0x7e, 0xfd,
+	0xfb, 0xbb, 0xfb, 0xca, 0xfa, 0x1f, 0x10, 0xc9, 0x98, 0xc3, 0xdf, 0x82, 0xe5, 0x6c, 0xa6, 0x59,
+	0x4b, 0xda, 0xfc, 0xa5, 0x49, 0xf3, 0x6f, 0x19, 0xcc, 0x41, 0xd0, 0x0d, 0xb5, 0x1b, 0x4f, 0x50,
+	0x6e, 0x0d, 0x48, 0xa5, 0x93, 0x2e, 0xe3, 0x37, 0x61, 0x23, 0xe2, 0x6c, 0xe0, 0xf2, 0x91, 0x43,
+	0x07, 0x2e, 0xf3, 0x1d, 0xd7, 0xf3, 0x38, 0x15, 0xc2, 0x2a, 0x65, 0x02, 0xf4, 0x01, 0x22, 0xe7,
+	0x63, 0xd4, 0x6d, 0x05, 0x6a, 0x19, 0x0c, 0xf6, 0xc1, 0x9e, 0x29, 0xec, 0x24, 0xa5, 0xa9, 0x6f,
+	0xa9, 0x3c, 0xe7, 0x2d, 0x55, 0x67, 0x98, 0xf8, 0x76, 0xa2, 0xa8, 0x25, 0xf1, 0x2e, 0x94, 0x22,
+	0x57, 0x88, 0xc7, 0x21, 0xf7, 0x2c, 0xc8, 0x5e, 0xcd, 0x17, 0x4b, 0x64, 0xbc, 0x83, 0xef, 0xc3,
+	0xf9, 0x64, 0xec, 0x64, 0x72, 0xad, 0x32, 0xe7, 0x21, 0xd6, 0x13, 0xe1, 0x77, 0xc7, 0x39, 0x77,
+	0x0d, 0x36, 0x39, 0xfd, 0x70, 0xc8, 0x38, 0x75, 0x26, 0x34, 0x5b, 0xcb, 0x3b, 0x68, 0xaf, 0x44,
+	0x36, 0xe2, 0xed, 0xfb, 0x27, 0x44, 0xf1, 0x55, 0x28, 0x0a, 0xa9, 0x50, 0xe7, 0x76, 0xd0, 0xde,
+	0x4a, 0x73, 0x63, 0xf2, 0x76, 0x1e, 0xa8, 0x4d, 0xed, 0xc3, 0xa7, 0xba, 0xc4, 0x0c, 0x1a, 0x5f,
+	0x85, 0x75, 0x3d, 0x70, 0xb2, 0xa9, 0x78, 0x21, 0xeb, 0xef, 0x27, 0x88, 0xac, 0x69, 0xc8, 0xad,
+	0x4c, 0x3e, 0x5e, 0x80, 0xa2, 0xeb, 0x0d, 0x58, 0x60, 0xad, 0xe8, 0x33, 0x99, 0x09, 0x7e, 0x0d,
+	0xb0, 0xa4, 0x83, 0x28, 0xe4, 0xea, 0x8e, 0xc6, 0xd1, 0x5b, 0x9d, 0x88, 0xde, 0xfa, 0x18, 0x93,
+	0xb8, 0x80, 0x3b, 0xb0, 0x3d, 0x2d, 0xe8, 0x64, 0xaa, 0x7f, 0x6d, 0xce, 0x80, 0x6e, 0x4d, 0xe9,
+	0x7e, 0x6b, 0x4c, 0x07, 0xb3, 0x8d, 0xd0, 0x8f, 0x22, 0xc6, 0xa9, 0x50, 0x46, 0xd6, 0x9f, 0xdb,
+	0xc8, 0x6d, 0xa3, 0xa4, 0x25, 0xf1, 0x4d, 0x58, 0x8d, 0x78, 0xd8, 0x65, 0x3e, 0x75, 0x62, 0xce,
+	0xb4, 0xb0, 0x56, 0xbb, 0x39, 0x79, 0x21, 0xf7, 0xcd, 0x36, 0x59, 0x89, 0xf1, 0xf1, 0x7c, 0xeb,
+	0x9b, 0xb0, 0x3a, 0x51, 0xc3, 0x78, 0x0d, 0xf2, 0x7d, 0x3a, 0xd2, 0x34, 0x5a, 0x26, 0x6a, 0xa8,
+	0xe2, 0x7f, 0xe4, 0xfa, 0x43, 0xaa, 0x69, 0xb1, 0x4c, 0xcc, 0xe4, 0x8d, 0xdc, 0xeb, 0xc8, 0xbe,
+	0x02, 0x45, 0xc5, 0x03, 0x02, 0xef, 0x43, 0x51, 0xb5, 0x40, 0xc5, 0xbe, 0xaa, 0x5c, 0x2f, 0xcc,
+	0x62, 0x0b, 0x62, 0x20, 0xf6, 0xcf, 0x11, 0xac, 0xdc, 0xa1, 0x52, 0x2f, 0xd1, 0x0f, 0x87, 0x54,
+	0x48, 0x7c, 0x0b, 0x4a, 0x6a, 0xcf, 0x79, 0x2e, 0xfe, 0x5e, 0x1a, 0xea, 0x2d, 0x81, 0xaf, 0x03,
+	0xa4, 0x9d, 0xee, 0x3f, 0x72, 0xf8, 0xdb, 0x0a, 0xf2, 0x8e, 0x2b, 0xfa, 0xa4, 0xdc, 0x4d, 0x86,
+	0xf6, 0xef, 0x72, 0xb0, 0x76, 0x8f, 0x09, 0x7d, 0x28, 0x91, 0x9c, 0xea, 0xa4, 0x3e, 0x74, 0x0a,
+	0x7d, 0xf8, 0x97, 0x08, 0x8a, 0x21, 0xf7, 0x28, 0x37, 0x31, 0x6b, 0xff, 0x0c, 0x1d, 0xb7, 0x7f,
+	0x8a, 0xf8, 0x8f, 0x10, 0x59, 0x30, 0xe7, 0x75, 0x98, 0x47, 0x4a, 0xb5, 0x64, 0xa4, 0x39, 0x9a,
+	0x14, 0x6b, 0xfa, 0x67, 0x36, 0x6b, 0x91, 0x8b, 0xb5, 0xd9, 0xeb, 0xa6, 0xb6, 0xc8, 0x62, 0xcd,
+	0xfc, 0x9a, 0xea, 0x20, 0x8b, 0x35, 0xf3, 0x9b, 0xe9, 0x6a, 0xa4, 0x52, 0xcb, 0x4c, 0xcc, 0xf1,
+	0x70, 0x15, 0x8a, 0x3e, 0x1b, 0x30, 0xd3, 0xb1, 0xce, 0xe9, 0xa8, 0xee, 0xeb, 0xc2, 0x31, 0xcb,
+	0x18, 0x43, 0x21, 0x72, 0x7b, 0xa6, 0xa9, 0x9c, 0x23, 0x7a, 0x8c, 0x2d, 0x58, 0x8a, 0xbb, 0x8e,
+	0xee, 0x23, 0x25, 0x92, 0x4c, 0x6d, 0x0e, 0xeb, 0xa6, 0x04, 0xb2, 0x97, 0xdb, 0x84, 0x82, 0xf2,
+	0x33, 0x0e, 0xe0, 0xcc, 0xd4, 0xc8, 0xdc, 0xa6, 0xc6, 0xe2, 0x57, 0x60, 0x8d, 0x05, 0x47, 0x4c,
+	0xba, 0x8a, 0x00, 0x1c, 0x19, 0xf6, 0x69, 0x10, 0x67, 0xdf, 0x6a, 0xba, 0xfe, 0x50, 0x2d, 0xdb,
+	0x9f, 0x22, 0x58, 0x37, 0xb4, 0xf4, 0xa2, 0x46, 0x5f, 0x20, 0x7f, 0xba, 0x50, 0x35, 0x8e, 0x3f,
+	0x9c, 0xac, 0xd6, 0x33, 0x4d, 0x71, 0xfb, 0xf7, 0x08, 0xbe, 0x94, 0x3a, 0xfb, 0x52, 0x6c, 0xe0,
+	0x2d, 0xc8, 0x07, 0xf4, 0x71, 0x9c, 0xb8, 0x29, 0x93, 0xaa, 0x45, 0xb5, 0x17, 0xfa, 0x9e, 0x95,
+	0x9f, 0xdc, 0x0b, 0x7d, 0x0f, 0xef, 0xc3, 0x3a, 0xa7, 0x47, 0x61, 0x9f, 0x3a, 0xae, 0xef, 0x3b,
+	0x6e, 0xa7, 0xa3, 0x7a, 0x6d, 0x41, 0x27, 0xc8, 0xaa, 0xd9, 0x68, 0xf9, 0x7e, 0x4b, 0x2f, 0xdb,
+	0x4f, 0x10, 0x5c, 0x4c, 0xea, 0xad, 0x75, 0xff, 0xe0, 0x2e, 0x1d, 0x89, 0xb3, 0x75, 0x62, 0x9c,
+	0xd7, 0xb9, 0x67, 0xe7, 0x75, 0x3e, 0xcd, 0x6b, 0x5b, 0xc0, 0x85, 0x3b, 0x34, 0x73, 0xa4, 0xb3,
+	0x3d, 0xd1, 0x06, 0x2c, 0xf6, 0xe9, 0xc8, 0x61, 0x5e, 0x42, 0xa3, 0x7d, 0x3a, 0x3a, 0xf0, 0xec,
+	0x3f, 0xe5, 0x60, 0x33, 0xad, 0x99, 0x97, 0x61, 0x38, 0x79, 0x17, 0xe6, 0x66, 0xbd, 0x0b, 0x6f,
+	0xc2, 0xa2, 0x79, 0x4d, 0x5b, 0xf9, 0x9d, 0xfc, 0xac, 0x56, 0x4e, 0xd4, 0x6e, 0x7b, 0xfd, 0xb8,
+	0xbd, 0xf2, 0x04, 0x55, 0x4a, 0xc8, 0x42, 0x76, 0xdc, 0xd3, 0x63, 0x39, 0x7c, 0x07, 0x20, 0xd3,
+	0xd6, 0x0a, 0xff, 0xb5, 0xad, 0x2d, 0x1f, 0xb7, 0x8b, 0xbf, 0x46, 0xb9, 0x9b, 0xc8, 0xbc, 0x5f,
+	0xe9, 0xb8, 0x9b, 0xbd, 0x0d, 0xcb, 0x9c, 0x0a, 0xc9, 0x59, 0x47, 0x15, 0xb7, 0xd0, 0xdc, 0x52,
+	0x69, 0xda, 0x93, 0x07, 0x4a, 0x42, 0x94, 0x22, 0xc9, 0x09, 0x39, 0xfb, 0x2f, 0x08, 0x36, 0xd3,
+	0x1a, 0x79, 0x19, 0x11, 0xbd, 0x0e, 0x4b, 0x6e, 0xc4, 0x1c, 0xd5, 0x26, 0x0d, 0x4b, 0x5c, 0x9c,
+	0x7d, 0xc8, 0x8c, 0xec, 0xa2, 0x1b, 0xb1, 0xbb, 0x74, 0x34, 0xc1, 0x31, 0xf9, 0xd3, 0x70, 0xcc,
+	0x8f, 0xf3, 0x00, 0x07, 0x63, 0xf2, 0xc3, 0xdb, 0x50, 0xd4, 0x8d, 0xc0, 0x42, 0x99, 0x7b, 0xfd,
+	0x00, 0x11, 0xb3, 0xaa, 0x9a, 0x76, 0x96, 0x36, 0xcd, 0x44, 0x7d, 0x64, 0x64, 0x2e, 0x6b, 0xee,
+	0xaf, 0x94, 0xf4, 0x92, 0x4e, 0x7e, 0x27, 0x15, 0x5e, 0xf4, 0x3b, 0xa9, 0x78, 0xfa, 0xef, 0xa4,
+	0x16, 0x54, 0x14, 0xb7, 0x44, 0xb1, 0x86, 0xc5, 0x39, 0x35, 0x40, 0x22, 0xa4, 0xdf, 0x4d, 0xa9,
+	0x8a, 0xc3, 0x91, 0xb5, 0x34, 0x57, 0x22, 0xa4, 0x1a, 0xda, 0x23, 0xfb, 0x9e, 0xa1, 0xaf, 0xf4,
+	0x3a, 0xc6, 0xf4, 0x35, 0x26, 0x1e, 0xf4, 0x6c, 0xe2, 0xc9, 0x65, 0x88, 0xe7, 0x2e, 0x54, 0x32,
+	0x9a, 0xf0, 0x37, 0xa0, 0x92, 0x36, 0xb9, 0xe4, 0x49, 0xb5, 0x35, 0x79, 0xbc, 0x54, 0x82, 0x64,
+	0xe1, 0xf6, 0x35, 0xd8, 0x78, 0x40, 0x03, 0x2f, 0xb3, 0x1d, 0x9f, 0xec, 0xd9, 0x09, 0x63, 0xbf,
+	0x0e, 0x9b, 0xb7, 0x74, 0x1b, 0x3f, 0xb5, 0xe4, 0x0f, 0x11, 0x5c, 0x54, 0xc1, 0x7a, 0x40, 0x85,
+	0x60, 0x61, 0x90, 0x89, 0xd9, 0x19, 0xd5, 0xdb, 0xd7, 0x00, 0x84, 0xd1, 0x3d, 0xa6, 0xcf, 0x84,
+	0xc7, 0x6e, 0x92, 0xb2, 0x48, 0xcc, 0xda, 0xff, 0xc8, 0x41, 0x25, 0x73, 0x90, 0xff, 0xad, 0xf5,
+	0x89, 0xd2, 0xc8, 0xbf, 0x68, 0x69, 0x14, 0x9e, 0xeb, 0x2f, 0x84, 0x4c, 0x75, 0x17, 0x4f, 0x5f,
+	0xdd, 0x5f, 0x85, 0x95, 0xc4, 0x55, 0x41, 0x3b, 0x9c, 0x9a, 0xf2, 0x2a, 0x93, 0x73, 0xf1, 0xea,
+	0x03, 0xbd, 0x68, 0xdf, 0x81, 0xe5, 0x4c, 0x98, 0x05, 0x7e, 0x0d, 0x4a, 0x31, 0x20, 0xc9, 0xd6,
+	0x4b, 0xb3, 0xe2, 0x1c, 0xe3, 0xc9, 0x18, 0x6c, 0xff, 0x11, 0xc1, 0x66, 0xf2, 0x0c, 0x48, 0xb4,
+	0x9d, 0x2d, 0x55, 0x5f, 0x3b, 0xf9, 0x0e, 0xdf, 0x39, 0x6e, 0x6f, 0xf3, 0x4b, 0x64, 0xe1, 0x65,
+	0xbc, 0x8b, 0xed, 0xdf, 0xe4, 0x00, 0xee, 0x85, 0x3d, 0x66, 0x1e, 0xa6, 0x67, 0xe4, 0xc0, 0xff,
+	0xc5, 0x1f, 0x53, 0xa7, 0x6a, 0xf0, 0x53, 0x59, 0x35, 0x6e, 0x45, 0xc5, 0x6c, 0x2b, 0xc2, 0xfa,
+	0x85, 0xee, 0xe9, 0x0c, 0x2b, 0xe9, 0x17, 0xb8, 0x67, 0xff, 0x20, 0x79, 0x0b, 0xa5, 0x61, 0x3c,
+	0xdb, 0x74, 0xd8, 0x06, 0x10, 0x7d, 0x16, 0x99, 0x4f, 0x28, 0x1d, 0xcd, 0x12, 0x29, 0xab, 0x15,
+	0xfd, 0xcf, 0x8c, 0xfd, 0x2a, 0x58, 0xd3, 0xf6, 0x45, 0x14, 0x06, 0x82, 0xa6, 0x5e, 0xa0, 0x8c,
+	0x17, 0xed, 0x77, 0xfe, 0xfa, 0xf7, 0xea, 0xc2, 0x27, 0x4f, 0xab, 0xe8, 0x57, 0x4f, 0xab, 0xe8,
+	0x8b, 0xa7, 0xd5, 0x85, 0x7f, 0x3e, 0xad, 0xa2, 0xcf, 0x3e, 0xaf, 0x2e, 0xfc, 0xf6, 0xf3, 0x2a,
+	0xfa, 0x4e, 0xa3, 0x17, 0xd6, 0xe5, 0x23, 0x2a, 0x1f, 0xb1, 0xa0, 0x27, 0xea, 0x01, 0x95, 0x8f,
+	0x43, 0xde, 0x6f, 0x9c, 0xfc, 0x43, 0xf3, 0xe8, 0x4a, 0x23, 0xea, 0xf7, 0x1a, 0x52, 0x06, 0xd1,
+	0xe1, 0xe1, 0xa2, 0x8e, 0xe7, 0x95, 0x7f, 0x0f, 0x00, 0xeb, 0x09, 0xc4, 0x51, 0x63, 0x16, 0x00,
+	0x00,
 }
 
 func (this *User) Equal(that interface{}) bool {
@@ -2007,6 +2017,9 @@ func (this *CreateUserAPIKeyRequest) Equal(that interface{}) bool {
 	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
 		return false
 	}
+	if !this.Restrictions.Equal(that1.Restrictions) {
+		return false
+	}
 	return true
 }
 func (this *UpdateUserAPIKeyRequest) Equal(that interface{}) bool {
@@ -2604,6 +2617,7 @@ func (this *CreateUserAPIKeyRequest) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
 		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Restrictions:` + strings.Replace(fmt.Sprintf("%v", this.Restrictions), "APIKeyRestrictions", "APIKeyRestrictions", 1) + `,`,
 		`}`,
 	}, "")
 	return s
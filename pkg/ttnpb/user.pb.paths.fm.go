@@ -207,6 +207,11 @@ var GetUserAPIKeyRequestFieldPathsTopLevel = []string{
 var CreateUserAPIKeyRequestFieldPathsNested = []string{
 	"expires_at",
 	"name",
+	"restrictions",
+	"restrictions.end_device_attributes",
+	"restrictions.end_device_ids",
+	"restrictions.methods",
+	"restrictions.source_cidrs",
 	"rights",
 	"user_ids",
 	"user_ids.email",
@@ -216,6 +221,7 @@ var CreateUserAPIKeyRequestFieldPathsNested = []string{
 var CreateUserAPIKeyRequestFieldPathsTopLevel = []string{
 	"expires_at",
 	"name",
+	"restrictions",
 	"rights",
 	"user_ids",
 }
@@ -226,6 +232,11 @@ var UpdateUserAPIKeyRequestFieldPathsNested = []string{
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
+	"api_key.restrictions",
+	"api_key.restrictions.end_device_attributes",
+	"api_key.restrictions.end_device_ids",
+	"api_key.restrictions.methods",
+	"api_key.restrictions.source_cidrs",
 	"api_key.rights",
 	"api_key.updated_at",
 	"field_mask",
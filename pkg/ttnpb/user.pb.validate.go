@@ -1481,6 +1481,18 @@ func (m *CreateUserAPIKeyRequest) ValidateFields(paths ...string) error {
 
 			}
 
+		case "restrictions":
+
+			if v, ok := interface{}(m.GetRestrictions()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateUserAPIKeyRequestValidationError{
+						field:  "restrictions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return CreateUserAPIKeyRequestValidationError{
 				field:  name,
@@ -466,6 +466,12 @@ func (x *CreateUserAPIKeyRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 			s.WriteTime(*x.ExpiresAt)
 		}
 	}
+	if x.Restrictions != nil || s.HasField("restrictions") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("restrictions")
+		// NOTE: APIKeyRestrictions does not seem to implement MarshalProtoJSON.
+		gogo.MarshalMessage(s, x.Restrictions)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -501,6 +507,12 @@ func (x *CreateUserAPIKeyRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalStat
 				return
 			}
 			x.ExpiresAt = v
+		case "restrictions":
+			s.AddField("restrictions")
+			// NOTE: APIKeyRestrictions does not seem to implement UnmarshalProtoJSON.
+			var v APIKeyRestrictions
+			gogo.UnmarshalMessage(s, &v)
+			x.Restrictions = &v
 		}
 	})
 }
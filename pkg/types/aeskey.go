@@ -16,8 +16,10 @@ package types
 
 import (
 	"encoding/hex"
+	"fmt"
 	"strings"
 
+	"github.com/TheThingsIndustries/protoc-gen-go-json/jsonplugin"
 	"github.com/vmihailenco/msgpack/v5"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 )
@@ -63,6 +65,30 @@ func (key *AES128Key) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalProtoJSON implements the jsonplugin.Marshaler interface.
+func (key *AES128Key) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if key == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteString(fmt.Sprintf("%X", key[:]))
+}
+
+// UnmarshalProtoJSON implements the jsonplugin.Unmarshaler interface.
+func (key *AES128Key) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	*key = [16]byte{}
+	b, err := hex.DecodeString(s.ReadString())
+	if err != nil {
+		s.SetError(err)
+		return
+	}
+	if len(b) != 16 {
+		s.SetError(errInvalidAESKey.WithCause(errInvalidLength.WithAttributes("want", 16, "got", len(b))))
+		return
+	}
+	copy(key[:], b)
+}
+
 // MarshalBinary implements the encoding.BinaryMarshaler interface.
 func (key AES128Key) MarshalBinary() ([]byte, error) { return marshalBinaryBytes(key[:]) }
 
@@ -27,6 +27,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/random"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc/metadata"
 )
 
 func handler(c echo.Context) error {
@@ -147,3 +148,44 @@ func TestCookies(t *testing.T) {
 		a.So(err, should.NotBeNil)
 	}
 }
+
+func TestRealIPMetadata(t *testing.T) {
+	for _, tc := range []struct {
+		Name           string
+		TrustedProxies []string
+		Header         http.Header
+		ExpectedIP     string
+	}{
+		{
+			Name:       "Untrusted",
+			Header:     http.Header{"X-Real-Ip": []string{"203.0.113.1"}},
+			ExpectedIP: "192.0.2.1",
+		},
+		{
+			Name:           "Trusted",
+			TrustedProxies: []string{"192.0.2.0/24"},
+			Header:         http.Header{"X-Forwarded-For": []string{"203.0.113.1, 192.0.2.1"}},
+			ExpectedIP:     "203.0.113.1",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			s, err := New(test.Context(), WithTrustedProxies(tc.TrustedProxies...))
+			if !a.So(err, should.BeNil) {
+				t.Fatal("Could not create a web instance")
+			}
+
+			var md metadata.MD
+			s.Prefix("/ip").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				md, _ = metadata.FromIncomingContext(r.Context())
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/ip", nil)
+			for key, values := range tc.Header {
+				req.Header[key] = values
+			}
+			s.ServeHTTP(httptest.NewRecorder(), req)
+			a.So(md.Get("x-real-ip"), should.Resemble, []string{tc.ExpectedIP})
+		})
+	}
+}
@@ -51,6 +51,11 @@ export default {
   account: {
     login: credentials => instance.post(`${appRoot}/api/auth/login`, credentials),
     tokenLogin: credentials => instance.post(`${appRoot}/api/auth/token-login`, credentials),
+    mfaLogin: code => instance.post(`${appRoot}/api/auth/mfa`, code),
+    mfaLoginStartTotpEnrolment: () => instance.post(`${appRoot}/api/auth/mfa/totp`),
+    mfaLoginConfirmTotpEnrolment: code =>
+      instance.post(`${appRoot}/api/auth/mfa/totp/confirm`, code),
+    providers: () => instance.get(`${appRoot}/api/auth/providers`),
     logout: () => instance.post(`${appRoot}/api/auth/logout`),
     me: () => instance.get(`${appRoot}/api/me`),
   },
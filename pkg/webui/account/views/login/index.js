@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-import React, { useState, useCallback } from 'react'
+import React, { useState, useCallback, useEffect } from 'react'
 import { useLocation } from 'react-router-dom'
 import Query from 'query-string'
 import { defineMessages } from 'react-intl'
@@ -25,8 +25,10 @@ import Input from '@ttn-lw/components/input'
 import SubmitButton from '@ttn-lw/components/submit-button'
 
 import IntlHelmet from '@ttn-lw/lib/components/intl-helmet'
+import Message from '@ttn-lw/lib/components/message'
 
 import style from '@account/views/front/front.styl'
+import { MFALogin, MFAEnrolment } from '@account/views/login/mfa'
 
 import Yup from '@ttn-lw/lib/yup'
 import {
@@ -36,6 +38,7 @@ import {
 } from '@ttn-lw/lib/selectors/env'
 import sharedMessages from '@ttn-lw/lib/shared-messages'
 import { userId as userIdRegexp } from '@ttn-lw/lib/regexp'
+import { isBackend, getBackendErrorName } from '@ttn-lw/lib/errors/utils'
 
 import { selectEnableUserRegistration } from '@account/lib/selectors/app-config'
 
@@ -45,6 +48,8 @@ const m = defineMessages({
   loginToContinue: 'Please login to continue',
   loginFailed: 'Login failed',
   accountDeleted: 'Account deleted',
+  mfa: 'Multi-factor authentication',
+  loginWithProvider: 'Login with {name}',
 })
 
 const appRoot = selectApplicationRootPath()
@@ -74,9 +79,42 @@ const url = (location, omitQuery = false) => {
   return next
 }
 
+const providerLoginUrl = (id, location) => {
+  const { n } = Query.parse(location.search)
+  const query = n ? `?${Query.stringify({ n })}` : ''
+
+  return `${appRoot}/api/auth/providers/${encodeURIComponent(id)}/login${query}`
+}
+
+// The MFA step is set by the federated login callback, or after the first login step.
+const mfaSteps = {
+  mfa_required: 'true',
+  mfa_enrolment_required: 'enrol',
+}
+
 const Login = () => {
   const [error, setError] = useState(undefined)
   const location = useLocation()
+  const [mfaStep, setMFAStep] = useState(Query.parse(location.search).mfa)
+
+  const [providers, setProviders] = useState([])
+
+  useEffect(() => {
+    const fetchProviders = async () => {
+      try {
+        const { data } = await api.account.providers()
+        setProviders(data.providers)
+      } catch (error) {
+        // The login form remains usable without federated login providers.
+        setProviders([])
+      }
+    }
+    fetchProviders()
+  }, [])
+
+  const handleLogin = useCallback(() => {
+    window.location = url(location)
+  }, [location])
 
   const handleSubmit = useCallback(
     async (values, { setSubmitting }) => {
@@ -86,13 +124,17 @@ const Login = () => {
         const castedValues = validationSchema.cast(values)
         await api.account.login(castedValues)
 
-        window.location = url(location)
+        handleLogin()
       } catch (error) {
+        if (isBackend(error) && getBackendErrorName(error) in mfaSteps) {
+          setMFAStep(mfaSteps[getBackendErrorName(error)])
+          return
+        }
         setError(error)
         setSubmitting(false)
       }
     },
-    [location],
+    [handleLogin],
   )
 
   const initialValues = {
@@ -111,6 +153,25 @@ const Login = () => {
     info = m.accountDeleted
   }
 
+  if (mfaStep === 'true' || mfaStep === 'enrol') {
+    return (
+      <div className={style.form}>
+        <IntlHelmet title={m.mfa} />
+        <h1 className={style.title}>
+          {siteName}
+          <br />
+          <Message component="strong" content={m.mfa} />
+        </h1>
+        <hr className={style.hRule} />
+        {mfaStep === 'enrol' ? (
+          <MFAEnrolment onLogin={handleLogin} />
+        ) : (
+          <MFALogin onLogin={handleLogin} />
+        )}
+      </div>
+    )
+  }
+
   return (
     <div className={style.form}>
       <IntlHelmet title={sharedMessages.login} />
@@ -160,6 +221,21 @@ const Login = () => {
           />
         </div>
       </Form>
+      {providers.length > 0 && (
+        <>
+          <hr className={style.hRule} />
+          <div className={style.providers}>
+            {providers.map(({ id, name }) => (
+              <Button.AnchorLink
+                key={id}
+                secondary
+                href={providerLoginUrl(id, location)}
+                message={{ ...m.loginWithProvider, values: { name: name || id } }}
+              />
+            ))}
+          </div>
+        </>
+      )}
     </div>
   )
 }
@@ -0,0 +1,248 @@
+// Copyright © 2021 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+import React, { useState, useCallback, useEffect } from 'react'
+import { defineMessages } from 'react-intl'
+
+import api from '@account/api'
+
+import Button from '@ttn-lw/components/button'
+import Form from '@ttn-lw/components/form'
+import Input from '@ttn-lw/components/input'
+import SubmitButton from '@ttn-lw/components/submit-button'
+import Notification from '@ttn-lw/components/notification'
+
+import Message from '@ttn-lw/lib/components/message'
+
+import style from '@account/views/front/front.styl'
+
+import Yup from '@ttn-lw/lib/yup'
+import sharedMessages from '@ttn-lw/lib/shared-messages'
+import PropTypes from '@ttn-lw/lib/prop-types'
+
+import mfaStyle from '@account/views/login/mfa.styl'
+
+const m = defineMessages({
+  mfaDescription: 'Enter the one-time password generated by your authenticator app',
+  recoveryCodeDescription:
+    'Enter one of the recovery codes that you saved when enabling multi-factor authentication',
+  oneTimePassword: 'One-time password',
+  recoveryCode: 'Recovery code',
+  useRecoveryCode: 'Use a recovery code',
+  useOneTimePassword: 'Use a one-time password',
+  verify: 'Verify',
+  mfaEnrolmentDescription:
+    'Multi-factor authentication is required for your account. Scan the QR code with your authenticator app, or enter the secret manually, and enter the generated one-time password to continue.',
+  secret: 'Secret',
+  recoveryCodes: 'Recovery codes',
+  recoveryCodesDescription:
+    'Multi-factor authentication is enabled. Store these recovery codes in a safe place. Each code can be used once to login when you do not have access to your authenticator app.',
+  continue: 'Continue',
+  verificationFailed: 'Verification failed',
+})
+
+const codeValidationSchema = Yup.object().shape({
+  code: Yup.string()
+    .length(6, Yup.passValues(sharedMessages.validateLength))
+    .required(sharedMessages.validateRequired),
+})
+
+const recoveryCodeValidationSchema = Yup.object().shape({
+  recovery_code: Yup.string().required(sharedMessages.validateRequired).trim(),
+})
+
+const MFALogin = ({ onLogin }) => {
+  const [error, setError] = useState(undefined)
+  const [useRecoveryCode, setUseRecoveryCode] = useState(false)
+
+  const handleSubmit = useCallback(
+    async (values, { setSubmitting }) => {
+      try {
+        setError(undefined)
+        await api.account.mfaLogin(values)
+        onLogin()
+      } catch (error) {
+        setError(error)
+        setSubmitting(false)
+      }
+    },
+    [onLogin],
+  )
+
+  const toggleRecoveryCode = useCallback(() => {
+    setError(undefined)
+    setUseRecoveryCode(useRecoveryCode => !useRecoveryCode)
+  }, [])
+
+  return (
+    <>
+      <Message
+        content={useRecoveryCode ? m.recoveryCodeDescription : m.mfaDescription}
+        component="p"
+      />
+      <Form
+        key={useRecoveryCode ? 'recovery-code' : 'code'}
+        onSubmit={handleSubmit}
+        initialValues={useRecoveryCode ? { recovery_code: '' } : { code: '' }}
+        error={error}
+        errorTitle={m.verificationFailed}
+        validationSchema={useRecoveryCode ? recoveryCodeValidationSchema : codeValidationSchema}
+        horizontal={false}
+      >
+        {useRecoveryCode ? (
+          <Form.Field
+            title={m.recoveryCode}
+            name="recovery_code"
+            component={Input}
+            autoComplete="off"
+            autoFocus
+            required
+          />
+        ) : (
+          <Form.Field
+            title={m.oneTimePassword}
+            name="code"
+            component={Input}
+            autoComplete="one-time-code"
+            inputMode="numeric"
+            autoFocus
+            required
+          />
+        )}
+        <div className={style.buttons}>
+          <Form.Submit
+            component={SubmitButton}
+            message={m.verify}
+            className={style.submitButton}
+          />
+          <Button
+            type="button"
+            naked
+            secondary
+            message={useRecoveryCode ? m.useOneTimePassword : m.useRecoveryCode}
+            onClick={toggleRecoveryCode}
+          />
+        </div>
+      </Form>
+    </>
+  )
+}
+
+MFALogin.propTypes = {
+  onLogin: PropTypes.func.isRequired,
+}
+
+const MFAEnrolment = ({ onLogin }) => {
+  const [error, setError] = useState(undefined)
+  const [enrolment, setEnrolment] = useState(undefined)
+  const [recoveryCodes, setRecoveryCodes] = useState(undefined)
+
+  useEffect(() => {
+    const startEnrolment = async () => {
+      try {
+        const { data } = await api.account.mfaLoginStartTotpEnrolment()
+        setEnrolment(data)
+      } catch (error) {
+        setError(error)
+      }
+    }
+    startEnrolment()
+  }, [])
+
+  const handleSubmit = useCallback(async (values, { setSubmitting }) => {
+    try {
+      setError(undefined)
+      const { data } = await api.account.mfaLoginConfirmTotpEnrolment(values)
+      setRecoveryCodes(data.recovery_codes)
+    } catch (error) {
+      setError(error)
+      setSubmitting(false)
+    }
+  }, [])
+
+  if (recoveryCodes) {
+    return (
+      <>
+        <Message content={m.recoveryCodesDescription} component="p" />
+        <Message content={m.recoveryCodes} component="h4" />
+        <ul className={mfaStyle.recoveryCodes}>
+          {recoveryCodes.map(code => (
+            <li key={code}>
+              <code>{code}</code>
+            </li>
+          ))}
+        </ul>
+        <div className={style.buttons}>
+          <Button
+            type="button"
+            primary
+            message={m.continue}
+            className={style.submitButton}
+            onClick={onLogin}
+          />
+        </div>
+      </>
+    )
+  }
+
+  return (
+    <>
+      <Message content={m.mfaEnrolmentDescription} component="p" />
+      {enrolment ? (
+        <>
+          <img
+            className={mfaStyle.qrCode}
+            src={`data:${enrolment.qr_code.mime_type};base64,${enrolment.qr_code.data}`}
+            alt={enrolment.uri}
+          />
+          <Message content={m.secret} component="h4" />
+          <code className={mfaStyle.secret}>{enrolment.secret}</code>
+          <Form
+            onSubmit={handleSubmit}
+            initialValues={{ code: '' }}
+            error={error}
+            errorTitle={m.verificationFailed}
+            validationSchema={codeValidationSchema}
+            horizontal={false}
+          >
+            <Form.Field
+              title={m.oneTimePassword}
+              name="code"
+              component={Input}
+              autoComplete="one-time-code"
+              inputMode="numeric"
+              autoFocus
+              required
+            />
+            <div className={style.buttons}>
+              <Form.Submit
+                component={SubmitButton}
+                message={m.verify}
+                className={style.submitButton}
+              />
+            </div>
+          </Form>
+        </>
+      ) : (
+        error && <Notification error content={error} title={m.verificationFailed} small />
+      )}
+    </>
+  )
+}
+
+MFAEnrolment.propTypes = {
+  onLogin: PropTypes.func.isRequired,
+}
+
+export { MFALogin, MFAEnrolment }